@@ -33,7 +33,7 @@ func rcopy(dst, src reflect.Value) {
 			} else if dst.CanSet() {
 				dst.Set(src)
 			}
-		} else {
+		} else if !src.IsNil() {
 			e := src.Type().Elem()
 			if dst.CanSet() {
 				dst.Set(reflect.New(e))
@@ -45,6 +45,9 @@ func rcopy(dst, src reflect.Value) {
 	case reflect.Struct:
 		dst.Set(reflect.New(src.Type()).Elem())
 		for i := 0; i < src.NumField(); i++ {
+			if src.Type().Field(i).PkgPath != "" {
+				continue // unexported fields cannot be set
+			}
 			rcopy(dst.Field(i), src.Field(i))
 		}
 	case reflect.Slice:
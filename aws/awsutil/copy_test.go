@@ -90,6 +90,18 @@ func TestCopyNil(t *testing.T) {
 	assert.Equal(t, "", s)
 }
 
+func TestCopyNilPointerFields(t *testing.T) {
+	type Foo struct {
+		A *string
+		B *int
+	}
+
+	f1 := &Foo{B: new(int)}
+	f2 := awsutil.CopyOf(f1).(*Foo)
+	assert.Nil(t, f2.A)
+	assert.NotNil(t, f2.B)
+}
+
 func TestCopyReader(t *testing.T) {
 	var buf io.Reader = bytes.NewReader([]byte("hello world"))
 	var r io.Reader
@@ -49,6 +49,15 @@ func rValuesAtPath(v interface{}, path string, create bool) []reflect.Value {
 
 		nextvals := []reflect.Value{}
 		for _, value := range values {
+			if c == "*" && value.Kind() == reflect.Map { // pull all map values
+				for _, k := range value.MapKeys() {
+					if f := reflect.Indirect(value.MapIndex(k)); f.IsValid() {
+						nextvals = append(nextvals, f)
+					}
+				}
+				continue
+			}
+
 			// pull component name out of struct member
 			if value.Kind() != reflect.Struct {
 				continue
@@ -105,6 +114,9 @@ func rValuesAtPath(v interface{}, path string, create bool) []reflect.Value {
 					}
 				} else if i < 0 { // support negative indexing
 					i = value.Len() + i
+					if i < 0 { // out of bounds of a shorter slice
+						continue
+					}
 				}
 				value = reflect.Indirect(value.Index(i))
 
@@ -132,11 +144,35 @@ func ValuesAtPath(i interface{}, path string) []interface{} {
 	return nil
 }
 
-// SetValueAtPath sets an object at the lexical path inside of a structure
+// SetValueAtPath sets an object at the lexical path inside of a structure.
+// A nil object clears the member at the path, setting nil pointers to nil.
 func SetValueAtPath(i interface{}, path string, v interface{}) {
+	if v == nil {
+		clearValueAtPath(i, path)
+		return
+	}
 	if rvals := rValuesAtPath(i, path, true); rvals != nil {
 		for _, rval := range rvals {
 			rval.Set(reflect.ValueOf(v))
 		}
 	}
 }
+
+// clearValueAtPath sets the member at the lexical path inside of a
+// structure to its zero value, without creating the structures leading to
+// it.
+func clearValueAtPath(i interface{}, path string) {
+	parents := []reflect.Value{reflect.Indirect(reflect.ValueOf(i))}
+	name := path
+	if n := strings.LastIndex(path, "."); n >= 0 {
+		parents, name = rValuesAtPath(i, path[:n], false), path[n+1:]
+	}
+	for _, parent := range parents {
+		if parent.Kind() != reflect.Struct {
+			continue
+		}
+		if f := parent.FieldByName(name); f.IsValid() && f.CanSet() {
+			f.Set(reflect.Zero(f.Type()))
+		}
+	}
+}
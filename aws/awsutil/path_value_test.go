@@ -34,6 +34,15 @@ func TestValueAtPathSuccess(t *testing.T) {
 	assert.Equal(t, []interface{}{"initial"}, awsutil.ValuesAtPath(data, "A.D.X || C"))
 }
 
+func TestValueAtPathMapWildcard(t *testing.T) {
+	type Attr struct{ Status *string }
+	status := "Success"
+	v := struct{ Attrs *map[string]*Attr }{
+		Attrs: &map[string]*Attr{"key": &Attr{Status: &status}},
+	}
+	assert.Equal(t, []interface{}{"Success"}, awsutil.ValuesAtPath(v, "Attrs.*.Status"))
+}
+
 func TestValueAtPathFailure(t *testing.T) {
 	assert.Equal(t, []interface{}(nil), awsutil.ValuesAtPath(data, "C.x"))
 	assert.Equal(t, []interface{}(nil), awsutil.ValuesAtPath(data, ".x"))
@@ -42,6 +51,8 @@ func TestValueAtPathFailure(t *testing.T) {
 	assert.Equal(t, []interface{}{}, awsutil.ValuesAtPath(data, "A[3].C"))
 	assert.Equal(t, []interface{}{}, awsutil.ValuesAtPath(data, "B.B.C.Z"))
 	assert.Equal(t, []interface{}(nil), awsutil.ValuesAtPath(data, "a[-1].C"))
+	assert.Equal(t, []interface{}{}, awsutil.ValuesAtPath(Struct{A: []Struct{}}, "A[-1].C"))
+	assert.Equal(t, []interface{}{}, awsutil.ValuesAtPath(data, "A[-4].C"))
 	assert.Equal(t, []interface{}{}, awsutil.ValuesAtPath(nil, "A.B.C"))
 }
 
@@ -57,4 +68,13 @@ func TestSetValueAtPathSuccess(t *testing.T) {
 	awsutil.SetValueAtPath(&s, "B.*.C", "test0")
 	assert.Equal(t, "test0", s.B.B.C)
 	assert.Equal(t, "test0", s.B.D.C)
+
+	awsutil.SetValueAtPath(&s, "B.B.C", nil)
+	assert.Equal(t, "", s.B.B.C)
+	assert.Equal(t, "test0", s.B.D.C)
+
+	awsutil.SetValueAtPath(&s, "B.D", nil)
+	assert.Nil(t, s.B.D)
+	awsutil.SetValueAtPath(&s, "D.C", nil)
+	assert.Nil(t, s.D)
 }
@@ -1,7 +1,6 @@
 package aws
 
 import (
-	"io"
 	"net/http"
 	"os"
 )
@@ -16,29 +15,67 @@ var DefaultConfig = &Config{
 	ManualSend:              false,
 	HTTPClient:              http.DefaultClient,
 	LogLevel:                0,
-	Logger:                  os.Stdout,
+	Logger:                  NewWriterLogger(os.Stdout),
 	MaxRetries:              DEFAULT_RETRIES,
 	DisableParamValidation:  false,
 	DisableComputeChecksums: false,
 	S3ForcePathStyle:        false,
 }
 
+// DetectRegion returns the region named by $AWS_REGION or, if it is unset,
+// the region of the EC2 instance the program runs on, from its instance
+// metadata.
+func DetectRegion() (string, error) {
+	if region := os.Getenv("AWS_REGION"); region != "" {
+		return region, nil
+	}
+	return newMetadataClient().Region()
+}
+
 type Config struct {
 	Credentials             CredentialsProvider
 	Endpoint                string
+	EndpointResolver        EndpointResolver
+	UseDualStack            bool
+	UseFIPS                 bool
 	Region                  string
 	DisableSSL              bool
 	ManualSend              bool
 	HTTPClient              *http.Client
-	LogLevel                uint
-	Logger                  io.Writer
+	LogLevel                LogLevel
+	Logger                  Logger
 	MaxRetries              int
+	Retryer                 Retryer
+	RateLimiter             *RateLimiter
+	Instrumenter            Instrumenter
 	DisableParamValidation  bool
 	DisableComputeChecksums bool
 	S3ForcePathStyle        bool
+
+	// ValidateEnums makes parameter validation reject values missing from
+	// the enums of the models. Services accept values added after the
+	// models, so enums aren't checked by default.
+	ValidateEnums bool
+
+	// complete is set on configurations returned by Complete.
+	complete bool
+}
+
+// Complete returns a copy of c marked as a complete configuration, such as
+// the configuration of a client from a session. Merging a complete
+// configuration into another returns a copy of it unchanged, so clients
+// built with it don't depend on DefaultConfig.
+func (c Config) Complete() *Config {
+	c.complete = true
+	return &c
 }
 
 func (c Config) Merge(newcfg *Config) *Config {
+	if newcfg != nil && newcfg.complete {
+		cfg := *newcfg
+		return &cfg
+	}
+
 	cfg := Config{}
 
 	if newcfg != nil && newcfg.Credentials != nil {
@@ -53,6 +90,24 @@ func (c Config) Merge(newcfg *Config) *Config {
 		cfg.Endpoint = c.Endpoint
 	}
 
+	if newcfg != nil && newcfg.EndpointResolver != nil {
+		cfg.EndpointResolver = newcfg.EndpointResolver
+	} else {
+		cfg.EndpointResolver = c.EndpointResolver
+	}
+
+	if newcfg != nil && newcfg.UseDualStack {
+		cfg.UseDualStack = newcfg.UseDualStack
+	} else {
+		cfg.UseDualStack = c.UseDualStack
+	}
+
+	if newcfg != nil && newcfg.UseFIPS {
+		cfg.UseFIPS = newcfg.UseFIPS
+	} else {
+		cfg.UseFIPS = c.UseFIPS
+	}
+
 	if newcfg != nil && newcfg.Region != "" {
 		cfg.Region = newcfg.Region
 	} else {
@@ -95,6 +150,24 @@ func (c Config) Merge(newcfg *Config) *Config {
 		cfg.MaxRetries = c.MaxRetries
 	}
 
+	if newcfg != nil && newcfg.Retryer != nil {
+		cfg.Retryer = newcfg.Retryer
+	} else {
+		cfg.Retryer = c.Retryer
+	}
+
+	if newcfg != nil && newcfg.RateLimiter != nil {
+		cfg.RateLimiter = newcfg.RateLimiter
+	} else {
+		cfg.RateLimiter = c.RateLimiter
+	}
+
+	if newcfg != nil && newcfg.Instrumenter != nil {
+		cfg.Instrumenter = newcfg.Instrumenter
+	} else {
+		cfg.Instrumenter = c.Instrumenter
+	}
+
 	if newcfg != nil && newcfg.DisableParamValidation {
 		cfg.DisableParamValidation = newcfg.DisableParamValidation
 	} else {
@@ -113,5 +186,11 @@ func (c Config) Merge(newcfg *Config) *Config {
 		cfg.S3ForcePathStyle = c.S3ForcePathStyle
 	}
 
+	if newcfg != nil && newcfg.ValidateEnums {
+		cfg.ValidateEnums = newcfg.ValidateEnums
+	} else {
+		cfg.ValidateEnums = c.ValidateEnums
+	}
+
 	return &cfg
 }
@@ -1,17 +1,23 @@
 package aws
 
 import (
-	"bufio"
+	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
-	"path/filepath"
+	"os/exec"
+	"runtime"
+	"strings"
 	"sync"
 	"time"
 
-	"github.com/vaughan0/go-ini"
+	"github.com/datacratic/aws-sdk-go/aws/ec2metadata"
 )
 
 var currentTime = time.Now
@@ -22,6 +28,10 @@ type Credentials struct {
 	AccessKeyID     string
 	SecretAccessKey string
 	SessionToken    string
+
+	// Expires is when temporary credentials expire. It is zero for
+	// credentials which don't expire.
+	Expires time.Time
 }
 
 // A CredentialsProvider is a provider of credentials.
@@ -31,6 +41,62 @@ type CredentialsProvider interface {
 	Credentials() (*Credentials, error)
 }
 
+// An Expirer is a CredentialsProvider caching its credentials, which can be
+// expired so that they are refreshed by the next call to Credentials. A
+// request failing because its credentials expired expires the credentials
+// of its service and is signed again, once, if they are an Expirer.
+type Expirer interface {
+	Expire()
+}
+
+// DefaultExpiryWindow is how long before they expire cached temporary
+// credentials are refreshed.
+const DefaultExpiryWindow = 5 * time.Minute
+
+// A CredentialsCache caches the credentials of a provider, refreshing them
+// ExpiryWindow before they expire.
+type CredentialsCache struct {
+	Provider     CredentialsProvider
+	ExpiryWindow time.Duration
+
+	creds *Credentials
+	m     sync.Mutex
+}
+
+// CachedCreds returns a cache of the credentials of provider, refreshed
+// DefaultExpiryWindow before they expire.
+func CachedCreds(provider CredentialsProvider) *CredentialsCache {
+	return &CredentialsCache{Provider: provider, ExpiryWindow: DefaultExpiryWindow}
+}
+
+func (c *CredentialsCache) Credentials() (*Credentials, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if c.creds != nil && (c.creds.Expires.IsZero() || c.creds.Expires.Add(-c.ExpiryWindow).After(currentTime())) {
+		return c.creds, nil
+	}
+
+	creds, err := c.Provider.Credentials()
+	if err != nil {
+		return nil, err
+	}
+	c.creds = creds
+	return creds, nil
+}
+
+// Expire expires the cached credentials, and those of the provider if it
+// is an Expirer.
+func (c *CredentialsCache) Expire() {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.creds = nil
+	if e, ok := c.Provider.(Expirer); ok {
+		e.Expire()
+	}
+}
+
 var (
 	// ErrAccessKeyIDNotFound is returned when the AWS Access Key ID can't be
 	// found in the process's environment.
@@ -38,9 +104,26 @@ var (
 	// ErrSecretAccessKeyNotFound is returned when the AWS Secret Access Key
 	// can't be found in the process's environment.
 	ErrSecretAccessKeyNotFound = fmt.Errorf("AWS_SECRET_ACCESS_KEY or AWS_SECRET_KEY not found in environment")
+	// ErrWebIdentityNotFound is returned when the web identity token file or
+	// role can't be found in the process's environment.
+	ErrWebIdentityNotFound = fmt.Errorf("AWS_WEB_IDENTITY_TOKEN_FILE or AWS_ROLE_ARN not found in environment")
+	// ErrWebIdentityNotRegistered is returned when web identity credentials
+	// are configured, but no web identity provider is registered.
+	ErrWebIdentityNotRegistered = fmt.Errorf("web identity credentials need the stscreds package")
+	// ErrRoleProfileNotRegistered is returned when a profile assumes a
+	// role, but no role profile provider is registered.
+	ErrRoleProfileNotRegistered = fmt.Errorf("profiles assuming roles need the stscreds package")
+	// ErrContainerCredentialsNotFound is returned when the container
+	// credentials endpoint can't be found in the process's environment.
+	ErrContainerCredentialsNotFound = fmt.Errorf("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI or AWS_CONTAINER_CREDENTIALS_FULL_URI not found in environment")
 )
 
 type DefaultCredentialsProvider struct {
+	profile     CredentialsProvider
+	webIdentity CredentialsProvider
+	container   CredentialsProvider
+	iam         CredentialsProvider
+	m           sync.Mutex
 }
 
 func (p *DefaultCredentialsProvider) Credentials() (*Credentials, error) {
@@ -49,15 +132,63 @@ func (p *DefaultCredentialsProvider) Credentials() (*Credentials, error) {
 		return env.Credentials()
 	}
 
-	profile, err := ProfileCreds("", "", 10*time.Minute)
-	if err == nil {
-		profileCreds, err := profile.Credentials()
+	// the profile, web identity, container and IAM providers are kept to
+	// cache their credentials
+	p.m.Lock()
+	if p.profile == nil {
+		p.profile, _ = ProfileCreds("", "", 10*time.Minute)
+	}
+	profile := p.profile
+	p.m.Unlock()
+	if profile != nil {
+		creds, err := profile.Credentials()
 		if err == nil {
-			return profileCreds, nil
+			return creds, nil
+		}
+		// a profile configured with credentials it can't provide must not
+		// be replaced by the credentials of another principal
+		if !isNoProfileCredentials(err) {
+			return nil, err
 		}
 	}
 
-	return IAMCreds().Credentials()
+	p.m.Lock()
+	if p.webIdentity == nil {
+		// web identity credentials configured without the stscreds
+		// package would be silently replaced by the instance's
+		if p.webIdentity, err = WebIdentityCreds(); err == ErrWebIdentityNotRegistered {
+			p.m.Unlock()
+			return nil, err
+		}
+	}
+	if p.container == nil {
+		p.container, _ = ContainerCreds()
+	}
+	if p.iam == nil {
+		p.iam = IAMCreds()
+	}
+	webIdentity, container, iam := p.webIdentity, p.container, p.iam
+	p.m.Unlock()
+	if webIdentity != nil {
+		return webIdentity.Credentials()
+	}
+	if container != nil {
+		return container.Credentials()
+	}
+
+	return iam.Credentials()
+}
+
+// Expire expires the cached credentials of the providers.
+func (p *DefaultCredentialsProvider) Expire() {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	for _, provider := range []CredentialsProvider{p.profile, p.webIdentity, p.container, p.iam} {
+		if e, ok := provider.(Expirer); ok {
+			e.Expire()
+		}
+	}
 }
 
 func DefaultCreds() CredentialsProvider {
@@ -73,7 +204,15 @@ func DefaultCreds() CredentialsProvider {
 // environment provider.
 //
 // If a profile configuration file is available in the default location and has
-// a default profile configured, it returns a profile provider.
+// a default profile configured with credentials, it returns a profile
+// provider, even if the credentials can't be provided.
+//
+// If a web identity token and role are configured in the environment, it
+// returns a web identity provider, or a provider failing with
+// ErrWebIdentityNotRegistered if the stscreds package isn't imported.
+//
+// If a container credentials endpoint is configured in the environment, it
+// returns a container provider.
 //
 // Otherwise, it returns an IAM instance provider.
 func DetectCreds(accessKeyID, secretAccessKey, sessionToken string) CredentialsProvider {
@@ -87,16 +226,25 @@ func DetectCreds(accessKeyID, secretAccessKey, sessionToken string) CredentialsP
 	}
 
 	profile, err := ProfileCreds("", "", 10*time.Minute)
-	if err != nil {
-		return IAMCreds()
+	if err == nil {
+		if _, err := profile.Credentials(); err == nil || !isNoProfileCredentials(err) {
+			return profile
+		}
 	}
 
-	_, err = profile.Credentials()
-	if err != nil {
-		return IAMCreds()
+	webIdentity, err := WebIdentityCreds()
+	if err == nil {
+		return webIdentity
+	} else if err == ErrWebIdentityNotRegistered {
+		return errorCredentialsProvider{err}
+	}
+
+	container, err := ContainerCreds()
+	if err == nil {
+		return container
 	}
 
-	return profile
+	return IAMCreds()
 }
 
 // EnvCreds returns a static provider of AWS credentials from the process's
@@ -134,45 +282,88 @@ func Creds(accessKeyID, secretAccessKey, sessionToken string) CredentialsProvide
 	}
 }
 
+// A WebIdentityProviderFunc returns a provider of the credentials of
+// roleARN, assumed with the web identity token read from tokenFile. If
+// sessionName is empty, a session name is generated.
+type WebIdentityProviderFunc func(roleARN, tokenFile, sessionName string) CredentialsProvider
+
+var webIdentityProvider WebIdentityProviderFunc
+
+// RegisterWebIdentityProvider sets the function returning the providers of
+// WebIdentityCreds. The stscreds package registers its provider when it is
+// imported.
+func RegisterWebIdentityProvider(fn WebIdentityProviderFunc) {
+	webIdentityProvider = fn
+}
+
+// A RoleProfileProviderFunc returns a provider of the credentials of a
+// profile assuming a role, read from the shared credentials and config
+// files. If both files are empty, the default files are read.
+type RoleProfileProviderFunc func(credentialsFile, configFile, profile string) CredentialsProvider
+
+var roleProfileProvider RoleProfileProviderFunc
+
+// RegisterRoleProfileProvider sets the function returning the providers of
+// the profiles of ProfileCreds assuming roles. The stscreds package
+// registers its provider when it is imported.
+func RegisterRoleProfileProvider(fn RoleProfileProviderFunc) {
+	roleProfileProvider = fn
+}
+
+// WebIdentityCreds returns a provider of the credentials of the role
+// $AWS_ROLE_ARN, assumed with the web identity token in the file
+// $AWS_WEB_IDENTITY_TOKEN_FILE, in the session $AWS_ROLE_SESSION_NAME if
+// set. It returns an error if these aren't set, or if no web identity
+// provider is registered.
+func WebIdentityCreds() (CredentialsProvider, error) {
+	tokenFile := os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE")
+	roleARN := os.Getenv("AWS_ROLE_ARN")
+	if tokenFile == "" || roleARN == "" {
+		return nil, ErrWebIdentityNotFound
+	}
+	if webIdentityProvider == nil {
+		return nil, ErrWebIdentityNotRegistered
+	}
+	return webIdentityProvider(roleARN, tokenFile, os.Getenv("AWS_ROLE_SESSION_NAME")), nil
+}
+
 // IAMCreds returns a provider which pulls credentials from the local EC2
 // instance's IAM roles.
 func IAMCreds() CredentialsProvider {
 	return &iamProvider{}
 }
 
-// ProfileCreds returns a provider which pulls credentials from the profile
-// configuration file.
+// ProfileCreds returns a provider which pulls static credentials from the
+// profile configuration file. If filename is empty, the profile is read from
+// both the shared credentials and config files.
+//
+// Profiles which assume a role need the stscreds package, and fail with
+// ErrRoleProfileNotRegistered without it.
 func ProfileCreds(filename, profile string, expiry time.Duration) (CredentialsProvider, error) {
+	var configFile string
 	if filename == "" {
-		homeDir := os.Getenv("HOME") // *nix
-		if homeDir == "" {           // Windows
-			homeDir = os.Getenv("USERPROFILE")
+		var err error
+		filename, configFile, err = SharedConfigFiles()
+		if err != nil {
+			return nil, err
 		}
-		if homeDir == "" {
-			return nil, errors.New("User home directory not found.")
-		}
-
-		filename = filepath.Join(homeDir, ".aws", "credentials")
-	}
-
-	if profile == "" {
-		profile = os.Getenv("AWS_PROFILE")
-	}
-	if profile == "" {
-		profile = "default"
 	}
 
 	return &profileProvider{
-		filename: filename,
-		profile:  profile,
-		expiry:   expiry,
+		filename:   filename,
+		configFile: configFile,
+		profile:    profileName(profile),
+		expiry:     expiry,
 	}, nil
 }
 
 type profileProvider struct {
-	filename string
-	profile  string
-	expiry   time.Duration
+	filename   string
+	configFile string
+	profile    string
+	expiry     time.Duration
+	process    *ProcessCredentialsProvider
+	role       CredentialsProvider
 
 	creds      Credentials
 	m          sync.Mutex
@@ -187,101 +378,416 @@ func (p *profileProvider) Credentials() (*Credentials, error) {
 		return &p.creds, nil
 	}
 
-	config, err := ini.LoadFile(p.filename)
+	profile, err := LoadProfileFiles(p.filename, p.configFile, p.profile)
 	if err != nil {
+		if _, ok := err.(*profileNotFoundError); ok {
+			return nil, noProfileCredentialsError{err}
+		}
 		return nil, err
 	}
-	profile := config.Section(p.profile)
 
-	accessKeyID, ok := profile["aws_access_key_id"]
-	if !ok {
+	if profile.RoleARN != "" {
+		return p.roleCredentials()
+	}
+	if !profile.HasCredentials() && profile.CredentialProcess != "" {
+		return p.processCredentials(profile.CredentialProcess)
+	}
+	if profile.Credentials.AccessKeyID == "" && profile.Credentials.SecretAccessKey == "" {
+		return nil, noProfileCredentialsError{fmt.Errorf("profile %s in %s has no credentials", p.profile, p.filename)}
+	}
+	if profile.Credentials.AccessKeyID == "" {
 		return nil, fmt.Errorf("profile %s in %s did not contain aws_access_key_id", p.profile, p.filename)
 	}
-
-	secretAccessKey, ok := profile["aws_secret_access_key"]
-	if !ok {
+	if profile.Credentials.SecretAccessKey == "" {
 		return nil, fmt.Errorf("profile %s in %s did not contain aws_secret_access_key", p.profile, p.filename)
 	}
 
-	sessionToken := profile["aws_session_token"]
+	p.creds = profile.Credentials
+	p.expiration = currentTime().Add(p.expiry)
 
-	p.creds = Credentials{
-		AccessKeyID:     accessKeyID,
-		SecretAccessKey: secretAccessKey,
-		SessionToken:    sessionToken,
+	return &p.creds, nil
+}
+
+// Expire expires the cached credentials, reading the profile again.
+func (p *profileProvider) Expire() {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	p.expiration = time.Time{}
+	if p.process != nil {
+		p.process.Expire()
+	}
+	if e, ok := p.role.(Expirer); ok {
+		e.Expire()
+	}
+}
+
+// roleCredentials returns the credentials of the profile assuming a role,
+// from the registered role profile provider, which caches them. It must be
+// called with p.m held.
+func (p *profileProvider) roleCredentials() (*Credentials, error) {
+	if p.role == nil {
+		if roleProfileProvider == nil {
+			return nil, ErrRoleProfileNotRegistered
+		}
+		p.role = roleProfileProvider(p.filename, p.configFile, p.profile)
+	}
+	return p.role.Credentials()
+}
+
+// noProfileCredentialsError is returned by profile providers whose profile
+// isn't found or has no credentials, so that other providers are tried.
+type noProfileCredentialsError struct {
+	error
+}
+
+func isNoProfileCredentials(err error) bool {
+	_, ok := err.(noProfileCredentialsError)
+	return ok
+}
+
+// processCredentials returns the credentials of the credential_process of
+// the profile. It must be called with p.m held.
+func (p *profileProvider) processCredentials(command string) (*Credentials, error) {
+	if p.process == nil || p.process.Command != command {
+		p.process = ProcessCreds(command)
 	}
+	creds, err := p.process.Credentials()
+	if err != nil {
+		return nil, err
+	}
+
+	p.creds = *creds
 	p.expiration = currentTime().Add(p.expiry)
+	if exp := p.process.expiration; !exp.IsZero() && exp.Add(-DefaultExpiryWindow).Before(p.expiration) {
+		p.expiration = exp.Add(-DefaultExpiryWindow)
+	}
 
 	return &p.creds, nil
 }
 
 type iamProvider struct {
+	client     *ec2metadata.Client
 	creds      Credentials
 	m          sync.Mutex
 	expiration time.Time
 }
 
-var metadataCredentialsEndpoint = "http://169.254.169.254/latest/meta-data/iam/security-credentials/"
+// metadataEndpoint is the address of the EC2 instance metadata service.
+var metadataEndpoint = ec2metadata.DefaultEndpoint
 
 // IAMClient is the HTTP client used to query the metadata endpoint for IAM
-// credentials.
+// credentials and the instance region.
 var IAMClient = http.Client{
 	Timeout: 1 * time.Second,
 }
 
+// newMetadataClient returns a client of the EC2 instance metadata service
+// using IAMClient.
+func newMetadataClient() *ec2metadata.Client {
+	return &ec2metadata.Client{
+		Endpoint:   metadataEndpoint,
+		HTTPClient: &IAMClient,
+		MaxRetries: ec2metadata.DefaultMaxRetries,
+	}
+}
+
 func (p *iamProvider) Credentials() (*Credentials, error) {
 	p.m.Lock()
 	defer p.m.Unlock()
 
-	if p.expiration.After(currentTime()) {
+	if p.expiration.Add(-DefaultExpiryWindow).After(currentTime()) {
 		return &p.creds, nil
 	}
 
-	var body struct {
-		Expiration      time.Time
-		AccessKeyID     string
-		SecretAccessKey string
-		Token           string
+	if p.client == nil {
+		p.client = newMetadataClient()
 	}
 
-	resp, err := IAMClient.Get(metadataCredentialsEndpoint)
+	roles, err := p.client.IAMRoles()
 	if err != nil {
-		return nil, fmt.Errorf("listing IAM credentials")
+		return nil, fmt.Errorf("listing IAM credentials: %s", err)
 	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-
-	// Take the first line of the body of the metadata endpoint
-	s := bufio.NewScanner(resp.Body)
-	if !s.Scan() {
+	if len(roles) == 0 {
 		return nil, fmt.Errorf("unable to find default IAM credentials")
-	} else if s.Err() != nil {
-		return nil, fmt.Errorf("%s listing IAM credentials", s.Err())
 	}
 
-	resp, err = IAMClient.Get(metadataCredentialsEndpoint + s.Text())
+	// Take the first role of the instance
+	body, err := p.client.IAMSecurityCredentials(roles[0])
+	if err != nil {
+		return nil, fmt.Errorf("getting %s IAM credentials: %s", roles[0], err)
+	}
+
+	p.creds = Credentials{
+		AccessKeyID:     body.AccessKeyID,
+		SecretAccessKey: body.SecretAccessKey,
+		SessionToken:    body.Token,
+		Expires:         body.Expiration,
+	}
+	p.expiration = body.Expiration
+
+	return &p.creds, nil
+}
+
+// Expire expires the cached credentials.
+func (p *iamProvider) Expire() {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	p.expiration = time.Time{}
+}
+
+// credentialsBody is the JSON format of the credentials served by the
+// container credentials endpoint.
+type credentialsBody struct {
+	Expiration      time.Time
+	AccessKeyID     string
+	SecretAccessKey string
+	Token           string
+}
+
+func (b *credentialsBody) credentials() Credentials {
+	return Credentials{
+		AccessKeyID:     b.AccessKeyID,
+		SecretAccessKey: b.SecretAccessKey,
+		SessionToken:    b.Token,
+		Expires:         b.Expiration,
+	}
+}
+
+// containerCredentialsHost is the address of the ECS container credentials
+// endpoint, for relative URIs.
+var containerCredentialsHost = "http://169.254.170.2"
+
+// containerCredentialsIPs are the link-local addresses of the ECS and EKS
+// credentials endpoints, which may be used in full URIs.
+var containerCredentialsIPs = map[string]bool{
+	"169.254.170.2":  true,
+	"169.254.170.23": true,
+	"fd00:ec2::23":   true,
+}
+
+// ContainerClient is the HTTP client used to query the container credentials
+// endpoint.
+var ContainerClient = http.Client{
+	Timeout: 5 * time.Second,
+}
+
+// ContainerCreds returns a provider which pulls credentials from the
+// container credentials endpoint, such as the task role of an ECS task. The
+// endpoint is $AWS_CONTAINER_CREDENTIALS_RELATIVE_URI on the ECS agent, or
+// else $AWS_CONTAINER_CREDENTIALS_FULL_URI, which must be a loopback or
+// container credentials address unless it uses https.
+// $AWS_CONTAINER_AUTHORIZATION_TOKEN, if set, is sent in the Authorization
+// header.
+func ContainerCreds() (CredentialsProvider, error) {
+	endpoint := os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI")
+	if endpoint != "" {
+		endpoint = containerCredentialsHost + endpoint
+	} else {
+		endpoint = os.Getenv("AWS_CONTAINER_CREDENTIALS_FULL_URI")
+		if endpoint == "" {
+			return nil, ErrContainerCredentialsNotFound
+		}
+		if err := validateContainerEndpoint(endpoint); err != nil {
+			return nil, err
+		}
+	}
+
+	return &containerProvider{
+		endpoint:  endpoint,
+		authToken: os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN"),
+	}, nil
+}
+
+// validateContainerEndpoint returns an error if credentials could be sent
+// in clear to a remote host.
+func validateContainerEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid AWS_CONTAINER_CREDENTIALS_FULL_URI: %s", err)
+	}
+	if u.Scheme == "https" {
+		return nil
+	}
+	if u.Scheme != "http" {
+		return fmt.Errorf("AWS_CONTAINER_CREDENTIALS_FULL_URI %s must use http or https", endpoint)
+	}
+
+	host := u.Hostname()
+	if host == "localhost" || containerCredentialsIPs[host] {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+	return fmt.Errorf("AWS_CONTAINER_CREDENTIALS_FULL_URI %s must use https or a loopback host", endpoint)
+}
+
+type containerProvider struct {
+	endpoint  string
+	authToken string
+
+	creds      Credentials
+	m          sync.Mutex
+	expiration time.Time
+}
+
+func (p *containerProvider) Credentials() (*Credentials, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.expiration.Add(-DefaultExpiryWindow).After(currentTime()) {
+		return &p.creds, nil
+	}
+
+	req, err := http.NewRequest("GET", p.endpoint, nil)
 	if err != nil {
-		return nil, fmt.Errorf("getting %s IAM credentials", s.Text())
+		return nil, err
+	}
+	if p.authToken != "" {
+		req.Header.Set("Authorization", p.authToken)
+	}
+
+	resp, err := ContainerClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("getting container credentials: %s", err)
 	}
 	defer func() {
 		_ = resp.Body.Close()
 	}()
 
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("getting container credentials: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	var body credentialsBody
 	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
-		return nil, fmt.Errorf("decoding %s IAM credentials", s.Text())
+		return nil, fmt.Errorf("decoding container credentials: %s", err)
+	}
+
+	p.creds = body.credentials()
+	p.expiration = body.Expiration
+
+	return &p.creds, nil
+}
+
+// Expire expires the cached credentials.
+func (p *containerProvider) Expire() {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	p.expiration = time.Time{}
+}
+
+// DefaultProcessTimeout is the time ProcessCreds allows a credential process
+// to run.
+const DefaultProcessTimeout = time.Minute
+
+// A ProcessCredentialsProvider provides the credentials printed by an
+// external command, such as the credential_process of a profile. The
+// command must print a JSON object with Version 1, AccessKeyId,
+// SecretAccessKey, and optionally SessionToken and Expiration. The
+// credentials are cached until DefaultExpiryWindow before they expire;
+// credentials without an Expiration never expire.
+type ProcessCredentialsProvider struct {
+	// Command is the command line, run by the shell.
+	Command string
+
+	// Timeout is the time the command may run before it is killed. Zero
+	// means no timeout.
+	Timeout time.Duration
+
+	creds      Credentials
+	m          sync.Mutex
+	expiration time.Time
+	fetched    bool
+}
+
+// ProcessCreds returns a provider of the credentials printed by command.
+func ProcessCreds(command string) *ProcessCredentialsProvider {
+	return &ProcessCredentialsProvider{
+		Command: command,
+		Timeout: DefaultProcessTimeout,
+	}
+}
+
+func (p *ProcessCredentialsProvider) Credentials() (*Credentials, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.fetched && (p.expiration.IsZero() || p.expiration.Add(-DefaultExpiryWindow).After(currentTime())) {
+		return &p.creds, nil
+	}
+
+	ctx := context.Background()
+	if p.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", p.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", p.Command)
+	}
+	// don't wait for children of the shell keeping its output open
+	cmd.WaitDelay = time.Second
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			err = fmt.Errorf("timed out after %s", p.Timeout)
+		}
+		return nil, fmt.Errorf("credential process %q failed: %s: %s",
+			p.Command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var body struct {
+		Version         int
+		AccessKeyID     string `json:"AccessKeyId"`
+		SecretAccessKey string
+		SessionToken    string
+		Expiration      *time.Time
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &body); err != nil {
+		return nil, fmt.Errorf("decoding output of credential process %q: %s", p.Command, err)
+	}
+	if body.Version != 1 {
+		return nil, fmt.Errorf("credential process %q printed unsupported version %d", p.Command, body.Version)
+	}
+	if body.AccessKeyID == "" || body.SecretAccessKey == "" {
+		return nil, fmt.Errorf("credential process %q printed no AccessKeyId or SecretAccessKey", p.Command)
 	}
 
 	p.creds = Credentials{
 		AccessKeyID:     body.AccessKeyID,
 		SecretAccessKey: body.SecretAccessKey,
-		SessionToken:    body.Token,
+		SessionToken:    body.SessionToken,
 	}
-	p.expiration = body.Expiration
+	p.expiration = time.Time{}
+	if body.Expiration != nil {
+		p.expiration = *body.Expiration
+	}
+	p.creds.Expires = p.expiration
+	p.fetched = true
 
 	return &p.creds, nil
 }
 
+// Expire expires the cached credentials, running the command again.
+func (p *ProcessCredentialsProvider) Expire() {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	p.fetched = false
+}
+
 type staticCredentialsProvider struct {
 	creds Credentials
 }
@@ -289,3 +795,11 @@ type staticCredentialsProvider struct {
 func (p staticCredentialsProvider) Credentials() (*Credentials, error) {
 	return &p.creds, nil
 }
+
+type errorCredentialsProvider struct {
+	err error
+}
+
+func (p errorCredentialsProvider) Credentials() (*Credentials, error) {
+	return nil, p.err
+}
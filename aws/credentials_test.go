@@ -2,9 +2,12 @@ package aws
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 )
@@ -84,8 +87,10 @@ func TestEnvCredsAlternateNames(t *testing.T) {
 
 func TestIAMCreds(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.RequestURI == "/" {
-			fmt.Fprintln(w, "/creds")
+		if r.Method == "PUT" {
+			fmt.Fprint(w, "metadataToken")
+		} else if r.URL.Path == "/latest/meta-data/iam/security-credentials/" {
+			fmt.Fprintln(w, "creds")
 		} else {
 			fmt.Fprintln(w, `{
   "AccessKeyId" : "accessKey",
@@ -98,9 +103,9 @@ func TestIAMCreds(t *testing.T) {
 	defer server.Close()
 
 	defer func(s string) {
-		metadataCredentialsEndpoint = s
-	}(metadataCredentialsEndpoint)
-	metadataCredentialsEndpoint = server.URL
+		metadataEndpoint = s
+	}(metadataEndpoint)
+	metadataEndpoint = server.URL
 
 	defer func() {
 		currentTime = time.Now
@@ -225,8 +230,10 @@ func BenchmarkProfileCreds(b *testing.B) {
 
 func BenchmarkIAMCreds(b *testing.B) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.RequestURI == "/" {
-			fmt.Fprintln(w, "/creds")
+		if r.Method == "PUT" {
+			fmt.Fprint(w, "metadataToken")
+		} else if r.URL.Path == "/latest/meta-data/iam/security-credentials/" {
+			fmt.Fprintln(w, "creds")
 		} else {
 			fmt.Fprintln(w, `{
   "AccessKeyId" : "accessKey",
@@ -239,9 +246,9 @@ func BenchmarkIAMCreds(b *testing.B) {
 	defer server.Close()
 
 	defer func(s string) {
-		metadataCredentialsEndpoint = s
-	}(metadataCredentialsEndpoint)
-	metadataCredentialsEndpoint = server.URL
+		metadataEndpoint = s
+	}(metadataEndpoint)
+	metadataEndpoint = server.URL
 
 	defer func() {
 		currentTime = time.Now
@@ -263,3 +270,520 @@ func BenchmarkIAMCreds(b *testing.B) {
 		}
 	})
 }
+
+func TestWebIdentityCreds(t *testing.T) {
+	os.Clearenv()
+	if _, err := WebIdentityCreds(); err != ErrWebIdentityNotFound {
+		t.Errorf("ErrWebIdentityNotFound expected, but was %v", err)
+	}
+
+	os.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", "/var/run/token")
+	os.Setenv("AWS_ROLE_ARN", "arn:aws:iam::123456789012:role/web")
+	if _, err := WebIdentityCreds(); err != ErrWebIdentityNotRegistered {
+		t.Errorf("ErrWebIdentityNotRegistered expected, but was %v", err)
+	}
+
+	os.Setenv("HOME", os.TempDir())
+	if _, err := DefaultCreds().Credentials(); err != ErrWebIdentityNotRegistered {
+		t.Errorf("ErrWebIdentityNotRegistered expected from the default provider, but was %v", err)
+	}
+	if _, err := DetectCreds("", "", "").Credentials(); err != ErrWebIdentityNotRegistered {
+		t.Errorf("ErrWebIdentityNotRegistered expected from the detected provider, but was %v", err)
+	}
+}
+
+func TestDefaultCredsWebIdentity(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HOME", os.TempDir())
+	os.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", "/var/run/token")
+	os.Setenv("AWS_ROLE_ARN", "arn:aws:iam::123456789012:role/web")
+
+	calls := 0
+	RegisterWebIdentityProvider(func(roleARN, tokenFile, sessionName string) CredentialsProvider {
+		calls++
+		return Creds(roleARN, tokenFile, sessionName)
+	})
+	defer RegisterWebIdentityProvider(nil)
+
+	p := DefaultCreds()
+	for i := 0; i < 2; i++ {
+		creds, err := p.Credentials()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v, want := creds.AccessKeyID, "arn:aws:iam::123456789012:role/web"; v != want {
+			t.Errorf("AccessKeyID was %v, expected %v", v, want)
+		}
+		if v, want := creds.SecretAccessKey, "/var/run/token"; v != want {
+			t.Errorf("SecretAccessKey was %v, expected %v", v, want)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected the web identity provider to be created once, but was %d times", calls)
+	}
+
+	if _, ok := DetectCreds("", "", "").(staticCredentialsProvider); !ok {
+		t.Errorf("Expected DetectCreds to return the web identity provider")
+	}
+}
+
+// testPath is the PATH of the process credentials commands, saved before
+// tests clear the environment.
+var testPath = os.Getenv("PATH")
+
+func TestProcessCreds(t *testing.T) {
+	os.Setenv("PATH", testPath)
+	dir, err := ioutil.TempDir("", "process-creds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	runs := filepath.Join(dir, "runs")
+
+	expiration := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	prov := ProcessCreds(fmt.Sprintf(`echo run >> %s; printf '{"Version": 1, "AccessKeyId": "access", "SecretAccessKey": "secret", "SessionToken": "token", "Expiration": "%s"}'`, runs, expiration.Format(time.RFC3339)))
+
+	for i := 0; i < 2; i++ {
+		creds, err := prov.Credentials()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v, want := *creds, (Credentials{"access", "secret", "token", expiration}); v != want {
+			t.Errorf("Credentials were %+v, expected %+v", v, want)
+		}
+	}
+	if b, _ := ioutil.ReadFile(runs); string(b) != "run\n" {
+		t.Errorf("Expected the process to run once, but ran %q", b)
+	}
+
+	defer func() { currentTime = time.Now }()
+	currentTime = func() time.Time { return time.Now().Add(56 * time.Minute) }
+	if _, err := prov.Credentials(); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := ioutil.ReadFile(runs); string(b) != "run\nrun\n" {
+		t.Errorf("Expected the process to run again shortly before expiration, but ran %q", b)
+	}
+}
+
+func TestProcessCredsErrors(t *testing.T) {
+	os.Setenv("PATH", testPath)
+	cases := []struct {
+		command string
+		timeout time.Duration
+		err     string
+	}{
+		{`echo "access denied" >&2; exit 3`, 0, "exit status 3: access denied"},
+		{`sleep 5`, 50 * time.Millisecond, "timed out after 50ms"},
+		{`echo not json`, 0, "decoding output"},
+		{`echo '{"Version": 2, "AccessKeyId": "access", "SecretAccessKey": "secret"}'`, 0, "unsupported version 2"},
+		{`echo '{"Version": 1, "AccessKeyId": "access"}'`, 0, "no AccessKeyId or SecretAccessKey"},
+	}
+
+	for _, c := range cases {
+		prov := &ProcessCredentialsProvider{Command: c.command, Timeout: c.timeout}
+		_, err := prov.Credentials()
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("Expected error containing %q for %q, but was %v", c.err, c.command, err)
+		}
+	}
+}
+
+func TestProfileCredsCredentialProcess(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PATH", testPath)
+
+	dir, err := ioutil.TempDir("", "process-creds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	configFile := filepath.Join(dir, "config")
+	ioutil.WriteFile(configFile, []byte(`
+[profile process]
+credential_process = echo '{"Version": 1, "AccessKeyId": "processKey", "SecretAccessKey": "processSecret"}'
+`), 0600)
+	os.Setenv("AWS_CONFIG_FILE", configFile)
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(dir, "credentials"))
+
+	prov, err := ProfileCreds("", "process", 10*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	creds, err := prov.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := creds.AccessKeyID, "processKey"; v != want {
+		t.Errorf("AccessKeyID was %v, expected %v", v, want)
+	}
+}
+
+func TestDefaultCredsCredentialProcess(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PATH", testPath)
+
+	dir, err := ioutil.TempDir("", "process-creds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	runs := filepath.Join(dir, "runs")
+	expiration := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	configFile := filepath.Join(dir, "config")
+	ioutil.WriteFile(configFile, []byte(fmt.Sprintf(`
+[default]
+credential_process = sh -c 'echo run >> %s; echo {\"Version\": 1, \"AccessKeyId\": \"processKey\", \"SecretAccessKey\": \"processSecret\", \"Expiration\": \"%s\"}'
+`, runs, expiration)), 0600)
+	os.Setenv("AWS_CONFIG_FILE", configFile)
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(dir, "credentials"))
+
+	p := DefaultCreds()
+	for i := 0; i < 2; i++ {
+		creds, err := p.Credentials()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v, want := creds.AccessKeyID, "processKey"; v != want {
+			t.Errorf("AccessKeyID was %v, expected %v", v, want)
+		}
+	}
+	if b, _ := ioutil.ReadFile(runs); string(b) != "run\n" {
+		t.Errorf("Expected the process to run once, but ran %q", b)
+	}
+
+	defer func() { currentTime = time.Now }()
+	currentTime = func() time.Time { return time.Now().Add(56 * time.Minute) }
+	if _, err := p.Credentials(); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := ioutil.ReadFile(runs); string(b) != "run\nrun\n" {
+		t.Errorf("Expected the process to run again shortly before expiration, but ran %q", b)
+	}
+}
+
+func TestDefaultCredsRoleProfile(t *testing.T) {
+	os.Clearenv()
+
+	dir, err := ioutil.TempDir("", "role-profile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	configFile := filepath.Join(dir, "config")
+	credentialsFile := filepath.Join(dir, "credentials")
+	ioutil.WriteFile(configFile, []byte(`
+[default]
+role_arn = arn:aws:iam::123456789012:role/dev
+source_profile = base
+
+[profile base]
+aws_access_key_id = baseKey
+aws_secret_access_key = baseSecret
+`), 0600)
+	os.Setenv("AWS_CONFIG_FILE", configFile)
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", credentialsFile)
+
+	// without stscreds, the role profile fails instead of falling through
+	// to the instance role
+	if _, err := DefaultCreds().Credentials(); err != ErrRoleProfileNotRegistered {
+		t.Errorf("ErrRoleProfileNotRegistered expected from the default provider, but was %v", err)
+	}
+	if _, err := DetectCreds("", "", "").Credentials(); err != ErrRoleProfileNotRegistered {
+		t.Errorf("ErrRoleProfileNotRegistered expected from the detected provider, but was %v", err)
+	}
+
+	var files []string
+	RegisterRoleProfileProvider(func(credentialsFile, configFile, profile string) CredentialsProvider {
+		files = append(files, credentialsFile, configFile)
+		return Creds("roleKey", "roleSecret", profile)
+	})
+	defer RegisterRoleProfileProvider(nil)
+
+	p := DefaultCreds()
+	for i := 0; i < 2; i++ {
+		creds, err := p.Credentials()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v, want := creds.AccessKeyID, "roleKey"; v != want {
+			t.Errorf("AccessKeyID was %v, expected %v", v, want)
+		}
+		if v, want := creds.SessionToken, "default"; v != want {
+			t.Errorf("SessionToken was %v, expected %v", v, want)
+		}
+	}
+	if v, want := fmt.Sprint(files), fmt.Sprint([]string{credentialsFile, configFile}); v != want {
+		t.Errorf("Expected the role provider to be created once with %v, but was %v", want, v)
+	}
+}
+
+func TestProfileCredsWithoutCredentials(t *testing.T) {
+	os.Clearenv()
+
+	dir, err := ioutil.TempDir("", "region-profile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	configFile := filepath.Join(dir, "config")
+	ioutil.WriteFile(configFile, []byte("[default]\nregion = eu-west-1\n"), 0600)
+	os.Setenv("AWS_CONFIG_FILE", configFile)
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(dir, "credentials"))
+
+	p, err := ProfileCreds("", "", 10*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Credentials(); !isNoProfileCredentials(err) {
+		t.Errorf("Expected the profile to have no credentials, but was %v", err)
+	}
+}
+
+func TestContainerCreds(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/v2/credentials/task" {
+			t.Errorf("Path was %v, expected /v2/credentials/task", r.URL.Path)
+		}
+		if v, want := r.Header.Get("Authorization"), "auth-token"; v != want {
+			t.Errorf("Authorization was %v, expected %v", v, want)
+		}
+		fmt.Fprintln(w, `{
+  "AccessKeyId" : "accessKey",
+  "SecretAccessKey" : "secret",
+  "Token" : "token",
+  "Expiration" : "2014-12-16T01:51:37Z"
+}`)
+	}))
+	defer server.Close()
+
+	defer func(s string) {
+		containerCredentialsHost = s
+	}(containerCredentialsHost)
+	containerCredentialsHost = server.URL
+
+	now := time.Date(2014, 12, 16, 1, 0, 0, 0, time.UTC)
+	defer func() {
+		currentTime = time.Now
+	}()
+	currentTime = func() time.Time {
+		return now
+	}
+
+	os.Clearenv()
+	os.Setenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI", "/v2/credentials/task")
+	os.Setenv("AWS_CONTAINER_AUTHORIZATION_TOKEN", "auth-token")
+
+	prov, err := ContainerCreds()
+	if err != nil {
+		t.Fatal(err)
+	}
+	creds, err := prov.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := *creds, (Credentials{"accessKey", "secret", "token", time.Date(2014, 12, 16, 1, 51, 37, 0, time.UTC)}); v != want {
+		t.Errorf("Credentials were %+v, expected %+v", v, want)
+	}
+
+	// refreshed shortly before expiration
+	prov.Credentials()
+	if requests != 1 {
+		t.Errorf("Expected 1 request, but was %d", requests)
+	}
+	now = now.Add(47 * time.Minute)
+	prov.Credentials()
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, but was %d", requests)
+	}
+
+	if _, ok := DetectCreds("", "", "").(*containerProvider); !ok {
+		t.Errorf("Expected DetectCreds to return the container provider")
+	}
+}
+
+func TestContainerCredsFullURI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprintln(w, "invalid token")
+	}))
+	defer server.Close()
+
+	os.Clearenv()
+	if _, err := ContainerCreds(); err != ErrContainerCredentialsNotFound {
+		t.Errorf("ErrContainerCredentialsNotFound expected, but was %v", err)
+	}
+
+	for _, uri := range []string{"http://10.0.0.1/creds", "ftp://localhost/creds", "http://example.com/creds"} {
+		os.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", uri)
+		if _, err := ContainerCreds(); err == nil {
+			t.Errorf("Expected an error for %s", uri)
+		}
+	}
+	for _, uri := range []string{"https://example.com/creds", "http://localhost:8080/creds", "http://[::1]/creds", "http://169.254.170.23/v1/credentials"} {
+		os.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", uri)
+		if _, err := ContainerCreds(); err != nil {
+			t.Errorf("Expected no error for %s, but was %v", uri, err)
+		}
+	}
+
+	os.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", server.URL)
+	prov, err := ContainerCreds()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = prov.Credentials()
+	if err == nil || !strings.Contains(err.Error(), "403 Forbidden: invalid token") {
+		t.Errorf("Expected a 403 error, but was %v", err)
+	}
+}
+
+// countingProvider returns credentials expiring at expires, counting its
+// calls and expirations.
+type countingProvider struct {
+	expires time.Time
+	calls   int
+	expired int
+}
+
+func (p *countingProvider) Credentials() (*Credentials, error) {
+	p.calls++
+	return &Credentials{
+		AccessKeyID:     fmt.Sprintf("access%d", p.calls),
+		SecretAccessKey: "secret",
+		Expires:         p.expires,
+	}, nil
+}
+
+func (p *countingProvider) Expire() {
+	p.expired++
+}
+
+func TestCachedCreds(t *testing.T) {
+	now := time.Date(2014, 12, 16, 1, 0, 0, 0, time.UTC)
+	defer func() {
+		currentTime = time.Now
+	}()
+	currentTime = func() time.Time {
+		return now
+	}
+
+	provider := &countingProvider{expires: now.Add(time.Hour)}
+	cache := CachedCreds(provider)
+
+	for i := 0; i < 2; i++ {
+		creds, err := cache.Credentials()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v, want := creds.AccessKeyID, "access1"; v != want {
+			t.Errorf("AccessKeyID was %v, expected %v", v, want)
+		}
+	}
+
+	// refreshed within the expiry window
+	now = now.Add(56 * time.Minute)
+	creds, _ := cache.Credentials()
+	if v, want := creds.AccessKeyID, "access2"; v != want {
+		t.Errorf("AccessKeyID was %v, expected %v", v, want)
+	}
+
+	cache.Expire()
+	if provider.expired != 1 {
+		t.Errorf("Expected the provider to be expired once, but was %d times", provider.expired)
+	}
+	creds, _ = cache.Credentials()
+	if v, want := creds.AccessKeyID, "access3"; v != want {
+		t.Errorf("AccessKeyID was %v, expected %v", v, want)
+	}
+}
+
+func TestCachedCredsWithoutExpiry(t *testing.T) {
+	provider := &countingProvider{}
+	cache := CachedCreds(provider)
+	cache.Credentials()
+	cache.Credentials()
+	if provider.calls != 1 {
+		t.Errorf("Expected 1 call, but was %d", provider.calls)
+	}
+}
+
+func TestIAMCredsExpire(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			fmt.Fprint(w, "metadataToken")
+			return
+		}
+		requests++
+		if r.URL.Path == "/latest/meta-data/iam/security-credentials/" {
+			fmt.Fprintln(w, "creds")
+		} else {
+			fmt.Fprintf(w, `{"AccessKeyId": "accessKey", "SecretAccessKey": "secret", "Token": "token", "Expiration": "%s"}`,
+				time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+		}
+	}))
+	defer server.Close()
+
+	defer func(s string) {
+		metadataEndpoint = s
+	}(metadataEndpoint)
+	metadataEndpoint = server.URL
+
+	prov := IAMCreds()
+	creds, err := prov.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.Expires.IsZero() {
+		t.Errorf("Expected the credentials to expire")
+	}
+	prov.Credentials()
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, but was %d", requests)
+	}
+
+	prov.(Expirer).Expire()
+	prov.Credentials()
+	if requests != 4 {
+		t.Errorf("Expected 4 requests after expiring, but was %d", requests)
+	}
+}
+
+func TestDetectRegion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			http.Error(w, "", http.StatusForbidden)
+		} else if r.URL.Path == "/latest/dynamic/instance-identity/document" {
+			fmt.Fprint(w, `{"availabilityZone": "eu-west-1b", "region": "eu-west-1"}`)
+		} else {
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	defer func(s string) {
+		metadataEndpoint = s
+	}(metadataEndpoint)
+	metadataEndpoint = server.URL
+
+	os.Clearenv()
+	region, err := DetectRegion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := region, "eu-west-1"; v != want {
+		t.Errorf("Region was %v, but expected %v", v, want)
+	}
+
+	os.Setenv("AWS_REGION", "us-west-2")
+	region, err = DetectRegion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := region, "us-west-2"; v != want {
+		t.Errorf("Region was %v, but expected %v", v, want)
+	}
+}
@@ -0,0 +1,228 @@
+package ec2metadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// An InstanceIdentityDocument describes an instance.
+type InstanceIdentityDocument struct {
+	AccountID               string    `json:"accountId"`
+	Architecture            string    `json:"architecture"`
+	AvailabilityZone        string    `json:"availabilityZone"`
+	BillingProducts         []string  `json:"billingProducts"`
+	DevpayProductCodes      []string  `json:"devpayProductCodes"`
+	ImageID                 string    `json:"imageId"`
+	InstanceID              string    `json:"instanceId"`
+	InstanceType            string    `json:"instanceType"`
+	KernelID                string    `json:"kernelId"`
+	MarketplaceProductCodes []string  `json:"marketplaceProductCodes"`
+	PendingTime             time.Time `json:"pendingTime"`
+	PrivateIP               string    `json:"privateIp"`
+	RamdiskID               string    `json:"ramdiskId"`
+	Region                  string    `json:"region"`
+	Version                 string    `json:"version"`
+}
+
+// GetInstanceIdentityDocument returns the identity document of the
+// instance.
+func (c *Client) GetInstanceIdentityDocument() (*InstanceIdentityDocument, error) {
+	body, err := c.GetDynamicData("instance-identity/document")
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &InstanceIdentityDocument{}
+	if err := json.Unmarshal([]byte(body), doc); err != nil {
+		return nil, fmt.Errorf("ec2metadata: decoding instance identity document: %s", err)
+	}
+	return doc, nil
+}
+
+// AvailabilityZone returns the availability zone of the instance.
+func (c *Client) AvailabilityZone() (string, error) {
+	return c.GetMetadata("placement/availability-zone")
+}
+
+// Region returns the region of the instance, from its identity document.
+// The region can't be derived from the availability zone, as the zones of
+// Local Zones and Wavelength Zones have longer names, such as
+// us-west-2-lax-1a.
+func (c *Client) Region() (string, error) {
+	doc, err := c.GetInstanceIdentityDocument()
+	if err != nil {
+		return "", err
+	}
+	if doc.Region == "" {
+		return "", fmt.Errorf("ec2metadata: no region in instance identity document")
+	}
+	return doc.Region, nil
+}
+
+// IAMInfo describes the instance profile of an instance.
+type IAMInfo struct {
+	Code               string
+	LastUpdated        time.Time
+	InstanceProfileArn string
+	InstanceProfileID  string `json:"InstanceProfileId"`
+}
+
+// IAMInfo returns the instance profile of the instance.
+func (c *Client) IAMInfo() (*IAMInfo, error) {
+	body, err := c.GetMetadata("iam/info")
+	if err != nil {
+		return nil, err
+	}
+
+	info := &IAMInfo{}
+	if err := json.Unmarshal([]byte(body), info); err != nil {
+		return nil, fmt.Errorf("ec2metadata: decoding IAM info: %s", err)
+	}
+	if info.Code != "Success" {
+		return nil, fmt.Errorf("ec2metadata: IAM info unavailable: %s", info.Code)
+	}
+	return info, nil
+}
+
+// IAMRoles returns the names of the IAM roles with credentials available to
+// the instance.
+func (c *Client) IAMRoles() ([]string, error) {
+	body, err := c.GetMetadata("iam/security-credentials/")
+	if err != nil {
+		return nil, err
+	}
+	return lines(body), nil
+}
+
+// SecurityCredentials are the credentials of an IAM role of an instance.
+type SecurityCredentials struct {
+	Code            string
+	LastUpdated     time.Time
+	Type            string
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string
+	Token           string
+	Expiration      time.Time
+}
+
+// IAMSecurityCredentials returns the credentials of an IAM role of the
+// instance.
+func (c *Client) IAMSecurityCredentials(role string) (*SecurityCredentials, error) {
+	body, err := c.GetMetadata("iam/security-credentials/" + role)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := &SecurityCredentials{}
+	if err := json.Unmarshal([]byte(body), creds); err != nil {
+		return nil, fmt.Errorf("ec2metadata: decoding %s credentials: %s", role, err)
+	}
+	if creds.Code != "" && creds.Code != "Success" {
+		return nil, fmt.Errorf("ec2metadata: %s credentials unavailable: %s", role, creds.Code)
+	}
+	return creds, nil
+}
+
+// A NetworkInterface is a network interface attached to an instance.
+type NetworkInterface struct {
+	MAC              string
+	DeviceNumber     int
+	InterfaceID      string
+	SubnetID         string
+	VPCID            string
+	PrivateIPv4s     []string
+	PublicIPv4s      []string
+	SecurityGroupIDs []string
+}
+
+// NetworkInterfaces returns the network interfaces of the instance, by
+// device number.
+func (c *Client) NetworkInterfaces() ([]NetworkInterface, error) {
+	body, err := c.GetMetadata("network/interfaces/macs/")
+	if err != nil {
+		return nil, err
+	}
+
+	var ifaces []NetworkInterface
+	for _, mac := range lines(body) {
+		iface, err := c.networkInterface(strings.TrimSuffix(mac, "/"))
+		if err != nil {
+			return nil, err
+		}
+		// keep the interfaces sorted by device number
+		i := len(ifaces)
+		for i > 0 && ifaces[i-1].DeviceNumber > iface.DeviceNumber {
+			i--
+		}
+		ifaces = append(ifaces, NetworkInterface{})
+		copy(ifaces[i+1:], ifaces[i:])
+		ifaces[i] = *iface
+	}
+	return ifaces, nil
+}
+
+// networkInterface returns the network interface with the given MAC
+// address.
+func (c *Client) networkInterface(mac string) (*NetworkInterface, error) {
+	prefix := "network/interfaces/macs/" + mac + "/"
+
+	// get returns the value of the interface's metadata, or "" if it is
+	// absent, as public addresses are on interfaces without any
+	get := func(name string) (string, error) {
+		v, err := c.GetMetadata(prefix + name)
+		if IsNotFound(err) {
+			return "", nil
+		}
+		return v, err
+	}
+
+	iface := &NetworkInterface{MAC: mac}
+	fields := []struct {
+		name  string
+		value *string
+		list  *[]string
+	}{
+		{name: "interface-id", value: &iface.InterfaceID},
+		{name: "subnet-id", value: &iface.SubnetID},
+		{name: "vpc-id", value: &iface.VPCID},
+		{name: "local-ipv4s", list: &iface.PrivateIPv4s},
+		{name: "public-ipv4s", list: &iface.PublicIPv4s},
+		{name: "security-group-ids", list: &iface.SecurityGroupIDs},
+	}
+	for _, f := range fields {
+		v, err := get(f.name)
+		if err != nil {
+			return nil, err
+		}
+		if f.value != nil {
+			*f.value = v
+		} else {
+			*f.list = lines(v)
+		}
+	}
+
+	device, err := get("device-number")
+	if err != nil {
+		return nil, err
+	}
+	if device != "" {
+		if iface.DeviceNumber, err = strconv.Atoi(device); err != nil {
+			return nil, fmt.Errorf("ec2metadata: invalid device number %q of %s", device, mac)
+		}
+	}
+	return iface, nil
+}
+
+// lines returns the non-empty lines of a metadata listing.
+func lines(body string) []string {
+	var l []string
+	for _, line := range strings.Split(body, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			l = append(l, line)
+		}
+	}
+	return l
+}
@@ -0,0 +1,231 @@
+// Package ec2metadata provides a client for the EC2 instance metadata
+// service, which serves information about the instance a program runs on,
+// such as its identity, region, network interfaces and IAM role credentials.
+//
+// The client uses session tokens (IMDSv2), falling back to tokenless
+// requests (IMDSv1) where the token endpoint is not available.
+package ec2metadata
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultEndpoint is the address of the instance metadata service.
+const DefaultEndpoint = "http://169.254.169.254"
+
+// Defaults of the Client.
+const (
+	DefaultMaxRetries = 3
+	DefaultTokenTTL   = 6 * time.Hour
+)
+
+const (
+	tokenPath      = "/latest/api/token"
+	tokenHeader    = "X-Aws-Ec2-Metadata-Token"
+	tokenTTLHeader = "X-Aws-Ec2-Metadata-Token-Ttl-Seconds"
+
+	// tokenExpiryWindow is how long before it expires a token is renewed.
+	tokenExpiryWindow = time.Minute
+)
+
+var sleepDelay = func(delay time.Duration) {
+	time.Sleep(delay)
+}
+
+var currentTime = time.Now
+
+// A Client queries the instance metadata service. Its zero value is not
+// usable; use New, or set Endpoint and HTTPClient. A Client is safe for
+// concurrent use.
+type Client struct {
+	// Endpoint is the address of the metadata service.
+	Endpoint string
+
+	HTTPClient *http.Client
+
+	// MaxRetries is the number of times a request failing with a server or
+	// network error is retried.
+	MaxRetries int
+
+	// TokenTTL is the lifetime of the session tokens requested. It defaults
+	// to DefaultTokenTTL.
+	TokenTTL time.Duration
+
+	// DisableFallback makes requests fail, instead of falling back to
+	// IMDSv1, when no session token can be obtained.
+	DisableFallback bool
+
+	m            sync.Mutex
+	token        string
+	tokenExpires time.Time
+	tokenless    bool
+}
+
+// New returns a client of the metadata service at DefaultEndpoint, with a
+// short timeout so that programs not running on EC2 don't wait long.
+func New() *Client {
+	return &Client{
+		Endpoint:   DefaultEndpoint,
+		HTTPClient: &http.Client{Timeout: 5 * time.Second},
+		MaxRetries: DefaultMaxRetries,
+	}
+}
+
+// A RequestError is returned when a metadata request fails.
+type RequestError struct {
+	Path string
+
+	// StatusCode is the HTTP status of the response, or zero if no response
+	// was received.
+	StatusCode int
+
+	Err error
+}
+
+func (e *RequestError) Error() string {
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("ec2metadata: %s: %d %s", e.Path, e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("ec2metadata: %s: %s", e.Path, e.Err)
+}
+
+// IsNotFound returns whether err is the error of a request for metadata
+// which doesn't exist, such as the user data of an instance without any.
+func IsNotFound(err error) bool {
+	rerr, ok := err.(*RequestError)
+	return ok && rerr.StatusCode == http.StatusNotFound
+}
+
+// Available returns whether the metadata service can be reached.
+func (c *Client) Available() bool {
+	_, err := c.GetMetadata("instance-id")
+	return err == nil
+}
+
+// GetMetadata returns the instance metadata at path, relative to
+// /latest/meta-data/.
+func (c *Client) GetMetadata(path string) (string, error) {
+	return c.get("/latest/meta-data/" + strings.TrimPrefix(path, "/"))
+}
+
+// GetDynamicData returns the dynamic data at path, relative to
+// /latest/dynamic/.
+func (c *Client) GetDynamicData(path string) (string, error) {
+	return c.get("/latest/dynamic/" + strings.TrimPrefix(path, "/"))
+}
+
+// GetUserData returns the user data of the instance.
+func (c *Client) GetUserData() (string, error) {
+	return c.get("/latest/user-data")
+}
+
+// get returns the body of the metadata at path, using a session token if
+// one can be obtained.
+func (c *Client) get(path string) (string, error) {
+	token, err := c.getToken(false)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := c.do("GET", path, token)
+	if rerr, ok := err.(*RequestError); ok && rerr.StatusCode == http.StatusUnauthorized && token != "" {
+		// the token was revoked or expired early
+		if token, err = c.getToken(true); err != nil {
+			return "", err
+		}
+		body, err = c.do("GET", path, token)
+	}
+	return body, err
+}
+
+// getToken returns the current session token, requesting a new one if it
+// is about to expire, or if renew is set. It returns an empty token if the
+// metadata service doesn't support tokens and fallback is allowed.
+func (c *Client) getToken(renew bool) (string, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if c.tokenless {
+		return "", nil
+	}
+	if !renew && c.token != "" && c.tokenExpires.Add(-tokenExpiryWindow).After(currentTime()) {
+		return c.token, nil
+	}
+
+	ttl := c.TokenTTL
+	if ttl == 0 {
+		ttl = DefaultTokenTTL
+	}
+	token, err := c.do("PUT", tokenPath, strconv.Itoa(int(ttl/time.Second)))
+	if err != nil {
+		c.token = ""
+		if c.DisableFallback {
+			return "", err
+		}
+		// remember that the service doesn't support tokens, unless the
+		// failure may be temporary
+		if rerr, ok := err.(*RequestError); ok && rerr.StatusCode != 0 && rerr.StatusCode < 500 {
+			c.tokenless = true
+		}
+		return "", nil
+	}
+
+	c.token = token
+	c.tokenExpires = currentTime().Add(ttl)
+	return c.token, nil
+}
+
+// do sends a request, retrying server and network errors. For PUT requests
+// of a token, arg is the token TTL; for GET requests, arg is the token.
+func (c *Client) do(method, path, arg string) (string, error) {
+	var err error
+	for retry := 0; ; retry++ {
+		var body string
+		var retryable bool
+		body, retryable, err = c.send(method, path, arg)
+		if err == nil {
+			return body, nil
+		}
+		if !retryable || retry >= c.MaxRetries {
+			return "", err
+		}
+		sleepDelay(time.Duration(1<<uint(retry)) * 100 * time.Millisecond)
+	}
+}
+
+// send sends a request, returning whether its error may be retried.
+func (c *Client) send(method, path, arg string) (string, bool, error) {
+	req, err := http.NewRequest(method, strings.TrimSuffix(c.Endpoint, "/")+path, nil)
+	if err != nil {
+		return "", false, &RequestError{Path: path, Err: err}
+	}
+	if method == "PUT" {
+		req.Header.Set(tokenTTLHeader, arg)
+	} else if arg != "" {
+		req.Header.Set(tokenHeader, arg)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		// don't retry timeouts, as the service is likely unreachable
+		nerr, ok := err.(net.Error)
+		return "", !ok || !nerr.Timeout(), &RequestError{Path: path, Err: err}
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", true, &RequestError{Path: path, Err: err}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", resp.StatusCode >= 500, &RequestError{Path: path, StatusCode: resp.StatusCode}
+	}
+	return string(body), false, nil
+}
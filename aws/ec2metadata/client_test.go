@@ -0,0 +1,303 @@
+package ec2metadata
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// metadataServer is a stand-in of the instance metadata service, serving
+// the metadata in data by path.
+type metadataServer struct {
+	*httptest.Server
+
+	data map[string]string
+
+	// tokenStatus is the status of token requests, or 0 to issue tokens.
+	tokenStatus int
+
+	// failures is the number of requests failed with a server error before
+	// serving them.
+	failures int
+
+	tokens   int
+	requests int
+	headers  []string
+}
+
+func newMetadataServer(data map[string]string) *metadataServer {
+	s := &metadataServer{data: data}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" && r.URL.Path == "/latest/api/token" {
+			if s.tokenStatus != 0 {
+				http.Error(w, "", s.tokenStatus)
+				return
+			}
+			s.tokens++
+			fmt.Fprintf(w, "token-%d", s.tokens)
+			return
+		}
+
+		s.requests++
+		s.headers = append(s.headers, r.Header.Get("X-Aws-Ec2-Metadata-Token"))
+		if s.failures > 0 {
+			s.failures--
+			http.Error(w, "", http.StatusInternalServerError)
+			return
+		}
+		if token := r.Header.Get("X-Aws-Ec2-Metadata-Token"); token != "" && token != fmt.Sprintf("token-%d", s.tokens) {
+			http.Error(w, "", http.StatusUnauthorized)
+			return
+		}
+		body, ok := s.data[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, body)
+	}))
+	return s
+}
+
+func (s *metadataServer) client() *Client {
+	c := New()
+	c.Endpoint = s.URL
+	return c
+}
+
+func init() {
+	sleepDelay = func(time.Duration) {}
+}
+
+func TestGetMetadataToken(t *testing.T) {
+	s := newMetadataServer(map[string]string{"/latest/meta-data/instance-id": "i-1234"})
+	defer s.Close()
+	c := s.client()
+
+	id, err := c.GetMetadata("instance-id")
+	assert.NoError(t, err)
+	assert.Equal(t, "i-1234", id)
+	assert.True(t, c.Available())
+
+	// the token is reused
+	assert.Equal(t, 1, s.tokens)
+	assert.Equal(t, []string{"token-1", "token-1"}, s.headers)
+
+	// and renewed before it expires
+	defer func() { currentTime = time.Now }()
+	currentTime = func() time.Time { return time.Now().Add(DefaultTokenTTL) }
+	_, err = c.GetMetadata("instance-id")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, s.tokens)
+	assert.Equal(t, "token-2", s.headers[2])
+}
+
+func TestGetMetadataTokenRevoked(t *testing.T) {
+	s := newMetadataServer(map[string]string{"/latest/meta-data/instance-id": "i-1234"})
+	defer s.Close()
+	c := s.client()
+
+	_, err := c.GetMetadata("instance-id")
+	assert.NoError(t, err)
+
+	// a new token is issued by another client
+	s.tokens++
+	id, err := c.GetMetadata("instance-id")
+	assert.NoError(t, err)
+	assert.Equal(t, "i-1234", id)
+	assert.Equal(t, []string{"token-1", "token-1", "token-3"}, s.headers)
+}
+
+func TestGetMetadataFallback(t *testing.T) {
+	s := newMetadataServer(map[string]string{"/latest/meta-data/instance-id": "i-1234"})
+	s.tokenStatus = http.StatusForbidden
+	defer s.Close()
+	c := s.client()
+
+	id, err := c.GetMetadata("instance-id")
+	assert.NoError(t, err)
+	assert.Equal(t, "i-1234", id)
+	assert.Equal(t, []string{""}, s.headers)
+
+	// tokens are not requested again
+	s.tokenStatus = 0
+	_, err = c.GetMetadata("instance-id")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, s.tokens)
+
+	c = s.client()
+	c.DisableFallback = true
+	s.tokenStatus = http.StatusForbidden
+	_, err = c.GetMetadata("instance-id")
+	assert.Error(t, err)
+	assert.Equal(t, 2, s.requests)
+}
+
+func TestGetMetadataRetry(t *testing.T) {
+	s := newMetadataServer(map[string]string{"/latest/meta-data/instance-id": "i-1234"})
+	defer s.Close()
+	c := s.client()
+
+	s.failures = DefaultMaxRetries
+	id, err := c.GetMetadata("instance-id")
+	assert.NoError(t, err)
+	assert.Equal(t, "i-1234", id)
+	assert.Equal(t, DefaultMaxRetries+1, s.requests)
+
+	s.failures = DefaultMaxRetries + 1
+	_, err = c.GetMetadata("instance-id")
+	assert.Equal(t, http.StatusInternalServerError, err.(*RequestError).StatusCode)
+
+	// not found is not retried
+	s.requests = 0
+	_, err = c.GetUserData()
+	assert.True(t, IsNotFound(err))
+	assert.Equal(t, 1, s.requests)
+}
+
+func TestGetMetadataUnreachable(t *testing.T) {
+	s := newMetadataServer(nil)
+	c := s.client()
+	s.Close()
+
+	_, err := c.GetMetadata("instance-id")
+	assert.Error(t, err)
+	assert.False(t, IsNotFound(err))
+	assert.False(t, c.Available())
+}
+
+func TestInstanceIdentity(t *testing.T) {
+	s := newMetadataServer(map[string]string{
+		"/latest/dynamic/instance-identity/document": `{
+  "accountId" : "123456789012",
+  "architecture" : "x86_64",
+  "availabilityZone" : "us-east-1d",
+  "imageId" : "ami-5fb8c835",
+  "instanceId" : "i-1234567890abcdef0",
+  "instanceType" : "t2.micro",
+  "pendingTime" : "2016-11-19T16:32:11Z",
+  "privateIp" : "10.158.112.84",
+  "region" : "us-east-1",
+  "version" : "2017-09-30"
+}`,
+		"/latest/meta-data/placement/availability-zone": "us-east-1d",
+		"/latest/user-data":                             "#!/bin/sh\necho hello\n",
+	})
+	defer s.Close()
+	c := s.client()
+
+	doc, err := c.GetInstanceIdentityDocument()
+	assert.NoError(t, err)
+	assert.Equal(t, "123456789012", doc.AccountID)
+	assert.Equal(t, "i-1234567890abcdef0", doc.InstanceID)
+	assert.Equal(t, "us-east-1", doc.Region)
+	assert.Equal(t, "10.158.112.84", doc.PrivateIP)
+	assert.Equal(t, time.Date(2016, 11, 19, 16, 32, 11, 0, time.UTC), doc.PendingTime)
+
+	az, err := c.AvailabilityZone()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-east-1d", az)
+
+	region, err := c.Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-east-1", region)
+
+	data, err := c.GetUserData()
+	assert.NoError(t, err)
+	assert.Equal(t, "#!/bin/sh\necho hello\n", data)
+}
+
+func TestRegionOfLocalZone(t *testing.T) {
+	s := newMetadataServer(map[string]string{
+		"/latest/dynamic/instance-identity/document":    `{"availabilityZone": "us-west-2-lax-1a", "region": "us-west-2"}`,
+		"/latest/meta-data/placement/availability-zone": "us-west-2-lax-1a",
+	})
+	defer s.Close()
+
+	region, err := s.client().Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-west-2", region)
+}
+
+func TestIAM(t *testing.T) {
+	s := newMetadataServer(map[string]string{
+		"/latest/meta-data/iam/info": `{
+  "Code" : "Success",
+  "LastUpdated" : "2016-11-19T16:32:11Z",
+  "InstanceProfileArn" : "arn:aws:iam::123456789012:instance-profile/web",
+  "InstanceProfileId" : "AIPAABCDEFGHIJKLMN123"
+}`,
+		"/latest/meta-data/iam/security-credentials/":    "web\n",
+		"/latest/meta-data/iam/security-credentials/web": `{"Code": "Success", "Type": "AWS-HMAC", "AccessKeyId": "accessKey", "SecretAccessKey": "secret", "Token": "token", "Expiration": "2016-11-20T00:00:00Z"}`,
+	})
+	defer s.Close()
+	c := s.client()
+
+	info, err := c.IAMInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, "arn:aws:iam::123456789012:instance-profile/web", info.InstanceProfileArn)
+	assert.Equal(t, "AIPAABCDEFGHIJKLMN123", info.InstanceProfileID)
+
+	roles, err := c.IAMRoles()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"web"}, roles)
+
+	creds, err := c.IAMSecurityCredentials("web")
+	assert.NoError(t, err)
+	assert.Equal(t, "accessKey", creds.AccessKeyID)
+	assert.Equal(t, "secret", creds.SecretAccessKey)
+	assert.Equal(t, "token", creds.Token)
+	assert.Equal(t, time.Date(2016, 11, 20, 0, 0, 0, 0, time.UTC), creds.Expiration)
+
+	_, err = c.IAMSecurityCredentials("other")
+	assert.True(t, IsNotFound(err))
+}
+
+func TestNetworkInterfaces(t *testing.T) {
+	macs := "/latest/meta-data/network/interfaces/macs/"
+	s := newMetadataServer(map[string]string{
+		macs:                                          "0e:00:00:00:00:02/\n0e:00:00:00:00:01/",
+		macs + "0e:00:00:00:00:01/device-number":      "0",
+		macs + "0e:00:00:00:00:01/interface-id":       "eni-1",
+		macs + "0e:00:00:00:00:01/subnet-id":          "subnet-1",
+		macs + "0e:00:00:00:00:01/vpc-id":             "vpc-1",
+		macs + "0e:00:00:00:00:01/local-ipv4s":        "10.0.0.1\n10.0.0.2",
+		macs + "0e:00:00:00:00:01/public-ipv4s":       "54.0.0.1",
+		macs + "0e:00:00:00:00:01/security-group-ids": "sg-1\nsg-2",
+		macs + "0e:00:00:00:00:02/device-number":      "1",
+		macs + "0e:00:00:00:00:02/interface-id":       "eni-2",
+		macs + "0e:00:00:00:00:02/subnet-id":          "subnet-2",
+		macs + "0e:00:00:00:00:02/vpc-id":             "vpc-1",
+		macs + "0e:00:00:00:00:02/local-ipv4s":        "10.0.1.1",
+		macs + "0e:00:00:00:00:02/security-group-ids": "sg-1",
+	})
+	defer s.Close()
+
+	ifaces, err := s.client().NetworkInterfaces()
+	assert.NoError(t, err)
+	assert.Equal(t, []NetworkInterface{
+		{
+			MAC:              "0e:00:00:00:00:01",
+			DeviceNumber:     0,
+			InterfaceID:      "eni-1",
+			SubnetID:         "subnet-1",
+			VPCID:            "vpc-1",
+			PrivateIPv4s:     []string{"10.0.0.1", "10.0.0.2"},
+			PublicIPv4s:      []string{"54.0.0.1"},
+			SecurityGroupIDs: []string{"sg-1", "sg-2"},
+		},
+		{
+			MAC:              "0e:00:00:00:00:02",
+			DeviceNumber:     1,
+			InterfaceID:      "eni-2",
+			SubnetID:         "subnet-2",
+			VPCID:            "vpc-1",
+			PrivateIPv4s:     []string{"10.0.1.1"},
+			SecurityGroupIDs: []string{"sg-1"},
+		},
+	}, ifaces)
+}
@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"os"
+	"strings"
+
+	"github.com/datacratic/aws-sdk-go/internal/endpoints"
+)
+
+// An Endpoint is the resolved endpoint of a service in a region.
+type Endpoint struct {
+	// URL is the endpoint's URL. Without a scheme, https is used, or http if
+	// SSL is disabled.
+	URL string
+
+	// SigningRegion and SigningName are the region and service name
+	// requests are signed for. If empty, the configured region and the
+	// service name are used.
+	SigningRegion string
+	SigningName   string
+}
+
+// An EndpointResolver resolves the endpoints of services, by the service's
+// endpoint prefix, such as "s3" or "sqs", and region.
+type EndpointResolver interface {
+	ResolveEndpoint(service, region string) (Endpoint, error)
+}
+
+// EndpointResolverFunc is an adapter to use a function as an
+// EndpointResolver.
+type EndpointResolverFunc func(service, region string) (Endpoint, error)
+
+// ResolveEndpoint calls f(service, region).
+func (f EndpointResolverFunc) ResolveEndpoint(service, region string) (Endpoint, error) {
+	return f(service, region)
+}
+
+// DefaultEndpointResolver resolves the standard endpoints of services, in
+// the DNS domain of the region's partition, such as amazonaws.com.cn for
+// the China regions.
+var DefaultEndpointResolver EndpointResolver = StandardEndpointResolver{}
+
+// A StandardEndpointResolver resolves the standard endpoints of services,
+// or their dual-stack or FIPS variants. Resolving a variant a service
+// doesn't have in a region fails.
+type StandardEndpointResolver struct {
+	UseDualStack bool
+	UseFIPS      bool
+}
+
+// ResolveEndpoint resolves the endpoint of the service in the region.
+func (r StandardEndpointResolver) ResolveEndpoint(service, region string) (Endpoint, error) {
+	var variant endpoints.Variant
+	if r.UseFIPS {
+		variant |= endpoints.FIPSVariant
+	}
+	if r.UseDualStack {
+		variant |= endpoints.DualStackVariant
+	}
+
+	url, signingRegion, err := endpoints.EndpointVariantForRegion(service, region, variant)
+	if err != nil {
+		return Endpoint{}, err
+	}
+	return Endpoint{URL: url, SigningRegion: signingRegion}, nil
+}
+
+// EnvEndpoint returns the endpoint of the service set in the environment,
+// by $AWS_ENDPOINT_URL_<SERVICE>, where SERVICE is the upper-cased
+// endpoint prefix of the service with dashes replaced by underscores, such
+// as AWS_ENDPOINT_URL_DYNAMODB, or else by $AWS_ENDPOINT_URL for all
+// services. It returns "" if neither is set.
+func EnvEndpoint(service string) string {
+	name := strings.ToUpper(strings.Replace(service, "-", "_", -1))
+	if endpoint := os.Getenv("AWS_ENDPOINT_URL_" + name); endpoint != "" {
+		return endpoint
+	}
+	return os.Getenv("AWS_ENDPOINT_URL")
+}
@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newEndpointService(name string, config *Config) *Service {
+	svc := &Service{Config: config, ServiceName: name}
+	svc.Initialize()
+	return svc
+}
+
+func TestEndpointResolver(t *testing.T) {
+	os.Clearenv()
+	resolver := EndpointResolverFunc(func(service, region string) (Endpoint, error) {
+		switch service {
+		case "s3":
+			return Endpoint{URL: "http://localhost:9000", SigningRegion: "local"}, nil
+		case "sqs":
+			return Endpoint{URL: "localhost:9324", SigningName: "queue"}, nil
+		}
+		return DefaultEndpointResolver.ResolveEndpoint(service, region)
+	})
+	config := &Config{Region: "us-west-2", EndpointResolver: resolver}
+
+	s3 := newEndpointService("s3", config)
+	assert.Equal(t, "http://localhost:9000", s3.Endpoint)
+	assert.Equal(t, "local", s3.SigningRegion)
+
+	sqs := newEndpointService("sqs", config)
+	assert.Equal(t, "https://localhost:9324", sqs.Endpoint)
+	assert.Equal(t, "queue", sqs.SigningName)
+
+	dynamodb := newEndpointService("dynamodb", config)
+	assert.Equal(t, "https://dynamodb.us-west-2.amazonaws.com", dynamodb.Endpoint)
+	assert.Equal(t, "", dynamodb.SigningRegion)
+
+	// an explicit endpoint takes precedence
+	sqs = newEndpointService("sqs", &Config{Region: "us-west-2", Endpoint: "http://sqs.local", EndpointResolver: resolver})
+	assert.Equal(t, "http://sqs.local", sqs.Endpoint)
+}
+
+func TestDefaultEndpointResolverPartitions(t *testing.T) {
+	os.Clearenv()
+	svc := newEndpointService("sqs", &Config{Region: "cn-northwest-1"})
+	assert.Equal(t, "https://sqs.cn-northwest-1.amazonaws.com.cn", svc.Endpoint)
+
+	svc = newEndpointService("iam", &Config{Region: "us-gov-west-1"})
+	assert.Equal(t, "https://iam.us-gov.amazonaws.com", svc.Endpoint)
+	assert.Equal(t, "us-gov-west-1", svc.SigningRegion)
+}
+
+func TestEnvEndpoint(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_ENDPOINT_URL_DYNAMODB", "http://localhost:8000")
+	os.Setenv("AWS_ENDPOINT_URL_ELASTIC_BEANSTALK", "http://localhost:8001")
+	os.Setenv("AWS_ENDPOINT_URL", "http://localhost:4566")
+	defer os.Clearenv()
+
+	assert.Equal(t, "http://localhost:8000", EnvEndpoint("dynamodb"))
+	assert.Equal(t, "http://localhost:8001", EnvEndpoint("elastic-beanstalk"))
+	assert.Equal(t, "http://localhost:4566", EnvEndpoint("sqs"))
+
+	svc := newEndpointService("dynamodb", &Config{Region: "us-east-1"})
+	assert.Equal(t, "http://localhost:8000", svc.Endpoint)
+
+	// an explicit resolver or endpoint takes precedence over the environment
+	resolver := EndpointResolverFunc(func(service, region string) (Endpoint, error) {
+		return Endpoint{URL: "http://resolved"}, nil
+	})
+	svc = newEndpointService("sqs", &Config{Region: "us-east-1", EndpointResolver: resolver})
+	assert.Equal(t, "http://resolved", svc.Endpoint)
+	svc = newEndpointService("sqs", &Config{Region: "us-east-1", Endpoint: "http://explicit"})
+	assert.Equal(t, "http://explicit", svc.Endpoint)
+}
+
+func TestEndpointResolverError(t *testing.T) {
+	os.Clearenv()
+	resolver := EndpointResolverFunc(func(service, region string) (Endpoint, error) {
+		return Endpoint{}, errors.New("no endpoint for " + service)
+	})
+	svc := newEndpointService("sqs", &Config{Region: "us-east-1", EndpointResolver: resolver})
+	svc.Handlers.Clear()
+	svc.Handlers.Validate.PushBack(ValidateEndpointHandler)
+
+	req := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	assert.EqualError(t, req.Build(), "no endpoint for sqs")
+}
+
+func TestEndpointVariants(t *testing.T) {
+	os.Clearenv()
+	svc := newEndpointService("s3", &Config{Region: "us-west-2", UseDualStack: true})
+	assert.Equal(t, "https://s3.dualstack.us-west-2.amazonaws.com", svc.Endpoint)
+
+	svc = newEndpointService("dynamodb", &Config{Region: "us-east-1", UseFIPS: true})
+	assert.Equal(t, "https://dynamodb-fips.us-east-1.amazonaws.com", svc.Endpoint)
+
+	svc = newEndpointService("s3", &Config{Region: "us-east-1", UseDualStack: true, UseFIPS: true})
+	assert.Equal(t, "https://s3-fips.dualstack.us-east-1.amazonaws.com", svc.Endpoint)
+
+	// a service without the variant fails its requests
+	svc = newEndpointService("sqs", &Config{Region: "us-east-1", UseDualStack: true})
+	svc.Handlers.Clear()
+	svc.Handlers.Validate.PushBack(ValidateEndpointHandler)
+	req := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	assert.EqualError(t, req.Build(), "no dual-stack endpoint for sqs in us-east-1")
+
+	// the flags are merged
+	config := DefaultConfig.Merge(&Config{UseDualStack: true, UseFIPS: true})
+	assert.True(t, config.UseDualStack)
+	assert.True(t, config.UseFIPS)
+}
@@ -6,18 +6,55 @@ type APIError struct {
 	Code       string
 	Message    string
 	RequestID  string
+
+	// OrigErr is the error causing the APIError, if any, such as the
+	// context error of a RequestCanceled error.
+	OrigErr error
 }
 
 func (e APIError) Error() string {
 	return e.Code + ": " + e.Message
 }
 
+// Unwrap returns the error causing e, so errors.Is(err, context.Canceled)
+// reports whether a request was canceled.
+func (e APIError) Unwrap() error {
+	return e.OrigErr
+}
+
+// A ServiceError is an error carrying an APIError. The typed errors
+// generated for each service's modeled errors embed an APIError and so
+// implement ServiceError, as does *APIError itself.
+type ServiceError interface {
+	error
+	BaseError() *APIError
+}
+
+// BaseError returns the APIError itself.
+func (e *APIError) BaseError() *APIError {
+	return e
+}
+
+// Error returns the APIError carried by e, or nil if e is not an API error.
 func Error(e error) *APIError {
-	if err, ok := e.(*APIError); ok {
-		return err
+	if err, ok := e.(ServiceError); ok {
+		return err.BaseError()
 	} else if err, ok := e.(APIError); ok {
 		return &err
 	} else {
 		return nil
 	}
 }
+
+// ErrCodeRequestCanceled is the error code of the APIError returned when a
+// request is aborted because its context was canceled or its deadline was
+// exceeded.
+const ErrCodeRequestCanceled = "RequestCanceled"
+
+func newRequestCanceledError(err error) *APIError {
+	return &APIError{
+		Code:    ErrCodeRequestCanceled,
+		Message: "request context canceled: " + err.Error(),
+		OrigErr: err,
+	}
+}
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strconv"
@@ -11,6 +12,24 @@ var sleepDelay = func(delay time.Duration) {
 	time.Sleep(delay)
 }
 
+// sleepWithContext waits for delay, returning early with the context's error
+// if ctx is done first.
+func sleepWithContext(ctx context.Context, delay time.Duration) error {
+	if ctx.Done() == nil {
+		sleepDelay(delay)
+		return nil
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
 type lener interface {
 	Len() int
 }
@@ -46,7 +65,11 @@ func UserAgentHandler(r *Request) {
 }
 
 func SendHandler(r *Request) {
-	r.HTTPResponse, r.Error = r.Service.Config.HTTPClient.Do(r.HTTPRequest)
+	if r.Error != nil {
+		// an earlier Send handler, such as the rate limiter, failed
+		return
+	}
+	r.HTTPResponse, r.Error = r.Service.Config.HTTPClient.Do(r.HTTPRequest.WithContext(r.Context()))
 }
 
 func ValidateResponseHandler(r *Request) {
@@ -57,13 +80,26 @@ func ValidateResponseHandler(r *Request) {
 			Message:    "unknown error",
 		}
 	}
-	r.Retryable = r.Service.ShouldRetry(r)
-	r.RetryDelay = r.Service.RetryRules(r)
+}
+
+// RetryHandler decides whether a failed request is retried, unless an
+// earlier handler already marked it retryable, and computes the retry delay.
+func RetryHandler(r *Request) {
+	retryer := r.retryer()
+	if !r.Retryable {
+		r.Retryable = retryer.ShouldRetry(r)
+	}
+	if r.WillRetry() {
+		r.RetryDelay = retryer.RetryRules(r)
+	}
 }
 
 func AfterRetryHandler(r *Request) {
 	if r.WillRetry() {
-		sleepDelay(r.RetryDelay)
+		if err := sleepWithContext(r.Context(), r.RetryDelay); err != nil {
+			r.Error = newRequestCanceledError(err)
+			return
+		}
 
 		r.RetryCount++
 		r.Retryable = false
@@ -77,7 +113,9 @@ var (
 )
 
 func ValidateEndpointHandler(r *Request) {
-	if r.Service.SigningRegion == "" && r.Service.Config.Region == "" {
+	if r.Service.endpointErr != nil {
+		r.Error = r.Service.endpointErr
+	} else if r.Service.SigningRegion == "" && r.Service.Config.Region == "" {
 		r.Error = ErrMissingRegion
 	} else if r.Service.Endpoint == "" {
 		r.Error = ErrMissingEndpoint
@@ -0,0 +1,206 @@
+package aws
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// An Instrumenter observes the API calls of the clients it is configured
+// on, to export their metrics or traces. A call is a request with all its
+// attempts: the first send and any retries. The methods are called from the
+// goroutine sending the request, and must not block it.
+type Instrumenter interface {
+	// AttemptStart is called before an attempt's HTTP request is sent.
+	AttemptStart(call CallMetrics, attempt AttemptMetrics)
+
+	// AttemptEnd is called once an attempt's response is handled, with its
+	// outcome, and the delay before the next attempt if it is retried.
+	AttemptEnd(call CallMetrics, attempt AttemptMetrics)
+
+	// CallEnd is called once a call succeeds or fails for good.
+	CallEnd(call CallMetrics)
+}
+
+// A TraceInjector is an Instrumenter propagating traces to AWS, by adding
+// headers such as X-Amzn-Trace-Id to the HTTP requests of calls, from the
+// trace of the request's context.
+type TraceInjector interface {
+	InjectTrace(ctx context.Context, header http.Header)
+}
+
+// CallMetrics describe an API call. The outcome of the call is only set
+// for CallEnd.
+type CallMetrics struct {
+	Service   string
+	Operation string
+	Region    string
+	Start     time.Time
+
+	Latency    time.Duration
+	Attempts   int
+	RetryDelay time.Duration // total delay between attempts
+	StatusCode int
+	RequestID  string
+	ErrorCode  string // empty if the call succeeded
+	Err        error
+}
+
+// AttemptMetrics describe an attempt of an API call. The outcome of the
+// attempt is only set for AttemptEnd.
+type AttemptMetrics struct {
+	Attempt int // from 1
+	Start   time.Time
+
+	Latency       time.Duration
+	StatusCode    int
+	RequestID     string
+	ErrorCode     string // empty if the attempt succeeded
+	Err           error
+	BytesSent     int64
+	BytesReceived int64
+	Retried       bool
+	RetryDelay    time.Duration
+}
+
+// instrumentation is the state of the instrumentation of a request.
+type instrumentation struct {
+	instrumenter Instrumenter
+	call         CallMetrics
+	attempt      AttemptMetrics
+	started      bool // whether an attempt is in progress
+	received     *countingReader
+}
+
+// newInstrumentation returns the state of the instrumentation of a call of
+// r, starting now.
+func newInstrumentation(r *Request, instrumenter Instrumenter) *instrumentation {
+	return &instrumentation{
+		instrumenter: instrumenter,
+		call: CallMetrics{
+			Service:   r.Service.ServiceName,
+			Operation: r.Operation.Name,
+			Region:    r.Service.Config.Region,
+			Start:     currentTime(),
+		},
+	}
+}
+
+// addInstrumentationHandlers adds the handlers reporting the attempts of
+// the calls of a service to instrumenter. Calls are started and ended by
+// Request.Send, so that calls failing before they are sent are reported.
+func addInstrumentationHandlers(handlers *Handlers, instrumenter Instrumenter) {
+	if injector, ok := instrumenter.(TraceInjector); ok {
+		handlers.Build.PushBack(func(r *Request) {
+			injector.InjectTrace(r.Context(), r.HTTPRequest.Header)
+		})
+	}
+
+	handlers.Send.PushFront(func(r *Request) {
+		in := r.instrumentation
+		if in == nil {
+			return
+		}
+		// an attempt ended by re-signing expired credentials
+		in.endAttempt(r)
+
+		in.call.Attempts++
+		in.attempt = AttemptMetrics{
+			Attempt:   in.call.Attempts,
+			Start:     currentTime(),
+			BytesSent: r.HTTPRequest.ContentLength,
+		}
+		in.started = true
+		in.received = nil
+		in.instrumenter.AttemptStart(in.call, in.attempt)
+	})
+
+	handlers.Send.PushBack(func(r *Request) {
+		if in := r.instrumentation; in != nil && r.HTTPResponse != nil && r.HTTPResponse.Body != nil {
+			in.received = &countingReader{ReadCloser: r.HTTPResponse.Body}
+			r.HTTPResponse.Body = in.received
+		}
+	})
+
+	// failed attempts end once their retry is decided, before the delay
+	handlers.AfterRetry.PushFront(func(r *Request) {
+		if in := r.instrumentation; in != nil {
+			in.endAttempt(r)
+		}
+	})
+}
+
+// endAttempt reports the end of the attempt in progress, if any.
+func (in *instrumentation) endAttempt(r *Request) {
+	if !in.started {
+		return
+	}
+	in.started = false
+
+	a := &in.attempt
+	a.Latency = currentTime().Sub(a.Start)
+	a.StatusCode, a.RequestID, a.ErrorCode = responseMetrics(r)
+	a.Err = r.Error
+	if r.HTTPResponse != nil && r.HTTPResponse.ContentLength >= 0 {
+		a.BytesReceived = r.HTTPResponse.ContentLength
+	} else if in.received != nil {
+		a.BytesReceived = in.received.n
+	}
+	if r.WillRetry() {
+		a.Retried = true
+		a.RetryDelay = r.RetryDelay
+		in.call.RetryDelay += r.RetryDelay
+	}
+	in.instrumenter.AttemptEnd(in.call, *a)
+}
+
+// endCall reports the end of the call, and of its last attempt if it is
+// still in progress.
+func (in *instrumentation) endCall(r *Request) {
+	in.endAttempt(r)
+
+	c := &in.call
+	c.Latency = currentTime().Sub(c.Start)
+	c.StatusCode, c.RequestID, c.ErrorCode = responseMetrics(r)
+	c.Err = r.Error
+	in.instrumenter.CallEnd(*c)
+}
+
+// responseMetrics returns the HTTP status, request ID and error code of the
+// last response to r.
+func responseMetrics(r *Request) (statusCode int, requestID, errorCode string) {
+	requestID = r.RequestID
+	if r.HTTPResponse != nil {
+		statusCode = r.HTTPResponse.StatusCode
+		if requestID == "" {
+			requestID = r.HTTPResponse.Header.Get("X-Amzn-Requestid")
+		}
+		if requestID == "" {
+			requestID = r.HTTPResponse.Header.Get("X-Amz-Request-Id")
+		}
+	}
+
+	if r.Error != nil {
+		errorCode = "UnknownError"
+		if err := Error(r.Error); err != nil {
+			errorCode = err.Code
+			if requestID == "" {
+				requestID = err.RequestID
+			}
+		}
+	}
+	return
+}
+
+// countingReader counts the bytes read from a response body.
+type countingReader struct {
+	io.ReadCloser
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,153 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type traceKey struct{}
+
+type recordingInstrumenter struct {
+	events   []string
+	attempts []AttemptMetrics
+	calls    []CallMetrics
+}
+
+func (i *recordingInstrumenter) AttemptStart(call CallMetrics, attempt AttemptMetrics) {
+	i.events = append(i.events, fmt.Sprintf("start %d", attempt.Attempt))
+}
+
+func (i *recordingInstrumenter) AttemptEnd(call CallMetrics, attempt AttemptMetrics) {
+	i.events = append(i.events, fmt.Sprintf("end %d", attempt.Attempt))
+	i.attempts = append(i.attempts, attempt)
+}
+
+func (i *recordingInstrumenter) CallEnd(call CallMetrics) {
+	i.events = append(i.events, "call")
+	i.calls = append(i.calls, call)
+}
+
+func (i *recordingInstrumenter) InjectTrace(ctx context.Context, header http.Header) {
+	if trace, ok := ctx.Value(traceKey{}).(string); ok {
+		header.Set("X-Amzn-Trace-Id", trace)
+	}
+}
+
+func newInstrumentedService(url string, instrumenter Instrumenter) *Service {
+	s := NewService(&Config{Endpoint: url, Region: "us-west-2", MaxRetries: 2, Instrumenter: instrumenter})
+	s.ServiceName = "mock"
+	s.Handlers.Validate.Clear()
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	return s
+}
+
+func TestInstrumentRetriedCall(t *testing.T) {
+	defer func(sleep func(time.Duration)) { sleepDelay = sleep }(sleepDelay)
+	sleepDelay = func(time.Duration) {}
+	retryRand = func(max time.Duration) time.Duration { return max }
+	defer func() { retryRand = defaultRetryRand }()
+
+	var traces []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traces = append(traces, r.Header.Get("X-Amzn-Trace-Id"))
+		ioutil.ReadAll(r.Body)
+		if len(traces) == 1 {
+			w.Header().Set("X-Amzn-Requestid", "first")
+			w.WriteHeader(500)
+			fmt.Fprint(w, `{"__type":"InternalError","message":"An error occurred."}`)
+			return
+		}
+		w.Header().Set("X-Amzn-Requestid", "second")
+		fmt.Fprint(w, `{"data":"valid"}`)
+	}))
+	defer server.Close()
+
+	instrumenter := &recordingInstrumenter{}
+	s := newInstrumentedService(server.URL, instrumenter)
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, out)
+	r.SetContext(context.WithValue(context.Background(), traceKey{}, "Root=1-abc"))
+	r.SetBufferBody([]byte("payload"))
+	assert.NoError(t, r.Send())
+	assert.Equal(t, "valid", out.Data)
+
+	assert.Equal(t, []string{"Root=1-abc", "Root=1-abc"}, traces)
+	assert.Equal(t, []string{"start 1", "end 1", "start 2", "end 2", "call"}, instrumenter.events)
+
+	first := instrumenter.attempts[0]
+	assert.Equal(t, 500, first.StatusCode)
+	assert.Equal(t, "first", first.RequestID)
+	assert.Equal(t, "InternalError", first.ErrorCode)
+	assert.Equal(t, int64(7), first.BytesSent)
+	assert.True(t, first.BytesReceived > 0)
+	assert.True(t, first.Retried)
+	assert.True(t, first.RetryDelay > 0)
+
+	second := instrumenter.attempts[1]
+	assert.Equal(t, 200, second.StatusCode)
+	assert.Equal(t, "", second.ErrorCode)
+	assert.False(t, second.Retried)
+
+	call := instrumenter.calls[0]
+	assert.Equal(t, "mock", call.Service)
+	assert.Equal(t, "Operation", call.Operation)
+	assert.Equal(t, "us-west-2", call.Region)
+	assert.Equal(t, 2, call.Attempts)
+	assert.Equal(t, first.RetryDelay, call.RetryDelay)
+	assert.Equal(t, 200, call.StatusCode)
+	assert.Equal(t, "second", call.RequestID)
+	assert.Equal(t, "", call.ErrorCode)
+	assert.NoError(t, call.Err)
+}
+
+func TestInstrumentFailedCall(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(400)
+		fmt.Fprint(w, `{"__type":"ValidationException","message":"Invalid."}`)
+	}))
+	defer server.Close()
+
+	instrumenter := &recordingInstrumenter{}
+	s := newInstrumentedService(server.URL, instrumenter)
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.Error(t, r.Send())
+
+	assert.Equal(t, []string{"start 1", "end 1", "call"}, instrumenter.events)
+	assert.False(t, instrumenter.attempts[0].Retried)
+
+	call := instrumenter.calls[0]
+	assert.Equal(t, 1, call.Attempts)
+	assert.Equal(t, 400, call.StatusCode)
+	assert.Equal(t, "ValidationException", call.ErrorCode)
+	assert.Error(t, call.Err)
+}
+
+func TestInstrumentCallsFailingBeforeSend(t *testing.T) {
+	instrumenter := &recordingInstrumenter{}
+	s := newInstrumentedService("http://localhost:1", instrumenter)
+	s.Handlers.Sign.PushBack(func(r *Request) {
+		r.Error = APIError{Code: "NoCredentialProviders", Message: "no credentials"}
+	})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.Error(t, r.Send())
+
+	s = newInstrumentedService("http://localhost:1", instrumenter)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r = NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(ctx)
+	assert.Error(t, r.Send())
+
+	assert.Equal(t, []string{"call", "call"}, instrumenter.events)
+	assert.Equal(t, 0, instrumenter.calls[0].Attempts)
+	assert.Equal(t, "NoCredentialProviders", instrumenter.calls[0].ErrorCode)
+	assert.Equal(t, ErrCodeRequestCanceled, instrumenter.calls[1].ErrorCode)
+}
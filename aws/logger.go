@@ -0,0 +1,198 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// A LogLevel is a set of flags selecting what the SDK logs.
+type LogLevel uint
+
+const (
+	// LogSigning logs the canonical request and string to sign of signed
+	// requests.
+	LogSigning LogLevel = 1 << iota
+
+	// LogHTTPHeaders logs the headers of requests and responses.
+	LogHTTPHeaders
+
+	// LogHTTPBodies logs the bodies of requests and responses, with their
+	// headers.
+	LogHTTPBodies
+
+	// LogRetries logs the errors of requests which are retried.
+	LogRetries
+
+	// LogRequestErrors logs the errors of requests which fail.
+	LogRequestErrors
+
+	// LogDebug logs everything.
+	LogDebug = LogSigning | LogHTTPHeaders | LogHTTPBodies | LogRetries | LogRequestErrors
+)
+
+// Matches returns whether any of flags is set in l.
+func (l LogLevel) Matches(flags LogLevel) bool {
+	return l&flags != 0
+}
+
+// A Logger receives the log messages of the SDK. The level is the single
+// flag the message is logged for, and keyvals are alternating keys and
+// values giving the context of the message, such as the service and
+// operation of the request it is about.
+type Logger interface {
+	Log(level LogLevel, msg string, keyvals ...interface{})
+}
+
+// LoggerFunc is an adapter to use a function as a Logger.
+type LoggerFunc func(level LogLevel, msg string, keyvals ...interface{})
+
+// Log calls f(level, msg, keyvals...).
+func (f LoggerFunc) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	f(level, msg, keyvals...)
+}
+
+// NewWriterLogger returns a Logger writing each message to w as a line of
+// text, followed by its key/value pairs. Multi-line values, such as HTTP
+// requests, are written on their own lines.
+func NewWriterLogger(w io.Writer) Logger {
+	return &writerLogger{w: w}
+}
+
+type writerLogger struct {
+	w io.Writer
+	m sync.Mutex
+}
+
+func (l *writerLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	var b bytes.Buffer
+	b.WriteString(msg)
+
+	var blocks bytes.Buffer
+	for i := 0; i < len(keyvals); i += 2 {
+		var v interface{} = "(missing)"
+		if i+1 < len(keyvals) {
+			v = keyvals[i+1]
+		}
+
+		s := fmt.Sprint(v)
+		switch {
+		case strings.Contains(s, "\n"):
+			fmt.Fprintf(&blocks, "---[ %v ]---\n%s\n", keyvals[i], strings.TrimRight(s, "\r\n"))
+		case strings.ContainsAny(s, " \"") || s == "":
+			fmt.Fprintf(&b, " %v=%s", keyvals[i], strconv.Quote(s))
+		default:
+			fmt.Fprintf(&b, " %v=%s", keyvals[i], s)
+		}
+	}
+	b.WriteByte('\n')
+	b.Write(blocks.Bytes())
+
+	l.m.Lock()
+	defer l.m.Unlock()
+	l.w.Write(b.Bytes())
+}
+
+// redacted replaces the values of sensitive headers and query parameters in
+// logs.
+const redacted = "REDACTED"
+
+// redactedHeaders are the headers holding secrets, in canonical form.
+var redactedHeaders = []string{
+	"Authorization",
+	"X-Amz-Security-Token",
+	"X-Amz-Server-Side-Encryption-Customer-Key",
+	"X-Amz-Server-Side-Encryption-Customer-Key-Md5",
+	"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key",
+	"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key-Md5",
+}
+
+// RedactHeader returns a copy of h with the values of the headers holding
+// secrets, such as Authorization and X-Amz-Security-Token, redacted.
+func RedactHeader(h http.Header) http.Header {
+	c := make(http.Header, len(h))
+	for k, v := range h {
+		c[k] = v
+	}
+	for _, k := range redactedHeaders {
+		if _, ok := c[k]; ok {
+			c[k] = []string{redacted}
+		}
+	}
+	return c
+}
+
+// RedactURL returns u with the values of query parameters holding secrets,
+// such as the X-Amz-Security-Token of presigned URLs, redacted.
+func RedactURL(u *url.URL) string {
+	query := u.Query()
+	found := false
+	for k := range query {
+		if strings.EqualFold(k, "X-Amz-Security-Token") {
+			query[k] = []string{redacted}
+			found = true
+		}
+	}
+	if !found {
+		return u.String()
+	}
+
+	c := *u
+	c.RawQuery = query.Encode()
+	return c.String()
+}
+
+// dumpRequest returns the dump of the HTTP request of r, with secrets
+// redacted, and its body if body is set. The body is read from r.Body, and
+// rewound so it can still be sent.
+func dumpRequest(r *Request, body bool) string {
+	req := *r.HTTPRequest
+	req.Header = RedactHeader(req.Header)
+	if req.URL != nil {
+		u, _ := url.Parse(RedactURL(req.URL))
+		req.URL = u
+	}
+
+	dump, err := httputil.DumpRequestOut(&req, false)
+	if err != nil {
+		return err.Error()
+	}
+	if body && r.Body != nil {
+		start, err := r.Body.Seek(0, 1)
+		if err != nil {
+			return err.Error()
+		}
+		b, err := ioutil.ReadAll(r.Body)
+		if _, serr := r.Body.Seek(start, 0); err == nil {
+			err = serr
+		}
+		if err != nil {
+			return err.Error()
+		}
+		dump = append(dump, b...)
+	}
+	return string(dump)
+}
+
+// dumpResponse returns the dump of the HTTP response of r, with secrets
+// redacted, and its body if body is set.
+func dumpResponse(r *Request, body bool) string {
+	resp := *r.HTTPResponse
+	resp.Header = RedactHeader(resp.Header)
+
+	dump, err := httputil.DumpResponse(&resp, body)
+	if body {
+		r.HTTPResponse.Body = resp.Body
+	}
+	if err != nil {
+		return err.Error()
+	}
+	return string(dump)
+}
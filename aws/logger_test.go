@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type logEntry struct {
+	level   LogLevel
+	msg     string
+	keyvals map[string]interface{}
+}
+
+// captureLogger returns a Logger appending its messages to entries.
+func captureLogger(entries *[]logEntry) Logger {
+	return LoggerFunc(func(level LogLevel, msg string, keyvals ...interface{}) {
+		e := logEntry{level: level, msg: msg, keyvals: map[string]interface{}{}}
+		for i := 0; i+1 < len(keyvals); i += 2 {
+			e.keyvals[fmt.Sprint(keyvals[i])] = keyvals[i+1]
+		}
+		*entries = append(*entries, e)
+	})
+}
+
+func newLoggedService(url string, level LogLevel, entries *[]logEntry) *Service {
+	s := NewService(&Config{Endpoint: url, MaxRetries: 2, LogLevel: level, Logger: captureLogger(entries)})
+	s.Handlers.Validate.Clear()
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Sign.PushBack(func(r *Request) {
+		r.HTTPRequest.Header.Set("Authorization", "AWS4-HMAC-SHA256 Signature=secret")
+		r.HTTPRequest.Header.Set("X-Amz-Security-Token", "sessionToken")
+		r.HTTPRequest.Header.Set("X-Amz-Server-Side-Encryption-Customer-Key", "customerKey")
+	})
+	return s
+}
+
+func TestLogRequests(t *testing.T) {
+	retryRand = func(time.Duration) time.Duration { return 0 }
+	defer func() { retryRand = defaultRetryRand }()
+
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		received = append(received, string(b))
+		if len(received) == 1 {
+			w.WriteHeader(500)
+			fmt.Fprint(w, `{"__type":"InternalError","message":"An error occurred."}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":"valid"}`)
+	}))
+	defer server.Close()
+
+	var entries []logEntry
+	s := newLoggedService(server.URL, LogDebug, &entries)
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetBufferBody([]byte("payload"))
+	assert.NoError(t, r.Send())
+
+	// the body is still sent after being logged
+	assert.Equal(t, []string{"payload", "payload"}, received)
+
+	var msgs []string
+	for _, e := range entries {
+		msgs = append(msgs, e.msg)
+	}
+	assert.Equal(t, []string{"sending request", "received response", "retrying request", "sending request", "received response"}, msgs)
+
+	sent := entries[0]
+	assert.Equal(t, LogHTTPBodies, sent.level)
+	assert.Equal(t, "Operation", sent.keyvals["operation"])
+	dump := sent.keyvals["request"].(string)
+	assert.Contains(t, dump, "payload")
+	assert.Contains(t, dump, "Authorization: REDACTED")
+	assert.Contains(t, dump, "X-Amz-Security-Token: REDACTED")
+	assert.Contains(t, dump, "X-Amz-Server-Side-Encryption-Customer-Key: REDACTED")
+	assert.NotContains(t, dump, "secret")
+	assert.NotContains(t, dump, "sessionToken")
+	assert.NotContains(t, dump, "customerKey")
+
+	assert.Contains(t, entries[1].keyvals["response"], "InternalError")
+
+	retry := entries[2]
+	assert.Equal(t, LogRetries, retry.level)
+	assert.Equal(t, uint(1), retry.keyvals["retry"])
+	assert.Equal(t, "InternalError", Error(retry.keyvals["error"].(error)).Code)
+}
+
+func TestLogRequestErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(400)
+		fmt.Fprint(w, `{"__type":"ValidationException","message":"Invalid."}`)
+	}))
+	defer server.Close()
+
+	var entries []logEntry
+	s := newLoggedService(server.URL, LogRequestErrors|LogHTTPHeaders, &entries)
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetBufferBody([]byte("payload"))
+	assert.Error(t, r.Send())
+
+	assert.Equal(t, 3, len(entries))
+	assert.Equal(t, LogHTTPHeaders, entries[0].level)
+	assert.NotContains(t, entries[0].keyvals["request"], "payload")
+	assert.NotContains(t, entries[1].keyvals["response"], "Invalid.")
+
+	failed := entries[2]
+	assert.Equal(t, LogRequestErrors, failed.level)
+	assert.Equal(t, "request failed", failed.msg)
+	assert.Equal(t, "ValidationException", Error(failed.keyvals["error"].(error)).Code)
+}
+
+func TestWriterLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewWriterLogger(&buf)
+	logger.Log(LogRetries, "retrying request", "service", "sqs", "error", "connection reset", "request", "GET / HTTP/1.1\r\nHost: sqs\r\n\r\n")
+
+	assert.Equal(t, "retrying request service=sqs error=\"connection reset\"\n"+
+		"---[ request ]---\nGET / HTTP/1.1\r\nHost: sqs\n", buf.String())
+}
+
+func TestRedact(t *testing.T) {
+	h := http.Header{}
+	h.Set("Authorization", "secret")
+	h.Set("Content-Type", "text/plain")
+	r := RedactHeader(h)
+	assert.Equal(t, "REDACTED", r.Get("Authorization"))
+	assert.Equal(t, "text/plain", r.Get("Content-Type"))
+	assert.Equal(t, "secret", h.Get("Authorization"))
+
+	u, _ := url.Parse("https://bucket.s3.amazonaws.com/key?X-Amz-Security-Token=token&X-Amz-Signature=abc")
+	assert.Equal(t, "https://bucket.s3.amazonaws.com/key?X-Amz-Security-Token=REDACTED&X-Amz-Signature=abc", RedactURL(u))
+}
@@ -3,12 +3,16 @@ package aws
 import (
 	"fmt"
 	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"unicode/utf8"
 )
 
 func ValidateParameters(r *Request) {
 	if r.ParamsFilled() {
-		v := validator{errors: []string{}}
+		v := validator{errors: []string{}, enums: r.Service.Config.ValidateEnums}
 		v.validateAny(reflect.ValueOf(r.Params), "")
 
 		if count := len(v.errors); count > 0 {
@@ -21,6 +25,7 @@ func ValidateParameters(r *Request) {
 
 type validator struct {
 	errors []string
+	enums  bool // whether enum constraints are checked
 }
 
 func (v *validator) validateAny(value reflect.Value, path string) {
@@ -74,7 +79,87 @@ func (v *validator) validateStruct(value reflect.Value, path string) {
 			msg := "missing required parameter: " + path + prefix + f.Name
 			v.errors = append(v.errors, msg)
 		} else {
+			v.validateConstraints(fvalue, f.Tag, path+prefix+f.Name)
 			v.validateAny(fvalue, path+prefix+f.Name)
 		}
 	}
 }
+
+// validateConstraints checks a set value against the min, max, pattern and
+// enum constraints in its struct tag. Lengths are checked for strings,
+// blobs, lists and maps, and values for numbers. Enums are only checked if
+// v.enums is set, as services accept values added after the models.
+func (v *validator) validateConstraints(value reflect.Value, tag reflect.StructTag, path string) {
+	if value.Kind() == reflect.Ptr || value.Kind() == reflect.Slice || value.Kind() == reflect.Map {
+		if value.IsNil() {
+			return
+		}
+	}
+	value = reflect.Indirect(value)
+
+	var n float64
+	var what string
+	switch value.Kind() {
+	case reflect.String:
+		n, what = float64(utf8.RuneCountInString(value.String())), "length"
+	case reflect.Slice, reflect.Map:
+		n, what = float64(value.Len()), "length"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, what = float64(value.Int()), "value"
+	case reflect.Float32, reflect.Float64:
+		n, what = value.Float(), "value"
+	default:
+		return
+	}
+
+	if min, err := strconv.ParseFloat(tag.Get("min"), 64); err == nil && n < min {
+		v.addError(path, "%s %v is less than minimum %v", what, n, min)
+	}
+	if max, err := strconv.ParseFloat(tag.Get("max"), 64); err == nil && n > max {
+		v.addError(path, "%s %v is greater than maximum %v", what, n, max)
+	}
+
+	if value.Kind() != reflect.String {
+		return
+	}
+	if p := tag.Get("pattern"); p != "" && !patternRegexp(p).MatchString(value.String()) {
+		v.addError(path, "value %q does not match pattern %q", value.String(), p)
+	}
+	if e := tag.Get("enum"); e != "" && v.enums {
+		valid := strings.Split(e, ",")
+		found := false
+		for _, ev := range valid {
+			if ev == value.String() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			v.addError(path, "value %q is not one of %s", value.String(), strings.Join(valid, ", "))
+		}
+	}
+}
+
+func (v *validator) addError(path string, format string, args ...interface{}) {
+	msg := "invalid parameter " + path + ": " + fmt.Sprintf(format, args...)
+	v.errors = append(v.errors, msg)
+}
+
+var patterns = struct {
+	sync.Mutex
+	m map[string]*regexp.Regexp
+}{m: map[string]*regexp.Regexp{}}
+
+// patternRegexp returns the compiled regular expression for a pattern
+// constraint, which must match the whole value.
+func patternRegexp(p string) *regexp.Regexp {
+	patterns.Lock()
+	defer patterns.Unlock()
+
+	re, ok := patterns.m[p]
+	if !ok {
+		re = regexp.MustCompile("^(?:" + p + ")$")
+		patterns.m[p] = re
+	}
+	return re
+}
@@ -81,5 +81,78 @@ func TestNestedMissingRequiredParameters(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "InvalidParameter", err.Code)
 	assert.Equal(t, "3 validation errors:\n- missing required parameter: RequiredList[0].Name\n- missing required parameter: RequiredMap[\"key2\"].Name\n- missing required parameter: OptionalStruct.Name", err.Message)
+}
+
+type ConstrainedShape struct {
+	Name    *string             `type:"string" min:"3" max:"8" pattern:"[a-z]+"`
+	State   *string             `type:"string" enum:"pending,running"`
+	Limit   *int64              `type:"integer" min:"1" max:"100"`
+	Tags    []*string           `type:"list" max:"2"`
+	Nested  *ConstrainedShape   `type:"structure"`
+	Entries []*ConstrainedShape `type:"list"`
+	Attrs   map[string]*string  `type:"map" min:"1"`
+}
+
+func TestConstraintsValid(t *testing.T) {
+	input := &ConstrainedShape{
+		Name:  aws.String("abc"),
+		State: aws.String("running"),
+		Limit: aws.Long(100),
+		Tags:  []*string{aws.String("a"), aws.String("b")},
+		Attrs: map[string]*string{"key": aws.String("value")},
+	}
+
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+	assert.NoError(t, req.Error)
+}
+
+func TestConstraintViolations(t *testing.T) {
+	input := &ConstrainedShape{
+		Name:  aws.String("ab"),
+		State: aws.String("stopped"), // enums aren't checked by default
+		Limit: aws.Long(0),
+		Tags:  []*string{aws.String("a"), aws.String("b"), aws.String("c")},
+		Nested: &ConstrainedShape{
+			Name:  aws.String("ABCD"),
+			Limit: aws.Long(101),
+		},
+		Entries: []*ConstrainedShape{&ConstrainedShape{Name: aws.String("abcdefghi")}},
+		Attrs:   map[string]*string{},
+	}
+
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+	err := aws.Error(req.Error)
+
+	assert.Error(t, err)
+	assert.Equal(t, "InvalidParameter", err.Code)
+	assert.Equal(t, "7 validation errors:\n"+
+		"- invalid parameter Name: length 2 is less than minimum 3\n"+
+		"- invalid parameter Limit: value 0 is less than minimum 1\n"+
+		"- invalid parameter Tags: length 3 is greater than maximum 2\n"+
+		"- invalid parameter Nested.Name: value \"ABCD\" does not match pattern \"[a-z]+\"\n"+
+		"- invalid parameter Nested.Limit: value 101 is greater than maximum 100\n"+
+		"- invalid parameter Entries[0].Name: length 9 is greater than maximum 8\n"+
+		"- invalid parameter Attrs: length 0 is less than minimum 1", err.Message)
+}
+
+func TestEnumViolations(t *testing.T) {
+	enumService := &aws.Service{
+		Config:      &aws.Config{ValidateEnums: true},
+		ServiceName: "mock-service",
+		APIVersion:  "2015-01-01",
+	}
+
+	req := aws.NewRequest(enumService, &aws.Operation{}, &ConstrainedShape{State: aws.String("running")}, nil)
+	aws.ValidateParameters(req)
+	assert.NoError(t, req.Error)
 
+	req = aws.NewRequest(enumService, &aws.Operation{}, &ConstrainedShape{State: aws.String("stopped")}, nil)
+	aws.ValidateParameters(req)
+	err := aws.Error(req.Error)
+
+	assert.Error(t, err)
+	assert.Equal(t, "1 validation errors:\n"+
+		"- invalid parameter State: value \"stopped\" is not one of pending, running", err.Message)
 }
@@ -0,0 +1,281 @@
+package aws
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// A RateLimit limits the requests sent to a service or operation. Zero
+// values mean no limit.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained rate of requests.
+	RequestsPerSecond float64
+
+	// Burst is the number of requests that can be sent at once after a
+	// quiet period. It defaults to 1.
+	Burst int
+
+	// MaxConcurrent is the number of requests that can be in flight at once.
+	MaxConcurrent int
+}
+
+// A RateLimiter holds back requests to stay within their RateLimit before
+// they are sent. Set it in Config to limit the services using that Config;
+// each service is limited separately, and operations listed in Operations
+// are limited separately from the rest of their service.
+//
+// In adaptive mode, throttling errors seen by the retry logic halve the rate
+// of the throttled service or operation, down to MinRequestsPerSecond, and
+// the rate then recovers linearly over RecoveryPeriod. Without a
+// RequestsPerSecond limit, the adaptive rate starts from the rate measured
+// when the first throttling error was seen, and the limit is lifted once it
+// has recovered.
+//
+// A RateLimiter must not be copied after first use.
+type RateLimiter struct {
+	RateLimit
+
+	// Operations overrides RateLimit for operations, by operation name.
+	Operations map[string]RateLimit
+
+	Adaptive             bool
+	MinRequestsPerSecond float64
+	RecoveryPeriod       time.Duration
+
+	mu     sync.Mutex
+	limits map[string]*rateLimitState
+}
+
+// Default adaptive RateLimiter settings.
+const (
+	DefaultMinRequestsPerSecond = 1
+	DefaultRecoveryPeriod       = 30 * time.Second
+)
+
+// Wait blocks until a request to the operation of the service is allowed by
+// its rate and concurrency limits, or ctx is done. The returned function
+// must be called when the request is complete to release its concurrency
+// slot.
+func (l *RateLimiter) Wait(ctx context.Context, service, operation string) (func(), error) {
+	release, _, err := l.state(service, operation).wait(ctx)
+	return release, err
+}
+
+// Throttled lowers the rate of the operation of the service, if the
+// RateLimiter is adaptive.
+func (l *RateLimiter) Throttled(service, operation string) {
+	if l.Adaptive {
+		l.state(service, operation).throttled()
+	}
+}
+
+// state returns the limit state of the operation of the service.
+func (l *RateLimiter) state(service, operation string) *rateLimitState {
+	key, limit := service, l.RateLimit
+	if opLimit, ok := l.Operations[operation]; ok {
+		key, limit = service+"."+operation, opLimit
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.limits == nil {
+		l.limits = map[string]*rateLimitState{}
+	}
+	s, ok := l.limits[key]
+	if !ok {
+		s = newRateLimitState(limit, l.MinRequestsPerSecond, l.RecoveryPeriod)
+		l.limits[key] = s
+	}
+	return s
+}
+
+// addHandlers adds the handlers enforcing the limits of l to h. Requests
+// are signed before they are sent, so a request held back by the limits is
+// signed again, and its signature doesn't age while it waits.
+func (l *RateLimiter) addHandlers(h *Handlers) {
+	h.Send.PushFront(func(r *Request) {
+		release, waited, err := l.state(r.Service.ServiceName, r.Operation.Name).wait(r.Context())
+		if err != nil {
+			r.Error = newRequestCanceledError(err)
+			return
+		}
+		r.rateLimitRelease = release
+		if waited {
+			r.Handlers.Sign.Run(r)
+		}
+	})
+	h.Send.PushBack(func(r *Request) {
+		if r.rateLimitRelease != nil {
+			r.rateLimitRelease()
+			r.rateLimitRelease = nil
+		}
+	})
+	h.Retry.PushFront(func(r *Request) {
+		if IsErrorThrottle(r) {
+			l.Throttled(r.Service.ServiceName, r.Operation.Name)
+		}
+	})
+}
+
+// rateLimitState is the state of one rate limited service or operation.
+type rateLimitState struct {
+	limit          RateLimit
+	minRate        float64
+	recoveryPeriod time.Duration
+	slots          chan struct{}
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+
+	// adaptive state: the rate after the last throttling error, when it
+	// happened, and the rate it recovers to
+	throttledRate float64
+	throttledAt   time.Time
+	ceiling       float64
+
+	// requests started in the current and previous one second windows
+	window      time.Time
+	count       int
+	countBefore int
+}
+
+func newRateLimitState(limit RateLimit, minRate float64, recoveryPeriod time.Duration) *rateLimitState {
+	if limit.Burst < 1 {
+		limit.Burst = 1
+	}
+	if minRate <= 0 {
+		minRate = DefaultMinRequestsPerSecond
+	}
+	if recoveryPeriod <= 0 {
+		recoveryPeriod = DefaultRecoveryPeriod
+	}
+
+	s := &rateLimitState{
+		limit:          limit,
+		minRate:        minRate,
+		recoveryPeriod: recoveryPeriod,
+		tokens:         float64(limit.Burst),
+		last:           bucketNow(),
+	}
+	if limit.MaxConcurrent > 0 {
+		s.slots = make(chan struct{}, limit.MaxConcurrent)
+	}
+	return s
+}
+
+// rate returns the current rate limit, or zero if the rate is unlimited.
+// It must be called with s.mu held.
+func (s *rateLimitState) rate(now time.Time) float64 {
+	if s.throttledAt.IsZero() {
+		return s.limit.RequestsPerSecond
+	}
+
+	elapsed := now.Sub(s.throttledAt)
+	if elapsed >= s.recoveryPeriod {
+		s.throttledAt = time.Time{}
+		return s.limit.RequestsPerSecond
+	}
+	recovered := float64(elapsed) / float64(s.recoveryPeriod)
+	return s.throttledRate + (s.ceiling-s.throttledRate)*recovered
+}
+
+// wait reserves a token, waits for it to be available and then for a
+// concurrency slot. It returns whether it had to wait for either.
+func (s *rateLimitState) wait(ctx context.Context) (func(), bool, error) {
+	s.mu.Lock()
+	now := bucketNow()
+	rate := s.rate(now)
+	s.countRequest(now)
+
+	var delay time.Duration
+	if rate > 0 {
+		s.tokens += now.Sub(s.last).Seconds() * rate
+		if burst := float64(s.limit.Burst); s.tokens > burst {
+			s.tokens = burst
+		}
+		s.tokens--
+		if s.tokens < 0 {
+			delay = time.Duration(-s.tokens / rate * float64(time.Second))
+		}
+	}
+	s.last = now
+	s.mu.Unlock()
+
+	if delay > 0 {
+		if err := sleepWithContext(ctx, delay); err != nil {
+			s.mu.Lock()
+			s.tokens++
+			s.mu.Unlock()
+			return nil, false, err
+		}
+	}
+
+	waited := delay > 0
+	if s.slots == nil {
+		return func() {}, waited, nil
+	}
+	select {
+	case s.slots <- struct{}{}:
+	default:
+		waited = true
+		select {
+		case s.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+	}
+	var once sync.Once
+	return func() { once.Do(func() { <-s.slots }) }, waited, nil
+}
+
+// countRequest counts a request in the current one second window. It must
+// be called with s.mu held.
+func (s *rateLimitState) countRequest(now time.Time) {
+	if elapsed := now.Sub(s.window); elapsed >= time.Second {
+		if elapsed < 2*time.Second {
+			s.countBefore = s.count
+		} else {
+			s.countBefore = 0
+		}
+		s.window = now
+		s.count = 0
+	}
+	s.count++
+}
+
+// throttled halves the current rate, unless it was already lowered in the
+// last second: a burst of requests throttled together lowers it only once.
+func (s *rateLimitState) throttled() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := bucketNow()
+	if !s.throttledAt.IsZero() && now.Sub(s.throttledAt) < time.Second {
+		return
+	}
+
+	rate := s.rate(now)
+	if rate == 0 {
+		// unlimited: start from the measured rate
+		rate = float64(s.countBefore)
+		if float64(s.count) > rate {
+			rate = float64(s.count)
+		}
+	}
+	if s.throttledAt.IsZero() {
+		s.ceiling = s.limit.RequestsPerSecond
+		if s.ceiling == 0 {
+			s.ceiling = rate
+		}
+	}
+
+	s.throttledRate = math.Max(rate/2, s.minRate)
+	s.throttledAt = now
+	if s.tokens > 0 {
+		s.tokens = 0
+	}
+}
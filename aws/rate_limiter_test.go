@@ -0,0 +1,237 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock controls bucketNow and sleepDelay, advancing the time by the
+// slept delays.
+type fakeClock struct {
+	now    time.Time
+	delays []time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	c := &fakeClock{now: time.Unix(1000, 0)}
+	bucketNow = func() time.Time { return c.now }
+	sleepDelay = func(delay time.Duration) {
+		c.delays = append(c.delays, delay)
+		c.now = c.now.Add(delay)
+	}
+	return c
+}
+
+func (c *fakeClock) restore() {
+	bucketNow = time.Now
+}
+
+func TestRateLimiterRequestsPerSecond(t *testing.T) {
+	clock := newFakeClock()
+	defer clock.restore()
+
+	l := &RateLimiter{RateLimit: RateLimit{RequestsPerSecond: 10, Burst: 2}}
+	for i := 0; i < 4; i++ {
+		release, err := l.Wait(context.Background(), "svc", "Op")
+		assert.NoError(t, err)
+		release()
+	}
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 100 * time.Millisecond}, clock.delays)
+
+	// other services are limited separately
+	clock.delays = nil
+	_, err := l.Wait(context.Background(), "other", "Op")
+	assert.NoError(t, err)
+	assert.Empty(t, clock.delays)
+}
+
+func TestRateLimiterOperations(t *testing.T) {
+	clock := newFakeClock()
+	defer clock.restore()
+
+	l := &RateLimiter{
+		RateLimit:  RateLimit{RequestsPerSecond: 100},
+		Operations: map[string]RateLimit{"Slow": {RequestsPerSecond: 1}},
+	}
+	l.Wait(context.Background(), "svc", "Slow")
+	l.Wait(context.Background(), "svc", "Fast")
+	l.Wait(context.Background(), "svc", "Fast")
+	assert.Equal(t, []time.Duration{10 * time.Millisecond}, clock.delays)
+
+	l.Wait(context.Background(), "svc", "Slow")
+	assert.Equal(t, []time.Duration{10 * time.Millisecond, 990 * time.Millisecond}, clock.delays)
+}
+
+func TestRateLimiterMaxConcurrent(t *testing.T) {
+	l := &RateLimiter{RateLimit: RateLimit{MaxConcurrent: 1}}
+
+	release, err := l.Wait(context.Background(), "svc", "Op")
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = l.Wait(ctx, "svc", "Op")
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	release()
+	release() // releasing twice frees one slot only
+	release, err = l.Wait(context.Background(), "svc", "Op")
+	assert.NoError(t, err)
+	_, err = l.Wait(ctx, "svc", "Op")
+	assert.Error(t, err)
+	release()
+}
+
+func TestRateLimiterAdaptive(t *testing.T) {
+	clock := newFakeClock()
+	defer clock.restore()
+
+	l := &RateLimiter{
+		RateLimit:      RateLimit{RequestsPerSecond: 40},
+		Adaptive:       true,
+		RecoveryPeriod: 10 * time.Second,
+	}
+	s := l.state("svc", "Op")
+
+	l.Throttled("svc", "Op")
+	assert.Equal(t, 20.0, s.rate(clock.now))
+
+	// throttles in the same second lower the rate once
+	l.Throttled("svc", "Op")
+	assert.Equal(t, 20.0, s.rate(clock.now))
+
+	clock.now = clock.now.Add(5 * time.Second)
+	assert.Equal(t, 30.0, s.rate(clock.now))
+
+	l.Throttled("svc", "Op")
+	assert.Equal(t, 15.0, s.rate(clock.now))
+
+	clock.now = clock.now.Add(10 * time.Second)
+	assert.Equal(t, 40.0, s.rate(clock.now))
+}
+
+func TestRateLimiterAdaptiveMinRate(t *testing.T) {
+	clock := newFakeClock()
+	defer clock.restore()
+
+	l := &RateLimiter{RateLimit: RateLimit{RequestsPerSecond: 4}, Adaptive: true, MinRequestsPerSecond: 3}
+	s := l.state("svc", "Op")
+	l.Throttled("svc", "Op")
+	assert.Equal(t, 3.0, s.rate(clock.now))
+}
+
+func TestRateLimiterAdaptiveUnlimited(t *testing.T) {
+	clock := newFakeClock()
+	defer clock.restore()
+
+	l := &RateLimiter{Adaptive: true, RecoveryPeriod: 10 * time.Second}
+	for i := 0; i < 8; i++ {
+		l.Wait(context.Background(), "svc", "Op")
+	}
+	assert.Empty(t, clock.delays)
+
+	s := l.state("svc", "Op")
+	l.Throttled("svc", "Op")
+	assert.Equal(t, 4.0, s.rate(clock.now))
+
+	l.Wait(context.Background(), "svc", "Op")
+	assert.Equal(t, []time.Duration{250 * time.Millisecond}, clock.delays)
+
+	clock.now = clock.now.Add(10 * time.Second)
+	assert.Equal(t, 0.0, s.rate(clock.now))
+}
+
+func TestRateLimiterNotAdaptive(t *testing.T) {
+	clock := newFakeClock()
+	defer clock.restore()
+
+	l := &RateLimiter{RateLimit: RateLimit{RequestsPerSecond: 10}}
+	l.Throttled("svc", "Op")
+	assert.Equal(t, 10.0, l.state("svc", "Op").rate(clock.now))
+}
+
+func TestRateLimiterHandlers(t *testing.T) {
+	clock := newFakeClock()
+	defer clock.restore()
+
+	reqNum := 0
+	reqs := []http.Response{
+		errorResponse(400, "ThrottlingException"),
+		{StatusCode: 200, Body: body(`{"data":"valid"}`)},
+	}
+
+	l := &RateLimiter{RateLimit: RateLimit{RequestsPerSecond: 10, MaxConcurrent: 1}, Adaptive: true}
+	s := NewService(&Config{MaxRetries: 3})
+	s.ServiceName = "svc"
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		assert.NotNil(t, r.rateLimitRelease)
+		r.HTTPResponse = &reqs[reqNum]
+		reqNum++
+	})
+	l.addHandlers(&s.Handlers)
+
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, out)
+	assert.Nil(t, r.Send())
+	assert.Equal(t, "valid", out.Data)
+	assert.Nil(t, r.rateLimitRelease)
+
+	st := l.state("svc", "Operation")
+	assert.False(t, st.throttledAt.IsZero())
+	assert.Equal(t, 0, len(st.slots))
+}
+
+func TestRateLimiterSignsAfterWait(t *testing.T) {
+	clock := newFakeClock()
+	defer clock.restore()
+
+	l := &RateLimiter{RateLimit: RateLimit{RequestsPerSecond: 1}}
+	s := NewService(&Config{MaxRetries: 3})
+	s.ServiceName = "svc"
+	s.Handlers.Validate.Clear()
+	signed := []time.Time{}
+	s.Handlers.Sign.PushBack(func(r *Request) { signed = append(signed, clock.now) })
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body(`{"data":"valid"}`)}
+	})
+	l.addHandlers(&s.Handlers)
+
+	assert.Nil(t, NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send())
+	assert.Equal(t, []time.Time{time.Unix(1000, 0)}, signed)
+
+	// the second request waits a second, and is signed again after waiting
+	signed = nil
+	assert.Nil(t, NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send())
+	assert.Equal(t, []time.Time{time.Unix(1000, 0), time.Unix(1001, 0)}, signed)
+}
+
+func TestRateLimiterCanceledWait(t *testing.T) {
+	l := &RateLimiter{RateLimit: RateLimit{MaxConcurrent: 1}}
+	release, _ := l.Wait(context.Background(), "svc", "Operation")
+	defer release()
+
+	sent := false
+	s := NewService(&Config{MaxRetries: 3})
+	s.ServiceName = "svc"
+	s.Handlers.Validate.Clear()
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) { sent = r.Error == nil })
+	l.addHandlers(&s.Handlers)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(ctx)
+	err := r.Send()
+	assert.Equal(t, ErrCodeRequestCanceled, Error(err).Code)
+	assert.False(t, sent)
+}
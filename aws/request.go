@@ -2,12 +2,15 @@ package aws
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
 	"time"
+
+	"github.com/datacratic/aws-sdk-go/aws/awsutil"
 )
 
 type Request struct {
@@ -27,13 +30,28 @@ type Request struct {
 	Retryable    bool
 	RetryDelay   time.Duration
 
-	built bool
+	built            bool
+	context          context.Context
+	rateLimitRelease func()
+	resigned         bool
+	instrumentation  *instrumentation
 }
 
 type Operation struct {
 	Name       string
 	HTTPMethod string
 	HTTPPath   string
+	*Paginator
+}
+
+// A Paginator describes how the pages of a paginated operation are chained
+// together: the output tokens of one page become the input tokens of the
+// next page.
+type Paginator struct {
+	InputTokens     []string
+	OutputTokens    []string
+	LimitToken      string
+	TruncationToken string
 }
 
 func NewRequest(service *Service, operation *Operation, params interface{}, data interface{}) *Request {
@@ -67,7 +85,7 @@ func NewRequest(service *Service, operation *Operation, params interface{}, data
 }
 
 func (r *Request) WillRetry() bool {
-	return r.Error != nil && r.Retryable && r.RetryCount < r.Service.MaxRetries()
+	return r.Error != nil && r.Retryable && r.RetryCount < r.retryer().MaxRetries()
 }
 
 func (r *Request) ParamsFilled() bool {
@@ -121,26 +139,107 @@ func (r *Request) Sign() error {
 	return r.Error
 }
 
+// resignExpired expires the credentials of the service and signs r again,
+// if r failed because its credentials expired and they are an Expirer. It
+// does so once per request, returning whether r should be sent again.
+func (r *Request) resignExpired(bodyStart int64) bool {
+	if r.resigned || !IsErrorExpiredCredentials(r) {
+		return false
+	}
+	expirer, ok := r.Service.Config.Credentials.(Expirer)
+	if !ok {
+		return false
+	}
+	r.resigned = true
+	expirer.Expire()
+
+	if r.Body != nil {
+		if _, err := r.Body.Seek(bodyStart, 0); err != nil {
+			return false
+		}
+	}
+
+	err := r.Error
+	r.Error = nil
+	r.Handlers.Sign.Run(r)
+	if r.Error != nil {
+		r.Error = err
+		return false
+	}
+	return true
+}
+
+// SetContext sets the context of the request. Canceling the context, or
+// reaching its deadline, aborts the request in flight and interrupts any
+// delay between retries. A nil context is not allowed.
+func (r *Request) SetContext(ctx context.Context) {
+	if ctx == nil {
+		panic("nil context")
+	}
+	r.context = ctx
+}
+
+// Context returns the context of the request, which defaults to
+// context.Background.
+func (r *Request) Context() context.Context {
+	if r.context == nil {
+		return context.Background()
+	}
+	return r.context
+}
+
 func (r *Request) Send() error {
+	if instrumenter := r.Service.Config.Instrumenter; instrumenter != nil {
+		r.instrumentation = newInstrumentation(r, instrumenter)
+		defer r.instrumentation.endCall(r)
+	}
+
 	r.Sign()
 	if r.Error != nil {
 		return r.Error
 	}
 
+	// remember where the body starts so retries can resend it
+	var bodyStart int64
+	if r.Body != nil {
+		bodyStart, _ = r.Body.Seek(0, 1)
+	}
+
 	for {
+		if err := r.Context().Err(); err != nil {
+			r.Error = newRequestCanceledError(err)
+			return r.Error
+		}
+
+		if r.RetryCount > 0 && r.Body != nil {
+			if _, err := r.Body.Seek(bodyStart, 0); err != nil {
+				r.Error = err
+				return r.Error
+			}
+		}
+
 		r.Handlers.Send.Run(r)
 		if r.Error != nil {
-			return r.Error
+			r.Handlers.Retry.Run(r)
+			r.Handlers.AfterRetry.Run(r)
+			if r.Error != nil {
+				return r.canceledError()
+			}
+			continue
 		}
 
 		r.Handlers.UnmarshalMeta.Run(r)
 		r.Handlers.ValidateResponse.Run(r)
 		if r.Error != nil {
+			// unmarshal the error first so the retryer can see its code
+			r.Handlers.UnmarshalError.Run(r)
+			if r.resignExpired(bodyStart) {
+				continue
+			}
 			r.Handlers.Retry.Run(r)
 			r.Handlers.AfterRetry.Run(r)
 			if r.Error != nil {
-				r.Handlers.UnmarshalError.Run(r)
-				return r.Error
+				return r.canceledError()
 			}
 			continue
 		}
@@ -150,7 +249,7 @@ func (r *Request) Send() error {
 			r.Handlers.Retry.Run(r)
 			r.Handlers.AfterRetry.Run(r)
 			if r.Error != nil {
-				return r.Error
+				return r.canceledError()
 			}
 			continue
 		}
@@ -158,3 +257,97 @@ func (r *Request) Send() error {
 		return nil
 	}
 }
+
+// canceledError replaces the request's error with a RequestCanceled error if
+// the request failed because its context is done, and returns it.
+func (r *Request) canceledError() error {
+	if err := r.Context().Err(); err != nil {
+		r.Error = newRequestCanceledError(err)
+	}
+	return r.Error
+}
+
+// HasNextPage returns true if this request has more pages of data available.
+func (r *Request) HasNextPage() bool {
+	return r.nextPageTokens() != nil
+}
+
+// nextPageTokens returns the tokens to use when asking for the next page of
+// data, or nil if this request is the last page.
+func (r *Request) nextPageTokens() []interface{} {
+	if r.Operation.Paginator == nil || !r.DataFilled() {
+		return nil
+	}
+
+	if r.Operation.TruncationToken != "" {
+		tr := awsutil.ValuesAtPath(r.Data, r.Operation.TruncationToken)
+		if len(tr) == 0 {
+			return nil
+		}
+		if v, ok := tr[0].(bool); ok && !v {
+			return nil
+		}
+	}
+
+	found := false
+	tokens := make([]interface{}, len(r.Operation.OutputTokens))
+	for i, outtok := range r.Operation.OutputTokens {
+		v := awsutil.ValuesAtPath(r.Data, outtok)
+		if len(v) > 0 {
+			found = true
+			tokens[i] = v[0]
+		}
+	}
+
+	if found {
+		return tokens
+	}
+	return nil
+}
+
+// NextPage returns a new Request that can be executed to return the next
+// page of result data, or nil if there are no more pages. Call Send() on
+// this request to execute it.
+func (r *Request) NextPage() *Request {
+	tokens := r.nextPageTokens()
+	if tokens == nil {
+		return nil
+	}
+
+	data := reflect.New(reflect.TypeOf(r.Data).Elem()).Interface()
+	nr := NewRequest(r.Service, r.Operation, awsutil.CopyOf(r.Params), data)
+	nr.Handlers = r.Handlers.copy()
+	nr.context = r.context
+	for i, intok := range nr.Operation.InputTokens {
+		// tokens missing from the page are cleared, not kept from the
+		// previous request
+		awsutil.SetValueAtPath(nr.Params, intok, tokens[i])
+	}
+	return nr
+}
+
+// EachPage iterates over each page of a paginated request object. The fn
+// parameter should be a function with the following sample signature:
+//
+//	func(page *T, lastPage bool) bool {
+//	    return true // return false to stop iterating
+//	}
+//
+// Where "T" is the structure type matching the output structure of the given
+// operation. For example, a request object generated by
+// DynamoDB.ListTablesRequest() would expect to see dynamodb.ListTablesOutput
+// as the structure "T". The lastPage value represents whether the page is
+// the last page of data or not. The return value of this function should
+// return true to keep iterating or false to stop.
+func (r *Request) EachPage(fn func(data interface{}, isLastPage bool) (shouldContinue bool)) error {
+	for page := r; page != nil; page = page.NextPage() {
+		if err := page.Send(); err != nil {
+			return err
+		}
+		if !fn(page.Data, !page.HasNextPage()) {
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package aws_test
+
+import (
+	"testing"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/service/dynamodb"
+	"github.com/datacratic/aws-sdk-go/service/route53"
+	"github.com/datacratic/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+var paginationConfig = &aws.Config{
+	Credentials: aws.Creds("AKID", "SECRET", ""),
+	Region:      "mock-region",
+}
+
+// Use DynamoDB methods for simplicity
+func TestPagination(t *testing.T) {
+	db := dynamodb.New(paginationConfig)
+	tokens, pages, numPages, gotToEnd := []string{}, []string{}, 0, false
+
+	reqNum := 0
+	resps := []*dynamodb.ListTablesOutput{
+		&dynamodb.ListTablesOutput{TableNames: []*string{aws.String("Table1"), aws.String("Table2")}, LastEvaluatedTableName: aws.String("Table2")},
+		&dynamodb.ListTablesOutput{TableNames: []*string{aws.String("Table3"), aws.String("Table4")}, LastEvaluatedTableName: aws.String("Table4")},
+		&dynamodb.ListTablesOutput{TableNames: []*string{aws.String("Table5")}},
+	}
+
+	db.Handlers.Send.Clear() // mock sending
+	db.Handlers.Unmarshal.Clear()
+	db.Handlers.UnmarshalMeta.Clear()
+	db.Handlers.ValidateResponse.Clear()
+	db.Handlers.Build.PushBack(func(r *aws.Request) {
+		in := r.Params.(*dynamodb.ListTablesInput)
+		if in.ExclusiveStartTableName != nil {
+			tokens = append(tokens, *in.ExclusiveStartTableName)
+		}
+	})
+	db.Handlers.Unmarshal.PushBack(func(r *aws.Request) {
+		r.Data = resps[reqNum]
+		reqNum++
+	})
+
+	params := &dynamodb.ListTablesInput{Limit: aws.Long(2)}
+	err := db.ListTablesPages(params, func(p *dynamodb.ListTablesOutput, last bool) bool {
+		numPages++
+		for _, t := range p.TableNames {
+			pages = append(pages, *t)
+		}
+		if last {
+			if gotToEnd {
+				assert.Fail(t, "last=true happened twice")
+			}
+			gotToEnd = true
+		}
+		return true
+	})
+
+	assert.Equal(t, []string{"Table2", "Table4"}, tokens)
+	assert.Equal(t, []string{"Table1", "Table2", "Table3", "Table4", "Table5"}, pages)
+	assert.Equal(t, 3, numPages)
+	assert.True(t, gotToEnd)
+	assert.Nil(t, err)
+	assert.Nil(t, params.ExclusiveStartTableName) // don't modify original params
+}
+
+func TestPaginationEarlyExit(t *testing.T) {
+	db := dynamodb.New(paginationConfig)
+	numPages, gotToEnd := 0, false
+
+	reqNum := 0
+	resps := []*dynamodb.ListTablesOutput{
+		&dynamodb.ListTablesOutput{TableNames: []*string{aws.String("Table1"), aws.String("Table2")}, LastEvaluatedTableName: aws.String("Table2")},
+		&dynamodb.ListTablesOutput{TableNames: []*string{aws.String("Table3"), aws.String("Table4")}, LastEvaluatedTableName: aws.String("Table4")},
+		&dynamodb.ListTablesOutput{TableNames: []*string{aws.String("Table5")}},
+	}
+
+	db.Handlers.Send.Clear() // mock sending
+	db.Handlers.Unmarshal.Clear()
+	db.Handlers.UnmarshalMeta.Clear()
+	db.Handlers.ValidateResponse.Clear()
+	db.Handlers.Unmarshal.PushBack(func(r *aws.Request) {
+		r.Data = resps[reqNum]
+		reqNum++
+	})
+
+	params := &dynamodb.ListTablesInput{Limit: aws.Long(2)}
+	err := db.ListTablesPages(params, func(p *dynamodb.ListTablesOutput, last bool) bool {
+		numPages++
+		if numPages == 2 {
+			return false
+		}
+		if last {
+			gotToEnd = true
+		}
+		return true
+	})
+
+	assert.Equal(t, 2, numPages)
+	assert.False(t, gotToEnd)
+	assert.Nil(t, err)
+}
+
+func TestPaginationTruncation(t *testing.T) {
+	client := s3.New(paginationConfig)
+
+	reqNum := 0
+	resps := []*s3.ListObjectsOutput{
+		&s3.ListObjectsOutput{IsTruncated: aws.Boolean(true), Contents: []*s3.Object{&s3.Object{Key: aws.String("Key1")}}},
+		&s3.ListObjectsOutput{IsTruncated: aws.Boolean(true), NextMarker: aws.String("Marker2"), Contents: []*s3.Object{&s3.Object{Key: aws.String("Key2")}}},
+		&s3.ListObjectsOutput{IsTruncated: aws.Boolean(false), Contents: []*s3.Object{&s3.Object{Key: aws.String("Key3")}}},
+		&s3.ListObjectsOutput{IsTruncated: aws.Boolean(true), Contents: []*s3.Object{&s3.Object{Key: aws.String("Key4")}}},
+	}
+
+	client.Handlers.Send.Clear() // mock sending
+	client.Handlers.Unmarshal.Clear()
+	client.Handlers.UnmarshalMeta.Clear()
+	client.Handlers.ValidateResponse.Clear()
+	client.Handlers.Unmarshal.PushBack(func(r *aws.Request) {
+		r.Data = resps[reqNum]
+		reqNum++
+	})
+
+	markers, results := []string{}, []string{}
+	client.Handlers.Build.PushBack(func(r *aws.Request) {
+		if m := r.Params.(*s3.ListObjectsInput).Marker; m != nil {
+			markers = append(markers, *m)
+		}
+	})
+
+	params := &s3.ListObjectsInput{Bucket: aws.String("bucket")}
+	req, _ := client.ListObjectsRequest(params)
+	err := req.EachPage(func(p interface{}, last bool) bool {
+		page := p.(*s3.ListObjectsOutput)
+		results = append(results, *page.Contents[0].Key)
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Key1", "Key2", "Key3"}, results)
+	assert.Equal(t, 3, reqNum)
+
+	// the marker is derived from the last key when NextMarker is absent
+	assert.Equal(t, []string{"Key1", "Marker2"}, markers)
+}
+
+func TestPaginationTruncationWithoutContents(t *testing.T) {
+	client := s3.New(paginationConfig)
+
+	reqNum := 0
+	client.Handlers.Send.Clear() // mock sending
+	client.Handlers.Unmarshal.Clear()
+	client.Handlers.UnmarshalMeta.Clear()
+	client.Handlers.ValidateResponse.Clear()
+	client.Handlers.Unmarshal.PushBack(func(r *aws.Request) {
+		r.Data = &s3.ListObjectsOutput{IsTruncated: aws.Boolean(true), Contents: []*s3.Object{}}
+		reqNum++
+	})
+
+	params := &s3.ListObjectsInput{Bucket: aws.String("bucket")}
+	req, _ := client.ListObjectsRequest(params)
+	err := req.EachPage(func(p interface{}, last bool) bool {
+		return true
+	})
+
+	// without a marker or a last key, there is no next page
+	assert.Nil(t, err)
+	assert.Equal(t, 1, reqNum)
+	assert.False(t, req.HasNextPage())
+	assert.Nil(t, req.NextPage())
+}
+
+func TestPaginationClearsMissingTokens(t *testing.T) {
+	client := route53.New(paginationConfig)
+
+	reqNum := 0
+	resps := []*route53.ListResourceRecordSetsOutput{
+		&route53.ListResourceRecordSetsOutput{IsTruncated: aws.Boolean(true), NextRecordName: aws.String("a.example.com"), NextRecordType: aws.String("A"), NextRecordIdentifier: aws.String("one")},
+		&route53.ListResourceRecordSetsOutput{IsTruncated: aws.Boolean(true), NextRecordName: aws.String("b.example.com"), NextRecordType: aws.String("A")},
+		&route53.ListResourceRecordSetsOutput{IsTruncated: aws.Boolean(false)},
+	}
+
+	client.Handlers.Send.Clear() // mock sending
+	client.Handlers.Unmarshal.Clear()
+	client.Handlers.UnmarshalMeta.Clear()
+	client.Handlers.ValidateResponse.Clear()
+	client.Handlers.Unmarshal.PushBack(func(r *aws.Request) {
+		r.Data = resps[reqNum]
+		reqNum++
+	})
+
+	identifiers := []*string{}
+	client.Handlers.Build.PushBack(func(r *aws.Request) {
+		identifiers = append(identifiers, r.Params.(*route53.ListResourceRecordSetsInput).StartRecordIdentifier)
+	})
+
+	params := &route53.ListResourceRecordSetsInput{HostedZoneID: aws.String("zone")}
+	err := client.ListResourceRecordSetsPages(params, func(p *route53.ListResourceRecordSetsOutput, last bool) bool {
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, reqNum)
+	// the identifier of the first page isn't kept for the third
+	assert.Equal(t, []*string{nil, aws.String("one"), nil}, identifiers)
+}
@@ -2,10 +2,13 @@ package aws
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 	"time"
@@ -89,6 +92,8 @@ func TestRequestExhaustRetries(t *testing.T) {
 	sleepDelay = func(delay time.Duration) {
 		delays = append(delays, delay)
 	}
+	retryRand = func(max time.Duration) time.Duration { return max }
+	defer func() { retryRand = defaultRetryRand }()
 
 	reqNum := 0
 	reqs := []http.Response{
@@ -118,3 +123,128 @@ func TestRequestExhaustRetries(t *testing.T) {
 	assert.Equal(t, 3, int(r.RetryCount))
 	assert.True(t, reflect.DeepEqual([]time.Duration{30 * time.Millisecond, 60 * time.Millisecond, 120 * time.Millisecond}, delays))
 }
+
+func TestRequestCanceledBeforeSend(t *testing.T) {
+	sent := false
+	s := NewService(&Config{MaxRetries: 10})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) { sent = true })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(ctx)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRequestCanceled, Error(err).Code)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.False(t, sent)
+}
+
+func TestRequestCanceledDuringRetryDelay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	reqNum := 0
+	s := NewService(&Config{MaxRetries: 10})
+	s.Handlers.Validate.Clear()
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 500, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)}
+		reqNum++
+	})
+	s.Handlers.Retry.PushBack(func(r *Request) {
+		r.RetryDelay = time.Hour
+		cancel()
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(ctx)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRequestCanceled, Error(err).Code)
+	assert.Equal(t, 1, reqNum)
+	assert.Equal(t, 0, int(r.RetryCount))
+}
+
+func TestRequestDeadlineAbortsSend(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	s := NewService(&Config{Endpoint: server.URL, MaxRetries: 10})
+	s.Handlers.Validate.Clear()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(ctx)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRequestCanceled, Error(err).Code)
+	assert.Contains(t, Error(err).Message, context.DeadlineExceeded.Error())
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestRequestResignsExpiredCredentials(t *testing.T) {
+	provider := &countingProvider{}
+	signedWith := []string{}
+
+	reqNum := 0
+	reqs := []http.Response{
+		http.Response{StatusCode: 400, Body: body(`{"__type":"ExpiredTokenException","message":"The security token included in the request is expired"}`)},
+		http.Response{StatusCode: 200, Body: body(`{"data":"valid"}`)},
+	}
+
+	s := NewService(&Config{MaxRetries: 0, Credentials: CachedCreds(provider)})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Sign.PushBack(func(r *Request) {
+		creds, _ := r.Service.Config.Credentials.Credentials()
+		signedWith = append(signedWith, creds.AccessKeyID)
+	})
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &reqs[reqNum]
+		reqNum++
+	})
+
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, out)
+	err := r.Send()
+	assert.Nil(t, err)
+	assert.Equal(t, "valid", out.Data)
+	assert.Equal(t, []string{"access1", "access2"}, signedWith)
+	assert.Equal(t, 1, provider.expired)
+	assert.Equal(t, 0, int(r.RetryCount))
+}
+
+func TestRequestResignsExpiredCredentialsOnce(t *testing.T) {
+	provider := &countingProvider{}
+	sends := 0
+
+	s := NewService(&Config{MaxRetries: 0, Credentials: CachedCreds(provider)})
+	s.Handlers.Validate.Clear()
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		sends++
+		r.HTTPResponse = &http.Response{StatusCode: 403, Body: body(`{"__type":"InvalidClientTokenId","message":"The security token included in the request is invalid"}`)}
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Equal(t, "InvalidClientTokenId", Error(err).Code)
+	assert.Equal(t, 2, sends)
+	assert.Equal(t, 1, provider.expired)
+}
@@ -0,0 +1,311 @@
+package aws
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// A Retryer decides whether a failed request is retried, and how long to
+// wait before retrying it. A Retryer set in Config replaces the retry rules
+// of every service using that Config.
+type Retryer interface {
+	// RetryRules returns the delay before the next attempt of r.
+	RetryRules(r *Request) time.Duration
+
+	// ShouldRetry returns whether r should be retried after its error.
+	ShouldRetry(r *Request) bool
+
+	// MaxRetries returns the maximum number of retries of a request.
+	MaxRetries() uint
+}
+
+// Default DefaultRetryer delays.
+const (
+	DefaultMinRetryDelay    = 30 * time.Millisecond
+	DefaultMinThrottleDelay = 500 * time.Millisecond
+	DefaultMaxRetryDelay    = 20 * time.Second
+)
+
+// DefaultRetryer retries throttled, transient and network errors with full
+// jitter exponential backoff: the delay before retry n is random between
+// zero and min(MaxRetryDelay, base * 2^n), where base is MinThrottleDelay
+// for throttling errors and MinRetryDelay otherwise. A Retry-After header in
+// the response takes precedence, up to MaxRetryDelay.
+//
+// Zero delays are replaced by their defaults.
+type DefaultRetryer struct {
+	NumMaxRetries    int
+	MinRetryDelay    time.Duration
+	MinThrottleDelay time.Duration
+	MaxRetryDelay    time.Duration
+
+	// TokenBucket, if set, limits the rate of retries. Share a bucket
+	// between clients to bound their combined retries during an outage.
+	TokenBucket *RetryTokenBucket
+}
+
+// MaxRetries returns the maximum number of retries of a request.
+func (d DefaultRetryer) MaxRetries() uint {
+	if d.NumMaxRetries < 0 {
+		return 0
+	}
+	return uint(d.NumMaxRetries)
+}
+
+// ShouldRetry returns whether r failed with a retryable error, and a retry
+// token is available if a TokenBucket is set.
+func (d DefaultRetryer) ShouldRetry(r *Request) bool {
+	if r.RetryCount >= d.MaxRetries() || !IsErrorRetryable(r) {
+		return false
+	}
+	return d.TokenBucket == nil || d.TokenBucket.Take()
+}
+
+// retryRand returns a random duration in [0, max]. It is a variable so
+// tests can make delays deterministic.
+var retryRand = defaultRetryRand
+
+func defaultRetryRand(max time.Duration) time.Duration {
+	return time.Duration(rand.Int63n(int64(max) + 1))
+}
+
+// RetryRules returns the delay before the next attempt of r.
+func (d DefaultRetryer) RetryRules(r *Request) time.Duration {
+	maxDelay := d.MaxRetryDelay
+	if maxDelay == 0 {
+		maxDelay = DefaultMaxRetryDelay
+	}
+
+	if delay, ok := retryAfter(r); ok {
+		if delay > maxDelay {
+			return maxDelay
+		}
+		return delay
+	}
+
+	base := d.MinRetryDelay
+	if base == 0 {
+		base = DefaultMinRetryDelay
+	}
+	if IsErrorThrottle(r) {
+		base = d.MinThrottleDelay
+		if base == 0 {
+			base = DefaultMinThrottleDelay
+		}
+	}
+
+	delay := maxDelay
+	if r.RetryCount < 32 && base<<r.RetryCount < maxDelay && base<<r.RetryCount > 0 {
+		delay = base << r.RetryCount
+	}
+	return retryRand(delay)
+}
+
+// retryAfter returns the delay requested by the Retry-After header of r's
+// response, given either in seconds or as an HTTP date.
+func retryAfter(r *Request) (time.Duration, bool) {
+	if r.HTTPResponse == nil {
+		return 0, false
+	}
+	v := r.HTTPResponse.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if delay := t.Sub(time.Now()); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// throttleCodes are the error codes of throttling errors.
+var throttleCodes = map[string]bool{
+	"Throttling":                             true,
+	"ThrottlingException":                    true,
+	"ThrottledException":                     true,
+	"RequestThrottledException":              true,
+	"RequestThrottled":                       true,
+	"TooManyRequestsException":               true,
+	"ProvisionedThroughputExceededException": true,
+	"TransactionInProgressException":         true,
+	"RequestLimitExceeded":                   true,
+	"BandwidthLimitExceeded":                 true,
+	"LimitExceededException":                 true,
+	"SlowDown":                               true,
+	"PriorRequestNotComplete":                true,
+	"EC2ThrottledException":                  true,
+}
+
+// transientCodes are the error codes of transient errors, which may succeed
+// when retried.
+var transientCodes = map[string]bool{
+	"RequestTimeout":          true,
+	"RequestTimeoutException": true,
+	"InternalError":           true,
+	"InternalFailure":         true,
+	"InternalServerError":     true,
+	"ServiceUnavailable":      true,
+	"IDPCommunicationError":   true,
+	"CRC32CheckFailed":        true,
+}
+
+// expiredCredentialsCodes are the error codes of requests signed with
+// expired credentials.
+var expiredCredentialsCodes = map[string]bool{
+	"ExpiredToken":          true,
+	"ExpiredTokenException": true,
+	"InvalidClientTokenId":  true,
+}
+
+// IsErrorExpiredCredentials returns whether r failed because it was signed
+// with expired credentials.
+func IsErrorExpiredCredentials(r *Request) bool {
+	if err := Error(r.Error); err != nil {
+		return expiredCredentialsCodes[err.Code]
+	}
+	return false
+}
+
+// IsErrorThrottle returns whether r failed because it was throttled.
+func IsErrorThrottle(r *Request) bool {
+	if r.HTTPResponse != nil && r.HTTPResponse.StatusCode == 429 {
+		return true
+	}
+	if err := Error(r.Error); err != nil {
+		return throttleCodes[err.Code]
+	}
+	return false
+}
+
+// IsErrorRetryable returns whether r failed with a throttling, transient or
+// network error.
+func IsErrorRetryable(r *Request) bool {
+	if r.Error == nil {
+		return false
+	}
+	if IsErrorThrottle(r) {
+		return true
+	}
+
+	if err := Error(r.Error); err != nil {
+		if err.Code == ErrCodeRequestCanceled {
+			return false
+		}
+		if transientCodes[err.Code] {
+			return true
+		}
+	}
+
+	if r.HTTPResponse != nil {
+		code := r.HTTPResponse.StatusCode
+		return code >= 500 && code != http.StatusNotImplemented
+	}
+	return isNetworkErrorRetryable(r.Error)
+}
+
+// isNetworkErrorRetryable returns whether an error sending a request is a
+// connection failure or timeout, rather than e.g. an invalid URL or a
+// certificate error.
+func isNetworkErrorRetryable(err error) bool {
+	switch {
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF),
+		errors.Is(err, syscall.ECONNRESET), errors.Is(err, syscall.ECONNREFUSED),
+		errors.Is(err, syscall.EPIPE):
+		return true
+	}
+
+	var nerr net.Error
+	if errors.As(err, &nerr) && nerr.Timeout() {
+		return true
+	}
+
+	var operr *net.OpError
+	if errors.As(err, &operr) {
+		return operr.Op == "dial" || operr.Op == "read"
+	}
+	return false
+}
+
+// A RetryTokenBucket limits the rate of retries. Each retry takes a token;
+// tokens are added at Rate per second, up to Burst.
+type RetryTokenBucket struct {
+	Rate  float64
+	Burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewRetryTokenBucket returns a full bucket allowing burst retries, refilled
+// at rate retries per second.
+func NewRetryTokenBucket(rate float64, burst int) *RetryTokenBucket {
+	return &RetryTokenBucket{
+		Rate:   rate,
+		Burst:  float64(burst),
+		tokens: float64(burst),
+		last:   bucketNow(),
+	}
+}
+
+// bucketNow returns the current time. It is a variable so tests can control
+// the refill of token buckets.
+var bucketNow = time.Now
+
+// Take takes a token from the bucket, returning false if none is available.
+func (b *RetryTokenBucket) Take() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := bucketNow()
+	b.tokens += now.Sub(b.last).Seconds() * b.Rate
+	if b.tokens > b.Burst {
+		b.tokens = b.Burst
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// serviceRetryer is the Retryer of a service without a Config.Retryer,
+// using the service's retry rules.
+type serviceRetryer struct {
+	s *Service
+}
+
+func (s serviceRetryer) RetryRules(r *Request) time.Duration {
+	return s.s.RetryRules(r)
+}
+
+func (s serviceRetryer) ShouldRetry(r *Request) bool {
+	return s.s.ShouldRetry(r)
+}
+
+func (s serviceRetryer) MaxRetries() uint {
+	return s.s.MaxRetries()
+}
+
+// retryer returns the Retryer of the request's service.
+func (r *Request) retryer() Retryer {
+	if r.Service.Config.Retryer != nil {
+		return r.Service.Config.Retryer
+	}
+	return serviceRetryer{r.Service}
+}
@@ -0,0 +1,213 @@
+package aws
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func errorResponse(status int, code string) http.Response {
+	return http.Response{
+		StatusCode: status,
+		Header:     http.Header{},
+		Body:       body(`{"__type":"` + code + `","message":"An error occurred."}`),
+	}
+}
+
+func TestIsErrorThrottle(t *testing.T) {
+	cases := []struct {
+		status    int
+		code      string
+		throttle  bool
+		retryable bool
+	}{
+		{400, "ThrottlingException", true, true},
+		{400, "ProvisionedThroughputExceededException", true, true},
+		{503, "SlowDown", true, true},
+		{429, "UnknownError", true, true},
+		{500, "InternalError", false, true},
+		{400, "RequestTimeout", false, true},
+		{503, "UnknownError", false, true},
+		{501, "NotImplemented", false, false},
+		{400, "ValidationException", false, false},
+	}
+
+	for _, c := range cases {
+		resp := errorResponse(c.status, c.code)
+		r := &Request{HTTPResponse: &resp, Error: APIError{StatusCode: c.status, Code: c.code}}
+		assert.Equal(t, c.throttle, IsErrorThrottle(r), c.code)
+		assert.Equal(t, c.retryable, IsErrorRetryable(r), c.code)
+	}
+}
+
+func TestIsErrorRetryableNetworkErrors(t *testing.T) {
+	assert.True(t, IsErrorRetryable(&Request{Error: syscall.ECONNRESET}))
+	assert.False(t, IsErrorRetryable(&Request{Error: APIError{Code: ErrCodeRequestCanceled}}))
+	assert.False(t, IsErrorRetryable(&Request{}))
+}
+
+func TestDefaultRetryerRetryRules(t *testing.T) {
+	retryRand = func(max time.Duration) time.Duration { return max }
+	defer func() { retryRand = defaultRetryRand }()
+
+	d := DefaultRetryer{MaxRetryDelay: time.Second}
+	resp := errorResponse(500, "InternalError")
+	r := &Request{HTTPResponse: &resp, Error: APIError{StatusCode: 500, Code: "InternalError"}}
+	assert.Equal(t, 30*time.Millisecond, d.RetryRules(r))
+	r.RetryCount = 2
+	assert.Equal(t, 120*time.Millisecond, d.RetryRules(r))
+	r.RetryCount = 10
+	assert.Equal(t, time.Second, d.RetryRules(r))
+	r.RetryCount = 100
+	assert.Equal(t, time.Second, d.RetryRules(r))
+
+	r.RetryCount = 0
+	r.Error = APIError{StatusCode: 400, Code: "Throttling"}
+	assert.Equal(t, 500*time.Millisecond, d.RetryRules(r))
+
+	resp.Header.Set("Retry-After", "3")
+	assert.Equal(t, time.Second, d.RetryRules(r))
+	d.MaxRetryDelay = 0
+	assert.Equal(t, 3*time.Second, d.RetryRules(r))
+}
+
+func TestDefaultRetryerJitter(t *testing.T) {
+	d := DefaultRetryer{}
+	r := &Request{Error: APIError{StatusCode: 500, Code: "InternalError"}, RetryCount: 3}
+	for i := 0; i < 100; i++ {
+		delay := d.RetryRules(r)
+		assert.True(t, delay >= 0 && delay <= 240*time.Millisecond, delay.String())
+	}
+}
+
+func TestRetryTokenBucket(t *testing.T) {
+	now := time.Unix(0, 0)
+	bucketNow = func() time.Time { return now }
+	defer func() { bucketNow = time.Now }()
+
+	b := NewRetryTokenBucket(1, 2)
+	assert.True(t, b.Take())
+	assert.True(t, b.Take())
+	assert.False(t, b.Take())
+
+	now = now.Add(1500 * time.Millisecond)
+	assert.True(t, b.Take())
+	assert.False(t, b.Take())
+
+	now = now.Add(time.Hour)
+	assert.True(t, b.Take())
+	assert.True(t, b.Take())
+	assert.False(t, b.Take())
+}
+
+func TestRetryerTokenBucketStopsRetries(t *testing.T) {
+	sleepDelay = func(time.Duration) {}
+	bucketNow = func() time.Time { return time.Unix(0, 0) }
+	defer func() { bucketNow = time.Now }()
+
+	retryer := DefaultRetryer{NumMaxRetries: 10, TokenBucket: NewRetryTokenBucket(1, 2)}
+	s := NewService(&Config{Retryer: retryer})
+	s.Handlers.Validate.Clear()
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		resp := errorResponse(500, "InternalError")
+		r.HTTPResponse = &resp
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Equal(t, "InternalError", Error(err).Code)
+	assert.Equal(t, 2, int(r.RetryCount))
+}
+
+func TestRetryerThrottleFromResponseCode(t *testing.T) {
+	delays := []time.Duration{}
+	sleepDelay = func(delay time.Duration) {
+		delays = append(delays, delay)
+	}
+	retryRand = func(max time.Duration) time.Duration { return max }
+	defer func() { retryRand = defaultRetryRand }()
+
+	reqNum := 0
+	reqs := []http.Response{
+		errorResponse(400, "ThrottlingException"),
+		errorResponse(400, "ThrottlingException"),
+		{StatusCode: 200, Body: body(`{"data":"valid"}`)},
+	}
+
+	s := NewService(&Config{MaxRetries: 5})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &reqs[reqNum]
+		reqNum++
+	})
+
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, out)
+	assert.Nil(t, r.Send())
+	assert.Equal(t, "valid", out.Data)
+	assert.Equal(t, []time.Duration{500 * time.Millisecond, time.Second}, delays)
+}
+
+func TestRetrySendErrorRewindsBody(t *testing.T) {
+	sleepDelay = func(time.Duration) {}
+
+	bodies := []string{}
+	s := NewService(&Config{MaxRetries: 3})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) < 3 {
+			r.Error = syscall.ECONNRESET
+			return
+		}
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body(`{"data":"valid"}`)}
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	r.SetReaderBody(bytes.NewReader([]byte("payload")))
+	assert.Nil(t, r.Send())
+	assert.Equal(t, []string{"payload", "payload", "payload"}, bodies)
+	assert.Equal(t, 2, int(r.RetryCount))
+}
+
+type countingRetryer struct {
+	DefaultRetryer
+	calls int
+}
+
+func (c *countingRetryer) ShouldRetry(r *Request) bool {
+	c.calls++
+	return c.DefaultRetryer.ShouldRetry(r)
+}
+
+func TestConfigRetryerOverridesService(t *testing.T) {
+	sleepDelay = func(time.Duration) {}
+
+	retryer := &countingRetryer{DefaultRetryer: DefaultRetryer{NumMaxRetries: 1}}
+	s := NewService(&Config{MaxRetries: 10, Retryer: retryer})
+	s.Handlers.Validate.Clear()
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		resp := errorResponse(500, "InternalError")
+		r.HTTPResponse = &resp
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.NotNil(t, r.Send())
+	assert.Equal(t, 1, int(r.RetryCount))
+	assert.Equal(t, 2, retryer.calls)
+}
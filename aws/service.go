@@ -1,14 +1,9 @@
 package aws
 
 import (
-	"fmt"
-	"math"
 	"net/http"
-	"net/http/httputil"
 	"regexp"
 	"time"
-
-	"github.com/datacratic/aws-sdk-go/internal/endpoints"
 )
 
 type Service struct {
@@ -19,15 +14,32 @@ type Service struct {
 	APIVersion        string
 	Endpoint          string
 	SigningRegion     string
+	SigningName       string
 	JSONVersion       string
 	TargetPrefix      string
 	RetryRules        func(*Request) time.Duration
 	ShouldRetry       func(*Request) bool
 	DefaultMaxRetries uint
+
+	// ErrorTypes maps the service's modeled error codes to constructors of
+	// their typed errors. It is used by the protocol UnmarshalError handlers.
+	ErrorTypes map[string]func() ServiceError
+
+	// endpointErr is the error resolving the endpoint, returned by requests.
+	endpointErr error
 }
 
 var schemeRE = regexp.MustCompile("^([^:]+)://")
 
+// NewError returns a new, empty typed error for the error code, or nil if
+// the service has no typed error for it.
+func (s *Service) NewError(code string) ServiceError {
+	if fn, ok := s.ErrorTypes[code]; ok {
+		return fn()
+	}
+	return nil
+}
+
 func NewService(config *Config) *Service {
 	svc := &Service{Config: config}
 	svc.Initialize()
@@ -43,11 +55,11 @@ func (s *Service) Initialize() {
 	}
 
 	if s.RetryRules == nil {
-		s.RetryRules = retryRules
+		s.RetryRules = DefaultRetryer{}.RetryRules
 	}
 
 	if s.ShouldRetry == nil {
-		s.ShouldRetry = shouldRetry
+		s.ShouldRetry = IsErrorRetryable
 	}
 
 	s.DefaultMaxRetries = 3
@@ -55,8 +67,15 @@ func (s *Service) Initialize() {
 	s.Handlers.Build.PushBack(UserAgentHandler)
 	s.Handlers.Sign.PushBack(BuildContentLength)
 	s.Handlers.Send.PushBack(SendHandler)
+	s.Handlers.Retry.PushBack(RetryHandler)
 	s.Handlers.AfterRetry.PushBack(AfterRetryHandler)
 	s.Handlers.ValidateResponse.PushBack(ValidateResponseHandler)
+	if s.Config.Instrumenter != nil {
+		addInstrumentationHandlers(&s.Handlers, s.Config.Instrumenter)
+	}
+	if s.Config.RateLimiter != nil {
+		s.Config.RateLimiter.addHandlers(&s.Handlers)
+	}
 	s.AddDebugHandlers()
 	s.buildEndpoint()
 
@@ -65,12 +84,34 @@ func (s *Service) Initialize() {
 	}
 }
 
+// buildEndpoint sets the endpoint of the service to, in order of
+// precedence, the configured endpoint, the endpoint resolved by the
+// configured resolver, the endpoint set in the environment, or the standard
+// endpoint. UseDualStack and UseFIPS select variants of the standard
+// endpoints.
 func (s *Service) buildEndpoint() {
 	if s.Config.Endpoint != "" {
 		s.Endpoint = s.Config.Endpoint
+	} else if endpoint := EnvEndpoint(s.ServiceName); endpoint != "" && s.Config.EndpointResolver == nil {
+		s.Endpoint = endpoint
 	} else {
-		s.Endpoint, s.SigningRegion =
-			endpoints.EndpointForRegion(s.ServiceName, s.Config.Region)
+		resolver := s.Config.EndpointResolver
+		if resolver == nil && (s.Config.UseDualStack || s.Config.UseFIPS) {
+			resolver = StandardEndpointResolver{
+				UseDualStack: s.Config.UseDualStack,
+				UseFIPS:      s.Config.UseFIPS,
+			}
+		} else if resolver == nil {
+			resolver = DefaultEndpointResolver
+		}
+		endpoint, err := resolver.ResolveEndpoint(s.ServiceName, s.Config.Region)
+		if err != nil {
+			s.endpointErr = err
+			return
+		}
+		s.Endpoint = endpoint.URL
+		s.SigningRegion = endpoint.SigningRegion
+		s.SigningName = endpoint.SigningName
 	}
 
 	if s.Endpoint != "" && !schemeRE.MatchString(s.Endpoint) {
@@ -82,38 +123,55 @@ func (s *Service) buildEndpoint() {
 	}
 }
 
+// AddDebugHandlers adds the handlers logging requests to the configured
+// Logger, as selected by the LogLevel.
 func (s *Service) AddDebugHandlers() {
-	out := s.Config.Logger
-	if s.Config.LogLevel == 0 {
+	level, logger := s.Config.LogLevel, s.Config.Logger
+	if level == 0 || logger == nil {
 		return
 	}
 
-	s.Handlers.Sign.PushBack(func(r *Request) {
-		dumpedBody, _ := httputil.DumpRequest(r.HTTPRequest, true)
-
-		fmt.Fprintf(out, "=> [%s] %s.%s(%+v)\n", r.Time,
-			r.Service.ServiceName, r.Operation.Name, r.Params)
-		fmt.Fprintf(out, "---[ REQUEST PRE-SIGN ]------------------------------\n")
-		fmt.Fprintf(out, "%s\n", string(dumpedBody))
-		fmt.Fprintf(out, "-----------------------------------------------------\n")
-	})
-	s.Handlers.Send.PushFront(func(r *Request) {
-		dumpedBody, _ := httputil.DumpRequestOut(r.HTTPRequest, true)
-
-		fmt.Fprintf(out, "---[ REQUEST POST-SIGN ]-----------------------------\n")
-		fmt.Fprintf(out, "%s\n", string(dumpedBody))
-		fmt.Fprintf(out, "-----------------------------------------------------\n")
-	})
-	s.Handlers.Send.PushBack(func(r *Request) {
-		fmt.Fprintf(out, "---[ RESPONSE ]--------------------------------------\n")
-		if r.HTTPResponse != nil {
-			dumpedBody, _ := httputil.DumpResponse(r.HTTPResponse, true)
-			fmt.Fprintf(out, "%s\n", string(dumpedBody))
-		} else if r.Error != nil {
-			fmt.Fprintf(out, "%s\n", r.Error)
+	if level.Matches(LogHTTPHeaders | LogHTTPBodies) {
+		body := level.Matches(LogHTTPBodies)
+		httpLevel := LogHTTPHeaders
+		if body {
+			httpLevel = LogHTTPBodies
 		}
-		fmt.Fprintf(out, "-----------------------------------------------------\n")
-	})
+
+		s.Handlers.Send.PushFront(func(r *Request) {
+			logger.Log(httpLevel, "sending request",
+				"service", r.Service.ServiceName, "operation", r.Operation.Name,
+				"retry", r.RetryCount, "request", dumpRequest(r, body))
+		})
+		s.Handlers.Send.PushBack(func(r *Request) {
+			if r.HTTPResponse == nil {
+				return
+			}
+			logger.Log(httpLevel, "received response",
+				"service", r.Service.ServiceName, "operation", r.Operation.Name,
+				"retry", r.RetryCount, "response", dumpResponse(r, body))
+		})
+	}
+
+	if level.Matches(LogRetries) {
+		s.Handlers.AfterRetry.PushFront(func(r *Request) {
+			if r.WillRetry() {
+				logger.Log(LogRetries, "retrying request",
+					"service", r.Service.ServiceName, "operation", r.Operation.Name,
+					"retry", r.RetryCount+1, "delay", r.RetryDelay, "error", r.Error)
+			}
+		})
+	}
+
+	if level.Matches(LogRequestErrors) {
+		s.Handlers.AfterRetry.PushBack(func(r *Request) {
+			if r.Error != nil {
+				logger.Log(LogRequestErrors, "request failed",
+					"service", r.Service.ServiceName, "operation", r.Operation.Name,
+					"retries", r.RetryCount, "error", r.Error)
+			}
+		})
+	}
 }
 
 func (s *Service) MaxRetries() uint {
@@ -123,21 +181,3 @@ func (s *Service) MaxRetries() uint {
 		return uint(s.Config.MaxRetries)
 	}
 }
-
-func retryRules(r *Request) time.Duration {
-	delay := time.Duration(math.Pow(2, float64(r.RetryCount))) * 30
-	return delay * time.Millisecond
-}
-
-func shouldRetry(r *Request) bool {
-	if r.HTTPResponse.StatusCode >= 500 {
-		return true
-	} else if err := Error(r.Error); err != nil {
-		switch err.Code {
-		case "ExpiredTokenException":
-		case "ProvisionedThroughputExceededException", "Throttling":
-			return true
-		}
-	}
-	return false
-}
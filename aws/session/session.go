@@ -0,0 +1,171 @@
+// Package session resolves the configuration of AWS clients once, from the
+// environment, the shared credentials and config files and explicit
+// settings, and shares it between the clients built from it.
+//
+// Clients of a session don't depend on aws.DefaultConfig, so independent
+// sessions can be used side by side:
+//
+//	sess, err := session.New(&session.Options{Profile: "prod"})
+//	if err != nil {
+//		return err
+//	}
+//	svc := sqs.New(sess.Config("sqs", nil))
+package session
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/aws/stscreds"
+)
+
+// Options are the explicit settings of a session.
+type Options struct {
+	// Config holds settings taking precedence over the environment and the
+	// shared config files, such as Region, Credentials, HTTPClient, LogLevel
+	// and MaxRetries. Like the configurations passed to clients, it is
+	// merged with aws.Config.Merge.
+	Config *aws.Config
+
+	// Profile is the profile of the shared config files to use. If empty,
+	// the profile named by $AWS_PROFILE, or else the default profile, is
+	// used. An explicit profile is used in preference to credentials in the
+	// environment.
+	Profile string
+
+	// CredentialsFile and ConfigFile are the shared files to read the
+	// profile from. If both are empty, the default files are read.
+	CredentialsFile string
+	ConfigFile      string
+
+	// Endpoints overrides the endpoints of services, by service name, such
+	// as "s3".
+	Endpoints map[string]string
+
+	// TokenProvider returns MFA token codes, for profiles assuming roles
+	// with an mfa_serial.
+	TokenProvider func() (string, error)
+
+	// DetectRegion enables querying the EC2 instance metadata for the region
+	// when none is configured.
+	DetectRegion bool
+}
+
+// A Session is a resolved configuration shared by clients. Its credentials
+// are cached, so the clients share their refreshes. A Session is safe for
+// concurrent use.
+type Session struct {
+	config    *aws.Config
+	endpoints map[string]string
+}
+
+// New returns a session resolved from the options, the environment and the
+// shared config files. Settings are taken from, in order of precedence, the
+// options, the environment, and the profile.
+func New(opts *Options) (*Session, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	prof, err := loadProfile(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &aws.Config{
+		Region:     os.Getenv("AWS_REGION"),
+		HTTPClient: http.DefaultClient,
+		Logger:     aws.NewWriterLogger(os.Stdout),
+		MaxRetries: aws.DEFAULT_RETRIES,
+	}
+	if config.Region == "" && prof != nil {
+		config.Region = prof.Region
+	}
+	config = config.Merge(opts.Config)
+
+	if config.Region == "" && opts.DetectRegion {
+		if config.Region, err = aws.DetectRegion(); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.Credentials == nil {
+		config.Credentials = credentials(opts, prof, config)
+	}
+	config.Credentials = aws.CachedCreds(config.Credentials)
+
+	endpoints := map[string]string{}
+	for service, endpoint := range opts.Endpoints {
+		endpoints[service] = endpoint
+	}
+
+	return &Session{config: config.Complete(), endpoints: endpoints}, nil
+}
+
+// Config returns a copy of the configuration of the session for a client
+// of the named service, such as "s3", with the overrides applied.
+func (s *Session) Config(service string, overrides *aws.Config) *aws.Config {
+	config := s.config.Merge(nil)
+	if endpoint, ok := s.endpoints[service]; ok {
+		config.Endpoint = endpoint
+	}
+	return config.Merge(overrides).Complete()
+}
+
+// Credentials returns the shared credentials provider of the session.
+func (s *Session) Credentials() aws.CredentialsProvider {
+	return s.config.Credentials
+}
+
+// Region returns the region of the session.
+func (s *Session) Region() string {
+	return s.config.Region
+}
+
+// loadProfile loads the profile of the options. It returns nil if the
+// default profile isn't found, but an error if a named profile isn't.
+func loadProfile(opts *Options) (*aws.Profile, error) {
+	var prof *aws.Profile
+	var err error
+	if opts.CredentialsFile == "" && opts.ConfigFile == "" {
+		prof, err = aws.LoadProfile(opts.Profile)
+	} else {
+		prof, err = aws.LoadProfileFiles(opts.CredentialsFile, opts.ConfigFile, opts.Profile)
+	}
+
+	if err != nil && opts.Profile == "" && os.Getenv("AWS_PROFILE") == "" {
+		return nil, nil
+	}
+	return prof, err
+}
+
+// credentials returns the provider of the credentials found first in the
+// environment, the profile, a web identity token, the container
+// credentials endpoint, or else the EC2 instance role. config is the
+// configuration of STS clients assuming roles.
+func credentials(opts *Options, prof *aws.Profile, config *aws.Config) aws.CredentialsProvider {
+	if opts.Profile == "" {
+		if env, err := aws.EnvCreds(); err == nil {
+			return env
+		}
+	}
+
+	if prof != nil && (prof.HasCredentials() || prof.RoleARN != "" || prof.CredentialProcess != "") {
+		return &stscreds.ProfileProvider{
+			Profile:         prof.Name,
+			CredentialsFile: opts.CredentialsFile,
+			ConfigFile:      opts.ConfigFile,
+			TokenProvider:   opts.TokenProvider,
+			Config:          config.Merge(nil),
+		}
+	}
+
+	if webIdentity, err := aws.WebIdentityCreds(); err == nil {
+		return webIdentity
+	}
+	if container, err := aws.ContainerCreds(); err == nil {
+		return container
+	}
+	return aws.IAMCreds()
+}
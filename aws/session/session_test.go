@@ -0,0 +1,159 @@
+package session
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/aws/stscreds"
+	"github.com/stretchr/testify/assert"
+)
+
+type countingProvider struct {
+	calls int
+}
+
+func (p *countingProvider) Credentials() (*aws.Credentials, error) {
+	p.calls++
+	return &aws.Credentials{AccessKeyID: "key", SecretAccessKey: "secret", Expires: time.Now().Add(time.Hour)}, nil
+}
+
+func writeConfigFiles(t *testing.T, credentials, config string) (string, string, string) {
+	dir, err := ioutil.TempDir("", "session")
+	if err != nil {
+		t.Fatal(err)
+	}
+	credentialsFile := filepath.Join(dir, "credentials")
+	configFile := filepath.Join(dir, "config")
+	ioutil.WriteFile(credentialsFile, []byte(credentials), 0600)
+	ioutil.WriteFile(configFile, []byte(config), 0600)
+	return dir, credentialsFile, configFile
+}
+
+func TestNewFromEnvironment(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_REGION", "eu-west-1")
+	os.Setenv("AWS_ACCESS_KEY_ID", "envKey")
+	os.Setenv("AWS_SECRET_ACCESS_KEY", "envSecret")
+
+	sess, err := New(&Options{CredentialsFile: "/nonexistent/credentials"})
+	assert.NoError(t, err)
+	assert.Equal(t, "eu-west-1", sess.Region())
+
+	creds, err := sess.Credentials().Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "envKey", creds.AccessKeyID)
+
+	config := sess.Config("sqs", nil)
+	assert.Equal(t, http.DefaultClient, config.HTTPClient)
+	assert.Equal(t, aws.DEFAULT_RETRIES, config.MaxRetries)
+}
+
+func TestNewFromProfile(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_ACCESS_KEY_ID", "envKey")
+	os.Setenv("AWS_SECRET_ACCESS_KEY", "envSecret")
+
+	dir, credentialsFile, configFile := writeConfigFiles(t, `
+[default]
+aws_access_key_id = defaultKey
+aws_secret_access_key = defaultSecret
+
+[dev]
+aws_access_key_id = devKey
+aws_secret_access_key = devSecret
+`, `
+[default]
+region = us-west-2
+
+[profile dev]
+region = ap-southeast-2
+`)
+	defer os.RemoveAll(dir)
+
+	// credentials in the environment take precedence over the default
+	// profile
+	sess, err := New(&Options{CredentialsFile: credentialsFile, ConfigFile: configFile})
+	assert.NoError(t, err)
+	assert.Equal(t, "us-west-2", sess.Region())
+	creds, err := sess.Credentials().Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "envKey", creds.AccessKeyID)
+
+	// but not over an explicit profile
+	sess, err = New(&Options{Profile: "dev", CredentialsFile: credentialsFile, ConfigFile: configFile})
+	assert.NoError(t, err)
+	assert.Equal(t, "ap-southeast-2", sess.Region())
+	assert.IsType(t, &stscreds.ProfileProvider{}, sess.Credentials().(*aws.CredentialsCache).Provider)
+	creds, err = sess.Credentials().Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "devKey", creds.AccessKeyID)
+
+	// the region of the environment takes precedence over the profile
+	os.Setenv("AWS_REGION", "eu-central-1")
+	sess, err = New(&Options{Profile: "dev", CredentialsFile: credentialsFile, ConfigFile: configFile})
+	assert.NoError(t, err)
+	assert.Equal(t, "eu-central-1", sess.Region())
+
+	_, err = New(&Options{Profile: "missing", CredentialsFile: credentialsFile, ConfigFile: configFile})
+	assert.Error(t, err)
+}
+
+func TestNewOverrides(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_REGION", "eu-west-1")
+
+	provider := &countingProvider{}
+	client := &http.Client{}
+	sess, err := New(&Options{
+		Config: &aws.Config{
+			Region:      "us-east-1",
+			Credentials: provider,
+			HTTPClient:  client,
+			LogLevel:    aws.LogRetries,
+			MaxRetries:  5,
+		},
+		Endpoints: map[string]string{"s3": "http://localhost:9000"},
+	})
+	assert.NoError(t, err)
+
+	s3Config := sess.Config("s3", nil)
+	assert.Equal(t, "us-east-1", s3Config.Region)
+	assert.Equal(t, client, s3Config.HTTPClient)
+	assert.Equal(t, aws.LogRetries, s3Config.LogLevel)
+	assert.Equal(t, 5, s3Config.MaxRetries)
+	assert.Equal(t, "http://localhost:9000", s3Config.Endpoint)
+
+	sqsConfig := sess.Config("sqs", &aws.Config{Region: "us-west-1", MaxRetries: aws.DEFAULT_RETRIES})
+	assert.Equal(t, "us-west-1", sqsConfig.Region)
+	assert.Equal(t, 5, sqsConfig.MaxRetries)
+	assert.Equal(t, "", sqsConfig.Endpoint)
+
+	// the clients share the cached credentials
+	s3Config.Credentials.Credentials()
+	sqsConfig.Credentials.Credentials()
+	assert.Equal(t, 1, provider.calls)
+	assert.Equal(t, sess.Credentials(), sqsConfig.Credentials)
+}
+
+func TestConfigIndependentOfDefaultConfig(t *testing.T) {
+	os.Clearenv()
+
+	defer func(c aws.Config) {
+		*aws.DefaultConfig = c
+	}(*aws.DefaultConfig)
+	aws.DefaultConfig.Region = "global-region"
+	aws.DefaultConfig.LogLevel = aws.LogDebug
+
+	sess, err := New(&Options{Config: &aws.Config{Credentials: &countingProvider{}, MaxRetries: aws.DEFAULT_RETRIES}})
+	assert.NoError(t, err)
+
+	config := aws.DefaultConfig.Merge(sess.Config("sqs", nil))
+	assert.Equal(t, "", config.Region)
+	assert.Equal(t, aws.LogLevel(0), config.LogLevel)
+	assert.Equal(t, "", aws.DefaultConfig.Merge(config).Region)
+}
@@ -0,0 +1,193 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/vaughan0/go-ini"
+)
+
+// A Profile is a named profile of the shared credentials file
+// (~/.aws/credentials) and config file (~/.aws/config). Settings in the
+// credentials file take precedence over the same settings in the config
+// file.
+type Profile struct {
+	Name string
+
+	// Region is the default region of the profile.
+	Region string
+
+	// Credentials are the static credentials of the profile, if any.
+	Credentials Credentials
+
+	// RoleARN is the role the profile assumes, using the credentials of
+	// SourceProfile.
+	RoleARN         string
+	SourceProfile   string
+	ExternalID      string
+	MFASerial       string
+	RoleSessionName string
+	DurationSeconds int
+
+	// CredentialProcess is the command printing the credentials of the
+	// profile, for a ProcessCredentialsProvider.
+	CredentialProcess string
+
+	// WebIdentityTokenFile is the file of the web identity token used to
+	// assume RoleARN, instead of the credentials of a source profile.
+	WebIdentityTokenFile string
+}
+
+// HasCredentials returns whether the profile has static credentials.
+func (p *Profile) HasCredentials() bool {
+	return p.Credentials.AccessKeyID != "" && p.Credentials.SecretAccessKey != ""
+}
+
+// SharedConfigFiles returns the paths of the shared credentials and config
+// files: $AWS_SHARED_CREDENTIALS_FILE and $AWS_CONFIG_FILE, defaulting to
+// credentials and config in the .aws directory of the user's home.
+func SharedConfigFiles() (credentialsFile, configFile string, err error) {
+	credentialsFile = os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	configFile = os.Getenv("AWS_CONFIG_FILE")
+	if credentialsFile != "" && configFile != "" {
+		return credentialsFile, configFile, nil
+	}
+
+	homeDir := os.Getenv("HOME") // *nix
+	if homeDir == "" {           // Windows
+		homeDir = os.Getenv("USERPROFILE")
+	}
+	if homeDir == "" {
+		return "", "", errors.New("User home directory not found.")
+	}
+
+	if credentialsFile == "" {
+		credentialsFile = filepath.Join(homeDir, ".aws", "credentials")
+	}
+	if configFile == "" {
+		configFile = filepath.Join(homeDir, ".aws", "config")
+	}
+	return credentialsFile, configFile, nil
+}
+
+// profileName returns name, or the profile named by $AWS_PROFILE, or the
+// default profile.
+func profileName(name string) string {
+	if name == "" {
+		name = os.Getenv("AWS_PROFILE")
+	}
+	if name == "" {
+		name = "default"
+	}
+	return name
+}
+
+// LoadProfile loads a profile from the shared credentials and config files.
+// If name is empty, the profile named by $AWS_PROFILE, or else the default
+// profile, is loaded.
+func LoadProfile(name string) (*Profile, error) {
+	credentialsFile, configFile, err := SharedConfigFiles()
+	if err != nil {
+		return nil, err
+	}
+	return LoadProfileFiles(credentialsFile, configFile, name)
+}
+
+// LoadProfileFiles loads a profile from the given credentials and config
+// files. Either file may be empty or missing, but the profile must be found
+// in one of them.
+func LoadProfileFiles(credentialsFile, configFile, name string) (*Profile, error) {
+	name = profileName(name)
+
+	values := map[string]string{}
+	found := false
+
+	// in the config file, profiles other than default are prefixed
+	if configFile != "" {
+		config, err := loadINIFile(configFile)
+		if err != nil {
+			return nil, err
+		}
+		sections := []string{"profile " + name}
+		if name == "default" {
+			sections = []string{"default", "profile default"}
+		}
+		for _, section := range sections {
+			if s, ok := config[section]; ok {
+				found = true
+				for k, v := range s {
+					values[k] = v
+				}
+			}
+		}
+	}
+
+	if credentialsFile != "" {
+		creds, err := loadINIFile(credentialsFile)
+		if err != nil {
+			return nil, err
+		}
+		if s, ok := creds[name]; ok {
+			found = true
+			for k, v := range s {
+				values[k] = v
+			}
+		}
+	}
+
+	if !found {
+		return nil, &profileNotFoundError{name, credentialsFile, configFile}
+	}
+
+	p := &Profile{
+		Name:   name,
+		Region: values["region"],
+		Credentials: Credentials{
+			AccessKeyID:     values["aws_access_key_id"],
+			SecretAccessKey: values["aws_secret_access_key"],
+			SessionToken:    values["aws_session_token"],
+		},
+		RoleARN:         values["role_arn"],
+		SourceProfile:   values["source_profile"],
+		ExternalID:      values["external_id"],
+		MFASerial:       values["mfa_serial"],
+		RoleSessionName: values["role_session_name"],
+
+		CredentialProcess:    values["credential_process"],
+		WebIdentityTokenFile: values["web_identity_token_file"],
+	}
+	if v := values["duration_seconds"]; v != "" {
+		d, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("profile %s has invalid duration_seconds %q", name, v)
+		}
+		p.DurationSeconds = d
+	}
+	if p.RoleARN != "" && p.SourceProfile == "" && p.WebIdentityTokenFile == "" {
+		return nil, fmt.Errorf("profile %s has role_arn but no source_profile or web_identity_token_file", name)
+	}
+	return p, nil
+}
+
+// loadINIFile loads an ini file, returning an empty file if it doesn't
+// exist.
+func loadINIFile(filename string) (ini.File, error) {
+	f, err := ini.LoadFile(filename)
+	if os.IsNotExist(err) {
+		return ini.File{}, nil
+	}
+	return f, err
+}
+
+// profileNotFoundError is returned when a profile is in neither of the
+// shared files.
+type profileNotFoundError struct {
+	name, credentialsFile, configFile string
+}
+
+func (e *profileNotFoundError) Error() string {
+	return fmt.Sprintf("profile %s not found in %s or %s", e.name, e.credentialsFile, e.configFile)
+}
@@ -0,0 +1,153 @@
+package aws
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const testCredentialsFile = `
+[default]
+aws_access_key_id = defaultKey
+aws_secret_access_key = defaultSecret
+
+[dev]
+aws_access_key_id = devKey
+aws_secret_access_key = devSecret
+`
+
+const testConfigFile = `
+[default]
+region = us-west-2
+
+[profile dev]
+region = eu-west-1
+aws_access_key_id = ignoredKey
+
+[profile admin]
+role_arn = arn:aws:iam::123456789012:role/admin
+source_profile = dev
+external_id = ext-1234
+mfa_serial = arn:aws:iam::123456789012:mfa/user
+role_session_name = admin-session
+duration_seconds = 1800
+
+[profile broken]
+role_arn = arn:aws:iam::123456789012:role/admin
+`
+
+func writeSharedConfigFiles(t *testing.T) (string, string) {
+	dir, err := ioutil.TempDir("", "shared-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	credentialsFile := filepath.Join(dir, "credentials")
+	configFile := filepath.Join(dir, "config")
+	if err := ioutil.WriteFile(credentialsFile, []byte(testCredentialsFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(configFile, []byte(testConfigFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return credentialsFile, configFile
+}
+
+func TestLoadProfileFiles(t *testing.T) {
+	os.Clearenv()
+	credentialsFile, configFile := writeSharedConfigFiles(t)
+	defer os.RemoveAll(filepath.Dir(credentialsFile))
+
+	p, err := LoadProfileFiles(credentialsFile, configFile, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := p.Region, "us-west-2"; v != want {
+		t.Errorf("Region was %v, expected %v", v, want)
+	}
+	if v, want := p.Credentials.AccessKeyID, "defaultKey"; v != want {
+		t.Errorf("AccessKeyID was %v, expected %v", v, want)
+	}
+
+	// the credentials file takes precedence over the config file
+	p, err = LoadProfileFiles(credentialsFile, configFile, "dev")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := p.Region, "eu-west-1"; v != want {
+		t.Errorf("Region was %v, expected %v", v, want)
+	}
+	if v, want := p.Credentials.AccessKeyID, "devKey"; v != want {
+		t.Errorf("AccessKeyID was %v, expected %v", v, want)
+	}
+
+	os.Setenv("AWS_PROFILE", "admin")
+	p, err = LoadProfileFiles(credentialsFile, configFile, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Profile{
+		Name:            "admin",
+		RoleARN:         "arn:aws:iam::123456789012:role/admin",
+		SourceProfile:   "dev",
+		ExternalID:      "ext-1234",
+		MFASerial:       "arn:aws:iam::123456789012:mfa/user",
+		RoleSessionName: "admin-session",
+		DurationSeconds: 1800,
+	}
+	if *p != *want {
+		t.Errorf("Profile was %+v, expected %+v", p, want)
+	}
+	if p.HasCredentials() {
+		t.Errorf("Expected no credentials in profile %s", p.Name)
+	}
+}
+
+func TestLoadProfileFilesErrors(t *testing.T) {
+	os.Clearenv()
+	credentialsFile, configFile := writeSharedConfigFiles(t)
+	defer os.RemoveAll(filepath.Dir(credentialsFile))
+
+	if _, err := LoadProfileFiles(credentialsFile, configFile, "missing"); err == nil {
+		t.Error("Expected an error for a missing profile")
+	}
+	if _, err := LoadProfileFiles(credentialsFile, configFile, "broken"); err == nil {
+		t.Error("Expected an error for a role_arn without source_profile")
+	}
+	if _, err := LoadProfileFiles(credentialsFile+".missing", "", "default"); err == nil {
+		t.Error("Expected an error without files")
+	}
+}
+
+func TestLoadProfileEnvFiles(t *testing.T) {
+	os.Clearenv()
+	credentialsFile, configFile := writeSharedConfigFiles(t)
+	defer os.RemoveAll(filepath.Dir(credentialsFile))
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", credentialsFile)
+	os.Setenv("AWS_CONFIG_FILE", configFile)
+
+	p, err := LoadProfile("dev")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := p.Region, "eu-west-1"; v != want {
+		t.Errorf("Region was %v, expected %v", v, want)
+	}
+
+	prov, err := ProfileCreds("", "dev", 10*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	creds, err := prov.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := creds.AccessKeyID, "devKey"; v != want {
+		t.Errorf("AccessKeyID was %v, expected %v", v, want)
+	}
+
+	if _, err := (&profileProvider{filename: credentialsFile, configFile: configFile, profile: "admin"}).Credentials(); err == nil {
+		t.Error("Expected an error for a profile assuming a role")
+	}
+}
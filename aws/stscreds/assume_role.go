@@ -0,0 +1,132 @@
+package stscreds
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/service/sts"
+	"github.com/datacratic/aws-sdk-go/service/sts/stsiface"
+)
+
+var currentTime = time.Now
+
+// DefaultExpiryWindow is how long before their expiration the credentials
+// of an AssumeRoleProvider created by AssumeRoleCreds are refreshed.
+const DefaultExpiryWindow = aws.DefaultExpiryWindow
+
+// An AssumeRoleProvider provides the credentials of an assumed role. The
+// credentials are cached, and the role is assumed again ExpiryWindow before
+// they expire.
+type AssumeRoleProvider struct {
+	// Client is the STS client assuming the role. Its credentials must be
+	// allowed to assume the role.
+	Client stsiface.STSAPI
+
+	RoleARN string
+
+	// RoleSessionName identifies the session of the assumed role. If empty,
+	// a name is generated.
+	RoleSessionName string
+
+	ExternalID string
+
+	// Duration of the credentials. If zero, the STS default is used.
+	Duration time.Duration
+
+	// SerialNumber is the MFA device of the role, if it requires MFA. Its
+	// token codes are returned by TokenProvider.
+	SerialNumber  string
+	TokenProvider func() (string, error)
+
+	ExpiryWindow time.Duration
+
+	creds      aws.Credentials
+	m          sync.Mutex
+	expiration time.Time
+}
+
+// AssumeRoleCreds returns a provider of the credentials of roleARN, assumed
+// by an STS client with the given configuration.
+func AssumeRoleCreds(config *aws.Config, roleARN string) *AssumeRoleProvider {
+	return &AssumeRoleProvider{
+		Client:       sts.New(config),
+		RoleARN:      roleARN,
+		ExpiryWindow: DefaultExpiryWindow,
+	}
+}
+
+// Credentials returns the credentials of the assumed role, assuming it
+// again if they are about to expire.
+func (p *AssumeRoleProvider) Credentials() (*aws.Credentials, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.expiration.Add(-p.ExpiryWindow).After(currentTime()) {
+		return &p.creds, nil
+	}
+
+	sessionName := p.RoleSessionName
+	if sessionName == "" {
+		sessionName = fmt.Sprintf("aws-sdk-go-%d", currentTime().UnixNano())
+	}
+
+	input := &sts.AssumeRoleInput{
+		RoleARN:         aws.String(p.RoleARN),
+		RoleSessionName: aws.String(sessionName),
+	}
+	if p.ExternalID != "" {
+		input.ExternalID = aws.String(p.ExternalID)
+	}
+	if p.Duration != 0 {
+		input.DurationSeconds = aws.Long(int64(p.Duration / time.Second))
+	}
+	if p.SerialNumber != "" {
+		if p.TokenProvider == nil {
+			return nil, fmt.Errorf("assuming role %s requires an MFA token for %s", p.RoleARN, p.SerialNumber)
+		}
+		token, err := p.TokenProvider()
+		if err != nil {
+			return nil, err
+		}
+		input.SerialNumber = aws.String(p.SerialNumber)
+		input.TokenCode = aws.String(token)
+	}
+
+	out, err := p.Client.AssumeRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := roleCredentials("AssumeRole", p.RoleARN, out.Credentials)
+	if err != nil {
+		return nil, err
+	}
+	p.creds = creds
+	p.expiration = creds.Expires
+
+	return &p.creds, nil
+}
+
+// Expire expires the cached credentials, assuming the role again.
+func (p *AssumeRoleProvider) Expire() {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	p.expiration = time.Time{}
+}
+
+// roleCredentials returns the credentials of roleARN returned by op, or an
+// error if the response has none.
+func roleCredentials(op, roleARN string, c *sts.Credentials) (aws.Credentials, error) {
+	if c == nil || c.AccessKeyID == nil || c.SecretAccessKey == nil || c.SessionToken == nil || c.Expiration == nil {
+		return aws.Credentials{}, fmt.Errorf("%s returned no credentials for role %s", op, roleARN)
+	}
+	return aws.Credentials{
+		AccessKeyID:     *c.AccessKeyID,
+		SecretAccessKey: *c.SecretAccessKey,
+		SessionToken:    *c.SessionToken,
+		Expires:         *c.Expiration,
+	}, nil
+}
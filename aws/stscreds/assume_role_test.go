@@ -0,0 +1,132 @@
+package stscreds
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/service/sts"
+	"github.com/datacratic/aws-sdk-go/service/sts/stsiface"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubSTS struct {
+	stsiface.STSAPI
+	inputs        []*sts.AssumeRoleInput
+	expiration    time.Time
+	err           error
+	noCredentials bool
+}
+
+func (s *stubSTS) AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
+	s.inputs = append(s.inputs, input)
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.noCredentials {
+		return &sts.AssumeRoleOutput{}, nil
+	}
+	return &sts.AssumeRoleOutput{
+		Credentials: &sts.Credentials{
+			AccessKeyID:     aws.String("accessKey"),
+			SecretAccessKey: aws.String("secret"),
+			SessionToken:    aws.String("token"),
+			Expiration:      aws.Time(s.expiration),
+		},
+	}, nil
+}
+
+func TestAssumeRoleProvider(t *testing.T) {
+	now := time.Now()
+	currentTime = func() time.Time { return now }
+	defer func() { currentTime = time.Now }()
+
+	client := &stubSTS{expiration: now.Add(15 * time.Minute)}
+	p := &AssumeRoleProvider{
+		Client:          client,
+		RoleARN:         "arn:aws:iam::123456789012:role/test",
+		RoleSessionName: "session",
+		ExternalID:      "ext-1234",
+		Duration:        15 * time.Minute,
+		ExpiryWindow:    time.Minute,
+	}
+
+	creds, err := p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, &aws.Credentials{AccessKeyID: "accessKey", SecretAccessKey: "secret", SessionToken: "token", Expires: client.expiration}, creds)
+	assert.Equal(t, 1, len(client.inputs))
+	assert.Equal(t, "arn:aws:iam::123456789012:role/test", *client.inputs[0].RoleARN)
+	assert.Equal(t, "session", *client.inputs[0].RoleSessionName)
+	assert.Equal(t, "ext-1234", *client.inputs[0].ExternalID)
+	assert.Equal(t, int64(900), *client.inputs[0].DurationSeconds)
+	assert.Nil(t, client.inputs[0].SerialNumber)
+
+	now = now.Add(13 * time.Minute)
+	_, err = p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(client.inputs))
+
+	// refreshed within the expiry window
+	now = now.Add(time.Minute)
+	_, err = p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(client.inputs))
+}
+
+func TestAssumeRoleProviderMFA(t *testing.T) {
+	client := &stubSTS{expiration: time.Now().Add(time.Hour)}
+	p := &AssumeRoleProvider{
+		Client:       client,
+		RoleARN:      "arn:aws:iam::123456789012:role/test",
+		SerialNumber: "arn:aws:iam::123456789012:mfa/user",
+	}
+
+	_, err := p.Credentials()
+	assert.Error(t, err)
+	assert.Empty(t, client.inputs)
+
+	p.TokenProvider = func() (string, error) { return "", errors.New("no token") }
+	_, err = p.Credentials()
+	assert.EqualError(t, err, "no token")
+	assert.Empty(t, client.inputs)
+
+	p.TokenProvider = func() (string, error) { return "123456", nil }
+	_, err = p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "arn:aws:iam::123456789012:mfa/user", *client.inputs[0].SerialNumber)
+	assert.Equal(t, "123456", *client.inputs[0].TokenCode)
+}
+
+func TestAssumeRoleProviderError(t *testing.T) {
+	client := &stubSTS{err: errors.New("access denied")}
+	p := &AssumeRoleProvider{Client: client, RoleARN: "arn:aws:iam::123456789012:role/test"}
+
+	_, err := p.Credentials()
+	assert.EqualError(t, err, "access denied")
+
+	// errors are not cached
+	_, err = p.Credentials()
+	assert.Error(t, err)
+	assert.Equal(t, 2, len(client.inputs))
+	assert.Contains(t, *client.inputs[0].RoleSessionName, "aws-sdk-go-")
+}
+
+func TestAssumeRoleCreds(t *testing.T) {
+	p := AssumeRoleCreds(&aws.Config{Region: "us-east-1"}, "arn:aws:iam::123456789012:role/test")
+	assert.Equal(t, DefaultExpiryWindow, p.ExpiryWindow)
+	assert.Equal(t, "arn:aws:iam::123456789012:role/test", p.RoleARN)
+	assert.IsType(t, &sts.STS{}, p.Client)
+
+	var _ aws.CredentialsProvider = p
+}
+
+func TestAssumeRoleProviderNoCredentials(t *testing.T) {
+	p := &AssumeRoleProvider{
+		Client:  &stubSTS{noCredentials: true},
+		RoleARN: "arn:aws:iam::123456789012:role/test",
+	}
+
+	_, err := p.Credentials()
+	assert.EqualError(t, err, "AssumeRole returned no credentials for role arn:aws:iam::123456789012:role/test")
+}
@@ -0,0 +1,180 @@
+// Package stscreds provides credentials obtained from AWS STS, such as the
+// credentials of assumed roles.
+package stscreds
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+)
+
+func init() {
+	aws.RegisterRoleProfileProvider(func(credentialsFile, configFile, profile string) aws.CredentialsProvider {
+		return &ProfileProvider{Profile: profile, CredentialsFile: credentialsFile, ConfigFile: configFile}
+	})
+}
+
+// A ProfileProvider provides the credentials of a profile of the shared
+// credentials and config files. A profile with a role_arn assumes the role
+// using the credentials of its source_profile, which may itself assume a
+// role, or using the token in its web_identity_token_file. Assumed role
+// credentials are cached, and refreshed shortly before they expire.
+type ProfileProvider struct {
+	// Profile is the name of the profile. If empty, the profile named by
+	// $AWS_PROFILE, or else the default profile, is used.
+	Profile string
+
+	// CredentialsFile and ConfigFile are the shared files to read the
+	// profiles from. If both are empty, the default files are read.
+	CredentialsFile string
+	ConfigFile      string
+
+	// TokenProvider returns MFA token codes, for profiles with an
+	// mfa_serial.
+	TokenProvider func() (string, error)
+
+	// Config is the base configuration of the STS clients assuming roles.
+	Config *aws.Config
+
+	provider aws.CredentialsProvider
+	m        sync.Mutex
+}
+
+// ProfileCreds returns a provider of the credentials of the named profile,
+// from the default shared credentials and config files.
+func ProfileCreds(profile string) *ProfileProvider {
+	return &ProfileProvider{Profile: profile}
+}
+
+// NewProfileConfig returns a Config with the credentials and default region
+// of the named profile.
+func NewProfileConfig(profile string) (*aws.Config, error) {
+	p := ProfileCreds(profile)
+	prof, err := p.loadProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+	return &aws.Config{Credentials: p, Region: prof.Region}, nil
+}
+
+// Credentials returns the credentials of the profile.
+func (p *ProfileProvider) Credentials() (*aws.Credentials, error) {
+	p.m.Lock()
+	if p.provider == nil {
+		provider, err := p.resolve(p.Profile, map[string]bool{})
+		if err != nil {
+			p.m.Unlock()
+			return nil, err
+		}
+		p.provider = provider
+	}
+	provider := p.provider
+	p.m.Unlock()
+
+	return provider.Credentials()
+}
+
+// Expire expires the cached credentials of the profile.
+func (p *ProfileProvider) Expire() {
+	p.m.Lock()
+	provider := p.provider
+	p.m.Unlock()
+
+	if e, ok := provider.(aws.Expirer); ok {
+		e.Expire()
+	}
+}
+
+// loadProfile loads the named profile from the files of p.
+func (p *ProfileProvider) loadProfile(name string) (*aws.Profile, error) {
+	if p.CredentialsFile == "" && p.ConfigFile == "" {
+		return aws.LoadProfile(name)
+	}
+	return aws.LoadProfileFiles(p.CredentialsFile, p.ConfigFile, name)
+}
+
+// resolve returns the provider of the credentials of the named profile.
+// visited holds the profiles already in the source_profile chain.
+func (p *ProfileProvider) resolve(name string, visited map[string]bool) (aws.CredentialsProvider, error) {
+	prof, err := p.loadProfile(name)
+	if err != nil {
+		return nil, err
+	}
+	if visited[prof.Name] {
+		return nil, fmt.Errorf("profile %s is its own source_profile through a chain of profiles", prof.Name)
+	}
+	visited[prof.Name] = true
+
+	if prof.RoleARN == "" {
+		return profileCredentials(prof)
+	}
+
+	if prof.WebIdentityTokenFile != "" {
+		provider := WebIdentityCreds(p.stsConfig(prof, nil), prof.RoleARN, prof.WebIdentityTokenFile)
+		provider.RoleSessionName = prof.RoleSessionName
+		provider.Duration = time.Duration(prof.DurationSeconds) * time.Second
+		return provider, nil
+	}
+
+	// a profile may assume a role with its own static credentials
+	var source aws.CredentialsProvider
+	if prof.SourceProfile == prof.Name {
+		source, err = profileCredentials(prof)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		source, err = p.resolve(prof.SourceProfile, visited)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	provider := AssumeRoleCreds(p.stsConfig(prof, source), prof.RoleARN)
+	provider.RoleSessionName = prof.RoleSessionName
+	provider.ExternalID = prof.ExternalID
+	provider.Duration = time.Duration(prof.DurationSeconds) * time.Second
+	provider.SerialNumber = prof.MFASerial
+	provider.TokenProvider = p.TokenProvider
+	return provider, nil
+}
+
+// profileCredentials returns the provider of the static credentials or
+// credential process of prof.
+func profileCredentials(prof *aws.Profile) (aws.CredentialsProvider, error) {
+	switch {
+	case prof.HasCredentials():
+		return aws.Creds(prof.Credentials.AccessKeyID, prof.Credentials.SecretAccessKey,
+			prof.Credentials.SessionToken), nil
+	case prof.CredentialProcess != "":
+		return aws.ProcessCreds(prof.CredentialProcess), nil
+	}
+	return nil, fmt.Errorf("profile %s has no credentials", prof.Name)
+}
+
+// stsConfig returns the configuration of the STS client assuming the role
+// of prof with the source credentials.
+func (p *ProfileProvider) stsConfig(prof *aws.Profile, source aws.CredentialsProvider) *aws.Config {
+	region := prof.Region
+	if region == "" && p.Config != nil {
+		region = p.Config.Region
+	}
+	if region == "" {
+		region = aws.DefaultConfig.Region
+	}
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	config := &aws.Config{MaxRetries: aws.DEFAULT_RETRIES}
+	if p.Config != nil {
+		config = p.Config.Merge(nil)
+	}
+	if source != nil {
+		config.Credentials = source
+	}
+	config.Region = region
+	return config
+}
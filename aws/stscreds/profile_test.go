@@ -0,0 +1,285 @@
+package stscreds
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+const testConfigFile = `
+[default]
+aws_access_key_id = defaultKey
+aws_secret_access_key = defaultSecret
+
+[profile dev]
+region = eu-west-1
+role_arn = arn:aws:iam::123456789012:role/dev
+source_profile = default
+external_id = ext-1234
+
+[profile admin]
+role_arn = arn:aws:iam::123456789012:role/admin
+source_profile = dev
+mfa_serial = arn:aws:iam::123456789012:mfa/user
+duration_seconds = 1800
+
+[profile self]
+aws_access_key_id = selfKey
+aws_secret_access_key = selfSecret
+role_arn = arn:aws:iam::123456789012:role/self
+source_profile = self
+
+[profile loop1]
+role_arn = arn:aws:iam::123456789012:role/loop
+source_profile = loop2
+
+[profile loop2]
+role_arn = arn:aws:iam::123456789012:role/loop
+source_profile = loop1
+`
+
+// assumeRoleCall is an AssumeRole call received by the test STS server.
+type assumeRoleCall struct {
+	accessKeyID string
+	form        map[string]string
+}
+
+// newSTSServer returns an STS stand-in answering AssumeRole calls with
+// credentials named after the role, expiring in an hour.
+func newSTSServer(calls *[]assumeRoleCall) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		call := assumeRoleCall{form: map[string]string{}}
+		for k := range r.PostForm {
+			call.form[k] = r.PostForm.Get(k)
+		}
+		auth := r.Header.Get("Authorization")
+		if i := strings.Index(auth, "Credential="); i >= 0 {
+			call.accessKeyID = strings.SplitN(auth[i+len("Credential="):], "/", 2)[0]
+		}
+		*calls = append(*calls, call)
+
+		role := call.form["RoleArn"][strings.LastIndex(call.form["RoleArn"], "/")+1:]
+		fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>%sKey</AccessKeyId>
+      <SecretAccessKey>%sSecret</SecretAccessKey>
+      <SessionToken>%sToken</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata><RequestId>request-id</RequestId></ResponseMetadata>
+</AssumeRoleResponse>`, role, role, role, currentTime().Add(time.Hour).UTC().Format(time.RFC3339))
+	}))
+}
+
+func newTestProvider(t *testing.T, profile string, server *httptest.Server) (*ProfileProvider, func()) {
+	dir, err := ioutil.TempDir("", "stscreds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	configFile := filepath.Join(dir, "config")
+	if err := ioutil.WriteFile(configFile, []byte(testConfigFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	p := &ProfileProvider{
+		Profile:    profile,
+		ConfigFile: configFile,
+		Config:     &aws.Config{Endpoint: server.URL, MaxRetries: 0},
+	}
+	return p, func() { os.RemoveAll(dir) }
+}
+
+func TestProfileProviderAssumeRole(t *testing.T) {
+	calls := []assumeRoleCall{}
+	server := newSTSServer(&calls)
+	defer server.Close()
+	p, cleanup := newTestProvider(t, "dev", server)
+	defer cleanup()
+
+	creds, err := p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "devKey", creds.AccessKeyID)
+	assert.Equal(t, "devSecret", creds.SecretAccessKey)
+	assert.Equal(t, "devToken", creds.SessionToken)
+	assert.False(t, creds.Expires.IsZero())
+
+	assert.Equal(t, 1, len(calls))
+	assert.Equal(t, "defaultKey", calls[0].accessKeyID)
+	assert.Equal(t, "AssumeRole", calls[0].form["Action"])
+	assert.Equal(t, "arn:aws:iam::123456789012:role/dev", calls[0].form["RoleArn"])
+	assert.Equal(t, "ext-1234", calls[0].form["ExternalId"])
+	assert.True(t, strings.HasPrefix(calls[0].form["RoleSessionName"], "aws-sdk-go-"))
+
+	// cached until shortly before expiration
+	_, err = p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(calls))
+
+	defer func() { currentTime = time.Now }()
+	currentTime = func() time.Time { return time.Now().Add(56 * time.Minute) }
+	_, err = p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(calls))
+}
+
+func TestProfileProviderChainWithMFA(t *testing.T) {
+	calls := []assumeRoleCall{}
+	server := newSTSServer(&calls)
+	defer server.Close()
+	p, cleanup := newTestProvider(t, "admin", server)
+	defer cleanup()
+
+	_, err := p.Credentials()
+	assert.Error(t, err)
+
+	p.TokenProvider = func() (string, error) { return "123456", nil }
+	p.provider = nil
+	creds, err := p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "adminKey", creds.AccessKeyID)
+
+	// the admin role is assumed with the credentials of the dev role
+	assert.Equal(t, 2, len(calls))
+	assert.Equal(t, "devKey", calls[1].accessKeyID)
+	assert.Equal(t, "arn:aws:iam::123456789012:role/admin", calls[1].form["RoleArn"])
+	assert.Equal(t, "arn:aws:iam::123456789012:mfa/user", calls[1].form["SerialNumber"])
+	assert.Equal(t, "123456", calls[1].form["TokenCode"])
+	assert.Equal(t, "1800", calls[1].form["DurationSeconds"])
+}
+
+func TestProfileProviderSelfSource(t *testing.T) {
+	calls := []assumeRoleCall{}
+	server := newSTSServer(&calls)
+	defer server.Close()
+	p, cleanup := newTestProvider(t, "self", server)
+	defer cleanup()
+
+	creds, err := p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "selfKey", creds.AccessKeyID)
+	assert.Equal(t, "selfKey", calls[0].accessKeyID)
+}
+
+func TestProfileProviderErrors(t *testing.T) {
+	calls := []assumeRoleCall{}
+	server := newSTSServer(&calls)
+	defer server.Close()
+
+	for _, profile := range []string{"loop1", "missing"} {
+		p, cleanup := newTestProvider(t, profile, server)
+		_, err := p.Credentials()
+		assert.Error(t, err, profile)
+		cleanup()
+	}
+	assert.Empty(t, calls)
+}
+
+func TestProfileProviderStaticCredentials(t *testing.T) {
+	calls := []assumeRoleCall{}
+	server := newSTSServer(&calls)
+	defer server.Close()
+	p, cleanup := newTestProvider(t, "default", server)
+	defer cleanup()
+
+	creds, err := p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "defaultKey", creds.AccessKeyID)
+	assert.Empty(t, calls)
+}
+
+func TestNewProfileConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stscreds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	configFile := filepath.Join(dir, "config")
+	ioutil.WriteFile(configFile, []byte(testConfigFile), 0600)
+
+	os.Clearenv()
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(dir, "credentials"))
+	os.Setenv("AWS_CONFIG_FILE", configFile)
+
+	config, err := NewProfileConfig("dev")
+	assert.NoError(t, err)
+	assert.Equal(t, "eu-west-1", config.Region)
+	assert.Equal(t, "dev", config.Credentials.(*ProfileProvider).Profile)
+
+	_, err = NewProfileConfig("missing")
+	assert.Error(t, err)
+}
+
+// testPath is the PATH of the credential process, saved before tests clear
+// the environment.
+var testPath = os.Getenv("PATH")
+
+func TestProfileProviderCredentialProcessSource(t *testing.T) {
+	os.Setenv("PATH", testPath)
+
+	calls := []assumeRoleCall{}
+	server := newSTSServer(&calls)
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "stscreds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	configFile := filepath.Join(dir, "config")
+	ioutil.WriteFile(configFile, []byte(`
+[profile process]
+credential_process = /bin/echo '{"Version": 1, "AccessKeyId": "processKey", "SecretAccessKey": "processSecret"}'
+
+[profile role]
+role_arn = arn:aws:iam::123456789012:role/role
+source_profile = process
+`), 0600)
+
+	p := &ProfileProvider{
+		Profile:    "role",
+		ConfigFile: configFile,
+		Config:     &aws.Config{Endpoint: server.URL, MaxRetries: 0},
+	}
+	creds, err := p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "roleKey", creds.AccessKeyID)
+	assert.Equal(t, "processKey", calls[0].accessKeyID)
+}
+
+func TestProfileRegistered(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stscreds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	credentialsFile := filepath.Join(dir, "credentials")
+	ioutil.WriteFile(credentialsFile, []byte(`
+[loop1]
+role_arn = arn:aws:iam::123456789012:role/loop
+source_profile = loop2
+
+[loop2]
+role_arn = arn:aws:iam::123456789012:role/loop
+source_profile = loop1
+`), 0600)
+
+	// aws.ProfileCreds assumes roles with a ProfileProvider, which detects
+	// the loop without calling STS
+	prov, err := aws.ProfileCreds(credentialsFile, "loop1", 10*time.Minute)
+	assert.NoError(t, err)
+	_, err = prov.Credentials()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is its own source_profile")
+}
@@ -0,0 +1,112 @@
+package stscreds
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/service/sts"
+	"github.com/datacratic/aws-sdk-go/service/sts/stsiface"
+)
+
+func init() {
+	aws.RegisterWebIdentityProvider(func(roleARN, tokenFile, sessionName string) aws.CredentialsProvider {
+		p := WebIdentityCreds(nil, roleARN, tokenFile)
+		p.RoleSessionName = sessionName
+		return p
+	})
+}
+
+// A WebIdentityProvider provides the credentials of a role assumed with an
+// OpenID Connect web identity token, such as a Kubernetes service account
+// token. The token is read from TokenFile each time the role is assumed, so
+// it can be rotated. The credentials are cached, and the role is assumed
+// again ExpiryWindow before they expire.
+type WebIdentityProvider struct {
+	// Client is the STS client assuming the role. AssumeRoleWithWebIdentity
+	// requests are not signed, so it needs no credentials.
+	Client stsiface.STSAPI
+
+	RoleARN   string
+	TokenFile string
+
+	// RoleSessionName identifies the session of the assumed role. If empty,
+	// a name is generated.
+	RoleSessionName string
+
+	// Duration of the credentials. If zero, the STS default is used.
+	Duration time.Duration
+
+	ExpiryWindow time.Duration
+
+	creds      aws.Credentials
+	m          sync.Mutex
+	expiration time.Time
+}
+
+// WebIdentityCreds returns a provider of the credentials of roleARN,
+// assumed with the web identity token in tokenFile by an STS client with the
+// given configuration.
+func WebIdentityCreds(config *aws.Config, roleARN, tokenFile string) *WebIdentityProvider {
+	return &WebIdentityProvider{
+		Client:       sts.New(config),
+		RoleARN:      roleARN,
+		TokenFile:    tokenFile,
+		ExpiryWindow: DefaultExpiryWindow,
+	}
+}
+
+// Credentials returns the credentials of the assumed role, assuming it
+// again if they are about to expire.
+func (p *WebIdentityProvider) Credentials() (*aws.Credentials, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.expiration.Add(-p.ExpiryWindow).After(currentTime()) {
+		return &p.creds, nil
+	}
+
+	token, err := ioutil.ReadFile(p.TokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading web identity token: %s", err)
+	}
+
+	sessionName := p.RoleSessionName
+	if sessionName == "" {
+		sessionName = fmt.Sprintf("aws-sdk-go-%d", currentTime().UnixNano())
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleARN:          aws.String(p.RoleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(strings.TrimSpace(string(token))),
+	}
+	if p.Duration != 0 {
+		input.DurationSeconds = aws.Long(int64(p.Duration / time.Second))
+	}
+
+	out, err := p.Client.AssumeRoleWithWebIdentity(input)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := roleCredentials("AssumeRoleWithWebIdentity", p.RoleARN, out.Credentials)
+	if err != nil {
+		return nil, err
+	}
+	p.creds = creds
+	p.expiration = creds.Expires
+
+	return &p.creds, nil
+}
+
+// Expire expires the cached credentials, assuming the role again.
+func (p *WebIdentityProvider) Expire() {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	p.expiration = time.Time{}
+}
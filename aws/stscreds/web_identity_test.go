@@ -0,0 +1,173 @@
+package stscreds
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/service/sts"
+	"github.com/datacratic/aws-sdk-go/service/sts/stsiface"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubWebIdentitySTS struct {
+	stsiface.STSAPI
+	inputs     []*sts.AssumeRoleWithWebIdentityInput
+	expiration time.Time
+	err        error
+}
+
+func (s *stubWebIdentitySTS) AssumeRoleWithWebIdentity(input *sts.AssumeRoleWithWebIdentityInput) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+	s.inputs = append(s.inputs, input)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &sts.AssumeRoleWithWebIdentityOutput{
+		Credentials: &sts.Credentials{
+			AccessKeyID:     aws.String("accessKey"),
+			SecretAccessKey: aws.String("secret"),
+			SessionToken:    aws.String("token"),
+			Expiration:      aws.Time(s.expiration),
+		},
+	}, nil
+}
+
+func writeTokenFile(t *testing.T, token string) string {
+	dir, err := ioutil.TempDir("", "stscreds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenFile := filepath.Join(dir, "token")
+	if err := ioutil.WriteFile(tokenFile, []byte(token+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return tokenFile
+}
+
+func TestWebIdentityProvider(t *testing.T) {
+	now := time.Now()
+	currentTime = func() time.Time { return now }
+	defer func() { currentTime = time.Now }()
+
+	tokenFile := writeTokenFile(t, "token-1")
+	defer os.RemoveAll(filepath.Dir(tokenFile))
+
+	client := &stubWebIdentitySTS{expiration: now.Add(time.Hour)}
+	p := &WebIdentityProvider{
+		Client:          client,
+		RoleARN:         "arn:aws:iam::123456789012:role/web",
+		TokenFile:       tokenFile,
+		RoleSessionName: "session",
+		Duration:        time.Hour,
+		ExpiryWindow:    DefaultExpiryWindow,
+	}
+
+	creds, err := p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, &aws.Credentials{AccessKeyID: "accessKey", SecretAccessKey: "secret", SessionToken: "token", Expires: client.expiration}, creds)
+	assert.Equal(t, 1, len(client.inputs))
+	assert.Equal(t, "arn:aws:iam::123456789012:role/web", *client.inputs[0].RoleARN)
+	assert.Equal(t, "session", *client.inputs[0].RoleSessionName)
+	assert.Equal(t, "token-1", *client.inputs[0].WebIdentityToken)
+	assert.Equal(t, int64(3600), *client.inputs[0].DurationSeconds)
+
+	// the rotated token is read again on refresh
+	ioutil.WriteFile(tokenFile, []byte("token-2"), 0600)
+	_, err = p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(client.inputs))
+
+	now = now.Add(56 * time.Minute)
+	client.expiration = now.Add(time.Hour)
+	_, err = p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(client.inputs))
+	assert.Equal(t, "token-2", *client.inputs[1].WebIdentityToken)
+}
+
+func TestWebIdentityProviderErrors(t *testing.T) {
+	client := &stubWebIdentitySTS{err: errors.New("invalid identity token")}
+	p := &WebIdentityProvider{Client: client, RoleARN: "arn:aws:iam::123456789012:role/web", TokenFile: "/nonexistent/token"}
+
+	_, err := p.Credentials()
+	assert.Error(t, err)
+	assert.Empty(t, client.inputs)
+
+	p.TokenFile = writeTokenFile(t, "token")
+	defer os.RemoveAll(filepath.Dir(p.TokenFile))
+	_, err = p.Credentials()
+	assert.EqualError(t, err, "invalid identity token")
+}
+
+func TestWebIdentityProviderUnsigned(t *testing.T) {
+	var auth, action string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		auth = r.Header.Get("Authorization")
+		action = r.PostForm.Get("Action")
+		fmt.Fprintf(w, `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>webKey</AccessKeyId>
+      <SecretAccessKey>webSecret</SecretAccessKey>
+      <SessionToken>webToken</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+  </AssumeRoleWithWebIdentityResult>
+</AssumeRoleWithWebIdentityResponse>`, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	}))
+	defer server.Close()
+
+	tokenFile := writeTokenFile(t, "token")
+	defer os.RemoveAll(filepath.Dir(tokenFile))
+
+	p := WebIdentityCreds(&aws.Config{Endpoint: server.URL, Region: "us-east-1"}, "arn:aws:iam::123456789012:role/web", tokenFile)
+	creds, err := p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "webKey", creds.AccessKeyID)
+	assert.Equal(t, "AssumeRoleWithWebIdentity", action)
+	assert.Empty(t, auth)
+}
+
+func TestWebIdentityRegistered(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", "/var/run/token")
+	os.Setenv("AWS_ROLE_ARN", "arn:aws:iam::123456789012:role/web")
+	os.Setenv("AWS_ROLE_SESSION_NAME", "session")
+
+	prov, err := aws.WebIdentityCreds()
+	assert.NoError(t, err)
+	p := prov.(*WebIdentityProvider)
+	assert.Equal(t, "/var/run/token", p.TokenFile)
+	assert.Equal(t, "arn:aws:iam::123456789012:role/web", p.RoleARN)
+	assert.Equal(t, "session", p.RoleSessionName)
+}
+
+func TestProfileProviderWebIdentity(t *testing.T) {
+	tokenFile := writeTokenFile(t, "token")
+	dir := filepath.Dir(tokenFile)
+	defer os.RemoveAll(dir)
+
+	configFile := filepath.Join(dir, "config")
+	ioutil.WriteFile(configFile, []byte(`
+[profile web]
+role_arn = arn:aws:iam::123456789012:role/web
+web_identity_token_file = `+tokenFile+`
+role_session_name = session
+`), 0600)
+
+	p := &ProfileProvider{Profile: "web", ConfigFile: configFile}
+	prov, err := p.resolve("web", map[string]bool{})
+	assert.NoError(t, err)
+	web := prov.(*WebIdentityProvider)
+	assert.Equal(t, tokenFile, web.TokenFile)
+	assert.Equal(t, "session", web.RoleSessionName)
+	assert.Equal(t, "arn:aws:iam::123456789012:role/web", web.RoleARN)
+}
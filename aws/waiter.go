@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/datacratic/aws-sdk-go/aws/awsutil"
+)
+
+// Waiter acceptor states.
+const (
+	WaiterStateSuccess = "success"
+	WaiterStateFailure = "failure"
+	WaiterStateRetry   = "retry"
+)
+
+// A WaitAcceptor describes a condition on the result of a waiter's operation
+// and the state the waiter transitions to when the condition is met.
+//
+// Matcher is one of "path", "pathAll", "pathAny", "status" or "error". Path
+// matchers evaluate Argument against the response data, "status" compares
+// the HTTP status code and "error" compares the API error code with Expected.
+type WaitAcceptor struct {
+	State    string
+	Matcher  string
+	Argument string
+	Expected interface{}
+}
+
+// A Waiter polls an operation until one of its acceptors transitions to a
+// success or failure state, or MaxAttempts requests have been made.
+type Waiter struct {
+	Name        string
+	Delay       time.Duration
+	MaxAttempts int
+	Acceptors   []WaitAcceptor
+
+	// NewRequest returns a new request for the waiter's operation. It is
+	// called once per attempt.
+	NewRequest func() *Request
+
+	// Context, if set, is attached to each request made by the waiter.
+	// Canceling it aborts the wait, including the delay between attempts.
+	Context context.Context
+}
+
+// Wait polls the waiter's operation until the condition is met. An error is
+// returned if a failure state is reached, if the operation fails with an
+// error no acceptor matches, or if the maximum number of attempts is
+// exceeded.
+func (w *Waiter) Wait() error {
+	for attempt := 1; ; attempt++ {
+		req := w.NewRequest()
+		if w.Context != nil {
+			req.SetContext(w.Context)
+		}
+		err := req.Send()
+		if aerr := Error(err); aerr != nil && aerr.Code == ErrCodeRequestCanceled {
+			return err
+		}
+
+		state := ""
+		for _, a := range w.Acceptors {
+			if a.match(req, err) {
+				state = a.State
+				break
+			}
+		}
+
+		switch state {
+		case WaiterStateSuccess:
+			return nil
+		case WaiterStateFailure:
+			return APIError{
+				Code:    "ResourceNotReady",
+				Message: fmt.Sprintf("failed waiting for %s", w.Name),
+			}
+		case "":
+			if err != nil {
+				return err
+			}
+		}
+
+		if attempt >= w.MaxAttempts {
+			return APIError{
+				Code:    "ResourceNotReady",
+				Message: fmt.Sprintf("exceeded %d wait attempts for %s", w.MaxAttempts, w.Name),
+			}
+		}
+
+		ctx := w.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		if err := sleepWithContext(ctx, w.Delay); err != nil {
+			return newRequestCanceledError(err)
+		}
+	}
+}
+
+func (a *WaitAcceptor) match(r *Request, err error) bool {
+	switch a.Matcher {
+	case "status":
+		return r.HTTPResponse != nil &&
+			waiterValueEqual(r.HTTPResponse.StatusCode, a.Expected)
+	case "error":
+		if aerr := Error(err); aerr != nil {
+			return waiterValueEqual(aerr.Code, a.Expected)
+		}
+		return false
+	}
+
+	if err != nil || !r.DataFilled() {
+		return false
+	}
+
+	vals := waiterValuesAtPath(r.Data, a.Argument)
+	switch a.Matcher {
+	case "path":
+		return len(vals) > 0 && waiterValueEqual(vals[0], a.Expected)
+	case "pathAll":
+		for _, v := range vals {
+			if !waiterValueEqual(v, a.Expected) {
+				return false
+			}
+		}
+		return len(vals) > 0
+	case "pathAny":
+		for _, v := range vals {
+			if waiterValueEqual(v, a.Expected) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var reWaiterLength = regexp.MustCompile("^length\\((.+)\\)\\s*(==|!=|>=|<=|>|<)\\s*`(\\d+)`$")
+
+// waiterValuesAtPath evaluates a waiter argument against data. Arguments are
+// paths understood by awsutil.ValuesAtPath, optionally wrapped in a length
+// comparison such as "length(Path) > `0`".
+func waiterValuesAtPath(data interface{}, path string) []interface{} {
+	m := reWaiterLength.FindStringSubmatch(path)
+	if m == nil {
+		return awsutil.ValuesAtPath(data, path)
+	}
+
+	length := 0
+	for _, v := range awsutil.ValuesAtPath(data, m[1]) {
+		switch rv := reflect.ValueOf(v); rv.Kind() {
+		case reflect.String, reflect.Slice, reflect.Map:
+			length += rv.Len()
+		}
+	}
+
+	n, _ := strconv.Atoi(m[3])
+	var ok bool
+	switch m[2] {
+	case "==":
+		ok = length == n
+	case "!=":
+		ok = length != n
+	case ">=":
+		ok = length >= n
+	case "<=":
+		ok = length <= n
+	case ">":
+		ok = length > n
+	case "<":
+		ok = length < n
+	}
+	return []interface{}{ok}
+}
+
+// waiterValueEqual compares a response value with an expected value, treating
+// all numeric types as equal if they hold the same number.
+func waiterValueEqual(v, expected interface{}) bool {
+	if a, ok := waiterNumber(v); ok {
+		b, ok := waiterNumber(expected)
+		return ok && a == b
+	}
+	return reflect.DeepEqual(v, expected)
+}
+
+func waiterNumber(v interface{}) (float64, bool) {
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	return 0, false
+}
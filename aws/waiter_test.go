@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockWaiterOutput struct {
+	States   []*string
+	Password *string
+}
+
+// mockWaiter returns a waiter whose attempts receive the given responses in
+// order, and a pointer to the number of attempts made.
+func mockWaiter(resps []http.Response, data []mockWaiterOutput, acceptors []WaitAcceptor) (*Waiter, *int) {
+	attempts := 0
+	s := NewService(&Config{MaxRetries: 0})
+	s.Handlers.Validate.Clear()
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &resps[attempts]
+	})
+	s.Handlers.Unmarshal.PushBack(func(r *Request) {
+		*(r.Data.(*mockWaiterOutput)) = data[attempts-1]
+	})
+
+	w := &Waiter{
+		Name:        "Mock",
+		Delay:       time.Second,
+		MaxAttempts: len(resps),
+		Acceptors:   acceptors,
+		NewRequest: func() *Request {
+			r := NewRequest(s, &Operation{Name: "Operation"}, nil, &mockWaiterOutput{})
+			r.Handlers.ValidateResponse.PushBack(func(r *Request) { attempts++ })
+			return r
+		},
+	}
+	return w, &attempts
+}
+
+func ok() http.Response {
+	return http.Response{StatusCode: 200, Body: body(`{}`)}
+}
+
+func TestWaiterPathAll(t *testing.T) {
+	delays := []time.Duration{}
+	sleepDelay = func(delay time.Duration) {
+		delays = append(delays, delay)
+	}
+
+	w, attempts := mockWaiter(
+		[]http.Response{ok(), ok(), ok()},
+		[]mockWaiterOutput{
+			{States: []*string{String("pending"), String("running")}},
+			{States: []*string{String("running"), String("running")}},
+		},
+		[]WaitAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "States[]", Expected: "running"},
+			{State: "failure", Matcher: "pathAny", Argument: "States[]", Expected: "terminated"},
+		},
+	)
+
+	err := w.Wait()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *attempts)
+	assert.Equal(t, []time.Duration{time.Second}, delays)
+}
+
+func TestWaiterPathAnyFailure(t *testing.T) {
+	sleepDelay = func(delay time.Duration) {}
+
+	w, attempts := mockWaiter(
+		[]http.Response{ok(), ok(), ok()},
+		[]mockWaiterOutput{
+			{States: []*string{String("pending"), String("terminated")}},
+		},
+		[]WaitAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "States[]", Expected: "running"},
+			{State: "failure", Matcher: "pathAny", Argument: "States[]", Expected: "terminated"},
+		},
+	)
+
+	err := w.Wait()
+	assert.Error(t, err)
+	assert.Equal(t, "ResourceNotReady", Error(err).Code)
+	assert.Equal(t, 1, *attempts)
+}
+
+func TestWaiterMaxAttempts(t *testing.T) {
+	sleepDelay = func(delay time.Duration) {}
+
+	w, attempts := mockWaiter(
+		[]http.Response{ok(), ok(), ok()},
+		[]mockWaiterOutput{{}, {}, {}},
+		[]WaitAcceptor{
+			{State: "success", Matcher: "path", Argument: "length(Password) > `0`", Expected: true},
+		},
+	)
+
+	err := w.Wait()
+	assert.Error(t, err)
+	assert.Equal(t, "ResourceNotReady", Error(err).Code)
+	assert.Contains(t, Error(err).Message, "exceeded 3 wait attempts")
+	assert.Equal(t, 3, *attempts)
+}
+
+func TestWaiterStatusAndError(t *testing.T) {
+	sleepDelay = func(delay time.Duration) {}
+
+	w, attempts := mockWaiter(
+		[]http.Response{
+			http.Response{StatusCode: 404, Body: body(`{"__type":"NotFound","message":"not found"}`)},
+			http.Response{StatusCode: 400, Body: body(`{"__type":"Pending","message":"pending"}`)},
+			ok(),
+		},
+		[]mockWaiterOutput{{}, {}, {Password: String("secret")}},
+		[]WaitAcceptor{
+			{State: "retry", Matcher: "status", Expected: 404},
+			{State: "retry", Matcher: "error", Expected: "Pending"},
+			{State: "success", Matcher: "path", Argument: "length(Password) > `0`", Expected: true},
+		},
+	)
+
+	err := w.Wait()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *attempts)
+}
+
+func TestWaiterUnmatchedError(t *testing.T) {
+	sleepDelay = func(delay time.Duration) {}
+
+	w, attempts := mockWaiter(
+		[]http.Response{
+			http.Response{StatusCode: 400, Body: body(`{"__type":"ValidationError","message":"invalid"}`)},
+			ok(),
+		},
+		[]mockWaiterOutput{{}, {}},
+		[]WaitAcceptor{
+			{State: "success", Matcher: "status", Expected: 200},
+		},
+	)
+
+	err := w.Wait()
+	assert.Error(t, err)
+	assert.Equal(t, "ValidationError", Error(err).Code)
+	assert.Equal(t, 1, *attempts)
+}
+
+func TestWaiterContextCanceled(t *testing.T) {
+	w, attempts := mockWaiter(
+		[]http.Response{ok(), ok(), ok()},
+		[]mockWaiterOutput{{}, {}, {}},
+		[]WaitAcceptor{
+			{State: "success", Matcher: "path", Argument: "length(Password) > `0`", Expected: true},
+		},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w.Delay = time.Hour
+	newRequest := w.NewRequest
+	w.NewRequest = func() *Request {
+		r := newRequest()
+		r.Handlers.Unmarshal.PushBack(func(r *Request) { cancel() })
+		return r
+	}
+	w.Context = ctx
+
+	err := w.Wait()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRequestCanceled, Error(err).Code)
+	assert.Equal(t, 1, *attempts)
+}
@@ -2,12 +2,96 @@ package endpoints
 
 //go:generate go run ../model/cli/gen-endpoints/main.go endpoints.json endpoints_map.go
 
-import "strings"
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
 
+// A Partition is a group of regions sharing a DNS suffix, such as the AWS
+// China regions. Endpoints may be defined for all the regions of a
+// partition, with keys prefixed by its ID.
+type Partition struct {
+	ID        string
+	DNSSuffix string
+
+	regionRE *regexp.Regexp
+}
+
+// partitions are the partitions other than the standard AWS partition, in
+// which regions not matching any of them are.
+var partitions = []Partition{
+	{
+		ID:        "aws-cn",
+		DNSSuffix: "amazonaws.com.cn",
+		regionRE:  regexp.MustCompile(`^cn\-\w+\-\d+$`),
+	},
+	{
+		ID:        "aws-us-gov",
+		DNSSuffix: "amazonaws.com",
+		regionRE:  regexp.MustCompile(`^us\-gov\-\w+\-\d+$`),
+	},
+}
+
+var awsPartition = Partition{ID: "aws", DNSSuffix: "amazonaws.com"}
+
+// PartitionForRegion returns the partition of region.
+func PartitionForRegion(region string) Partition {
+	for _, p := range partitions {
+		if p.regionRE.MatchString(region) {
+			return p
+		}
+	}
+	return awsPartition
+}
+
+// A Variant selects variants of the standard endpoints. Variants may be
+// combined.
+type Variant int
+
+const (
+	// FIPSVariant selects endpoints using FIPS 140-2 validated
+	// cryptographic modules.
+	FIPSVariant Variant = 1 << iota
+
+	// DualStackVariant selects endpoints reachable over both IPv4 and
+	// IPv6.
+	DualStackVariant
+)
+
+func (v Variant) String() string {
+	switch v {
+	case 0:
+		return "standard"
+	case FIPSVariant:
+		return "FIPS"
+	case DualStackVariant:
+		return "dual-stack"
+	case FIPSVariant | DualStackVariant:
+		return "FIPS dual-stack"
+	}
+	return fmt.Sprintf("Variant(%d)", int(v))
+}
+
+// EndpointForRegion returns the standard endpoint of a service in a region,
+// and the region to sign its requests for if it isn't the region itself,
+// such as for global services.
 func EndpointForRegion(svcName, region string) (endpoint, signingRegion string) {
+	endpoint, signingRegion, _ = EndpointVariantForRegion(svcName, region, 0)
+	return
+}
+
+// EndpointVariantForRegion returns the endpoint of a service in a region,
+// in the given variant. The variant is taken from the most specific
+// endpoint of the service in the region, and an error is returned if that
+// endpoint has no such variant.
+func EndpointVariantForRegion(svcName, region string, variant Variant) (endpoint, signingRegion string, err error) {
+	partition := PartitionForRegion(region)
 	derivedKeys := []string{
 		region + "/" + svcName,
 		region + "/*",
+		partition.ID + "/" + svcName,
+		partition.ID + "/*",
 		"*/" + svcName,
 		"*/*",
 	}
@@ -15,8 +99,21 @@ func EndpointForRegion(svcName, region string) (endpoint, signingRegion string)
 	for _, key := range derivedKeys {
 		if val, ok := endpointsMap.Endpoints[key]; ok {
 			ep := val.Endpoint
+			switch variant {
+			case FIPSVariant:
+				ep = val.FIPSEndpoint
+			case DualStackVariant:
+				ep = val.DualStackEndpoint
+			case FIPSVariant | DualStackVariant:
+				ep = val.FIPSDualStackEndpoint
+			}
+			if ep == "" && variant != 0 {
+				return "", "", fmt.Errorf("no %s endpoint for %s in %s", variant, svcName, region)
+			}
+
 			ep = strings.Replace(ep, "{region}", region, -1)
 			ep = strings.Replace(ep, "{service}", svcName, -1)
+			ep = strings.Replace(ep, "{dnsSuffix}", partition.DNSSuffix, -1)
 
 			endpoint = ep
 			signingRegion = val.SigningRegion
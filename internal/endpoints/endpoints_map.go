@@ -8,82 +8,209 @@ type endpointStruct struct {
 }
 
 type endpointEntry struct {
-	Endpoint      string
-	SigningRegion string
+	Endpoint              string
+	FIPSEndpoint          string
+	DualStackEndpoint     string
+	FIPSDualStackEndpoint string
+	SigningRegion         string
 }
 
 var endpointsMap = endpointStruct{
 	Version: 2,
 	Endpoints: map[string]endpointEntry{
 		"*/*": endpointEntry{
-			Endpoint: "{service}.{region}.amazonaws.com",
+			Endpoint: "{service}.{region}.{dnsSuffix}",
 		},
-		"*/cloudfront": endpointEntry{
-			Endpoint:      "cloudfront.amazonaws.com",
-			SigningRegion: "us-east-1",
+		"*/autoscaling": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/cloudformation": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
 		},
 		"*/cloudsearchdomain": endpointEntry{
 			Endpoint:      "",
 			SigningRegion: "us-east-1",
 		},
-		"*/iam": endpointEntry{
+		"*/cloudtrail": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/codedeploy": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/config": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/directconnect": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/dynamodb": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/ec2": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/ecs": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/elasticloadbalancing": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/kinesis": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/kms": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/lambda": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/logs": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/monitoring": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/rds": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/redshift": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/s3": endpointEntry{
+			Endpoint:              "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint:          "s3-fips.{region}.{dnsSuffix}",
+			DualStackEndpoint:     "s3.dualstack.{region}.{dnsSuffix}",
+			FIPSDualStackEndpoint: "s3-fips.dualstack.{region}.{dnsSuffix}",
+		},
+		"*/sns": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/sqs": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/ssm": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"*/swf": endpointEntry{
+			Endpoint:     "{service}.{region}.{dnsSuffix}",
+			FIPSEndpoint: "{service}-fips.{region}.{dnsSuffix}",
+		},
+		"ap-northeast-1/s3": endpointEntry{
+			Endpoint:              "s3-{region}.amazonaws.com",
+			FIPSEndpoint:          "s3-fips.{region}.{dnsSuffix}",
+			DualStackEndpoint:     "s3.dualstack.{region}.{dnsSuffix}",
+			FIPSDualStackEndpoint: "s3-fips.dualstack.{region}.{dnsSuffix}",
+		},
+		"ap-southeast-1/s3": endpointEntry{
+			Endpoint:              "s3-{region}.amazonaws.com",
+			FIPSEndpoint:          "s3-fips.{region}.{dnsSuffix}",
+			DualStackEndpoint:     "s3.dualstack.{region}.{dnsSuffix}",
+			FIPSDualStackEndpoint: "s3-fips.dualstack.{region}.{dnsSuffix}",
+		},
+		"ap-southeast-2/s3": endpointEntry{
+			Endpoint:              "s3-{region}.amazonaws.com",
+			FIPSEndpoint:          "s3-fips.{region}.{dnsSuffix}",
+			DualStackEndpoint:     "s3.dualstack.{region}.{dnsSuffix}",
+			FIPSDualStackEndpoint: "s3-fips.dualstack.{region}.{dnsSuffix}",
+		},
+		"aws-cn/*": endpointEntry{
+			Endpoint: "{service}.{region}.{dnsSuffix}",
+		},
+		"aws-cn/s3": endpointEntry{
+			Endpoint:          "{service}.{region}.{dnsSuffix}",
+			DualStackEndpoint: "s3.dualstack.{region}.{dnsSuffix}",
+		},
+		"aws-us-gov/iam": endpointEntry{
+			Endpoint:      "iam.us-gov.amazonaws.com",
+			FIPSEndpoint:  "iam.us-gov.amazonaws.com",
+			SigningRegion: "us-gov-west-1",
+		},
+		"aws/cloudfront": endpointEntry{
+			Endpoint:      "cloudfront.amazonaws.com",
+			SigningRegion: "us-east-1",
+		},
+		"aws/iam": endpointEntry{
 			Endpoint:      "iam.amazonaws.com",
+			FIPSEndpoint:  "iam-fips.amazonaws.com",
 			SigningRegion: "us-east-1",
 		},
-		"*/importexport": endpointEntry{
+		"aws/importexport": endpointEntry{
 			Endpoint:      "importexport.amazonaws.com",
 			SigningRegion: "us-east-1",
 		},
-		"*/route53": endpointEntry{
+		"aws/route53": endpointEntry{
 			Endpoint:      "route53.amazonaws.com",
 			SigningRegion: "us-east-1",
 		},
-		"*/sts": endpointEntry{
+		"aws/sts": endpointEntry{
 			Endpoint:      "sts.amazonaws.com",
 			SigningRegion: "us-east-1",
 		},
-		"ap-northeast-1/s3": endpointEntry{
-			Endpoint: "s3-{region}.amazonaws.com",
-		},
-		"ap-southeast-1/s3": endpointEntry{
-			Endpoint: "s3-{region}.amazonaws.com",
-		},
-		"ap-southeast-2/s3": endpointEntry{
-			Endpoint: "s3-{region}.amazonaws.com",
-		},
-		"cn-north-1/*": endpointEntry{
-			Endpoint: "{service}.{region}.amazonaws.com.cn",
-		},
 		"eu-central-1/s3": endpointEntry{
-			Endpoint: "{service}.{region}.amazonaws.com",
+			Endpoint:              "{service}.{region}.amazonaws.com",
+			FIPSEndpoint:          "s3-fips.{region}.{dnsSuffix}",
+			DualStackEndpoint:     "s3.dualstack.{region}.{dnsSuffix}",
+			FIPSDualStackEndpoint: "s3-fips.dualstack.{region}.{dnsSuffix}",
 		},
 		"eu-west-1/s3": endpointEntry{
-			Endpoint: "s3-{region}.amazonaws.com",
+			Endpoint:              "s3-{region}.amazonaws.com",
+			FIPSEndpoint:          "s3-fips.{region}.{dnsSuffix}",
+			DualStackEndpoint:     "s3.dualstack.{region}.{dnsSuffix}",
+			FIPSDualStackEndpoint: "s3-fips.dualstack.{region}.{dnsSuffix}",
 		},
 		"sa-east-1/s3": endpointEntry{
-			Endpoint: "s3-{region}.amazonaws.com",
+			Endpoint:              "s3-{region}.amazonaws.com",
+			FIPSEndpoint:          "s3-fips.{region}.{dnsSuffix}",
+			DualStackEndpoint:     "s3.dualstack.{region}.{dnsSuffix}",
+			FIPSDualStackEndpoint: "s3-fips.dualstack.{region}.{dnsSuffix}",
 		},
 		"us-east-1/s3": endpointEntry{
-			Endpoint: "s3.amazonaws.com",
+			Endpoint:              "s3.amazonaws.com",
+			FIPSEndpoint:          "s3-fips.{region}.{dnsSuffix}",
+			DualStackEndpoint:     "s3.dualstack.{region}.{dnsSuffix}",
+			FIPSDualStackEndpoint: "s3-fips.dualstack.{region}.{dnsSuffix}",
 		},
 		"us-east-1/sdb": endpointEntry{
 			Endpoint:      "sdb.amazonaws.com",
 			SigningRegion: "us-east-1",
 		},
-		"us-gov-west-1/iam": endpointEntry{
-			Endpoint: "iam.us-gov.amazonaws.com",
-		},
 		"us-gov-west-1/s3": endpointEntry{
-			Endpoint: "s3-{region}.amazonaws.com",
-		},
-		"us-gov-west-1/sts": endpointEntry{
-			Endpoint: "sts.us-gov-west-1.amazonaws.com",
+			Endpoint:              "s3-{region}.amazonaws.com",
+			FIPSEndpoint:          "s3-fips.{region}.{dnsSuffix}",
+			DualStackEndpoint:     "s3.dualstack.{region}.{dnsSuffix}",
+			FIPSDualStackEndpoint: "s3-fips.dualstack.{region}.{dnsSuffix}",
 		},
 		"us-west-1/s3": endpointEntry{
-			Endpoint: "s3-{region}.amazonaws.com",
+			Endpoint:              "s3-{region}.amazonaws.com",
+			FIPSEndpoint:          "s3-fips.{region}.{dnsSuffix}",
+			DualStackEndpoint:     "s3.dualstack.{region}.{dnsSuffix}",
+			FIPSDualStackEndpoint: "s3-fips.dualstack.{region}.{dnsSuffix}",
 		},
 		"us-west-2/s3": endpointEntry{
-			Endpoint: "s3-{region}.amazonaws.com",
+			Endpoint:              "s3-{region}.amazonaws.com",
+			FIPSEndpoint:          "s3-fips.{region}.{dnsSuffix}",
+			DualStackEndpoint:     "s3.dualstack.{region}.{dnsSuffix}",
+			FIPSDualStackEndpoint: "s3-fips.dualstack.{region}.{dnsSuffix}",
 		},
 	},
 }
@@ -26,3 +26,64 @@ func TestServicesInCN(t *testing.T) {
 		assert.Equal(t, name+"."+region+".amazonaws.com.cn", ep)
 	}
 }
+
+func TestPartitions(t *testing.T) {
+	ep, sr := EndpointForRegion("sqs", "cn-northwest-1")
+	assert.Equal(t, "sqs.cn-northwest-1.amazonaws.com.cn", ep)
+	assert.Equal(t, "", sr)
+
+	ep, sr = EndpointForRegion("sts", "cn-north-1")
+	assert.Equal(t, "sts.cn-north-1.amazonaws.com.cn", ep)
+	assert.Equal(t, "", sr)
+
+	ep, sr = EndpointForRegion("iam", "us-gov-west-1")
+	assert.Equal(t, "iam.us-gov.amazonaws.com", ep)
+	assert.Equal(t, "us-gov-west-1", sr)
+
+	ep, sr = EndpointForRegion("sts", "us-gov-east-1")
+	assert.Equal(t, "sts.us-gov-east-1.amazonaws.com", ep)
+	assert.Equal(t, "", sr)
+
+	ep, _ = EndpointForRegion("s3", "us-gov-west-1")
+	assert.Equal(t, "s3-us-gov-west-1.amazonaws.com", ep)
+
+	assert.Equal(t, "aws-cn", PartitionForRegion("cn-north-1").ID)
+	assert.Equal(t, "aws-us-gov", PartitionForRegion("us-gov-west-1").ID)
+	assert.Equal(t, "aws", PartitionForRegion("us-east-1").ID)
+}
+
+func TestVariants(t *testing.T) {
+	ep, _, err := EndpointVariantForRegion("s3", "us-west-2", DualStackVariant)
+	assert.NoError(t, err)
+	assert.Equal(t, "s3.dualstack.us-west-2.amazonaws.com", ep)
+
+	ep, _, err = EndpointVariantForRegion("s3", "eu-west-3", FIPSVariant|DualStackVariant)
+	assert.NoError(t, err)
+	assert.Equal(t, "s3-fips.dualstack.eu-west-3.amazonaws.com", ep)
+
+	ep, _, err = EndpointVariantForRegion("s3", "cn-north-1", DualStackVariant)
+	assert.NoError(t, err)
+	assert.Equal(t, "s3.dualstack.cn-north-1.amazonaws.com.cn", ep)
+
+	ep, _, err = EndpointVariantForRegion("dynamodb", "us-gov-west-1", FIPSVariant)
+	assert.NoError(t, err)
+	assert.Equal(t, "dynamodb-fips.us-gov-west-1.amazonaws.com", ep)
+
+	ep, sr, err := EndpointVariantForRegion("iam", "us-east-1", FIPSVariant)
+	assert.NoError(t, err)
+	assert.Equal(t, "iam-fips.amazonaws.com", ep)
+	assert.Equal(t, "us-east-1", sr)
+
+	_, _, err = EndpointVariantForRegion("sqs", "us-east-1", DualStackVariant)
+	assert.EqualError(t, err, "no dual-stack endpoint for sqs in us-east-1")
+
+	_, _, err = EndpointVariantForRegion("sqs", "cn-north-1", FIPSVariant)
+	assert.EqualError(t, err, "no FIPS endpoint for sqs in cn-north-1")
+
+	_, _, err = EndpointVariantForRegion("route53", "us-east-1", FIPSVariant)
+	assert.Error(t, err)
+
+	// FIPS endpoints are only derived for the services declaring them
+	_, _, err = EndpointVariantForRegion("opsworks", "us-east-1", FIPSVariant)
+	assert.EqualError(t, err, "no FIPS endpoint for opsworks in us-east-1")
+}
@@ -15,6 +15,7 @@ type API struct {
 	Metadata   Metadata
 	Operations map[string]*Operation
 	Shapes     map[string]*Shape
+	Waiters    []Waiter `json:"-"`
 
 	// Disables inflection checks. Only use this when generating tests
 	NoInflections bool
@@ -175,13 +176,19 @@ var oprw sync.Mutex
 {{ end }}
 
 {{ range $_, $s := .ShapeList }}
-{{ if eq $s.Type "structure" }}{{ $s.GoCode }}{{ end }}
+{{ if and (eq $s.Type "structure") (not $s.Exception) }}{{ $s.GoCode }}{{ end }}
+
+{{ end }}
+
+{{ range $_, $s := .ShapeList }}
+{{ if $s.IsEnum }}{{ $s.EnumGoCode }}{{ end }}
 
 {{ end }}
 `))
 
 func (a *API) APIGoCode() string {
 	a.resetImports()
+	a.imports["context"] = true
 	a.imports["sync"] = true
 	var buf bytes.Buffer
 	err := tplAPI.Execute(&buf, a)
@@ -218,6 +225,7 @@ func New(config *aws.Config) *{{ .StructName }} {
 		APIVersion:   "{{ .Metadata.APIVersion }}",
 {{ if eq .Metadata.Protocol "json" }}JSONVersion:  "{{ .Metadata.JSONVersion }}",
 		TargetPrefix: "{{ .Metadata.TargetPrefix }}",
+{{ end }}{{ if .ExceptionShapes }}ErrorTypes:   errorTypes,
 {{ end }}
   }
 	service.Initialize()
@@ -291,6 +299,11 @@ var tplInterface = template.Must(template.New("interface").Parse(`
 type {{ .StructName }} interface {
     {{ range $_, $o := .OperationList }}
         {{ $o.InterfaceSignature }}
+        {{ $o.PagesInterfaceSignature }}
+    {{ end }}
+
+    {{ range $_, $w := .Waiters }}
+        {{ $w.InterfaceSignature }}
     {{ end }}
 }
 `))
@@ -301,6 +314,7 @@ type {{ .StructName }} interface {
 func (a *API) InterfaceGoCode() string {
 	a.resetImports()
 	a.imports = map[string]bool{
+		"context": true,
 		"github.com/datacratic/aws-sdk-go/service/" + a.PackageName(): true,
 	}
 
@@ -308,9 +322,11 @@ func (a *API) InterfaceGoCode() string {
 	err := tplInterface.Execute(&buf, &struct {
 		StructName    string
 		OperationList []*Operation
+		Waiters       []Waiter
 	}{
 		StructName:    a.StructName() + "API",
 		OperationList: a.OperationList(),
+		Waiters:       a.Waiters,
 	})
 
 	if err != nil {
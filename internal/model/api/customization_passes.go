@@ -18,6 +18,18 @@ func s3Customizations(a *API) {
 		}
 	}
 
+	// The location constraint is populated from the configured region, which
+	// may be newer than the regions listed in the model.
+	if s, ok := a.Shapes["BucketLocationConstraint"]; ok {
+		s.openEnum = true
+	}
+
+	// CopySource is documented as "bucket/key", which the model pattern
+	// does not accept.
+	if s, ok := a.Shapes["CopySource"]; ok {
+		s.Pattern = ""
+	}
+
 	// Rename "Rule" to "LifecycleRule"
 	if s, ok := a.Shapes["Rule"]; ok {
 		s.Rename("LifecycleRule")
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"github.com/datacratic/aws-sdk-go/internal/util"
+)
+
+// ErrorInfo is the error code and HTTP status code of an exception shape.
+type ErrorInfo struct {
+	Code           string
+	HTTPStatusCode int
+}
+
+// ExceptionShapes returns the API's exception shapes sorted by name.
+func (a *API) ExceptionShapes() []*Shape {
+	list := []*Shape{}
+	for _, s := range a.ShapeList() {
+		if s.Exception {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+// ErrorCode returns the error code of an exception shape, which defaults to
+// the shape's name.
+func (s *Shape) ErrorCode() string {
+	if s.ErrorInfo.Code != "" {
+		return s.ErrorInfo.Code
+	}
+	return s.ShapeName
+}
+
+// ErrorCodeName returns the name of the exception shape's error code
+// constant.
+func (s *Shape) ErrorCodeName() string {
+	return "ErrCode" + s.ShapeName
+}
+
+// apiErrorFields are the fields of aws.APIError. Members with these names
+// are carried by the embedded APIError instead.
+var apiErrorFields = map[string]bool{
+	"StatusCode": true,
+	"Code":       true,
+	"Message":    true,
+	"RequestID":  true,
+}
+
+// errorMethods are the methods of typed errors. Members with these names are
+// named after their shape instead; their locationName keeps the wire name.
+var errorMethods = map[string]bool{
+	"Error":     true,
+	"BaseError": true,
+}
+
+// ErrorGoCode returns the Go code for the typed error of an exception shape.
+func (s *Shape) ErrorGoCode() string {
+	code := s.Docstring()
+	if strings.TrimSpace(code) == "" {
+		code = "// " + s.ShapeName + " is returned with the " + s.ErrorCodeName() + " error code.\n"
+	}
+
+	code += "type " + s.ShapeName + " struct {\n"
+	code += "aws.APIError\n\n"
+	for _, n := range s.MemberNames() {
+		if apiErrorFields[n] {
+			continue
+		}
+		m := s.MemberRefs[n]
+		if errorMethods[n] {
+			n = m.Shape.ShapeName
+		}
+		code += m.Docstring()
+		code += n + " " + m.GoType() + " " + m.GoTags(false, false) + "\n\n"
+	}
+	code += "}"
+
+	return util.GoFmt(code)
+}
+
+var tplErrors = template.Must(template.New("errors").Parse(`
+// Error codes of the {{ .StructName }} errors. Errors with these codes are
+// returned as the typed error of the same name, e.g. an error with the code
+// ErrCodeFoo is a *Foo.
+const (
+	{{ range $_, $s := .ExceptionShapes }}{{ $s.ErrorCodeName }} = "{{ $s.ErrorCode }}"
+	{{ end }}
+)
+
+{{ range $_, $s := .ExceptionShapes }}
+{{ $s.ErrorGoCode }}
+
+{{ end }}
+
+var errorTypes = map[string]func() aws.ServiceError{
+	{{ range $_, $s := .ExceptionShapes }}{{ $s.ErrorCodeName }}: func() aws.ServiceError { return &{{ $s.ShapeName }}{} },
+	{{ end }}
+}
+`))
+
+// ErrorsGoCode returns the generated Go code for the API's error codes and
+// typed errors.
+func (a *API) ErrorsGoCode() string {
+	a.resetImports()
+
+	var buf bytes.Buffer
+	if err := tplErrors.Execute(&buf, a); err != nil {
+		panic(err)
+	}
+
+	code := a.importsGoCode() + strings.TrimSpace(buf.String())
+	return util.GoFmt(code)
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorGoCode(t *testing.T) {
+	a := &API{Shapes: map[string]*Shape{}}
+	str := &Shape{API: a, ShapeName: "String", Type: "string"}
+	detail := &Shape{API: a, ShapeName: "ErrorDetail", Type: "structure"}
+	s := &Shape{
+		API:       a,
+		ShapeName: "QueueDoesNotExist",
+		Type:      "structure",
+		Exception: true,
+		ErrorInfo: ErrorInfo{Code: "AWS.SimpleQueueService.NonExistentQueue"},
+		MemberRefs: map[string]*ShapeRef{
+			"Message": &ShapeRef{API: a, Shape: str},
+			"Error":   &ShapeRef{API: a, Shape: detail, LocationName: "error"},
+		},
+	}
+
+	assert.Equal(t, "AWS.SimpleQueueService.NonExistentQueue", s.ErrorCode())
+	assert.Equal(t, "ErrCodeQueueDoesNotExist", s.ErrorCodeName())
+	assert.Equal(t, `// QueueDoesNotExist is returned with the ErrCodeQueueDoesNotExist error code.
+type QueueDoesNotExist struct {
+	aws.APIError
+
+	ErrorDetail *ErrorDetail `+"`"+`locationName:"error" type:"structure"`+"`"+`
+}`, s.ErrorGoCode())
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 )
 
 func Load(api, docs, paginators, waiters string) *API {
@@ -15,7 +16,19 @@ func Load(api, docs, paginators, waiters string) *API {
 	return &a
 }
 
+// Attach decodes the model file filename into the API. Paginator
+// definitions (*.paginators.json) are attached to the API's operations and
+// waiter definitions (*.waiters.json) to the API's waiters.
 func (a *API) Attach(filename string) {
+	switch {
+	case strings.HasSuffix(filename, ".paginators.json"):
+		a.attachPaginators(filename)
+		return
+	case strings.HasSuffix(filename, ".waiters.json"):
+		a.attachWaiters(filename)
+		return
+	}
+
 	f, err := os.Open(filename)
 	if err != nil {
 		panic(err)
@@ -69,3 +69,111 @@ func TestRenamedShapes(t *testing.T) {
 	assert.Nil(t, a.Shapes["TestVpnIcmp"])
 	assert.NotNil(t, a.Shapes["TestVPNICMP"])
 }
+
+func TestAttachPaginators(t *testing.T) {
+	json := `{
+		"operations": {
+			"ListObjects": {
+				"input": { "shape": "ListObjectsRequest" },
+				"output": { "shape": "ListObjectsResult" }
+			}
+		},
+		"shapes": {
+			"ListObjectsRequest": {
+				"type": "structure",
+				"members": {
+					"Marker": { "shape": "String" },
+					"MaxKeys": { "shape": "Integer" }
+				}
+			},
+			"ListObjectsResult": {
+				"type": "structure",
+				"members": {
+					"IsTruncated": { "shape": "Boolean" },
+					"NextMarker": { "shape": "String" },
+					"Contents": { "shape": "ObjectList" }
+				}
+			},
+			"ObjectList": { "type": "list", "member": { "shape": "Object" } },
+			"Object": {
+				"type": "structure",
+				"members": {
+					"Key": { "shape": "String" }
+				}
+			},
+			"Boolean": { "type": "boolean" },
+			"Integer": { "type": "integer" },
+			"String": { "type": "string" }
+		}
+	}`
+	a := API{}
+	a.AttachString(json)
+
+	p := paginationDefinitions{API: &a}
+	p.Pagination = map[string]Paginator{
+		"ListObjects": Paginator{
+			InputTokens:  "Marker",
+			OutputTokens: "NextMarker || Contents[-1].Key",
+			LimitKey:     "MaxKeys",
+			MoreResults:  "IsTruncated",
+		},
+		"UnknownOperation": Paginator{InputTokens: "Marker", OutputTokens: "Marker"},
+	}
+	p.setup()
+
+	pg := a.Operations["ListObjects"].Paginator
+	assert.NotNil(t, pg)
+	assert.Equal(t, `[]string{"Marker"}`, pg.InputTokensString())
+	assert.Equal(t, `[]string{"NextMarker || Contents[-1].Key"}`, pg.OutputTokensString())
+	assert.Equal(t, "MaxKeys", pg.LimitKey)
+	assert.Equal(t, "IsTruncated", pg.MoreResults)
+}
+
+func TestAttachWaiters(t *testing.T) {
+	json := `{
+		"metadata": { "serviceAbbreviation": "Amazon EC2" },
+		"operations": {
+			"GetPasswordData": {
+				"input": { "shape": "GetPasswordDataRequest" },
+				"output": { "shape": "GetPasswordDataResult" }
+			}
+		},
+		"shapes": {
+			"GetPasswordDataRequest": {
+				"type": "structure",
+				"members": {
+					"InstanceId": { "shape": "String" }
+				}
+			},
+			"GetPasswordDataResult": {
+				"type": "structure",
+				"members": {
+					"PasswordData": { "shape": "String" }
+				}
+			},
+			"String": { "type": "string" }
+		}
+	}`
+	a := API{}
+	a.AttachString(json)
+
+	w := waiterDefinitions{API: &a}
+	w.Waiters = map[string]Waiter{
+		"PasswordDataAvailable": Waiter{
+			Delay:         15,
+			MaxAttempts:   40,
+			OperationName: "GetPasswordData",
+			Acceptors: []WaitAcceptor{
+				{State: "success", Matcher: "path", Argument: "length(PasswordData) > `0`", Expected: true},
+			},
+		},
+		"UnknownWaiter": Waiter{OperationName: "UnknownOperation"},
+	}
+	w.setup()
+
+	assert.Equal(t, 1, len(a.Waiters))
+	assert.Equal(t, "PasswordDataAvailable", a.Waiters[0].Name)
+	assert.Equal(t, a.Operations["GetPasswordData"], a.Waiters[0].Operation)
+	assert.Equal(t, "true", a.Waiters[0].Acceptors[0].ExpectedString())
+	assert.Contains(t, a.WaitersGoCode(), "func (c *EC2) WaitUntilPasswordDataAvailable(input *GetPasswordDataInput) error")
+}
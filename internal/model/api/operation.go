@@ -17,8 +17,9 @@ type Operation struct {
 	Name          string
 	Documentation string
 	HTTP          HTTPInfo
-	InputRef      ShapeRef `json:"input"`
-	OutputRef     ShapeRef `json:"output"`
+	InputRef      ShapeRef   `json:"input"`
+	OutputRef     ShapeRef   `json:"output"`
+	Paginator     *Paginator `json:"-"`
 }
 
 type HTTPInfo struct {
@@ -54,6 +55,12 @@ func (c *{{ .API.StructName }}) {{ .ExportedName }}Request(` +
 			Name:       "{{ .Name }}",
 			{{ if ne .HTTP.Method "" }}HTTPMethod: "{{ .HTTP.Method }}",
 			{{ end }}{{ if ne .HTTP.RequestURI "" }}HTTPPath:   "{{ .HTTP.RequestURI }}",
+			{{ end }}{{ if .Paginator }}Paginator: &aws.Paginator{
+				InputTokens:     {{ .Paginator.InputTokensString }},
+				OutputTokens:    {{ .Paginator.OutputTokensString }},
+				LimitToken:      "{{ .Paginator.LimitKey }}",
+				TruncationToken: "{{ .Paginator.MoreResults }}",
+			},
 			{{ end }}
 		}
 	}
@@ -76,6 +83,29 @@ func (c *{{ .API.StructName }}) {{ .ExportedName }}Request(` +
 	return
 }
 
+// {{ .ExportedName }}WithContext is the same as {{ .ExportedName }} with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *{{ .API.StructName }}) {{ .ExportedName }}WithContext(` +
+	`ctx context.Context, input {{ .InputRef.GoType }}) (output {{ .OutputRef.GoType }}, err error) {
+	req, out := c.{{ .ExportedName }}Request(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+{{ if .Paginator }}
+// {{ .ExportedName }}Pages iterates over the pages of a {{ .ExportedName }} operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *{{ .API.StructName }}) {{ .ExportedName }}Pages(` +
+	`input {{ .InputRef.GoType }}, fn func(p {{ .OutputRef.GoType }}, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.{{ .ExportedName }}Request(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.({{ .OutputRef.GoType }}), lastPage)
+	})
+}
+{{ end }}
 var op{{ .ExportedName }} *aws.Operation
 `))
 
@@ -91,6 +121,8 @@ func (o *Operation) GoCode() string {
 
 var tplInfSig = template.Must(template.New("opsig").Parse(`
 {{ .ExportedName }}({{ .InputRef.GoTypeWithPkgName }}) ({{ .OutputRef.GoTypeWithPkgName }}, error)
+
+{{ .ExportedName }}WithContext(context.Context, {{ .InputRef.GoTypeWithPkgName }}) ({{ .OutputRef.GoTypeWithPkgName }}, error)
 `))
 
 // Returns a string representing the Operation's interface{} functional signature.
@@ -104,6 +136,27 @@ func (o *Operation) InterfaceSignature() string {
 	return strings.TrimSpace(util.GoFmt(buf.String()))
 }
 
+var tplInfPagesSig = template.Must(template.New("oppagessig").Parse(`
+{{ .ExportedName }}Pages({{ .InputRef.GoTypeWithPkgName }}, func({{ .OutputRef.GoTypeWithPkgName }}, bool) bool) error
+`))
+
+// Returns a string representing the Operation's paginated interface{}
+// functional signature, or an empty string if the operation does not
+// paginate.
+func (o *Operation) PagesInterfaceSignature() string {
+	if o.Paginator == nil {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	err := tplInfPagesSig.Execute(&buf, o)
+	if err != nil {
+		panic(err)
+	}
+
+	return strings.TrimSpace(buf.String())
+}
+
 var tplExample = template.Must(template.New("operationExample").Parse(`
 func Example{{ .API.StructName }}_{{ .ExportedName }}() {
 	svc := {{ .API.NewAPIGoCodeWithPkgName "nil" }}
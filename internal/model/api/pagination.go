@@ -0,0 +1,140 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// A Paginator is the pagination configuration of a single operation, as
+// described by a *.paginators.json model file. Tokens may be a single path
+// or a list of paths.
+type Paginator struct {
+	InputTokens  interface{} `json:"input_token"`
+	OutputTokens interface{} `json:"output_token"`
+	LimitKey     string      `json:"limit_key"`
+	MoreResults  string      `json:"more_results"`
+}
+
+// InputTokensString returns the Go code for the paginator's input tokens.
+func (p *Paginator) InputTokensString() string {
+	return goStringSlice(toStringSlice(p.InputTokens))
+}
+
+// OutputTokensString returns the Go code for the paginator's output tokens.
+func (p *Paginator) OutputTokensString() string {
+	return goStringSlice(toStringSlice(p.OutputTokens))
+}
+
+type paginationDefinitions struct {
+	*API
+	Pagination map[string]Paginator
+}
+
+// attachPaginators decodes the pagination configuration in filename and
+// attaches it to the API's operations.
+func (a *API) attachPaginators(filename string) {
+	p := paginationDefinitions{API: a}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	if err := json.NewDecoder(f).Decode(&p); err != nil {
+		panic(err)
+	}
+	p.setup()
+}
+
+func (p *paginationDefinitions) setup() {
+	for n, e := range p.Pagination {
+		o, ok := p.Operations[p.ExportableName(n)]
+		if !ok {
+			continue // paginator for an operation not in this API version
+		}
+
+		in := p.exportablePaths(toStringSlice(e.InputTokens))
+		out := p.exportablePaths(toStringSlice(e.OutputTokens))
+		if len(in) != len(out) {
+			panic(fmt.Sprintf("%s: paginator has %d input tokens but %d output tokens",
+				n, len(in), len(out)))
+		}
+
+		paginator := Paginator{
+			InputTokens:  in,
+			OutputTokens: out,
+			LimitKey:     p.exportablePath(e.LimitKey),
+			MoreResults:  p.exportablePath(e.MoreResults),
+		}
+
+		o.Paginator = &paginator
+	}
+}
+
+var rePathIndex = regexp.MustCompile(`^([^\[]+)(\[-?\d*\])?$`)
+
+// exportablePath converts each member name in a path expression such as
+// "NextMarker || Contents[-1].Key" into its exported Go name.
+func (a *API) exportablePath(path string) string {
+	if path == "" {
+		return path
+	}
+
+	alts := strings.Split(path, "||")
+	for i, alt := range alts {
+		parts := strings.Split(strings.TrimSpace(alt), ".")
+		for j, part := range parts {
+			m := rePathIndex.FindStringSubmatch(strings.TrimSpace(part))
+			if m == nil {
+				panic("unsupported paginator path " + path)
+			}
+			if m[1] != "*" {
+				m[1] = a.ExportableName(m[1])
+			}
+			parts[j] = m[1] + m[2]
+		}
+		alts[i] = strings.Join(parts, ".")
+	}
+	return strings.Join(alts, " || ")
+}
+
+func (a *API) exportablePaths(paths []string) []string {
+	out := make([]string, len(paths))
+	for i, path := range paths {
+		out[i] = a.exportablePath(path)
+	}
+	return out
+}
+
+// toStringSlice normalizes a token definition, which may be a single string
+// or a list of strings, into a list of strings.
+func toStringSlice(v interface{}) []string {
+	switch t := v.(type) {
+	case nil:
+		return nil
+	case string:
+		return []string{t}
+	case []string:
+		return t
+	case []interface{}:
+		out := make([]string, len(t))
+		for i, s := range t {
+			out[i] = s.(string)
+		}
+		return out
+	default:
+		panic(fmt.Sprintf("unsupported paginator token %#v", v))
+	}
+}
+
+func goStringSlice(list []string) string {
+	quoted := make([]string, len(list))
+	for i, s := range list {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
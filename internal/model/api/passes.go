@@ -183,7 +183,7 @@ func (a *API) makeIOShape(name string) *Shape {
 
 func (a *API) removeUnusedShapes() {
 	for n, s := range a.Shapes {
-		if len(s.refs) == 0 {
+		if len(s.refs) == 0 && !s.Exception {
 			delete(a.Shapes, n)
 		}
 	}
@@ -2,7 +2,9 @@ package api
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/datacratic/aws-sdk-go/internal/util"
@@ -40,14 +42,19 @@ type Shape struct {
 	Payload       string
 	Type          string
 	Exception     bool
+	ErrorInfo     ErrorInfo `json:"error"`
 	Enum          []string
+	Min           *int64
+	Max           *int64
+	Pattern       string
 	Flattened     bool
 	Streaming     bool
 	Location      string
 	LocationName  string
 	XMLNamespace  XMLInfo
 
-	refs []*ShapeRef
+	refs     []*ShapeRef
+	openEnum bool // values outside Enum are accepted by the service
 }
 
 func (s *Shape) Rename(newName string) {
@@ -197,8 +204,10 @@ func (ref *ShapeRef) GoTags(toplevel bool, isRequired bool) string {
 		code += `xmlAttribute:"true" `
 	}
 
+	code += ref.Shape.constraintTags()
+
 	if isRequired {
-		code += `required:"true"`
+		code += `required:"true" `
 	}
 
 	if toplevel {
@@ -221,6 +230,42 @@ func (ref *ShapeRef) GoTags(toplevel bool, isRequired bool) string {
 	return strings.TrimSpace(code) + "`"
 }
 
+// constraintTags returns the struct tags describing the shape's min, max,
+// pattern and enum constraints, which are checked by aws.ValidateParameters.
+func (s *Shape) constraintTags() string {
+	code := ""
+	if s.Min != nil {
+		code += fmt.Sprintf(`min:"%d" `, *s.Min)
+	}
+	if s.Max != nil {
+		code += fmt.Sprintf(`max:"%d" `, *s.Max)
+	}
+	if p, ok := goPattern(s.Pattern); ok {
+		code += `pattern:` + strconv.Quote(p) + ` `
+	}
+	if len(s.Enum) > 0 && !s.openEnum {
+		code += `enum:"` + strings.Join(s.Enum, ",") + `" `
+	}
+	return code
+}
+
+var reJavaUnicodeEscape = regexp.MustCompile(`\\u([0-9A-Fa-f]{4})`)
+
+// goPattern converts a model pattern, which uses Java regular expression
+// syntax, into Go syntax. Patterns which cannot be expressed in Go, such as
+// those using lookahead, are dropped.
+func goPattern(pattern string) (string, bool) {
+	if pattern == "" {
+		return "", false
+	}
+
+	p := reJavaUnicodeEscape.ReplaceAllString(pattern, `\x{$1}`)
+	if _, err := regexp.Compile(p); err != nil {
+		return "", false
+	}
+	return p, true
+}
+
 func (ref *ShapeRef) Docstring() string {
 	if ref.Documentation != "" {
 		return docstring(ref.Documentation)
@@ -276,3 +321,54 @@ func (s *Shape) IsRequired(member string) bool {
 	}
 	return false
 }
+
+// IsEnum returns whether the shape is a string shape with enumerated values.
+func (s *Shape) IsEnum() bool {
+	return s.Type == "string" && len(s.Enum) > 0
+}
+
+var reEnumDelims = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// EnumName returns the name of the constant for the shape's nth enum value,
+// e.g. "StorageClassStandardIA" for "STANDARD_IA", with the inflections of
+// member names. It returns an empty string if the value has no alphanumeric
+// characters.
+func (s *Shape) EnumName(n int) string {
+	name := ""
+	for _, p := range reEnumDelims.Split(s.Enum[n], -1) {
+		if p == "" {
+			continue
+		}
+		if len(p) > 2 && strings.ToUpper(p) == p {
+			p = strings.ToLower(p) // "STANDARD" -> "Standard", but keep "IA"
+		}
+		name += strings.ToUpper(p[:1]) + p[1:]
+	}
+	if name == "" {
+		return ""
+	}
+	return s.API.ExportableName(s.ShapeName + name)
+}
+
+// EnumGoCode returns the Go code for the shape's enum constants and the
+// function listing its valid values.
+func (s *Shape) EnumGoCode() string {
+	code := "// Enum values for " + s.ShapeName + ".\nconst (\n"
+	values := ""
+	for i, v := range s.Enum {
+		if n := s.EnumName(i); n != "" {
+			code += fmt.Sprintf("%s = %q\n", n, v)
+			values += n + ",\n"
+		} else {
+			values += fmt.Sprintf("%q,\n", v)
+		}
+	}
+	code += ")\n\n"
+
+	code += "// " + s.ShapeName + "Values returns the valid values of " + s.ShapeName + ".\n"
+	code += "func " + s.ShapeName + "Values() []string {\n"
+	code += "return []string{\n" + values + "}\n"
+	code += "}"
+
+	return util.GoFmt(code)
+}
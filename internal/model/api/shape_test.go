@@ -0,0 +1,55 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumName(t *testing.T) {
+	s := &Shape{
+		API:       &API{unrecognizedNames: map[string]string{}},
+		ShapeName: "StorageClass",
+		Type:      "string",
+		Enum:      []string{"STANDARD_IA", "public-read", "t2.micro", "HLSv3", "EU", "url", "application/json", ""},
+	}
+
+	names := []string{}
+	for i := range s.Enum {
+		names = append(names, s.EnumName(i))
+	}
+	assert.Equal(t, []string{
+		"StorageClassStandardIA",
+		"StorageClassPublicRead",
+		"StorageClassT2Micro",
+		"StorageClassHLSv3",
+		"StorageClassEU",
+		"StorageClassURL",
+		"StorageClassApplicationJSON",
+		"",
+	}, names)
+}
+
+func TestEnumGoCode(t *testing.T) {
+	s := &Shape{
+		API:       &API{unrecognizedNames: map[string]string{}},
+		ShapeName: "ReturnValue",
+		Type:      "string",
+		Enum:      []string{"NONE", "ALL_OLD"},
+	}
+
+	assert.True(t, s.IsEnum())
+	assert.Equal(t, `// Enum values for ReturnValue.
+const (
+	ReturnValueNone   = "NONE"
+	ReturnValueAllOld = "ALL_OLD"
+)
+
+// ReturnValueValues returns the valid values of ReturnValue.
+func ReturnValueValues() []string {
+	return []string{
+		ReturnValueNone,
+		ReturnValueAllOld,
+	}
+}`, s.EnumGoCode())
+}
@@ -0,0 +1,171 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/datacratic/aws-sdk-go/internal/util"
+)
+
+// A WaitAcceptor is a single acceptor of a waiter, as described by a
+// *.waiters.json model file.
+type WaitAcceptor struct {
+	Expected interface{}
+	Matcher  string
+	State    string
+	Argument string
+}
+
+// ExpectedString returns the Go code for the acceptor's expected value.
+func (a *WaitAcceptor) ExpectedString() string {
+	switch v := a.Expected.(type) {
+	case string:
+		return fmt.Sprintf("%q", v)
+	case float64:
+		return fmt.Sprintf("%v", v)
+	case bool:
+		return fmt.Sprintf("%t", v)
+	default:
+		panic(fmt.Sprintf("unsupported waiter expected value %#v", v))
+	}
+}
+
+// A Waiter is the configuration of a single waiter.
+type Waiter struct {
+	Name          string
+	Delay         int
+	MaxAttempts   int
+	OperationName string `json:"operation"`
+	Operation     *Operation
+	Acceptors     []WaitAcceptor
+}
+
+type waiterDefinitions struct {
+	*API
+	Waiters map[string]Waiter
+}
+
+// attachWaiters decodes the waiter configuration in filename and attaches it
+// to the API.
+func (a *API) attachWaiters(filename string) {
+	p := waiterDefinitions{API: a}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	if err := json.NewDecoder(f).Decode(&p); err != nil {
+		panic(err)
+	}
+	p.setup()
+}
+
+func (p *waiterDefinitions) setup() {
+	p.API.Waiters = []Waiter{}
+	for n, e := range p.Waiters {
+		o, ok := p.Operations[p.ExportableName(e.OperationName)]
+		if !ok {
+			continue // waiter for an operation not in this API version
+		}
+
+		e.Name = n
+		e.Operation = o
+		for i, a := range e.Acceptors {
+			e.Acceptors[i].Argument = p.exportableArgument(a.Argument)
+		}
+		p.API.Waiters = append(p.API.Waiters, e)
+	}
+
+	sort.Sort(waitersByName(p.API.Waiters))
+}
+
+var reWaiterFunc = regexp.MustCompile(`^(\w+)\((.+)\)(.*)$`)
+
+// exportableArgument converts the member names in a waiter argument into
+// their exported Go names. Arguments may wrap a path in a function call,
+// e.g. "length(PasswordData) > `0`".
+func (a *API) exportableArgument(arg string) string {
+	if m := reWaiterFunc.FindStringSubmatch(arg); m != nil {
+		return m[1] + "(" + a.exportablePath(m[2]) + ")" + m[3]
+	}
+	return a.exportablePath(arg)
+}
+
+type waitersByName []Waiter
+
+func (w waitersByName) Len() int           { return len(w) }
+func (w waitersByName) Swap(i, j int)      { w[i], w[j] = w[j], w[i] }
+func (w waitersByName) Less(i, j int) bool { return w[i].Name < w[j].Name }
+
+var tplWaiter = template.Must(template.New("waiter").Parse(`
+// {{ .Name }}Waiter returns a waiter which polls {{ .Operation.ExportedName }} until the
+// {{ .Name }} condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *{{ .Operation.API.StructName }}) {{ .Name }}Waiter(` +
+	`input {{ .Operation.InputRef.GoType }}) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "{{ .Name }}",
+		Delay:       {{ .Delay }} * time.Second,
+		MaxAttempts: {{ .MaxAttempts }},
+		Acceptors: []aws.WaitAcceptor{
+			{{ range $_, $a := .Acceptors }}aws.WaitAcceptor{
+				State:    "{{ $a.State }}",
+				Matcher:  "{{ $a.Matcher }}",
+				Argument: "{{ $a.Argument }}",
+				Expected: {{ $a.ExpectedString }},
+			},
+			{{ end }}
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.{{ .Operation.ExportedName }}Request(input)
+			return req
+		},
+	}
+}
+
+// WaitUntil{{ .Name }} polls {{ .Operation.ExportedName }} until the {{ .Name }}
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *{{ .Operation.API.StructName }}) WaitUntil{{ .Name }}(` +
+	`input {{ .Operation.InputRef.GoType }}) error {
+	return c.{{ .Name }}Waiter(input).Wait()
+}
+`))
+
+// GoCode returns the generated Go code for the waiter.
+func (w *Waiter) GoCode() string {
+	var buf bytes.Buffer
+	if err := tplWaiter.Execute(&buf, w); err != nil {
+		panic(err)
+	}
+
+	return strings.TrimSpace(buf.String())
+}
+
+// InterfaceSignature returns a string representing the waiter's interface{}
+// functional signature.
+func (w *Waiter) InterfaceSignature() string {
+	return fmt.Sprintf("WaitUntil%s(%s) error",
+		w.Name, w.Operation.InputRef.GoTypeWithPkgName())
+}
+
+// WaitersGoCode returns the generated Go code for the API's waiters.
+func (a *API) WaitersGoCode() string {
+	a.resetImports()
+	a.imports["time"] = true
+
+	code := []string{}
+	for _, w := range a.Waiters {
+		code = append(code, w.GoCode())
+	}
+
+	return util.GoFmt(a.importsGoCode() + strings.Join(code, "\n\n"))
+}
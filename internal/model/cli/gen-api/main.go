@@ -16,6 +16,7 @@ import (
 	"sync"
 
 	"github.com/datacratic/aws-sdk-go/internal/model/api"
+	"github.com/datacratic/aws-sdk-go/internal/util"
 )
 
 type generateInfo struct {
@@ -27,6 +28,15 @@ func newGenerateInfo(modelFile, svcPath string) *generateInfo {
 	g := &generateInfo{API: &api.API{}}
 	g.API.Attach(modelFile)
 
+	// attach the paginators and waiters definitions shipped alongside the
+	// model, if any
+	for _, ext := range []string{".paginators.json", ".waiters.json"} {
+		file := strings.Replace(modelFile, ".normal.json", ext, -1)
+		if _, err := os.Stat(file); err == nil {
+			g.API.Attach(file)
+		}
+	}
+
 	if svc := os.Getenv("SERVICES"); svc != "" {
 		svcs := strings.Split(svc, ",")
 		for _, s := range svcs {
@@ -107,6 +117,8 @@ func main() {
 					g.writeExamplesFile()
 					g.writeServiceFile()
 					g.writeInterfaceFile()
+					g.writeWaitersFile()
+					g.writeErrorsFile()
 				}
 			}
 		}()
@@ -124,6 +136,28 @@ func (g *generateInfo) writeServiceFile() {
 	ioutil.WriteFile(file, []byte("package "+g.API.PackageName()+"\n\n"+g.API.ServiceGoCode()), 0664)
 }
 
+func (g *generateInfo) writeWaitersFile() {
+	if len(g.API.Waiters) == 0 {
+		return
+	}
+
+	file := filepath.Join(g.PackageDir, "waiters.go")
+	code := fmt.Sprintf("// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.\n\n"+
+		"package %s\n\n%s", g.API.PackageName(), g.API.WaitersGoCode())
+	ioutil.WriteFile(file, []byte(util.GoFmt(code)), 0664)
+}
+
+func (g *generateInfo) writeErrorsFile() {
+	if len(g.API.ExceptionShapes()) == 0 {
+		return
+	}
+
+	file := filepath.Join(g.PackageDir, "errors.go")
+	code := fmt.Sprintf("// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.\n\n"+
+		"package %s\n\n%s", g.API.PackageName(), g.API.ErrorsGoCode())
+	ioutil.WriteFile(file, []byte(util.GoFmt(code)), 0664)
+}
+
 func (g *generateInfo) writeInterfaceFile() {
 	file := filepath.Join(g.PackageDir, g.API.InterfacePackageName(), "interface.go")
 	ioutil.WriteFile(file, []byte("package "+g.API.InterfacePackageName()+"\n\n"+g.API.InterfaceGoCode()), 0664)
@@ -21,8 +21,11 @@ func main() {
 	var endpoints struct {
 		Version   int
 		Endpoints map[string]struct {
-			Endpoint      string
-			SigningRegion string
+			Endpoint              string
+			FIPSEndpoint          string
+			DualStackEndpoint     string
+			FIPSDualStackEndpoint string
+			SigningRegion         string
 		}
 	}
 	if err := json.NewDecoder(in).Decode(&endpoints); err != nil {
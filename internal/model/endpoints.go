@@ -39,8 +39,11 @@ type endpointStruct struct {
 }
 
 type endpointEntry struct {
-	Endpoint      string
-	SigningRegion string
+	Endpoint              string
+	FIPSEndpoint          string
+	DualStackEndpoint     string
+	FIPSDualStackEndpoint string
+	SigningRegion         string
 }
 
 var endpointsMap = endpointStruct{
@@ -48,7 +51,10 @@ var endpointsMap = endpointStruct{
 	Endpoints: map[string]endpointEntry{
 		{{ range $key, $entry := .Endpoints }}"{{ $key }}": endpointEntry{
 			Endpoint:      "{{ $entry.Endpoint }}",
-			{{ if ne $entry.SigningRegion "" }}SigningRegion: "{{ $entry.SigningRegion }}",
+			{{ if ne $entry.FIPSEndpoint "" }}FIPSEndpoint: "{{ $entry.FIPSEndpoint }}",
+			{{ end }}{{ if ne $entry.DualStackEndpoint "" }}DualStackEndpoint: "{{ $entry.DualStackEndpoint }}",
+			{{ end }}{{ if ne $entry.FIPSDualStackEndpoint "" }}FIPSDualStackEndpoint: "{{ $entry.FIPSDualStackEndpoint }}",
+			{{ end }}			{{ if ne $entry.SigningRegion "" }}SigningRegion: "{{ $entry.SigningRegion }}",
 			{{ end }}
 		},
 		{{ end }}
@@ -4,6 +4,7 @@ package jsonrpc
 //go:generate go run ../../fixtures/protocol/generate.go ../../fixtures/protocol/output/json.json unmarshal_test.go
 
 import (
+	"bytes"
 	"encoding/json"
 	"io/ioutil"
 	"strings"
@@ -75,11 +76,21 @@ func UnmarshalError(req *aws.Request) {
 	}
 
 	codes := strings.SplitN(jsonErr.Code, "#", 2)
-	req.Error = aws.APIError{
+	apiErr := aws.APIError{
 		StatusCode: req.HTTPResponse.StatusCode,
 		Code:       codes[len(codes)-1],
 		Message:    jsonErr.Message,
 	}
+
+	// the generic error is kept if the typed error can't be decoded
+	if e := req.Service.NewError(apiErr.Code); e != nil {
+		if err := jsonutil.UnmarshalJSON(e, bytes.NewReader(bodyBytes)); err == nil {
+			*e.BaseError() = apiErr
+			req.Error = e
+			return
+		}
+	}
+	req.Error = apiErr
 }
 
 type jsonErrorResponse struct {
@@ -0,0 +1,59 @@
+package jsonrpc_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/internal/protocol/jsonrpc"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockError struct {
+	aws.APIError
+
+	Reason *string `locationName:"reason" type:"string"`
+}
+
+func newErrorRequest(body string) *aws.Request {
+	s := aws.NewService(&aws.Config{})
+	s.ErrorTypes = map[string]func() aws.ServiceError{
+		"MockException": func() aws.ServiceError { return &mockError{} },
+	}
+
+	r := aws.NewRequest(s, &aws.Operation{Name: "Operation"}, nil, nil)
+	r.HTTPResponse = &http.Response{
+		StatusCode: 400,
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+	}
+	return r
+}
+
+func TestUnmarshalTypedError(t *testing.T) {
+	r := newErrorRequest(`{"__type":"com.amazon.service#MockException","message":"mock message","reason":"mock reason"}`)
+	jsonrpc.UnmarshalError(r)
+
+	err, ok := r.Error.(*mockError)
+	assert.True(t, ok)
+	assert.Equal(t, "MockException", err.Code)
+	assert.Equal(t, "mock message", err.Message)
+	assert.Equal(t, 400, err.StatusCode)
+	assert.Equal(t, "mock reason", *err.Reason)
+	assert.Equal(t, "MockException", aws.Error(r.Error).Code)
+}
+
+func TestUnmarshalUntypedError(t *testing.T) {
+	r := newErrorRequest(`{"__type":"com.amazon.service#OtherException","message":"other message"}`)
+	jsonrpc.UnmarshalError(r)
+
+	assert.Equal(t, aws.APIError{StatusCode: 400, Code: "OtherException", Message: "other message"}, r.Error)
+}
+
+func TestUnmarshalUndecodableTypedError(t *testing.T) {
+	r := newErrorRequest(`{"__type":"com.amazon.service#MockException","message":"mock message","reason":42}`)
+	jsonrpc.UnmarshalError(r)
+
+	assert.Equal(t, aws.APIError{StatusCode: 400, Code: "MockException", Message: "mock message"}, r.Error)
+}
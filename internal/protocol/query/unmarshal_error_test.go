@@ -0,0 +1,40 @@
+package query_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/internal/protocol/query"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockError struct {
+	aws.APIError
+
+	BoxUsage *float64 `type:"float"`
+}
+
+func TestUnmarshalTypedError(t *testing.T) {
+	s := aws.NewService(&aws.Config{})
+	s.ErrorTypes = map[string]func() aws.ServiceError{
+		"AWS.Mock.Error": func() aws.ServiceError { return &mockError{} },
+	}
+
+	body := `<ErrorResponse><Error><Type>Sender</Type><Code>AWS.Mock.Error</Code><Message>mock message</Message><BoxUsage>0.5</BoxUsage></Error><RequestId>request-id</RequestId></ErrorResponse>`
+	r := aws.NewRequest(s, &aws.Operation{Name: "Operation"}, nil, nil)
+	r.HTTPResponse = &http.Response{
+		StatusCode: 400,
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+	}
+	query.UnmarshalError(r)
+
+	err, ok := r.Error.(*mockError)
+	assert.True(t, ok)
+	assert.Equal(t, "AWS.Mock.Error", err.Code)
+	assert.Equal(t, "mock message", err.Message)
+	assert.Equal(t, 0.5, *err.BoxUsage)
+	assert.Equal(t, "AWS.Mock.Error: mock message", r.Error.Error())
+}
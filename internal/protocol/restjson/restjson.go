@@ -4,11 +4,13 @@ package restjson
 //go:generate go run ../../fixtures/protocol/generate.go ../../fixtures/protocol/output/rest-json.json unmarshal_test.go
 
 import (
+	"bytes"
 	"encoding/json"
 	"io/ioutil"
 	"strings"
 
 	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/internal/protocol/json/jsonutil"
 	"github.com/datacratic/aws-sdk-go/internal/protocol/jsonrpc"
 	"github.com/datacratic/aws-sdk-go/internal/protocol/rest"
 )
@@ -52,11 +54,21 @@ func UnmarshalError(r *aws.Request) {
 	}
 
 	codes := strings.SplitN(code, ":", 2)
-	r.Error = aws.APIError{
+	apiErr := aws.APIError{
 		StatusCode: r.HTTPResponse.StatusCode,
 		Code:       codes[0],
 		Message:    jsonErr.Message,
 	}
+
+	// the generic error is kept if the typed error can't be decoded
+	if e := r.Service.NewError(apiErr.Code); e != nil {
+		if err := jsonutil.UnmarshalJSON(e, bytes.NewReader(bodyBytes)); err == nil {
+			*e.BaseError() = apiErr
+			r.Error = e
+			return
+		}
+	}
+	r.Error = apiErr
 }
 
 type jsonErrorResponse struct {
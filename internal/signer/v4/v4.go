@@ -4,7 +4,6 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
-	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -17,7 +16,10 @@ import (
 )
 
 const (
-	authHeaderPrefix = "AWS4-HMAC-SHA256"
+	// Algorithm is the name of the signature version 4 algorithm.
+	Algorithm = "AWS4-HMAC-SHA256"
+
+	authHeaderPrefix = Algorithm
 	timeFormat       = "20060102T150405Z"
 	shortTimeFormat  = "20060102"
 )
@@ -40,8 +42,8 @@ type signer struct {
 	SessionToken    string
 	Query           url.Values
 	Body            io.ReadSeeker
-	Debug           uint
-	Logger          io.Writer
+	Debug           aws.LogLevel
+	Logger          aws.Logger
 
 	isPresign          bool
 	formattedTime      string
@@ -69,13 +71,18 @@ func Sign(req *aws.Request) {
 		region = req.Service.Config.Region
 	}
 
+	name := req.Service.SigningName
+	if name == "" {
+		name = req.Service.ServiceName
+	}
+
 	s := signer{
 		Request:         req.HTTPRequest,
 		Time:            req.Time,
 		ExpireTime:      req.ExpireTime,
 		Query:           req.HTTPRequest.URL.Query(),
 		Body:            req.Body,
-		ServiceName:     req.Service.ServiceName,
+		ServiceName:     name,
 		Region:          region,
 		AccessKeyID:     creds.AccessKeyID,
 		SecretAccessKey: creds.SecretAccessKey,
@@ -101,19 +108,18 @@ func (v4 *signer) sign() {
 		}
 	} else if v4.SessionToken != "" {
 		v4.Request.Header.Set("X-Amz-Security-Token", v4.SessionToken)
+	} else {
+		v4.Request.Header.Del("X-Amz-Security-Token")
 	}
 
 	v4.build()
 
-	if v4.Debug > 0 {
-		out := v4.Logger
-		fmt.Fprintf(out, "---[ CANONICAL STRING  ]-----------------------------\n")
-		fmt.Fprintln(out, v4.canonicalString)
-		fmt.Fprintf(out, "---[ STRING TO SIGN ]--------------------------------\n")
-		fmt.Fprintln(out, v4.stringToSign)
-		fmt.Fprintf(out, "---[ SIGNED URL ]--------------------------------\n")
-		fmt.Fprintln(out, v4.Request.URL)
-		fmt.Fprintf(out, "-----------------------------------------------------\n")
+	if v4.Debug.Matches(aws.LogSigning) && v4.Logger != nil {
+		v4.Logger.Log(aws.LogSigning, "signed request",
+			"service", v4.ServiceName, "region", v4.Region,
+			"canonical-string", v4.redactedCanonicalString(),
+			"string-to-sign", v4.stringToSign,
+			"url", aws.RedactURL(v4.Request.URL))
 	}
 }
 
@@ -154,12 +160,7 @@ func (v4 *signer) buildTime() {
 }
 
 func (v4 *signer) buildCredentialString() {
-	v4.credentialString = strings.Join([]string{
-		v4.formattedShortTime,
-		v4.Region,
-		v4.ServiceName,
-		"aws4_request",
-	}, "/")
+	v4.credentialString = CredentialScope(v4.Time, v4.Region, v4.ServiceName)
 
 	if v4.isPresign {
 		v4.Query.Set("X-Amz-Credential", v4.AccessKeyID+"/"+v4.credentialString)
@@ -200,21 +201,45 @@ func (v4 *signer) buildCanonicalHeaders() {
 		v4.Query.Set("X-Amz-SignedHeaders", v4.signedHeaders)
 	}
 
+	v4.canonicalHeaders = v4.headerValues(v4.Request.Header)
+}
+
+// headerValues returns the canonical headers of the signed headers, with
+// their values in header.
+func (v4 *signer) headerValues(header http.Header) string {
+	headers := strings.Split(v4.signedHeaders, ";")
 	headerValues := make([]string, len(headers))
 	for i, k := range headers {
 		if k == "host" {
 			headerValues[i] = "host:" + v4.Request.URL.Host
 		} else {
 			headerValues[i] = k + ":" +
-				strings.Join(v4.Request.Header[http.CanonicalHeaderKey(k)], ",")
+				strings.Join(header[http.CanonicalHeaderKey(k)], ",")
 		}
 	}
-
-	v4.canonicalHeaders = strings.Join(headerValues, "\n")
+	return strings.Join(headerValues, "\n")
 }
 
 func (v4 *signer) buildCanonicalString() {
 	v4.Request.URL.RawQuery = v4.Query.Encode()
+	v4.canonicalString = v4.joinCanonicalString(v4.Request.URL.RawQuery, v4.canonicalHeaders)
+}
+
+// redactedCanonicalString returns the canonical string with the values of
+// the headers and query parameters holding secrets, such as the security
+// token and SSE-C keys, redacted, so that it can be logged.
+func (v4 *signer) redactedCanonicalString() string {
+	query := url.Values{}
+	for k, v := range v4.Query {
+		query[k] = v
+	}
+	if _, ok := query["X-Amz-Security-Token"]; ok {
+		query.Set("X-Amz-Security-Token", "REDACTED")
+	}
+	return v4.joinCanonicalString(query.Encode(), v4.headerValues(aws.RedactHeader(v4.Request.Header)))
+}
+
+func (v4 *signer) joinCanonicalString(query, canonicalHeaders string) string {
 	uri := v4.Request.URL.Opaque
 	if uri != "" {
 		uri = "/" + strings.Join(strings.Split(uri, "/")[3:], "/")
@@ -225,11 +250,11 @@ func (v4 *signer) buildCanonicalString() {
 		uri = "/"
 	}
 
-	v4.canonicalString = strings.Join([]string{
+	return strings.Join([]string{
 		v4.Request.Method,
 		uri,
-		v4.Request.URL.RawQuery,
-		v4.canonicalHeaders + "\n",
+		query,
+		canonicalHeaders + "\n",
 		v4.signedHeaders,
 		v4.bodyDigest(),
 	}, "\n")
@@ -245,13 +270,34 @@ func (v4 *signer) buildStringToSign() {
 }
 
 func (v4 *signer) buildSignature() {
-	secret := v4.SecretAccessKey
-	date := makeHmac([]byte("AWS4"+secret), []byte(v4.formattedShortTime))
-	region := makeHmac(date, []byte(v4.Region))
-	service := makeHmac(region, []byte(v4.ServiceName))
-	credentials := makeHmac(service, []byte("aws4_request"))
-	signature := makeHmac(credentials, []byte(v4.stringToSign))
-	v4.signature = hex.EncodeToString(signature)
+	key := SigningKey(v4.SecretAccessKey, v4.Time, v4.Region, v4.ServiceName)
+	v4.signature = SignString(key, v4.stringToSign)
+}
+
+// CredentialScope returns the scope of credentials signing for service in
+// region on the day of t, as in the credential of signatures.
+func CredentialScope(t time.Time, region, service string) string {
+	return strings.Join([]string{
+		t.UTC().Format(shortTimeFormat),
+		region,
+		service,
+		"aws4_request",
+	}, "/")
+}
+
+// SigningKey derives the key signing for service in region on the day of t
+// from a secret access key.
+func SigningKey(secret string, t time.Time, region, service string) []byte {
+	date := makeHmac([]byte("AWS4"+secret), []byte(t.UTC().Format(shortTimeFormat)))
+	regionKey := makeHmac(date, []byte(region))
+	serviceKey := makeHmac(regionKey, []byte(service))
+	return makeHmac(serviceKey, []byte("aws4_request"))
+}
+
+// SignString returns the hex signature of s with a signing key, such as a
+// string to sign or a policy document.
+func SignString(key []byte, s string) string {
+	return hex.EncodeToString(makeHmac(key, []byte(s)))
 }
 
 func (v4 *signer) bodyDigest() string {
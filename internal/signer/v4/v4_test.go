@@ -1,11 +1,13 @@
 package v4
 
 import (
+	"bytes"
 	"net/http"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/datacratic/aws-sdk-go/aws"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -74,6 +76,25 @@ func TestSignRequest(t *testing.T) {
 	assert.Equal(t, expectedDate, q.Get("X-Amz-Date"))
 }
 
+func TestSignLogRedactsSecrets(t *testing.T) {
+	for _, expireTime := range []time.Duration{0, 300 * time.Second} {
+		var buf bytes.Buffer
+		signer := buildSigner("s3", "us-east-1", time.Unix(0, 0), expireTime, "{}")
+		signer.Request.Header.Set("X-Amz-Server-Side-Encryption-Customer-Key", "CUSTOMERKEY")
+		signer.Request.Header.Set("X-Amz-Server-Side-Encryption-Customer-Key-Md5", "CUSTOMERMD5")
+		signer.Debug = aws.LogSigning
+		signer.Logger = aws.NewWriterLogger(&buf)
+		signer.sign()
+
+		log := buf.String()
+		assert.Contains(t, log, "canonical-string")
+		assert.Contains(t, log, "REDACTED")
+		for _, secret := range []string{"SESSION", "CUSTOMERKEY", "CUSTOMERMD5", "SECRET"} {
+			assert.NotContains(t, log, secret)
+		}
+	}
+}
+
 func BenchmarkPresignRequest(b *testing.B) {
 	signer := buildSigner("dynamodb", "us-east-1", time.Now(), 300*time.Second, "{}")
 	for i := 0; i < b.N; i++ {
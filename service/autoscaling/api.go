@@ -4,6 +4,7 @@
 package autoscaling
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -47,6 +48,17 @@ func (c *AutoScaling) AttachInstances(input *AttachInstancesInput) (output *Atta
 	return
 }
 
+// AttachInstancesWithContext is the same as AttachInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) AttachInstancesWithContext(ctx context.Context, input *AttachInstancesInput) (output *AttachInstancesOutput, err error) {
+	req, out := c.AttachInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAttachInstances *aws.Operation
 
 // CompleteLifecycleActionRequest generates a request for the CompleteLifecycleAction operation.
@@ -94,6 +106,17 @@ func (c *AutoScaling) CompleteLifecycleAction(input *CompleteLifecycleActionInpu
 	return
 }
 
+// CompleteLifecycleActionWithContext is the same as CompleteLifecycleAction with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) CompleteLifecycleActionWithContext(ctx context.Context, input *CompleteLifecycleActionInput) (output *CompleteLifecycleActionOutput, err error) {
+	req, out := c.CompleteLifecycleActionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCompleteLifecycleAction *aws.Operation
 
 // CreateAutoScalingGroupRequest generates a request for the CreateAutoScalingGroup operation.
@@ -131,6 +154,17 @@ func (c *AutoScaling) CreateAutoScalingGroup(input *CreateAutoScalingGroupInput)
 	return
 }
 
+// CreateAutoScalingGroupWithContext is the same as CreateAutoScalingGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) CreateAutoScalingGroupWithContext(ctx context.Context, input *CreateAutoScalingGroupInput) (output *CreateAutoScalingGroupOutput, err error) {
+	req, out := c.CreateAutoScalingGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateAutoScalingGroup *aws.Operation
 
 // CreateLaunchConfigurationRequest generates a request for the CreateLaunchConfiguration operation.
@@ -168,6 +202,17 @@ func (c *AutoScaling) CreateLaunchConfiguration(input *CreateLaunchConfiguration
 	return
 }
 
+// CreateLaunchConfigurationWithContext is the same as CreateLaunchConfiguration with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) CreateLaunchConfigurationWithContext(ctx context.Context, input *CreateLaunchConfigurationInput) (output *CreateLaunchConfigurationOutput, err error) {
+	req, out := c.CreateLaunchConfigurationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateLaunchConfiguration *aws.Operation
 
 // CreateOrUpdateTagsRequest generates a request for the CreateOrUpdateTags operation.
@@ -207,6 +252,17 @@ func (c *AutoScaling) CreateOrUpdateTags(input *CreateOrUpdateTagsInput) (output
 	return
 }
 
+// CreateOrUpdateTagsWithContext is the same as CreateOrUpdateTags with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) CreateOrUpdateTagsWithContext(ctx context.Context, input *CreateOrUpdateTagsInput) (output *CreateOrUpdateTagsOutput, err error) {
+	req, out := c.CreateOrUpdateTagsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateOrUpdateTags *aws.Operation
 
 // DeleteAutoScalingGroupRequest generates a request for the DeleteAutoScalingGroup operation.
@@ -246,6 +302,17 @@ func (c *AutoScaling) DeleteAutoScalingGroup(input *DeleteAutoScalingGroupInput)
 	return
 }
 
+// DeleteAutoScalingGroupWithContext is the same as DeleteAutoScalingGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DeleteAutoScalingGroupWithContext(ctx context.Context, input *DeleteAutoScalingGroupInput) (output *DeleteAutoScalingGroupOutput, err error) {
+	req, out := c.DeleteAutoScalingGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteAutoScalingGroup *aws.Operation
 
 // DeleteLaunchConfigurationRequest generates a request for the DeleteLaunchConfiguration operation.
@@ -283,6 +350,17 @@ func (c *AutoScaling) DeleteLaunchConfiguration(input *DeleteLaunchConfiguration
 	return
 }
 
+// DeleteLaunchConfigurationWithContext is the same as DeleteLaunchConfiguration with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DeleteLaunchConfigurationWithContext(ctx context.Context, input *DeleteLaunchConfigurationInput) (output *DeleteLaunchConfigurationOutput, err error) {
+	req, out := c.DeleteLaunchConfigurationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteLaunchConfiguration *aws.Operation
 
 // DeleteLifecycleHookRequest generates a request for the DeleteLifecycleHook operation.
@@ -319,6 +397,17 @@ func (c *AutoScaling) DeleteLifecycleHook(input *DeleteLifecycleHookInput) (outp
 	return
 }
 
+// DeleteLifecycleHookWithContext is the same as DeleteLifecycleHook with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DeleteLifecycleHookWithContext(ctx context.Context, input *DeleteLifecycleHookInput) (output *DeleteLifecycleHookOutput, err error) {
+	req, out := c.DeleteLifecycleHookRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteLifecycleHook *aws.Operation
 
 // DeleteNotificationConfigurationRequest generates a request for the DeleteNotificationConfiguration operation.
@@ -352,6 +441,17 @@ func (c *AutoScaling) DeleteNotificationConfiguration(input *DeleteNotificationC
 	return
 }
 
+// DeleteNotificationConfigurationWithContext is the same as DeleteNotificationConfiguration with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DeleteNotificationConfigurationWithContext(ctx context.Context, input *DeleteNotificationConfigurationInput) (output *DeleteNotificationConfigurationOutput, err error) {
+	req, out := c.DeleteNotificationConfigurationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteNotificationConfiguration *aws.Operation
 
 // DeletePolicyRequest generates a request for the DeletePolicy operation.
@@ -385,6 +485,17 @@ func (c *AutoScaling) DeletePolicy(input *DeletePolicyInput) (output *DeletePoli
 	return
 }
 
+// DeletePolicyWithContext is the same as DeletePolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DeletePolicyWithContext(ctx context.Context, input *DeletePolicyInput) (output *DeletePolicyOutput, err error) {
+	req, out := c.DeletePolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeletePolicy *aws.Operation
 
 // DeleteScheduledActionRequest generates a request for the DeleteScheduledAction operation.
@@ -418,6 +529,17 @@ func (c *AutoScaling) DeleteScheduledAction(input *DeleteScheduledActionInput) (
 	return
 }
 
+// DeleteScheduledActionWithContext is the same as DeleteScheduledAction with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DeleteScheduledActionWithContext(ctx context.Context, input *DeleteScheduledActionInput) (output *DeleteScheduledActionOutput, err error) {
+	req, out := c.DeleteScheduledActionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteScheduledAction *aws.Operation
 
 // DeleteTagsRequest generates a request for the DeleteTags operation.
@@ -451,6 +573,17 @@ func (c *AutoScaling) DeleteTags(input *DeleteTagsInput) (output *DeleteTagsOutp
 	return
 }
 
+// DeleteTagsWithContext is the same as DeleteTags with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DeleteTagsWithContext(ctx context.Context, input *DeleteTagsInput) (output *DeleteTagsOutput, err error) {
+	req, out := c.DeleteTagsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteTags *aws.Operation
 
 // DescribeAccountLimitsRequest generates a request for the DescribeAccountLimits operation.
@@ -487,6 +620,17 @@ func (c *AutoScaling) DescribeAccountLimits(input *DescribeAccountLimitsInput) (
 	return
 }
 
+// DescribeAccountLimitsWithContext is the same as DescribeAccountLimits with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DescribeAccountLimitsWithContext(ctx context.Context, input *DescribeAccountLimitsInput) (output *DescribeAccountLimitsOutput, err error) {
+	req, out := c.DescribeAccountLimitsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeAccountLimits *aws.Operation
 
 // DescribeAdjustmentTypesRequest generates a request for the DescribeAdjustmentTypes operation.
@@ -520,6 +664,17 @@ func (c *AutoScaling) DescribeAdjustmentTypes(input *DescribeAdjustmentTypesInpu
 	return
 }
 
+// DescribeAdjustmentTypesWithContext is the same as DescribeAdjustmentTypes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DescribeAdjustmentTypesWithContext(ctx context.Context, input *DescribeAdjustmentTypesInput) (output *DescribeAdjustmentTypesOutput, err error) {
+	req, out := c.DescribeAdjustmentTypesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeAdjustmentTypes *aws.Operation
 
 // DescribeAutoScalingGroupsRequest generates a request for the DescribeAutoScalingGroups operation.
@@ -532,6 +687,12 @@ func (c *AutoScaling) DescribeAutoScalingGroupsRequest(input *DescribeAutoScalin
 			Name:       "DescribeAutoScalingGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -558,6 +719,27 @@ func (c *AutoScaling) DescribeAutoScalingGroups(input *DescribeAutoScalingGroups
 	return
 }
 
+// DescribeAutoScalingGroupsWithContext is the same as DescribeAutoScalingGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DescribeAutoScalingGroupsWithContext(ctx context.Context, input *DescribeAutoScalingGroupsInput) (output *DescribeAutoScalingGroupsOutput, err error) {
+	req, out := c.DescribeAutoScalingGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeAutoScalingGroupsPages iterates over the pages of a DescribeAutoScalingGroups operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *AutoScaling) DescribeAutoScalingGroupsPages(input *DescribeAutoScalingGroupsInput, fn func(p *DescribeAutoScalingGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeAutoScalingGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeAutoScalingGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeAutoScalingGroups *aws.Operation
 
 // DescribeAutoScalingInstancesRequest generates a request for the DescribeAutoScalingInstances operation.
@@ -570,6 +752,12 @@ func (c *AutoScaling) DescribeAutoScalingInstancesRequest(input *DescribeAutoSca
 			Name:       "DescribeAutoScalingInstances",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -597,6 +785,27 @@ func (c *AutoScaling) DescribeAutoScalingInstances(input *DescribeAutoScalingIns
 	return
 }
 
+// DescribeAutoScalingInstancesWithContext is the same as DescribeAutoScalingInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DescribeAutoScalingInstancesWithContext(ctx context.Context, input *DescribeAutoScalingInstancesInput) (output *DescribeAutoScalingInstancesOutput, err error) {
+	req, out := c.DescribeAutoScalingInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeAutoScalingInstancesPages iterates over the pages of a DescribeAutoScalingInstances operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *AutoScaling) DescribeAutoScalingInstancesPages(input *DescribeAutoScalingInstancesInput, fn func(p *DescribeAutoScalingInstancesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeAutoScalingInstancesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeAutoScalingInstancesOutput), lastPage)
+	})
+}
+
 var opDescribeAutoScalingInstances *aws.Operation
 
 // DescribeAutoScalingNotificationTypesRequest generates a request for the DescribeAutoScalingNotificationTypes operation.
@@ -630,6 +839,17 @@ func (c *AutoScaling) DescribeAutoScalingNotificationTypes(input *DescribeAutoSc
 	return
 }
 
+// DescribeAutoScalingNotificationTypesWithContext is the same as DescribeAutoScalingNotificationTypes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DescribeAutoScalingNotificationTypesWithContext(ctx context.Context, input *DescribeAutoScalingNotificationTypesInput) (output *DescribeAutoScalingNotificationTypesOutput, err error) {
+	req, out := c.DescribeAutoScalingNotificationTypesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeAutoScalingNotificationTypes *aws.Operation
 
 // DescribeLaunchConfigurationsRequest generates a request for the DescribeLaunchConfigurations operation.
@@ -642,6 +862,12 @@ func (c *AutoScaling) DescribeLaunchConfigurationsRequest(input *DescribeLaunchC
 			Name:       "DescribeLaunchConfigurations",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -668,6 +894,27 @@ func (c *AutoScaling) DescribeLaunchConfigurations(input *DescribeLaunchConfigur
 	return
 }
 
+// DescribeLaunchConfigurationsWithContext is the same as DescribeLaunchConfigurations with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DescribeLaunchConfigurationsWithContext(ctx context.Context, input *DescribeLaunchConfigurationsInput) (output *DescribeLaunchConfigurationsOutput, err error) {
+	req, out := c.DescribeLaunchConfigurationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeLaunchConfigurationsPages iterates over the pages of a DescribeLaunchConfigurations operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *AutoScaling) DescribeLaunchConfigurationsPages(input *DescribeLaunchConfigurationsInput, fn func(p *DescribeLaunchConfigurationsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeLaunchConfigurationsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeLaunchConfigurationsOutput), lastPage)
+	})
+}
+
 var opDescribeLaunchConfigurations *aws.Operation
 
 // DescribeLifecycleHookTypesRequest generates a request for the DescribeLifecycleHookTypes operation.
@@ -701,6 +948,17 @@ func (c *AutoScaling) DescribeLifecycleHookTypes(input *DescribeLifecycleHookTyp
 	return
 }
 
+// DescribeLifecycleHookTypesWithContext is the same as DescribeLifecycleHookTypes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DescribeLifecycleHookTypesWithContext(ctx context.Context, input *DescribeLifecycleHookTypesInput) (output *DescribeLifecycleHookTypesOutput, err error) {
+	req, out := c.DescribeLifecycleHookTypesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeLifecycleHookTypes *aws.Operation
 
 // DescribeLifecycleHooksRequest generates a request for the DescribeLifecycleHooks operation.
@@ -734,6 +992,17 @@ func (c *AutoScaling) DescribeLifecycleHooks(input *DescribeLifecycleHooksInput)
 	return
 }
 
+// DescribeLifecycleHooksWithContext is the same as DescribeLifecycleHooks with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DescribeLifecycleHooksWithContext(ctx context.Context, input *DescribeLifecycleHooksInput) (output *DescribeLifecycleHooksOutput, err error) {
+	req, out := c.DescribeLifecycleHooksRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeLifecycleHooks *aws.Operation
 
 // DescribeMetricCollectionTypesRequest generates a request for the DescribeMetricCollectionTypes operation.
@@ -771,6 +1040,17 @@ func (c *AutoScaling) DescribeMetricCollectionTypes(input *DescribeMetricCollect
 	return
 }
 
+// DescribeMetricCollectionTypesWithContext is the same as DescribeMetricCollectionTypes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DescribeMetricCollectionTypesWithContext(ctx context.Context, input *DescribeMetricCollectionTypesInput) (output *DescribeMetricCollectionTypesOutput, err error) {
+	req, out := c.DescribeMetricCollectionTypesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeMetricCollectionTypes *aws.Operation
 
 // DescribeNotificationConfigurationsRequest generates a request for the DescribeNotificationConfigurations operation.
@@ -783,6 +1063,12 @@ func (c *AutoScaling) DescribeNotificationConfigurationsRequest(input *DescribeN
 			Name:       "DescribeNotificationConfigurations",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -805,6 +1091,27 @@ func (c *AutoScaling) DescribeNotificationConfigurations(input *DescribeNotifica
 	return
 }
 
+// DescribeNotificationConfigurationsWithContext is the same as DescribeNotificationConfigurations with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DescribeNotificationConfigurationsWithContext(ctx context.Context, input *DescribeNotificationConfigurationsInput) (output *DescribeNotificationConfigurationsOutput, err error) {
+	req, out := c.DescribeNotificationConfigurationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeNotificationConfigurationsPages iterates over the pages of a DescribeNotificationConfigurations operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *AutoScaling) DescribeNotificationConfigurationsPages(input *DescribeNotificationConfigurationsInput, fn func(p *DescribeNotificationConfigurationsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeNotificationConfigurationsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeNotificationConfigurationsOutput), lastPage)
+	})
+}
+
 var opDescribeNotificationConfigurations *aws.Operation
 
 // DescribePoliciesRequest generates a request for the DescribePolicies operation.
@@ -817,6 +1124,12 @@ func (c *AutoScaling) DescribePoliciesRequest(input *DescribePoliciesInput) (req
 			Name:       "DescribePolicies",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -842,6 +1155,27 @@ func (c *AutoScaling) DescribePolicies(input *DescribePoliciesInput) (output *De
 	return
 }
 
+// DescribePoliciesWithContext is the same as DescribePolicies with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DescribePoliciesWithContext(ctx context.Context, input *DescribePoliciesInput) (output *DescribePoliciesOutput, err error) {
+	req, out := c.DescribePoliciesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribePoliciesPages iterates over the pages of a DescribePolicies operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *AutoScaling) DescribePoliciesPages(input *DescribePoliciesInput, fn func(p *DescribePoliciesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribePoliciesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribePoliciesOutput), lastPage)
+	})
+}
+
 var opDescribePolicies *aws.Operation
 
 // DescribeScalingActivitiesRequest generates a request for the DescribeScalingActivities operation.
@@ -854,6 +1188,12 @@ func (c *AutoScaling) DescribeScalingActivitiesRequest(input *DescribeScalingAct
 			Name:       "DescribeScalingActivities",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -882,6 +1222,27 @@ func (c *AutoScaling) DescribeScalingActivities(input *DescribeScalingActivities
 	return
 }
 
+// DescribeScalingActivitiesWithContext is the same as DescribeScalingActivities with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DescribeScalingActivitiesWithContext(ctx context.Context, input *DescribeScalingActivitiesInput) (output *DescribeScalingActivitiesOutput, err error) {
+	req, out := c.DescribeScalingActivitiesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeScalingActivitiesPages iterates over the pages of a DescribeScalingActivities operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *AutoScaling) DescribeScalingActivitiesPages(input *DescribeScalingActivitiesInput, fn func(p *DescribeScalingActivitiesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeScalingActivitiesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeScalingActivitiesOutput), lastPage)
+	})
+}
+
 var opDescribeScalingActivities *aws.Operation
 
 // DescribeScalingProcessTypesRequest generates a request for the DescribeScalingProcessTypes operation.
@@ -916,6 +1277,17 @@ func (c *AutoScaling) DescribeScalingProcessTypes(input *DescribeScalingProcessT
 	return
 }
 
+// DescribeScalingProcessTypesWithContext is the same as DescribeScalingProcessTypes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DescribeScalingProcessTypesWithContext(ctx context.Context, input *DescribeScalingProcessTypesInput) (output *DescribeScalingProcessTypesOutput, err error) {
+	req, out := c.DescribeScalingProcessTypesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeScalingProcessTypes *aws.Operation
 
 // DescribeScheduledActionsRequest generates a request for the DescribeScheduledActions operation.
@@ -928,6 +1300,12 @@ func (c *AutoScaling) DescribeScheduledActionsRequest(input *DescribeScheduledAc
 			Name:       "DescribeScheduledActions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -950,6 +1328,27 @@ func (c *AutoScaling) DescribeScheduledActions(input *DescribeScheduledActionsIn
 	return
 }
 
+// DescribeScheduledActionsWithContext is the same as DescribeScheduledActions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DescribeScheduledActionsWithContext(ctx context.Context, input *DescribeScheduledActionsInput) (output *DescribeScheduledActionsOutput, err error) {
+	req, out := c.DescribeScheduledActionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeScheduledActionsPages iterates over the pages of a DescribeScheduledActions operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *AutoScaling) DescribeScheduledActionsPages(input *DescribeScheduledActionsInput, fn func(p *DescribeScheduledActionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeScheduledActionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeScheduledActionsOutput), lastPage)
+	})
+}
+
 var opDescribeScheduledActions *aws.Operation
 
 // DescribeTagsRequest generates a request for the DescribeTags operation.
@@ -962,6 +1361,12 @@ func (c *AutoScaling) DescribeTagsRequest(input *DescribeTagsInput) (req *aws.Re
 			Name:       "DescribeTags",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -992,6 +1397,27 @@ func (c *AutoScaling) DescribeTags(input *DescribeTagsInput) (output *DescribeTa
 	return
 }
 
+// DescribeTagsWithContext is the same as DescribeTags with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DescribeTagsWithContext(ctx context.Context, input *DescribeTagsInput) (output *DescribeTagsOutput, err error) {
+	req, out := c.DescribeTagsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeTagsPages iterates over the pages of a DescribeTags operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *AutoScaling) DescribeTagsPages(input *DescribeTagsInput, fn func(p *DescribeTagsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeTagsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeTagsOutput), lastPage)
+	})
+}
+
 var opDescribeTags *aws.Operation
 
 // DescribeTerminationPolicyTypesRequest generates a request for the DescribeTerminationPolicyTypes operation.
@@ -1025,6 +1451,17 @@ func (c *AutoScaling) DescribeTerminationPolicyTypes(input *DescribeTerminationP
 	return
 }
 
+// DescribeTerminationPolicyTypesWithContext is the same as DescribeTerminationPolicyTypes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DescribeTerminationPolicyTypesWithContext(ctx context.Context, input *DescribeTerminationPolicyTypesInput) (output *DescribeTerminationPolicyTypesOutput, err error) {
+	req, out := c.DescribeTerminationPolicyTypesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeTerminationPolicyTypes *aws.Operation
 
 // DetachInstancesRequest generates a request for the DetachInstances operation.
@@ -1064,6 +1501,17 @@ func (c *AutoScaling) DetachInstances(input *DetachInstancesInput) (output *Deta
 	return
 }
 
+// DetachInstancesWithContext is the same as DetachInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DetachInstancesWithContext(ctx context.Context, input *DetachInstancesInput) (output *DetachInstancesOutput, err error) {
+	req, out := c.DetachInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDetachInstances *aws.Operation
 
 // DisableMetricsCollectionRequest generates a request for the DisableMetricsCollection operation.
@@ -1098,6 +1546,17 @@ func (c *AutoScaling) DisableMetricsCollection(input *DisableMetricsCollectionIn
 	return
 }
 
+// DisableMetricsCollectionWithContext is the same as DisableMetricsCollection with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) DisableMetricsCollectionWithContext(ctx context.Context, input *DisableMetricsCollectionInput) (output *DisableMetricsCollectionOutput, err error) {
+	req, out := c.DisableMetricsCollectionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDisableMetricsCollection *aws.Operation
 
 // EnableMetricsCollectionRequest generates a request for the EnableMetricsCollection operation.
@@ -1135,6 +1594,17 @@ func (c *AutoScaling) EnableMetricsCollection(input *EnableMetricsCollectionInpu
 	return
 }
 
+// EnableMetricsCollectionWithContext is the same as EnableMetricsCollection with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) EnableMetricsCollectionWithContext(ctx context.Context, input *EnableMetricsCollectionInput) (output *EnableMetricsCollectionOutput, err error) {
+	req, out := c.EnableMetricsCollectionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEnableMetricsCollection *aws.Operation
 
 // EnterStandbyRequest generates a request for the EnterStandby operation.
@@ -1171,6 +1641,17 @@ func (c *AutoScaling) EnterStandby(input *EnterStandbyInput) (output *EnterStand
 	return
 }
 
+// EnterStandbyWithContext is the same as EnterStandby with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) EnterStandbyWithContext(ctx context.Context, input *EnterStandbyInput) (output *EnterStandbyOutput, err error) {
+	req, out := c.EnterStandbyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEnterStandby *aws.Operation
 
 // ExecutePolicyRequest generates a request for the ExecutePolicy operation.
@@ -1204,6 +1685,17 @@ func (c *AutoScaling) ExecutePolicy(input *ExecutePolicyInput) (output *ExecuteP
 	return
 }
 
+// ExecutePolicyWithContext is the same as ExecutePolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) ExecutePolicyWithContext(ctx context.Context, input *ExecutePolicyInput) (output *ExecutePolicyOutput, err error) {
+	req, out := c.ExecutePolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opExecutePolicy *aws.Operation
 
 // ExitStandbyRequest generates a request for the ExitStandby operation.
@@ -1240,6 +1732,17 @@ func (c *AutoScaling) ExitStandby(input *ExitStandbyInput) (output *ExitStandbyO
 	return
 }
 
+// ExitStandbyWithContext is the same as ExitStandby with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) ExitStandbyWithContext(ctx context.Context, input *ExitStandbyInput) (output *ExitStandbyOutput, err error) {
+	req, out := c.ExitStandbyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opExitStandby *aws.Operation
 
 // PutLifecycleHookRequest generates a request for the PutLifecycleHook operation.
@@ -1290,6 +1793,17 @@ func (c *AutoScaling) PutLifecycleHook(input *PutLifecycleHookInput) (output *Pu
 	return
 }
 
+// PutLifecycleHookWithContext is the same as PutLifecycleHook with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) PutLifecycleHookWithContext(ctx context.Context, input *PutLifecycleHookInput) (output *PutLifecycleHookOutput, err error) {
+	req, out := c.PutLifecycleHookRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutLifecycleHook *aws.Operation
 
 // PutNotificationConfigurationRequest generates a request for the PutNotificationConfiguration operation.
@@ -1331,6 +1845,17 @@ func (c *AutoScaling) PutNotificationConfiguration(input *PutNotificationConfigu
 	return
 }
 
+// PutNotificationConfigurationWithContext is the same as PutNotificationConfiguration with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) PutNotificationConfigurationWithContext(ctx context.Context, input *PutNotificationConfigurationInput) (output *PutNotificationConfigurationOutput, err error) {
+	req, out := c.PutNotificationConfigurationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutNotificationConfiguration *aws.Operation
 
 // PutScalingPolicyRequest generates a request for the PutScalingPolicy operation.
@@ -1367,6 +1892,17 @@ func (c *AutoScaling) PutScalingPolicy(input *PutScalingPolicyInput) (output *Pu
 	return
 }
 
+// PutScalingPolicyWithContext is the same as PutScalingPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) PutScalingPolicyWithContext(ctx context.Context, input *PutScalingPolicyInput) (output *PutScalingPolicyOutput, err error) {
+	req, out := c.PutScalingPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutScalingPolicy *aws.Operation
 
 // PutScheduledUpdateGroupActionRequest generates a request for the PutScheduledUpdateGroupAction operation.
@@ -1408,6 +1944,17 @@ func (c *AutoScaling) PutScheduledUpdateGroupAction(input *PutScheduledUpdateGro
 	return
 }
 
+// PutScheduledUpdateGroupActionWithContext is the same as PutScheduledUpdateGroupAction with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) PutScheduledUpdateGroupActionWithContext(ctx context.Context, input *PutScheduledUpdateGroupActionInput) (output *PutScheduledUpdateGroupActionOutput, err error) {
+	req, out := c.PutScheduledUpdateGroupActionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutScheduledUpdateGroupAction *aws.Operation
 
 // RecordLifecycleActionHeartbeatRequest generates a request for the RecordLifecycleActionHeartbeat operation.
@@ -1456,6 +2003,17 @@ func (c *AutoScaling) RecordLifecycleActionHeartbeat(input *RecordLifecycleActio
 	return
 }
 
+// RecordLifecycleActionHeartbeatWithContext is the same as RecordLifecycleActionHeartbeat with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) RecordLifecycleActionHeartbeatWithContext(ctx context.Context, input *RecordLifecycleActionHeartbeatInput) (output *RecordLifecycleActionHeartbeatOutput, err error) {
+	req, out := c.RecordLifecycleActionHeartbeatRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRecordLifecycleActionHeartbeat *aws.Operation
 
 // ResumeProcessesRequest generates a request for the ResumeProcesses operation.
@@ -1493,6 +2051,17 @@ func (c *AutoScaling) ResumeProcesses(input *ScalingProcessQuery) (output *Resum
 	return
 }
 
+// ResumeProcessesWithContext is the same as ResumeProcesses with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) ResumeProcessesWithContext(ctx context.Context, input *ScalingProcessQuery) (output *ResumeProcessesOutput, err error) {
+	req, out := c.ResumeProcessesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opResumeProcesses *aws.Operation
 
 // SetDesiredCapacityRequest generates a request for the SetDesiredCapacity operation.
@@ -1526,6 +2095,17 @@ func (c *AutoScaling) SetDesiredCapacity(input *SetDesiredCapacityInput) (output
 	return
 }
 
+// SetDesiredCapacityWithContext is the same as SetDesiredCapacity with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) SetDesiredCapacityWithContext(ctx context.Context, input *SetDesiredCapacityInput) (output *SetDesiredCapacityOutput, err error) {
+	req, out := c.SetDesiredCapacityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetDesiredCapacity *aws.Operation
 
 // SetInstanceHealthRequest generates a request for the SetInstanceHealth operation.
@@ -1562,6 +2142,17 @@ func (c *AutoScaling) SetInstanceHealth(input *SetInstanceHealthInput) (output *
 	return
 }
 
+// SetInstanceHealthWithContext is the same as SetInstanceHealth with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) SetInstanceHealthWithContext(ctx context.Context, input *SetInstanceHealthInput) (output *SetInstanceHealthOutput, err error) {
+	req, out := c.SetInstanceHealthRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetInstanceHealth *aws.Operation
 
 // SuspendProcessesRequest generates a request for the SuspendProcesses operation.
@@ -1605,6 +2196,17 @@ func (c *AutoScaling) SuspendProcesses(input *ScalingProcessQuery) (output *Susp
 	return
 }
 
+// SuspendProcessesWithContext is the same as SuspendProcesses with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) SuspendProcessesWithContext(ctx context.Context, input *ScalingProcessQuery) (output *SuspendProcessesOutput, err error) {
+	req, out := c.SuspendProcessesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSuspendProcesses *aws.Operation
 
 // TerminateInstanceInAutoScalingGroupRequest generates a request for the TerminateInstanceInAutoScalingGroup operation.
@@ -1642,6 +2244,17 @@ func (c *AutoScaling) TerminateInstanceInAutoScalingGroup(input *TerminateInstan
 	return
 }
 
+// TerminateInstanceInAutoScalingGroupWithContext is the same as TerminateInstanceInAutoScalingGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) TerminateInstanceInAutoScalingGroupWithContext(ctx context.Context, input *TerminateInstanceInAutoScalingGroupInput) (output *TerminateInstanceInAutoScalingGroupOutput, err error) {
+	req, out := c.TerminateInstanceInAutoScalingGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opTerminateInstanceInAutoScalingGroup *aws.Operation
 
 // UpdateAutoScalingGroupRequest generates a request for the UpdateAutoScalingGroup operation.
@@ -1698,6 +2311,17 @@ func (c *AutoScaling) UpdateAutoScalingGroup(input *UpdateAutoScalingGroupInput)
 	return
 }
 
+// UpdateAutoScalingGroupWithContext is the same as UpdateAutoScalingGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *AutoScaling) UpdateAutoScalingGroupWithContext(ctx context.Context, input *UpdateAutoScalingGroupInput) (output *UpdateAutoScalingGroupOutput, err error) {
+	req, out := c.UpdateAutoScalingGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateAutoScalingGroup *aws.Operation
 
 // Describes a long-running process that represents a change to your Auto Scaling
@@ -1708,10 +2332,10 @@ type Activity struct {
 	ActivityID *string `locationName:"ActivityId" type:"string" required:"true"`
 
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The reason the activity was begun.
-	Cause *string `type:"string" required:"true"`
+	Cause *string `type:"string" min:"1" max:"1023" required:"true"`
 
 	// A friendly, more verbose description of the scaling activity.
 	Description *string `type:"string"`
@@ -1729,10 +2353,10 @@ type Activity struct {
 	StartTime *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
 	// The current status of the activity.
-	StatusCode *string `type:"string" required:"true"`
+	StatusCode *string `type:"string" enum:"WaitingForSpotInstanceRequestId,WaitingForSpotInstanceId,WaitingForInstanceId,PreInService,InProgress,WaitingForELBConnectionDraining,MidLifecycleAction,Successful,Failed,Cancelled" required:"true"`
 
 	// A friendly, more verbose description of the activity status.
-	StatusMessage *string `type:"string"`
+	StatusMessage *string `type:"string" min:"1" max:"255"`
 
 	metadataActivity `json:"-", xml:"-"`
 }
@@ -1748,7 +2372,7 @@ type AdjustmentType struct {
 	//
 	// For more information, see Dynamic Scaling (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/as-scale-based-on-demand.html)
 	// in the Auto Scaling Developer Guide.
-	AdjustmentType *string `type:"string"`
+	AdjustmentType *string `type:"string" min:"1" max:"255"`
 
 	metadataAdjustmentType `json:"-", xml:"-"`
 }
@@ -1760,10 +2384,10 @@ type metadataAdjustmentType struct {
 // Describes an alarm.
 type Alarm struct {
 	// The Amazon Resource Name (ARN) of the alarm.
-	AlarmARN *string `type:"string"`
+	AlarmARN *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the alarm.
-	AlarmName *string `type:"string"`
+	AlarmName *string `type:"string" min:"1" max:"255"`
 
 	metadataAlarm `json:"-", xml:"-"`
 }
@@ -1774,7 +2398,7 @@ type metadataAlarm struct {
 
 type AttachInstancesInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more EC2 instance IDs. You must specify at least one ID.
 	InstanceIDs []*string `locationName:"InstanceIds" type:"list"`
@@ -1797,13 +2421,13 @@ type metadataAttachInstancesOutput struct {
 // Describes an Auto Scaling group.
 type AutoScalingGroup struct {
 	// The Amazon Resource Name (ARN) of the group.
-	AutoScalingGroupARN *string `type:"string"`
+	AutoScalingGroupARN *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// One or more Availability Zones for the group.
-	AvailabilityZones []*string `type:"list" required:"true"`
+	AvailabilityZones []*string `type:"list" min:"1" required:"true"`
 
 	// The date and time the group was created.
 	CreatedTime *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
@@ -1824,13 +2448,13 @@ type AutoScalingGroup struct {
 
 	// The service of interest for the health status check, which can be either
 	// EC2 for Amazon EC2 or ELB for Elastic Load Balancing.
-	HealthCheckType *string `type:"string" required:"true"`
+	HealthCheckType *string `type:"string" min:"1" max:"32" required:"true"`
 
 	// The EC2 instances associated with the group.
 	Instances []*Instance `type:"list"`
 
 	// The name of the associated launch configuration.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// One or more load balancers associated with the group.
 	LoadBalancerNames []*string `type:"list"`
@@ -1843,11 +2467,11 @@ type AutoScalingGroup struct {
 
 	// The name of the placement group into which you'll launch your instances,
 	// if any. For more information, see Placement Groups (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/placement-groups.html).
-	PlacementGroup *string `type:"string"`
+	PlacementGroup *string `type:"string" min:"1" max:"255"`
 
 	// The current state of the Auto Scaling group when a DeleteAutoScalingGroup
 	// action is in progress.
-	Status *string `type:"string"`
+	Status *string `type:"string" min:"1" max:"255"`
 
 	// The suspended processes associated with the group.
 	SuspendedProcesses []*SuspendedProcess `type:"list"`
@@ -1862,7 +2486,7 @@ type AutoScalingGroup struct {
 	//
 	// If you specify VPCZoneIdentifier and AvailabilityZones, ensure that the
 	// Availability Zones of the subnets match the values for AvailabilityZones.
-	VPCZoneIdentifier *string `type:"string"`
+	VPCZoneIdentifier *string `type:"string" min:"1" max:"255"`
 
 	metadataAutoScalingGroup `json:"-", xml:"-"`
 }
@@ -1874,26 +2498,26 @@ type metadataAutoScalingGroup struct {
 // Describes an EC2 instance associated with an Auto Scaling group.
 type AutoScalingInstanceDetails struct {
 	// The name of the Auto Scaling group associated with the instance.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The Availability Zone for the instance.
-	AvailabilityZone *string `type:"string" required:"true"`
+	AvailabilityZone *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The health status of this instance. "Healthy" means that the instance is
 	// healthy and should remain in service. "Unhealthy" means that the instance
 	// is unhealthy and Auto Scaling should terminate and replace it.
-	HealthStatus *string `type:"string" required:"true"`
+	HealthStatus *string `type:"string" min:"1" max:"32" required:"true"`
 
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" required:"true"`
 
 	// The launch configuration associated with the instance.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The lifecycle state for the instance. For more information, see Auto Scaling
 	// Instance States (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/AutoScalingGroupLifecycle.html#AutoScalingStates)
 	// in the Auto Scaling Developer Guide.
-	LifecycleState *string `type:"string" required:"true"`
+	LifecycleState *string `type:"string" min:"1" max:"32" required:"true"`
 
 	metadataAutoScalingInstanceDetails `json:"-", xml:"-"`
 }
@@ -1905,7 +2529,7 @@ type metadataAutoScalingInstanceDetails struct {
 // Describes a block device mapping.
 type BlockDeviceMapping struct {
 	// The device name exposed to the EC2 instance (for example, /dev/sdh or xvdh).
-	DeviceName *string `type:"string" required:"true"`
+	DeviceName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The information about the Amazon EBS volume.
 	EBS *EBS `locationName:"Ebs" type:"structure"`
@@ -1918,7 +2542,7 @@ type BlockDeviceMapping struct {
 	NoDevice *bool `type:"boolean"`
 
 	// The name of the virtual device, ephemeral0 to ephemeral3.
-	VirtualName *string `type:"string"`
+	VirtualName *string `type:"string" min:"1" max:"255"`
 
 	metadataBlockDeviceMapping `json:"-", xml:"-"`
 }
@@ -1929,7 +2553,7 @@ type metadataBlockDeviceMapping struct {
 
 type CompleteLifecycleActionInput struct {
 	// The name of the group for the lifecycle hook.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The action for the group to take. This parameter can be either CONTINUE or
 	// ABANDON.
@@ -1938,10 +2562,10 @@ type CompleteLifecycleActionInput struct {
 	// A universally unique identifier (UUID) that identifies a specific lifecycle
 	// action associated with an instance. Auto Scaling sends this token to the
 	// notification target you specified when you created the lifecycle hook.
-	LifecycleActionToken *string `type:"string" required:"true"`
+	LifecycleActionToken *string `type:"string" min:"36" max:"36" required:"true"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string" required:"true"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" pattern:"[A-Za-z0-9\\-_\\/]+" required:"true"`
 
 	metadataCompleteLifecycleActionInput `json:"-", xml:"-"`
 }
@@ -1961,11 +2585,11 @@ type metadataCompleteLifecycleActionOutput struct {
 type CreateAutoScalingGroupInput struct {
 	// The name of the group. This name must be unique within the scope of your
 	// AWS account.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// One or more Availability Zones for the group. This parameter is optional
 	// if you specify subnets using the VPCZoneIdentifier parameter.
-	AvailabilityZones []*string `type:"list"`
+	AvailabilityZones []*string `type:"list" min:"1"`
 
 	// The amount of time, in seconds, after a scaling activity completes before
 	// another scaling activity can start.
@@ -1998,7 +2622,7 @@ type CreateAutoScalingGroupInput struct {
 	//
 	// By default, health checks use Amazon EC2 instance status checks to determine
 	// the health of an instance. For more information, see Health Checks (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/healthcheck.html).
-	HealthCheckType *string `type:"string"`
+	HealthCheckType *string `type:"string" min:"1" max:"32"`
 
 	// The ID of the EC2 instance used to create a launch configuration for the
 	// group. Alternatively, use the LaunchConfigurationName parameter to specify
@@ -2012,11 +2636,11 @@ type CreateAutoScalingGroupInput struct {
 	// For more information, see Create an Auto Scaling Group Using an EC2 Instance
 	// ID (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/create-asg-from-instance.html)
 	// in the Auto Scaling Developer Guide.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16"`
 
 	// The name of the launch configuration. Alternatively, use the InstanceId parameter
 	// to specify an EC2 instance instead of a launch configuration.
-	LaunchConfigurationName *string `type:"string"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"1600"`
 
 	// One or more load balancers.
 	//
@@ -2032,7 +2656,7 @@ type CreateAutoScalingGroupInput struct {
 
 	// The name of the placement group into which you'll launch your instances,
 	// if any. For more information, see Placement Groups (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/placement-groups.html).
-	PlacementGroup *string `type:"string"`
+	PlacementGroup *string `type:"string" min:"1" max:"255"`
 
 	// The tag to be created or updated. Each tag should be defined by its resource
 	// type, resource ID, key, value, and a propagate flag. Valid values: key=value,
@@ -2059,7 +2683,7 @@ type CreateAutoScalingGroupInput struct {
 	//
 	// For more information, see Auto Scaling and Amazon VPC (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/autoscalingsubnets.html)
 	// in the Auto Scaling Developer Guide.
-	VPCZoneIdentifier *string `type:"string"`
+	VPCZoneIdentifier *string `type:"string" min:"1" max:"255"`
 
 	metadataCreateAutoScalingGroupInput `json:"-", xml:"-"`
 }
@@ -2100,7 +2724,7 @@ type CreateLaunchConfigurationInput struct {
 	// This parameter can only be used if you are launching EC2-Classic instances.
 	// For more information, see ClassicLink (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/vpc-classiclink.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	ClassicLinkVPCID *string `locationName:"ClassicLinkVPCId" type:"string"`
+	ClassicLinkVPCID *string `locationName:"ClassicLinkVPCId" type:"string" min:"1" max:"255"`
 
 	// The IDs of one or more security groups for the VPC specified in ClassicLinkVPCId.
 	// This parameter is required if ClassicLinkVPCId is specified, and cannot be
@@ -2126,12 +2750,12 @@ type CreateLaunchConfigurationInput struct {
 	// securely access other AWS resources. For more information, see Launch Auto
 	// Scaling Instances with an IAM Role (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/us-iam-role.html)
 	// in the Auto Scaling Developer Guide.
-	IAMInstanceProfile *string `locationName:"IamInstanceProfile" type:"string"`
+	IAMInstanceProfile *string `locationName:"IamInstanceProfile" type:"string" min:"1" max:"1600"`
 
 	// The ID of the Amazon Machine Image (AMI) to use to launch your EC2 instances.
 	// For more information, see Finding an AMI (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/finding-an-ami.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	ImageID *string `locationName:"ImageId" type:"string"`
+	ImageID *string `locationName:"ImageId" type:"string" min:"1" max:"255"`
 
 	// The ID of the EC2 instance to use to create the launch configuration.
 	//
@@ -2144,7 +2768,7 @@ type CreateLaunchConfigurationInput struct {
 	// For more information, see Create a Launch Configuration Using an EC2 Instance
 	// (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/create-lc-with-instanceID.html)
 	// in the Auto Scaling Developer Guide.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16"`
 
 	// Enables detailed monitoring if it is disabled. Detailed monitoring is enabled
 	// by default.
@@ -2159,19 +2783,19 @@ type CreateLaunchConfigurationInput struct {
 	// The instance type of the Amazon EC2 instance. For information about available
 	// Amazon EC2 instance types, see  Available Instance Types (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-types.html#AvailableInstanceTypes)
 	// in the Amazon Elastic Cloud Compute User Guide.
-	InstanceType *string `type:"string"`
+	InstanceType *string `type:"string" min:"1" max:"255"`
 
 	// The ID of the kernel associated with the Amazon EC2 AMI.
-	KernelID *string `locationName:"KernelId" type:"string"`
+	KernelID *string `locationName:"KernelId" type:"string" min:"1" max:"255"`
 
 	// The name of the key pair. For more information, see Amazon EC2 Key Pairs
 	// (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html) in
 	// the Amazon Elastic Compute Cloud User Guide.
-	KeyName *string `type:"string"`
+	KeyName *string `type:"string" min:"1" max:"255"`
 
 	// The name of the launch configuration. This name must be unique within the
 	// scope of your AWS account.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The tenancy of the instance. An instance with a tenancy of dedicated runs
 	// on single-tenant hardware and can only be launched in a VPC.
@@ -2187,10 +2811,10 @@ type CreateLaunchConfigurationInput struct {
 	// in the Auto Scaling Developer Guide.
 	//
 	// Valid values: default | dedicated
-	PlacementTenancy *string `type:"string"`
+	PlacementTenancy *string `type:"string" min:"1" max:"64"`
 
 	// The ID of the RAM disk associated with the Amazon EC2 AMI.
-	RAMDiskID *string `locationName:"RamdiskId" type:"string"`
+	RAMDiskID *string `locationName:"RamdiskId" type:"string" min:"1" max:"255"`
 
 	// One or more security groups with which to associate the instances.
 	//
@@ -2209,7 +2833,7 @@ type CreateLaunchConfigurationInput struct {
 	// the current Spot market price. For more information, see Launch Spot Instances
 	// in Your Auto Scaling Group (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/US-SpotInstances.html)
 	// in the Auto Scaling Developer Guide.
-	SpotPrice *string `type:"string"`
+	SpotPrice *string `type:"string" min:"1" max:"255"`
 
 	// The user data to make available to the launched EC2 instances. For more information,
 	// see Instance Metadata and User Data (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-instance-metadata.html)
@@ -2217,7 +2841,7 @@ type CreateLaunchConfigurationInput struct {
 	//
 	// At this time, launch configurations don't support compressed (zipped) user
 	// data files.
-	UserData *string `type:"string"`
+	UserData *string `type:"string" max:"21847"`
 
 	metadataCreateLaunchConfigurationInput `json:"-", xml:"-"`
 }
@@ -2269,7 +2893,7 @@ type metadataCreateOrUpdateTagsOutput struct {
 
 type DeleteAutoScalingGroupInput struct {
 	// The name of the group to delete.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// Specifies that the group will be deleted along with all instances associated
 	// with the group, without waiting for all instances to be terminated. This
@@ -2293,7 +2917,7 @@ type metadataDeleteAutoScalingGroupOutput struct {
 
 type DeleteLaunchConfigurationInput struct {
 	// The name of the launch configuration.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataDeleteLaunchConfigurationInput `json:"-", xml:"-"`
 }
@@ -2312,10 +2936,10 @@ type metadataDeleteLaunchConfigurationOutput struct {
 
 type DeleteLifecycleHookInput struct {
 	// The name of the Auto Scaling group for the lifecycle hook.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string" required:"true"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" pattern:"[A-Za-z0-9\\-_\\/]+" required:"true"`
 
 	metadataDeleteLifecycleHookInput `json:"-", xml:"-"`
 }
@@ -2334,11 +2958,11 @@ type metadataDeleteLifecycleHookOutput struct {
 
 type DeleteNotificationConfigurationInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the Amazon Simple Notification Service
 	// (SNS) topic.
-	TopicARN *string `type:"string" required:"true"`
+	TopicARN *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataDeleteNotificationConfigurationInput `json:"-", xml:"-"`
 }
@@ -2357,10 +2981,10 @@ type metadataDeleteNotificationConfigurationOutput struct {
 
 type DeletePolicyInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The name or Amazon Resource Name (ARN) of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataDeletePolicyInput `json:"-", xml:"-"`
 }
@@ -2379,10 +3003,10 @@ type metadataDeletePolicyOutput struct {
 
 type DeleteScheduledActionInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the action to delete.
-	ScheduledActionName *string `type:"string" required:"true"`
+	ScheduledActionName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataDeleteScheduledActionInput `json:"-", xml:"-"`
 }
@@ -2619,7 +3243,7 @@ type metadataDescribeLifecycleHookTypesOutput struct {
 
 type DescribeLifecycleHooksInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The names of one or more lifecycle hooks.
 	LifecycleHookNames []*string `type:"list"`
@@ -2718,7 +3342,7 @@ type metadataDescribeNotificationConfigurationsOutput struct {
 
 type DescribePoliciesInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The maximum number of items to be returned with each call.
 	MaxRecords *int64 `type:"integer"`
@@ -2764,7 +3388,7 @@ type DescribeScalingActivitiesInput struct {
 	ActivityIDs []*string `locationName:"ActivityIds" type:"list"`
 
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The maximum number of items to return with this call.
 	MaxRecords *int64 `type:"integer"`
@@ -2816,7 +3440,7 @@ type metadataDescribeScalingProcessTypesOutput struct {
 
 type DescribeScheduledActionsInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The latest scheduled start time to return. If scheduled action names are
 	// provided, this parameter is ignored.
@@ -2923,7 +3547,7 @@ type metadataDescribeTerminationPolicyTypesOutput struct {
 
 type DetachInstancesInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more instance IDs.
 	InstanceIDs []*string `locationName:"InstanceIds" type:"list"`
@@ -2952,7 +3576,7 @@ type metadataDetachInstancesOutput struct {
 
 type DisableMetricsCollectionInput struct {
 	// The name or Amazon Resource Name (ARN) of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more of the following metrics:
 	//
@@ -3003,10 +3627,10 @@ type EBS struct {
 	// Valid values: Range is 100 to 4000.
 	//
 	// Default: None
-	IOPS *int64 `locationName:"Iops" type:"integer"`
+	IOPS *int64 `locationName:"Iops" type:"integer" min:"100" max:"30000"`
 
 	// The ID of the snapshot.
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" min:"1" max:"255"`
 
 	// The volume size, in gigabytes.
 	//
@@ -3018,14 +3642,14 @@ type EBS struct {
 	// volume size, the default is the size of the snapshot.
 	//
 	// Required: Required when the volume type is io1.
-	VolumeSize *int64 `type:"integer"`
+	VolumeSize *int64 `type:"integer" min:"1" max:"16384"`
 
 	// The volume type.
 	//
 	// Valid values: standard | io1 | gp2
 	//
 	// Default: standard
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" min:"1" max:"255"`
 
 	metadataEBS `json:"-", xml:"-"`
 }
@@ -3036,11 +3660,11 @@ type metadataEBS struct {
 
 type EnableMetricsCollectionInput struct {
 	// The name or ARN of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The granularity to associate with the metrics to collect. Currently, the
 	// only valid value is "1Minute".
-	Granularity *string `type:"string" required:"true"`
+	Granularity *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// One or more of the following metrics:
 	//
@@ -3084,10 +3708,10 @@ type metadataEnableMetricsCollectionOutput struct {
 // Describes an enabled metric.
 type EnabledMetric struct {
 	// The granularity of the metric.
-	Granularity *string `type:"string"`
+	Granularity *string `type:"string" min:"1" max:"255"`
 
 	// The name of the metric.
-	Metric *string `type:"string"`
+	Metric *string `type:"string" min:"1" max:"255"`
 
 	metadataEnabledMetric `json:"-", xml:"-"`
 }
@@ -3098,7 +3722,7 @@ type metadataEnabledMetric struct {
 
 type EnterStandbyInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more instances to move into Standby mode. You must specify at least
 	// one instance ID.
@@ -3130,7 +3754,7 @@ type metadataEnterStandbyOutput struct {
 
 type ExecutePolicyInput struct {
 	// The name or Amazon Resource Name (ARN) of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// Set to True if you want Auto Scaling to wait for the cooldown period associated
 	// with the Auto Scaling group to complete before executing the policy.
@@ -3144,7 +3768,7 @@ type ExecutePolicyInput struct {
 	HonorCooldown *bool `type:"boolean"`
 
 	// The name or ARN of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataExecutePolicyInput `json:"-", xml:"-"`
 }
@@ -3163,7 +3787,7 @@ type metadataExecutePolicyOutput struct {
 
 type ExitStandbyInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more instance IDs. You must specify at least one instance ID.
 	InstanceIDs []*string `locationName:"InstanceIds" type:"list"`
@@ -3205,21 +3829,21 @@ type metadataFilter struct {
 // Describes an EC2 instance.
 type Instance struct {
 	// The Availability Zone associated with this instance.
-	AvailabilityZone *string `type:"string" required:"true"`
+	AvailabilityZone *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The health status of the instance.
-	HealthStatus *string `type:"string" required:"true"`
+	HealthStatus *string `type:"string" min:"1" max:"32" required:"true"`
 
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" required:"true"`
 
 	// The launch configuration associated with the instance.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// A description of the current lifecycle state.
 	//
 	//  The Quarantined lifecycle state is not used.
-	LifecycleState *string `type:"string" required:"true"`
+	LifecycleState *string `type:"string" enum:"Pending,Pending:Wait,Pending:Proceed,Quarantined,InService,Terminating,Terminating:Wait,Terminating:Proceed,Terminated,Detaching,Detached,EnteringStandby,Standby" required:"true"`
 
 	metadataInstance `json:"-", xml:"-"`
 }
@@ -3254,7 +3878,7 @@ type LaunchConfiguration struct {
 	// This parameter can only be used if you are launching EC2-Classic instances.
 	// For more information, see ClassicLink (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/vpc-classiclink.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	ClassicLinkVPCID *string `locationName:"ClassicLinkVPCId" type:"string"`
+	ClassicLinkVPCID *string `locationName:"ClassicLinkVPCId" type:"string" min:"1" max:"255"`
 
 	// The IDs of one or more security groups for the VPC specified in ClassicLinkVPCId.
 	// This parameter is required if ClassicLinkVPCId is specified, and cannot be
@@ -3270,45 +3894,45 @@ type LaunchConfiguration struct {
 
 	// The name or Amazon Resource Name (ARN) of the instance profile associated
 	// with the IAM role for the instance.
-	IAMInstanceProfile *string `locationName:"IamInstanceProfile" type:"string"`
+	IAMInstanceProfile *string `locationName:"IamInstanceProfile" type:"string" min:"1" max:"1600"`
 
 	// The ID of the Amazon Machine Image (AMI).
-	ImageID *string `locationName:"ImageId" type:"string" required:"true"`
+	ImageID *string `locationName:"ImageId" type:"string" min:"1" max:"255" required:"true"`
 
 	// Controls whether instances in this group are launched with detailed monitoring.
 	InstanceMonitoring *InstanceMonitoring `type:"structure"`
 
 	// The instance type for the EC2 instances.
-	InstanceType *string `type:"string" required:"true"`
+	InstanceType *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The ID of the kernel associated with the AMI.
-	KernelID *string `locationName:"KernelId" type:"string"`
+	KernelID *string `locationName:"KernelId" type:"string" min:"1" max:"255"`
 
 	// The name of the key pair.
-	KeyName *string `type:"string"`
+	KeyName *string `type:"string" min:"1" max:"255"`
 
 	// The Amazon Resource Name (ARN) of the launch configuration.
-	LaunchConfigurationARN *string `type:"string"`
+	LaunchConfigurationARN *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the launch configuration.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The tenancy of the instance, either default or dedicated. An instance with
 	// dedicated tenancy runs in an isolated, single-tenant hardware and can only
 	// be launched in a VPC.
-	PlacementTenancy *string `type:"string"`
+	PlacementTenancy *string `type:"string" min:"1" max:"64"`
 
 	// The ID of the RAM disk associated with the AMI.
-	RAMDiskID *string `locationName:"RamdiskId" type:"string"`
+	RAMDiskID *string `locationName:"RamdiskId" type:"string" min:"1" max:"255"`
 
 	// The security groups to associate with the EC2 instances.
 	SecurityGroups []*string `type:"list"`
 
 	// The price to bid when launching Spot Instances.
-	SpotPrice *string `type:"string"`
+	SpotPrice *string `type:"string" min:"1" max:"255"`
 
 	// The user data available to the EC2 instances.
-	UserData *string `type:"string"`
+	UserData *string `type:"string" max:"21847"`
 
 	metadataLaunchConfiguration `json:"-", xml:"-"`
 }
@@ -3328,7 +3952,7 @@ type metadataLaunchConfiguration struct {
 // in the Auto Scaling Developer Guide.
 type LifecycleHook struct {
 	// The name of the Auto Scaling group for the lifecycle hook.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// Defines the action the Auto Scaling group should take when the lifecycle
 	// hook timeout elapses or if an unexpected failure occurs. The valid values
@@ -3346,7 +3970,7 @@ type LifecycleHook struct {
 	HeartbeatTimeout *int64 `type:"integer"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" pattern:"[A-Za-z0-9\\-_\\/]+"`
 
 	// The state of the EC2 instance to which you want to attach the lifecycle hook.
 	// For a list of lifecycle hook types, see DescribeLifecycleHooks.
@@ -3354,7 +3978,7 @@ type LifecycleHook struct {
 
 	// Additional information that you want to include any time Auto Scaling sends
 	// a message to the notification target.
-	NotificationMetadata *string `type:"string"`
+	NotificationMetadata *string `type:"string" min:"1" max:"1023"`
 
 	// The ARN of the notification target that Auto Scaling uses to notify you when
 	// an instance is in the transition state for the lifecycle hook. This ARN target
@@ -3363,11 +3987,11 @@ type LifecycleHook struct {
 	//
 	//  Lifecycle action token User account ID Name of the Auto Scaling group Lifecycle
 	// hook name EC2 instance ID Lifecycle transition Notification metadata
-	NotificationTargetARN *string `type:"string"`
+	NotificationTargetARN *string `type:"string" min:"1" max:"1600"`
 
 	// The ARN of the IAM role that allows the Auto Scaling group to publish to
 	// the specified notification target.
-	RoleARN *string `type:"string"`
+	RoleARN *string `type:"string" min:"1" max:"1600"`
 
 	metadataLifecycleHook `json:"-", xml:"-"`
 }
@@ -3379,7 +4003,7 @@ type metadataLifecycleHook struct {
 // Describes a metric.
 type MetricCollectionType struct {
 	// The metric.
-	Metric *string `type:"string"`
+	Metric *string `type:"string" min:"1" max:"255"`
 
 	metadataMetricCollectionType `json:"-", xml:"-"`
 }
@@ -3391,7 +4015,7 @@ type metadataMetricCollectionType struct {
 // Describes a granularity of a metric.
 type MetricGranularityType struct {
 	// The granularity.
-	Granularity *string `type:"string"`
+	Granularity *string `type:"string" min:"1" max:"255"`
 
 	metadataMetricGranularityType `json:"-", xml:"-"`
 }
@@ -3403,14 +4027,14 @@ type metadataMetricGranularityType struct {
 // Describes a notification.
 type NotificationConfiguration struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The types of events for an action to start.
-	NotificationType *string `type:"string"`
+	NotificationType *string `type:"string" min:"1" max:"255"`
 
 	// The Amazon Resource Name (ARN) of the Amazon Simple Notification Service
 	// (SNS) topic.
-	TopicARN *string `type:"string"`
+	TopicARN *string `type:"string" min:"1" max:"1600"`
 
 	metadataNotificationConfiguration `json:"-", xml:"-"`
 }
@@ -3493,7 +4117,7 @@ type metadataNotificationConfiguration struct {
 // or Terminate, your scheduled actions might not function as expected.
 type ProcessType struct {
 	// The name of the process.
-	ProcessName *string `type:"string" required:"true"`
+	ProcessName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	metadataProcessType `json:"-", xml:"-"`
 }
@@ -3505,7 +4129,7 @@ type metadataProcessType struct {
 type PutLifecycleHookInput struct {
 	// The name of the Auto Scaling group to which you want to assign the lifecycle
 	// hook.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// Defines the action the Auto Scaling group should take when the lifecycle
 	// hook timeout elapses or if an unexpected failure occurs. The value for this
@@ -3521,7 +4145,7 @@ type PutLifecycleHookInput struct {
 	HeartbeatTimeout *int64 `type:"integer"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string" required:"true"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" pattern:"[A-Za-z0-9\\-_\\/]+" required:"true"`
 
 	// The Amazon EC2 instance state to which you want to attach the lifecycle hook.
 	// See DescribeLifecycleHookTypes for a list of available lifecycle hook types.
@@ -3532,7 +4156,7 @@ type PutLifecycleHookInput struct {
 
 	// Contains additional information that you want to include any time Auto Scaling
 	// sends a message to the notification target.
-	NotificationMetadata *string `type:"string"`
+	NotificationMetadata *string `type:"string" min:"1" max:"1023"`
 
 	// The ARN of the notification target that Auto Scaling will use to notify you
 	// when an instance is in the transition state for the lifecycle hook. This
@@ -3553,14 +4177,14 @@ type PutLifecycleHookInput struct {
 	//
 	// When you call this operation, a test message is sent to the notification
 	// target. This test message contains an additional key/value pair: Event:autoscaling:TEST_NOTIFICATION.
-	NotificationTargetARN *string `type:"string"`
+	NotificationTargetARN *string `type:"string" min:"1" max:"1600"`
 
 	// The ARN of the IAM role that allows the Auto Scaling group to publish to
 	// the specified notification target.
 	//
 	//  This parameter is required for new lifecycle hooks, but optional when updating
 	// existing hooks.
-	RoleARN *string `type:"string"`
+	RoleARN *string `type:"string" min:"1" max:"1600"`
 
 	metadataPutLifecycleHookInput `json:"-", xml:"-"`
 }
@@ -3579,7 +4203,7 @@ type metadataPutLifecycleHookOutput struct {
 
 type PutNotificationConfigurationInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The type of event that will cause the notification to be sent. For details
 	// about notification types supported by Auto Scaling, see DescribeAutoScalingNotificationTypes.
@@ -3587,7 +4211,7 @@ type PutNotificationConfigurationInput struct {
 
 	// The Amazon Resource Name (ARN) of the Amazon Simple Notification Service
 	// (SNS) topic.
-	TopicARN *string `type:"string" required:"true"`
+	TopicARN *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataPutNotificationConfigurationInput `json:"-", xml:"-"`
 }
@@ -3611,10 +4235,10 @@ type PutScalingPolicyInput struct {
 	//
 	// For more information, see Dynamic Scaling (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/as-scale-based-on-demand.html)
 	// in the Auto Scaling Developer Guide.
-	AdjustmentType *string `type:"string" required:"true"`
+	AdjustmentType *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The name or ARN of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The amount of time, in seconds, after a scaling activity completes and before
 	// the next scaling activity can start.
@@ -3632,7 +4256,7 @@ type PutScalingPolicyInput struct {
 	MinAdjustmentStep *int64 `type:"integer"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The number of instances by which to scale. AdjustmentType determines the
 	// interpretation of this number (e.g., as an absolute number or as a percentage
@@ -3649,7 +4273,7 @@ type metadataPutScalingPolicyInput struct {
 
 type PutScalingPolicyOutput struct {
 	// The Amazon Resource Name (ARN) of the policy.
-	PolicyARN *string `type:"string"`
+	PolicyARN *string `type:"string" min:"1" max:"1600"`
 
 	metadataPutScalingPolicyOutput `json:"-", xml:"-"`
 }
@@ -3660,7 +4284,7 @@ type metadataPutScalingPolicyOutput struct {
 
 type PutScheduledUpdateGroupActionInput struct {
 	// The name or Amazon Resource Name (ARN) of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The number of Amazon EC2 instances that should be running in the group.
 	DesiredCapacity *int64 `type:"integer"`
@@ -3680,10 +4304,10 @@ type PutScheduledUpdateGroupActionInput struct {
 	//
 	// When StartTime and EndTime are specified with Recurrence, they form the
 	// boundaries of when the recurring action will start and stop.
-	Recurrence *string `type:"string"`
+	Recurrence *string `type:"string" min:"1" max:"255"`
 
 	// The name of this scaling action.
-	ScheduledActionName *string `type:"string" required:"true"`
+	ScheduledActionName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The time for this action to start, as in --start-time 2010-06-01T00:00:00Z.
 	//
@@ -3719,15 +4343,15 @@ type metadataPutScheduledUpdateGroupActionOutput struct {
 
 type RecordLifecycleActionHeartbeatInput struct {
 	// The name of the Auto Scaling group for the hook.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// A token that uniquely identifies a specific lifecycle action associated with
 	// an instance. Auto Scaling sends this token to the notification target you
 	// specified when you created the lifecycle hook.
-	LifecycleActionToken *string `type:"string" required:"true"`
+	LifecycleActionToken *string `type:"string" min:"36" max:"36" required:"true"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string" required:"true"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" pattern:"[A-Za-z0-9\\-_\\/]+" required:"true"`
 
 	metadataRecordLifecycleActionHeartbeatInput `json:"-", xml:"-"`
 }
@@ -3757,13 +4381,13 @@ type ScalingPolicy struct {
 	// Specifies whether the ScalingAdjustment is an absolute number or a percentage
 	// of the current capacity. Valid values are ChangeInCapacity, ExactCapacity,
 	// and PercentChangeInCapacity.
-	AdjustmentType *string `type:"string"`
+	AdjustmentType *string `type:"string" min:"1" max:"255"`
 
 	// The CloudWatch Alarms related to the policy.
 	Alarms []*Alarm `type:"list"`
 
 	// The name of the Auto Scaling group associated with this scaling policy.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255"`
 
 	// The amount of time, in seconds, after a scaling activity completes before
 	// any further trigger-related scaling activities can start.
@@ -3774,10 +4398,10 @@ type ScalingPolicy struct {
 	MinAdjustmentStep *int64 `type:"integer"`
 
 	// The Amazon Resource Name (ARN) of the policy.
-	PolicyARN *string `type:"string"`
+	PolicyARN *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the scaling policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"255"`
 
 	// The number associated with the specified adjustment type. A positive value
 	// adds to the current capacity and a negative value removes from the current
@@ -3793,7 +4417,7 @@ type metadataScalingPolicy struct {
 
 type ScalingProcessQuery struct {
 	// The name or Amazon Resource Name (ARN) of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more of the following processes:
 	//
@@ -3811,7 +4435,7 @@ type metadataScalingProcessQuery struct {
 // Describes a scheduled update to an Auto Scaling group.
 type ScheduledUpdateGroupAction struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255"`
 
 	// The number of instances you prefer to maintain in the group.
 	DesiredCapacity *int64 `type:"integer"`
@@ -3827,13 +4451,13 @@ type ScheduledUpdateGroupAction struct {
 	MinSize *int64 `type:"integer"`
 
 	// The regular schedule that an action occurs.
-	Recurrence *string `type:"string"`
+	Recurrence *string `type:"string" min:"1" max:"255"`
 
 	// The Amazon Resource Name (ARN) of the scheduled action.
-	ScheduledActionARN *string `type:"string"`
+	ScheduledActionARN *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the scheduled action.
-	ScheduledActionName *string `type:"string"`
+	ScheduledActionName *string `type:"string" min:"1" max:"255"`
 
 	// The time that the action is scheduled to begin. This value can be up to one
 	// month in the future.
@@ -3856,7 +4480,7 @@ type metadataScheduledUpdateGroupAction struct {
 
 type SetDesiredCapacityInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The number of EC2 instances that should be running in the Auto Scaling group.
 	DesiredCapacity *int64 `type:"integer" required:"true"`
@@ -3886,10 +4510,10 @@ type SetInstanceHealthInput struct {
 	// The health status of the instance. Set to Healthy if you want the instance
 	// to remain in service. Set to Unhealthy if you want the instance to be out
 	// of service. Auto Scaling will terminate and replace the unhealthy instance.
-	HealthStatus *string `type:"string" required:"true"`
+	HealthStatus *string `type:"string" min:"1" max:"32" required:"true"`
 
 	// The ID of the EC2 instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" required:"true"`
 
 	// If the Auto Scaling group of the specified instance has a HealthCheckGracePeriod
 	// specified for the group, by default, this call will respect the grace period.
@@ -3927,10 +4551,10 @@ type metadataSuspendProcessesOutput struct {
 // see ProcessType.
 type SuspendedProcess struct {
 	// The name of the suspended process.
-	ProcessName *string `type:"string"`
+	ProcessName *string `type:"string" min:"1" max:"255"`
 
 	// The reason that the process was suspended.
-	SuspensionReason *string `type:"string"`
+	SuspensionReason *string `type:"string" min:"1" max:"255"`
 
 	metadataSuspendedProcess `json:"-", xml:"-"`
 }
@@ -3942,7 +4566,7 @@ type metadataSuspendedProcess struct {
 // Describes a tag applied to an Auto Scaling group.
 type Tag struct {
 	// The tag key.
-	Key *string `type:"string" required:"true"`
+	Key *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// Specifies whether the tag is applied to instances launched after the tag
 	// is created. The same behavior applies to updates: If you change a tag, it
@@ -3957,7 +4581,7 @@ type Tag struct {
 	ResourceType *string `type:"string"`
 
 	// The tag value.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"0" max:"256"`
 
 	metadataTag `json:"-", xml:"-"`
 }
@@ -3969,7 +4593,7 @@ type metadataTag struct {
 // Describes a tag applied to an Auto Scaling group.
 type TagDescription struct {
 	// The tag key.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"128"`
 
 	// Specifies whether the tag is applied to instances launched after the tag
 	// is created. The same behavior applies to updates: If you change a tag, it
@@ -3984,7 +4608,7 @@ type TagDescription struct {
 	ResourceType *string `type:"string"`
 
 	// The tag value.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"0" max:"256"`
 
 	metadataTagDescription `json:"-", xml:"-"`
 }
@@ -3995,7 +4619,7 @@ type metadataTagDescription struct {
 
 type TerminateInstanceInAutoScalingGroupInput struct {
 	// The ID of the EC2 instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" required:"true"`
 
 	// If true, terminating this instance also decrements the size of the Auto Scaling
 	// group.
@@ -4021,10 +4645,10 @@ type metadataTerminateInstanceInAutoScalingGroupOutput struct {
 
 type UpdateAutoScalingGroupInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more Availability Zones for the group.
-	AvailabilityZones []*string `type:"list"`
+	AvailabilityZones []*string `type:"list" min:"1"`
 
 	// The amount of time, in seconds, after a scaling activity completes before
 	// another scaling activity can start. For more information, see Understanding
@@ -4045,10 +4669,10 @@ type UpdateAutoScalingGroupInput struct {
 	// The type of health check for the instances in the Auto Scaling group. The
 	// health check type can either be EC2 for Amazon EC2 or ELB for Elastic Load
 	// Balancing.
-	HealthCheckType *string `type:"string"`
+	HealthCheckType *string `type:"string" min:"1" max:"32"`
 
 	// The name of the launch configuration.
-	LaunchConfigurationName *string `type:"string"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"1600"`
 
 	// The maximum size of the Auto Scaling group.
 	MaxSize *int64 `type:"integer"`
@@ -4058,7 +4682,7 @@ type UpdateAutoScalingGroupInput struct {
 
 	// The name of the placement group into which you'll launch your instances,
 	// if any. For more information, see Placement Groups (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/placement-groups.html).
-	PlacementGroup *string `type:"string"`
+	PlacementGroup *string `type:"string" min:"1" max:"255"`
 
 	// A standalone termination policy or a list of termination policies used to
 	// select the instance to terminate. The policies are executed in the order
@@ -4077,7 +4701,7 @@ type UpdateAutoScalingGroupInput struct {
 	//
 	//  For more information, see Auto Scaling and Amazon VPC (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/autoscalingsubnets.html)
 	// in the Auto Scaling Developer Guide.
-	VPCZoneIdentifier *string `type:"string"`
+	VPCZoneIdentifier *string `type:"string" min:"1" max:"255"`
 
 	metadataUpdateAutoScalingGroupInput `json:"-", xml:"-"`
 }
@@ -4092,4 +4716,70 @@ type UpdateAutoScalingGroupOutput struct {
 
 type metadataUpdateAutoScalingGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for LifecycleState.
+const (
+	LifecycleStatePending            = "Pending"
+	LifecycleStatePendingWait        = "Pending:Wait"
+	LifecycleStatePendingProceed     = "Pending:Proceed"
+	LifecycleStateQuarantined        = "Quarantined"
+	LifecycleStateInService          = "InService"
+	LifecycleStateTerminating        = "Terminating"
+	LifecycleStateTerminatingWait    = "Terminating:Wait"
+	LifecycleStateTerminatingProceed = "Terminating:Proceed"
+	LifecycleStateTerminated         = "Terminated"
+	LifecycleStateDetaching          = "Detaching"
+	LifecycleStateDetached           = "Detached"
+	LifecycleStateEnteringStandby    = "EnteringStandby"
+	LifecycleStateStandby            = "Standby"
+)
+
+// LifecycleStateValues returns the valid values of LifecycleState.
+func LifecycleStateValues() []string {
+	return []string{
+		LifecycleStatePending,
+		LifecycleStatePendingWait,
+		LifecycleStatePendingProceed,
+		LifecycleStateQuarantined,
+		LifecycleStateInService,
+		LifecycleStateTerminating,
+		LifecycleStateTerminatingWait,
+		LifecycleStateTerminatingProceed,
+		LifecycleStateTerminated,
+		LifecycleStateDetaching,
+		LifecycleStateDetached,
+		LifecycleStateEnteringStandby,
+		LifecycleStateStandby,
+	}
+}
+
+// Enum values for ScalingActivityStatusCode.
+const (
+	ScalingActivityStatusCodeWaitingForSpotInstanceRequestId = "WaitingForSpotInstanceRequestId"
+	ScalingActivityStatusCodeWaitingForSpotInstanceId        = "WaitingForSpotInstanceId"
+	ScalingActivityStatusCodeWaitingForInstanceId            = "WaitingForInstanceId"
+	ScalingActivityStatusCodePreInService                    = "PreInService"
+	ScalingActivityStatusCodeInProgress                      = "InProgress"
+	ScalingActivityStatusCodeWaitingForELBConnectionDraining = "WaitingForELBConnectionDraining"
+	ScalingActivityStatusCodeMidLifecycleAction              = "MidLifecycleAction"
+	ScalingActivityStatusCodeSuccessful                      = "Successful"
+	ScalingActivityStatusCodeFailed                          = "Failed"
+	ScalingActivityStatusCodeCancelled                       = "Cancelled"
+)
+
+// ScalingActivityStatusCodeValues returns the valid values of ScalingActivityStatusCode.
+func ScalingActivityStatusCodeValues() []string {
+	return []string{
+		ScalingActivityStatusCodeWaitingForSpotInstanceRequestId,
+		ScalingActivityStatusCodeWaitingForSpotInstanceId,
+		ScalingActivityStatusCodeWaitingForInstanceId,
+		ScalingActivityStatusCodePreInService,
+		ScalingActivityStatusCodeInProgress,
+		ScalingActivityStatusCodeWaitingForELBConnectionDraining,
+		ScalingActivityStatusCodeMidLifecycleAction,
+		ScalingActivityStatusCodeSuccessful,
+		ScalingActivityStatusCodeFailed,
+		ScalingActivityStatusCodeCancelled,
+	}
 }
\ No newline at end of file
@@ -27,6 +27,7 @@ func New(config *aws.Config) *AutoScaling {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "autoscaling",
 		APIVersion:  "2011-01-01",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package cloudformation
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -46,6 +47,17 @@ func (c *CloudFormation) CancelUpdateStack(input *CancelUpdateStackInput) (outpu
 	return
 }
 
+// CancelUpdateStackWithContext is the same as CancelUpdateStack with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) CancelUpdateStackWithContext(ctx context.Context, input *CancelUpdateStackInput) (output *CancelUpdateStackOutput, err error) {
+	req, out := c.CancelUpdateStackRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCancelUpdateStack *aws.Operation
 
 // CreateStackRequest generates a request for the CreateStack operation.
@@ -81,6 +93,17 @@ func (c *CloudFormation) CreateStack(input *CreateStackInput) (output *CreateSta
 	return
 }
 
+// CreateStackWithContext is the same as CreateStack with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) CreateStackWithContext(ctx context.Context, input *CreateStackInput) (output *CreateStackOutput, err error) {
+	req, out := c.CreateStackRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateStack *aws.Operation
 
 // DeleteStackRequest generates a request for the DeleteStack operation.
@@ -116,6 +139,17 @@ func (c *CloudFormation) DeleteStack(input *DeleteStackInput) (output *DeleteSta
 	return
 }
 
+// DeleteStackWithContext is the same as DeleteStack with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) DeleteStackWithContext(ctx context.Context, input *DeleteStackInput) (output *DeleteStackOutput, err error) {
+	req, out := c.DeleteStackRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteStack *aws.Operation
 
 // DescribeStackEventsRequest generates a request for the DescribeStackEvents operation.
@@ -128,6 +162,12 @@ func (c *CloudFormation) DescribeStackEventsRequest(input *DescribeStackEventsIn
 			Name:       "DescribeStackEvents",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -154,6 +194,27 @@ func (c *CloudFormation) DescribeStackEvents(input *DescribeStackEventsInput) (o
 	return
 }
 
+// DescribeStackEventsWithContext is the same as DescribeStackEvents with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) DescribeStackEventsWithContext(ctx context.Context, input *DescribeStackEventsInput) (output *DescribeStackEventsOutput, err error) {
+	req, out := c.DescribeStackEventsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeStackEventsPages iterates over the pages of a DescribeStackEvents operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *CloudFormation) DescribeStackEventsPages(input *DescribeStackEventsInput, fn func(p *DescribeStackEventsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeStackEventsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeStackEventsOutput), lastPage)
+	})
+}
+
 var opDescribeStackEvents *aws.Operation
 
 // DescribeStackResourceRequest generates a request for the DescribeStackResource operation.
@@ -190,6 +251,17 @@ func (c *CloudFormation) DescribeStackResource(input *DescribeStackResourceInput
 	return
 }
 
+// DescribeStackResourceWithContext is the same as DescribeStackResource with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) DescribeStackResourceWithContext(ctx context.Context, input *DescribeStackResourceInput) (output *DescribeStackResourceOutput, err error) {
+	req, out := c.DescribeStackResourceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeStackResource *aws.Operation
 
 // DescribeStackResourcesRequest generates a request for the DescribeStackResources operation.
@@ -239,6 +311,17 @@ func (c *CloudFormation) DescribeStackResources(input *DescribeStackResourcesInp
 	return
 }
 
+// DescribeStackResourcesWithContext is the same as DescribeStackResources with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) DescribeStackResourcesWithContext(ctx context.Context, input *DescribeStackResourcesInput) (output *DescribeStackResourcesOutput, err error) {
+	req, out := c.DescribeStackResourcesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeStackResources *aws.Operation
 
 // DescribeStacksRequest generates a request for the DescribeStacks operation.
@@ -251,6 +334,12 @@ func (c *CloudFormation) DescribeStacksRequest(input *DescribeStacksInput) (req
 			Name:       "DescribeStacks",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -273,6 +362,27 @@ func (c *CloudFormation) DescribeStacks(input *DescribeStacksInput) (output *Des
 	return
 }
 
+// DescribeStacksWithContext is the same as DescribeStacks with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) DescribeStacksWithContext(ctx context.Context, input *DescribeStacksInput) (output *DescribeStacksOutput, err error) {
+	req, out := c.DescribeStacksRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeStacksPages iterates over the pages of a DescribeStacks operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *CloudFormation) DescribeStacksPages(input *DescribeStacksInput, fn func(p *DescribeStacksOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeStacksRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeStacksOutput), lastPage)
+	})
+}
+
 var opDescribeStacks *aws.Operation
 
 // EstimateTemplateCostRequest generates a request for the EstimateTemplateCost operation.
@@ -308,6 +418,17 @@ func (c *CloudFormation) EstimateTemplateCost(input *EstimateTemplateCostInput)
 	return
 }
 
+// EstimateTemplateCostWithContext is the same as EstimateTemplateCost with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) EstimateTemplateCostWithContext(ctx context.Context, input *EstimateTemplateCostInput) (output *EstimateTemplateCostOutput, err error) {
+	req, out := c.EstimateTemplateCostRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEstimateTemplateCost *aws.Operation
 
 // GetStackPolicyRequest generates a request for the GetStackPolicy operation.
@@ -342,6 +463,17 @@ func (c *CloudFormation) GetStackPolicy(input *GetStackPolicyInput) (output *Get
 	return
 }
 
+// GetStackPolicyWithContext is the same as GetStackPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) GetStackPolicyWithContext(ctx context.Context, input *GetStackPolicyInput) (output *GetStackPolicyOutput, err error) {
+	req, out := c.GetStackPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetStackPolicy *aws.Operation
 
 // GetTemplateRequest generates a request for the GetTemplate operation.
@@ -381,6 +513,17 @@ func (c *CloudFormation) GetTemplate(input *GetTemplateInput) (output *GetTempla
 	return
 }
 
+// GetTemplateWithContext is the same as GetTemplate with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) GetTemplateWithContext(ctx context.Context, input *GetTemplateInput) (output *GetTemplateOutput, err error) {
+	req, out := c.GetTemplateRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetTemplate *aws.Operation
 
 // GetTemplateSummaryRequest generates a request for the GetTemplateSummary operation.
@@ -423,6 +566,17 @@ func (c *CloudFormation) GetTemplateSummary(input *GetTemplateSummaryInput) (out
 	return
 }
 
+// GetTemplateSummaryWithContext is the same as GetTemplateSummary with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) GetTemplateSummaryWithContext(ctx context.Context, input *GetTemplateSummaryInput) (output *GetTemplateSummaryOutput, err error) {
+	req, out := c.GetTemplateSummaryRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetTemplateSummary *aws.Operation
 
 // ListStackResourcesRequest generates a request for the ListStackResources operation.
@@ -435,6 +589,12 @@ func (c *CloudFormation) ListStackResourcesRequest(input *ListStackResourcesInpu
 			Name:       "ListStackResources",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -459,6 +619,27 @@ func (c *CloudFormation) ListStackResources(input *ListStackResourcesInput) (out
 	return
 }
 
+// ListStackResourcesWithContext is the same as ListStackResources with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) ListStackResourcesWithContext(ctx context.Context, input *ListStackResourcesInput) (output *ListStackResourcesOutput, err error) {
+	req, out := c.ListStackResourcesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListStackResourcesPages iterates over the pages of a ListStackResources operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *CloudFormation) ListStackResourcesPages(input *ListStackResourcesInput, fn func(p *ListStackResourcesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListStackResourcesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListStackResourcesOutput), lastPage)
+	})
+}
+
 var opListStackResources *aws.Operation
 
 // ListStacksRequest generates a request for the ListStacks operation.
@@ -471,6 +652,12 @@ func (c *CloudFormation) ListStacksRequest(input *ListStacksInput) (req *aws.Req
 			Name:       "ListStacks",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -496,6 +683,27 @@ func (c *CloudFormation) ListStacks(input *ListStacksInput) (output *ListStacksO
 	return
 }
 
+// ListStacksWithContext is the same as ListStacks with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) ListStacksWithContext(ctx context.Context, input *ListStacksInput) (output *ListStacksOutput, err error) {
+	req, out := c.ListStacksRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListStacksPages iterates over the pages of a ListStacks operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *CloudFormation) ListStacksPages(input *ListStacksInput, fn func(p *ListStacksOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListStacksRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListStacksOutput), lastPage)
+	})
+}
+
 var opListStacks *aws.Operation
 
 // SetStackPolicyRequest generates a request for the SetStackPolicy operation.
@@ -529,6 +737,17 @@ func (c *CloudFormation) SetStackPolicy(input *SetStackPolicyInput) (output *Set
 	return
 }
 
+// SetStackPolicyWithContext is the same as SetStackPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) SetStackPolicyWithContext(ctx context.Context, input *SetStackPolicyInput) (output *SetStackPolicyOutput, err error) {
+	req, out := c.SetStackPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetStackPolicy *aws.Operation
 
 // SignalResourceRequest generates a request for the SignalResource operation.
@@ -567,6 +786,17 @@ func (c *CloudFormation) SignalResource(input *SignalResourceInput) (output *Sig
 	return
 }
 
+// SignalResourceWithContext is the same as SignalResource with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) SignalResourceWithContext(ctx context.Context, input *SignalResourceInput) (output *SignalResourceOutput, err error) {
+	req, out := c.SignalResourceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSignalResource *aws.Operation
 
 // UpdateStackRequest generates a request for the UpdateStack operation.
@@ -611,6 +841,17 @@ func (c *CloudFormation) UpdateStack(input *UpdateStackInput) (output *UpdateSta
 	return
 }
 
+// UpdateStackWithContext is the same as UpdateStack with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) UpdateStackWithContext(ctx context.Context, input *UpdateStackInput) (output *UpdateStackOutput, err error) {
+	req, out := c.UpdateStackRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateStack *aws.Operation
 
 // ValidateTemplateRequest generates a request for the ValidateTemplate operation.
@@ -644,6 +885,17 @@ func (c *CloudFormation) ValidateTemplate(input *ValidateTemplateInput) (output
 	return
 }
 
+// ValidateTemplateWithContext is the same as ValidateTemplate with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFormation) ValidateTemplateWithContext(ctx context.Context, input *ValidateTemplateInput) (output *ValidateTemplateOutput, err error) {
+	req, out := c.ValidateTemplateRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opValidateTemplate *aws.Operation
 
 // The input for CancelUpdateStack action.
@@ -696,14 +948,14 @@ type CreateStackInput struct {
 	// The Simple Notification Service (SNS) topic ARNs to publish stack related
 	// events. You can find your SNS topic ARNs using the SNS console (http://console.aws.amazon.com/sns)
 	// or your Command Line Interface (CLI).
-	NotificationARNs []*string `type:"list"`
+	NotificationARNs []*string `type:"list" max:"5"`
 
 	// Determines what action will be taken if stack creation fails. This must be
 	// one of: DO_NOTHING, ROLLBACK, or DELETE. You can specify either OnFailure
 	// or DisableRollback, but not both.
 	//
 	// Default: ROLLBACK
-	OnFailure *string `type:"string"`
+	OnFailure *string `type:"string" enum:"DO_NOTHING,ROLLBACK,DELETE"`
 
 	// A list of Parameter structures that specify input parameters for the stack.
 	Parameters []*Parameter `type:"list"`
@@ -719,13 +971,13 @@ type CreateStackInput struct {
 	// Prevent Updates to Stack Resources (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/protect-stack-resources.html)
 	// in the AWS CloudFormation User Guide. You can specify either the StackPolicyBody
 	// or the StackPolicyURL parameter, but not both.
-	StackPolicyBody *string `type:"string"`
+	StackPolicyBody *string `type:"string" min:"1" max:"16384"`
 
 	// Location of a file containing the stack policy. The URL must point to a policy
 	// (max size: 16KB) located in an S3 bucket in the same region as the stack.
 	// You can specify either the StackPolicyBody or the StackPolicyURL parameter,
 	// but not both.
-	StackPolicyURL *string `type:"string"`
+	StackPolicyURL *string `type:"string" min:"1" max:"1350"`
 
 	// A set of user-defined Tags to associate with this stack, represented by key/value
 	// pairs. Tags defined for the stack are propagated to EC2 resources that are
@@ -739,7 +991,7 @@ type CreateStackInput struct {
 	//
 	// Conditional: You must specify either the TemplateBody or the TemplateURL
 	// parameter, but not both.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// (max size: 307,200 bytes) located in an S3 bucket in the same region as the
@@ -748,12 +1000,12 @@ type CreateStackInput struct {
 	//
 	// Conditional: You must specify either the TemplateBody or the TemplateURL
 	// parameter, but not both.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	// The amount of time that can pass before the stack status becomes CREATE_FAILED;
 	// if DisableRollback is not set or is set to false, the stack will be rolled
 	// back.
-	TimeoutInMinutes *int64 `type:"integer"`
+	TimeoutInMinutes *int64 `type:"integer" min:"1"`
 
 	metadataCreateStackInput `json:"-", xml:"-"`
 }
@@ -800,7 +1052,7 @@ type DescribeStackEventsInput struct {
 	// one.
 	//
 	// Default: There is no default value.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// The name or the unique identifier associated with the stack, which are not
 	// always interchangeable:
@@ -821,7 +1073,7 @@ type metadataDescribeStackEventsInput struct {
 type DescribeStackEventsOutput struct {
 	// String that identifies the start of the next list of events, if there is
 	// one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// A list of StackEvents structures.
 	StackEvents []*StackEvent `type:"list"`
@@ -923,7 +1175,7 @@ type metadataDescribeStackResourcesOutput struct {
 type DescribeStacksInput struct {
 	// String that identifies the start of the next list of stacks, if there is
 	// one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// The name or the unique identifier associated with the stack, which are not
 	// always interchangeable:
@@ -944,7 +1196,7 @@ type metadataDescribeStacksInput struct {
 type DescribeStacksOutput struct {
 	// String that identifies the start of the next list of stacks, if there is
 	// one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// A list of stack structures.
 	Stacks []*Stack `type:"list"`
@@ -967,7 +1219,7 @@ type EstimateTemplateCostInput struct {
 	//
 	// Conditional: You must pass TemplateBody or TemplateURL. If both are passed,
 	// only TemplateBody is used.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// located in an S3 bucket in the same region as the stack. For more information,
@@ -976,7 +1228,7 @@ type EstimateTemplateCostInput struct {
 	//
 	// Conditional: You must pass TemplateURL or TemplateBody. If both are passed,
 	// only TemplateBody is used.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	metadataEstimateTemplateCostInput `json:"-", xml:"-"`
 }
@@ -1016,7 +1268,7 @@ type GetStackPolicyOutput struct {
 	// Structure containing the stack policy body. (For more information, go to
 	//  Prevent Updates to Stack Resources (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/protect-stack-resources.html)
 	// in the AWS CloudFormation User Guide.)
-	StackPolicyBody *string `type:"string"`
+	StackPolicyBody *string `type:"string" min:"1" max:"16384"`
 
 	metadataGetStackPolicyOutput `json:"-", xml:"-"`
 }
@@ -1047,7 +1299,7 @@ type GetTemplateOutput struct {
 	// Structure containing the template body. (For more information, go to Template
 	// Anatomy (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/template-anatomy.html)
 	// in the AWS CloudFormation User Guide.)
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	metadataGetTemplateOutput `json:"-", xml:"-"`
 }
@@ -1065,7 +1317,7 @@ type GetTemplateSummaryInput struct {
 	//
 	// Conditional: You must specify only one of the following parameters: StackName,
 	// TemplateBody, or TemplateURL.
-	StackName *string `type:"string"`
+	StackName *string `type:"string" min:"1" pattern:"([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)"`
 
 	// Structure containing the template body with a minimum length of 1 byte and
 	// a maximum length of 51,200 bytes. For more information about templates, see
@@ -1074,7 +1326,7 @@ type GetTemplateSummaryInput struct {
 	//
 	// Conditional: You must specify only one of the following parameters: StackName,
 	// TemplateBody, or TemplateURL.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// (max size: 307,200 bytes) located in an Amazon S3 bucket. For more information
@@ -1083,7 +1335,7 @@ type GetTemplateSummaryInput struct {
 	//
 	// Conditional: You must specify only one of the following parameters: StackName,
 	// TemplateBody, or TemplateURL.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	metadataGetTemplateSummaryInput `json:"-", xml:"-"`
 }
@@ -1128,7 +1380,7 @@ type ListStackResourcesInput struct {
 	// if there is one.
 	//
 	// Default: There is no default value.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// The name or the unique identifier associated with the stack, which are not
 	// always interchangeable:
@@ -1149,7 +1401,7 @@ type metadataListStackResourcesInput struct {
 type ListStackResourcesOutput struct {
 	// String that identifies the start of the next list of stack resources, if
 	// there is one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// A list of StackResourceSummary structures.
 	StackResourceSummaries []*StackResourceSummary `type:"list"`
@@ -1167,7 +1419,7 @@ type ListStacksInput struct {
 	// one.
 	//
 	// Default: There is no default value.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// Stack status to use as a filter. Specify one or more stack status codes to
 	// list only stacks with the specified status codes. For a complete list of
@@ -1185,7 +1437,7 @@ type metadataListStacksInput struct {
 type ListStacksOutput struct {
 	// String that identifies the start of the next list of stacks, if there is
 	// one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// A list of StackSummary structures containing information about the specified
 	// stacks.
@@ -1269,13 +1521,13 @@ type SetStackPolicyInput struct {
 	// Prevent Updates to Stack Resources (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/protect-stack-resources.html)
 	// in the AWS CloudFormation User Guide. You can specify either the StackPolicyBody
 	// or the StackPolicyURL parameter, but not both.
-	StackPolicyBody *string `type:"string"`
+	StackPolicyBody *string `type:"string" min:"1" max:"16384"`
 
 	// Location of a file containing the stack policy. The URL must point to a policy
 	// (max size: 16KB) located in an S3 bucket in the same region as the stack.
 	// You can specify either the StackPolicyBody or the StackPolicyURL parameter,
 	// but not both.
-	StackPolicyURL *string `type:"string"`
+	StackPolicyURL *string `type:"string" min:"1" max:"1350"`
 
 	metadataSetStackPolicyInput `json:"-", xml:"-"`
 }
@@ -1299,17 +1551,17 @@ type SignalResourceInput struct {
 	LogicalResourceID *string `locationName:"LogicalResourceId" type:"string" required:"true"`
 
 	// The stack name or ID that includes the resource that you want to signal.
-	StackName *string `type:"string" required:"true"`
+	StackName *string `type:"string" min:"1" pattern:"([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)" required:"true"`
 
 	// The status of the signal, which is either success or failure. A failure signal
 	// causes AWS CloudFormation to immediately fail the stack creation or update.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"SUCCESS,FAILURE" required:"true"`
 
 	// A unique ID of the signal. When you signal Amazon EC2 instances or Auto Scaling
 	// groups, specify the instance ID that you are signaling as the unique ID.
 	// If you send multiple signals to a single resource (such as signaling a wait
 	// condition), each signal requires a different unique ID.
-	UniqueID *string `locationName:"UniqueId" type:"string" required:"true"`
+	UniqueID *string `locationName:"UniqueId" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataSignalResourceInput `json:"-", xml:"-"`
 }
@@ -1347,7 +1599,7 @@ type Stack struct {
 	LastUpdatedTime *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// SNS topic ARNs to which stack related events are published.
-	NotificationARNs []*string `type:"list"`
+	NotificationARNs []*string `type:"list" max:"5"`
 
 	// A list of output structures.
 	Outputs []*Output `type:"list"`
@@ -1362,7 +1614,7 @@ type Stack struct {
 	StackName *string `type:"string" required:"true"`
 
 	// Current status of the stack.
-	StackStatus *string `type:"string" required:"true"`
+	StackStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,ROLLBACK_IN_PROGRESS,ROLLBACK_FAILED,ROLLBACK_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,UPDATE_IN_PROGRESS,UPDATE_COMPLETE_CLEANUP_IN_PROGRESS,UPDATE_COMPLETE,UPDATE_ROLLBACK_IN_PROGRESS,UPDATE_ROLLBACK_FAILED,UPDATE_ROLLBACK_COMPLETE_CLEANUP_IN_PROGRESS,UPDATE_ROLLBACK_COMPLETE" required:"true"`
 
 	// Success/failure message associated with the stack status.
 	StackStatusReason *string `type:"string"`
@@ -1371,7 +1623,7 @@ type Stack struct {
 	Tags []*Tag `type:"list"`
 
 	// The amount of time within which stack creation should complete.
-	TimeoutInMinutes *int64 `type:"integer"`
+	TimeoutInMinutes *int64 `type:"integer" min:"1"`
 
 	metadataStack `json:"-", xml:"-"`
 }
@@ -1396,7 +1648,7 @@ type StackEvent struct {
 	ResourceProperties *string `type:"string"`
 
 	// Current status of the resource.
-	ResourceStatus *string `type:"string"`
+	ResourceStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,DELETE_SKIPPED,UPDATE_IN_PROGRESS,UPDATE_FAILED,UPDATE_COMPLETE"`
 
 	// Success/failure message associated with the resource.
 	ResourceStatusReason *string `type:"string"`
@@ -1435,7 +1687,7 @@ type StackResource struct {
 	PhysicalResourceID *string `locationName:"PhysicalResourceId" type:"string"`
 
 	// Current status of the resource.
-	ResourceStatus *string `type:"string" required:"true"`
+	ResourceStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,DELETE_SKIPPED,UPDATE_IN_PROGRESS,UPDATE_FAILED,UPDATE_COMPLETE" required:"true"`
 
 	// Success/failure message associated with the resource.
 	ResourceStatusReason *string `type:"string"`
@@ -1482,7 +1734,7 @@ type StackResourceDetail struct {
 	PhysicalResourceID *string `locationName:"PhysicalResourceId" type:"string"`
 
 	// Current status of the resource.
-	ResourceStatus *string `type:"string" required:"true"`
+	ResourceStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,DELETE_SKIPPED,UPDATE_IN_PROGRESS,UPDATE_FAILED,UPDATE_COMPLETE" required:"true"`
 
 	// Success/failure message associated with the resource.
 	ResourceStatusReason *string `type:"string"`
@@ -1518,7 +1770,7 @@ type StackResourceSummary struct {
 	PhysicalResourceID *string `locationName:"PhysicalResourceId" type:"string"`
 
 	// Current status of the resource.
-	ResourceStatus *string `type:"string" required:"true"`
+	ResourceStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,DELETE_SKIPPED,UPDATE_IN_PROGRESS,UPDATE_FAILED,UPDATE_COMPLETE" required:"true"`
 
 	// Success/failure message associated with the resource.
 	ResourceStatusReason *string `type:"string"`
@@ -1554,7 +1806,7 @@ type StackSummary struct {
 	StackName *string `type:"string" required:"true"`
 
 	// The current status of the stack.
-	StackStatus *string `type:"string" required:"true"`
+	StackStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,ROLLBACK_IN_PROGRESS,ROLLBACK_FAILED,ROLLBACK_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,UPDATE_IN_PROGRESS,UPDATE_COMPLETE_CLEANUP_IN_PROGRESS,UPDATE_COMPLETE,UPDATE_ROLLBACK_IN_PROGRESS,UPDATE_ROLLBACK_FAILED,UPDATE_ROLLBACK_COMPLETE_CLEANUP_IN_PROGRESS,UPDATE_ROLLBACK_COMPLETE" required:"true"`
 
 	// Success/Failure message associated with the stack status.
 	StackStatusReason *string `type:"string"`
@@ -1632,7 +1884,7 @@ type UpdateStackInput struct {
 	Capabilities []*string `type:"list"`
 
 	// Update the ARNs for the Amazon SNS topics that are associated with the stack.
-	NotificationARNs []*string `type:"list"`
+	NotificationARNs []*string `type:"list" max:"5"`
 
 	// A list of Parameter structures that specify input parameters for the stack.
 	Parameters []*Parameter `type:"list"`
@@ -1649,7 +1901,7 @@ type UpdateStackInput struct {
 	// You might update the stack policy, for example, in order to protect a new
 	// resource that you created during a stack update. If you do not specify a
 	// stack policy, the current policy that is associated with the stack is unchanged.
-	StackPolicyBody *string `type:"string"`
+	StackPolicyBody *string `type:"string" min:"1" max:"16384"`
 
 	// Structure containing the temporary overriding stack policy body. You can
 	// specify either the StackPolicyDuringUpdateBody or the StackPolicyDuringUpdateURL
@@ -1658,7 +1910,7 @@ type UpdateStackInput struct {
 	// If you want to update protected resources, specify a temporary overriding
 	// stack policy during this update. If you do not specify a stack policy, the
 	// current policy that is associated with the stack will be used.
-	StackPolicyDuringUpdateBody *string `type:"string"`
+	StackPolicyDuringUpdateBody *string `type:"string" min:"1" max:"16384"`
 
 	// Location of a file containing the temporary overriding stack policy. The
 	// URL must point to a policy (max size: 16KB) located in an S3 bucket in the
@@ -1668,7 +1920,7 @@ type UpdateStackInput struct {
 	// If you want to update protected resources, specify a temporary overriding
 	// stack policy during this update. If you do not specify a stack policy, the
 	// current policy that is associated with the stack will be used.
-	StackPolicyDuringUpdateURL *string `type:"string"`
+	StackPolicyDuringUpdateURL *string `type:"string" min:"1" max:"1350"`
 
 	// Location of a file containing the updated stack policy. The URL must point
 	// to a policy (max size: 16KB) located in an S3 bucket in the same region as
@@ -1678,7 +1930,7 @@ type UpdateStackInput struct {
 	// You might update the stack policy, for example, in order to protect a new
 	// resource that you created during a stack update. If you do not specify a
 	// stack policy, the current policy that is associated with the stack is unchanged.
-	StackPolicyURL *string `type:"string"`
+	StackPolicyURL *string `type:"string" min:"1" max:"1350"`
 
 	// Structure containing the template body with a minimum length of 1 byte and
 	// a maximum length of 51,200 bytes. (For more information, go to Template Anatomy
@@ -1687,7 +1939,7 @@ type UpdateStackInput struct {
 	//
 	// Conditional: You must specify either the TemplateBody or the TemplateURL
 	// parameter, but not both.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// located in an S3 bucket in the same region as the stack. For more information,
@@ -1696,7 +1948,7 @@ type UpdateStackInput struct {
 	//
 	// Conditional: You must specify either the TemplateBody or the TemplateURL
 	// parameter, but not both.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	// Reuse the existing template that is associated with the stack that you are
 	// updating.
@@ -1730,7 +1982,7 @@ type ValidateTemplateInput struct {
 	//
 	// Conditional: You must pass TemplateURL or TemplateBody. If both are passed,
 	// only TemplateBody is used.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// (max size: 307,200 bytes) located in an S3 bucket in the same region as the
@@ -1739,7 +1991,7 @@ type ValidateTemplateInput struct {
 	//
 	// Conditional: You must pass TemplateURL or TemplateBody. If both are passed,
 	// only TemplateBody is used.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	metadataValidateTemplateInput `json:"-", xml:"-"`
 }
@@ -1771,4 +2023,118 @@ type ValidateTemplateOutput struct {
 
 type metadataValidateTemplateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for Capability.
+const (
+	CapabilityCapabilityIAM = "CAPABILITY_IAM"
+)
+
+// CapabilityValues returns the valid values of Capability.
+func CapabilityValues() []string {
+	return []string{
+		CapabilityCapabilityIAM,
+	}
+}
+
+// Enum values for OnFailure.
+const (
+	OnFailureDONothing = "DO_NOTHING"
+	OnFailureRollback  = "ROLLBACK"
+	OnFailureDelete    = "DELETE"
+)
+
+// OnFailureValues returns the valid values of OnFailure.
+func OnFailureValues() []string {
+	return []string{
+		OnFailureDONothing,
+		OnFailureRollback,
+		OnFailureDelete,
+	}
+}
+
+// Enum values for ResourceSignalStatus.
+const (
+	ResourceSignalStatusSuccess = "SUCCESS"
+	ResourceSignalStatusFailure = "FAILURE"
+)
+
+// ResourceSignalStatusValues returns the valid values of ResourceSignalStatus.
+func ResourceSignalStatusValues() []string {
+	return []string{
+		ResourceSignalStatusSuccess,
+		ResourceSignalStatusFailure,
+	}
+}
+
+// Enum values for ResourceStatus.
+const (
+	ResourceStatusCreateINProgress = "CREATE_IN_PROGRESS"
+	ResourceStatusCreateFailed     = "CREATE_FAILED"
+	ResourceStatusCreateComplete   = "CREATE_COMPLETE"
+	ResourceStatusDeleteINProgress = "DELETE_IN_PROGRESS"
+	ResourceStatusDeleteFailed     = "DELETE_FAILED"
+	ResourceStatusDeleteComplete   = "DELETE_COMPLETE"
+	ResourceStatusDeleteSkipped    = "DELETE_SKIPPED"
+	ResourceStatusUpdateINProgress = "UPDATE_IN_PROGRESS"
+	ResourceStatusUpdateFailed     = "UPDATE_FAILED"
+	ResourceStatusUpdateComplete   = "UPDATE_COMPLETE"
+)
+
+// ResourceStatusValues returns the valid values of ResourceStatus.
+func ResourceStatusValues() []string {
+	return []string{
+		ResourceStatusCreateINProgress,
+		ResourceStatusCreateFailed,
+		ResourceStatusCreateComplete,
+		ResourceStatusDeleteINProgress,
+		ResourceStatusDeleteFailed,
+		ResourceStatusDeleteComplete,
+		ResourceStatusDeleteSkipped,
+		ResourceStatusUpdateINProgress,
+		ResourceStatusUpdateFailed,
+		ResourceStatusUpdateComplete,
+	}
+}
+
+// Enum values for StackStatus.
+const (
+	StackStatusCreateINProgress                        = "CREATE_IN_PROGRESS"
+	StackStatusCreateFailed                            = "CREATE_FAILED"
+	StackStatusCreateComplete                          = "CREATE_COMPLETE"
+	StackStatusRollbackINProgress                      = "ROLLBACK_IN_PROGRESS"
+	StackStatusRollbackFailed                          = "ROLLBACK_FAILED"
+	StackStatusRollbackComplete                        = "ROLLBACK_COMPLETE"
+	StackStatusDeleteINProgress                        = "DELETE_IN_PROGRESS"
+	StackStatusDeleteFailed                            = "DELETE_FAILED"
+	StackStatusDeleteComplete                          = "DELETE_COMPLETE"
+	StackStatusUpdateINProgress                        = "UPDATE_IN_PROGRESS"
+	StackStatusUpdateCompleteCleanupINProgress         = "UPDATE_COMPLETE_CLEANUP_IN_PROGRESS"
+	StackStatusUpdateComplete                          = "UPDATE_COMPLETE"
+	StackStatusUpdateRollbackINProgress                = "UPDATE_ROLLBACK_IN_PROGRESS"
+	StackStatusUpdateRollbackFailed                    = "UPDATE_ROLLBACK_FAILED"
+	StackStatusUpdateRollbackCompleteCleanupINProgress = "UPDATE_ROLLBACK_COMPLETE_CLEANUP_IN_PROGRESS"
+	StackStatusUpdateRollbackComplete                  = "UPDATE_ROLLBACK_COMPLETE"
+)
+
+// StackStatusValues returns the valid values of StackStatus.
+func StackStatusValues() []string {
+	return []string{
+		StackStatusCreateINProgress,
+		StackStatusCreateFailed,
+		StackStatusCreateComplete,
+		StackStatusRollbackINProgress,
+		StackStatusRollbackFailed,
+		StackStatusRollbackComplete,
+		StackStatusDeleteINProgress,
+		StackStatusDeleteFailed,
+		StackStatusDeleteComplete,
+		StackStatusUpdateINProgress,
+		StackStatusUpdateCompleteCleanupINProgress,
+		StackStatusUpdateComplete,
+		StackStatusUpdateRollbackINProgress,
+		StackStatusUpdateRollbackFailed,
+		StackStatusUpdateRollbackCompleteCleanupINProgress,
+		StackStatusUpdateRollbackComplete,
+	}
 }
\ No newline at end of file
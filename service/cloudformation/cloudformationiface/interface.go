@@ -1,41 +1,81 @@
 package cloudformationiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/cloudformation"
 )
 
 type CloudFormationAPI interface {
 	CancelUpdateStack(*cloudformation.CancelUpdateStackInput) (*cloudformation.CancelUpdateStackOutput, error)
 
+	CancelUpdateStackWithContext(context.Context, *cloudformation.CancelUpdateStackInput) (*cloudformation.CancelUpdateStackOutput, error)
+
 	CreateStack(*cloudformation.CreateStackInput) (*cloudformation.CreateStackOutput, error)
 
+	CreateStackWithContext(context.Context, *cloudformation.CreateStackInput) (*cloudformation.CreateStackOutput, error)
+
 	DeleteStack(*cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error)
 
+	DeleteStackWithContext(context.Context, *cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error)
+
 	DescribeStackEvents(*cloudformation.DescribeStackEventsInput) (*cloudformation.DescribeStackEventsOutput, error)
 
+	DescribeStackEventsWithContext(context.Context, *cloudformation.DescribeStackEventsInput) (*cloudformation.DescribeStackEventsOutput, error)
+	DescribeStackEventsPages(*cloudformation.DescribeStackEventsInput, func(*cloudformation.DescribeStackEventsOutput, bool) bool) error
+
 	DescribeStackResource(*cloudformation.DescribeStackResourceInput) (*cloudformation.DescribeStackResourceOutput, error)
 
+	DescribeStackResourceWithContext(context.Context, *cloudformation.DescribeStackResourceInput) (*cloudformation.DescribeStackResourceOutput, error)
+
 	DescribeStackResources(*cloudformation.DescribeStackResourcesInput) (*cloudformation.DescribeStackResourcesOutput, error)
 
+	DescribeStackResourcesWithContext(context.Context, *cloudformation.DescribeStackResourcesInput) (*cloudformation.DescribeStackResourcesOutput, error)
+
 	DescribeStacks(*cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error)
 
+	DescribeStacksWithContext(context.Context, *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error)
+	DescribeStacksPages(*cloudformation.DescribeStacksInput, func(*cloudformation.DescribeStacksOutput, bool) bool) error
+
 	EstimateTemplateCost(*cloudformation.EstimateTemplateCostInput) (*cloudformation.EstimateTemplateCostOutput, error)
 
+	EstimateTemplateCostWithContext(context.Context, *cloudformation.EstimateTemplateCostInput) (*cloudformation.EstimateTemplateCostOutput, error)
+
 	GetStackPolicy(*cloudformation.GetStackPolicyInput) (*cloudformation.GetStackPolicyOutput, error)
 
+	GetStackPolicyWithContext(context.Context, *cloudformation.GetStackPolicyInput) (*cloudformation.GetStackPolicyOutput, error)
+
 	GetTemplate(*cloudformation.GetTemplateInput) (*cloudformation.GetTemplateOutput, error)
 
+	GetTemplateWithContext(context.Context, *cloudformation.GetTemplateInput) (*cloudformation.GetTemplateOutput, error)
+
 	GetTemplateSummary(*cloudformation.GetTemplateSummaryInput) (*cloudformation.GetTemplateSummaryOutput, error)
 
+	GetTemplateSummaryWithContext(context.Context, *cloudformation.GetTemplateSummaryInput) (*cloudformation.GetTemplateSummaryOutput, error)
+
 	ListStackResources(*cloudformation.ListStackResourcesInput) (*cloudformation.ListStackResourcesOutput, error)
 
+	ListStackResourcesWithContext(context.Context, *cloudformation.ListStackResourcesInput) (*cloudformation.ListStackResourcesOutput, error)
+	ListStackResourcesPages(*cloudformation.ListStackResourcesInput, func(*cloudformation.ListStackResourcesOutput, bool) bool) error
+
 	ListStacks(*cloudformation.ListStacksInput) (*cloudformation.ListStacksOutput, error)
 
+	ListStacksWithContext(context.Context, *cloudformation.ListStacksInput) (*cloudformation.ListStacksOutput, error)
+	ListStacksPages(*cloudformation.ListStacksInput, func(*cloudformation.ListStacksOutput, bool) bool) error
+
 	SetStackPolicy(*cloudformation.SetStackPolicyInput) (*cloudformation.SetStackPolicyOutput, error)
 
+	SetStackPolicyWithContext(context.Context, *cloudformation.SetStackPolicyInput) (*cloudformation.SetStackPolicyOutput, error)
+
 	SignalResource(*cloudformation.SignalResourceInput) (*cloudformation.SignalResourceOutput, error)
 
+	SignalResourceWithContext(context.Context, *cloudformation.SignalResourceInput) (*cloudformation.SignalResourceOutput, error)
+
 	UpdateStack(*cloudformation.UpdateStackInput) (*cloudformation.UpdateStackOutput, error)
 
+	UpdateStackWithContext(context.Context, *cloudformation.UpdateStackInput) (*cloudformation.UpdateStackOutput, error)
+
 	ValidateTemplate(*cloudformation.ValidateTemplateInput) (*cloudformation.ValidateTemplateOutput, error)
+
+	ValidateTemplateWithContext(context.Context, *cloudformation.ValidateTemplateInput) (*cloudformation.ValidateTemplateOutput, error)
 }
\ No newline at end of file
@@ -27,6 +27,7 @@ func New(config *aws.Config) *CloudFormation {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "cloudformation",
 		APIVersion:  "2010-05-15",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
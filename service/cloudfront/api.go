@@ -4,6 +4,7 @@
 package cloudfront
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -43,6 +44,17 @@ func (c *CloudFront) CreateCloudFrontOriginAccessIdentity(input *CreateCloudFron
 	return
 }
 
+// CreateCloudFrontOriginAccessIdentityWithContext is the same as CreateCloudFrontOriginAccessIdentity with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) CreateCloudFrontOriginAccessIdentityWithContext(ctx context.Context, input *CreateCloudFrontOriginAccessIdentityInput) (output *CreateCloudFrontOriginAccessIdentityOutput, err error) {
+	req, out := c.CreateCloudFrontOriginAccessIdentityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateCloudFrontOriginAccessIdentity *aws.Operation
 
 // CreateDistributionRequest generates a request for the CreateDistribution operation.
@@ -76,6 +88,17 @@ func (c *CloudFront) CreateDistribution(input *CreateDistributionInput) (output
 	return
 }
 
+// CreateDistributionWithContext is the same as CreateDistribution with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) CreateDistributionWithContext(ctx context.Context, input *CreateDistributionInput) (output *CreateDistributionOutput, err error) {
+	req, out := c.CreateDistributionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateDistribution *aws.Operation
 
 // CreateInvalidationRequest generates a request for the CreateInvalidation operation.
@@ -109,6 +132,17 @@ func (c *CloudFront) CreateInvalidation(input *CreateInvalidationInput) (output
 	return
 }
 
+// CreateInvalidationWithContext is the same as CreateInvalidation with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) CreateInvalidationWithContext(ctx context.Context, input *CreateInvalidationInput) (output *CreateInvalidationOutput, err error) {
+	req, out := c.CreateInvalidationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateInvalidation *aws.Operation
 
 // CreateStreamingDistributionRequest generates a request for the CreateStreamingDistribution operation.
@@ -142,6 +176,17 @@ func (c *CloudFront) CreateStreamingDistribution(input *CreateStreamingDistribut
 	return
 }
 
+// CreateStreamingDistributionWithContext is the same as CreateStreamingDistribution with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) CreateStreamingDistributionWithContext(ctx context.Context, input *CreateStreamingDistributionInput) (output *CreateStreamingDistributionOutput, err error) {
+	req, out := c.CreateStreamingDistributionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateStreamingDistribution *aws.Operation
 
 // DeleteCloudFrontOriginAccessIdentityRequest generates a request for the DeleteCloudFrontOriginAccessIdentity operation.
@@ -175,6 +220,17 @@ func (c *CloudFront) DeleteCloudFrontOriginAccessIdentity(input *DeleteCloudFron
 	return
 }
 
+// DeleteCloudFrontOriginAccessIdentityWithContext is the same as DeleteCloudFrontOriginAccessIdentity with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) DeleteCloudFrontOriginAccessIdentityWithContext(ctx context.Context, input *DeleteCloudFrontOriginAccessIdentityInput) (output *DeleteCloudFrontOriginAccessIdentityOutput, err error) {
+	req, out := c.DeleteCloudFrontOriginAccessIdentityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteCloudFrontOriginAccessIdentity *aws.Operation
 
 // DeleteDistributionRequest generates a request for the DeleteDistribution operation.
@@ -208,6 +264,17 @@ func (c *CloudFront) DeleteDistribution(input *DeleteDistributionInput) (output
 	return
 }
 
+// DeleteDistributionWithContext is the same as DeleteDistribution with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) DeleteDistributionWithContext(ctx context.Context, input *DeleteDistributionInput) (output *DeleteDistributionOutput, err error) {
+	req, out := c.DeleteDistributionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteDistribution *aws.Operation
 
 // DeleteStreamingDistributionRequest generates a request for the DeleteStreamingDistribution operation.
@@ -241,6 +308,17 @@ func (c *CloudFront) DeleteStreamingDistribution(input *DeleteStreamingDistribut
 	return
 }
 
+// DeleteStreamingDistributionWithContext is the same as DeleteStreamingDistribution with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) DeleteStreamingDistributionWithContext(ctx context.Context, input *DeleteStreamingDistributionInput) (output *DeleteStreamingDistributionOutput, err error) {
+	req, out := c.DeleteStreamingDistributionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteStreamingDistribution *aws.Operation
 
 // GetCloudFrontOriginAccessIdentityRequest generates a request for the GetCloudFrontOriginAccessIdentity operation.
@@ -274,6 +352,17 @@ func (c *CloudFront) GetCloudFrontOriginAccessIdentity(input *GetCloudFrontOrigi
 	return
 }
 
+// GetCloudFrontOriginAccessIdentityWithContext is the same as GetCloudFrontOriginAccessIdentity with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) GetCloudFrontOriginAccessIdentityWithContext(ctx context.Context, input *GetCloudFrontOriginAccessIdentityInput) (output *GetCloudFrontOriginAccessIdentityOutput, err error) {
+	req, out := c.GetCloudFrontOriginAccessIdentityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetCloudFrontOriginAccessIdentity *aws.Operation
 
 // GetCloudFrontOriginAccessIdentityConfigRequest generates a request for the GetCloudFrontOriginAccessIdentityConfig operation.
@@ -307,6 +396,17 @@ func (c *CloudFront) GetCloudFrontOriginAccessIdentityConfig(input *GetCloudFron
 	return
 }
 
+// GetCloudFrontOriginAccessIdentityConfigWithContext is the same as GetCloudFrontOriginAccessIdentityConfig with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) GetCloudFrontOriginAccessIdentityConfigWithContext(ctx context.Context, input *GetCloudFrontOriginAccessIdentityConfigInput) (output *GetCloudFrontOriginAccessIdentityConfigOutput, err error) {
+	req, out := c.GetCloudFrontOriginAccessIdentityConfigRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetCloudFrontOriginAccessIdentityConfig *aws.Operation
 
 // GetDistributionRequest generates a request for the GetDistribution operation.
@@ -340,6 +440,17 @@ func (c *CloudFront) GetDistribution(input *GetDistributionInput) (output *GetDi
 	return
 }
 
+// GetDistributionWithContext is the same as GetDistribution with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) GetDistributionWithContext(ctx context.Context, input *GetDistributionInput) (output *GetDistributionOutput, err error) {
+	req, out := c.GetDistributionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetDistribution *aws.Operation
 
 // GetDistributionConfigRequest generates a request for the GetDistributionConfig operation.
@@ -373,6 +484,17 @@ func (c *CloudFront) GetDistributionConfig(input *GetDistributionConfigInput) (o
 	return
 }
 
+// GetDistributionConfigWithContext is the same as GetDistributionConfig with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) GetDistributionConfigWithContext(ctx context.Context, input *GetDistributionConfigInput) (output *GetDistributionConfigOutput, err error) {
+	req, out := c.GetDistributionConfigRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetDistributionConfig *aws.Operation
 
 // GetInvalidationRequest generates a request for the GetInvalidation operation.
@@ -406,6 +528,17 @@ func (c *CloudFront) GetInvalidation(input *GetInvalidationInput) (output *GetIn
 	return
 }
 
+// GetInvalidationWithContext is the same as GetInvalidation with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) GetInvalidationWithContext(ctx context.Context, input *GetInvalidationInput) (output *GetInvalidationOutput, err error) {
+	req, out := c.GetInvalidationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetInvalidation *aws.Operation
 
 // GetStreamingDistributionRequest generates a request for the GetStreamingDistribution operation.
@@ -439,6 +572,17 @@ func (c *CloudFront) GetStreamingDistribution(input *GetStreamingDistributionInp
 	return
 }
 
+// GetStreamingDistributionWithContext is the same as GetStreamingDistribution with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) GetStreamingDistributionWithContext(ctx context.Context, input *GetStreamingDistributionInput) (output *GetStreamingDistributionOutput, err error) {
+	req, out := c.GetStreamingDistributionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetStreamingDistribution *aws.Operation
 
 // GetStreamingDistributionConfigRequest generates a request for the GetStreamingDistributionConfig operation.
@@ -472,6 +616,17 @@ func (c *CloudFront) GetStreamingDistributionConfig(input *GetStreamingDistribut
 	return
 }
 
+// GetStreamingDistributionConfigWithContext is the same as GetStreamingDistributionConfig with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) GetStreamingDistributionConfigWithContext(ctx context.Context, input *GetStreamingDistributionConfigInput) (output *GetStreamingDistributionConfigOutput, err error) {
+	req, out := c.GetStreamingDistributionConfigRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetStreamingDistributionConfig *aws.Operation
 
 // ListCloudFrontOriginAccessIdentitiesRequest generates a request for the ListCloudFrontOriginAccessIdentities operation.
@@ -484,6 +639,12 @@ func (c *CloudFront) ListCloudFrontOriginAccessIdentitiesRequest(input *ListClou
 			Name:       "ListCloudFrontOriginAccessIdentities2014_11_06",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2014-11-06/origin-access-identity/cloudfront",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"CloudFrontOriginAccessIdentityList.NextMarker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "CloudFrontOriginAccessIdentityList.IsTruncated",
+			},
 		}
 	}
 
@@ -505,6 +666,27 @@ func (c *CloudFront) ListCloudFrontOriginAccessIdentities(input *ListCloudFrontO
 	return
 }
 
+// ListCloudFrontOriginAccessIdentitiesWithContext is the same as ListCloudFrontOriginAccessIdentities with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) ListCloudFrontOriginAccessIdentitiesWithContext(ctx context.Context, input *ListCloudFrontOriginAccessIdentitiesInput) (output *ListCloudFrontOriginAccessIdentitiesOutput, err error) {
+	req, out := c.ListCloudFrontOriginAccessIdentitiesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListCloudFrontOriginAccessIdentitiesPages iterates over the pages of a ListCloudFrontOriginAccessIdentities operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *CloudFront) ListCloudFrontOriginAccessIdentitiesPages(input *ListCloudFrontOriginAccessIdentitiesInput, fn func(p *ListCloudFrontOriginAccessIdentitiesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListCloudFrontOriginAccessIdentitiesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListCloudFrontOriginAccessIdentitiesOutput), lastPage)
+	})
+}
+
 var opListCloudFrontOriginAccessIdentities *aws.Operation
 
 // ListDistributionsRequest generates a request for the ListDistributions operation.
@@ -517,6 +699,12 @@ func (c *CloudFront) ListDistributionsRequest(input *ListDistributionsInput) (re
 			Name:       "ListDistributions2014_11_06",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2014-11-06/distribution",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"DistributionList.NextMarker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "DistributionList.IsTruncated",
+			},
 		}
 	}
 
@@ -538,6 +726,27 @@ func (c *CloudFront) ListDistributions(input *ListDistributionsInput) (output *L
 	return
 }
 
+// ListDistributionsWithContext is the same as ListDistributions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) ListDistributionsWithContext(ctx context.Context, input *ListDistributionsInput) (output *ListDistributionsOutput, err error) {
+	req, out := c.ListDistributionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListDistributionsPages iterates over the pages of a ListDistributions operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *CloudFront) ListDistributionsPages(input *ListDistributionsInput, fn func(p *ListDistributionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListDistributionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListDistributionsOutput), lastPage)
+	})
+}
+
 var opListDistributions *aws.Operation
 
 // ListInvalidationsRequest generates a request for the ListInvalidations operation.
@@ -550,6 +759,12 @@ func (c *CloudFront) ListInvalidationsRequest(input *ListInvalidationsInput) (re
 			Name:       "ListInvalidations2014_11_06",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2014-11-06/distribution/{DistributionId}/invalidation",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"InvalidationList.NextMarker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "InvalidationList.IsTruncated",
+			},
 		}
 	}
 
@@ -571,6 +786,27 @@ func (c *CloudFront) ListInvalidations(input *ListInvalidationsInput) (output *L
 	return
 }
 
+// ListInvalidationsWithContext is the same as ListInvalidations with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) ListInvalidationsWithContext(ctx context.Context, input *ListInvalidationsInput) (output *ListInvalidationsOutput, err error) {
+	req, out := c.ListInvalidationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListInvalidationsPages iterates over the pages of a ListInvalidations operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *CloudFront) ListInvalidationsPages(input *ListInvalidationsInput, fn func(p *ListInvalidationsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListInvalidationsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListInvalidationsOutput), lastPage)
+	})
+}
+
 var opListInvalidations *aws.Operation
 
 // ListStreamingDistributionsRequest generates a request for the ListStreamingDistributions operation.
@@ -583,6 +819,12 @@ func (c *CloudFront) ListStreamingDistributionsRequest(input *ListStreamingDistr
 			Name:       "ListStreamingDistributions2014_11_06",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2014-11-06/streaming-distribution",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"StreamingDistributionList.NextMarker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "StreamingDistributionList.IsTruncated",
+			},
 		}
 	}
 
@@ -604,6 +846,27 @@ func (c *CloudFront) ListStreamingDistributions(input *ListStreamingDistribution
 	return
 }
 
+// ListStreamingDistributionsWithContext is the same as ListStreamingDistributions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) ListStreamingDistributionsWithContext(ctx context.Context, input *ListStreamingDistributionsInput) (output *ListStreamingDistributionsOutput, err error) {
+	req, out := c.ListStreamingDistributionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListStreamingDistributionsPages iterates over the pages of a ListStreamingDistributions operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *CloudFront) ListStreamingDistributionsPages(input *ListStreamingDistributionsInput, fn func(p *ListStreamingDistributionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListStreamingDistributionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListStreamingDistributionsOutput), lastPage)
+	})
+}
+
 var opListStreamingDistributions *aws.Operation
 
 // UpdateCloudFrontOriginAccessIdentityRequest generates a request for the UpdateCloudFrontOriginAccessIdentity operation.
@@ -637,6 +900,17 @@ func (c *CloudFront) UpdateCloudFrontOriginAccessIdentity(input *UpdateCloudFron
 	return
 }
 
+// UpdateCloudFrontOriginAccessIdentityWithContext is the same as UpdateCloudFrontOriginAccessIdentity with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) UpdateCloudFrontOriginAccessIdentityWithContext(ctx context.Context, input *UpdateCloudFrontOriginAccessIdentityInput) (output *UpdateCloudFrontOriginAccessIdentityOutput, err error) {
+	req, out := c.UpdateCloudFrontOriginAccessIdentityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateCloudFrontOriginAccessIdentity *aws.Operation
 
 // UpdateDistributionRequest generates a request for the UpdateDistribution operation.
@@ -670,6 +944,17 @@ func (c *CloudFront) UpdateDistribution(input *UpdateDistributionInput) (output
 	return
 }
 
+// UpdateDistributionWithContext is the same as UpdateDistribution with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) UpdateDistributionWithContext(ctx context.Context, input *UpdateDistributionInput) (output *UpdateDistributionOutput, err error) {
+	req, out := c.UpdateDistributionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateDistribution *aws.Operation
 
 // UpdateStreamingDistributionRequest generates a request for the UpdateStreamingDistribution operation.
@@ -703,6 +988,17 @@ func (c *CloudFront) UpdateStreamingDistribution(input *UpdateStreamingDistribut
 	return
 }
 
+// UpdateStreamingDistributionWithContext is the same as UpdateStreamingDistribution with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudFront) UpdateStreamingDistributionWithContext(ctx context.Context, input *UpdateStreamingDistributionInput) (output *UpdateStreamingDistributionOutput, err error) {
+	req, out := c.UpdateStreamingDistributionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateStreamingDistribution *aws.Operation
 
 // A complex type that lists the AWS accounts, if any, that you included in
@@ -854,7 +1150,7 @@ type CacheBehavior struct {
 	// request with an HTTP status code of 301 (Moved Permanently) and the HTTPS
 	// URL, specify redirect-to-https. The viewer then resubmits the request using
 	// the HTTPS URL.
-	ViewerProtocolPolicy *string `type:"string" required:"true"`
+	ViewerProtocolPolicy *string `type:"string" enum:"allow-all,https-only,redirect-to-https" required:"true"`
 
 	metadataCacheBehavior `json:"-", xml:"-"`
 }
@@ -1026,7 +1322,7 @@ type CookiePreference struct {
 	// to the origin that is associated with this cache behavior. You can specify
 	// all, none or whitelist. If you choose All, CloudFront forwards all cookies
 	// regardless of how many your application uses.
-	Forward *string `type:"string" required:"true"`
+	Forward *string `type:"string" enum:"none,whitelist,all" required:"true"`
 
 	// A complex type that specifies the whitelisted cookies, if any, that you want
 	// CloudFront to forward to your origin that is associated with this cache behavior.
@@ -1229,7 +1525,7 @@ type CustomOriginConfig struct {
 	HTTPSPort *int64 `type:"integer" required:"true"`
 
 	// The origin protocol policy to apply to your origin.
-	OriginProtocolPolicy *string `type:"string" required:"true"`
+	OriginProtocolPolicy *string `type:"string" enum:"http-only,match-viewer" required:"true"`
 
 	metadataCustomOriginConfig `json:"-", xml:"-"`
 }
@@ -1292,7 +1588,7 @@ type DefaultCacheBehavior struct {
 	// request with an HTTP status code of 301 (Moved Permanently) and the HTTPS
 	// URL, specify redirect-to-https. The viewer then resubmits the request using
 	// the HTTPS URL.
-	ViewerProtocolPolicy *string `type:"string" required:"true"`
+	ViewerProtocolPolicy *string `type:"string" enum:"allow-all,https-only,redirect-to-https" required:"true"`
 
 	metadataDefaultCacheBehavior `json:"-", xml:"-"`
 }
@@ -1464,7 +1760,7 @@ type DistributionConfig struct {
 	Origins *Origins `type:"structure" required:"true"`
 
 	// A complex type that contains information about price class for this distribution.
-	PriceClass *string `type:"string"`
+	PriceClass *string `type:"string" enum:"PriceClass_100,PriceClass_200,PriceClass_All"`
 
 	// A complex type that identifies ways in which you want to restrict distribution
 	// of your content.
@@ -1549,7 +1845,7 @@ type DistributionSummary struct {
 	// A complex type that contains information about origins for this distribution.
 	Origins *Origins `type:"structure" required:"true"`
 
-	PriceClass *string `type:"string" required:"true"`
+	PriceClass *string `type:"string" enum:"PriceClass_100,PriceClass_200,PriceClass_All" required:"true"`
 
 	// A complex type that identifies ways in which you want to restrict distribution
 	// of your content.
@@ -1622,7 +1918,7 @@ type GeoRestriction struct {
 	// specify the countries in which you do not want CloudFront to distribute your
 	// content. - whitelist: The Location elements specify the countries in which
 	// you want CloudFront to distribute your content.
-	RestrictionType *string `type:"string" required:"true"`
+	RestrictionType *string `type:"string" enum:"blacklist,whitelist,none" required:"true"`
 
 	metadataGeoRestriction `json:"-", xml:"-"`
 }
@@ -2172,7 +2468,7 @@ type metadataOrigin struct {
 // A complex type that contains information about origins for this distribution.
 type Origins struct {
 	// A complex type that contains origins for this distribution.
-	Items []*Origin `locationNameList:"Origin" type:"list"`
+	Items []*Origin `locationNameList:"Origin" type:"list" min:"1"`
 
 	// The number of origins for this distribution.
 	Quantity *int64 `type:"integer" required:"true"`
@@ -2345,7 +2641,7 @@ type StreamingDistributionConfig struct {
 
 	// A complex type that contains information about price class for this streaming
 	// distribution.
-	PriceClass *string `type:"string"`
+	PriceClass *string `type:"string" enum:"PriceClass_100,PriceClass_200,PriceClass_All"`
 
 	// A complex type that contains information about the Amazon S3 bucket from
 	// which you want CloudFront to get your media files for distribution.
@@ -2426,7 +2722,7 @@ type StreamingDistributionSummary struct {
 	// The date and time the distribution was last modified.
 	LastModifiedTime *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
-	PriceClass *string `type:"string" required:"true"`
+	PriceClass *string `type:"string" enum:"PriceClass_100,PriceClass_200,PriceClass_All" required:"true"`
 
 	// A complex type that contains information about the Amazon S3 bucket from
 	// which you want CloudFront to get your media files for distribution.
@@ -2642,7 +2938,7 @@ type ViewerCertificate struct {
 	// If you're using a custom certificate (if you specify a value for IAMCertificateId)
 	// and if you're using SNI (if you specify sni-only for SSLSupportMethod), you
 	// must specify TLSv1 for MinimumProtocolVersion.
-	MinimumProtocolVersion *string `type:"string"`
+	MinimumProtocolVersion *string `type:"string" enum:"SSLv3,TLSv1"`
 
 	// If you specify a value for IAMCertificateId, you must also specify how you
 	// want CloudFront to serve HTTPS requests. Valid values are vip and sni-only.
@@ -2653,11 +2949,141 @@ type ViewerCertificate struct {
 	// viewers that support Server Name Indication (SNI). All modern browsers support
 	// SNI, but some browsers still in use don't support SNI. Do not specify a value
 	// for SSLSupportMethod if you specified true for CloudFrontDefaultCertificate.
-	SSLSupportMethod *string `type:"string"`
+	SSLSupportMethod *string `type:"string" enum:"sni-only,vip"`
 
 	metadataViewerCertificate `json:"-", xml:"-"`
 }
 
 type metadataViewerCertificate struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for GeoRestrictionType.
+const (
+	GeoRestrictionTypeBlacklist = "blacklist"
+	GeoRestrictionTypeWhitelist = "whitelist"
+	GeoRestrictionTypeNone      = "none"
+)
+
+// GeoRestrictionTypeValues returns the valid values of GeoRestrictionType.
+func GeoRestrictionTypeValues() []string {
+	return []string{
+		GeoRestrictionTypeBlacklist,
+		GeoRestrictionTypeWhitelist,
+		GeoRestrictionTypeNone,
+	}
+}
+
+// Enum values for ItemSelection.
+const (
+	ItemSelectionNone      = "none"
+	ItemSelectionWhitelist = "whitelist"
+	ItemSelectionAll       = "all"
+)
+
+// ItemSelectionValues returns the valid values of ItemSelection.
+func ItemSelectionValues() []string {
+	return []string{
+		ItemSelectionNone,
+		ItemSelectionWhitelist,
+		ItemSelectionAll,
+	}
+}
+
+// Enum values for Method.
+const (
+	MethodGet     = "GET"
+	MethodHead    = "HEAD"
+	MethodPost    = "POST"
+	MethodPut     = "PUT"
+	MethodPatch   = "PATCH"
+	MethodOptions = "OPTIONS"
+	MethodDelete  = "DELETE"
+)
+
+// MethodValues returns the valid values of Method.
+func MethodValues() []string {
+	return []string{
+		MethodGet,
+		MethodHead,
+		MethodPost,
+		MethodPut,
+		MethodPatch,
+		MethodOptions,
+		MethodDelete,
+	}
+}
+
+// Enum values for MinimumProtocolVersion.
+const (
+	MinimumProtocolVersionSSLv3 = "SSLv3"
+	MinimumProtocolVersionTLSv1 = "TLSv1"
+)
+
+// MinimumProtocolVersionValues returns the valid values of MinimumProtocolVersion.
+func MinimumProtocolVersionValues() []string {
+	return []string{
+		MinimumProtocolVersionSSLv3,
+		MinimumProtocolVersionTLSv1,
+	}
+}
+
+// Enum values for OriginProtocolPolicy.
+const (
+	OriginProtocolPolicyHTTPOnly    = "http-only"
+	OriginProtocolPolicyMatchViewer = "match-viewer"
+)
+
+// OriginProtocolPolicyValues returns the valid values of OriginProtocolPolicy.
+func OriginProtocolPolicyValues() []string {
+	return []string{
+		OriginProtocolPolicyHTTPOnly,
+		OriginProtocolPolicyMatchViewer,
+	}
+}
+
+// Enum values for PriceClass.
+const (
+	PriceClassPriceClass100 = "PriceClass_100"
+	PriceClassPriceClass200 = "PriceClass_200"
+	PriceClassPriceClassAll = "PriceClass_All"
+)
+
+// PriceClassValues returns the valid values of PriceClass.
+func PriceClassValues() []string {
+	return []string{
+		PriceClassPriceClass100,
+		PriceClassPriceClass200,
+		PriceClassPriceClassAll,
+	}
+}
+
+// Enum values for SSLSupportMethod.
+const (
+	SSLSupportMethodSniOnly = "sni-only"
+	SSLSupportMethodVip     = "vip"
+)
+
+// SSLSupportMethodValues returns the valid values of SSLSupportMethod.
+func SSLSupportMethodValues() []string {
+	return []string{
+		SSLSupportMethodSniOnly,
+		SSLSupportMethodVip,
+	}
+}
+
+// Enum values for ViewerProtocolPolicy.
+const (
+	ViewerProtocolPolicyAllowAll        = "allow-all"
+	ViewerProtocolPolicyHttpsOnly       = "https-only"
+	ViewerProtocolPolicyRedirectToHttps = "redirect-to-https"
+)
+
+// ViewerProtocolPolicyValues returns the valid values of ViewerProtocolPolicy.
+func ViewerProtocolPolicyValues() []string {
+	return []string{
+		ViewerProtocolPolicyAllowAll,
+		ViewerProtocolPolicyHttpsOnly,
+		ViewerProtocolPolicyRedirectToHttps,
+	}
 }
\ No newline at end of file
@@ -0,0 +1,357 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package cloudfront
+
+import (
+	"github.com/datacratic/aws-sdk-go/aws"
+)
+
+// Error codes of the CloudFront errors. Errors with these codes are
+// returned as the typed error of the same name, e.g. an error with the code
+// ErrCodeFoo is a *Foo.
+const (
+	ErrCodeAccessDenied                                = "AccessDenied"
+	ErrCodeBatchTooLarge                               = "BatchTooLarge"
+	ErrCodeCNAMEAlreadyExists                          = "CNAMEAlreadyExists"
+	ErrCodeCloudFrontOriginAccessIdentityAlreadyExists = "CloudFrontOriginAccessIdentityAlreadyExists"
+	ErrCodeCloudFrontOriginAccessIdentityInUse         = "CloudFrontOriginAccessIdentityInUse"
+	ErrCodeDistributionAlreadyExists                   = "DistributionAlreadyExists"
+	ErrCodeDistributionNotDisabled                     = "DistributionNotDisabled"
+	ErrCodeIllegalUpdate                               = "IllegalUpdate"
+	ErrCodeInconsistentQuantities                      = "InconsistentQuantities"
+	ErrCodeInvalidArgument                             = "InvalidArgument"
+	ErrCodeInvalidDefaultRootObject                    = "InvalidDefaultRootObject"
+	ErrCodeInvalidErrorCode                            = "InvalidErrorCode"
+	ErrCodeInvalidForwardCookies                       = "InvalidForwardCookies"
+	ErrCodeInvalidGeoRestrictionParameter              = "InvalidGeoRestrictionParameter"
+	ErrCodeInvalidHeadersForS3Origin                   = "InvalidHeadersForS3Origin"
+	ErrCodeInvalidIfMatchVersion                       = "InvalidIfMatchVersion"
+	ErrCodeInvalidLocationCode                         = "InvalidLocationCode"
+	ErrCodeInvalidOrigin                               = "InvalidOrigin"
+	ErrCodeInvalidOriginAccessIdentity                 = "InvalidOriginAccessIdentity"
+	ErrCodeInvalidProtocolSettings                     = "InvalidProtocolSettings"
+	ErrCodeInvalidRelativePath                         = "InvalidRelativePath"
+	ErrCodeInvalidRequiredProtocol                     = "InvalidRequiredProtocol"
+	ErrCodeInvalidResponseCode                         = "InvalidResponseCode"
+	ErrCodeInvalidViewerCertificate                    = "InvalidViewerCertificate"
+	ErrCodeMissingBody                                 = "MissingBody"
+	ErrCodeNoSuchCloudFrontOriginAccessIdentity        = "NoSuchCloudFrontOriginAccessIdentity"
+	ErrCodeNoSuchDistribution                          = "NoSuchDistribution"
+	ErrCodeNoSuchInvalidation                          = "NoSuchInvalidation"
+	ErrCodeNoSuchOrigin                                = "NoSuchOrigin"
+	ErrCodeNoSuchStreamingDistribution                 = "NoSuchStreamingDistribution"
+	ErrCodePreconditionFailed                          = "PreconditionFailed"
+	ErrCodeStreamingDistributionAlreadyExists          = "StreamingDistributionAlreadyExists"
+	ErrCodeStreamingDistributionNotDisabled            = "StreamingDistributionNotDisabled"
+	ErrCodeTooManyCacheBehaviors                       = "TooManyCacheBehaviors"
+	ErrCodeTooManyCertificates                         = "TooManyCertificates"
+	ErrCodeTooManyCloudFrontOriginAccessIdentities     = "TooManyCloudFrontOriginAccessIdentities"
+	ErrCodeTooManyCookieNamesInWhiteList               = "TooManyCookieNamesInWhiteList"
+	ErrCodeTooManyDistributionCNAMEs                   = "TooManyDistributionCNAMEs"
+	ErrCodeTooManyDistributions                        = "TooManyDistributions"
+	ErrCodeTooManyHeadersInForwardedValues             = "TooManyHeadersInForwardedValues"
+	ErrCodeTooManyInvalidationsInProgress              = "TooManyInvalidationsInProgress"
+	ErrCodeTooManyOrigins                              = "TooManyOrigins"
+	ErrCodeTooManyStreamingDistributionCNAMEs          = "TooManyStreamingDistributionCNAMEs"
+	ErrCodeTooManyStreamingDistributions               = "TooManyStreamingDistributions"
+	ErrCodeTooManyTrustedSigners                       = "TooManyTrustedSigners"
+	ErrCodeTrustedSignerDoesNotExist                   = "TrustedSignerDoesNotExist"
+)
+
+// Access denied.
+type AccessDenied struct {
+	aws.APIError
+}
+
+// BatchTooLarge is returned with the ErrCodeBatchTooLarge error code.
+type BatchTooLarge struct {
+	aws.APIError
+}
+
+// CNAMEAlreadyExists is returned with the ErrCodeCNAMEAlreadyExists error code.
+type CNAMEAlreadyExists struct {
+	aws.APIError
+}
+
+// If the CallerReference is a value you already sent in a previous request
+// to create an identity but the content of the CloudFrontOriginAccessIdentityConfig
+// is different from the original request, CloudFront returns a CloudFrontOriginAccessIdentityAlreadyExists
+// error.
+type CloudFrontOriginAccessIdentityAlreadyExists struct {
+	aws.APIError
+}
+
+// CloudFrontOriginAccessIdentityInUse is returned with the ErrCodeCloudFrontOriginAccessIdentityInUse error code.
+type CloudFrontOriginAccessIdentityInUse struct {
+	aws.APIError
+}
+
+// The caller reference you attempted to create the distribution with is associated
+// with another distribution.
+type DistributionAlreadyExists struct {
+	aws.APIError
+}
+
+// DistributionNotDisabled is returned with the ErrCodeDistributionNotDisabled error code.
+type DistributionNotDisabled struct {
+	aws.APIError
+}
+
+// Origin and CallerReference cannot be updated.
+type IllegalUpdate struct {
+	aws.APIError
+}
+
+// The value of Quantity and the size of Items do not match.
+type InconsistentQuantities struct {
+	aws.APIError
+}
+
+// The argument is invalid.
+type InvalidArgument struct {
+	aws.APIError
+}
+
+// The default root object file name is too big or contains an invalid character.
+type InvalidDefaultRootObject struct {
+	aws.APIError
+}
+
+// InvalidErrorCode is returned with the ErrCodeInvalidErrorCode error code.
+type InvalidErrorCode struct {
+	aws.APIError
+}
+
+// Your request contains forward cookies option which doesn't match with the
+// expectation for the whitelisted list of cookie names. Either list of cookie
+// names has been specified when not allowed or list of cookie names is missing
+// when expected.
+type InvalidForwardCookies struct {
+	aws.APIError
+}
+
+// InvalidGeoRestrictionParameter is returned with the ErrCodeInvalidGeoRestrictionParameter error code.
+type InvalidGeoRestrictionParameter struct {
+	aws.APIError
+}
+
+// InvalidHeadersForS3Origin is returned with the ErrCodeInvalidHeadersForS3Origin error code.
+type InvalidHeadersForS3Origin struct {
+	aws.APIError
+}
+
+// The If-Match version is missing or not valid for the distribution.
+type InvalidIfMatchVersion struct {
+	aws.APIError
+}
+
+// InvalidLocationCode is returned with the ErrCodeInvalidLocationCode error code.
+type InvalidLocationCode struct {
+	aws.APIError
+}
+
+// The Amazon S3 origin server specified does not refer to a valid Amazon S3
+// bucket.
+type InvalidOrigin struct {
+	aws.APIError
+}
+
+// The origin access identity is not valid or doesn't exist.
+type InvalidOriginAccessIdentity struct {
+	aws.APIError
+}
+
+// You cannot specify SSLv3 as the minimum protocol version if you only want
+// to support only clients that Support Server Name Indication (SNI).
+type InvalidProtocolSettings struct {
+	aws.APIError
+}
+
+// The relative path is too big, is not URL-encoded, or does not begin with
+// a slash (/).
+type InvalidRelativePath struct {
+	aws.APIError
+}
+
+// This operation requires the HTTPS protocol. Ensure that you specify the HTTPS
+// protocol in your request, or omit the RequiredProtocols element from your
+// distribution configuration.
+type InvalidRequiredProtocol struct {
+	aws.APIError
+}
+
+// InvalidResponseCode is returned with the ErrCodeInvalidResponseCode error code.
+type InvalidResponseCode struct {
+	aws.APIError
+}
+
+// InvalidViewerCertificate is returned with the ErrCodeInvalidViewerCertificate error code.
+type InvalidViewerCertificate struct {
+	aws.APIError
+}
+
+// This operation requires a body. Ensure that the body is present and the Content-Type
+// header is set.
+type MissingBody struct {
+	aws.APIError
+}
+
+// The specified origin access identity does not exist.
+type NoSuchCloudFrontOriginAccessIdentity struct {
+	aws.APIError
+}
+
+// The specified distribution does not exist.
+type NoSuchDistribution struct {
+	aws.APIError
+}
+
+// The specified invalidation does not exist.
+type NoSuchInvalidation struct {
+	aws.APIError
+}
+
+// No origin exists with the specified Origin Id.
+type NoSuchOrigin struct {
+	aws.APIError
+}
+
+// The specified streaming distribution does not exist.
+type NoSuchStreamingDistribution struct {
+	aws.APIError
+}
+
+// The precondition given in one or more of the request-header fields evaluated
+// to false.
+type PreconditionFailed struct {
+	aws.APIError
+}
+
+// StreamingDistributionAlreadyExists is returned with the ErrCodeStreamingDistributionAlreadyExists error code.
+type StreamingDistributionAlreadyExists struct {
+	aws.APIError
+}
+
+// StreamingDistributionNotDisabled is returned with the ErrCodeStreamingDistributionNotDisabled error code.
+type StreamingDistributionNotDisabled struct {
+	aws.APIError
+}
+
+// You cannot create anymore cache behaviors for the distribution.
+type TooManyCacheBehaviors struct {
+	aws.APIError
+}
+
+// You cannot create anymore custom ssl certificates.
+type TooManyCertificates struct {
+	aws.APIError
+}
+
+// Processing your request would cause you to exceed the maximum number of origin
+// access identities allowed.
+type TooManyCloudFrontOriginAccessIdentities struct {
+	aws.APIError
+}
+
+// Your request contains more cookie names in the whitelist than are allowed
+// per cache behavior.
+type TooManyCookieNamesInWhiteList struct {
+	aws.APIError
+}
+
+// Your request contains more CNAMEs than are allowed per distribution.
+type TooManyDistributionCNAMEs struct {
+	aws.APIError
+}
+
+// Processing your request would cause you to exceed the maximum number of distributions
+// allowed.
+type TooManyDistributions struct {
+	aws.APIError
+}
+
+// TooManyHeadersInForwardedValues is returned with the ErrCodeTooManyHeadersInForwardedValues error code.
+type TooManyHeadersInForwardedValues struct {
+	aws.APIError
+}
+
+// You have exceeded the maximum number of allowable InProgress invalidation
+// batch requests, or invalidation objects.
+type TooManyInvalidationsInProgress struct {
+	aws.APIError
+}
+
+// You cannot create anymore origins for the distribution.
+type TooManyOrigins struct {
+	aws.APIError
+}
+
+// TooManyStreamingDistributionCNAMEs is returned with the ErrCodeTooManyStreamingDistributionCNAMEs error code.
+type TooManyStreamingDistributionCNAMEs struct {
+	aws.APIError
+}
+
+// Processing your request would cause you to exceed the maximum number of streaming
+// distributions allowed.
+type TooManyStreamingDistributions struct {
+	aws.APIError
+}
+
+// Your request contains more trusted signers than are allowed per distribution.
+type TooManyTrustedSigners struct {
+	aws.APIError
+}
+
+// One or more of your trusted signers do not exist.
+type TrustedSignerDoesNotExist struct {
+	aws.APIError
+}
+
+var errorTypes = map[string]func() aws.ServiceError{
+	ErrCodeAccessDenied:                                func() aws.ServiceError { return &AccessDenied{} },
+	ErrCodeBatchTooLarge:                               func() aws.ServiceError { return &BatchTooLarge{} },
+	ErrCodeCNAMEAlreadyExists:                          func() aws.ServiceError { return &CNAMEAlreadyExists{} },
+	ErrCodeCloudFrontOriginAccessIdentityAlreadyExists: func() aws.ServiceError { return &CloudFrontOriginAccessIdentityAlreadyExists{} },
+	ErrCodeCloudFrontOriginAccessIdentityInUse:         func() aws.ServiceError { return &CloudFrontOriginAccessIdentityInUse{} },
+	ErrCodeDistributionAlreadyExists:                   func() aws.ServiceError { return &DistributionAlreadyExists{} },
+	ErrCodeDistributionNotDisabled:                     func() aws.ServiceError { return &DistributionNotDisabled{} },
+	ErrCodeIllegalUpdate:                               func() aws.ServiceError { return &IllegalUpdate{} },
+	ErrCodeInconsistentQuantities:                      func() aws.ServiceError { return &InconsistentQuantities{} },
+	ErrCodeInvalidArgument:                             func() aws.ServiceError { return &InvalidArgument{} },
+	ErrCodeInvalidDefaultRootObject:                    func() aws.ServiceError { return &InvalidDefaultRootObject{} },
+	ErrCodeInvalidErrorCode:                            func() aws.ServiceError { return &InvalidErrorCode{} },
+	ErrCodeInvalidForwardCookies:                       func() aws.ServiceError { return &InvalidForwardCookies{} },
+	ErrCodeInvalidGeoRestrictionParameter:              func() aws.ServiceError { return &InvalidGeoRestrictionParameter{} },
+	ErrCodeInvalidHeadersForS3Origin:                   func() aws.ServiceError { return &InvalidHeadersForS3Origin{} },
+	ErrCodeInvalidIfMatchVersion:                       func() aws.ServiceError { return &InvalidIfMatchVersion{} },
+	ErrCodeInvalidLocationCode:                         func() aws.ServiceError { return &InvalidLocationCode{} },
+	ErrCodeInvalidOrigin:                               func() aws.ServiceError { return &InvalidOrigin{} },
+	ErrCodeInvalidOriginAccessIdentity:                 func() aws.ServiceError { return &InvalidOriginAccessIdentity{} },
+	ErrCodeInvalidProtocolSettings:                     func() aws.ServiceError { return &InvalidProtocolSettings{} },
+	ErrCodeInvalidRelativePath:                         func() aws.ServiceError { return &InvalidRelativePath{} },
+	ErrCodeInvalidRequiredProtocol:                     func() aws.ServiceError { return &InvalidRequiredProtocol{} },
+	ErrCodeInvalidResponseCode:                         func() aws.ServiceError { return &InvalidResponseCode{} },
+	ErrCodeInvalidViewerCertificate:                    func() aws.ServiceError { return &InvalidViewerCertificate{} },
+	ErrCodeMissingBody:                                 func() aws.ServiceError { return &MissingBody{} },
+	ErrCodeNoSuchCloudFrontOriginAccessIdentity:        func() aws.ServiceError { return &NoSuchCloudFrontOriginAccessIdentity{} },
+	ErrCodeNoSuchDistribution:                          func() aws.ServiceError { return &NoSuchDistribution{} },
+	ErrCodeNoSuchInvalidation:                          func() aws.ServiceError { return &NoSuchInvalidation{} },
+	ErrCodeNoSuchOrigin:                                func() aws.ServiceError { return &NoSuchOrigin{} },
+	ErrCodeNoSuchStreamingDistribution:                 func() aws.ServiceError { return &NoSuchStreamingDistribution{} },
+	ErrCodePreconditionFailed:                          func() aws.ServiceError { return &PreconditionFailed{} },
+	ErrCodeStreamingDistributionAlreadyExists:          func() aws.ServiceError { return &StreamingDistributionAlreadyExists{} },
+	ErrCodeStreamingDistributionNotDisabled:            func() aws.ServiceError { return &StreamingDistributionNotDisabled{} },
+	ErrCodeTooManyCacheBehaviors:                       func() aws.ServiceError { return &TooManyCacheBehaviors{} },
+	ErrCodeTooManyCertificates:                         func() aws.ServiceError { return &TooManyCertificates{} },
+	ErrCodeTooManyCloudFrontOriginAccessIdentities:     func() aws.ServiceError { return &TooManyCloudFrontOriginAccessIdentities{} },
+	ErrCodeTooManyCookieNamesInWhiteList:               func() aws.ServiceError { return &TooManyCookieNamesInWhiteList{} },
+	ErrCodeTooManyDistributionCNAMEs:                   func() aws.ServiceError { return &TooManyDistributionCNAMEs{} },
+	ErrCodeTooManyDistributions:                        func() aws.ServiceError { return &TooManyDistributions{} },
+	ErrCodeTooManyHeadersInForwardedValues:             func() aws.ServiceError { return &TooManyHeadersInForwardedValues{} },
+	ErrCodeTooManyInvalidationsInProgress:              func() aws.ServiceError { return &TooManyInvalidationsInProgress{} },
+	ErrCodeTooManyOrigins:                              func() aws.ServiceError { return &TooManyOrigins{} },
+	ErrCodeTooManyStreamingDistributionCNAMEs:          func() aws.ServiceError { return &TooManyStreamingDistributionCNAMEs{} },
+	ErrCodeTooManyStreamingDistributions:               func() aws.ServiceError { return &TooManyStreamingDistributions{} },
+	ErrCodeTooManyTrustedSigners:                       func() aws.ServiceError { return &TooManyTrustedSigners{} },
+	ErrCodeTrustedSignerDoesNotExist:                   func() aws.ServiceError { return &TrustedSignerDoesNotExist{} },
+}
@@ -27,6 +27,7 @@ func New(config *aws.Config) *CloudFront {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "cloudfront",
 		APIVersion:  "2014-11-06",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
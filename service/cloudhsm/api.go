@@ -4,6 +4,7 @@
 package cloudhsm
 
 import (
+	"context"
 	"sync"
 
 	"github.com/datacratic/aws-sdk-go/aws"
@@ -43,6 +44,17 @@ func (c *CloudHSM) CreateHAPG(input *CreateHAPGInput) (output *CreateHAPGOutput,
 	return
 }
 
+// CreateHAPGWithContext is the same as CreateHAPG with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) CreateHAPGWithContext(ctx context.Context, input *CreateHAPGInput) (output *CreateHAPGOutput, err error) {
+	req, out := c.CreateHAPGRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateHAPG *aws.Operation
 
 // CreateHSMRequest generates a request for the CreateHSM operation.
@@ -77,6 +89,17 @@ func (c *CloudHSM) CreateHSM(input *CreateHSMInput) (output *CreateHSMOutput, er
 	return
 }
 
+// CreateHSMWithContext is the same as CreateHSM with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) CreateHSMWithContext(ctx context.Context, input *CreateHSMInput) (output *CreateHSMOutput, err error) {
+	req, out := c.CreateHSMRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateHSM *aws.Operation
 
 // CreateLunaClientRequest generates a request for the CreateLunaClient operation.
@@ -110,6 +133,17 @@ func (c *CloudHSM) CreateLunaClient(input *CreateLunaClientInput) (output *Creat
 	return
 }
 
+// CreateLunaClientWithContext is the same as CreateLunaClient with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) CreateLunaClientWithContext(ctx context.Context, input *CreateLunaClientInput) (output *CreateLunaClientOutput, err error) {
+	req, out := c.CreateLunaClientRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateLunaClient *aws.Operation
 
 // DeleteHAPGRequest generates a request for the DeleteHAPG operation.
@@ -143,6 +177,17 @@ func (c *CloudHSM) DeleteHAPG(input *DeleteHAPGInput) (output *DeleteHAPGOutput,
 	return
 }
 
+// DeleteHAPGWithContext is the same as DeleteHAPG with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) DeleteHAPGWithContext(ctx context.Context, input *DeleteHAPGInput) (output *DeleteHAPGOutput, err error) {
+	req, out := c.DeleteHAPGRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteHAPG *aws.Operation
 
 // DeleteHSMRequest generates a request for the DeleteHSM operation.
@@ -177,6 +222,17 @@ func (c *CloudHSM) DeleteHSM(input *DeleteHSMInput) (output *DeleteHSMOutput, er
 	return
 }
 
+// DeleteHSMWithContext is the same as DeleteHSM with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) DeleteHSMWithContext(ctx context.Context, input *DeleteHSMInput) (output *DeleteHSMOutput, err error) {
+	req, out := c.DeleteHSMRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteHSM *aws.Operation
 
 // DeleteLunaClientRequest generates a request for the DeleteLunaClient operation.
@@ -210,6 +266,17 @@ func (c *CloudHSM) DeleteLunaClient(input *DeleteLunaClientInput) (output *Delet
 	return
 }
 
+// DeleteLunaClientWithContext is the same as DeleteLunaClient with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) DeleteLunaClientWithContext(ctx context.Context, input *DeleteLunaClientInput) (output *DeleteLunaClientOutput, err error) {
+	req, out := c.DeleteLunaClientRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteLunaClient *aws.Operation
 
 // DescribeHAPGRequest generates a request for the DescribeHAPG operation.
@@ -243,6 +310,17 @@ func (c *CloudHSM) DescribeHAPG(input *DescribeHAPGInput) (output *DescribeHAPGO
 	return
 }
 
+// DescribeHAPGWithContext is the same as DescribeHAPG with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) DescribeHAPGWithContext(ctx context.Context, input *DescribeHAPGInput) (output *DescribeHAPGOutput, err error) {
+	req, out := c.DescribeHAPGRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeHAPG *aws.Operation
 
 // DescribeHSMRequest generates a request for the DescribeHSM operation.
@@ -277,6 +355,17 @@ func (c *CloudHSM) DescribeHSM(input *DescribeHSMInput) (output *DescribeHSMOutp
 	return
 }
 
+// DescribeHSMWithContext is the same as DescribeHSM with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) DescribeHSMWithContext(ctx context.Context, input *DescribeHSMInput) (output *DescribeHSMOutput, err error) {
+	req, out := c.DescribeHSMRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeHSM *aws.Operation
 
 // DescribeLunaClientRequest generates a request for the DescribeLunaClient operation.
@@ -310,6 +399,17 @@ func (c *CloudHSM) DescribeLunaClient(input *DescribeLunaClientInput) (output *D
 	return
 }
 
+// DescribeLunaClientWithContext is the same as DescribeLunaClient with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) DescribeLunaClientWithContext(ctx context.Context, input *DescribeLunaClientInput) (output *DescribeLunaClientOutput, err error) {
+	req, out := c.DescribeLunaClientRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeLunaClient *aws.Operation
 
 // GetConfigRequest generates a request for the GetConfig operation.
@@ -344,6 +444,17 @@ func (c *CloudHSM) GetConfig(input *GetConfigInput) (output *GetConfigOutput, er
 	return
 }
 
+// GetConfigWithContext is the same as GetConfig with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) GetConfigWithContext(ctx context.Context, input *GetConfigInput) (output *GetConfigOutput, err error) {
+	req, out := c.GetConfigRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetConfig *aws.Operation
 
 // ListAvailableZonesRequest generates a request for the ListAvailableZones operation.
@@ -377,6 +488,17 @@ func (c *CloudHSM) ListAvailableZones(input *ListAvailableZonesInput) (output *L
 	return
 }
 
+// ListAvailableZonesWithContext is the same as ListAvailableZones with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) ListAvailableZonesWithContext(ctx context.Context, input *ListAvailableZonesInput) (output *ListAvailableZonesOutput, err error) {
+	req, out := c.ListAvailableZonesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListAvailableZones *aws.Operation
 
 // ListHSMsRequest generates a request for the ListHSMs operation.
@@ -416,6 +538,17 @@ func (c *CloudHSM) ListHSMs(input *ListHSMsInput) (output *ListHSMsOutput, err e
 	return
 }
 
+// ListHSMsWithContext is the same as ListHSMs with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) ListHSMsWithContext(ctx context.Context, input *ListHSMsInput) (output *ListHSMsOutput, err error) {
+	req, out := c.ListHSMsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListHSMs *aws.Operation
 
 // ListHapgsRequest generates a request for the ListHapgs operation.
@@ -454,6 +587,17 @@ func (c *CloudHSM) ListHapgs(input *ListHapgsInput) (output *ListHapgsOutput, er
 	return
 }
 
+// ListHapgsWithContext is the same as ListHapgs with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) ListHapgsWithContext(ctx context.Context, input *ListHapgsInput) (output *ListHapgsOutput, err error) {
+	req, out := c.ListHapgsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListHapgs *aws.Operation
 
 // ListLunaClientsRequest generates a request for the ListLunaClients operation.
@@ -492,6 +636,17 @@ func (c *CloudHSM) ListLunaClients(input *ListLunaClientsInput) (output *ListLun
 	return
 }
 
+// ListLunaClientsWithContext is the same as ListLunaClients with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) ListLunaClientsWithContext(ctx context.Context, input *ListLunaClientsInput) (output *ListLunaClientsOutput, err error) {
+	req, out := c.ListLunaClientsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListLunaClients *aws.Operation
 
 // ModifyHAPGRequest generates a request for the ModifyHAPG operation.
@@ -525,6 +680,17 @@ func (c *CloudHSM) ModifyHAPG(input *ModifyHAPGInput) (output *ModifyHAPGOutput,
 	return
 }
 
+// ModifyHAPGWithContext is the same as ModifyHAPG with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) ModifyHAPGWithContext(ctx context.Context, input *ModifyHAPGInput) (output *ModifyHAPGOutput, err error) {
+	req, out := c.ModifyHAPGRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyHAPG *aws.Operation
 
 // ModifyHSMRequest generates a request for the ModifyHSM operation.
@@ -558,6 +724,17 @@ func (c *CloudHSM) ModifyHSM(input *ModifyHSMInput) (output *ModifyHSMOutput, er
 	return
 }
 
+// ModifyHSMWithContext is the same as ModifyHSM with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) ModifyHSMWithContext(ctx context.Context, input *ModifyHSMInput) (output *ModifyHSMOutput, err error) {
+	req, out := c.ModifyHSMRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyHSM *aws.Operation
 
 // ModifyLunaClientRequest generates a request for the ModifyLunaClient operation.
@@ -594,12 +771,23 @@ func (c *CloudHSM) ModifyLunaClient(input *ModifyLunaClientInput) (output *Modif
 	return
 }
 
+// ModifyLunaClientWithContext is the same as ModifyLunaClient with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudHSM) ModifyLunaClientWithContext(ctx context.Context, input *ModifyLunaClientInput) (output *ModifyLunaClientOutput, err error) {
+	req, out := c.ModifyLunaClientRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyLunaClient *aws.Operation
 
 // Contains the inputs for the CreateHapgRequest action.
 type CreateHAPGInput struct {
 	// The label of the new high-availability partition group.
-	Label *string `type:"string" required:"true"`
+	Label *string `type:"string" pattern:"[a-zA-Z0-9_.-]{1,64}" required:"true"`
 
 	metadataCreateHAPGInput `json:"-", xml:"-"`
 }
@@ -611,7 +799,7 @@ type metadataCreateHAPGInput struct {
 // Contains the output of the CreateHAPartitionGroup action.
 type CreateHAPGOutput struct {
 	// The ARN of the high-availability partition group.
-	HAPGARN *string `locationName:"HapgArn" type:"string"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}"`
 
 	metadataCreateHAPGOutput `json:"-", xml:"-"`
 }
@@ -624,29 +812,29 @@ type metadataCreateHAPGOutput struct {
 type CreateHSMInput struct {
 	// A user-defined token to ensure idempotence. Subsequent calls to this action
 	// with the same token will be ignored.
-	ClientToken *string `locationName:"ClientToken" type:"string"`
+	ClientToken *string `locationName:"ClientToken" type:"string" pattern:"[a-zA-Z0-9]{1,64}"`
 
 	// The IP address to assign to the HSM's ENI.
-	ENIIP *string `locationName:"EniIp" type:"string"`
+	ENIIP *string `locationName:"EniIp" type:"string" pattern:"\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"`
 
 	// The external ID from IamRoleArn, if present.
-	ExternalID *string `locationName:"ExternalId" type:"string"`
+	ExternalID *string `locationName:"ExternalId" type:"string" pattern:"[\\w :+=./-]*"`
 
 	// The ARN of an IAM role to enable the AWS CloudHSM service to allocate an
 	// ENI on your behalf.
-	IAMRoleARN *string `locationName:"IamRoleArn" type:"string" required:"true"`
+	IAMRoleARN *string `locationName:"IamRoleArn" type:"string" pattern:"arn:aws(-iso)?:iam::[0-9]{12}:role/[a-zA-Z0-9_\\+=,\\.\\-@]{1,64}" required:"true"`
 
 	// The SSH public key to install on the HSM.
-	SSHKey *string `locationName:"SshKey" type:"string" required:"true"`
+	SSHKey *string `locationName:"SshKey" type:"string" pattern:"[a-zA-Z0-9+/= ._:\\\\@-]*" required:"true"`
 
 	// The identifier of the subnet in your VPC in which to place the HSM.
-	SubnetID *string `locationName:"SubnetId" type:"string" required:"true"`
+	SubnetID *string `locationName:"SubnetId" type:"string" pattern:"subnet-[0-9a-f]{8}" required:"true"`
 
 	// The subscription type.
-	SubscriptionType *string `locationName:"SubscriptionType" type:"string" required:"true"`
+	SubscriptionType *string `locationName:"SubscriptionType" type:"string" enum:"PRODUCTION" required:"true"`
 
 	// The IP address for the syslog monitoring server.
-	SyslogIP *string `locationName:"SyslogIp" type:"string"`
+	SyslogIP *string `locationName:"SyslogIp" type:"string" pattern:"\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"`
 
 	metadataCreateHSMInput `json:"-", xml:"-"`
 }
@@ -658,7 +846,7 @@ type metadataCreateHSMInput struct {
 // Contains the output of the CreateHsm action.
 type CreateHSMOutput struct {
 	// The ARN of the HSM.
-	HSMARN *string `locationName:"HsmArn" type:"string"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}"`
 
 	metadataCreateHSMOutput `json:"-", xml:"-"`
 }
@@ -671,10 +859,10 @@ type metadataCreateHSMOutput struct {
 type CreateLunaClientInput struct {
 	// The contents of a Base64-Encoded X.509 v3 certificate to be installed on
 	// the HSMs used by this client.
-	Certificate *string `type:"string" required:"true"`
+	Certificate *string `type:"string" min:"600" max:"2400" pattern:"[\\w :+=./\\n-]*" required:"true"`
 
 	// The label for the client.
-	Label *string `type:"string"`
+	Label *string `type:"string" pattern:"[a-zA-Z0-9_.-]{2,64}"`
 
 	metadataCreateLunaClientInput `json:"-", xml:"-"`
 }
@@ -686,7 +874,7 @@ type metadataCreateLunaClientInput struct {
 // Contains the output of the CreateLunaClient action.
 type CreateLunaClientOutput struct {
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}"`
 
 	metadataCreateLunaClientOutput `json:"-", xml:"-"`
 }
@@ -698,7 +886,7 @@ type metadataCreateLunaClientOutput struct {
 // Contains the inputs for the DeleteHapg action.
 type DeleteHAPGInput struct {
 	// The ARN of the high-availability partition group to delete.
-	HAPGARN *string `locationName:"HapgArn" type:"string" required:"true"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}" required:"true"`
 
 	metadataDeleteHAPGInput `json:"-", xml:"-"`
 }
@@ -710,7 +898,7 @@ type metadataDeleteHAPGInput struct {
 // Contains the output of the DeleteHapg action.
 type DeleteHAPGOutput struct {
 	// The status of the action.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" pattern:"[\\w :+=./\\\\-]*" required:"true"`
 
 	metadataDeleteHAPGOutput `json:"-", xml:"-"`
 }
@@ -722,7 +910,7 @@ type metadataDeleteHAPGOutput struct {
 // Contains the inputs for the DeleteHsm action.
 type DeleteHSMInput struct {
 	// The ARN of the HSM to delete.
-	HSMARN *string `locationName:"HsmArn" type:"string" required:"true"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}" required:"true"`
 
 	metadataDeleteHSMInput `json:"-", xml:"-"`
 }
@@ -734,7 +922,7 @@ type metadataDeleteHSMInput struct {
 // Contains the output of the DeleteHsm action.
 type DeleteHSMOutput struct {
 	// The status of the action.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" pattern:"[\\w :+=./\\\\-]*" required:"true"`
 
 	metadataDeleteHSMOutput `json:"-", xml:"-"`
 }
@@ -745,7 +933,7 @@ type metadataDeleteHSMOutput struct {
 
 type DeleteLunaClientInput struct {
 	// The ARN of the client to delete.
-	ClientARN *string `locationName:"ClientArn" type:"string" required:"true"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}" required:"true"`
 
 	metadataDeleteLunaClientInput `json:"-", xml:"-"`
 }
@@ -756,7 +944,7 @@ type metadataDeleteLunaClientInput struct {
 
 type DeleteLunaClientOutput struct {
 	// The status of the action.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" pattern:"[\\w :+=./\\\\-]*" required:"true"`
 
 	metadataDeleteLunaClientOutput `json:"-", xml:"-"`
 }
@@ -768,7 +956,7 @@ type metadataDeleteLunaClientOutput struct {
 // Contains the inputs for the DescribeHapg action.
 type DescribeHAPGInput struct {
 	// The ARN of the high-availability partition group to describe.
-	HAPGARN *string `locationName:"HapgArn" type:"string" required:"true"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}" required:"true"`
 
 	metadataDescribeHAPGInput `json:"-", xml:"-"`
 }
@@ -780,10 +968,10 @@ type metadataDescribeHAPGInput struct {
 // Contains the output of the DescribeHapg action.
 type DescribeHAPGOutput struct {
 	// The ARN of the high-availability partition group.
-	HAPGARN *string `locationName:"HapgArn" type:"string"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}"`
 
 	// The serial number of the high-availability partition group.
-	HAPGSerial *string `locationName:"HapgSerial" type:"string"`
+	HAPGSerial *string `locationName:"HapgSerial" type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// Contains a list of ARNs that identify the HSMs.
 	HSMsLastActionFailed []*string `locationName:"HsmsLastActionFailed" type:"list"`
@@ -795,17 +983,17 @@ type DescribeHAPGOutput struct {
 	HSMsPendingRegistration []*string `locationName:"HsmsPendingRegistration" type:"list"`
 
 	// The label for the high-availability partition group.
-	Label *string `type:"string"`
+	Label *string `type:"string" pattern:"[a-zA-Z0-9_.-]{1,64}"`
 
 	// The date and time the high-availability partition group was last modified.
-	LastModifiedTimestamp *string `type:"string"`
+	LastModifiedTimestamp *string `type:"string" pattern:"\\d*"`
 
 	// The list of partition serial numbers that belong to the high-availability
 	// partition group.
 	PartitionSerialList []*string `type:"list"`
 
 	// The state of the high-availability partition group.
-	State *string `type:"string"`
+	State *string `type:"string" enum:"READY,UPDATING,DEGRADED"`
 
 	metadataDescribeHAPGOutput `json:"-", xml:"-"`
 }
@@ -818,11 +1006,11 @@ type metadataDescribeHAPGOutput struct {
 type DescribeHSMInput struct {
 	// The ARN of the HSM. Either the HsmArn or the SerialNumber parameter must
 	// be specified.
-	HSMARN *string `locationName:"HsmArn" type:"string"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}"`
 
 	// The serial number of the HSM. Either the HsmArn or the HsmSerialNumber parameter
 	// must be specified.
-	HSMSerialNumber *string `locationName:"HsmSerialNumber" type:"string"`
+	HSMSerialNumber *string `locationName:"HsmSerialNumber" type:"string" pattern:"\\d{1,16}"`
 
 	metadataDescribeHSMInput `json:"-", xml:"-"`
 }
@@ -834,67 +1022,67 @@ type metadataDescribeHSMInput struct {
 // Contains the output of the DescribeHsm action.
 type DescribeHSMOutput struct {
 	// The Availability Zone that the HSM is in.
-	AvailabilityZone *string `type:"string"`
+	AvailabilityZone *string `type:"string" pattern:"[a-zA-Z0-9\\-]*"`
 
 	// The identifier of the elastic network interface (ENI) attached to the HSM.
-	ENIID *string `locationName:"EniId" type:"string"`
+	ENIID *string `locationName:"EniId" type:"string" pattern:"eni-[0-9a-f]{8}"`
 
 	// The IP address assigned to the HSM's ENI.
-	ENIIP *string `locationName:"EniIp" type:"string"`
+	ENIIP *string `locationName:"EniIp" type:"string" pattern:"\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"`
 
 	// The ARN of the HSM.
-	HSMARN *string `locationName:"HsmArn" type:"string"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}"`
 
 	// The HSM model type.
-	HSMType *string `locationName:"HsmType" type:"string"`
+	HSMType *string `locationName:"HsmType" type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The ARN of the IAM role assigned to the HSM.
-	IAMRoleARN *string `locationName:"IamRoleArn" type:"string"`
+	IAMRoleARN *string `locationName:"IamRoleArn" type:"string" pattern:"arn:aws(-iso)?:iam::[0-9]{12}:role/[a-zA-Z0-9_\\+=,\\.\\-@]{1,64}"`
 
 	// The list of partitions on the HSM.
 	Partitions []*string `type:"list"`
 
 	// The date and time the SSH key was last updated.
-	SSHKeyLastUpdated *string `locationName:"SshKeyLastUpdated" type:"string"`
+	SSHKeyLastUpdated *string `locationName:"SshKeyLastUpdated" type:"string" pattern:"\\d*"`
 
 	// The public SSH key.
-	SSHPublicKey *string `locationName:"SshPublicKey" type:"string"`
+	SSHPublicKey *string `locationName:"SshPublicKey" type:"string" pattern:"[a-zA-Z0-9+/= ._:\\\\@-]*"`
 
 	// The serial number of the HSM.
-	SerialNumber *string `type:"string"`
+	SerialNumber *string `type:"string" pattern:"\\d{1,16}"`
 
 	// The date and time the server certificate was last updated.
-	ServerCertLastUpdated *string `type:"string"`
+	ServerCertLastUpdated *string `type:"string" pattern:"\\d*"`
 
 	// The URI of the certificate server.
-	ServerCertURI *string `locationName:"ServerCertUri" type:"string"`
+	ServerCertURI *string `locationName:"ServerCertUri" type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The HSM software version.
-	SoftwareVersion *string `type:"string"`
+	SoftwareVersion *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The status of the HSM.
-	Status *string `type:"string"`
+	Status *string `type:"string" enum:"PENDING,RUNNING,UPDATING,SUSPENDED,TERMINATING,TERMINATED,DEGRADED"`
 
 	// Contains additional information about the status of the HSM.
-	StatusDetails *string `type:"string"`
+	StatusDetails *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The identifier of the subnet the HSM is in.
-	SubnetID *string `locationName:"SubnetId" type:"string"`
+	SubnetID *string `locationName:"SubnetId" type:"string" pattern:"subnet-[0-9a-f]{8}"`
 
 	// The subscription end date.
-	SubscriptionEndDate *string `type:"string"`
+	SubscriptionEndDate *string `type:"string" pattern:"\\d*"`
 
 	// The subscription start date.
-	SubscriptionStartDate *string `type:"string"`
+	SubscriptionStartDate *string `type:"string" pattern:"\\d*"`
 
 	// The subscription type.
-	SubscriptionType *string `type:"string"`
+	SubscriptionType *string `type:"string" enum:"PRODUCTION"`
 
 	// The identifier of the VPC that the HSM is in.
-	VPCID *string `locationName:"VpcId" type:"string"`
+	VPCID *string `locationName:"VpcId" type:"string" pattern:"vpc-[0-9a-f]{8}"`
 
 	// The name of the HSM vendor.
-	VendorName *string `type:"string"`
+	VendorName *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	metadataDescribeHSMOutput `json:"-", xml:"-"`
 }
@@ -905,10 +1093,10 @@ type metadataDescribeHSMOutput struct {
 
 type DescribeLunaClientInput struct {
 	// The certificate fingerprint.
-	CertificateFingerprint *string `type:"string"`
+	CertificateFingerprint *string `type:"string" pattern:"([0-9a-fA-F][0-9a-fA-F]:){15}[0-9a-fA-F][0-9a-fA-F]"`
 
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}"`
 
 	metadataDescribeLunaClientInput `json:"-", xml:"-"`
 }
@@ -919,19 +1107,19 @@ type metadataDescribeLunaClientInput struct {
 
 type DescribeLunaClientOutput struct {
 	// The certificate installed on the HSMs used by this client.
-	Certificate *string `type:"string"`
+	Certificate *string `type:"string" min:"600" max:"2400" pattern:"[\\w :+=./\\n-]*"`
 
 	// The certificate fingerprint.
-	CertificateFingerprint *string `type:"string"`
+	CertificateFingerprint *string `type:"string" pattern:"([0-9a-fA-F][0-9a-fA-F]:){15}[0-9a-fA-F][0-9a-fA-F]"`
 
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}"`
 
 	// The label of the client.
-	Label *string `type:"string"`
+	Label *string `type:"string" pattern:"[a-zA-Z0-9_.-]{1,64}"`
 
 	// The date and time the client was last modified.
-	LastModifiedTimestamp *string `type:"string"`
+	LastModifiedTimestamp *string `type:"string" pattern:"\\d*"`
 
 	metadataDescribeLunaClientOutput `json:"-", xml:"-"`
 }
@@ -942,10 +1130,10 @@ type metadataDescribeLunaClientOutput struct {
 
 type GetConfigInput struct {
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string" required:"true"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}" required:"true"`
 
 	// The client version.
-	ClientVersion *string `type:"string" required:"true"`
+	ClientVersion *string `type:"string" enum:"5.1,5.3" required:"true"`
 
 	// A list of ARNs that identify the high-availability partition groups that
 	// are associated with the client.
@@ -960,13 +1148,13 @@ type metadataGetConfigInput struct {
 
 type GetConfigOutput struct {
 	// The certificate file containing the server.pem files of the HSMs.
-	ConfigCred *string `type:"string"`
+	ConfigCred *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The chrystoki.conf configuration file.
-	ConfigFile *string `type:"string"`
+	ConfigFile *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The type of credentials.
-	ConfigType *string `type:"string"`
+	ConfigType *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	metadataGetConfigOutput `json:"-", xml:"-"`
 }
@@ -998,7 +1186,7 @@ type metadataListAvailableZonesOutput struct {
 type ListHSMsInput struct {
 	// The NextToken value from a previous call to ListHsms. Pass null if this is
 	// the first call.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListHSMsInput `json:"-", xml:"-"`
 }
@@ -1014,7 +1202,7 @@ type ListHSMsOutput struct {
 
 	// If not null, more results are available. Pass this value to ListHsms to retrieve
 	// the next set of items.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListHSMsOutput `json:"-", xml:"-"`
 }
@@ -1026,7 +1214,7 @@ type metadataListHSMsOutput struct {
 type ListHapgsInput struct {
 	// The NextToken value from a previous call to ListHapgs. Pass null if this
 	// is the first call.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListHapgsInput `json:"-", xml:"-"`
 }
@@ -1041,7 +1229,7 @@ type ListHapgsOutput struct {
 
 	// If not null, more results are available. Pass this value to ListHapgs to
 	// retrieve the next set of items.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListHapgsOutput `json:"-", xml:"-"`
 }
@@ -1053,7 +1241,7 @@ type metadataListHapgsOutput struct {
 type ListLunaClientsInput struct {
 	// The NextToken value from a previous call to ListLunaClients. Pass null if
 	// this is the first call.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListLunaClientsInput `json:"-", xml:"-"`
 }
@@ -1068,7 +1256,7 @@ type ListLunaClientsOutput struct {
 
 	// If not null, more results are available. Pass this to ListLunaClients to
 	// retrieve the next set of items.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListLunaClientsOutput `json:"-", xml:"-"`
 }
@@ -1079,10 +1267,10 @@ type metadataListLunaClientsOutput struct {
 
 type ModifyHAPGInput struct {
 	// The ARN of the high-availability partition group to modify.
-	HAPGARN *string `locationName:"HapgArn" type:"string" required:"true"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}" required:"true"`
 
 	// The new label for the high-availability partition group.
-	Label *string `type:"string"`
+	Label *string `type:"string" pattern:"[a-zA-Z0-9_.-]{1,64}"`
 
 	// The list of partition serial numbers to make members of the high-availability
 	// partition group.
@@ -1097,7 +1285,7 @@ type metadataModifyHAPGInput struct {
 
 type ModifyHAPGOutput struct {
 	// The ARN of the high-availability partition group.
-	HAPGARN *string `locationName:"HapgArn" type:"string"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}"`
 
 	metadataModifyHAPGOutput `json:"-", xml:"-"`
 }
@@ -1109,22 +1297,22 @@ type metadataModifyHAPGOutput struct {
 // Contains the inputs for the ModifyHsm action.
 type ModifyHSMInput struct {
 	// The new IP address for the elastic network interface attached to the HSM.
-	ENIIP *string `locationName:"EniIp" type:"string"`
+	ENIIP *string `locationName:"EniIp" type:"string" pattern:"\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"`
 
 	// The new external ID.
-	ExternalID *string `locationName:"ExternalId" type:"string"`
+	ExternalID *string `locationName:"ExternalId" type:"string" pattern:"[\\w :+=./-]*"`
 
 	// The ARN of the HSM to modify.
-	HSMARN *string `locationName:"HsmArn" type:"string" required:"true"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}" required:"true"`
 
 	// The new IAM role ARN.
-	IAMRoleARN *string `locationName:"IamRoleArn" type:"string"`
+	IAMRoleARN *string `locationName:"IamRoleArn" type:"string" pattern:"arn:aws(-iso)?:iam::[0-9]{12}:role/[a-zA-Z0-9_\\+=,\\.\\-@]{1,64}"`
 
 	// The new identifier of the subnet that the HSM is in.
-	SubnetID *string `locationName:"SubnetId" type:"string"`
+	SubnetID *string `locationName:"SubnetId" type:"string" pattern:"subnet-[0-9a-f]{8}"`
 
 	// The new IP address for the syslog monitoring server.
-	SyslogIP *string `locationName:"SyslogIp" type:"string"`
+	SyslogIP *string `locationName:"SyslogIp" type:"string" pattern:"\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"`
 
 	metadataModifyHSMInput `json:"-", xml:"-"`
 }
@@ -1136,7 +1324,7 @@ type metadataModifyHSMInput struct {
 // Contains the output of the ModifyHsm action.
 type ModifyHSMOutput struct {
 	// The ARN of the HSM.
-	HSMARN *string `locationName:"HsmArn" type:"string"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}"`
 
 	metadataModifyHSMOutput `json:"-", xml:"-"`
 }
@@ -1147,10 +1335,10 @@ type metadataModifyHSMOutput struct {
 
 type ModifyLunaClientInput struct {
 	// The new certificate for the client.
-	Certificate *string `type:"string" required:"true"`
+	Certificate *string `type:"string" min:"600" max:"2400" pattern:"[\\w :+=./\\n-]*" required:"true"`
 
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string" required:"true"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}" required:"true"`
 
 	metadataModifyLunaClientInput `json:"-", xml:"-"`
 }
@@ -1161,11 +1349,77 @@ type metadataModifyLunaClientInput struct {
 
 type ModifyLunaClientOutput struct {
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}"`
 
 	metadataModifyLunaClientOutput `json:"-", xml:"-"`
 }
 
 type metadataModifyLunaClientOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for ClientVersion.
+const (
+	ClientVersion51 = "5.1"
+	ClientVersion53 = "5.3"
+)
+
+// ClientVersionValues returns the valid values of ClientVersion.
+func ClientVersionValues() []string {
+	return []string{
+		ClientVersion51,
+		ClientVersion53,
+	}
+}
+
+// Enum values for CloudHsmObjectState.
+const (
+	CloudHSMObjectStateReady    = "READY"
+	CloudHsmObjectStateUpdating = "UPDATING"
+	CloudHsmObjectStateDegraded = "DEGRADED"
+)
+
+// CloudHsmObjectStateValues returns the valid values of CloudHsmObjectState.
+func CloudHsmObjectStateValues() []string {
+	return []string{
+		CloudHSMObjectStateReady,
+		CloudHsmObjectStateUpdating,
+		CloudHsmObjectStateDegraded,
+	}
+}
+
+// Enum values for HsmStatus.
+const (
+	HSMStatusPending     = "PENDING"
+	HSMStatusRunning     = "RUNNING"
+	HsmStatusUpdating    = "UPDATING"
+	HSMStatusSuspended   = "SUSPENDED"
+	HSMStatusTerminating = "TERMINATING"
+	HSMStatusTerminated  = "TERMINATED"
+	HsmStatusDegraded    = "DEGRADED"
+)
+
+// HsmStatusValues returns the valid values of HsmStatus.
+func HsmStatusValues() []string {
+	return []string{
+		HSMStatusPending,
+		HSMStatusRunning,
+		HsmStatusUpdating,
+		HSMStatusSuspended,
+		HSMStatusTerminating,
+		HSMStatusTerminated,
+		HsmStatusDegraded,
+	}
+}
+
+// Enum values for SubscriptionType.
+const (
+	SubscriptionTypeProduction = "PRODUCTION"
+)
+
+// SubscriptionTypeValues returns the valid values of SubscriptionType.
+func SubscriptionTypeValues() []string {
+	return []string{
+		SubscriptionTypeProduction,
+	}
 }
\ No newline at end of file
@@ -1,41 +1,77 @@
 package cloudhsmiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/cloudhsm"
 )
 
 type CloudHSMAPI interface {
 	CreateHAPG(*cloudhsm.CreateHAPGInput) (*cloudhsm.CreateHAPGOutput, error)
 
+	CreateHAPGWithContext(context.Context, *cloudhsm.CreateHAPGInput) (*cloudhsm.CreateHAPGOutput, error)
+
 	CreateHSM(*cloudhsm.CreateHSMInput) (*cloudhsm.CreateHSMOutput, error)
 
+	CreateHSMWithContext(context.Context, *cloudhsm.CreateHSMInput) (*cloudhsm.CreateHSMOutput, error)
+
 	CreateLunaClient(*cloudhsm.CreateLunaClientInput) (*cloudhsm.CreateLunaClientOutput, error)
 
+	CreateLunaClientWithContext(context.Context, *cloudhsm.CreateLunaClientInput) (*cloudhsm.CreateLunaClientOutput, error)
+
 	DeleteHAPG(*cloudhsm.DeleteHAPGInput) (*cloudhsm.DeleteHAPGOutput, error)
 
+	DeleteHAPGWithContext(context.Context, *cloudhsm.DeleteHAPGInput) (*cloudhsm.DeleteHAPGOutput, error)
+
 	DeleteHSM(*cloudhsm.DeleteHSMInput) (*cloudhsm.DeleteHSMOutput, error)
 
+	DeleteHSMWithContext(context.Context, *cloudhsm.DeleteHSMInput) (*cloudhsm.DeleteHSMOutput, error)
+
 	DeleteLunaClient(*cloudhsm.DeleteLunaClientInput) (*cloudhsm.DeleteLunaClientOutput, error)
 
+	DeleteLunaClientWithContext(context.Context, *cloudhsm.DeleteLunaClientInput) (*cloudhsm.DeleteLunaClientOutput, error)
+
 	DescribeHAPG(*cloudhsm.DescribeHAPGInput) (*cloudhsm.DescribeHAPGOutput, error)
 
+	DescribeHAPGWithContext(context.Context, *cloudhsm.DescribeHAPGInput) (*cloudhsm.DescribeHAPGOutput, error)
+
 	DescribeHSM(*cloudhsm.DescribeHSMInput) (*cloudhsm.DescribeHSMOutput, error)
 
+	DescribeHSMWithContext(context.Context, *cloudhsm.DescribeHSMInput) (*cloudhsm.DescribeHSMOutput, error)
+
 	DescribeLunaClient(*cloudhsm.DescribeLunaClientInput) (*cloudhsm.DescribeLunaClientOutput, error)
 
+	DescribeLunaClientWithContext(context.Context, *cloudhsm.DescribeLunaClientInput) (*cloudhsm.DescribeLunaClientOutput, error)
+
 	GetConfig(*cloudhsm.GetConfigInput) (*cloudhsm.GetConfigOutput, error)
 
+	GetConfigWithContext(context.Context, *cloudhsm.GetConfigInput) (*cloudhsm.GetConfigOutput, error)
+
 	ListAvailableZones(*cloudhsm.ListAvailableZonesInput) (*cloudhsm.ListAvailableZonesOutput, error)
 
+	ListAvailableZonesWithContext(context.Context, *cloudhsm.ListAvailableZonesInput) (*cloudhsm.ListAvailableZonesOutput, error)
+
 	ListHSMs(*cloudhsm.ListHSMsInput) (*cloudhsm.ListHSMsOutput, error)
 
+	ListHSMsWithContext(context.Context, *cloudhsm.ListHSMsInput) (*cloudhsm.ListHSMsOutput, error)
+
 	ListHapgs(*cloudhsm.ListHapgsInput) (*cloudhsm.ListHapgsOutput, error)
 
+	ListHapgsWithContext(context.Context, *cloudhsm.ListHapgsInput) (*cloudhsm.ListHapgsOutput, error)
+
 	ListLunaClients(*cloudhsm.ListLunaClientsInput) (*cloudhsm.ListLunaClientsOutput, error)
 
+	ListLunaClientsWithContext(context.Context, *cloudhsm.ListLunaClientsInput) (*cloudhsm.ListLunaClientsOutput, error)
+
 	ModifyHAPG(*cloudhsm.ModifyHAPGInput) (*cloudhsm.ModifyHAPGOutput, error)
 
+	ModifyHAPGWithContext(context.Context, *cloudhsm.ModifyHAPGInput) (*cloudhsm.ModifyHAPGOutput, error)
+
 	ModifyHSM(*cloudhsm.ModifyHSMInput) (*cloudhsm.ModifyHSMOutput, error)
 
+	ModifyHSMWithContext(context.Context, *cloudhsm.ModifyHSMInput) (*cloudhsm.ModifyHSMOutput, error)
+
 	ModifyLunaClient(*cloudhsm.ModifyLunaClientInput) (*cloudhsm.ModifyLunaClientOutput, error)
+
+	ModifyLunaClientWithContext(context.Context, *cloudhsm.ModifyLunaClientInput) (*cloudhsm.ModifyLunaClientOutput, error)
 }
\ No newline at end of file
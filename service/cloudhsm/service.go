@@ -29,6 +29,7 @@ func New(config *aws.Config) *CloudHSM {
 		APIVersion:   "2014-05-30",
 		JSONVersion:  "1.1",
 		TargetPrefix: "CloudHsmFrontendService",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package cloudsearch
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -45,6 +46,17 @@ func (c *CloudSearch) BuildSuggesters(input *BuildSuggestersInput) (output *Buil
 	return
 }
 
+// BuildSuggestersWithContext is the same as BuildSuggesters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) BuildSuggestersWithContext(ctx context.Context, input *BuildSuggestersInput) (output *BuildSuggestersOutput, err error) {
+	req, out := c.BuildSuggestersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opBuildSuggesters *aws.Operation
 
 // CreateDomainRequest generates a request for the CreateDomain operation.
@@ -80,6 +92,17 @@ func (c *CloudSearch) CreateDomain(input *CreateDomainInput) (output *CreateDoma
 	return
 }
 
+// CreateDomainWithContext is the same as CreateDomain with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) CreateDomainWithContext(ctx context.Context, input *CreateDomainInput) (output *CreateDomainOutput, err error) {
+	req, out := c.CreateDomainRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateDomain *aws.Operation
 
 // DefineAnalysisSchemeRequest generates a request for the DefineAnalysisScheme operation.
@@ -116,6 +139,17 @@ func (c *CloudSearch) DefineAnalysisScheme(input *DefineAnalysisSchemeInput) (ou
 	return
 }
 
+// DefineAnalysisSchemeWithContext is the same as DefineAnalysisScheme with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DefineAnalysisSchemeWithContext(ctx context.Context, input *DefineAnalysisSchemeInput) (output *DefineAnalysisSchemeOutput, err error) {
+	req, out := c.DefineAnalysisSchemeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDefineAnalysisScheme *aws.Operation
 
 // DefineExpressionRequest generates a request for the DefineExpression operation.
@@ -152,6 +186,17 @@ func (c *CloudSearch) DefineExpression(input *DefineExpressionInput) (output *De
 	return
 }
 
+// DefineExpressionWithContext is the same as DefineExpression with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DefineExpressionWithContext(ctx context.Context, input *DefineExpressionInput) (output *DefineExpressionOutput, err error) {
+	req, out := c.DefineExpressionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDefineExpression *aws.Operation
 
 // DefineIndexFieldRequest generates a request for the DefineIndexField operation.
@@ -192,6 +237,17 @@ func (c *CloudSearch) DefineIndexField(input *DefineIndexFieldInput) (output *De
 	return
 }
 
+// DefineIndexFieldWithContext is the same as DefineIndexField with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DefineIndexFieldWithContext(ctx context.Context, input *DefineIndexFieldInput) (output *DefineIndexFieldOutput, err error) {
+	req, out := c.DefineIndexFieldRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDefineIndexField *aws.Operation
 
 // DefineSuggesterRequest generates a request for the DefineSuggester operation.
@@ -230,6 +286,17 @@ func (c *CloudSearch) DefineSuggester(input *DefineSuggesterInput) (output *Defi
 	return
 }
 
+// DefineSuggesterWithContext is the same as DefineSuggester with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DefineSuggesterWithContext(ctx context.Context, input *DefineSuggesterInput) (output *DefineSuggesterOutput, err error) {
+	req, out := c.DefineSuggesterRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDefineSuggester *aws.Operation
 
 // DeleteAnalysisSchemeRequest generates a request for the DeleteAnalysisScheme operation.
@@ -265,6 +332,17 @@ func (c *CloudSearch) DeleteAnalysisScheme(input *DeleteAnalysisSchemeInput) (ou
 	return
 }
 
+// DeleteAnalysisSchemeWithContext is the same as DeleteAnalysisScheme with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DeleteAnalysisSchemeWithContext(ctx context.Context, input *DeleteAnalysisSchemeInput) (output *DeleteAnalysisSchemeOutput, err error) {
+	req, out := c.DeleteAnalysisSchemeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteAnalysisScheme *aws.Operation
 
 // DeleteDomainRequest generates a request for the DeleteDomain operation.
@@ -301,6 +379,17 @@ func (c *CloudSearch) DeleteDomain(input *DeleteDomainInput) (output *DeleteDoma
 	return
 }
 
+// DeleteDomainWithContext is the same as DeleteDomain with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DeleteDomainWithContext(ctx context.Context, input *DeleteDomainInput) (output *DeleteDomainOutput, err error) {
+	req, out := c.DeleteDomainRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteDomain *aws.Operation
 
 // DeleteExpressionRequest generates a request for the DeleteExpression operation.
@@ -336,6 +425,17 @@ func (c *CloudSearch) DeleteExpression(input *DeleteExpressionInput) (output *De
 	return
 }
 
+// DeleteExpressionWithContext is the same as DeleteExpression with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DeleteExpressionWithContext(ctx context.Context, input *DeleteExpressionInput) (output *DeleteExpressionOutput, err error) {
+	req, out := c.DeleteExpressionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteExpression *aws.Operation
 
 // DeleteIndexFieldRequest generates a request for the DeleteIndexField operation.
@@ -371,6 +471,17 @@ func (c *CloudSearch) DeleteIndexField(input *DeleteIndexFieldInput) (output *De
 	return
 }
 
+// DeleteIndexFieldWithContext is the same as DeleteIndexField with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DeleteIndexFieldWithContext(ctx context.Context, input *DeleteIndexFieldInput) (output *DeleteIndexFieldOutput, err error) {
+	req, out := c.DeleteIndexFieldRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteIndexField *aws.Operation
 
 // DeleteSuggesterRequest generates a request for the DeleteSuggester operation.
@@ -406,6 +517,17 @@ func (c *CloudSearch) DeleteSuggester(input *DeleteSuggesterInput) (output *Dele
 	return
 }
 
+// DeleteSuggesterWithContext is the same as DeleteSuggester with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DeleteSuggesterWithContext(ctx context.Context, input *DeleteSuggesterInput) (output *DeleteSuggesterOutput, err error) {
+	req, out := c.DeleteSuggesterRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteSuggester *aws.Operation
 
 // DescribeAnalysisSchemesRequest generates a request for the DescribeAnalysisSchemes operation.
@@ -445,6 +567,17 @@ func (c *CloudSearch) DescribeAnalysisSchemes(input *DescribeAnalysisSchemesInpu
 	return
 }
 
+// DescribeAnalysisSchemesWithContext is the same as DescribeAnalysisSchemes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DescribeAnalysisSchemesWithContext(ctx context.Context, input *DescribeAnalysisSchemesInput) (output *DescribeAnalysisSchemesOutput, err error) {
+	req, out := c.DescribeAnalysisSchemesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeAnalysisSchemes *aws.Operation
 
 // DescribeAvailabilityOptionsRequest generates a request for the DescribeAvailabilityOptions operation.
@@ -482,6 +615,17 @@ func (c *CloudSearch) DescribeAvailabilityOptions(input *DescribeAvailabilityOpt
 	return
 }
 
+// DescribeAvailabilityOptionsWithContext is the same as DescribeAvailabilityOptions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DescribeAvailabilityOptionsWithContext(ctx context.Context, input *DescribeAvailabilityOptionsInput) (output *DescribeAvailabilityOptionsOutput, err error) {
+	req, out := c.DescribeAvailabilityOptionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeAvailabilityOptions *aws.Operation
 
 // DescribeDomainsRequest generates a request for the DescribeDomains operation.
@@ -520,6 +664,17 @@ func (c *CloudSearch) DescribeDomains(input *DescribeDomainsInput) (output *Desc
 	return
 }
 
+// DescribeDomainsWithContext is the same as DescribeDomains with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DescribeDomainsWithContext(ctx context.Context, input *DescribeDomainsInput) (output *DescribeDomainsOutput, err error) {
+	req, out := c.DescribeDomainsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeDomains *aws.Operation
 
 // DescribeExpressionsRequest generates a request for the DescribeExpressions operation.
@@ -558,6 +713,17 @@ func (c *CloudSearch) DescribeExpressions(input *DescribeExpressionsInput) (outp
 	return
 }
 
+// DescribeExpressionsWithContext is the same as DescribeExpressions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DescribeExpressionsWithContext(ctx context.Context, input *DescribeExpressionsInput) (output *DescribeExpressionsOutput, err error) {
+	req, out := c.DescribeExpressionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeExpressions *aws.Operation
 
 // DescribeIndexFieldsRequest generates a request for the DescribeIndexFields operation.
@@ -596,6 +762,17 @@ func (c *CloudSearch) DescribeIndexFields(input *DescribeIndexFieldsInput) (outp
 	return
 }
 
+// DescribeIndexFieldsWithContext is the same as DescribeIndexFields with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DescribeIndexFieldsWithContext(ctx context.Context, input *DescribeIndexFieldsInput) (output *DescribeIndexFieldsOutput, err error) {
+	req, out := c.DescribeIndexFieldsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeIndexFields *aws.Operation
 
 // DescribeScalingParametersRequest generates a request for the DescribeScalingParameters operation.
@@ -632,6 +809,17 @@ func (c *CloudSearch) DescribeScalingParameters(input *DescribeScalingParameters
 	return
 }
 
+// DescribeScalingParametersWithContext is the same as DescribeScalingParameters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DescribeScalingParametersWithContext(ctx context.Context, input *DescribeScalingParametersInput) (output *DescribeScalingParametersOutput, err error) {
+	req, out := c.DescribeScalingParametersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeScalingParameters *aws.Operation
 
 // DescribeServiceAccessPoliciesRequest generates a request for the DescribeServiceAccessPolicies operation.
@@ -670,6 +858,17 @@ func (c *CloudSearch) DescribeServiceAccessPolicies(input *DescribeServiceAccess
 	return
 }
 
+// DescribeServiceAccessPoliciesWithContext is the same as DescribeServiceAccessPolicies with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DescribeServiceAccessPoliciesWithContext(ctx context.Context, input *DescribeServiceAccessPoliciesInput) (output *DescribeServiceAccessPoliciesOutput, err error) {
+	req, out := c.DescribeServiceAccessPoliciesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeServiceAccessPolicies *aws.Operation
 
 // DescribeSuggestersRequest generates a request for the DescribeSuggesters operation.
@@ -709,6 +908,17 @@ func (c *CloudSearch) DescribeSuggesters(input *DescribeSuggestersInput) (output
 	return
 }
 
+// DescribeSuggestersWithContext is the same as DescribeSuggesters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) DescribeSuggestersWithContext(ctx context.Context, input *DescribeSuggestersInput) (output *DescribeSuggestersOutput, err error) {
+	req, out := c.DescribeSuggestersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeSuggesters *aws.Operation
 
 // IndexDocumentsRequest generates a request for the IndexDocuments operation.
@@ -744,6 +954,17 @@ func (c *CloudSearch) IndexDocuments(input *IndexDocumentsInput) (output *IndexD
 	return
 }
 
+// IndexDocumentsWithContext is the same as IndexDocuments with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) IndexDocumentsWithContext(ctx context.Context, input *IndexDocumentsInput) (output *IndexDocumentsOutput, err error) {
+	req, out := c.IndexDocumentsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opIndexDocuments *aws.Operation
 
 // ListDomainNamesRequest generates a request for the ListDomainNames operation.
@@ -777,6 +998,17 @@ func (c *CloudSearch) ListDomainNames(input *ListDomainNamesInput) (output *List
 	return
 }
 
+// ListDomainNamesWithContext is the same as ListDomainNames with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) ListDomainNamesWithContext(ctx context.Context, input *ListDomainNamesInput) (output *ListDomainNamesOutput, err error) {
+	req, out := c.ListDomainNamesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListDomainNames *aws.Operation
 
 // UpdateAvailabilityOptionsRequest generates a request for the UpdateAvailabilityOptions operation.
@@ -815,6 +1047,17 @@ func (c *CloudSearch) UpdateAvailabilityOptions(input *UpdateAvailabilityOptions
 	return
 }
 
+// UpdateAvailabilityOptionsWithContext is the same as UpdateAvailabilityOptions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) UpdateAvailabilityOptionsWithContext(ctx context.Context, input *UpdateAvailabilityOptionsInput) (output *UpdateAvailabilityOptionsOutput, err error) {
+	req, out := c.UpdateAvailabilityOptionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateAvailabilityOptions *aws.Operation
 
 // UpdateScalingParametersRequest generates a request for the UpdateScalingParameters operation.
@@ -855,6 +1098,17 @@ func (c *CloudSearch) UpdateScalingParameters(input *UpdateScalingParametersInpu
 	return
 }
 
+// UpdateScalingParametersWithContext is the same as UpdateScalingParameters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) UpdateScalingParametersWithContext(ctx context.Context, input *UpdateScalingParametersInput) (output *UpdateScalingParametersOutput, err error) {
+	req, out := c.UpdateScalingParametersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateScalingParameters *aws.Operation
 
 // UpdateServiceAccessPoliciesRequest generates a request for the UpdateServiceAccessPolicies operation.
@@ -891,6 +1145,17 @@ func (c *CloudSearch) UpdateServiceAccessPolicies(input *UpdateServiceAccessPoli
 	return
 }
 
+// UpdateServiceAccessPoliciesWithContext is the same as UpdateServiceAccessPolicies with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearch) UpdateServiceAccessPoliciesWithContext(ctx context.Context, input *UpdateServiceAccessPoliciesInput) (output *UpdateServiceAccessPoliciesOutput, err error) {
+	req, out := c.UpdateServiceAccessPoliciesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateServiceAccessPolicies *aws.Operation
 
 // The configured access rules for the domain's document and search endpoints,
@@ -919,7 +1184,7 @@ type AnalysisOptions struct {
 	// The available levels vary depending on the language. For more information,
 	// see Language Specific Text Processing Settings (http://docs.aws.amazon.com/cloudsearch/latest/developerguide/text-processing.html#text-processing-settings"
 	// target="_blank) in the Amazon CloudSearch Developer Guide
-	AlgorithmicStemming *string `type:"string"`
+	AlgorithmicStemming *string `type:"string" enum:"none,minimal,light,full"`
 
 	// A JSON array that contains a collection of terms, tokens, readings and part
 	// of speech for Japanese Tokenizaiton. The Japanese tokenization dictionary
@@ -969,11 +1234,11 @@ type AnalysisScheme struct {
 
 	// An IETF RFC 4646 (http://tools.ietf.org/html/rfc4646" target="_blank) language
 	// code or mul for multiple languages.
-	AnalysisSchemeLanguage *string `type:"string" required:"true"`
+	AnalysisSchemeLanguage *string `type:"string" enum:"ar,bg,ca,cs,da,de,el,en,es,eu,fa,fi,fr,ga,gl,he,hi,hu,hy,id,it,ja,ko,lv,mul,nl,no,pt,ro,ru,sv,th,tr,zh-Hans,zh-Hant" required:"true"`
 
 	// Names must begin with a letter and can contain the following characters:
 	// a-z (lowercase), 0-9, and _ (underscore).
-	AnalysisSchemeName *string `type:"string" required:"true"`
+	AnalysisSchemeName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	metadataAnalysisScheme `json:"-", xml:"-"`
 }
@@ -1022,7 +1287,7 @@ type BuildSuggestersInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataBuildSuggestersInput `json:"-", xml:"-"`
 }
@@ -1050,7 +1315,7 @@ type CreateDomainInput struct {
 	// A name for the domain you are creating. Allowed characters are a-z (lower-case
 	// letters), 0-9, and hyphen (-). Domain names must start with a letter or number
 	// and be at least 3 and no more than 28 characters long.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataCreateDomainInput `json:"-", xml:"-"`
 }
@@ -1076,7 +1341,7 @@ type metadataCreateDomainOutput struct {
 // specifies the field is of type date-array. All options are enabled by default.
 type DateArrayOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -1088,7 +1353,7 @@ type DateArrayOptions struct {
 	SearchEnabled *bool `type:"boolean"`
 
 	// A list of source fields to map to the field.
-	SourceFields *string `type:"string"`
+	SourceFields *string `type:"string" pattern:"\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"`
 
 	metadataDateArrayOptions `json:"-", xml:"-"`
 }
@@ -1103,7 +1368,7 @@ type metadataDateArrayOptions struct {
 // by default.
 type DateOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -1131,7 +1396,7 @@ type DateOptions struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataDateOptions `json:"-", xml:"-"`
 }
@@ -1153,7 +1418,7 @@ type DefineAnalysisSchemeInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDefineAnalysisSchemeInput `json:"-", xml:"-"`
 }
@@ -1183,7 +1448,7 @@ type DefineExpressionInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// A named expression that can be evaluated at search time. Can be used to sort
 	// the search results, define other expressions, or return computed information
@@ -1217,7 +1482,7 @@ type DefineIndexFieldInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// The index field and field options you want to configure.
 	IndexField *IndexField `type:"structure" required:"true"`
@@ -1249,7 +1514,7 @@ type DefineSuggesterInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// Configuration information for a search suggester. Each suggester has a unique
 	// name and specifies the text field you want to use for suggestions. The following
@@ -1281,13 +1546,13 @@ type metadataDefineSuggesterOutput struct {
 // to delete.
 type DeleteAnalysisSchemeInput struct {
 	// The name of the analysis scheme you want to delete.
-	AnalysisSchemeName *string `type:"string" required:"true"`
+	AnalysisSchemeName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	// A string that represents the name of a domain. Domain names are unique across
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDeleteAnalysisSchemeInput `json:"-", xml:"-"`
 }
@@ -1313,7 +1578,7 @@ type metadataDeleteAnalysisSchemeOutput struct {
 // name of the domain you want to delete.
 type DeleteDomainInput struct {
 	// The name of the domain you want to permanently delete.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDeleteDomainInput `json:"-", xml:"-"`
 }
@@ -1343,10 +1608,10 @@ type DeleteExpressionInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// The name of the Expression to delete.
-	ExpressionName *string `type:"string" required:"true"`
+	ExpressionName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	metadataDeleteExpressionInput `json:"-", xml:"-"`
 }
@@ -1376,11 +1641,11 @@ type DeleteIndexFieldInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// The name of the index field your want to remove from the domain's indexing
 	// options.
-	IndexFieldName *string `type:"string" required:"true"`
+	IndexFieldName *string `type:"string" min:"1" max:"64" pattern:"([a-z][a-z0-9_]*\\*?|\\*[a-z0-9_]*)" required:"true"`
 
 	metadataDeleteIndexFieldInput `json:"-", xml:"-"`
 }
@@ -1409,10 +1674,10 @@ type DeleteSuggesterInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// Specifies the name of the suggester you want to delete.
-	SuggesterName *string `type:"string" required:"true"`
+	SuggesterName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	metadataDeleteSuggesterInput `json:"-", xml:"-"`
 }
@@ -1448,7 +1713,7 @@ type DescribeAnalysisSchemesInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDescribeAnalysisSchemesInput `json:"-", xml:"-"`
 }
@@ -1480,7 +1745,7 @@ type DescribeAvailabilityOptionsInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDescribeAvailabilityOptionsInput `json:"-", xml:"-"`
 }
@@ -1541,7 +1806,7 @@ type DescribeExpressionsInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// Limits the DescribeExpressions response to the specified expressions. If
 	// not specified, all expressions are shown.
@@ -1578,7 +1843,7 @@ type DescribeIndexFieldsInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// A list of the index fields you want to describe. If not specified, information
 	// is returned for all configured index fields.
@@ -1611,7 +1876,7 @@ type DescribeScalingParametersInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDescribeScalingParametersInput `json:"-", xml:"-"`
 }
@@ -1643,7 +1908,7 @@ type DescribeServiceAccessPoliciesInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDescribeServiceAccessPoliciesInput `json:"-", xml:"-"`
 }
@@ -1675,7 +1940,7 @@ type DescribeSuggestersInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// The suggesters you want to describe.
 	SuggesterNames []*string `type:"list"`
@@ -1706,7 +1971,7 @@ type DocumentSuggesterOptions struct {
 	// With low, suggestions must differ from the specified string by no more than
 	// one character. With high, suggestions can differ by up to two characters.
 	// The default is none.
-	FuzzyMatching *string `type:"string"`
+	FuzzyMatching *string `type:"string" enum:"none,low,high"`
 
 	// An expression that computes a score for each suggestion to control how they
 	// are sorted. The scores are rounded to the nearest integer, with a floor of
@@ -1718,7 +1983,7 @@ type DocumentSuggesterOptions struct {
 	SortExpression *string `type:"string"`
 
 	// The name of the index field you want to use for suggestions.
-	SourceField *string `type:"string" required:"true"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	metadataDocumentSuggesterOptions `json:"-", xml:"-"`
 }
@@ -1750,13 +2015,13 @@ type DomainStatus struct {
 	DocService *ServiceEndpoint `type:"structure"`
 
 	// An internally generated unique identifier for a domain.
-	DomainID *string `locationName:"DomainId" type:"string" required:"true"`
+	DomainID *string `locationName:"DomainId" type:"string" min:"1" max:"64" required:"true"`
 
 	// A string that represents the name of a domain. Domain names are unique across
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	Limits *Limits `type:"structure"`
 
@@ -1768,13 +2033,13 @@ type DomainStatus struct {
 	RequiresIndexDocuments *bool `type:"boolean" required:"true"`
 
 	// The number of search instances that are available to process search requests.
-	SearchInstanceCount *int64 `type:"integer"`
+	SearchInstanceCount *int64 `type:"integer" min:"1"`
 
 	// The instance type that is being used to process search requests.
 	SearchInstanceType *string `type:"string"`
 
 	// The number of partitions across which the search index is spread.
-	SearchPartitionCount *int64 `type:"integer"`
+	SearchPartitionCount *int64 `type:"integer" min:"1"`
 
 	// The service endpoint for requesting search results from a search domain.
 	SearchService *ServiceEndpoint `type:"structure"`
@@ -1803,7 +2068,7 @@ type DoubleArrayOptions struct {
 	SearchEnabled *bool `type:"boolean"`
 
 	// A list of source fields to map to the field.
-	SourceFields *string `type:"string"`
+	SourceFields *string `type:"string" pattern:"\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"`
 
 	metadataDoubleArrayOptions `json:"-", xml:"-"`
 }
@@ -1833,7 +2098,7 @@ type DoubleOptions struct {
 	SortEnabled *bool `type:"boolean"`
 
 	// The name of the source field to map to the field.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataDoubleOptions `json:"-", xml:"-"`
 }
@@ -1848,13 +2113,13 @@ type metadataDoubleOptions struct {
 type Expression struct {
 	// Names must begin with a letter and can contain the following characters:
 	// a-z (lowercase), 0-9, and _ (underscore).
-	ExpressionName *string `type:"string" required:"true"`
+	ExpressionName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	// The expression to evaluate for sorting while processing a search request.
 	// The Expression syntax is based on JavaScript expressions. For more information,
 	// see Configuring Expressions (http://docs.aws.amazon.com/cloudsearch/latest/developerguide/configuring-expressions.html"
 	// target="_blank) in the Amazon CloudSearch Developer Guide.
-	ExpressionValue *string `type:"string" required:"true"`
+	ExpressionValue *string `type:"string" min:"1" max:"10240" required:"true"`
 
 	metadataExpression `json:"-", xml:"-"`
 }
@@ -1885,7 +2150,7 @@ type IndexDocumentsInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataIndexDocumentsInput `json:"-", xml:"-"`
 }
@@ -1943,13 +2208,13 @@ type IndexField struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	IndexFieldName *string `type:"string" required:"true"`
+	IndexFieldName *string `type:"string" min:"1" max:"64" pattern:"([a-z][a-z0-9_]*\\*?|\\*[a-z0-9_]*)" required:"true"`
 
 	// The type of field. The valid options for a field depend on the field type.
 	// For more information about the supported field types, see Configuring Index
 	// Fields (http://docs.aws.amazon.com/cloudsearch/latest/developerguide/configuring-index-fields.html"
 	// target="_blank) in the Amazon CloudSearch Developer Guide.
-	IndexFieldType *string `type:"string" required:"true"`
+	IndexFieldType *string `type:"string" enum:"int,double,literal,text,date,latlon,int-array,double-array,literal-array,text-array,date-array" required:"true"`
 
 	// Options for a field that contains an array of 64-bit signed integers. Present
 	// if IndexFieldType specifies the field is of type int-array. All options are
@@ -2024,7 +2289,7 @@ type IntArrayOptions struct {
 	SearchEnabled *bool `type:"boolean"`
 
 	// A list of source fields to map to the field.
-	SourceFields *string `type:"string"`
+	SourceFields *string `type:"string" pattern:"\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"`
 
 	metadataIntArrayOptions `json:"-", xml:"-"`
 }
@@ -2054,7 +2319,7 @@ type IntOptions struct {
 	SortEnabled *bool `type:"boolean"`
 
 	// The name of the source field to map to the field.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataIntOptions `json:"-", xml:"-"`
 }
@@ -2068,7 +2333,7 @@ type metadataIntOptions struct {
 // the field is of type latlon. All options are enabled by default.
 type LatLonOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -2096,7 +2361,7 @@ type LatLonOptions struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataLatLonOptions `json:"-", xml:"-"`
 }
@@ -2106,9 +2371,9 @@ type metadataLatLonOptions struct {
 }
 
 type Limits struct {
-	MaximumPartitionCount *int64 `type:"integer" required:"true"`
+	MaximumPartitionCount *int64 `type:"integer" min:"1" required:"true"`
 
-	MaximumReplicationCount *int64 `type:"integer" required:"true"`
+	MaximumReplicationCount *int64 `type:"integer" min:"1" required:"true"`
 
 	metadataLimits `json:"-", xml:"-"`
 }
@@ -2143,7 +2408,7 @@ type metadataListDomainNamesOutput struct {
 // are enabled by default.
 type LiteralArrayOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -2155,7 +2420,7 @@ type LiteralArrayOptions struct {
 	SearchEnabled *bool `type:"boolean"`
 
 	// A list of source fields to map to the field.
-	SourceFields *string `type:"string"`
+	SourceFields *string `type:"string" pattern:"\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"`
 
 	metadataLiteralArrayOptions `json:"-", xml:"-"`
 }
@@ -2168,7 +2433,7 @@ type metadataLiteralArrayOptions struct {
 // is of type literal. All options are enabled by default.
 type LiteralOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -2196,7 +2461,7 @@ type LiteralOptions struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataLiteralOptions `json:"-", xml:"-"`
 }
@@ -2222,13 +2487,13 @@ type OptionStatus struct {
 	// option value is not compatible with the domain's data and cannot be used
 	// to index the data. You must either modify the option value or update or remove
 	// the incompatible documents.
-	State *string `type:"string" required:"true"`
+	State *string `type:"string" enum:"RequiresIndexDocuments,Processing,Active,FailedToValidate" required:"true"`
 
 	// A timestamp for when this option was last updated.
 	UpdateDate *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
 	// A unique integer that indicates when this option was last updated.
-	UpdateVersion *int64 `type:"integer"`
+	UpdateVersion *int64 `type:"integer" min:"0"`
 
 	metadataOptionStatus `json:"-", xml:"-"`
 }
@@ -2241,14 +2506,14 @@ type metadataOptionStatus struct {
 type ScalingParameters struct {
 	// The instance type that you want to preconfigure for your domain. For example,
 	// search.m1.small.
-	DesiredInstanceType *string `type:"string"`
+	DesiredInstanceType *string `type:"string" enum:"search.m1.small,search.m1.large,search.m2.xlarge,search.m2.2xlarge,search.m3.medium,search.m3.large,search.m3.xlarge,search.m3.2xlarge"`
 
 	// The number of partitions you want to preconfigure for your domain. Only valid
 	// when you select m2.2xlarge as the desired instance type.
-	DesiredPartitionCount *int64 `type:"integer"`
+	DesiredPartitionCount *int64 `type:"integer" min:"0"`
 
 	// The number of replicas you want to preconfigure for each index partition.
-	DesiredReplicationCount *int64 `type:"integer"`
+	DesiredReplicationCount *int64 `type:"integer" min:"0"`
 
 	metadataScalingParameters `json:"-", xml:"-"`
 }
@@ -2294,7 +2559,7 @@ type Suggester struct {
 
 	// Names must begin with a letter and can contain the following characters:
 	// a-z (lowercase), 0-9, and _ (underscore).
-	SuggesterName *string `type:"string" required:"true"`
+	SuggesterName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	metadataSuggester `json:"-", xml:"-"`
 }
@@ -2325,10 +2590,10 @@ type metadataSuggesterStatus struct {
 // All options are enabled by default.
 type TextArrayOptions struct {
 	// The name of an analysis scheme for a text-array field.
-	AnalysisScheme *string `type:"string"`
+	AnalysisScheme *string `type:"string" pattern:"[\\S]+"`
 
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether highlights can be returned for the field.
 	HighlightEnabled *bool `type:"boolean"`
@@ -2337,7 +2602,7 @@ type TextArrayOptions struct {
 	ReturnEnabled *bool `type:"boolean"`
 
 	// A list of source fields to map to the field.
-	SourceFields *string `type:"string"`
+	SourceFields *string `type:"string" pattern:"\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"`
 
 	metadataTextArrayOptions `json:"-", xml:"-"`
 }
@@ -2351,10 +2616,10 @@ type metadataTextArrayOptions struct {
 // by default.
 type TextOptions struct {
 	// The name of an analysis scheme for a text field.
-	AnalysisScheme *string `type:"string"`
+	AnalysisScheme *string `type:"string" pattern:"[\\S]+"`
 
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether highlights can be returned for the field.
 	HighlightEnabled *bool `type:"boolean"`
@@ -2379,7 +2644,7 @@ type TextOptions struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataTextOptions `json:"-", xml:"-"`
 }
@@ -2396,7 +2661,7 @@ type UpdateAvailabilityOptionsInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// You expand an existing search domain to a second Availability Zone by setting
 	// the Multi-AZ option to true. Similarly, you can turn off the Multi-AZ option
@@ -2433,7 +2698,7 @@ type UpdateScalingParametersInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// The desired instance type and desired number of replicas of each index partition.
 	ScalingParameters *ScalingParameters `type:"structure" required:"true"`
@@ -2470,7 +2735,7 @@ type UpdateServiceAccessPoliciesInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataUpdateServiceAccessPoliciesInput `json:"-", xml:"-"`
 }
@@ -2490,4 +2755,194 @@ type UpdateServiceAccessPoliciesOutput struct {
 
 type metadataUpdateServiceAccessPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for AlgorithmicStemming.
+const (
+	AlgorithmicStemmingNone    = "none"
+	AlgorithmicStemmingMinimal = "minimal"
+	AlgorithmicStemmingLight   = "light"
+	AlgorithmicStemmingFull    = "full"
+)
+
+// AlgorithmicStemmingValues returns the valid values of AlgorithmicStemming.
+func AlgorithmicStemmingValues() []string {
+	return []string{
+		AlgorithmicStemmingNone,
+		AlgorithmicStemmingMinimal,
+		AlgorithmicStemmingLight,
+		AlgorithmicStemmingFull,
+	}
+}
+
+// Enum values for AnalysisSchemeLanguage.
+const (
+	AnalysisSchemeLanguageAr     = "ar"
+	AnalysisSchemeLanguageBg     = "bg"
+	AnalysisSchemeLanguageCa     = "ca"
+	AnalysisSchemeLanguageCs     = "cs"
+	AnalysisSchemeLanguageDa     = "da"
+	AnalysisSchemeLanguageDe     = "de"
+	AnalysisSchemeLanguageEl     = "el"
+	AnalysisSchemeLanguageEn     = "en"
+	AnalysisSchemeLanguageEs     = "es"
+	AnalysisSchemeLanguageEu     = "eu"
+	AnalysisSchemeLanguageFa     = "fa"
+	AnalysisSchemeLanguageFi     = "fi"
+	AnalysisSchemeLanguageFr     = "fr"
+	AnalysisSchemeLanguageGa     = "ga"
+	AnalysisSchemeLanguageGl     = "gl"
+	AnalysisSchemeLanguageHe     = "he"
+	AnalysisSchemeLanguageHi     = "hi"
+	AnalysisSchemeLanguageHu     = "hu"
+	AnalysisSchemeLanguageHy     = "hy"
+	AnalysisSchemeLanguageID     = "id"
+	AnalysisSchemeLanguageIt     = "it"
+	AnalysisSchemeLanguageJa     = "ja"
+	AnalysisSchemeLanguageKo     = "ko"
+	AnalysisSchemeLanguageLv     = "lv"
+	AnalysisSchemeLanguageMul    = "mul"
+	AnalysisSchemeLanguageNl     = "nl"
+	AnalysisSchemeLanguageNo     = "no"
+	AnalysisSchemeLanguagePt     = "pt"
+	AnalysisSchemeLanguageRo     = "ro"
+	AnalysisSchemeLanguageRu     = "ru"
+	AnalysisSchemeLanguageSv     = "sv"
+	AnalysisSchemeLanguageTh     = "th"
+	AnalysisSchemeLanguageTr     = "tr"
+	AnalysisSchemeLanguageZhHans = "zh-Hans"
+	AnalysisSchemeLanguageZhHant = "zh-Hant"
+)
+
+// AnalysisSchemeLanguageValues returns the valid values of AnalysisSchemeLanguage.
+func AnalysisSchemeLanguageValues() []string {
+	return []string{
+		AnalysisSchemeLanguageAr,
+		AnalysisSchemeLanguageBg,
+		AnalysisSchemeLanguageCa,
+		AnalysisSchemeLanguageCs,
+		AnalysisSchemeLanguageDa,
+		AnalysisSchemeLanguageDe,
+		AnalysisSchemeLanguageEl,
+		AnalysisSchemeLanguageEn,
+		AnalysisSchemeLanguageEs,
+		AnalysisSchemeLanguageEu,
+		AnalysisSchemeLanguageFa,
+		AnalysisSchemeLanguageFi,
+		AnalysisSchemeLanguageFr,
+		AnalysisSchemeLanguageGa,
+		AnalysisSchemeLanguageGl,
+		AnalysisSchemeLanguageHe,
+		AnalysisSchemeLanguageHi,
+		AnalysisSchemeLanguageHu,
+		AnalysisSchemeLanguageHy,
+		AnalysisSchemeLanguageID,
+		AnalysisSchemeLanguageIt,
+		AnalysisSchemeLanguageJa,
+		AnalysisSchemeLanguageKo,
+		AnalysisSchemeLanguageLv,
+		AnalysisSchemeLanguageMul,
+		AnalysisSchemeLanguageNl,
+		AnalysisSchemeLanguageNo,
+		AnalysisSchemeLanguagePt,
+		AnalysisSchemeLanguageRo,
+		AnalysisSchemeLanguageRu,
+		AnalysisSchemeLanguageSv,
+		AnalysisSchemeLanguageTh,
+		AnalysisSchemeLanguageTr,
+		AnalysisSchemeLanguageZhHans,
+		AnalysisSchemeLanguageZhHant,
+	}
+}
+
+// Enum values for IndexFieldType.
+const (
+	IndexFieldTypeInt          = "int"
+	IndexFieldTypeDouble       = "double"
+	IndexFieldTypeLiteral      = "literal"
+	IndexFieldTypeText         = "text"
+	IndexFieldTypeDate         = "date"
+	IndexFieldTypeLatlon       = "latlon"
+	IndexFieldTypeIntArray     = "int-array"
+	IndexFieldTypeDoubleArray  = "double-array"
+	IndexFieldTypeLiteralArray = "literal-array"
+	IndexFieldTypeTextArray    = "text-array"
+	IndexFieldTypeDateArray    = "date-array"
+)
+
+// IndexFieldTypeValues returns the valid values of IndexFieldType.
+func IndexFieldTypeValues() []string {
+	return []string{
+		IndexFieldTypeInt,
+		IndexFieldTypeDouble,
+		IndexFieldTypeLiteral,
+		IndexFieldTypeText,
+		IndexFieldTypeDate,
+		IndexFieldTypeLatlon,
+		IndexFieldTypeIntArray,
+		IndexFieldTypeDoubleArray,
+		IndexFieldTypeLiteralArray,
+		IndexFieldTypeTextArray,
+		IndexFieldTypeDateArray,
+	}
+}
+
+// Enum values for OptionState.
+const (
+	OptionStateRequiresIndexDocuments = "RequiresIndexDocuments"
+	OptionStateProcessing             = "Processing"
+	OptionStateActive                 = "Active"
+	OptionStateFailedToValidate       = "FailedToValidate"
+)
+
+// OptionStateValues returns the valid values of OptionState.
+func OptionStateValues() []string {
+	return []string{
+		OptionStateRequiresIndexDocuments,
+		OptionStateProcessing,
+		OptionStateActive,
+		OptionStateFailedToValidate,
+	}
+}
+
+// Enum values for PartitionInstanceType.
+const (
+	PartitionInstanceTypeSearchM1Small   = "search.m1.small"
+	PartitionInstanceTypeSearchM1Large   = "search.m1.large"
+	PartitionInstanceTypeSearchM2Xlarge  = "search.m2.xlarge"
+	PartitionInstanceTypeSearchM22xlarge = "search.m2.2xlarge"
+	PartitionInstanceTypeSearchM3Medium  = "search.m3.medium"
+	PartitionInstanceTypeSearchM3Large   = "search.m3.large"
+	PartitionInstanceTypeSearchM3Xlarge  = "search.m3.xlarge"
+	PartitionInstanceTypeSearchM32xlarge = "search.m3.2xlarge"
+)
+
+// PartitionInstanceTypeValues returns the valid values of PartitionInstanceType.
+func PartitionInstanceTypeValues() []string {
+	return []string{
+		PartitionInstanceTypeSearchM1Small,
+		PartitionInstanceTypeSearchM1Large,
+		PartitionInstanceTypeSearchM2Xlarge,
+		PartitionInstanceTypeSearchM22xlarge,
+		PartitionInstanceTypeSearchM3Medium,
+		PartitionInstanceTypeSearchM3Large,
+		PartitionInstanceTypeSearchM3Xlarge,
+		PartitionInstanceTypeSearchM32xlarge,
+	}
+}
+
+// Enum values for SuggesterFuzzyMatching.
+const (
+	SuggesterFuzzyMatchingNone = "none"
+	SuggesterFuzzyMatchingLow  = "low"
+	SuggesterFuzzyMatchingHigh = "high"
+)
+
+// SuggesterFuzzyMatchingValues returns the valid values of SuggesterFuzzyMatching.
+func SuggesterFuzzyMatchingValues() []string {
+	return []string{
+		SuggesterFuzzyMatchingNone,
+		SuggesterFuzzyMatchingLow,
+		SuggesterFuzzyMatchingHigh,
+	}
 }
\ No newline at end of file
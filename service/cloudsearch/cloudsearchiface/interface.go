@@ -1,55 +1,105 @@
 package cloudsearchiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/cloudsearch"
 )
 
 type CloudSearchAPI interface {
 	BuildSuggesters(*cloudsearch.BuildSuggestersInput) (*cloudsearch.BuildSuggestersOutput, error)
 
+	BuildSuggestersWithContext(context.Context, *cloudsearch.BuildSuggestersInput) (*cloudsearch.BuildSuggestersOutput, error)
+
 	CreateDomain(*cloudsearch.CreateDomainInput) (*cloudsearch.CreateDomainOutput, error)
 
+	CreateDomainWithContext(context.Context, *cloudsearch.CreateDomainInput) (*cloudsearch.CreateDomainOutput, error)
+
 	DefineAnalysisScheme(*cloudsearch.DefineAnalysisSchemeInput) (*cloudsearch.DefineAnalysisSchemeOutput, error)
 
+	DefineAnalysisSchemeWithContext(context.Context, *cloudsearch.DefineAnalysisSchemeInput) (*cloudsearch.DefineAnalysisSchemeOutput, error)
+
 	DefineExpression(*cloudsearch.DefineExpressionInput) (*cloudsearch.DefineExpressionOutput, error)
 
+	DefineExpressionWithContext(context.Context, *cloudsearch.DefineExpressionInput) (*cloudsearch.DefineExpressionOutput, error)
+
 	DefineIndexField(*cloudsearch.DefineIndexFieldInput) (*cloudsearch.DefineIndexFieldOutput, error)
 
+	DefineIndexFieldWithContext(context.Context, *cloudsearch.DefineIndexFieldInput) (*cloudsearch.DefineIndexFieldOutput, error)
+
 	DefineSuggester(*cloudsearch.DefineSuggesterInput) (*cloudsearch.DefineSuggesterOutput, error)
 
+	DefineSuggesterWithContext(context.Context, *cloudsearch.DefineSuggesterInput) (*cloudsearch.DefineSuggesterOutput, error)
+
 	DeleteAnalysisScheme(*cloudsearch.DeleteAnalysisSchemeInput) (*cloudsearch.DeleteAnalysisSchemeOutput, error)
 
+	DeleteAnalysisSchemeWithContext(context.Context, *cloudsearch.DeleteAnalysisSchemeInput) (*cloudsearch.DeleteAnalysisSchemeOutput, error)
+
 	DeleteDomain(*cloudsearch.DeleteDomainInput) (*cloudsearch.DeleteDomainOutput, error)
 
+	DeleteDomainWithContext(context.Context, *cloudsearch.DeleteDomainInput) (*cloudsearch.DeleteDomainOutput, error)
+
 	DeleteExpression(*cloudsearch.DeleteExpressionInput) (*cloudsearch.DeleteExpressionOutput, error)
 
+	DeleteExpressionWithContext(context.Context, *cloudsearch.DeleteExpressionInput) (*cloudsearch.DeleteExpressionOutput, error)
+
 	DeleteIndexField(*cloudsearch.DeleteIndexFieldInput) (*cloudsearch.DeleteIndexFieldOutput, error)
 
+	DeleteIndexFieldWithContext(context.Context, *cloudsearch.DeleteIndexFieldInput) (*cloudsearch.DeleteIndexFieldOutput, error)
+
 	DeleteSuggester(*cloudsearch.DeleteSuggesterInput) (*cloudsearch.DeleteSuggesterOutput, error)
 
+	DeleteSuggesterWithContext(context.Context, *cloudsearch.DeleteSuggesterInput) (*cloudsearch.DeleteSuggesterOutput, error)
+
 	DescribeAnalysisSchemes(*cloudsearch.DescribeAnalysisSchemesInput) (*cloudsearch.DescribeAnalysisSchemesOutput, error)
 
+	DescribeAnalysisSchemesWithContext(context.Context, *cloudsearch.DescribeAnalysisSchemesInput) (*cloudsearch.DescribeAnalysisSchemesOutput, error)
+
 	DescribeAvailabilityOptions(*cloudsearch.DescribeAvailabilityOptionsInput) (*cloudsearch.DescribeAvailabilityOptionsOutput, error)
 
+	DescribeAvailabilityOptionsWithContext(context.Context, *cloudsearch.DescribeAvailabilityOptionsInput) (*cloudsearch.DescribeAvailabilityOptionsOutput, error)
+
 	DescribeDomains(*cloudsearch.DescribeDomainsInput) (*cloudsearch.DescribeDomainsOutput, error)
 
+	DescribeDomainsWithContext(context.Context, *cloudsearch.DescribeDomainsInput) (*cloudsearch.DescribeDomainsOutput, error)
+
 	DescribeExpressions(*cloudsearch.DescribeExpressionsInput) (*cloudsearch.DescribeExpressionsOutput, error)
 
+	DescribeExpressionsWithContext(context.Context, *cloudsearch.DescribeExpressionsInput) (*cloudsearch.DescribeExpressionsOutput, error)
+
 	DescribeIndexFields(*cloudsearch.DescribeIndexFieldsInput) (*cloudsearch.DescribeIndexFieldsOutput, error)
 
+	DescribeIndexFieldsWithContext(context.Context, *cloudsearch.DescribeIndexFieldsInput) (*cloudsearch.DescribeIndexFieldsOutput, error)
+
 	DescribeScalingParameters(*cloudsearch.DescribeScalingParametersInput) (*cloudsearch.DescribeScalingParametersOutput, error)
 
+	DescribeScalingParametersWithContext(context.Context, *cloudsearch.DescribeScalingParametersInput) (*cloudsearch.DescribeScalingParametersOutput, error)
+
 	DescribeServiceAccessPolicies(*cloudsearch.DescribeServiceAccessPoliciesInput) (*cloudsearch.DescribeServiceAccessPoliciesOutput, error)
 
+	DescribeServiceAccessPoliciesWithContext(context.Context, *cloudsearch.DescribeServiceAccessPoliciesInput) (*cloudsearch.DescribeServiceAccessPoliciesOutput, error)
+
 	DescribeSuggesters(*cloudsearch.DescribeSuggestersInput) (*cloudsearch.DescribeSuggestersOutput, error)
 
+	DescribeSuggestersWithContext(context.Context, *cloudsearch.DescribeSuggestersInput) (*cloudsearch.DescribeSuggestersOutput, error)
+
 	IndexDocuments(*cloudsearch.IndexDocumentsInput) (*cloudsearch.IndexDocumentsOutput, error)
 
+	IndexDocumentsWithContext(context.Context, *cloudsearch.IndexDocumentsInput) (*cloudsearch.IndexDocumentsOutput, error)
+
 	ListDomainNames(*cloudsearch.ListDomainNamesInput) (*cloudsearch.ListDomainNamesOutput, error)
 
+	ListDomainNamesWithContext(context.Context, *cloudsearch.ListDomainNamesInput) (*cloudsearch.ListDomainNamesOutput, error)
+
 	UpdateAvailabilityOptions(*cloudsearch.UpdateAvailabilityOptionsInput) (*cloudsearch.UpdateAvailabilityOptionsOutput, error)
 
+	UpdateAvailabilityOptionsWithContext(context.Context, *cloudsearch.UpdateAvailabilityOptionsInput) (*cloudsearch.UpdateAvailabilityOptionsOutput, error)
+
 	UpdateScalingParameters(*cloudsearch.UpdateScalingParametersInput) (*cloudsearch.UpdateScalingParametersOutput, error)
 
+	UpdateScalingParametersWithContext(context.Context, *cloudsearch.UpdateScalingParametersInput) (*cloudsearch.UpdateScalingParametersOutput, error)
+
 	UpdateServiceAccessPolicies(*cloudsearch.UpdateServiceAccessPoliciesInput) (*cloudsearch.UpdateServiceAccessPoliciesOutput, error)
+
+	UpdateServiceAccessPoliciesWithContext(context.Context, *cloudsearch.UpdateServiceAccessPoliciesInput) (*cloudsearch.UpdateServiceAccessPoliciesOutput, error)
 }
\ No newline at end of file
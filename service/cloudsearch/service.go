@@ -27,6 +27,7 @@ func New(config *aws.Config) *CloudSearch {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "cloudsearch",
 		APIVersion:  "2013-01-01",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package cloudsearchdomain
 
 import (
+	"context"
 	"io"
 	"sync"
 
@@ -61,6 +62,17 @@ func (c *CloudSearchDomain) Search(input *SearchInput) (output *SearchOutput, er
 	return
 }
 
+// SearchWithContext is the same as Search with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearchDomain) SearchWithContext(ctx context.Context, input *SearchInput) (output *SearchOutput, err error) {
+	req, out := c.SearchRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSearch *aws.Operation
 
 // SuggestRequest generates a request for the Suggest operation.
@@ -110,6 +122,17 @@ func (c *CloudSearchDomain) Suggest(input *SuggestInput) (output *SuggestOutput,
 	return
 }
 
+// SuggestWithContext is the same as Suggest with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearchDomain) SuggestWithContext(ctx context.Context, input *SuggestInput) (output *SuggestOutput, err error) {
+	req, out := c.SuggestRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSuggest *aws.Operation
 
 // UploadDocumentsRequest generates a request for the UploadDocuments operation.
@@ -164,6 +187,17 @@ func (c *CloudSearchDomain) UploadDocuments(input *UploadDocumentsInput) (output
 	return
 }
 
+// UploadDocumentsWithContext is the same as UploadDocuments with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudSearchDomain) UploadDocumentsWithContext(ctx context.Context, input *UploadDocumentsInput) (output *UploadDocumentsOutput, err error) {
+	req, out := c.UploadDocumentsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUploadDocuments *aws.Operation
 
 // A container for facet information.
@@ -461,7 +495,7 @@ type SearchInput struct {
 	//   dismax: search using the simplified subset of the Apache Lucene query parser
 	// syntax defined by the DisMax query parser. For more information, see DisMax
 	// Query Parser Syntax (http://wiki.apache.org/solr/DisMaxQParserPlugin#Query_Syntax).
-	QueryParser *string `location:"querystring" locationName:"q.parser" type:"string"`
+	QueryParser *string `location:"querystring" locationName:"q.parser" type:"string" enum:"simple,structured,lucene,dismax"`
 
 	// Specifies the field and expression values to include in the response. Multiple
 	// fields or expressions are specified as a comma-separated list. By default,
@@ -628,7 +662,7 @@ type UploadDocumentsInput struct {
 	// document batch formats:
 	//
 	//  application/json application/xml
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string" required:"true"`
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string" enum:"application/json,application/xml" required:"true"`
 
 	// A batch of documents formatted in JSON or HTML.
 	Documents io.ReadSeeker `locationName:"documents" type:"blob" required:"true"`
@@ -659,4 +693,36 @@ type UploadDocumentsOutput struct {
 
 type metadataUploadDocumentsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for ContentType.
+const (
+	ContentTypeApplicationJSON = "application/json"
+	ContentTypeApplicationXML  = "application/xml"
+)
+
+// ContentTypeValues returns the valid values of ContentType.
+func ContentTypeValues() []string {
+	return []string{
+		ContentTypeApplicationJSON,
+		ContentTypeApplicationXML,
+	}
+}
+
+// Enum values for QueryParser.
+const (
+	QueryParserSimple     = "simple"
+	QueryParserStructured = "structured"
+	QueryParserLucene     = "lucene"
+	QueryParserDismax     = "dismax"
+)
+
+// QueryParserValues returns the valid values of QueryParser.
+func QueryParserValues() []string {
+	return []string{
+		QueryParserSimple,
+		QueryParserStructured,
+		QueryParserLucene,
+		QueryParserDismax,
+	}
 }
\ No newline at end of file
@@ -1,13 +1,21 @@
 package cloudsearchdomainiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/cloudsearchdomain"
 )
 
 type CloudSearchDomainAPI interface {
 	Search(*cloudsearchdomain.SearchInput) (*cloudsearchdomain.SearchOutput, error)
 
+	SearchWithContext(context.Context, *cloudsearchdomain.SearchInput) (*cloudsearchdomain.SearchOutput, error)
+
 	Suggest(*cloudsearchdomain.SuggestInput) (*cloudsearchdomain.SuggestOutput, error)
 
+	SuggestWithContext(context.Context, *cloudsearchdomain.SuggestInput) (*cloudsearchdomain.SuggestOutput, error)
+
 	UploadDocuments(*cloudsearchdomain.UploadDocumentsInput) (*cloudsearchdomain.UploadDocumentsOutput, error)
+
+	UploadDocumentsWithContext(context.Context, *cloudsearchdomain.UploadDocumentsInput) (*cloudsearchdomain.UploadDocumentsOutput, error)
 }
\ No newline at end of file
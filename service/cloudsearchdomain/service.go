@@ -27,6 +27,7 @@ func New(config *aws.Config) *CloudSearchDomain {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "cloudsearchdomain",
 		APIVersion:  "2013-01-01",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package cloudtrail
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -46,6 +47,17 @@ func (c *CloudTrail) CreateTrail(input *CreateTrailInput) (output *CreateTrailOu
 	return
 }
 
+// CreateTrailWithContext is the same as CreateTrail with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudTrail) CreateTrailWithContext(ctx context.Context, input *CreateTrailInput) (output *CreateTrailOutput, err error) {
+	req, out := c.CreateTrailRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateTrail *aws.Operation
 
 // DeleteTrailRequest generates a request for the DeleteTrail operation.
@@ -79,6 +91,17 @@ func (c *CloudTrail) DeleteTrail(input *DeleteTrailInput) (output *DeleteTrailOu
 	return
 }
 
+// DeleteTrailWithContext is the same as DeleteTrail with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudTrail) DeleteTrailWithContext(ctx context.Context, input *DeleteTrailInput) (output *DeleteTrailOutput, err error) {
+	req, out := c.DeleteTrailRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteTrail *aws.Operation
 
 // DescribeTrailsRequest generates a request for the DescribeTrails operation.
@@ -113,6 +136,17 @@ func (c *CloudTrail) DescribeTrails(input *DescribeTrailsInput) (output *Describ
 	return
 }
 
+// DescribeTrailsWithContext is the same as DescribeTrails with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudTrail) DescribeTrailsWithContext(ctx context.Context, input *DescribeTrailsInput) (output *DescribeTrailsOutput, err error) {
+	req, out := c.DescribeTrailsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeTrails *aws.Operation
 
 // GetTrailStatusRequest generates a request for the GetTrailStatus operation.
@@ -148,6 +182,17 @@ func (c *CloudTrail) GetTrailStatus(input *GetTrailStatusInput) (output *GetTrai
 	return
 }
 
+// GetTrailStatusWithContext is the same as GetTrailStatus with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudTrail) GetTrailStatusWithContext(ctx context.Context, input *GetTrailStatusInput) (output *GetTrailStatusOutput, err error) {
+	req, out := c.GetTrailStatusRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetTrailStatus *aws.Operation
 
 // LookupEventsRequest generates a request for the LookupEvents operation.
@@ -194,6 +239,17 @@ func (c *CloudTrail) LookupEvents(input *LookupEventsInput) (output *LookupEvent
 	return
 }
 
+// LookupEventsWithContext is the same as LookupEvents with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudTrail) LookupEventsWithContext(ctx context.Context, input *LookupEventsInput) (output *LookupEventsOutput, err error) {
+	req, out := c.LookupEventsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opLookupEvents *aws.Operation
 
 // StartLoggingRequest generates a request for the StartLogging operation.
@@ -227,6 +283,17 @@ func (c *CloudTrail) StartLogging(input *StartLoggingInput) (output *StartLoggin
 	return
 }
 
+// StartLoggingWithContext is the same as StartLogging with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudTrail) StartLoggingWithContext(ctx context.Context, input *StartLoggingInput) (output *StartLoggingOutput, err error) {
+	req, out := c.StartLoggingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opStartLogging *aws.Operation
 
 // StopLoggingRequest generates a request for the StopLogging operation.
@@ -263,6 +330,17 @@ func (c *CloudTrail) StopLogging(input *StopLoggingInput) (output *StopLoggingOu
 	return
 }
 
+// StopLoggingWithContext is the same as StopLogging with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudTrail) StopLoggingWithContext(ctx context.Context, input *StopLoggingInput) (output *StopLoggingOutput, err error) {
+	req, out := c.StopLoggingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opStopLogging *aws.Operation
 
 // UpdateTrailRequest generates a request for the UpdateTrail operation.
@@ -301,6 +379,17 @@ func (c *CloudTrail) UpdateTrail(input *UpdateTrailInput) (output *UpdateTrailOu
 	return
 }
 
+// UpdateTrailWithContext is the same as UpdateTrail with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudTrail) UpdateTrailWithContext(ctx context.Context, input *UpdateTrailInput) (output *UpdateTrailOutput, err error) {
+	req, out := c.UpdateTrailRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateTrail *aws.Operation
 
 // Specifies the settings for each trail.
@@ -516,7 +605,7 @@ type metadataGetTrailStatusOutput struct {
 // Specifies an attribute and value that filter the events returned.
 type LookupAttribute struct {
 	// Specifies an attribute on which to filter the events returned.
-	AttributeKey *string `type:"string" required:"true"`
+	AttributeKey *string `type:"string" enum:"EventId,EventName,Username,ResourceType,ResourceName" required:"true"`
 
 	// Specifies a value for the specified AttributeKey.
 	AttributeValue *string `type:"string" required:"true"`
@@ -541,7 +630,7 @@ type LookupEventsInput struct {
 
 	// The number of events to return. Possible values are 1 through 50. The default
 	// is 10.
-	MaxResults *int64 `type:"integer"`
+	MaxResults *int64 `type:"integer" min:"1" max:"50"`
 
 	// The token to use to get the next page of results after a previous API call.
 	// This token must be passed in with the same parameters that were specified
@@ -757,4 +846,24 @@ type UpdateTrailOutput struct {
 
 type metadataUpdateTrailOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for LookupAttributeKey.
+const (
+	LookupAttributeKeyEventID      = "EventId"
+	LookupAttributeKeyEventName    = "EventName"
+	LookupAttributeKeyUsername     = "Username"
+	LookupAttributeKeyResourceType = "ResourceType"
+	LookupAttributeKeyResourceName = "ResourceName"
+)
+
+// LookupAttributeKeyValues returns the valid values of LookupAttributeKey.
+func LookupAttributeKeyValues() []string {
+	return []string{
+		LookupAttributeKeyEventID,
+		LookupAttributeKeyEventName,
+		LookupAttributeKeyUsername,
+		LookupAttributeKeyResourceType,
+		LookupAttributeKeyResourceName,
+	}
 }
\ No newline at end of file
@@ -1,23 +1,41 @@
 package cloudtrailiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/cloudtrail"
 )
 
 type CloudTrailAPI interface {
 	CreateTrail(*cloudtrail.CreateTrailInput) (*cloudtrail.CreateTrailOutput, error)
 
+	CreateTrailWithContext(context.Context, *cloudtrail.CreateTrailInput) (*cloudtrail.CreateTrailOutput, error)
+
 	DeleteTrail(*cloudtrail.DeleteTrailInput) (*cloudtrail.DeleteTrailOutput, error)
 
+	DeleteTrailWithContext(context.Context, *cloudtrail.DeleteTrailInput) (*cloudtrail.DeleteTrailOutput, error)
+
 	DescribeTrails(*cloudtrail.DescribeTrailsInput) (*cloudtrail.DescribeTrailsOutput, error)
 
+	DescribeTrailsWithContext(context.Context, *cloudtrail.DescribeTrailsInput) (*cloudtrail.DescribeTrailsOutput, error)
+
 	GetTrailStatus(*cloudtrail.GetTrailStatusInput) (*cloudtrail.GetTrailStatusOutput, error)
 
+	GetTrailStatusWithContext(context.Context, *cloudtrail.GetTrailStatusInput) (*cloudtrail.GetTrailStatusOutput, error)
+
 	LookupEvents(*cloudtrail.LookupEventsInput) (*cloudtrail.LookupEventsOutput, error)
 
+	LookupEventsWithContext(context.Context, *cloudtrail.LookupEventsInput) (*cloudtrail.LookupEventsOutput, error)
+
 	StartLogging(*cloudtrail.StartLoggingInput) (*cloudtrail.StartLoggingOutput, error)
 
+	StartLoggingWithContext(context.Context, *cloudtrail.StartLoggingInput) (*cloudtrail.StartLoggingOutput, error)
+
 	StopLogging(*cloudtrail.StopLoggingInput) (*cloudtrail.StopLoggingOutput, error)
 
+	StopLoggingWithContext(context.Context, *cloudtrail.StopLoggingInput) (*cloudtrail.StopLoggingOutput, error)
+
 	UpdateTrail(*cloudtrail.UpdateTrailInput) (*cloudtrail.UpdateTrailOutput, error)
+
+	UpdateTrailWithContext(context.Context, *cloudtrail.UpdateTrailInput) (*cloudtrail.UpdateTrailOutput, error)
 }
\ No newline at end of file
@@ -0,0 +1,137 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package cloudtrail
+
+import (
+	"github.com/datacratic/aws-sdk-go/aws"
+)
+
+// Error codes of the CloudTrail errors. Errors with these codes are
+// returned as the typed error of the same name, e.g. an error with the code
+// ErrCodeFoo is a *Foo.
+const (
+	ErrCodeCloudWatchLogsDeliveryUnavailableException = "CloudWatchLogsDeliveryUnavailable"
+	ErrCodeInsufficientS3BucketPolicyException        = "InsufficientS3BucketPolicy"
+	ErrCodeInsufficientSNSTopicPolicyException        = "InsufficientSnsTopicPolicy"
+	ErrCodeInvalidCloudWatchLogsLogGroupARNException  = "InvalidCloudWatchLogsLogGroupArn"
+	ErrCodeInvalidCloudWatchLogsRoleARNException      = "InvalidCloudWatchLogsRoleArn"
+	ErrCodeInvalidLookupAttributesException           = "InvalidLookupAttributes"
+	ErrCodeInvalidMaxResultsException                 = "InvalidMaxResults"
+	ErrCodeInvalidNextTokenException                  = "InvalidNextToken"
+	ErrCodeInvalidS3BucketNameException               = "InvalidS3BucketName"
+	ErrCodeInvalidS3PrefixException                   = "InvalidS3Prefix"
+	ErrCodeInvalidSNSTopicNameException               = "InvalidSnsTopicName"
+	ErrCodeInvalidTimeRangeException                  = "InvalidTimeRange"
+	ErrCodeInvalidTrailNameException                  = "InvalidTrailName"
+	ErrCodeMaximumNumberOfTrailsExceededException     = "MaximumNumberOfTrailsExceeded"
+	ErrCodeS3BucketDoesNotExistException              = "S3BucketDoesNotExist"
+	ErrCodeTrailAlreadyExistsException                = "TrailAlreadyExists"
+	ErrCodeTrailNotFoundException                     = "TrailNotFound"
+)
+
+// Cannot set a CloudWatch Logs delivery for this region.
+type CloudWatchLogsDeliveryUnavailableException struct {
+	aws.APIError
+}
+
+// This exception is thrown when the policy on the S3 bucket is not sufficient.
+type InsufficientS3BucketPolicyException struct {
+	aws.APIError
+}
+
+// This exception is thrown when the policy on the SNS topic is not sufficient.
+type InsufficientSNSTopicPolicyException struct {
+	aws.APIError
+}
+
+// This exception is thrown when the provided CloudWatch log group is not valid.
+type InvalidCloudWatchLogsLogGroupARNException struct {
+	aws.APIError
+}
+
+// This exception is thrown when the provided role is not valid.
+type InvalidCloudWatchLogsRoleARNException struct {
+	aws.APIError
+}
+
+// Occurs when an invalid lookup attribute is specified.
+type InvalidLookupAttributesException struct {
+	aws.APIError
+}
+
+// This exception is thrown if the limit specified is invalid.
+type InvalidMaxResultsException struct {
+	aws.APIError
+}
+
+// Invalid token or token that was previously used in a request with different
+// parameters. This exception is thrown if the token is invalid.
+type InvalidNextTokenException struct {
+	aws.APIError
+}
+
+// This exception is thrown when the provided S3 bucket name is not valid.
+type InvalidS3BucketNameException struct {
+	aws.APIError
+}
+
+// This exception is thrown when the provided S3 prefix is not valid.
+type InvalidS3PrefixException struct {
+	aws.APIError
+}
+
+// This exception is thrown when the provided SNS topic name is not valid.
+type InvalidSNSTopicNameException struct {
+	aws.APIError
+}
+
+// Occurs if the timestamp values are invalid. Either the start time occurs
+// after the end time or the time range is outside the range of possible values.
+type InvalidTimeRangeException struct {
+	aws.APIError
+}
+
+// This exception is thrown when the provided trail name is not valid.
+type InvalidTrailNameException struct {
+	aws.APIError
+}
+
+// This exception is thrown when the maximum number of trails is reached.
+type MaximumNumberOfTrailsExceededException struct {
+	aws.APIError
+}
+
+// This exception is thrown when the specified S3 bucket does not exist.
+type S3BucketDoesNotExistException struct {
+	aws.APIError
+}
+
+// This exception is thrown when the specified trail already exists.
+type TrailAlreadyExistsException struct {
+	aws.APIError
+}
+
+// This exception is thrown when the trail with the given name is not found.
+type TrailNotFoundException struct {
+	aws.APIError
+}
+
+var errorTypes = map[string]func() aws.ServiceError{
+	ErrCodeCloudWatchLogsDeliveryUnavailableException: func() aws.ServiceError { return &CloudWatchLogsDeliveryUnavailableException{} },
+	ErrCodeInsufficientS3BucketPolicyException:        func() aws.ServiceError { return &InsufficientS3BucketPolicyException{} },
+	ErrCodeInsufficientSNSTopicPolicyException:        func() aws.ServiceError { return &InsufficientSNSTopicPolicyException{} },
+	ErrCodeInvalidCloudWatchLogsLogGroupARNException:  func() aws.ServiceError { return &InvalidCloudWatchLogsLogGroupARNException{} },
+	ErrCodeInvalidCloudWatchLogsRoleARNException:      func() aws.ServiceError { return &InvalidCloudWatchLogsRoleARNException{} },
+	ErrCodeInvalidLookupAttributesException:           func() aws.ServiceError { return &InvalidLookupAttributesException{} },
+	ErrCodeInvalidMaxResultsException:                 func() aws.ServiceError { return &InvalidMaxResultsException{} },
+	ErrCodeInvalidNextTokenException:                  func() aws.ServiceError { return &InvalidNextTokenException{} },
+	ErrCodeInvalidS3BucketNameException:               func() aws.ServiceError { return &InvalidS3BucketNameException{} },
+	ErrCodeInvalidS3PrefixException:                   func() aws.ServiceError { return &InvalidS3PrefixException{} },
+	ErrCodeInvalidSNSTopicNameException:               func() aws.ServiceError { return &InvalidSNSTopicNameException{} },
+	ErrCodeInvalidTimeRangeException:                  func() aws.ServiceError { return &InvalidTimeRangeException{} },
+	ErrCodeInvalidTrailNameException:                  func() aws.ServiceError { return &InvalidTrailNameException{} },
+	ErrCodeMaximumNumberOfTrailsExceededException:     func() aws.ServiceError { return &MaximumNumberOfTrailsExceededException{} },
+	ErrCodeS3BucketDoesNotExistException:              func() aws.ServiceError { return &S3BucketDoesNotExistException{} },
+	ErrCodeTrailAlreadyExistsException:                func() aws.ServiceError { return &TrailAlreadyExistsException{} },
+	ErrCodeTrailNotFoundException:                     func() aws.ServiceError { return &TrailNotFoundException{} },
+}
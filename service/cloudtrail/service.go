@@ -29,6 +29,7 @@ func New(config *aws.Config) *CloudTrail {
 		APIVersion:   "2013-11-01",
 		JSONVersion:  "1.1",
 		TargetPrefix: "com.amazonaws.cloudtrail.v20131101.CloudTrail_20131101",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
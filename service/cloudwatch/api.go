@@ -4,6 +4,7 @@
 package cloudwatch
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -43,6 +44,17 @@ func (c *CloudWatch) DeleteAlarms(input *DeleteAlarmsInput) (output *DeleteAlarm
 	return
 }
 
+// DeleteAlarmsWithContext is the same as DeleteAlarms with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatch) DeleteAlarmsWithContext(ctx context.Context, input *DeleteAlarmsInput) (output *DeleteAlarmsOutput, err error) {
+	req, out := c.DeleteAlarmsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteAlarms *aws.Operation
 
 // DescribeAlarmHistoryRequest generates a request for the DescribeAlarmHistory operation.
@@ -55,6 +67,12 @@ func (c *CloudWatch) DescribeAlarmHistoryRequest(input *DescribeAlarmHistoryInpu
 			Name:       "DescribeAlarmHistory",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -78,6 +96,27 @@ func (c *CloudWatch) DescribeAlarmHistory(input *DescribeAlarmHistoryInput) (out
 	return
 }
 
+// DescribeAlarmHistoryWithContext is the same as DescribeAlarmHistory with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatch) DescribeAlarmHistoryWithContext(ctx context.Context, input *DescribeAlarmHistoryInput) (output *DescribeAlarmHistoryOutput, err error) {
+	req, out := c.DescribeAlarmHistoryRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeAlarmHistoryPages iterates over the pages of a DescribeAlarmHistory operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *CloudWatch) DescribeAlarmHistoryPages(input *DescribeAlarmHistoryInput, fn func(p *DescribeAlarmHistoryOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeAlarmHistoryRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeAlarmHistoryOutput), lastPage)
+	})
+}
+
 var opDescribeAlarmHistory *aws.Operation
 
 // DescribeAlarmsRequest generates a request for the DescribeAlarms operation.
@@ -90,6 +129,12 @@ func (c *CloudWatch) DescribeAlarmsRequest(input *DescribeAlarmsInput) (req *aws
 			Name:       "DescribeAlarms",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -113,6 +158,27 @@ func (c *CloudWatch) DescribeAlarms(input *DescribeAlarmsInput) (output *Describ
 	return
 }
 
+// DescribeAlarmsWithContext is the same as DescribeAlarms with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatch) DescribeAlarmsWithContext(ctx context.Context, input *DescribeAlarmsInput) (output *DescribeAlarmsOutput, err error) {
+	req, out := c.DescribeAlarmsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeAlarmsPages iterates over the pages of a DescribeAlarms operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *CloudWatch) DescribeAlarmsPages(input *DescribeAlarmsInput, fn func(p *DescribeAlarmsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeAlarmsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeAlarmsOutput), lastPage)
+	})
+}
+
 var opDescribeAlarms *aws.Operation
 
 // DescribeAlarmsForMetricRequest generates a request for the DescribeAlarmsForMetric operation.
@@ -147,6 +213,17 @@ func (c *CloudWatch) DescribeAlarmsForMetric(input *DescribeAlarmsForMetricInput
 	return
 }
 
+// DescribeAlarmsForMetricWithContext is the same as DescribeAlarmsForMetric with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatch) DescribeAlarmsForMetricWithContext(ctx context.Context, input *DescribeAlarmsForMetricInput) (output *DescribeAlarmsForMetricOutput, err error) {
+	req, out := c.DescribeAlarmsForMetricRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeAlarmsForMetric *aws.Operation
 
 // DisableAlarmActionsRequest generates a request for the DisableAlarmActions operation.
@@ -181,6 +258,17 @@ func (c *CloudWatch) DisableAlarmActions(input *DisableAlarmActionsInput) (outpu
 	return
 }
 
+// DisableAlarmActionsWithContext is the same as DisableAlarmActions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatch) DisableAlarmActionsWithContext(ctx context.Context, input *DisableAlarmActionsInput) (output *DisableAlarmActionsOutput, err error) {
+	req, out := c.DisableAlarmActionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDisableAlarmActions *aws.Operation
 
 // EnableAlarmActionsRequest generates a request for the EnableAlarmActions operation.
@@ -214,6 +302,17 @@ func (c *CloudWatch) EnableAlarmActions(input *EnableAlarmActionsInput) (output
 	return
 }
 
+// EnableAlarmActionsWithContext is the same as EnableAlarmActions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatch) EnableAlarmActionsWithContext(ctx context.Context, input *EnableAlarmActionsInput) (output *EnableAlarmActionsOutput, err error) {
+	req, out := c.EnableAlarmActionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEnableAlarmActions *aws.Operation
 
 // GetMetricStatisticsRequest generates a request for the GetMetricStatistics operation.
@@ -272,6 +371,17 @@ func (c *CloudWatch) GetMetricStatistics(input *GetMetricStatisticsInput) (outpu
 	return
 }
 
+// GetMetricStatisticsWithContext is the same as GetMetricStatistics with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatch) GetMetricStatisticsWithContext(ctx context.Context, input *GetMetricStatisticsInput) (output *GetMetricStatisticsOutput, err error) {
+	req, out := c.GetMetricStatisticsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetMetricStatistics *aws.Operation
 
 // ListMetricsRequest generates a request for the ListMetrics operation.
@@ -284,6 +394,12 @@ func (c *CloudWatch) ListMetricsRequest(input *ListMetricsInput) (req *aws.Reque
 			Name:       "ListMetrics",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -307,6 +423,27 @@ func (c *CloudWatch) ListMetrics(input *ListMetricsInput) (output *ListMetricsOu
 	return
 }
 
+// ListMetricsWithContext is the same as ListMetrics with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatch) ListMetricsWithContext(ctx context.Context, input *ListMetricsInput) (output *ListMetricsOutput, err error) {
+	req, out := c.ListMetricsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListMetricsPages iterates over the pages of a ListMetrics operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *CloudWatch) ListMetricsPages(input *ListMetricsInput, fn func(p *ListMetricsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListMetricsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListMetricsOutput), lastPage)
+	})
+}
+
 var opListMetrics *aws.Operation
 
 // PutMetricAlarmRequest generates a request for the PutMetricAlarm operation.
@@ -346,6 +483,17 @@ func (c *CloudWatch) PutMetricAlarm(input *PutMetricAlarmInput) (output *PutMetr
 	return
 }
 
+// PutMetricAlarmWithContext is the same as PutMetricAlarm with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatch) PutMetricAlarmWithContext(ctx context.Context, input *PutMetricAlarmInput) (output *PutMetricAlarmOutput, err error) {
+	req, out := c.PutMetricAlarmRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutMetricAlarm *aws.Operation
 
 // PutMetricDataRequest generates a request for the PutMetricData operation.
@@ -392,6 +540,17 @@ func (c *CloudWatch) PutMetricData(input *PutMetricDataInput) (output *PutMetric
 	return
 }
 
+// PutMetricDataWithContext is the same as PutMetricData with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatch) PutMetricDataWithContext(ctx context.Context, input *PutMetricDataInput) (output *PutMetricDataOutput, err error) {
+	req, out := c.PutMetricDataRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutMetricData *aws.Operation
 
 // SetAlarmStateRequest generates a request for the SetAlarmState operation.
@@ -428,6 +587,17 @@ func (c *CloudWatch) SetAlarmState(input *SetAlarmStateInput) (output *SetAlarmS
 	return
 }
 
+// SetAlarmStateWithContext is the same as SetAlarmState with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatch) SetAlarmStateWithContext(ctx context.Context, input *SetAlarmStateInput) (output *SetAlarmStateOutput, err error) {
+	req, out := c.SetAlarmStateRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetAlarmState *aws.Operation
 
 // The AlarmHistoryItem data type contains descriptive information about the
@@ -435,16 +605,16 @@ var opSetAlarmState *aws.Operation
 // returns this data type as part of the DescribeAlarmHistoryResult data type.
 type AlarmHistoryItem struct {
 	// The descriptive name for the alarm.
-	AlarmName *string `type:"string"`
+	AlarmName *string `type:"string" min:"1" max:"255"`
 
 	// Machine-readable data about the alarm in JSON format.
-	HistoryData *string `type:"string"`
+	HistoryData *string `type:"string" min:"1" max:"4095"`
 
 	// The type of alarm history item.
-	HistoryItemType *string `type:"string"`
+	HistoryItemType *string `type:"string" enum:"ConfigurationUpdate,StateUpdate,Action"`
 
 	// A human-readable summary of the alarm history.
-	HistorySummary *string `type:"string"`
+	HistorySummary *string `type:"string" min:"1" max:"255"`
 
 	// The time stamp for the alarm history item. Amazon CloudWatch uses Coordinated
 	// Universal Time (UTC) when returning time stamps, which do not accommodate
@@ -487,7 +657,7 @@ type Datapoint struct {
 	Timestamp *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The standard unit used for the datapoint.
-	Unit *string `type:"string"`
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	metadataDatapoint `json:"-", xml:"-"`
 }
@@ -498,7 +668,7 @@ type metadataDatapoint struct {
 
 type DeleteAlarmsInput struct {
 	// A list of alarms to be deleted.
-	AlarmNames []*string `type:"list" required:"true"`
+	AlarmNames []*string `type:"list" max:"100" required:"true"`
 
 	metadataDeleteAlarmsInput `json:"-", xml:"-"`
 }
@@ -517,16 +687,16 @@ type metadataDeleteAlarmsOutput struct {
 
 type DescribeAlarmHistoryInput struct {
 	// The name of the alarm.
-	AlarmName *string `type:"string"`
+	AlarmName *string `type:"string" min:"1" max:"255"`
 
 	// The ending date to retrieve alarm history.
 	EndDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The type of alarm histories to retrieve.
-	HistoryItemType *string `type:"string"`
+	HistoryItemType *string `type:"string" enum:"ConfigurationUpdate,StateUpdate,Action"`
 
 	// The maximum number of alarm history records to retrieve.
-	MaxRecords *int64 `type:"integer"`
+	MaxRecords *int64 `type:"integer" min:"1" max:"100"`
 
 	// The token returned by a previous call to indicate that there is more data
 	// available.
@@ -559,22 +729,22 @@ type metadataDescribeAlarmHistoryOutput struct {
 
 type DescribeAlarmsForMetricInput struct {
 	// The list of dimensions associated with the metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The name of the metric.
-	MetricName *string `type:"string" required:"true"`
+	MetricName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The namespace of the metric.
-	Namespace *string `type:"string" required:"true"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*" required:"true"`
 
 	// The period in seconds over which the statistic is applied.
-	Period *int64 `type:"integer"`
+	Period *int64 `type:"integer" min:"60"`
 
 	// The statistic for the metric.
-	Statistic *string `type:"string"`
+	Statistic *string `type:"string" enum:"SampleCount,Average,Sum,Minimum,Maximum"`
 
 	// The unit for the metric.
-	Unit *string `type:"string"`
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	metadataDescribeAlarmsForMetricInput `json:"-", xml:"-"`
 }
@@ -597,24 +767,24 @@ type metadataDescribeAlarmsForMetricOutput struct {
 
 type DescribeAlarmsInput struct {
 	// The action name prefix.
-	ActionPrefix *string `type:"string"`
+	ActionPrefix *string `type:"string" min:"1" max:"1024"`
 
 	// The alarm name prefix. AlarmNames cannot be specified if this parameter is
 	// specified.
-	AlarmNamePrefix *string `type:"string"`
+	AlarmNamePrefix *string `type:"string" min:"1" max:"255"`
 
 	// A list of alarm names to retrieve information for.
-	AlarmNames []*string `type:"list"`
+	AlarmNames []*string `type:"list" max:"100"`
 
 	// The maximum number of alarm descriptions to retrieve.
-	MaxRecords *int64 `type:"integer"`
+	MaxRecords *int64 `type:"integer" min:"1" max:"100"`
 
 	// The token returned by a previous call to indicate that there is more data
 	// available.
 	NextToken *string `type:"string"`
 
 	// The state value to be used in matching alarms.
-	StateValue *string `type:"string"`
+	StateValue *string `type:"string" enum:"OK,ALARM,INSUFFICIENT_DATA"`
 
 	metadataDescribeAlarmsInput `json:"-", xml:"-"`
 }
@@ -644,10 +814,10 @@ type metadataDescribeAlarmsOutput struct {
 // For examples that use one or more dimensions, see PutMetricData.
 type Dimension struct {
 	// The name of the dimension.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The value representing the dimension measurement
-	Value *string `type:"string" required:"true"`
+	Value *string `type:"string" min:"1" max:"255" required:"true"`
 
 	metadataDimension `json:"-", xml:"-"`
 }
@@ -659,10 +829,10 @@ type metadataDimension struct {
 // The DimensionFilter data type is used to filter ListMetrics results.
 type DimensionFilter struct {
 	// The dimension name to be matched.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The value of the dimension to be matched.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"1" max:"255"`
 
 	metadataDimensionFilter `json:"-", xml:"-"`
 }
@@ -673,7 +843,7 @@ type metadataDimensionFilter struct {
 
 type DisableAlarmActionsInput struct {
 	// The names of the alarms to disable actions for.
-	AlarmNames []*string `type:"list" required:"true"`
+	AlarmNames []*string `type:"list" max:"100" required:"true"`
 
 	metadataDisableAlarmActionsInput `json:"-", xml:"-"`
 }
@@ -692,7 +862,7 @@ type metadataDisableAlarmActionsOutput struct {
 
 type EnableAlarmActionsInput struct {
 	// The names of the alarms to enable actions for.
-	AlarmNames []*string `type:"list" required:"true"`
+	AlarmNames []*string `type:"list" max:"100" required:"true"`
 
 	metadataEnableAlarmActionsInput `json:"-", xml:"-"`
 }
@@ -711,7 +881,7 @@ type metadataEnableAlarmActionsOutput struct {
 
 type GetMetricStatisticsInput struct {
 	// A list of dimensions describing qualities of the metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The time stamp to use for determining the last datapoint to return. The value
 	// specified is exclusive; results will include datapoints up to the time stamp
@@ -719,14 +889,14 @@ type GetMetricStatisticsInput struct {
 	EndTime *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
 	// The name of the metric, with or without spaces.
-	MetricName *string `type:"string" required:"true"`
+	MetricName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The namespace of the metric, with or without spaces.
-	Namespace *string `type:"string" required:"true"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*" required:"true"`
 
 	// The granularity, in seconds, of the returned datapoints. Period must be at
 	// least 60 seconds and must be a multiple of 60. The default value is 60.
-	Period *int64 `type:"integer" required:"true"`
+	Period *int64 `type:"integer" min:"60" required:"true"`
 
 	// The time stamp to use for determining the first datapoint to return. The
 	// value specified is inclusive; results include datapoints with the time stamp
@@ -738,10 +908,10 @@ type GetMetricStatisticsInput struct {
 	// in the Amazon CloudWatch Developer Guide.
 	//
 	//  Valid Values: Average | Sum | SampleCount | Maximum | Minimum
-	Statistics []*string `type:"list" required:"true"`
+	Statistics []*string `type:"list" min:"1" max:"5" required:"true"`
 
 	// The unit for the metric.
-	Unit *string `type:"string"`
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	metadataGetMetricStatisticsInput `json:"-", xml:"-"`
 }
@@ -767,13 +937,13 @@ type metadataGetMetricStatisticsOutput struct {
 
 type ListMetricsInput struct {
 	// A list of dimensions to filter against.
-	Dimensions []*DimensionFilter `type:"list"`
+	Dimensions []*DimensionFilter `type:"list" max:"10"`
 
 	// The name of the metric to filter against.
-	MetricName *string `type:"string"`
+	MetricName *string `type:"string" min:"1" max:"255"`
 
 	// The namespace to filter against.
-	Namespace *string `type:"string"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*"`
 
 	// The token returned by a previous call to indicate that there is more data
 	// available.
@@ -810,13 +980,13 @@ type metadataListMetricsOutput struct {
 // two dimensions, InstanceID and InstanceType.
 type Metric struct {
 	// A list of dimensions associated with the metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The name of the metric.
-	MetricName *string `type:"string"`
+	MetricName *string `type:"string" min:"1" max:"255"`
 
 	// The namespace of the metric.
-	Namespace *string `type:"string"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*"`
 
 	metadataMetric `json:"-", xml:"-"`
 }
@@ -833,13 +1003,13 @@ type MetricAlarm struct {
 	ActionsEnabled *bool `type:"boolean"`
 
 	// The Amazon Resource Name (ARN) of the alarm.
-	AlarmARN *string `locationName:"AlarmArn" type:"string"`
+	AlarmARN *string `locationName:"AlarmArn" type:"string" min:"1" max:"1600"`
 
 	// The list of actions to execute when this alarm transitions into an ALARM
 	// state from any other state. Each action is specified as an Amazon Resource
 	// Number (ARN). Currently the only actions supported are publishing to an Amazon
 	// SNS topic and triggering an Auto Scaling policy.
-	AlarmActions []*string `type:"list"`
+	AlarmActions []*string `type:"list" max:"5"`
 
 	// The time stamp of the last update to the alarm configuration. Amazon CloudWatch
 	// uses Coordinated Universal Time (UTC) when returning time stamps, which do
@@ -849,20 +1019,20 @@ type MetricAlarm struct {
 	AlarmConfigurationUpdatedTimestamp *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The description for the alarm.
-	AlarmDescription *string `type:"string"`
+	AlarmDescription *string `type:"string" min:"0" max:"255"`
 
 	// The name of the alarm.
-	AlarmName *string `type:"string"`
+	AlarmName *string `type:"string" min:"1" max:"255"`
 
 	// The arithmetic operation to use when comparing the specified Statistic and
 	// Threshold. The specified Statistic value is used as the first operand.
-	ComparisonOperator *string `type:"string"`
+	ComparisonOperator *string `type:"string" enum:"GreaterThanOrEqualToThreshold,GreaterThanThreshold,LessThanThreshold,LessThanOrEqualToThreshold"`
 
 	// The list of dimensions associated with the alarm's associated metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The number of periods over which data is compared to the specified threshold.
-	EvaluationPeriods *int64 `type:"integer"`
+	EvaluationPeriods *int64 `type:"integer" min:"1"`
 
 	// The list of actions to execute when this alarm transitions into an INSUFFICIENT_DATA
 	// state from any other state. Each action is specified as an Amazon Resource
@@ -870,28 +1040,28 @@ type MetricAlarm struct {
 	// SNS topic or triggering an Auto Scaling policy.
 	//
 	// The current WSDL lists this attribute as UnknownActions.
-	InsufficientDataActions []*string `type:"list"`
+	InsufficientDataActions []*string `type:"list" max:"5"`
 
 	// The name of the alarm's metric.
-	MetricName *string `type:"string"`
+	MetricName *string `type:"string" min:"1" max:"255"`
 
 	// The namespace of alarm's associated metric.
-	Namespace *string `type:"string"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*"`
 
 	// The list of actions to execute when this alarm transitions into an OK state
 	// from any other state. Each action is specified as an Amazon Resource Number
 	// (ARN). Currently the only actions supported are publishing to an Amazon SNS
 	// topic and triggering an Auto Scaling policy.
-	OKActions []*string `type:"list"`
+	OKActions []*string `type:"list" max:"5"`
 
 	// The period in seconds over which the statistic is applied.
-	Period *int64 `type:"integer"`
+	Period *int64 `type:"integer" min:"60"`
 
 	// A human-readable explanation for the alarm's state.
-	StateReason *string `type:"string"`
+	StateReason *string `type:"string" min:"0" max:"1023"`
 
 	// An explanation for the alarm's state in machine-readable JSON format
-	StateReasonData *string `type:"string"`
+	StateReasonData *string `type:"string" min:"0" max:"4000"`
 
 	// The time stamp of the last update to the alarm's state. Amazon CloudWatch
 	// uses Coordinated Universal Time (UTC) when returning time stamps, which do
@@ -901,16 +1071,16 @@ type MetricAlarm struct {
 	StateUpdatedTimestamp *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The state value for the alarm.
-	StateValue *string `type:"string"`
+	StateValue *string `type:"string" enum:"OK,ALARM,INSUFFICIENT_DATA"`
 
 	// The statistic to apply to the alarm's associated metric.
-	Statistic *string `type:"string"`
+	Statistic *string `type:"string" enum:"SampleCount,Average,Sum,Minimum,Maximum"`
 
 	// The value against which the specified statistic is compared.
 	Threshold *float64 `type:"double"`
 
 	// The unit of the alarm's associated metric.
-	Unit *string `type:"string"`
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	metadataMetricAlarm `json:"-", xml:"-"`
 }
@@ -925,10 +1095,10 @@ type metadataMetricAlarm struct {
 type MetricDatum struct {
 	// A list of dimensions associated with the metric. Note, when using the Dimensions
 	// value in a query, you need to append .member.N to it (e.g., Dimensions.member.N).
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The name of the metric.
-	MetricName *string `type:"string" required:"true"`
+	MetricName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// A set of statistical values describing the metric.
 	StatisticValues *StatisticSet `type:"structure"`
@@ -942,7 +1112,7 @@ type MetricDatum struct {
 	Timestamp *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The unit of the metric.
-	Unit *string `type:"string"`
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	// The value for the metric.
 	//
@@ -968,54 +1138,54 @@ type PutMetricAlarmInput struct {
 	// state from any other state. Each action is specified as an Amazon Resource
 	// Number (ARN). Currently the only action supported is publishing to an Amazon
 	// SNS topic or an Amazon Auto Scaling policy.
-	AlarmActions []*string `type:"list"`
+	AlarmActions []*string `type:"list" max:"5"`
 
 	// The description for the alarm.
-	AlarmDescription *string `type:"string"`
+	AlarmDescription *string `type:"string" min:"0" max:"255"`
 
 	// The descriptive name for the alarm. This name must be unique within the user's
 	// AWS account
-	AlarmName *string `type:"string" required:"true"`
+	AlarmName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The arithmetic operation to use when comparing the specified Statistic and
 	// Threshold. The specified Statistic value is used as the first operand.
-	ComparisonOperator *string `type:"string" required:"true"`
+	ComparisonOperator *string `type:"string" enum:"GreaterThanOrEqualToThreshold,GreaterThanThreshold,LessThanThreshold,LessThanOrEqualToThreshold" required:"true"`
 
 	// The dimensions for the alarm's associated metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The number of periods over which data is compared to the specified threshold.
-	EvaluationPeriods *int64 `type:"integer" required:"true"`
+	EvaluationPeriods *int64 `type:"integer" min:"1" required:"true"`
 
 	// The list of actions to execute when this alarm transitions into an INSUFFICIENT_DATA
 	// state from any other state. Each action is specified as an Amazon Resource
 	// Number (ARN). Currently the only action supported is publishing to an Amazon
 	// SNS topic or an Amazon Auto Scaling policy.
-	InsufficientDataActions []*string `type:"list"`
+	InsufficientDataActions []*string `type:"list" max:"5"`
 
 	// The name for the alarm's associated metric.
-	MetricName *string `type:"string" required:"true"`
+	MetricName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The namespace for the alarm's associated metric.
-	Namespace *string `type:"string" required:"true"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*" required:"true"`
 
 	// The list of actions to execute when this alarm transitions into an OK state
 	// from any other state. Each action is specified as an Amazon Resource Number
 	// (ARN). Currently the only action supported is publishing to an Amazon SNS
 	// topic or an Amazon Auto Scaling policy.
-	OKActions []*string `type:"list"`
+	OKActions []*string `type:"list" max:"5"`
 
 	// The period in seconds over which the specified statistic is applied.
-	Period *int64 `type:"integer" required:"true"`
+	Period *int64 `type:"integer" min:"60" required:"true"`
 
 	// The statistic to apply to the alarm's associated metric.
-	Statistic *string `type:"string" required:"true"`
+	Statistic *string `type:"string" enum:"SampleCount,Average,Sum,Minimum,Maximum" required:"true"`
 
 	// The value against which the specified statistic is compared.
 	Threshold *float64 `type:"double" required:"true"`
 
 	// The unit for the alarm's associated metric.
-	Unit *string `type:"string"`
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	metadataPutMetricAlarmInput `json:"-", xml:"-"`
 }
@@ -1037,7 +1207,7 @@ type PutMetricDataInput struct {
 	MetricData []*MetricDatum `type:"list" required:"true"`
 
 	// The namespace for the metric data.
-	Namespace *string `type:"string" required:"true"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*" required:"true"`
 
 	metadataPutMetricDataInput `json:"-", xml:"-"`
 }
@@ -1057,18 +1227,18 @@ type metadataPutMetricDataOutput struct {
 type SetAlarmStateInput struct {
 	// The descriptive name for the alarm. This name must be unique within the user's
 	// AWS account. The maximum length is 255 characters.
-	AlarmName *string `type:"string" required:"true"`
+	AlarmName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The reason that this alarm is set to this specific state (in human-readable
 	// text format)
-	StateReason *string `type:"string" required:"true"`
+	StateReason *string `type:"string" min:"0" max:"1023" required:"true"`
 
 	// The reason that this alarm is set to this specific state (in machine-readable
 	// JSON format)
-	StateReasonData *string `type:"string"`
+	StateReasonData *string `type:"string" min:"0" max:"4000"`
 
 	// The value of the state.
-	StateValue *string `type:"string" required:"true"`
+	StateValue *string `type:"string" enum:"OK,ALARM,INSUFFICIENT_DATA" required:"true"`
 
 	metadataSetAlarmStateInput `json:"-", xml:"-"`
 }
@@ -1105,4 +1275,138 @@ type StatisticSet struct {
 
 type metadataStatisticSet struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for ComparisonOperator.
+const (
+	ComparisonOperatorGreaterThanOrEqualToThreshold = "GreaterThanOrEqualToThreshold"
+	ComparisonOperatorGreaterThanThreshold          = "GreaterThanThreshold"
+	ComparisonOperatorLessThanThreshold             = "LessThanThreshold"
+	ComparisonOperatorLessThanOrEqualToThreshold    = "LessThanOrEqualToThreshold"
+)
+
+// ComparisonOperatorValues returns the valid values of ComparisonOperator.
+func ComparisonOperatorValues() []string {
+	return []string{
+		ComparisonOperatorGreaterThanOrEqualToThreshold,
+		ComparisonOperatorGreaterThanThreshold,
+		ComparisonOperatorLessThanThreshold,
+		ComparisonOperatorLessThanOrEqualToThreshold,
+	}
+}
+
+// Enum values for HistoryItemType.
+const (
+	HistoryItemTypeConfigurationUpdate = "ConfigurationUpdate"
+	HistoryItemTypeStateUpdate         = "StateUpdate"
+	HistoryItemTypeAction              = "Action"
+)
+
+// HistoryItemTypeValues returns the valid values of HistoryItemType.
+func HistoryItemTypeValues() []string {
+	return []string{
+		HistoryItemTypeConfigurationUpdate,
+		HistoryItemTypeStateUpdate,
+		HistoryItemTypeAction,
+	}
+}
+
+// Enum values for StandardUnit.
+const (
+	StandardUnitSeconds         = "Seconds"
+	StandardUnitMicroseconds    = "Microseconds"
+	StandardUnitMilliseconds    = "Milliseconds"
+	StandardUnitBytes           = "Bytes"
+	StandardUnitKilobytes       = "Kilobytes"
+	StandardUnitMegabytes       = "Megabytes"
+	StandardUnitGigabytes       = "Gigabytes"
+	StandardUnitTerabytes       = "Terabytes"
+	StandardUnitBits            = "Bits"
+	StandardUnitKilobits        = "Kilobits"
+	StandardUnitMegabits        = "Megabits"
+	StandardUnitGigabits        = "Gigabits"
+	StandardUnitTerabits        = "Terabits"
+	StandardUnitPercent         = "Percent"
+	StandardUnitCount           = "Count"
+	StandardUnitBytesSecond     = "Bytes/Second"
+	StandardUnitKilobytesSecond = "Kilobytes/Second"
+	StandardUnitMegabytesSecond = "Megabytes/Second"
+	StandardUnitGigabytesSecond = "Gigabytes/Second"
+	StandardUnitTerabytesSecond = "Terabytes/Second"
+	StandardUnitBitsSecond      = "Bits/Second"
+	StandardUnitKilobitsSecond  = "Kilobits/Second"
+	StandardUnitMegabitsSecond  = "Megabits/Second"
+	StandardUnitGigabitsSecond  = "Gigabits/Second"
+	StandardUnitTerabitsSecond  = "Terabits/Second"
+	StandardUnitCountSecond     = "Count/Second"
+	StandardUnitNone            = "None"
+)
+
+// StandardUnitValues returns the valid values of StandardUnit.
+func StandardUnitValues() []string {
+	return []string{
+		StandardUnitSeconds,
+		StandardUnitMicroseconds,
+		StandardUnitMilliseconds,
+		StandardUnitBytes,
+		StandardUnitKilobytes,
+		StandardUnitMegabytes,
+		StandardUnitGigabytes,
+		StandardUnitTerabytes,
+		StandardUnitBits,
+		StandardUnitKilobits,
+		StandardUnitMegabits,
+		StandardUnitGigabits,
+		StandardUnitTerabits,
+		StandardUnitPercent,
+		StandardUnitCount,
+		StandardUnitBytesSecond,
+		StandardUnitKilobytesSecond,
+		StandardUnitMegabytesSecond,
+		StandardUnitGigabytesSecond,
+		StandardUnitTerabytesSecond,
+		StandardUnitBitsSecond,
+		StandardUnitKilobitsSecond,
+		StandardUnitMegabitsSecond,
+		StandardUnitGigabitsSecond,
+		StandardUnitTerabitsSecond,
+		StandardUnitCountSecond,
+		StandardUnitNone,
+	}
+}
+
+// Enum values for StateValue.
+const (
+	StateValueOK               = "OK"
+	StateValueAlarm            = "ALARM"
+	StateValueInsufficientData = "INSUFFICIENT_DATA"
+)
+
+// StateValueValues returns the valid values of StateValue.
+func StateValueValues() []string {
+	return []string{
+		StateValueOK,
+		StateValueAlarm,
+		StateValueInsufficientData,
+	}
+}
+
+// Enum values for Statistic.
+const (
+	StatisticSampleCount = "SampleCount"
+	StatisticAverage     = "Average"
+	StatisticSum         = "Sum"
+	StatisticMinimum     = "Minimum"
+	StatisticMaximum     = "Maximum"
+)
+
+// StatisticValues returns the valid values of Statistic.
+func StatisticValues() []string {
+	return []string{
+		StatisticSampleCount,
+		StatisticAverage,
+		StatisticSum,
+		StatisticMinimum,
+		StatisticMaximum,
+	}
 }
\ No newline at end of file
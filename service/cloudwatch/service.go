@@ -27,6 +27,7 @@ func New(config *aws.Config) *CloudWatch {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "monitoring",
 		APIVersion:  "2010-08-01",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package cloudwatchlogs
 
 import (
+	"context"
 	"sync"
 
 	"github.com/datacratic/aws-sdk-go/aws"
@@ -48,6 +49,17 @@ func (c *CloudWatchLogs) CreateLogGroup(input *CreateLogGroupInput) (output *Cre
 	return
 }
 
+// CreateLogGroupWithContext is the same as CreateLogGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatchLogs) CreateLogGroupWithContext(ctx context.Context, input *CreateLogGroupInput) (output *CreateLogGroupOutput, err error) {
+	req, out := c.CreateLogGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateLogGroup *aws.Operation
 
 // CreateLogStreamRequest generates a request for the CreateLogStream operation.
@@ -87,6 +99,17 @@ func (c *CloudWatchLogs) CreateLogStream(input *CreateLogStreamInput) (output *C
 	return
 }
 
+// CreateLogStreamWithContext is the same as CreateLogStream with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatchLogs) CreateLogStreamWithContext(ctx context.Context, input *CreateLogStreamInput) (output *CreateLogStreamOutput, err error) {
+	req, out := c.CreateLogStreamRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateLogStream *aws.Operation
 
 // DeleteLogGroupRequest generates a request for the DeleteLogGroup operation.
@@ -121,6 +144,17 @@ func (c *CloudWatchLogs) DeleteLogGroup(input *DeleteLogGroupInput) (output *Del
 	return
 }
 
+// DeleteLogGroupWithContext is the same as DeleteLogGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatchLogs) DeleteLogGroupWithContext(ctx context.Context, input *DeleteLogGroupInput) (output *DeleteLogGroupOutput, err error) {
+	req, out := c.DeleteLogGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteLogGroup *aws.Operation
 
 // DeleteLogStreamRequest generates a request for the DeleteLogStream operation.
@@ -155,6 +189,17 @@ func (c *CloudWatchLogs) DeleteLogStream(input *DeleteLogStreamInput) (output *D
 	return
 }
 
+// DeleteLogStreamWithContext is the same as DeleteLogStream with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatchLogs) DeleteLogStreamWithContext(ctx context.Context, input *DeleteLogStreamInput) (output *DeleteLogStreamOutput, err error) {
+	req, out := c.DeleteLogStreamRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteLogStream *aws.Operation
 
 // DeleteMetricFilterRequest generates a request for the DeleteMetricFilter operation.
@@ -188,6 +233,17 @@ func (c *CloudWatchLogs) DeleteMetricFilter(input *DeleteMetricFilterInput) (out
 	return
 }
 
+// DeleteMetricFilterWithContext is the same as DeleteMetricFilter with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatchLogs) DeleteMetricFilterWithContext(ctx context.Context, input *DeleteMetricFilterInput) (output *DeleteMetricFilterOutput, err error) {
+	req, out := c.DeleteMetricFilterRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteMetricFilter *aws.Operation
 
 // DeleteRetentionPolicyRequest generates a request for the DeleteRetentionPolicy operation.
@@ -222,6 +278,17 @@ func (c *CloudWatchLogs) DeleteRetentionPolicy(input *DeleteRetentionPolicyInput
 	return
 }
 
+// DeleteRetentionPolicyWithContext is the same as DeleteRetentionPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatchLogs) DeleteRetentionPolicyWithContext(ctx context.Context, input *DeleteRetentionPolicyInput) (output *DeleteRetentionPolicyOutput, err error) {
+	req, out := c.DeleteRetentionPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteRetentionPolicy *aws.Operation
 
 // DescribeLogGroupsRequest generates a request for the DescribeLogGroups operation.
@@ -262,6 +329,17 @@ func (c *CloudWatchLogs) DescribeLogGroups(input *DescribeLogGroupsInput) (outpu
 	return
 }
 
+// DescribeLogGroupsWithContext is the same as DescribeLogGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatchLogs) DescribeLogGroupsWithContext(ctx context.Context, input *DescribeLogGroupsInput) (output *DescribeLogGroupsOutput, err error) {
+	req, out := c.DescribeLogGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeLogGroups *aws.Operation
 
 // DescribeLogStreamsRequest generates a request for the DescribeLogStreams operation.
@@ -303,6 +381,17 @@ func (c *CloudWatchLogs) DescribeLogStreams(input *DescribeLogStreamsInput) (out
 	return
 }
 
+// DescribeLogStreamsWithContext is the same as DescribeLogStreams with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatchLogs) DescribeLogStreamsWithContext(ctx context.Context, input *DescribeLogStreamsInput) (output *DescribeLogStreamsOutput, err error) {
+	req, out := c.DescribeLogStreamsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeLogStreams *aws.Operation
 
 // DescribeMetricFiltersRequest generates a request for the DescribeMetricFilters operation.
@@ -342,6 +431,17 @@ func (c *CloudWatchLogs) DescribeMetricFilters(input *DescribeMetricFiltersInput
 	return
 }
 
+// DescribeMetricFiltersWithContext is the same as DescribeMetricFilters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatchLogs) DescribeMetricFiltersWithContext(ctx context.Context, input *DescribeMetricFiltersInput) (output *DescribeMetricFiltersOutput, err error) {
+	req, out := c.DescribeMetricFiltersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeMetricFilters *aws.Operation
 
 // GetLogEventsRequest generates a request for the GetLogEvents operation.
@@ -384,6 +484,17 @@ func (c *CloudWatchLogs) GetLogEvents(input *GetLogEventsInput) (output *GetLogE
 	return
 }
 
+// GetLogEventsWithContext is the same as GetLogEvents with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatchLogs) GetLogEventsWithContext(ctx context.Context, input *GetLogEventsInput) (output *GetLogEventsOutput, err error) {
+	req, out := c.GetLogEventsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetLogEvents *aws.Operation
 
 // PutLogEventsRequest generates a request for the PutLogEvents operation.
@@ -429,6 +540,17 @@ func (c *CloudWatchLogs) PutLogEvents(input *PutLogEventsInput) (output *PutLogE
 	return
 }
 
+// PutLogEventsWithContext is the same as PutLogEvents with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatchLogs) PutLogEventsWithContext(ctx context.Context, input *PutLogEventsInput) (output *PutLogEventsOutput, err error) {
+	req, out := c.PutLogEventsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutLogEvents *aws.Operation
 
 // PutMetricFilterRequest generates a request for the PutMetricFilter operation.
@@ -464,6 +586,17 @@ func (c *CloudWatchLogs) PutMetricFilter(input *PutMetricFilterInput) (output *P
 	return
 }
 
+// PutMetricFilterWithContext is the same as PutMetricFilter with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatchLogs) PutMetricFilterWithContext(ctx context.Context, input *PutMetricFilterInput) (output *PutMetricFilterOutput, err error) {
+	req, out := c.PutMetricFilterRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutMetricFilter *aws.Operation
 
 // PutRetentionPolicyRequest generates a request for the PutRetentionPolicy operation.
@@ -499,6 +632,17 @@ func (c *CloudWatchLogs) PutRetentionPolicy(input *PutRetentionPolicyInput) (out
 	return
 }
 
+// PutRetentionPolicyWithContext is the same as PutRetentionPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatchLogs) PutRetentionPolicyWithContext(ctx context.Context, input *PutRetentionPolicyInput) (output *PutRetentionPolicyOutput, err error) {
+	req, out := c.PutRetentionPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutRetentionPolicy *aws.Operation
 
 // TestMetricFilterRequest generates a request for the TestMetricFilter operation.
@@ -534,10 +678,21 @@ func (c *CloudWatchLogs) TestMetricFilter(input *TestMetricFilterInput) (output
 	return
 }
 
+// TestMetricFilterWithContext is the same as TestMetricFilter with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CloudWatchLogs) TestMetricFilterWithContext(ctx context.Context, input *TestMetricFilterInput) (output *TestMetricFilterOutput, err error) {
+	req, out := c.TestMetricFilterRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opTestMetricFilter *aws.Operation
 
 type CreateLogGroupInput struct {
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	metadataCreateLogGroupInput `json:"-", xml:"-"`
 }
@@ -555,9 +710,9 @@ type metadataCreateLogGroupOutput struct {
 }
 
 type CreateLogStreamInput struct {
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
-	LogStreamName *string `locationName:"logStreamName" type:"string" required:"true"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	metadataCreateLogStreamInput `json:"-", xml:"-"`
 }
@@ -575,7 +730,7 @@ type metadataCreateLogStreamOutput struct {
 }
 
 type DeleteLogGroupInput struct {
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	metadataDeleteLogGroupInput `json:"-", xml:"-"`
 }
@@ -593,9 +748,9 @@ type metadataDeleteLogGroupOutput struct {
 }
 
 type DeleteLogStreamInput struct {
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
-	LogStreamName *string `locationName:"logStreamName" type:"string" required:"true"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	metadataDeleteLogStreamInput `json:"-", xml:"-"`
 }
@@ -614,9 +769,9 @@ type metadataDeleteLogStreamOutput struct {
 
 type DeleteMetricFilterInput struct {
 	// The name of the metric filter.
-	FilterName *string `locationName:"filterName" type:"string" required:"true"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	metadataDeleteMetricFilterInput `json:"-", xml:"-"`
 }
@@ -634,7 +789,7 @@ type metadataDeleteMetricFilterOutput struct {
 }
 
 type DeleteRetentionPolicyInput struct {
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	metadataDeleteRetentionPolicyInput `json:"-", xml:"-"`
 }
@@ -654,14 +809,14 @@ type metadataDeleteRetentionPolicyOutput struct {
 type DescribeLogGroupsInput struct {
 	// The maximum number of items returned in the response. If you don't specify
 	// a value, the request would return up to 50 items.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"50"`
 
-	LogGroupNamePrefix *string `locationName:"logGroupNamePrefix" type:"string"`
+	LogGroupNamePrefix *string `locationName:"logGroupNamePrefix" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous DescribeLogGroups
 	// request.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeLogGroupsInput `json:"-", xml:"-"`
 }
@@ -677,7 +832,7 @@ type DescribeLogGroupsOutput struct {
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeLogGroupsOutput `json:"-", xml:"-"`
 }
@@ -693,24 +848,24 @@ type DescribeLogStreamsInput struct {
 
 	// The maximum number of items returned in the response. If you don't specify
 	// a value, the request would return up to 50 items.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"50"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// Will only return log streams that match the provided logStreamNamePrefix.
 	// If you don't specify a value, no prefix filter is applied.
-	LogStreamNamePrefix *string `locationName:"logStreamNamePrefix" type:"string"`
+	LogStreamNamePrefix *string `locationName:"logStreamNamePrefix" type:"string" min:"1" max:"512" pattern:"[^:*]*"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous DescribeLogStreams
 	// request.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	// Specifies what to order the returned log streams by. Valid arguments are
 	// 'LogStreamName' or 'LastEventTime'. If you don't specify a value, results
 	// are ordered by LogStreamName. If 'LastEventTime' is chosen, the request cannot
 	// also contain a logStreamNamePrefix.
-	OrderBy *string `locationName:"orderBy" type:"string"`
+	OrderBy *string `locationName:"orderBy" type:"string" enum:"LogStreamName,LastEventTime"`
 
 	metadataDescribeLogStreamsInput `json:"-", xml:"-"`
 }
@@ -726,7 +881,7 @@ type DescribeLogStreamsOutput struct {
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeLogStreamsOutput `json:"-", xml:"-"`
 }
@@ -737,18 +892,18 @@ type metadataDescribeLogStreamsOutput struct {
 
 type DescribeMetricFiltersInput struct {
 	// The name of the metric filter.
-	FilterNamePrefix *string `locationName:"filterNamePrefix" type:"string"`
+	FilterNamePrefix *string `locationName:"filterNamePrefix" type:"string" min:"1" max:"512" pattern:"[^:*]*"`
 
 	// The maximum number of items returned in the response. If you don't specify
 	// a value, the request would return up to 50 items.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"50"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous DescribeMetricFilters
 	// request.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeMetricFiltersInput `json:"-", xml:"-"`
 }
@@ -763,7 +918,7 @@ type DescribeMetricFiltersOutput struct {
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeMetricFiltersOutput `json:"-", xml:"-"`
 }
@@ -775,21 +930,21 @@ type metadataDescribeMetricFiltersOutput struct {
 type GetLogEventsInput struct {
 	// A point in time expressed as the number milliseconds since Jan 1, 1970 00:00:00
 	// UTC.
-	EndTime *int64 `locationName:"endTime" type:"long"`
+	EndTime *int64 `locationName:"endTime" type:"long" min:"0"`
 
 	// The maximum number of log events returned in the response. If you don't specify
 	// a value, the request would return as much log events as can fit in a response
 	// size of 1MB, up to 10,000 log events.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"10000"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
-	LogStreamName *string `locationName:"logStreamName" type:"string" required:"true"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the nextForwardToken or nextBackwardToken
 	// fields in the response of the previous GetLogEvents request.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	// If set to true, the earliest log events would be returned first. The default
 	// is false (the latest log events are returned first).
@@ -797,7 +952,7 @@ type GetLogEventsInput struct {
 
 	// A point in time expressed as the number milliseconds since Jan 1, 1970 00:00:00
 	// UTC.
-	StartTime *int64 `locationName:"startTime" type:"long"`
+	StartTime *int64 `locationName:"startTime" type:"long" min:"0"`
 
 	metadataGetLogEventsInput `json:"-", xml:"-"`
 }
@@ -812,12 +967,12 @@ type GetLogEventsOutput struct {
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextBackwardToken *string `locationName:"nextBackwardToken" type:"string"`
+	NextBackwardToken *string `locationName:"nextBackwardToken" type:"string" min:"1"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextForwardToken *string `locationName:"nextForwardToken" type:"string"`
+	NextForwardToken *string `locationName:"nextForwardToken" type:"string" min:"1"`
 
 	metadataGetLogEventsOutput `json:"-", xml:"-"`
 }
@@ -831,11 +986,11 @@ type metadataGetLogEventsOutput struct {
 // Logs understands contains two properties: the timestamp of when the event
 // occurred, and the raw event message.
 type InputLogEvent struct {
-	Message *string `locationName:"message" type:"string" required:"true"`
+	Message *string `locationName:"message" type:"string" min:"1" required:"true"`
 
 	// A point in time expressed as the number milliseconds since Jan 1, 1970 00:00:00
 	// UTC.
-	Timestamp *int64 `locationName:"timestamp" type:"long" required:"true"`
+	Timestamp *int64 `locationName:"timestamp" type:"long" min:"0" required:"true"`
 
 	metadataInputLogEvent `json:"-", xml:"-"`
 }
@@ -849,9 +1004,9 @@ type LogGroup struct {
 
 	// A point in time expressed as the number milliseconds since Jan 1, 1970 00:00:00
 	// UTC.
-	CreationTime *int64 `locationName:"creationTime" type:"long"`
+	CreationTime *int64 `locationName:"creationTime" type:"long" min:"0"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+"`
 
 	// The number of metric filters associated with the log group.
 	MetricFilterCount *int64 `locationName:"metricFilterCount" type:"integer"`
@@ -861,7 +1016,7 @@ type LogGroup struct {
 	// 365, 400, 545, 731, 1827, 3653.
 	RetentionInDays *int64 `locationName:"retentionInDays" type:"integer"`
 
-	StoredBytes *int64 `locationName:"storedBytes" type:"long"`
+	StoredBytes *int64 `locationName:"storedBytes" type:"long" min:"0"`
 
 	metadataLogGroup `json:"-", xml:"-"`
 }
@@ -876,28 +1031,28 @@ type LogStream struct {
 
 	// A point in time expressed as the number milliseconds since Jan 1, 1970 00:00:00
 	// UTC.
-	CreationTime *int64 `locationName:"creationTime" type:"long"`
+	CreationTime *int64 `locationName:"creationTime" type:"long" min:"0"`
 
 	// A point in time expressed as the number milliseconds since Jan 1, 1970 00:00:00
 	// UTC.
-	FirstEventTimestamp *int64 `locationName:"firstEventTimestamp" type:"long"`
+	FirstEventTimestamp *int64 `locationName:"firstEventTimestamp" type:"long" min:"0"`
 
 	// A point in time expressed as the number milliseconds since Jan 1, 1970 00:00:00
 	// UTC.
-	LastEventTimestamp *int64 `locationName:"lastEventTimestamp" type:"long"`
+	LastEventTimestamp *int64 `locationName:"lastEventTimestamp" type:"long" min:"0"`
 
 	// A point in time expressed as the number milliseconds since Jan 1, 1970 00:00:00
 	// UTC.
-	LastIngestionTime *int64 `locationName:"lastIngestionTime" type:"long"`
+	LastIngestionTime *int64 `locationName:"lastIngestionTime" type:"long" min:"0"`
 
-	LogStreamName *string `locationName:"logStreamName" type:"string"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*"`
 
-	StoredBytes *int64 `locationName:"storedBytes" type:"long"`
+	StoredBytes *int64 `locationName:"storedBytes" type:"long" min:"0"`
 
 	// A string token used for making PutLogEvents requests. A sequenceToken can
 	// only be used once, and PutLogEvents requests must include the sequenceToken
 	// obtained from the response of the previous request.
-	UploadSequenceToken *string `locationName:"uploadSequenceToken" type:"string"`
+	UploadSequenceToken *string `locationName:"uploadSequenceToken" type:"string" min:"1"`
 
 	metadataLogStream `json:"-", xml:"-"`
 }
@@ -912,18 +1067,18 @@ type metadataLogStream struct {
 type MetricFilter struct {
 	// A point in time expressed as the number milliseconds since Jan 1, 1970 00:00:00
 	// UTC.
-	CreationTime *int64 `locationName:"creationTime" type:"long"`
+	CreationTime *int64 `locationName:"creationTime" type:"long" min:"0"`
 
 	// The name of the metric filter.
-	FilterName *string `locationName:"filterName" type:"string"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512" pattern:"[^:*]*"`
 
 	// A symbolic description of how Amazon CloudWatch Logs should interpret the
 	// data in each log entry. For example, a log entry may contain timestamps,
 	// IP addresses, strings, and so on. You use the pattern to specify what to
 	// look for in the log stream.
-	FilterPattern *string `locationName:"filterPattern" type:"string"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" min:"0" max:"512"`
 
-	MetricTransformations []*MetricTransformation `locationName:"metricTransformations" type:"list"`
+	MetricTransformations []*MetricTransformation `locationName:"metricTransformations" type:"list" min:"1" max:"1"`
 
 	metadataMetricFilter `json:"-", xml:"-"`
 }
@@ -933,7 +1088,7 @@ type metadataMetricFilter struct {
 }
 
 type MetricFilterMatchRecord struct {
-	EventMessage *string `locationName:"eventMessage" type:"string"`
+	EventMessage *string `locationName:"eventMessage" type:"string" min:"1"`
 
 	EventNumber *int64 `locationName:"eventNumber" type:"long"`
 
@@ -949,16 +1104,16 @@ type metadataMetricFilterMatchRecord struct {
 type MetricTransformation struct {
 	// The name of the CloudWatch metric to which the monitored log information
 	// should be published. For example, you may publish to a metric called ErrorCount.
-	MetricName *string `locationName:"metricName" type:"string" required:"true"`
+	MetricName *string `locationName:"metricName" type:"string" max:"255" pattern:"[^:*$]*" required:"true"`
 
 	// The destination namespace of the new CloudWatch metric.
-	MetricNamespace *string `locationName:"metricNamespace" type:"string" required:"true"`
+	MetricNamespace *string `locationName:"metricNamespace" type:"string" max:"255" pattern:"[^:*$]*" required:"true"`
 
 	// What to publish to the metric. For example, if you're counting the occurrences
 	// of a particular term like "Error", the value will be "1" for each occurrence.
 	// If you're counting the bytes transferred the published value will be the
 	// value in the log event.
-	MetricValue *string `locationName:"metricValue" type:"string" required:"true"`
+	MetricValue *string `locationName:"metricValue" type:"string" max:"100" required:"true"`
 
 	metadataMetricTransformation `json:"-", xml:"-"`
 }
@@ -970,13 +1125,13 @@ type metadataMetricTransformation struct {
 type OutputLogEvent struct {
 	// A point in time expressed as the number milliseconds since Jan 1, 1970 00:00:00
 	// UTC.
-	IngestionTime *int64 `locationName:"ingestionTime" type:"long"`
+	IngestionTime *int64 `locationName:"ingestionTime" type:"long" min:"0"`
 
-	Message *string `locationName:"message" type:"string"`
+	Message *string `locationName:"message" type:"string" min:"1"`
 
 	// A point in time expressed as the number milliseconds since Jan 1, 1970 00:00:00
 	// UTC.
-	Timestamp *int64 `locationName:"timestamp" type:"long"`
+	Timestamp *int64 `locationName:"timestamp" type:"long" min:"0"`
 
 	metadataOutputLogEvent `json:"-", xml:"-"`
 }
@@ -987,15 +1142,15 @@ type metadataOutputLogEvent struct {
 
 type PutLogEventsInput struct {
 	// A list of events belonging to a log stream.
-	LogEvents []*InputLogEvent `locationName:"logEvents" type:"list" required:"true"`
+	LogEvents []*InputLogEvent `locationName:"logEvents" type:"list" min:"1" max:"10000" required:"true"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
-	LogStreamName *string `locationName:"logStreamName" type:"string" required:"true"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	// A string token that must be obtained from the response of the previous PutLogEvents
 	// request.
-	SequenceToken *string `locationName:"sequenceToken" type:"string"`
+	SequenceToken *string `locationName:"sequenceToken" type:"string" min:"1"`
 
 	metadataPutLogEventsInput `json:"-", xml:"-"`
 }
@@ -1008,7 +1163,7 @@ type PutLogEventsOutput struct {
 	// A string token used for making PutLogEvents requests. A sequenceToken can
 	// only be used once, and PutLogEvents requests must include the sequenceToken
 	// obtained from the response of the previous request.
-	NextSequenceToken *string `locationName:"nextSequenceToken" type:"string"`
+	NextSequenceToken *string `locationName:"nextSequenceToken" type:"string" min:"1"`
 
 	RejectedLogEventsInfo *RejectedLogEventsInfo `locationName:"rejectedLogEventsInfo" type:"structure"`
 
@@ -1021,17 +1176,17 @@ type metadataPutLogEventsOutput struct {
 
 type PutMetricFilterInput struct {
 	// The name of the metric filter.
-	FilterName *string `locationName:"filterName" type:"string" required:"true"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	// A symbolic description of how Amazon CloudWatch Logs should interpret the
 	// data in each log entry. For example, a log entry may contain timestamps,
 	// IP addresses, strings, and so on. You use the pattern to specify what to
 	// look for in the log stream.
-	FilterPattern *string `locationName:"filterPattern" type:"string" required:"true"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" min:"0" max:"512" required:"true"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
-	MetricTransformations []*MetricTransformation `locationName:"metricTransformations" type:"list" required:"true"`
+	MetricTransformations []*MetricTransformation `locationName:"metricTransformations" type:"list" min:"1" max:"1" required:"true"`
 
 	metadataPutMetricFilterInput `json:"-", xml:"-"`
 }
@@ -1049,7 +1204,7 @@ type metadataPutMetricFilterOutput struct {
 }
 
 type PutRetentionPolicyInput struct {
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// Specifies the number of days you want to retain log events in the specified
 	// log group. Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180,
@@ -1090,9 +1245,9 @@ type TestMetricFilterInput struct {
 	// data in each log entry. For example, a log entry may contain timestamps,
 	// IP addresses, strings, and so on. You use the pattern to specify what to
 	// look for in the log stream.
-	FilterPattern *string `locationName:"filterPattern" type:"string" required:"true"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" min:"0" max:"512" required:"true"`
 
-	LogEventMessages []*string `locationName:"logEventMessages" type:"list" required:"true"`
+	LogEventMessages []*string `locationName:"logEventMessages" type:"list" min:"1" max:"50" required:"true"`
 
 	metadataTestMetricFilterInput `json:"-", xml:"-"`
 }
@@ -1109,4 +1264,18 @@ type TestMetricFilterOutput struct {
 
 type metadataTestMetricFilterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for OrderBy.
+const (
+	OrderByLogStreamName = "LogStreamName"
+	OrderByLastEventTime = "LastEventTime"
+)
+
+// OrderByValues returns the valid values of OrderBy.
+func OrderByValues() []string {
+	return []string{
+		OrderByLogStreamName,
+		OrderByLastEventTime,
+	}
 }
\ No newline at end of file
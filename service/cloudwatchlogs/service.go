@@ -29,6 +29,7 @@ func New(config *aws.Config) *CloudWatchLogs {
 		APIVersion:   "2014-03-28",
 		JSONVersion:  "1.1",
 		TargetPrefix: "Logs_20140328",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package codedeploy
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -43,6 +44,17 @@ func (c *CodeDeploy) BatchGetApplications(input *BatchGetApplicationsInput) (out
 	return
 }
 
+// BatchGetApplicationsWithContext is the same as BatchGetApplications with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) BatchGetApplicationsWithContext(ctx context.Context, input *BatchGetApplicationsInput) (output *BatchGetApplicationsOutput, err error) {
+	req, out := c.BatchGetApplicationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opBatchGetApplications *aws.Operation
 
 // BatchGetDeploymentsRequest generates a request for the BatchGetDeployments operation.
@@ -76,6 +88,17 @@ func (c *CodeDeploy) BatchGetDeployments(input *BatchGetDeploymentsInput) (outpu
 	return
 }
 
+// BatchGetDeploymentsWithContext is the same as BatchGetDeployments with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) BatchGetDeploymentsWithContext(ctx context.Context, input *BatchGetDeploymentsInput) (output *BatchGetDeploymentsOutput, err error) {
+	req, out := c.BatchGetDeploymentsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opBatchGetDeployments *aws.Operation
 
 // CreateApplicationRequest generates a request for the CreateApplication operation.
@@ -109,6 +132,17 @@ func (c *CodeDeploy) CreateApplication(input *CreateApplicationInput) (output *C
 	return
 }
 
+// CreateApplicationWithContext is the same as CreateApplication with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) CreateApplicationWithContext(ctx context.Context, input *CreateApplicationInput) (output *CreateApplicationOutput, err error) {
+	req, out := c.CreateApplicationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateApplication *aws.Operation
 
 // CreateDeploymentRequest generates a request for the CreateDeployment operation.
@@ -142,6 +176,17 @@ func (c *CodeDeploy) CreateDeployment(input *CreateDeploymentInput) (output *Cre
 	return
 }
 
+// CreateDeploymentWithContext is the same as CreateDeployment with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) CreateDeploymentWithContext(ctx context.Context, input *CreateDeploymentInput) (output *CreateDeploymentOutput, err error) {
+	req, out := c.CreateDeploymentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateDeployment *aws.Operation
 
 // CreateDeploymentConfigRequest generates a request for the CreateDeploymentConfig operation.
@@ -175,6 +220,17 @@ func (c *CodeDeploy) CreateDeploymentConfig(input *CreateDeploymentConfigInput)
 	return
 }
 
+// CreateDeploymentConfigWithContext is the same as CreateDeploymentConfig with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) CreateDeploymentConfigWithContext(ctx context.Context, input *CreateDeploymentConfigInput) (output *CreateDeploymentConfigOutput, err error) {
+	req, out := c.CreateDeploymentConfigRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateDeploymentConfig *aws.Operation
 
 // CreateDeploymentGroupRequest generates a request for the CreateDeploymentGroup operation.
@@ -208,6 +264,17 @@ func (c *CodeDeploy) CreateDeploymentGroup(input *CreateDeploymentGroupInput) (o
 	return
 }
 
+// CreateDeploymentGroupWithContext is the same as CreateDeploymentGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) CreateDeploymentGroupWithContext(ctx context.Context, input *CreateDeploymentGroupInput) (output *CreateDeploymentGroupOutput, err error) {
+	req, out := c.CreateDeploymentGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateDeploymentGroup *aws.Operation
 
 // DeleteApplicationRequest generates a request for the DeleteApplication operation.
@@ -241,6 +308,17 @@ func (c *CodeDeploy) DeleteApplication(input *DeleteApplicationInput) (output *D
 	return
 }
 
+// DeleteApplicationWithContext is the same as DeleteApplication with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) DeleteApplicationWithContext(ctx context.Context, input *DeleteApplicationInput) (output *DeleteApplicationOutput, err error) {
+	req, out := c.DeleteApplicationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteApplication *aws.Operation
 
 // DeleteDeploymentConfigRequest generates a request for the DeleteDeploymentConfig operation.
@@ -277,6 +355,17 @@ func (c *CodeDeploy) DeleteDeploymentConfig(input *DeleteDeploymentConfigInput)
 	return
 }
 
+// DeleteDeploymentConfigWithContext is the same as DeleteDeploymentConfig with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) DeleteDeploymentConfigWithContext(ctx context.Context, input *DeleteDeploymentConfigInput) (output *DeleteDeploymentConfigOutput, err error) {
+	req, out := c.DeleteDeploymentConfigRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteDeploymentConfig *aws.Operation
 
 // DeleteDeploymentGroupRequest generates a request for the DeleteDeploymentGroup operation.
@@ -310,6 +399,17 @@ func (c *CodeDeploy) DeleteDeploymentGroup(input *DeleteDeploymentGroupInput) (o
 	return
 }
 
+// DeleteDeploymentGroupWithContext is the same as DeleteDeploymentGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) DeleteDeploymentGroupWithContext(ctx context.Context, input *DeleteDeploymentGroupInput) (output *DeleteDeploymentGroupOutput, err error) {
+	req, out := c.DeleteDeploymentGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteDeploymentGroup *aws.Operation
 
 // GetApplicationRequest generates a request for the GetApplication operation.
@@ -343,6 +443,17 @@ func (c *CodeDeploy) GetApplication(input *GetApplicationInput) (output *GetAppl
 	return
 }
 
+// GetApplicationWithContext is the same as GetApplication with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) GetApplicationWithContext(ctx context.Context, input *GetApplicationInput) (output *GetApplicationOutput, err error) {
+	req, out := c.GetApplicationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetApplication *aws.Operation
 
 // GetApplicationRevisionRequest generates a request for the GetApplicationRevision operation.
@@ -376,6 +487,17 @@ func (c *CodeDeploy) GetApplicationRevision(input *GetApplicationRevisionInput)
 	return
 }
 
+// GetApplicationRevisionWithContext is the same as GetApplicationRevision with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) GetApplicationRevisionWithContext(ctx context.Context, input *GetApplicationRevisionInput) (output *GetApplicationRevisionOutput, err error) {
+	req, out := c.GetApplicationRevisionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetApplicationRevision *aws.Operation
 
 // GetDeploymentRequest generates a request for the GetDeployment operation.
@@ -409,6 +531,17 @@ func (c *CodeDeploy) GetDeployment(input *GetDeploymentInput) (output *GetDeploy
 	return
 }
 
+// GetDeploymentWithContext is the same as GetDeployment with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) GetDeploymentWithContext(ctx context.Context, input *GetDeploymentInput) (output *GetDeploymentOutput, err error) {
+	req, out := c.GetDeploymentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetDeployment *aws.Operation
 
 // GetDeploymentConfigRequest generates a request for the GetDeploymentConfig operation.
@@ -442,6 +575,17 @@ func (c *CodeDeploy) GetDeploymentConfig(input *GetDeploymentConfigInput) (outpu
 	return
 }
 
+// GetDeploymentConfigWithContext is the same as GetDeploymentConfig with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) GetDeploymentConfigWithContext(ctx context.Context, input *GetDeploymentConfigInput) (output *GetDeploymentConfigOutput, err error) {
+	req, out := c.GetDeploymentConfigRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetDeploymentConfig *aws.Operation
 
 // GetDeploymentGroupRequest generates a request for the GetDeploymentGroup operation.
@@ -475,6 +619,17 @@ func (c *CodeDeploy) GetDeploymentGroup(input *GetDeploymentGroupInput) (output
 	return
 }
 
+// GetDeploymentGroupWithContext is the same as GetDeploymentGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) GetDeploymentGroupWithContext(ctx context.Context, input *GetDeploymentGroupInput) (output *GetDeploymentGroupOutput, err error) {
+	req, out := c.GetDeploymentGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetDeploymentGroup *aws.Operation
 
 // GetDeploymentInstanceRequest generates a request for the GetDeploymentInstance operation.
@@ -508,6 +663,17 @@ func (c *CodeDeploy) GetDeploymentInstance(input *GetDeploymentInstanceInput) (o
 	return
 }
 
+// GetDeploymentInstanceWithContext is the same as GetDeploymentInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) GetDeploymentInstanceWithContext(ctx context.Context, input *GetDeploymentInstanceInput) (output *GetDeploymentInstanceOutput, err error) {
+	req, out := c.GetDeploymentInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetDeploymentInstance *aws.Operation
 
 // ListApplicationRevisionsRequest generates a request for the ListApplicationRevisions operation.
@@ -541,6 +707,17 @@ func (c *CodeDeploy) ListApplicationRevisions(input *ListApplicationRevisionsInp
 	return
 }
 
+// ListApplicationRevisionsWithContext is the same as ListApplicationRevisions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) ListApplicationRevisionsWithContext(ctx context.Context, input *ListApplicationRevisionsInput) (output *ListApplicationRevisionsOutput, err error) {
+	req, out := c.ListApplicationRevisionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListApplicationRevisions *aws.Operation
 
 // ListApplicationsRequest generates a request for the ListApplications operation.
@@ -574,6 +751,17 @@ func (c *CodeDeploy) ListApplications(input *ListApplicationsInput) (output *Lis
 	return
 }
 
+// ListApplicationsWithContext is the same as ListApplications with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) ListApplicationsWithContext(ctx context.Context, input *ListApplicationsInput) (output *ListApplicationsOutput, err error) {
+	req, out := c.ListApplicationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListApplications *aws.Operation
 
 // ListDeploymentConfigsRequest generates a request for the ListDeploymentConfigs operation.
@@ -607,6 +795,17 @@ func (c *CodeDeploy) ListDeploymentConfigs(input *ListDeploymentConfigsInput) (o
 	return
 }
 
+// ListDeploymentConfigsWithContext is the same as ListDeploymentConfigs with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) ListDeploymentConfigsWithContext(ctx context.Context, input *ListDeploymentConfigsInput) (output *ListDeploymentConfigsOutput, err error) {
+	req, out := c.ListDeploymentConfigsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListDeploymentConfigs *aws.Operation
 
 // ListDeploymentGroupsRequest generates a request for the ListDeploymentGroups operation.
@@ -641,6 +840,17 @@ func (c *CodeDeploy) ListDeploymentGroups(input *ListDeploymentGroupsInput) (out
 	return
 }
 
+// ListDeploymentGroupsWithContext is the same as ListDeploymentGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) ListDeploymentGroupsWithContext(ctx context.Context, input *ListDeploymentGroupsInput) (output *ListDeploymentGroupsOutput, err error) {
+	req, out := c.ListDeploymentGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListDeploymentGroups *aws.Operation
 
 // ListDeploymentInstancesRequest generates a request for the ListDeploymentInstances operation.
@@ -674,6 +884,17 @@ func (c *CodeDeploy) ListDeploymentInstances(input *ListDeploymentInstancesInput
 	return
 }
 
+// ListDeploymentInstancesWithContext is the same as ListDeploymentInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) ListDeploymentInstancesWithContext(ctx context.Context, input *ListDeploymentInstancesInput) (output *ListDeploymentInstancesOutput, err error) {
+	req, out := c.ListDeploymentInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListDeploymentInstances *aws.Operation
 
 // ListDeploymentsRequest generates a request for the ListDeployments operation.
@@ -708,6 +929,17 @@ func (c *CodeDeploy) ListDeployments(input *ListDeploymentsInput) (output *ListD
 	return
 }
 
+// ListDeploymentsWithContext is the same as ListDeployments with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) ListDeploymentsWithContext(ctx context.Context, input *ListDeploymentsInput) (output *ListDeploymentsOutput, err error) {
+	req, out := c.ListDeploymentsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListDeployments *aws.Operation
 
 // RegisterApplicationRevisionRequest generates a request for the RegisterApplicationRevision operation.
@@ -741,6 +973,17 @@ func (c *CodeDeploy) RegisterApplicationRevision(input *RegisterApplicationRevis
 	return
 }
 
+// RegisterApplicationRevisionWithContext is the same as RegisterApplicationRevision with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) RegisterApplicationRevisionWithContext(ctx context.Context, input *RegisterApplicationRevisionInput) (output *RegisterApplicationRevisionOutput, err error) {
+	req, out := c.RegisterApplicationRevisionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRegisterApplicationRevision *aws.Operation
 
 // StopDeploymentRequest generates a request for the StopDeployment operation.
@@ -774,6 +1017,17 @@ func (c *CodeDeploy) StopDeployment(input *StopDeploymentInput) (output *StopDep
 	return
 }
 
+// StopDeploymentWithContext is the same as StopDeployment with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) StopDeploymentWithContext(ctx context.Context, input *StopDeploymentInput) (output *StopDeploymentOutput, err error) {
+	req, out := c.StopDeploymentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opStopDeployment *aws.Operation
 
 // UpdateApplicationRequest generates a request for the UpdateApplication operation.
@@ -807,6 +1061,17 @@ func (c *CodeDeploy) UpdateApplication(input *UpdateApplicationInput) (output *U
 	return
 }
 
+// UpdateApplicationWithContext is the same as UpdateApplication with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) UpdateApplicationWithContext(ctx context.Context, input *UpdateApplicationInput) (output *UpdateApplicationOutput, err error) {
+	req, out := c.UpdateApplicationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateApplication *aws.Operation
 
 // UpdateDeploymentGroupRequest generates a request for the UpdateDeploymentGroup operation.
@@ -840,6 +1105,17 @@ func (c *CodeDeploy) UpdateDeploymentGroup(input *UpdateDeploymentGroupInput) (o
 	return
 }
 
+// UpdateDeploymentGroupWithContext is the same as UpdateDeploymentGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CodeDeploy) UpdateDeploymentGroupWithContext(ctx context.Context, input *UpdateDeploymentGroupInput) (output *UpdateDeploymentGroupOutput, err error) {
+	req, out := c.UpdateDeploymentGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateDeploymentGroup *aws.Operation
 
 // Information about an application.
@@ -848,7 +1124,7 @@ type ApplicationInfo struct {
 	ApplicationID *string `locationName:"applicationId" type:"string"`
 
 	// The application name.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// The time that the application was created.
 	CreateTime *time.Time `locationName:"createTime" type:"timestamp" timestampFormat:"unix"`
@@ -932,7 +1208,7 @@ type metadataBatchGetDeploymentsOutput struct {
 type CreateApplicationInput struct {
 	// The name of the application. This name must be unique within the AWS user
 	// account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateApplicationInput `json:"-", xml:"-"`
 }
@@ -956,7 +1232,7 @@ type metadataCreateApplicationOutput struct {
 // Represents the input of a create deployment configuration operation.
 type CreateDeploymentConfigInput struct {
 	// The name of the deployment configuration to create.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" required:"true"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The minimum number of healthy instances that should be available at any time
 	// during the deployment. There are two parameters expected in the input: type
@@ -998,7 +1274,7 @@ type metadataCreateDeploymentConfigOutput struct {
 // Represents the input of a create deployment group operation.
 type CreateDeploymentGroupInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// A list of associated Auto Scaling groups.
 	AutoScalingGroups []*string `locationName:"autoScalingGroups" type:"list"`
@@ -1032,10 +1308,10 @@ type CreateDeploymentGroupInput struct {
 	// specified for either the deployment or the deployment group.  To create a
 	// custom deployment configuration, call the create deployment configuration
 	// operation.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// The name of an existing deployment group for the specified application.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" required:"true"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The Amazon EC2 tags to filter on.
 	EC2TagFilters []*EC2TagFilter `locationName:"ec2TagFilters" type:"list"`
@@ -1066,17 +1342,17 @@ type metadataCreateDeploymentGroupOutput struct {
 // Represents the input of a create deployment operation.
 type CreateDeploymentInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of an existing deployment configuration within the AWS user account.
 	//
 	// If not specified, the value configured in the deployment group will be used
 	// as the default. If the deployment group does not have a deployment configuration
 	// associated with it, then CodeDeployDefault.OneAtATime will be used by default.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// The deployment group's name.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100"`
 
 	// A comment about the deployment.
 	Description *string `locationName:"description" type:"string"`
@@ -1118,7 +1394,7 @@ type metadataCreateDeploymentOutput struct {
 // Represents the input of a delete application operation.
 type DeleteApplicationInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteApplicationInput `json:"-", xml:"-"`
 }
@@ -1138,7 +1414,7 @@ type metadataDeleteApplicationOutput struct {
 // Represents the input of a delete deployment configuration operation.
 type DeleteDeploymentConfigInput struct {
 	// The name of an existing deployment configuration within the AWS user account.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" required:"true"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteDeploymentConfigInput `json:"-", xml:"-"`
 }
@@ -1158,10 +1434,10 @@ type metadataDeleteDeploymentConfigOutput struct {
 // Represents the input of a delete deployment group operation.
 type DeleteDeploymentGroupInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of an existing deployment group for the specified application.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" required:"true"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteDeploymentGroupInput `json:"-", xml:"-"`
 }
@@ -1196,7 +1472,7 @@ type DeploymentConfigInfo struct {
 	DeploymentConfigID *string `locationName:"deploymentConfigId" type:"string"`
 
 	// The deployment configuration name.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// Information about the number or percentage of minimum healthy instances.
 	MinimumHealthyHosts *MinimumHealthyHosts `locationName:"minimumHealthyHosts" type:"structure"`
@@ -1211,19 +1487,19 @@ type metadataDeploymentConfigInfo struct {
 // Information about a deployment group.
 type DeploymentGroupInfo struct {
 	// The application name.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// A list of associated Auto Scaling groups.
 	AutoScalingGroups []*AutoScalingGroup `locationName:"autoScalingGroups" type:"list"`
 
 	// The deployment configuration name.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// The deployment group ID.
 	DeploymentGroupID *string `locationName:"deploymentGroupId" type:"string"`
 
 	// The deployment group name.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100"`
 
 	// The Amazon EC2 tags to filter on.
 	EC2TagFilters []*EC2TagFilter `locationName:"ec2TagFilters" type:"list"`
@@ -1245,7 +1521,7 @@ type metadataDeploymentGroupInfo struct {
 // Information about a deployment.
 type DeploymentInfo struct {
 	// The application name.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// A timestamp indicating when the deployment was completed.
 	CompleteTime *time.Time `locationName:"completeTime" type:"timestamp" timestampFormat:"unix"`
@@ -1257,13 +1533,13 @@ type DeploymentInfo struct {
 	//
 	//  user: A user created the deployment. autoscaling: Auto Scaling created
 	// the deployment.
-	Creator *string `locationName:"creator" type:"string"`
+	Creator *string `locationName:"creator" type:"string" enum:"user,autoscaling"`
 
 	// The deployment configuration name.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// The deployment group name.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100"`
 
 	// The deployment ID.
 	DeploymentID *string `locationName:"deploymentId" type:"string"`
@@ -1301,7 +1577,7 @@ type DeploymentInfo struct {
 	StartTime *time.Time `locationName:"startTime" type:"timestamp" timestampFormat:"unix"`
 
 	// The current state of the deployment as a whole.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"Created,Queued,InProgress,Succeeded,Failed,Stopped"`
 
 	metadataDeploymentInfo `json:"-", xml:"-"`
 }
@@ -1344,7 +1620,7 @@ type Diagnostics struct {
 	// script did not finish running in the specified time period. ScriptFailed:
 	// The specified script failed to run as expected. UnknownError: The specified
 	// script did not run for an unknown reason.
-	ErrorCode *string `locationName:"errorCode" type:"string"`
+	ErrorCode *string `locationName:"errorCode" type:"string" enum:"Success,ScriptMissing,ScriptNotExecutable,ScriptTimedOut,ScriptFailed,UnknownError"`
 
 	// The last portion of the associated diagnostic log.
 	LogTail *string `locationName:"logTail" type:"string"`
@@ -1370,7 +1646,7 @@ type EC2TagFilter struct {
 	// The Amazon EC2 tag filter type:
 	//
 	//  KEY_ONLY: Key only. VALUE_ONLY: Value only. KEY_AND_VALUE: Key and value.
-	Type *string `type:"string"`
+	Type *string `type:"string" enum:"KEY_ONLY,VALUE_ONLY,KEY_AND_VALUE"`
 
 	// The Amazon EC2 tag filter value.
 	Value *string `type:"string"`
@@ -1402,7 +1678,7 @@ type ErrorInformation struct {
 	// as specified. HEALTH_CONSTRAINTS: The deployment failed on too many instances
 	// to be able to successfully deploy under the specified instance health constraints.
 	// INTERNAL_ERROR: There was an internal error.
-	Code *string `locationName:"code" type:"string"`
+	Code *string `locationName:"code" type:"string" enum:"DEPLOYMENT_GROUP_MISSING,APPLICATION_MISSING,REVISION_MISSING,IAM_ROLE_MISSING,IAM_ROLE_PERMISSIONS,OVER_MAX_INSTANCES,NO_INSTANCES,TIMEOUT,HEALTH_CONSTRAINTS_INVALID,HEALTH_CONSTRAINTS,INTERNAL_ERROR"`
 
 	// An accompanying error message.
 	Message *string `locationName:"message" type:"string"`
@@ -1441,7 +1717,7 @@ type metadataGenericRevisionInfo struct {
 // Represents the input of a get application operation.
 type GetApplicationInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataGetApplicationInput `json:"-", xml:"-"`
 }
@@ -1465,7 +1741,7 @@ type metadataGetApplicationOutput struct {
 // Represents the input of a get application revision operation.
 type GetApplicationRevisionInput struct {
 	// The name of the application that corresponds to the revision.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// Information about the application revision to get, including the revision's
 	// type and its location.
@@ -1481,7 +1757,7 @@ type metadataGetApplicationRevisionInput struct {
 // Represents the output of a get application revision operation.
 type GetApplicationRevisionOutput struct {
 	// The name of the application that corresponds to the revision.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// Additional information about the revision, including the revision's type
 	// and its location.
@@ -1500,7 +1776,7 @@ type metadataGetApplicationRevisionOutput struct {
 // Represents the input of a get deployment configuration operation.
 type GetDeploymentConfigInput struct {
 	// The name of an existing deployment configuration within the AWS user account.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" required:"true"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataGetDeploymentConfigInput `json:"-", xml:"-"`
 }
@@ -1524,10 +1800,10 @@ type metadataGetDeploymentConfigOutput struct {
 // Represents the input of a get deployment group operation.
 type GetDeploymentGroupInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of an existing deployment group for the specified application.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" required:"true"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataGetDeploymentGroupInput `json:"-", xml:"-"`
 }
@@ -1640,7 +1916,7 @@ type InstanceSummary struct {
 	// succeeded for this instance. Failed: The deployment has failed for this instance.
 	// Skipped: The deployment has been skipped for this instance. Unknown: The
 	// deployment status is unknown for this instance.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"Pending,InProgress,Succeeded,Failed,Skipped,Unknown"`
 
 	metadataInstanceSummary `json:"-", xml:"-"`
 }
@@ -1671,7 +1947,7 @@ type LifecycleEvent struct {
 	// has succeeded. Failed: The deployment lifecycle event has failed. Skipped:
 	// The deployment lifecycle event has been skipped. Unknown: The deployment
 	// lifecycle event is unknown.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"Pending,InProgress,Succeeded,Failed,Skipped,Unknown"`
 
 	metadataLifecycleEvent `json:"-", xml:"-"`
 }
@@ -1683,7 +1959,7 @@ type metadataLifecycleEvent struct {
 // Represents the input of a list application revisions operation.
 type ListApplicationRevisionsInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// Whether to list revisions based on whether the revision is the target revision
 	// of an deployment group:
@@ -1692,7 +1968,7 @@ type ListApplicationRevisionsInput struct {
 	// exclude: Do not list revisions that are target revisions of a deployment
 	// group. ignore: List all revisions, regardless of whether they are target
 	// revisions of a deployment group.
-	Deployed *string `locationName:"deployed" type:"string"`
+	Deployed *string `locationName:"deployed" type:"string" enum:"include,exclude,ignore"`
 
 	// An identifier that was returned from the previous list application revisions
 	// call, which can be used to return the next set of applications in the list.
@@ -1714,7 +1990,7 @@ type ListApplicationRevisionsInput struct {
 	// were first used by in a deployment. lastUsedTime: Sort the list results by
 	// when the revisions were last used in a deployment.  If not specified or set
 	// to null, the results will be returned in an arbitrary order.
-	SortBy *string `locationName:"sortBy" type:"string"`
+	SortBy *string `locationName:"sortBy" type:"string" enum:"registerTime,firstUsedTime,lastUsedTime"`
 
 	// The order to sort the list results by:
 	//
@@ -1723,7 +1999,7 @@ type ListApplicationRevisionsInput struct {
 	// sorted in ascending order.
 	//
 	// If set to null, the results will be sorted in an arbitrary order.
-	SortOrder *string `locationName:"sortOrder" type:"string"`
+	SortOrder *string `locationName:"sortOrder" type:"string" enum:"ascending,descending"`
 
 	metadataListApplicationRevisionsInput `json:"-", xml:"-"`
 }
@@ -1816,7 +2092,7 @@ type metadataListDeploymentConfigsOutput struct {
 // Represents the input of a list deployment groups operation.
 type ListDeploymentGroupsInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// An identifier that was returned from the previous list deployment groups
 	// call, which can be used to return the next set of deployment groups in the
@@ -1833,7 +2109,7 @@ type metadataListDeploymentGroupsInput struct {
 // Represents the output of a list deployment groups operation.
 type ListDeploymentGroupsOutput struct {
 	// The application name.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// A list of corresponding deployment group names.
 	DeploymentGroups []*string `locationName:"deploymentGroups" type:"list"`
@@ -1900,14 +2176,14 @@ type metadataListDeploymentInstancesOutput struct {
 // Represents the input of a list deployments operation.
 type ListDeploymentsInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// A deployment creation start- and end-time range for returning a subset of
 	// the list of deployments.
 	CreateTimeRange *TimeRange `locationName:"createTimeRange" type:"structure"`
 
 	// The name of an existing deployment group for the specified application.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100"`
 
 	// A subset of deployments to list, by status:  Created: Include in the resulting
 	// list created deployments. Queued: Include in the resulting list queued deployments.
@@ -1963,7 +2239,7 @@ type MinimumHealthyHosts struct {
 	// will return a minimum healthy instances type of MOST_CONCURRENCY and a value
 	// of 1. This means a deployment to only one Amazon EC2 instance at a time.
 	// (You cannot set the type to MOST_CONCURRENCY, only to HOST_COUNT or FLEET_PERCENT.)
-	Type *string `locationName:"type" type:"string"`
+	Type *string `locationName:"type" type:"string" enum:"HOST_COUNT,FLEET_PERCENT"`
 
 	// The minimum healthy instances value.
 	Value *int64 `locationName:"value" type:"integer"`
@@ -1978,7 +2254,7 @@ type metadataMinimumHealthyHosts struct {
 // Represents the input of a register application revision operation.
 type RegisterApplicationRevisionInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// A comment about the revision.
 	Description *string `locationName:"description" type:"string"`
@@ -2012,7 +2288,7 @@ type RevisionLocation struct {
 	//
 	//  S3: An application revision stored in Amazon S3. GitHub: An application
 	// revision stored in GitHub.
-	RevisionType *string `locationName:"revisionType" type:"string"`
+	RevisionType *string `locationName:"revisionType" type:"string" enum:"S3,GitHub"`
 
 	// Information about the location of application artifacts that are stored in
 	// Amazon S3.
@@ -2035,7 +2311,7 @@ type S3Location struct {
 	//
 	//  tar: A tar archive file. tgz: A compressed tar archive file. zip: A zip
 	// archive file.
-	BundleType *string `locationName:"bundleType" type:"string"`
+	BundleType *string `locationName:"bundleType" type:"string" enum:"tar,tgz,zip"`
 
 	// The ETag of the Amazon S3 object that represents the bundled artifacts for
 	// the application revision.
@@ -2079,7 +2355,7 @@ type StopDeploymentOutput struct {
 	// The status of the stop deployment operation:
 	//
 	//  Pending: The stop operation is pending. Succeeded: The stop operation succeeded.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"Pending,Succeeded"`
 
 	// An accompanying status message.
 	StatusMessage *string `locationName:"statusMessage" type:"string"`
@@ -2113,10 +2389,10 @@ type metadataTimeRange struct {
 // Represents the input of an update application operation.
 type UpdateApplicationInput struct {
 	// The current name of the application that you want to change.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// The new name that you want to change the application to.
-	NewApplicationName *string `locationName:"newApplicationName" type:"string"`
+	NewApplicationName *string `locationName:"newApplicationName" type:"string" min:"1" max:"100"`
 
 	metadataUpdateApplicationInput `json:"-", xml:"-"`
 }
@@ -2136,25 +2412,25 @@ type metadataUpdateApplicationOutput struct {
 // Represents the input of an update deployment group operation.
 type UpdateDeploymentGroupInput struct {
 	// The application name corresponding to the deployment group to update.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The replacement list of Auto Scaling groups to be included in the deployment
 	// group, if you want to change them.
 	AutoScalingGroups []*string `locationName:"autoScalingGroups" type:"list"`
 
 	// The current name of the existing deployment group.
-	CurrentDeploymentGroupName *string `locationName:"currentDeploymentGroupName" type:"string" required:"true"`
+	CurrentDeploymentGroupName *string `locationName:"currentDeploymentGroupName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The replacement deployment configuration name to use, if you want to change
 	// it.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// The replacement set of Amazon EC2 tags to filter on, if you want to change
 	// them.
 	EC2TagFilters []*EC2TagFilter `locationName:"ec2TagFilters" type:"list"`
 
 	// The new name of the deployment group, if you want to change it.
-	NewDeploymentGroupName *string `locationName:"newDeploymentGroupName" type:"string"`
+	NewDeploymentGroupName *string `locationName:"newDeploymentGroupName" type:"string" min:"1" max:"100"`
 
 	// A replacement service role's ARN, if you want to change it.
 	ServiceRoleARN *string `locationName:"serviceRoleArn" type:"string"`
@@ -2180,4 +2456,258 @@ type UpdateDeploymentGroupOutput struct {
 
 type metadataUpdateDeploymentGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for ApplicationRevisionSortBy.
+const (
+	ApplicationRevisionSortByRegisterTime  = "registerTime"
+	ApplicationRevisionSortByFirstUsedTime = "firstUsedTime"
+	ApplicationRevisionSortByLastUsedTime  = "lastUsedTime"
+)
+
+// ApplicationRevisionSortByValues returns the valid values of ApplicationRevisionSortBy.
+func ApplicationRevisionSortByValues() []string {
+	return []string{
+		ApplicationRevisionSortByRegisterTime,
+		ApplicationRevisionSortByFirstUsedTime,
+		ApplicationRevisionSortByLastUsedTime,
+	}
+}
+
+// Enum values for BundleType.
+const (
+	BundleTypeTar = "tar"
+	BundleTypeTgz = "tgz"
+	BundleTypeZip = "zip"
+)
+
+// BundleTypeValues returns the valid values of BundleType.
+func BundleTypeValues() []string {
+	return []string{
+		BundleTypeTar,
+		BundleTypeTgz,
+		BundleTypeZip,
+	}
+}
+
+// Enum values for DeploymentCreator.
+const (
+	DeploymentCreatorUser        = "user"
+	DeploymentCreatorAutoscaling = "autoscaling"
+)
+
+// DeploymentCreatorValues returns the valid values of DeploymentCreator.
+func DeploymentCreatorValues() []string {
+	return []string{
+		DeploymentCreatorUser,
+		DeploymentCreatorAutoscaling,
+	}
+}
+
+// Enum values for DeploymentStatus.
+const (
+	DeploymentStatusCreated    = "Created"
+	DeploymentStatusQueued     = "Queued"
+	DeploymentStatusInProgress = "InProgress"
+	DeploymentStatusSucceeded  = "Succeeded"
+	DeploymentStatusFailed     = "Failed"
+	DeploymentStatusStopped    = "Stopped"
+)
+
+// DeploymentStatusValues returns the valid values of DeploymentStatus.
+func DeploymentStatusValues() []string {
+	return []string{
+		DeploymentStatusCreated,
+		DeploymentStatusQueued,
+		DeploymentStatusInProgress,
+		DeploymentStatusSucceeded,
+		DeploymentStatusFailed,
+		DeploymentStatusStopped,
+	}
+}
+
+// Enum values for EC2TagFilterType.
+const (
+	EC2TagFilterTypeKeyOnly     = "KEY_ONLY"
+	EC2TagFilterTypeValueOnly   = "VALUE_ONLY"
+	EC2TagFilterTypeKeyAndValue = "KEY_AND_VALUE"
+)
+
+// EC2TagFilterTypeValues returns the valid values of EC2TagFilterType.
+func EC2TagFilterTypeValues() []string {
+	return []string{
+		EC2TagFilterTypeKeyOnly,
+		EC2TagFilterTypeValueOnly,
+		EC2TagFilterTypeKeyAndValue,
+	}
+}
+
+// Enum values for ErrorCode.
+const (
+	ErrorCodeDeploymentGroupMissing   = "DEPLOYMENT_GROUP_MISSING"
+	ErrorCodeApplicationMissing       = "APPLICATION_MISSING"
+	ErrorCodeRevisionMissing          = "REVISION_MISSING"
+	ErrorCodeIAMRoleMissing           = "IAM_ROLE_MISSING"
+	ErrorCodeIAMRolePermissions       = "IAM_ROLE_PERMISSIONS"
+	ErrorCodeOverMaxInstances         = "OVER_MAX_INSTANCES"
+	ErrorCodeNOInstances              = "NO_INSTANCES"
+	ErrorCodeTimeout                  = "TIMEOUT"
+	ErrorCodeHealthConstraintsInvalid = "HEALTH_CONSTRAINTS_INVALID"
+	ErrorCodeHealthConstraints        = "HEALTH_CONSTRAINTS"
+	ErrorCodeInternalError            = "INTERNAL_ERROR"
+)
+
+// ErrorCodeValues returns the valid values of ErrorCode.
+func ErrorCodeValues() []string {
+	return []string{
+		ErrorCodeDeploymentGroupMissing,
+		ErrorCodeApplicationMissing,
+		ErrorCodeRevisionMissing,
+		ErrorCodeIAMRoleMissing,
+		ErrorCodeIAMRolePermissions,
+		ErrorCodeOverMaxInstances,
+		ErrorCodeNOInstances,
+		ErrorCodeTimeout,
+		ErrorCodeHealthConstraintsInvalid,
+		ErrorCodeHealthConstraints,
+		ErrorCodeInternalError,
+	}
+}
+
+// Enum values for InstanceStatus.
+const (
+	InstanceStatusPending    = "Pending"
+	InstanceStatusInProgress = "InProgress"
+	InstanceStatusSucceeded  = "Succeeded"
+	InstanceStatusFailed     = "Failed"
+	InstanceStatusSkipped    = "Skipped"
+	InstanceStatusUnknown    = "Unknown"
+)
+
+// InstanceStatusValues returns the valid values of InstanceStatus.
+func InstanceStatusValues() []string {
+	return []string{
+		InstanceStatusPending,
+		InstanceStatusInProgress,
+		InstanceStatusSucceeded,
+		InstanceStatusFailed,
+		InstanceStatusSkipped,
+		InstanceStatusUnknown,
+	}
+}
+
+// Enum values for LifecycleErrorCode.
+const (
+	LifecycleErrorCodeSuccess             = "Success"
+	LifecycleErrorCodeScriptMissing       = "ScriptMissing"
+	LifecycleErrorCodeScriptNotExecutable = "ScriptNotExecutable"
+	LifecycleErrorCodeScriptTimedOut      = "ScriptTimedOut"
+	LifecycleErrorCodeScriptFailed        = "ScriptFailed"
+	LifecycleErrorCodeUnknownError        = "UnknownError"
+)
+
+// LifecycleErrorCodeValues returns the valid values of LifecycleErrorCode.
+func LifecycleErrorCodeValues() []string {
+	return []string{
+		LifecycleErrorCodeSuccess,
+		LifecycleErrorCodeScriptMissing,
+		LifecycleErrorCodeScriptNotExecutable,
+		LifecycleErrorCodeScriptTimedOut,
+		LifecycleErrorCodeScriptFailed,
+		LifecycleErrorCodeUnknownError,
+	}
+}
+
+// Enum values for LifecycleEventStatus.
+const (
+	LifecycleEventStatusPending    = "Pending"
+	LifecycleEventStatusInProgress = "InProgress"
+	LifecycleEventStatusSucceeded  = "Succeeded"
+	LifecycleEventStatusFailed     = "Failed"
+	LifecycleEventStatusSkipped    = "Skipped"
+	LifecycleEventStatusUnknown    = "Unknown"
+)
+
+// LifecycleEventStatusValues returns the valid values of LifecycleEventStatus.
+func LifecycleEventStatusValues() []string {
+	return []string{
+		LifecycleEventStatusPending,
+		LifecycleEventStatusInProgress,
+		LifecycleEventStatusSucceeded,
+		LifecycleEventStatusFailed,
+		LifecycleEventStatusSkipped,
+		LifecycleEventStatusUnknown,
+	}
+}
+
+// Enum values for ListStateFilterAction.
+const (
+	ListStateFilterActionInclude = "include"
+	ListStateFilterActionExclude = "exclude"
+	ListStateFilterActionIgnore  = "ignore"
+)
+
+// ListStateFilterActionValues returns the valid values of ListStateFilterAction.
+func ListStateFilterActionValues() []string {
+	return []string{
+		ListStateFilterActionInclude,
+		ListStateFilterActionExclude,
+		ListStateFilterActionIgnore,
+	}
+}
+
+// Enum values for MinimumHealthyHostsType.
+const (
+	MinimumHealthyHostsTypeHostCount    = "HOST_COUNT"
+	MinimumHealthyHostsTypeFleetPercent = "FLEET_PERCENT"
+)
+
+// MinimumHealthyHostsTypeValues returns the valid values of MinimumHealthyHostsType.
+func MinimumHealthyHostsTypeValues() []string {
+	return []string{
+		MinimumHealthyHostsTypeHostCount,
+		MinimumHealthyHostsTypeFleetPercent,
+	}
+}
+
+// Enum values for RevisionLocationType.
+const (
+	RevisionLocationTypeS3     = "S3"
+	RevisionLocationTypeGitHub = "GitHub"
+)
+
+// RevisionLocationTypeValues returns the valid values of RevisionLocationType.
+func RevisionLocationTypeValues() []string {
+	return []string{
+		RevisionLocationTypeS3,
+		RevisionLocationTypeGitHub,
+	}
+}
+
+// Enum values for SortOrder.
+const (
+	SortOrderAscending  = "ascending"
+	SortOrderDescending = "descending"
+)
+
+// SortOrderValues returns the valid values of SortOrder.
+func SortOrderValues() []string {
+	return []string{
+		SortOrderAscending,
+		SortOrderDescending,
+	}
+}
+
+// Enum values for StopStatus.
+const (
+	StopStatusPending   = "Pending"
+	StopStatusSucceeded = "Succeeded"
+)
+
+// StopStatusValues returns the valid values of StopStatus.
+func StopStatusValues() []string {
+	return []string{
+		StopStatusPending,
+		StopStatusSucceeded,
+	}
 }
\ No newline at end of file
@@ -0,0 +1,330 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package codedeploy
+
+import (
+	"github.com/datacratic/aws-sdk-go/aws"
+)
+
+// Error codes of the CodeDeploy errors. Errors with these codes are
+// returned as the typed error of the same name, e.g. an error with the code
+// ErrCodeFoo is a *Foo.
+const (
+	ErrCodeApplicationAlreadyExistsException       = "ApplicationAlreadyExistsException"
+	ErrCodeApplicationDoesNotExistException        = "ApplicationDoesNotExistException"
+	ErrCodeApplicationLimitExceededException       = "ApplicationLimitExceededException"
+	ErrCodeApplicationNameRequiredException        = "ApplicationNameRequiredException"
+	ErrCodeBucketNameFilterRequiredException       = "BucketNameFilterRequiredException"
+	ErrCodeDeploymentAlreadyCompletedException     = "DeploymentAlreadyCompletedException"
+	ErrCodeDeploymentConfigAlreadyExistsException  = "DeploymentConfigAlreadyExistsException"
+	ErrCodeDeploymentConfigDoesNotExistException   = "DeploymentConfigDoesNotExistException"
+	ErrCodeDeploymentConfigInUseException          = "DeploymentConfigInUseException"
+	ErrCodeDeploymentConfigLimitExceededException  = "DeploymentConfigLimitExceededException"
+	ErrCodeDeploymentConfigNameRequiredException   = "DeploymentConfigNameRequiredException"
+	ErrCodeDeploymentDoesNotExistException         = "DeploymentDoesNotExistException"
+	ErrCodeDeploymentGroupAlreadyExistsException   = "DeploymentGroupAlreadyExistsException"
+	ErrCodeDeploymentGroupDoesNotExistException    = "DeploymentGroupDoesNotExistException"
+	ErrCodeDeploymentGroupLimitExceededException   = "DeploymentGroupLimitExceededException"
+	ErrCodeDeploymentGroupNameRequiredException    = "DeploymentGroupNameRequiredException"
+	ErrCodeDeploymentIDRequiredException           = "DeploymentIDRequiredException"
+	ErrCodeDeploymentLimitExceededException        = "DeploymentLimitExceededException"
+	ErrCodeDeploymentNotStartedException           = "DeploymentNotStartedException"
+	ErrCodeDescriptionTooLongException             = "DescriptionTooLongException"
+	ErrCodeInstanceDoesNotExistException           = "InstanceDoesNotExistException"
+	ErrCodeInstanceIDRequiredException             = "InstanceIDRequiredException"
+	ErrCodeInvalidApplicationNameException         = "InvalidApplicationNameException"
+	ErrCodeInvalidAutoScalingGroupException        = "InvalidAutoScalingGroupException"
+	ErrCodeInvalidBucketNameFilterException        = "InvalidBucketNameFilterException"
+	ErrCodeInvalidDeployedStateFilterException     = "InvalidDeployedStateFilterException"
+	ErrCodeInvalidDeploymentConfigNameException    = "InvalidDeploymentConfigNameException"
+	ErrCodeInvalidDeploymentGroupNameException     = "InvalidDeploymentGroupNameException"
+	ErrCodeInvalidDeploymentIDException            = "InvalidDeploymentIDException"
+	ErrCodeInvalidDeploymentStatusException        = "InvalidDeploymentStatusException"
+	ErrCodeInvalidEC2TagException                  = "InvalidEC2TagException"
+	ErrCodeInvalidInstanceStatusException          = "InvalidInstanceStatusException"
+	ErrCodeInvalidKeyPrefixFilterException         = "InvalidKeyPrefixFilterException"
+	ErrCodeInvalidMinimumHealthyHostValueException = "InvalidMinimumHealthyHostValueException"
+	ErrCodeInvalidNextTokenException               = "InvalidNextTokenException"
+	ErrCodeInvalidOperationException               = "InvalidOperationException"
+	ErrCodeInvalidRevisionException                = "InvalidRevisionException"
+	ErrCodeInvalidRoleException                    = "InvalidRoleException"
+	ErrCodeInvalidSortByException                  = "InvalidSortByException"
+	ErrCodeInvalidSortOrderException               = "InvalidSortOrderException"
+	ErrCodeInvalidTimeRangeException               = "InvalidTimeRangeException"
+	ErrCodeRevisionDoesNotExistException           = "RevisionDoesNotExistException"
+	ErrCodeRevisionRequiredException               = "RevisionRequiredException"
+	ErrCodeRoleRequiredException                   = "RoleRequiredException"
+)
+
+// An application with the specified name already exists within the AWS user
+// account.
+type ApplicationAlreadyExistsException struct {
+	aws.APIError
+}
+
+// The application does not exist within the AWS user account.
+type ApplicationDoesNotExistException struct {
+	aws.APIError
+}
+
+// More applications were attempted to be created than were allowed.
+type ApplicationLimitExceededException struct {
+	aws.APIError
+}
+
+// The minimum number of required application names was not specified.
+type ApplicationNameRequiredException struct {
+	aws.APIError
+}
+
+// A bucket name is required but was not provided.
+type BucketNameFilterRequiredException struct {
+	aws.APIError
+}
+
+// The deployment is already completed.
+type DeploymentAlreadyCompletedException struct {
+	aws.APIError
+}
+
+// A deployment configuration with the specified name already exists within
+// the AWS user account.
+type DeploymentConfigAlreadyExistsException struct {
+	aws.APIError
+}
+
+// The deployment configuration does not exist within the AWS user account.
+type DeploymentConfigDoesNotExistException struct {
+	aws.APIError
+}
+
+// The deployment configuration is still in use.
+type DeploymentConfigInUseException struct {
+	aws.APIError
+}
+
+// The deployment configurations limit was exceeded.
+type DeploymentConfigLimitExceededException struct {
+	aws.APIError
+}
+
+// The deployment configuration name was not specified.
+type DeploymentConfigNameRequiredException struct {
+	aws.APIError
+}
+
+// The deployment does not exist within the AWS user account.
+type DeploymentDoesNotExistException struct {
+	aws.APIError
+}
+
+// A deployment group group with the specified name already exists within the
+// AWS user account.
+type DeploymentGroupAlreadyExistsException struct {
+	aws.APIError
+}
+
+// The named deployment group does not exist within the AWS user account.
+type DeploymentGroupDoesNotExistException struct {
+	aws.APIError
+}
+
+// The deployment groups limit was exceeded.
+type DeploymentGroupLimitExceededException struct {
+	aws.APIError
+}
+
+// The deployment group name was not specified.
+type DeploymentGroupNameRequiredException struct {
+	aws.APIError
+}
+
+// At least one deployment ID must be specified.
+type DeploymentIDRequiredException struct {
+	aws.APIError
+}
+
+// The number of allowed deployments was exceeded.
+type DeploymentLimitExceededException struct {
+	aws.APIError
+}
+
+// The specified deployment has not started.
+type DeploymentNotStartedException struct {
+	aws.APIError
+}
+
+// The description that was provided is too long.
+type DescriptionTooLongException struct {
+	aws.APIError
+}
+
+// The specified instance does not exist in the deployment group.
+type InstanceDoesNotExistException struct {
+	aws.APIError
+}
+
+// The instance ID was not specified.
+type InstanceIDRequiredException struct {
+	aws.APIError
+}
+
+// The application name was specified in an invalid format.
+type InvalidApplicationNameException struct {
+	aws.APIError
+}
+
+// The Auto Scaling group was specified in an invalid format or does not exist.
+type InvalidAutoScalingGroupException struct {
+	aws.APIError
+}
+
+// The bucket name either doesn't exist or was specified in an invalid format.
+type InvalidBucketNameFilterException struct {
+	aws.APIError
+}
+
+// The deployed state filter was specified in an invalid format.
+type InvalidDeployedStateFilterException struct {
+	aws.APIError
+}
+
+// The deployment configuration name was specified in an invalid format.
+type InvalidDeploymentConfigNameException struct {
+	aws.APIError
+}
+
+// The deployment group name was specified in an invalid format.
+type InvalidDeploymentGroupNameException struct {
+	aws.APIError
+}
+
+// At least one of the deployment IDs was specified in an invalid format.
+type InvalidDeploymentIDException struct {
+	aws.APIError
+}
+
+// The specified deployment status doesn't exist or cannot be determined.
+type InvalidDeploymentStatusException struct {
+	aws.APIError
+}
+
+// The Amazon EC2 tag was specified in an invalid format.
+type InvalidEC2TagException struct {
+	aws.APIError
+}
+
+// The specified instance status does not exist.
+type InvalidInstanceStatusException struct {
+	aws.APIError
+}
+
+// The specified key prefix filter was specified in an invalid format.
+type InvalidKeyPrefixFilterException struct {
+	aws.APIError
+}
+
+// The minimum healthy instances value was specified in an invalid format.
+type InvalidMinimumHealthyHostValueException struct {
+	aws.APIError
+}
+
+// The next token was specified in an invalid format.
+type InvalidNextTokenException struct {
+	aws.APIError
+}
+
+// An invalid operation was detected.
+type InvalidOperationException struct {
+	aws.APIError
+}
+
+// The revision was specified in an invalid format.
+type InvalidRevisionException struct {
+	aws.APIError
+}
+
+// The service role ARN was specified in an invalid format. Or, if an Auto Scaling
+// group was specified, the specified service role does not grant the appropriate
+// permissions to Auto Scaling.
+type InvalidRoleException struct {
+	aws.APIError
+}
+
+// The column name to sort by is either not present or was specified in an invalid
+// format.
+type InvalidSortByException struct {
+	aws.APIError
+}
+
+// The sort order was specified in an invalid format.
+type InvalidSortOrderException struct {
+	aws.APIError
+}
+
+// The specified time range was specified in an invalid format.
+type InvalidTimeRangeException struct {
+	aws.APIError
+}
+
+// The named revision does not exist within the AWS user account.
+type RevisionDoesNotExistException struct {
+	aws.APIError
+}
+
+// The revision ID was not specified.
+type RevisionRequiredException struct {
+	aws.APIError
+}
+
+// The role ID was not specified.
+type RoleRequiredException struct {
+	aws.APIError
+}
+
+var errorTypes = map[string]func() aws.ServiceError{
+	ErrCodeApplicationAlreadyExistsException:       func() aws.ServiceError { return &ApplicationAlreadyExistsException{} },
+	ErrCodeApplicationDoesNotExistException:        func() aws.ServiceError { return &ApplicationDoesNotExistException{} },
+	ErrCodeApplicationLimitExceededException:       func() aws.ServiceError { return &ApplicationLimitExceededException{} },
+	ErrCodeApplicationNameRequiredException:        func() aws.ServiceError { return &ApplicationNameRequiredException{} },
+	ErrCodeBucketNameFilterRequiredException:       func() aws.ServiceError { return &BucketNameFilterRequiredException{} },
+	ErrCodeDeploymentAlreadyCompletedException:     func() aws.ServiceError { return &DeploymentAlreadyCompletedException{} },
+	ErrCodeDeploymentConfigAlreadyExistsException:  func() aws.ServiceError { return &DeploymentConfigAlreadyExistsException{} },
+	ErrCodeDeploymentConfigDoesNotExistException:   func() aws.ServiceError { return &DeploymentConfigDoesNotExistException{} },
+	ErrCodeDeploymentConfigInUseException:          func() aws.ServiceError { return &DeploymentConfigInUseException{} },
+	ErrCodeDeploymentConfigLimitExceededException:  func() aws.ServiceError { return &DeploymentConfigLimitExceededException{} },
+	ErrCodeDeploymentConfigNameRequiredException:   func() aws.ServiceError { return &DeploymentConfigNameRequiredException{} },
+	ErrCodeDeploymentDoesNotExistException:         func() aws.ServiceError { return &DeploymentDoesNotExistException{} },
+	ErrCodeDeploymentGroupAlreadyExistsException:   func() aws.ServiceError { return &DeploymentGroupAlreadyExistsException{} },
+	ErrCodeDeploymentGroupDoesNotExistException:    func() aws.ServiceError { return &DeploymentGroupDoesNotExistException{} },
+	ErrCodeDeploymentGroupLimitExceededException:   func() aws.ServiceError { return &DeploymentGroupLimitExceededException{} },
+	ErrCodeDeploymentGroupNameRequiredException:    func() aws.ServiceError { return &DeploymentGroupNameRequiredException{} },
+	ErrCodeDeploymentIDRequiredException:           func() aws.ServiceError { return &DeploymentIDRequiredException{} },
+	ErrCodeDeploymentLimitExceededException:        func() aws.ServiceError { return &DeploymentLimitExceededException{} },
+	ErrCodeDeploymentNotStartedException:           func() aws.ServiceError { return &DeploymentNotStartedException{} },
+	ErrCodeDescriptionTooLongException:             func() aws.ServiceError { return &DescriptionTooLongException{} },
+	ErrCodeInstanceDoesNotExistException:           func() aws.ServiceError { return &InstanceDoesNotExistException{} },
+	ErrCodeInstanceIDRequiredException:             func() aws.ServiceError { return &InstanceIDRequiredException{} },
+	ErrCodeInvalidApplicationNameException:         func() aws.ServiceError { return &InvalidApplicationNameException{} },
+	ErrCodeInvalidAutoScalingGroupException:        func() aws.ServiceError { return &InvalidAutoScalingGroupException{} },
+	ErrCodeInvalidBucketNameFilterException:        func() aws.ServiceError { return &InvalidBucketNameFilterException{} },
+	ErrCodeInvalidDeployedStateFilterException:     func() aws.ServiceError { return &InvalidDeployedStateFilterException{} },
+	ErrCodeInvalidDeploymentConfigNameException:    func() aws.ServiceError { return &InvalidDeploymentConfigNameException{} },
+	ErrCodeInvalidDeploymentGroupNameException:     func() aws.ServiceError { return &InvalidDeploymentGroupNameException{} },
+	ErrCodeInvalidDeploymentIDException:            func() aws.ServiceError { return &InvalidDeploymentIDException{} },
+	ErrCodeInvalidDeploymentStatusException:        func() aws.ServiceError { return &InvalidDeploymentStatusException{} },
+	ErrCodeInvalidEC2TagException:                  func() aws.ServiceError { return &InvalidEC2TagException{} },
+	ErrCodeInvalidInstanceStatusException:          func() aws.ServiceError { return &InvalidInstanceStatusException{} },
+	ErrCodeInvalidKeyPrefixFilterException:         func() aws.ServiceError { return &InvalidKeyPrefixFilterException{} },
+	ErrCodeInvalidMinimumHealthyHostValueException: func() aws.ServiceError { return &InvalidMinimumHealthyHostValueException{} },
+	ErrCodeInvalidNextTokenException:               func() aws.ServiceError { return &InvalidNextTokenException{} },
+	ErrCodeInvalidOperationException:               func() aws.ServiceError { return &InvalidOperationException{} },
+	ErrCodeInvalidRevisionException:                func() aws.ServiceError { return &InvalidRevisionException{} },
+	ErrCodeInvalidRoleException:                    func() aws.ServiceError { return &InvalidRoleException{} },
+	ErrCodeInvalidSortByException:                  func() aws.ServiceError { return &InvalidSortByException{} },
+	ErrCodeInvalidSortOrderException:               func() aws.ServiceError { return &InvalidSortOrderException{} },
+	ErrCodeInvalidTimeRangeException:               func() aws.ServiceError { return &InvalidTimeRangeException{} },
+	ErrCodeRevisionDoesNotExistException:           func() aws.ServiceError { return &RevisionDoesNotExistException{} },
+	ErrCodeRevisionRequiredException:               func() aws.ServiceError { return &RevisionRequiredException{} },
+	ErrCodeRoleRequiredException:                   func() aws.ServiceError { return &RoleRequiredException{} },
+}
@@ -29,6 +29,7 @@ func New(config *aws.Config) *CodeDeploy {
 		APIVersion:   "2014-10-06",
 		JSONVersion:  "1.1",
 		TargetPrefix: "CodeDeploy_20141006",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
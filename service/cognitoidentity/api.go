@@ -4,6 +4,7 @@
 package cognitoidentity
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -45,6 +46,17 @@ func (c *CognitoIdentity) CreateIdentityPool(input *CreateIdentityPoolInput) (ou
 	return
 }
 
+// CreateIdentityPoolWithContext is the same as CreateIdentityPool with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) CreateIdentityPoolWithContext(ctx context.Context, input *CreateIdentityPoolInput) (output *IdentityPool, err error) {
+	req, out := c.CreateIdentityPoolRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateIdentityPool *aws.Operation
 
 // DeleteIdentityPoolRequest generates a request for the DeleteIdentityPool operation.
@@ -79,6 +91,17 @@ func (c *CognitoIdentity) DeleteIdentityPool(input *DeleteIdentityPoolInput) (ou
 	return
 }
 
+// DeleteIdentityPoolWithContext is the same as DeleteIdentityPool with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) DeleteIdentityPoolWithContext(ctx context.Context, input *DeleteIdentityPoolInput) (output *DeleteIdentityPoolOutput, err error) {
+	req, out := c.DeleteIdentityPoolRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteIdentityPool *aws.Operation
 
 // DescribeIdentityRequest generates a request for the DescribeIdentity operation.
@@ -113,6 +136,17 @@ func (c *CognitoIdentity) DescribeIdentity(input *DescribeIdentityInput) (output
 	return
 }
 
+// DescribeIdentityWithContext is the same as DescribeIdentity with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) DescribeIdentityWithContext(ctx context.Context, input *DescribeIdentityInput) (output *IdentityDescription, err error) {
+	req, out := c.DescribeIdentityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeIdentity *aws.Operation
 
 // DescribeIdentityPoolRequest generates a request for the DescribeIdentityPool operation.
@@ -147,6 +181,17 @@ func (c *CognitoIdentity) DescribeIdentityPool(input *DescribeIdentityPoolInput)
 	return
 }
 
+// DescribeIdentityPoolWithContext is the same as DescribeIdentityPool with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) DescribeIdentityPoolWithContext(ctx context.Context, input *DescribeIdentityPoolInput) (output *IdentityPool, err error) {
+	req, out := c.DescribeIdentityPoolRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeIdentityPool *aws.Operation
 
 // GetCredentialsForIdentityRequest generates a request for the GetCredentialsForIdentity operation.
@@ -183,6 +228,17 @@ func (c *CognitoIdentity) GetCredentialsForIdentity(input *GetCredentialsForIden
 	return
 }
 
+// GetCredentialsForIdentityWithContext is the same as GetCredentialsForIdentity with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) GetCredentialsForIdentityWithContext(ctx context.Context, input *GetCredentialsForIdentityInput) (output *GetCredentialsForIdentityOutput, err error) {
+	req, out := c.GetCredentialsForIdentityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetCredentialsForIdentity *aws.Operation
 
 // GetIDRequest generates a request for the GetID operation.
@@ -217,6 +273,17 @@ func (c *CognitoIdentity) GetID(input *GetIDInput) (output *GetIDOutput, err err
 	return
 }
 
+// GetIDWithContext is the same as GetID with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) GetIDWithContext(ctx context.Context, input *GetIDInput) (output *GetIDOutput, err error) {
+	req, out := c.GetIDRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetID *aws.Operation
 
 // GetIdentityPoolRolesRequest generates a request for the GetIdentityPoolRoles operation.
@@ -250,6 +317,17 @@ func (c *CognitoIdentity) GetIdentityPoolRoles(input *GetIdentityPoolRolesInput)
 	return
 }
 
+// GetIdentityPoolRolesWithContext is the same as GetIdentityPoolRoles with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) GetIdentityPoolRolesWithContext(ctx context.Context, input *GetIdentityPoolRolesInput) (output *GetIdentityPoolRolesOutput, err error) {
+	req, out := c.GetIdentityPoolRolesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetIdentityPoolRoles *aws.Operation
 
 // GetOpenIDTokenRequest generates a request for the GetOpenIDToken operation.
@@ -287,6 +365,17 @@ func (c *CognitoIdentity) GetOpenIDToken(input *GetOpenIDTokenInput) (output *Ge
 	return
 }
 
+// GetOpenIDTokenWithContext is the same as GetOpenIDToken with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) GetOpenIDTokenWithContext(ctx context.Context, input *GetOpenIDTokenInput) (output *GetOpenIDTokenOutput, err error) {
+	req, out := c.GetOpenIDTokenRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetOpenIDToken *aws.Operation
 
 // GetOpenIDTokenForDeveloperIdentityRequest generates a request for the GetOpenIDTokenForDeveloperIdentity operation.
@@ -333,6 +422,17 @@ func (c *CognitoIdentity) GetOpenIDTokenForDeveloperIdentity(input *GetOpenIDTok
 	return
 }
 
+// GetOpenIDTokenForDeveloperIdentityWithContext is the same as GetOpenIDTokenForDeveloperIdentity with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) GetOpenIDTokenForDeveloperIdentityWithContext(ctx context.Context, input *GetOpenIDTokenForDeveloperIdentityInput) (output *GetOpenIDTokenForDeveloperIdentityOutput, err error) {
+	req, out := c.GetOpenIDTokenForDeveloperIdentityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetOpenIDTokenForDeveloperIdentity *aws.Operation
 
 // ListIdentitiesRequest generates a request for the ListIdentities operation.
@@ -366,6 +466,17 @@ func (c *CognitoIdentity) ListIdentities(input *ListIdentitiesInput) (output *Li
 	return
 }
 
+// ListIdentitiesWithContext is the same as ListIdentities with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) ListIdentitiesWithContext(ctx context.Context, input *ListIdentitiesInput) (output *ListIdentitiesOutput, err error) {
+	req, out := c.ListIdentitiesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListIdentities *aws.Operation
 
 // ListIdentityPoolsRequest generates a request for the ListIdentityPools operation.
@@ -399,6 +510,17 @@ func (c *CognitoIdentity) ListIdentityPools(input *ListIdentityPoolsInput) (outp
 	return
 }
 
+// ListIdentityPoolsWithContext is the same as ListIdentityPools with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) ListIdentityPoolsWithContext(ctx context.Context, input *ListIdentityPoolsInput) (output *ListIdentityPoolsOutput, err error) {
+	req, out := c.ListIdentityPoolsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListIdentityPools *aws.Operation
 
 // LookupDeveloperIdentityRequest generates a request for the LookupDeveloperIdentity operation.
@@ -439,6 +561,17 @@ func (c *CognitoIdentity) LookupDeveloperIdentity(input *LookupDeveloperIdentity
 	return
 }
 
+// LookupDeveloperIdentityWithContext is the same as LookupDeveloperIdentity with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) LookupDeveloperIdentityWithContext(ctx context.Context, input *LookupDeveloperIdentityInput) (output *LookupDeveloperIdentityOutput, err error) {
+	req, out := c.LookupDeveloperIdentityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opLookupDeveloperIdentity *aws.Operation
 
 // MergeDeveloperIdentitiesRequest generates a request for the MergeDeveloperIdentities operation.
@@ -478,6 +611,17 @@ func (c *CognitoIdentity) MergeDeveloperIdentities(input *MergeDeveloperIdentiti
 	return
 }
 
+// MergeDeveloperIdentitiesWithContext is the same as MergeDeveloperIdentities with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) MergeDeveloperIdentitiesWithContext(ctx context.Context, input *MergeDeveloperIdentitiesInput) (output *MergeDeveloperIdentitiesOutput, err error) {
+	req, out := c.MergeDeveloperIdentitiesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opMergeDeveloperIdentities *aws.Operation
 
 // SetIdentityPoolRolesRequest generates a request for the SetIdentityPoolRoles operation.
@@ -512,6 +656,17 @@ func (c *CognitoIdentity) SetIdentityPoolRoles(input *SetIdentityPoolRolesInput)
 	return
 }
 
+// SetIdentityPoolRolesWithContext is the same as SetIdentityPoolRoles with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) SetIdentityPoolRolesWithContext(ctx context.Context, input *SetIdentityPoolRolesInput) (output *SetIdentityPoolRolesOutput, err error) {
+	req, out := c.SetIdentityPoolRolesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetIdentityPoolRoles *aws.Operation
 
 // UnlinkDeveloperIdentityRequest generates a request for the UnlinkDeveloperIdentity operation.
@@ -548,6 +703,17 @@ func (c *CognitoIdentity) UnlinkDeveloperIdentity(input *UnlinkDeveloperIdentity
 	return
 }
 
+// UnlinkDeveloperIdentityWithContext is the same as UnlinkDeveloperIdentity with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) UnlinkDeveloperIdentityWithContext(ctx context.Context, input *UnlinkDeveloperIdentityInput) (output *UnlinkDeveloperIdentityOutput, err error) {
+	req, out := c.UnlinkDeveloperIdentityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUnlinkDeveloperIdentity *aws.Operation
 
 // UnlinkIdentityRequest generates a request for the UnlinkIdentity operation.
@@ -583,6 +749,17 @@ func (c *CognitoIdentity) UnlinkIdentity(input *UnlinkIdentityInput) (output *Un
 	return
 }
 
+// UnlinkIdentityWithContext is the same as UnlinkIdentity with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) UnlinkIdentityWithContext(ctx context.Context, input *UnlinkIdentityInput) (output *UnlinkIdentityOutput, err error) {
+	req, out := c.UnlinkIdentityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUnlinkIdentity *aws.Operation
 
 // UpdateIdentityPoolRequest generates a request for the UpdateIdentityPool operation.
@@ -616,6 +793,17 @@ func (c *CognitoIdentity) UpdateIdentityPool(input *IdentityPool) (output *Ident
 	return
 }
 
+// UpdateIdentityPoolWithContext is the same as UpdateIdentityPool with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoIdentity) UpdateIdentityPoolWithContext(ctx context.Context, input *IdentityPool) (output *IdentityPool, err error) {
+	req, out := c.UpdateIdentityPoolRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateIdentityPool *aws.Operation
 
 // Input to the CreateIdentityPool action.
@@ -630,16 +818,16 @@ type CreateIdentityPoolInput struct {
 	//
 	// Once you have set a developer provider name, you cannot change it. Please
 	// take care in setting this parameter.
-	DeveloperProviderName *string `type:"string"`
+	DeveloperProviderName *string `type:"string" min:"1" max:"128" pattern:"[\\w._-]+"`
 
 	// A string that you provide.
-	IdentityPoolName *string `type:"string" required:"true"`
+	IdentityPoolName *string `type:"string" min:"1" max:"128" pattern:"[\\w ]+" required:"true"`
 
 	// A list of OpendID Connect provider ARNs.
 	OpenIDConnectProviderARNs []*string `locationName:"OpenIdConnectProviderARNs" type:"list"`
 
 	// Optional key:value pairs mapping provider names to provider app IDs.
-	SupportedLoginProviders *map[string]*string `type:"map"`
+	SupportedLoginProviders *map[string]*string `type:"map" max:"10"`
 
 	metadataCreateIdentityPoolInput `json:"-", xml:"-"`
 }
@@ -672,7 +860,7 @@ type metadataCredentials struct {
 // Input to the DeleteIdentityPool action.
 type DeleteIdentityPoolInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDeleteIdentityPoolInput `json:"-", xml:"-"`
 }
@@ -692,7 +880,7 @@ type metadataDeleteIdentityPoolOutput struct {
 // Input to the DescribeIdentity action.
 type DescribeIdentityInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDescribeIdentityInput `json:"-", xml:"-"`
 }
@@ -704,7 +892,7 @@ type metadataDescribeIdentityInput struct {
 // Input to the DescribeIdentityPool action.
 type DescribeIdentityPoolInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDescribeIdentityPoolInput `json:"-", xml:"-"`
 }
@@ -716,10 +904,10 @@ type metadataDescribeIdentityPoolInput struct {
 // Input to the GetCredentialsForIdentity action.
 type GetCredentialsForIdentityInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
-	Logins *map[string]*string `type:"map"`
+	Logins *map[string]*string `type:"map" max:"10"`
 
 	metadataGetCredentialsForIdentityInput `json:"-", xml:"-"`
 }
@@ -734,7 +922,7 @@ type GetCredentialsForIdentityOutput struct {
 	Credentials *Credentials `type:"structure"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataGetCredentialsForIdentityOutput `json:"-", xml:"-"`
 }
@@ -746,16 +934,16 @@ type metadataGetCredentialsForIdentityOutput struct {
 // Input to the GetId action.
 type GetIDInput struct {
 	// A standard AWS account ID (9+ digits).
-	AccountID *string `locationName:"AccountId" type:"string"`
+	AccountID *string `locationName:"AccountId" type:"string" min:"1" max:"15" pattern:"\\d+"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
 	//
 	// The available provider names for Logins are as follows:  Facebook: graph.facebook.com
 	//  Google: accounts.google.com  Amazon: www.amazon.com
-	Logins *map[string]*string `type:"map"`
+	Logins *map[string]*string `type:"map" max:"10"`
 
 	metadataGetIDInput `json:"-", xml:"-"`
 }
@@ -767,7 +955,7 @@ type metadataGetIDInput struct {
 // Returned in response to a GetId request.
 type GetIDOutput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataGetIDOutput `json:"-", xml:"-"`
 }
@@ -779,7 +967,7 @@ type metadataGetIDOutput struct {
 // Input to the GetIdentityPoolRoles action.
 type GetIdentityPoolRolesInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataGetIdentityPoolRolesInput `json:"-", xml:"-"`
 }
@@ -791,11 +979,11 @@ type metadataGetIdentityPoolRolesInput struct {
 // Returned in response to a successful GetIdentityPoolRoles operation.
 type GetIdentityPoolRolesOutput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// The map of roles associated with this pool. Currently only authenticated
 	// and unauthenticated roles are supported.
-	Roles *map[string]*string `type:"map"`
+	Roles *map[string]*string `type:"map" max:"2"`
 
 	metadataGetIdentityPoolRolesOutput `json:"-", xml:"-"`
 }
@@ -807,10 +995,10 @@ type metadataGetIdentityPoolRolesOutput struct {
 // Input to the GetOpenIdTokenForDeveloperIdentity action.
 type GetOpenIDTokenForDeveloperIdentityInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
 	// Each name-value pair represents a user from a public provider or developer
@@ -821,7 +1009,7 @@ type GetOpenIDTokenForDeveloperIdentityInput struct {
 	// The developer user identifier is an identifier from your backend that uniquely
 	// identifies a user. When you create an identity pool, you can specify the
 	// supported logins.
-	Logins *map[string]*string `type:"map" required:"true"`
+	Logins *map[string]*string `type:"map" max:"10" required:"true"`
 
 	// The expiration time of the token, in seconds. You can specify a custom expiration
 	// time for the token so that you can cache it. If you don't provide an expiration
@@ -831,7 +1019,7 @@ type GetOpenIDTokenForDeveloperIdentityInput struct {
 	// take care in setting the expiration time for a token, as there are significant
 	// security implications: an attacker could use a leaked token to access your
 	// AWS resources for the token's duration.
-	TokenDuration *int64 `type:"long"`
+	TokenDuration *int64 `type:"long" min:"1" max:"86400"`
 
 	metadataGetOpenIDTokenForDeveloperIdentityInput `json:"-", xml:"-"`
 }
@@ -843,7 +1031,7 @@ type metadataGetOpenIDTokenForDeveloperIdentityInput struct {
 // Returned in response to a successful GetOpenIdTokenForDeveloperIdentity request.
 type GetOpenIDTokenForDeveloperIdentityOutput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// An OpenID token.
 	Token *string `type:"string"`
@@ -858,10 +1046,10 @@ type metadataGetOpenIDTokenForDeveloperIdentityOutput struct {
 // Input to the GetOpenIdToken action.
 type GetOpenIDTokenInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
-	Logins *map[string]*string `type:"map"`
+	Logins *map[string]*string `type:"map" max:"10"`
 
 	metadataGetOpenIDTokenInput `json:"-", xml:"-"`
 }
@@ -874,7 +1062,7 @@ type metadataGetOpenIDTokenInput struct {
 type GetOpenIDTokenOutput struct {
 	// A unique identifier in the format REGION:GUID. Note that the IdentityId returned
 	// may not match the one passed on input.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// An OpenID token, valid for 15 minutes.
 	Token *string `type:"string"`
@@ -892,7 +1080,7 @@ type IdentityDescription struct {
 	CreationDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// Date on which the identity was last modified.
 	LastModifiedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -913,19 +1101,19 @@ type IdentityPool struct {
 	AllowUnauthenticatedIdentities *bool `type:"boolean" required:"true"`
 
 	// The "domain" by which Cognito will refer to your users.
-	DeveloperProviderName *string `type:"string"`
+	DeveloperProviderName *string `type:"string" min:"1" max:"128" pattern:"[\\w._-]+"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A string that you provide.
-	IdentityPoolName *string `type:"string" required:"true"`
+	IdentityPoolName *string `type:"string" min:"1" max:"128" pattern:"[\\w ]+" required:"true"`
 
 	// A list of OpendID Connect provider ARNs.
 	OpenIDConnectProviderARNs []*string `locationName:"OpenIdConnectProviderARNs" type:"list"`
 
 	// Optional key:value pairs mapping provider names to provider app IDs.
-	SupportedLoginProviders *map[string]*string `type:"map"`
+	SupportedLoginProviders *map[string]*string `type:"map" max:"10"`
 
 	metadataIdentityPool `json:"-", xml:"-"`
 }
@@ -937,10 +1125,10 @@ type metadataIdentityPool struct {
 // A description of the identity pool.
 type IdentityPoolShortDescription struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// A string that you provide.
-	IdentityPoolName *string `type:"string"`
+	IdentityPoolName *string `type:"string" min:"1" max:"128" pattern:"[\\w ]+"`
 
 	metadataIdentityPoolShortDescription `json:"-", xml:"-"`
 }
@@ -952,13 +1140,13 @@ type metadataIdentityPoolShortDescription struct {
 // Input to the ListIdentities action.
 type ListIdentitiesInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The maximum number of identities to return.
-	MaxResults *int64 `type:"integer" required:"true"`
+	MaxResults *int64 `type:"integer" min:"1" max:"60" required:"true"`
 
 	// A pagination token.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataListIdentitiesInput `json:"-", xml:"-"`
 }
@@ -973,10 +1161,10 @@ type ListIdentitiesOutput struct {
 	Identities []*IdentityDescription `type:"list"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// A pagination token.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataListIdentitiesOutput `json:"-", xml:"-"`
 }
@@ -988,10 +1176,10 @@ type metadataListIdentitiesOutput struct {
 // Input to the ListIdentityPools action.
 type ListIdentityPoolsInput struct {
 	// The maximum number of identities to return.
-	MaxResults *int64 `type:"integer" required:"true"`
+	MaxResults *int64 `type:"integer" min:"1" max:"60" required:"true"`
 
 	// A pagination token.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataListIdentityPoolsInput `json:"-", xml:"-"`
 }
@@ -1006,7 +1194,7 @@ type ListIdentityPoolsOutput struct {
 	IdentityPools []*IdentityPoolShortDescription `type:"list"`
 
 	// A pagination token.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataListIdentityPoolsOutput `json:"-", xml:"-"`
 }
@@ -1020,16 +1208,16 @@ type LookupDeveloperIdentityInput struct {
 	// A unique ID used by your backend authentication process to identify a user.
 	// Typically, a developer identity provider would issue many developer user
 	// identifiers, in keeping with the number of users.
-	DeveloperUserIdentifier *string `type:"string"`
+	DeveloperUserIdentifier *string `type:"string" min:"1" max:"1024" pattern:"[\\w.@_-]+"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The maximum number of identities to return.
-	MaxResults *int64 `type:"integer"`
+	MaxResults *int64 `type:"integer" min:"1" max:"60"`
 
 	// A pagination token. The first call you make will have NextToken set to null.
 	// After that the service will return NextToken values as needed. For example,
@@ -1037,7 +1225,7 @@ type LookupDeveloperIdentityInput struct {
 	// matches in the database. The service will return a pagination token as a
 	// part of the response. This token can be used to call the API again and get
 	// results starting from the 11th match.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataLookupDeveloperIdentityInput `json:"-", xml:"-"`
 }
@@ -1054,7 +1242,7 @@ type LookupDeveloperIdentityOutput struct {
 	DeveloperUserIdentifierList []*string `type:"list"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// A pagination token. The first call you make will have NextToken set to null.
 	// After that the service will return NextToken values as needed. For example,
@@ -1062,7 +1250,7 @@ type LookupDeveloperIdentityOutput struct {
 	// matches in the database. The service will return a pagination token as a
 	// part of the response. This token can be used to call the API again and get
 	// results starting from the 11th match.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataLookupDeveloperIdentityOutput `json:"-", xml:"-"`
 }
@@ -1074,20 +1262,20 @@ type metadataLookupDeveloperIdentityOutput struct {
 // Input to the MergeDeveloperIdentities action.
 type MergeDeveloperIdentitiesInput struct {
 	// User identifier for the destination user. The value should be a DeveloperUserIdentifier.
-	DestinationUserIdentifier *string `type:"string" required:"true"`
+	DestinationUserIdentifier *string `type:"string" min:"1" max:"1024" pattern:"[\\w.@_-]+" required:"true"`
 
 	// The "domain" by which Cognito will refer to your users. This is a (pseudo)
 	// domain name that you provide while creating an identity pool. This name acts
 	// as a placeholder that allows your backend and the Cognito service to communicate
 	// about the developer provider. For the DeveloperProviderName, you can use
 	// letters as well as period (.), underscore (_), and dash (-).
-	DeveloperProviderName *string `type:"string" required:"true"`
+	DeveloperProviderName *string `type:"string" min:"1" max:"128" pattern:"[\\w._-]+" required:"true"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// User identifier for the source user. The value should be a DeveloperUserIdentifier.
-	SourceUserIdentifier *string `type:"string" required:"true"`
+	SourceUserIdentifier *string `type:"string" min:"1" max:"1024" pattern:"[\\w.@_-]+" required:"true"`
 
 	metadataMergeDeveloperIdentitiesInput `json:"-", xml:"-"`
 }
@@ -1099,7 +1287,7 @@ type metadataMergeDeveloperIdentitiesInput struct {
 // Returned in response to a successful MergeDeveloperIdentities action.
 type MergeDeveloperIdentitiesOutput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataMergeDeveloperIdentitiesOutput `json:"-", xml:"-"`
 }
@@ -1111,11 +1299,11 @@ type metadataMergeDeveloperIdentitiesOutput struct {
 // Input to the SetIdentityPoolRoles action.
 type SetIdentityPoolRolesInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The map of roles associated with this pool. Currently only authenticated
 	// and unauthenticated roles are supported.
-	Roles *map[string]*string `type:"map" required:"true"`
+	Roles *map[string]*string `type:"map" max:"2" required:"true"`
 
 	metadataSetIdentityPoolRolesInput `json:"-", xml:"-"`
 }
@@ -1135,16 +1323,16 @@ type metadataSetIdentityPoolRolesOutput struct {
 // Input to the UnlinkDeveloperIdentity action.
 type UnlinkDeveloperIdentityInput struct {
 	// The "domain" by which Cognito will refer to your users.
-	DeveloperProviderName *string `type:"string" required:"true"`
+	DeveloperProviderName *string `type:"string" min:"1" max:"128" pattern:"[\\w._-]+" required:"true"`
 
 	// A unique ID used by your backend authentication process to identify a user.
-	DeveloperUserIdentifier *string `type:"string" required:"true"`
+	DeveloperUserIdentifier *string `type:"string" min:"1" max:"1024" pattern:"[\\w.@_-]+" required:"true"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataUnlinkDeveloperIdentityInput `json:"-", xml:"-"`
 }
@@ -1164,10 +1352,10 @@ type metadataUnlinkDeveloperIdentityOutput struct {
 // Input to the UnlinkIdentity action.
 type UnlinkIdentityInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
-	Logins *map[string]*string `type:"map" required:"true"`
+	Logins *map[string]*string `type:"map" max:"10" required:"true"`
 
 	// Provider names to unlink from this identity.
 	LoginsToRemove []*string `type:"list" required:"true"`
@@ -1,41 +1,77 @@
 package cognitoidentityiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/cognitoidentity"
 )
 
 type CognitoIdentityAPI interface {
 	CreateIdentityPool(*cognitoidentity.CreateIdentityPoolInput) (*cognitoidentity.IdentityPool, error)
 
+	CreateIdentityPoolWithContext(context.Context, *cognitoidentity.CreateIdentityPoolInput) (*cognitoidentity.IdentityPool, error)
+
 	DeleteIdentityPool(*cognitoidentity.DeleteIdentityPoolInput) (*cognitoidentity.DeleteIdentityPoolOutput, error)
 
+	DeleteIdentityPoolWithContext(context.Context, *cognitoidentity.DeleteIdentityPoolInput) (*cognitoidentity.DeleteIdentityPoolOutput, error)
+
 	DescribeIdentity(*cognitoidentity.DescribeIdentityInput) (*cognitoidentity.IdentityDescription, error)
 
+	DescribeIdentityWithContext(context.Context, *cognitoidentity.DescribeIdentityInput) (*cognitoidentity.IdentityDescription, error)
+
 	DescribeIdentityPool(*cognitoidentity.DescribeIdentityPoolInput) (*cognitoidentity.IdentityPool, error)
 
+	DescribeIdentityPoolWithContext(context.Context, *cognitoidentity.DescribeIdentityPoolInput) (*cognitoidentity.IdentityPool, error)
+
 	GetCredentialsForIdentity(*cognitoidentity.GetCredentialsForIdentityInput) (*cognitoidentity.GetCredentialsForIdentityOutput, error)
 
+	GetCredentialsForIdentityWithContext(context.Context, *cognitoidentity.GetCredentialsForIdentityInput) (*cognitoidentity.GetCredentialsForIdentityOutput, error)
+
 	GetID(*cognitoidentity.GetIDInput) (*cognitoidentity.GetIDOutput, error)
 
+	GetIDWithContext(context.Context, *cognitoidentity.GetIDInput) (*cognitoidentity.GetIDOutput, error)
+
 	GetIdentityPoolRoles(*cognitoidentity.GetIdentityPoolRolesInput) (*cognitoidentity.GetIdentityPoolRolesOutput, error)
 
+	GetIdentityPoolRolesWithContext(context.Context, *cognitoidentity.GetIdentityPoolRolesInput) (*cognitoidentity.GetIdentityPoolRolesOutput, error)
+
 	GetOpenIDToken(*cognitoidentity.GetOpenIDTokenInput) (*cognitoidentity.GetOpenIDTokenOutput, error)
 
+	GetOpenIDTokenWithContext(context.Context, *cognitoidentity.GetOpenIDTokenInput) (*cognitoidentity.GetOpenIDTokenOutput, error)
+
 	GetOpenIDTokenForDeveloperIdentity(*cognitoidentity.GetOpenIDTokenForDeveloperIdentityInput) (*cognitoidentity.GetOpenIDTokenForDeveloperIdentityOutput, error)
 
+	GetOpenIDTokenForDeveloperIdentityWithContext(context.Context, *cognitoidentity.GetOpenIDTokenForDeveloperIdentityInput) (*cognitoidentity.GetOpenIDTokenForDeveloperIdentityOutput, error)
+
 	ListIdentities(*cognitoidentity.ListIdentitiesInput) (*cognitoidentity.ListIdentitiesOutput, error)
 
+	ListIdentitiesWithContext(context.Context, *cognitoidentity.ListIdentitiesInput) (*cognitoidentity.ListIdentitiesOutput, error)
+
 	ListIdentityPools(*cognitoidentity.ListIdentityPoolsInput) (*cognitoidentity.ListIdentityPoolsOutput, error)
 
+	ListIdentityPoolsWithContext(context.Context, *cognitoidentity.ListIdentityPoolsInput) (*cognitoidentity.ListIdentityPoolsOutput, error)
+
 	LookupDeveloperIdentity(*cognitoidentity.LookupDeveloperIdentityInput) (*cognitoidentity.LookupDeveloperIdentityOutput, error)
 
+	LookupDeveloperIdentityWithContext(context.Context, *cognitoidentity.LookupDeveloperIdentityInput) (*cognitoidentity.LookupDeveloperIdentityOutput, error)
+
 	MergeDeveloperIdentities(*cognitoidentity.MergeDeveloperIdentitiesInput) (*cognitoidentity.MergeDeveloperIdentitiesOutput, error)
 
+	MergeDeveloperIdentitiesWithContext(context.Context, *cognitoidentity.MergeDeveloperIdentitiesInput) (*cognitoidentity.MergeDeveloperIdentitiesOutput, error)
+
 	SetIdentityPoolRoles(*cognitoidentity.SetIdentityPoolRolesInput) (*cognitoidentity.SetIdentityPoolRolesOutput, error)
 
+	SetIdentityPoolRolesWithContext(context.Context, *cognitoidentity.SetIdentityPoolRolesInput) (*cognitoidentity.SetIdentityPoolRolesOutput, error)
+
 	UnlinkDeveloperIdentity(*cognitoidentity.UnlinkDeveloperIdentityInput) (*cognitoidentity.UnlinkDeveloperIdentityOutput, error)
 
+	UnlinkDeveloperIdentityWithContext(context.Context, *cognitoidentity.UnlinkDeveloperIdentityInput) (*cognitoidentity.UnlinkDeveloperIdentityOutput, error)
+
 	UnlinkIdentity(*cognitoidentity.UnlinkIdentityInput) (*cognitoidentity.UnlinkIdentityOutput, error)
 
+	UnlinkIdentityWithContext(context.Context, *cognitoidentity.UnlinkIdentityInput) (*cognitoidentity.UnlinkIdentityOutput, error)
+
 	UpdateIdentityPool(*cognitoidentity.IdentityPool) (*cognitoidentity.IdentityPool, error)
+
+	UpdateIdentityPoolWithContext(context.Context, *cognitoidentity.IdentityPool) (*cognitoidentity.IdentityPool, error)
 }
\ No newline at end of file
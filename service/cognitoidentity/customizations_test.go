@@ -14,7 +14,7 @@ var svc = cognitoidentity.New(&aws.Config{
 
 func TestUnsignedRequest_GetID(t *testing.T) {
 	req, _ := svc.GetIDRequest(&cognitoidentity.GetIDInput{
-		IdentityPoolID: aws.String("IdentityPoolId"),
+		IdentityPoolID: aws.String("mock-region:0123abcd-0123-4567-89ab-0123456789ab"),
 	})
 
 	err := req.Sign()
@@ -24,7 +24,7 @@ func TestUnsignedRequest_GetID(t *testing.T) {
 
 func TestUnsignedRequest_GetOpenIDToken(t *testing.T) {
 	req, _ := svc.GetOpenIDTokenRequest(&cognitoidentity.GetOpenIDTokenInput{
-		IdentityID: aws.String("IdentityId"),
+		IdentityID: aws.String("mock-region:0123abcd-0123-4567-89ab-0123456789ab"),
 	})
 
 	err := req.Sign()
@@ -34,7 +34,7 @@ func TestUnsignedRequest_GetOpenIDToken(t *testing.T) {
 
 func TestUnsignedRequest_GetCredentialsForIdentity(t *testing.T) {
 	req, _ := svc.GetCredentialsForIdentityRequest(&cognitoidentity.GetCredentialsForIdentityInput{
-		IdentityID: aws.String("IdentityId"),
+		IdentityID: aws.String("mock-region:0123abcd-0123-4567-89ab-0123456789ab"),
 	})
 
 	err := req.Sign()
@@ -29,6 +29,7 @@ func New(config *aws.Config) *CognitoIdentity {
 		APIVersion:   "2014-06-30",
 		JSONVersion:  "1.1",
 		TargetPrefix: "AWSCognitoIdentityService",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package cognitosync
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -46,6 +47,17 @@ func (c *CognitoSync) BulkPublish(input *BulkPublishInput) (output *BulkPublishO
 	return
 }
 
+// BulkPublishWithContext is the same as BulkPublish with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoSync) BulkPublishWithContext(ctx context.Context, input *BulkPublishInput) (output *BulkPublishOutput, err error) {
+	req, out := c.BulkPublishRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opBulkPublish *aws.Operation
 
 // DeleteDatasetRequest generates a request for the DeleteDataset operation.
@@ -85,6 +97,17 @@ func (c *CognitoSync) DeleteDataset(input *DeleteDatasetInput) (output *DeleteDa
 	return
 }
 
+// DeleteDatasetWithContext is the same as DeleteDataset with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoSync) DeleteDatasetWithContext(ctx context.Context, input *DeleteDatasetInput) (output *DeleteDatasetOutput, err error) {
+	req, out := c.DeleteDatasetRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteDataset *aws.Operation
 
 // DescribeDatasetRequest generates a request for the DescribeDataset operation.
@@ -124,6 +147,17 @@ func (c *CognitoSync) DescribeDataset(input *DescribeDatasetInput) (output *Desc
 	return
 }
 
+// DescribeDatasetWithContext is the same as DescribeDataset with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoSync) DescribeDatasetWithContext(ctx context.Context, input *DescribeDatasetInput) (output *DescribeDatasetOutput, err error) {
+	req, out := c.DescribeDatasetRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeDataset *aws.Operation
 
 // DescribeIdentityPoolUsageRequest generates a request for the DescribeIdentityPoolUsage operation.
@@ -162,6 +196,17 @@ func (c *CognitoSync) DescribeIdentityPoolUsage(input *DescribeIdentityPoolUsage
 	return
 }
 
+// DescribeIdentityPoolUsageWithContext is the same as DescribeIdentityPoolUsage with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoSync) DescribeIdentityPoolUsageWithContext(ctx context.Context, input *DescribeIdentityPoolUsageInput) (output *DescribeIdentityPoolUsageOutput, err error) {
+	req, out := c.DescribeIdentityPoolUsageRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeIdentityPoolUsage *aws.Operation
 
 // DescribeIdentityUsageRequest generates a request for the DescribeIdentityUsage operation.
@@ -199,6 +244,17 @@ func (c *CognitoSync) DescribeIdentityUsage(input *DescribeIdentityUsageInput) (
 	return
 }
 
+// DescribeIdentityUsageWithContext is the same as DescribeIdentityUsage with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoSync) DescribeIdentityUsageWithContext(ctx context.Context, input *DescribeIdentityUsageInput) (output *DescribeIdentityUsageOutput, err error) {
+	req, out := c.DescribeIdentityUsageRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeIdentityUsage *aws.Operation
 
 // GetBulkPublishDetailsRequest generates a request for the GetBulkPublishDetails operation.
@@ -232,6 +288,17 @@ func (c *CognitoSync) GetBulkPublishDetails(input *GetBulkPublishDetailsInput) (
 	return
 }
 
+// GetBulkPublishDetailsWithContext is the same as GetBulkPublishDetails with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoSync) GetBulkPublishDetailsWithContext(ctx context.Context, input *GetBulkPublishDetailsInput) (output *GetBulkPublishDetailsOutput, err error) {
+	req, out := c.GetBulkPublishDetailsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetBulkPublishDetails *aws.Operation
 
 // GetIdentityPoolConfigurationRequest generates a request for the GetIdentityPoolConfiguration operation.
@@ -265,6 +332,17 @@ func (c *CognitoSync) GetIdentityPoolConfiguration(input *GetIdentityPoolConfigu
 	return
 }
 
+// GetIdentityPoolConfigurationWithContext is the same as GetIdentityPoolConfiguration with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoSync) GetIdentityPoolConfigurationWithContext(ctx context.Context, input *GetIdentityPoolConfigurationInput) (output *GetIdentityPoolConfigurationOutput, err error) {
+	req, out := c.GetIdentityPoolConfigurationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetIdentityPoolConfiguration *aws.Operation
 
 // ListDatasetsRequest generates a request for the ListDatasets operation.
@@ -304,6 +382,17 @@ func (c *CognitoSync) ListDatasets(input *ListDatasetsInput) (output *ListDatase
 	return
 }
 
+// ListDatasetsWithContext is the same as ListDatasets with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoSync) ListDatasetsWithContext(ctx context.Context, input *ListDatasetsInput) (output *ListDatasetsOutput, err error) {
+	req, out := c.ListDatasetsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListDatasets *aws.Operation
 
 // ListIdentityPoolUsageRequest generates a request for the ListIdentityPoolUsage operation.
@@ -341,6 +430,17 @@ func (c *CognitoSync) ListIdentityPoolUsage(input *ListIdentityPoolUsageInput) (
 	return
 }
 
+// ListIdentityPoolUsageWithContext is the same as ListIdentityPoolUsage with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoSync) ListIdentityPoolUsageWithContext(ctx context.Context, input *ListIdentityPoolUsageInput) (output *ListIdentityPoolUsageOutput, err error) {
+	req, out := c.ListIdentityPoolUsageRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListIdentityPoolUsage *aws.Operation
 
 // ListRecordsRequest generates a request for the ListRecords operation.
@@ -381,6 +481,17 @@ func (c *CognitoSync) ListRecords(input *ListRecordsInput) (output *ListRecordsO
 	return
 }
 
+// ListRecordsWithContext is the same as ListRecords with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoSync) ListRecordsWithContext(ctx context.Context, input *ListRecordsInput) (output *ListRecordsOutput, err error) {
+	req, out := c.ListRecordsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListRecords *aws.Operation
 
 // RegisterDeviceRequest generates a request for the RegisterDevice operation.
@@ -414,6 +525,17 @@ func (c *CognitoSync) RegisterDevice(input *RegisterDeviceInput) (output *Regist
 	return
 }
 
+// RegisterDeviceWithContext is the same as RegisterDevice with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoSync) RegisterDeviceWithContext(ctx context.Context, input *RegisterDeviceInput) (output *RegisterDeviceOutput, err error) {
+	req, out := c.RegisterDeviceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRegisterDevice *aws.Operation
 
 // SetIdentityPoolConfigurationRequest generates a request for the SetIdentityPoolConfiguration operation.
@@ -447,6 +569,17 @@ func (c *CognitoSync) SetIdentityPoolConfiguration(input *SetIdentityPoolConfigu
 	return
 }
 
+// SetIdentityPoolConfigurationWithContext is the same as SetIdentityPoolConfiguration with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoSync) SetIdentityPoolConfigurationWithContext(ctx context.Context, input *SetIdentityPoolConfigurationInput) (output *SetIdentityPoolConfigurationOutput, err error) {
+	req, out := c.SetIdentityPoolConfigurationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetIdentityPoolConfiguration *aws.Operation
 
 // SubscribeToDatasetRequest generates a request for the SubscribeToDataset operation.
@@ -481,6 +614,17 @@ func (c *CognitoSync) SubscribeToDataset(input *SubscribeToDatasetInput) (output
 	return
 }
 
+// SubscribeToDatasetWithContext is the same as SubscribeToDataset with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoSync) SubscribeToDatasetWithContext(ctx context.Context, input *SubscribeToDatasetInput) (output *SubscribeToDatasetOutput, err error) {
+	req, out := c.SubscribeToDatasetRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSubscribeToDataset *aws.Operation
 
 // UnsubscribeFromDatasetRequest generates a request for the UnsubscribeFromDataset operation.
@@ -515,6 +659,17 @@ func (c *CognitoSync) UnsubscribeFromDataset(input *UnsubscribeFromDatasetInput)
 	return
 }
 
+// UnsubscribeFromDatasetWithContext is the same as UnsubscribeFromDataset with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoSync) UnsubscribeFromDatasetWithContext(ctx context.Context, input *UnsubscribeFromDatasetInput) (output *UnsubscribeFromDatasetOutput, err error) {
+	req, out := c.UnsubscribeFromDatasetRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUnsubscribeFromDataset *aws.Operation
 
 // UpdateRecordsRequest generates a request for the UpdateRecords operation.
@@ -551,13 +706,24 @@ func (c *CognitoSync) UpdateRecords(input *UpdateRecordsInput) (output *UpdateRe
 	return
 }
 
+// UpdateRecordsWithContext is the same as UpdateRecords with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *CognitoSync) UpdateRecordsWithContext(ctx context.Context, input *UpdateRecordsInput) (output *UpdateRecordsOutput, err error) {
+	req, out := c.UpdateRecordsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateRecords *aws.Operation
 
 // The input for the BulkPublish operation.
 type BulkPublishInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataBulkPublishInput `json:"-", xml:"-"`
 }
@@ -570,7 +736,7 @@ type metadataBulkPublishInput struct {
 type BulkPublishOutput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataBulkPublishOutput `json:"-", xml:"-"`
 }
@@ -584,18 +750,18 @@ type CognitoStreams struct {
 	// The ARN of the role Amazon Cognito can assume in order to publish to the
 	// stream. This role must grant access to Amazon Cognito (cognito-sync) to invoke
 	// PutRecord on your Cognito stream.
-	RoleARN *string `locationName:"RoleArn" type:"string"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" pattern:"arn:aws:iam::\\d+:role/.*"`
 
 	// The name of the Cognito stream to receive updates. This stream must be in
 	// the developers account and in the same region as the identity pool.
-	StreamName *string `type:"string"`
+	StreamName *string `type:"string" min:"1" max:"128"`
 
 	// Status of the Cognito streams. Valid values are: ENABLED - Streaming of updates
 	// to identity pool is enabled.
 	//
 	// DISABLEDStreaming of updates to identity pool is disabled. Bulk publish
 	// will also fail if StreamingStatus is DISABLED.
-	StreamingStatus *string `type:"string"`
+	StreamingStatus *string `type:"string" enum:"ENABLED,DISABLED"`
 
 	metadataCognitoStreams `json:"-", xml:"-"`
 }
@@ -618,11 +784,11 @@ type Dataset struct {
 
 	// A string of up to 128 characters. Allowed characters are a-z, A-Z, 0-9, '_'
 	// (underscore), '-' (dash), and '.' (dot).
-	DatasetName *string `type:"string"`
+	DatasetName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// The device that made the last change to this dataset.
 	LastModifiedBy *string `type:"string"`
@@ -644,15 +810,15 @@ type metadataDataset struct {
 type DeleteDatasetInput struct {
 	// A string of up to 128 characters. Allowed characters are a-z, A-Z, 0-9, '_'
 	// (underscore), '-' (dash), and '.' (dot).
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDeleteDatasetInput `json:"-", xml:"-"`
 }
@@ -682,15 +848,15 @@ type metadataDeleteDatasetOutput struct {
 type DescribeDatasetInput struct {
 	// A string of up to 128 characters. Allowed characters are a-z, A-Z, 0-9, '_'
 	// (underscore), '-' (dash), and '.' (dot).
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDescribeDatasetInput `json:"-", xml:"-"`
 }
@@ -719,7 +885,7 @@ type metadataDescribeDatasetOutput struct {
 type DescribeIdentityPoolUsageInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDescribeIdentityPoolUsageInput `json:"-", xml:"-"`
 }
@@ -744,11 +910,11 @@ type metadataDescribeIdentityPoolUsageOutput struct {
 type DescribeIdentityUsageInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDescribeIdentityUsageInput `json:"-", xml:"-"`
 }
@@ -773,7 +939,7 @@ type metadataDescribeIdentityUsageOutput struct {
 type GetBulkPublishDetailsInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataGetBulkPublishDetailsInput `json:"-", xml:"-"`
 }
@@ -801,7 +967,7 @@ type GetBulkPublishDetailsOutput struct {
 	//
 	// FAILED - Some portion of the data has failed to publish, check FailureMessage
 	// for the cause.
-	BulkPublishStatus *string `type:"string"`
+	BulkPublishStatus *string `type:"string" enum:"NOT_STARTED,IN_PROGRESS,FAILED,SUCCEEDED"`
 
 	// If BulkPublishStatus is FAILED this field will contain the error message
 	// that caused the bulk publish to fail.
@@ -809,7 +975,7 @@ type GetBulkPublishDetailsOutput struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataGetBulkPublishDetailsOutput `json:"-", xml:"-"`
 }
@@ -823,7 +989,7 @@ type GetIdentityPoolConfigurationInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. This is the ID of the pool for which to return
 	// a configuration.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataGetIdentityPoolConfigurationInput `json:"-", xml:"-"`
 }
@@ -839,7 +1005,7 @@ type GetIdentityPoolConfigurationOutput struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// Options to apply to this identity pool for push synchronization.
 	PushSync *PushSync `type:"structure"`
@@ -858,7 +1024,7 @@ type IdentityPoolUsage struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// Date on which the identity pool was last modified.
 	LastModifiedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -883,11 +1049,11 @@ type IdentityUsage struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// Date on which the identity was last modified.
 	LastModifiedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -903,11 +1069,11 @@ type metadataIdentityUsage struct {
 type ListDatasetsInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The maximum number of results to be returned.
 	MaxResults *int64 `location:"querystring" locationName:"maxResults" type:"integer"`
@@ -980,15 +1146,15 @@ type metadataListIdentityPoolUsageOutput struct {
 type ListRecordsInput struct {
 	// A string of up to 128 characters. Allowed characters are a-z, A-Z, 0-9, '_'
 	// (underscore), '-' (dash), and '.' (dot).
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The last server sync count for this record.
 	LastSyncCount *int64 `location:"querystring" locationName:"lastSyncCount" type:"long"`
@@ -1051,7 +1217,7 @@ type PushSync struct {
 	ApplicationARNs []*string `locationName:"ApplicationArns" type:"list"`
 
 	// A role configured to allow Cognito to call SNS on behalf of the developer.
-	RoleARN *string `locationName:"RoleArn" type:"string"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" pattern:"arn:aws:iam::\\d+:role/.*"`
 
 	metadataPushSync `json:"-", xml:"-"`
 }
@@ -1066,7 +1232,7 @@ type Record struct {
 	DeviceLastModifiedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The key for the record.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"1024"`
 
 	// The user/device that made the last change to this record.
 	LastModifiedBy *string `type:"string"`
@@ -1078,7 +1244,7 @@ type Record struct {
 	SyncCount *int64 `type:"long"`
 
 	// The value for the record.
-	Value *string `type:"string"`
+	Value *string `type:"string" max:"1048575"`
 
 	metadataRecord `json:"-", xml:"-"`
 }
@@ -1093,16 +1259,16 @@ type RecordPatch struct {
 	DeviceLastModifiedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The key associated with the record patch.
-	Key *string `type:"string" required:"true"`
+	Key *string `type:"string" min:"1" max:"1024" required:"true"`
 
 	// An operation, either replace or remove.
-	Op *string `type:"string" required:"true"`
+	Op *string `type:"string" enum:"replace,remove" required:"true"`
 
 	// Last known server sync count for this record. Set to 0 if unknown.
 	SyncCount *int64 `type:"long" required:"true"`
 
 	// The value associated with the record patch.
-	Value *string `type:"string"`
+	Value *string `type:"string" max:"1048575"`
 
 	metadataRecordPatch `json:"-", xml:"-"`
 }
@@ -1114,15 +1280,15 @@ type metadataRecordPatch struct {
 // A request to RegisterDevice.
 type RegisterDeviceInput struct {
 	// The unique ID for this identity.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. Here, the ID of the pool that the identity belongs
 	// to.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The SNS platform type (e.g. GCM, SDM, APNS, APNS_SANDBOX).
-	Platform *string `type:"string" required:"true"`
+	Platform *string `type:"string" enum:"APNS,APNS_SANDBOX,GCM,ADM" required:"true"`
 
 	// The push token.
 	Token *string `type:"string" required:"true"`
@@ -1137,7 +1303,7 @@ type metadataRegisterDeviceInput struct {
 // Response to a RegisterDevice request.
 type RegisterDeviceOutput struct {
 	// The unique ID generated for this device by Cognito.
-	DeviceID *string `locationName:"DeviceId" type:"string"`
+	DeviceID *string `locationName:"DeviceId" type:"string" min:"1" max:"256"`
 
 	metadataRegisterDeviceOutput `json:"-", xml:"-"`
 }
@@ -1153,7 +1319,7 @@ type SetIdentityPoolConfigurationInput struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. This is the ID of the pool to modify.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// Options to apply to this identity pool for push synchronization.
 	PushSync *PushSync `type:"structure"`
@@ -1172,7 +1338,7 @@ type SetIdentityPoolConfigurationOutput struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// Options to apply to this identity pool for push synchronization.
 	PushSync *PushSync `type:"structure"`
@@ -1187,17 +1353,17 @@ type metadataSetIdentityPoolConfigurationOutput struct {
 // A request to SubscribeToDatasetRequest.
 type SubscribeToDatasetInput struct {
 	// The name of the dataset to subcribe to.
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// The unique ID generated for this device by Cognito.
-	DeviceID *string `location:"uri" locationName:"DeviceId" type:"string" required:"true"`
+	DeviceID *string `location:"uri" locationName:"DeviceId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Unique ID for this identity.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. The ID of the pool to which the identity belongs.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataSubscribeToDatasetInput `json:"-", xml:"-"`
 }
@@ -1218,17 +1384,17 @@ type metadataSubscribeToDatasetOutput struct {
 // A request to UnsubscribeFromDataset.
 type UnsubscribeFromDatasetInput struct {
 	// The name of the dataset from which to unsubcribe.
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// The unique ID generated for this device by Cognito.
-	DeviceID *string `location:"uri" locationName:"DeviceId" type:"string" required:"true"`
+	DeviceID *string `location:"uri" locationName:"DeviceId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Unique ID for this identity.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. The ID of the pool to which this identity belongs.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataUnsubscribeFromDatasetInput `json:"-", xml:"-"`
 }
@@ -1255,18 +1421,18 @@ type UpdateRecordsInput struct {
 
 	// A string of up to 128 characters. Allowed characters are a-z, A-Z, 0-9, '_'
 	// (underscore), '-' (dash), and '.' (dot).
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// The unique ID generated for this device by Cognito.
-	DeviceID *string `locationName:"DeviceId" type:"string"`
+	DeviceID *string `locationName:"DeviceId" type:"string" min:"1" max:"256"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A list of patch operations.
 	RecordPatches []*RecordPatch `type:"list"`
@@ -1292,4 +1458,68 @@ type UpdateRecordsOutput struct {
 
 type metadataUpdateRecordsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for BulkPublishStatus.
+const (
+	BulkPublishStatusNotStarted = "NOT_STARTED"
+	BulkPublishStatusINProgress = "IN_PROGRESS"
+	BulkPublishStatusFailed     = "FAILED"
+	BulkPublishStatusSucceeded  = "SUCCEEDED"
+)
+
+// BulkPublishStatusValues returns the valid values of BulkPublishStatus.
+func BulkPublishStatusValues() []string {
+	return []string{
+		BulkPublishStatusNotStarted,
+		BulkPublishStatusINProgress,
+		BulkPublishStatusFailed,
+		BulkPublishStatusSucceeded,
+	}
+}
+
+// Enum values for Operation.
+const (
+	OperationReplace = "replace"
+	OperationRemove  = "remove"
+)
+
+// OperationValues returns the valid values of Operation.
+func OperationValues() []string {
+	return []string{
+		OperationReplace,
+		OperationRemove,
+	}
+}
+
+// Enum values for Platform.
+const (
+	PlatformApns        = "APNS"
+	PlatformApnsSandbox = "APNS_SANDBOX"
+	PlatformGcm         = "GCM"
+	PlatformAdm         = "ADM"
+)
+
+// PlatformValues returns the valid values of Platform.
+func PlatformValues() []string {
+	return []string{
+		PlatformApns,
+		PlatformApnsSandbox,
+		PlatformGcm,
+		PlatformAdm,
+	}
+}
+
+// Enum values for StreamingStatus.
+const (
+	StreamingStatusEnabled  = "ENABLED"
+	StreamingStatusDisabled = "DISABLED"
+)
+
+// StreamingStatusValues returns the valid values of StreamingStatus.
+func StreamingStatusValues() []string {
+	return []string{
+		StreamingStatusEnabled,
+		StreamingStatusDisabled,
+	}
 }
\ No newline at end of file
@@ -1,37 +1,69 @@
 package cognitosynciface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/cognitosync"
 )
 
 type CognitoSyncAPI interface {
 	BulkPublish(*cognitosync.BulkPublishInput) (*cognitosync.BulkPublishOutput, error)
 
+	BulkPublishWithContext(context.Context, *cognitosync.BulkPublishInput) (*cognitosync.BulkPublishOutput, error)
+
 	DeleteDataset(*cognitosync.DeleteDatasetInput) (*cognitosync.DeleteDatasetOutput, error)
 
+	DeleteDatasetWithContext(context.Context, *cognitosync.DeleteDatasetInput) (*cognitosync.DeleteDatasetOutput, error)
+
 	DescribeDataset(*cognitosync.DescribeDatasetInput) (*cognitosync.DescribeDatasetOutput, error)
 
+	DescribeDatasetWithContext(context.Context, *cognitosync.DescribeDatasetInput) (*cognitosync.DescribeDatasetOutput, error)
+
 	DescribeIdentityPoolUsage(*cognitosync.DescribeIdentityPoolUsageInput) (*cognitosync.DescribeIdentityPoolUsageOutput, error)
 
+	DescribeIdentityPoolUsageWithContext(context.Context, *cognitosync.DescribeIdentityPoolUsageInput) (*cognitosync.DescribeIdentityPoolUsageOutput, error)
+
 	DescribeIdentityUsage(*cognitosync.DescribeIdentityUsageInput) (*cognitosync.DescribeIdentityUsageOutput, error)
 
+	DescribeIdentityUsageWithContext(context.Context, *cognitosync.DescribeIdentityUsageInput) (*cognitosync.DescribeIdentityUsageOutput, error)
+
 	GetBulkPublishDetails(*cognitosync.GetBulkPublishDetailsInput) (*cognitosync.GetBulkPublishDetailsOutput, error)
 
+	GetBulkPublishDetailsWithContext(context.Context, *cognitosync.GetBulkPublishDetailsInput) (*cognitosync.GetBulkPublishDetailsOutput, error)
+
 	GetIdentityPoolConfiguration(*cognitosync.GetIdentityPoolConfigurationInput) (*cognitosync.GetIdentityPoolConfigurationOutput, error)
 
+	GetIdentityPoolConfigurationWithContext(context.Context, *cognitosync.GetIdentityPoolConfigurationInput) (*cognitosync.GetIdentityPoolConfigurationOutput, error)
+
 	ListDatasets(*cognitosync.ListDatasetsInput) (*cognitosync.ListDatasetsOutput, error)
 
+	ListDatasetsWithContext(context.Context, *cognitosync.ListDatasetsInput) (*cognitosync.ListDatasetsOutput, error)
+
 	ListIdentityPoolUsage(*cognitosync.ListIdentityPoolUsageInput) (*cognitosync.ListIdentityPoolUsageOutput, error)
 
+	ListIdentityPoolUsageWithContext(context.Context, *cognitosync.ListIdentityPoolUsageInput) (*cognitosync.ListIdentityPoolUsageOutput, error)
+
 	ListRecords(*cognitosync.ListRecordsInput) (*cognitosync.ListRecordsOutput, error)
 
+	ListRecordsWithContext(context.Context, *cognitosync.ListRecordsInput) (*cognitosync.ListRecordsOutput, error)
+
 	RegisterDevice(*cognitosync.RegisterDeviceInput) (*cognitosync.RegisterDeviceOutput, error)
 
+	RegisterDeviceWithContext(context.Context, *cognitosync.RegisterDeviceInput) (*cognitosync.RegisterDeviceOutput, error)
+
 	SetIdentityPoolConfiguration(*cognitosync.SetIdentityPoolConfigurationInput) (*cognitosync.SetIdentityPoolConfigurationOutput, error)
 
+	SetIdentityPoolConfigurationWithContext(context.Context, *cognitosync.SetIdentityPoolConfigurationInput) (*cognitosync.SetIdentityPoolConfigurationOutput, error)
+
 	SubscribeToDataset(*cognitosync.SubscribeToDatasetInput) (*cognitosync.SubscribeToDatasetOutput, error)
 
+	SubscribeToDatasetWithContext(context.Context, *cognitosync.SubscribeToDatasetInput) (*cognitosync.SubscribeToDatasetOutput, error)
+
 	UnsubscribeFromDataset(*cognitosync.UnsubscribeFromDatasetInput) (*cognitosync.UnsubscribeFromDatasetOutput, error)
 
+	UnsubscribeFromDatasetWithContext(context.Context, *cognitosync.UnsubscribeFromDatasetInput) (*cognitosync.UnsubscribeFromDatasetOutput, error)
+
 	UpdateRecords(*cognitosync.UpdateRecordsInput) (*cognitosync.UpdateRecordsOutput, error)
+
+	UpdateRecordsWithContext(context.Context, *cognitosync.UpdateRecordsInput) (*cognitosync.UpdateRecordsOutput, error)
 }
\ No newline at end of file
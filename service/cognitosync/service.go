@@ -27,6 +27,7 @@ func New(config *aws.Config) *CognitoSync {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "cognito-sync",
 		APIVersion:  "2014-06-30",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
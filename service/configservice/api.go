@@ -4,6 +4,7 @@
 package configservice
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -48,6 +49,17 @@ func (c *ConfigService) DeleteDeliveryChannel(input *DeleteDeliveryChannelInput)
 	return
 }
 
+// DeleteDeliveryChannelWithContext is the same as DeleteDeliveryChannel with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ConfigService) DeleteDeliveryChannelWithContext(ctx context.Context, input *DeleteDeliveryChannelInput) (output *DeleteDeliveryChannelOutput, err error) {
+	req, out := c.DeleteDeliveryChannelRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteDeliveryChannel *aws.Operation
 
 // DeliverConfigSnapshotRequest generates a request for the DeliverConfigSnapshot operation.
@@ -87,6 +99,17 @@ func (c *ConfigService) DeliverConfigSnapshot(input *DeliverConfigSnapshotInput)
 	return
 }
 
+// DeliverConfigSnapshotWithContext is the same as DeliverConfigSnapshot with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ConfigService) DeliverConfigSnapshotWithContext(ctx context.Context, input *DeliverConfigSnapshotInput) (output *DeliverConfigSnapshotOutput, err error) {
+	req, out := c.DeliverConfigSnapshotRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeliverConfigSnapshot *aws.Operation
 
 // DescribeConfigurationRecorderStatusRequest generates a request for the DescribeConfigurationRecorderStatus operation.
@@ -122,6 +145,17 @@ func (c *ConfigService) DescribeConfigurationRecorderStatus(input *DescribeConfi
 	return
 }
 
+// DescribeConfigurationRecorderStatusWithContext is the same as DescribeConfigurationRecorderStatus with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ConfigService) DescribeConfigurationRecorderStatusWithContext(ctx context.Context, input *DescribeConfigurationRecorderStatusInput) (output *DescribeConfigurationRecorderStatusOutput, err error) {
+	req, out := c.DescribeConfigurationRecorderStatusRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeConfigurationRecorderStatus *aws.Operation
 
 // DescribeConfigurationRecordersRequest generates a request for the DescribeConfigurationRecorders operation.
@@ -157,6 +191,17 @@ func (c *ConfigService) DescribeConfigurationRecorders(input *DescribeConfigurat
 	return
 }
 
+// DescribeConfigurationRecordersWithContext is the same as DescribeConfigurationRecorders with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ConfigService) DescribeConfigurationRecordersWithContext(ctx context.Context, input *DescribeConfigurationRecordersInput) (output *DescribeConfigurationRecordersOutput, err error) {
+	req, out := c.DescribeConfigurationRecordersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeConfigurationRecorders *aws.Operation
 
 // DescribeDeliveryChannelStatusRequest generates a request for the DescribeDeliveryChannelStatus operation.
@@ -192,6 +237,17 @@ func (c *ConfigService) DescribeDeliveryChannelStatus(input *DescribeDeliveryCha
 	return
 }
 
+// DescribeDeliveryChannelStatusWithContext is the same as DescribeDeliveryChannelStatus with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ConfigService) DescribeDeliveryChannelStatusWithContext(ctx context.Context, input *DescribeDeliveryChannelStatusInput) (output *DescribeDeliveryChannelStatusOutput, err error) {
+	req, out := c.DescribeDeliveryChannelStatusRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeDeliveryChannelStatus *aws.Operation
 
 // DescribeDeliveryChannelsRequest generates a request for the DescribeDeliveryChannels operation.
@@ -227,6 +283,17 @@ func (c *ConfigService) DescribeDeliveryChannels(input *DescribeDeliveryChannels
 	return
 }
 
+// DescribeDeliveryChannelsWithContext is the same as DescribeDeliveryChannels with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ConfigService) DescribeDeliveryChannelsWithContext(ctx context.Context, input *DescribeDeliveryChannelsInput) (output *DescribeDeliveryChannelsOutput, err error) {
+	req, out := c.DescribeDeliveryChannelsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeDeliveryChannels *aws.Operation
 
 // GetResourceConfigHistoryRequest generates a request for the GetResourceConfigHistory operation.
@@ -264,6 +331,17 @@ func (c *ConfigService) GetResourceConfigHistory(input *GetResourceConfigHistory
 	return
 }
 
+// GetResourceConfigHistoryWithContext is the same as GetResourceConfigHistory with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ConfigService) GetResourceConfigHistoryWithContext(ctx context.Context, input *GetResourceConfigHistoryInput) (output *GetResourceConfigHistoryOutput, err error) {
+	req, out := c.GetResourceConfigHistoryRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetResourceConfigHistory *aws.Operation
 
 // PutConfigurationRecorderRequest generates a request for the PutConfigurationRecorder operation.
@@ -301,6 +379,17 @@ func (c *ConfigService) PutConfigurationRecorder(input *PutConfigurationRecorder
 	return
 }
 
+// PutConfigurationRecorderWithContext is the same as PutConfigurationRecorder with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ConfigService) PutConfigurationRecorderWithContext(ctx context.Context, input *PutConfigurationRecorderInput) (output *PutConfigurationRecorderOutput, err error) {
+	req, out := c.PutConfigurationRecorderRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutConfigurationRecorder *aws.Operation
 
 // PutDeliveryChannelRequest generates a request for the PutDeliveryChannel operation.
@@ -342,6 +431,17 @@ func (c *ConfigService) PutDeliveryChannel(input *PutDeliveryChannelInput) (outp
 	return
 }
 
+// PutDeliveryChannelWithContext is the same as PutDeliveryChannel with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ConfigService) PutDeliveryChannelWithContext(ctx context.Context, input *PutDeliveryChannelInput) (output *PutDeliveryChannelOutput, err error) {
+	req, out := c.PutDeliveryChannelRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutDeliveryChannel *aws.Operation
 
 // StartConfigurationRecorderRequest generates a request for the StartConfigurationRecorder operation.
@@ -379,6 +479,17 @@ func (c *ConfigService) StartConfigurationRecorder(input *StartConfigurationReco
 	return
 }
 
+// StartConfigurationRecorderWithContext is the same as StartConfigurationRecorder with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ConfigService) StartConfigurationRecorderWithContext(ctx context.Context, input *StartConfigurationRecorderInput) (output *StartConfigurationRecorderOutput, err error) {
+	req, out := c.StartConfigurationRecorderRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opStartConfigurationRecorder *aws.Operation
 
 // StopConfigurationRecorderRequest generates a request for the StopConfigurationRecorder operation.
@@ -412,6 +523,17 @@ func (c *ConfigService) StopConfigurationRecorder(input *StopConfigurationRecord
 	return
 }
 
+// StopConfigurationRecorderWithContext is the same as StopConfigurationRecorder with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ConfigService) StopConfigurationRecorderWithContext(ctx context.Context, input *StopConfigurationRecorderInput) (output *StopConfigurationRecorderOutput, err error) {
+	req, out := c.StopConfigurationRecorderRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opStopConfigurationRecorder *aws.Operation
 
 // A list that contains the status of the delivery of either the snapshot or
@@ -427,7 +549,7 @@ type ConfigExportDeliveryInfo struct {
 	LastErrorMessage *string `locationName:"lastErrorMessage" type:"string"`
 
 	// Status of the last attempted delivery.
-	LastStatus *string `locationName:"lastStatus" type:"string"`
+	LastStatus *string `locationName:"lastStatus" type:"string" enum:"Success,Failure"`
 
 	// The time of the last successful delivery.
 	LastSuccessfulTime *time.Time `locationName:"lastSuccessfulTime" type:"timestamp" timestampFormat:"unix"`
@@ -449,7 +571,7 @@ type ConfigStreamDeliveryInfo struct {
 	LastErrorMessage *string `locationName:"lastErrorMessage" type:"string"`
 
 	// Status of the last attempted delivery.
-	LastStatus *string `locationName:"lastStatus" type:"string"`
+	LastStatus *string `locationName:"lastStatus" type:"string" enum:"Success,Failure"`
 
 	// The time from the last status change.
 	LastStatusChangeTime *time.Time `locationName:"lastStatusChangeTime" type:"timestamp" timestampFormat:"unix"`
@@ -485,7 +607,7 @@ type ConfigurationItem struct {
 	ConfigurationItemMD5Hash *string `locationName:"configurationItemMD5Hash" type:"string"`
 
 	// The configuration item status.
-	ConfigurationItemStatus *string `locationName:"configurationItemStatus" type:"string"`
+	ConfigurationItemStatus *string `locationName:"configurationItemStatus" type:"string" enum:"Ok,Failed,Discovered,Deleted"`
 
 	// An identifier that indicates the ordering of the configuration items of a
 	// resource.
@@ -511,7 +633,7 @@ type ConfigurationItem struct {
 	ResourceID *string `locationName:"resourceId" type:"string"`
 
 	// The type of AWS resource.
-	ResourceType *string `locationName:"resourceType" type:"string"`
+	ResourceType *string `locationName:"resourceType" type:"string" enum:"AWS::EC2::CustomerGateway,AWS::EC2::EIP,AWS::EC2::Instance,AWS::EC2::InternetGateway,AWS::EC2::NetworkAcl,AWS::EC2::NetworkInterface,AWS::EC2::RouteTable,AWS::EC2::SecurityGroup,AWS::EC2::Subnet,AWS::CloudTrail::Trail,AWS::EC2::Volume,AWS::EC2::VPC,AWS::EC2::VPNConnection,AWS::EC2::VPNGateway"`
 
 	// A mapping of key value tags associated with the resource.
 	Tags *map[string]*string `locationName:"tags" type:"map"`
@@ -532,7 +654,7 @@ type ConfigurationRecorder struct {
 	// The name of the recorder. By default, AWS Config automatically assigns the
 	// name "default" when creating the configuration recorder. You cannot change
 	// the assigned name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256"`
 
 	// Amazon Resource Name (ARN) of the IAM role used to describe the AWS resources
 	// associated with the account.
@@ -557,7 +679,7 @@ type ConfigurationRecorderStatus struct {
 	LastStartTime *time.Time `locationName:"lastStartTime" type:"timestamp" timestampFormat:"unix"`
 
 	// The last (previous) status of the recorder.
-	LastStatus *string `locationName:"lastStatus" type:"string"`
+	LastStatus *string `locationName:"lastStatus" type:"string" enum:"Pending,Success,Failure"`
 
 	// The time when the status was last changed.
 	LastStatusChangeTime *time.Time `locationName:"lastStatusChangeTime" type:"timestamp" timestampFormat:"unix"`
@@ -582,7 +704,7 @@ type metadataConfigurationRecorderStatus struct {
 // data in JSON format.
 type DeleteDeliveryChannelInput struct {
 	// The name of the delivery channel to delete.
-	DeliveryChannelName *string `type:"string" required:"true"`
+	DeliveryChannelName *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeleteDeliveryChannelInput `json:"-", xml:"-"`
 }
@@ -602,7 +724,7 @@ type metadataDeleteDeliveryChannelOutput struct {
 // The input for the DeliverConfigSnapshot action.
 type DeliverConfigSnapshotInput struct {
 	// The name of the delivery channel through which the snapshot is delivered.
-	DeliveryChannelName *string `locationName:"deliveryChannelName" type:"string" required:"true"`
+	DeliveryChannelName *string `locationName:"deliveryChannelName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeliverConfigSnapshotInput `json:"-", xml:"-"`
 }
@@ -629,7 +751,7 @@ type DeliveryChannel struct {
 	// The name of the delivery channel. By default, AWS Config automatically assigns
 	// the name "default" when creating the delivery channel. You cannot change
 	// the assigned name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256"`
 
 	// The name of the Amazon S3 bucket used to store configuration history for
 	// the delivery channel.
@@ -777,7 +899,7 @@ type metadataDescribeDeliveryChannelsOutput struct {
 type GetResourceConfigHistoryInput struct {
 	// The chronological order for configuration items listed. By default the results
 	// are listed in reverse chronological order.
-	ChronologicalOrder *string `locationName:"chronologicalOrder" type:"string"`
+	ChronologicalOrder *string `locationName:"chronologicalOrder" type:"string" enum:"Reverse,Forward"`
 
 	// The time stamp that indicates an earlier time. If not specified, the action
 	// returns paginated results that contain configuration items that start from
@@ -790,7 +912,7 @@ type GetResourceConfigHistoryInput struct {
 
 	// The maximum number of configuration items returned in each page. The default
 	// is 10. You cannot specify a limit greater than 100.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"0" max:"100"`
 
 	// An optional parameter used for pagination of the results.
 	NextToken *string `locationName:"nextToken" type:"string"`
@@ -799,7 +921,7 @@ type GetResourceConfigHistoryInput struct {
 	ResourceID *string `locationName:"resourceId" type:"string" required:"true"`
 
 	// The resource type.
-	ResourceType *string `locationName:"resourceType" type:"string" required:"true"`
+	ResourceType *string `locationName:"resourceType" type:"string" enum:"AWS::EC2::CustomerGateway,AWS::EC2::EIP,AWS::EC2::Instance,AWS::EC2::InternetGateway,AWS::EC2::NetworkAcl,AWS::EC2::NetworkInterface,AWS::EC2::RouteTable,AWS::EC2::SecurityGroup,AWS::EC2::Subnet,AWS::CloudTrail::Trail,AWS::EC2::Volume,AWS::EC2::VPC,AWS::EC2::VPNConnection,AWS::EC2::VPNGateway" required:"true"`
 
 	metadataGetResourceConfigHistoryInput `json:"-", xml:"-"`
 }
@@ -874,7 +996,7 @@ type Relationship struct {
 	ResourceID *string `locationName:"resourceId" type:"string"`
 
 	// The resource type of the related resource.
-	ResourceType *string `locationName:"resourceType" type:"string"`
+	ResourceType *string `locationName:"resourceType" type:"string" enum:"AWS::EC2::CustomerGateway,AWS::EC2::EIP,AWS::EC2::Instance,AWS::EC2::InternetGateway,AWS::EC2::NetworkAcl,AWS::EC2::NetworkInterface,AWS::EC2::RouteTable,AWS::EC2::SecurityGroup,AWS::EC2::Subnet,AWS::CloudTrail::Trail,AWS::EC2::Volume,AWS::EC2::VPC,AWS::EC2::VPNConnection,AWS::EC2::VPNGateway"`
 
 	metadataRelationship `json:"-", xml:"-"`
 }
@@ -887,7 +1009,7 @@ type metadataRelationship struct {
 type StartConfigurationRecorderInput struct {
 	// The name of the recorder object that records each configuration change made
 	// to the resources.
-	ConfigurationRecorderName *string `type:"string" required:"true"`
+	ConfigurationRecorderName *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataStartConfigurationRecorderInput `json:"-", xml:"-"`
 }
@@ -908,7 +1030,7 @@ type metadataStartConfigurationRecorderOutput struct {
 type StopConfigurationRecorderInput struct {
 	// The name of the recorder object that records each configuration change made
 	// to the resources.
-	ConfigurationRecorderName *string `type:"string" required:"true"`
+	ConfigurationRecorderName *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataStopConfigurationRecorderInput `json:"-", xml:"-"`
 }
@@ -923,4 +1045,104 @@ type StopConfigurationRecorderOutput struct {
 
 type metadataStopConfigurationRecorderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for ChronologicalOrder.
+const (
+	ChronologicalOrderReverse = "Reverse"
+	ChronologicalOrderForward = "Forward"
+)
+
+// ChronologicalOrderValues returns the valid values of ChronologicalOrder.
+func ChronologicalOrderValues() []string {
+	return []string{
+		ChronologicalOrderReverse,
+		ChronologicalOrderForward,
+	}
+}
+
+// Enum values for ConfigurationItemStatus.
+const (
+	ConfigurationItemStatusOk         = "Ok"
+	ConfigurationItemStatusFailed     = "Failed"
+	ConfigurationItemStatusDiscovered = "Discovered"
+	ConfigurationItemStatusDeleted    = "Deleted"
+)
+
+// ConfigurationItemStatusValues returns the valid values of ConfigurationItemStatus.
+func ConfigurationItemStatusValues() []string {
+	return []string{
+		ConfigurationItemStatusOk,
+		ConfigurationItemStatusFailed,
+		ConfigurationItemStatusDiscovered,
+		ConfigurationItemStatusDeleted,
+	}
+}
+
+// Enum values for DeliveryStatus.
+const (
+	DeliveryStatusSuccess = "Success"
+	DeliveryStatusFailure = "Failure"
+)
+
+// DeliveryStatusValues returns the valid values of DeliveryStatus.
+func DeliveryStatusValues() []string {
+	return []string{
+		DeliveryStatusSuccess,
+		DeliveryStatusFailure,
+	}
+}
+
+// Enum values for RecorderStatus.
+const (
+	RecorderStatusPending = "Pending"
+	RecorderStatusSuccess = "Success"
+	RecorderStatusFailure = "Failure"
+)
+
+// RecorderStatusValues returns the valid values of RecorderStatus.
+func RecorderStatusValues() []string {
+	return []string{
+		RecorderStatusPending,
+		RecorderStatusSuccess,
+		RecorderStatusFailure,
+	}
+}
+
+// Enum values for ResourceType.
+const (
+	ResourceTypeAWSEC2CustomerGateway  = "AWS::EC2::CustomerGateway"
+	ResourceTypeAwsEc2Eip              = "AWS::EC2::EIP"
+	ResourceTypeAWSEC2Instance         = "AWS::EC2::Instance"
+	ResourceTypeAWSEC2InternetGateway  = "AWS::EC2::InternetGateway"
+	ResourceTypeAWSEC2NetworkACL       = "AWS::EC2::NetworkAcl"
+	ResourceTypeAWSEC2NetworkInterface = "AWS::EC2::NetworkInterface"
+	ResourceTypeAWSEC2RouteTable       = "AWS::EC2::RouteTable"
+	ResourceTypeAWSEC2SecurityGroup    = "AWS::EC2::SecurityGroup"
+	ResourceTypeAWSEC2Subnet           = "AWS::EC2::Subnet"
+	ResourceTypeAWSCloudTrailTrail     = "AWS::CloudTrail::Trail"
+	ResourceTypeAWSEC2Volume           = "AWS::EC2::Volume"
+	ResourceTypeAWSEC2VPC              = "AWS::EC2::VPC"
+	ResourceTypeAwsEc2VPNConnection    = "AWS::EC2::VPNConnection"
+	ResourceTypeAwsEc2VPNGateway       = "AWS::EC2::VPNGateway"
+)
+
+// ResourceTypeValues returns the valid values of ResourceType.
+func ResourceTypeValues() []string {
+	return []string{
+		ResourceTypeAWSEC2CustomerGateway,
+		ResourceTypeAwsEc2Eip,
+		ResourceTypeAWSEC2Instance,
+		ResourceTypeAWSEC2InternetGateway,
+		ResourceTypeAWSEC2NetworkACL,
+		ResourceTypeAWSEC2NetworkInterface,
+		ResourceTypeAWSEC2RouteTable,
+		ResourceTypeAWSEC2SecurityGroup,
+		ResourceTypeAWSEC2Subnet,
+		ResourceTypeAWSCloudTrailTrail,
+		ResourceTypeAWSEC2Volume,
+		ResourceTypeAWSEC2VPC,
+		ResourceTypeAwsEc2VPNConnection,
+		ResourceTypeAwsEc2VPNGateway,
+	}
 }
\ No newline at end of file
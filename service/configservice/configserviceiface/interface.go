@@ -1,29 +1,53 @@
 package configserviceiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/configservice"
 )
 
 type ConfigServiceAPI interface {
 	DeleteDeliveryChannel(*configservice.DeleteDeliveryChannelInput) (*configservice.DeleteDeliveryChannelOutput, error)
 
+	DeleteDeliveryChannelWithContext(context.Context, *configservice.DeleteDeliveryChannelInput) (*configservice.DeleteDeliveryChannelOutput, error)
+
 	DeliverConfigSnapshot(*configservice.DeliverConfigSnapshotInput) (*configservice.DeliverConfigSnapshotOutput, error)
 
+	DeliverConfigSnapshotWithContext(context.Context, *configservice.DeliverConfigSnapshotInput) (*configservice.DeliverConfigSnapshotOutput, error)
+
 	DescribeConfigurationRecorderStatus(*configservice.DescribeConfigurationRecorderStatusInput) (*configservice.DescribeConfigurationRecorderStatusOutput, error)
 
+	DescribeConfigurationRecorderStatusWithContext(context.Context, *configservice.DescribeConfigurationRecorderStatusInput) (*configservice.DescribeConfigurationRecorderStatusOutput, error)
+
 	DescribeConfigurationRecorders(*configservice.DescribeConfigurationRecordersInput) (*configservice.DescribeConfigurationRecordersOutput, error)
 
+	DescribeConfigurationRecordersWithContext(context.Context, *configservice.DescribeConfigurationRecordersInput) (*configservice.DescribeConfigurationRecordersOutput, error)
+
 	DescribeDeliveryChannelStatus(*configservice.DescribeDeliveryChannelStatusInput) (*configservice.DescribeDeliveryChannelStatusOutput, error)
 
+	DescribeDeliveryChannelStatusWithContext(context.Context, *configservice.DescribeDeliveryChannelStatusInput) (*configservice.DescribeDeliveryChannelStatusOutput, error)
+
 	DescribeDeliveryChannels(*configservice.DescribeDeliveryChannelsInput) (*configservice.DescribeDeliveryChannelsOutput, error)
 
+	DescribeDeliveryChannelsWithContext(context.Context, *configservice.DescribeDeliveryChannelsInput) (*configservice.DescribeDeliveryChannelsOutput, error)
+
 	GetResourceConfigHistory(*configservice.GetResourceConfigHistoryInput) (*configservice.GetResourceConfigHistoryOutput, error)
 
+	GetResourceConfigHistoryWithContext(context.Context, *configservice.GetResourceConfigHistoryInput) (*configservice.GetResourceConfigHistoryOutput, error)
+
 	PutConfigurationRecorder(*configservice.PutConfigurationRecorderInput) (*configservice.PutConfigurationRecorderOutput, error)
 
+	PutConfigurationRecorderWithContext(context.Context, *configservice.PutConfigurationRecorderInput) (*configservice.PutConfigurationRecorderOutput, error)
+
 	PutDeliveryChannel(*configservice.PutDeliveryChannelInput) (*configservice.PutDeliveryChannelOutput, error)
 
+	PutDeliveryChannelWithContext(context.Context, *configservice.PutDeliveryChannelInput) (*configservice.PutDeliveryChannelOutput, error)
+
 	StartConfigurationRecorder(*configservice.StartConfigurationRecorderInput) (*configservice.StartConfigurationRecorderOutput, error)
 
+	StartConfigurationRecorderWithContext(context.Context, *configservice.StartConfigurationRecorderInput) (*configservice.StartConfigurationRecorderOutput, error)
+
 	StopConfigurationRecorder(*configservice.StopConfigurationRecorderInput) (*configservice.StopConfigurationRecorderOutput, error)
+
+	StopConfigurationRecorderWithContext(context.Context, *configservice.StopConfigurationRecorderInput) (*configservice.StopConfigurationRecorderOutput, error)
 }
\ No newline at end of file
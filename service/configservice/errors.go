@@ -0,0 +1,159 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package configservice
+
+import (
+	"github.com/datacratic/aws-sdk-go/aws"
+)
+
+// Error codes of the ConfigService errors. Errors with these codes are
+// returned as the typed error of the same name, e.g. an error with the code
+// ErrCodeFoo is a *Foo.
+const (
+	ErrCodeInsufficientDeliveryPolicyException                = "InsufficientDeliveryPolicyException"
+	ErrCodeInvalidConfigurationRecorderNameException          = "InvalidConfigurationRecorderNameException"
+	ErrCodeInvalidDeliveryChannelNameException                = "InvalidDeliveryChannelNameException"
+	ErrCodeInvalidLimitException                              = "InvalidLimitException"
+	ErrCodeInvalidNextTokenException                          = "InvalidNextTokenException"
+	ErrCodeInvalidRoleException                               = "InvalidRoleException"
+	ErrCodeInvalidS3KeyPrefixException                        = "InvalidS3KeyPrefixException"
+	ErrCodeInvalidSNSTopicARNException                        = "InvalidSNSTopicARNException"
+	ErrCodeInvalidTimeRangeException                          = "InvalidTimeRangeException"
+	ErrCodeLastDeliveryChannelDeleteFailedException           = "LastDeliveryChannelDeleteFailedException"
+	ErrCodeMaxNumberOfConfigurationRecordersExceededException = "MaxNumberOfConfigurationRecordersExceededException"
+	ErrCodeMaxNumberOfDeliveryChannelsExceededException       = "MaxNumberOfDeliveryChannelsExceededException"
+	ErrCodeNoAvailableConfigurationRecorderException          = "NoAvailableConfigurationRecorderException"
+	ErrCodeNoAvailableDeliveryChannelException                = "NoAvailableDeliveryChannelException"
+	ErrCodeNoRunningConfigurationRecorderException            = "NoRunningConfigurationRecorderException"
+	ErrCodeNoSuchBucketException                              = "NoSuchBucketException"
+	ErrCodeNoSuchConfigurationRecorderException               = "NoSuchConfigurationRecorderException"
+	ErrCodeNoSuchDeliveryChannelException                     = "NoSuchDeliveryChannelException"
+	ErrCodeResourceNotDiscoveredException                     = "ResourceNotDiscoveredException"
+	ErrCodeValidationException                                = "ValidationException"
+)
+
+// Your Amazon S3 bucket policy does not permit AWS Config to write to it.
+type InsufficientDeliveryPolicyException struct {
+	aws.APIError
+}
+
+// You have provided a configuration recorder name that is not valid.
+type InvalidConfigurationRecorderNameException struct {
+	aws.APIError
+}
+
+// The specified delivery channel name is not valid.
+type InvalidDeliveryChannelNameException struct {
+	aws.APIError
+}
+
+// You have reached the limit on the pagination.
+type InvalidLimitException struct {
+	aws.APIError
+}
+
+// The specified nextToken for pagination is not valid.
+type InvalidNextTokenException struct {
+	aws.APIError
+}
+
+// You have provided a null or empty role ARN.
+type InvalidRoleException struct {
+	aws.APIError
+}
+
+// The specified Amazon S3 key prefix is not valid.
+type InvalidS3KeyPrefixException struct {
+	aws.APIError
+}
+
+// The specified Amazon SNS topic does not exist.
+type InvalidSNSTopicARNException struct {
+	aws.APIError
+}
+
+// The specified time range is not valid. The earlier time is not chronologically
+// before the later time.
+type InvalidTimeRangeException struct {
+	aws.APIError
+}
+
+// You cannot delete the delivery channel you specified because the configuration
+// recorder is running.
+type LastDeliveryChannelDeleteFailedException struct {
+	aws.APIError
+}
+
+// You have reached the limit on the number of recorders you can create.
+type MaxNumberOfConfigurationRecordersExceededException struct {
+	aws.APIError
+}
+
+// You have reached the limit on the number of delivery channels you can create.
+type MaxNumberOfDeliveryChannelsExceededException struct {
+	aws.APIError
+}
+
+// There are no configuration recorders available to provide the role needed
+// to describe your resources.
+type NoAvailableConfigurationRecorderException struct {
+	aws.APIError
+}
+
+// There is no delivery channel available to record configurations.
+type NoAvailableDeliveryChannelException struct {
+	aws.APIError
+}
+
+// There is no configuration recorder running.
+type NoRunningConfigurationRecorderException struct {
+	aws.APIError
+}
+
+// The specified Amazon S3 bucket does not exist.
+type NoSuchBucketException struct {
+	aws.APIError
+}
+
+// You have specified a configuration recorder that does not exist.
+type NoSuchConfigurationRecorderException struct {
+	aws.APIError
+}
+
+// You have specified a delivery channel that does not exist.
+type NoSuchDeliveryChannelException struct {
+	aws.APIError
+}
+
+// You have specified a resource that is either unknown or has not been discovered.
+type ResourceNotDiscoveredException struct {
+	aws.APIError
+}
+
+// The requested action is not valid.
+type ValidationException struct {
+	aws.APIError
+}
+
+var errorTypes = map[string]func() aws.ServiceError{
+	ErrCodeInsufficientDeliveryPolicyException:                func() aws.ServiceError { return &InsufficientDeliveryPolicyException{} },
+	ErrCodeInvalidConfigurationRecorderNameException:          func() aws.ServiceError { return &InvalidConfigurationRecorderNameException{} },
+	ErrCodeInvalidDeliveryChannelNameException:                func() aws.ServiceError { return &InvalidDeliveryChannelNameException{} },
+	ErrCodeInvalidLimitException:                              func() aws.ServiceError { return &InvalidLimitException{} },
+	ErrCodeInvalidNextTokenException:                          func() aws.ServiceError { return &InvalidNextTokenException{} },
+	ErrCodeInvalidRoleException:                               func() aws.ServiceError { return &InvalidRoleException{} },
+	ErrCodeInvalidS3KeyPrefixException:                        func() aws.ServiceError { return &InvalidS3KeyPrefixException{} },
+	ErrCodeInvalidSNSTopicARNException:                        func() aws.ServiceError { return &InvalidSNSTopicARNException{} },
+	ErrCodeInvalidTimeRangeException:                          func() aws.ServiceError { return &InvalidTimeRangeException{} },
+	ErrCodeLastDeliveryChannelDeleteFailedException:           func() aws.ServiceError { return &LastDeliveryChannelDeleteFailedException{} },
+	ErrCodeMaxNumberOfConfigurationRecordersExceededException: func() aws.ServiceError { return &MaxNumberOfConfigurationRecordersExceededException{} },
+	ErrCodeMaxNumberOfDeliveryChannelsExceededException:       func() aws.ServiceError { return &MaxNumberOfDeliveryChannelsExceededException{} },
+	ErrCodeNoAvailableConfigurationRecorderException:          func() aws.ServiceError { return &NoAvailableConfigurationRecorderException{} },
+	ErrCodeNoAvailableDeliveryChannelException:                func() aws.ServiceError { return &NoAvailableDeliveryChannelException{} },
+	ErrCodeNoRunningConfigurationRecorderException:            func() aws.ServiceError { return &NoRunningConfigurationRecorderException{} },
+	ErrCodeNoSuchBucketException:                              func() aws.ServiceError { return &NoSuchBucketException{} },
+	ErrCodeNoSuchConfigurationRecorderException:               func() aws.ServiceError { return &NoSuchConfigurationRecorderException{} },
+	ErrCodeNoSuchDeliveryChannelException:                     func() aws.ServiceError { return &NoSuchDeliveryChannelException{} },
+	ErrCodeResourceNotDiscoveredException:                     func() aws.ServiceError { return &ResourceNotDiscoveredException{} },
+	ErrCodeValidationException:                                func() aws.ServiceError { return &ValidationException{} },
+}
@@ -29,6 +29,7 @@ func New(config *aws.Config) *ConfigService {
 		APIVersion:   "2014-11-12",
 		JSONVersion:  "1.1",
 		TargetPrefix: "StarlingDoveService",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
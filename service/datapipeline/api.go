@@ -4,6 +4,7 @@
 package datapipeline
 
 import (
+	"context"
 	"sync"
 
 	"github.com/datacratic/aws-sdk-go/aws"
@@ -48,6 +49,17 @@ func (c *DataPipeline) ActivatePipeline(input *ActivatePipelineInput) (output *A
 	return
 }
 
+// ActivatePipelineWithContext is the same as ActivatePipeline with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) ActivatePipelineWithContext(ctx context.Context, input *ActivatePipelineInput) (output *ActivatePipelineOutput, err error) {
+	req, out := c.ActivatePipelineRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opActivatePipeline *aws.Operation
 
 // AddTagsRequest generates a request for the AddTags operation.
@@ -81,6 +93,17 @@ func (c *DataPipeline) AddTags(input *AddTagsInput) (output *AddTagsOutput, err
 	return
 }
 
+// AddTagsWithContext is the same as AddTags with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) AddTagsWithContext(ctx context.Context, input *AddTagsInput) (output *AddTagsOutput, err error) {
+	req, out := c.AddTagsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddTags *aws.Operation
 
 // CreatePipelineRequest generates a request for the CreatePipeline operation.
@@ -115,6 +138,17 @@ func (c *DataPipeline) CreatePipeline(input *CreatePipelineInput) (output *Creat
 	return
 }
 
+// CreatePipelineWithContext is the same as CreatePipeline with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) CreatePipelineWithContext(ctx context.Context, input *CreatePipelineInput) (output *CreatePipelineOutput, err error) {
+	req, out := c.CreatePipelineRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreatePipeline *aws.Operation
 
 // DeletePipelineRequest generates a request for the DeletePipeline operation.
@@ -155,6 +189,17 @@ func (c *DataPipeline) DeletePipeline(input *DeletePipelineInput) (output *Delet
 	return
 }
 
+// DeletePipelineWithContext is the same as DeletePipeline with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) DeletePipelineWithContext(ctx context.Context, input *DeletePipelineInput) (output *DeletePipelineOutput, err error) {
+	req, out := c.DeletePipelineRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeletePipeline *aws.Operation
 
 // DescribeObjectsRequest generates a request for the DescribeObjects operation.
@@ -167,6 +212,12 @@ func (c *DataPipeline) DescribeObjectsRequest(input *DescribeObjectsInput) (req
 			Name:       "DescribeObjects",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "",
+				TruncationToken: "HasMoreResults",
+			},
 		}
 	}
 
@@ -190,6 +241,27 @@ func (c *DataPipeline) DescribeObjects(input *DescribeObjectsInput) (output *Des
 	return
 }
 
+// DescribeObjectsWithContext is the same as DescribeObjects with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) DescribeObjectsWithContext(ctx context.Context, input *DescribeObjectsInput) (output *DescribeObjectsOutput, err error) {
+	req, out := c.DescribeObjectsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeObjectsPages iterates over the pages of a DescribeObjects operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *DataPipeline) DescribeObjectsPages(input *DescribeObjectsInput, fn func(p *DescribeObjectsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeObjectsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeObjectsOutput), lastPage)
+	})
+}
+
 var opDescribeObjects *aws.Operation
 
 // DescribePipelinesRequest generates a request for the DescribePipelines operation.
@@ -231,6 +303,17 @@ func (c *DataPipeline) DescribePipelines(input *DescribePipelinesInput) (output
 	return
 }
 
+// DescribePipelinesWithContext is the same as DescribePipelines with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) DescribePipelinesWithContext(ctx context.Context, input *DescribePipelinesInput) (output *DescribePipelinesOutput, err error) {
+	req, out := c.DescribePipelinesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribePipelines *aws.Operation
 
 // EvaluateExpressionRequest generates a request for the EvaluateExpression operation.
@@ -265,6 +348,17 @@ func (c *DataPipeline) EvaluateExpression(input *EvaluateExpressionInput) (outpu
 	return
 }
 
+// EvaluateExpressionWithContext is the same as EvaluateExpression with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) EvaluateExpressionWithContext(ctx context.Context, input *EvaluateExpressionInput) (output *EvaluateExpressionOutput, err error) {
+	req, out := c.EvaluateExpressionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEvaluateExpression *aws.Operation
 
 // GetPipelineDefinitionRequest generates a request for the GetPipelineDefinition operation.
@@ -299,6 +393,17 @@ func (c *DataPipeline) GetPipelineDefinition(input *GetPipelineDefinitionInput)
 	return
 }
 
+// GetPipelineDefinitionWithContext is the same as GetPipelineDefinition with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) GetPipelineDefinitionWithContext(ctx context.Context, input *GetPipelineDefinitionInput) (output *GetPipelineDefinitionOutput, err error) {
+	req, out := c.GetPipelineDefinitionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetPipelineDefinition *aws.Operation
 
 // ListPipelinesRequest generates a request for the ListPipelines operation.
@@ -311,6 +416,12 @@ func (c *DataPipeline) ListPipelinesRequest(input *ListPipelinesInput) (req *aws
 			Name:       "ListPipelines",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "",
+				TruncationToken: "HasMoreResults",
+			},
 		}
 	}
 
@@ -333,6 +444,27 @@ func (c *DataPipeline) ListPipelines(input *ListPipelinesInput) (output *ListPip
 	return
 }
 
+// ListPipelinesWithContext is the same as ListPipelines with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) ListPipelinesWithContext(ctx context.Context, input *ListPipelinesInput) (output *ListPipelinesOutput, err error) {
+	req, out := c.ListPipelinesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListPipelinesPages iterates over the pages of a ListPipelines operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *DataPipeline) ListPipelinesPages(input *ListPipelinesInput, fn func(p *ListPipelinesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListPipelinesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListPipelinesOutput), lastPage)
+	})
+}
+
 var opListPipelines *aws.Operation
 
 // PollForTaskRequest generates a request for the PollForTask operation.
@@ -379,6 +511,17 @@ func (c *DataPipeline) PollForTask(input *PollForTaskInput) (output *PollForTask
 	return
 }
 
+// PollForTaskWithContext is the same as PollForTask with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) PollForTaskWithContext(ctx context.Context, input *PollForTaskInput) (output *PollForTaskOutput, err error) {
+	req, out := c.PollForTaskRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPollForTask *aws.Operation
 
 // PutPipelineDefinitionRequest generates a request for the PutPipelineDefinition operation.
@@ -423,6 +566,17 @@ func (c *DataPipeline) PutPipelineDefinition(input *PutPipelineDefinitionInput)
 	return
 }
 
+// PutPipelineDefinitionWithContext is the same as PutPipelineDefinition with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) PutPipelineDefinitionWithContext(ctx context.Context, input *PutPipelineDefinitionInput) (output *PutPipelineDefinitionOutput, err error) {
+	req, out := c.PutPipelineDefinitionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutPipelineDefinition *aws.Operation
 
 // QueryObjectsRequest generates a request for the QueryObjects operation.
@@ -435,6 +589,12 @@ func (c *DataPipeline) QueryObjectsRequest(input *QueryObjectsInput) (req *aws.R
 			Name:       "QueryObjects",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "Limit",
+				TruncationToken: "HasMoreResults",
+			},
 		}
 	}
 
@@ -463,6 +623,27 @@ func (c *DataPipeline) QueryObjects(input *QueryObjectsInput) (output *QueryObje
 	return
 }
 
+// QueryObjectsWithContext is the same as QueryObjects with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) QueryObjectsWithContext(ctx context.Context, input *QueryObjectsInput) (output *QueryObjectsOutput, err error) {
+	req, out := c.QueryObjectsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// QueryObjectsPages iterates over the pages of a QueryObjects operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *DataPipeline) QueryObjectsPages(input *QueryObjectsInput, fn func(p *QueryObjectsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.QueryObjectsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*QueryObjectsOutput), lastPage)
+	})
+}
+
 var opQueryObjects *aws.Operation
 
 // RemoveTagsRequest generates a request for the RemoveTags operation.
@@ -496,6 +677,17 @@ func (c *DataPipeline) RemoveTags(input *RemoveTagsInput) (output *RemoveTagsOut
 	return
 }
 
+// RemoveTagsWithContext is the same as RemoveTags with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) RemoveTagsWithContext(ctx context.Context, input *RemoveTagsInput) (output *RemoveTagsOutput, err error) {
+	req, out := c.RemoveTagsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRemoveTags *aws.Operation
 
 // ReportTaskProgressRequest generates a request for the ReportTaskProgress operation.
@@ -540,6 +732,17 @@ func (c *DataPipeline) ReportTaskProgress(input *ReportTaskProgressInput) (outpu
 	return
 }
 
+// ReportTaskProgressWithContext is the same as ReportTaskProgress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) ReportTaskProgressWithContext(ctx context.Context, input *ReportTaskProgressInput) (output *ReportTaskProgressOutput, err error) {
+	req, out := c.ReportTaskProgressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opReportTaskProgress *aws.Operation
 
 // ReportTaskRunnerHeartbeatRequest generates a request for the ReportTaskRunnerHeartbeat operation.
@@ -577,6 +780,17 @@ func (c *DataPipeline) ReportTaskRunnerHeartbeat(input *ReportTaskRunnerHeartbea
 	return
 }
 
+// ReportTaskRunnerHeartbeatWithContext is the same as ReportTaskRunnerHeartbeat with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) ReportTaskRunnerHeartbeatWithContext(ctx context.Context, input *ReportTaskRunnerHeartbeatInput) (output *ReportTaskRunnerHeartbeatOutput, err error) {
+	req, out := c.ReportTaskRunnerHeartbeatRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opReportTaskRunnerHeartbeat *aws.Operation
 
 // SetStatusRequest generates a request for the SetStatus operation.
@@ -614,6 +828,17 @@ func (c *DataPipeline) SetStatus(input *SetStatusInput) (output *SetStatusOutput
 	return
 }
 
+// SetStatusWithContext is the same as SetStatus with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) SetStatusWithContext(ctx context.Context, input *SetStatusInput) (output *SetStatusOutput, err error) {
+	req, out := c.SetStatusRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetStatus *aws.Operation
 
 // SetTaskStatusRequest generates a request for the SetTaskStatus operation.
@@ -650,6 +875,17 @@ func (c *DataPipeline) SetTaskStatus(input *SetTaskStatusInput) (output *SetTask
 	return
 }
 
+// SetTaskStatusWithContext is the same as SetTaskStatus with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) SetTaskStatusWithContext(ctx context.Context, input *SetTaskStatusInput) (output *SetTaskStatusOutput, err error) {
+	req, out := c.SetTaskStatusRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetTaskStatus *aws.Operation
 
 // ValidatePipelineDefinitionRequest generates a request for the ValidatePipelineDefinition operation.
@@ -684,6 +920,17 @@ func (c *DataPipeline) ValidatePipelineDefinition(input *ValidatePipelineDefinit
 	return
 }
 
+// ValidatePipelineDefinitionWithContext is the same as ValidatePipelineDefinition with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DataPipeline) ValidatePipelineDefinitionWithContext(ctx context.Context, input *ValidatePipelineDefinitionInput) (output *ValidatePipelineDefinitionOutput, err error) {
+	req, out := c.ValidatePipelineDefinitionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opValidatePipelineDefinition *aws.Operation
 
 // The input of the ActivatePipeline action.
@@ -692,7 +939,7 @@ type ActivatePipelineInput struct {
 	ParameterValues []*ParameterValue `locationName:"parameterValues" type:"list"`
 
 	// The identifier of the pipeline to activate.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataActivatePipelineInput `json:"-", xml:"-"`
 }
@@ -713,10 +960,10 @@ type metadataActivatePipelineOutput struct {
 // The input to the AddTags action.
 type AddTagsInput struct {
 	// The identifier of the pipeline to which you want to add the tags.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The tags as key/value pairs to add to the pipeline.
-	Tags []*Tag `locationName:"tags" type:"list" required:"true"`
+	Tags []*Tag `locationName:"tags" type:"list" min:"0" max:"10" required:"true"`
 
 	metadataAddTagsInput `json:"-", xml:"-"`
 }
@@ -737,18 +984,18 @@ type metadataAddTagsOutput struct {
 // The input for the CreatePipeline action.
 type CreatePipelineInput struct {
 	// The description of the new pipeline.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" min:"0" max:"1024"`
 
 	// The name of the new pipeline. You can use the same name for multiple pipelines
 	// associated with your AWS account, because AWS Data Pipeline assigns each
 	// new pipeline a unique pipeline identifier.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"1024" required:"true"`
 
 	// A list of tags to associate with a pipeline at creation time. Tags let you
 	// control access to pipelines. For more information, see Controlling User Access
 	// to Pipelines (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-control-access.html)
 	// in the AWS Data Pipeline Developer Guide.
-	Tags []*Tag `locationName:"tags" type:"list"`
+	Tags []*Tag `locationName:"tags" type:"list" min:"0" max:"10"`
 
 	// A unique identifier that you specify. This identifier is not the same as
 	// the pipeline identifier assigned by AWS Data Pipeline. You are responsible
@@ -761,7 +1008,7 @@ type CreatePipelineInput struct {
 	// will not be created. Instead, you'll receive the pipeline identifier from
 	// the previous attempt. The uniqueness of the name and unique identifier combination
 	// is scoped to the AWS account or IAM user credentials.
-	UniqueID *string `locationName:"uniqueId" type:"string" required:"true"`
+	UniqueID *string `locationName:"uniqueId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataCreatePipelineInput `json:"-", xml:"-"`
 }
@@ -774,7 +1021,7 @@ type metadataCreatePipelineInput struct {
 type CreatePipelineOutput struct {
 	// The ID that AWS Data Pipeline assigns the newly created pipeline. The ID
 	// is a string of the form: df-06372391ZG65EXAMPLE.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataCreatePipelineOutput `json:"-", xml:"-"`
 }
@@ -786,7 +1033,7 @@ type metadataCreatePipelineOutput struct {
 // The input for the DeletePipeline action.
 type DeletePipelineInput struct {
 	// The identifier of the pipeline to be deleted.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataDeletePipelineInput `json:"-", xml:"-"`
 }
@@ -815,14 +1062,14 @@ type DescribeObjectsInput struct {
 	// DescribeObjects, this value should be empty. As long as the action returns
 	// HasMoreResults as True, you can call DescribeObjects again and pass the marker
 	// value from the response to retrieve the next set of results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	// Identifiers of the pipeline objects that contain the definitions to be described.
 	// You can pass as many as 25 identifiers in a single call to DescribeObjects.
 	ObjectIDs []*string `locationName:"objectIds" type:"list" required:"true"`
 
 	// Identifier of the pipeline that contains the object definitions.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataDescribeObjectsInput `json:"-", xml:"-"`
 }
@@ -838,7 +1085,7 @@ type DescribeObjectsOutput struct {
 
 	// The starting point for the next page of results. To view the next page of
 	// results, call DescribeObjects again with this marker value.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	// An array of object definitions that are returned by the call to DescribeObjects.
 	PipelineObjects []*PipelineObject `locationName:"pipelineObjects" type:"list" required:"true"`
@@ -879,13 +1126,13 @@ type metadataDescribePipelinesOutput struct {
 // The input for the EvaluateExpression action.
 type EvaluateExpressionInput struct {
 	// The expression to evaluate.
-	Expression *string `locationName:"expression" type:"string" required:"true"`
+	Expression *string `locationName:"expression" type:"string" min:"0" max:"20971520" required:"true"`
 
 	// The identifier of the object.
-	ObjectID *string `locationName:"objectId" type:"string" required:"true"`
+	ObjectID *string `locationName:"objectId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The identifier of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataEvaluateExpressionInput `json:"-", xml:"-"`
 }
@@ -897,7 +1144,7 @@ type metadataEvaluateExpressionInput struct {
 // Contains the output from the EvaluateExpression action.
 type EvaluateExpressionOutput struct {
 	// The evaluated expression.
-	EvaluatedExpression *string `locationName:"evaluatedExpression" type:"string" required:"true"`
+	EvaluatedExpression *string `locationName:"evaluatedExpression" type:"string" min:"0" max:"20971520" required:"true"`
 
 	metadataEvaluateExpressionOutput `json:"-", xml:"-"`
 }
@@ -911,13 +1158,13 @@ type metadataEvaluateExpressionOutput struct {
 // object (RefValue) but not as both.
 type Field struct {
 	// The field identifier.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	Key *string `locationName:"key" type:"string" min:"1" max:"256" required:"true"`
 
 	// The field value, expressed as the identifier of another object.
-	RefValue *string `locationName:"refValue" type:"string"`
+	RefValue *string `locationName:"refValue" type:"string" min:"1" max:"256"`
 
 	// The field value, expressed as a String.
-	StringValue *string `locationName:"stringValue" type:"string"`
+	StringValue *string `locationName:"stringValue" type:"string" min:"0" max:"10240"`
 
 	metadataField `json:"-", xml:"-"`
 }
@@ -929,13 +1176,13 @@ type metadataField struct {
 // The input for the GetPipelineDefinition action.
 type GetPipelineDefinitionInput struct {
 	// The identifier of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The version of the pipeline definition to retrieve. This parameter accepts
 	// the values latest (default) and active. Where latest indicates the last definition
 	// saved to the pipeline and active indicates the last definition of the pipeline
 	// that was activated.
-	Version *string `locationName:"version" type:"string"`
+	Version *string `locationName:"version" type:"string" min:"0" max:"1024"`
 
 	metadataGetPipelineDefinitionInput `json:"-", xml:"-"`
 }
@@ -972,11 +1219,11 @@ type InstanceIdentity struct {
 	// A description of an Amazon EC2 instance that is generated when the instance
 	// is launched and exposed to the instance via the instance metadata service
 	// in the form of a JSON representation of an object.
-	Document *string `locationName:"document" type:"string"`
+	Document *string `locationName:"document" type:"string" min:"0" max:"1024"`
 
 	// A signature which can be used to verify the accuracy and authenticity of
 	// the information provided in the instance identity document.
-	Signature *string `locationName:"signature" type:"string"`
+	Signature *string `locationName:"signature" type:"string" min:"0" max:"1024"`
 
 	metadataInstanceIdentity `json:"-", xml:"-"`
 }
@@ -991,7 +1238,7 @@ type ListPipelinesInput struct {
 	// ListPipelines, this value should be empty. As long as the action returns
 	// HasMoreResults as True, you can call ListPipelines again and pass the marker
 	// value from the response to retrieve the next set of results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	metadataListPipelinesInput `json:"-", xml:"-"`
 }
@@ -1009,7 +1256,7 @@ type ListPipelinesOutput struct {
 	// If not null, indicates the starting point for the set of pipeline identifiers
 	// that the next call to ListPipelines will retrieve. If null, there are no
 	// more pipeline identifiers.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	// A list of all the pipeline identifiers that your account has permission to
 	// access. If you require additional information about the pipelines, you can
@@ -1044,7 +1291,7 @@ type Operator struct {
 	// only alpha-numeric values, as symbols may be reserved by AWS Data Pipeline.
 	// User-defined fields that you add to a pipeline should prefix their name with
 	// the string "my".
-	Type *string `locationName:"type" type:"string"`
+	Type *string `locationName:"type" type:"string" enum:"EQ,REF_EQ,LE,GE,BETWEEN"`
 
 	// The value that the actual field value will be compared with.
 	Values []*string `locationName:"values" type:"list"`
@@ -1059,10 +1306,10 @@ type metadataOperator struct {
 // The attributes allowed or specified with a parameter object.
 type ParameterAttribute struct {
 	// The field identifier.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	Key *string `locationName:"key" type:"string" min:"1" max:"256" required:"true"`
 
 	// The field value, expressed as a String.
-	StringValue *string `locationName:"stringValue" type:"string" required:"true"`
+	StringValue *string `locationName:"stringValue" type:"string" min:"0" max:"10240" required:"true"`
 
 	metadataParameterAttribute `json:"-", xml:"-"`
 }
@@ -1077,7 +1324,7 @@ type ParameterObject struct {
 	Attributes []*ParameterAttribute `locationName:"attributes" type:"list" required:"true"`
 
 	// Identifier of the parameter object.
-	ID *string `locationName:"id" type:"string" required:"true"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataParameterObject `json:"-", xml:"-"`
 }
@@ -1089,10 +1336,10 @@ type metadataParameterObject struct {
 // A value or list of parameter values.
 type ParameterValue struct {
 	// Identifier of the parameter value.
-	ID *string `locationName:"id" type:"string" required:"true"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"256" required:"true"`
 
 	// The field value, expressed as a String.
-	StringValue *string `locationName:"stringValue" type:"string" required:"true"`
+	StringValue *string `locationName:"stringValue" type:"string" min:"0" max:"10240" required:"true"`
 
 	metadataParameterValue `json:"-", xml:"-"`
 }
@@ -1104,24 +1351,24 @@ type metadataParameterValue struct {
 // Contains pipeline metadata.
 type PipelineDescription struct {
 	// Description of the pipeline.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" min:"0" max:"1024"`
 
 	// A list of read-only fields that contain metadata about the pipeline: @userId,
 	// @accountId, and @pipelineState.
 	Fields []*Field `locationName:"fields" type:"list" required:"true"`
 
 	// Name of the pipeline.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The pipeline identifier that was assigned by AWS Data Pipeline. This is a
 	// string of the form df-297EG78HU43EEXAMPLE.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// A list of tags to associated with a pipeline. Tags let you control access
 	// to pipelines. For more information, see Controlling User Access to Pipelines
 	// (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-control-access.html)
 	// in the AWS Data Pipeline Developer Guide.
-	Tags []*Tag `locationName:"tags" type:"list"`
+	Tags []*Tag `locationName:"tags" type:"list" min:"0" max:"10"`
 
 	metadataPipelineDescription `json:"-", xml:"-"`
 }
@@ -1134,10 +1381,10 @@ type metadataPipelineDescription struct {
 type PipelineIDName struct {
 	// Identifier of the pipeline that was assigned by AWS Data Pipeline. This is
 	// a string of the form df-297EG78HU43EEXAMPLE.
-	ID *string `locationName:"id" type:"string"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"1024"`
 
 	// Name of the pipeline.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"1024"`
 
 	metadataPipelineIDName `json:"-", xml:"-"`
 }
@@ -1154,10 +1401,10 @@ type PipelineObject struct {
 	Fields []*Field `locationName:"fields" type:"list" required:"true"`
 
 	// Identifier of the object.
-	ID *string `locationName:"id" type:"string" required:"true"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"1024" required:"true"`
 
 	// Name of the object.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataPipelineObject `json:"-", xml:"-"`
 }
@@ -1169,7 +1416,7 @@ type metadataPipelineObject struct {
 // The data type passed in as input to the PollForTask action.
 type PollForTaskInput struct {
 	// The public DNS name of the calling task runner.
-	Hostname *string `locationName:"hostname" type:"string"`
+	Hostname *string `locationName:"hostname" type:"string" min:"1" max:"1024"`
 
 	// Identity information for the Amazon EC2 instance that is hosting the task
 	// runner. You can get this value by calling the URI, http://169.254.169.254/latest/meta-data/instance-id,
@@ -1184,7 +1431,7 @@ type PollForTaskInput struct {
 	// created. You can only specify a single value for workerGroup in the call
 	// to PollForTask. There are no wildcard values permitted in workerGroup, the
 	// string must be an exact, case-sensitive, match.
-	WorkerGroup *string `locationName:"workerGroup" type:"string" required:"true"`
+	WorkerGroup *string `locationName:"workerGroup" type:"string" min:"0" max:"1024" required:"true"`
 
 	metadataPollForTaskInput `json:"-", xml:"-"`
 }
@@ -1218,7 +1465,7 @@ type PutPipelineDefinitionInput struct {
 	ParameterValues []*ParameterValue `locationName:"parameterValues" type:"list"`
 
 	// The identifier of the pipeline to be configured.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The objects that define the pipeline. These will overwrite the existing pipeline
 	// definition.
@@ -1276,10 +1523,10 @@ type QueryObjectsInput struct {
 	// QueryObjects, this value should be empty. As long as the action returns HasMoreResults
 	// as True, you can call QueryObjects again and pass the marker value from the
 	// response to retrieve the next set of results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	// Identifier of the pipeline to be queried for object names.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// Query that defines the objects to be returned. The Query object can contain
 	// a maximum of ten selectors. The conditions in the query are limited to top-level
@@ -1289,7 +1536,7 @@ type QueryObjectsInput struct {
 
 	// Specifies whether the query applies to components or instances. Allowable
 	// values: COMPONENT, INSTANCE, ATTEMPT.
-	Sphere *string `locationName:"sphere" type:"string" required:"true"`
+	Sphere *string `locationName:"sphere" type:"string" min:"0" max:"1024" required:"true"`
 
 	metadataQueryObjectsInput `json:"-", xml:"-"`
 }
@@ -1310,7 +1557,7 @@ type QueryObjectsOutput struct {
 	// The starting point for the results to be returned. As long as the action
 	// returns HasMoreResults as True, you can call QueryObjects again and pass
 	// the marker value from the response to retrieve the next set of results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	metadataQueryObjectsOutput `json:"-", xml:"-"`
 }
@@ -1322,7 +1569,7 @@ type metadataQueryObjectsOutput struct {
 // The input to the RemoveTags action.
 type RemoveTagsInput struct {
 	// The pipeline from which you want to remove tags.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The keys of the tags you wish to remove.
 	TagKeys []*string `locationName:"tagKeys" type:"list" required:"true"`
@@ -1352,7 +1599,7 @@ type ReportTaskProgressInput struct {
 	// Identifier of the task assigned to the task runner. This value is provided
 	// in the TaskObject that the service returns with the response for the PollForTask
 	// action.
-	TaskID *string `locationName:"taskId" type:"string" required:"true"`
+	TaskID *string `locationName:"taskId" type:"string" min:"1" max:"2048" required:"true"`
 
 	metadataReportTaskProgressInput `json:"-", xml:"-"`
 }
@@ -1377,21 +1624,21 @@ type metadataReportTaskProgressOutput struct {
 // The input for the ReportTaskRunnerHeartbeat action.
 type ReportTaskRunnerHeartbeatInput struct {
 	// The public DNS name of the calling task runner.
-	Hostname *string `locationName:"hostname" type:"string"`
+	Hostname *string `locationName:"hostname" type:"string" min:"1" max:"1024"`
 
 	// The identifier of the task runner. This value should be unique across your
 	// AWS account. In the case of AWS Data Pipeline Task Runner launched on a resource
 	// managed by AWS Data Pipeline, the web service provides a unique identifier
 	// when it launches the application. If you have written a custom task runner,
 	// you should assign a unique identifier for the task runner.
-	TaskRunnerID *string `locationName:"taskrunnerId" type:"string" required:"true"`
+	TaskRunnerID *string `locationName:"taskrunnerId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// Indicates the type of task the task runner is configured to accept and process.
 	// The worker group is set as a field on objects in the pipeline when they are
 	// created. You can only specify a single value for workerGroup in the call
 	// to ReportTaskRunnerHeartbeat. There are no wildcard values permitted in workerGroup,
 	// the string must be an exact, case-sensitive, match.
-	WorkerGroup *string `locationName:"workerGroup" type:"string"`
+	WorkerGroup *string `locationName:"workerGroup" type:"string" min:"0" max:"1024"`
 
 	metadataReportTaskRunnerHeartbeatInput `json:"-", xml:"-"`
 }
@@ -1420,7 +1667,7 @@ type Selector struct {
 	// is the "key" portion of the field definition in the pipeline definition syntax
 	// that is used by the AWS Data Pipeline API. If the field is not set on the
 	// object, the condition fails.
-	FieldName *string `locationName:"fieldName" type:"string"`
+	FieldName *string `locationName:"fieldName" type:"string" min:"0" max:"1024"`
 
 	// Contains a logical operation for comparing the value of a field with a specified
 	// value.
@@ -1440,12 +1687,12 @@ type SetStatusInput struct {
 	ObjectIDs []*string `locationName:"objectIds" type:"list" required:"true"`
 
 	// Identifies the pipeline that contains the objects.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// Specifies the status to be set on all the objects in objectIds. For components,
 	// this can be either PAUSE or RESUME. For instances, this can be either TRY_CANCEL,
 	// RERUN, or MARK_FINISHED.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	Status *string `locationName:"status" type:"string" min:"0" max:"1024" required:"true"`
 
 	metadataSetStatusInput `json:"-", xml:"-"`
 }
@@ -1468,7 +1715,7 @@ type SetTaskStatusInput struct {
 	// represents the error. This value is set on the physical attempt object. It
 	// is used to display error information to the user. It should not start with
 	// string "Service_" which is reserved by the system.
-	ErrorID *string `locationName:"errorId" type:"string"`
+	ErrorID *string `locationName:"errorId" type:"string" min:"0" max:"1024"`
 
 	// If an error occurred during the task, this value specifies a text description
 	// of the error. This value is set on the physical attempt object. It is used
@@ -1480,15 +1727,15 @@ type SetTaskStatusInput struct {
 	// associated with the error. This value is set on the physical attempt object.
 	// It is used to display error information to the user. The web service does
 	// not parse this value.
-	ErrorStackTrace *string `locationName:"errorStackTrace" type:"string"`
+	ErrorStackTrace *string `locationName:"errorStackTrace" type:"string" min:"0" max:"1024"`
 
 	// Identifies the task assigned to the task runner. This value is set in the
 	// TaskObject that is returned by the PollForTask action.
-	TaskID *string `locationName:"taskId" type:"string" required:"true"`
+	TaskID *string `locationName:"taskId" type:"string" min:"1" max:"2048" required:"true"`
 
 	// If FINISHED, the task successfully completed. If FAILED the task ended unsuccessfully.
 	// The FALSE value is used by preconditions.
-	TaskStatus *string `locationName:"taskStatus" type:"string" required:"true"`
+	TaskStatus *string `locationName:"taskStatus" type:"string" enum:"FINISHED,FAILED,FALSE" required:"true"`
 
 	metadataSetTaskStatusInput `json:"-", xml:"-"`
 }
@@ -1515,12 +1762,12 @@ type Tag struct {
 	// The key name of a tag defined by a user. For more information, see Controlling
 	// User Access to Pipelines (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-control-access.html)
 	// in the AWS Data Pipeline Developer Guide.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	Key *string `locationName:"key" type:"string" min:"1" max:"128" required:"true"`
 
 	// The optional value portion of a tag defined by a user. For more information,
 	// see Controlling User Access to Pipelines (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-control-access.html)
 	// in the AWS Data Pipeline Developer Guide.
-	Value *string `locationName:"value" type:"string" required:"true"`
+	Value *string `locationName:"value" type:"string" min:"0" max:"256" required:"true"`
 
 	metadataTag `json:"-", xml:"-"`
 }
@@ -1533,18 +1780,18 @@ type metadataTag struct {
 type TaskObject struct {
 	// Identifier of the pipeline task attempt object. AWS Data Pipeline uses this
 	// value to track how many times a task is attempted.
-	AttemptID *string `locationName:"attemptId" type:"string"`
+	AttemptID *string `locationName:"attemptId" type:"string" min:"1" max:"1024"`
 
 	// Connection information for the location where the task runner will publish
 	// the output of the task.
 	Objects *map[string]*PipelineObject `locationName:"objects" type:"map"`
 
 	// Identifier of the pipeline that provided the task.
-	PipelineID *string `locationName:"pipelineId" type:"string"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024"`
 
 	// An internal identifier for the task. This ID is passed to the SetTaskStatus
 	// and ReportTaskProgress actions.
-	TaskID *string `locationName:"taskId" type:"string"`
+	TaskID *string `locationName:"taskId" type:"string" min:"1" max:"2048"`
 
 	metadataTaskObject `json:"-", xml:"-"`
 }
@@ -1562,7 +1809,7 @@ type ValidatePipelineDefinitionInput struct {
 	ParameterValues []*ParameterValue `locationName:"parameterValues" type:"list"`
 
 	// Identifies the pipeline whose definition is to be validated.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// A list of objects that define the pipeline changes to validate against the
 	// pipeline.
@@ -1601,7 +1848,7 @@ type ValidationError struct {
 	Errors []*string `locationName:"errors" type:"list"`
 
 	// The identifier of the object that contains the validation error.
-	ID *string `locationName:"id" type:"string"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"1024"`
 
 	metadataValidationError `json:"-", xml:"-"`
 }
@@ -1615,7 +1862,7 @@ type metadataValidationError struct {
 // warnings that can be returned are defined by AWS Data Pipeline.
 type ValidationWarning struct {
 	// The identifier of the object that contains the validation warning.
-	ID *string `locationName:"id" type:"string"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"1024"`
 
 	// A description of the validation warning.
 	Warnings []*string `locationName:"warnings" type:"list"`
@@ -1625,4 +1872,40 @@ type ValidationWarning struct {
 
 type metadataValidationWarning struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for OperatorType.
+const (
+	OperatorTypeEQ      = "EQ"
+	OperatorTypeRefEQ   = "REF_EQ"
+	OperatorTypeLE      = "LE"
+	OperatorTypeGE      = "GE"
+	OperatorTypeBetween = "BETWEEN"
+)
+
+// OperatorTypeValues returns the valid values of OperatorType.
+func OperatorTypeValues() []string {
+	return []string{
+		OperatorTypeEQ,
+		OperatorTypeRefEQ,
+		OperatorTypeLE,
+		OperatorTypeGE,
+		OperatorTypeBetween,
+	}
+}
+
+// Enum values for TaskStatus.
+const (
+	TaskStatusFinished = "FINISHED"
+	TaskStatusFailed   = "FAILED"
+	TaskStatusFalse    = "FALSE"
+)
+
+// TaskStatusValues returns the valid values of TaskStatus.
+func TaskStatusValues() []string {
+	return []string{
+		TaskStatusFinished,
+		TaskStatusFailed,
+		TaskStatusFalse,
+	}
 }
\ No newline at end of file
@@ -1,43 +1,84 @@
 package datapipelineiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/datapipeline"
 )
 
 type DataPipelineAPI interface {
 	ActivatePipeline(*datapipeline.ActivatePipelineInput) (*datapipeline.ActivatePipelineOutput, error)
 
+	ActivatePipelineWithContext(context.Context, *datapipeline.ActivatePipelineInput) (*datapipeline.ActivatePipelineOutput, error)
+
 	AddTags(*datapipeline.AddTagsInput) (*datapipeline.AddTagsOutput, error)
 
+	AddTagsWithContext(context.Context, *datapipeline.AddTagsInput) (*datapipeline.AddTagsOutput, error)
+
 	CreatePipeline(*datapipeline.CreatePipelineInput) (*datapipeline.CreatePipelineOutput, error)
 
+	CreatePipelineWithContext(context.Context, *datapipeline.CreatePipelineInput) (*datapipeline.CreatePipelineOutput, error)
+
 	DeletePipeline(*datapipeline.DeletePipelineInput) (*datapipeline.DeletePipelineOutput, error)
 
+	DeletePipelineWithContext(context.Context, *datapipeline.DeletePipelineInput) (*datapipeline.DeletePipelineOutput, error)
+
 	DescribeObjects(*datapipeline.DescribeObjectsInput) (*datapipeline.DescribeObjectsOutput, error)
 
+	DescribeObjectsWithContext(context.Context, *datapipeline.DescribeObjectsInput) (*datapipeline.DescribeObjectsOutput, error)
+	DescribeObjectsPages(*datapipeline.DescribeObjectsInput, func(*datapipeline.DescribeObjectsOutput, bool) bool) error
+
 	DescribePipelines(*datapipeline.DescribePipelinesInput) (*datapipeline.DescribePipelinesOutput, error)
 
+	DescribePipelinesWithContext(context.Context, *datapipeline.DescribePipelinesInput) (*datapipeline.DescribePipelinesOutput, error)
+
 	EvaluateExpression(*datapipeline.EvaluateExpressionInput) (*datapipeline.EvaluateExpressionOutput, error)
 
+	EvaluateExpressionWithContext(context.Context, *datapipeline.EvaluateExpressionInput) (*datapipeline.EvaluateExpressionOutput, error)
+
 	GetPipelineDefinition(*datapipeline.GetPipelineDefinitionInput) (*datapipeline.GetPipelineDefinitionOutput, error)
 
+	GetPipelineDefinitionWithContext(context.Context, *datapipeline.GetPipelineDefinitionInput) (*datapipeline.GetPipelineDefinitionOutput, error)
+
 	ListPipelines(*datapipeline.ListPipelinesInput) (*datapipeline.ListPipelinesOutput, error)
 
+	ListPipelinesWithContext(context.Context, *datapipeline.ListPipelinesInput) (*datapipeline.ListPipelinesOutput, error)
+	ListPipelinesPages(*datapipeline.ListPipelinesInput, func(*datapipeline.ListPipelinesOutput, bool) bool) error
+
 	PollForTask(*datapipeline.PollForTaskInput) (*datapipeline.PollForTaskOutput, error)
 
+	PollForTaskWithContext(context.Context, *datapipeline.PollForTaskInput) (*datapipeline.PollForTaskOutput, error)
+
 	PutPipelineDefinition(*datapipeline.PutPipelineDefinitionInput) (*datapipeline.PutPipelineDefinitionOutput, error)
 
+	PutPipelineDefinitionWithContext(context.Context, *datapipeline.PutPipelineDefinitionInput) (*datapipeline.PutPipelineDefinitionOutput, error)
+
 	QueryObjects(*datapipeline.QueryObjectsInput) (*datapipeline.QueryObjectsOutput, error)
 
+	QueryObjectsWithContext(context.Context, *datapipeline.QueryObjectsInput) (*datapipeline.QueryObjectsOutput, error)
+	QueryObjectsPages(*datapipeline.QueryObjectsInput, func(*datapipeline.QueryObjectsOutput, bool) bool) error
+
 	RemoveTags(*datapipeline.RemoveTagsInput) (*datapipeline.RemoveTagsOutput, error)
 
+	RemoveTagsWithContext(context.Context, *datapipeline.RemoveTagsInput) (*datapipeline.RemoveTagsOutput, error)
+
 	ReportTaskProgress(*datapipeline.ReportTaskProgressInput) (*datapipeline.ReportTaskProgressOutput, error)
 
+	ReportTaskProgressWithContext(context.Context, *datapipeline.ReportTaskProgressInput) (*datapipeline.ReportTaskProgressOutput, error)
+
 	ReportTaskRunnerHeartbeat(*datapipeline.ReportTaskRunnerHeartbeatInput) (*datapipeline.ReportTaskRunnerHeartbeatOutput, error)
 
+	ReportTaskRunnerHeartbeatWithContext(context.Context, *datapipeline.ReportTaskRunnerHeartbeatInput) (*datapipeline.ReportTaskRunnerHeartbeatOutput, error)
+
 	SetStatus(*datapipeline.SetStatusInput) (*datapipeline.SetStatusOutput, error)
 
+	SetStatusWithContext(context.Context, *datapipeline.SetStatusInput) (*datapipeline.SetStatusOutput, error)
+
 	SetTaskStatus(*datapipeline.SetTaskStatusInput) (*datapipeline.SetTaskStatusOutput, error)
 
+	SetTaskStatusWithContext(context.Context, *datapipeline.SetTaskStatusInput) (*datapipeline.SetTaskStatusOutput, error)
+
 	ValidatePipelineDefinition(*datapipeline.ValidatePipelineDefinitionInput) (*datapipeline.ValidatePipelineDefinitionOutput, error)
+
+	ValidatePipelineDefinitionWithContext(context.Context, *datapipeline.ValidatePipelineDefinitionInput) (*datapipeline.ValidatePipelineDefinitionOutput, error)
 }
\ No newline at end of file
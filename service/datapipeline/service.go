@@ -29,6 +29,7 @@ func New(config *aws.Config) *DataPipeline {
 		APIVersion:   "2012-10-29",
 		JSONVersion:  "1.1",
 		TargetPrefix: "DataPipeline",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package directconnect
 
 import (
+	"context"
 	"sync"
 
 	"github.com/datacratic/aws-sdk-go/aws"
@@ -45,6 +46,17 @@ func (c *DirectConnect) AllocateConnectionOnInterconnect(input *AllocateConnecti
 	return
 }
 
+// AllocateConnectionOnInterconnectWithContext is the same as AllocateConnectionOnInterconnect with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) AllocateConnectionOnInterconnectWithContext(ctx context.Context, input *AllocateConnectionOnInterconnectInput) (output *Connection, err error) {
+	req, out := c.AllocateConnectionOnInterconnectRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAllocateConnectionOnInterconnect *aws.Operation
 
 // AllocatePrivateVirtualInterfaceRequest generates a request for the AllocatePrivateVirtualInterface operation.
@@ -86,6 +98,17 @@ func (c *DirectConnect) AllocatePrivateVirtualInterface(input *AllocatePrivateVi
 	return
 }
 
+// AllocatePrivateVirtualInterfaceWithContext is the same as AllocatePrivateVirtualInterface with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) AllocatePrivateVirtualInterfaceWithContext(ctx context.Context, input *AllocatePrivateVirtualInterfaceInput) (output *VirtualInterface, err error) {
+	req, out := c.AllocatePrivateVirtualInterfaceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAllocatePrivateVirtualInterface *aws.Operation
 
 // AllocatePublicVirtualInterfaceRequest generates a request for the AllocatePublicVirtualInterface operation.
@@ -127,6 +150,17 @@ func (c *DirectConnect) AllocatePublicVirtualInterface(input *AllocatePublicVirt
 	return
 }
 
+// AllocatePublicVirtualInterfaceWithContext is the same as AllocatePublicVirtualInterface with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) AllocatePublicVirtualInterfaceWithContext(ctx context.Context, input *AllocatePublicVirtualInterfaceInput) (output *VirtualInterface, err error) {
+	req, out := c.AllocatePublicVirtualInterfaceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAllocatePublicVirtualInterface *aws.Operation
 
 // ConfirmConnectionRequest generates a request for the ConfirmConnection operation.
@@ -164,6 +198,17 @@ func (c *DirectConnect) ConfirmConnection(input *ConfirmConnectionInput) (output
 	return
 }
 
+// ConfirmConnectionWithContext is the same as ConfirmConnection with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) ConfirmConnectionWithContext(ctx context.Context, input *ConfirmConnectionInput) (output *ConfirmConnectionOutput, err error) {
+	req, out := c.ConfirmConnectionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opConfirmConnection *aws.Operation
 
 // ConfirmPrivateVirtualInterfaceRequest generates a request for the ConfirmPrivateVirtualInterface operation.
@@ -201,6 +246,17 @@ func (c *DirectConnect) ConfirmPrivateVirtualInterface(input *ConfirmPrivateVirt
 	return
 }
 
+// ConfirmPrivateVirtualInterfaceWithContext is the same as ConfirmPrivateVirtualInterface with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) ConfirmPrivateVirtualInterfaceWithContext(ctx context.Context, input *ConfirmPrivateVirtualInterfaceInput) (output *ConfirmPrivateVirtualInterfaceOutput, err error) {
+	req, out := c.ConfirmPrivateVirtualInterfaceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opConfirmPrivateVirtualInterface *aws.Operation
 
 // ConfirmPublicVirtualInterfaceRequest generates a request for the ConfirmPublicVirtualInterface operation.
@@ -237,6 +293,17 @@ func (c *DirectConnect) ConfirmPublicVirtualInterface(input *ConfirmPublicVirtua
 	return
 }
 
+// ConfirmPublicVirtualInterfaceWithContext is the same as ConfirmPublicVirtualInterface with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) ConfirmPublicVirtualInterfaceWithContext(ctx context.Context, input *ConfirmPublicVirtualInterfaceInput) (output *ConfirmPublicVirtualInterfaceOutput, err error) {
+	req, out := c.ConfirmPublicVirtualInterfaceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opConfirmPublicVirtualInterface *aws.Operation
 
 // CreateConnectionRequest generates a request for the CreateConnection operation.
@@ -279,6 +346,17 @@ func (c *DirectConnect) CreateConnection(input *CreateConnectionInput) (output *
 	return
 }
 
+// CreateConnectionWithContext is the same as CreateConnection with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) CreateConnectionWithContext(ctx context.Context, input *CreateConnectionInput) (output *Connection, err error) {
+	req, out := c.CreateConnectionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateConnection *aws.Operation
 
 // CreateInterconnectRequest generates a request for the CreateInterconnect operation.
@@ -327,6 +405,17 @@ func (c *DirectConnect) CreateInterconnect(input *CreateInterconnectInput) (outp
 	return
 }
 
+// CreateInterconnectWithContext is the same as CreateInterconnect with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) CreateInterconnectWithContext(ctx context.Context, input *CreateInterconnectInput) (output *Interconnect, err error) {
+	req, out := c.CreateInterconnectRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateInterconnect *aws.Operation
 
 // CreatePrivateVirtualInterfaceRequest generates a request for the CreatePrivateVirtualInterface operation.
@@ -362,6 +451,17 @@ func (c *DirectConnect) CreatePrivateVirtualInterface(input *CreatePrivateVirtua
 	return
 }
 
+// CreatePrivateVirtualInterfaceWithContext is the same as CreatePrivateVirtualInterface with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) CreatePrivateVirtualInterfaceWithContext(ctx context.Context, input *CreatePrivateVirtualInterfaceInput) (output *VirtualInterface, err error) {
+	req, out := c.CreatePrivateVirtualInterfaceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreatePrivateVirtualInterface *aws.Operation
 
 // CreatePublicVirtualInterfaceRequest generates a request for the CreatePublicVirtualInterface operation.
@@ -398,6 +498,17 @@ func (c *DirectConnect) CreatePublicVirtualInterface(input *CreatePublicVirtualI
 	return
 }
 
+// CreatePublicVirtualInterfaceWithContext is the same as CreatePublicVirtualInterface with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) CreatePublicVirtualInterfaceWithContext(ctx context.Context, input *CreatePublicVirtualInterfaceInput) (output *VirtualInterface, err error) {
+	req, out := c.CreatePublicVirtualInterfaceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreatePublicVirtualInterface *aws.Operation
 
 // DeleteConnectionRequest generates a request for the DeleteConnection operation.
@@ -436,6 +547,17 @@ func (c *DirectConnect) DeleteConnection(input *DeleteConnectionInput) (output *
 	return
 }
 
+// DeleteConnectionWithContext is the same as DeleteConnection with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) DeleteConnectionWithContext(ctx context.Context, input *DeleteConnectionInput) (output *Connection, err error) {
+	req, out := c.DeleteConnectionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteConnection *aws.Operation
 
 // DeleteInterconnectRequest generates a request for the DeleteInterconnect operation.
@@ -469,6 +591,17 @@ func (c *DirectConnect) DeleteInterconnect(input *DeleteInterconnectInput) (outp
 	return
 }
 
+// DeleteInterconnectWithContext is the same as DeleteInterconnect with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) DeleteInterconnectWithContext(ctx context.Context, input *DeleteInterconnectInput) (output *DeleteInterconnectOutput, err error) {
+	req, out := c.DeleteInterconnectRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteInterconnect *aws.Operation
 
 // DeleteVirtualInterfaceRequest generates a request for the DeleteVirtualInterface operation.
@@ -502,6 +635,17 @@ func (c *DirectConnect) DeleteVirtualInterface(input *DeleteVirtualInterfaceInpu
 	return
 }
 
+// DeleteVirtualInterfaceWithContext is the same as DeleteVirtualInterface with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) DeleteVirtualInterfaceWithContext(ctx context.Context, input *DeleteVirtualInterfaceInput) (output *DeleteVirtualInterfaceOutput, err error) {
+	req, out := c.DeleteVirtualInterfaceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteVirtualInterface *aws.Operation
 
 // DescribeConnectionsRequest generates a request for the DescribeConnections operation.
@@ -537,6 +681,17 @@ func (c *DirectConnect) DescribeConnections(input *DescribeConnectionsInput) (ou
 	return
 }
 
+// DescribeConnectionsWithContext is the same as DescribeConnections with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) DescribeConnectionsWithContext(ctx context.Context, input *DescribeConnectionsInput) (output *Connections, err error) {
+	req, out := c.DescribeConnectionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeConnections *aws.Operation
 
 // DescribeConnectionsOnInterconnectRequest generates a request for the DescribeConnectionsOnInterconnect operation.
@@ -570,6 +725,17 @@ func (c *DirectConnect) DescribeConnectionsOnInterconnect(input *DescribeConnect
 	return
 }
 
+// DescribeConnectionsOnInterconnectWithContext is the same as DescribeConnectionsOnInterconnect with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) DescribeConnectionsOnInterconnectWithContext(ctx context.Context, input *DescribeConnectionsOnInterconnectInput) (output *Connections, err error) {
+	req, out := c.DescribeConnectionsOnInterconnectRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeConnectionsOnInterconnect *aws.Operation
 
 // DescribeInterconnectsRequest generates a request for the DescribeInterconnects operation.
@@ -605,6 +771,17 @@ func (c *DirectConnect) DescribeInterconnects(input *DescribeInterconnectsInput)
 	return
 }
 
+// DescribeInterconnectsWithContext is the same as DescribeInterconnects with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) DescribeInterconnectsWithContext(ctx context.Context, input *DescribeInterconnectsInput) (output *DescribeInterconnectsOutput, err error) {
+	req, out := c.DescribeInterconnectsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeInterconnects *aws.Operation
 
 // DescribeLocationsRequest generates a request for the DescribeLocations operation.
@@ -640,6 +817,17 @@ func (c *DirectConnect) DescribeLocations(input *DescribeLocationsInput) (output
 	return
 }
 
+// DescribeLocationsWithContext is the same as DescribeLocations with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) DescribeLocationsWithContext(ctx context.Context, input *DescribeLocationsInput) (output *DescribeLocationsOutput, err error) {
+	req, out := c.DescribeLocationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeLocations *aws.Operation
 
 // DescribeVirtualGatewaysRequest generates a request for the DescribeVirtualGateways operation.
@@ -679,6 +867,17 @@ func (c *DirectConnect) DescribeVirtualGateways(input *DescribeVirtualGatewaysIn
 	return
 }
 
+// DescribeVirtualGatewaysWithContext is the same as DescribeVirtualGateways with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) DescribeVirtualGatewaysWithContext(ctx context.Context, input *DescribeVirtualGatewaysInput) (output *DescribeVirtualGatewaysOutput, err error) {
+	req, out := c.DescribeVirtualGatewaysRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeVirtualGateways *aws.Operation
 
 // DescribeVirtualInterfacesRequest generates a request for the DescribeVirtualInterfaces operation.
@@ -723,6 +922,17 @@ func (c *DirectConnect) DescribeVirtualInterfaces(input *DescribeVirtualInterfac
 	return
 }
 
+// DescribeVirtualInterfacesWithContext is the same as DescribeVirtualInterfaces with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DirectConnect) DescribeVirtualInterfacesWithContext(ctx context.Context, input *DescribeVirtualInterfacesInput) (output *DescribeVirtualInterfacesOutput, err error) {
+	req, out := c.DescribeVirtualInterfacesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeVirtualInterfaces *aws.Operation
 
 // Container for the parameters to the AllocateConnectionOnInterconnect operation.
@@ -845,7 +1055,7 @@ type ConfirmConnectionOutput struct {
 	// for use.  Down: The network link is down.  Deleted: The connection has been
 	// deleted.  Rejected: A hosted connection in the 'Ordering' state will enter
 	// the 'Rejected' state if it is deleted by the end customer.
-	ConnectionState *string `locationName:"connectionState" type:"string"`
+	ConnectionState *string `locationName:"connectionState" type:"string" enum:"ordering,requested,pending,available,down,deleting,deleted,rejected"`
 
 	metadataConfirmConnectionOutput `json:"-", xml:"-"`
 }
@@ -897,7 +1107,7 @@ type ConfirmPrivateVirtualInterfaceOutput struct {
 	// of the virtual interface. If a virtual interface in the 'Confirming' state
 	// is deleted by the virtual interface owner, the virtual interface will enter
 	// the 'Rejected' state.
-	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string"`
+	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"confirming,verifying,pending,available,deleting,deleted,rejected"`
 
 	metadataConfirmPrivateVirtualInterfaceOutput `json:"-", xml:"-"`
 }
@@ -940,7 +1150,7 @@ type ConfirmPublicVirtualInterfaceOutput struct {
 	// of the virtual interface. If a virtual interface in the 'Confirming' state
 	// is deleted by the virtual interface owner, the virtual interface will enter
 	// the 'Rejected' state.
-	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string"`
+	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"confirming,verifying,pending,available,deleting,deleted,rejected"`
 
 	metadataConfirmPublicVirtualInterfaceOutput `json:"-", xml:"-"`
 }
@@ -983,7 +1193,7 @@ type Connection struct {
 	// for use.  Down: The network link is down.  Deleted: The connection has been
 	// deleted.  Rejected: A hosted connection in the 'Ordering' state will enter
 	// the 'Rejected' state if it is deleted by the end customer.
-	ConnectionState *string `locationName:"connectionState" type:"string"`
+	ConnectionState *string `locationName:"connectionState" type:"string" enum:"ordering,requested,pending,available,down,deleting,deleted,rejected"`
 
 	// Where the connection is located.
 	//
@@ -1169,7 +1379,7 @@ type DeleteInterconnectOutput struct {
 	// and is being initialized.  Available: The network link is up, and the interconnect
 	// is ready for use.  Down: The network link is down.  Deleted: The interconnect
 	// has been deleted.
-	InterconnectState *string `locationName:"interconnectState" type:"string"`
+	InterconnectState *string `locationName:"interconnectState" type:"string" enum:"requested,pending,available,down,deleting,deleted"`
 
 	metadataDeleteInterconnectOutput `json:"-", xml:"-"`
 }
@@ -1212,7 +1422,7 @@ type DeleteVirtualInterfaceOutput struct {
 	// of the virtual interface. If a virtual interface in the 'Confirming' state
 	// is deleted by the virtual interface owner, the virtual interface will enter
 	// the 'Rejected' state.
-	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string"`
+	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"confirming,verifying,pending,available,deleting,deleted,rejected"`
 
 	metadataDeleteVirtualInterfaceOutput `json:"-", xml:"-"`
 }
@@ -1388,7 +1598,7 @@ type Interconnect struct {
 	// and is being initialized.  Available: The network link is up, and the interconnect
 	// is ready for use.  Down: The network link is down.  Deleted: The interconnect
 	// has been deleted.
-	InterconnectState *string `locationName:"interconnectState" type:"string"`
+	InterconnectState *string `locationName:"interconnectState" type:"string" enum:"requested,pending,available,down,deleting,deleted"`
 
 	// Where the connection is located.
 	//
@@ -1727,7 +1937,7 @@ type VirtualInterface struct {
 	// of the virtual interface. If a virtual interface in the 'Confirming' state
 	// is deleted by the virtual interface owner, the virtual interface will enter
 	// the 'Rejected' state.
-	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string"`
+	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"confirming,verifying,pending,available,deleting,deleted,rejected"`
 
 	// The type of virtual interface.
 	//
@@ -1740,4 +1950,76 @@ type VirtualInterface struct {
 
 type metadataVirtualInterface struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for ConnectionState.
+const (
+	ConnectionStateOrdering  = "ordering"
+	ConnectionStateRequested = "requested"
+	ConnectionStatePending   = "pending"
+	ConnectionStateAvailable = "available"
+	ConnectionStateDown      = "down"
+	ConnectionStateDeleting  = "deleting"
+	ConnectionStateDeleted   = "deleted"
+	ConnectionStateRejected  = "rejected"
+)
+
+// ConnectionStateValues returns the valid values of ConnectionState.
+func ConnectionStateValues() []string {
+	return []string{
+		ConnectionStateOrdering,
+		ConnectionStateRequested,
+		ConnectionStatePending,
+		ConnectionStateAvailable,
+		ConnectionStateDown,
+		ConnectionStateDeleting,
+		ConnectionStateDeleted,
+		ConnectionStateRejected,
+	}
+}
+
+// Enum values for InterconnectState.
+const (
+	InterconnectStateRequested = "requested"
+	InterconnectStatePending   = "pending"
+	InterconnectStateAvailable = "available"
+	InterconnectStateDown      = "down"
+	InterconnectStateDeleting  = "deleting"
+	InterconnectStateDeleted   = "deleted"
+)
+
+// InterconnectStateValues returns the valid values of InterconnectState.
+func InterconnectStateValues() []string {
+	return []string{
+		InterconnectStateRequested,
+		InterconnectStatePending,
+		InterconnectStateAvailable,
+		InterconnectStateDown,
+		InterconnectStateDeleting,
+		InterconnectStateDeleted,
+	}
+}
+
+// Enum values for VirtualInterfaceState.
+const (
+	VirtualInterfaceStateConfirming = "confirming"
+	VirtualInterfaceStateVerifying  = "verifying"
+	VirtualInterfaceStatePending    = "pending"
+	VirtualInterfaceStateAvailable  = "available"
+	VirtualInterfaceStateDeleting   = "deleting"
+	VirtualInterfaceStateDeleted    = "deleted"
+	VirtualInterfaceStateRejected   = "rejected"
+)
+
+// VirtualInterfaceStateValues returns the valid values of VirtualInterfaceState.
+func VirtualInterfaceStateValues() []string {
+	return []string{
+		VirtualInterfaceStateConfirming,
+		VirtualInterfaceStateVerifying,
+		VirtualInterfaceStatePending,
+		VirtualInterfaceStateAvailable,
+		VirtualInterfaceStateDeleting,
+		VirtualInterfaceStateDeleted,
+		VirtualInterfaceStateRejected,
+	}
 }
\ No newline at end of file
@@ -1,45 +1,85 @@
 package directconnectiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/directconnect"
 )
 
 type DirectConnectAPI interface {
 	AllocateConnectionOnInterconnect(*directconnect.AllocateConnectionOnInterconnectInput) (*directconnect.Connection, error)
 
+	AllocateConnectionOnInterconnectWithContext(context.Context, *directconnect.AllocateConnectionOnInterconnectInput) (*directconnect.Connection, error)
+
 	AllocatePrivateVirtualInterface(*directconnect.AllocatePrivateVirtualInterfaceInput) (*directconnect.VirtualInterface, error)
 
+	AllocatePrivateVirtualInterfaceWithContext(context.Context, *directconnect.AllocatePrivateVirtualInterfaceInput) (*directconnect.VirtualInterface, error)
+
 	AllocatePublicVirtualInterface(*directconnect.AllocatePublicVirtualInterfaceInput) (*directconnect.VirtualInterface, error)
 
+	AllocatePublicVirtualInterfaceWithContext(context.Context, *directconnect.AllocatePublicVirtualInterfaceInput) (*directconnect.VirtualInterface, error)
+
 	ConfirmConnection(*directconnect.ConfirmConnectionInput) (*directconnect.ConfirmConnectionOutput, error)
 
+	ConfirmConnectionWithContext(context.Context, *directconnect.ConfirmConnectionInput) (*directconnect.ConfirmConnectionOutput, error)
+
 	ConfirmPrivateVirtualInterface(*directconnect.ConfirmPrivateVirtualInterfaceInput) (*directconnect.ConfirmPrivateVirtualInterfaceOutput, error)
 
+	ConfirmPrivateVirtualInterfaceWithContext(context.Context, *directconnect.ConfirmPrivateVirtualInterfaceInput) (*directconnect.ConfirmPrivateVirtualInterfaceOutput, error)
+
 	ConfirmPublicVirtualInterface(*directconnect.ConfirmPublicVirtualInterfaceInput) (*directconnect.ConfirmPublicVirtualInterfaceOutput, error)
 
+	ConfirmPublicVirtualInterfaceWithContext(context.Context, *directconnect.ConfirmPublicVirtualInterfaceInput) (*directconnect.ConfirmPublicVirtualInterfaceOutput, error)
+
 	CreateConnection(*directconnect.CreateConnectionInput) (*directconnect.Connection, error)
 
+	CreateConnectionWithContext(context.Context, *directconnect.CreateConnectionInput) (*directconnect.Connection, error)
+
 	CreateInterconnect(*directconnect.CreateInterconnectInput) (*directconnect.Interconnect, error)
 
+	CreateInterconnectWithContext(context.Context, *directconnect.CreateInterconnectInput) (*directconnect.Interconnect, error)
+
 	CreatePrivateVirtualInterface(*directconnect.CreatePrivateVirtualInterfaceInput) (*directconnect.VirtualInterface, error)
 
+	CreatePrivateVirtualInterfaceWithContext(context.Context, *directconnect.CreatePrivateVirtualInterfaceInput) (*directconnect.VirtualInterface, error)
+
 	CreatePublicVirtualInterface(*directconnect.CreatePublicVirtualInterfaceInput) (*directconnect.VirtualInterface, error)
 
+	CreatePublicVirtualInterfaceWithContext(context.Context, *directconnect.CreatePublicVirtualInterfaceInput) (*directconnect.VirtualInterface, error)
+
 	DeleteConnection(*directconnect.DeleteConnectionInput) (*directconnect.Connection, error)
 
+	DeleteConnectionWithContext(context.Context, *directconnect.DeleteConnectionInput) (*directconnect.Connection, error)
+
 	DeleteInterconnect(*directconnect.DeleteInterconnectInput) (*directconnect.DeleteInterconnectOutput, error)
 
+	DeleteInterconnectWithContext(context.Context, *directconnect.DeleteInterconnectInput) (*directconnect.DeleteInterconnectOutput, error)
+
 	DeleteVirtualInterface(*directconnect.DeleteVirtualInterfaceInput) (*directconnect.DeleteVirtualInterfaceOutput, error)
 
+	DeleteVirtualInterfaceWithContext(context.Context, *directconnect.DeleteVirtualInterfaceInput) (*directconnect.DeleteVirtualInterfaceOutput, error)
+
 	DescribeConnections(*directconnect.DescribeConnectionsInput) (*directconnect.Connections, error)
 
+	DescribeConnectionsWithContext(context.Context, *directconnect.DescribeConnectionsInput) (*directconnect.Connections, error)
+
 	DescribeConnectionsOnInterconnect(*directconnect.DescribeConnectionsOnInterconnectInput) (*directconnect.Connections, error)
 
+	DescribeConnectionsOnInterconnectWithContext(context.Context, *directconnect.DescribeConnectionsOnInterconnectInput) (*directconnect.Connections, error)
+
 	DescribeInterconnects(*directconnect.DescribeInterconnectsInput) (*directconnect.DescribeInterconnectsOutput, error)
 
+	DescribeInterconnectsWithContext(context.Context, *directconnect.DescribeInterconnectsInput) (*directconnect.DescribeInterconnectsOutput, error)
+
 	DescribeLocations(*directconnect.DescribeLocationsInput) (*directconnect.DescribeLocationsOutput, error)
 
+	DescribeLocationsWithContext(context.Context, *directconnect.DescribeLocationsInput) (*directconnect.DescribeLocationsOutput, error)
+
 	DescribeVirtualGateways(*directconnect.DescribeVirtualGatewaysInput) (*directconnect.DescribeVirtualGatewaysOutput, error)
 
+	DescribeVirtualGatewaysWithContext(context.Context, *directconnect.DescribeVirtualGatewaysInput) (*directconnect.DescribeVirtualGatewaysOutput, error)
+
 	DescribeVirtualInterfaces(*directconnect.DescribeVirtualInterfacesInput) (*directconnect.DescribeVirtualInterfacesOutput, error)
+
+	DescribeVirtualInterfacesWithContext(context.Context, *directconnect.DescribeVirtualInterfacesInput) (*directconnect.DescribeVirtualInterfacesOutput, error)
 }
\ No newline at end of file
@@ -29,6 +29,7 @@ func New(config *aws.Config) *DirectConnect {
 		APIVersion:   "2012-10-25",
 		JSONVersion:  "1.1",
 		TargetPrefix: "OvertureService",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package dynamodb
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -88,6 +89,17 @@ func (c *DynamoDB) BatchGetItem(input *BatchGetItemInput) (output *BatchGetItemO
 	return
 }
 
+// BatchGetItemWithContext is the same as BatchGetItem with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DynamoDB) BatchGetItemWithContext(ctx context.Context, input *BatchGetItemInput) (output *BatchGetItemOutput, err error) {
+	req, out := c.BatchGetItemRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opBatchGetItem *aws.Operation
 
 // BatchWriteItemRequest generates a request for the BatchWriteItem operation.
@@ -189,6 +201,17 @@ func (c *DynamoDB) BatchWriteItem(input *BatchWriteItemInput) (output *BatchWrit
 	return
 }
 
+// BatchWriteItemWithContext is the same as BatchWriteItem with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DynamoDB) BatchWriteItemWithContext(ctx context.Context, input *BatchWriteItemInput) (output *BatchWriteItemOutput, err error) {
+	req, out := c.BatchWriteItemRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opBatchWriteItem *aws.Operation
 
 // CreateTableRequest generates a request for the CreateTable operation.
@@ -236,6 +259,17 @@ func (c *DynamoDB) CreateTable(input *CreateTableInput) (output *CreateTableOutp
 	return
 }
 
+// CreateTableWithContext is the same as CreateTable with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DynamoDB) CreateTableWithContext(ctx context.Context, input *CreateTableInput) (output *CreateTableOutput, err error) {
+	req, out := c.CreateTableRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateTable *aws.Operation
 
 // DeleteItemRequest generates a request for the DeleteItem operation.
@@ -282,6 +316,17 @@ func (c *DynamoDB) DeleteItem(input *DeleteItemInput) (output *DeleteItemOutput,
 	return
 }
 
+// DeleteItemWithContext is the same as DeleteItem with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DynamoDB) DeleteItemWithContext(ctx context.Context, input *DeleteItemInput) (output *DeleteItemOutput, err error) {
+	req, out := c.DeleteItemRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteItem *aws.Operation
 
 // DeleteTableRequest generates a request for the DeleteTable operation.
@@ -328,6 +373,17 @@ func (c *DynamoDB) DeleteTable(input *DeleteTableInput) (output *DeleteTableOutp
 	return
 }
 
+// DeleteTableWithContext is the same as DeleteTable with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DynamoDB) DeleteTableWithContext(ctx context.Context, input *DeleteTableInput) (output *DeleteTableOutput, err error) {
+	req, out := c.DeleteTableRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteTable *aws.Operation
 
 // DescribeTableRequest generates a request for the DescribeTable operation.
@@ -369,6 +425,17 @@ func (c *DynamoDB) DescribeTable(input *DescribeTableInput) (output *DescribeTab
 	return
 }
 
+// DescribeTableWithContext is the same as DescribeTable with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DynamoDB) DescribeTableWithContext(ctx context.Context, input *DescribeTableInput) (output *DescribeTableOutput, err error) {
+	req, out := c.DescribeTableRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeTable *aws.Operation
 
 // GetItemRequest generates a request for the GetItem operation.
@@ -408,6 +475,17 @@ func (c *DynamoDB) GetItem(input *GetItemInput) (output *GetItemOutput, err erro
 	return
 }
 
+// GetItemWithContext is the same as GetItem with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DynamoDB) GetItemWithContext(ctx context.Context, input *GetItemInput) (output *GetItemOutput, err error) {
+	req, out := c.GetItemRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetItem *aws.Operation
 
 // ListTablesRequest generates a request for the ListTables operation.
@@ -420,6 +498,12 @@ func (c *DynamoDB) ListTablesRequest(input *ListTablesInput) (req *aws.Request,
 			Name:       "ListTables",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"ExclusiveStartTableName"},
+				OutputTokens:    []string{"LastEvaluatedTableName"},
+				LimitToken:      "Limit",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -443,6 +527,27 @@ func (c *DynamoDB) ListTables(input *ListTablesInput) (output *ListTablesOutput,
 	return
 }
 
+// ListTablesWithContext is the same as ListTables with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DynamoDB) ListTablesWithContext(ctx context.Context, input *ListTablesInput) (output *ListTablesOutput, err error) {
+	req, out := c.ListTablesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListTablesPages iterates over the pages of a ListTables operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *DynamoDB) ListTablesPages(input *ListTablesInput, fn func(p *ListTablesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListTablesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListTablesOutput), lastPage)
+	})
+}
+
 var opListTables *aws.Operation
 
 // PutItemRequest generates a request for the PutItem operation.
@@ -500,6 +605,17 @@ func (c *DynamoDB) PutItem(input *PutItemInput) (output *PutItemOutput, err erro
 	return
 }
 
+// PutItemWithContext is the same as PutItem with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DynamoDB) PutItemWithContext(ctx context.Context, input *PutItemInput) (output *PutItemOutput, err error) {
+	req, out := c.PutItemRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutItem *aws.Operation
 
 // QueryRequest generates a request for the Query operation.
@@ -512,6 +628,12 @@ func (c *DynamoDB) QueryRequest(input *QueryInput) (req *aws.Request, output *Qu
 			Name:       "Query",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"ExclusiveStartKey"},
+				OutputTokens:    []string{"LastEvaluatedKey"},
+				LimitToken:      "Limit",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -554,6 +676,27 @@ func (c *DynamoDB) Query(input *QueryInput) (output *QueryOutput, err error) {
 	return
 }
 
+// QueryWithContext is the same as Query with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DynamoDB) QueryWithContext(ctx context.Context, input *QueryInput) (output *QueryOutput, err error) {
+	req, out := c.QueryRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// QueryPages iterates over the pages of a Query operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *DynamoDB) QueryPages(input *QueryInput, fn func(p *QueryOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.QueryRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*QueryOutput), lastPage)
+	})
+}
+
 var opQuery *aws.Operation
 
 // ScanRequest generates a request for the Scan operation.
@@ -566,6 +709,12 @@ func (c *DynamoDB) ScanRequest(input *ScanInput) (req *aws.Request, output *Scan
 			Name:       "Scan",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"ExclusiveStartKey"},
+				OutputTokens:    []string{"LastEvaluatedKey"},
+				LimitToken:      "Limit",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -603,6 +752,27 @@ func (c *DynamoDB) Scan(input *ScanInput) (output *ScanOutput, err error) {
 	return
 }
 
+// ScanWithContext is the same as Scan with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DynamoDB) ScanWithContext(ctx context.Context, input *ScanInput) (output *ScanOutput, err error) {
+	req, out := c.ScanRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ScanPages iterates over the pages of a Scan operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *DynamoDB) ScanPages(input *ScanInput, fn func(p *ScanOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ScanRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ScanOutput), lastPage)
+	})
+}
+
 var opScan *aws.Operation
 
 // UpdateItemRequest generates a request for the UpdateItem operation.
@@ -645,6 +815,17 @@ func (c *DynamoDB) UpdateItem(input *UpdateItemInput) (output *UpdateItemOutput,
 	return
 }
 
+// UpdateItemWithContext is the same as UpdateItem with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DynamoDB) UpdateItemWithContext(ctx context.Context, input *UpdateItemInput) (output *UpdateItemOutput, err error) {
+	req, out := c.UpdateItemRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateItem *aws.Operation
 
 // UpdateTableRequest generates a request for the UpdateTable operation.
@@ -695,15 +876,26 @@ func (c *DynamoDB) UpdateTable(input *UpdateTableInput) (output *UpdateTableOutp
 	return
 }
 
+// UpdateTableWithContext is the same as UpdateTable with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *DynamoDB) UpdateTableWithContext(ctx context.Context, input *UpdateTableInput) (output *UpdateTableOutput, err error) {
+	req, out := c.UpdateTableRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateTable *aws.Operation
 
 // Represents an attribute for describing the key schema for the table and indexes.
 type AttributeDefinition struct {
 	// A name for the attribute.
-	AttributeName *string `type:"string" required:"true"`
+	AttributeName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The data type for the attribute.
-	AttributeType *string `type:"string" required:"true"`
+	AttributeType *string `type:"string" enum:"S,N,B" required:"true"`
 
 	metadataAttributeDefinition `json:"-", xml:"-"`
 }
@@ -830,7 +1022,7 @@ type AttributeValueUpdate struct {
 	//   ADD - DynamoDB creates an item with the supplied primary key and number
 	// (or set of numbers) for the attribute value. The only data types allowed
 	// are number and number set; no other data types can be specified.
-	Action *string `type:"string"`
+	Action *string `type:"string" enum:"ADD,PUT,DELETE"`
 
 	// Represents the data for an attribute. You can set one, and only one, of the
 	// elements.
@@ -871,13 +1063,13 @@ type BatchGetItemInput struct {
 	//
 	//   ConsistentRead - If true, a strongly consistent read is used; if false
 	// (the default), an eventually consistent read is used.
-	RequestItems *map[string]*KeysAndAttributes `type:"map" required:"true"`
+	RequestItems *map[string]*KeysAndAttributes `type:"map" min:"1" max:"100" required:"true"`
 
 	// A value that if set to TOTAL, the response includes ConsumedCapacity data
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	metadataBatchGetItemInput `json:"-", xml:"-"`
 }
@@ -922,7 +1114,7 @@ type BatchGetItemOutput struct {
 	//
 	//   If there are no unprocessed keys remaining, the response contains an empty
 	// UnprocessedKeys map.
-	UnprocessedKeys *map[string]*KeysAndAttributes `type:"map"`
+	UnprocessedKeys *map[string]*KeysAndAttributes `type:"map" min:"1" max:"100"`
 
 	metadataBatchGetItemOutput `json:"-", xml:"-"`
 }
@@ -959,18 +1151,18 @@ type BatchWriteItemInput struct {
 	// If you specify any attributes that are part of an index key, then the data
 	// types for those attributes must match those of the schema in the table's
 	// attribute definition.
-	RequestItems *map[string][]*WriteRequest `type:"map" required:"true"`
+	RequestItems *map[string][]*WriteRequest `type:"map" min:"1" max:"25" required:"true"`
 
 	// A value that if set to TOTAL, the response includes ConsumedCapacity data
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// A value that if set to SIZE, the response includes statistics about item
 	// collections, if any, that were modified during the operation are returned
 	// in the response. If set to NONE (the default), no statistics are returned.
-	ReturnItemCollectionMetrics *string `type:"string"`
+	ReturnItemCollectionMetrics *string `type:"string" enum:"SIZE,NONE"`
 
 	metadataBatchWriteItemInput `json:"-", xml:"-"`
 }
@@ -1040,7 +1232,7 @@ type BatchWriteItemOutput struct {
 	//
 	//     If there are no unprocessed items remaining, the response contains an
 	// empty UnprocessedItems map.
-	UnprocessedItems *map[string][]*WriteRequest `type:"map"`
+	UnprocessedItems *map[string][]*WriteRequest `type:"map" min:"1" max:"25"`
 
 	metadataBatchWriteItemOutput `json:"-", xml:"-"`
 }
@@ -1221,7 +1413,7 @@ type Condition struct {
 	//   For usage examples of AttributeValueList and ComparisonOperator, see Legacy
 	// Conditional Parameters (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/LegacyConditionalParameters.html)
 	// in the Amazon DynamoDB Developer Guide.
-	ComparisonOperator *string `type:"string" required:"true"`
+	ComparisonOperator *string `type:"string" enum:"EQ,NE,IN,LE,LT,GE,GT,BETWEEN,NOT_NULL,NULL,CONTAINS,NOT_CONTAINS,BEGINS_WITH" required:"true"`
 
 	metadataCondition `json:"-", xml:"-"`
 }
@@ -1250,7 +1442,7 @@ type ConsumedCapacity struct {
 	Table *Capacity `type:"structure"`
 
 	// The name of the table that was affected by the operation.
-	TableName *string `type:"string"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataConsumedCapacity `json:"-", xml:"-"`
 }
@@ -1262,10 +1454,10 @@ type metadataConsumedCapacity struct {
 // Represents a new global secondary index to be added to an existing table.
 type CreateGlobalSecondaryIndexAction struct {
 	// The name of the global secondary index to be created.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The key schema for the global secondary index.
-	KeySchema []*KeySchemaElement `type:"list" required:"true"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2" required:"true"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -1344,7 +1536,7 @@ type CreateTableInput struct {
 	//
 	// For more information, see Specifying the Primary Key (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/WorkingWithTables.html#WorkingWithTables.primary.key)
 	// in the Amazon DynamoDB Developer Guide.
-	KeySchema []*KeySchemaElement `type:"list" required:"true"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2" required:"true"`
 
 	// One or more local secondary indexes (the maximum is five) to be created on
 	// the table. Each index is scoped to a given hash key value. There is a 10
@@ -1389,7 +1581,7 @@ type CreateTableInput struct {
 	ProvisionedThroughput *ProvisionedThroughput `type:"structure" required:"true"`
 
 	// The name of the table to create.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataCreateTableInput `json:"-", xml:"-"`
 }
@@ -1413,7 +1605,7 @@ type metadataCreateTableOutput struct {
 // Represents a global secondary index to be deleted from an existing table.
 type DeleteGlobalSecondaryIndexAction struct {
 	// The name of the global secondary index to be deleted.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteGlobalSecondaryIndexAction `json:"-", xml:"-"`
 }
@@ -1460,7 +1652,7 @@ type DeleteItemInput struct {
 	// The operation will succeed only if the entire map evaluates to true.
 	//
 	// This parameter does not support attributes of type List or Map.
-	ConditionalOperator *string `type:"string"`
+	ConditionalOperator *string `type:"string" enum:"AND,OR"`
 
 	// There is a newer parameter available. Use ConditionExpression instead. Note
 	// that if you use Expected and  ConditionExpression  at the same time, DynamoDB
@@ -1727,12 +1919,12 @@ type DeleteItemInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// A value that if set to SIZE, the response includes statistics about item
 	// collections, if any, that were modified during the operation are returned
 	// in the response. If set to NONE (the default), no statistics are returned.
-	ReturnItemCollectionMetrics *string `type:"string"`
+	ReturnItemCollectionMetrics *string `type:"string" enum:"SIZE,NONE"`
 
 	// Use ReturnValues if you want to get the item attributes as they appeared
 	// before they were deleted. For DeleteItem, the valid values are:
@@ -1741,10 +1933,10 @@ type DeleteItemInput struct {
 	// nothing is returned. (This setting is the default for ReturnValues.)
 	//
 	//   ALL_OLD - The content of the old item is returned.
-	ReturnValues *string `type:"string"`
+	ReturnValues *string `type:"string" enum:"NONE,ALL_OLD,UPDATED_OLD,ALL_NEW,UPDATED_NEW"`
 
 	// The name of the table from which to delete the item.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteItemInput `json:"-", xml:"-"`
 }
@@ -1813,7 +2005,7 @@ type metadataDeleteRequest struct {
 // Represents the input of a DeleteTable operation.
 type DeleteTableInput struct {
 	// The name of the table to delete.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteTableInput `json:"-", xml:"-"`
 }
@@ -1837,7 +2029,7 @@ type metadataDeleteTableOutput struct {
 // Represents the input of a DescribeTable operation.
 type DescribeTableInput struct {
 	// The name of the table to describe.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDescribeTableInput `json:"-", xml:"-"`
 }
@@ -2023,7 +2215,7 @@ type ExpectedAttributeValue struct {
 	// element of a different type than the one provided in the request, the value
 	// does not match. For example, {"S":"6"} does not compare to {"N":"6"}. Also,
 	// {"N":"6"} does not compare to {"NS":["6", "2", "1"]}
-	ComparisonOperator *string `type:"string"`
+	ComparisonOperator *string `type:"string" enum:"EQ,NE,IN,LE,LT,GE,GT,BETWEEN,NOT_NULL,NULL,CONTAINS,NOT_CONTAINS,BEGINS_WITH"`
 
 	// Causes DynamoDB to evaluate the value before attempting a conditional operation:
 	//
@@ -2081,7 +2273,7 @@ type GetItemInput struct {
 	// Note that AttributesToGet has no effect on provisioned throughput consumption.
 	// DynamoDB determines capacity units consumed based on item size, not on the
 	// amount of data that is returned to an application.
-	AttributesToGet []*string `type:"list"`
+	AttributesToGet []*string `type:"list" min:"1"`
 
 	// A value that if set to true, then the operation uses strongly consistent
 	// reads; otherwise, eventually consistent reads are used.
@@ -2148,10 +2340,10 @@ type GetItemInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// The name of the table containing the requested item.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataGetItemInput `json:"-", xml:"-"`
 }
@@ -2184,11 +2376,11 @@ type metadataGetItemOutput struct {
 type GlobalSecondaryIndex struct {
 	// The name of the global secondary index. The name must be unique among all
 	// other indexes on this table.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The complete key schema for a global secondary index, which consists of one
 	// or more pairs of attribute names and key types (HASH or RANGE).
-	KeySchema []*KeySchemaElement `type:"list" required:"true"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2" required:"true"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -2224,7 +2416,7 @@ type GlobalSecondaryIndexDescription struct {
 	Backfilling *bool `type:"boolean"`
 
 	// The name of the global secondary index.
-	IndexName *string `type:"string"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The total size of the specified index, in bytes. DynamoDB updates this value
 	// approximately every six hours. Recent changes might not be reflected in this
@@ -2240,7 +2432,7 @@ type GlobalSecondaryIndexDescription struct {
 	//   DELETING - The index is being deleted.
 	//
 	//   ACTIVE - The index is ready for use.
-	IndexStatus *string `type:"string"`
+	IndexStatus *string `type:"string" enum:"CREATING,UPDATING,DELETING,ACTIVE"`
 
 	// The number of items in the specified index. DynamoDB updates this value approximately
 	// every six hours. Recent changes might not be reflected in this value.
@@ -2248,7 +2440,7 @@ type GlobalSecondaryIndexDescription struct {
 
 	// The complete key schema for the global secondary index, consisting of one
 	// or more pairs of attribute names and key types (HASH or RANGE).
-	KeySchema []*KeySchemaElement `type:"list"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -2338,10 +2530,10 @@ type metadataItemCollectionMetrics struct {
 // the hash attribute, and another KeySchemaElement for the range attribute.
 type KeySchemaElement struct {
 	// The name of a key attribute.
-	AttributeName *string `type:"string" required:"true"`
+	AttributeName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The attribute data, consisting of the data type and the attribute value itself.
-	KeyType *string `type:"string" required:"true"`
+	KeyType *string `type:"string" enum:"HASH,RANGE" required:"true"`
 
 	metadataKeySchemaElement `json:"-", xml:"-"`
 }
@@ -2361,7 +2553,7 @@ type KeysAndAttributes struct {
 	// One or more attributes to retrieve from the table or index. If no attribute
 	// names are specified then all attributes will be returned. If any of the specified
 	// attributes are not found, they will not appear in the result.
-	AttributesToGet []*string `type:"list"`
+	AttributesToGet []*string `type:"list" min:"1"`
 
 	// The consistency of a read operation. If set to true, then a strongly consistent
 	// read is used; otherwise, an eventually consistent read is used.
@@ -2405,7 +2597,7 @@ type KeysAndAttributes struct {
 
 	// The primary key attribute values that define the items and the attributes
 	// associated with the items.
-	Keys []*map[string]*AttributeValue `type:"list" required:"true"`
+	Keys []*map[string]*AttributeValue `type:"list" min:"1" max:"100" required:"true"`
 
 	// A string that identifies one or more attributes to retrieve from the table.
 	// These attributes can include scalars, sets, or elements of a JSON document.
@@ -2431,11 +2623,11 @@ type ListTablesInput struct {
 	// The first table name that this operation will evaluate. Use the value that
 	// was returned for LastEvaluatedTableName in a previous operation, so that
 	// you can obtain the next page of results.
-	ExclusiveStartTableName *string `type:"string"`
+	ExclusiveStartTableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// A maximum number of table names to return. If this parameter is not specified,
 	// the limit is 100.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"100"`
 
 	metadataListTablesInput `json:"-", xml:"-"`
 }
@@ -2452,7 +2644,7 @@ type ListTablesOutput struct {
 	//
 	// If you do not receive a LastEvaluatedTableName value in the response, this
 	// means that there are no more table names to be retrieved.
-	LastEvaluatedTableName *string `type:"string"`
+	LastEvaluatedTableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The names of the tables associated with the current account at the current
 	// endpoint. The maximum size of this array is 100.
@@ -2473,11 +2665,11 @@ type metadataListTablesOutput struct {
 type LocalSecondaryIndex struct {
 	// The name of the local secondary index. The name must be unique among all
 	// other indexes on this table.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The complete key schema for the local secondary index, consisting of one
 	// or more pairs of attribute names and key types (HASH or RANGE).
-	KeySchema []*KeySchemaElement `type:"list" required:"true"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2" required:"true"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -2494,7 +2686,7 @@ type metadataLocalSecondaryIndex struct {
 // Represents the properties of a local secondary index.
 type LocalSecondaryIndexDescription struct {
 	// Represents the name of the local secondary index.
-	IndexName *string `type:"string"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The total size of the specified index, in bytes. DynamoDB updates this value
 	// approximately every six hours. Recent changes might not be reflected in this
@@ -2507,7 +2699,7 @@ type LocalSecondaryIndexDescription struct {
 
 	// The complete index key schema, which consists of one or more pairs of attribute
 	// names and key types (HASH or RANGE).
-	KeySchema []*KeySchemaElement `type:"list"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -2531,7 +2723,7 @@ type Projection struct {
 	// across all of the local secondary indexes, must not exceed 20. If you project
 	// the same attribute into two different indexes, this counts as two distinct
 	// attributes when determining the total.
-	NonKeyAttributes []*string `type:"list"`
+	NonKeyAttributes []*string `type:"list" min:"1" max:"20"`
 
 	// The set of attributes that are projected into the index:
 	//
@@ -2541,7 +2733,7 @@ type Projection struct {
 	// The list of projected attributes are in NonKeyAttributes.
 	//
 	//   ALL - All of the table attributes are projected into the index.
-	ProjectionType *string `type:"string"`
+	ProjectionType *string `type:"string" enum:"ALL,KEYS_ONLY,INCLUDE"`
 
 	metadataProjection `json:"-", xml:"-"`
 }
@@ -2561,13 +2753,13 @@ type ProvisionedThroughput struct {
 	// DynamoDB returns a ThrottlingException. For more information, see Specifying
 	// Read and Write Requirements (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/WorkingWithTables.html#ProvisionedThroughput)
 	// in the Amazon DynamoDB Developer Guide.
-	ReadCapacityUnits *int64 `type:"long" required:"true"`
+	ReadCapacityUnits *int64 `type:"long" min:"1" required:"true"`
 
 	// The maximum number of writes consumed per second before DynamoDB returns
 	// a ThrottlingException. For more information, see Specifying Read and Write
 	// Requirements (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/WorkingWithTables.html#ProvisionedThroughput)
 	// in the Amazon DynamoDB Developer Guide.
-	WriteCapacityUnits *int64 `type:"long" required:"true"`
+	WriteCapacityUnits *int64 `type:"long" min:"1" required:"true"`
 
 	metadataProvisionedThroughput `json:"-", xml:"-"`
 }
@@ -2589,17 +2781,17 @@ type ProvisionedThroughputDescription struct {
 	// UTC calendar day. For current maximums on provisioned throughput decreases,
 	// see Limits (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/Limits.html)
 	// in the Amazon DynamoDB Developer Guide.
-	NumberOfDecreasesToday *int64 `type:"long"`
+	NumberOfDecreasesToday *int64 `type:"long" min:"1"`
 
 	// The maximum number of strongly consistent reads consumed per second before
 	// DynamoDB returns a ThrottlingException. Eventually consistent reads require
 	// less effort than strongly consistent reads, so a setting of 50 ReadCapacityUnits
 	// per second provides 100 eventually consistent ReadCapacityUnits per second.
-	ReadCapacityUnits *int64 `type:"long"`
+	ReadCapacityUnits *int64 `type:"long" min:"1"`
 
 	// The maximum number of writes consumed per second before DynamoDB returns
 	// a ThrottlingException.
-	WriteCapacityUnits *int64 `type:"long"`
+	WriteCapacityUnits *int64 `type:"long" min:"1"`
 
 	metadataProvisionedThroughputDescription `json:"-", xml:"-"`
 }
@@ -2646,7 +2838,7 @@ type PutItemInput struct {
 	// The operation will succeed only if the entire map evaluates to true.
 	//
 	// This parameter does not support attributes of type List or Map.
-	ConditionalOperator *string `type:"string"`
+	ConditionalOperator *string `type:"string" enum:"AND,OR"`
 
 	// There is a newer parameter available. Use ConditionExpression instead. Note
 	// that if you use Expected and  ConditionExpression  at the same time, DynamoDB
@@ -2923,12 +3115,12 @@ type PutItemInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// A value that if set to SIZE, the response includes statistics about item
 	// collections, if any, that were modified during the operation are returned
 	// in the response. If set to NONE (the default), no statistics are returned.
-	ReturnItemCollectionMetrics *string `type:"string"`
+	ReturnItemCollectionMetrics *string `type:"string" enum:"SIZE,NONE"`
 
 	// Use ReturnValues if you want to get the item attributes as they appeared
 	// before they were updated with the PutItem request. For PutItem, the valid
@@ -2939,10 +3131,10 @@ type PutItemInput struct {
 	//
 	//   ALL_OLD - If PutItem overwrote an attribute name-value pair, then the
 	// content of the old item is returned.
-	ReturnValues *string `type:"string"`
+	ReturnValues *string `type:"string" enum:"NONE,ALL_OLD,UPDATED_OLD,ALL_NEW,UPDATED_NEW"`
 
 	// The name of the table to contain the item.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataPutItemInput `json:"-", xml:"-"`
 }
@@ -3041,7 +3233,7 @@ type QueryInput struct {
 	// If you query a global secondary index, you can only request attributes that
 	// are projected into the index. Global secondary index queries cannot fetch
 	// attributes from the parent table.
-	AttributesToGet []*string `type:"list"`
+	AttributesToGet []*string `type:"list" min:"1"`
 
 	// A logical operator to apply to the conditions in a QueryFilter map:
 	//
@@ -3056,7 +3248,7 @@ type QueryInput struct {
 	// The operation will succeed only if the entire map evaluates to true.
 	//
 	// This parameter does not support attributes of type List or Map.
-	ConditionalOperator *string `type:"string"`
+	ConditionalOperator *string `type:"string" enum:"AND,OR"`
 
 	// A value that if set to true, then the operation uses strongly consistent
 	// reads; otherwise, eventually consistent reads are used.
@@ -3144,7 +3336,7 @@ type QueryInput struct {
 
 	// The name of an index to query. This index can be any local secondary index
 	// or global secondary index on the table.
-	IndexName *string `type:"string"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The selection criteria for the query. For a query on a table, you can have
 	// conditions only on the table primary key attributes. You must provide the
@@ -3257,7 +3449,7 @@ type QueryInput struct {
 	// and returns the matching values up to the limit, and a key in LastEvaluatedKey
 	// to apply in a subsequent operation to continue the operation. For more information,
 	// see Query and Scan in the Amazon DynamoDB Developer Guide.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A string that identifies one or more attributes to retrieve from the table.
 	// These attributes can include scalars, sets, or elements of a JSON document.
@@ -3329,7 +3521,7 @@ type QueryInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// A value that specifies ascending (true) or descending (false) traversal of
 	// the index. DynamoDB returns results reflecting the requested order determined
@@ -3381,10 +3573,10 @@ type QueryInput struct {
 	// in a single request, unless the value for Select is SPECIFIC_ATTRIBUTES.
 	// (This usage is equivalent to specifying AttributesToGet without any value
 	// for Select.)
-	Select *string `type:"string"`
+	Select *string `type:"string" enum:"ALL_ATTRIBUTES,ALL_PROJECTED_ATTRIBUTES,SPECIFIC_ATTRIBUTES,COUNT"`
 
 	// The name of the table containing the requested items.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataQueryInput `json:"-", xml:"-"`
 }
@@ -3461,7 +3653,7 @@ type ScanInput struct {
 	// Note that AttributesToGet has no effect on provisioned throughput consumption.
 	// DynamoDB determines capacity units consumed based on item size, not on the
 	// amount of data that is returned to an application.
-	AttributesToGet []*string `type:"list"`
+	AttributesToGet []*string `type:"list" min:"1"`
 
 	// There is a newer parameter available. Use ConditionExpression instead. Note
 	// that if you use ConditionalOperator and  ConditionExpression  at the same
@@ -3480,7 +3672,7 @@ type ScanInput struct {
 	// The operation will succeed only if the entire map evaluates to true.
 	//
 	// This parameter does not support attributes of type List or Map.
-	ConditionalOperator *string `type:"string"`
+	ConditionalOperator *string `type:"string" enum:"AND,OR"`
 
 	// The primary key of the first item that this operation will evaluate. Use
 	// the value that was returned for LastEvaluatedKey in the previous operation.
@@ -3565,7 +3757,7 @@ type ScanInput struct {
 	// The name of a secondary index to scan. This index can be any local secondary
 	// index or global secondary index. Note that if you use the IndexName parameter,
 	// you must also provide TableName.
-	IndexName *string `type:"string"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The maximum number of items to evaluate (not necessarily the number of matching
 	// items). If DynamoDB processes the number of items up to the limit while processing
@@ -3576,7 +3768,7 @@ type ScanInput struct {
 	// and returns the matching values up to the limit, and a key in LastEvaluatedKey
 	// to apply in a subsequent operation to continue the operation. For more information,
 	// see Query and Scan in the Amazon DynamoDB Developer Guide.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A string that identifies one or more attributes to retrieve from the specified
 	// table or index. These attributes can include scalars, sets, or elements of
@@ -3594,7 +3786,7 @@ type ScanInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// There is a newer parameter available. Use FilterExpression instead. Note
 	// that if you use ScanFilter and FilterExpression at the same time, DynamoDB
@@ -3658,7 +3850,7 @@ type ScanInput struct {
 	// the value provided for TotalSegments.
 	//
 	// If you provide Segment, you must also provide TotalSegments.
-	Segment *int64 `type:"integer"`
+	Segment *int64 `type:"integer" min:"0" max:"999999"`
 
 	// The attributes to be returned in the result. You can retrieve all item attributes,
 	// specific item attributes, or the count of matching items.
@@ -3677,11 +3869,11 @@ type ScanInput struct {
 	// in a single request, unless the value for Select is SPECIFIC_ATTRIBUTES.
 	// (This usage is equivalent to specifying AttributesToGet without any value
 	// for Select.)
-	Select *string `type:"string"`
+	Select *string `type:"string" enum:"ALL_ATTRIBUTES,ALL_PROJECTED_ATTRIBUTES,SPECIFIC_ATTRIBUTES,COUNT"`
 
 	// The name of the table containing the requested items; or, if you provide
 	// IndexName, the name of the table to which that index belongs.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// For a parallel Scan request, TotalSegments represents the total number of
 	// segments into which the Scan operation will be divided. The value of TotalSegments
@@ -3694,7 +3886,7 @@ type ScanInput struct {
 	// Scan operation will be sequential rather than parallel.
 	//
 	// If you specify TotalSegments, you must also specify Segment.
-	TotalSegments *int64 `type:"integer"`
+	TotalSegments *int64 `type:"integer" min:"1" max:"1000000"`
 
 	metadataScanInput `json:"-", xml:"-"`
 }
@@ -3843,7 +4035,7 @@ type TableDescription struct {
 	//
 	//   For more information about primary keys, see Primary Key (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/DataModel.html#DataModelPrimaryKey)
 	// in the Amazon DynamoDB Developer Guide.
-	KeySchema []*KeySchemaElement `type:"list"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2"`
 
 	// Represents one or more local secondary indexes on the table. Each index is
 	// scoped to a given hash key value. Tables with one or more local secondary
@@ -3894,7 +4086,7 @@ type TableDescription struct {
 	ProvisionedThroughput *ProvisionedThroughputDescription `type:"structure"`
 
 	// The name of the table.
-	TableName *string `type:"string"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The total size of the specified table, in bytes. DynamoDB updates this value
 	// approximately every six hours. Recent changes might not be reflected in this
@@ -3910,7 +4102,7 @@ type TableDescription struct {
 	//   DELETING - The table is being deleted.
 	//
 	//   ACTIVE - The table is ready for use.
-	TableStatus *string `type:"string"`
+	TableStatus *string `type:"string" enum:"CREATING,UPDATING,DELETING,ACTIVE"`
 
 	metadataTableDescription `json:"-", xml:"-"`
 }
@@ -3923,7 +4115,7 @@ type metadataTableDescription struct {
 // secondary index.
 type UpdateGlobalSecondaryIndexAction struct {
 	// The name of the global secondary index to be updated.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// Represents the provisioned throughput settings for a specified table or index.
 	// The settings can be modified using the UpdateTable operation.
@@ -4066,7 +4258,7 @@ type UpdateItemInput struct {
 	// The operation will succeed only if the entire map evaluates to true.
 	//
 	// This parameter does not support attributes of type List or Map.
-	ConditionalOperator *string `type:"string"`
+	ConditionalOperator *string `type:"string" enum:"AND,OR"`
 
 	// There is a newer parameter available. Use  ConditionExpression  instead.
 	// Note that if you use Expected and  ConditionExpression  at the same time,
@@ -4333,12 +4525,12 @@ type UpdateItemInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// A value that if set to SIZE, the response includes statistics about item
 	// collections, if any, that were modified during the operation are returned
 	// in the response. If set to NONE (the default), no statistics are returned.
-	ReturnItemCollectionMetrics *string `type:"string"`
+	ReturnItemCollectionMetrics *string `type:"string" enum:"SIZE,NONE"`
 
 	// Use ReturnValues if you want to get the item attributes as they appeared
 	// either before or after they were updated. For UpdateItem, the valid values
@@ -4355,10 +4547,10 @@ type UpdateItemInput struct {
 	//   ALL_NEW - All of the attributes of the new version of the item are returned.
 	//
 	//   UPDATED_NEW - The new versions of only the updated attributes are returned.
-	ReturnValues *string `type:"string"`
+	ReturnValues *string `type:"string" enum:"NONE,ALL_OLD,UPDATED_OLD,ALL_NEW,UPDATED_NEW"`
 
 	// The name of the table containing the item to update.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// An expression that defines one or more attributes to be updated, the action
 	// to be performed on them, and new value(s) for them.
@@ -4495,7 +4687,7 @@ type UpdateTableInput struct {
 	ProvisionedThroughput *ProvisionedThroughput `type:"structure"`
 
 	// The name of the table to be updated.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataUpdateTableInput `json:"-", xml:"-"`
 }
@@ -4532,4 +4724,220 @@ type WriteRequest struct {
 
 type metadataWriteRequest struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for AttributeAction.
+const (
+	AttributeActionAdd    = "ADD"
+	AttributeActionPut    = "PUT"
+	AttributeActionDelete = "DELETE"
+)
+
+// AttributeActionValues returns the valid values of AttributeAction.
+func AttributeActionValues() []string {
+	return []string{
+		AttributeActionAdd,
+		AttributeActionPut,
+		AttributeActionDelete,
+	}
+}
+
+// Enum values for ComparisonOperator.
+const (
+	ComparisonOperatorEQ          = "EQ"
+	ComparisonOperatorNE          = "NE"
+	ComparisonOperatorIN          = "IN"
+	ComparisonOperatorLE          = "LE"
+	ComparisonOperatorLT          = "LT"
+	ComparisonOperatorGE          = "GE"
+	ComparisonOperatorGT          = "GT"
+	ComparisonOperatorBetween     = "BETWEEN"
+	ComparisonOperatorNotNull     = "NOT_NULL"
+	ComparisonOperatorNull        = "NULL"
+	ComparisonOperatorContains    = "CONTAINS"
+	ComparisonOperatorNotContains = "NOT_CONTAINS"
+	ComparisonOperatorBeginsWith  = "BEGINS_WITH"
+)
+
+// ComparisonOperatorValues returns the valid values of ComparisonOperator.
+func ComparisonOperatorValues() []string {
+	return []string{
+		ComparisonOperatorEQ,
+		ComparisonOperatorNE,
+		ComparisonOperatorIN,
+		ComparisonOperatorLE,
+		ComparisonOperatorLT,
+		ComparisonOperatorGE,
+		ComparisonOperatorGT,
+		ComparisonOperatorBetween,
+		ComparisonOperatorNotNull,
+		ComparisonOperatorNull,
+		ComparisonOperatorContains,
+		ComparisonOperatorNotContains,
+		ComparisonOperatorBeginsWith,
+	}
+}
+
+// Enum values for ConditionalOperator.
+const (
+	ConditionalOperatorAnd = "AND"
+	ConditionalOperatorOR  = "OR"
+)
+
+// ConditionalOperatorValues returns the valid values of ConditionalOperator.
+func ConditionalOperatorValues() []string {
+	return []string{
+		ConditionalOperatorAnd,
+		ConditionalOperatorOR,
+	}
+}
+
+// Enum values for IndexStatus.
+const (
+	IndexStatusCreating = "CREATING"
+	IndexStatusUpdating = "UPDATING"
+	IndexStatusDeleting = "DELETING"
+	IndexStatusActive   = "ACTIVE"
+)
+
+// IndexStatusValues returns the valid values of IndexStatus.
+func IndexStatusValues() []string {
+	return []string{
+		IndexStatusCreating,
+		IndexStatusUpdating,
+		IndexStatusDeleting,
+		IndexStatusActive,
+	}
+}
+
+// Enum values for KeyType.
+const (
+	KeyTypeHash  = "HASH"
+	KeyTypeRange = "RANGE"
+)
+
+// KeyTypeValues returns the valid values of KeyType.
+func KeyTypeValues() []string {
+	return []string{
+		KeyTypeHash,
+		KeyTypeRange,
+	}
+}
+
+// Enum values for ProjectionType.
+const (
+	ProjectionTypeAll      = "ALL"
+	ProjectionTypeKeysOnly = "KEYS_ONLY"
+	ProjectionTypeInclude  = "INCLUDE"
+)
+
+// ProjectionTypeValues returns the valid values of ProjectionType.
+func ProjectionTypeValues() []string {
+	return []string{
+		ProjectionTypeAll,
+		ProjectionTypeKeysOnly,
+		ProjectionTypeInclude,
+	}
+}
+
+// Enum values for ReturnConsumedCapacity.
+const (
+	ReturnConsumedCapacityIndexes = "INDEXES"
+	ReturnConsumedCapacityTotal   = "TOTAL"
+	ReturnConsumedCapacityNone    = "NONE"
+)
+
+// ReturnConsumedCapacityValues returns the valid values of ReturnConsumedCapacity.
+func ReturnConsumedCapacityValues() []string {
+	return []string{
+		ReturnConsumedCapacityIndexes,
+		ReturnConsumedCapacityTotal,
+		ReturnConsumedCapacityNone,
+	}
+}
+
+// Enum values for ReturnItemCollectionMetrics.
+const (
+	ReturnItemCollectionMetricsSize = "SIZE"
+	ReturnItemCollectionMetricsNone = "NONE"
+)
+
+// ReturnItemCollectionMetricsValues returns the valid values of ReturnItemCollectionMetrics.
+func ReturnItemCollectionMetricsValues() []string {
+	return []string{
+		ReturnItemCollectionMetricsSize,
+		ReturnItemCollectionMetricsNone,
+	}
+}
+
+// Enum values for ReturnValue.
+const (
+	ReturnValueNone       = "NONE"
+	ReturnValueAllOld     = "ALL_OLD"
+	ReturnValueUpdatedOld = "UPDATED_OLD"
+	ReturnValueAllNew     = "ALL_NEW"
+	ReturnValueUpdatedNew = "UPDATED_NEW"
+)
+
+// ReturnValueValues returns the valid values of ReturnValue.
+func ReturnValueValues() []string {
+	return []string{
+		ReturnValueNone,
+		ReturnValueAllOld,
+		ReturnValueUpdatedOld,
+		ReturnValueAllNew,
+		ReturnValueUpdatedNew,
+	}
+}
+
+// Enum values for ScalarAttributeType.
+const (
+	ScalarAttributeTypeS = "S"
+	ScalarAttributeTypeN = "N"
+	ScalarAttributeTypeB = "B"
+)
+
+// ScalarAttributeTypeValues returns the valid values of ScalarAttributeType.
+func ScalarAttributeTypeValues() []string {
+	return []string{
+		ScalarAttributeTypeS,
+		ScalarAttributeTypeN,
+		ScalarAttributeTypeB,
+	}
+}
+
+// Enum values for Select.
+const (
+	SelectAllAttributes          = "ALL_ATTRIBUTES"
+	SelectAllProjectedAttributes = "ALL_PROJECTED_ATTRIBUTES"
+	SelectSpecificAttributes     = "SPECIFIC_ATTRIBUTES"
+	SelectCount                  = "COUNT"
+)
+
+// SelectValues returns the valid values of Select.
+func SelectValues() []string {
+	return []string{
+		SelectAllAttributes,
+		SelectAllProjectedAttributes,
+		SelectSpecificAttributes,
+		SelectCount,
+	}
+}
+
+// Enum values for TableStatus.
+const (
+	TableStatusCreating = "CREATING"
+	TableStatusUpdating = "UPDATING"
+	TableStatusDeleting = "DELETING"
+	TableStatusActive   = "ACTIVE"
+)
+
+// TableStatusValues returns the valid values of TableStatus.
+func TableStatusValues() []string {
+	return []string{
+		TableStatusCreating,
+		TableStatusUpdating,
+		TableStatusDeleting,
+		TableStatusActive,
+	}
 }
\ No newline at end of file
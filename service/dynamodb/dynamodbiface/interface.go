@@ -1,33 +1,68 @@
 package dynamodbiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/dynamodb"
 )
 
 type DynamoDBAPI interface {
 	BatchGetItem(*dynamodb.BatchGetItemInput) (*dynamodb.BatchGetItemOutput, error)
 
+	BatchGetItemWithContext(context.Context, *dynamodb.BatchGetItemInput) (*dynamodb.BatchGetItemOutput, error)
+
 	BatchWriteItem(*dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error)
 
+	BatchWriteItemWithContext(context.Context, *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error)
+
 	CreateTable(*dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error)
 
+	CreateTableWithContext(context.Context, *dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error)
+
 	DeleteItem(*dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error)
 
+	DeleteItemWithContext(context.Context, *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error)
+
 	DeleteTable(*dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error)
 
+	DeleteTableWithContext(context.Context, *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error)
+
 	DescribeTable(*dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error)
 
+	DescribeTableWithContext(context.Context, *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error)
+
 	GetItem(*dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error)
 
+	GetItemWithContext(context.Context, *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error)
+
 	ListTables(*dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error)
 
+	ListTablesWithContext(context.Context, *dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error)
+	ListTablesPages(*dynamodb.ListTablesInput, func(*dynamodb.ListTablesOutput, bool) bool) error
+
 	PutItem(*dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error)
 
+	PutItemWithContext(context.Context, *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error)
+
 	Query(*dynamodb.QueryInput) (*dynamodb.QueryOutput, error)
 
+	QueryWithContext(context.Context, *dynamodb.QueryInput) (*dynamodb.QueryOutput, error)
+	QueryPages(*dynamodb.QueryInput, func(*dynamodb.QueryOutput, bool) bool) error
+
 	Scan(*dynamodb.ScanInput) (*dynamodb.ScanOutput, error)
 
+	ScanWithContext(context.Context, *dynamodb.ScanInput) (*dynamodb.ScanOutput, error)
+	ScanPages(*dynamodb.ScanInput, func(*dynamodb.ScanOutput, bool) bool) error
+
 	UpdateItem(*dynamodb.UpdateItemInput) (*dynamodb.UpdateItemOutput, error)
 
+	UpdateItemWithContext(context.Context, *dynamodb.UpdateItemInput) (*dynamodb.UpdateItemOutput, error)
+
 	UpdateTable(*dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error)
+
+	UpdateTableWithContext(context.Context, *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error)
+
+	WaitUntilTableExists(*dynamodb.DescribeTableInput) error
+
+	WaitUntilTableNotExists(*dynamodb.DescribeTableInput) error
 }
\ No newline at end of file
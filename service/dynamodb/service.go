@@ -29,6 +29,7 @@ func New(config *aws.Config) *DynamoDB {
 		APIVersion:   "2012-08-10",
 		JSONVersion:  "1.0",
 		TargetPrefix: "DynamoDB_20120810",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
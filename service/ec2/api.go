@@ -4,6 +4,7 @@
 package ec2
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -46,6 +47,17 @@ func (c *EC2) AcceptVPCPeeringConnection(input *AcceptVPCPeeringConnectionInput)
 	return
 }
 
+// AcceptVPCPeeringConnectionWithContext is the same as AcceptVPCPeeringConnection with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) AcceptVPCPeeringConnectionWithContext(ctx context.Context, input *AcceptVPCPeeringConnectionInput) (output *AcceptVPCPeeringConnectionOutput, err error) {
+	req, out := c.AcceptVPCPeeringConnectionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAcceptVPCPeeringConnection *aws.Operation
 
 // AllocateAddressRequest generates a request for the AllocateAddress operation.
@@ -83,6 +95,17 @@ func (c *EC2) AllocateAddress(input *AllocateAddressInput) (output *AllocateAddr
 	return
 }
 
+// AllocateAddressWithContext is the same as AllocateAddress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) AllocateAddressWithContext(ctx context.Context, input *AllocateAddressInput) (output *AllocateAddressOutput, err error) {
+	req, out := c.AllocateAddressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAllocateAddress *aws.Operation
 
 // AssignPrivateIPAddressesRequest generates a request for the AssignPrivateIPAddresses operation.
@@ -126,6 +149,17 @@ func (c *EC2) AssignPrivateIPAddresses(input *AssignPrivateIPAddressesInput) (ou
 	return
 }
 
+// AssignPrivateIPAddressesWithContext is the same as AssignPrivateIPAddresses with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) AssignPrivateIPAddressesWithContext(ctx context.Context, input *AssignPrivateIPAddressesInput) (output *AssignPrivateIPAddressesOutput, err error) {
+	req, out := c.AssignPrivateIPAddressesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAssignPrivateIPAddresses *aws.Operation
 
 // AssociateAddressRequest generates a request for the AssociateAddress operation.
@@ -175,6 +209,17 @@ func (c *EC2) AssociateAddress(input *AssociateAddressInput) (output *AssociateA
 	return
 }
 
+// AssociateAddressWithContext is the same as AssociateAddress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) AssociateAddressWithContext(ctx context.Context, input *AssociateAddressInput) (output *AssociateAddressOutput, err error) {
+	req, out := c.AssociateAddressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAssociateAddress *aws.Operation
 
 // AssociateDHCPOptionsRequest generates a request for the AssociateDHCPOptions operation.
@@ -219,6 +264,17 @@ func (c *EC2) AssociateDHCPOptions(input *AssociateDHCPOptionsInput) (output *As
 	return
 }
 
+// AssociateDHCPOptionsWithContext is the same as AssociateDHCPOptions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) AssociateDHCPOptionsWithContext(ctx context.Context, input *AssociateDHCPOptionsInput) (output *AssociateDHCPOptionsOutput, err error) {
+	req, out := c.AssociateDHCPOptionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAssociateDHCPOptions *aws.Operation
 
 // AssociateRouteTableRequest generates a request for the AssociateRouteTable operation.
@@ -259,6 +315,17 @@ func (c *EC2) AssociateRouteTable(input *AssociateRouteTableInput) (output *Asso
 	return
 }
 
+// AssociateRouteTableWithContext is the same as AssociateRouteTable with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) AssociateRouteTableWithContext(ctx context.Context, input *AssociateRouteTableInput) (output *AssociateRouteTableOutput, err error) {
+	req, out := c.AssociateRouteTableRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAssociateRouteTable *aws.Operation
 
 // AttachClassicLinkVPCRequest generates a request for the AttachClassicLinkVPC operation.
@@ -303,6 +370,17 @@ func (c *EC2) AttachClassicLinkVPC(input *AttachClassicLinkVPCInput) (output *At
 	return
 }
 
+// AttachClassicLinkVPCWithContext is the same as AttachClassicLinkVPC with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) AttachClassicLinkVPCWithContext(ctx context.Context, input *AttachClassicLinkVPCInput) (output *AttachClassicLinkVPCOutput, err error) {
+	req, out := c.AttachClassicLinkVPCRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAttachClassicLinkVPC *aws.Operation
 
 // AttachInternetGatewayRequest generates a request for the AttachInternetGateway operation.
@@ -338,6 +416,17 @@ func (c *EC2) AttachInternetGateway(input *AttachInternetGatewayInput) (output *
 	return
 }
 
+// AttachInternetGatewayWithContext is the same as AttachInternetGateway with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) AttachInternetGatewayWithContext(ctx context.Context, input *AttachInternetGatewayInput) (output *AttachInternetGatewayOutput, err error) {
+	req, out := c.AttachInternetGatewayRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAttachInternetGateway *aws.Operation
 
 // AttachNetworkInterfaceRequest generates a request for the AttachNetworkInterface operation.
@@ -371,6 +460,17 @@ func (c *EC2) AttachNetworkInterface(input *AttachNetworkInterfaceInput) (output
 	return
 }
 
+// AttachNetworkInterfaceWithContext is the same as AttachNetworkInterface with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) AttachNetworkInterfaceWithContext(ctx context.Context, input *AttachNetworkInterfaceInput) (output *AttachNetworkInterfaceOutput, err error) {
+	req, out := c.AttachNetworkInterfaceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAttachNetworkInterface *aws.Operation
 
 // AttachVPNGatewayRequest generates a request for the AttachVPNGateway operation.
@@ -406,6 +506,17 @@ func (c *EC2) AttachVPNGateway(input *AttachVPNGatewayInput) (output *AttachVPNG
 	return
 }
 
+// AttachVPNGatewayWithContext is the same as AttachVPNGateway with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) AttachVPNGatewayWithContext(ctx context.Context, input *AttachVPNGatewayInput) (output *AttachVPNGatewayOutput, err error) {
+	req, out := c.AttachVPNGatewayRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAttachVPNGateway *aws.Operation
 
 // AttachVolumeRequest generates a request for the AttachVolume operation.
@@ -464,6 +575,17 @@ func (c *EC2) AttachVolume(input *AttachVolumeInput) (output *VolumeAttachment,
 	return
 }
 
+// AttachVolumeWithContext is the same as AttachVolume with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) AttachVolumeWithContext(ctx context.Context, input *AttachVolumeInput) (output *VolumeAttachment, err error) {
+	req, out := c.AttachVolumeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAttachVolume *aws.Operation
 
 // AuthorizeSecurityGroupEgressRequest generates a request for the AuthorizeSecurityGroupEgress operation.
@@ -517,6 +639,17 @@ func (c *EC2) AuthorizeSecurityGroupEgress(input *AuthorizeSecurityGroupEgressIn
 	return
 }
 
+// AuthorizeSecurityGroupEgressWithContext is the same as AuthorizeSecurityGroupEgress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) AuthorizeSecurityGroupEgressWithContext(ctx context.Context, input *AuthorizeSecurityGroupEgressInput) (output *AuthorizeSecurityGroupEgressOutput, err error) {
+	req, out := c.AuthorizeSecurityGroupEgressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAuthorizeSecurityGroupEgress *aws.Operation
 
 // AuthorizeSecurityGroupIngressRequest generates a request for the AuthorizeSecurityGroupIngress operation.
@@ -568,6 +701,17 @@ func (c *EC2) AuthorizeSecurityGroupIngress(input *AuthorizeSecurityGroupIngress
 	return
 }
 
+// AuthorizeSecurityGroupIngressWithContext is the same as AuthorizeSecurityGroupIngress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) AuthorizeSecurityGroupIngressWithContext(ctx context.Context, input *AuthorizeSecurityGroupIngressInput) (output *AuthorizeSecurityGroupIngressOutput, err error) {
+	req, out := c.AuthorizeSecurityGroupIngressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAuthorizeSecurityGroupIngress *aws.Operation
 
 // BundleInstanceRequest generates a request for the BundleInstance operation.
@@ -610,6 +754,17 @@ func (c *EC2) BundleInstance(input *BundleInstanceInput) (output *BundleInstance
 	return
 }
 
+// BundleInstanceWithContext is the same as BundleInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) BundleInstanceWithContext(ctx context.Context, input *BundleInstanceInput) (output *BundleInstanceOutput, err error) {
+	req, out := c.BundleInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opBundleInstance *aws.Operation
 
 // CancelBundleTaskRequest generates a request for the CancelBundleTask operation.
@@ -643,6 +798,17 @@ func (c *EC2) CancelBundleTask(input *CancelBundleTaskInput) (output *CancelBund
 	return
 }
 
+// CancelBundleTaskWithContext is the same as CancelBundleTask with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CancelBundleTaskWithContext(ctx context.Context, input *CancelBundleTaskInput) (output *CancelBundleTaskOutput, err error) {
+	req, out := c.CancelBundleTaskRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCancelBundleTask *aws.Operation
 
 // CancelConversionTaskRequest generates a request for the CancelConversionTask operation.
@@ -684,6 +850,17 @@ func (c *EC2) CancelConversionTask(input *CancelConversionTaskInput) (output *Ca
 	return
 }
 
+// CancelConversionTaskWithContext is the same as CancelConversionTask with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CancelConversionTaskWithContext(ctx context.Context, input *CancelConversionTaskInput) (output *CancelConversionTaskOutput, err error) {
+	req, out := c.CancelConversionTaskRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCancelConversionTask *aws.Operation
 
 // CancelExportTaskRequest generates a request for the CancelExportTask operation.
@@ -720,6 +897,17 @@ func (c *EC2) CancelExportTask(input *CancelExportTaskInput) (output *CancelExpo
 	return
 }
 
+// CancelExportTaskWithContext is the same as CancelExportTask with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CancelExportTaskWithContext(ctx context.Context, input *CancelExportTaskInput) (output *CancelExportTaskOutput, err error) {
+	req, out := c.CancelExportTaskRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCancelExportTask *aws.Operation
 
 // CancelReservedInstancesListingRequest generates a request for the CancelReservedInstancesListing operation.
@@ -757,6 +945,17 @@ func (c *EC2) CancelReservedInstancesListing(input *CancelReservedInstancesListi
 	return
 }
 
+// CancelReservedInstancesListingWithContext is the same as CancelReservedInstancesListing with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CancelReservedInstancesListingWithContext(ctx context.Context, input *CancelReservedInstancesListingInput) (output *CancelReservedInstancesListingOutput, err error) {
+	req, out := c.CancelReservedInstancesListingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCancelReservedInstancesListing *aws.Operation
 
 // CancelSpotInstanceRequestsRequest generates a request for the CancelSpotInstanceRequests operation.
@@ -798,6 +997,17 @@ func (c *EC2) CancelSpotInstanceRequests(input *CancelSpotInstanceRequestsInput)
 	return
 }
 
+// CancelSpotInstanceRequestsWithContext is the same as CancelSpotInstanceRequests with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CancelSpotInstanceRequestsWithContext(ctx context.Context, input *CancelSpotInstanceRequestsInput) (output *CancelSpotInstanceRequestsOutput, err error) {
+	req, out := c.CancelSpotInstanceRequestsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCancelSpotInstanceRequests *aws.Operation
 
 // ConfirmProductInstanceRequest generates a request for the ConfirmProductInstance operation.
@@ -834,6 +1044,17 @@ func (c *EC2) ConfirmProductInstance(input *ConfirmProductInstanceInput) (output
 	return
 }
 
+// ConfirmProductInstanceWithContext is the same as ConfirmProductInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ConfirmProductInstanceWithContext(ctx context.Context, input *ConfirmProductInstanceInput) (output *ConfirmProductInstanceOutput, err error) {
+	req, out := c.ConfirmProductInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opConfirmProductInstance *aws.Operation
 
 // CopyImageRequest generates a request for the CopyImage operation.
@@ -873,6 +1094,17 @@ func (c *EC2) CopyImage(input *CopyImageInput) (output *CopyImageOutput, err err
 	return
 }
 
+// CopyImageWithContext is the same as CopyImage with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CopyImageWithContext(ctx context.Context, input *CopyImageInput) (output *CopyImageOutput, err error) {
+	req, out := c.CopyImageRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCopyImage *aws.Operation
 
 // CopySnapshotRequest generates a request for the CopySnapshot operation.
@@ -919,6 +1151,17 @@ func (c *EC2) CopySnapshot(input *CopySnapshotInput) (output *CopySnapshotOutput
 	return
 }
 
+// CopySnapshotWithContext is the same as CopySnapshot with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CopySnapshotWithContext(ctx context.Context, input *CopySnapshotInput) (output *CopySnapshotOutput, err error) {
+	req, out := c.CopySnapshotRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCopySnapshot *aws.Operation
 
 // CreateCustomerGatewayRequest generates a request for the CreateCustomerGateway operation.
@@ -970,6 +1213,17 @@ func (c *EC2) CreateCustomerGateway(input *CreateCustomerGatewayInput) (output *
 	return
 }
 
+// CreateCustomerGatewayWithContext is the same as CreateCustomerGateway with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateCustomerGatewayWithContext(ctx context.Context, input *CreateCustomerGatewayInput) (output *CreateCustomerGatewayOutput, err error) {
+	req, out := c.CreateCustomerGatewayRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateCustomerGateway *aws.Operation
 
 // CreateDHCPOptionsRequest generates a request for the CreateDHCPOptions operation.
@@ -1031,6 +1285,17 @@ func (c *EC2) CreateDHCPOptions(input *CreateDHCPOptionsInput) (output *CreateDH
 	return
 }
 
+// CreateDHCPOptionsWithContext is the same as CreateDHCPOptions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateDHCPOptionsWithContext(ctx context.Context, input *CreateDHCPOptionsInput) (output *CreateDHCPOptionsOutput, err error) {
+	req, out := c.CreateDHCPOptionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateDHCPOptions *aws.Operation
 
 // CreateImageRequest generates a request for the CreateImage operation.
@@ -1073,6 +1338,17 @@ func (c *EC2) CreateImage(input *CreateImageInput) (output *CreateImageOutput, e
 	return
 }
 
+// CreateImageWithContext is the same as CreateImage with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateImageWithContext(ctx context.Context, input *CreateImageInput) (output *CreateImageOutput, err error) {
+	req, out := c.CreateImageRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateImage *aws.Operation
 
 // CreateInstanceExportTaskRequest generates a request for the CreateInstanceExportTask operation.
@@ -1111,6 +1387,17 @@ func (c *EC2) CreateInstanceExportTask(input *CreateInstanceExportTaskInput) (ou
 	return
 }
 
+// CreateInstanceExportTaskWithContext is the same as CreateInstanceExportTask with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateInstanceExportTaskWithContext(ctx context.Context, input *CreateInstanceExportTaskInput) (output *CreateInstanceExportTaskOutput, err error) {
+	req, out := c.CreateInstanceExportTaskRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateInstanceExportTask *aws.Operation
 
 // CreateInternetGatewayRequest generates a request for the CreateInternetGateway operation.
@@ -1148,6 +1435,17 @@ func (c *EC2) CreateInternetGateway(input *CreateInternetGatewayInput) (output *
 	return
 }
 
+// CreateInternetGatewayWithContext is the same as CreateInternetGateway with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateInternetGatewayWithContext(ctx context.Context, input *CreateInternetGatewayInput) (output *CreateInternetGatewayOutput, err error) {
+	req, out := c.CreateInternetGatewayRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateInternetGateway *aws.Operation
 
 // CreateKeyPairRequest generates a request for the CreateKeyPair operation.
@@ -1192,6 +1490,17 @@ func (c *EC2) CreateKeyPair(input *CreateKeyPairInput) (output *CreateKeyPairOut
 	return
 }
 
+// CreateKeyPairWithContext is the same as CreateKeyPair with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateKeyPairWithContext(ctx context.Context, input *CreateKeyPairInput) (output *CreateKeyPairOutput, err error) {
+	req, out := c.CreateKeyPairRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateKeyPair *aws.Operation
 
 // CreateNetworkACLRequest generates a request for the CreateNetworkACL operation.
@@ -1229,6 +1538,17 @@ func (c *EC2) CreateNetworkACL(input *CreateNetworkACLInput) (output *CreateNetw
 	return
 }
 
+// CreateNetworkACLWithContext is the same as CreateNetworkACL with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateNetworkACLWithContext(ctx context.Context, input *CreateNetworkACLInput) (output *CreateNetworkACLOutput, err error) {
+	req, out := c.CreateNetworkACLRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateNetworkACL *aws.Operation
 
 // CreateNetworkACLEntryRequest generates a request for the CreateNetworkACLEntry operation.
@@ -1278,6 +1598,17 @@ func (c *EC2) CreateNetworkACLEntry(input *CreateNetworkACLEntryInput) (output *
 	return
 }
 
+// CreateNetworkACLEntryWithContext is the same as CreateNetworkACLEntry with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateNetworkACLEntryWithContext(ctx context.Context, input *CreateNetworkACLEntryInput) (output *CreateNetworkACLEntryOutput, err error) {
+	req, out := c.CreateNetworkACLEntryRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateNetworkACLEntry *aws.Operation
 
 // CreateNetworkInterfaceRequest generates a request for the CreateNetworkInterface operation.
@@ -1315,6 +1646,17 @@ func (c *EC2) CreateNetworkInterface(input *CreateNetworkInterfaceInput) (output
 	return
 }
 
+// CreateNetworkInterfaceWithContext is the same as CreateNetworkInterface with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateNetworkInterfaceWithContext(ctx context.Context, input *CreateNetworkInterfaceInput) (output *CreateNetworkInterfaceOutput, err error) {
+	req, out := c.CreateNetworkInterfaceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateNetworkInterface *aws.Operation
 
 // CreatePlacementGroupRequest generates a request for the CreatePlacementGroup operation.
@@ -1353,6 +1695,17 @@ func (c *EC2) CreatePlacementGroup(input *CreatePlacementGroupInput) (output *Cr
 	return
 }
 
+// CreatePlacementGroupWithContext is the same as CreatePlacementGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreatePlacementGroupWithContext(ctx context.Context, input *CreatePlacementGroupInput) (output *CreatePlacementGroupOutput, err error) {
+	req, out := c.CreatePlacementGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreatePlacementGroup *aws.Operation
 
 // CreateReservedInstancesListingRequest generates a request for the CreateReservedInstancesListing operation.
@@ -1405,6 +1758,17 @@ func (c *EC2) CreateReservedInstancesListing(input *CreateReservedInstancesListi
 	return
 }
 
+// CreateReservedInstancesListingWithContext is the same as CreateReservedInstancesListing with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateReservedInstancesListingWithContext(ctx context.Context, input *CreateReservedInstancesListingInput) (output *CreateReservedInstancesListingOutput, err error) {
+	req, out := c.CreateReservedInstancesListingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateReservedInstancesListing *aws.Operation
 
 // CreateRouteRequest generates a request for the CreateRoute operation.
@@ -1456,6 +1820,17 @@ func (c *EC2) CreateRoute(input *CreateRouteInput) (output *CreateRouteOutput, e
 	return
 }
 
+// CreateRouteWithContext is the same as CreateRoute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateRouteWithContext(ctx context.Context, input *CreateRouteInput) (output *CreateRouteOutput, err error) {
+	req, out := c.CreateRouteRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateRoute *aws.Operation
 
 // CreateRouteTableRequest generates a request for the CreateRouteTable operation.
@@ -1493,6 +1868,17 @@ func (c *EC2) CreateRouteTable(input *CreateRouteTableInput) (output *CreateRout
 	return
 }
 
+// CreateRouteTableWithContext is the same as CreateRouteTable with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateRouteTableWithContext(ctx context.Context, input *CreateRouteTableInput) (output *CreateRouteTableOutput, err error) {
+	req, out := c.CreateRouteTableRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateRouteTable *aws.Operation
 
 // CreateSecurityGroupRequest generates a request for the CreateSecurityGroup operation.
@@ -1552,6 +1938,17 @@ func (c *EC2) CreateSecurityGroup(input *CreateSecurityGroupInput) (output *Crea
 	return
 }
 
+// CreateSecurityGroupWithContext is the same as CreateSecurityGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateSecurityGroupWithContext(ctx context.Context, input *CreateSecurityGroupInput) (output *CreateSecurityGroupOutput, err error) {
+	req, out := c.CreateSecurityGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateSecurityGroup *aws.Operation
 
 // CreateSnapshotRequest generates a request for the CreateSnapshot operation.
@@ -1613,6 +2010,17 @@ func (c *EC2) CreateSnapshot(input *CreateSnapshotInput) (output *Snapshot, err
 	return
 }
 
+// CreateSnapshotWithContext is the same as CreateSnapshot with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateSnapshotWithContext(ctx context.Context, input *CreateSnapshotInput) (output *Snapshot, err error) {
+	req, out := c.CreateSnapshotRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateSnapshot *aws.Operation
 
 // CreateSpotDatafeedSubscriptionRequest generates a request for the CreateSpotDatafeedSubscription operation.
@@ -1649,6 +2057,17 @@ func (c *EC2) CreateSpotDatafeedSubscription(input *CreateSpotDatafeedSubscripti
 	return
 }
 
+// CreateSpotDatafeedSubscriptionWithContext is the same as CreateSpotDatafeedSubscription with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateSpotDatafeedSubscriptionWithContext(ctx context.Context, input *CreateSpotDatafeedSubscriptionInput) (output *CreateSpotDatafeedSubscriptionOutput, err error) {
+	req, out := c.CreateSpotDatafeedSubscriptionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateSpotDatafeedSubscription *aws.Operation
 
 // CreateSubnetRequest generates a request for the CreateSubnet operation.
@@ -1706,6 +2125,17 @@ func (c *EC2) CreateSubnet(input *CreateSubnetInput) (output *CreateSubnetOutput
 	return
 }
 
+// CreateSubnetWithContext is the same as CreateSubnet with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateSubnetWithContext(ctx context.Context, input *CreateSubnetInput) (output *CreateSubnetOutput, err error) {
+	req, out := c.CreateSubnetRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateSubnet *aws.Operation
 
 // CreateTagsRequest generates a request for the CreateTags operation.
@@ -1744,6 +2174,17 @@ func (c *EC2) CreateTags(input *CreateTagsInput) (output *CreateTagsOutput, err
 	return
 }
 
+// CreateTagsWithContext is the same as CreateTags with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateTagsWithContext(ctx context.Context, input *CreateTagsInput) (output *CreateTagsOutput, err error) {
+	req, out := c.CreateTagsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateTags *aws.Operation
 
 // CreateVPCRequest generates a request for the CreateVPC operation.
@@ -1787,6 +2228,17 @@ func (c *EC2) CreateVPC(input *CreateVPCInput) (output *CreateVPCOutput, err err
 	return
 }
 
+// CreateVPCWithContext is the same as CreateVPC with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateVPCWithContext(ctx context.Context, input *CreateVPCInput) (output *CreateVPCOutput, err error) {
+	req, out := c.CreateVPCRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateVPC *aws.Operation
 
 // CreateVPCPeeringConnectionRequest generates a request for the CreateVPCPeeringConnection operation.
@@ -1830,6 +2282,17 @@ func (c *EC2) CreateVPCPeeringConnection(input *CreateVPCPeeringConnectionInput)
 	return
 }
 
+// CreateVPCPeeringConnectionWithContext is the same as CreateVPCPeeringConnection with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateVPCPeeringConnectionWithContext(ctx context.Context, input *CreateVPCPeeringConnectionInput) (output *CreateVPCPeeringConnectionOutput, err error) {
+	req, out := c.CreateVPCPeeringConnectionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateVPCPeeringConnection *aws.Operation
 
 // CreateVPNConnectionRequest generates a request for the CreateVPNConnection operation.
@@ -1879,10 +2342,21 @@ func (c *EC2) CreateVPNConnection(input *CreateVPNConnectionInput) (output *Crea
 	return
 }
 
-var opCreateVPNConnection *aws.Operation
-
-// CreateVPNConnectionRouteRequest generates a request for the CreateVPNConnectionRoute operation.
-func (c *EC2) CreateVPNConnectionRouteRequest(input *CreateVPNConnectionRouteInput) (req *aws.Request, output *CreateVPNConnectionRouteOutput) {
+// CreateVPNConnectionWithContext is the same as CreateVPNConnection with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateVPNConnectionWithContext(ctx context.Context, input *CreateVPNConnectionInput) (output *CreateVPNConnectionOutput, err error) {
+	req, out := c.CreateVPNConnectionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+var opCreateVPNConnection *aws.Operation
+
+// CreateVPNConnectionRouteRequest generates a request for the CreateVPNConnectionRoute operation.
+func (c *EC2) CreateVPNConnectionRouteRequest(input *CreateVPNConnectionRouteInput) (req *aws.Request, output *CreateVPNConnectionRouteOutput) {
 	oprw.Lock()
 	defer oprw.Unlock()
 
@@ -1919,6 +2393,17 @@ func (c *EC2) CreateVPNConnectionRoute(input *CreateVPNConnectionRouteInput) (ou
 	return
 }
 
+// CreateVPNConnectionRouteWithContext is the same as CreateVPNConnectionRoute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateVPNConnectionRouteWithContext(ctx context.Context, input *CreateVPNConnectionRouteInput) (output *CreateVPNConnectionRouteOutput, err error) {
+	req, out := c.CreateVPNConnectionRouteRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateVPNConnectionRoute *aws.Operation
 
 // CreateVPNGatewayRequest generates a request for the CreateVPNGateway operation.
@@ -1958,6 +2443,17 @@ func (c *EC2) CreateVPNGateway(input *CreateVPNGatewayInput) (output *CreateVPNG
 	return
 }
 
+// CreateVPNGatewayWithContext is the same as CreateVPNGateway with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateVPNGatewayWithContext(ctx context.Context, input *CreateVPNGatewayInput) (output *CreateVPNGatewayOutput, err error) {
+	req, out := c.CreateVPNGatewayRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateVPNGateway *aws.Operation
 
 // CreateVolumeRequest generates a request for the CreateVolume operation.
@@ -2007,6 +2503,17 @@ func (c *EC2) CreateVolume(input *CreateVolumeInput) (output *Volume, err error)
 	return
 }
 
+// CreateVolumeWithContext is the same as CreateVolume with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) CreateVolumeWithContext(ctx context.Context, input *CreateVolumeInput) (output *Volume, err error) {
+	req, out := c.CreateVolumeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateVolume *aws.Operation
 
 // DeleteCustomerGatewayRequest generates a request for the DeleteCustomerGateway operation.
@@ -2041,6 +2548,17 @@ func (c *EC2) DeleteCustomerGateway(input *DeleteCustomerGatewayInput) (output *
 	return
 }
 
+// DeleteCustomerGatewayWithContext is the same as DeleteCustomerGateway with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteCustomerGatewayWithContext(ctx context.Context, input *DeleteCustomerGatewayInput) (output *DeleteCustomerGatewayOutput, err error) {
+	req, out := c.DeleteCustomerGatewayRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteCustomerGateway *aws.Operation
 
 // DeleteDHCPOptionsRequest generates a request for the DeleteDHCPOptions operation.
@@ -2077,6 +2595,17 @@ func (c *EC2) DeleteDHCPOptions(input *DeleteDHCPOptionsInput) (output *DeleteDH
 	return
 }
 
+// DeleteDHCPOptionsWithContext is the same as DeleteDHCPOptions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteDHCPOptionsWithContext(ctx context.Context, input *DeleteDHCPOptionsInput) (output *DeleteDHCPOptionsOutput, err error) {
+	req, out := c.DeleteDHCPOptionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteDHCPOptions *aws.Operation
 
 // DeleteInternetGatewayRequest generates a request for the DeleteInternetGateway operation.
@@ -2111,6 +2640,17 @@ func (c *EC2) DeleteInternetGateway(input *DeleteInternetGatewayInput) (output *
 	return
 }
 
+// DeleteInternetGatewayWithContext is the same as DeleteInternetGateway with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteInternetGatewayWithContext(ctx context.Context, input *DeleteInternetGatewayInput) (output *DeleteInternetGatewayOutput, err error) {
+	req, out := c.DeleteInternetGatewayRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteInternetGateway *aws.Operation
 
 // DeleteKeyPairRequest generates a request for the DeleteKeyPair operation.
@@ -2144,6 +2684,17 @@ func (c *EC2) DeleteKeyPair(input *DeleteKeyPairInput) (output *DeleteKeyPairOut
 	return
 }
 
+// DeleteKeyPairWithContext is the same as DeleteKeyPair with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteKeyPairWithContext(ctx context.Context, input *DeleteKeyPairInput) (output *DeleteKeyPairOutput, err error) {
+	req, out := c.DeleteKeyPairRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteKeyPair *aws.Operation
 
 // DeleteNetworkACLRequest generates a request for the DeleteNetworkACL operation.
@@ -2178,6 +2729,17 @@ func (c *EC2) DeleteNetworkACL(input *DeleteNetworkACLInput) (output *DeleteNetw
 	return
 }
 
+// DeleteNetworkACLWithContext is the same as DeleteNetworkACL with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteNetworkACLWithContext(ctx context.Context, input *DeleteNetworkACLInput) (output *DeleteNetworkACLOutput, err error) {
+	req, out := c.DeleteNetworkACLRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteNetworkACL *aws.Operation
 
 // DeleteNetworkACLEntryRequest generates a request for the DeleteNetworkACLEntry operation.
@@ -2212,6 +2774,17 @@ func (c *EC2) DeleteNetworkACLEntry(input *DeleteNetworkACLEntryInput) (output *
 	return
 }
 
+// DeleteNetworkACLEntryWithContext is the same as DeleteNetworkACLEntry with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteNetworkACLEntryWithContext(ctx context.Context, input *DeleteNetworkACLEntryInput) (output *DeleteNetworkACLEntryOutput, err error) {
+	req, out := c.DeleteNetworkACLEntryRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteNetworkACLEntry *aws.Operation
 
 // DeleteNetworkInterfaceRequest generates a request for the DeleteNetworkInterface operation.
@@ -2246,6 +2819,17 @@ func (c *EC2) DeleteNetworkInterface(input *DeleteNetworkInterfaceInput) (output
 	return
 }
 
+// DeleteNetworkInterfaceWithContext is the same as DeleteNetworkInterface with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteNetworkInterfaceWithContext(ctx context.Context, input *DeleteNetworkInterfaceInput) (output *DeleteNetworkInterfaceOutput, err error) {
+	req, out := c.DeleteNetworkInterfaceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteNetworkInterface *aws.Operation
 
 // DeletePlacementGroupRequest generates a request for the DeletePlacementGroup operation.
@@ -2282,6 +2866,17 @@ func (c *EC2) DeletePlacementGroup(input *DeletePlacementGroupInput) (output *De
 	return
 }
 
+// DeletePlacementGroupWithContext is the same as DeletePlacementGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeletePlacementGroupWithContext(ctx context.Context, input *DeletePlacementGroupInput) (output *DeletePlacementGroupOutput, err error) {
+	req, out := c.DeletePlacementGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeletePlacementGroup *aws.Operation
 
 // DeleteRouteRequest generates a request for the DeleteRoute operation.
@@ -2315,6 +2910,17 @@ func (c *EC2) DeleteRoute(input *DeleteRouteInput) (output *DeleteRouteOutput, e
 	return
 }
 
+// DeleteRouteWithContext is the same as DeleteRoute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteRouteWithContext(ctx context.Context, input *DeleteRouteInput) (output *DeleteRouteOutput, err error) {
+	req, out := c.DeleteRouteRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteRoute *aws.Operation
 
 // DeleteRouteTableRequest generates a request for the DeleteRouteTable operation.
@@ -2350,6 +2956,17 @@ func (c *EC2) DeleteRouteTable(input *DeleteRouteTableInput) (output *DeleteRout
 	return
 }
 
+// DeleteRouteTableWithContext is the same as DeleteRouteTable with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteRouteTableWithContext(ctx context.Context, input *DeleteRouteTableInput) (output *DeleteRouteTableOutput, err error) {
+	req, out := c.DeleteRouteTableRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteRouteTable *aws.Operation
 
 // DeleteSecurityGroupRequest generates a request for the DeleteSecurityGroup operation.
@@ -2387,6 +3004,17 @@ func (c *EC2) DeleteSecurityGroup(input *DeleteSecurityGroupInput) (output *Dele
 	return
 }
 
+// DeleteSecurityGroupWithContext is the same as DeleteSecurityGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteSecurityGroupWithContext(ctx context.Context, input *DeleteSecurityGroupInput) (output *DeleteSecurityGroupOutput, err error) {
+	req, out := c.DeleteSecurityGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteSecurityGroup *aws.Operation
 
 // DeleteSnapshotRequest generates a request for the DeleteSnapshot operation.
@@ -2434,6 +3062,17 @@ func (c *EC2) DeleteSnapshot(input *DeleteSnapshotInput) (output *DeleteSnapshot
 	return
 }
 
+// DeleteSnapshotWithContext is the same as DeleteSnapshot with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteSnapshotWithContext(ctx context.Context, input *DeleteSnapshotInput) (output *DeleteSnapshotOutput, err error) {
+	req, out := c.DeleteSnapshotRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteSnapshot *aws.Operation
 
 // DeleteSpotDatafeedSubscriptionRequest generates a request for the DeleteSpotDatafeedSubscription operation.
@@ -2469,6 +3108,17 @@ func (c *EC2) DeleteSpotDatafeedSubscription(input *DeleteSpotDatafeedSubscripti
 	return
 }
 
+// DeleteSpotDatafeedSubscriptionWithContext is the same as DeleteSpotDatafeedSubscription with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteSpotDatafeedSubscriptionWithContext(ctx context.Context, input *DeleteSpotDatafeedSubscriptionInput) (output *DeleteSpotDatafeedSubscriptionOutput, err error) {
+	req, out := c.DeleteSpotDatafeedSubscriptionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteSpotDatafeedSubscription *aws.Operation
 
 // DeleteSubnetRequest generates a request for the DeleteSubnet operation.
@@ -2503,6 +3153,17 @@ func (c *EC2) DeleteSubnet(input *DeleteSubnetInput) (output *DeleteSubnetOutput
 	return
 }
 
+// DeleteSubnetWithContext is the same as DeleteSubnet with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteSubnetWithContext(ctx context.Context, input *DeleteSubnetInput) (output *DeleteSubnetOutput, err error) {
+	req, out := c.DeleteSubnetRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteSubnet *aws.Operation
 
 // DeleteTagsRequest generates a request for the DeleteTags operation.
@@ -2540,6 +3201,17 @@ func (c *EC2) DeleteTags(input *DeleteTagsInput) (output *DeleteTagsOutput, err
 	return
 }
 
+// DeleteTagsWithContext is the same as DeleteTags with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteTagsWithContext(ctx context.Context, input *DeleteTagsInput) (output *DeleteTagsOutput, err error) {
+	req, out := c.DeleteTagsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteTags *aws.Operation
 
 // DeleteVPCRequest generates a request for the DeleteVPC operation.
@@ -2577,6 +3249,17 @@ func (c *EC2) DeleteVPC(input *DeleteVPCInput) (output *DeleteVPCOutput, err err
 	return
 }
 
+// DeleteVPCWithContext is the same as DeleteVPC with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteVPCWithContext(ctx context.Context, input *DeleteVPCInput) (output *DeleteVPCOutput, err error) {
+	req, out := c.DeleteVPCRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteVPC *aws.Operation
 
 // DeleteVPCPeeringConnectionRequest generates a request for the DeleteVPCPeeringConnection operation.
@@ -2613,6 +3296,17 @@ func (c *EC2) DeleteVPCPeeringConnection(input *DeleteVPCPeeringConnectionInput)
 	return
 }
 
+// DeleteVPCPeeringConnectionWithContext is the same as DeleteVPCPeeringConnection with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteVPCPeeringConnectionWithContext(ctx context.Context, input *DeleteVPCPeeringConnectionInput) (output *DeleteVPCPeeringConnectionOutput, err error) {
+	req, out := c.DeleteVPCPeeringConnectionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteVPCPeeringConnection *aws.Operation
 
 // DeleteVPNConnectionRequest generates a request for the DeleteVPNConnection operation.
@@ -2655,6 +3349,17 @@ func (c *EC2) DeleteVPNConnection(input *DeleteVPNConnectionInput) (output *Dele
 	return
 }
 
+// DeleteVPNConnectionWithContext is the same as DeleteVPNConnection with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteVPNConnectionWithContext(ctx context.Context, input *DeleteVPNConnectionInput) (output *DeleteVPNConnectionOutput, err error) {
+	req, out := c.DeleteVPNConnectionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteVPNConnection *aws.Operation
 
 // DeleteVPNConnectionRouteRequest generates a request for the DeleteVPNConnectionRoute operation.
@@ -2691,6 +3396,17 @@ func (c *EC2) DeleteVPNConnectionRoute(input *DeleteVPNConnectionRouteInput) (ou
 	return
 }
 
+// DeleteVPNConnectionRouteWithContext is the same as DeleteVPNConnectionRoute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteVPNConnectionRouteWithContext(ctx context.Context, input *DeleteVPNConnectionRouteInput) (output *DeleteVPNConnectionRouteOutput, err error) {
+	req, out := c.DeleteVPNConnectionRouteRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteVPNConnectionRoute *aws.Operation
 
 // DeleteVPNGatewayRequest generates a request for the DeleteVPNGateway operation.
@@ -2728,6 +3444,17 @@ func (c *EC2) DeleteVPNGateway(input *DeleteVPNGatewayInput) (output *DeleteVPNG
 	return
 }
 
+// DeleteVPNGatewayWithContext is the same as DeleteVPNGateway with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteVPNGatewayWithContext(ctx context.Context, input *DeleteVPNGatewayInput) (output *DeleteVPNGatewayOutput, err error) {
+	req, out := c.DeleteVPNGatewayRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteVPNGateway *aws.Operation
 
 // DeleteVolumeRequest generates a request for the DeleteVolume operation.
@@ -2767,6 +3494,17 @@ func (c *EC2) DeleteVolume(input *DeleteVolumeInput) (output *DeleteVolumeOutput
 	return
 }
 
+// DeleteVolumeWithContext is the same as DeleteVolume with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeleteVolumeWithContext(ctx context.Context, input *DeleteVolumeInput) (output *DeleteVolumeOutput, err error) {
+	req, out := c.DeleteVolumeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteVolume *aws.Operation
 
 // DeregisterImageRequest generates a request for the DeregisterImage operation.
@@ -2803,6 +3541,17 @@ func (c *EC2) DeregisterImage(input *DeregisterImageInput) (output *DeregisterIm
 	return
 }
 
+// DeregisterImageWithContext is the same as DeregisterImage with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DeregisterImageWithContext(ctx context.Context, input *DeregisterImageInput) (output *DeregisterImageOutput, err error) {
+	req, out := c.DeregisterImageRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeregisterImage *aws.Operation
 
 // DescribeAccountAttributesRequest generates a request for the DescribeAccountAttributes operation.
@@ -2854,6 +3603,17 @@ func (c *EC2) DescribeAccountAttributes(input *DescribeAccountAttributesInput) (
 	return
 }
 
+// DescribeAccountAttributesWithContext is the same as DescribeAccountAttributes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeAccountAttributesWithContext(ctx context.Context, input *DescribeAccountAttributesInput) (output *DescribeAccountAttributesOutput, err error) {
+	req, out := c.DescribeAccountAttributesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeAccountAttributes *aws.Operation
 
 // DescribeAddressesRequest generates a request for the DescribeAddresses operation.
@@ -2891,6 +3651,17 @@ func (c *EC2) DescribeAddresses(input *DescribeAddressesInput) (output *Describe
 	return
 }
 
+// DescribeAddressesWithContext is the same as DescribeAddresses with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeAddressesWithContext(ctx context.Context, input *DescribeAddressesInput) (output *DescribeAddressesOutput, err error) {
+	req, out := c.DescribeAddressesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeAddresses *aws.Operation
 
 // DescribeAvailabilityZonesRequest generates a request for the DescribeAvailabilityZones operation.
@@ -2930,6 +3701,17 @@ func (c *EC2) DescribeAvailabilityZones(input *DescribeAvailabilityZonesInput) (
 	return
 }
 
+// DescribeAvailabilityZonesWithContext is the same as DescribeAvailabilityZones with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeAvailabilityZonesWithContext(ctx context.Context, input *DescribeAvailabilityZonesInput) (output *DescribeAvailabilityZonesOutput, err error) {
+	req, out := c.DescribeAvailabilityZonesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeAvailabilityZones *aws.Operation
 
 // DescribeBundleTasksRequest generates a request for the DescribeBundleTasks operation.
@@ -2968,6 +3750,17 @@ func (c *EC2) DescribeBundleTasks(input *DescribeBundleTasksInput) (output *Desc
 	return
 }
 
+// DescribeBundleTasksWithContext is the same as DescribeBundleTasks with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeBundleTasksWithContext(ctx context.Context, input *DescribeBundleTasksInput) (output *DescribeBundleTasksOutput, err error) {
+	req, out := c.DescribeBundleTasksRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeBundleTasks *aws.Operation
 
 // DescribeClassicLinkInstancesRequest generates a request for the DescribeClassicLinkInstances operation.
@@ -3004,6 +3797,17 @@ func (c *EC2) DescribeClassicLinkInstances(input *DescribeClassicLinkInstancesIn
 	return
 }
 
+// DescribeClassicLinkInstancesWithContext is the same as DescribeClassicLinkInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeClassicLinkInstancesWithContext(ctx context.Context, input *DescribeClassicLinkInstancesInput) (output *DescribeClassicLinkInstancesOutput, err error) {
+	req, out := c.DescribeClassicLinkInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeClassicLinkInstances *aws.Operation
 
 // DescribeConversionTasksRequest generates a request for the DescribeConversionTasks operation.
@@ -3040,6 +3844,17 @@ func (c *EC2) DescribeConversionTasks(input *DescribeConversionTasksInput) (outp
 	return
 }
 
+// DescribeConversionTasksWithContext is the same as DescribeConversionTasks with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeConversionTasksWithContext(ctx context.Context, input *DescribeConversionTasksInput) (output *DescribeConversionTasksOutput, err error) {
+	req, out := c.DescribeConversionTasksRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeConversionTasks *aws.Operation
 
 // DescribeCustomerGatewaysRequest generates a request for the DescribeCustomerGateways operation.
@@ -3077,6 +3892,17 @@ func (c *EC2) DescribeCustomerGateways(input *DescribeCustomerGatewaysInput) (ou
 	return
 }
 
+// DescribeCustomerGatewaysWithContext is the same as DescribeCustomerGateways with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeCustomerGatewaysWithContext(ctx context.Context, input *DescribeCustomerGatewaysInput) (output *DescribeCustomerGatewaysOutput, err error) {
+	req, out := c.DescribeCustomerGatewaysRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeCustomerGateways *aws.Operation
 
 // DescribeDHCPOptionsRequest generates a request for the DescribeDHCPOptions operation.
@@ -3113,6 +3939,17 @@ func (c *EC2) DescribeDHCPOptions(input *DescribeDHCPOptionsInput) (output *Desc
 	return
 }
 
+// DescribeDHCPOptionsWithContext is the same as DescribeDHCPOptions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeDHCPOptionsWithContext(ctx context.Context, input *DescribeDHCPOptionsInput) (output *DescribeDHCPOptionsOutput, err error) {
+	req, out := c.DescribeDHCPOptionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeDHCPOptions *aws.Operation
 
 // DescribeExportTasksRequest generates a request for the DescribeExportTasks operation.
@@ -3146,6 +3983,17 @@ func (c *EC2) DescribeExportTasks(input *DescribeExportTasksInput) (output *Desc
 	return
 }
 
+// DescribeExportTasksWithContext is the same as DescribeExportTasks with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeExportTasksWithContext(ctx context.Context, input *DescribeExportTasksInput) (output *DescribeExportTasksOutput, err error) {
+	req, out := c.DescribeExportTasksRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeExportTasks *aws.Operation
 
 // DescribeImageAttributeRequest generates a request for the DescribeImageAttribute operation.
@@ -3180,6 +4028,17 @@ func (c *EC2) DescribeImageAttribute(input *DescribeImageAttributeInput) (output
 	return
 }
 
+// DescribeImageAttributeWithContext is the same as DescribeImageAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeImageAttributeWithContext(ctx context.Context, input *DescribeImageAttributeInput) (output *DescribeImageAttributeOutput, err error) {
+	req, out := c.DescribeImageAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeImageAttribute *aws.Operation
 
 // DescribeImagesRequest generates a request for the DescribeImages operation.
@@ -3219,6 +4078,17 @@ func (c *EC2) DescribeImages(input *DescribeImagesInput) (output *DescribeImages
 	return
 }
 
+// DescribeImagesWithContext is the same as DescribeImages with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeImagesWithContext(ctx context.Context, input *DescribeImagesInput) (output *DescribeImagesOutput, err error) {
+	req, out := c.DescribeImagesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeImages *aws.Operation
 
 // DescribeInstanceAttributeRequest generates a request for the DescribeInstanceAttribute operation.
@@ -3256,6 +4126,17 @@ func (c *EC2) DescribeInstanceAttribute(input *DescribeInstanceAttributeInput) (
 	return
 }
 
+// DescribeInstanceAttributeWithContext is the same as DescribeInstanceAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeInstanceAttributeWithContext(ctx context.Context, input *DescribeInstanceAttributeInput) (output *DescribeInstanceAttributeOutput, err error) {
+	req, out := c.DescribeInstanceAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeInstanceAttribute *aws.Operation
 
 // DescribeInstanceStatusRequest generates a request for the DescribeInstanceStatus operation.
@@ -3268,6 +4149,12 @@ func (c *EC2) DescribeInstanceStatusRequest(input *DescribeInstanceStatusInput)
 			Name:       "DescribeInstanceStatus",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxResults",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -3347,6 +4234,27 @@ func (c *EC2) DescribeInstanceStatus(input *DescribeInstanceStatusInput) (output
 	return
 }
 
+// DescribeInstanceStatusWithContext is the same as DescribeInstanceStatus with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeInstanceStatusWithContext(ctx context.Context, input *DescribeInstanceStatusInput) (output *DescribeInstanceStatusOutput, err error) {
+	req, out := c.DescribeInstanceStatusRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeInstanceStatusPages iterates over the pages of a DescribeInstanceStatus operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *EC2) DescribeInstanceStatusPages(input *DescribeInstanceStatusInput, fn func(p *DescribeInstanceStatusOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeInstanceStatusRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeInstanceStatusOutput), lastPage)
+	})
+}
+
 var opDescribeInstanceStatus *aws.Operation
 
 // DescribeInstancesRequest generates a request for the DescribeInstances operation.
@@ -3359,6 +4267,12 @@ func (c *EC2) DescribeInstancesRequest(input *DescribeInstancesInput) (req *aws.
 			Name:       "DescribeInstances",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxResults",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -3389,6 +4303,27 @@ func (c *EC2) DescribeInstances(input *DescribeInstancesInput) (output *Describe
 	return
 }
 
+// DescribeInstancesWithContext is the same as DescribeInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeInstancesWithContext(ctx context.Context, input *DescribeInstancesInput) (output *DescribeInstancesOutput, err error) {
+	req, out := c.DescribeInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeInstancesPages iterates over the pages of a DescribeInstances operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *EC2) DescribeInstancesPages(input *DescribeInstancesInput, fn func(p *DescribeInstancesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeInstancesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeInstancesOutput), lastPage)
+	})
+}
+
 var opDescribeInstances *aws.Operation
 
 // DescribeInternetGatewaysRequest generates a request for the DescribeInternetGateways operation.
@@ -3422,6 +4357,17 @@ func (c *EC2) DescribeInternetGateways(input *DescribeInternetGatewaysInput) (ou
 	return
 }
 
+// DescribeInternetGatewaysWithContext is the same as DescribeInternetGateways with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeInternetGatewaysWithContext(ctx context.Context, input *DescribeInternetGatewaysInput) (output *DescribeInternetGatewaysOutput, err error) {
+	req, out := c.DescribeInternetGatewaysRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeInternetGateways *aws.Operation
 
 // DescribeKeyPairsRequest generates a request for the DescribeKeyPairs operation.
@@ -3458,8 +4404,19 @@ func (c *EC2) DescribeKeyPairs(input *DescribeKeyPairsInput) (output *DescribeKe
 	return
 }
 
-var opDescribeKeyPairs *aws.Operation
-
+// DescribeKeyPairsWithContext is the same as DescribeKeyPairs with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeKeyPairsWithContext(ctx context.Context, input *DescribeKeyPairsInput) (output *DescribeKeyPairsOutput, err error) {
+	req, out := c.DescribeKeyPairsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+var opDescribeKeyPairs *aws.Operation
+
 // DescribeNetworkACLsRequest generates a request for the DescribeNetworkACLs operation.
 func (c *EC2) DescribeNetworkACLsRequest(input *DescribeNetworkACLsInput) (req *aws.Request, output *DescribeNetworkACLsOutput) {
 	oprw.Lock()
@@ -3494,6 +4451,17 @@ func (c *EC2) DescribeNetworkACLs(input *DescribeNetworkACLsInput) (output *Desc
 	return
 }
 
+// DescribeNetworkACLsWithContext is the same as DescribeNetworkACLs with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeNetworkACLsWithContext(ctx context.Context, input *DescribeNetworkACLsInput) (output *DescribeNetworkACLsOutput, err error) {
+	req, out := c.DescribeNetworkACLsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeNetworkACLs *aws.Operation
 
 // DescribeNetworkInterfaceAttributeRequest generates a request for the DescribeNetworkInterfaceAttribute operation.
@@ -3528,6 +4496,17 @@ func (c *EC2) DescribeNetworkInterfaceAttribute(input *DescribeNetworkInterfaceA
 	return
 }
 
+// DescribeNetworkInterfaceAttributeWithContext is the same as DescribeNetworkInterfaceAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeNetworkInterfaceAttributeWithContext(ctx context.Context, input *DescribeNetworkInterfaceAttributeInput) (output *DescribeNetworkInterfaceAttributeOutput, err error) {
+	req, out := c.DescribeNetworkInterfaceAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeNetworkInterfaceAttribute *aws.Operation
 
 // DescribeNetworkInterfacesRequest generates a request for the DescribeNetworkInterfaces operation.
@@ -3561,6 +4540,17 @@ func (c *EC2) DescribeNetworkInterfaces(input *DescribeNetworkInterfacesInput) (
 	return
 }
 
+// DescribeNetworkInterfacesWithContext is the same as DescribeNetworkInterfaces with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeNetworkInterfacesWithContext(ctx context.Context, input *DescribeNetworkInterfacesInput) (output *DescribeNetworkInterfacesOutput, err error) {
+	req, out := c.DescribeNetworkInterfacesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeNetworkInterfaces *aws.Operation
 
 // DescribePlacementGroupsRequest generates a request for the DescribePlacementGroups operation.
@@ -3596,6 +4586,17 @@ func (c *EC2) DescribePlacementGroups(input *DescribePlacementGroupsInput) (outp
 	return
 }
 
+// DescribePlacementGroupsWithContext is the same as DescribePlacementGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribePlacementGroupsWithContext(ctx context.Context, input *DescribePlacementGroupsInput) (output *DescribePlacementGroupsOutput, err error) {
+	req, out := c.DescribePlacementGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribePlacementGroups *aws.Operation
 
 // DescribeRegionsRequest generates a request for the DescribeRegions operation.
@@ -3632,6 +4633,17 @@ func (c *EC2) DescribeRegions(input *DescribeRegionsInput) (output *DescribeRegi
 	return
 }
 
+// DescribeRegionsWithContext is the same as DescribeRegions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeRegionsWithContext(ctx context.Context, input *DescribeRegionsInput) (output *DescribeRegionsOutput, err error) {
+	req, out := c.DescribeRegionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeRegions *aws.Operation
 
 // DescribeReservedInstancesRequest generates a request for the DescribeReservedInstances operation.
@@ -3668,6 +4680,17 @@ func (c *EC2) DescribeReservedInstances(input *DescribeReservedInstancesInput) (
 	return
 }
 
+// DescribeReservedInstancesWithContext is the same as DescribeReservedInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeReservedInstancesWithContext(ctx context.Context, input *DescribeReservedInstancesInput) (output *DescribeReservedInstancesOutput, err error) {
+	req, out := c.DescribeReservedInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeReservedInstances *aws.Operation
 
 // DescribeReservedInstancesListingsRequest generates a request for the DescribeReservedInstancesListings operation.
@@ -3722,6 +4745,17 @@ func (c *EC2) DescribeReservedInstancesListings(input *DescribeReservedInstances
 	return
 }
 
+// DescribeReservedInstancesListingsWithContext is the same as DescribeReservedInstancesListings with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeReservedInstancesListingsWithContext(ctx context.Context, input *DescribeReservedInstancesListingsInput) (output *DescribeReservedInstancesListingsOutput, err error) {
+	req, out := c.DescribeReservedInstancesListingsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeReservedInstancesListings *aws.Operation
 
 // DescribeReservedInstancesModificationsRequest generates a request for the DescribeReservedInstancesModifications operation.
@@ -3734,6 +4768,12 @@ func (c *EC2) DescribeReservedInstancesModificationsRequest(input *DescribeReser
 			Name:       "DescribeReservedInstancesModifications",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -3761,6 +4801,27 @@ func (c *EC2) DescribeReservedInstancesModifications(input *DescribeReservedInst
 	return
 }
 
+// DescribeReservedInstancesModificationsWithContext is the same as DescribeReservedInstancesModifications with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeReservedInstancesModificationsWithContext(ctx context.Context, input *DescribeReservedInstancesModificationsInput) (output *DescribeReservedInstancesModificationsOutput, err error) {
+	req, out := c.DescribeReservedInstancesModificationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeReservedInstancesModificationsPages iterates over the pages of a DescribeReservedInstancesModifications operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *EC2) DescribeReservedInstancesModificationsPages(input *DescribeReservedInstancesModificationsInput, fn func(p *DescribeReservedInstancesModificationsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReservedInstancesModificationsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReservedInstancesModificationsOutput), lastPage)
+	})
+}
+
 var opDescribeReservedInstancesModifications *aws.Operation
 
 // DescribeReservedInstancesOfferingsRequest generates a request for the DescribeReservedInstancesOfferings operation.
@@ -3773,6 +4834,12 @@ func (c *EC2) DescribeReservedInstancesOfferingsRequest(input *DescribeReservedI
 			Name:       "DescribeReservedInstancesOfferings",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxResults",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -3801,6 +4868,27 @@ func (c *EC2) DescribeReservedInstancesOfferings(input *DescribeReservedInstance
 	return
 }
 
+// DescribeReservedInstancesOfferingsWithContext is the same as DescribeReservedInstancesOfferings with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeReservedInstancesOfferingsWithContext(ctx context.Context, input *DescribeReservedInstancesOfferingsInput) (output *DescribeReservedInstancesOfferingsOutput, err error) {
+	req, out := c.DescribeReservedInstancesOfferingsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeReservedInstancesOfferingsPages iterates over the pages of a DescribeReservedInstancesOfferings operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *EC2) DescribeReservedInstancesOfferingsPages(input *DescribeReservedInstancesOfferingsInput, fn func(p *DescribeReservedInstancesOfferingsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReservedInstancesOfferingsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReservedInstancesOfferingsOutput), lastPage)
+	})
+}
+
 var opDescribeReservedInstancesOfferings *aws.Operation
 
 // DescribeRouteTablesRequest generates a request for the DescribeRouteTables operation.
@@ -3837,6 +4925,17 @@ func (c *EC2) DescribeRouteTables(input *DescribeRouteTablesInput) (output *Desc
 	return
 }
 
+// DescribeRouteTablesWithContext is the same as DescribeRouteTables with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeRouteTablesWithContext(ctx context.Context, input *DescribeRouteTablesInput) (output *DescribeRouteTablesOutput, err error) {
+	req, out := c.DescribeRouteTablesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeRouteTables *aws.Operation
 
 // DescribeSecurityGroupsRequest generates a request for the DescribeSecurityGroups operation.
@@ -3877,6 +4976,17 @@ func (c *EC2) DescribeSecurityGroups(input *DescribeSecurityGroupsInput) (output
 	return
 }
 
+// DescribeSecurityGroupsWithContext is the same as DescribeSecurityGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeSecurityGroupsWithContext(ctx context.Context, input *DescribeSecurityGroupsInput) (output *DescribeSecurityGroupsOutput, err error) {
+	req, out := c.DescribeSecurityGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeSecurityGroups *aws.Operation
 
 // DescribeSnapshotAttributeRequest generates a request for the DescribeSnapshotAttribute operation.
@@ -3914,6 +5024,17 @@ func (c *EC2) DescribeSnapshotAttribute(input *DescribeSnapshotAttributeInput) (
 	return
 }
 
+// DescribeSnapshotAttributeWithContext is the same as DescribeSnapshotAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeSnapshotAttributeWithContext(ctx context.Context, input *DescribeSnapshotAttributeInput) (output *DescribeSnapshotAttributeOutput, err error) {
+	req, out := c.DescribeSnapshotAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeSnapshotAttribute *aws.Operation
 
 // DescribeSnapshotsRequest generates a request for the DescribeSnapshots operation.
@@ -3926,6 +5047,12 @@ func (c *EC2) DescribeSnapshotsRequest(input *DescribeSnapshotsInput) (req *aws.
 			Name:       "DescribeSnapshots",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxResults",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -3986,6 +5113,27 @@ func (c *EC2) DescribeSnapshots(input *DescribeSnapshotsInput) (output *Describe
 	return
 }
 
+// DescribeSnapshotsWithContext is the same as DescribeSnapshots with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeSnapshotsWithContext(ctx context.Context, input *DescribeSnapshotsInput) (output *DescribeSnapshotsOutput, err error) {
+	req, out := c.DescribeSnapshotsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeSnapshotsPages iterates over the pages of a DescribeSnapshots operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *EC2) DescribeSnapshotsPages(input *DescribeSnapshotsInput, fn func(p *DescribeSnapshotsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeSnapshotsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeSnapshotsOutput), lastPage)
+	})
+}
+
 var opDescribeSnapshots *aws.Operation
 
 // DescribeSpotDatafeedSubscriptionRequest generates a request for the DescribeSpotDatafeedSubscription operation.
@@ -4021,6 +5169,17 @@ func (c *EC2) DescribeSpotDatafeedSubscription(input *DescribeSpotDatafeedSubscr
 	return
 }
 
+// DescribeSpotDatafeedSubscriptionWithContext is the same as DescribeSpotDatafeedSubscription with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeSpotDatafeedSubscriptionWithContext(ctx context.Context, input *DescribeSpotDatafeedSubscriptionInput) (output *DescribeSpotDatafeedSubscriptionOutput, err error) {
+	req, out := c.DescribeSpotDatafeedSubscriptionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeSpotDatafeedSubscription *aws.Operation
 
 // DescribeSpotInstanceRequestsRequest generates a request for the DescribeSpotInstanceRequests operation.
@@ -4065,6 +5224,17 @@ func (c *EC2) DescribeSpotInstanceRequests(input *DescribeSpotInstanceRequestsIn
 	return
 }
 
+// DescribeSpotInstanceRequestsWithContext is the same as DescribeSpotInstanceRequests with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeSpotInstanceRequestsWithContext(ctx context.Context, input *DescribeSpotInstanceRequestsInput) (output *DescribeSpotInstanceRequestsOutput, err error) {
+	req, out := c.DescribeSpotInstanceRequestsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeSpotInstanceRequests *aws.Operation
 
 // DescribeSpotPriceHistoryRequest generates a request for the DescribeSpotPriceHistory operation.
@@ -4077,6 +5247,12 @@ func (c *EC2) DescribeSpotPriceHistoryRequest(input *DescribeSpotPriceHistoryInp
 			Name:       "DescribeSpotPriceHistory",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxResults",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -4106,6 +5282,27 @@ func (c *EC2) DescribeSpotPriceHistory(input *DescribeSpotPriceHistoryInput) (ou
 	return
 }
 
+// DescribeSpotPriceHistoryWithContext is the same as DescribeSpotPriceHistory with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeSpotPriceHistoryWithContext(ctx context.Context, input *DescribeSpotPriceHistoryInput) (output *DescribeSpotPriceHistoryOutput, err error) {
+	req, out := c.DescribeSpotPriceHistoryRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeSpotPriceHistoryPages iterates over the pages of a DescribeSpotPriceHistory operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *EC2) DescribeSpotPriceHistoryPages(input *DescribeSpotPriceHistoryInput, fn func(p *DescribeSpotPriceHistoryOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeSpotPriceHistoryRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeSpotPriceHistoryOutput), lastPage)
+	})
+}
+
 var opDescribeSpotPriceHistory *aws.Operation
 
 // DescribeSubnetsRequest generates a request for the DescribeSubnets operation.
@@ -4142,6 +5339,17 @@ func (c *EC2) DescribeSubnets(input *DescribeSubnetsInput) (output *DescribeSubn
 	return
 }
 
+// DescribeSubnetsWithContext is the same as DescribeSubnets with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeSubnetsWithContext(ctx context.Context, input *DescribeSubnetsInput) (output *DescribeSubnetsOutput, err error) {
+	req, out := c.DescribeSubnetsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeSubnets *aws.Operation
 
 // DescribeTagsRequest generates a request for the DescribeTags operation.
@@ -4154,6 +5362,12 @@ func (c *EC2) DescribeTagsRequest(input *DescribeTagsInput) (req *aws.Request, o
 			Name:       "DescribeTags",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxResults",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -4178,6 +5392,27 @@ func (c *EC2) DescribeTags(input *DescribeTagsInput) (output *DescribeTagsOutput
 	return
 }
 
+// DescribeTagsWithContext is the same as DescribeTags with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeTagsWithContext(ctx context.Context, input *DescribeTagsInput) (output *DescribeTagsOutput, err error) {
+	req, out := c.DescribeTagsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeTagsPages iterates over the pages of a DescribeTags operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *EC2) DescribeTagsPages(input *DescribeTagsInput, fn func(p *DescribeTagsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeTagsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeTagsOutput), lastPage)
+	})
+}
+
 var opDescribeTags *aws.Operation
 
 // DescribeVPCAttributeRequest generates a request for the DescribeVPCAttribute operation.
@@ -4212,6 +5447,17 @@ func (c *EC2) DescribeVPCAttribute(input *DescribeVPCAttributeInput) (output *De
 	return
 }
 
+// DescribeVPCAttributeWithContext is the same as DescribeVPCAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeVPCAttributeWithContext(ctx context.Context, input *DescribeVPCAttributeInput) (output *DescribeVPCAttributeOutput, err error) {
+	req, out := c.DescribeVPCAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeVPCAttribute *aws.Operation
 
 // DescribeVPCClassicLinkRequest generates a request for the DescribeVPCClassicLink operation.
@@ -4245,6 +5491,17 @@ func (c *EC2) DescribeVPCClassicLink(input *DescribeVPCClassicLinkInput) (output
 	return
 }
 
+// DescribeVPCClassicLinkWithContext is the same as DescribeVPCClassicLink with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeVPCClassicLinkWithContext(ctx context.Context, input *DescribeVPCClassicLinkInput) (output *DescribeVPCClassicLinkOutput, err error) {
+	req, out := c.DescribeVPCClassicLinkRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeVPCClassicLink *aws.Operation
 
 // DescribeVPCPeeringConnectionsRequest generates a request for the DescribeVPCPeeringConnections operation.
@@ -4278,6 +5535,17 @@ func (c *EC2) DescribeVPCPeeringConnections(input *DescribeVPCPeeringConnections
 	return
 }
 
+// DescribeVPCPeeringConnectionsWithContext is the same as DescribeVPCPeeringConnections with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeVPCPeeringConnectionsWithContext(ctx context.Context, input *DescribeVPCPeeringConnectionsInput) (output *DescribeVPCPeeringConnectionsOutput, err error) {
+	req, out := c.DescribeVPCPeeringConnectionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeVPCPeeringConnections *aws.Operation
 
 // DescribeVPCsRequest generates a request for the DescribeVPCs operation.
@@ -4311,6 +5579,17 @@ func (c *EC2) DescribeVPCs(input *DescribeVPCsInput) (output *DescribeVPCsOutput
 	return
 }
 
+// DescribeVPCsWithContext is the same as DescribeVPCs with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeVPCsWithContext(ctx context.Context, input *DescribeVPCsInput) (output *DescribeVPCsOutput, err error) {
+	req, out := c.DescribeVPCsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeVPCs *aws.Operation
 
 // DescribeVPNConnectionsRequest generates a request for the DescribeVPNConnections operation.
@@ -4348,6 +5627,17 @@ func (c *EC2) DescribeVPNConnections(input *DescribeVPNConnectionsInput) (output
 	return
 }
 
+// DescribeVPNConnectionsWithContext is the same as DescribeVPNConnections with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeVPNConnectionsWithContext(ctx context.Context, input *DescribeVPNConnectionsInput) (output *DescribeVPNConnectionsOutput, err error) {
+	req, out := c.DescribeVPNConnectionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeVPNConnections *aws.Operation
 
 // DescribeVPNGatewaysRequest generates a request for the DescribeVPNGateways operation.
@@ -4385,6 +5675,17 @@ func (c *EC2) DescribeVPNGateways(input *DescribeVPNGatewaysInput) (output *Desc
 	return
 }
 
+// DescribeVPNGatewaysWithContext is the same as DescribeVPNGateways with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeVPNGatewaysWithContext(ctx context.Context, input *DescribeVPNGatewaysInput) (output *DescribeVPNGatewaysOutput, err error) {
+	req, out := c.DescribeVPNGatewaysRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeVPNGateways *aws.Operation
 
 // DescribeVolumeAttributeRequest generates a request for the DescribeVolumeAttribute operation.
@@ -4422,6 +5723,17 @@ func (c *EC2) DescribeVolumeAttribute(input *DescribeVolumeAttributeInput) (outp
 	return
 }
 
+// DescribeVolumeAttributeWithContext is the same as DescribeVolumeAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeVolumeAttributeWithContext(ctx context.Context, input *DescribeVolumeAttributeInput) (output *DescribeVolumeAttributeOutput, err error) {
+	req, out := c.DescribeVolumeAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeVolumeAttribute *aws.Operation
 
 // DescribeVolumeStatusRequest generates a request for the DescribeVolumeStatus operation.
@@ -4434,6 +5746,12 @@ func (c *EC2) DescribeVolumeStatusRequest(input *DescribeVolumeStatusInput) (req
 			Name:       "DescribeVolumeStatus",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxResults",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -4489,6 +5807,27 @@ func (c *EC2) DescribeVolumeStatus(input *DescribeVolumeStatusInput) (output *De
 	return
 }
 
+// DescribeVolumeStatusWithContext is the same as DescribeVolumeStatus with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeVolumeStatusWithContext(ctx context.Context, input *DescribeVolumeStatusInput) (output *DescribeVolumeStatusOutput, err error) {
+	req, out := c.DescribeVolumeStatusRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeVolumeStatusPages iterates over the pages of a DescribeVolumeStatus operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *EC2) DescribeVolumeStatusPages(input *DescribeVolumeStatusInput, fn func(p *DescribeVolumeStatusOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeVolumeStatusRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeVolumeStatusOutput), lastPage)
+	})
+}
+
 var opDescribeVolumeStatus *aws.Operation
 
 // DescribeVolumesRequest generates a request for the DescribeVolumes operation.
@@ -4532,6 +5871,17 @@ func (c *EC2) DescribeVolumes(input *DescribeVolumesInput) (output *DescribeVolu
 	return
 }
 
+// DescribeVolumesWithContext is the same as DescribeVolumes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DescribeVolumesWithContext(ctx context.Context, input *DescribeVolumesInput) (output *DescribeVolumesOutput, err error) {
+	req, out := c.DescribeVolumesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeVolumes *aws.Operation
 
 // DetachClassicLinkVPCRequest generates a request for the DetachClassicLinkVPC operation.
@@ -4567,6 +5917,17 @@ func (c *EC2) DetachClassicLinkVPC(input *DetachClassicLinkVPCInput) (output *De
 	return
 }
 
+// DetachClassicLinkVPCWithContext is the same as DetachClassicLinkVPC with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DetachClassicLinkVPCWithContext(ctx context.Context, input *DetachClassicLinkVPCInput) (output *DetachClassicLinkVPCOutput, err error) {
+	req, out := c.DetachClassicLinkVPCRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDetachClassicLinkVPC *aws.Operation
 
 // DetachInternetGatewayRequest generates a request for the DetachInternetGateway operation.
@@ -4602,6 +5963,17 @@ func (c *EC2) DetachInternetGateway(input *DetachInternetGatewayInput) (output *
 	return
 }
 
+// DetachInternetGatewayWithContext is the same as DetachInternetGateway with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DetachInternetGatewayWithContext(ctx context.Context, input *DetachInternetGatewayInput) (output *DetachInternetGatewayOutput, err error) {
+	req, out := c.DetachInternetGatewayRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDetachInternetGateway *aws.Operation
 
 // DetachNetworkInterfaceRequest generates a request for the DetachNetworkInterface operation.
@@ -4635,6 +6007,17 @@ func (c *EC2) DetachNetworkInterface(input *DetachNetworkInterfaceInput) (output
 	return
 }
 
+// DetachNetworkInterfaceWithContext is the same as DetachNetworkInterface with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DetachNetworkInterfaceWithContext(ctx context.Context, input *DetachNetworkInterfaceInput) (output *DetachNetworkInterfaceOutput, err error) {
+	req, out := c.DetachNetworkInterfaceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDetachNetworkInterface *aws.Operation
 
 // DetachVPNGatewayRequest generates a request for the DetachVPNGateway operation.
@@ -4675,6 +6058,17 @@ func (c *EC2) DetachVPNGateway(input *DetachVPNGatewayInput) (output *DetachVPNG
 	return
 }
 
+// DetachVPNGatewayWithContext is the same as DetachVPNGateway with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DetachVPNGatewayWithContext(ctx context.Context, input *DetachVPNGatewayInput) (output *DetachVPNGatewayOutput, err error) {
+	req, out := c.DetachVPNGatewayRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDetachVPNGateway *aws.Operation
 
 // DetachVolumeRequest generates a request for the DetachVolume operation.
@@ -4721,6 +6115,17 @@ func (c *EC2) DetachVolume(input *DetachVolumeInput) (output *VolumeAttachment,
 	return
 }
 
+// DetachVolumeWithContext is the same as DetachVolume with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DetachVolumeWithContext(ctx context.Context, input *DetachVolumeInput) (output *VolumeAttachment, err error) {
+	req, out := c.DetachVolumeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDetachVolume *aws.Operation
 
 // DisableVGWRoutePropagationRequest generates a request for the DisableVGWRoutePropagation operation.
@@ -4755,6 +6160,17 @@ func (c *EC2) DisableVGWRoutePropagation(input *DisableVGWRoutePropagationInput)
 	return
 }
 
+// DisableVGWRoutePropagationWithContext is the same as DisableVGWRoutePropagation with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DisableVGWRoutePropagationWithContext(ctx context.Context, input *DisableVGWRoutePropagationInput) (output *DisableVGWRoutePropagationOutput, err error) {
+	req, out := c.DisableVGWRoutePropagationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDisableVGWRoutePropagation *aws.Operation
 
 // DisableVPCClassicLinkRequest generates a request for the DisableVPCClassicLink operation.
@@ -4789,6 +6205,17 @@ func (c *EC2) DisableVPCClassicLink(input *DisableVPCClassicLinkInput) (output *
 	return
 }
 
+// DisableVPCClassicLinkWithContext is the same as DisableVPCClassicLink with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DisableVPCClassicLinkWithContext(ctx context.Context, input *DisableVPCClassicLinkInput) (output *DisableVPCClassicLinkOutput, err error) {
+	req, out := c.DisableVPCClassicLinkRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDisableVPCClassicLink *aws.Operation
 
 // DisassociateAddressRequest generates a request for the DisassociateAddress operation.
@@ -4830,6 +6257,17 @@ func (c *EC2) DisassociateAddress(input *DisassociateAddressInput) (output *Disa
 	return
 }
 
+// DisassociateAddressWithContext is the same as DisassociateAddress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DisassociateAddressWithContext(ctx context.Context, input *DisassociateAddressInput) (output *DisassociateAddressOutput, err error) {
+	req, out := c.DisassociateAddressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDisassociateAddress *aws.Operation
 
 // DisassociateRouteTableRequest generates a request for the DisassociateRouteTable operation.
@@ -4868,6 +6306,17 @@ func (c *EC2) DisassociateRouteTable(input *DisassociateRouteTableInput) (output
 	return
 }
 
+// DisassociateRouteTableWithContext is the same as DisassociateRouteTable with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) DisassociateRouteTableWithContext(ctx context.Context, input *DisassociateRouteTableInput) (output *DisassociateRouteTableOutput, err error) {
+	req, out := c.DisassociateRouteTableRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDisassociateRouteTable *aws.Operation
 
 // EnableVGWRoutePropagationRequest generates a request for the EnableVGWRoutePropagation operation.
@@ -4902,6 +6351,17 @@ func (c *EC2) EnableVGWRoutePropagation(input *EnableVGWRoutePropagationInput) (
 	return
 }
 
+// EnableVGWRoutePropagationWithContext is the same as EnableVGWRoutePropagation with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) EnableVGWRoutePropagationWithContext(ctx context.Context, input *EnableVGWRoutePropagationInput) (output *EnableVGWRoutePropagationOutput, err error) {
+	req, out := c.EnableVGWRoutePropagationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEnableVGWRoutePropagation *aws.Operation
 
 // EnableVPCClassicLinkRequest generates a request for the EnableVPCClassicLink operation.
@@ -4941,6 +6401,17 @@ func (c *EC2) EnableVPCClassicLink(input *EnableVPCClassicLinkInput) (output *En
 	return
 }
 
+// EnableVPCClassicLinkWithContext is the same as EnableVPCClassicLink with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) EnableVPCClassicLinkWithContext(ctx context.Context, input *EnableVPCClassicLinkInput) (output *EnableVPCClassicLinkOutput, err error) {
+	req, out := c.EnableVPCClassicLinkRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEnableVPCClassicLink *aws.Operation
 
 // EnableVolumeIORequest generates a request for the EnableVolumeIO operation.
@@ -4975,6 +6446,17 @@ func (c *EC2) EnableVolumeIO(input *EnableVolumeIOInput) (output *EnableVolumeIO
 	return
 }
 
+// EnableVolumeIOWithContext is the same as EnableVolumeIO with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) EnableVolumeIOWithContext(ctx context.Context, input *EnableVolumeIOInput) (output *EnableVolumeIOOutput, err error) {
+	req, out := c.EnableVolumeIORequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEnableVolumeIO *aws.Operation
 
 // GetConsoleOutputRequest generates a request for the GetConsoleOutput operation.
@@ -5025,8 +6507,19 @@ func (c *EC2) GetConsoleOutput(input *GetConsoleOutputInput) (output *GetConsole
 	return
 }
 
-var opGetConsoleOutput *aws.Operation
-
+// GetConsoleOutputWithContext is the same as GetConsoleOutput with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) GetConsoleOutputWithContext(ctx context.Context, input *GetConsoleOutputInput) (output *GetConsoleOutputOutput, err error) {
+	req, out := c.GetConsoleOutputRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+var opGetConsoleOutput *aws.Operation
+
 // GetPasswordDataRequest generates a request for the GetPasswordData operation.
 func (c *EC2) GetPasswordDataRequest(input *GetPasswordDataInput) (req *aws.Request, output *GetPasswordDataOutput) {
 	oprw.Lock()
@@ -5071,6 +6564,17 @@ func (c *EC2) GetPasswordData(input *GetPasswordDataInput) (output *GetPasswordD
 	return
 }
 
+// GetPasswordDataWithContext is the same as GetPasswordData with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) GetPasswordDataWithContext(ctx context.Context, input *GetPasswordDataInput) (output *GetPasswordDataOutput, err error) {
+	req, out := c.GetPasswordDataRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetPasswordData *aws.Operation
 
 // ImportInstanceRequest generates a request for the ImportInstance operation.
@@ -5108,6 +6612,17 @@ func (c *EC2) ImportInstance(input *ImportInstanceInput) (output *ImportInstance
 	return
 }
 
+// ImportInstanceWithContext is the same as ImportInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ImportInstanceWithContext(ctx context.Context, input *ImportInstanceInput) (output *ImportInstanceOutput, err error) {
+	req, out := c.ImportInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opImportInstance *aws.Operation
 
 // ImportKeyPairRequest generates a request for the ImportKeyPair operation.
@@ -5148,6 +6663,17 @@ func (c *EC2) ImportKeyPair(input *ImportKeyPairInput) (output *ImportKeyPairOut
 	return
 }
 
+// ImportKeyPairWithContext is the same as ImportKeyPair with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ImportKeyPairWithContext(ctx context.Context, input *ImportKeyPairInput) (output *ImportKeyPairOutput, err error) {
+	req, out := c.ImportKeyPairRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opImportKeyPair *aws.Operation
 
 // ImportVolumeRequest generates a request for the ImportVolume operation.
@@ -5186,6 +6712,17 @@ func (c *EC2) ImportVolume(input *ImportVolumeInput) (output *ImportVolumeOutput
 	return
 }
 
+// ImportVolumeWithContext is the same as ImportVolume with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ImportVolumeWithContext(ctx context.Context, input *ImportVolumeInput) (output *ImportVolumeOutput, err error) {
+	req, out := c.ImportVolumeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opImportVolume *aws.Operation
 
 // ModifyImageAttributeRequest generates a request for the ModifyImageAttribute operation.
@@ -5223,6 +6760,17 @@ func (c *EC2) ModifyImageAttribute(input *ModifyImageAttributeInput) (output *Mo
 	return
 }
 
+// ModifyImageAttributeWithContext is the same as ModifyImageAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ModifyImageAttributeWithContext(ctx context.Context, input *ModifyImageAttributeInput) (output *ModifyImageAttributeOutput, err error) {
+	req, out := c.ModifyImageAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyImageAttribute *aws.Operation
 
 // ModifyInstanceAttributeRequest generates a request for the ModifyInstanceAttribute operation.
@@ -5261,6 +6809,17 @@ func (c *EC2) ModifyInstanceAttribute(input *ModifyInstanceAttributeInput) (outp
 	return
 }
 
+// ModifyInstanceAttributeWithContext is the same as ModifyInstanceAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ModifyInstanceAttributeWithContext(ctx context.Context, input *ModifyInstanceAttributeInput) (output *ModifyInstanceAttributeOutput, err error) {
+	req, out := c.ModifyInstanceAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyInstanceAttribute *aws.Operation
 
 // ModifyNetworkInterfaceAttributeRequest generates a request for the ModifyNetworkInterfaceAttribute operation.
@@ -5295,6 +6854,17 @@ func (c *EC2) ModifyNetworkInterfaceAttribute(input *ModifyNetworkInterfaceAttri
 	return
 }
 
+// ModifyNetworkInterfaceAttributeWithContext is the same as ModifyNetworkInterfaceAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ModifyNetworkInterfaceAttributeWithContext(ctx context.Context, input *ModifyNetworkInterfaceAttributeInput) (output *ModifyNetworkInterfaceAttributeOutput, err error) {
+	req, out := c.ModifyNetworkInterfaceAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyNetworkInterfaceAttribute *aws.Operation
 
 // ModifyReservedInstancesRequest generates a request for the ModifyReservedInstances operation.
@@ -5334,6 +6904,17 @@ func (c *EC2) ModifyReservedInstances(input *ModifyReservedInstancesInput) (outp
 	return
 }
 
+// ModifyReservedInstancesWithContext is the same as ModifyReservedInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ModifyReservedInstancesWithContext(ctx context.Context, input *ModifyReservedInstancesInput) (output *ModifyReservedInstancesOutput, err error) {
+	req, out := c.ModifyReservedInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyReservedInstances *aws.Operation
 
 // ModifySnapshotAttributeRequest generates a request for the ModifySnapshotAttribute operation.
@@ -5377,6 +6958,17 @@ func (c *EC2) ModifySnapshotAttribute(input *ModifySnapshotAttributeInput) (outp
 	return
 }
 
+// ModifySnapshotAttributeWithContext is the same as ModifySnapshotAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ModifySnapshotAttributeWithContext(ctx context.Context, input *ModifySnapshotAttributeInput) (output *ModifySnapshotAttributeOutput, err error) {
+	req, out := c.ModifySnapshotAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifySnapshotAttribute *aws.Operation
 
 // ModifySubnetAttributeRequest generates a request for the ModifySubnetAttribute operation.
@@ -5410,6 +7002,17 @@ func (c *EC2) ModifySubnetAttribute(input *ModifySubnetAttributeInput) (output *
 	return
 }
 
+// ModifySubnetAttributeWithContext is the same as ModifySubnetAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ModifySubnetAttributeWithContext(ctx context.Context, input *ModifySubnetAttributeInput) (output *ModifySubnetAttributeOutput, err error) {
+	req, out := c.ModifySubnetAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifySubnetAttribute *aws.Operation
 
 // ModifyVPCAttributeRequest generates a request for the ModifyVPCAttribute operation.
@@ -5443,6 +7046,17 @@ func (c *EC2) ModifyVPCAttribute(input *ModifyVPCAttributeInput) (output *Modify
 	return
 }
 
+// ModifyVPCAttributeWithContext is the same as ModifyVPCAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ModifyVPCAttributeWithContext(ctx context.Context, input *ModifyVPCAttributeInput) (output *ModifyVPCAttributeOutput, err error) {
+	req, out := c.ModifyVPCAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyVPCAttribute *aws.Operation
 
 // ModifyVolumeAttributeRequest generates a request for the ModifyVolumeAttribute operation.
@@ -5485,6 +7099,17 @@ func (c *EC2) ModifyVolumeAttribute(input *ModifyVolumeAttributeInput) (output *
 	return
 }
 
+// ModifyVolumeAttributeWithContext is the same as ModifyVolumeAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ModifyVolumeAttributeWithContext(ctx context.Context, input *ModifyVolumeAttributeInput) (output *ModifyVolumeAttributeOutput, err error) {
+	req, out := c.ModifyVolumeAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyVolumeAttribute *aws.Operation
 
 // MonitorInstancesRequest generates a request for the MonitorInstances operation.
@@ -5520,6 +7145,17 @@ func (c *EC2) MonitorInstances(input *MonitorInstancesInput) (output *MonitorIns
 	return
 }
 
+// MonitorInstancesWithContext is the same as MonitorInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) MonitorInstancesWithContext(ctx context.Context, input *MonitorInstancesInput) (output *MonitorInstancesOutput, err error) {
+	req, out := c.MonitorInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opMonitorInstances *aws.Operation
 
 // PurchaseReservedInstancesOfferingRequest generates a request for the PurchaseReservedInstancesOffering operation.
@@ -5565,6 +7201,17 @@ func (c *EC2) PurchaseReservedInstancesOffering(input *PurchaseReservedInstances
 	return
 }
 
+// PurchaseReservedInstancesOfferingWithContext is the same as PurchaseReservedInstancesOffering with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) PurchaseReservedInstancesOfferingWithContext(ctx context.Context, input *PurchaseReservedInstancesOfferingInput) (output *PurchaseReservedInstancesOfferingOutput, err error) {
+	req, out := c.PurchaseReservedInstancesOfferingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPurchaseReservedInstancesOffering *aws.Operation
 
 // RebootInstancesRequest generates a request for the RebootInstances operation.
@@ -5608,6 +7255,17 @@ func (c *EC2) RebootInstances(input *RebootInstancesInput) (output *RebootInstan
 	return
 }
 
+// RebootInstancesWithContext is the same as RebootInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) RebootInstancesWithContext(ctx context.Context, input *RebootInstancesInput) (output *RebootInstancesOutput, err error) {
+	req, out := c.RebootInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRebootInstances *aws.Operation
 
 // RegisterImageRequest generates a request for the RegisterImage operation.
@@ -5660,6 +7318,17 @@ func (c *EC2) RegisterImage(input *RegisterImageInput) (output *RegisterImageOut
 	return
 }
 
+// RegisterImageWithContext is the same as RegisterImage with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) RegisterImageWithContext(ctx context.Context, input *RegisterImageInput) (output *RegisterImageOutput, err error) {
+	req, out := c.RegisterImageRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRegisterImage *aws.Operation
 
 // RejectVPCPeeringConnectionRequest generates a request for the RejectVPCPeeringConnection operation.
@@ -5697,6 +7366,17 @@ func (c *EC2) RejectVPCPeeringConnection(input *RejectVPCPeeringConnectionInput)
 	return
 }
 
+// RejectVPCPeeringConnectionWithContext is the same as RejectVPCPeeringConnection with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) RejectVPCPeeringConnectionWithContext(ctx context.Context, input *RejectVPCPeeringConnectionInput) (output *RejectVPCPeeringConnectionOutput, err error) {
+	req, out := c.RejectVPCPeeringConnectionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRejectVPCPeeringConnection *aws.Operation
 
 // ReleaseAddressRequest generates a request for the ReleaseAddress operation.
@@ -5744,6 +7424,17 @@ func (c *EC2) ReleaseAddress(input *ReleaseAddressInput) (output *ReleaseAddress
 	return
 }
 
+// ReleaseAddressWithContext is the same as ReleaseAddress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ReleaseAddressWithContext(ctx context.Context, input *ReleaseAddressInput) (output *ReleaseAddressOutput, err error) {
+	req, out := c.ReleaseAddressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opReleaseAddress *aws.Operation
 
 // ReplaceNetworkACLAssociationRequest generates a request for the ReplaceNetworkACLAssociation operation.
@@ -5780,6 +7471,17 @@ func (c *EC2) ReplaceNetworkACLAssociation(input *ReplaceNetworkACLAssociationIn
 	return
 }
 
+// ReplaceNetworkACLAssociationWithContext is the same as ReplaceNetworkACLAssociation with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ReplaceNetworkACLAssociationWithContext(ctx context.Context, input *ReplaceNetworkACLAssociationInput) (output *ReplaceNetworkACLAssociationOutput, err error) {
+	req, out := c.ReplaceNetworkACLAssociationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opReplaceNetworkACLAssociation *aws.Operation
 
 // ReplaceNetworkACLEntryRequest generates a request for the ReplaceNetworkACLEntry operation.
@@ -5815,6 +7517,17 @@ func (c *EC2) ReplaceNetworkACLEntry(input *ReplaceNetworkACLEntryInput) (output
 	return
 }
 
+// ReplaceNetworkACLEntryWithContext is the same as ReplaceNetworkACLEntry with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ReplaceNetworkACLEntryWithContext(ctx context.Context, input *ReplaceNetworkACLEntryInput) (output *ReplaceNetworkACLEntryOutput, err error) {
+	req, out := c.ReplaceNetworkACLEntryRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opReplaceNetworkACLEntry *aws.Operation
 
 // ReplaceRouteRequest generates a request for the ReplaceRoute operation.
@@ -5853,6 +7566,17 @@ func (c *EC2) ReplaceRoute(input *ReplaceRouteInput) (output *ReplaceRouteOutput
 	return
 }
 
+// ReplaceRouteWithContext is the same as ReplaceRoute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ReplaceRouteWithContext(ctx context.Context, input *ReplaceRouteInput) (output *ReplaceRouteOutput, err error) {
+	req, out := c.ReplaceRouteRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opReplaceRoute *aws.Operation
 
 // ReplaceRouteTableAssociationRequest generates a request for the ReplaceRouteTableAssociation operation.
@@ -5894,6 +7618,17 @@ func (c *EC2) ReplaceRouteTableAssociation(input *ReplaceRouteTableAssociationIn
 	return
 }
 
+// ReplaceRouteTableAssociationWithContext is the same as ReplaceRouteTableAssociation with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ReplaceRouteTableAssociationWithContext(ctx context.Context, input *ReplaceRouteTableAssociationInput) (output *ReplaceRouteTableAssociationOutput, err error) {
+	req, out := c.ReplaceRouteTableAssociationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opReplaceRouteTableAssociation *aws.Operation
 
 // ReportInstanceStatusRequest generates a request for the ReportInstanceStatus operation.
@@ -5933,6 +7668,17 @@ func (c *EC2) ReportInstanceStatus(input *ReportInstanceStatusInput) (output *Re
 	return
 }
 
+// ReportInstanceStatusWithContext is the same as ReportInstanceStatus with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ReportInstanceStatusWithContext(ctx context.Context, input *ReportInstanceStatusInput) (output *ReportInstanceStatusOutput, err error) {
+	req, out := c.ReportInstanceStatusRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opReportInstanceStatus *aws.Operation
 
 // RequestSpotInstancesRequest generates a request for the RequestSpotInstances operation.
@@ -5971,6 +7717,17 @@ func (c *EC2) RequestSpotInstances(input *RequestSpotInstancesInput) (output *Re
 	return
 }
 
+// RequestSpotInstancesWithContext is the same as RequestSpotInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) RequestSpotInstancesWithContext(ctx context.Context, input *RequestSpotInstancesInput) (output *RequestSpotInstancesOutput, err error) {
+	req, out := c.RequestSpotInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRequestSpotInstances *aws.Operation
 
 // ResetImageAttributeRequest generates a request for the ResetImageAttribute operation.
@@ -6006,6 +7763,17 @@ func (c *EC2) ResetImageAttribute(input *ResetImageAttributeInput) (output *Rese
 	return
 }
 
+// ResetImageAttributeWithContext is the same as ResetImageAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ResetImageAttributeWithContext(ctx context.Context, input *ResetImageAttributeInput) (output *ResetImageAttributeOutput, err error) {
+	req, out := c.ResetImageAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opResetImageAttribute *aws.Operation
 
 // ResetInstanceAttributeRequest generates a request for the ResetInstanceAttribute operation.
@@ -6047,6 +7815,17 @@ func (c *EC2) ResetInstanceAttribute(input *ResetInstanceAttributeInput) (output
 	return
 }
 
+// ResetInstanceAttributeWithContext is the same as ResetInstanceAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ResetInstanceAttributeWithContext(ctx context.Context, input *ResetInstanceAttributeInput) (output *ResetInstanceAttributeOutput, err error) {
+	req, out := c.ResetInstanceAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opResetInstanceAttribute *aws.Operation
 
 // ResetNetworkInterfaceAttributeRequest generates a request for the ResetNetworkInterfaceAttribute operation.
@@ -6081,6 +7860,17 @@ func (c *EC2) ResetNetworkInterfaceAttribute(input *ResetNetworkInterfaceAttribu
 	return
 }
 
+// ResetNetworkInterfaceAttributeWithContext is the same as ResetNetworkInterfaceAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ResetNetworkInterfaceAttributeWithContext(ctx context.Context, input *ResetNetworkInterfaceAttributeInput) (output *ResetNetworkInterfaceAttributeOutput, err error) {
+	req, out := c.ResetNetworkInterfaceAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opResetNetworkInterfaceAttribute *aws.Operation
 
 // ResetSnapshotAttributeRequest generates a request for the ResetSnapshotAttribute operation.
@@ -6118,6 +7908,17 @@ func (c *EC2) ResetSnapshotAttribute(input *ResetSnapshotAttributeInput) (output
 	return
 }
 
+// ResetSnapshotAttributeWithContext is the same as ResetSnapshotAttribute with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) ResetSnapshotAttributeWithContext(ctx context.Context, input *ResetSnapshotAttributeInput) (output *ResetSnapshotAttributeOutput, err error) {
+	req, out := c.ResetSnapshotAttributeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opResetSnapshotAttribute *aws.Operation
 
 // RevokeSecurityGroupEgressRequest generates a request for the RevokeSecurityGroupEgress operation.
@@ -6161,6 +7962,17 @@ func (c *EC2) RevokeSecurityGroupEgress(input *RevokeSecurityGroupEgressInput) (
 	return
 }
 
+// RevokeSecurityGroupEgressWithContext is the same as RevokeSecurityGroupEgress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) RevokeSecurityGroupEgressWithContext(ctx context.Context, input *RevokeSecurityGroupEgressInput) (output *RevokeSecurityGroupEgressOutput, err error) {
+	req, out := c.RevokeSecurityGroupEgressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRevokeSecurityGroupEgress *aws.Operation
 
 // RevokeSecurityGroupIngressRequest generates a request for the RevokeSecurityGroupIngress operation.
@@ -6204,6 +8016,17 @@ func (c *EC2) RevokeSecurityGroupIngress(input *RevokeSecurityGroupIngressInput)
 	return
 }
 
+// RevokeSecurityGroupIngressWithContext is the same as RevokeSecurityGroupIngress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) RevokeSecurityGroupIngressWithContext(ctx context.Context, input *RevokeSecurityGroupIngressInput) (output *RevokeSecurityGroupIngressOutput, err error) {
+	req, out := c.RevokeSecurityGroupIngressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRevokeSecurityGroupIngress *aws.Operation
 
 // RunInstancesRequest generates a request for the RunInstances operation.
@@ -6269,6 +8092,17 @@ func (c *EC2) RunInstances(input *RunInstancesInput) (output *Reservation, err e
 	return
 }
 
+// RunInstancesWithContext is the same as RunInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) RunInstancesWithContext(ctx context.Context, input *RunInstancesInput) (output *Reservation, err error) {
+	req, out := c.RunInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRunInstances *aws.Operation
 
 // StartInstancesRequest generates a request for the StartInstances operation.
@@ -6320,6 +8154,17 @@ func (c *EC2) StartInstances(input *StartInstancesInput) (output *StartInstances
 	return
 }
 
+// StartInstancesWithContext is the same as StartInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) StartInstancesWithContext(ctx context.Context, input *StartInstancesInput) (output *StartInstancesOutput, err error) {
+	req, out := c.StartInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opStartInstances *aws.Operation
 
 // StopInstancesRequest generates a request for the StopInstances operation.
@@ -6383,6 +8228,17 @@ func (c *EC2) StopInstances(input *StopInstancesInput) (output *StopInstancesOut
 	return
 }
 
+// StopInstancesWithContext is the same as StopInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) StopInstancesWithContext(ctx context.Context, input *StopInstancesInput) (output *StopInstancesOutput, err error) {
+	req, out := c.StopInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opStopInstances *aws.Operation
 
 // TerminateInstancesRequest generates a request for the TerminateInstances operation.
@@ -6437,6 +8293,17 @@ func (c *EC2) TerminateInstances(input *TerminateInstancesInput) (output *Termin
 	return
 }
 
+// TerminateInstancesWithContext is the same as TerminateInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) TerminateInstancesWithContext(ctx context.Context, input *TerminateInstancesInput) (output *TerminateInstancesOutput, err error) {
+	req, out := c.TerminateInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opTerminateInstances *aws.Operation
 
 // UnassignPrivateIPAddressesRequest generates a request for the UnassignPrivateIPAddresses operation.
@@ -6470,6 +8337,17 @@ func (c *EC2) UnassignPrivateIPAddresses(input *UnassignPrivateIPAddressesInput)
 	return
 }
 
+// UnassignPrivateIPAddressesWithContext is the same as UnassignPrivateIPAddresses with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) UnassignPrivateIPAddressesWithContext(ctx context.Context, input *UnassignPrivateIPAddressesInput) (output *UnassignPrivateIPAddressesOutput, err error) {
+	req, out := c.UnassignPrivateIPAddressesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUnassignPrivateIPAddresses *aws.Operation
 
 // UnmonitorInstancesRequest generates a request for the UnmonitorInstances operation.
@@ -6505,6 +8383,17 @@ func (c *EC2) UnmonitorInstances(input *UnmonitorInstancesInput) (output *Unmoni
 	return
 }
 
+// UnmonitorInstancesWithContext is the same as UnmonitorInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EC2) UnmonitorInstancesWithContext(ctx context.Context, input *UnmonitorInstancesInput) (output *UnmonitorInstancesOutput, err error) {
+	req, out := c.UnmonitorInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUnmonitorInstances *aws.Operation
 
 type AcceptVPCPeeringConnectionInput struct {
@@ -6569,7 +8458,7 @@ type Address struct {
 
 	// Indicates whether this Elastic IP address is for use with instances in EC2-Classic
 	// (standard) or instances in a VPC (vpc).
-	Domain *string `locationName:"domain" type:"string"`
+	Domain *string `locationName:"domain" type:"string" enum:"vpc,standard"`
 
 	// The ID of the instance the address is associated with (if any).
 	InstanceID *string `locationName:"instanceId" type:"string"`
@@ -6597,7 +8486,7 @@ type AllocateAddressInput struct {
 	// Set to vpc to allocate the address for use with instances in a VPC.
 	//
 	// Default: The address is for use with instances in EC2-Classic.
-	Domain *string `type:"string"`
+	Domain *string `type:"string" enum:"vpc,standard"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -6615,7 +8504,7 @@ type AllocateAddressOutput struct {
 
 	// Indicates whether this Elastic IP address is for use with instances in EC2-Classic
 	// (standard) or instances in a VPC (vpc).
-	Domain *string `locationName:"domain" type:"string"`
+	Domain *string `locationName:"domain" type:"string" enum:"vpc,standard"`
 
 	// The Elastic IP address.
 	PublicIP *string `locationName:"publicIp" type:"string"`
@@ -7030,7 +8919,7 @@ type AvailabilityZone struct {
 	RegionName *string `locationName:"regionName" type:"string"`
 
 	// The state of the Availability Zone (available | impaired | unavailable).
-	State *string `locationName:"zoneState" type:"string"`
+	State *string `locationName:"zoneState" type:"string" enum:"available"`
 
 	// The name of the Availability Zone.
 	ZoneName *string `locationName:"zoneName" type:"string"`
@@ -7149,7 +9038,7 @@ type BundleTask struct {
 	StartTime *time.Time `locationName:"startTime" type:"timestamp" timestampFormat:"iso8601"`
 
 	// The state of the task.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"pending,waiting-for-shutdown,bundling,storing,cancelling,complete,failed"`
 
 	// The Amazon S3 storage locations.
 	Storage *Storage `locationName:"storage" type:"structure"`
@@ -7297,7 +9186,7 @@ type CancelledSpotInstanceRequest struct {
 	SpotInstanceRequestID *string `locationName:"spotInstanceRequestId" type:"string"`
 
 	// The state of the Spot Instance request.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"active,open,closed,cancelled,completed"`
 
 	metadataCancelledSpotInstanceRequest `json:"-", xml:"-"`
 }
@@ -7373,7 +9262,7 @@ type ConversionTask struct {
 	ImportVolume *ImportVolumeTaskDetails `locationName:"importVolume" type:"structure"`
 
 	// The state of the conversion task.
-	State *string `locationName:"state" type:"string" required:"true"`
+	State *string `locationName:"state" type:"string" enum:"active,cancelling,cancelled,completed" required:"true"`
 
 	// The status message related to the conversion task.
 	StatusMessage *string `locationName:"statusMessage" type:"string"`
@@ -7486,7 +9375,7 @@ type CreateCustomerGatewayInput struct {
 	PublicIP *string `locationName:"IpAddress" type:"string" required:"true"`
 
 	// The type of VPN connection that this customer gateway supports (ipsec.1).
-	Type *string `type:"string" required:"true"`
+	Type *string `type:"string" enum:"ipsec.1" required:"true"`
 
 	metadataCreateCustomerGatewayInput `json:"-", xml:"-"`
 }
@@ -7585,7 +9474,7 @@ type CreateInstanceExportTaskInput struct {
 	InstanceID *string `locationName:"instanceId" type:"string" required:"true"`
 
 	// The target virtualization environment.
-	TargetEnvironment *string `locationName:"targetEnvironment" type:"string"`
+	TargetEnvironment *string `locationName:"targetEnvironment" type:"string" enum:"citrix,vmware,microsoft"`
 
 	metadataCreateInstanceExportTaskInput `json:"-", xml:"-"`
 }
@@ -7683,7 +9572,7 @@ type CreateNetworkACLEntryInput struct {
 	Protocol *string `locationName:"protocol" type:"string" required:"true"`
 
 	// Indicates whether to allow or deny the traffic that matches the rule.
-	RuleAction *string `locationName:"ruleAction" type:"string" required:"true"`
+	RuleAction *string `locationName:"ruleAction" type:"string" enum:"allow,deny" required:"true"`
 
 	// The rule number for the entry (for example, 100). ACL entries are processed
 	// in ascending order by rule number.
@@ -7789,7 +9678,7 @@ type CreatePlacementGroupInput struct {
 	GroupName *string `locationName:"groupName" type:"string" required:"true"`
 
 	// The placement strategy.
-	Strategy *string `locationName:"strategy" type:"string" required:"true"`
+	Strategy *string `locationName:"strategy" type:"string" enum:"cluster" required:"true"`
 
 	metadataCreatePlacementGroupInput `json:"-", xml:"-"`
 }
@@ -8063,7 +9952,7 @@ type CreateVPCInput struct {
 	// Dedicated tenancy instances run on single-tenant hardware.
 	//
 	// Default: default
-	InstanceTenancy *string `locationName:"instanceTenancy" type:"string"`
+	InstanceTenancy *string `locationName:"instanceTenancy" type:"string" enum:"default,dedicated"`
 
 	metadataCreateVPCInput `json:"-", xml:"-"`
 }
@@ -8181,7 +10070,7 @@ type CreateVPNGatewayInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
 	// The type of VPN connection this virtual private gateway supports.
-	Type *string `type:"string" required:"true"`
+	Type *string `type:"string" enum:"ipsec.1" required:"true"`
 
 	metadataCreateVPNGatewayInput `json:"-", xml:"-"`
 }
@@ -8247,7 +10136,7 @@ type CreateVolumeInput struct {
 	// Provisioned IOPS (SSD) volumes, or standard for Magnetic volumes.
 	//
 	// Default: standard
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" enum:"standard,io1,gp2"`
 
 	metadataCreateVolumeInput `json:"-", xml:"-"`
 }
@@ -8259,7 +10148,7 @@ type metadataCreateVolumeInput struct {
 type CreateVolumePermission struct {
 	// The specific group that is to be added or removed from a volume's list of
 	// create volume permissions.
-	Group *string `locationName:"group" type:"string"`
+	Group *string `locationName:"group" type:"string" enum:"all"`
 
 	// The specific AWS account ID that is to be added or removed from a volume's
 	// list of create volume permissions.
@@ -9213,7 +11102,7 @@ type DescribeImageAttributeInput struct {
 	// Note: Depending on your account privileges, the blockDeviceMapping attribute
 	// may return a Client.AuthFailure error. If this happens, use DescribeImages
 	// to get information about the block device mapping for the AMI.
-	Attribute *string `type:"string" required:"true"`
+	Attribute *string `type:"string" enum:"description,kernel,ramdisk,launchPermission,productCodes,blockDeviceMapping" required:"true"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -9370,7 +11259,7 @@ type metadataDescribeImagesOutput struct {
 
 type DescribeInstanceAttributeInput struct {
 	// The instance attribute.
-	Attribute *string `locationName:"attribute" type:"string" required:"true"`
+	Attribute *string `locationName:"attribute" type:"string" enum:"instanceType,kernel,ramdisk,userData,disableApiTermination,instanceInitiatedShutdownBehavior,rootDeviceName,blockDeviceMapping,productCodes,sourceDestCheck,groupSet,ebsOptimized,sriovNetSupport" required:"true"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -9950,7 +11839,7 @@ type metadataDescribeNetworkACLsOutput struct {
 
 type DescribeNetworkInterfaceAttributeInput struct {
 	// The attribute of the network interface.
-	Attribute *string `locationName:"attribute" type:"string"`
+	Attribute *string `locationName:"attribute" type:"string" enum:"description,groupSet,sourceDestCheck,attachment"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -10230,7 +12119,7 @@ type DescribeReservedInstancesInput struct {
 	// The Reserved Instance offering type. If you are using tools that predate
 	// the 2011-11-01 API version, you only have access to the Medium Utilization
 	// Reserved Instance offering type.
-	OfferingType *string `locationName:"offeringType" type:"string"`
+	OfferingType *string `locationName:"offeringType" type:"string" enum:"Heavy Utilization,Medium Utilization,Light Utilization,No Upfront,Partial Upfront,All Upfront"`
 
 	// One or more Reserved Instance IDs.
 	//
@@ -10387,12 +12276,12 @@ type DescribeReservedInstancesOfferingsInput struct {
 	// VPC.
 	//
 	// Default: default
-	InstanceTenancy *string `locationName:"instanceTenancy" type:"string"`
+	InstanceTenancy *string `locationName:"instanceTenancy" type:"string" enum:"default,dedicated"`
 
 	// The instance type on which the Reserved Instance can be used. For more information,
 	// see Instance Types (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-types.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	InstanceType *string `type:"string"`
+	InstanceType *string `type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// The maximum duration (in seconds) to filter when searching for offerings.
 	//
@@ -10420,11 +12309,11 @@ type DescribeReservedInstancesOfferingsInput struct {
 	// The Reserved Instance offering type. If you are using tools that predate
 	// the 2011-11-01 API version, you only have access to the Medium Utilization
 	// Reserved Instance offering type.
-	OfferingType *string `locationName:"offeringType" type:"string"`
+	OfferingType *string `locationName:"offeringType" type:"string" enum:"Heavy Utilization,Medium Utilization,Light Utilization,No Upfront,Partial Upfront,All Upfront"`
 
 	// The Reserved Instance description. Instances that include (Amazon VPC) in
 	// the description are for use with Amazon VPC.
-	ProductDescription *string `type:"string"`
+	ProductDescription *string `type:"string" enum:"Linux/UNIX,Linux/UNIX (Amazon VPC),Windows,Windows (Amazon VPC)"`
 
 	// One or more Reserved Instances offering IDs.
 	ReservedInstancesOfferingIDs []*string `locationName:"ReservedInstancesOfferingId" type:"list"`
@@ -10613,7 +12502,7 @@ type metadataDescribeSecurityGroupsOutput struct {
 
 type DescribeSnapshotAttributeInput struct {
 	// The snapshot attribute you would like to view.
-	Attribute *string `type:"string" required:"true"`
+	Attribute *string `type:"string" enum:"productCodes,createVolumePermission" required:"true"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -11069,7 +12958,7 @@ type metadataDescribeTagsOutput struct {
 
 type DescribeVPCAttributeInput struct {
 	// The VPC attribute.
-	Attribute *string `type:"string"`
+	Attribute *string `type:"string" enum:"enableDnsSupport,enableDnsHostnames"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -11390,7 +13279,7 @@ type metadataDescribeVPNGatewaysOutput struct {
 
 type DescribeVolumeAttributeInput struct {
 	// The instance attribute.
-	Attribute *string `type:"string"`
+	Attribute *string `type:"string" enum:"autoEnableIO,productCodes"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -11825,7 +13714,7 @@ type DiskImageDescription struct {
 	Checksum *string `locationName:"checksum" type:"string"`
 
 	// The disk image format.
-	Format *string `locationName:"format" type:"string" required:"true"`
+	Format *string `locationName:"format" type:"string" enum:"VMDK,RAW,VHD" required:"true"`
 
 	// A presigned URL for the import manifest stored in Amazon S3. For information
 	// about creating a presigned URL for an Amazon S3 object, read the "Query String
@@ -11848,7 +13737,7 @@ type DiskImageDetail struct {
 	Bytes *int64 `locationName:"bytes" type:"long" required:"true"`
 
 	// The disk image format.
-	Format *string `locationName:"format" type:"string" required:"true"`
+	Format *string `locationName:"format" type:"string" enum:"VMDK,RAW,VHD" required:"true"`
 
 	// A presigned URL for the import manifest stored in Amazon S3 and presented
 	// here as an Amazon S3 presigned URL. For information about creating a presigned
@@ -11920,7 +13809,7 @@ type EBSBlockDevice struct {
 	// IOPS (SSD) volumes, and standard for Magnetic volumes.
 	//
 	// Default: standard
-	VolumeType *string `locationName:"volumeType" type:"string"`
+	VolumeType *string `locationName:"volumeType" type:"string" enum:"standard,io1,gp2"`
 
 	metadataEBSBlockDevice `json:"-", xml:"-"`
 }
@@ -11939,7 +13828,7 @@ type EBSInstanceBlockDevice struct {
 	DeleteOnTermination *bool `locationName:"deleteOnTermination" type:"boolean"`
 
 	// The attachment state.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	// The ID of the Amazon EBS volume.
 	VolumeID *string `locationName:"volumeId" type:"string"`
@@ -12046,7 +13935,7 @@ type ExportTask struct {
 	InstanceExportDetails *InstanceExportDetails `locationName:"instanceExport" type:"structure"`
 
 	// The state of the conversion task.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"active,cancelling,cancelled,completed"`
 
 	// The status message related to the export task.
 	StatusMessage *string `locationName:"statusMessage" type:"string"`
@@ -12061,10 +13950,10 @@ type metadataExportTask struct {
 type ExportToS3Task struct {
 	// The container format used to combine disk images with metadata (such as OVF).
 	// If absent, only the disk image is exported.
-	ContainerFormat *string `locationName:"containerFormat" type:"string"`
+	ContainerFormat *string `locationName:"containerFormat" type:"string" enum:"ova"`
 
 	// The format for the exported image.
-	DiskImageFormat *string `locationName:"diskImageFormat" type:"string"`
+	DiskImageFormat *string `locationName:"diskImageFormat" type:"string" enum:"VMDK,RAW,VHD"`
 
 	// The Amazon S3 bucket for the destination image. The destination bucket must
 	// exist and grant WRITE and READ_ACL permissions to the AWS account vm-import-export@amazon.com.
@@ -12080,9 +13969,9 @@ type metadataExportToS3Task struct {
 }
 
 type ExportToS3TaskSpecification struct {
-	ContainerFormat *string `locationName:"containerFormat" type:"string"`
+	ContainerFormat *string `locationName:"containerFormat" type:"string" enum:"ova"`
 
-	DiskImageFormat *string `locationName:"diskImageFormat" type:"string"`
+	DiskImageFormat *string `locationName:"diskImageFormat" type:"string" enum:"VMDK,RAW,VHD"`
 
 	S3Bucket *string `locationName:"s3Bucket" type:"string"`
 
@@ -12283,7 +14172,7 @@ type metadataIPRange struct {
 // Describes an image.
 type Image struct {
 	// The architecture of the image.
-	Architecture *string `locationName:"architecture" type:"string"`
+	Architecture *string `locationName:"architecture" type:"string" enum:"i386,x86_64"`
 
 	// Any block device mapping entries.
 	BlockDeviceMappings []*BlockDeviceMapping `locationName:"blockDeviceMapping" locationNameList:"item" type:"list"`
@@ -12295,7 +14184,7 @@ type Image struct {
 	Description *string `locationName:"description" type:"string"`
 
 	// The hypervisor type of the image.
-	Hypervisor *string `locationName:"hypervisor" type:"string"`
+	Hypervisor *string `locationName:"hypervisor" type:"string" enum:"ovm,xen"`
 
 	// The ID of the AMI.
 	ImageID *string `locationName:"imageId" type:"string"`
@@ -12308,7 +14197,7 @@ type Image struct {
 	ImageOwnerAlias *string `locationName:"imageOwnerAlias" type:"string"`
 
 	// The type of image.
-	ImageType *string `locationName:"imageType" type:"string"`
+	ImageType *string `locationName:"imageType" type:"string" enum:"machine,kernel,ramdisk"`
 
 	// The kernel associated with the image, if any. Only applicable for machine
 	// images.
@@ -12321,7 +14210,7 @@ type Image struct {
 	OwnerID *string `locationName:"imageOwnerId" type:"string"`
 
 	// The value is Windows for Windows AMIs; otherwise blank.
-	Platform *string `locationName:"platform" type:"string"`
+	Platform *string `locationName:"platform" type:"string" enum:"Windows"`
 
 	// Any product codes associated with the AMI.
 	ProductCodes []*ProductCode `locationName:"productCodes" locationNameList:"item" type:"list"`
@@ -12340,14 +14229,14 @@ type Image struct {
 
 	// The type of root device used by the AMI. The AMI can use an Amazon EBS volume
 	// or an instance store volume.
-	RootDeviceType *string `locationName:"rootDeviceType" type:"string"`
+	RootDeviceType *string `locationName:"rootDeviceType" type:"string" enum:"ebs,instance-store"`
 
 	// Specifies whether enhanced networking is enabled.
 	SRIOVNetSupport *string `locationName:"sriovNetSupport" type:"string"`
 
 	// The current state of the AMI. If the state is available, the image is successfully
 	// registered and can be used to launch an instance.
-	State *string `locationName:"imageState" type:"string"`
+	State *string `locationName:"imageState" type:"string" enum:"available,deregistered"`
 
 	// The reason for the state change.
 	StateReason *StateReason `locationName:"stateReason" type:"structure"`
@@ -12356,7 +14245,7 @@ type Image struct {
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
 
 	// The type of virtualization of the AMI.
-	VirtualizationType *string `locationName:"virtualizationType" type:"string"`
+	VirtualizationType *string `locationName:"virtualizationType" type:"string" enum:"hvm,paravirtual"`
 
 	metadataImage `json:"-", xml:"-"`
 }
@@ -12376,7 +14265,7 @@ type ImportInstanceInput struct {
 	LaunchSpecification *ImportInstanceLaunchSpecification `locationName:"launchSpecification" type:"structure"`
 
 	// The instance operating system.
-	Platform *string `locationName:"platform" type:"string" required:"true"`
+	Platform *string `locationName:"platform" type:"string" enum:"Windows" required:"true"`
 
 	metadataImportInstanceInput `json:"-", xml:"-"`
 }
@@ -12389,7 +14278,7 @@ type ImportInstanceLaunchSpecification struct {
 	AdditionalInfo *string `locationName:"additionalInfo" type:"string"`
 
 	// The architecture of the instance.
-	Architecture *string `locationName:"architecture" type:"string"`
+	Architecture *string `locationName:"architecture" type:"string" enum:"i386,x86_64"`
 
 	// One or more security group IDs.
 	GroupIDs []*string `locationName:"GroupId" locationNameList:"SecurityGroupId" type:"list"`
@@ -12399,7 +14288,7 @@ type ImportInstanceLaunchSpecification struct {
 
 	// Indicates whether an instance stops or terminates when you initiate shutdown
 	// from the instance (using the operating system command for system shutdown).
-	InstanceInitiatedShutdownBehavior *string `locationName:"instanceInitiatedShutdownBehavior" type:"string"`
+	InstanceInitiatedShutdownBehavior *string `locationName:"instanceInitiatedShutdownBehavior" type:"string" enum:"stop,terminate"`
 
 	// The instance type. This is not supported for VMs imported into a VPC, which
 	// are assigned the default security group. After a VM is imported into a VPC,
@@ -12408,7 +14297,7 @@ type ImportInstanceLaunchSpecification struct {
 	// in the Amazon Elastic Compute Cloud User Guide. For more information about
 	// the Linux instance types you can import, see Before You Get Started (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/VMImportPrerequisites.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	Monitoring *bool `locationName:"monitoring" type:"boolean"`
 
@@ -12449,7 +14338,7 @@ type ImportInstanceTaskDetails struct {
 	InstanceID *string `locationName:"instanceId" type:"string"`
 
 	// The instance operating system.
-	Platform *string `locationName:"platform" type:"string"`
+	Platform *string `locationName:"platform" type:"string" enum:"Windows"`
 
 	Volumes []*ImportInstanceVolumeDetailItem `locationName:"volumes" locationNameList:"item" type:"list" required:"true"`
 
@@ -12583,7 +14472,7 @@ type Instance struct {
 	AMILaunchIndex *int64 `locationName:"amiLaunchIndex" type:"integer"`
 
 	// The architecture of the image.
-	Architecture *string `locationName:"architecture" type:"string"`
+	Architecture *string `locationName:"architecture" type:"string" enum:"i386,x86_64"`
 
 	// Any block device mapping entries for the instance.
 	BlockDeviceMappings []*InstanceBlockDeviceMapping `locationName:"blockDeviceMapping" locationNameList:"item" type:"list"`
@@ -12599,7 +14488,7 @@ type Instance struct {
 	EBSOptimized *bool `locationName:"ebsOptimized" type:"boolean"`
 
 	// The hypervisor type of the instance.
-	Hypervisor *string `locationName:"hypervisor" type:"string"`
+	Hypervisor *string `locationName:"hypervisor" type:"string" enum:"ovm,xen"`
 
 	// The IAM instance profile associated with the instance.
 	IAMInstanceProfile *IAMInstanceProfile `locationName:"iamInstanceProfile" type:"structure"`
@@ -12611,10 +14500,10 @@ type Instance struct {
 	InstanceID *string `locationName:"instanceId" type:"string"`
 
 	// Indicates whether this is a Spot Instance.
-	InstanceLifecycle *string `locationName:"instanceLifecycle" type:"string"`
+	InstanceLifecycle *string `locationName:"instanceLifecycle" type:"string" enum:"spot"`
 
 	// The instance type.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// The kernel associated with this instance.
 	KernelID *string `locationName:"kernelId" type:"string"`
@@ -12636,7 +14525,7 @@ type Instance struct {
 	Placement *Placement `locationName:"placement" type:"structure"`
 
 	// The value is Windows for Windows instances; otherwise blank.
-	Platform *string `locationName:"platform" type:"string"`
+	Platform *string `locationName:"platform" type:"string" enum:"Windows"`
 
 	// The private DNS name assigned to the instance. This DNS name can only be
 	// used inside the Amazon EC2 network. This name is not available until the
@@ -12664,7 +14553,7 @@ type Instance struct {
 
 	// The root device type used by the AMI. The AMI can use an Amazon EBS volume
 	// or an instance store volume.
-	RootDeviceType *string `locationName:"rootDeviceType" type:"string"`
+	RootDeviceType *string `locationName:"rootDeviceType" type:"string" enum:"ebs,instance-store"`
 
 	// Specifies whether enhanced networking is enabled.
 	SRIOVNetSupport *string `locationName:"sriovNetSupport" type:"string"`
@@ -12702,7 +14591,7 @@ type Instance struct {
 	VPCID *string `locationName:"vpcId" type:"string"`
 
 	// The virtualization type of the instance.
-	VirtualizationType *string `locationName:"virtualizationType" type:"string"`
+	VirtualizationType *string `locationName:"virtualizationType" type:"string" enum:"hvm,paravirtual"`
 
 	metadataInstance `json:"-", xml:"-"`
 }
@@ -12755,7 +14644,7 @@ type InstanceCount struct {
 	InstanceCount *int64 `locationName:"instanceCount" type:"integer"`
 
 	// The states of the listed Reserved Instances.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"available,sold,cancelled,pending"`
 
 	metadataInstanceCount `json:"-", xml:"-"`
 }
@@ -12770,7 +14659,7 @@ type InstanceExportDetails struct {
 	InstanceID *string `locationName:"instanceId" type:"string"`
 
 	// The target virtualization environment.
-	TargetEnvironment *string `locationName:"targetEnvironment" type:"string"`
+	TargetEnvironment *string `locationName:"targetEnvironment" type:"string" enum:"citrix,vmware,microsoft"`
 
 	metadataInstanceExportDetails `json:"-", xml:"-"`
 }
@@ -12831,7 +14720,7 @@ type InstanceNetworkInterface struct {
 	SourceDestCheck *bool `locationName:"sourceDestCheck" type:"boolean"`
 
 	// The status of the network interface.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"available,attaching,in-use,detaching"`
 
 	// The ID of the subnet.
 	SubnetID *string `locationName:"subnetId" type:"string"`
@@ -12879,7 +14768,7 @@ type InstanceNetworkInterfaceAttachment struct {
 	DeviceIndex *int64 `locationName:"deviceIndex" type:"integer"`
 
 	// The attachment state.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	metadataInstanceNetworkInterfaceAttachment `json:"-", xml:"-"`
 }
@@ -12983,7 +14872,7 @@ type InstanceState struct {
 	Code *int64 `locationName:"code" type:"integer"`
 
 	// The current state of the instance.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" enum:"pending,running,shutting-down,terminated,stopping,stopped"`
 
 	metadataInstanceState `json:"-", xml:"-"`
 }
@@ -13048,10 +14937,10 @@ type InstanceStatusDetails struct {
 	ImpairedSince *time.Time `locationName:"impairedSince" type:"timestamp" timestampFormat:"iso8601"`
 
 	// The type of instance status.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" enum:"reachability"`
 
 	// The status.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"passed,failed,insufficient-data"`
 
 	metadataInstanceStatusDetails `json:"-", xml:"-"`
 }
@@ -13063,7 +14952,7 @@ type metadataInstanceStatusDetails struct {
 // Describes an instance event.
 type InstanceStatusEvent struct {
 	// The associated code of the event.
-	Code *string `locationName:"code" type:"string"`
+	Code *string `locationName:"code" type:"string" enum:"instance-reboot,system-reboot,system-maintenance,instance-retirement,instance-stop"`
 
 	// A description of the event.
 	Description *string `locationName:"description" type:"string"`
@@ -13087,7 +14976,7 @@ type InstanceStatusSummary struct {
 	Details []*InstanceStatusDetails `locationName:"details" locationNameList:"item" type:"list"`
 
 	// The status.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"ok,impaired,insufficient-data,not-applicable"`
 
 	metadataInstanceStatusSummary `json:"-", xml:"-"`
 }
@@ -13117,7 +15006,7 @@ type metadataInternetGateway struct {
 // Describes the attachment of a VPC to an Internet gateway.
 type InternetGatewayAttachment struct {
 	// The current state of the attachment.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	// The ID of the VPC.
 	VPCID *string `locationName:"vpcId" type:"string"`
@@ -13150,7 +15039,7 @@ type metadataKeyPairInfo struct {
 // Describes a launch permission.
 type LaunchPermission struct {
 	// The name of the group.
-	Group *string `locationName:"group" type:"string"`
+	Group *string `locationName:"group" type:"string" enum:"all"`
 
 	// The AWS account ID.
 	UserID *string `locationName:"userId" type:"string"`
@@ -13202,7 +15091,7 @@ type LaunchSpecification struct {
 	ImageID *string `locationName:"imageId" type:"string"`
 
 	// The instance type.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// The ID of the kernel.
 	KernelID *string `locationName:"kernelId" type:"string"`
@@ -13291,7 +15180,7 @@ type metadataModifyImageAttributeOutput struct {
 
 type ModifyInstanceAttributeInput struct {
 	// The name of the attribute.
-	Attribute *string `locationName:"attribute" type:"string"`
+	Attribute *string `locationName:"attribute" type:"string" enum:"instanceType,kernel,ramdisk,userData,disableApiTermination,instanceInitiatedShutdownBehavior,rootDeviceName,blockDeviceMapping,productCodes,sourceDestCheck,groupSet,ebsOptimized,sriovNetSupport"`
 
 	// Modifies the DeleteOnTermination attribute for volumes that are currently
 	// attached. The volume must be owned by the caller. If no value is specified
@@ -13454,7 +15343,7 @@ type metadataModifyReservedInstancesOutput struct {
 
 type ModifySnapshotAttributeInput struct {
 	// The snapshot attribute to modify.
-	Attribute *string `type:"string"`
+	Attribute *string `type:"string" enum:"productCodes,createVolumePermission"`
 
 	// A JSON representation of the snapshot attribute modification.
 	CreateVolumePermission *CreateVolumePermissionModifications `type:"structure"`
@@ -13593,7 +15482,7 @@ type metadataMonitorInstancesOutput struct {
 // Describes the monitoring for the instance.
 type Monitoring struct {
 	// Indicates whether monitoring is enabled for the instance.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"disabled,enabled,pending"`
 
 	metadataMonitoring `json:"-", xml:"-"`
 }
@@ -13666,7 +15555,7 @@ type NetworkACLEntry struct {
 	Protocol *string `locationName:"protocol" type:"string"`
 
 	// Indicates whether to allow or deny the traffic that matches the rule.
-	RuleAction *string `locationName:"ruleAction" type:"string"`
+	RuleAction *string `locationName:"ruleAction" type:"string" enum:"allow,deny"`
 
 	// The rule number for the entry. ACL entries are processed in ascending order
 	// by rule number.
@@ -13726,7 +15615,7 @@ type NetworkInterface struct {
 	SourceDestCheck *bool `locationName:"sourceDestCheck" type:"boolean"`
 
 	// The status of the network interface.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"available,attaching,in-use,detaching"`
 
 	// The ID of the subnet.
 	SubnetID *string `locationName:"subnetId" type:"string"`
@@ -13789,7 +15678,7 @@ type NetworkInterfaceAttachment struct {
 	InstanceOwnerID *string `locationName:"instanceOwnerId" type:"string"`
 
 	// The attachment state.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	metadataNetworkInterfaceAttachment `json:"-", xml:"-"`
 }
@@ -13858,7 +15747,7 @@ type Placement struct {
 
 	// The tenancy of the instance (if the instance is running in a VPC). An instance
 	// with a tenancy of dedicated runs on single-tenant hardware.
-	Tenancy *string `locationName:"tenancy" type:"string"`
+	Tenancy *string `locationName:"tenancy" type:"string" enum:"default,dedicated"`
 
 	metadataPlacement `json:"-", xml:"-"`
 }
@@ -13873,10 +15762,10 @@ type PlacementGroup struct {
 	GroupName *string `locationName:"groupName" type:"string"`
 
 	// The state of the placement group.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"pending,available,deleting,deleted"`
 
 	// The placement strategy.
-	Strategy *string `locationName:"strategy" type:"string"`
+	Strategy *string `locationName:"strategy" type:"string" enum:"cluster"`
 
 	metadataPlacementGroup `json:"-", xml:"-"`
 }
@@ -13916,7 +15805,7 @@ type PriceSchedule struct {
 
 	// The currency for transacting the Reserved Instance resale. At this time,
 	// the only supported currency is USD.
-	CurrencyCode *string `locationName:"currencyCode" type:"string"`
+	CurrencyCode *string `locationName:"currencyCode" type:"string" enum:"USD"`
 
 	// The fixed price for the term.
 	Price *float64 `locationName:"price" type:"double"`
@@ -13936,7 +15825,7 @@ type metadataPriceSchedule struct {
 type PriceScheduleSpecification struct {
 	// The currency for transacting the Reserved Instance resale. At this time,
 	// the only supported currency is USD.
-	CurrencyCode *string `locationName:"currencyCode" type:"string"`
+	CurrencyCode *string `locationName:"currencyCode" type:"string" enum:"USD"`
 
 	// The fixed price for the term.
 	Price *float64 `locationName:"price" type:"double"`
@@ -13989,7 +15878,7 @@ type ProductCode struct {
 	ProductCodeID *string `locationName:"productCode" type:"string"`
 
 	// The type of product code.
-	ProductCodeType *string `locationName:"type" type:"string"`
+	ProductCodeType *string `locationName:"type" type:"string" enum:"devpay,marketplace"`
 
 	metadataProductCode `json:"-", xml:"-"`
 }
@@ -14069,7 +15958,7 @@ type RecurringCharge struct {
 	Amount *float64 `locationName:"amount" type:"double"`
 
 	// The frequency of the recurring charge.
-	Frequency *string `locationName:"frequency" type:"string"`
+	Frequency *string `locationName:"frequency" type:"string" enum:"Hourly"`
 
 	metadataRecurringCharge `json:"-", xml:"-"`
 }
@@ -14098,7 +15987,7 @@ type RegisterImageInput struct {
 	//
 	// Default: For Amazon EBS-backed AMIs, i386. For instance store-backed AMIs,
 	// the architecture specified in the manifest file.
-	Architecture *string `locationName:"architecture" type:"string"`
+	Architecture *string `locationName:"architecture" type:"string" enum:"i386,x86_64"`
 
 	// One or more block device mapping entries.
 	BlockDeviceMappings []*BlockDeviceMapping `locationName:"BlockDeviceMapping" locationNameList:"BlockDeviceMapping" type:"list"`
@@ -14261,7 +16150,7 @@ type ReplaceNetworkACLEntryInput struct {
 	Protocol *string `locationName:"protocol" type:"string" required:"true"`
 
 	// Indicates whether to allow or deny the traffic that matches the rule.
-	RuleAction *string `locationName:"ruleAction" type:"string" required:"true"`
+	RuleAction *string `locationName:"ruleAction" type:"string" enum:"allow,deny" required:"true"`
 
 	// The rule number of the entry to replace.
 	RuleNumber *int64 `locationName:"ruleNumber" type:"integer" required:"true"`
@@ -14385,7 +16274,7 @@ type ReportInstanceStatusInput struct {
 	StartTime *time.Time `locationName:"startTime" type:"timestamp" timestampFormat:"iso8601"`
 
 	// The status of all instances listed.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	Status *string `locationName:"status" type:"string" enum:"ok,impaired" required:"true"`
 
 	metadataReportInstanceStatusInput `json:"-", xml:"-"`
 }
@@ -14447,7 +16336,7 @@ type RequestSpotInstancesInput struct {
 	// The Spot Instance request type.
 	//
 	// Default: one-time
-	Type *string `locationName:"type" type:"string"`
+	Type *string `locationName:"type" type:"string" enum:"one-time,persistent"`
 
 	// The start date of the request. If this is a one-time request, the request
 	// becomes active at this date and time and remains active until all instances
@@ -14508,7 +16397,7 @@ type RequestSpotLaunchSpecification struct {
 	ImageID *string `locationName:"imageId" type:"string"`
 
 	// The instance type.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// The ID of the kernel.
 	KernelID *string `locationName:"kernelId" type:"string"`
@@ -14578,7 +16467,7 @@ type ReservedInstanceLimitPrice struct {
 
 	// The currency in which the limitPrice amount is specified. At this time, the
 	// only supported currency is USD.
-	CurrencyCode *string `locationName:"currencyCode" type:"string"`
+	CurrencyCode *string `locationName:"currencyCode" type:"string" enum:"USD"`
 
 	metadataReservedInstanceLimitPrice `json:"-", xml:"-"`
 }
@@ -14594,7 +16483,7 @@ type ReservedInstances struct {
 
 	// The currency of the Reserved Instance. It's specified using ISO 4217 standard
 	// currency codes. At this time, the only supported currency is USD.
-	CurrencyCode *string `locationName:"currencyCode" type:"string"`
+	CurrencyCode *string `locationName:"currencyCode" type:"string" enum:"USD"`
 
 	// The duration of the Reserved Instance, in seconds.
 	Duration *int64 `locationName:"duration" type:"long"`
@@ -14609,16 +16498,16 @@ type ReservedInstances struct {
 	InstanceCount *int64 `locationName:"instanceCount" type:"integer"`
 
 	// The tenancy of the reserved instance.
-	InstanceTenancy *string `locationName:"instanceTenancy" type:"string"`
+	InstanceTenancy *string `locationName:"instanceTenancy" type:"string" enum:"default,dedicated"`
 
 	// The instance type on which the Reserved Instance can be used.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// The Reserved Instance offering type.
-	OfferingType *string `locationName:"offeringType" type:"string"`
+	OfferingType *string `locationName:"offeringType" type:"string" enum:"Heavy Utilization,Medium Utilization,Light Utilization,No Upfront,Partial Upfront,All Upfront"`
 
 	// The Reserved Instance description.
-	ProductDescription *string `locationName:"productDescription" type:"string"`
+	ProductDescription *string `locationName:"productDescription" type:"string" enum:"Linux/UNIX,Linux/UNIX (Amazon VPC),Windows,Windows (Amazon VPC)"`
 
 	// The recurring charge tag assigned to the resource.
 	RecurringCharges []*RecurringCharge `locationName:"recurringCharges" locationNameList:"item" type:"list"`
@@ -14630,7 +16519,7 @@ type ReservedInstances struct {
 	Start *time.Time `locationName:"start" type:"timestamp" timestampFormat:"iso8601"`
 
 	// The state of the Reserved Instance purchase.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"payment-pending,active,payment-failed,retired"`
 
 	// Any tags assigned to the resource.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
@@ -14654,7 +16543,7 @@ type ReservedInstancesConfiguration struct {
 	InstanceCount *int64 `locationName:"instanceCount" type:"integer"`
 
 	// The instance type for the modified Reserved Instances.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// The network platform of the modified Reserved Instances, which is either
 	// EC2-Classic or EC2-VPC.
@@ -14700,7 +16589,7 @@ type ReservedInstancesListing struct {
 	ReservedInstancesListingID *string `locationName:"reservedInstancesListingId" type:"string"`
 
 	// The status of the Reserved Instance listing.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"active,pending,cancelled,closed"`
 
 	// The reason for the current status of the Reserved Instance listing. The response
 	// can be blank.
@@ -14781,7 +16670,7 @@ type ReservedInstancesOffering struct {
 	// The currency of the Reserved Instance offering you are purchasing. It's specified
 	// using ISO 4217 standard currency codes. At this time, the only supported
 	// currency is USD.
-	CurrencyCode *string `locationName:"currencyCode" type:"string"`
+	CurrencyCode *string `locationName:"currencyCode" type:"string" enum:"USD"`
 
 	// The duration of the Reserved Instance, in seconds.
 	Duration *int64 `locationName:"duration" type:"long"`
@@ -14790,10 +16679,10 @@ type ReservedInstancesOffering struct {
 	FixedPrice *float64 `locationName:"fixedPrice" type:"float"`
 
 	// The tenancy of the reserved instance.
-	InstanceTenancy *string `locationName:"instanceTenancy" type:"string"`
+	InstanceTenancy *string `locationName:"instanceTenancy" type:"string" enum:"default,dedicated"`
 
 	// The instance type on which the Reserved Instance can be used.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// Indicates whether the offering is available through the Reserved Instance
 	// Marketplace (resale) or AWS. If it's a Reserved Instance Marketplace offering,
@@ -14801,13 +16690,13 @@ type ReservedInstancesOffering struct {
 	Marketplace *bool `locationName:"marketplace" type:"boolean"`
 
 	// The Reserved Instance offering type.
-	OfferingType *string `locationName:"offeringType" type:"string"`
+	OfferingType *string `locationName:"offeringType" type:"string" enum:"Heavy Utilization,Medium Utilization,Light Utilization,No Upfront,Partial Upfront,All Upfront"`
 
 	// The pricing details of the Reserved Instance offering.
 	PricingDetails []*PricingDetail `locationName:"pricingDetailsSet" locationNameList:"item" type:"list"`
 
 	// The Reserved Instance description.
-	ProductDescription *string `locationName:"productDescription" type:"string"`
+	ProductDescription *string `locationName:"productDescription" type:"string" enum:"Linux/UNIX,Linux/UNIX (Amazon VPC),Windows,Windows (Amazon VPC)"`
 
 	// The recurring charge tag assigned to the resource.
 	RecurringCharges []*RecurringCharge `locationName:"recurringCharges" locationNameList:"item" type:"list"`
@@ -14828,7 +16717,7 @@ type metadataReservedInstancesOffering struct {
 type ResetImageAttributeInput struct {
 	// The attribute to reset (currently you can only reset the launch permission
 	// attribute).
-	Attribute *string `type:"string" required:"true"`
+	Attribute *string `type:"string" enum:"launchPermission" required:"true"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -14852,7 +16741,7 @@ type metadataResetImageAttributeOutput struct {
 
 type ResetInstanceAttributeInput struct {
 	// The attribute to reset.
-	Attribute *string `locationName:"attribute" type:"string" required:"true"`
+	Attribute *string `locationName:"attribute" type:"string" enum:"instanceType,kernel,ramdisk,userData,disableApiTermination,instanceInitiatedShutdownBehavior,rootDeviceName,blockDeviceMapping,productCodes,sourceDestCheck,groupSet,ebsOptimized,sriovNetSupport" required:"true"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -14901,7 +16790,7 @@ type metadataResetNetworkInterfaceAttributeOutput struct {
 type ResetSnapshotAttributeInput struct {
 	// The attribute to reset (currently only the attribute for permission to create
 	// volumes can be reset).
-	Attribute *string `type:"string" required:"true"`
+	Attribute *string `type:"string" enum:"productCodes,createVolumePermission" required:"true"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -15047,12 +16936,12 @@ type Route struct {
 	// route table was created.  CreateRoute indicates that the route was manually
 	// added to the route table.  EnableVgwRoutePropagation indicates that the route
 	// was propagated by route propagation.
-	Origin *string `locationName:"origin" type:"string"`
+	Origin *string `locationName:"origin" type:"string" enum:"CreateRouteTable,CreateRoute,EnableVgwRoutePropagation"`
 
 	// The state of the route. The blackhole state indicates that the route's target
 	// isn't available (for example, the specified gateway isn't attached to the
 	// VPC, or the specified NAT instance has been terminated).
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"active,blackhole"`
 
 	// The ID of the VPC peering connection.
 	VPCPeeringConnectionID *string `locationName:"vpcPeeringConnectionId" type:"string"`
@@ -15158,13 +17047,13 @@ type RunInstancesInput struct {
 	// from the instance (using the operating system command for system shutdown).
 	//
 	// Default: stop
-	InstanceInitiatedShutdownBehavior *string `locationName:"instanceInitiatedShutdownBehavior" type:"string"`
+	InstanceInitiatedShutdownBehavior *string `locationName:"instanceInitiatedShutdownBehavior" type:"string" enum:"stop,terminate"`
 
 	// The instance type. For more information, see Instance Types (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-types.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
 	//
 	// Default: m1.small
-	InstanceType *string `type:"string"`
+	InstanceType *string `type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// The ID of the kernel.
 	//
@@ -15354,7 +17243,7 @@ type Snapshot struct {
 	StartTime *time.Time `locationName:"startTime" type:"timestamp" timestampFormat:"iso8601"`
 
 	// The snapshot state.
-	State *string `locationName:"status" type:"string"`
+	State *string `locationName:"status" type:"string" enum:"pending,completed,error"`
 
 	// Any tags assigned to the snapshot.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
@@ -15387,7 +17276,7 @@ type SpotDatafeedSubscription struct {
 	Prefix *string `locationName:"prefix" type:"string"`
 
 	// The state of the Spot Instance data feed subscription.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"Active,Inactive"`
 
 	metadataSpotDatafeedSubscription `json:"-", xml:"-"`
 }
@@ -15424,7 +17313,7 @@ type SpotInstanceRequest struct {
 	LaunchedAvailabilityZone *string `locationName:"launchedAvailabilityZone" type:"string"`
 
 	// The product description associated with the Spot Instance.
-	ProductDescription *string `locationName:"productDescription" type:"string"`
+	ProductDescription *string `locationName:"productDescription" type:"string" enum:"Linux/UNIX,Linux/UNIX (Amazon VPC),Windows,Windows (Amazon VPC)"`
 
 	// The ID of the Spot Instance request.
 	SpotInstanceRequestID *string `locationName:"spotInstanceRequestId" type:"string"`
@@ -15437,7 +17326,7 @@ type SpotInstanceRequest struct {
 	// you track your Spot Instance requests. For more information, see Spot Bid
 	// Status (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/spot-bid-status.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"open,active,closed,cancelled,failed"`
 
 	// The status code and status message describing the Spot Instance request.
 	Status *SpotInstanceStatus `locationName:"status" type:"structure"`
@@ -15446,7 +17335,7 @@ type SpotInstanceRequest struct {
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
 
 	// The Spot Instance request type.
-	Type *string `locationName:"type" type:"string"`
+	Type *string `locationName:"type" type:"string" enum:"one-time,persistent"`
 
 	// The start date of the request. If this is a one-time request, the request
 	// becomes active at this date and time and remains active until all instances
@@ -15523,10 +17412,10 @@ type SpotPrice struct {
 	AvailabilityZone *string `locationName:"availabilityZone" type:"string"`
 
 	// The instance type.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// A general description of the AMI.
-	ProductDescription *string `locationName:"productDescription" type:"string"`
+	ProductDescription *string `locationName:"productDescription" type:"string" enum:"Linux/UNIX,Linux/UNIX (Amazon VPC),Windows,Windows (Amazon VPC)"`
 
 	// The maximum price (bid) that you are willing to pay for a Spot Instance.
 	SpotPrice *string `locationName:"spotPrice" type:"string"`
@@ -15668,7 +17557,7 @@ type Subnet struct {
 	MapPublicIPOnLaunch *bool `locationName:"mapPublicIpOnLaunch" type:"boolean"`
 
 	// The current state of the subnet.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"pending,available"`
 
 	// The ID of the subnet.
 	SubnetID *string `locationName:"subnetId" type:"string"`
@@ -15716,7 +17605,7 @@ type TagDescription struct {
 	ResourceID *string `locationName:"resourceId" type:"string"`
 
 	// The resource type.
-	ResourceType *string `locationName:"resourceType" type:"string"`
+	ResourceType *string `locationName:"resourceType" type:"string" enum:"customer-gateway,dhcp-options,image,instance,internet-gateway,network-acl,network-interface,reserved-instances,route-table,snapshot,spot-instances-request,subnet,security-group,volume,vpc,vpn-connection,vpn-gateway"`
 
 	// The tag value.
 	Value *string `locationName:"value" type:"string"`
@@ -15842,7 +17731,7 @@ type VGWTelemetry struct {
 	OutsideIPAddress *string `locationName:"outsideIpAddress" type:"string"`
 
 	// The status of the VPN tunnel.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"UP,DOWN"`
 
 	// If an error occurs, a description of the error.
 	StatusMessage *string `locationName:"statusMessage" type:"string"`
@@ -15864,13 +17753,13 @@ type VPC struct {
 	DHCPOptionsID *string `locationName:"dhcpOptionsId" type:"string"`
 
 	// The allowed tenancy of instances launched into the VPC.
-	InstanceTenancy *string `locationName:"instanceTenancy" type:"string"`
+	InstanceTenancy *string `locationName:"instanceTenancy" type:"string" enum:"default,dedicated"`
 
 	// Indicates whether the VPC is the default VPC.
 	IsDefault *bool `locationName:"isDefault" type:"boolean"`
 
 	// The current state of the VPC.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"pending,available"`
 
 	// Any tags assigned to the VPC.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
@@ -15888,7 +17777,7 @@ type metadataVPC struct {
 // Describes an attachment between a virtual private gateway and a VPC.
 type VPCAttachment struct {
 	// The current state of the attachment.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	// The ID of the VPC.
 	VPCID *string `locationName:"vpcId" type:"string"`
@@ -15996,13 +17885,13 @@ type VPNConnection struct {
 	Routes []*VPNStaticRoute `locationName:"routes" locationNameList:"item" type:"list"`
 
 	// The current state of the VPN connection.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"pending,available,deleting,deleted"`
 
 	// Any tags assigned to the VPN connection.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
 
 	// The type of VPN connection.
-	Type *string `locationName:"type" type:"string"`
+	Type *string `locationName:"type" type:"string" enum:"ipsec.1"`
 
 	// Information about the VPN tunnel.
 	VGWTelemetry []*VGWTelemetry `locationName:"vgwTelemetry" locationNameList:"item" type:"list"`
@@ -16052,13 +17941,13 @@ type VPNGateway struct {
 	AvailabilityZone *string `locationName:"availabilityZone" type:"string"`
 
 	// The current state of the virtual private gateway.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"pending,available,deleting,deleted"`
 
 	// Any tags assigned to the virtual private gateway.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
 
 	// The type of VPN connection the virtual private gateway supports.
-	Type *string `locationName:"type" type:"string"`
+	Type *string `locationName:"type" type:"string" enum:"ipsec.1"`
 
 	// Any VPCs attached to the virtual private gateway.
 	VPCAttachments []*VPCAttachment `locationName:"attachments" locationNameList:"item" type:"list"`
@@ -16079,10 +17968,10 @@ type VPNStaticRoute struct {
 	DestinationCIDRBlock *string `locationName:"destinationCidrBlock" type:"string"`
 
 	// Indicates how the routes were provided.
-	Source *string `locationName:"source" type:"string"`
+	Source *string `locationName:"source" type:"string" enum:"Static"`
 
 	// The current state of the static route.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"pending,available,deleting,deleted"`
 
 	metadataVPNStaticRoute `json:"-", xml:"-"`
 }
@@ -16130,7 +18019,7 @@ type Volume struct {
 	SnapshotID *string `locationName:"snapshotId" type:"string"`
 
 	// The volume state.
-	State *string `locationName:"status" type:"string"`
+	State *string `locationName:"status" type:"string" enum:"creating,available,in-use,deleting,deleted,error"`
 
 	// Any tags assigned to the volume.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
@@ -16140,7 +18029,7 @@ type Volume struct {
 
 	// The volume type. This can be gp2 for General Purpose (SSD) volumes, io1 for
 	// Provisioned IOPS (SSD) volumes, or standard for Magnetic volumes.
-	VolumeType *string `locationName:"volumeType" type:"string"`
+	VolumeType *string `locationName:"volumeType" type:"string" enum:"standard,io1,gp2"`
 
 	metadataVolume `json:"-", xml:"-"`
 }
@@ -16164,7 +18053,7 @@ type VolumeAttachment struct {
 	InstanceID *string `locationName:"instanceId" type:"string"`
 
 	// The attachment state of the volume.
-	State *string `locationName:"status" type:"string"`
+	State *string `locationName:"status" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	// The ID of the volume.
 	VolumeID *string `locationName:"volumeId" type:"string"`
@@ -16212,7 +18101,7 @@ type metadataVolumeStatusAction struct {
 // Describes a volume status.
 type VolumeStatusDetails struct {
 	// The name of the volume status.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" enum:"io-enabled,io-performance"`
 
 	// The intended status of the volume status.
 	Status *string `locationName:"status" type:"string"`
@@ -16254,7 +18143,7 @@ type VolumeStatusInfo struct {
 	Details []*VolumeStatusDetails `locationName:"details" locationNameList:"item" type:"list"`
 
 	// The status of the volume.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"ok,impaired,insufficient-data"`
 
 	metadataVolumeStatusInfo `json:"-", xml:"-"`
 }
@@ -16285,4 +18174,1210 @@ type VolumeStatusItem struct {
 
 type metadataVolumeStatusItem struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for AccountAttributeName.
+const (
+	AccountAttributeNameSupportedPlatforms = "supported-platforms"
+	AccountAttributeNameDefaultVPC         = "default-vpc"
+)
+
+// AccountAttributeNameValues returns the valid values of AccountAttributeName.
+func AccountAttributeNameValues() []string {
+	return []string{
+		AccountAttributeNameSupportedPlatforms,
+		AccountAttributeNameDefaultVPC,
+	}
+}
+
+// Enum values for ArchitectureValues.
+const (
+	ArchitectureValuesI386  = "i386"
+	ArchitectureValuesX8664 = "x86_64"
+)
+
+// ArchitectureValuesValues returns the valid values of ArchitectureValues.
+func ArchitectureValuesValues() []string {
+	return []string{
+		ArchitectureValuesI386,
+		ArchitectureValuesX8664,
+	}
+}
+
+// Enum values for AttachmentStatus.
+const (
+	AttachmentStatusAttaching = "attaching"
+	AttachmentStatusAttached  = "attached"
+	AttachmentStatusDetaching = "detaching"
+	AttachmentStatusDetached  = "detached"
+)
+
+// AttachmentStatusValues returns the valid values of AttachmentStatus.
+func AttachmentStatusValues() []string {
+	return []string{
+		AttachmentStatusAttaching,
+		AttachmentStatusAttached,
+		AttachmentStatusDetaching,
+		AttachmentStatusDetached,
+	}
+}
+
+// Enum values for AvailabilityZoneState.
+const (
+	AvailabilityZoneStateAvailable = "available"
+)
+
+// AvailabilityZoneStateValues returns the valid values of AvailabilityZoneState.
+func AvailabilityZoneStateValues() []string {
+	return []string{
+		AvailabilityZoneStateAvailable,
+	}
+}
+
+// Enum values for BundleTaskState.
+const (
+	BundleTaskStatePending            = "pending"
+	BundleTaskStateWaitingForShutdown = "waiting-for-shutdown"
+	BundleTaskStateBundling           = "bundling"
+	BundleTaskStateStoring            = "storing"
+	BundleTaskStateCancelling         = "cancelling"
+	BundleTaskStateComplete           = "complete"
+	BundleTaskStateFailed             = "failed"
+)
+
+// BundleTaskStateValues returns the valid values of BundleTaskState.
+func BundleTaskStateValues() []string {
+	return []string{
+		BundleTaskStatePending,
+		BundleTaskStateWaitingForShutdown,
+		BundleTaskStateBundling,
+		BundleTaskStateStoring,
+		BundleTaskStateCancelling,
+		BundleTaskStateComplete,
+		BundleTaskStateFailed,
+	}
+}
+
+// Enum values for CancelSpotInstanceRequestState.
+const (
+	CancelSpotInstanceRequestStateActive    = "active"
+	CancelSpotInstanceRequestStateOpen      = "open"
+	CancelSpotInstanceRequestStateClosed    = "closed"
+	CancelSpotInstanceRequestStateCancelled = "cancelled"
+	CancelSpotInstanceRequestStateCompleted = "completed"
+)
+
+// CancelSpotInstanceRequestStateValues returns the valid values of CancelSpotInstanceRequestState.
+func CancelSpotInstanceRequestStateValues() []string {
+	return []string{
+		CancelSpotInstanceRequestStateActive,
+		CancelSpotInstanceRequestStateOpen,
+		CancelSpotInstanceRequestStateClosed,
+		CancelSpotInstanceRequestStateCancelled,
+		CancelSpotInstanceRequestStateCompleted,
+	}
+}
+
+// Enum values for ContainerFormat.
+const (
+	ContainerFormatOva = "ova"
+)
+
+// ContainerFormatValues returns the valid values of ContainerFormat.
+func ContainerFormatValues() []string {
+	return []string{
+		ContainerFormatOva,
+	}
+}
+
+// Enum values for ConversionTaskState.
+const (
+	ConversionTaskStateActive     = "active"
+	ConversionTaskStateCancelling = "cancelling"
+	ConversionTaskStateCancelled  = "cancelled"
+	ConversionTaskStateCompleted  = "completed"
+)
+
+// ConversionTaskStateValues returns the valid values of ConversionTaskState.
+func ConversionTaskStateValues() []string {
+	return []string{
+		ConversionTaskStateActive,
+		ConversionTaskStateCancelling,
+		ConversionTaskStateCancelled,
+		ConversionTaskStateCompleted,
+	}
+}
+
+// Enum values for CurrencyCodeValues.
+const (
+	CurrencyCodeValuesUsd = "USD"
+)
+
+// CurrencyCodeValuesValues returns the valid values of CurrencyCodeValues.
+func CurrencyCodeValuesValues() []string {
+	return []string{
+		CurrencyCodeValuesUsd,
+	}
+}
+
+// Enum values for DatafeedSubscriptionState.
+const (
+	DatafeedSubscriptionStateActive   = "Active"
+	DatafeedSubscriptionStateInactive = "Inactive"
+)
+
+// DatafeedSubscriptionStateValues returns the valid values of DatafeedSubscriptionState.
+func DatafeedSubscriptionStateValues() []string {
+	return []string{
+		DatafeedSubscriptionStateActive,
+		DatafeedSubscriptionStateInactive,
+	}
+}
+
+// Enum values for DeviceType.
+const (
+	DeviceTypeEBS           = "ebs"
+	DeviceTypeInstanceStore = "instance-store"
+)
+
+// DeviceTypeValues returns the valid values of DeviceType.
+func DeviceTypeValues() []string {
+	return []string{
+		DeviceTypeEBS,
+		DeviceTypeInstanceStore,
+	}
+}
+
+// Enum values for DiskImageFormat.
+const (
+	DiskImageFormatVmdk = "VMDK"
+	DiskImageFormatRaw  = "RAW"
+	DiskImageFormatVhd  = "VHD"
+)
+
+// DiskImageFormatValues returns the valid values of DiskImageFormat.
+func DiskImageFormatValues() []string {
+	return []string{
+		DiskImageFormatVmdk,
+		DiskImageFormatRaw,
+		DiskImageFormatVhd,
+	}
+}
+
+// Enum values for DomainType.
+const (
+	DomainTypeVPC      = "vpc"
+	DomainTypeStandard = "standard"
+)
+
+// DomainTypeValues returns the valid values of DomainType.
+func DomainTypeValues() []string {
+	return []string{
+		DomainTypeVPC,
+		DomainTypeStandard,
+	}
+}
+
+// Enum values for EventCode.
+const (
+	EventCodeInstanceReboot     = "instance-reboot"
+	EventCodeSystemReboot       = "system-reboot"
+	EventCodeSystemMaintenance  = "system-maintenance"
+	EventCodeInstanceRetirement = "instance-retirement"
+	EventCodeInstanceStop       = "instance-stop"
+)
+
+// EventCodeValues returns the valid values of EventCode.
+func EventCodeValues() []string {
+	return []string{
+		EventCodeInstanceReboot,
+		EventCodeSystemReboot,
+		EventCodeSystemMaintenance,
+		EventCodeInstanceRetirement,
+		EventCodeInstanceStop,
+	}
+}
+
+// Enum values for ExportEnvironment.
+const (
+	ExportEnvironmentCitrix    = "citrix"
+	ExportEnvironmentVmware    = "vmware"
+	ExportEnvironmentMicrosoft = "microsoft"
+)
+
+// ExportEnvironmentValues returns the valid values of ExportEnvironment.
+func ExportEnvironmentValues() []string {
+	return []string{
+		ExportEnvironmentCitrix,
+		ExportEnvironmentVmware,
+		ExportEnvironmentMicrosoft,
+	}
+}
+
+// Enum values for ExportTaskState.
+const (
+	ExportTaskStateActive     = "active"
+	ExportTaskStateCancelling = "cancelling"
+	ExportTaskStateCancelled  = "cancelled"
+	ExportTaskStateCompleted  = "completed"
+)
+
+// ExportTaskStateValues returns the valid values of ExportTaskState.
+func ExportTaskStateValues() []string {
+	return []string{
+		ExportTaskStateActive,
+		ExportTaskStateCancelling,
+		ExportTaskStateCancelled,
+		ExportTaskStateCompleted,
+	}
+}
+
+// Enum values for GatewayType.
+const (
+	GatewayTypeIpsec1 = "ipsec.1"
+)
+
+// GatewayTypeValues returns the valid values of GatewayType.
+func GatewayTypeValues() []string {
+	return []string{
+		GatewayTypeIpsec1,
+	}
+}
+
+// Enum values for HypervisorType.
+const (
+	HypervisorTypeOvm = "ovm"
+	HypervisorTypeXen = "xen"
+)
+
+// HypervisorTypeValues returns the valid values of HypervisorType.
+func HypervisorTypeValues() []string {
+	return []string{
+		HypervisorTypeOvm,
+		HypervisorTypeXen,
+	}
+}
+
+// Enum values for ImageAttributeName.
+const (
+	ImageAttributeNameDescription        = "description"
+	ImageAttributeNameKernel             = "kernel"
+	ImageAttributeNameRAMDisk            = "ramdisk"
+	ImageAttributeNameLaunchPermission   = "launchPermission"
+	ImageAttributeNameProductCodes       = "productCodes"
+	ImageAttributeNameBlockDeviceMapping = "blockDeviceMapping"
+)
+
+// ImageAttributeNameValues returns the valid values of ImageAttributeName.
+func ImageAttributeNameValues() []string {
+	return []string{
+		ImageAttributeNameDescription,
+		ImageAttributeNameKernel,
+		ImageAttributeNameRAMDisk,
+		ImageAttributeNameLaunchPermission,
+		ImageAttributeNameProductCodes,
+		ImageAttributeNameBlockDeviceMapping,
+	}
+}
+
+// Enum values for ImageState.
+const (
+	ImageStateAvailable    = "available"
+	ImageStateDeregistered = "deregistered"
+)
+
+// ImageStateValues returns the valid values of ImageState.
+func ImageStateValues() []string {
+	return []string{
+		ImageStateAvailable,
+		ImageStateDeregistered,
+	}
+}
+
+// Enum values for ImageTypeValues.
+const (
+	ImageTypeValuesMachine = "machine"
+	ImageTypeValuesKernel  = "kernel"
+	ImageTypeValuesRAMDisk = "ramdisk"
+)
+
+// ImageTypeValuesValues returns the valid values of ImageTypeValues.
+func ImageTypeValuesValues() []string {
+	return []string{
+		ImageTypeValuesMachine,
+		ImageTypeValuesKernel,
+		ImageTypeValuesRAMDisk,
+	}
+}
+
+// Enum values for InstanceAttributeName.
+const (
+	InstanceAttributeNameInstanceType                      = "instanceType"
+	InstanceAttributeNameKernel                            = "kernel"
+	InstanceAttributeNameRAMDisk                           = "ramdisk"
+	InstanceAttributeNameUserData                          = "userData"
+	InstanceAttributeNameDisableAPITermination             = "disableApiTermination"
+	InstanceAttributeNameInstanceInitiatedShutdownBehavior = "instanceInitiatedShutdownBehavior"
+	InstanceAttributeNameRootDeviceName                    = "rootDeviceName"
+	InstanceAttributeNameBlockDeviceMapping                = "blockDeviceMapping"
+	InstanceAttributeNameProductCodes                      = "productCodes"
+	InstanceAttributeNameSourceDestCheck                   = "sourceDestCheck"
+	InstanceAttributeNameGroupSet                          = "groupSet"
+	InstanceAttributeNameEBSOptimized                      = "ebsOptimized"
+	InstanceAttributeNameSRIOVNetSupport                   = "sriovNetSupport"
+)
+
+// InstanceAttributeNameValues returns the valid values of InstanceAttributeName.
+func InstanceAttributeNameValues() []string {
+	return []string{
+		InstanceAttributeNameInstanceType,
+		InstanceAttributeNameKernel,
+		InstanceAttributeNameRAMDisk,
+		InstanceAttributeNameUserData,
+		InstanceAttributeNameDisableAPITermination,
+		InstanceAttributeNameInstanceInitiatedShutdownBehavior,
+		InstanceAttributeNameRootDeviceName,
+		InstanceAttributeNameBlockDeviceMapping,
+		InstanceAttributeNameProductCodes,
+		InstanceAttributeNameSourceDestCheck,
+		InstanceAttributeNameGroupSet,
+		InstanceAttributeNameEBSOptimized,
+		InstanceAttributeNameSRIOVNetSupport,
+	}
+}
+
+// Enum values for InstanceLifecycleType.
+const (
+	InstanceLifecycleTypeSpot = "spot"
+)
+
+// InstanceLifecycleTypeValues returns the valid values of InstanceLifecycleType.
+func InstanceLifecycleTypeValues() []string {
+	return []string{
+		InstanceLifecycleTypeSpot,
+	}
+}
+
+// Enum values for InstanceStateName.
+const (
+	InstanceStateNamePending      = "pending"
+	InstanceStateNameRunning      = "running"
+	InstanceStateNameShuttingDown = "shutting-down"
+	InstanceStateNameTerminated   = "terminated"
+	InstanceStateNameStopping     = "stopping"
+	InstanceStateNameStopped      = "stopped"
+)
+
+// InstanceStateNameValues returns the valid values of InstanceStateName.
+func InstanceStateNameValues() []string {
+	return []string{
+		InstanceStateNamePending,
+		InstanceStateNameRunning,
+		InstanceStateNameShuttingDown,
+		InstanceStateNameTerminated,
+		InstanceStateNameStopping,
+		InstanceStateNameStopped,
+	}
+}
+
+// Enum values for InstanceType.
+const (
+	InstanceTypeT1Micro    = "t1.micro"
+	InstanceTypeM1Small    = "m1.small"
+	InstanceTypeM1Medium   = "m1.medium"
+	InstanceTypeM1Large    = "m1.large"
+	InstanceTypeM1Xlarge   = "m1.xlarge"
+	InstanceTypeM3Medium   = "m3.medium"
+	InstanceTypeM3Large    = "m3.large"
+	InstanceTypeM3Xlarge   = "m3.xlarge"
+	InstanceTypeM32xlarge  = "m3.2xlarge"
+	InstanceTypeT2Micro    = "t2.micro"
+	InstanceTypeT2Small    = "t2.small"
+	InstanceTypeT2Medium   = "t2.medium"
+	InstanceTypeM2Xlarge   = "m2.xlarge"
+	InstanceTypeM22xlarge  = "m2.2xlarge"
+	InstanceTypeM24xlarge  = "m2.4xlarge"
+	InstanceTypeCr18xlarge = "cr1.8xlarge"
+	InstanceTypeI2Xlarge   = "i2.xlarge"
+	InstanceTypeI22xlarge  = "i2.2xlarge"
+	InstanceTypeI24xlarge  = "i2.4xlarge"
+	InstanceTypeI28xlarge  = "i2.8xlarge"
+	InstanceTypeHi14xlarge = "hi1.4xlarge"
+	InstanceTypeHs18xlarge = "hs1.8xlarge"
+	InstanceTypeC1Medium   = "c1.medium"
+	InstanceTypeC1Xlarge   = "c1.xlarge"
+	InstanceTypeC3Large    = "c3.large"
+	InstanceTypeC3Xlarge   = "c3.xlarge"
+	InstanceTypeC32xlarge  = "c3.2xlarge"
+	InstanceTypeC34xlarge  = "c3.4xlarge"
+	InstanceTypeC38xlarge  = "c3.8xlarge"
+	InstanceTypeC4Large    = "c4.large"
+	InstanceTypeC4Xlarge   = "c4.xlarge"
+	InstanceTypeC42xlarge  = "c4.2xlarge"
+	InstanceTypeC44xlarge  = "c4.4xlarge"
+	InstanceTypeC48xlarge  = "c4.8xlarge"
+	InstanceTypeCc14xlarge = "cc1.4xlarge"
+	InstanceTypeCc28xlarge = "cc2.8xlarge"
+	InstanceTypeG22xlarge  = "g2.2xlarge"
+	InstanceTypeCg14xlarge = "cg1.4xlarge"
+	InstanceTypeR3Large    = "r3.large"
+	InstanceTypeR3Xlarge   = "r3.xlarge"
+	InstanceTypeR32xlarge  = "r3.2xlarge"
+	InstanceTypeR34xlarge  = "r3.4xlarge"
+	InstanceTypeR38xlarge  = "r3.8xlarge"
+)
+
+// InstanceTypeValues returns the valid values of InstanceType.
+func InstanceTypeValues() []string {
+	return []string{
+		InstanceTypeT1Micro,
+		InstanceTypeM1Small,
+		InstanceTypeM1Medium,
+		InstanceTypeM1Large,
+		InstanceTypeM1Xlarge,
+		InstanceTypeM3Medium,
+		InstanceTypeM3Large,
+		InstanceTypeM3Xlarge,
+		InstanceTypeM32xlarge,
+		InstanceTypeT2Micro,
+		InstanceTypeT2Small,
+		InstanceTypeT2Medium,
+		InstanceTypeM2Xlarge,
+		InstanceTypeM22xlarge,
+		InstanceTypeM24xlarge,
+		InstanceTypeCr18xlarge,
+		InstanceTypeI2Xlarge,
+		InstanceTypeI22xlarge,
+		InstanceTypeI24xlarge,
+		InstanceTypeI28xlarge,
+		InstanceTypeHi14xlarge,
+		InstanceTypeHs18xlarge,
+		InstanceTypeC1Medium,
+		InstanceTypeC1Xlarge,
+		InstanceTypeC3Large,
+		InstanceTypeC3Xlarge,
+		InstanceTypeC32xlarge,
+		InstanceTypeC34xlarge,
+		InstanceTypeC38xlarge,
+		InstanceTypeC4Large,
+		InstanceTypeC4Xlarge,
+		InstanceTypeC42xlarge,
+		InstanceTypeC44xlarge,
+		InstanceTypeC48xlarge,
+		InstanceTypeCc14xlarge,
+		InstanceTypeCc28xlarge,
+		InstanceTypeG22xlarge,
+		InstanceTypeCg14xlarge,
+		InstanceTypeR3Large,
+		InstanceTypeR3Xlarge,
+		InstanceTypeR32xlarge,
+		InstanceTypeR34xlarge,
+		InstanceTypeR38xlarge,
+	}
+}
+
+// Enum values for ListingState.
+const (
+	ListingStateAvailable = "available"
+	ListingStateSold      = "sold"
+	ListingStateCancelled = "cancelled"
+	ListingStatePending   = "pending"
+)
+
+// ListingStateValues returns the valid values of ListingState.
+func ListingStateValues() []string {
+	return []string{
+		ListingStateAvailable,
+		ListingStateSold,
+		ListingStateCancelled,
+		ListingStatePending,
+	}
+}
+
+// Enum values for ListingStatus.
+const (
+	ListingStatusActive    = "active"
+	ListingStatusPending   = "pending"
+	ListingStatusCancelled = "cancelled"
+	ListingStatusClosed    = "closed"
+)
+
+// ListingStatusValues returns the valid values of ListingStatus.
+func ListingStatusValues() []string {
+	return []string{
+		ListingStatusActive,
+		ListingStatusPending,
+		ListingStatusCancelled,
+		ListingStatusClosed,
+	}
+}
+
+// Enum values for MonitoringState.
+const (
+	MonitoringStateDisabled = "disabled"
+	MonitoringStateEnabled  = "enabled"
+	MonitoringStatePending  = "pending"
+)
+
+// MonitoringStateValues returns the valid values of MonitoringState.
+func MonitoringStateValues() []string {
+	return []string{
+		MonitoringStateDisabled,
+		MonitoringStateEnabled,
+		MonitoringStatePending,
+	}
+}
+
+// Enum values for NetworkInterfaceAttribute.
+const (
+	NetworkInterfaceAttributeDescription     = "description"
+	NetworkInterfaceAttributeGroupSet        = "groupSet"
+	NetworkInterfaceAttributeSourceDestCheck = "sourceDestCheck"
+	NetworkInterfaceAttributeAttachment      = "attachment"
+)
+
+// NetworkInterfaceAttributeValues returns the valid values of NetworkInterfaceAttribute.
+func NetworkInterfaceAttributeValues() []string {
+	return []string{
+		NetworkInterfaceAttributeDescription,
+		NetworkInterfaceAttributeGroupSet,
+		NetworkInterfaceAttributeSourceDestCheck,
+		NetworkInterfaceAttributeAttachment,
+	}
+}
+
+// Enum values for NetworkInterfaceStatus.
+const (
+	NetworkInterfaceStatusAvailable = "available"
+	NetworkInterfaceStatusAttaching = "attaching"
+	NetworkInterfaceStatusInUse     = "in-use"
+	NetworkInterfaceStatusDetaching = "detaching"
+)
+
+// NetworkInterfaceStatusValues returns the valid values of NetworkInterfaceStatus.
+func NetworkInterfaceStatusValues() []string {
+	return []string{
+		NetworkInterfaceStatusAvailable,
+		NetworkInterfaceStatusAttaching,
+		NetworkInterfaceStatusInUse,
+		NetworkInterfaceStatusDetaching,
+	}
+}
+
+// Enum values for OfferingTypeValues.
+const (
+	OfferingTypeValuesHeavyUtilization  = "Heavy Utilization"
+	OfferingTypeValuesMediumUtilization = "Medium Utilization"
+	OfferingTypeValuesLightUtilization  = "Light Utilization"
+	OfferingTypeValuesNoUpfront         = "No Upfront"
+	OfferingTypeValuesPartialUpfront    = "Partial Upfront"
+	OfferingTypeValuesAllUpfront        = "All Upfront"
+)
+
+// OfferingTypeValuesValues returns the valid values of OfferingTypeValues.
+func OfferingTypeValuesValues() []string {
+	return []string{
+		OfferingTypeValuesHeavyUtilization,
+		OfferingTypeValuesMediumUtilization,
+		OfferingTypeValuesLightUtilization,
+		OfferingTypeValuesNoUpfront,
+		OfferingTypeValuesPartialUpfront,
+		OfferingTypeValuesAllUpfront,
+	}
+}
+
+// Enum values for PermissionGroup.
+const (
+	PermissionGroupAll = "all"
+)
+
+// PermissionGroupValues returns the valid values of PermissionGroup.
+func PermissionGroupValues() []string {
+	return []string{
+		PermissionGroupAll,
+	}
+}
+
+// Enum values for PlacementGroupState.
+const (
+	PlacementGroupStatePending   = "pending"
+	PlacementGroupStateAvailable = "available"
+	PlacementGroupStateDeleting  = "deleting"
+	PlacementGroupStateDeleted   = "deleted"
+)
+
+// PlacementGroupStateValues returns the valid values of PlacementGroupState.
+func PlacementGroupStateValues() []string {
+	return []string{
+		PlacementGroupStatePending,
+		PlacementGroupStateAvailable,
+		PlacementGroupStateDeleting,
+		PlacementGroupStateDeleted,
+	}
+}
+
+// Enum values for PlacementStrategy.
+const (
+	PlacementStrategyCluster = "cluster"
+)
+
+// PlacementStrategyValues returns the valid values of PlacementStrategy.
+func PlacementStrategyValues() []string {
+	return []string{
+		PlacementStrategyCluster,
+	}
+}
+
+// Enum values for PlatformValues.
+const (
+	PlatformValuesWindows = "Windows"
+)
+
+// PlatformValuesValues returns the valid values of PlatformValues.
+func PlatformValuesValues() []string {
+	return []string{
+		PlatformValuesWindows,
+	}
+}
+
+// Enum values for ProductCodeValues.
+const (
+	ProductCodeValuesDevpay      = "devpay"
+	ProductCodeValuesMarketplace = "marketplace"
+)
+
+// ProductCodeValuesValues returns the valid values of ProductCodeValues.
+func ProductCodeValuesValues() []string {
+	return []string{
+		ProductCodeValuesDevpay,
+		ProductCodeValuesMarketplace,
+	}
+}
+
+// Enum values for RIProductDescription.
+const (
+	RIProductDescriptionLinuxUnix          = "Linux/UNIX"
+	RIProductDescriptionLinuxUnixAmazonVpc = "Linux/UNIX (Amazon VPC)"
+	RIProductDescriptionWindows            = "Windows"
+	RIProductDescriptionWindowsAmazonVpc   = "Windows (Amazon VPC)"
+)
+
+// RIProductDescriptionValues returns the valid values of RIProductDescription.
+func RIProductDescriptionValues() []string {
+	return []string{
+		RIProductDescriptionLinuxUnix,
+		RIProductDescriptionLinuxUnixAmazonVpc,
+		RIProductDescriptionWindows,
+		RIProductDescriptionWindowsAmazonVpc,
+	}
+}
+
+// Enum values for RecurringChargeFrequency.
+const (
+	RecurringChargeFrequencyHourly = "Hourly"
+)
+
+// RecurringChargeFrequencyValues returns the valid values of RecurringChargeFrequency.
+func RecurringChargeFrequencyValues() []string {
+	return []string{
+		RecurringChargeFrequencyHourly,
+	}
+}
+
+// Enum values for ReportInstanceReasonCodes.
+const (
+	ReportInstanceReasonCodesInstanceStuckInState     = "instance-stuck-in-state"
+	ReportInstanceReasonCodesUnresponsive             = "unresponsive"
+	ReportInstanceReasonCodesNotAcceptingCredentials  = "not-accepting-credentials"
+	ReportInstanceReasonCodesPasswordNotAvailable     = "password-not-available"
+	ReportInstanceReasonCodesPerformanceNetwork       = "performance-network"
+	ReportInstanceReasonCodesPerformanceInstanceStore = "performance-instance-store"
+	ReportInstanceReasonCodesPerformanceEbsVolume     = "performance-ebs-volume"
+	ReportInstanceReasonCodesPerformanceOther         = "performance-other"
+	ReportInstanceReasonCodesOther                    = "other"
+)
+
+// ReportInstanceReasonCodesValues returns the valid values of ReportInstanceReasonCodes.
+func ReportInstanceReasonCodesValues() []string {
+	return []string{
+		ReportInstanceReasonCodesInstanceStuckInState,
+		ReportInstanceReasonCodesUnresponsive,
+		ReportInstanceReasonCodesNotAcceptingCredentials,
+		ReportInstanceReasonCodesPasswordNotAvailable,
+		ReportInstanceReasonCodesPerformanceNetwork,
+		ReportInstanceReasonCodesPerformanceInstanceStore,
+		ReportInstanceReasonCodesPerformanceEbsVolume,
+		ReportInstanceReasonCodesPerformanceOther,
+		ReportInstanceReasonCodesOther,
+	}
+}
+
+// Enum values for ReportStatusType.
+const (
+	ReportStatusTypeOk       = "ok"
+	ReportStatusTypeImpaired = "impaired"
+)
+
+// ReportStatusTypeValues returns the valid values of ReportStatusType.
+func ReportStatusTypeValues() []string {
+	return []string{
+		ReportStatusTypeOk,
+		ReportStatusTypeImpaired,
+	}
+}
+
+// Enum values for ReservedInstanceState.
+const (
+	ReservedInstanceStatePaymentPending = "payment-pending"
+	ReservedInstanceStateActive         = "active"
+	ReservedInstanceStatePaymentFailed  = "payment-failed"
+	ReservedInstanceStateRetired        = "retired"
+)
+
+// ReservedInstanceStateValues returns the valid values of ReservedInstanceState.
+func ReservedInstanceStateValues() []string {
+	return []string{
+		ReservedInstanceStatePaymentPending,
+		ReservedInstanceStateActive,
+		ReservedInstanceStatePaymentFailed,
+		ReservedInstanceStateRetired,
+	}
+}
+
+// Enum values for ResetImageAttributeName.
+const (
+	ResetImageAttributeNameLaunchPermission = "launchPermission"
+)
+
+// ResetImageAttributeNameValues returns the valid values of ResetImageAttributeName.
+func ResetImageAttributeNameValues() []string {
+	return []string{
+		ResetImageAttributeNameLaunchPermission,
+	}
+}
+
+// Enum values for ResourceType.
+const (
+	ResourceTypeCustomerGateway      = "customer-gateway"
+	ResourceTypeDHCPOptions          = "dhcp-options"
+	ResourceTypeImage                = "image"
+	ResourceTypeInstance             = "instance"
+	ResourceTypeInternetGateway      = "internet-gateway"
+	ResourceTypeNetworkACL           = "network-acl"
+	ResourceTypeNetworkInterface     = "network-interface"
+	ResourceTypeReservedInstances    = "reserved-instances"
+	ResourceTypeRouteTable           = "route-table"
+	ResourceTypeSnapshot             = "snapshot"
+	ResourceTypeSpotInstancesRequest = "spot-instances-request"
+	ResourceTypeSubnet               = "subnet"
+	ResourceTypeSecurityGroup        = "security-group"
+	ResourceTypeVolume               = "volume"
+	ResourceTypeVPC                  = "vpc"
+	ResourceTypeVPNConnection        = "vpn-connection"
+	ResourceTypeVPNGateway           = "vpn-gateway"
+)
+
+// ResourceTypeValues returns the valid values of ResourceType.
+func ResourceTypeValues() []string {
+	return []string{
+		ResourceTypeCustomerGateway,
+		ResourceTypeDHCPOptions,
+		ResourceTypeImage,
+		ResourceTypeInstance,
+		ResourceTypeInternetGateway,
+		ResourceTypeNetworkACL,
+		ResourceTypeNetworkInterface,
+		ResourceTypeReservedInstances,
+		ResourceTypeRouteTable,
+		ResourceTypeSnapshot,
+		ResourceTypeSpotInstancesRequest,
+		ResourceTypeSubnet,
+		ResourceTypeSecurityGroup,
+		ResourceTypeVolume,
+		ResourceTypeVPC,
+		ResourceTypeVPNConnection,
+		ResourceTypeVPNGateway,
+	}
+}
+
+// Enum values for RouteOrigin.
+const (
+	RouteOriginCreateRouteTable          = "CreateRouteTable"
+	RouteOriginCreateRoute               = "CreateRoute"
+	RouteOriginEnableVGWRoutePropagation = "EnableVgwRoutePropagation"
+)
+
+// RouteOriginValues returns the valid values of RouteOrigin.
+func RouteOriginValues() []string {
+	return []string{
+		RouteOriginCreateRouteTable,
+		RouteOriginCreateRoute,
+		RouteOriginEnableVGWRoutePropagation,
+	}
+}
+
+// Enum values for RouteState.
+const (
+	RouteStateActive    = "active"
+	RouteStateBlackhole = "blackhole"
+)
+
+// RouteStateValues returns the valid values of RouteState.
+func RouteStateValues() []string {
+	return []string{
+		RouteStateActive,
+		RouteStateBlackhole,
+	}
+}
+
+// Enum values for RuleAction.
+const (
+	RuleActionAllow = "allow"
+	RuleActionDeny  = "deny"
+)
+
+// RuleActionValues returns the valid values of RuleAction.
+func RuleActionValues() []string {
+	return []string{
+		RuleActionAllow,
+		RuleActionDeny,
+	}
+}
+
+// Enum values for ShutdownBehavior.
+const (
+	ShutdownBehaviorStop      = "stop"
+	ShutdownBehaviorTerminate = "terminate"
+)
+
+// ShutdownBehaviorValues returns the valid values of ShutdownBehavior.
+func ShutdownBehaviorValues() []string {
+	return []string{
+		ShutdownBehaviorStop,
+		ShutdownBehaviorTerminate,
+	}
+}
+
+// Enum values for SnapshotAttributeName.
+const (
+	SnapshotAttributeNameProductCodes           = "productCodes"
+	SnapshotAttributeNameCreateVolumePermission = "createVolumePermission"
+)
+
+// SnapshotAttributeNameValues returns the valid values of SnapshotAttributeName.
+func SnapshotAttributeNameValues() []string {
+	return []string{
+		SnapshotAttributeNameProductCodes,
+		SnapshotAttributeNameCreateVolumePermission,
+	}
+}
+
+// Enum values for SnapshotState.
+const (
+	SnapshotStatePending   = "pending"
+	SnapshotStateCompleted = "completed"
+	SnapshotStateError     = "error"
+)
+
+// SnapshotStateValues returns the valid values of SnapshotState.
+func SnapshotStateValues() []string {
+	return []string{
+		SnapshotStatePending,
+		SnapshotStateCompleted,
+		SnapshotStateError,
+	}
+}
+
+// Enum values for SpotInstanceState.
+const (
+	SpotInstanceStateOpen      = "open"
+	SpotInstanceStateActive    = "active"
+	SpotInstanceStateClosed    = "closed"
+	SpotInstanceStateCancelled = "cancelled"
+	SpotInstanceStateFailed    = "failed"
+)
+
+// SpotInstanceStateValues returns the valid values of SpotInstanceState.
+func SpotInstanceStateValues() []string {
+	return []string{
+		SpotInstanceStateOpen,
+		SpotInstanceStateActive,
+		SpotInstanceStateClosed,
+		SpotInstanceStateCancelled,
+		SpotInstanceStateFailed,
+	}
+}
+
+// Enum values for SpotInstanceType.
+const (
+	SpotInstanceTypeOneTime    = "one-time"
+	SpotInstanceTypePersistent = "persistent"
+)
+
+// SpotInstanceTypeValues returns the valid values of SpotInstanceType.
+func SpotInstanceTypeValues() []string {
+	return []string{
+		SpotInstanceTypeOneTime,
+		SpotInstanceTypePersistent,
+	}
+}
+
+// Enum values for StatusName.
+const (
+	StatusNameReachability = "reachability"
+)
+
+// StatusNameValues returns the valid values of StatusName.
+func StatusNameValues() []string {
+	return []string{
+		StatusNameReachability,
+	}
+}
+
+// Enum values for StatusType.
+const (
+	StatusTypePassed           = "passed"
+	StatusTypeFailed           = "failed"
+	StatusTypeInsufficientData = "insufficient-data"
+)
+
+// StatusTypeValues returns the valid values of StatusType.
+func StatusTypeValues() []string {
+	return []string{
+		StatusTypePassed,
+		StatusTypeFailed,
+		StatusTypeInsufficientData,
+	}
+}
+
+// Enum values for SubnetState.
+const (
+	SubnetStatePending   = "pending"
+	SubnetStateAvailable = "available"
+)
+
+// SubnetStateValues returns the valid values of SubnetState.
+func SubnetStateValues() []string {
+	return []string{
+		SubnetStatePending,
+		SubnetStateAvailable,
+	}
+}
+
+// Enum values for SummaryStatus.
+const (
+	SummaryStatusOk               = "ok"
+	SummaryStatusImpaired         = "impaired"
+	SummaryStatusInsufficientData = "insufficient-data"
+	SummaryStatusNotApplicable    = "not-applicable"
+)
+
+// SummaryStatusValues returns the valid values of SummaryStatus.
+func SummaryStatusValues() []string {
+	return []string{
+		SummaryStatusOk,
+		SummaryStatusImpaired,
+		SummaryStatusInsufficientData,
+		SummaryStatusNotApplicable,
+	}
+}
+
+// Enum values for TelemetryStatus.
+const (
+	TelemetryStatusUP   = "UP"
+	TelemetryStatusDown = "DOWN"
+)
+
+// TelemetryStatusValues returns the valid values of TelemetryStatus.
+func TelemetryStatusValues() []string {
+	return []string{
+		TelemetryStatusUP,
+		TelemetryStatusDown,
+	}
+}
+
+// Enum values for Tenancy.
+const (
+	TenancyDefault   = "default"
+	TenancyDedicated = "dedicated"
+)
+
+// TenancyValues returns the valid values of Tenancy.
+func TenancyValues() []string {
+	return []string{
+		TenancyDefault,
+		TenancyDedicated,
+	}
+}
+
+// Enum values for VirtualizationType.
+const (
+	VirtualizationTypeHvm         = "hvm"
+	VirtualizationTypeParavirtual = "paravirtual"
+)
+
+// VirtualizationTypeValues returns the valid values of VirtualizationType.
+func VirtualizationTypeValues() []string {
+	return []string{
+		VirtualizationTypeHvm,
+		VirtualizationTypeParavirtual,
+	}
+}
+
+// Enum values for VolumeAttachmentState.
+const (
+	VolumeAttachmentStateAttaching = "attaching"
+	VolumeAttachmentStateAttached  = "attached"
+	VolumeAttachmentStateDetaching = "detaching"
+	VolumeAttachmentStateDetached  = "detached"
+)
+
+// VolumeAttachmentStateValues returns the valid values of VolumeAttachmentState.
+func VolumeAttachmentStateValues() []string {
+	return []string{
+		VolumeAttachmentStateAttaching,
+		VolumeAttachmentStateAttached,
+		VolumeAttachmentStateDetaching,
+		VolumeAttachmentStateDetached,
+	}
+}
+
+// Enum values for VolumeAttributeName.
+const (
+	VolumeAttributeNameAutoEnableIO = "autoEnableIO"
+	VolumeAttributeNameProductCodes = "productCodes"
+)
+
+// VolumeAttributeNameValues returns the valid values of VolumeAttributeName.
+func VolumeAttributeNameValues() []string {
+	return []string{
+		VolumeAttributeNameAutoEnableIO,
+		VolumeAttributeNameProductCodes,
+	}
+}
+
+// Enum values for VolumeState.
+const (
+	VolumeStateCreating  = "creating"
+	VolumeStateAvailable = "available"
+	VolumeStateInUse     = "in-use"
+	VolumeStateDeleting  = "deleting"
+	VolumeStateDeleted   = "deleted"
+	VolumeStateError     = "error"
+)
+
+// VolumeStateValues returns the valid values of VolumeState.
+func VolumeStateValues() []string {
+	return []string{
+		VolumeStateCreating,
+		VolumeStateAvailable,
+		VolumeStateInUse,
+		VolumeStateDeleting,
+		VolumeStateDeleted,
+		VolumeStateError,
+	}
+}
+
+// Enum values for VolumeStatusInfoStatus.
+const (
+	VolumeStatusInfoStatusOk               = "ok"
+	VolumeStatusInfoStatusImpaired         = "impaired"
+	VolumeStatusInfoStatusInsufficientData = "insufficient-data"
+)
+
+// VolumeStatusInfoStatusValues returns the valid values of VolumeStatusInfoStatus.
+func VolumeStatusInfoStatusValues() []string {
+	return []string{
+		VolumeStatusInfoStatusOk,
+		VolumeStatusInfoStatusImpaired,
+		VolumeStatusInfoStatusInsufficientData,
+	}
+}
+
+// Enum values for VolumeStatusName.
+const (
+	VolumeStatusNameIoEnabled     = "io-enabled"
+	VolumeStatusNameIoPerformance = "io-performance"
+)
+
+// VolumeStatusNameValues returns the valid values of VolumeStatusName.
+func VolumeStatusNameValues() []string {
+	return []string{
+		VolumeStatusNameIoEnabled,
+		VolumeStatusNameIoPerformance,
+	}
+}
+
+// Enum values for VolumeType.
+const (
+	VolumeTypeStandard = "standard"
+	VolumeTypeIo1      = "io1"
+	VolumeTypeGp2      = "gp2"
+)
+
+// VolumeTypeValues returns the valid values of VolumeType.
+func VolumeTypeValues() []string {
+	return []string{
+		VolumeTypeStandard,
+		VolumeTypeIo1,
+		VolumeTypeGp2,
+	}
+}
+
+// Enum values for VpcAttributeName.
+const (
+	VPCAttributeNameEnableDNSSupport   = "enableDnsSupport"
+	VPCAttributeNameEnableDNSHostnames = "enableDnsHostnames"
+)
+
+// VpcAttributeNameValues returns the valid values of VpcAttributeName.
+func VpcAttributeNameValues() []string {
+	return []string{
+		VPCAttributeNameEnableDNSSupport,
+		VPCAttributeNameEnableDNSHostnames,
+	}
+}
+
+// Enum values for VpcState.
+const (
+	VPCStatePending   = "pending"
+	VPCStateAvailable = "available"
+)
+
+// VpcStateValues returns the valid values of VpcState.
+func VpcStateValues() []string {
+	return []string{
+		VPCStatePending,
+		VPCStateAvailable,
+	}
+}
+
+// Enum values for VpnState.
+const (
+	VPNStatePending   = "pending"
+	VPNStateAvailable = "available"
+	VpnStateDeleting  = "deleting"
+	VPNStateDeleted   = "deleted"
+)
+
+// VpnStateValues returns the valid values of VpnState.
+func VpnStateValues() []string {
+	return []string{
+		VPNStatePending,
+		VPNStateAvailable,
+		VpnStateDeleting,
+		VPNStateDeleted,
+	}
+}
+
+// Enum values for VpnStaticRouteSource.
+const (
+	VPNStaticRouteSourceStatic = "Static"
+)
+
+// VpnStaticRouteSourceValues returns the valid values of VpnStaticRouteSource.
+func VpnStaticRouteSourceValues() []string {
+	return []string{
+		VPNStaticRouteSourceStatic,
+	}
 }
\ No newline at end of file
@@ -0,0 +1,831 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package ec2
+
+import (
+	"time"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+)
+
+// BundleTaskCompleteWaiter returns a waiter which polls DescribeBundleTasks until the
+// BundleTaskComplete condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) BundleTaskCompleteWaiter(input *DescribeBundleTasksInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "BundleTaskComplete",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "BundleTasks[].State",
+				Expected: "complete",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "BundleTasks[].State",
+				Expected: "failed",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeBundleTasksRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilBundleTaskComplete polls DescribeBundleTasks until the BundleTaskComplete
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilBundleTaskComplete(input *DescribeBundleTasksInput) error {
+	return c.BundleTaskCompleteWaiter(input).Wait()
+}
+
+// ConversionTaskCancelledWaiter returns a waiter which polls DescribeConversionTasks until the
+// ConversionTaskCancelled condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) ConversionTaskCancelledWaiter(input *DescribeConversionTasksInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "ConversionTaskCancelled",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "ConversionTasks[].State",
+				Expected: "cancelled",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeConversionTasksRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilConversionTaskCancelled polls DescribeConversionTasks until the ConversionTaskCancelled
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilConversionTaskCancelled(input *DescribeConversionTasksInput) error {
+	return c.ConversionTaskCancelledWaiter(input).Wait()
+}
+
+// ConversionTaskCompletedWaiter returns a waiter which polls DescribeConversionTasks until the
+// ConversionTaskCompleted condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) ConversionTaskCompletedWaiter(input *DescribeConversionTasksInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "ConversionTaskCompleted",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "ConversionTasks[].State",
+				Expected: "completed",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "ConversionTasks[].State",
+				Expected: "cancelled",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "ConversionTasks[].State",
+				Expected: "cancelling",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeConversionTasksRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilConversionTaskCompleted polls DescribeConversionTasks until the ConversionTaskCompleted
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilConversionTaskCompleted(input *DescribeConversionTasksInput) error {
+	return c.ConversionTaskCompletedWaiter(input).Wait()
+}
+
+// ConversionTaskDeletedWaiter returns a waiter which polls DescribeConversionTasks until the
+// ConversionTaskDeleted condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) ConversionTaskDeletedWaiter(input *DescribeConversionTasksInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "ConversionTaskDeleted",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "ConversionTasks[].State",
+				Expected: "deleted",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeConversionTasksRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilConversionTaskDeleted polls DescribeConversionTasks until the ConversionTaskDeleted
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilConversionTaskDeleted(input *DescribeConversionTasksInput) error {
+	return c.ConversionTaskDeletedWaiter(input).Wait()
+}
+
+// CustomerGatewayAvailableWaiter returns a waiter which polls DescribeCustomerGateways until the
+// CustomerGatewayAvailable condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) CustomerGatewayAvailableWaiter(input *DescribeCustomerGatewaysInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "CustomerGatewayAvailable",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "CustomerGateways[].State",
+				Expected: "available",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "CustomerGateways[].State",
+				Expected: "deleted",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "CustomerGateways[].State",
+				Expected: "deleting",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeCustomerGatewaysRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilCustomerGatewayAvailable polls DescribeCustomerGateways until the CustomerGatewayAvailable
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilCustomerGatewayAvailable(input *DescribeCustomerGatewaysInput) error {
+	return c.CustomerGatewayAvailableWaiter(input).Wait()
+}
+
+// ExportTaskCancelledWaiter returns a waiter which polls DescribeExportTasks until the
+// ExportTaskCancelled condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) ExportTaskCancelledWaiter(input *DescribeExportTasksInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "ExportTaskCancelled",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "ExportTasks[].State",
+				Expected: "cancelled",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeExportTasksRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilExportTaskCancelled polls DescribeExportTasks until the ExportTaskCancelled
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilExportTaskCancelled(input *DescribeExportTasksInput) error {
+	return c.ExportTaskCancelledWaiter(input).Wait()
+}
+
+// ExportTaskCompletedWaiter returns a waiter which polls DescribeExportTasks until the
+// ExportTaskCompleted condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) ExportTaskCompletedWaiter(input *DescribeExportTasksInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "ExportTaskCompleted",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "ExportTasks[].State",
+				Expected: "completed",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeExportTasksRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilExportTaskCompleted polls DescribeExportTasks until the ExportTaskCompleted
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilExportTaskCompleted(input *DescribeExportTasksInput) error {
+	return c.ExportTaskCompletedWaiter(input).Wait()
+}
+
+// ImageAvailableWaiter returns a waiter which polls DescribeImages until the
+// ImageAvailable condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) ImageAvailableWaiter(input *DescribeImagesInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "ImageAvailable",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "Images[].State",
+				Expected: "available",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "Images[].State",
+				Expected: "failed",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeImagesRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilImageAvailable polls DescribeImages until the ImageAvailable
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilImageAvailable(input *DescribeImagesInput) error {
+	return c.ImageAvailableWaiter(input).Wait()
+}
+
+// InstanceRunningWaiter returns a waiter which polls DescribeInstances until the
+// InstanceRunning condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) InstanceRunningWaiter(input *DescribeInstancesInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "InstanceRunning",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "Reservations[].Instances[].State.Name",
+				Expected: "running",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "Reservations[].Instances[].State.Name",
+				Expected: "shutting-down",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "Reservations[].Instances[].State.Name",
+				Expected: "terminated",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "Reservations[].Instances[].State.Name",
+				Expected: "stopping",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeInstancesRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilInstanceRunning polls DescribeInstances until the InstanceRunning
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilInstanceRunning(input *DescribeInstancesInput) error {
+	return c.InstanceRunningWaiter(input).Wait()
+}
+
+// InstanceStatusOkWaiter returns a waiter which polls DescribeInstanceStatus until the
+// InstanceStatusOk condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) InstanceStatusOkWaiter(input *DescribeInstanceStatusInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "InstanceStatusOk",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "InstanceStatuses[].InstanceStatus.Status",
+				Expected: "ok",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeInstanceStatusRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilInstanceStatusOk polls DescribeInstanceStatus until the InstanceStatusOk
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilInstanceStatusOk(input *DescribeInstanceStatusInput) error {
+	return c.InstanceStatusOkWaiter(input).Wait()
+}
+
+// InstanceStoppedWaiter returns a waiter which polls DescribeInstances until the
+// InstanceStopped condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) InstanceStoppedWaiter(input *DescribeInstancesInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "InstanceStopped",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "Reservations[].Instances[].State.Name",
+				Expected: "stopped",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "Reservations[].Instances[].State.Name",
+				Expected: "pending",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "Reservations[].Instances[].State.Name",
+				Expected: "terminated",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeInstancesRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilInstanceStopped polls DescribeInstances until the InstanceStopped
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilInstanceStopped(input *DescribeInstancesInput) error {
+	return c.InstanceStoppedWaiter(input).Wait()
+}
+
+// InstanceTerminatedWaiter returns a waiter which polls DescribeInstances until the
+// InstanceTerminated condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) InstanceTerminatedWaiter(input *DescribeInstancesInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "InstanceTerminated",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "Reservations[].Instances[].State.Name",
+				Expected: "terminated",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "Reservations[].Instances[].State.Name",
+				Expected: "pending",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "Reservations[].Instances[].State.Name",
+				Expected: "stopping",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeInstancesRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilInstanceTerminated polls DescribeInstances until the InstanceTerminated
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilInstanceTerminated(input *DescribeInstancesInput) error {
+	return c.InstanceTerminatedWaiter(input).Wait()
+}
+
+// PasswordDataAvailableWaiter returns a waiter which polls GetPasswordData until the
+// PasswordDataAvailable condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) PasswordDataAvailableWaiter(input *GetPasswordDataInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "PasswordDataAvailable",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "path",
+				Argument: "length(PasswordData) > `0`",
+				Expected: true,
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.GetPasswordDataRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilPasswordDataAvailable polls GetPasswordData until the PasswordDataAvailable
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilPasswordDataAvailable(input *GetPasswordDataInput) error {
+	return c.PasswordDataAvailableWaiter(input).Wait()
+}
+
+// SnapshotCompletedWaiter returns a waiter which polls DescribeSnapshots until the
+// SnapshotCompleted condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) SnapshotCompletedWaiter(input *DescribeSnapshotsInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "SnapshotCompleted",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "Snapshots[].State",
+				Expected: "completed",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeSnapshotsRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilSnapshotCompleted polls DescribeSnapshots until the SnapshotCompleted
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilSnapshotCompleted(input *DescribeSnapshotsInput) error {
+	return c.SnapshotCompletedWaiter(input).Wait()
+}
+
+// SpotInstanceRequestFulfilledWaiter returns a waiter which polls DescribeSpotInstanceRequests until the
+// SpotInstanceRequestFulfilled condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) SpotInstanceRequestFulfilledWaiter(input *DescribeSpotInstanceRequestsInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "SpotInstanceRequestFulfilled",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "SpotInstanceRequests[].Status.Code",
+				Expected: "fulfilled",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "SpotInstanceRequests[].Status.Code",
+				Expected: "schedule-expired",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "SpotInstanceRequests[].Status.Code",
+				Expected: "canceled-before-fulfillment",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "SpotInstanceRequests[].Status.Code",
+				Expected: "bad-parameters",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "SpotInstanceRequests[].Status.Code",
+				Expected: "system-error",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeSpotInstanceRequestsRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilSpotInstanceRequestFulfilled polls DescribeSpotInstanceRequests until the SpotInstanceRequestFulfilled
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilSpotInstanceRequestFulfilled(input *DescribeSpotInstanceRequestsInput) error {
+	return c.SpotInstanceRequestFulfilledWaiter(input).Wait()
+}
+
+// SubnetAvailableWaiter returns a waiter which polls DescribeSubnets until the
+// SubnetAvailable condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) SubnetAvailableWaiter(input *DescribeSubnetsInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "SubnetAvailable",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "Subnets[].State",
+				Expected: "available",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeSubnetsRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilSubnetAvailable polls DescribeSubnets until the SubnetAvailable
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilSubnetAvailable(input *DescribeSubnetsInput) error {
+	return c.SubnetAvailableWaiter(input).Wait()
+}
+
+// SystemStatusOkWaiter returns a waiter which polls DescribeInstanceStatus until the
+// SystemStatusOk condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) SystemStatusOkWaiter(input *DescribeInstanceStatusInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "SystemStatusOk",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "InstanceStatuses[].SystemStatus.Status",
+				Expected: "ok",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeInstanceStatusRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilSystemStatusOk polls DescribeInstanceStatus until the SystemStatusOk
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilSystemStatusOk(input *DescribeInstanceStatusInput) error {
+	return c.SystemStatusOkWaiter(input).Wait()
+}
+
+// VolumeAvailableWaiter returns a waiter which polls DescribeVolumes until the
+// VolumeAvailable condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) VolumeAvailableWaiter(input *DescribeVolumesInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "VolumeAvailable",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "Volumes[].State",
+				Expected: "available",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "Volumes[].State",
+				Expected: "deleted",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeVolumesRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilVolumeAvailable polls DescribeVolumes until the VolumeAvailable
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilVolumeAvailable(input *DescribeVolumesInput) error {
+	return c.VolumeAvailableWaiter(input).Wait()
+}
+
+// VolumeDeletedWaiter returns a waiter which polls DescribeVolumes until the
+// VolumeDeleted condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) VolumeDeletedWaiter(input *DescribeVolumesInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "VolumeDeleted",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "Volumes[].State",
+				Expected: "deleted",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeVolumesRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilVolumeDeleted polls DescribeVolumes until the VolumeDeleted
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilVolumeDeleted(input *DescribeVolumesInput) error {
+	return c.VolumeDeletedWaiter(input).Wait()
+}
+
+// VolumeInUseWaiter returns a waiter which polls DescribeVolumes until the
+// VolumeInUse condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) VolumeInUseWaiter(input *DescribeVolumesInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "VolumeInUse",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "Volumes[].State",
+				Expected: "in-use",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "Volumes[].State",
+				Expected: "deleted",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeVolumesRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilVolumeInUse polls DescribeVolumes until the VolumeInUse
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilVolumeInUse(input *DescribeVolumesInput) error {
+	return c.VolumeInUseWaiter(input).Wait()
+}
+
+// VpcAvailableWaiter returns a waiter which polls DescribeVPCs until the
+// VpcAvailable condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) VpcAvailableWaiter(input *DescribeVPCsInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "VpcAvailable",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "VPCs[].State",
+				Expected: "available",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeVPCsRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilVpcAvailable polls DescribeVPCs until the VpcAvailable
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilVpcAvailable(input *DescribeVPCsInput) error {
+	return c.VpcAvailableWaiter(input).Wait()
+}
+
+// VpnConnectionAvailableWaiter returns a waiter which polls DescribeVPNConnections until the
+// VpnConnectionAvailable condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) VpnConnectionAvailableWaiter(input *DescribeVPNConnectionsInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "VpnConnectionAvailable",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "VPNConnections[].State",
+				Expected: "available",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "VPNConnections[].State",
+				Expected: "deleting",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "VPNConnections[].State",
+				Expected: "deleted",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeVPNConnectionsRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilVpnConnectionAvailable polls DescribeVPNConnections until the VpnConnectionAvailable
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilVpnConnectionAvailable(input *DescribeVPNConnectionsInput) error {
+	return c.VpnConnectionAvailableWaiter(input).Wait()
+}
+
+// VpnConnectionDeletedWaiter returns a waiter which polls DescribeVPNConnections until the
+// VpnConnectionDeleted condition is met. Its Delay and MaxAttempts may be changed before
+// calling Wait.
+func (c *EC2) VpnConnectionDeletedWaiter(input *DescribeVPNConnectionsInput) *aws.Waiter {
+	return &aws.Waiter{
+		Name:        "VpnConnectionDeleted",
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaitAcceptor{
+			aws.WaitAcceptor{
+				State:    "success",
+				Matcher:  "pathAll",
+				Argument: "VPNConnections[].State",
+				Expected: "deleted",
+			},
+			aws.WaitAcceptor{
+				State:    "failure",
+				Matcher:  "pathAny",
+				Argument: "VPNConnections[].State",
+				Expected: "pending",
+			},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeVPNConnectionsRequest(input)
+			return req
+		},
+	}
+}
+
+// WaitUntilVpnConnectionDeleted polls DescribeVPNConnections until the VpnConnectionDeleted
+// condition is met. An error is returned if the condition fails or the maximum
+// number of attempts is exceeded.
+func (c *EC2) WaitUntilVpnConnectionDeleted(input *DescribeVPNConnectionsInput) error {
+	return c.VpnConnectionDeletedWaiter(input).Wait()
+}
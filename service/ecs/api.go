@@ -4,6 +4,7 @@
 package ecs
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -45,6 +46,17 @@ func (c *ECS) CreateCluster(input *CreateClusterInput) (output *CreateClusterOut
 	return
 }
 
+// CreateClusterWithContext is the same as CreateCluster with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) CreateClusterWithContext(ctx context.Context, input *CreateClusterInput) (output *CreateClusterOutput, err error) {
+	req, out := c.CreateClusterRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateCluster *aws.Operation
 
 // CreateServiceRequest generates a request for the CreateService operation.
@@ -80,6 +92,17 @@ func (c *ECS) CreateService(input *CreateServiceInput) (output *CreateServiceOut
 	return
 }
 
+// CreateServiceWithContext is the same as CreateService with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) CreateServiceWithContext(ctx context.Context, input *CreateServiceInput) (output *CreateServiceOutput, err error) {
+	req, out := c.CreateServiceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateService *aws.Operation
 
 // DeleteClusterRequest generates a request for the DeleteCluster operation.
@@ -115,6 +138,17 @@ func (c *ECS) DeleteCluster(input *DeleteClusterInput) (output *DeleteClusterOut
 	return
 }
 
+// DeleteClusterWithContext is the same as DeleteCluster with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) DeleteClusterWithContext(ctx context.Context, input *DeleteClusterInput) (output *DeleteClusterOutput, err error) {
+	req, out := c.DeleteClusterRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteCluster *aws.Operation
 
 // DeleteServiceRequest generates a request for the DeleteService operation.
@@ -148,6 +182,17 @@ func (c *ECS) DeleteService(input *DeleteServiceInput) (output *DeleteServiceOut
 	return
 }
 
+// DeleteServiceWithContext is the same as DeleteService with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) DeleteServiceWithContext(ctx context.Context, input *DeleteServiceInput) (output *DeleteServiceOutput, err error) {
+	req, out := c.DeleteServiceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteService *aws.Operation
 
 // DeregisterContainerInstanceRequest generates a request for the DeregisterContainerInstance operation.
@@ -182,6 +227,17 @@ func (c *ECS) DeregisterContainerInstance(input *DeregisterContainerInstanceInpu
 	return
 }
 
+// DeregisterContainerInstanceWithContext is the same as DeregisterContainerInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) DeregisterContainerInstanceWithContext(ctx context.Context, input *DeregisterContainerInstanceInput) (output *DeregisterContainerInstanceOutput, err error) {
+	req, out := c.DeregisterContainerInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeregisterContainerInstance *aws.Operation
 
 // DeregisterTaskDefinitionRequest generates a request for the DeregisterTaskDefinition operation.
@@ -218,6 +274,17 @@ func (c *ECS) DeregisterTaskDefinition(input *DeregisterTaskDefinitionInput) (ou
 	return
 }
 
+// DeregisterTaskDefinitionWithContext is the same as DeregisterTaskDefinition with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) DeregisterTaskDefinitionWithContext(ctx context.Context, input *DeregisterTaskDefinitionInput) (output *DeregisterTaskDefinitionOutput, err error) {
+	req, out := c.DeregisterTaskDefinitionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeregisterTaskDefinition *aws.Operation
 
 // DescribeClustersRequest generates a request for the DescribeClusters operation.
@@ -251,6 +318,17 @@ func (c *ECS) DescribeClusters(input *DescribeClustersInput) (output *DescribeCl
 	return
 }
 
+// DescribeClustersWithContext is the same as DescribeClusters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) DescribeClustersWithContext(ctx context.Context, input *DescribeClustersInput) (output *DescribeClustersOutput, err error) {
+	req, out := c.DescribeClustersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeClusters *aws.Operation
 
 // DescribeContainerInstancesRequest generates a request for the DescribeContainerInstances operation.
@@ -285,6 +363,17 @@ func (c *ECS) DescribeContainerInstances(input *DescribeContainerInstancesInput)
 	return
 }
 
+// DescribeContainerInstancesWithContext is the same as DescribeContainerInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) DescribeContainerInstancesWithContext(ctx context.Context, input *DescribeContainerInstancesInput) (output *DescribeContainerInstancesOutput, err error) {
+	req, out := c.DescribeContainerInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeContainerInstances *aws.Operation
 
 // DescribeServicesRequest generates a request for the DescribeServices operation.
@@ -318,6 +407,17 @@ func (c *ECS) DescribeServices(input *DescribeServicesInput) (output *DescribeSe
 	return
 }
 
+// DescribeServicesWithContext is the same as DescribeServices with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) DescribeServicesWithContext(ctx context.Context, input *DescribeServicesInput) (output *DescribeServicesOutput, err error) {
+	req, out := c.DescribeServicesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeServices *aws.Operation
 
 // DescribeTaskDefinitionRequest generates a request for the DescribeTaskDefinition operation.
@@ -353,6 +453,17 @@ func (c *ECS) DescribeTaskDefinition(input *DescribeTaskDefinitionInput) (output
 	return
 }
 
+// DescribeTaskDefinitionWithContext is the same as DescribeTaskDefinition with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) DescribeTaskDefinitionWithContext(ctx context.Context, input *DescribeTaskDefinitionInput) (output *DescribeTaskDefinitionOutput, err error) {
+	req, out := c.DescribeTaskDefinitionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeTaskDefinition *aws.Operation
 
 // DescribeTasksRequest generates a request for the DescribeTasks operation.
@@ -386,6 +497,17 @@ func (c *ECS) DescribeTasks(input *DescribeTasksInput) (output *DescribeTasksOut
 	return
 }
 
+// DescribeTasksWithContext is the same as DescribeTasks with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) DescribeTasksWithContext(ctx context.Context, input *DescribeTasksInput) (output *DescribeTasksOutput, err error) {
+	req, out := c.DescribeTasksRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeTasks *aws.Operation
 
 // DiscoverPollEndpointRequest generates a request for the DiscoverPollEndpoint operation.
@@ -423,6 +545,17 @@ func (c *ECS) DiscoverPollEndpoint(input *DiscoverPollEndpointInput) (output *Di
 	return
 }
 
+// DiscoverPollEndpointWithContext is the same as DiscoverPollEndpoint with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) DiscoverPollEndpointWithContext(ctx context.Context, input *DiscoverPollEndpointInput) (output *DiscoverPollEndpointOutput, err error) {
+	req, out := c.DiscoverPollEndpointRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDiscoverPollEndpoint *aws.Operation
 
 // ListClustersRequest generates a request for the ListClusters operation.
@@ -456,6 +589,17 @@ func (c *ECS) ListClusters(input *ListClustersInput) (output *ListClustersOutput
 	return
 }
 
+// ListClustersWithContext is the same as ListClusters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) ListClustersWithContext(ctx context.Context, input *ListClustersInput) (output *ListClustersOutput, err error) {
+	req, out := c.ListClustersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListClusters *aws.Operation
 
 // ListContainerInstancesRequest generates a request for the ListContainerInstances operation.
@@ -489,6 +633,17 @@ func (c *ECS) ListContainerInstances(input *ListContainerInstancesInput) (output
 	return
 }
 
+// ListContainerInstancesWithContext is the same as ListContainerInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) ListContainerInstancesWithContext(ctx context.Context, input *ListContainerInstancesInput) (output *ListContainerInstancesOutput, err error) {
+	req, out := c.ListContainerInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListContainerInstances *aws.Operation
 
 // ListServicesRequest generates a request for the ListServices operation.
@@ -522,6 +677,17 @@ func (c *ECS) ListServices(input *ListServicesInput) (output *ListServicesOutput
 	return
 }
 
+// ListServicesWithContext is the same as ListServices with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) ListServicesWithContext(ctx context.Context, input *ListServicesInput) (output *ListServicesOutput, err error) {
+	req, out := c.ListServicesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListServices *aws.Operation
 
 // ListTaskDefinitionFamiliesRequest generates a request for the ListTaskDefinitionFamilies operation.
@@ -556,6 +722,17 @@ func (c *ECS) ListTaskDefinitionFamilies(input *ListTaskDefinitionFamiliesInput)
 	return
 }
 
+// ListTaskDefinitionFamiliesWithContext is the same as ListTaskDefinitionFamilies with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) ListTaskDefinitionFamiliesWithContext(ctx context.Context, input *ListTaskDefinitionFamiliesInput) (output *ListTaskDefinitionFamiliesOutput, err error) {
+	req, out := c.ListTaskDefinitionFamiliesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListTaskDefinitionFamilies *aws.Operation
 
 // ListTaskDefinitionsRequest generates a request for the ListTaskDefinitions operation.
@@ -590,6 +767,17 @@ func (c *ECS) ListTaskDefinitions(input *ListTaskDefinitionsInput) (output *List
 	return
 }
 
+// ListTaskDefinitionsWithContext is the same as ListTaskDefinitions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) ListTaskDefinitionsWithContext(ctx context.Context, input *ListTaskDefinitionsInput) (output *ListTaskDefinitionsOutput, err error) {
+	req, out := c.ListTaskDefinitionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListTaskDefinitions *aws.Operation
 
 // ListTasksRequest generates a request for the ListTasks operation.
@@ -625,6 +813,17 @@ func (c *ECS) ListTasks(input *ListTasksInput) (output *ListTasksOutput, err err
 	return
 }
 
+// ListTasksWithContext is the same as ListTasks with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) ListTasksWithContext(ctx context.Context, input *ListTasksInput) (output *ListTasksOutput, err error) {
+	req, out := c.ListTasksRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListTasks *aws.Operation
 
 // RegisterContainerInstanceRequest generates a request for the RegisterContainerInstance operation.
@@ -662,6 +861,17 @@ func (c *ECS) RegisterContainerInstance(input *RegisterContainerInstanceInput) (
 	return
 }
 
+// RegisterContainerInstanceWithContext is the same as RegisterContainerInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) RegisterContainerInstanceWithContext(ctx context.Context, input *RegisterContainerInstanceInput) (output *RegisterContainerInstanceOutput, err error) {
+	req, out := c.RegisterContainerInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRegisterContainerInstance *aws.Operation
 
 // RegisterTaskDefinitionRequest generates a request for the RegisterTaskDefinition operation.
@@ -699,6 +909,17 @@ func (c *ECS) RegisterTaskDefinition(input *RegisterTaskDefinitionInput) (output
 	return
 }
 
+// RegisterTaskDefinitionWithContext is the same as RegisterTaskDefinition with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) RegisterTaskDefinitionWithContext(ctx context.Context, input *RegisterTaskDefinitionInput) (output *RegisterTaskDefinitionOutput, err error) {
+	req, out := c.RegisterTaskDefinitionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRegisterTaskDefinition *aws.Operation
 
 // RunTaskRequest generates a request for the RunTask operation.
@@ -736,6 +957,17 @@ func (c *ECS) RunTask(input *RunTaskInput) (output *RunTaskOutput, err error) {
 	return
 }
 
+// RunTaskWithContext is the same as RunTask with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) RunTaskWithContext(ctx context.Context, input *RunTaskInput) (output *RunTaskOutput, err error) {
+	req, out := c.RunTaskRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRunTask *aws.Operation
 
 // StartTaskRequest generates a request for the StartTask operation.
@@ -773,6 +1005,17 @@ func (c *ECS) StartTask(input *StartTaskInput) (output *StartTaskOutput, err err
 	return
 }
 
+// StartTaskWithContext is the same as StartTask with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) StartTaskWithContext(ctx context.Context, input *StartTaskInput) (output *StartTaskOutput, err error) {
+	req, out := c.StartTaskRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opStartTask *aws.Operation
 
 // StopTaskRequest generates a request for the StopTask operation.
@@ -806,6 +1049,17 @@ func (c *ECS) StopTask(input *StopTaskInput) (output *StopTaskOutput, err error)
 	return
 }
 
+// StopTaskWithContext is the same as StopTask with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) StopTaskWithContext(ctx context.Context, input *StopTaskInput) (output *StopTaskOutput, err error) {
+	req, out := c.StopTaskRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opStopTask *aws.Operation
 
 // SubmitContainerStateChangeRequest generates a request for the SubmitContainerStateChange operation.
@@ -842,6 +1096,17 @@ func (c *ECS) SubmitContainerStateChange(input *SubmitContainerStateChangeInput)
 	return
 }
 
+// SubmitContainerStateChangeWithContext is the same as SubmitContainerStateChange with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) SubmitContainerStateChangeWithContext(ctx context.Context, input *SubmitContainerStateChangeInput) (output *SubmitContainerStateChangeOutput, err error) {
+	req, out := c.SubmitContainerStateChangeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSubmitContainerStateChange *aws.Operation
 
 // SubmitTaskStateChangeRequest generates a request for the SubmitTaskStateChange operation.
@@ -878,6 +1143,17 @@ func (c *ECS) SubmitTaskStateChange(input *SubmitTaskStateChangeInput) (output *
 	return
 }
 
+// SubmitTaskStateChangeWithContext is the same as SubmitTaskStateChange with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) SubmitTaskStateChangeWithContext(ctx context.Context, input *SubmitTaskStateChangeInput) (output *SubmitTaskStateChangeOutput, err error) {
+	req, out := c.SubmitTaskStateChangeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSubmitTaskStateChange *aws.Operation
 
 // UpdateServiceRequest generates a request for the UpdateService operation.
@@ -925,6 +1201,17 @@ func (c *ECS) UpdateService(input *UpdateServiceInput) (output *UpdateServiceOut
 	return
 }
 
+// UpdateServiceWithContext is the same as UpdateService with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ECS) UpdateServiceWithContext(ctx context.Context, input *UpdateServiceInput) (output *UpdateServiceOutput, err error) {
+	req, out := c.UpdateServiceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateService *aws.Operation
 
 // A regional grouping of one or more container instances on which you can run
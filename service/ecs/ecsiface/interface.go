@@ -1,59 +1,113 @@
 package ecsiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/ecs"
 )
 
 type ECSAPI interface {
 	CreateCluster(*ecs.CreateClusterInput) (*ecs.CreateClusterOutput, error)
 
+	CreateClusterWithContext(context.Context, *ecs.CreateClusterInput) (*ecs.CreateClusterOutput, error)
+
 	CreateService(*ecs.CreateServiceInput) (*ecs.CreateServiceOutput, error)
 
+	CreateServiceWithContext(context.Context, *ecs.CreateServiceInput) (*ecs.CreateServiceOutput, error)
+
 	DeleteCluster(*ecs.DeleteClusterInput) (*ecs.DeleteClusterOutput, error)
 
+	DeleteClusterWithContext(context.Context, *ecs.DeleteClusterInput) (*ecs.DeleteClusterOutput, error)
+
 	DeleteService(*ecs.DeleteServiceInput) (*ecs.DeleteServiceOutput, error)
 
+	DeleteServiceWithContext(context.Context, *ecs.DeleteServiceInput) (*ecs.DeleteServiceOutput, error)
+
 	DeregisterContainerInstance(*ecs.DeregisterContainerInstanceInput) (*ecs.DeregisterContainerInstanceOutput, error)
 
+	DeregisterContainerInstanceWithContext(context.Context, *ecs.DeregisterContainerInstanceInput) (*ecs.DeregisterContainerInstanceOutput, error)
+
 	DeregisterTaskDefinition(*ecs.DeregisterTaskDefinitionInput) (*ecs.DeregisterTaskDefinitionOutput, error)
 
+	DeregisterTaskDefinitionWithContext(context.Context, *ecs.DeregisterTaskDefinitionInput) (*ecs.DeregisterTaskDefinitionOutput, error)
+
 	DescribeClusters(*ecs.DescribeClustersInput) (*ecs.DescribeClustersOutput, error)
 
+	DescribeClustersWithContext(context.Context, *ecs.DescribeClustersInput) (*ecs.DescribeClustersOutput, error)
+
 	DescribeContainerInstances(*ecs.DescribeContainerInstancesInput) (*ecs.DescribeContainerInstancesOutput, error)
 
+	DescribeContainerInstancesWithContext(context.Context, *ecs.DescribeContainerInstancesInput) (*ecs.DescribeContainerInstancesOutput, error)
+
 	DescribeServices(*ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error)
 
+	DescribeServicesWithContext(context.Context, *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error)
+
 	DescribeTaskDefinition(*ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error)
 
+	DescribeTaskDefinitionWithContext(context.Context, *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error)
+
 	DescribeTasks(*ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error)
 
+	DescribeTasksWithContext(context.Context, *ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error)
+
 	DiscoverPollEndpoint(*ecs.DiscoverPollEndpointInput) (*ecs.DiscoverPollEndpointOutput, error)
 
+	DiscoverPollEndpointWithContext(context.Context, *ecs.DiscoverPollEndpointInput) (*ecs.DiscoverPollEndpointOutput, error)
+
 	ListClusters(*ecs.ListClustersInput) (*ecs.ListClustersOutput, error)
 
+	ListClustersWithContext(context.Context, *ecs.ListClustersInput) (*ecs.ListClustersOutput, error)
+
 	ListContainerInstances(*ecs.ListContainerInstancesInput) (*ecs.ListContainerInstancesOutput, error)
 
+	ListContainerInstancesWithContext(context.Context, *ecs.ListContainerInstancesInput) (*ecs.ListContainerInstancesOutput, error)
+
 	ListServices(*ecs.ListServicesInput) (*ecs.ListServicesOutput, error)
 
+	ListServicesWithContext(context.Context, *ecs.ListServicesInput) (*ecs.ListServicesOutput, error)
+
 	ListTaskDefinitionFamilies(*ecs.ListTaskDefinitionFamiliesInput) (*ecs.ListTaskDefinitionFamiliesOutput, error)
 
+	ListTaskDefinitionFamiliesWithContext(context.Context, *ecs.ListTaskDefinitionFamiliesInput) (*ecs.ListTaskDefinitionFamiliesOutput, error)
+
 	ListTaskDefinitions(*ecs.ListTaskDefinitionsInput) (*ecs.ListTaskDefinitionsOutput, error)
 
+	ListTaskDefinitionsWithContext(context.Context, *ecs.ListTaskDefinitionsInput) (*ecs.ListTaskDefinitionsOutput, error)
+
 	ListTasks(*ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
 
+	ListTasksWithContext(context.Context, *ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
+
 	RegisterContainerInstance(*ecs.RegisterContainerInstanceInput) (*ecs.RegisterContainerInstanceOutput, error)
 
+	RegisterContainerInstanceWithContext(context.Context, *ecs.RegisterContainerInstanceInput) (*ecs.RegisterContainerInstanceOutput, error)
+
 	RegisterTaskDefinition(*ecs.RegisterTaskDefinitionInput) (*ecs.RegisterTaskDefinitionOutput, error)
 
+	RegisterTaskDefinitionWithContext(context.Context, *ecs.RegisterTaskDefinitionInput) (*ecs.RegisterTaskDefinitionOutput, error)
+
 	RunTask(*ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
 
+	RunTaskWithContext(context.Context, *ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
+
 	StartTask(*ecs.StartTaskInput) (*ecs.StartTaskOutput, error)
 
+	StartTaskWithContext(context.Context, *ecs.StartTaskInput) (*ecs.StartTaskOutput, error)
+
 	StopTask(*ecs.StopTaskInput) (*ecs.StopTaskOutput, error)
 
+	StopTaskWithContext(context.Context, *ecs.StopTaskInput) (*ecs.StopTaskOutput, error)
+
 	SubmitContainerStateChange(*ecs.SubmitContainerStateChangeInput) (*ecs.SubmitContainerStateChangeOutput, error)
 
+	SubmitContainerStateChangeWithContext(context.Context, *ecs.SubmitContainerStateChangeInput) (*ecs.SubmitContainerStateChangeOutput, error)
+
 	SubmitTaskStateChange(*ecs.SubmitTaskStateChangeInput) (*ecs.SubmitTaskStateChangeOutput, error)
 
+	SubmitTaskStateChangeWithContext(context.Context, *ecs.SubmitTaskStateChangeInput) (*ecs.SubmitTaskStateChangeOutput, error)
+
 	UpdateService(*ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error)
+
+	UpdateServiceWithContext(context.Context, *ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error)
 }
\ No newline at end of file
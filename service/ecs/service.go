@@ -29,6 +29,7 @@ func New(config *aws.Config) *ECS {
 		APIVersion:   "2014-11-13",
 		JSONVersion:  "1.1",
 		TargetPrefix: "AmazonEC2ContainerServiceV20141113",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package elasticache
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -53,6 +54,17 @@ func (c *ElastiCache) AddTagsToResource(input *AddTagsToResourceInput) (output *
 	return
 }
 
+// AddTagsToResourceWithContext is the same as AddTagsToResource with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) AddTagsToResourceWithContext(ctx context.Context, input *AddTagsToResourceInput) (output *TagListMessage, err error) {
+	req, out := c.AddTagsToResourceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddTagsToResource *aws.Operation
 
 // AuthorizeCacheSecurityGroupIngressRequest generates a request for the AuthorizeCacheSecurityGroupIngress operation.
@@ -91,6 +103,17 @@ func (c *ElastiCache) AuthorizeCacheSecurityGroupIngress(input *AuthorizeCacheSe
 	return
 }
 
+// AuthorizeCacheSecurityGroupIngressWithContext is the same as AuthorizeCacheSecurityGroupIngress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) AuthorizeCacheSecurityGroupIngressWithContext(ctx context.Context, input *AuthorizeCacheSecurityGroupIngressInput) (output *AuthorizeCacheSecurityGroupIngressOutput, err error) {
+	req, out := c.AuthorizeCacheSecurityGroupIngressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAuthorizeCacheSecurityGroupIngress *aws.Operation
 
 // CopySnapshotRequest generates a request for the CopySnapshot operation.
@@ -124,6 +147,17 @@ func (c *ElastiCache) CopySnapshot(input *CopySnapshotInput) (output *CopySnapsh
 	return
 }
 
+// CopySnapshotWithContext is the same as CopySnapshot with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) CopySnapshotWithContext(ctx context.Context, input *CopySnapshotInput) (output *CopySnapshotOutput, err error) {
+	req, out := c.CopySnapshotRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCopySnapshot *aws.Operation
 
 // CreateCacheClusterRequest generates a request for the CreateCacheCluster operation.
@@ -159,6 +193,17 @@ func (c *ElastiCache) CreateCacheCluster(input *CreateCacheClusterInput) (output
 	return
 }
 
+// CreateCacheClusterWithContext is the same as CreateCacheCluster with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) CreateCacheClusterWithContext(ctx context.Context, input *CreateCacheClusterInput) (output *CreateCacheClusterOutput, err error) {
+	req, out := c.CreateCacheClusterRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateCacheCluster *aws.Operation
 
 // CreateCacheParameterGroupRequest generates a request for the CreateCacheParameterGroup operation.
@@ -194,6 +239,17 @@ func (c *ElastiCache) CreateCacheParameterGroup(input *CreateCacheParameterGroup
 	return
 }
 
+// CreateCacheParameterGroupWithContext is the same as CreateCacheParameterGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) CreateCacheParameterGroupWithContext(ctx context.Context, input *CreateCacheParameterGroupInput) (output *CreateCacheParameterGroupOutput, err error) {
+	req, out := c.CreateCacheParameterGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateCacheParameterGroup *aws.Operation
 
 // CreateCacheSecurityGroupRequest generates a request for the CreateCacheSecurityGroup operation.
@@ -233,6 +289,17 @@ func (c *ElastiCache) CreateCacheSecurityGroup(input *CreateCacheSecurityGroupIn
 	return
 }
 
+// CreateCacheSecurityGroupWithContext is the same as CreateCacheSecurityGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) CreateCacheSecurityGroupWithContext(ctx context.Context, input *CreateCacheSecurityGroupInput) (output *CreateCacheSecurityGroupOutput, err error) {
+	req, out := c.CreateCacheSecurityGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateCacheSecurityGroup *aws.Operation
 
 // CreateCacheSubnetGroupRequest generates a request for the CreateCacheSubnetGroup operation.
@@ -269,6 +336,17 @@ func (c *ElastiCache) CreateCacheSubnetGroup(input *CreateCacheSubnetGroupInput)
 	return
 }
 
+// CreateCacheSubnetGroupWithContext is the same as CreateCacheSubnetGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) CreateCacheSubnetGroupWithContext(ctx context.Context, input *CreateCacheSubnetGroupInput) (output *CreateCacheSubnetGroupOutput, err error) {
+	req, out := c.CreateCacheSubnetGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateCacheSubnetGroup *aws.Operation
 
 // CreateReplicationGroupRequest generates a request for the CreateReplicationGroup operation.
@@ -312,6 +390,17 @@ func (c *ElastiCache) CreateReplicationGroup(input *CreateReplicationGroupInput)
 	return
 }
 
+// CreateReplicationGroupWithContext is the same as CreateReplicationGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) CreateReplicationGroupWithContext(ctx context.Context, input *CreateReplicationGroupInput) (output *CreateReplicationGroupOutput, err error) {
+	req, out := c.CreateReplicationGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateReplicationGroup *aws.Operation
 
 // CreateSnapshotRequest generates a request for the CreateSnapshot operation.
@@ -346,6 +435,17 @@ func (c *ElastiCache) CreateSnapshot(input *CreateSnapshotInput) (output *Create
 	return
 }
 
+// CreateSnapshotWithContext is the same as CreateSnapshot with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) CreateSnapshotWithContext(ctx context.Context, input *CreateSnapshotInput) (output *CreateSnapshotOutput, err error) {
+	req, out := c.CreateSnapshotRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateSnapshot *aws.Operation
 
 // DeleteCacheClusterRequest generates a request for the DeleteCacheCluster operation.
@@ -386,6 +486,17 @@ func (c *ElastiCache) DeleteCacheCluster(input *DeleteCacheClusterInput) (output
 	return
 }
 
+// DeleteCacheClusterWithContext is the same as DeleteCacheCluster with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DeleteCacheClusterWithContext(ctx context.Context, input *DeleteCacheClusterInput) (output *DeleteCacheClusterOutput, err error) {
+	req, out := c.DeleteCacheClusterRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteCacheCluster *aws.Operation
 
 // DeleteCacheParameterGroupRequest generates a request for the DeleteCacheParameterGroup operation.
@@ -421,6 +532,17 @@ func (c *ElastiCache) DeleteCacheParameterGroup(input *DeleteCacheParameterGroup
 	return
 }
 
+// DeleteCacheParameterGroupWithContext is the same as DeleteCacheParameterGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DeleteCacheParameterGroupWithContext(ctx context.Context, input *DeleteCacheParameterGroupInput) (output *DeleteCacheParameterGroupOutput, err error) {
+	req, out := c.DeleteCacheParameterGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteCacheParameterGroup *aws.Operation
 
 // DeleteCacheSecurityGroupRequest generates a request for the DeleteCacheSecurityGroup operation.
@@ -457,6 +579,17 @@ func (c *ElastiCache) DeleteCacheSecurityGroup(input *DeleteCacheSecurityGroupIn
 	return
 }
 
+// DeleteCacheSecurityGroupWithContext is the same as DeleteCacheSecurityGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DeleteCacheSecurityGroupWithContext(ctx context.Context, input *DeleteCacheSecurityGroupInput) (output *DeleteCacheSecurityGroupOutput, err error) {
+	req, out := c.DeleteCacheSecurityGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteCacheSecurityGroup *aws.Operation
 
 // DeleteCacheSubnetGroupRequest generates a request for the DeleteCacheSubnetGroup operation.
@@ -493,6 +626,17 @@ func (c *ElastiCache) DeleteCacheSubnetGroup(input *DeleteCacheSubnetGroupInput)
 	return
 }
 
+// DeleteCacheSubnetGroupWithContext is the same as DeleteCacheSubnetGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DeleteCacheSubnetGroupWithContext(ctx context.Context, input *DeleteCacheSubnetGroupInput) (output *DeleteCacheSubnetGroupOutput, err error) {
+	req, out := c.DeleteCacheSubnetGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteCacheSubnetGroup *aws.Operation
 
 // DeleteReplicationGroupRequest generates a request for the DeleteReplicationGroup operation.
@@ -533,6 +677,17 @@ func (c *ElastiCache) DeleteReplicationGroup(input *DeleteReplicationGroupInput)
 	return
 }
 
+// DeleteReplicationGroupWithContext is the same as DeleteReplicationGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DeleteReplicationGroupWithContext(ctx context.Context, input *DeleteReplicationGroupInput) (output *DeleteReplicationGroupOutput, err error) {
+	req, out := c.DeleteReplicationGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteReplicationGroup *aws.Operation
 
 // DeleteSnapshotRequest generates a request for the DeleteSnapshot operation.
@@ -568,6 +723,17 @@ func (c *ElastiCache) DeleteSnapshot(input *DeleteSnapshotInput) (output *Delete
 	return
 }
 
+// DeleteSnapshotWithContext is the same as DeleteSnapshot with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DeleteSnapshotWithContext(ctx context.Context, input *DeleteSnapshotInput) (output *DeleteSnapshotOutput, err error) {
+	req, out := c.DeleteSnapshotRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteSnapshot *aws.Operation
 
 // DescribeCacheClustersRequest generates a request for the DescribeCacheClusters operation.
@@ -580,6 +746,12 @@ func (c *ElastiCache) DescribeCacheClustersRequest(input *DescribeCacheClustersI
 			Name:       "DescribeCacheClusters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -622,6 +794,27 @@ func (c *ElastiCache) DescribeCacheClusters(input *DescribeCacheClustersInput) (
 	return
 }
 
+// DescribeCacheClustersWithContext is the same as DescribeCacheClusters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DescribeCacheClustersWithContext(ctx context.Context, input *DescribeCacheClustersInput) (output *DescribeCacheClustersOutput, err error) {
+	req, out := c.DescribeCacheClustersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeCacheClustersPages iterates over the pages of a DescribeCacheClusters operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElastiCache) DescribeCacheClustersPages(input *DescribeCacheClustersInput, fn func(p *DescribeCacheClustersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeCacheClustersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCacheClustersOutput), lastPage)
+	})
+}
+
 var opDescribeCacheClusters *aws.Operation
 
 // DescribeCacheEngineVersionsRequest generates a request for the DescribeCacheEngineVersions operation.
@@ -634,6 +827,12 @@ func (c *ElastiCache) DescribeCacheEngineVersionsRequest(input *DescribeCacheEng
 			Name:       "DescribeCacheEngineVersions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -656,6 +855,27 @@ func (c *ElastiCache) DescribeCacheEngineVersions(input *DescribeCacheEngineVers
 	return
 }
 
+// DescribeCacheEngineVersionsWithContext is the same as DescribeCacheEngineVersions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DescribeCacheEngineVersionsWithContext(ctx context.Context, input *DescribeCacheEngineVersionsInput) (output *DescribeCacheEngineVersionsOutput, err error) {
+	req, out := c.DescribeCacheEngineVersionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeCacheEngineVersionsPages iterates over the pages of a DescribeCacheEngineVersions operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElastiCache) DescribeCacheEngineVersionsPages(input *DescribeCacheEngineVersionsInput, fn func(p *DescribeCacheEngineVersionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeCacheEngineVersionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCacheEngineVersionsOutput), lastPage)
+	})
+}
+
 var opDescribeCacheEngineVersions *aws.Operation
 
 // DescribeCacheParameterGroupsRequest generates a request for the DescribeCacheParameterGroups operation.
@@ -668,6 +888,12 @@ func (c *ElastiCache) DescribeCacheParameterGroupsRequest(input *DescribeCachePa
 			Name:       "DescribeCacheParameterGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -691,6 +917,27 @@ func (c *ElastiCache) DescribeCacheParameterGroups(input *DescribeCacheParameter
 	return
 }
 
+// DescribeCacheParameterGroupsWithContext is the same as DescribeCacheParameterGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DescribeCacheParameterGroupsWithContext(ctx context.Context, input *DescribeCacheParameterGroupsInput) (output *DescribeCacheParameterGroupsOutput, err error) {
+	req, out := c.DescribeCacheParameterGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeCacheParameterGroupsPages iterates over the pages of a DescribeCacheParameterGroups operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElastiCache) DescribeCacheParameterGroupsPages(input *DescribeCacheParameterGroupsInput, fn func(p *DescribeCacheParameterGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeCacheParameterGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCacheParameterGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeCacheParameterGroups *aws.Operation
 
 // DescribeCacheParametersRequest generates a request for the DescribeCacheParameters operation.
@@ -703,6 +950,12 @@ func (c *ElastiCache) DescribeCacheParametersRequest(input *DescribeCacheParamet
 			Name:       "DescribeCacheParameters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -725,6 +978,27 @@ func (c *ElastiCache) DescribeCacheParameters(input *DescribeCacheParametersInpu
 	return
 }
 
+// DescribeCacheParametersWithContext is the same as DescribeCacheParameters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DescribeCacheParametersWithContext(ctx context.Context, input *DescribeCacheParametersInput) (output *DescribeCacheParametersOutput, err error) {
+	req, out := c.DescribeCacheParametersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeCacheParametersPages iterates over the pages of a DescribeCacheParameters operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElastiCache) DescribeCacheParametersPages(input *DescribeCacheParametersInput, fn func(p *DescribeCacheParametersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeCacheParametersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCacheParametersOutput), lastPage)
+	})
+}
+
 var opDescribeCacheParameters *aws.Operation
 
 // DescribeCacheSecurityGroupsRequest generates a request for the DescribeCacheSecurityGroups operation.
@@ -737,6 +1011,12 @@ func (c *ElastiCache) DescribeCacheSecurityGroupsRequest(input *DescribeCacheSec
 			Name:       "DescribeCacheSecurityGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -760,6 +1040,27 @@ func (c *ElastiCache) DescribeCacheSecurityGroups(input *DescribeCacheSecurityGr
 	return
 }
 
+// DescribeCacheSecurityGroupsWithContext is the same as DescribeCacheSecurityGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DescribeCacheSecurityGroupsWithContext(ctx context.Context, input *DescribeCacheSecurityGroupsInput) (output *DescribeCacheSecurityGroupsOutput, err error) {
+	req, out := c.DescribeCacheSecurityGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeCacheSecurityGroupsPages iterates over the pages of a DescribeCacheSecurityGroups operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElastiCache) DescribeCacheSecurityGroupsPages(input *DescribeCacheSecurityGroupsInput, fn func(p *DescribeCacheSecurityGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeCacheSecurityGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCacheSecurityGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeCacheSecurityGroups *aws.Operation
 
 // DescribeCacheSubnetGroupsRequest generates a request for the DescribeCacheSubnetGroups operation.
@@ -772,6 +1073,12 @@ func (c *ElastiCache) DescribeCacheSubnetGroupsRequest(input *DescribeCacheSubne
 			Name:       "DescribeCacheSubnetGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -795,6 +1102,27 @@ func (c *ElastiCache) DescribeCacheSubnetGroups(input *DescribeCacheSubnetGroups
 	return
 }
 
+// DescribeCacheSubnetGroupsWithContext is the same as DescribeCacheSubnetGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DescribeCacheSubnetGroupsWithContext(ctx context.Context, input *DescribeCacheSubnetGroupsInput) (output *DescribeCacheSubnetGroupsOutput, err error) {
+	req, out := c.DescribeCacheSubnetGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeCacheSubnetGroupsPages iterates over the pages of a DescribeCacheSubnetGroups operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElastiCache) DescribeCacheSubnetGroupsPages(input *DescribeCacheSubnetGroupsInput, fn func(p *DescribeCacheSubnetGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeCacheSubnetGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCacheSubnetGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeCacheSubnetGroups *aws.Operation
 
 // DescribeEngineDefaultParametersRequest generates a request for the DescribeEngineDefaultParameters operation.
@@ -807,6 +1135,12 @@ func (c *ElastiCache) DescribeEngineDefaultParametersRequest(input *DescribeEngi
 			Name:       "DescribeEngineDefaultParameters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"EngineDefaults.Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -829,6 +1163,27 @@ func (c *ElastiCache) DescribeEngineDefaultParameters(input *DescribeEngineDefau
 	return
 }
 
+// DescribeEngineDefaultParametersWithContext is the same as DescribeEngineDefaultParameters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DescribeEngineDefaultParametersWithContext(ctx context.Context, input *DescribeEngineDefaultParametersInput) (output *DescribeEngineDefaultParametersOutput, err error) {
+	req, out := c.DescribeEngineDefaultParametersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeEngineDefaultParametersPages iterates over the pages of a DescribeEngineDefaultParameters operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElastiCache) DescribeEngineDefaultParametersPages(input *DescribeEngineDefaultParametersInput, fn func(p *DescribeEngineDefaultParametersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeEngineDefaultParametersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEngineDefaultParametersOutput), lastPage)
+	})
+}
+
 var opDescribeEngineDefaultParameters *aws.Operation
 
 // DescribeEventsRequest generates a request for the DescribeEvents operation.
@@ -841,6 +1196,12 @@ func (c *ElastiCache) DescribeEventsRequest(input *DescribeEventsInput) (req *aw
 			Name:       "DescribeEvents",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -868,6 +1229,27 @@ func (c *ElastiCache) DescribeEvents(input *DescribeEventsInput) (output *Descri
 	return
 }
 
+// DescribeEventsWithContext is the same as DescribeEvents with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DescribeEventsWithContext(ctx context.Context, input *DescribeEventsInput) (output *DescribeEventsOutput, err error) {
+	req, out := c.DescribeEventsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeEventsPages iterates over the pages of a DescribeEvents operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElastiCache) DescribeEventsPages(input *DescribeEventsInput, fn func(p *DescribeEventsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeEventsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEventsOutput), lastPage)
+	})
+}
+
 var opDescribeEvents *aws.Operation
 
 // DescribeReplicationGroupsRequest generates a request for the DescribeReplicationGroups operation.
@@ -880,6 +1262,12 @@ func (c *ElastiCache) DescribeReplicationGroupsRequest(input *DescribeReplicatio
 			Name:       "DescribeReplicationGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -903,6 +1291,27 @@ func (c *ElastiCache) DescribeReplicationGroups(input *DescribeReplicationGroups
 	return
 }
 
+// DescribeReplicationGroupsWithContext is the same as DescribeReplicationGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DescribeReplicationGroupsWithContext(ctx context.Context, input *DescribeReplicationGroupsInput) (output *DescribeReplicationGroupsOutput, err error) {
+	req, out := c.DescribeReplicationGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeReplicationGroupsPages iterates over the pages of a DescribeReplicationGroups operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElastiCache) DescribeReplicationGroupsPages(input *DescribeReplicationGroupsInput, fn func(p *DescribeReplicationGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReplicationGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReplicationGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeReplicationGroups *aws.Operation
 
 // DescribeReservedCacheNodesRequest generates a request for the DescribeReservedCacheNodes operation.
@@ -915,6 +1324,12 @@ func (c *ElastiCache) DescribeReservedCacheNodesRequest(input *DescribeReservedC
 			Name:       "DescribeReservedCacheNodes",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -937,6 +1352,27 @@ func (c *ElastiCache) DescribeReservedCacheNodes(input *DescribeReservedCacheNod
 	return
 }
 
+// DescribeReservedCacheNodesWithContext is the same as DescribeReservedCacheNodes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DescribeReservedCacheNodesWithContext(ctx context.Context, input *DescribeReservedCacheNodesInput) (output *DescribeReservedCacheNodesOutput, err error) {
+	req, out := c.DescribeReservedCacheNodesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeReservedCacheNodesPages iterates over the pages of a DescribeReservedCacheNodes operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElastiCache) DescribeReservedCacheNodesPages(input *DescribeReservedCacheNodesInput, fn func(p *DescribeReservedCacheNodesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReservedCacheNodesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReservedCacheNodesOutput), lastPage)
+	})
+}
+
 var opDescribeReservedCacheNodes *aws.Operation
 
 // DescribeReservedCacheNodesOfferingsRequest generates a request for the DescribeReservedCacheNodesOfferings operation.
@@ -949,6 +1385,12 @@ func (c *ElastiCache) DescribeReservedCacheNodesOfferingsRequest(input *Describe
 			Name:       "DescribeReservedCacheNodesOfferings",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -971,6 +1413,27 @@ func (c *ElastiCache) DescribeReservedCacheNodesOfferings(input *DescribeReserve
 	return
 }
 
+// DescribeReservedCacheNodesOfferingsWithContext is the same as DescribeReservedCacheNodesOfferings with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DescribeReservedCacheNodesOfferingsWithContext(ctx context.Context, input *DescribeReservedCacheNodesOfferingsInput) (output *DescribeReservedCacheNodesOfferingsOutput, err error) {
+	req, out := c.DescribeReservedCacheNodesOfferingsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeReservedCacheNodesOfferingsPages iterates over the pages of a DescribeReservedCacheNodesOfferings operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElastiCache) DescribeReservedCacheNodesOfferingsPages(input *DescribeReservedCacheNodesOfferingsInput, fn func(p *DescribeReservedCacheNodesOfferingsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReservedCacheNodesOfferingsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReservedCacheNodesOfferingsOutput), lastPage)
+	})
+}
+
 var opDescribeReservedCacheNodesOfferings *aws.Operation
 
 // DescribeSnapshotsRequest generates a request for the DescribeSnapshots operation.
@@ -983,6 +1446,12 @@ func (c *ElastiCache) DescribeSnapshotsRequest(input *DescribeSnapshotsInput) (r
 			Name:       "DescribeSnapshots",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1007,6 +1476,27 @@ func (c *ElastiCache) DescribeSnapshots(input *DescribeSnapshotsInput) (output *
 	return
 }
 
+// DescribeSnapshotsWithContext is the same as DescribeSnapshots with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) DescribeSnapshotsWithContext(ctx context.Context, input *DescribeSnapshotsInput) (output *DescribeSnapshotsOutput, err error) {
+	req, out := c.DescribeSnapshotsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeSnapshotsPages iterates over the pages of a DescribeSnapshots operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElastiCache) DescribeSnapshotsPages(input *DescribeSnapshotsInput, fn func(p *DescribeSnapshotsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeSnapshotsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeSnapshotsOutput), lastPage)
+	})
+}
+
 var opDescribeSnapshots *aws.Operation
 
 // ListTagsForResourceRequest generates a request for the ListTagsForResource operation.
@@ -1047,6 +1537,17 @@ func (c *ElastiCache) ListTagsForResource(input *ListTagsForResourceInput) (outp
 	return
 }
 
+// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) ListTagsForResourceWithContext(ctx context.Context, input *ListTagsForResourceInput) (output *TagListMessage, err error) {
+	req, out := c.ListTagsForResourceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListTagsForResource *aws.Operation
 
 // ModifyCacheClusterRequest generates a request for the ModifyCacheCluster operation.
@@ -1082,6 +1583,17 @@ func (c *ElastiCache) ModifyCacheCluster(input *ModifyCacheClusterInput) (output
 	return
 }
 
+// ModifyCacheClusterWithContext is the same as ModifyCacheCluster with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) ModifyCacheClusterWithContext(ctx context.Context, input *ModifyCacheClusterInput) (output *ModifyCacheClusterOutput, err error) {
+	req, out := c.ModifyCacheClusterRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyCacheCluster *aws.Operation
 
 // ModifyCacheParameterGroupRequest generates a request for the ModifyCacheParameterGroup operation.
@@ -1117,6 +1629,17 @@ func (c *ElastiCache) ModifyCacheParameterGroup(input *ModifyCacheParameterGroup
 	return
 }
 
+// ModifyCacheParameterGroupWithContext is the same as ModifyCacheParameterGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) ModifyCacheParameterGroupWithContext(ctx context.Context, input *ModifyCacheParameterGroupInput) (output *CacheParameterGroupNameMessage, err error) {
+	req, out := c.ModifyCacheParameterGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyCacheParameterGroup *aws.Operation
 
 // ModifyCacheSubnetGroupRequest generates a request for the ModifyCacheSubnetGroup operation.
@@ -1150,6 +1673,17 @@ func (c *ElastiCache) ModifyCacheSubnetGroup(input *ModifyCacheSubnetGroupInput)
 	return
 }
 
+// ModifyCacheSubnetGroupWithContext is the same as ModifyCacheSubnetGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) ModifyCacheSubnetGroupWithContext(ctx context.Context, input *ModifyCacheSubnetGroupInput) (output *ModifyCacheSubnetGroupOutput, err error) {
+	req, out := c.ModifyCacheSubnetGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyCacheSubnetGroup *aws.Operation
 
 // ModifyReplicationGroupRequest generates a request for the ModifyReplicationGroup operation.
@@ -1184,6 +1718,17 @@ func (c *ElastiCache) ModifyReplicationGroup(input *ModifyReplicationGroupInput)
 	return
 }
 
+// ModifyReplicationGroupWithContext is the same as ModifyReplicationGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) ModifyReplicationGroupWithContext(ctx context.Context, input *ModifyReplicationGroupInput) (output *ModifyReplicationGroupOutput, err error) {
+	req, out := c.ModifyReplicationGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyReplicationGroup *aws.Operation
 
 // PurchaseReservedCacheNodesOfferingRequest generates a request for the PurchaseReservedCacheNodesOffering operation.
@@ -1218,6 +1763,17 @@ func (c *ElastiCache) PurchaseReservedCacheNodesOffering(input *PurchaseReserved
 	return
 }
 
+// PurchaseReservedCacheNodesOfferingWithContext is the same as PurchaseReservedCacheNodesOffering with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) PurchaseReservedCacheNodesOfferingWithContext(ctx context.Context, input *PurchaseReservedCacheNodesOfferingInput) (output *PurchaseReservedCacheNodesOfferingOutput, err error) {
+	req, out := c.PurchaseReservedCacheNodesOfferingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPurchaseReservedCacheNodesOffering *aws.Operation
 
 // RebootCacheClusterRequest generates a request for the RebootCacheCluster operation.
@@ -1260,6 +1816,17 @@ func (c *ElastiCache) RebootCacheCluster(input *RebootCacheClusterInput) (output
 	return
 }
 
+// RebootCacheClusterWithContext is the same as RebootCacheCluster with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) RebootCacheClusterWithContext(ctx context.Context, input *RebootCacheClusterInput) (output *RebootCacheClusterOutput, err error) {
+	req, out := c.RebootCacheClusterRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRebootCacheCluster *aws.Operation
 
 // RemoveTagsFromResourceRequest generates a request for the RemoveTagsFromResource operation.
@@ -1294,6 +1861,17 @@ func (c *ElastiCache) RemoveTagsFromResource(input *RemoveTagsFromResourceInput)
 	return
 }
 
+// RemoveTagsFromResourceWithContext is the same as RemoveTagsFromResource with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) RemoveTagsFromResourceWithContext(ctx context.Context, input *RemoveTagsFromResourceInput) (output *TagListMessage, err error) {
+	req, out := c.RemoveTagsFromResourceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRemoveTagsFromResource *aws.Operation
 
 // ResetCacheParameterGroupRequest generates a request for the ResetCacheParameterGroup operation.
@@ -1330,6 +1908,17 @@ func (c *ElastiCache) ResetCacheParameterGroup(input *ResetCacheParameterGroupIn
 	return
 }
 
+// ResetCacheParameterGroupWithContext is the same as ResetCacheParameterGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) ResetCacheParameterGroupWithContext(ctx context.Context, input *ResetCacheParameterGroupInput) (output *CacheParameterGroupNameMessage, err error) {
+	req, out := c.ResetCacheParameterGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opResetCacheParameterGroup *aws.Operation
 
 // RevokeCacheSecurityGroupIngressRequest generates a request for the RevokeCacheSecurityGroupIngress operation.
@@ -1365,6 +1954,17 @@ func (c *ElastiCache) RevokeCacheSecurityGroupIngress(input *RevokeCacheSecurity
 	return
 }
 
+// RevokeCacheSecurityGroupIngressWithContext is the same as RevokeCacheSecurityGroupIngress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElastiCache) RevokeCacheSecurityGroupIngressWithContext(ctx context.Context, input *RevokeCacheSecurityGroupIngressInput) (output *RevokeCacheSecurityGroupIngressOutput, err error) {
+	req, out := c.RevokeCacheSecurityGroupIngressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRevokeCacheSecurityGroupIngress *aws.Operation
 
 // Represents the input of an AddTagsToResource action.
@@ -1834,7 +2434,7 @@ type CreateCacheClusterInput struct {
 	//
 	// If the AZMode and PreferredAvailabilityZones are not specified, ElastiCache
 	// assumes single-az mode.
-	AZMode *string `type:"string"`
+	AZMode *string `type:"string" enum:"single-az,cross-az"`
 
 	// This parameter is currently disabled.
 	AutoMinorVersionUpgrade *bool `type:"boolean"`
@@ -2859,7 +3459,7 @@ type DescribeEventsInput struct {
 	//
 	// Valid values are: cache-cluster | cache-parameter-group | cache-security-group
 	// | cache-subnet-group
-	SourceType *string `type:"string"`
+	SourceType *string `type:"string" enum:"cache-cluster,cache-parameter-group,cache-security-group,cache-subnet-group"`
 
 	// The beginning of the time interval to retrieve events for, specified in ISO
 	// 8601 format.
@@ -3231,7 +3831,7 @@ type Event struct {
 
 	// Specifies the origin of this event - a cache cluster, a parameter group,
 	// a security group, etc.
-	SourceType *string `type:"string"`
+	SourceType *string `type:"string" enum:"cache-cluster,cache-parameter-group,cache-security-group,cache-subnet-group"`
 
 	metadataEvent `json:"-", xml:"-"`
 }
@@ -3270,7 +3870,7 @@ type ModifyCacheClusterInput struct {
 	// For instructions on how to move existing Memcached nodes to different Availability
 	// Zones, see the Availability Zone Considerations section of Cache Node Considerations
 	// for Memcached (http://docs.aws.amazon.com/AmazonElastiCache/latest/UserGuide/CacheNode.Memcached.html).
-	AZMode *string `type:"string"`
+	AZMode *string `type:"string" enum:"single-az,cross-az"`
 
 	// If true, this parameter causes the modifications in this request and any
 	// pending modifications to be applied, asynchronously and as soon as possible,
@@ -3897,7 +4497,7 @@ type ReplicationGroup struct {
 	// ElastiCache Multi-AZ replication groups are not supported on:
 	//
 	//  Redis versions earlier than 2.8.6. T1 and T2 cache node types.
-	AutomaticFailover *string `type:"string"`
+	AutomaticFailover *string `type:"string" enum:"enabled,disabled,enabling,disabling"`
 
 	// The description of the replication group.
 	Description *string `type:"string"`
@@ -3938,7 +4538,7 @@ type ReplicationGroupPendingModifiedValues struct {
 	// ElastiCache Multi-AZ replication groups are not supported on:
 	//
 	//  Redis versions earlier than 2.8.6. T1 and T2 cache node types.
-	AutomaticFailoverStatus *string `type:"string"`
+	AutomaticFailoverStatus *string `type:"string" enum:"enabled,disabled"`
 
 	// The primary cluster ID which will be applied immediately (if --apply-immediately
 	// was specified), or during the next maintenance window.
@@ -4296,4 +4896,68 @@ type TagListMessage struct {
 
 type metadataTagListMessage struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for AZMode.
+const (
+	AZModeSingleAz = "single-az"
+	AZModeCrossAZ  = "cross-az"
+)
+
+// AZModeValues returns the valid values of AZMode.
+func AZModeValues() []string {
+	return []string{
+		AZModeSingleAz,
+		AZModeCrossAZ,
+	}
+}
+
+// Enum values for AutomaticFailoverStatus.
+const (
+	AutomaticFailoverStatusEnabled   = "enabled"
+	AutomaticFailoverStatusDisabled  = "disabled"
+	AutomaticFailoverStatusEnabling  = "enabling"
+	AutomaticFailoverStatusDisabling = "disabling"
+)
+
+// AutomaticFailoverStatusValues returns the valid values of AutomaticFailoverStatus.
+func AutomaticFailoverStatusValues() []string {
+	return []string{
+		AutomaticFailoverStatusEnabled,
+		AutomaticFailoverStatusDisabled,
+		AutomaticFailoverStatusEnabling,
+		AutomaticFailoverStatusDisabling,
+	}
+}
+
+// Enum values for PendingAutomaticFailoverStatus.
+const (
+	PendingAutomaticFailoverStatusEnabled  = "enabled"
+	PendingAutomaticFailoverStatusDisabled = "disabled"
+)
+
+// PendingAutomaticFailoverStatusValues returns the valid values of PendingAutomaticFailoverStatus.
+func PendingAutomaticFailoverStatusValues() []string {
+	return []string{
+		PendingAutomaticFailoverStatusEnabled,
+		PendingAutomaticFailoverStatusDisabled,
+	}
+}
+
+// Enum values for SourceType.
+const (
+	SourceTypeCacheCluster        = "cache-cluster"
+	SourceTypeCacheParameterGroup = "cache-parameter-group"
+	SourceTypeCacheSecurityGroup  = "cache-security-group"
+	SourceTypeCacheSubnetGroup    = "cache-subnet-group"
+)
+
+// SourceTypeValues returns the valid values of SourceType.
+func SourceTypeValues() []string {
+	return []string{
+		SourceTypeCacheCluster,
+		SourceTypeCacheParameterGroup,
+		SourceTypeCacheSecurityGroup,
+		SourceTypeCacheSubnetGroup,
+	}
 }
\ No newline at end of file
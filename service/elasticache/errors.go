@@ -0,0 +1,340 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package elasticache
+
+import (
+	"github.com/datacratic/aws-sdk-go/aws"
+)
+
+// Error codes of the ElastiCache errors. Errors with these codes are
+// returned as the typed error of the same name, e.g. an error with the code
+// ErrCodeFoo is a *Foo.
+const (
+	ErrCodeAuthorizationAlreadyExistsFault         = "AuthorizationAlreadyExists"
+	ErrCodeAuthorizationNotFoundFault              = "AuthorizationNotFound"
+	ErrCodeCacheClusterAlreadyExistsFault          = "CacheClusterAlreadyExists"
+	ErrCodeCacheClusterNotFoundFault               = "CacheClusterNotFound"
+	ErrCodeCacheParameterGroupAlreadyExistsFault   = "CacheParameterGroupAlreadyExists"
+	ErrCodeCacheParameterGroupNotFoundFault        = "CacheParameterGroupNotFound"
+	ErrCodeCacheParameterGroupQuotaExceededFault   = "CacheParameterGroupQuotaExceeded"
+	ErrCodeCacheSecurityGroupAlreadyExistsFault    = "CacheSecurityGroupAlreadyExists"
+	ErrCodeCacheSecurityGroupNotFoundFault         = "CacheSecurityGroupNotFound"
+	ErrCodeCacheSecurityGroupQuotaExceededFault    = "QuotaExceeded.CacheSecurityGroup"
+	ErrCodeCacheSubnetGroupAlreadyExistsFault      = "CacheSubnetGroupAlreadyExists"
+	ErrCodeCacheSubnetGroupInUse                   = "CacheSubnetGroupInUse"
+	ErrCodeCacheSubnetGroupNotFoundFault           = "CacheSubnetGroupNotFoundFault"
+	ErrCodeCacheSubnetGroupQuotaExceededFault      = "CacheSubnetGroupQuotaExceeded"
+	ErrCodeCacheSubnetQuotaExceededFault           = "CacheSubnetQuotaExceededFault"
+	ErrCodeClusterQuotaForCustomerExceededFault    = "ClusterQuotaForCustomerExceeded"
+	ErrCodeInsufficientCacheClusterCapacityFault   = "InsufficientCacheClusterCapacity"
+	ErrCodeInvalidARNFault                         = "InvalidARN"
+	ErrCodeInvalidCacheClusterStateFault           = "InvalidCacheClusterState"
+	ErrCodeInvalidCacheParameterGroupStateFault    = "InvalidCacheParameterGroupState"
+	ErrCodeInvalidCacheSecurityGroupStateFault     = "InvalidCacheSecurityGroupState"
+	ErrCodeInvalidParameterCombinationException    = "InvalidParameterCombination"
+	ErrCodeInvalidParameterValueException          = "InvalidParameterValue"
+	ErrCodeInvalidReplicationGroupStateFault       = "InvalidReplicationGroupState"
+	ErrCodeInvalidSnapshotStateFault               = "InvalidSnapshotState"
+	ErrCodeInvalidSubnet                           = "InvalidSubnet"
+	ErrCodeInvalidVPCNetworkStateFault             = "InvalidVPCNetworkStateFault"
+	ErrCodeNodeQuotaForClusterExceededFault        = "NodeQuotaForClusterExceeded"
+	ErrCodeNodeQuotaForCustomerExceededFault       = "NodeQuotaForCustomerExceeded"
+	ErrCodeReplicationGroupAlreadyExistsFault      = "ReplicationGroupAlreadyExists"
+	ErrCodeReplicationGroupNotFoundFault           = "ReplicationGroupNotFoundFault"
+	ErrCodeReservedCacheNodeAlreadyExistsFault     = "ReservedCacheNodeAlreadyExists"
+	ErrCodeReservedCacheNodeNotFoundFault          = "ReservedCacheNodeNotFound"
+	ErrCodeReservedCacheNodeQuotaExceededFault     = "ReservedCacheNodeQuotaExceeded"
+	ErrCodeReservedCacheNodesOfferingNotFoundFault = "ReservedCacheNodesOfferingNotFound"
+	ErrCodeSnapshotAlreadyExistsFault              = "SnapshotAlreadyExistsFault"
+	ErrCodeSnapshotFeatureNotSupportedFault        = "SnapshotFeatureNotSupportedFault"
+	ErrCodeSnapshotNotFoundFault                   = "SnapshotNotFoundFault"
+	ErrCodeSnapshotQuotaExceededFault              = "SnapshotQuotaExceededFault"
+	ErrCodeSubnetInUse                             = "SubnetInUse"
+	ErrCodeTagNotFoundFault                        = "TagNotFound"
+	ErrCodeTagQuotaPerResourceExceeded             = "TagQuotaPerResourceExceeded"
+)
+
+// The specified Amazon EC2 security group is already authorized for the specified
+// cache security group.
+type AuthorizationAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// The specified Amazon EC2 security group is not authorized for the specified
+// cache security group.
+type AuthorizationNotFoundFault struct {
+	aws.APIError
+}
+
+// You already have a cache cluster with the given identifier.
+type CacheClusterAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// The requested cache cluster ID does not refer to an existing cache cluster.
+type CacheClusterNotFoundFault struct {
+	aws.APIError
+}
+
+// A cache parameter group with the requested name already exists.
+type CacheParameterGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// The requested cache parameter group name does not refer to an existing cache
+// parameter group.
+type CacheParameterGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// The request cannot be processed because it would exceed the maximum number
+// of cache security groups.
+type CacheParameterGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// A cache security group with the specified name already exists.
+type CacheSecurityGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// The requested cache security group name does not refer to an existing cache
+// security group.
+type CacheSecurityGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// The request cannot be processed because it would exceed the allowed number
+// of cache security groups.
+type CacheSecurityGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// The requested cache subnet group name is already in use by an existing cache
+// subnet group.
+type CacheSubnetGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// The requested cache subnet group is currently in use.
+type CacheSubnetGroupInUse struct {
+	aws.APIError
+}
+
+// The requested cache subnet group name does not refer to an existing cache
+// subnet group.
+type CacheSubnetGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// The request cannot be processed because it would exceed the allowed number
+// of cache subnet groups.
+type CacheSubnetGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// The request cannot be processed because it would exceed the allowed number
+// of subnets in a cache subnet group.
+type CacheSubnetQuotaExceededFault struct {
+	aws.APIError
+}
+
+// The request cannot be processed because it would exceed the allowed number
+// of cache clusters per customer.
+type ClusterQuotaForCustomerExceededFault struct {
+	aws.APIError
+}
+
+// The requested cache node type is not available in the specified Availability
+// Zone.
+type InsufficientCacheClusterCapacityFault struct {
+	aws.APIError
+}
+
+// The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+type InvalidARNFault struct {
+	aws.APIError
+}
+
+// The requested cache cluster is not in the available state.
+type InvalidCacheClusterStateFault struct {
+	aws.APIError
+}
+
+// The current state of the cache parameter group does not allow the requested
+// action to occur.
+type InvalidCacheParameterGroupStateFault struct {
+	aws.APIError
+}
+
+// The current state of the cache security group does not allow deletion.
+type InvalidCacheSecurityGroupStateFault struct {
+	aws.APIError
+}
+
+// Two or more incompatible parameters were specified.
+type InvalidParameterCombinationException struct {
+	aws.APIError
+}
+
+// The value for a parameter is invalid.
+type InvalidParameterValueException struct {
+	aws.APIError
+}
+
+// The requested replication group is not in the available state.
+type InvalidReplicationGroupStateFault struct {
+	aws.APIError
+}
+
+// The current state of the snapshot does not allow the requested action to
+// occur.
+type InvalidSnapshotStateFault struct {
+	aws.APIError
+}
+
+// An invalid subnet identifier was specified.
+type InvalidSubnet struct {
+	aws.APIError
+}
+
+// The VPC network is in an invalid state.
+type InvalidVPCNetworkStateFault struct {
+	aws.APIError
+}
+
+// The request cannot be processed because it would exceed the allowed number
+// of cache nodes in a single cache cluster.
+type NodeQuotaForClusterExceededFault struct {
+	aws.APIError
+}
+
+// The request cannot be processed because it would exceed the allowed number
+// of cache nodes per customer.
+type NodeQuotaForCustomerExceededFault struct {
+	aws.APIError
+}
+
+// The specified replication group already exists.
+type ReplicationGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// The specified replication group does not exist.
+type ReplicationGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// You already have a reservation with the given identifier.
+type ReservedCacheNodeAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// The requested reserved cache node was not found.
+type ReservedCacheNodeNotFoundFault struct {
+	aws.APIError
+}
+
+// The request cannot be processed because it would exceed the user's cache
+// node quota.
+type ReservedCacheNodeQuotaExceededFault struct {
+	aws.APIError
+}
+
+// The requested cache node offering does not exist.
+type ReservedCacheNodesOfferingNotFoundFault struct {
+	aws.APIError
+}
+
+// You already have a snapshot with the given name.
+type SnapshotAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// You attempted one of the following actions:
+//
+//	Creating a snapshot of a Redis cache cluster running on a t1.micro cache
+//
+// node.
+//
+//	Creating a snapshot of a cache cluster that is running Memcached rather
+//
+// than Redis.
+//
+//	Neither of these are supported by ElastiCache.
+type SnapshotFeatureNotSupportedFault struct {
+	aws.APIError
+}
+
+// The requested snapshot name does not refer to an existing snapshot.
+type SnapshotNotFoundFault struct {
+	aws.APIError
+}
+
+// The request cannot be processed because it would exceed the maximum number
+// of snapshots.
+type SnapshotQuotaExceededFault struct {
+	aws.APIError
+}
+
+// The requested subnet is being used by another cache subnet group.
+type SubnetInUse struct {
+	aws.APIError
+}
+
+// The requested tag was not found on this resource.
+type TagNotFoundFault struct {
+	aws.APIError
+}
+
+// The request cannot be processed because it would cause the resource to have
+// more than the allowed number of tags. The maximum number of tags permitted
+// on a resource is 10.
+type TagQuotaPerResourceExceeded struct {
+	aws.APIError
+}
+
+var errorTypes = map[string]func() aws.ServiceError{
+	ErrCodeAuthorizationAlreadyExistsFault:         func() aws.ServiceError { return &AuthorizationAlreadyExistsFault{} },
+	ErrCodeAuthorizationNotFoundFault:              func() aws.ServiceError { return &AuthorizationNotFoundFault{} },
+	ErrCodeCacheClusterAlreadyExistsFault:          func() aws.ServiceError { return &CacheClusterAlreadyExistsFault{} },
+	ErrCodeCacheClusterNotFoundFault:               func() aws.ServiceError { return &CacheClusterNotFoundFault{} },
+	ErrCodeCacheParameterGroupAlreadyExistsFault:   func() aws.ServiceError { return &CacheParameterGroupAlreadyExistsFault{} },
+	ErrCodeCacheParameterGroupNotFoundFault:        func() aws.ServiceError { return &CacheParameterGroupNotFoundFault{} },
+	ErrCodeCacheParameterGroupQuotaExceededFault:   func() aws.ServiceError { return &CacheParameterGroupQuotaExceededFault{} },
+	ErrCodeCacheSecurityGroupAlreadyExistsFault:    func() aws.ServiceError { return &CacheSecurityGroupAlreadyExistsFault{} },
+	ErrCodeCacheSecurityGroupNotFoundFault:         func() aws.ServiceError { return &CacheSecurityGroupNotFoundFault{} },
+	ErrCodeCacheSecurityGroupQuotaExceededFault:    func() aws.ServiceError { return &CacheSecurityGroupQuotaExceededFault{} },
+	ErrCodeCacheSubnetGroupAlreadyExistsFault:      func() aws.ServiceError { return &CacheSubnetGroupAlreadyExistsFault{} },
+	ErrCodeCacheSubnetGroupInUse:                   func() aws.ServiceError { return &CacheSubnetGroupInUse{} },
+	ErrCodeCacheSubnetGroupNotFoundFault:           func() aws.ServiceError { return &CacheSubnetGroupNotFoundFault{} },
+	ErrCodeCacheSubnetGroupQuotaExceededFault:      func() aws.ServiceError { return &CacheSubnetGroupQuotaExceededFault{} },
+	ErrCodeCacheSubnetQuotaExceededFault:           func() aws.ServiceError { return &CacheSubnetQuotaExceededFault{} },
+	ErrCodeClusterQuotaForCustomerExceededFault:    func() aws.ServiceError { return &ClusterQuotaForCustomerExceededFault{} },
+	ErrCodeInsufficientCacheClusterCapacityFault:   func() aws.ServiceError { return &InsufficientCacheClusterCapacityFault{} },
+	ErrCodeInvalidARNFault:                         func() aws.ServiceError { return &InvalidARNFault{} },
+	ErrCodeInvalidCacheClusterStateFault:           func() aws.ServiceError { return &InvalidCacheClusterStateFault{} },
+	ErrCodeInvalidCacheParameterGroupStateFault:    func() aws.ServiceError { return &InvalidCacheParameterGroupStateFault{} },
+	ErrCodeInvalidCacheSecurityGroupStateFault:     func() aws.ServiceError { return &InvalidCacheSecurityGroupStateFault{} },
+	ErrCodeInvalidParameterCombinationException:    func() aws.ServiceError { return &InvalidParameterCombinationException{} },
+	ErrCodeInvalidParameterValueException:          func() aws.ServiceError { return &InvalidParameterValueException{} },
+	ErrCodeInvalidReplicationGroupStateFault:       func() aws.ServiceError { return &InvalidReplicationGroupStateFault{} },
+	ErrCodeInvalidSnapshotStateFault:               func() aws.ServiceError { return &InvalidSnapshotStateFault{} },
+	ErrCodeInvalidSubnet:                           func() aws.ServiceError { return &InvalidSubnet{} },
+	ErrCodeInvalidVPCNetworkStateFault:             func() aws.ServiceError { return &InvalidVPCNetworkStateFault{} },
+	ErrCodeNodeQuotaForClusterExceededFault:        func() aws.ServiceError { return &NodeQuotaForClusterExceededFault{} },
+	ErrCodeNodeQuotaForCustomerExceededFault:       func() aws.ServiceError { return &NodeQuotaForCustomerExceededFault{} },
+	ErrCodeReplicationGroupAlreadyExistsFault:      func() aws.ServiceError { return &ReplicationGroupAlreadyExistsFault{} },
+	ErrCodeReplicationGroupNotFoundFault:           func() aws.ServiceError { return &ReplicationGroupNotFoundFault{} },
+	ErrCodeReservedCacheNodeAlreadyExistsFault:     func() aws.ServiceError { return &ReservedCacheNodeAlreadyExistsFault{} },
+	ErrCodeReservedCacheNodeNotFoundFault:          func() aws.ServiceError { return &ReservedCacheNodeNotFoundFault{} },
+	ErrCodeReservedCacheNodeQuotaExceededFault:     func() aws.ServiceError { return &ReservedCacheNodeQuotaExceededFault{} },
+	ErrCodeReservedCacheNodesOfferingNotFoundFault: func() aws.ServiceError { return &ReservedCacheNodesOfferingNotFoundFault{} },
+	ErrCodeSnapshotAlreadyExistsFault:              func() aws.ServiceError { return &SnapshotAlreadyExistsFault{} },
+	ErrCodeSnapshotFeatureNotSupportedFault:        func() aws.ServiceError { return &SnapshotFeatureNotSupportedFault{} },
+	ErrCodeSnapshotNotFoundFault:                   func() aws.ServiceError { return &SnapshotNotFoundFault{} },
+	ErrCodeSnapshotQuotaExceededFault:              func() aws.ServiceError { return &SnapshotQuotaExceededFault{} },
+	ErrCodeSubnetInUse:                             func() aws.ServiceError { return &SubnetInUse{} },
+	ErrCodeTagNotFoundFault:                        func() aws.ServiceError { return &TagNotFoundFault{} },
+	ErrCodeTagQuotaPerResourceExceeded:             func() aws.ServiceError { return &TagQuotaPerResourceExceeded{} },
+}
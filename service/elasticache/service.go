@@ -27,6 +27,7 @@ func New(config *aws.Config) *ElastiCache {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "elasticache",
 		APIVersion:  "2015-02-02",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
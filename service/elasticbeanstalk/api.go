@@ -4,6 +4,7 @@
 package elasticbeanstalk
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -43,6 +44,17 @@ func (c *ElasticBeanstalk) CheckDNSAvailability(input *CheckDNSAvailabilityInput
 	return
 }
 
+// CheckDNSAvailabilityWithContext is the same as CheckDNSAvailability with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) CheckDNSAvailabilityWithContext(ctx context.Context, input *CheckDNSAvailabilityInput) (output *CheckDNSAvailabilityOutput, err error) {
+	req, out := c.CheckDNSAvailabilityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCheckDNSAvailability *aws.Operation
 
 // CreateApplicationRequest generates a request for the CreateApplication operation.
@@ -77,6 +89,17 @@ func (c *ElasticBeanstalk) CreateApplication(input *CreateApplicationInput) (out
 	return
 }
 
+// CreateApplicationWithContext is the same as CreateApplication with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) CreateApplicationWithContext(ctx context.Context, input *CreateApplicationInput) (output *ApplicationDescriptionMessage, err error) {
+	req, out := c.CreateApplicationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateApplication *aws.Operation
 
 // CreateApplicationVersionRequest generates a request for the CreateApplicationVersion operation.
@@ -110,6 +133,17 @@ func (c *ElasticBeanstalk) CreateApplicationVersion(input *CreateApplicationVers
 	return
 }
 
+// CreateApplicationVersionWithContext is the same as CreateApplicationVersion with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) CreateApplicationVersionWithContext(ctx context.Context, input *CreateApplicationVersionInput) (output *ApplicationVersionDescriptionMessage, err error) {
+	req, out := c.CreateApplicationVersionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateApplicationVersion *aws.Operation
 
 // CreateConfigurationTemplateRequest generates a request for the CreateConfigurationTemplate operation.
@@ -149,6 +183,17 @@ func (c *ElasticBeanstalk) CreateConfigurationTemplate(input *CreateConfiguratio
 	return
 }
 
+// CreateConfigurationTemplateWithContext is the same as CreateConfigurationTemplate with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) CreateConfigurationTemplateWithContext(ctx context.Context, input *CreateConfigurationTemplateInput) (output *ConfigurationSettingsDescription, err error) {
+	req, out := c.CreateConfigurationTemplateRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateConfigurationTemplate *aws.Operation
 
 // CreateEnvironmentRequest generates a request for the CreateEnvironment operation.
@@ -183,6 +228,17 @@ func (c *ElasticBeanstalk) CreateEnvironment(input *CreateEnvironmentInput) (out
 	return
 }
 
+// CreateEnvironmentWithContext is the same as CreateEnvironment with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) CreateEnvironmentWithContext(ctx context.Context, input *CreateEnvironmentInput) (output *EnvironmentDescription, err error) {
+	req, out := c.CreateEnvironmentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateEnvironment *aws.Operation
 
 // CreateStorageLocationRequest generates a request for the CreateStorageLocation operation.
@@ -218,6 +274,17 @@ func (c *ElasticBeanstalk) CreateStorageLocation(input *CreateStorageLocationInp
 	return
 }
 
+// CreateStorageLocationWithContext is the same as CreateStorageLocation with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) CreateStorageLocationWithContext(ctx context.Context, input *CreateStorageLocationInput) (output *CreateStorageLocationOutput, err error) {
+	req, out := c.CreateStorageLocationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateStorageLocation *aws.Operation
 
 // DeleteApplicationRequest generates a request for the DeleteApplication operation.
@@ -253,6 +320,17 @@ func (c *ElasticBeanstalk) DeleteApplication(input *DeleteApplicationInput) (out
 	return
 }
 
+// DeleteApplicationWithContext is the same as DeleteApplication with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) DeleteApplicationWithContext(ctx context.Context, input *DeleteApplicationInput) (output *DeleteApplicationOutput, err error) {
+	req, out := c.DeleteApplicationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteApplication *aws.Operation
 
 // DeleteApplicationVersionRequest generates a request for the DeleteApplicationVersion operation.
@@ -286,6 +364,17 @@ func (c *ElasticBeanstalk) DeleteApplicationVersion(input *DeleteApplicationVers
 	return
 }
 
+// DeleteApplicationVersionWithContext is the same as DeleteApplicationVersion with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) DeleteApplicationVersionWithContext(ctx context.Context, input *DeleteApplicationVersionInput) (output *DeleteApplicationVersionOutput, err error) {
+	req, out := c.DeleteApplicationVersionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteApplicationVersion *aws.Operation
 
 // DeleteConfigurationTemplateRequest generates a request for the DeleteConfigurationTemplate operation.
@@ -319,6 +408,17 @@ func (c *ElasticBeanstalk) DeleteConfigurationTemplate(input *DeleteConfiguratio
 	return
 }
 
+// DeleteConfigurationTemplateWithContext is the same as DeleteConfigurationTemplate with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) DeleteConfigurationTemplateWithContext(ctx context.Context, input *DeleteConfigurationTemplateInput) (output *DeleteConfigurationTemplateOutput, err error) {
+	req, out := c.DeleteConfigurationTemplateRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteConfigurationTemplate *aws.Operation
 
 // DeleteEnvironmentConfigurationRequest generates a request for the DeleteEnvironmentConfiguration operation.
@@ -359,6 +459,17 @@ func (c *ElasticBeanstalk) DeleteEnvironmentConfiguration(input *DeleteEnvironme
 	return
 }
 
+// DeleteEnvironmentConfigurationWithContext is the same as DeleteEnvironmentConfiguration with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) DeleteEnvironmentConfigurationWithContext(ctx context.Context, input *DeleteEnvironmentConfigurationInput) (output *DeleteEnvironmentConfigurationOutput, err error) {
+	req, out := c.DeleteEnvironmentConfigurationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteEnvironmentConfiguration *aws.Operation
 
 // DescribeApplicationVersionsRequest generates a request for the DescribeApplicationVersions operation.
@@ -392,6 +503,17 @@ func (c *ElasticBeanstalk) DescribeApplicationVersions(input *DescribeApplicatio
 	return
 }
 
+// DescribeApplicationVersionsWithContext is the same as DescribeApplicationVersions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) DescribeApplicationVersionsWithContext(ctx context.Context, input *DescribeApplicationVersionsInput) (output *DescribeApplicationVersionsOutput, err error) {
+	req, out := c.DescribeApplicationVersionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeApplicationVersions *aws.Operation
 
 // DescribeApplicationsRequest generates a request for the DescribeApplications operation.
@@ -425,6 +547,17 @@ func (c *ElasticBeanstalk) DescribeApplications(input *DescribeApplicationsInput
 	return
 }
 
+// DescribeApplicationsWithContext is the same as DescribeApplications with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) DescribeApplicationsWithContext(ctx context.Context, input *DescribeApplicationsInput) (output *DescribeApplicationsOutput, err error) {
+	req, out := c.DescribeApplicationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeApplications *aws.Operation
 
 // DescribeConfigurationOptionsRequest generates a request for the DescribeConfigurationOptions operation.
@@ -462,6 +595,17 @@ func (c *ElasticBeanstalk) DescribeConfigurationOptions(input *DescribeConfigura
 	return
 }
 
+// DescribeConfigurationOptionsWithContext is the same as DescribeConfigurationOptions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) DescribeConfigurationOptionsWithContext(ctx context.Context, input *DescribeConfigurationOptionsInput) (output *DescribeConfigurationOptionsOutput, err error) {
+	req, out := c.DescribeConfigurationOptionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeConfigurationOptions *aws.Operation
 
 // DescribeConfigurationSettingsRequest generates a request for the DescribeConfigurationSettings operation.
@@ -507,6 +651,17 @@ func (c *ElasticBeanstalk) DescribeConfigurationSettings(input *DescribeConfigur
 	return
 }
 
+// DescribeConfigurationSettingsWithContext is the same as DescribeConfigurationSettings with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) DescribeConfigurationSettingsWithContext(ctx context.Context, input *DescribeConfigurationSettingsInput) (output *DescribeConfigurationSettingsOutput, err error) {
+	req, out := c.DescribeConfigurationSettingsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeConfigurationSettings *aws.Operation
 
 // DescribeEnvironmentResourcesRequest generates a request for the DescribeEnvironmentResources operation.
@@ -540,6 +695,17 @@ func (c *ElasticBeanstalk) DescribeEnvironmentResources(input *DescribeEnvironme
 	return
 }
 
+// DescribeEnvironmentResourcesWithContext is the same as DescribeEnvironmentResources with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) DescribeEnvironmentResourcesWithContext(ctx context.Context, input *DescribeEnvironmentResourcesInput) (output *DescribeEnvironmentResourcesOutput, err error) {
+	req, out := c.DescribeEnvironmentResourcesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeEnvironmentResources *aws.Operation
 
 // DescribeEnvironmentsRequest generates a request for the DescribeEnvironments operation.
@@ -573,6 +739,17 @@ func (c *ElasticBeanstalk) DescribeEnvironments(input *DescribeEnvironmentsInput
 	return
 }
 
+// DescribeEnvironmentsWithContext is the same as DescribeEnvironments with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) DescribeEnvironmentsWithContext(ctx context.Context, input *DescribeEnvironmentsInput) (output *DescribeEnvironmentsOutput, err error) {
+	req, out := c.DescribeEnvironmentsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeEnvironments *aws.Operation
 
 // DescribeEventsRequest generates a request for the DescribeEvents operation.
@@ -585,6 +762,12 @@ func (c *ElasticBeanstalk) DescribeEventsRequest(input *DescribeEventsInput) (re
 			Name:       "DescribeEvents",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -606,6 +789,27 @@ func (c *ElasticBeanstalk) DescribeEvents(input *DescribeEventsInput) (output *D
 	return
 }
 
+// DescribeEventsWithContext is the same as DescribeEvents with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) DescribeEventsWithContext(ctx context.Context, input *DescribeEventsInput) (output *DescribeEventsOutput, err error) {
+	req, out := c.DescribeEventsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeEventsPages iterates over the pages of a DescribeEvents operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElasticBeanstalk) DescribeEventsPages(input *DescribeEventsInput, fn func(p *DescribeEventsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeEventsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEventsOutput), lastPage)
+	})
+}
+
 var opDescribeEvents *aws.Operation
 
 // ListAvailableSolutionStacksRequest generates a request for the ListAvailableSolutionStacks operation.
@@ -639,6 +843,17 @@ func (c *ElasticBeanstalk) ListAvailableSolutionStacks(input *ListAvailableSolut
 	return
 }
 
+// ListAvailableSolutionStacksWithContext is the same as ListAvailableSolutionStacks with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) ListAvailableSolutionStacksWithContext(ctx context.Context, input *ListAvailableSolutionStacksInput) (output *ListAvailableSolutionStacksOutput, err error) {
+	req, out := c.ListAvailableSolutionStacksRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListAvailableSolutionStacks *aws.Operation
 
 // RebuildEnvironmentRequest generates a request for the RebuildEnvironment operation.
@@ -673,6 +888,17 @@ func (c *ElasticBeanstalk) RebuildEnvironment(input *RebuildEnvironmentInput) (o
 	return
 }
 
+// RebuildEnvironmentWithContext is the same as RebuildEnvironment with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) RebuildEnvironmentWithContext(ctx context.Context, input *RebuildEnvironmentInput) (output *RebuildEnvironmentOutput, err error) {
+	req, out := c.RebuildEnvironmentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRebuildEnvironment *aws.Operation
 
 // RequestEnvironmentInfoRequest generates a request for the RequestEnvironmentInfo operation.
@@ -715,6 +941,17 @@ func (c *ElasticBeanstalk) RequestEnvironmentInfo(input *RequestEnvironmentInfoI
 	return
 }
 
+// RequestEnvironmentInfoWithContext is the same as RequestEnvironmentInfo with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) RequestEnvironmentInfoWithContext(ctx context.Context, input *RequestEnvironmentInfoInput) (output *RequestEnvironmentInfoOutput, err error) {
+	req, out := c.RequestEnvironmentInfoRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRequestEnvironmentInfo *aws.Operation
 
 // RestartAppServerRequest generates a request for the RestartAppServer operation.
@@ -749,6 +986,17 @@ func (c *ElasticBeanstalk) RestartAppServer(input *RestartAppServerInput) (outpu
 	return
 }
 
+// RestartAppServerWithContext is the same as RestartAppServer with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) RestartAppServerWithContext(ctx context.Context, input *RestartAppServerInput) (output *RestartAppServerOutput, err error) {
+	req, out := c.RestartAppServerRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRestartAppServer *aws.Operation
 
 // RetrieveEnvironmentInfoRequest generates a request for the RetrieveEnvironmentInfo operation.
@@ -786,6 +1034,17 @@ func (c *ElasticBeanstalk) RetrieveEnvironmentInfo(input *RetrieveEnvironmentInf
 	return
 }
 
+// RetrieveEnvironmentInfoWithContext is the same as RetrieveEnvironmentInfo with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) RetrieveEnvironmentInfoWithContext(ctx context.Context, input *RetrieveEnvironmentInfoInput) (output *RetrieveEnvironmentInfoOutput, err error) {
+	req, out := c.RetrieveEnvironmentInfoRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRetrieveEnvironmentInfo *aws.Operation
 
 // SwapEnvironmentCNAMEsRequest generates a request for the SwapEnvironmentCNAMEs operation.
@@ -819,6 +1078,17 @@ func (c *ElasticBeanstalk) SwapEnvironmentCNAMEs(input *SwapEnvironmentCNAMEsInp
 	return
 }
 
+// SwapEnvironmentCNAMEsWithContext is the same as SwapEnvironmentCNAMEs with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) SwapEnvironmentCNAMEsWithContext(ctx context.Context, input *SwapEnvironmentCNAMEsInput) (output *SwapEnvironmentCNAMEsOutput, err error) {
+	req, out := c.SwapEnvironmentCNAMEsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSwapEnvironmentCNAMEs *aws.Operation
 
 // TerminateEnvironmentRequest generates a request for the TerminateEnvironment operation.
@@ -852,6 +1122,17 @@ func (c *ElasticBeanstalk) TerminateEnvironment(input *TerminateEnvironmentInput
 	return
 }
 
+// TerminateEnvironmentWithContext is the same as TerminateEnvironment with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) TerminateEnvironmentWithContext(ctx context.Context, input *TerminateEnvironmentInput) (output *EnvironmentDescription, err error) {
+	req, out := c.TerminateEnvironmentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opTerminateEnvironment *aws.Operation
 
 // UpdateApplicationRequest generates a request for the UpdateApplication operation.
@@ -885,6 +1166,17 @@ func (c *ElasticBeanstalk) UpdateApplication(input *UpdateApplicationInput) (out
 	return
 }
 
+// UpdateApplicationWithContext is the same as UpdateApplication with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) UpdateApplicationWithContext(ctx context.Context, input *UpdateApplicationInput) (output *ApplicationDescriptionMessage, err error) {
+	req, out := c.UpdateApplicationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateApplication *aws.Operation
 
 // UpdateApplicationVersionRequest generates a request for the UpdateApplicationVersion operation.
@@ -918,6 +1210,17 @@ func (c *ElasticBeanstalk) UpdateApplicationVersion(input *UpdateApplicationVers
 	return
 }
 
+// UpdateApplicationVersionWithContext is the same as UpdateApplicationVersion with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) UpdateApplicationVersionWithContext(ctx context.Context, input *UpdateApplicationVersionInput) (output *ApplicationVersionDescriptionMessage, err error) {
+	req, out := c.UpdateApplicationVersionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateApplicationVersion *aws.Operation
 
 // UpdateConfigurationTemplateRequest generates a request for the UpdateConfigurationTemplate operation.
@@ -956,6 +1259,17 @@ func (c *ElasticBeanstalk) UpdateConfigurationTemplate(input *UpdateConfiguratio
 	return
 }
 
+// UpdateConfigurationTemplateWithContext is the same as UpdateConfigurationTemplate with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) UpdateConfigurationTemplateWithContext(ctx context.Context, input *UpdateConfigurationTemplateInput) (output *ConfigurationSettingsDescription, err error) {
+	req, out := c.UpdateConfigurationTemplateRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateConfigurationTemplate *aws.Operation
 
 // UpdateEnvironmentRequest generates a request for the UpdateEnvironment operation.
@@ -999,6 +1313,17 @@ func (c *ElasticBeanstalk) UpdateEnvironment(input *UpdateEnvironmentInput) (out
 	return
 }
 
+// UpdateEnvironmentWithContext is the same as UpdateEnvironment with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) UpdateEnvironmentWithContext(ctx context.Context, input *UpdateEnvironmentInput) (output *EnvironmentDescription, err error) {
+	req, out := c.UpdateEnvironmentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateEnvironment *aws.Operation
 
 // ValidateConfigurationSettingsRequest generates a request for the ValidateConfigurationSettings operation.
@@ -1036,12 +1361,23 @@ func (c *ElasticBeanstalk) ValidateConfigurationSettings(input *ValidateConfigur
 	return
 }
 
+// ValidateConfigurationSettingsWithContext is the same as ValidateConfigurationSettings with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticBeanstalk) ValidateConfigurationSettingsWithContext(ctx context.Context, input *ValidateConfigurationSettingsInput) (output *ValidateConfigurationSettingsOutput, err error) {
+	req, out := c.ValidateConfigurationSettingsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opValidateConfigurationSettings *aws.Operation
 
 // Describes the properties of an application.
 type ApplicationDescription struct {
 	// The name of the application.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The names of the configuration templates associated with this application.
 	ConfigurationTemplates []*string `type:"list"`
@@ -1053,7 +1389,7 @@ type ApplicationDescription struct {
 	DateUpdated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// User-defined description of the application.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The names of the versions for this application.
 	Versions []*string `type:"list"`
@@ -1080,7 +1416,7 @@ type metadataApplicationDescriptionMessage struct {
 // Describes the properties of an application version.
 type ApplicationVersionDescription struct {
 	// The name of the application associated with this release.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The creation date of the application version.
 	DateCreated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -1089,13 +1425,13 @@ type ApplicationVersionDescription struct {
 	DateUpdated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The description of this application version.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The location where the source bundle is located for this version.
 	SourceBundle *S3Location `type:"structure"`
 
 	// A label uniquely identifying the version for the associated application.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataApplicationVersionDescription `json:"-", xml:"-"`
 }
@@ -1131,7 +1467,7 @@ type metadataAutoScalingGroup struct {
 // Results message indicating whether a CNAME is available.
 type CheckDNSAvailabilityInput struct {
 	// The prefix used when this CNAME is reserved.
-	CNAMEPrefix *string `type:"string" required:"true"`
+	CNAMEPrefix *string `type:"string" min:"4" max:"63" required:"true"`
 
 	metadataCheckDNSAvailabilityInput `json:"-", xml:"-"`
 }
@@ -1153,7 +1489,7 @@ type CheckDNSAvailabilityOutput struct {
 
 	// The fully qualified CNAME to reserve when CreateEnvironment is called with
 	// the provided prefix.
-	FullyQualifiedCNAME *string `type:"string"`
+	FullyQualifiedCNAME *string `type:"string" min:"1" max:"255"`
 
 	metadataCheckDNSAvailabilityOutput `json:"-", xml:"-"`
 }
@@ -1250,7 +1586,7 @@ type ConfigurationOptionDescription struct {
 	// constraints.   List : Values for this option are multiple selections from
 	// the possible values.   Boolean : Values for this option are either true or
 	// false .
-	ValueType *string `type:"string"`
+	ValueType *string `type:"string" enum:"Scalar,List"`
 
 	metadataConfigurationOptionDescription `json:"-", xml:"-"`
 }
@@ -1283,7 +1619,7 @@ type metadataConfigurationOptionSetting struct {
 // Describes the settings for a configuration set.
 type ConfigurationSettingsDescription struct {
 	// The name of the application associated with this configuration set.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The date (in UTC time) when this configuration set was created.
 	DateCreated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -1309,24 +1645,24 @@ type ConfigurationSettingsDescription struct {
 	// environment but is in the process of deploying.   deployed: This is the configuration
 	// that is currently deployed to the associated running environment.   failed:
 	// This is a draft configuration that failed to successfully deploy.
-	DeploymentStatus *string `type:"string"`
+	DeploymentStatus *string `type:"string" enum:"deployed,pending,failed"`
 
 	// Describes this configuration set.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// If not null, the name of the environment for this configuration set.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// A list of the configuration options and their values in this configuration
 	// set.
 	OptionSettings []*ConfigurationOptionSetting `type:"list"`
 
 	// The name of the solution stack this configuration set uses.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// If not null, the name of the configuration template for this configuration
 	// set.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataConfigurationSettingsDescription `json:"-", xml:"-"`
 }
@@ -1341,10 +1677,10 @@ type CreateApplicationInput struct {
 	//
 	//  Constraint: This name must be unique within your account. If the specified
 	// name already exists, the action returns an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// Describes the application.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	metadataCreateApplicationInput `json:"-", xml:"-"`
 }
@@ -1356,7 +1692,7 @@ type metadataCreateApplicationInput struct {
 type CreateApplicationVersionInput struct {
 	// The name of the application. If no application is found with this name, and
 	// AutoCreateApplication is false, returns an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// Determines how the system behaves if the specified application for this version
 	// does not already exist:
@@ -1376,7 +1712,7 @@ type CreateApplicationVersionInput struct {
 	AutoCreateApplication *bool `type:"boolean"`
 
 	// Describes this version.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The Amazon S3 bucket and key that identify the location of the source bundle
 	// for this version.
@@ -1396,7 +1732,7 @@ type CreateApplicationVersionInput struct {
 	// Constraint: Must be unique per application. If an application version already
 	// exists with this label for the specified application, AWS Elastic Beanstalk
 	// returns an InvalidParameterValue error.
-	VersionLabel *string `type:"string" required:"true"`
+	VersionLabel *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateApplicationVersionInput `json:"-", xml:"-"`
 }
@@ -1410,10 +1746,10 @@ type CreateConfigurationTemplateInput struct {
 	// The name of the application to associate with this configuration template.
 	// If no application is found with this name, AWS Elastic Beanstalk returns
 	// an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// Describes this configuration.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The ID of the environment used with this configuration template.
 	EnvironmentID *string `locationName:"EnvironmentId" type:"string"`
@@ -1437,7 +1773,7 @@ type CreateConfigurationTemplateInput struct {
 	//  If a solution stack name is not specified and the source configuration
 	// parameter is specified, AWS Elastic Beanstalk uses the same solution stack
 	// as the source configuration template.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// If specified, AWS Elastic Beanstalk uses the configuration values from the
 	// specified configuration template to create a new configuration.
@@ -1460,7 +1796,7 @@ type CreateConfigurationTemplateInput struct {
 	//
 	// Default: If a configuration template already exists with this name, AWS
 	// Elastic Beanstalk returns an InvalidParameterValue error.
-	TemplateName *string `type:"string" required:"true"`
+	TemplateName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateConfigurationTemplateInput `json:"-", xml:"-"`
 }
@@ -1474,15 +1810,15 @@ type CreateEnvironmentInput struct {
 	//
 	//  If no application is found with this name, CreateEnvironment returns an
 	// InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// If specified, the environment attempts to use this value as the prefix for
 	// the CNAME. If not specified, the CNAME is generated automatically by appending
 	// a random alphanumeric string to the environment name.
-	CNAMEPrefix *string `type:"string"`
+	CNAMEPrefix *string `type:"string" min:"4" max:"63"`
 
 	// Describes this environment.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// A unique name for the deployment environment. Used in the application URL.
 	//
@@ -1493,7 +1829,7 @@ type CreateEnvironmentInput struct {
 	//
 	// Default: If the CNAME parameter is not specified, the environment name becomes
 	// part of the CNAME, and therefore part of the visible URL for your application.
-	EnvironmentName *string `type:"string" required:"true"`
+	EnvironmentName *string `type:"string" min:"4" max:"23" required:"true"`
 
 	// If specified, AWS Elastic Beanstalk sets the specified configuration options
 	// to the requested value in the configuration set for the new environment.
@@ -1513,7 +1849,7 @@ type CreateEnvironmentInput struct {
 	// If you specify both, AWS Elastic Beanstalk returns an InvalidParameterCombination
 	// error. If you do not specify either, AWS Elastic Beanstalk returns a MissingRequiredParameter
 	// error.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// This specifies the tags applied to resources in the environment.
 	Tags []*Tag `type:"list"`
@@ -1526,7 +1862,7 @@ type CreateEnvironmentInput struct {
 	// but not both. If you specify both, AWS Elastic Beanstalk returns an InvalidParameterCombination
 	// error. If you do not specify either, AWS Elastic Beanstalk returns a MissingRequiredParameter
 	// error.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// This specifies the tier to use for creating this environment.
 	Tier *EnvironmentTier `type:"structure"`
@@ -1538,7 +1874,7 @@ type CreateEnvironmentInput struct {
 	//
 	//  Default: If not specified, AWS Elastic Beanstalk attempts to launch the
 	// sample application in the container.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataCreateEnvironmentInput `json:"-", xml:"-"`
 }
@@ -1558,7 +1894,7 @@ type metadataCreateStorageLocationInput struct {
 // Results of a CreateStorageLocationResult call.
 type CreateStorageLocationOutput struct {
 	// The name of the Amazon S3 bucket created.
-	S3Bucket *string `type:"string"`
+	S3Bucket *string `type:"string" max:"255"`
 
 	metadataCreateStorageLocationOutput `json:"-", xml:"-"`
 }
@@ -1570,7 +1906,7 @@ type metadataCreateStorageLocationOutput struct {
 // This documentation target is not reported in the API reference.
 type DeleteApplicationInput struct {
 	// The name of the application to delete.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// When set to true, running environments will be terminated before deleting
 	// the application.
@@ -1594,7 +1930,7 @@ type metadataDeleteApplicationOutput struct {
 // This documentation target is not reported in the API reference.
 type DeleteApplicationVersionInput struct {
 	// The name of the application to delete releases from.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// Indicates whether to delete the associated source bundle from Amazon S3:
 	//
@@ -1604,7 +1940,7 @@ type DeleteApplicationVersionInput struct {
 	DeleteSourceBundle *bool `type:"boolean"`
 
 	// The label of the version to delete.
-	VersionLabel *string `type:"string" required:"true"`
+	VersionLabel *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteApplicationVersionInput `json:"-", xml:"-"`
 }
@@ -1624,10 +1960,10 @@ type metadataDeleteApplicationVersionOutput struct {
 // This documentation target is not reported in the API reference.
 type DeleteConfigurationTemplateInput struct {
 	// The name of the application to delete the configuration template from.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the configuration template to delete.
-	TemplateName *string `type:"string" required:"true"`
+	TemplateName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteConfigurationTemplateInput `json:"-", xml:"-"`
 }
@@ -1647,10 +1983,10 @@ type metadataDeleteConfigurationTemplateOutput struct {
 // This documentation target is not reported in the API reference.
 type DeleteEnvironmentConfigurationInput struct {
 	// The name of the application the environment is associated with.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the environment to delete the draft configuration from.
-	EnvironmentName *string `type:"string" required:"true"`
+	EnvironmentName *string `type:"string" min:"4" max:"23" required:"true"`
 
 	metadataDeleteEnvironmentConfigurationInput `json:"-", xml:"-"`
 }
@@ -1671,7 +2007,7 @@ type metadataDeleteEnvironmentConfigurationOutput struct {
 type DescribeApplicationVersionsInput struct {
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// only include ones that are associated with the specified application.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// If specified, restricts the returned descriptions to only include ones that
 	// have the specified version labels.
@@ -1726,20 +2062,20 @@ type DescribeConfigurationOptionsInput struct {
 	// The name of the application associated with the configuration template or
 	// environment. Only needed if you want to describe the configuration options
 	// associated with either the configuration template or environment.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The name of the environment whose configuration options you want to describe.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// If specified, restricts the descriptions to only the specified options.
 	Options []*OptionSpecification `type:"list"`
 
 	// The name of the solution stack whose configuration options you want to describe.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// The name of the configuration template whose configuration options you want
 	// to describe.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataDescribeConfigurationOptionsInput `json:"-", xml:"-"`
 }
@@ -1754,7 +2090,7 @@ type DescribeConfigurationOptionsOutput struct {
 	Options []*ConfigurationOptionDescription `type:"list"`
 
 	// The name of the solution stack these configuration options belong to.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	metadataDescribeConfigurationOptionsOutput `json:"-", xml:"-"`
 }
@@ -1767,7 +2103,7 @@ type metadataDescribeConfigurationOptionsOutput struct {
 // solution stack or configuration template.
 type DescribeConfigurationSettingsInput struct {
 	// The application for the environment or configuration template.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the environment to describe.
 	//
@@ -1775,7 +2111,7 @@ type DescribeConfigurationSettingsInput struct {
 	// If you specify both, AWS Elastic Beanstalk returns an InvalidParameterCombination
 	// error. If you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The name of the configuration template to describe.
 	//
@@ -1783,7 +2119,7 @@ type DescribeConfigurationSettingsInput struct {
 	// but not both. If you specify both, AWS Elastic Beanstalk returns an InvalidParameterCombination
 	// error. If you do not specify either, AWS Elastic Beanstalk returns a MissingRequiredParameter
 	// error.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataDescribeConfigurationSettingsInput `json:"-", xml:"-"`
 }
@@ -1818,7 +2154,7 @@ type DescribeEnvironmentResourcesInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataDescribeEnvironmentResourcesInput `json:"-", xml:"-"`
 }
@@ -1843,7 +2179,7 @@ type metadataDescribeEnvironmentResourcesOutput struct {
 type DescribeEnvironmentsInput struct {
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// include only those that are associated with this application.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// include only those that have the specified IDs.
@@ -1867,7 +2203,7 @@ type DescribeEnvironmentsInput struct {
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// include only those that are associated with this application version.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataDescribeEnvironmentsInput `json:"-", xml:"-"`
 }
@@ -1892,7 +2228,7 @@ type metadataDescribeEnvironmentsOutput struct {
 type DescribeEventsInput struct {
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// include only those associated with this application.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those that occur up to, but not including, the EndTime.
@@ -1904,11 +2240,11 @@ type DescribeEventsInput struct {
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those associated with this environment.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// Specifies the maximum number of events that can be returned, beginning with
 	// the most recent event.
-	MaxRecords *int64 `type:"integer"`
+	MaxRecords *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Pagination token. If specified, the events return the next batch of results.
 	NextToken *string `type:"string"`
@@ -1919,7 +2255,7 @@ type DescribeEventsInput struct {
 
 	// If specified, limits the events returned from this call to include only those
 	// with the specified severity or higher.
-	Severity *string `type:"string"`
+	Severity *string `type:"string" enum:"TRACE,DEBUG,INFO,WARN,ERROR,FATAL"`
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those that occur on or after this time.
@@ -1927,11 +2263,11 @@ type DescribeEventsInput struct {
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those that are associated with this environment configuration.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those associated with this application version.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataDescribeEventsInput `json:"-", xml:"-"`
 }
@@ -1959,10 +2295,10 @@ type metadataDescribeEventsOutput struct {
 // Describes the properties of an environment.
 type EnvironmentDescription struct {
 	// The name of the application associated with this environment.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The URL to the CNAME for this environment.
-	CNAME *string `type:"string"`
+	CNAME *string `type:"string" min:"1" max:"255"`
 
 	// The creation date for this environment.
 	DateCreated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -1971,7 +2307,7 @@ type EnvironmentDescription struct {
 	DateUpdated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// Describes this environment.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// For load-balanced, autoscaling environments, the URL to the LoadBalancer.
 	// For single-instance environments, the IP address of the instance.
@@ -1981,7 +2317,7 @@ type EnvironmentDescription struct {
 	EnvironmentID *string `locationName:"EnvironmentId" type:"string"`
 
 	// The name of this environment.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// Describes the health status of the environment. AWS Elastic Beanstalk indicates
 	// the failure levels for a running environment:
@@ -2000,13 +2336,13 @@ type EnvironmentDescription struct {
 	//   Grey: Default health for a new environment. The environment is not fully
 	// launched and health checks have not started or health checks are suspended
 	// during an UpdateEnvironment or RestartEnvironement request.    Default: Grey
-	Health *string `type:"string"`
+	Health *string `type:"string" enum:"Green,Yellow,Red,Grey"`
 
 	// The description of the AWS resources used by this environment.
 	Resources *EnvironmentResourcesDescription `type:"structure"`
 
 	// The name of the SolutionStack deployed with this environment.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// The current operational status of the environment:
 	//
@@ -2015,16 +2351,16 @@ type EnvironmentDescription struct {
 	// version.   Ready: Environment is available to have an action performed on
 	// it, such as update or terminate.   Terminating: Environment is in the shut-down
 	// process.   Terminated: Environment is not running.
-	Status *string `type:"string"`
+	Status *string `type:"string" enum:"Launching,Updating,Ready,Terminating,Terminated"`
 
 	// The name of the configuration template used to originally launch this environment.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// Describes the current tier of this environment.
 	Tier *EnvironmentTier `type:"structure"`
 
 	// The application version deployed in this environment.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataEnvironmentDescription `json:"-", xml:"-"`
 }
@@ -2039,7 +2375,7 @@ type EnvironmentInfoDescription struct {
 	EC2InstanceID *string `locationName:"Ec2InstanceId" type:"string"`
 
 	// The type of information retrieved.
-	InfoType *string `type:"string"`
+	InfoType *string `type:"string" enum:"tail"`
 
 	// The retrieved information.
 	Message *string `type:"string"`
@@ -2060,7 +2396,7 @@ type EnvironmentResourceDescription struct {
 	AutoScalingGroups []*AutoScalingGroup `type:"list"`
 
 	// The name of the environment.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The Amazon EC2 instances used by this environment.
 	Instances []*Instance `type:"list"`
@@ -2118,10 +2454,10 @@ type metadataEnvironmentTier struct {
 // Describes an event.
 type EventDescription struct {
 	// The application associated with the event.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The name of the environment associated with this event.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The date when the event occurred.
 	EventDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -2133,13 +2469,13 @@ type EventDescription struct {
 	RequestID *string `locationName:"RequestId" type:"string"`
 
 	// The severity level of this event.
-	Severity *string `type:"string"`
+	Severity *string `type:"string" enum:"TRACE,DEBUG,INFO,WARN,ERROR,FATAL"`
 
 	// The name of the configuration associated with this event.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// The release label for the application version associated with this event.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataEventDescription `json:"-", xml:"-"`
 }
@@ -2300,7 +2636,7 @@ type RebuildEnvironmentInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataRebuildEnvironmentInput `json:"-", xml:"-"`
 }
@@ -2337,10 +2673,10 @@ type RequestEnvironmentInfoInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The type of information to request.
-	InfoType *string `type:"string" required:"true"`
+	InfoType *string `type:"string" enum:"tail" required:"true"`
 
 	metadataRequestEnvironmentInfoInput `json:"-", xml:"-"`
 }
@@ -2370,7 +2706,7 @@ type RestartAppServerInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataRestartAppServerInput `json:"-", xml:"-"`
 }
@@ -2405,10 +2741,10 @@ type RetrieveEnvironmentInfoInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The type of information to retrieve.
-	InfoType *string `type:"string" required:"true"`
+	InfoType *string `type:"string" enum:"tail" required:"true"`
 
 	metadataRetrieveEnvironmentInfoInput `json:"-", xml:"-"`
 }
@@ -2432,10 +2768,10 @@ type metadataRetrieveEnvironmentInfoOutput struct {
 // A specification of a location in Amazon S3.
 type S3Location struct {
 	// The Amazon S3 bucket where the data is located.
-	S3Bucket *string `type:"string"`
+	S3Bucket *string `type:"string" max:"255"`
 
 	// The Amazon S3 key where the data is located.
-	S3Key *string `type:"string"`
+	S3Key *string `type:"string" max:"1024"`
 
 	metadataS3Location `json:"-", xml:"-"`
 }
@@ -2450,7 +2786,7 @@ type SolutionStackDescription struct {
 	PermittedFileTypes []*string `type:"list"`
 
 	// The name of the solution stack.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	metadataSolutionStackDescription `json:"-", xml:"-"`
 }
@@ -2462,10 +2798,10 @@ type metadataSolutionStackDescription struct {
 // A specification for an environment configuration
 type SourceConfiguration struct {
 	// The name of the application associated with the configuration.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The name of the configuration template.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataSourceConfiguration `json:"-", xml:"-"`
 }
@@ -2487,7 +2823,7 @@ type SwapEnvironmentCNAMEsInput struct {
 	//  Condition: You must specify at least the DestinationEnvironmentID or the
 	// DestinationEnvironmentName. You may also specify both. You must specify the
 	// SourceEnvironmentName with the DestinationEnvironmentName.
-	DestinationEnvironmentName *string `type:"string"`
+	DestinationEnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The ID of the source environment.
 	//
@@ -2501,7 +2837,7 @@ type SwapEnvironmentCNAMEsInput struct {
 	//  Condition: You must specify at least the SourceEnvironmentID or the SourceEnvironmentName.
 	// You may also specify both. If you specify the SourceEnvironmentName, you
 	// must specify the DestinationEnvironmentName.
-	SourceEnvironmentName *string `type:"string"`
+	SourceEnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataSwapEnvironmentCNAMEsInput `json:"-", xml:"-"`
 }
@@ -2521,10 +2857,10 @@ type metadataSwapEnvironmentCNAMEsOutput struct {
 // Describes a tag applied to a resource in an environment.
 type Tag struct {
 	// The key of the tag.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"128"`
 
 	// The value of the tag.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"1" max:"256"`
 
 	metadataTag `json:"-", xml:"-"`
 }
@@ -2547,7 +2883,7 @@ type TerminateEnvironmentInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// Indicates whether the associated AWS resources should shut down when the
 	// environment is terminated:
@@ -2592,12 +2928,12 @@ type metadataTrigger struct {
 type UpdateApplicationInput struct {
 	// The name of the application to update. If no such application is found, UpdateApplication
 	// returns an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// A new description for the application.
 	//
 	// Default: If not specified, AWS Elastic Beanstalk does not update the description.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	metadataUpdateApplicationInput `json:"-", xml:"-"`
 }
@@ -2611,16 +2947,16 @@ type UpdateApplicationVersionInput struct {
 	//
 	//  If no application is found with this name, UpdateApplication returns an
 	// InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// A new description for this release.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The name of the version to update.
 	//
 	//  If no application version is found with this label, UpdateApplication returns
 	// an InvalidParameterValue error.
-	VersionLabel *string `type:"string" required:"true"`
+	VersionLabel *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataUpdateApplicationVersionInput `json:"-", xml:"-"`
 }
@@ -2636,10 +2972,10 @@ type UpdateConfigurationTemplateInput struct {
 	//
 	//  If no application is found with this name, UpdateConfigurationTemplate
 	// returns an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// A new description for the configuration.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// A list of configuration option settings to update with the new specified
 	// option value.
@@ -2654,7 +2990,7 @@ type UpdateConfigurationTemplateInput struct {
 	//
 	//  If no configuration template is found with this name, UpdateConfigurationTemplate
 	// returns an InvalidParameterValue error.
-	TemplateName *string `type:"string" required:"true"`
+	TemplateName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataUpdateConfigurationTemplateInput `json:"-", xml:"-"`
 }
@@ -2667,7 +3003,7 @@ type metadataUpdateConfigurationTemplateInput struct {
 type UpdateEnvironmentInput struct {
 	// If this parameter is specified, AWS Elastic Beanstalk updates the description
 	// of this environment.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The ID of the environment to update.
 	//
@@ -2685,7 +3021,7 @@ type UpdateEnvironmentInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// If specified, AWS Elastic Beanstalk updates the configuration set associated
 	// with the running environment and sets the specified configuration options
@@ -2699,7 +3035,7 @@ type UpdateEnvironmentInput struct {
 	// If this parameter is specified, AWS Elastic Beanstalk deploys this configuration
 	// template to the environment. If no such configuration template is found,
 	// AWS Elastic Beanstalk returns an InvalidParameterValue error.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// This specifies the tier to use to update the environment.
 	//
@@ -2711,7 +3047,7 @@ type UpdateEnvironmentInput struct {
 	// If this parameter is specified, AWS Elastic Beanstalk deploys the named application
 	// version to the environment. If no such application version is found, returns
 	// an InvalidParameterValue error.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataUpdateEnvironmentInput `json:"-", xml:"-"`
 }
@@ -2724,12 +3060,12 @@ type metadataUpdateEnvironmentInput struct {
 type ValidateConfigurationSettingsInput struct {
 	// The name of the application that the configuration template or environment
 	// belongs to.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the environment to validate the settings against.
 	//
 	//  Condition: You cannot specify both this and a configuration template name.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// A list of the options and desired values to evaluate.
 	OptionSettings []*ConfigurationOptionSetting `type:"list" required:"true"`
@@ -2737,7 +3073,7 @@ type ValidateConfigurationSettingsInput struct {
 	// The name of the configuration template to validate the settings against.
 	//
 	//  Condition: You cannot specify both this and an environment name.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataValidateConfigurationSettingsInput `json:"-", xml:"-"`
 }
@@ -2777,11 +3113,127 @@ type ValidationMessage struct {
 	//     error: This message indicates that this is not a valid setting for an
 	// option.   warning: This message is providing information you should take
 	// into account.
-	Severity *string `type:"string"`
+	Severity *string `type:"string" enum:"error,warning"`
 
 	metadataValidationMessage `json:"-", xml:"-"`
 }
 
 type metadataValidationMessage struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for ConfigurationDeploymentStatus.
+const (
+	ConfigurationDeploymentStatusDeployed = "deployed"
+	ConfigurationDeploymentStatusPending  = "pending"
+	ConfigurationDeploymentStatusFailed   = "failed"
+)
+
+// ConfigurationDeploymentStatusValues returns the valid values of ConfigurationDeploymentStatus.
+func ConfigurationDeploymentStatusValues() []string {
+	return []string{
+		ConfigurationDeploymentStatusDeployed,
+		ConfigurationDeploymentStatusPending,
+		ConfigurationDeploymentStatusFailed,
+	}
+}
+
+// Enum values for ConfigurationOptionValueType.
+const (
+	ConfigurationOptionValueTypeScalar = "Scalar"
+	ConfigurationOptionValueTypeList   = "List"
+)
+
+// ConfigurationOptionValueTypeValues returns the valid values of ConfigurationOptionValueType.
+func ConfigurationOptionValueTypeValues() []string {
+	return []string{
+		ConfigurationOptionValueTypeScalar,
+		ConfigurationOptionValueTypeList,
+	}
+}
+
+// Enum values for EnvironmentHealth.
+const (
+	EnvironmentHealthGreen  = "Green"
+	EnvironmentHealthYellow = "Yellow"
+	EnvironmentHealthRed    = "Red"
+	EnvironmentHealthGrey   = "Grey"
+)
+
+// EnvironmentHealthValues returns the valid values of EnvironmentHealth.
+func EnvironmentHealthValues() []string {
+	return []string{
+		EnvironmentHealthGreen,
+		EnvironmentHealthYellow,
+		EnvironmentHealthRed,
+		EnvironmentHealthGrey,
+	}
+}
+
+// Enum values for EnvironmentInfoType.
+const (
+	EnvironmentInfoTypeTail = "tail"
+)
+
+// EnvironmentInfoTypeValues returns the valid values of EnvironmentInfoType.
+func EnvironmentInfoTypeValues() []string {
+	return []string{
+		EnvironmentInfoTypeTail,
+	}
+}
+
+// Enum values for EnvironmentStatus.
+const (
+	EnvironmentStatusLaunching   = "Launching"
+	EnvironmentStatusUpdating    = "Updating"
+	EnvironmentStatusReady       = "Ready"
+	EnvironmentStatusTerminating = "Terminating"
+	EnvironmentStatusTerminated  = "Terminated"
+)
+
+// EnvironmentStatusValues returns the valid values of EnvironmentStatus.
+func EnvironmentStatusValues() []string {
+	return []string{
+		EnvironmentStatusLaunching,
+		EnvironmentStatusUpdating,
+		EnvironmentStatusReady,
+		EnvironmentStatusTerminating,
+		EnvironmentStatusTerminated,
+	}
+}
+
+// Enum values for EventSeverity.
+const (
+	EventSeverityTrace = "TRACE"
+	EventSeverityDebug = "DEBUG"
+	EventSeverityInfo  = "INFO"
+	EventSeverityWarn  = "WARN"
+	EventSeverityError = "ERROR"
+	EventSeverityFatal = "FATAL"
+)
+
+// EventSeverityValues returns the valid values of EventSeverity.
+func EventSeverityValues() []string {
+	return []string{
+		EventSeverityTrace,
+		EventSeverityDebug,
+		EventSeverityInfo,
+		EventSeverityWarn,
+		EventSeverityError,
+		EventSeverityFatal,
+	}
+}
+
+// Enum values for ValidationSeverity.
+const (
+	ValidationSeverityError   = "error"
+	ValidationSeverityWarning = "warning"
+)
+
+// ValidationSeverityValues returns the valid values of ValidationSeverity.
+func ValidationSeverityValues() []string {
+	return []string{
+		ValidationSeverityError,
+		ValidationSeverityWarning,
+	}
 }
\ No newline at end of file
@@ -27,6 +27,7 @@ func New(config *aws.Config) *ElasticBeanstalk {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "elasticbeanstalk",
 		APIVersion:  "2010-12-01",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package elastictranscoder
 
 import (
+	"context"
 	"sync"
 
 	"github.com/datacratic/aws-sdk-go/aws"
@@ -46,6 +47,17 @@ func (c *ElasticTranscoder) CancelJob(input *CancelJobInput) (output *CancelJobO
 	return
 }
 
+// CancelJobWithContext is the same as CancelJob with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) CancelJobWithContext(ctx context.Context, input *CancelJobInput) (output *CancelJobOutput, err error) {
+	req, out := c.CancelJobRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCancelJob *aws.Operation
 
 // CreateJobRequest generates a request for the CreateJob operation.
@@ -85,6 +97,17 @@ func (c *ElasticTranscoder) CreateJob(input *CreateJobInput) (output *CreateJobR
 	return
 }
 
+// CreateJobWithContext is the same as CreateJob with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) CreateJobWithContext(ctx context.Context, input *CreateJobInput) (output *CreateJobResponse, err error) {
+	req, out := c.CreateJobRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateJob *aws.Operation
 
 // CreatePipelineRequest generates a request for the CreatePipeline operation.
@@ -118,6 +141,17 @@ func (c *ElasticTranscoder) CreatePipeline(input *CreatePipelineInput) (output *
 	return
 }
 
+// CreatePipelineWithContext is the same as CreatePipeline with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) CreatePipelineWithContext(ctx context.Context, input *CreatePipelineInput) (output *CreatePipelineOutput, err error) {
+	req, out := c.CreatePipelineRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreatePipeline *aws.Operation
 
 // CreatePresetRequest generates a request for the CreatePreset operation.
@@ -165,6 +199,17 @@ func (c *ElasticTranscoder) CreatePreset(input *CreatePresetInput) (output *Crea
 	return
 }
 
+// CreatePresetWithContext is the same as CreatePreset with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) CreatePresetWithContext(ctx context.Context, input *CreatePresetInput) (output *CreatePresetOutput, err error) {
+	req, out := c.CreatePresetRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreatePreset *aws.Operation
 
 // DeletePipelineRequest generates a request for the DeletePipeline operation.
@@ -202,6 +247,17 @@ func (c *ElasticTranscoder) DeletePipeline(input *DeletePipelineInput) (output *
 	return
 }
 
+// DeletePipelineWithContext is the same as DeletePipeline with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) DeletePipelineWithContext(ctx context.Context, input *DeletePipelineInput) (output *DeletePipelineOutput, err error) {
+	req, out := c.DeletePipelineRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeletePipeline *aws.Operation
 
 // DeletePresetRequest generates a request for the DeletePreset operation.
@@ -237,6 +293,17 @@ func (c *ElasticTranscoder) DeletePreset(input *DeletePresetInput) (output *Dele
 	return
 }
 
+// DeletePresetWithContext is the same as DeletePreset with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) DeletePresetWithContext(ctx context.Context, input *DeletePresetInput) (output *DeletePresetOutput, err error) {
+	req, out := c.DeletePresetRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeletePreset *aws.Operation
 
 // ListJobsByPipelineRequest generates a request for the ListJobsByPipeline operation.
@@ -249,6 +316,12 @@ func (c *ElasticTranscoder) ListJobsByPipelineRequest(input *ListJobsByPipelineI
 			Name:       "ListJobsByPipeline",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2012-09-25/jobsByPipeline/{PipelineId}",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"PageToken"},
+				OutputTokens:    []string{"NextPageToken"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -274,6 +347,27 @@ func (c *ElasticTranscoder) ListJobsByPipeline(input *ListJobsByPipelineInput) (
 	return
 }
 
+// ListJobsByPipelineWithContext is the same as ListJobsByPipeline with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) ListJobsByPipelineWithContext(ctx context.Context, input *ListJobsByPipelineInput) (output *ListJobsByPipelineOutput, err error) {
+	req, out := c.ListJobsByPipelineRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListJobsByPipelinePages iterates over the pages of a ListJobsByPipeline operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElasticTranscoder) ListJobsByPipelinePages(input *ListJobsByPipelineInput, fn func(p *ListJobsByPipelineOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListJobsByPipelineRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListJobsByPipelineOutput), lastPage)
+	})
+}
+
 var opListJobsByPipeline *aws.Operation
 
 // ListJobsByStatusRequest generates a request for the ListJobsByStatus operation.
@@ -286,6 +380,12 @@ func (c *ElasticTranscoder) ListJobsByStatusRequest(input *ListJobsByStatusInput
 			Name:       "ListJobsByStatus",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2012-09-25/jobsByStatus/{Status}",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"PageToken"},
+				OutputTokens:    []string{"NextPageToken"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -309,6 +409,27 @@ func (c *ElasticTranscoder) ListJobsByStatus(input *ListJobsByStatusInput) (outp
 	return
 }
 
+// ListJobsByStatusWithContext is the same as ListJobsByStatus with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) ListJobsByStatusWithContext(ctx context.Context, input *ListJobsByStatusInput) (output *ListJobsByStatusOutput, err error) {
+	req, out := c.ListJobsByStatusRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListJobsByStatusPages iterates over the pages of a ListJobsByStatus operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElasticTranscoder) ListJobsByStatusPages(input *ListJobsByStatusInput, fn func(p *ListJobsByStatusOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListJobsByStatusRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListJobsByStatusOutput), lastPage)
+	})
+}
+
 var opListJobsByStatus *aws.Operation
 
 // ListPipelinesRequest generates a request for the ListPipelines operation.
@@ -321,6 +442,12 @@ func (c *ElasticTranscoder) ListPipelinesRequest(input *ListPipelinesInput) (req
 			Name:       "ListPipelines",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2012-09-25/pipelines",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"PageToken"},
+				OutputTokens:    []string{"NextPageToken"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -343,6 +470,27 @@ func (c *ElasticTranscoder) ListPipelines(input *ListPipelinesInput) (output *Li
 	return
 }
 
+// ListPipelinesWithContext is the same as ListPipelines with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) ListPipelinesWithContext(ctx context.Context, input *ListPipelinesInput) (output *ListPipelinesOutput, err error) {
+	req, out := c.ListPipelinesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListPipelinesPages iterates over the pages of a ListPipelines operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElasticTranscoder) ListPipelinesPages(input *ListPipelinesInput, fn func(p *ListPipelinesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListPipelinesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListPipelinesOutput), lastPage)
+	})
+}
+
 var opListPipelines *aws.Operation
 
 // ListPresetsRequest generates a request for the ListPresets operation.
@@ -355,6 +503,12 @@ func (c *ElasticTranscoder) ListPresetsRequest(input *ListPresetsInput) (req *aw
 			Name:       "ListPresets",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2012-09-25/presets",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"PageToken"},
+				OutputTokens:    []string{"NextPageToken"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -377,6 +531,27 @@ func (c *ElasticTranscoder) ListPresets(input *ListPresetsInput) (output *ListPr
 	return
 }
 
+// ListPresetsWithContext is the same as ListPresets with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) ListPresetsWithContext(ctx context.Context, input *ListPresetsInput) (output *ListPresetsOutput, err error) {
+	req, out := c.ListPresetsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListPresetsPages iterates over the pages of a ListPresets operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ElasticTranscoder) ListPresetsPages(input *ListPresetsInput, fn func(p *ListPresetsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListPresetsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListPresetsOutput), lastPage)
+	})
+}
+
 var opListPresets *aws.Operation
 
 // ReadJobRequest generates a request for the ReadJob operation.
@@ -410,6 +585,17 @@ func (c *ElasticTranscoder) ReadJob(input *ReadJobInput) (output *ReadJobOutput,
 	return
 }
 
+// ReadJobWithContext is the same as ReadJob with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) ReadJobWithContext(ctx context.Context, input *ReadJobInput) (output *ReadJobOutput, err error) {
+	req, out := c.ReadJobRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opReadJob *aws.Operation
 
 // ReadPipelineRequest generates a request for the ReadPipeline operation.
@@ -443,6 +629,17 @@ func (c *ElasticTranscoder) ReadPipeline(input *ReadPipelineInput) (output *Read
 	return
 }
 
+// ReadPipelineWithContext is the same as ReadPipeline with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) ReadPipelineWithContext(ctx context.Context, input *ReadPipelineInput) (output *ReadPipelineOutput, err error) {
+	req, out := c.ReadPipelineRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opReadPipeline *aws.Operation
 
 // ReadPresetRequest generates a request for the ReadPreset operation.
@@ -476,6 +673,17 @@ func (c *ElasticTranscoder) ReadPreset(input *ReadPresetInput) (output *ReadPres
 	return
 }
 
+// ReadPresetWithContext is the same as ReadPreset with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) ReadPresetWithContext(ctx context.Context, input *ReadPresetInput) (output *ReadPresetOutput, err error) {
+	req, out := c.ReadPresetRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opReadPreset *aws.Operation
 
 // TestRoleRequest generates a request for the TestRole operation.
@@ -515,6 +723,17 @@ func (c *ElasticTranscoder) TestRole(input *TestRoleInput) (output *TestRoleOutp
 	return
 }
 
+// TestRoleWithContext is the same as TestRole with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) TestRoleWithContext(ctx context.Context, input *TestRoleInput) (output *TestRoleOutput, err error) {
+	req, out := c.TestRoleRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opTestRole *aws.Operation
 
 // UpdatePipelineRequest generates a request for the UpdatePipeline operation.
@@ -552,6 +771,17 @@ func (c *ElasticTranscoder) UpdatePipeline(input *UpdatePipelineInput) (output *
 	return
 }
 
+// UpdatePipelineWithContext is the same as UpdatePipeline with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) UpdatePipelineWithContext(ctx context.Context, input *UpdatePipelineInput) (output *UpdatePipelineOutput, err error) {
+	req, out := c.UpdatePipelineRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdatePipeline *aws.Operation
 
 // UpdatePipelineNotificationsRequest generates a request for the UpdatePipelineNotifications operation.
@@ -589,6 +819,17 @@ func (c *ElasticTranscoder) UpdatePipelineNotifications(input *UpdatePipelineNot
 	return
 }
 
+// UpdatePipelineNotificationsWithContext is the same as UpdatePipelineNotifications with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) UpdatePipelineNotificationsWithContext(ctx context.Context, input *UpdatePipelineNotificationsInput) (output *UpdatePipelineNotificationsOutput, err error) {
+	req, out := c.UpdatePipelineNotificationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdatePipelineNotifications *aws.Operation
 
 // UpdatePipelineStatusRequest generates a request for the UpdatePipelineStatus operation.
@@ -629,6 +870,17 @@ func (c *ElasticTranscoder) UpdatePipelineStatus(input *UpdatePipelineStatusInpu
 	return
 }
 
+// UpdatePipelineStatusWithContext is the same as UpdatePipelineStatus with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ElasticTranscoder) UpdatePipelineStatusWithContext(ctx context.Context, input *UpdatePipelineStatusInput) (output *UpdatePipelineStatusOutput, err error) {
+	req, out := c.UpdatePipelineStatusRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdatePipelineStatus *aws.Operation
 
 // The file to be used as album art. There can be multiple artworks associated
@@ -641,7 +893,7 @@ var opUpdatePipelineStatus *aws.Operation
 // "Append", or "Fallback", and use an empty Artwork array.
 type Artwork struct {
 	// The format of album art, if any. Valid formats are .jpg and .png.
-	AlbumArtFormat *string `type:"string"`
+	AlbumArtFormat *string `type:"string" pattern:"(^jpg$)|(^png$)"`
 
 	// The encryption settings, if any, that you want Elastic Transcoder to apply
 	// to your artwork.
@@ -655,23 +907,23 @@ type Artwork struct {
 	// If the file name includes a prefix, for example, cooking/pie.jpg, include
 	// the prefix in the key. If the file isn't in the specified bucket, Elastic
 	// Transcoder returns an error.
-	InputKey *string `type:"string"`
+	InputKey *string `type:"string" min:"1" max:"255" pattern:"(^.{1,}.jpg$)|(^.{1,}.jpeg$)|(^.{1,}.png$)"`
 
 	// The maximum height of the output album art in pixels. If you specify auto,
 	// Elastic Transcoder uses 600 as the default value. If you specify a numeric
 	// value, enter an even integer between 32 and 3072, inclusive.
-	MaxHeight *string `type:"string"`
+	MaxHeight *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// The maximum width of the output album art in pixels. If you specify auto,
 	// Elastic Transcoder uses 600 as the default value. If you specify a numeric
 	// value, enter an even integer between 32 and 4096, inclusive.
-	MaxWidth *string `type:"string"`
+	MaxWidth *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// When you set PaddingPolicy to Pad, Elastic Transcoder may add white bars
 	// to the top and bottom and/or left and right sides of the output album art
 	// to make the total size of the output art match the values that you specified
 	// for MaxWidth and MaxHeight.
-	PaddingPolicy *string `type:"string"`
+	PaddingPolicy *string `type:"string" pattern:"(^Pad$)|(^NoPad$)"`
 
 	// Specify one of the following values to control scaling of the output album
 	// art:
@@ -695,7 +947,7 @@ type Artwork struct {
 	// that its dimensions match the values that you specified for at least one
 	// of MaxWidth and MaxHeight without dropping below either value. If you specify
 	// this option, Elastic Transcoder does not scale the art up.
-	SizingPolicy *string `type:"string"`
+	SizingPolicy *string `type:"string" pattern:"(^Fit$)|(^Fill$)|(^Stretch$)|(^Keep$)|(^ShrinkToFit$)|(^ShrinkToFill$)"`
 
 	metadataArtwork `json:"-", xml:"-"`
 }
@@ -723,7 +975,7 @@ type AudioCodecOptions struct {
 	// If you created any presets before AAC profiles were added, Elastic Transcoder
 	// automatically updated your presets to use AAC-LC. You can change the value
 	// as required.
-	Profile *string `type:"string"`
+	Profile *string `type:"string" pattern:"(^auto$)|(^AAC-LC$)|(^HE-AAC$)|(^HE-AACv2$)"`
 
 	metadataAudioCodecOptions `json:"-", xml:"-"`
 }
@@ -736,7 +988,7 @@ type metadataAudioCodecOptions struct {
 type AudioParameters struct {
 	// The bit rate of the audio stream in the output file, in kilobits/second.
 	// Enter an integer between 64 and 320, inclusive.
-	BitRate *string `type:"string"`
+	BitRate *string `type:"string" pattern:"^\\d{1,3}$"`
 
 	// The number of audio channels in the output file. Valid values include:
 	//
@@ -744,11 +996,11 @@ type AudioParameters struct {
 	//
 	// If you specify auto, Elastic Transcoder automatically detects the number
 	// of channels in the input file.
-	Channels *string `type:"string"`
+	Channels *string `type:"string" pattern:"(^auto$)|(^0$)|(^1$)|(^2$)"`
 
 	// The audio codec for the output file. Valid values include aac, mp2, mp3,
 	// and vorbis.
-	Codec *string `type:"string"`
+	Codec *string `type:"string" pattern:"(^AAC$)|(^vorbis$)|(^mp3$)|(^mp2$)"`
 
 	// If you specified AAC for Audio:Codec, this is the AAC compression profile
 	// to use. Valid values include:
@@ -766,7 +1018,7 @@ type AudioParameters struct {
 	//
 	// If you specify auto, Elastic Transcoder automatically detects the sample
 	// rate.
-	SampleRate *string `type:"string"`
+	SampleRate *string `type:"string" pattern:"(^auto$)|(^22050$)|(^32000$)|(^44100$)|(^48000$)|(^96000$)"`
 
 	metadataAudioParameters `json:"-", xml:"-"`
 }
@@ -781,7 +1033,7 @@ type CancelJobInput struct {
 	//
 	// To get a list of the jobs (including their jobId) that have a status of
 	// Submitted, use the ListJobsByStatus API action.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataCancelJobInput `json:"-", xml:"-"`
 }
@@ -831,7 +1083,7 @@ type CaptionFormat struct {
 	// Non-FMP4 outputs: All sidecar types
 	//
 	//  fmp4 captions have an extension of .ismt
-	Format *string `type:"string"`
+	Format *string `type:"string" pattern:"(^mov-text$)|(^srt$)|(^scc$)|(^webvtt$)|(^dfxp$)"`
 
 	// The prefix for caption filenames, in the form description-{language}, where:
 	//
@@ -845,7 +1097,7 @@ type CaptionFormat struct {
 	// For example, suppose you're transcoding into srt format. When you enter
 	// "Sydney-{language}-sunrise", and the language of the captions is English
 	// (en), the name of the first caption file will be Sydney-en-sunrise00000.srt.
-	Pattern *string `type:"string"`
+	Pattern *string `type:"string" pattern:"(^$)|(^.*\\{language\\}.*$)"`
 
 	metadataCaptionFormat `json:"-", xml:"-"`
 }
@@ -863,12 +1115,12 @@ type CaptionSource struct {
 
 	// The name of the sidecar caption file that you want Elastic Transcoder to
 	// include in the output file.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"255"`
 
 	// The label of the caption shown in the player when choosing a language. We
 	// recommend that you put the caption language name here, in the language of
 	// the captions.
-	Label *string `type:"string"`
+	Label *string `type:"string" min:"1" max:"40"`
 
 	// A string that specifies the language of the caption. Specify this as one
 	// of:
@@ -879,14 +1131,14 @@ type CaptionSource struct {
 	//
 	//  For more information on ISO language codes and language names, see the
 	// List of ISO 639-1 codes.
-	Language *string `type:"string"`
+	Language *string `type:"string" min:"1" max:"255"`
 
 	// For clip generation or captions that do not start at the same time as the
 	// associated video file, the TimeOffset tells Elastic Transcoder how much of
 	// the video to encode before including captions.
 	//
 	// Specify the TimeOffset in the form [+-]SS.sss or [+-]HH:mm:SS.ss.
-	TimeOffset *string `type:"string"`
+	TimeOffset *string `type:"string" pattern:"(^[+-]?\\d{1,5}(\\.\\d{0,3})?$)|(^[+-]?([0-1]?[0-9]:|2[0-3]:)?([0-5]?[0-9]:)?[0-5]?[0-9](\\.\\d{0,3})?$)"`
 
 	metadataCaptionSource `json:"-", xml:"-"`
 }
@@ -899,11 +1151,11 @@ type metadataCaptionSource struct {
 type Captions struct {
 	// The array of file formats for the output captions. If you leave this value
 	// blank, Elastic Transcoder returns an error.
-	CaptionFormats []*CaptionFormat `type:"list"`
+	CaptionFormats []*CaptionFormat `type:"list" max:"4"`
 
 	// Source files for the input sidecar captions used during the transcoding process.
 	// To omit all sidecar captions, leave CaptionSources blank.
-	CaptionSources []*CaptionSource `type:"list"`
+	CaptionSources []*CaptionSource `type:"list" max:"20"`
 
 	// A policy that determines how Elastic Transcoder handles the existence of
 	// multiple captions.
@@ -923,7 +1175,7 @@ type Captions struct {
 	// specify in CaptionSources.
 	//
 	//  MergePolicy cannot be null.
-	MergePolicy *string `type:"string"`
+	MergePolicy *string `type:"string" pattern:"(^MergeOverride$)|(^MergeRetain$)|(^Override$)"`
 
 	metadataCaptions `json:"-", xml:"-"`
 }
@@ -957,25 +1209,25 @@ type CreateJobInput struct {
 	// The value, if any, that you want Elastic Transcoder to prepend to the names
 	// of all files that this job creates, including output files, thumbnails, and
 	// playlists.
-	OutputKeyPrefix *string `type:"string"`
+	OutputKeyPrefix *string `type:"string" min:"1" max:"255"`
 
 	// A section of the request body that provides information about the transcoded
 	// (target) files. We recommend that you use the Outputs syntax instead of the
 	// Output syntax.
-	Outputs []*CreateJobOutput `type:"list"`
+	Outputs []*CreateJobOutput `type:"list" max:"30"`
 
 	// The Id of the pipeline that you want Elastic Transcoder to use for transcoding.
 	// The pipeline determines several settings, including the Amazon S3 bucket
 	// from which Elastic Transcoder gets the files to transcode and the bucket
 	// into which Elastic Transcoder puts the transcoded files.
-	PipelineID *string `locationName:"PipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"PipelineId" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	// If you specify a preset in PresetId for which the value of Container is fmp4
 	// (Fragmented MP4) or ts (MPEG-TS), Playlists contains information about the
 	// master playlists that you want Elastic Transcoder to create.
 	//
 	// The maximum number of master playlists in a job is 30.
-	Playlists []*CreateJobPlaylist `type:"list"`
+	Playlists []*CreateJobPlaylist `type:"list" max:"30"`
 
 	// User-defined metadata that you want to associate with an Elastic Transcoder
 	// job. You specify metadata in key/value pairs, and you can add up to 10 key/value
@@ -1059,17 +1311,17 @@ type CreateJobOutput struct {
 	// in the Amazon S3 bucket specified by the OutputBucket object in the pipeline
 	// that is specified by the pipeline ID. If a file with the specified name already
 	// exists in the output bucket, the job fails.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"255"`
 
 	// The Id of the preset to use for this job. The preset determines the audio,
 	// video, and thumbnail settings that Elastic Transcoder uses for transcoding.
-	PresetID *string `locationName:"PresetId" type:"string"`
+	PresetID *string `locationName:"PresetId" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// The number of degrees clockwise by which you want Elastic Transcoder to rotate
 	// the output relative to the input. Enter one of the following values: auto,
 	// 0, 90, 180, 270. The value auto generally works only if the file that you're
 	// transcoding contains rotation metadata.
-	Rotate *string `type:"string"`
+	Rotate *string `type:"string" pattern:"(^auto$)|(^0$)|(^90$)|(^180$)|(^270$)"`
 
 	// (Outputs in Fragmented MP4 or MPEG-TS format only.If you specify a preset
 	// in PresetId for which the value of Container is fmp4 (Fragmented MP4) or
@@ -1087,7 +1339,7 @@ type CreateJobOutput struct {
 	// Elastic Transcoder creates an output-specific playlist for each output HLS
 	// output that you specify in OutputKeys. To add an output to the master playlist
 	// for this job, include it in the OutputKeys of the associated playlist.
-	SegmentDuration *string `type:"string"`
+	SegmentDuration *string `type:"string" pattern:"^\\d{1,5}(\\.\\d{0,5})?$"`
 
 	// The encryption settings, if any, that you want Elastic Transcoder to apply
 	// to your thumbnail.
@@ -1122,7 +1374,7 @@ type CreateJobOutput struct {
 	// in the format (.jpg or .png) that appears in the preset that you specified
 	// in the PresetID value of CreateJobOutput. Elastic Transcoder also appends
 	// the applicable file name extension.
-	ThumbnailPattern *string `type:"string"`
+	ThumbnailPattern *string `type:"string" pattern:"(^$)|(^.*\\{count\\}.*$)"`
 
 	// Information about the watermarks that you want Elastic Transcoder to add
 	// to the video during transcoding. You can specify up to four watermarks for
@@ -1141,7 +1393,7 @@ type metadataCreateJobOutput struct {
 type CreateJobPlaylist struct {
 	// The format of the output playlist. Valid formats include HLSv3, HLSv4, and
 	// Smooth.
-	Format *string `type:"string"`
+	Format *string `type:"string" pattern:"(^HLSv3$)|(^HLSv4$)|(^Smooth$)"`
 
 	// The HLS content protection settings, if any, that you want Elastic Transcoder
 	// to apply to the output files associated with this playlist.
@@ -1157,7 +1409,7 @@ type CreateJobPlaylist struct {
 	// to the file name (.m3u8 for HLSv3 and HLSv4 playlists, and .ism and .ismc
 	// for Smooth playlists). If you include a file extension in Name, the file
 	// name will have two extensions.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"255"`
 
 	// For each output in this job that you want to include in a master playlist,
 	// the value of the Outputs:Key object.
@@ -1196,7 +1448,7 @@ type CreateJobPlaylist struct {
 	// in the playlist. For Smooth playlists, the Audio:Profile, Video:Profile,
 	// and Video:FrameRate to Video:KeyframesMaxDist ratio must be the same for
 	// all outputs.
-	OutputKeys []*string `type:"list"`
+	OutputKeys []*string `type:"list" max:"30"`
 
 	metadataCreateJobPlaylist `json:"-", xml:"-"`
 }
@@ -1228,7 +1480,7 @@ type CreatePipelineInput struct {
 	// key, is created for you automatically. You need to provide an AWS-KMS key
 	// only if you want to use a non-default AWS-KMS key, or if you are using an
 	// Encryption:Mode of AES-PKCS7, AES-CTR, or AES-GCM.
-	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string"`
+	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string" min:"0" max:"255"`
 
 	// The optional ContentConfig object specifies information about the Amazon
 	// S3 bucket in which you want Elastic Transcoder to save transcoded files and
@@ -1279,13 +1531,13 @@ type CreatePipelineInput struct {
 
 	// The Amazon S3 bucket in which you saved the media files that you want to
 	// transcode.
-	InputBucket *string `type:"string" required:"true"`
+	InputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$" required:"true"`
 
 	// The name of the pipeline. We recommend that the name be unique within the
 	// AWS account, but uniqueness is not enforced.
 	//
 	// Constraints: Maximum 40 characters.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"40" required:"true"`
 
 	// The Amazon Simple Notification Service (Amazon SNS) topic that you want to
 	// notify to report job status.
@@ -1324,11 +1576,11 @@ type CreatePipelineInput struct {
 	// in another bucket, specify which users can access the transcoded files or
 	// the permissions the users have, or change the Amazon S3 storage class, omit
 	// OutputBucket and specify values for ContentConfig and ThumbnailConfig instead.
-	OutputBucket *string `type:"string"`
+	OutputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$"`
 
 	// The IAM Amazon Resource Name (ARN) for the role that you want Elastic Transcoder
 	// to use to create the pipeline.
-	Role *string `type:"string" required:"true"`
+	Role *string `type:"string" pattern:"^arn:aws:iam::\\w{12}:role/.+$" required:"true"`
 
 	// The ThumbnailConfig object specifies several values, including the Amazon
 	// S3 bucket in which you want Elastic Transcoder to save thumbnail files, which
@@ -1407,14 +1659,14 @@ type CreatePresetInput struct {
 
 	// The container type for the output file. Valid values include flv, fmp4, gif,
 	// mp3, mp4, mpg, ogg, ts, and webm.
-	Container *string `type:"string" required:"true"`
+	Container *string `type:"string" pattern:"(^mp4$)|(^ts$)|(^webm$)|(^mp3$)|(^ogg$)|(^fmp4$)|(^mpg$)|(^flv$)|(^gif$)" required:"true"`
 
 	// A description of the preset.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"255"`
 
 	// The name of the preset. We recommend that the name be unique within the AWS
 	// account, but uniqueness is not enforced.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"40" required:"true"`
 
 	// A section of the request body that specifies the thumbnail parameters, if
 	// any.
@@ -1452,7 +1704,7 @@ type metadataCreatePresetOutput struct {
 // The DeletePipelineRequest structure.
 type DeletePipelineInput struct {
 	// The identifier of the pipeline that you want to delete.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataDeletePipelineInput `json:"-", xml:"-"`
 }
@@ -1473,7 +1725,7 @@ type metadataDeletePipelineOutput struct {
 // The DeletePresetRequest structure.
 type DeletePresetInput struct {
 	// The identifier of the preset for which you want to get detailed information.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataDeletePresetInput `json:"-", xml:"-"`
 }
@@ -1502,7 +1754,7 @@ type Encryption struct {
 	// want Elastic Transcoder to use to encrypt your output files. The initialization
 	// vector must be base64-encoded, and it must be exactly 16 bytes long before
 	// being base64-encoded.
-	InitializationVector *string `type:"string"`
+	InitializationVector *string `type:"string" min:"0" max:"255"`
 
 	// The data encryption key that you want Elastic Transcoder to use to encrypt
 	// your output file, or that was used to encrypt your input file. The key must
@@ -1512,14 +1764,14 @@ type Encryption struct {
 	// 128, 192, or 256.
 	//
 	// The key must also be encrypted by using the Amazon Key Management Service.
-	Key *string `type:"string"`
+	Key *string `type:"string" pattern:"^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"`
 
 	// The MD5 digest of the key that you used to encrypt your input file, or that
 	// you want Elastic Transcoder to use to encrypt your output file. Elastic Transcoder
 	// uses the key digest as a checksum to make sure your key was not corrupted
 	// in transit. The key MD5 must be base64-encoded, and it must be exactly 16
 	// bytes long before being base64-encoded.
-	KeyMD5 *string `locationName:"KeyMd5" type:"string"`
+	KeyMD5 *string `locationName:"KeyMd5" type:"string" pattern:"^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"`
 
 	// The specific server-side encryption mode that you want Elastic Transcoder
 	// to use when decrypting your input files or encrypting your output files.
@@ -1554,7 +1806,7 @@ type Encryption struct {
 	// are never stored by AWS; therefore, it is important that you safely manage
 	// your encryption keys. If you lose them, you won't be able to unencrypt your
 	// data.
-	Mode *string `type:"string"`
+	Mode *string `type:"string" pattern:"(^s3$)|(^s3-aws-kms$)|(^aes-cbc-pkcs7$)|(^aes-ctr$)|(^aes-gcm$)"`
 
 	metadataEncryption `json:"-", xml:"-"`
 }
@@ -1573,7 +1825,7 @@ type HLSContentProtection struct {
 	// every encryption operation, that you want Elastic Transcoder to use to encrypt
 	// your output files. The initialization vector must be base64-encoded, and
 	// it must be exactly 16 bytes before being base64-encoded.
-	InitializationVector *string `type:"string"`
+	InitializationVector *string `type:"string" min:"0" max:"255"`
 
 	// If you want Elastic Transcoder to generate a key for you, leave this field
 	// blank.
@@ -1583,7 +1835,7 @@ type HLSContentProtection struct {
 	// bit lengths before being base64-encoded:
 	//
 	// 128, 192, or 256.
-	Key *string `type:"string"`
+	Key *string `type:"string" pattern:"^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"`
 
 	// If Elastic Transcoder is generating your key for you, you must leave this
 	// field blank.
@@ -1592,24 +1844,24 @@ type HLSContentProtection struct {
 	// your output file, and that you want Elastic Transcoder to use as a checksum
 	// to make sure your key was not corrupted in transit. The key MD5 must be base64-encoded,
 	// and it must be exactly 16 bytes before being base64- encoded.
-	KeyMD5 *string `locationName:"KeyMd5" type:"string"`
+	KeyMD5 *string `locationName:"KeyMd5" type:"string" pattern:"^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"`
 
 	// Specify whether you want Elastic Transcoder to write your HLS license key
 	// to an Amazon S3 bucket. If you choose WithVariantPlaylists, LicenseAcquisitionUrl
 	// must be left blank and Elastic Transcoder writes your data key into the same
 	// bucket as the associated playlist.
-	KeyStoragePolicy *string `type:"string"`
+	KeyStoragePolicy *string `type:"string" pattern:"(^NoStore$)|(^WithVariantPlaylists$)"`
 
 	// The location of the license key required to decrypt your HLS playlist. The
 	// URL must be an absolute path, and is referenced in the URI attribute of the
 	// EXT-X-KEY metadata tag in the playlist file.
-	LicenseAcquisitionURL *string `locationName:"LicenseAcquisitionUrl" type:"string"`
+	LicenseAcquisitionURL *string `locationName:"LicenseAcquisitionUrl" type:"string" min:"0" max:"512"`
 
 	// The content protection method for your output. The only valid value is: aes-128.
 	//
 	// This value will be written into the method attribute of the EXT-X-KEY metadata
 	// tag in the output playlist.
-	Method *string `type:"string"`
+	Method *string `type:"string" pattern:"(^aes-128$)"`
 
 	metadataHLSContentProtection `json:"-", xml:"-"`
 }
@@ -1626,7 +1878,7 @@ type Job struct {
 
 	// The identifier that Elastic Transcoder assigned to the job. You use this
 	// value to get settings for the job or to delete the job.
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// A section of the request or response body that provides information about
 	// the file that is being transcoded.
@@ -1645,7 +1897,7 @@ type Job struct {
 	// of all files that this job creates, including output files, thumbnails, and
 	// playlists. We recommend that you add a / or some other delimiter to the end
 	// of the OutputKeyPrefix.
-	OutputKeyPrefix *string `type:"string"`
+	OutputKeyPrefix *string `type:"string" min:"1" max:"255"`
 
 	// Information about the output files. We recommend that you use the Outputs
 	// syntax for all jobs, even when you want Elastic Transcoder to transcode a
@@ -1660,7 +1912,7 @@ type Job struct {
 	// The pipeline determines several settings, including the Amazon S3 bucket
 	// from which Elastic Transcoder gets the files to transcode and the bucket
 	// into which Elastic Transcoder puts the transcoded files.
-	PipelineID *string `locationName:"PipelineId" type:"string"`
+	PipelineID *string `locationName:"PipelineId" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// Outputs in Fragmented MP4 or MPEG-TS format only.If you specify a preset
 	// in PresetId for which the value of Container is fmp4 (Fragmented MP4) or
@@ -1671,7 +1923,7 @@ type Job struct {
 	Playlists []*Playlist `type:"list"`
 
 	// The status of the job: Submitted, Progressing, Complete, Canceled, or Error.
-	Status *string `type:"string"`
+	Status *string `type:"string" pattern:"(^Submitted$)|(^Progressing$)|(^Complete$)|(^Canceled$)|(^Error$)"`
 
 	// User-defined metadata that you want to associate with an Elastic Transcoder
 	// job. You specify metadata in key/value pairs, and you can add up to 10 key/value
@@ -1712,7 +1964,7 @@ type JobAlbumArt struct {
 	// Transcoder will use that artwork for the output. If the original input does
 	// not contain artwork, Elastic Transcoder will use the specified album art
 	// file.
-	MergePolicy *string `type:"string"`
+	MergePolicy *string `type:"string" pattern:"(^Replace$)|(^Prepend$)|(^Append$)|(^Fallback$)"`
 
 	metadataJobAlbumArt `json:"-", xml:"-"`
 }
@@ -1731,7 +1983,7 @@ type JobInput struct {
 	//
 	//  If you specify a value other than auto, Elastic Transcoder disables automatic
 	// detection of the aspect ratio.
-	AspectRatio *string `type:"string"`
+	AspectRatio *string `type:"string" pattern:"(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)"`
 
 	// The container type for the input file. If you want Elastic Transcoder to
 	// automatically detect the container type of the input file, specify auto.
@@ -1740,7 +1992,7 @@ type JobInput struct {
 	//
 	//  3gp, aac, asf, avi, divx, flv, m4a, mkv, mov, mp3, mp4, mpeg, mpeg-ps,
 	// mpeg-ts, mxf, ogg, vob, wav, webm
-	Container *string `type:"string"`
+	Container *string `type:"string" pattern:"(^auto$)|(^3gp$)|(^asf$)|(^avi$)|(^divx$)|(^flv$)|(^mkv$)|(^mov$)|(^mp4$)|(^mpeg$)|(^mpeg-ps$)|(^mpeg-ts$)|(^mxf$)|(^ogg$)|(^ts$)|(^vob$)|(^wav$)|(^webm$)|(^mp3$)|(^m4a$)|(^aac$)"`
 
 	// The encryption settings, if any, that are used for decrypting your input
 	// files. If your input file is encrypted, you must specify the mode that Elastic
@@ -1755,7 +2007,7 @@ type JobInput struct {
 	//
 	// If you specify a value other than auto, Elastic Transcoder disables automatic
 	// detection of the frame rate.
-	FrameRate *string `type:"string"`
+	FrameRate *string `type:"string" pattern:"(^auto$)|(^10$)|(^15$)|(^23.97$)|(^24$)|(^25$)|(^29.97$)|(^30$)|(^50$)|(^60$)"`
 
 	// Whether the input file is interlaced. If you want Elastic Transcoder to automatically
 	// detect whether the input file is interlaced, specify auto. If you want to
@@ -1766,7 +2018,7 @@ type JobInput struct {
 	//
 	// If you specify a value other than auto, Elastic Transcoder disables automatic
 	// detection of interlacing.
-	Interlaced *string `type:"string"`
+	Interlaced *string `type:"string" pattern:"(^auto$)|(^true$)|(^false$)"`
 
 	// The name of the file to transcode. Elsewhere in the body of the JSON block
 	// is the the ID of the pipeline to use for processing the job. The InputBucket
@@ -1776,11 +2028,11 @@ type JobInput struct {
 	// If the file name includes a prefix, such as cooking/lasagna.mpg, include
 	// the prefix in the key. If the file isn't in the specified bucket, Elastic
 	// Transcoder returns an error.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"255"`
 
 	// This value must be auto, which causes Elastic Transcoder to automatically
 	// detect the resolution of the input file.
-	Resolution *string `type:"string"`
+	Resolution *string `type:"string" pattern:"(^auto$)|(^\\d{1,5}x\\d{1,5}$)"`
 
 	metadataJobInput `json:"-", xml:"-"`
 }
@@ -1874,7 +2126,7 @@ type JobOutput struct {
 	// The name to assign to the transcoded file. Elastic Transcoder saves the file
 	// in the Amazon S3 bucket specified by the OutputBucket object in the pipeline
 	// that is specified by the pipeline ID.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"255"`
 
 	// The value of the Id object for the preset that you want to use for this job.
 	// The preset determines the audio, video, and thumbnail settings that Elastic
@@ -1882,7 +2134,7 @@ type JobOutput struct {
 	// the preset ID that Elastic Transcoder returned in the response when you created
 	// the preset. You can also use the Elastic Transcoder system presets, which
 	// you can get with ListPresets.
-	PresetID *string `locationName:"PresetId" type:"string"`
+	PresetID *string `locationName:"PresetId" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// The number of degrees clockwise by which you want Elastic Transcoder to rotate
 	// the output relative to the input. Enter one of the following values:
@@ -1891,7 +2143,7 @@ type JobOutput struct {
 	//
 	//  The value auto generally works only if the file that you're transcoding
 	// contains rotation metadata.
-	Rotate *string `type:"string"`
+	Rotate *string `type:"string" pattern:"(^auto$)|(^0$)|(^90$)|(^180$)|(^270$)"`
 
 	// (Outputs in Fragmented MP4 or MPEG-TS format only.If you specify a preset
 	// in PresetId for which the value of Container is fmp4 (Fragmented MP4) or
@@ -1909,7 +2161,7 @@ type JobOutput struct {
 	// Elastic Transcoder creates an output-specific playlist for each output HLS
 	// output that you specify in OutputKeys. To add an output to the master playlist
 	// for this job, include it in the OutputKeys of the associated playlist.
-	SegmentDuration *string `type:"string"`
+	SegmentDuration *string `type:"string" pattern:"^\\d{1,5}(\\.\\d{0,5})?$"`
 
 	// The status of one output in a job. If you specified only one output for the
 	// job, Outputs:Status is always the same as Job:Status. If you specified more
@@ -1925,10 +2177,10 @@ type JobOutput struct {
 	// is Error, the terminal status for Job:Status is also Error.  The value of
 	// Status is one of the following: Submitted, Progressing, Complete, Canceled,
 	// or Error.
-	Status *string `type:"string"`
+	Status *string `type:"string" pattern:"(^Submitted$)|(^Progressing$)|(^Complete$)|(^Canceled$)|(^Error$)"`
 
 	// Information that further explains Status.
-	StatusDetail *string `type:"string"`
+	StatusDetail *string `type:"string" min:"0" max:"255"`
 
 	// The encryption settings, if any, that you want Elastic Transcoder to apply
 	// to your thumbnail.
@@ -1963,7 +2215,7 @@ type JobOutput struct {
 	// in the format (.jpg or .png) that appears in the preset that you specified
 	// in the PresetID value of CreateJobOutput. Elastic Transcoder also appends
 	// the applicable file name extension.
-	ThumbnailPattern *string `type:"string"`
+	ThumbnailPattern *string `type:"string" pattern:"(^$)|(^.*\\{count\\}.*$)"`
 
 	// Information about the watermarks that you want Elastic Transcoder to add
 	// to the video during transcoding. You can specify up to four watermarks for
@@ -2004,13 +2256,13 @@ type JobWatermark struct {
 	//  If the file name includes a prefix, for example, logos/128x64.png, include
 	// the prefix in the key. If the file isn't in the specified bucket, Elastic
 	// Transcoder returns an error.
-	InputKey *string `type:"string"`
+	InputKey *string `type:"string" min:"1" max:"255" pattern:"(^.{1,}.jpg$)|(^.{1,}.jpeg$)|(^.{1,}.png$)"`
 
 	// The ID of the watermark settings that Elastic Transcoder uses to add watermarks
 	// to the video during transcoding. The settings are in the preset specified
 	// by Preset for the current output. In that preset, the value of Watermarks
 	// Id tells Elastic Transcoder which settings to use.
-	PresetWatermarkID *string `locationName:"PresetWatermarkId" type:"string"`
+	PresetWatermarkID *string `locationName:"PresetWatermarkId" type:"string" min:"1" max:"40"`
 
 	metadataJobWatermark `json:"-", xml:"-"`
 }
@@ -2023,14 +2275,14 @@ type metadataJobWatermark struct {
 type ListJobsByPipelineInput struct {
 	// To list jobs in chronological order by the date and time that they were submitted,
 	// enter true. To list jobs in reverse chronological order, enter false.
-	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
+	Ascending *string `location:"querystring" locationName:"Ascending" type:"string" pattern:"(^true$)|(^false$)"`
 
 	// When Elastic Transcoder returns more than one page of results, use pageToken
 	// in subsequent GET requests to get each successive page of results.
-	PageToken *string `location:"querystring" locationName:"PageToken" type:"string"`
+	PageToken *string `location:"querystring" locationName:"PageToken" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// The ID of the pipeline for which you want to get job information.
-	PipelineID *string `location:"uri" locationName:"PipelineId" type:"string" required:"true"`
+	PipelineID *string `location:"uri" locationName:"PipelineId" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataListJobsByPipelineInput `json:"-", xml:"-"`
 }
@@ -2047,7 +2299,7 @@ type ListJobsByPipelineOutput struct {
 	// A value that you use to access the second and subsequent pages of results,
 	// if any. When the jobs in the specified pipeline fit on one page or when you've
 	// reached the last page of results, the value of NextPageToken is null.
-	NextPageToken *string `type:"string"`
+	NextPageToken *string `type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	metadataListJobsByPipelineOutput `json:"-", xml:"-"`
 }
@@ -2060,16 +2312,16 @@ type metadataListJobsByPipelineOutput struct {
 type ListJobsByStatusInput struct {
 	// To list jobs in chronological order by the date and time that they were submitted,
 	// enter true. To list jobs in reverse chronological order, enter false.
-	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
+	Ascending *string `location:"querystring" locationName:"Ascending" type:"string" pattern:"(^true$)|(^false$)"`
 
 	// When Elastic Transcoder returns more than one page of results, use pageToken
 	// in subsequent GET requests to get each successive page of results.
-	PageToken *string `location:"querystring" locationName:"PageToken" type:"string"`
+	PageToken *string `location:"querystring" locationName:"PageToken" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// To get information about all of the jobs associated with the current AWS
 	// account that have a given status, specify the following status: Submitted,
 	// Progressing, Complete, Canceled, or Error.
-	Status *string `location:"uri" locationName:"Status" type:"string" required:"true"`
+	Status *string `location:"uri" locationName:"Status" type:"string" pattern:"(^Submitted$)|(^Progressing$)|(^Complete$)|(^Canceled$)|(^Error$)" required:"true"`
 
 	metadataListJobsByStatusInput `json:"-", xml:"-"`
 }
@@ -2086,7 +2338,7 @@ type ListJobsByStatusOutput struct {
 	// A value that you use to access the second and subsequent pages of results,
 	// if any. When the jobs in the specified pipeline fit on one page or when you've
 	// reached the last page of results, the value of NextPageToken is null.
-	NextPageToken *string `type:"string"`
+	NextPageToken *string `type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	metadataListJobsByStatusOutput `json:"-", xml:"-"`
 }
@@ -2100,11 +2352,11 @@ type ListPipelinesInput struct {
 	// To list pipelines in chronological order by the date and time that they were
 	// created, enter true. To list pipelines in reverse chronological order, enter
 	// false.
-	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
+	Ascending *string `location:"querystring" locationName:"Ascending" type:"string" pattern:"(^true$)|(^false$)"`
 
 	// When Elastic Transcoder returns more than one page of results, use pageToken
 	// in subsequent GET requests to get each successive page of results.
-	PageToken *string `location:"querystring" locationName:"PageToken" type:"string"`
+	PageToken *string `location:"querystring" locationName:"PageToken" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	metadataListPipelinesInput `json:"-", xml:"-"`
 }
@@ -2118,7 +2370,7 @@ type ListPipelinesOutput struct {
 	// A value that you use to access the second and subsequent pages of results,
 	// if any. When the pipelines fit on one page or when you've reached the last
 	// page of results, the value of NextPageToken is null.
-	NextPageToken *string `type:"string"`
+	NextPageToken *string `type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// An array of Pipeline objects.
 	Pipelines []*Pipeline `type:"list"`
@@ -2135,11 +2387,11 @@ type ListPresetsInput struct {
 	// To list presets in chronological order by the date and time that they were
 	// created, enter true. To list presets in reverse chronological order, enter
 	// false.
-	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
+	Ascending *string `location:"querystring" locationName:"Ascending" type:"string" pattern:"(^true$)|(^false$)"`
 
 	// When Elastic Transcoder returns more than one page of results, use pageToken
 	// in subsequent GET requests to get each successive page of results.
-	PageToken *string `location:"querystring" locationName:"PageToken" type:"string"`
+	PageToken *string `location:"querystring" locationName:"PageToken" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	metadataListPresetsInput `json:"-", xml:"-"`
 }
@@ -2153,7 +2405,7 @@ type ListPresetsOutput struct {
 	// A value that you use to access the second and subsequent pages of results,
 	// if any. When the presets fit on one page or when you've reached the last
 	// page of results, the value of NextPageToken is null.
-	NextPageToken *string `type:"string"`
+	NextPageToken *string `type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// An array of Preset objects.
 	Presets []*Preset `type:"list"`
@@ -2173,19 +2425,19 @@ type metadataListPresetsOutput struct {
 type Notifications struct {
 	// The Amazon SNS topic that you want to notify when Elastic Transcoder has
 	// finished processing the job.
-	Completed *string `type:"string"`
+	Completed *string `type:"string" pattern:"(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)"`
 
 	// The Amazon SNS topic that you want to notify when Elastic Transcoder encounters
 	// an error condition.
-	Error *string `type:"string"`
+	Error *string `type:"string" pattern:"(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)"`
 
 	// The Amazon Simple Notification Service (Amazon SNS) topic that you want to
 	// notify when Elastic Transcoder has started to process the job.
-	Progressing *string `type:"string"`
+	Progressing *string `type:"string" pattern:"(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)"`
 
 	// The Amazon SNS topic that you want to notify when Elastic Transcoder encounters
 	// a warning condition.
-	Warning *string `type:"string"`
+	Warning *string `type:"string" pattern:"(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)"`
 
 	metadataNotifications `json:"-", xml:"-"`
 }
@@ -2204,14 +2456,14 @@ type Permission struct {
 	// the thumbnails that Elastic Transcoder adds to the Amazon S3 bucket.  FULL_CONTROL:
 	// The grantee has READ, READ_ACP, and WRITE_ACP permissions for the thumbnails
 	// that Elastic Transcoder adds to the Amazon S3 bucket.
-	Access []*string `type:"list"`
+	Access []*string `type:"list" max:"30"`
 
 	// The AWS user or group that you want to have access to transcoded files and
 	// playlists. To identify the user or group, you can specify the canonical user
 	// ID for an AWS account, an origin access identity for a CloudFront distribution,
 	// the registered email address of an AWS account, or a predefined Amazon S3
 	// group.
-	Grantee *string `type:"string"`
+	Grantee *string `type:"string" min:"1" max:"255"`
 
 	// The type of value that appears in the Grantee object:   Canonical: Either
 	// the canonical user ID for an AWS account or an origin access identity for
@@ -2219,7 +2471,7 @@ type Permission struct {
 	// an AWS account number.   Email: The registered email address of an AWS account.
 	//  Group: One of the following predefined Amazon S3 groups: AllUsers, AuthenticatedUsers,
 	// or LogDelivery.
-	GranteeType *string `type:"string"`
+	GranteeType *string `type:"string" pattern:"(^Canonical$)|(^Email$)|(^Group$)"`
 
 	metadataPermission `json:"-", xml:"-"`
 }
@@ -2241,7 +2493,7 @@ type Pipeline struct {
 	// key, is created for you automatically. You need to provide an AWS-KMS key
 	// only if you want to use a non-default AWS-KMS key, or if you are using an
 	// Encryption:Mode of AES-PKCS7, AES-CTR, or AES-GCM.
-	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string"`
+	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string" min:"0" max:"255"`
 
 	// Information about the Amazon S3 bucket in which you want Elastic Transcoder
 	// to save transcoded files and playlists. Either you specify both ContentConfig
@@ -2273,17 +2525,17 @@ type Pipeline struct {
 	// The identifier for the pipeline. You use this value to identify the pipeline
 	// in which you want to perform a variety of operations, such as creating a
 	// job or a preset.
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// The Amazon S3 bucket from which Elastic Transcoder gets media files for transcoding
 	// and the graphics files, if any, that you want to use for watermarks.
-	InputBucket *string `type:"string"`
+	InputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$"`
 
 	// The name of the pipeline. We recommend that the name be unique within the
 	// AWS account, but uniqueness is not enforced.
 	//
 	// Constraints: Maximum 40 characters
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"40"`
 
 	// The Amazon Simple Notification Service (Amazon SNS) topic that you want to
 	// notify to report job status.
@@ -2302,17 +2554,17 @@ type Pipeline struct {
 	// The Amazon S3 bucket in which you want Elastic Transcoder to save transcoded
 	// files, thumbnails, and playlists. Either you specify this value, or you specify
 	// both ContentConfig and ThumbnailConfig.
-	OutputBucket *string `type:"string"`
+	OutputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$"`
 
 	// The IAM Amazon Resource Name (ARN) for the role that Elastic Transcoder uses
 	// to transcode jobs for this pipeline.
-	Role *string `type:"string"`
+	Role *string `type:"string" pattern:"^arn:aws:iam::\\w{12}:role/.+$"`
 
 	// The current status of the pipeline:
 	//
 	//   Active: The pipeline is processing jobs.  Paused: The pipeline is not
 	// currently processing jobs.
-	Status *string `type:"string"`
+	Status *string `type:"string" pattern:"(^Active$)|(^Paused$)"`
 
 	// Information about the Amazon S3 bucket in which you want Elastic Transcoder
 	// to save thumbnail files. Either you specify both ContentConfig and ThumbnailConfig,
@@ -2362,7 +2614,7 @@ type PipelineOutputConfig struct {
 	// transcoded files or the permissions the users have, or change the Amazon
 	// S3 storage class, omit OutputBucket and specify values for ContentConfig
 	// and ThumbnailConfig instead.
-	Bucket *string `type:"string"`
+	Bucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$"`
 
 	// Optional. The Permissions object specifies which users and/or predefined
 	// Amazon S3 groups you want to have access to transcoded files and playlists,
@@ -2377,12 +2629,12 @@ type PipelineOutputConfig struct {
 	//  If you omit Permissions, Elastic Transcoder grants full control over the
 	// transcoded files and playlists to the owner of the role specified by Role,
 	// and grants no other permissions to any other user or group.
-	Permissions []*Permission `type:"list"`
+	Permissions []*Permission `type:"list" max:"30"`
 
 	// The Amazon S3 storage class, Standard or ReducedRedundancy, that you want
 	// Elastic Transcoder to assign to the video files and playlists that it stores
 	// in your Amazon S3 bucket.
-	StorageClass *string `type:"string"`
+	StorageClass *string `type:"string" pattern:"(^ReducedRedundancy$)|(^Standard$)"`
 
 	metadataPipelineOutputConfig `json:"-", xml:"-"`
 }
@@ -2399,7 +2651,7 @@ type metadataPipelineOutputConfig struct {
 type Playlist struct {
 	// The format of the output playlist. Valid formats include HLSv3, HLSv4, and
 	// Smooth.
-	Format *string `type:"string"`
+	Format *string `type:"string" pattern:"(^HLSv3$)|(^HLSv4$)|(^Smooth$)"`
 
 	// The HLS content protection settings, if any, that you want Elastic Transcoder
 	// to apply to the output files associated with this playlist.
@@ -2415,7 +2667,7 @@ type Playlist struct {
 	// to the file name (.m3u8 for HLSv3 and HLSv4 playlists, and .ism and .ismc
 	// for Smooth playlists). If you include a file extension in Name, the file
 	// name will have two extensions.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"255"`
 
 	// For each output in this job that you want to include in a master playlist,
 	// the value of the Outputs:Key object.
@@ -2454,13 +2706,13 @@ type Playlist struct {
 	// in the playlist. For Smooth playlists, the Audio:Profile, Video:Profile,
 	// and Video:FrameRate to Video:KeyframesMaxDist ratio must be the same for
 	// all outputs.
-	OutputKeys []*string `type:"list"`
+	OutputKeys []*string `type:"list" max:"30"`
 
 	// The status of the job with which the playlist is associated.
-	Status *string `type:"string"`
+	Status *string `type:"string" pattern:"(^Submitted$)|(^Progressing$)|(^Complete$)|(^Canceled$)|(^Error$)"`
 
 	// Information that further explains the status.
-	StatusDetail *string `type:"string"`
+	StatusDetail *string `type:"string" min:"0" max:"255"`
 
 	metadataPlaylist `json:"-", xml:"-"`
 }
@@ -2485,17 +2737,17 @@ type Preset struct {
 
 	// The container type for the output file. Valid values include flv, fmp4, gif,
 	// mp3, mp4, mpg, ogg, ts, and webm.
-	Container *string `type:"string"`
+	Container *string `type:"string" pattern:"(^mp4$)|(^ts$)|(^webm$)|(^mp3$)|(^ogg$)|(^fmp4$)|(^mpg$)|(^flv$)|(^gif$)"`
 
 	// A description of the preset.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"255"`
 
 	// Identifier for the new preset. You use this value to get settings for the
 	// preset or to delete it.
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// The name of the preset.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"40"`
 
 	// A section of the response body that provides information about the thumbnail
 	// preset values, if any.
@@ -2503,7 +2755,7 @@ type Preset struct {
 
 	// Whether the preset is a default preset provided by Elastic Transcoder (System)
 	// or a preset that you have defined (Custom).
-	Type *string `type:"string"`
+	Type *string `type:"string" pattern:"(^System$)|(^Custom$)"`
 
 	// A section of the response body that provides information about the video
 	// preset values.
@@ -2536,7 +2788,7 @@ type PresetWatermark struct {
 	// the left border of the video.  Right: The right edge of the watermark is
 	// aligned with the right border of the video.  Center: The watermark is centered
 	// between the left and right borders.
-	HorizontalAlign *string `type:"string"`
+	HorizontalAlign *string `type:"string" pattern:"(^Left$)|(^Right$)|(^Center$)"`
 
 	// The amount by which you want the horizontal position of the watermark to
 	// be offset from the position specified by HorizontalAlign:  number of pixels
@@ -2555,11 +2807,11 @@ type PresetWatermark struct {
 	//
 	// Use the value of Target to specify whether you want to include the black
 	// bars that are added by Elastic Transcoder, if any, in the offset calculation.
-	HorizontalOffset *string `type:"string"`
+	HorizontalOffset *string `type:"string" pattern:"(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)"`
 
 	// A unique identifier for the settings for one watermark. The value of Id can
 	// be up to 40 characters long.
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" min:"1" max:"40"`
 
 	// The maximum height of the watermark in one of the following formats:  number
 	// of pixels (px): The minimum value is 16 pixels, and the maximum value is
@@ -2568,7 +2820,7 @@ type PresetWatermark struct {
 	// Transcoder to include the black bars that are added by Elastic Transcoder,
 	// if any, in the calculation.  If you specify the value in pixels, it must
 	// be less than or equal to the value of MaxHeight.
-	MaxHeight *string `type:"string"`
+	MaxHeight *string `type:"string" pattern:"(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)"`
 
 	// The maximum width of the watermark in one of the following formats:  number
 	// of pixels (px): The minimum value is 16 pixels, and the maximum value is
@@ -2577,7 +2829,7 @@ type PresetWatermark struct {
 	// Transcoder to include the black bars that are added by Elastic Transcoder,
 	// if any, in the calculation. If you specify the value in pixels, it must be
 	// less than or equal to the value of MaxWidth.
-	MaxWidth *string `type:"string"`
+	MaxWidth *string `type:"string" pattern:"(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)"`
 
 	// A percentage that indicates how much you want a watermark to obscure the
 	// video in the location where it appears. Valid values are 0 (the watermark
@@ -2587,7 +2839,7 @@ type PresetWatermark struct {
 	// Elastic Transcoder supports transparent .png graphics. If you use a transparent
 	// .png, the transparent portion of the video appears as if you had specified
 	// a value of 0 for Opacity. The .jpg file format doesn't support transparency.
-	Opacity *string `type:"string"`
+	Opacity *string `type:"string" pattern:"^\\d{1,3}(\\.\\d{0,20})?$"`
 
 	// A value that controls scaling of the watermark:   Fit: Elastic Transcoder
 	// scales the watermark so it matches the value that you specified in either
@@ -2599,7 +2851,7 @@ type PresetWatermark struct {
 	// so that its dimensions match the values that you specified for at least one
 	// of MaxWidth and MaxHeight without exceeding either value. If you specify
 	// this option, Elastic Transcoder does not scale the watermark up.
-	SizingPolicy *string `type:"string"`
+	SizingPolicy *string `type:"string" pattern:"(^Fit$)|(^Stretch$)|(^ShrinkToFit$)"`
 
 	// A value that determines how Elastic Transcoder interprets values that you
 	// specified for HorizontalOffset, VerticalOffset, MaxWidth, and MaxHeight:
@@ -2612,14 +2864,14 @@ type PresetWatermark struct {
 	// added by Elastic Transcoder, if any. In addition, MaxWidth and MaxHeight,
 	// if specified as a percentage, are calculated based on the borders of the
 	// video including black bars added by Elastic Transcoder, if any.
-	Target *string `type:"string"`
+	Target *string `type:"string" pattern:"(^Content$)|(^Frame$)"`
 
 	// The vertical position of the watermark unless you specify a non-zero value
 	// for VerticalOffset:   Top: The top edge of the watermark is aligned with
 	// the top border of the video.  Bottom: The bottom edge of the watermark is
 	// aligned with the bottom border of the video.  Center: The watermark is centered
 	// between the top and bottom borders.
-	VerticalAlign *string `type:"string"`
+	VerticalAlign *string `type:"string" pattern:"(^Top$)|(^Bottom$)|(^Center$)"`
 
 	// VerticalOffset The amount by which you want the vertical position of the
 	// watermark to be offset from the position specified by VerticalAlign: number
@@ -2640,7 +2892,7 @@ type PresetWatermark struct {
 	// Use the value of Target to specify whether you want Elastic Transcoder to
 	// include the black bars that are added by Elastic Transcoder, if any, in the
 	// offset calculation.
-	VerticalOffset *string `type:"string"`
+	VerticalOffset *string `type:"string" pattern:"(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)"`
 
 	metadataPresetWatermark `json:"-", xml:"-"`
 }
@@ -2652,7 +2904,7 @@ type metadataPresetWatermark struct {
 // The ReadJobRequest structure.
 type ReadJobInput struct {
 	// The identifier of the job for which you want to get detailed information.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataReadJobInput `json:"-", xml:"-"`
 }
@@ -2676,7 +2928,7 @@ type metadataReadJobOutput struct {
 // The ReadPipelineRequest structure.
 type ReadPipelineInput struct {
 	// The identifier of the pipeline to read.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataReadPipelineInput `json:"-", xml:"-"`
 }
@@ -2708,7 +2960,7 @@ type metadataReadPipelineOutput struct {
 // The ReadPresetRequest structure.
 type ReadPresetInput struct {
 	// The identifier of the preset for which you want to get detailed information.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataReadPresetInput `json:"-", xml:"-"`
 }
@@ -2733,19 +2985,19 @@ type metadataReadPresetOutput struct {
 type TestRoleInput struct {
 	// The Amazon S3 bucket that contains media files to be transcoded. The action
 	// attempts to read from this bucket.
-	InputBucket *string `type:"string" required:"true"`
+	InputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$" required:"true"`
 
 	// The Amazon S3 bucket that Elastic Transcoder will write transcoded media
 	// files to. The action attempts to read from this bucket.
-	OutputBucket *string `type:"string" required:"true"`
+	OutputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$" required:"true"`
 
 	// The IAM Amazon Resource Name (ARN) for the role that you want Elastic Transcoder
 	// to test.
-	Role *string `type:"string" required:"true"`
+	Role *string `type:"string" pattern:"^arn:aws:iam::\\w{12}:role/.+$" required:"true"`
 
 	// The ARNs of one or more Amazon Simple Notification Service (Amazon SNS) topics
 	// that you want the action to send a test notification to.
-	Topics []*string `type:"list" required:"true"`
+	Topics []*string `type:"list" max:"30" required:"true"`
 
 	metadataTestRoleInput `json:"-", xml:"-"`
 }
@@ -2762,7 +3014,7 @@ type TestRoleOutput struct {
 
 	// If the operation is successful, this value is true; otherwise, the value
 	// is false.
-	Success *string `type:"string"`
+	Success *string `type:"string" pattern:"(^true$)|(^false$)"`
 
 	metadataTestRoleOutput `json:"-", xml:"-"`
 }
@@ -2784,33 +3036,33 @@ type Thumbnails struct {
 	//
 	// If you specify auto, Elastic Transcoder tries to preserve the aspect ratio
 	// of the video in the output file.
-	AspectRatio *string `type:"string"`
+	AspectRatio *string `type:"string" pattern:"(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)"`
 
 	// The format of thumbnails, if any. Valid values are jpg and png.
 	//
 	// You specify whether you want Elastic Transcoder to create thumbnails when
 	// you create a job.
-	Format *string `type:"string"`
+	Format *string `type:"string" pattern:"(^jpg$)|(^png$)"`
 
 	// The approximate number of seconds between thumbnails. Specify an integer
 	// value.
-	Interval *string `type:"string"`
+	Interval *string `type:"string" pattern:"^\\d{1,5}$"`
 
 	// The maximum height of thumbnails in pixels. If you specify auto, Elastic
 	// Transcoder uses 1080 (Full HD) as the default value. If you specify a numeric
 	// value, enter an even integer between 32 and 3072.
-	MaxHeight *string `type:"string"`
+	MaxHeight *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// The maximum width of thumbnails in pixels. If you specify auto, Elastic Transcoder
 	// uses 1920 (Full HD) as the default value. If you specify a numeric value,
 	// enter an even integer between 32 and 4096.
-	MaxWidth *string `type:"string"`
+	MaxWidth *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// When you set PaddingPolicy to Pad, Elastic Transcoder may add black bars
 	// to the top and bottom and/or left and right sides of thumbnails to make the
 	// total size of the thumbnails match the values that you specified for thumbnail
 	// MaxWidth and MaxHeight settings.
-	PaddingPolicy *string `type:"string"`
+	PaddingPolicy *string `type:"string" pattern:"(^Pad$)|(^NoPad$)"`
 
 	// To better control resolution and aspect ratio of thumbnails, we recommend
 	// that you use the values MaxWidth, MaxHeight, SizingPolicy, and PaddingPolicy
@@ -2820,7 +3072,7 @@ type Thumbnails struct {
 	//  The width and height of thumbnail files in pixels. Specify a value in the
 	// format width x height where both values are even integers. The values cannot
 	// exceed the width and height that you specified in the Video:Resolution object.
-	Resolution *string `type:"string"`
+	Resolution *string `type:"string" pattern:"^\\d{1,5}x\\d{1,5}$"`
 
 	// Specify one of the following values to control scaling of thumbnails:
 	//
@@ -2844,7 +3096,7 @@ type Thumbnails struct {
 	// the values that you specified for at least one of MaxWidth and MaxHeight
 	// without dropping below either value. If you specify this option, Elastic
 	// Transcoder does not scale thumbnails up.
-	SizingPolicy *string `type:"string"`
+	SizingPolicy *string `type:"string" pattern:"(^Fit$)|(^Fill$)|(^Stretch$)|(^Keep$)|(^ShrinkToFit$)|(^ShrinkToFill$)"`
 
 	metadataThumbnails `json:"-", xml:"-"`
 }
@@ -2862,13 +3114,13 @@ type TimeSpan struct {
 	//
 	// If you specify a value longer than the duration of the input file, Elastic
 	// Transcoder transcodes the file and returns a warning message.
-	Duration *string `type:"string"`
+	Duration *string `type:"string" pattern:"(^\\d{1,5}(\\.\\d{0,3})?$)|(^([0-1]?[0-9]:|2[0-3]:)?([0-5]?[0-9]:)?[0-5]?[0-9](\\.\\d{0,3})?$)"`
 
 	// The place in the input file where you want a clip to start. The format can
 	// be either HH:mm:ss.SSS (maximum value: 23:59:59.999; SSS is thousandths of
 	// a second) or sssss.SSS (maximum value: 86399.999). If you don't specify a
 	// value, Elastic Transcoder starts at the beginning of the input file.
-	StartTime *string `type:"string"`
+	StartTime *string `type:"string" pattern:"(^\\d{1,5}(\\.\\d{0,3})?$)|(^([0-1]?[0-9]:|2[0-3]:)?([0-5]?[0-9]:)?[0-5]?[0-9](\\.\\d{0,3})?$)"`
 
 	metadataTimeSpan `json:"-", xml:"-"`
 }
@@ -2887,7 +3139,7 @@ type UpdatePipelineInput struct {
 	// key, is created for you automatically. You need to provide an AWS-KMS key
 	// only if you want to use a non-default AWS-KMS key, or if you are using an
 	// Encryption:Mode of AES-PKCS7, AES-CTR, or AES-GCM.
-	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string"`
+	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string" min:"0" max:"255"`
 
 	// The optional ContentConfig object specifies information about the Amazon
 	// S3 bucket in which you want Elastic Transcoder to save transcoded files and
@@ -2937,17 +3189,17 @@ type UpdatePipelineInput struct {
 	ContentConfig *PipelineOutputConfig `type:"structure"`
 
 	// The ID of the pipeline that you want to update.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	// The Amazon S3 bucket in which you saved the media files that you want to
 	// transcode and the graphics that you want to use as watermarks.
-	InputBucket *string `type:"string"`
+	InputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$"`
 
 	// The name of the pipeline. We recommend that the name be unique within the
 	// AWS account, but uniqueness is not enforced.
 	//
 	// Constraints: Maximum 40 characters
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"40"`
 
 	// The Amazon Simple Notification Service (Amazon SNS) topic or topics to notify
 	// in order to report job status.
@@ -2958,7 +3210,7 @@ type UpdatePipelineInput struct {
 
 	// The IAM Amazon Resource Name (ARN) for the role that you want Elastic Transcoder
 	// to use to transcode jobs for this pipeline.
-	Role *string `type:"string"`
+	Role *string `type:"string" pattern:"^arn:aws:iam::\\w{12}:role/.+$"`
 
 	// The ThumbnailConfig object specifies several values, including the Amazon
 	// S3 bucket in which you want Elastic Transcoder to save thumbnail files, which
@@ -3012,7 +3264,7 @@ type metadataUpdatePipelineInput struct {
 type UpdatePipelineNotificationsInput struct {
 	// The identifier of the pipeline for which you want to change notification
 	// settings.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	// The topic ARN for the Amazon Simple Notification Service (Amazon SNS) topic
 	// that you want to notify to report job status.
@@ -3075,13 +3327,13 @@ type metadataUpdatePipelineOutput struct {
 // The UpdatePipelineStatusRequest structure.
 type UpdatePipelineStatusInput struct {
 	// The identifier of the pipeline to update.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	// The desired status of the pipeline:
 	//
 	//   Active: The pipeline is processing jobs.  Paused: The pipeline is not
 	// currently processing jobs.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" pattern:"(^Active$)|(^Paused$)" required:"true"`
 
 	metadataUpdatePipelineStatusInput `json:"-", xml:"-"`
 }
@@ -3122,7 +3374,7 @@ type VideoParameters struct {
 	// ratio of the input file, Elastic Transcoder adds pillarboxing (black bars
 	// on the sides) or letterboxing (black bars on the top and bottom) to maintain
 	// the aspect ratio of the active region of the video.
-	AspectRatio *string `type:"string"`
+	AspectRatio *string `type:"string" pattern:"(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)"`
 
 	// The bit rate of the video stream in the output file, in kilobits/second.
 	// Valid values depend on the values of Level and Profile. If you specify auto,
@@ -3137,12 +3389,12 @@ type VideoParameters struct {
 	//  1 - 64 : 80 1b - 128 : 160 1.1 - 192 : 240 1.2 - 384 : 480 1.3 - 768 :
 	// 960 2 - 2000 : 2500 3 - 10000 : 12500 3.1 - 14000 : 17500 3.2 - 20000 : 25000
 	// 4 - 20000 : 25000 4.1 - 50000 : 62500
-	BitRate *string `type:"string"`
+	BitRate *string `type:"string" pattern:"(^\\d{2,5}$)|(^auto$)"`
 
 	// The video codec for the output file. Valid values include gif, H.264, mpeg2,
 	// and vp8. You can only specify vp8 when the container type is webm, gif when
 	// the container type is gif, and mpeg2 when the container type is mpg.
-	Codec *string `type:"string"`
+	Codec *string `type:"string" pattern:"(^H\\.264$)|(^vp8$)|(^mpeg2$)|(^gif$)"`
 
 	// Profile (H.264/VP8 Only)
 	//
@@ -3246,10 +3498,10 @@ type VideoParameters struct {
 	//
 	// The number of times you want the output gif to loop. Valid values include
 	// Infinite and integers between 0 and 100, inclusive.
-	CodecOptions *map[string]*string `type:"map"`
+	CodecOptions *map[string]*string `type:"map" max:"30"`
 
 	// The value that Elastic Transcoder adds to the metadata in the output file.
-	DisplayAspectRatio *string `type:"string"`
+	DisplayAspectRatio *string `type:"string" pattern:"(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)"`
 
 	// Applicable only when the value of Video:Codec is one of H.264, MPEG2, or
 	// VP8.
@@ -3260,7 +3512,7 @@ type VideoParameters struct {
 	// between key frames (the number of frames in a group of pictures, or GOP).
 	//  false: The distance between key frames can vary.  FixedGOP must be set to
 	// true for fmp4 containers.
-	FixedGOP *string `type:"string"`
+	FixedGOP *string `type:"string" pattern:"(^true$)|(^false$)"`
 
 	// The frames per second for the video stream in the output file. Valid values
 	// include:
@@ -3286,7 +3538,7 @@ type VideoParameters struct {
 	//  1 - 380160 1b - 380160 1.1 - 76800 1.2 - 1536000 1.3 - 3041280 2 - 3041280
 	// 2.1 - 5068800 2.2 - 5184000 3 - 10368000 3.1 - 27648000 3.2 - 55296000 4
 	// - 62914560 4.1 - 62914560
-	FrameRate *string `type:"string"`
+	FrameRate *string `type:"string" pattern:"(^auto$)|(^10$)|(^15$)|(^23.97$)|(^24$)|(^25$)|(^29.97$)|(^30$)|(^50$)|(^60$)"`
 
 	// Applicable only when the value of Video:Codec is one of H.264, MPEG2, or
 	// VP8.
@@ -3309,30 +3561,30 @@ type VideoParameters struct {
 	//
 	// Alternately, this can be achieved by setting FrameRate to auto and having
 	// the same values for MaxFrameRate and KeyframesMaxDist.
-	KeyframesMaxDist *string `type:"string"`
+	KeyframesMaxDist *string `type:"string" pattern:"^\\d{1,6}$"`
 
 	// If you specify auto for FrameRate, Elastic Transcoder uses the frame rate
 	// of the input video for the frame rate of the output video. Specify the maximum
 	// frame rate that you want Elastic Transcoder to use when the frame rate of
 	// the input video is greater than the desired maximum frame rate of the output
 	// video. Valid values include: 10, 15, 23.97, 24, 25, 29.97, 30, 60.
-	MaxFrameRate *string `type:"string"`
+	MaxFrameRate *string `type:"string" pattern:"(^10$)|(^15$)|(^23.97$)|(^24$)|(^25$)|(^29.97$)|(^30$)|(^50$)|(^60$)"`
 
 	// The maximum height of the output video in pixels. If you specify auto, Elastic
 	// Transcoder uses 1080 (Full HD) as the default value. If you specify a numeric
 	// value, enter an even integer between 96 and 3072.
-	MaxHeight *string `type:"string"`
+	MaxHeight *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// The maximum width of the output video in pixels. If you specify auto, Elastic
 	// Transcoder uses 1920 (Full HD) as the default value. If you specify a numeric
 	// value, enter an even integer between 128 and 4096.
-	MaxWidth *string `type:"string"`
+	MaxWidth *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// When you set PaddingPolicy to Pad, Elastic Transcoder may add black bars
 	// to the top and bottom and/or left and right sides of the output video to
 	// make the total size of the output video match the values that you specified
 	// for MaxWidth and MaxHeight.
-	PaddingPolicy *string `type:"string"`
+	PaddingPolicy *string `type:"string" pattern:"(^Pad$)|(^NoPad$)"`
 
 	// To better control resolution and aspect ratio of output videos, we recommend
 	// that you use the values MaxWidth, MaxHeight, SizingPolicy, PaddingPolicy,
@@ -3358,7 +3610,7 @@ type VideoParameters struct {
 	// x height value):  1 - 25344 1b - 25344 1.1 - 101376 1.2 - 101376 1.3 - 101376
 	// 2 - 101376 2.1 - 202752 2.2 - 404720 3 - 404720 3.1 - 921600 3.2 - 1310720
 	// 4 - 2097152 4.1 - 2097152
-	Resolution *string `type:"string"`
+	Resolution *string `type:"string" pattern:"(^auto$)|(^\\d{1,5}x\\d{1,5}$)"`
 
 	// Specify one of the following values to control scaling of the output video:
 	//
@@ -3382,7 +3634,7 @@ type VideoParameters struct {
 	// for at least one of MaxWidth and MaxHeight without dropping below either
 	// value. If you specify this option, Elastic Transcoder does not scale the
 	// video up.
-	SizingPolicy *string `type:"string"`
+	SizingPolicy *string `type:"string" pattern:"(^Fit$)|(^Fill$)|(^Stretch$)|(^Keep$)|(^ShrinkToFit$)|(^ShrinkToFill$)"`
 
 	// Settings for the size, location, and opacity of graphics that you want Elastic
 	// Transcoder to overlay over videos that are transcoded using this preset.
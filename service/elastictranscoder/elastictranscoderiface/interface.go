@@ -1,41 +1,83 @@
 package elastictranscoderiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/elastictranscoder"
 )
 
 type ElasticTranscoderAPI interface {
 	CancelJob(*elastictranscoder.CancelJobInput) (*elastictranscoder.CancelJobOutput, error)
 
+	CancelJobWithContext(context.Context, *elastictranscoder.CancelJobInput) (*elastictranscoder.CancelJobOutput, error)
+
 	CreateJob(*elastictranscoder.CreateJobInput) (*elastictranscoder.CreateJobResponse, error)
 
+	CreateJobWithContext(context.Context, *elastictranscoder.CreateJobInput) (*elastictranscoder.CreateJobResponse, error)
+
 	CreatePipeline(*elastictranscoder.CreatePipelineInput) (*elastictranscoder.CreatePipelineOutput, error)
 
+	CreatePipelineWithContext(context.Context, *elastictranscoder.CreatePipelineInput) (*elastictranscoder.CreatePipelineOutput, error)
+
 	CreatePreset(*elastictranscoder.CreatePresetInput) (*elastictranscoder.CreatePresetOutput, error)
 
+	CreatePresetWithContext(context.Context, *elastictranscoder.CreatePresetInput) (*elastictranscoder.CreatePresetOutput, error)
+
 	DeletePipeline(*elastictranscoder.DeletePipelineInput) (*elastictranscoder.DeletePipelineOutput, error)
 
+	DeletePipelineWithContext(context.Context, *elastictranscoder.DeletePipelineInput) (*elastictranscoder.DeletePipelineOutput, error)
+
 	DeletePreset(*elastictranscoder.DeletePresetInput) (*elastictranscoder.DeletePresetOutput, error)
 
+	DeletePresetWithContext(context.Context, *elastictranscoder.DeletePresetInput) (*elastictranscoder.DeletePresetOutput, error)
+
 	ListJobsByPipeline(*elastictranscoder.ListJobsByPipelineInput) (*elastictranscoder.ListJobsByPipelineOutput, error)
 
+	ListJobsByPipelineWithContext(context.Context, *elastictranscoder.ListJobsByPipelineInput) (*elastictranscoder.ListJobsByPipelineOutput, error)
+	ListJobsByPipelinePages(*elastictranscoder.ListJobsByPipelineInput, func(*elastictranscoder.ListJobsByPipelineOutput, bool) bool) error
+
 	ListJobsByStatus(*elastictranscoder.ListJobsByStatusInput) (*elastictranscoder.ListJobsByStatusOutput, error)
 
+	ListJobsByStatusWithContext(context.Context, *elastictranscoder.ListJobsByStatusInput) (*elastictranscoder.ListJobsByStatusOutput, error)
+	ListJobsByStatusPages(*elastictranscoder.ListJobsByStatusInput, func(*elastictranscoder.ListJobsByStatusOutput, bool) bool) error
+
 	ListPipelines(*elastictranscoder.ListPipelinesInput) (*elastictranscoder.ListPipelinesOutput, error)
 
+	ListPipelinesWithContext(context.Context, *elastictranscoder.ListPipelinesInput) (*elastictranscoder.ListPipelinesOutput, error)
+	ListPipelinesPages(*elastictranscoder.ListPipelinesInput, func(*elastictranscoder.ListPipelinesOutput, bool) bool) error
+
 	ListPresets(*elastictranscoder.ListPresetsInput) (*elastictranscoder.ListPresetsOutput, error)
 
+	ListPresetsWithContext(context.Context, *elastictranscoder.ListPresetsInput) (*elastictranscoder.ListPresetsOutput, error)
+	ListPresetsPages(*elastictranscoder.ListPresetsInput, func(*elastictranscoder.ListPresetsOutput, bool) bool) error
+
 	ReadJob(*elastictranscoder.ReadJobInput) (*elastictranscoder.ReadJobOutput, error)
 
+	ReadJobWithContext(context.Context, *elastictranscoder.ReadJobInput) (*elastictranscoder.ReadJobOutput, error)
+
 	ReadPipeline(*elastictranscoder.ReadPipelineInput) (*elastictranscoder.ReadPipelineOutput, error)
 
+	ReadPipelineWithContext(context.Context, *elastictranscoder.ReadPipelineInput) (*elastictranscoder.ReadPipelineOutput, error)
+
 	ReadPreset(*elastictranscoder.ReadPresetInput) (*elastictranscoder.ReadPresetOutput, error)
 
+	ReadPresetWithContext(context.Context, *elastictranscoder.ReadPresetInput) (*elastictranscoder.ReadPresetOutput, error)
+
 	TestRole(*elastictranscoder.TestRoleInput) (*elastictranscoder.TestRoleOutput, error)
 
+	TestRoleWithContext(context.Context, *elastictranscoder.TestRoleInput) (*elastictranscoder.TestRoleOutput, error)
+
 	UpdatePipeline(*elastictranscoder.UpdatePipelineInput) (*elastictranscoder.UpdatePipelineOutput, error)
 
+	UpdatePipelineWithContext(context.Context, *elastictranscoder.UpdatePipelineInput) (*elastictranscoder.UpdatePipelineOutput, error)
+
 	UpdatePipelineNotifications(*elastictranscoder.UpdatePipelineNotificationsInput) (*elastictranscoder.UpdatePipelineNotificationsOutput, error)
 
+	UpdatePipelineNotificationsWithContext(context.Context, *elastictranscoder.UpdatePipelineNotificationsInput) (*elastictranscoder.UpdatePipelineNotificationsOutput, error)
+
 	UpdatePipelineStatus(*elastictranscoder.UpdatePipelineStatusInput) (*elastictranscoder.UpdatePipelineStatusOutput, error)
+
+	UpdatePipelineStatusWithContext(context.Context, *elastictranscoder.UpdatePipelineStatusInput) (*elastictranscoder.UpdatePipelineStatusOutput, error)
+
+	WaitUntilJobComplete(*elastictranscoder.ReadJobInput) error
 }
\ No newline at end of file
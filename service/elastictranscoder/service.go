@@ -27,6 +27,7 @@ func New(config *aws.Config) *ElasticTranscoder {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "elastictranscoder",
 		APIVersion:  "2012-09-25",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
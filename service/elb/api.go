@@ -4,6 +4,7 @@
 package elb
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -52,6 +53,17 @@ func (c *ELB) AddTags(input *AddTagsInput) (output *AddTagsOutput, err error) {
 	return
 }
 
+// AddTagsWithContext is the same as AddTags with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) AddTagsWithContext(ctx context.Context, input *AddTagsInput) (output *AddTagsOutput, err error) {
+	req, out := c.AddTagsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddTags *aws.Operation
 
 // ApplySecurityGroupsToLoadBalancerRequest generates a request for the ApplySecurityGroupsToLoadBalancer operation.
@@ -90,6 +102,17 @@ func (c *ELB) ApplySecurityGroupsToLoadBalancer(input *ApplySecurityGroupsToLoad
 	return
 }
 
+// ApplySecurityGroupsToLoadBalancerWithContext is the same as ApplySecurityGroupsToLoadBalancer with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) ApplySecurityGroupsToLoadBalancerWithContext(ctx context.Context, input *ApplySecurityGroupsToLoadBalancerInput) (output *ApplySecurityGroupsToLoadBalancerOutput, err error) {
+	req, out := c.ApplySecurityGroupsToLoadBalancerRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opApplySecurityGroupsToLoadBalancer *aws.Operation
 
 // AttachLoadBalancerToSubnetsRequest generates a request for the AttachLoadBalancerToSubnets operation.
@@ -129,6 +152,17 @@ func (c *ELB) AttachLoadBalancerToSubnets(input *AttachLoadBalancerToSubnetsInpu
 	return
 }
 
+// AttachLoadBalancerToSubnetsWithContext is the same as AttachLoadBalancerToSubnets with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) AttachLoadBalancerToSubnetsWithContext(ctx context.Context, input *AttachLoadBalancerToSubnetsInput) (output *AttachLoadBalancerToSubnetsOutput, err error) {
+	req, out := c.AttachLoadBalancerToSubnetsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAttachLoadBalancerToSubnets *aws.Operation
 
 // ConfigureHealthCheckRequest generates a request for the ConfigureHealthCheck operation.
@@ -166,6 +200,17 @@ func (c *ELB) ConfigureHealthCheck(input *ConfigureHealthCheckInput) (output *Co
 	return
 }
 
+// ConfigureHealthCheckWithContext is the same as ConfigureHealthCheck with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) ConfigureHealthCheckWithContext(ctx context.Context, input *ConfigureHealthCheckInput) (output *ConfigureHealthCheckOutput, err error) {
+	req, out := c.ConfigureHealthCheckRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opConfigureHealthCheck *aws.Operation
 
 // CreateAppCookieStickinessPolicyRequest generates a request for the CreateAppCookieStickinessPolicy operation.
@@ -216,6 +261,17 @@ func (c *ELB) CreateAppCookieStickinessPolicy(input *CreateAppCookieStickinessPo
 	return
 }
 
+// CreateAppCookieStickinessPolicyWithContext is the same as CreateAppCookieStickinessPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) CreateAppCookieStickinessPolicyWithContext(ctx context.Context, input *CreateAppCookieStickinessPolicyInput) (output *CreateAppCookieStickinessPolicyOutput, err error) {
+	req, out := c.CreateAppCookieStickinessPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateAppCookieStickinessPolicy *aws.Operation
 
 // CreateLBCookieStickinessPolicyRequest generates a request for the CreateLBCookieStickinessPolicy operation.
@@ -265,6 +321,17 @@ func (c *ELB) CreateLBCookieStickinessPolicy(input *CreateLBCookieStickinessPoli
 	return
 }
 
+// CreateLBCookieStickinessPolicyWithContext is the same as CreateLBCookieStickinessPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) CreateLBCookieStickinessPolicyWithContext(ctx context.Context, input *CreateLBCookieStickinessPolicyInput) (output *CreateLBCookieStickinessPolicyOutput, err error) {
+	req, out := c.CreateLBCookieStickinessPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateLBCookieStickinessPolicy *aws.Operation
 
 // CreateLoadBalancerRequest generates a request for the CreateLoadBalancer operation.
@@ -319,6 +386,17 @@ func (c *ELB) CreateLoadBalancer(input *CreateLoadBalancerInput) (output *Create
 	return
 }
 
+// CreateLoadBalancerWithContext is the same as CreateLoadBalancer with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) CreateLoadBalancerWithContext(ctx context.Context, input *CreateLoadBalancerInput) (output *CreateLoadBalancerOutput, err error) {
+	req, out := c.CreateLoadBalancerRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateLoadBalancer *aws.Operation
 
 // CreateLoadBalancerListenersRequest generates a request for the CreateLoadBalancerListeners operation.
@@ -358,6 +436,17 @@ func (c *ELB) CreateLoadBalancerListeners(input *CreateLoadBalancerListenersInpu
 	return
 }
 
+// CreateLoadBalancerListenersWithContext is the same as CreateLoadBalancerListeners with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) CreateLoadBalancerListenersWithContext(ctx context.Context, input *CreateLoadBalancerListenersInput) (output *CreateLoadBalancerListenersOutput, err error) {
+	req, out := c.CreateLoadBalancerListenersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateLoadBalancerListeners *aws.Operation
 
 // CreateLoadBalancerPolicyRequest generates a request for the CreateLoadBalancerPolicy operation.
@@ -394,6 +483,17 @@ func (c *ELB) CreateLoadBalancerPolicy(input *CreateLoadBalancerPolicyInput) (ou
 	return
 }
 
+// CreateLoadBalancerPolicyWithContext is the same as CreateLoadBalancerPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) CreateLoadBalancerPolicyWithContext(ctx context.Context, input *CreateLoadBalancerPolicyInput) (output *CreateLoadBalancerPolicyOutput, err error) {
+	req, out := c.CreateLoadBalancerPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateLoadBalancerPolicy *aws.Operation
 
 // DeleteLoadBalancerRequest generates a request for the DeleteLoadBalancer operation.
@@ -439,6 +539,17 @@ func (c *ELB) DeleteLoadBalancer(input *DeleteLoadBalancerInput) (output *Delete
 	return
 }
 
+// DeleteLoadBalancerWithContext is the same as DeleteLoadBalancer with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) DeleteLoadBalancerWithContext(ctx context.Context, input *DeleteLoadBalancerInput) (output *DeleteLoadBalancerOutput, err error) {
+	req, out := c.DeleteLoadBalancerRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteLoadBalancer *aws.Operation
 
 // DeleteLoadBalancerListenersRequest generates a request for the DeleteLoadBalancerListeners operation.
@@ -472,6 +583,17 @@ func (c *ELB) DeleteLoadBalancerListeners(input *DeleteLoadBalancerListenersInpu
 	return
 }
 
+// DeleteLoadBalancerListenersWithContext is the same as DeleteLoadBalancerListeners with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) DeleteLoadBalancerListenersWithContext(ctx context.Context, input *DeleteLoadBalancerListenersInput) (output *DeleteLoadBalancerListenersOutput, err error) {
+	req, out := c.DeleteLoadBalancerListenersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteLoadBalancerListeners *aws.Operation
 
 // DeleteLoadBalancerPolicyRequest generates a request for the DeleteLoadBalancerPolicy operation.
@@ -506,6 +628,17 @@ func (c *ELB) DeleteLoadBalancerPolicy(input *DeleteLoadBalancerPolicyInput) (ou
 	return
 }
 
+// DeleteLoadBalancerPolicyWithContext is the same as DeleteLoadBalancerPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) DeleteLoadBalancerPolicyWithContext(ctx context.Context, input *DeleteLoadBalancerPolicyInput) (output *DeleteLoadBalancerPolicyOutput, err error) {
+	req, out := c.DeleteLoadBalancerPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteLoadBalancerPolicy *aws.Operation
 
 // DeregisterInstancesFromLoadBalancerRequest generates a request for the DeregisterInstancesFromLoadBalancer operation.
@@ -550,6 +683,17 @@ func (c *ELB) DeregisterInstancesFromLoadBalancer(input *DeregisterInstancesFrom
 	return
 }
 
+// DeregisterInstancesFromLoadBalancerWithContext is the same as DeregisterInstancesFromLoadBalancer with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) DeregisterInstancesFromLoadBalancerWithContext(ctx context.Context, input *DeregisterInstancesFromLoadBalancerInput) (output *DeregisterInstancesFromLoadBalancerOutput, err error) {
+	req, out := c.DeregisterInstancesFromLoadBalancerRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeregisterInstancesFromLoadBalancer *aws.Operation
 
 // DescribeInstanceHealthRequest generates a request for the DescribeInstanceHealth operation.
@@ -588,6 +732,17 @@ func (c *ELB) DescribeInstanceHealth(input *DescribeInstanceHealthInput) (output
 	return
 }
 
+// DescribeInstanceHealthWithContext is the same as DescribeInstanceHealth with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) DescribeInstanceHealthWithContext(ctx context.Context, input *DescribeInstanceHealthInput) (output *DescribeInstanceHealthOutput, err error) {
+	req, out := c.DescribeInstanceHealthRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeInstanceHealth *aws.Operation
 
 // DescribeLoadBalancerAttributesRequest generates a request for the DescribeLoadBalancerAttributes operation.
@@ -622,6 +777,17 @@ func (c *ELB) DescribeLoadBalancerAttributes(input *DescribeLoadBalancerAttribut
 	return
 }
 
+// DescribeLoadBalancerAttributesWithContext is the same as DescribeLoadBalancerAttributes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) DescribeLoadBalancerAttributesWithContext(ctx context.Context, input *DescribeLoadBalancerAttributesInput) (output *DescribeLoadBalancerAttributesOutput, err error) {
+	req, out := c.DescribeLoadBalancerAttributesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeLoadBalancerAttributes *aws.Operation
 
 // DescribeLoadBalancerPoliciesRequest generates a request for the DescribeLoadBalancerPolicies operation.
@@ -661,6 +827,17 @@ func (c *ELB) DescribeLoadBalancerPolicies(input *DescribeLoadBalancerPoliciesIn
 	return
 }
 
+// DescribeLoadBalancerPoliciesWithContext is the same as DescribeLoadBalancerPolicies with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) DescribeLoadBalancerPoliciesWithContext(ctx context.Context, input *DescribeLoadBalancerPoliciesInput) (output *DescribeLoadBalancerPoliciesOutput, err error) {
+	req, out := c.DescribeLoadBalancerPoliciesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeLoadBalancerPolicies *aws.Operation
 
 // DescribeLoadBalancerPolicyTypesRequest generates a request for the DescribeLoadBalancerPolicyTypes operation.
@@ -697,6 +874,17 @@ func (c *ELB) DescribeLoadBalancerPolicyTypes(input *DescribeLoadBalancerPolicyT
 	return
 }
 
+// DescribeLoadBalancerPolicyTypesWithContext is the same as DescribeLoadBalancerPolicyTypes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) DescribeLoadBalancerPolicyTypesWithContext(ctx context.Context, input *DescribeLoadBalancerPolicyTypesInput) (output *DescribeLoadBalancerPolicyTypesOutput, err error) {
+	req, out := c.DescribeLoadBalancerPolicyTypesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeLoadBalancerPolicyTypes *aws.Operation
 
 // DescribeLoadBalancersRequest generates a request for the DescribeLoadBalancers operation.
@@ -709,6 +897,12 @@ func (c *ELB) DescribeLoadBalancersRequest(input *DescribeLoadBalancersInput) (r
 			Name:       "DescribeLoadBalancers",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"NextMarker"},
+				LimitToken:      "PageSize",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -735,6 +929,27 @@ func (c *ELB) DescribeLoadBalancers(input *DescribeLoadBalancersInput) (output *
 	return
 }
 
+// DescribeLoadBalancersWithContext is the same as DescribeLoadBalancers with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) DescribeLoadBalancersWithContext(ctx context.Context, input *DescribeLoadBalancersInput) (output *DescribeLoadBalancersOutput, err error) {
+	req, out := c.DescribeLoadBalancersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeLoadBalancersPages iterates over the pages of a DescribeLoadBalancers operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *ELB) DescribeLoadBalancersPages(input *DescribeLoadBalancersInput, fn func(p *DescribeLoadBalancersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeLoadBalancersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeLoadBalancersOutput), lastPage)
+	})
+}
+
 var opDescribeLoadBalancers *aws.Operation
 
 // DescribeTagsRequest generates a request for the DescribeTags operation.
@@ -768,6 +983,17 @@ func (c *ELB) DescribeTags(input *DescribeTagsInput) (output *DescribeTagsOutput
 	return
 }
 
+// DescribeTagsWithContext is the same as DescribeTags with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) DescribeTagsWithContext(ctx context.Context, input *DescribeTagsInput) (output *DescribeTagsOutput, err error) {
+	req, out := c.DescribeTagsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeTags *aws.Operation
 
 // DetachLoadBalancerFromSubnetsRequest generates a request for the DetachLoadBalancerFromSubnets operation.
@@ -807,6 +1033,17 @@ func (c *ELB) DetachLoadBalancerFromSubnets(input *DetachLoadBalancerFromSubnets
 	return
 }
 
+// DetachLoadBalancerFromSubnetsWithContext is the same as DetachLoadBalancerFromSubnets with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) DetachLoadBalancerFromSubnetsWithContext(ctx context.Context, input *DetachLoadBalancerFromSubnetsInput) (output *DetachLoadBalancerFromSubnetsOutput, err error) {
+	req, out := c.DetachLoadBalancerFromSubnetsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDetachLoadBalancerFromSubnets *aws.Operation
 
 // DisableAvailabilityZonesForLoadBalancerRequest generates a request for the DisableAvailabilityZonesForLoadBalancer operation.
@@ -853,6 +1090,17 @@ func (c *ELB) DisableAvailabilityZonesForLoadBalancer(input *DisableAvailability
 	return
 }
 
+// DisableAvailabilityZonesForLoadBalancerWithContext is the same as DisableAvailabilityZonesForLoadBalancer with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) DisableAvailabilityZonesForLoadBalancerWithContext(ctx context.Context, input *DisableAvailabilityZonesForLoadBalancerInput) (output *DisableAvailabilityZonesForLoadBalancerOutput, err error) {
+	req, out := c.DisableAvailabilityZonesForLoadBalancerRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDisableAvailabilityZonesForLoadBalancer *aws.Operation
 
 // EnableAvailabilityZonesForLoadBalancerRequest generates a request for the EnableAvailabilityZonesForLoadBalancer operation.
@@ -895,6 +1143,17 @@ func (c *ELB) EnableAvailabilityZonesForLoadBalancer(input *EnableAvailabilityZo
 	return
 }
 
+// EnableAvailabilityZonesForLoadBalancerWithContext is the same as EnableAvailabilityZonesForLoadBalancer with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) EnableAvailabilityZonesForLoadBalancerWithContext(ctx context.Context, input *EnableAvailabilityZonesForLoadBalancerInput) (output *EnableAvailabilityZonesForLoadBalancerOutput, err error) {
+	req, out := c.EnableAvailabilityZonesForLoadBalancerRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEnableAvailabilityZonesForLoadBalancer *aws.Operation
 
 // ModifyLoadBalancerAttributesRequest generates a request for the ModifyLoadBalancerAttributes operation.
@@ -940,6 +1199,17 @@ func (c *ELB) ModifyLoadBalancerAttributes(input *ModifyLoadBalancerAttributesIn
 	return
 }
 
+// ModifyLoadBalancerAttributesWithContext is the same as ModifyLoadBalancerAttributes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) ModifyLoadBalancerAttributesWithContext(ctx context.Context, input *ModifyLoadBalancerAttributesInput) (output *ModifyLoadBalancerAttributesOutput, err error) {
+	req, out := c.ModifyLoadBalancerAttributesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyLoadBalancerAttributes *aws.Operation
 
 // RegisterInstancesWithLoadBalancerRequest generates a request for the RegisterInstancesWithLoadBalancer operation.
@@ -998,6 +1268,17 @@ func (c *ELB) RegisterInstancesWithLoadBalancer(input *RegisterInstancesWithLoad
 	return
 }
 
+// RegisterInstancesWithLoadBalancerWithContext is the same as RegisterInstancesWithLoadBalancer with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) RegisterInstancesWithLoadBalancerWithContext(ctx context.Context, input *RegisterInstancesWithLoadBalancerInput) (output *RegisterInstancesWithLoadBalancerOutput, err error) {
+	req, out := c.RegisterInstancesWithLoadBalancerRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRegisterInstancesWithLoadBalancer *aws.Operation
 
 // RemoveTagsRequest generates a request for the RemoveTags operation.
@@ -1031,6 +1312,17 @@ func (c *ELB) RemoveTags(input *RemoveTagsInput) (output *RemoveTagsOutput, err
 	return
 }
 
+// RemoveTagsWithContext is the same as RemoveTags with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) RemoveTagsWithContext(ctx context.Context, input *RemoveTagsInput) (output *RemoveTagsOutput, err error) {
+	req, out := c.RemoveTagsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRemoveTags *aws.Operation
 
 // SetLoadBalancerListenerSSLCertificateRequest generates a request for the SetLoadBalancerListenerSSLCertificate operation.
@@ -1070,6 +1362,17 @@ func (c *ELB) SetLoadBalancerListenerSSLCertificate(input *SetLoadBalancerListen
 	return
 }
 
+// SetLoadBalancerListenerSSLCertificateWithContext is the same as SetLoadBalancerListenerSSLCertificate with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) SetLoadBalancerListenerSSLCertificateWithContext(ctx context.Context, input *SetLoadBalancerListenerSSLCertificateInput) (output *SetLoadBalancerListenerSSLCertificateOutput, err error) {
+	req, out := c.SetLoadBalancerListenerSSLCertificateRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetLoadBalancerListenerSSLCertificate *aws.Operation
 
 // SetLoadBalancerPoliciesForBackendServerRequest generates a request for the SetLoadBalancerPoliciesForBackendServer operation.
@@ -1116,6 +1419,17 @@ func (c *ELB) SetLoadBalancerPoliciesForBackendServer(input *SetLoadBalancerPoli
 	return
 }
 
+// SetLoadBalancerPoliciesForBackendServerWithContext is the same as SetLoadBalancerPoliciesForBackendServer with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) SetLoadBalancerPoliciesForBackendServerWithContext(ctx context.Context, input *SetLoadBalancerPoliciesForBackendServerInput) (output *SetLoadBalancerPoliciesForBackendServerOutput, err error) {
+	req, out := c.SetLoadBalancerPoliciesForBackendServerRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetLoadBalancerPoliciesForBackendServer *aws.Operation
 
 // SetLoadBalancerPoliciesOfListenerRequest generates a request for the SetLoadBalancerPoliciesOfListener operation.
@@ -1150,6 +1464,17 @@ func (c *ELB) SetLoadBalancerPoliciesOfListener(input *SetLoadBalancerPoliciesOf
 	return
 }
 
+// SetLoadBalancerPoliciesOfListenerWithContext is the same as SetLoadBalancerPoliciesOfListener with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *ELB) SetLoadBalancerPoliciesOfListenerWithContext(ctx context.Context, input *SetLoadBalancerPoliciesOfListenerInput) (output *SetLoadBalancerPoliciesOfListenerOutput, err error) {
+	req, out := c.SetLoadBalancerPoliciesOfListenerRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetLoadBalancerPoliciesOfListener *aws.Operation
 
 // The AccessLog data type.
@@ -1185,7 +1510,7 @@ type AddTagsInput struct {
 	LoadBalancerNames []*string `type:"list" required:"true"`
 
 	// A list of tags for each load balancer.
-	Tags []*Tag `type:"list" required:"true"`
+	Tags []*Tag `type:"list" min:"1" required:"true"`
 
 	metadataAddTagsInput `json:"-", xml:"-"`
 }
@@ -1294,7 +1619,7 @@ type metadataAttachLoadBalancerToSubnetsOutput struct {
 // action to describe the configuration of the back-end server.
 type BackendServerDescription struct {
 	// Provides the port on which the back-end server is listening.
-	InstancePort *int64 `type:"integer"`
+	InstancePort *int64 `type:"integer" min:"1" max:"65535"`
 
 	// Provides a list of policy names enabled for the back-end server.
 	PolicyNames []*string `type:"list"`
@@ -1354,7 +1679,7 @@ type metadataConnectionDraining struct {
 type ConnectionSettings struct {
 	// Specifies the time (in seconds) the connection is allowed to be idle (no
 	// data has been sent over the connection) before it is closed by the load balancer.
-	IdleTimeout *int64 `type:"integer" required:"true"`
+	IdleTimeout *int64 `type:"integer" min:"1" max:"3600" required:"true"`
 
 	metadataConnectionSettings `json:"-", xml:"-"`
 }
@@ -1466,7 +1791,7 @@ type CreateLoadBalancerInput struct {
 	//
 	// For more information about setting tags for your load balancer, see Tagging
 	// (http://docs.aws.amazon.com/ElasticLoadBalancing/latest/DeveloperGuide/TerminologyandKeyConcepts.html#tagging-elb).
-	Tags []*Tag `type:"list"`
+	Tags []*Tag `type:"list" min:"1"`
 
 	metadataCreateLoadBalancerInput `json:"-", xml:"-"`
 }
@@ -1769,7 +2094,7 @@ type DescribeLoadBalancersInput struct {
 
 	// The number of results returned in each page. The default is 400. You cannot
 	// specify a page size greater than 400 or less than 1.
-	PageSize *int64 `type:"integer"`
+	PageSize *int64 `type:"integer" min:"1" max:"400"`
 
 	metadataDescribeLoadBalancersInput `json:"-", xml:"-"`
 }
@@ -1797,7 +2122,7 @@ type metadataDescribeLoadBalancersOutput struct {
 // The input for the DescribeTags action.
 type DescribeTagsInput struct {
 	// The names of the load balancers.
-	LoadBalancerNames []*string `type:"list" required:"true"`
+	LoadBalancerNames []*string `type:"list" min:"1" max:"20" required:"true"`
 
 	metadataDescribeTagsInput `json:"-", xml:"-"`
 }
@@ -1908,11 +2233,11 @@ type metadataEnableAvailabilityZonesForLoadBalancerOutput struct {
 type HealthCheck struct {
 	// Specifies the number of consecutive health probe successes required before
 	// moving the instance to the Healthy state.
-	HealthyThreshold *int64 `type:"integer" required:"true"`
+	HealthyThreshold *int64 `type:"integer" min:"2" max:"10" required:"true"`
 
 	// Specifies the approximate interval, in seconds, between health checks of
 	// an individual instance.
-	Interval *int64 `type:"integer" required:"true"`
+	Interval *int64 `type:"integer" min:"1" max:"300" required:"true"`
 
 	// Specifies the instance being checked. The protocol is either TCP, HTTP, HTTPS,
 	// or SSL. The range of valid ports is one (1) through 65535.
@@ -1938,11 +2263,11 @@ type HealthCheck struct {
 	// a failed health probe.
 	//
 	//  This value must be less than the Interval value.
-	Timeout *int64 `type:"integer" required:"true"`
+	Timeout *int64 `type:"integer" min:"1" max:"300" required:"true"`
 
 	// Specifies the number of consecutive health probe failures required before
 	// moving the instance to the Unhealthy state.
-	UnhealthyThreshold *int64 `type:"integer" required:"true"`
+	UnhealthyThreshold *int64 `type:"integer" min:"2" max:"10" required:"true"`
 
 	metadataHealthCheck `json:"-", xml:"-"`
 }
@@ -2012,7 +2337,7 @@ type metadataLBCookieStickinessPolicy struct {
 type Listener struct {
 	// Specifies the TCP port on which the instance server is listening. This property
 	// cannot be modified for the life of the load balancer.
-	InstancePort *int64 `type:"integer" required:"true"`
+	InstancePort *int64 `type:"integer" min:"1" max:"65535" required:"true"`
 
 	// Specifies the protocol to use for routing traffic to back-end instances -
 	// HTTP, HTTPS, TCP, or SSL. This property cannot be modified for the life of
@@ -2367,7 +2692,7 @@ type RemoveTagsInput struct {
 	LoadBalancerNames []*string `type:"list" required:"true"`
 
 	// A list of tag keys to remove.
-	Tags []*TagKeyOnly `type:"list" required:"true"`
+	Tags []*TagKeyOnly `type:"list" min:"1" required:"true"`
 
 	metadataRemoveTagsInput `json:"-", xml:"-"`
 }
@@ -2497,10 +2822,10 @@ type metadataSourceSecurityGroup struct {
 // in the Elastic Load Balancing Developer Guide.
 type Tag struct {
 	// The key of the tag.
-	Key *string `type:"string" required:"true"`
+	Key *string `type:"string" min:"1" max:"128" pattern:"^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$" required:"true"`
 
 	// The value of the tag.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"0" max:"256" pattern:"^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"`
 
 	metadataTag `json:"-", xml:"-"`
 }
@@ -2515,7 +2840,7 @@ type TagDescription struct {
 	LoadBalancerName *string `type:"string"`
 
 	// List of tags associated with the load balancer.
-	Tags []*Tag `type:"list"`
+	Tags []*Tag `type:"list" min:"1"`
 
 	metadataTagDescription `json:"-", xml:"-"`
 }
@@ -2527,7 +2852,7 @@ type metadataTagDescription struct {
 // The key of a tag to be removed.
 type TagKeyOnly struct {
 	// The name of the key.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"128" pattern:"^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"`
 
 	metadataTagKeyOnly `json:"-", xml:"-"`
 }
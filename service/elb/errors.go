@@ -0,0 +1,155 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package elb
+
+import (
+	"github.com/datacratic/aws-sdk-go/aws"
+)
+
+// Error codes of the ELB errors. Errors with these codes are
+// returned as the typed error of the same name, e.g. an error with the code
+// ErrCodeFoo is a *Foo.
+const (
+	ErrCodeAccessPointNotFoundException           = "LoadBalancerNotFound"
+	ErrCodeCertificateNotFoundException           = "CertificateNotFound"
+	ErrCodeDuplicateAccessPointNameException      = "DuplicateLoadBalancerName"
+	ErrCodeDuplicateListenerException             = "DuplicateListener"
+	ErrCodeDuplicatePolicyNameException           = "DuplicatePolicyName"
+	ErrCodeDuplicateTagKeysException              = "DuplicateTagKeys"
+	ErrCodeInvalidConfigurationRequestException   = "InvalidConfigurationRequest"
+	ErrCodeInvalidEndPointException               = "InvalidInstance"
+	ErrCodeInvalidSchemeException                 = "InvalidScheme"
+	ErrCodeInvalidSecurityGroupException          = "InvalidSecurityGroup"
+	ErrCodeInvalidSubnetException                 = "InvalidSubnet"
+	ErrCodeListenerNotFoundException              = "ListenerNotFound"
+	ErrCodeLoadBalancerAttributeNotFoundException = "LoadBalancerAttributeNotFound"
+	ErrCodePolicyNotFoundException                = "PolicyNotFound"
+	ErrCodePolicyTypeNotFoundException            = "PolicyTypeNotFound"
+	ErrCodeSubnetNotFoundException                = "SubnetNotFound"
+	ErrCodeTooManyAccessPointsException           = "TooManyLoadBalancers"
+	ErrCodeTooManyPoliciesException               = "TooManyPolicies"
+	ErrCodeTooManyTagsException                   = "TooManyTags"
+)
+
+// The specified load balancer could not be found.
+type AccessPointNotFoundException struct {
+	aws.APIError
+}
+
+// The specified SSL ID does not refer to a valid SSL certificate in the AWS
+// Identity and Access Management Service.
+type CertificateNotFoundException struct {
+	aws.APIError
+}
+
+// The load balancer name already exists for this account. Please choose another
+// name.
+type DuplicateAccessPointNameException struct {
+	aws.APIError
+}
+
+// A Listener already exists for the given LoadBalancerName and LoadBalancerPort,
+// but with a different InstancePort, Protocol, or SSLCertificateId.
+type DuplicateListenerException struct {
+	aws.APIError
+}
+
+// Policy with the same name exists for this load balancer. Please choose another
+// name.
+type DuplicatePolicyNameException struct {
+	aws.APIError
+}
+
+// The same tag key specified multiple times.
+type DuplicateTagKeysException struct {
+	aws.APIError
+}
+
+// Requested configuration change is invalid.
+type InvalidConfigurationRequestException struct {
+	aws.APIError
+}
+
+// The specified EndPoint is not valid.
+type InvalidEndPointException struct {
+	aws.APIError
+}
+
+// Invalid value for scheme. Scheme can only be specified for load balancers
+// in VPC.
+type InvalidSchemeException struct {
+	aws.APIError
+}
+
+// One or more specified security groups do not exist.
+type InvalidSecurityGroupException struct {
+	aws.APIError
+}
+
+// The VPC has no Internet gateway.
+type InvalidSubnetException struct {
+	aws.APIError
+}
+
+// Load balancer does not have a listener configured at the given port.
+type ListenerNotFoundException struct {
+	aws.APIError
+}
+
+// The specified load balancer attribute could not be found.
+type LoadBalancerAttributeNotFoundException struct {
+	aws.APIError
+}
+
+// One or more specified policies were not found.
+type PolicyNotFoundException struct {
+	aws.APIError
+}
+
+// One or more of the specified policy types do not exist.
+type PolicyTypeNotFoundException struct {
+	aws.APIError
+}
+
+// One or more subnets were not found.
+type SubnetNotFoundException struct {
+	aws.APIError
+}
+
+// The quota for the number of load balancers has already been reached.
+type TooManyAccessPointsException struct {
+	aws.APIError
+}
+
+// Quota for number of policies for this load balancer has already been reached.
+type TooManyPoliciesException struct {
+	aws.APIError
+}
+
+// The quota for the number of tags that can be assigned to a load balancer
+// has been reached.
+type TooManyTagsException struct {
+	aws.APIError
+}
+
+var errorTypes = map[string]func() aws.ServiceError{
+	ErrCodeAccessPointNotFoundException:           func() aws.ServiceError { return &AccessPointNotFoundException{} },
+	ErrCodeCertificateNotFoundException:           func() aws.ServiceError { return &CertificateNotFoundException{} },
+	ErrCodeDuplicateAccessPointNameException:      func() aws.ServiceError { return &DuplicateAccessPointNameException{} },
+	ErrCodeDuplicateListenerException:             func() aws.ServiceError { return &DuplicateListenerException{} },
+	ErrCodeDuplicatePolicyNameException:           func() aws.ServiceError { return &DuplicatePolicyNameException{} },
+	ErrCodeDuplicateTagKeysException:              func() aws.ServiceError { return &DuplicateTagKeysException{} },
+	ErrCodeInvalidConfigurationRequestException:   func() aws.ServiceError { return &InvalidConfigurationRequestException{} },
+	ErrCodeInvalidEndPointException:               func() aws.ServiceError { return &InvalidEndPointException{} },
+	ErrCodeInvalidSchemeException:                 func() aws.ServiceError { return &InvalidSchemeException{} },
+	ErrCodeInvalidSecurityGroupException:          func() aws.ServiceError { return &InvalidSecurityGroupException{} },
+	ErrCodeInvalidSubnetException:                 func() aws.ServiceError { return &InvalidSubnetException{} },
+	ErrCodeListenerNotFoundException:              func() aws.ServiceError { return &ListenerNotFoundException{} },
+	ErrCodeLoadBalancerAttributeNotFoundException: func() aws.ServiceError { return &LoadBalancerAttributeNotFoundException{} },
+	ErrCodePolicyNotFoundException:                func() aws.ServiceError { return &PolicyNotFoundException{} },
+	ErrCodePolicyTypeNotFoundException:            func() aws.ServiceError { return &PolicyTypeNotFoundException{} },
+	ErrCodeSubnetNotFoundException:                func() aws.ServiceError { return &SubnetNotFoundException{} },
+	ErrCodeTooManyAccessPointsException:           func() aws.ServiceError { return &TooManyAccessPointsException{} },
+	ErrCodeTooManyPoliciesException:               func() aws.ServiceError { return &TooManyPoliciesException{} },
+	ErrCodeTooManyTagsException:                   func() aws.ServiceError { return &TooManyTagsException{} },
+}
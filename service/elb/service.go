@@ -27,6 +27,7 @@ func New(config *aws.Config) *ELB {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "elasticloadbalancing",
 		APIVersion:  "2012-06-01",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
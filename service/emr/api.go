@@ -4,6 +4,7 @@
 package emr
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -43,6 +44,17 @@ func (c *EMR) AddInstanceGroups(input *AddInstanceGroupsInput) (output *AddInsta
 	return
 }
 
+// AddInstanceGroupsWithContext is the same as AddInstanceGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) AddInstanceGroupsWithContext(ctx context.Context, input *AddInstanceGroupsInput) (output *AddInstanceGroupsOutput, err error) {
+	req, out := c.AddInstanceGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddInstanceGroups *aws.Operation
 
 // AddJobFlowStepsRequest generates a request for the AddJobFlowSteps operation.
@@ -99,6 +111,17 @@ func (c *EMR) AddJobFlowSteps(input *AddJobFlowStepsInput) (output *AddJobFlowSt
 	return
 }
 
+// AddJobFlowStepsWithContext is the same as AddJobFlowSteps with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) AddJobFlowStepsWithContext(ctx context.Context, input *AddJobFlowStepsInput) (output *AddJobFlowStepsOutput, err error) {
+	req, out := c.AddJobFlowStepsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddJobFlowSteps *aws.Operation
 
 // AddTagsRequest generates a request for the AddTags operation.
@@ -135,6 +158,17 @@ func (c *EMR) AddTags(input *AddTagsInput) (output *AddTagsOutput, err error) {
 	return
 }
 
+// AddTagsWithContext is the same as AddTags with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) AddTagsWithContext(ctx context.Context, input *AddTagsInput) (output *AddTagsOutput, err error) {
+	req, out := c.AddTagsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddTags *aws.Operation
 
 // DescribeClusterRequest generates a request for the DescribeCluster operation.
@@ -169,6 +203,17 @@ func (c *EMR) DescribeCluster(input *DescribeClusterInput) (output *DescribeClus
 	return
 }
 
+// DescribeClusterWithContext is the same as DescribeCluster with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) DescribeClusterWithContext(ctx context.Context, input *DescribeClusterInput) (output *DescribeClusterOutput, err error) {
+	req, out := c.DescribeClusterRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeCluster *aws.Operation
 
 // DescribeJobFlowsRequest generates a request for the DescribeJobFlows operation.
@@ -219,6 +264,17 @@ func (c *EMR) DescribeJobFlows(input *DescribeJobFlowsInput) (output *DescribeJo
 	return
 }
 
+// DescribeJobFlowsWithContext is the same as DescribeJobFlows with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) DescribeJobFlowsWithContext(ctx context.Context, input *DescribeJobFlowsInput) (output *DescribeJobFlowsOutput, err error) {
+	req, out := c.DescribeJobFlowsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeJobFlows *aws.Operation
 
 // DescribeStepRequest generates a request for the DescribeStep operation.
@@ -252,6 +308,17 @@ func (c *EMR) DescribeStep(input *DescribeStepInput) (output *DescribeStepOutput
 	return
 }
 
+// DescribeStepWithContext is the same as DescribeStep with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) DescribeStepWithContext(ctx context.Context, input *DescribeStepInput) (output *DescribeStepOutput, err error) {
+	req, out := c.DescribeStepRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeStep *aws.Operation
 
 // ListBootstrapActionsRequest generates a request for the ListBootstrapActions operation.
@@ -264,6 +331,12 @@ func (c *EMR) ListBootstrapActionsRequest(input *ListBootstrapActionsInput) (req
 			Name:       "ListBootstrapActions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -285,6 +358,27 @@ func (c *EMR) ListBootstrapActions(input *ListBootstrapActionsInput) (output *Li
 	return
 }
 
+// ListBootstrapActionsWithContext is the same as ListBootstrapActions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) ListBootstrapActionsWithContext(ctx context.Context, input *ListBootstrapActionsInput) (output *ListBootstrapActionsOutput, err error) {
+	req, out := c.ListBootstrapActionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListBootstrapActionsPages iterates over the pages of a ListBootstrapActions operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *EMR) ListBootstrapActionsPages(input *ListBootstrapActionsInput, fn func(p *ListBootstrapActionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListBootstrapActionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListBootstrapActionsOutput), lastPage)
+	})
+}
+
 var opListBootstrapActions *aws.Operation
 
 // ListClustersRequest generates a request for the ListClusters operation.
@@ -297,6 +391,12 @@ func (c *EMR) ListClustersRequest(input *ListClustersInput) (req *aws.Request, o
 			Name:       "ListClusters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -322,6 +422,27 @@ func (c *EMR) ListClusters(input *ListClustersInput) (output *ListClustersOutput
 	return
 }
 
+// ListClustersWithContext is the same as ListClusters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) ListClustersWithContext(ctx context.Context, input *ListClustersInput) (output *ListClustersOutput, err error) {
+	req, out := c.ListClustersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListClustersPages iterates over the pages of a ListClusters operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *EMR) ListClustersPages(input *ListClustersInput, fn func(p *ListClustersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListClustersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListClustersOutput), lastPage)
+	})
+}
+
 var opListClusters *aws.Operation
 
 // ListInstanceGroupsRequest generates a request for the ListInstanceGroups operation.
@@ -334,6 +455,12 @@ func (c *EMR) ListInstanceGroupsRequest(input *ListInstanceGroupsInput) (req *aw
 			Name:       "ListInstanceGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -355,6 +482,27 @@ func (c *EMR) ListInstanceGroups(input *ListInstanceGroupsInput) (output *ListIn
 	return
 }
 
+// ListInstanceGroupsWithContext is the same as ListInstanceGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) ListInstanceGroupsWithContext(ctx context.Context, input *ListInstanceGroupsInput) (output *ListInstanceGroupsOutput, err error) {
+	req, out := c.ListInstanceGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListInstanceGroupsPages iterates over the pages of a ListInstanceGroups operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *EMR) ListInstanceGroupsPages(input *ListInstanceGroupsInput, fn func(p *ListInstanceGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListInstanceGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListInstanceGroupsOutput), lastPage)
+	})
+}
+
 var opListInstanceGroups *aws.Operation
 
 // ListInstancesRequest generates a request for the ListInstances operation.
@@ -367,6 +515,12 @@ func (c *EMR) ListInstancesRequest(input *ListInstancesInput) (req *aws.Request,
 			Name:       "ListInstances",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -392,6 +546,27 @@ func (c *EMR) ListInstances(input *ListInstancesInput) (output *ListInstancesOut
 	return
 }
 
+// ListInstancesWithContext is the same as ListInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) ListInstancesWithContext(ctx context.Context, input *ListInstancesInput) (output *ListInstancesOutput, err error) {
+	req, out := c.ListInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListInstancesPages iterates over the pages of a ListInstances operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *EMR) ListInstancesPages(input *ListInstancesInput, fn func(p *ListInstancesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListInstancesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListInstancesOutput), lastPage)
+	})
+}
+
 var opListInstances *aws.Operation
 
 // ListStepsRequest generates a request for the ListSteps operation.
@@ -404,6 +579,12 @@ func (c *EMR) ListStepsRequest(input *ListStepsInput) (req *aws.Request, output
 			Name:       "ListSteps",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -425,6 +606,27 @@ func (c *EMR) ListSteps(input *ListStepsInput) (output *ListStepsOutput, err err
 	return
 }
 
+// ListStepsWithContext is the same as ListSteps with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) ListStepsWithContext(ctx context.Context, input *ListStepsInput) (output *ListStepsOutput, err error) {
+	req, out := c.ListStepsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListStepsPages iterates over the pages of a ListSteps operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *EMR) ListStepsPages(input *ListStepsInput, fn func(p *ListStepsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListStepsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListStepsOutput), lastPage)
+	})
+}
+
 var opListSteps *aws.Operation
 
 // ModifyInstanceGroupsRequest generates a request for the ModifyInstanceGroups operation.
@@ -461,6 +663,17 @@ func (c *EMR) ModifyInstanceGroups(input *ModifyInstanceGroupsInput) (output *Mo
 	return
 }
 
+// ModifyInstanceGroupsWithContext is the same as ModifyInstanceGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) ModifyInstanceGroupsWithContext(ctx context.Context, input *ModifyInstanceGroupsInput) (output *ModifyInstanceGroupsOutput, err error) {
+	req, out := c.ModifyInstanceGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyInstanceGroups *aws.Operation
 
 // RemoveTagsRequest generates a request for the RemoveTags operation.
@@ -499,6 +712,17 @@ func (c *EMR) RemoveTags(input *RemoveTagsInput) (output *RemoveTagsOutput, err
 	return
 }
 
+// RemoveTagsWithContext is the same as RemoveTags with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) RemoveTagsWithContext(ctx context.Context, input *RemoveTagsInput) (output *RemoveTagsOutput, err error) {
+	req, out := c.RemoveTagsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRemoveTags *aws.Operation
 
 // RunJobFlowRequest generates a request for the RunJobFlow operation.
@@ -554,6 +778,17 @@ func (c *EMR) RunJobFlow(input *RunJobFlowInput) (output *RunJobFlowOutput, err
 	return
 }
 
+// RunJobFlowWithContext is the same as RunJobFlow with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) RunJobFlowWithContext(ctx context.Context, input *RunJobFlowInput) (output *RunJobFlowOutput, err error) {
+	req, out := c.RunJobFlowRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRunJobFlow *aws.Operation
 
 // SetTerminationProtectionRequest generates a request for the SetTerminationProtection operation.
@@ -603,6 +838,17 @@ func (c *EMR) SetTerminationProtection(input *SetTerminationProtectionInput) (ou
 	return
 }
 
+// SetTerminationProtectionWithContext is the same as SetTerminationProtection with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) SetTerminationProtectionWithContext(ctx context.Context, input *SetTerminationProtectionInput) (output *SetTerminationProtectionOutput, err error) {
+	req, out := c.SetTerminationProtectionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetTerminationProtection *aws.Operation
 
 // SetVisibleToAllUsersRequest generates a request for the SetVisibleToAllUsers operation.
@@ -641,6 +887,17 @@ func (c *EMR) SetVisibleToAllUsers(input *SetVisibleToAllUsersInput) (output *Se
 	return
 }
 
+// SetVisibleToAllUsersWithContext is the same as SetVisibleToAllUsers with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) SetVisibleToAllUsersWithContext(ctx context.Context, input *SetVisibleToAllUsersInput) (output *SetVisibleToAllUsersOutput, err error) {
+	req, out := c.SetVisibleToAllUsersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetVisibleToAllUsers *aws.Operation
 
 // TerminateJobFlowsRequest generates a request for the TerminateJobFlows operation.
@@ -682,6 +939,17 @@ func (c *EMR) TerminateJobFlows(input *TerminateJobFlowsInput) (output *Terminat
 	return
 }
 
+// TerminateJobFlowsWithContext is the same as TerminateJobFlows with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *EMR) TerminateJobFlowsWithContext(ctx context.Context, input *TerminateJobFlowsInput) (output *TerminateJobFlowsOutput, err error) {
+	req, out := c.TerminateJobFlowsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opTerminateJobFlows *aws.Operation
 
 // Input to an AddInstanceGroups call.
@@ -690,7 +958,7 @@ type AddInstanceGroupsInput struct {
 	InstanceGroups []*InstanceGroupConfig `type:"list" required:"true"`
 
 	// Job flow in which to add the instance groups.
-	JobFlowID *string `locationName:"JobFlowId" type:"string" required:"true"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" min:"0" max:"256" required:"true"`
 
 	metadataAddInstanceGroupsInput `json:"-", xml:"-"`
 }
@@ -705,7 +973,7 @@ type AddInstanceGroupsOutput struct {
 	InstanceGroupIDs []*string `locationName:"InstanceGroupIds" type:"list"`
 
 	// The job flow ID in which the instance groups are added.
-	JobFlowID *string `locationName:"JobFlowId" type:"string"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" min:"0" max:"256"`
 
 	metadataAddInstanceGroupsOutput `json:"-", xml:"-"`
 }
@@ -718,7 +986,7 @@ type metadataAddInstanceGroupsOutput struct {
 type AddJobFlowStepsInput struct {
 	// A string that uniquely identifies the job flow. This identifier is returned
 	// by RunJobFlow and can also be obtained from ListClusters.
-	JobFlowID *string `locationName:"JobFlowId" type:"string" required:"true"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" min:"0" max:"256" required:"true"`
 
 	// A list of StepConfig to be executed by the job flow.
 	Steps []*StepConfig `type:"list" required:"true"`
@@ -806,7 +1074,7 @@ type metadataApplication struct {
 // Configuration of a bootstrap action.
 type BootstrapActionConfig struct {
 	// The name of the bootstrap action.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"0" max:"256" required:"true"`
 
 	// The script run by the bootstrap action.
 	ScriptBootstrapAction *ScriptBootstrapActionConfig `type:"structure" required:"true"`
@@ -902,7 +1170,7 @@ type metadataCluster struct {
 // The reason that the cluster changed to its current state.
 type ClusterStateChangeReason struct {
 	// The programmatic code for the state change reason.
-	Code *string `type:"string"`
+	Code *string `type:"string" enum:"INTERNAL_ERROR,VALIDATION_ERROR,INSTANCE_FAILURE,BOOTSTRAP_FAILURE,USER_REQUEST,STEP_FAILURE,ALL_STEPS_COMPLETED"`
 
 	// The descriptive message for the state change reason.
 	Message *string `type:"string"`
@@ -917,7 +1185,7 @@ type metadataClusterStateChangeReason struct {
 // The detailed status of the cluster.
 type ClusterStatus struct {
 	// The current state of the cluster.
-	State *string `type:"string"`
+	State *string `type:"string" enum:"STARTING,BOOTSTRAPPING,RUNNING,WAITING,TERMINATING,TERMINATED,TERMINATED_WITH_ERRORS"`
 
 	// The reason for the cluster status change.
 	StateChangeReason *ClusterStateChangeReason `type:"structure"`
@@ -1133,11 +1401,11 @@ type HadoopJARStepConfig struct {
 	Args []*string `type:"list"`
 
 	// A path to a JAR file run during the step.
-	JAR *string `locationName:"Jar" type:"string" required:"true"`
+	JAR *string `locationName:"Jar" type:"string" min:"0" max:"10280" required:"true"`
 
 	// The name of the main class in the specified Java file. If not specified,
 	// the JAR file should specify a Main-Class in its manifest file.
-	MainClass *string `type:"string"`
+	MainClass *string `type:"string" min:"0" max:"10280"`
 
 	// A list of Java properties that are set when the step runs. You can use these
 	// properties to pass key value pairs to your main function.
@@ -1217,14 +1485,14 @@ type InstanceGroup struct {
 	ID *string `locationName:"Id" type:"string"`
 
 	// The type of the instance group. Valid values are MASTER, CORE or TASK.
-	InstanceGroupType *string `type:"string"`
+	InstanceGroupType *string `type:"string" enum:"MASTER,CORE,TASK"`
 
 	// The EC2 instance type for all instances in the instance group.
-	InstanceType *string `type:"string"`
+	InstanceType *string `type:"string" min:"1" max:"256"`
 
 	// The marketplace to provision instances for this group. Valid values are ON_DEMAND
 	// or SPOT.
-	Market *string `type:"string"`
+	Market *string `type:"string" enum:"ON_DEMAND,SPOT"`
 
 	// The name of the instance group.
 	Name *string `type:"string"`
@@ -1249,22 +1517,22 @@ type metadataInstanceGroup struct {
 type InstanceGroupConfig struct {
 	// Bid price for each Amazon EC2 instance in the instance group when launching
 	// nodes as Spot Instances, expressed in USD.
-	BidPrice *string `type:"string"`
+	BidPrice *string `type:"string" min:"0" max:"256"`
 
 	// Target number of instances for the instance group.
 	InstanceCount *int64 `type:"integer" required:"true"`
 
 	// The role of the instance group in the cluster.
-	InstanceRole *string `type:"string" required:"true"`
+	InstanceRole *string `type:"string" enum:"MASTER,CORE,TASK" required:"true"`
 
 	// The Amazon EC2 instance type for all instances in the instance group.
-	InstanceType *string `type:"string" required:"true"`
+	InstanceType *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// Market type of the Amazon EC2 instances used to create a cluster node.
-	Market *string `type:"string"`
+	Market *string `type:"string" enum:"ON_DEMAND,SPOT"`
 
 	// Friendly name given to the instance group.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"0" max:"256"`
 
 	metadataInstanceGroupConfig `json:"-", xml:"-"`
 }
@@ -1277,7 +1545,7 @@ type metadataInstanceGroupConfig struct {
 type InstanceGroupDetail struct {
 	// Bid price for EC2 Instances when launching nodes as Spot Instances, expressed
 	// in USD.
-	BidPrice *string `type:"string"`
+	BidPrice *string `type:"string" min:"0" max:"256"`
 
 	// The date/time the instance group was created.
 	CreationDateTime *time.Time `type:"timestamp" timestampFormat:"unix" required:"true"`
@@ -1286,28 +1554,28 @@ type InstanceGroupDetail struct {
 	EndDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// Unique identifier for the instance group.
-	InstanceGroupID *string `locationName:"InstanceGroupId" type:"string"`
+	InstanceGroupID *string `locationName:"InstanceGroupId" type:"string" min:"0" max:"256"`
 
 	// Target number of instances to run in the instance group.
 	InstanceRequestCount *int64 `type:"integer" required:"true"`
 
 	// Instance group role in the cluster
-	InstanceRole *string `type:"string" required:"true"`
+	InstanceRole *string `type:"string" enum:"MASTER,CORE,TASK" required:"true"`
 
 	// Actual count of running instances.
 	InstanceRunningCount *int64 `type:"integer" required:"true"`
 
 	// Amazon EC2 Instance type.
-	InstanceType *string `type:"string" required:"true"`
+	InstanceType *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// Details regarding the state of the instance group.
-	LastStateChangeReason *string `type:"string"`
+	LastStateChangeReason *string `type:"string" min:"0" max:"10280"`
 
 	// Market type of the Amazon EC2 instances used to create a cluster node.
-	Market *string `type:"string" required:"true"`
+	Market *string `type:"string" enum:"ON_DEMAND,SPOT" required:"true"`
 
 	// Friendly name for the instance group.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"0" max:"256"`
 
 	// The date/time the instance group was available to the cluster.
 	ReadyDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -1317,7 +1585,7 @@ type InstanceGroupDetail struct {
 
 	// State of instance group. The following values are deprecated: STARTING, TERMINATED,
 	// and FAILED.
-	State *string `type:"string" required:"true"`
+	State *string `type:"string" enum:"PROVISIONING,BOOTSTRAPPING,RUNNING,RESIZING,SUSPENDED,TERMINATING,TERMINATED,ARRESTED,SHUTTING_DOWN,ENDED" required:"true"`
 
 	metadataInstanceGroupDetail `json:"-", xml:"-"`
 }
@@ -1337,7 +1605,7 @@ type InstanceGroupModifyConfig struct {
 	InstanceCount *int64 `type:"integer"`
 
 	// Unique ID of the instance group to expand or shrink.
-	InstanceGroupID *string `locationName:"InstanceGroupId" type:"string" required:"true"`
+	InstanceGroupID *string `locationName:"InstanceGroupId" type:"string" min:"0" max:"256" required:"true"`
 
 	metadataInstanceGroupModifyConfig `json:"-", xml:"-"`
 }
@@ -1349,7 +1617,7 @@ type metadataInstanceGroupModifyConfig struct {
 // The status change reason details for the instance group.
 type InstanceGroupStateChangeReason struct {
 	// The programmable code for the state change reason.
-	Code *string `type:"string"`
+	Code *string `type:"string" enum:"INTERNAL_ERROR,VALIDATION_ERROR,INSTANCE_FAILURE,CLUSTER_TERMINATED"`
 
 	// The status change reason description.
 	Message *string `type:"string"`
@@ -1364,7 +1632,7 @@ type metadataInstanceGroupStateChangeReason struct {
 // The details of the instance group status.
 type InstanceGroupStatus struct {
 	// The current state of the instance group.
-	State *string `type:"string"`
+	State *string `type:"string" enum:"PROVISIONING,BOOTSTRAPPING,RUNNING,RESIZING,SUSPENDED,TERMINATING,TERMINATED,ARRESTED,SHUTTING_DOWN,ENDED"`
 
 	// The status change reason details for the instance group.
 	StateChangeReason *InstanceGroupStateChangeReason `type:"structure"`
@@ -1400,7 +1668,7 @@ type metadataInstanceGroupTimeline struct {
 // The details of the status change reason for the instance.
 type InstanceStateChangeReason struct {
 	// The programmable code for the state change reason.
-	Code *string `type:"string"`
+	Code *string `type:"string" enum:"INTERNAL_ERROR,VALIDATION_ERROR,INSTANCE_FAILURE,BOOTSTRAP_FAILURE,CLUSTER_TERMINATED"`
 
 	// The status change reason description.
 	Message *string `type:"string"`
@@ -1415,7 +1683,7 @@ type metadataInstanceStateChangeReason struct {
 // The instance status details.
 type InstanceStatus struct {
 	// The current state of the instance.
-	State *string `type:"string"`
+	State *string `type:"string" enum:"AWAITING_FULFILLMENT,PROVISIONING,BOOTSTRAPPING,RUNNING,TERMINATED"`
 
 	// The details of the status change reason for the instance.
 	StateChangeReason *InstanceStateChangeReason `type:"structure"`
@@ -1454,7 +1722,7 @@ type JobFlowDetail struct {
 	// flow. For a list of AMI versions currently supported by Amazon ElasticMapReduce,
 	// go to AMI Versions Supported in Elastic MapReduce (http://docs.aws.amazon.com/ElasticMapReduce/latest/DeveloperGuide/EnvironmentConfig_AMIVersion.html#ami-versions-supported)
 	// in the Amazon Elastic MapReduce Developer's Guide.
-	AMIVersion *string `locationName:"AmiVersion" type:"string"`
+	AMIVersion *string `locationName:"AmiVersion" type:"string" min:"0" max:"256"`
 
 	// A list of the bootstrap actions run by the job flow.
 	BootstrapActions []*BootstrapActionDetail `type:"list"`
@@ -1466,21 +1734,21 @@ type JobFlowDetail struct {
 	Instances *JobFlowInstancesDetail `type:"structure" required:"true"`
 
 	// The job flow identifier.
-	JobFlowID *string `locationName:"JobFlowId" type:"string" required:"true"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" min:"0" max:"256" required:"true"`
 
 	// The IAM role that was specified when the job flow was launched. The EC2 instances
 	// of the job flow assume this role.
-	JobFlowRole *string `type:"string"`
+	JobFlowRole *string `type:"string" min:"0" max:"10280"`
 
 	// The location in Amazon S3 where log files for the job are stored.
-	LogURI *string `locationName:"LogUri" type:"string"`
+	LogURI *string `locationName:"LogUri" type:"string" min:"0" max:"10280"`
 
 	// The name of the job flow.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"0" max:"256" required:"true"`
 
 	// The IAM role that will be assumed by the Amazon EMR service to access AWS
 	// resources on your behalf.
-	ServiceRole *string `type:"string"`
+	ServiceRole *string `type:"string" min:"0" max:"10280"`
 
 	// A list of steps run by the job flow.
 	Steps []*StepDetail `type:"list"`
@@ -1514,7 +1782,7 @@ type JobFlowExecutionStatusDetail struct {
 	EndDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// Description of the job flow last changed state.
-	LastStateChangeReason *string `type:"string"`
+	LastStateChangeReason *string `type:"string" min:"0" max:"10280"`
 
 	// The date and time when the job flow was ready to start running bootstrap
 	// actions.
@@ -1524,7 +1792,7 @@ type JobFlowExecutionStatusDetail struct {
 	StartDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The state of the job flow.
-	State *string `type:"string" required:"true"`
+	State *string `type:"string" enum:"STARTING,BOOTSTRAPPING,RUNNING,WAITING,SHUTTING_DOWN,TERMINATED,COMPLETED,FAILED" required:"true"`
 
 	metadataJobFlowExecutionStatusDetail `json:"-", xml:"-"`
 }
@@ -1546,7 +1814,7 @@ type JobFlowInstancesConfig struct {
 
 	// The name of the Amazon EC2 key pair that can be used to ssh to the master
 	// node as the user called "hadoop."
-	EC2KeyName *string `locationName:"Ec2KeyName" type:"string"`
+	EC2KeyName *string `locationName:"Ec2KeyName" type:"string" min:"0" max:"256"`
 
 	// To launch the job flow in Amazon Virtual Private Cloud (Amazon VPC), set
 	// this parameter to the identifier of the Amazon VPC subnet where you want
@@ -1556,21 +1824,21 @@ type JobFlowInstancesConfig struct {
 	//  Amazon VPC currently does not support cluster compute quadruple extra large
 	// (cc1.4xlarge) instances. Thus you cannot specify the cc1.4xlarge instance
 	// type for nodes of a job flow launched in a Amazon VPC.
-	EC2SubnetID *string `locationName:"Ec2SubnetId" type:"string"`
+	EC2SubnetID *string `locationName:"Ec2SubnetId" type:"string" min:"0" max:"256"`
 
 	// The identifier of the Amazon EC2 security group (managed by Amazon ElasticMapReduce)
 	// for the master node.
-	EMRManagedMasterSecurityGroup *string `locationName:"EmrManagedMasterSecurityGroup" type:"string"`
+	EMRManagedMasterSecurityGroup *string `locationName:"EmrManagedMasterSecurityGroup" type:"string" min:"0" max:"256"`
 
 	// The identifier of the Amazon EC2 security group (managed by Amazon ElasticMapReduce)
 	// for the slave nodes.
-	EMRManagedSlaveSecurityGroup *string `locationName:"EmrManagedSlaveSecurityGroup" type:"string"`
+	EMRManagedSlaveSecurityGroup *string `locationName:"EmrManagedSlaveSecurityGroup" type:"string" min:"0" max:"256"`
 
 	// The Hadoop version for the job flow. Valid inputs are "0.18", "0.20", "0.20.205",
 	// "1.0.3", "2.2.0", or "2.4.0". If you do not set this value, the default of
 	// 0.18 is used, unless the AmiVersion parameter is set in the RunJobFlow call,
 	// in which case the default version of Hadoop for that AMI version is used.
-	HadoopVersion *string `type:"string"`
+	HadoopVersion *string `type:"string" min:"0" max:"256"`
 
 	// The number of Amazon EC2 instances used to execute the job flow.
 	InstanceCount *int64 `type:"integer"`
@@ -1582,13 +1850,13 @@ type JobFlowInstancesConfig struct {
 	KeepJobFlowAliveWhenNoSteps *bool `type:"boolean"`
 
 	// The EC2 instance type of the master node.
-	MasterInstanceType *string `type:"string"`
+	MasterInstanceType *string `type:"string" min:"1" max:"256"`
 
 	// The Availability Zone the job flow will run in.
 	Placement *PlacementType `type:"structure"`
 
 	// The EC2 instance type of the slave nodes.
-	SlaveInstanceType *string `type:"string"`
+	SlaveInstanceType *string `type:"string" min:"1" max:"256"`
 
 	// Specifies whether to lock the job flow to prevent the Amazon EC2 instances
 	// from being terminated by API call, user intervention, or in the event of
@@ -1606,14 +1874,14 @@ type metadataJobFlowInstancesConfig struct {
 type JobFlowInstancesDetail struct {
 	// The name of an Amazon EC2 key pair that can be used to ssh to the master
 	// node of job flow.
-	EC2KeyName *string `locationName:"Ec2KeyName" type:"string"`
+	EC2KeyName *string `locationName:"Ec2KeyName" type:"string" min:"0" max:"256"`
 
 	// For job flows launched within Amazon Virtual Private Cloud, this value specifies
 	// the identifier of the subnet where the job flow was launched.
-	EC2SubnetID *string `locationName:"Ec2SubnetId" type:"string"`
+	EC2SubnetID *string `locationName:"Ec2SubnetId" type:"string" min:"0" max:"256"`
 
 	// The Hadoop version for the job flow.
-	HadoopVersion *string `type:"string"`
+	HadoopVersion *string `type:"string" min:"0" max:"256"`
 
 	// The number of Amazon EC2 instances in the cluster. If the value is 1, the
 	// same instance serves as both the master and slave node. If the value is greater
@@ -1627,13 +1895,13 @@ type JobFlowInstancesDetail struct {
 	KeepJobFlowAliveWhenNoSteps *bool `type:"boolean"`
 
 	// The Amazon EC2 instance identifier of the master node.
-	MasterInstanceID *string `locationName:"MasterInstanceId" type:"string"`
+	MasterInstanceID *string `locationName:"MasterInstanceId" type:"string" min:"0" max:"10280"`
 
 	// The Amazon EC2 master node instance type.
-	MasterInstanceType *string `type:"string" required:"true"`
+	MasterInstanceType *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// The DNS name of the master node.
-	MasterPublicDNSName *string `locationName:"MasterPublicDnsName" type:"string"`
+	MasterPublicDNSName *string `locationName:"MasterPublicDnsName" type:"string" min:"0" max:"10280"`
 
 	// An approximation of the cost of the job flow, represented in m1.small/hours.
 	// This value is incremented once for every hour an m1.small runs. Larger instances
@@ -1647,7 +1915,7 @@ type JobFlowInstancesDetail struct {
 	Placement *PlacementType `type:"structure"`
 
 	// The Amazon EC2 slave node instance type.
-	SlaveInstanceType *string `type:"string" required:"true"`
+	SlaveInstanceType *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// Specifies whether the Amazon EC2 instances in the cluster are protected from
 	// termination by API calls, user intervention, or in the event of a job flow
@@ -1664,10 +1932,10 @@ type metadataJobFlowInstancesDetail struct {
 // A key value pair.
 type KeyValue struct {
 	// The unique identifier of a key value pair.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"0" max:"10280"`
 
 	// The value part of the identified key.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"0" max:"10280"`
 
 	metadataKeyValue `json:"-", xml:"-"`
 }
@@ -1869,7 +2137,7 @@ type metadataModifyInstanceGroupsOutput struct {
 // The Amazon EC2 location for the job flow.
 type PlacementType struct {
 	// The Amazon EC2 Availability Zone for the job flow.
-	AvailabilityZone *string `type:"string" required:"true"`
+	AvailabilityZone *string `type:"string" min:"0" max:"10280" required:"true"`
 
 	metadataPlacementType `json:"-", xml:"-"`
 }
@@ -1917,10 +2185,10 @@ type RunJobFlowInput struct {
 	// For details about the AMI versions currently supported by Amazon Elastic
 	// MapReduce, go to AMI Versions Supported in Elastic MapReduce (http://docs.aws.amazon.com/ElasticMapReduce/latest/DeveloperGuide/EnvironmentConfig_AMIVersion.html#ami-versions-supported)
 	// in the Amazon Elastic MapReduce Developer's Guide.
-	AMIVersion *string `locationName:"AmiVersion" type:"string"`
+	AMIVersion *string `locationName:"AmiVersion" type:"string" min:"0" max:"256"`
 
 	// A JSON string for selecting additional features.
-	AdditionalInfo *string `type:"string"`
+	AdditionalInfo *string `type:"string" min:"0" max:"10280"`
 
 	// A list of bootstrap actions that will be run before Hadoop is started on
 	// the cluster nodes.
@@ -1933,14 +2201,14 @@ type RunJobFlowInput struct {
 	// An IAM role for the job flow. The EC2 instances of the job flow assume this
 	// role. The default role is EMRJobflowDefault. In order to use the default
 	// role, you must have already created it using the CLI.
-	JobFlowRole *string `type:"string"`
+	JobFlowRole *string `type:"string" min:"0" max:"10280"`
 
 	// The location in Amazon S3 to write the log files of the job flow. If a value
 	// is not provided, logs are not created.
-	LogURI *string `locationName:"LogUri" type:"string"`
+	LogURI *string `locationName:"LogUri" type:"string" min:"0" max:"10280"`
 
 	// The name of the job flow.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"0" max:"256" required:"true"`
 
 	// A list of strings that indicates third-party software to use with the job
 	// flow that accepts a user argument list. EMR accepts and forwards the argument
@@ -1957,7 +2225,7 @@ type RunJobFlowInput struct {
 
 	// The IAM role that will be assumed by the Amazon EMR service to access AWS
 	// resources on your behalf.
-	ServiceRole *string `type:"string"`
+	ServiceRole *string `type:"string" min:"0" max:"10280"`
 
 	// A list of steps to be executed by the job flow.
 	Steps []*StepConfig `type:"list"`
@@ -1991,7 +2259,7 @@ type metadataRunJobFlowInput struct {
 // The result of the RunJobFlow operation.
 type RunJobFlowOutput struct {
 	// An unique identifier for the job flow.
-	JobFlowID *string `locationName:"JobFlowId" type:"string"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" min:"0" max:"256"`
 
 	metadataRunJobFlowOutput `json:"-", xml:"-"`
 }
@@ -2007,7 +2275,7 @@ type ScriptBootstrapActionConfig struct {
 
 	// Location of the script to run during a bootstrap action. Can be either a
 	// location in Amazon S3 or on a local file system.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"0" max:"10280" required:"true"`
 
 	metadataScriptBootstrapActionConfig `json:"-", xml:"-"`
 }
@@ -2074,7 +2342,7 @@ type metadataSetVisibleToAllUsersOutput struct {
 type Step struct {
 	// This specifies what action to take when the cluster step fails. Possible
 	// values are TERMINATE_CLUSTER, CANCEL_AND_WAIT, and CONTINUE.
-	ActionOnFailure *string `type:"string"`
+	ActionOnFailure *string `type:"string" enum:"TERMINATE_JOB_FLOW,TERMINATE_CLUSTER,CANCEL_AND_WAIT,CONTINUE"`
 
 	// The Hadoop job configuration of the cluster step.
 	Config *HadoopStepConfig `type:"structure"`
@@ -2098,13 +2366,13 @@ type metadataStep struct {
 // Specification of a job flow step.
 type StepConfig struct {
 	// The action to take if the job flow step fails.
-	ActionOnFailure *string `type:"string"`
+	ActionOnFailure *string `type:"string" enum:"TERMINATE_JOB_FLOW,TERMINATE_CLUSTER,CANCEL_AND_WAIT,CONTINUE"`
 
 	// The JAR file used for the job flow step.
 	HadoopJARStep *HadoopJARStepConfig `locationName:"HadoopJarStep" type:"structure" required:"true"`
 
 	// The name of the job flow step.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"0" max:"256" required:"true"`
 
 	metadataStepConfig `json:"-", xml:"-"`
 }
@@ -2137,13 +2405,13 @@ type StepExecutionStatusDetail struct {
 	EndDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// A description of the step's current state.
-	LastStateChangeReason *string `type:"string"`
+	LastStateChangeReason *string `type:"string" min:"0" max:"10280"`
 
 	// The start date and time of the step.
 	StartDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The state of the job flow step.
-	State *string `type:"string" required:"true"`
+	State *string `type:"string" enum:"PENDING,RUNNING,CONTINUE,COMPLETED,CANCELLED,FAILED,INTERRUPTED" required:"true"`
 
 	metadataStepExecutionStatusDetail `json:"-", xml:"-"`
 }
@@ -2155,7 +2423,7 @@ type metadataStepExecutionStatusDetail struct {
 // The details of the step state change reason.
 type StepStateChangeReason struct {
 	// The programmable code for the state change reason.
-	Code *string `type:"string"`
+	Code *string `type:"string" enum:"NONE"`
 
 	// The descriptive message for the state change reason.
 	Message *string `type:"string"`
@@ -2170,7 +2438,7 @@ type metadataStepStateChangeReason struct {
 // The execution status details of the cluster step.
 type StepStatus struct {
 	// The execution state of the cluster step.
-	State *string `type:"string"`
+	State *string `type:"string" enum:"PENDING,RUNNING,COMPLETED,CANCELLED,FAILED,INTERRUPTED"`
 
 	// The reason for the step execution status change.
 	StateChangeReason *StepStateChangeReason `type:"structure"`
@@ -2189,7 +2457,7 @@ type metadataStepStatus struct {
 type StepSummary struct {
 	// This specifies what action to take when the cluster step fails. Possible
 	// values are TERMINATE_CLUSTER, CANCEL_AND_WAIT, and CONTINUE.
-	ActionOnFailure *string `type:"string"`
+	ActionOnFailure *string `type:"string" enum:"TERMINATE_JOB_FLOW,TERMINATE_CLUSTER,CANCEL_AND_WAIT,CONTINUE"`
 
 	// The Hadoop job configuration of the cluster step.
 	Config *HadoopStepConfig `type:"structure"`
@@ -2236,7 +2504,7 @@ type SupportedProductConfig struct {
 	Args []*string `type:"list"`
 
 	// The name of the product configuration.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"0" max:"256"`
 
 	metadataSupportedProductConfig `json:"-", xml:"-"`
 }
@@ -2284,4 +2552,288 @@ type TerminateJobFlowsOutput struct {
 
 type metadataTerminateJobFlowsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for ActionOnFailure.
+const (
+	ActionOnFailureTerminateJobFlow = "TERMINATE_JOB_FLOW"
+	ActionOnFailureTerminateCluster = "TERMINATE_CLUSTER"
+	ActionOnFailureCancelAndWait    = "CANCEL_AND_WAIT"
+	ActionOnFailureContinue         = "CONTINUE"
+)
+
+// ActionOnFailureValues returns the valid values of ActionOnFailure.
+func ActionOnFailureValues() []string {
+	return []string{
+		ActionOnFailureTerminateJobFlow,
+		ActionOnFailureTerminateCluster,
+		ActionOnFailureCancelAndWait,
+		ActionOnFailureContinue,
+	}
+}
+
+// Enum values for ClusterState.
+const (
+	ClusterStateStarting             = "STARTING"
+	ClusterStateBootstrapping        = "BOOTSTRAPPING"
+	ClusterStateRunning              = "RUNNING"
+	ClusterStateWaiting              = "WAITING"
+	ClusterStateTerminating          = "TERMINATING"
+	ClusterStateTerminated           = "TERMINATED"
+	ClusterStateTerminatedWithErrors = "TERMINATED_WITH_ERRORS"
+)
+
+// ClusterStateValues returns the valid values of ClusterState.
+func ClusterStateValues() []string {
+	return []string{
+		ClusterStateStarting,
+		ClusterStateBootstrapping,
+		ClusterStateRunning,
+		ClusterStateWaiting,
+		ClusterStateTerminating,
+		ClusterStateTerminated,
+		ClusterStateTerminatedWithErrors,
+	}
+}
+
+// Enum values for ClusterStateChangeReasonCode.
+const (
+	ClusterStateChangeReasonCodeInternalError     = "INTERNAL_ERROR"
+	ClusterStateChangeReasonCodeValidationError   = "VALIDATION_ERROR"
+	ClusterStateChangeReasonCodeInstanceFailure   = "INSTANCE_FAILURE"
+	ClusterStateChangeReasonCodeBootstrapFailure  = "BOOTSTRAP_FAILURE"
+	ClusterStateChangeReasonCodeUserRequest       = "USER_REQUEST"
+	ClusterStateChangeReasonCodeStepFailure       = "STEP_FAILURE"
+	ClusterStateChangeReasonCodeAllStepsCompleted = "ALL_STEPS_COMPLETED"
+)
+
+// ClusterStateChangeReasonCodeValues returns the valid values of ClusterStateChangeReasonCode.
+func ClusterStateChangeReasonCodeValues() []string {
+	return []string{
+		ClusterStateChangeReasonCodeInternalError,
+		ClusterStateChangeReasonCodeValidationError,
+		ClusterStateChangeReasonCodeInstanceFailure,
+		ClusterStateChangeReasonCodeBootstrapFailure,
+		ClusterStateChangeReasonCodeUserRequest,
+		ClusterStateChangeReasonCodeStepFailure,
+		ClusterStateChangeReasonCodeAllStepsCompleted,
+	}
+}
+
+// Enum values for InstanceGroupState.
+const (
+	InstanceGroupStateProvisioning  = "PROVISIONING"
+	InstanceGroupStateBootstrapping = "BOOTSTRAPPING"
+	InstanceGroupStateRunning       = "RUNNING"
+	InstanceGroupStateResizing      = "RESIZING"
+	InstanceGroupStateSuspended     = "SUSPENDED"
+	InstanceGroupStateTerminating   = "TERMINATING"
+	InstanceGroupStateTerminated    = "TERMINATED"
+	InstanceGroupStateArrested      = "ARRESTED"
+	InstanceGroupStateShuttingDown  = "SHUTTING_DOWN"
+	InstanceGroupStateEnded         = "ENDED"
+)
+
+// InstanceGroupStateValues returns the valid values of InstanceGroupState.
+func InstanceGroupStateValues() []string {
+	return []string{
+		InstanceGroupStateProvisioning,
+		InstanceGroupStateBootstrapping,
+		InstanceGroupStateRunning,
+		InstanceGroupStateResizing,
+		InstanceGroupStateSuspended,
+		InstanceGroupStateTerminating,
+		InstanceGroupStateTerminated,
+		InstanceGroupStateArrested,
+		InstanceGroupStateShuttingDown,
+		InstanceGroupStateEnded,
+	}
+}
+
+// Enum values for InstanceGroupStateChangeReasonCode.
+const (
+	InstanceGroupStateChangeReasonCodeInternalError     = "INTERNAL_ERROR"
+	InstanceGroupStateChangeReasonCodeValidationError   = "VALIDATION_ERROR"
+	InstanceGroupStateChangeReasonCodeInstanceFailure   = "INSTANCE_FAILURE"
+	InstanceGroupStateChangeReasonCodeClusterTerminated = "CLUSTER_TERMINATED"
+)
+
+// InstanceGroupStateChangeReasonCodeValues returns the valid values of InstanceGroupStateChangeReasonCode.
+func InstanceGroupStateChangeReasonCodeValues() []string {
+	return []string{
+		InstanceGroupStateChangeReasonCodeInternalError,
+		InstanceGroupStateChangeReasonCodeValidationError,
+		InstanceGroupStateChangeReasonCodeInstanceFailure,
+		InstanceGroupStateChangeReasonCodeClusterTerminated,
+	}
+}
+
+// Enum values for InstanceGroupType.
+const (
+	InstanceGroupTypeMaster = "MASTER"
+	InstanceGroupTypeCore   = "CORE"
+	InstanceGroupTypeTask   = "TASK"
+)
+
+// InstanceGroupTypeValues returns the valid values of InstanceGroupType.
+func InstanceGroupTypeValues() []string {
+	return []string{
+		InstanceGroupTypeMaster,
+		InstanceGroupTypeCore,
+		InstanceGroupTypeTask,
+	}
+}
+
+// Enum values for InstanceRoleType.
+const (
+	InstanceRoleTypeMaster = "MASTER"
+	InstanceRoleTypeCore   = "CORE"
+	InstanceRoleTypeTask   = "TASK"
+)
+
+// InstanceRoleTypeValues returns the valid values of InstanceRoleType.
+func InstanceRoleTypeValues() []string {
+	return []string{
+		InstanceRoleTypeMaster,
+		InstanceRoleTypeCore,
+		InstanceRoleTypeTask,
+	}
+}
+
+// Enum values for InstanceState.
+const (
+	InstanceStateAwaitingFulfillment = "AWAITING_FULFILLMENT"
+	InstanceStateProvisioning        = "PROVISIONING"
+	InstanceStateBootstrapping       = "BOOTSTRAPPING"
+	InstanceStateRunning             = "RUNNING"
+	InstanceStateTerminated          = "TERMINATED"
+)
+
+// InstanceStateValues returns the valid values of InstanceState.
+func InstanceStateValues() []string {
+	return []string{
+		InstanceStateAwaitingFulfillment,
+		InstanceStateProvisioning,
+		InstanceStateBootstrapping,
+		InstanceStateRunning,
+		InstanceStateTerminated,
+	}
+}
+
+// Enum values for InstanceStateChangeReasonCode.
+const (
+	InstanceStateChangeReasonCodeInternalError     = "INTERNAL_ERROR"
+	InstanceStateChangeReasonCodeValidationError   = "VALIDATION_ERROR"
+	InstanceStateChangeReasonCodeInstanceFailure   = "INSTANCE_FAILURE"
+	InstanceStateChangeReasonCodeBootstrapFailure  = "BOOTSTRAP_FAILURE"
+	InstanceStateChangeReasonCodeClusterTerminated = "CLUSTER_TERMINATED"
+)
+
+// InstanceStateChangeReasonCodeValues returns the valid values of InstanceStateChangeReasonCode.
+func InstanceStateChangeReasonCodeValues() []string {
+	return []string{
+		InstanceStateChangeReasonCodeInternalError,
+		InstanceStateChangeReasonCodeValidationError,
+		InstanceStateChangeReasonCodeInstanceFailure,
+		InstanceStateChangeReasonCodeBootstrapFailure,
+		InstanceStateChangeReasonCodeClusterTerminated,
+	}
+}
+
+// Enum values for JobFlowExecutionState.
+const (
+	JobFlowExecutionStateStarting      = "STARTING"
+	JobFlowExecutionStateBootstrapping = "BOOTSTRAPPING"
+	JobFlowExecutionStateRunning       = "RUNNING"
+	JobFlowExecutionStateWaiting       = "WAITING"
+	JobFlowExecutionStateShuttingDown  = "SHUTTING_DOWN"
+	JobFlowExecutionStateTerminated    = "TERMINATED"
+	JobFlowExecutionStateCompleted     = "COMPLETED"
+	JobFlowExecutionStateFailed        = "FAILED"
+)
+
+// JobFlowExecutionStateValues returns the valid values of JobFlowExecutionState.
+func JobFlowExecutionStateValues() []string {
+	return []string{
+		JobFlowExecutionStateStarting,
+		JobFlowExecutionStateBootstrapping,
+		JobFlowExecutionStateRunning,
+		JobFlowExecutionStateWaiting,
+		JobFlowExecutionStateShuttingDown,
+		JobFlowExecutionStateTerminated,
+		JobFlowExecutionStateCompleted,
+		JobFlowExecutionStateFailed,
+	}
+}
+
+// Enum values for MarketType.
+const (
+	MarketTypeONDemand = "ON_DEMAND"
+	MarketTypeSpot     = "SPOT"
+)
+
+// MarketTypeValues returns the valid values of MarketType.
+func MarketTypeValues() []string {
+	return []string{
+		MarketTypeONDemand,
+		MarketTypeSpot,
+	}
+}
+
+// Enum values for StepExecutionState.
+const (
+	StepExecutionStatePending     = "PENDING"
+	StepExecutionStateRunning     = "RUNNING"
+	StepExecutionStateContinue    = "CONTINUE"
+	StepExecutionStateCompleted   = "COMPLETED"
+	StepExecutionStateCancelled   = "CANCELLED"
+	StepExecutionStateFailed      = "FAILED"
+	StepExecutionStateInterrupted = "INTERRUPTED"
+)
+
+// StepExecutionStateValues returns the valid values of StepExecutionState.
+func StepExecutionStateValues() []string {
+	return []string{
+		StepExecutionStatePending,
+		StepExecutionStateRunning,
+		StepExecutionStateContinue,
+		StepExecutionStateCompleted,
+		StepExecutionStateCancelled,
+		StepExecutionStateFailed,
+		StepExecutionStateInterrupted,
+	}
+}
+
+// Enum values for StepState.
+const (
+	StepStatePending     = "PENDING"
+	StepStateRunning     = "RUNNING"
+	StepStateCompleted   = "COMPLETED"
+	StepStateCancelled   = "CANCELLED"
+	StepStateFailed      = "FAILED"
+	StepStateInterrupted = "INTERRUPTED"
+)
+
+// StepStateValues returns the valid values of StepState.
+func StepStateValues() []string {
+	return []string{
+		StepStatePending,
+		StepStateRunning,
+		StepStateCompleted,
+		StepStateCancelled,
+		StepStateFailed,
+		StepStateInterrupted,
+	}
+}
+
+// Enum values for StepStateChangeReasonCode.
+const (
+	StepStateChangeReasonCodeNone = "NONE"
+)
+
+// StepStateChangeReasonCodeValues returns the valid values of StepStateChangeReasonCode.
+func StepStateChangeReasonCodeValues() []string {
+	return []string{
+		StepStateChangeReasonCodeNone,
+	}
 }
\ No newline at end of file
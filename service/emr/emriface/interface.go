@@ -1,41 +1,84 @@
 package emriface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/emr"
 )
 
 type EMRAPI interface {
 	AddInstanceGroups(*emr.AddInstanceGroupsInput) (*emr.AddInstanceGroupsOutput, error)
 
+	AddInstanceGroupsWithContext(context.Context, *emr.AddInstanceGroupsInput) (*emr.AddInstanceGroupsOutput, error)
+
 	AddJobFlowSteps(*emr.AddJobFlowStepsInput) (*emr.AddJobFlowStepsOutput, error)
 
+	AddJobFlowStepsWithContext(context.Context, *emr.AddJobFlowStepsInput) (*emr.AddJobFlowStepsOutput, error)
+
 	AddTags(*emr.AddTagsInput) (*emr.AddTagsOutput, error)
 
+	AddTagsWithContext(context.Context, *emr.AddTagsInput) (*emr.AddTagsOutput, error)
+
 	DescribeCluster(*emr.DescribeClusterInput) (*emr.DescribeClusterOutput, error)
 
+	DescribeClusterWithContext(context.Context, *emr.DescribeClusterInput) (*emr.DescribeClusterOutput, error)
+
 	DescribeJobFlows(*emr.DescribeJobFlowsInput) (*emr.DescribeJobFlowsOutput, error)
 
+	DescribeJobFlowsWithContext(context.Context, *emr.DescribeJobFlowsInput) (*emr.DescribeJobFlowsOutput, error)
+
 	DescribeStep(*emr.DescribeStepInput) (*emr.DescribeStepOutput, error)
 
+	DescribeStepWithContext(context.Context, *emr.DescribeStepInput) (*emr.DescribeStepOutput, error)
+
 	ListBootstrapActions(*emr.ListBootstrapActionsInput) (*emr.ListBootstrapActionsOutput, error)
 
+	ListBootstrapActionsWithContext(context.Context, *emr.ListBootstrapActionsInput) (*emr.ListBootstrapActionsOutput, error)
+	ListBootstrapActionsPages(*emr.ListBootstrapActionsInput, func(*emr.ListBootstrapActionsOutput, bool) bool) error
+
 	ListClusters(*emr.ListClustersInput) (*emr.ListClustersOutput, error)
 
+	ListClustersWithContext(context.Context, *emr.ListClustersInput) (*emr.ListClustersOutput, error)
+	ListClustersPages(*emr.ListClustersInput, func(*emr.ListClustersOutput, bool) bool) error
+
 	ListInstanceGroups(*emr.ListInstanceGroupsInput) (*emr.ListInstanceGroupsOutput, error)
 
+	ListInstanceGroupsWithContext(context.Context, *emr.ListInstanceGroupsInput) (*emr.ListInstanceGroupsOutput, error)
+	ListInstanceGroupsPages(*emr.ListInstanceGroupsInput, func(*emr.ListInstanceGroupsOutput, bool) bool) error
+
 	ListInstances(*emr.ListInstancesInput) (*emr.ListInstancesOutput, error)
 
+	ListInstancesWithContext(context.Context, *emr.ListInstancesInput) (*emr.ListInstancesOutput, error)
+	ListInstancesPages(*emr.ListInstancesInput, func(*emr.ListInstancesOutput, bool) bool) error
+
 	ListSteps(*emr.ListStepsInput) (*emr.ListStepsOutput, error)
 
+	ListStepsWithContext(context.Context, *emr.ListStepsInput) (*emr.ListStepsOutput, error)
+	ListStepsPages(*emr.ListStepsInput, func(*emr.ListStepsOutput, bool) bool) error
+
 	ModifyInstanceGroups(*emr.ModifyInstanceGroupsInput) (*emr.ModifyInstanceGroupsOutput, error)
 
+	ModifyInstanceGroupsWithContext(context.Context, *emr.ModifyInstanceGroupsInput) (*emr.ModifyInstanceGroupsOutput, error)
+
 	RemoveTags(*emr.RemoveTagsInput) (*emr.RemoveTagsOutput, error)
 
+	RemoveTagsWithContext(context.Context, *emr.RemoveTagsInput) (*emr.RemoveTagsOutput, error)
+
 	RunJobFlow(*emr.RunJobFlowInput) (*emr.RunJobFlowOutput, error)
 
+	RunJobFlowWithContext(context.Context, *emr.RunJobFlowInput) (*emr.RunJobFlowOutput, error)
+
 	SetTerminationProtection(*emr.SetTerminationProtectionInput) (*emr.SetTerminationProtectionOutput, error)
 
+	SetTerminationProtectionWithContext(context.Context, *emr.SetTerminationProtectionInput) (*emr.SetTerminationProtectionOutput, error)
+
 	SetVisibleToAllUsers(*emr.SetVisibleToAllUsersInput) (*emr.SetVisibleToAllUsersOutput, error)
 
+	SetVisibleToAllUsersWithContext(context.Context, *emr.SetVisibleToAllUsersInput) (*emr.SetVisibleToAllUsersOutput, error)
+
 	TerminateJobFlows(*emr.TerminateJobFlowsInput) (*emr.TerminateJobFlowsOutput, error)
+
+	TerminateJobFlowsWithContext(context.Context, *emr.TerminateJobFlowsInput) (*emr.TerminateJobFlowsOutput, error)
+
+	WaitUntilClusterRunning(*emr.DescribeClusterInput) error
 }
\ No newline at end of file
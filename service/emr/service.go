@@ -29,6 +29,7 @@ func New(config *aws.Config) *EMR {
 		APIVersion:   "2009-03-31",
 		JSONVersion:  "1.1",
 		TargetPrefix: "ElasticMapReduce",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package glacier
 
 import (
+	"context"
 	"io"
 	"sync"
 
@@ -62,6 +63,17 @@ func (c *Glacier) AbortMultipartUpload(input *AbortMultipartUploadInput) (output
 	return
 }
 
+// AbortMultipartUploadWithContext is the same as AbortMultipartUpload with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) AbortMultipartUploadWithContext(ctx context.Context, input *AbortMultipartUploadInput) (output *AbortMultipartUploadOutput, err error) {
+	req, out := c.AbortMultipartUploadRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAbortMultipartUpload *aws.Operation
 
 // CompleteMultipartUploadRequest generates a request for the CompleteMultipartUpload operation.
@@ -138,6 +150,17 @@ func (c *Glacier) CompleteMultipartUpload(input *CompleteMultipartUploadInput) (
 	return
 }
 
+// CompleteMultipartUploadWithContext is the same as CompleteMultipartUpload with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) CompleteMultipartUploadWithContext(ctx context.Context, input *CompleteMultipartUploadInput) (output *ArchiveCreationOutput, err error) {
+	req, out := c.CompleteMultipartUploadRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCompleteMultipartUpload *aws.Operation
 
 // CreateVaultRequest generates a request for the CreateVault operation.
@@ -194,6 +217,17 @@ func (c *Glacier) CreateVault(input *CreateVaultInput) (output *CreateVaultOutpu
 	return
 }
 
+// CreateVaultWithContext is the same as CreateVault with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) CreateVaultWithContext(ctx context.Context, input *CreateVaultInput) (output *CreateVaultOutput, err error) {
+	req, out := c.CreateVaultRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateVault *aws.Operation
 
 // DeleteArchiveRequest generates a request for the DeleteArchive operation.
@@ -247,6 +281,17 @@ func (c *Glacier) DeleteArchive(input *DeleteArchiveInput) (output *DeleteArchiv
 	return
 }
 
+// DeleteArchiveWithContext is the same as DeleteArchive with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) DeleteArchiveWithContext(ctx context.Context, input *DeleteArchiveInput) (output *DeleteArchiveOutput, err error) {
+	req, out := c.DeleteArchiveRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteArchive *aws.Operation
 
 // DeleteVaultRequest generates a request for the DeleteVault operation.
@@ -302,6 +347,17 @@ func (c *Glacier) DeleteVault(input *DeleteVaultInput) (output *DeleteVaultOutpu
 	return
 }
 
+// DeleteVaultWithContext is the same as DeleteVault with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) DeleteVaultWithContext(ctx context.Context, input *DeleteVaultInput) (output *DeleteVaultOutput, err error) {
+	req, out := c.DeleteVaultRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteVault *aws.Operation
 
 // DeleteVaultNotificationsRequest generates a request for the DeleteVaultNotifications operation.
@@ -349,6 +405,17 @@ func (c *Glacier) DeleteVaultNotifications(input *DeleteVaultNotificationsInput)
 	return
 }
 
+// DeleteVaultNotificationsWithContext is the same as DeleteVaultNotifications with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) DeleteVaultNotificationsWithContext(ctx context.Context, input *DeleteVaultNotificationsInput) (output *DeleteVaultNotificationsOutput, err error) {
+	req, out := c.DeleteVaultNotificationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteVaultNotifications *aws.Operation
 
 // DescribeJobRequest generates a request for the DescribeJob operation.
@@ -403,6 +470,17 @@ func (c *Glacier) DescribeJob(input *DescribeJobInput) (output *GlacierJobDescri
 	return
 }
 
+// DescribeJobWithContext is the same as DescribeJob with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) DescribeJobWithContext(ctx context.Context, input *DescribeJobInput) (output *GlacierJobDescription, err error) {
+	req, out := c.DescribeJobRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeJob *aws.Operation
 
 // DescribeVaultRequest generates a request for the DescribeVault operation.
@@ -455,6 +533,17 @@ func (c *Glacier) DescribeVault(input *DescribeVaultInput) (output *DescribeVaul
 	return
 }
 
+// DescribeVaultWithContext is the same as DescribeVault with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) DescribeVaultWithContext(ctx context.Context, input *DescribeVaultInput) (output *DescribeVaultOutput, err error) {
+	req, out := c.DescribeVaultRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeVault *aws.Operation
 
 // GetDataRetrievalPolicyRequest generates a request for the GetDataRetrievalPolicy operation.
@@ -487,6 +576,17 @@ func (c *Glacier) GetDataRetrievalPolicy(input *GetDataRetrievalPolicyInput) (ou
 	return
 }
 
+// GetDataRetrievalPolicyWithContext is the same as GetDataRetrievalPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) GetDataRetrievalPolicyWithContext(ctx context.Context, input *GetDataRetrievalPolicyInput) (output *GetDataRetrievalPolicyOutput, err error) {
+	req, out := c.GetDataRetrievalPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetDataRetrievalPolicy *aws.Operation
 
 // GetJobOutputRequest generates a request for the GetJobOutput operation.
@@ -563,6 +663,17 @@ func (c *Glacier) GetJobOutput(input *GetJobOutputInput) (output *GetJobOutputOu
 	return
 }
 
+// GetJobOutputWithContext is the same as GetJobOutput with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) GetJobOutputWithContext(ctx context.Context, input *GetJobOutputInput) (output *GetJobOutputOutput, err error) {
+	req, out := c.GetJobOutputRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetJobOutput *aws.Operation
 
 // GetVaultNotificationsRequest generates a request for the GetVaultNotifications operation.
@@ -614,6 +725,17 @@ func (c *Glacier) GetVaultNotifications(input *GetVaultNotificationsInput) (outp
 	return
 }
 
+// GetVaultNotificationsWithContext is the same as GetVaultNotifications with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) GetVaultNotificationsWithContext(ctx context.Context, input *GetVaultNotificationsInput) (output *GetVaultNotificationsOutput, err error) {
+	req, out := c.GetVaultNotificationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetVaultNotifications *aws.Operation
 
 // InitiateJobRequest generates a request for the InitiateJob operation.
@@ -756,6 +878,17 @@ func (c *Glacier) InitiateJob(input *InitiateJobInput) (output *InitiateJobOutpu
 	return
 }
 
+// InitiateJobWithContext is the same as InitiateJob with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) InitiateJobWithContext(ctx context.Context, input *InitiateJobInput) (output *InitiateJobOutput, err error) {
+	req, out := c.InitiateJobRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opInitiateJob *aws.Operation
 
 // InitiateMultipartUploadRequest generates a request for the InitiateMultipartUpload operation.
@@ -823,6 +956,17 @@ func (c *Glacier) InitiateMultipartUpload(input *InitiateMultipartUploadInput) (
 	return
 }
 
+// InitiateMultipartUploadWithContext is the same as InitiateMultipartUpload with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) InitiateMultipartUploadWithContext(ctx context.Context, input *InitiateMultipartUploadInput) (output *InitiateMultipartUploadOutput, err error) {
+	req, out := c.InitiateMultipartUploadRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opInitiateMultipartUpload *aws.Operation
 
 // ListJobsRequest generates a request for the ListJobs operation.
@@ -895,6 +1039,17 @@ func (c *Glacier) ListJobs(input *ListJobsInput) (output *ListJobsOutput, err er
 	return
 }
 
+// ListJobsWithContext is the same as ListJobs with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) ListJobsWithContext(ctx context.Context, input *ListJobsInput) (output *ListJobsOutput, err error) {
+	req, out := c.ListJobsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListJobs *aws.Operation
 
 // ListMultipartUploadsRequest generates a request for the ListMultipartUploads operation.
@@ -957,6 +1112,17 @@ func (c *Glacier) ListMultipartUploads(input *ListMultipartUploadsInput) (output
 	return
 }
 
+// ListMultipartUploadsWithContext is the same as ListMultipartUploads with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) ListMultipartUploadsWithContext(ctx context.Context, input *ListMultipartUploadsInput) (output *ListMultipartUploadsOutput, err error) {
+	req, out := c.ListMultipartUploadsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListMultipartUploads *aws.Operation
 
 // ListPartsRequest generates a request for the ListParts operation.
@@ -1013,6 +1179,17 @@ func (c *Glacier) ListParts(input *ListPartsInput) (output *ListPartsOutput, err
 	return
 }
 
+// ListPartsWithContext is the same as ListParts with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) ListPartsWithContext(ctx context.Context, input *ListPartsInput) (output *ListPartsOutput, err error) {
+	req, out := c.ListPartsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListParts *aws.Operation
 
 // ListVaultsRequest generates a request for the ListVaults operation.
@@ -1067,6 +1244,17 @@ func (c *Glacier) ListVaults(input *ListVaultsInput) (output *ListVaultsOutput,
 	return
 }
 
+// ListVaultsWithContext is the same as ListVaults with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) ListVaultsWithContext(ctx context.Context, input *ListVaultsInput) (output *ListVaultsOutput, err error) {
+	req, out := c.ListVaultsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListVaults *aws.Operation
 
 // SetDataRetrievalPolicyRequest generates a request for the SetDataRetrievalPolicy operation.
@@ -1099,6 +1287,17 @@ func (c *Glacier) SetDataRetrievalPolicy(input *SetDataRetrievalPolicyInput) (ou
 	return
 }
 
+// SetDataRetrievalPolicyWithContext is the same as SetDataRetrievalPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) SetDataRetrievalPolicyWithContext(ctx context.Context, input *SetDataRetrievalPolicyInput) (output *SetDataRetrievalPolicyOutput, err error) {
+	req, out := c.SetDataRetrievalPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetDataRetrievalPolicy *aws.Operation
 
 // SetVaultNotificationsRequest generates a request for the SetVaultNotifications operation.
@@ -1160,6 +1359,17 @@ func (c *Glacier) SetVaultNotifications(input *SetVaultNotificationsInput) (outp
 	return
 }
 
+// SetVaultNotificationsWithContext is the same as SetVaultNotifications with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) SetVaultNotificationsWithContext(ctx context.Context, input *SetVaultNotificationsInput) (output *SetVaultNotificationsOutput, err error) {
+	req, out := c.SetVaultNotificationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetVaultNotifications *aws.Operation
 
 // UploadArchiveRequest generates a request for the UploadArchive operation.
@@ -1228,6 +1438,17 @@ func (c *Glacier) UploadArchive(input *UploadArchiveInput) (output *ArchiveCreat
 	return
 }
 
+// UploadArchiveWithContext is the same as UploadArchive with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) UploadArchiveWithContext(ctx context.Context, input *UploadArchiveInput) (output *ArchiveCreationOutput, err error) {
+	req, out := c.UploadArchiveRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUploadArchive *aws.Operation
 
 // UploadMultipartPartRequest generates a request for the UploadMultipartPart operation.
@@ -1303,6 +1524,17 @@ func (c *Glacier) UploadMultipartPart(input *UploadMultipartPartInput) (output *
 	return
 }
 
+// UploadMultipartPartWithContext is the same as UploadMultipartPart with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Glacier) UploadMultipartPartWithContext(ctx context.Context, input *UploadMultipartPartInput) (output *UploadMultipartPartOutput, err error) {
+	req, out := c.UploadMultipartPartRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUploadMultipartPart *aws.Operation
 
 // Provides options to abort a multipart upload identified by the upload ID.
@@ -1729,7 +1961,7 @@ type metadataGetVaultNotificationsOutput struct {
 // Describes an Amazon Glacier job.
 type GlacierJobDescription struct {
 	// The job type. It is either ArchiveRetrieval or InventoryRetrieval.
-	Action *string `type:"string"`
+	Action *string `type:"string" enum:"ArchiveRetrieval,InventoryRetrieval"`
 
 	// For an ArchiveRetrieval job, this is the archive ID requested for download.
 	// Otherwise, this field is null.
@@ -1797,7 +2029,7 @@ type GlacierJobDescription struct {
 
 	// The status code can be InProgress, Succeeded, or Failed, and indicates the
 	// status of the job.
-	StatusCode *string `type:"string"`
+	StatusCode *string `type:"string" enum:"InProgress,Succeeded,Failed"`
 
 	// A friendly message that describes the job status.
 	StatusMessage *string `type:"string"`
@@ -2395,4 +2627,34 @@ type VaultNotificationConfig struct {
 
 type metadataVaultNotificationConfig struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for ActionCode.
+const (
+	ActionCodeArchiveRetrieval   = "ArchiveRetrieval"
+	ActionCodeInventoryRetrieval = "InventoryRetrieval"
+)
+
+// ActionCodeValues returns the valid values of ActionCode.
+func ActionCodeValues() []string {
+	return []string{
+		ActionCodeArchiveRetrieval,
+		ActionCodeInventoryRetrieval,
+	}
+}
+
+// Enum values for StatusCode.
+const (
+	StatusCodeInProgress = "InProgress"
+	StatusCodeSucceeded  = "Succeeded"
+	StatusCodeFailed     = "Failed"
+)
+
+// StatusCodeValues returns the valid values of StatusCode.
+func StatusCodeValues() []string {
+	return []string{
+		StatusCodeInProgress,
+		StatusCodeSucceeded,
+		StatusCodeFailed,
+	}
 }
\ No newline at end of file
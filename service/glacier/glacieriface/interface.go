@@ -1,49 +1,93 @@
 package glacieriface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/glacier"
 )
 
 type GlacierAPI interface {
 	AbortMultipartUpload(*glacier.AbortMultipartUploadInput) (*glacier.AbortMultipartUploadOutput, error)
 
+	AbortMultipartUploadWithContext(context.Context, *glacier.AbortMultipartUploadInput) (*glacier.AbortMultipartUploadOutput, error)
+
 	CompleteMultipartUpload(*glacier.CompleteMultipartUploadInput) (*glacier.ArchiveCreationOutput, error)
 
+	CompleteMultipartUploadWithContext(context.Context, *glacier.CompleteMultipartUploadInput) (*glacier.ArchiveCreationOutput, error)
+
 	CreateVault(*glacier.CreateVaultInput) (*glacier.CreateVaultOutput, error)
 
+	CreateVaultWithContext(context.Context, *glacier.CreateVaultInput) (*glacier.CreateVaultOutput, error)
+
 	DeleteArchive(*glacier.DeleteArchiveInput) (*glacier.DeleteArchiveOutput, error)
 
+	DeleteArchiveWithContext(context.Context, *glacier.DeleteArchiveInput) (*glacier.DeleteArchiveOutput, error)
+
 	DeleteVault(*glacier.DeleteVaultInput) (*glacier.DeleteVaultOutput, error)
 
+	DeleteVaultWithContext(context.Context, *glacier.DeleteVaultInput) (*glacier.DeleteVaultOutput, error)
+
 	DeleteVaultNotifications(*glacier.DeleteVaultNotificationsInput) (*glacier.DeleteVaultNotificationsOutput, error)
 
+	DeleteVaultNotificationsWithContext(context.Context, *glacier.DeleteVaultNotificationsInput) (*glacier.DeleteVaultNotificationsOutput, error)
+
 	DescribeJob(*glacier.DescribeJobInput) (*glacier.GlacierJobDescription, error)
 
+	DescribeJobWithContext(context.Context, *glacier.DescribeJobInput) (*glacier.GlacierJobDescription, error)
+
 	DescribeVault(*glacier.DescribeVaultInput) (*glacier.DescribeVaultOutput, error)
 
+	DescribeVaultWithContext(context.Context, *glacier.DescribeVaultInput) (*glacier.DescribeVaultOutput, error)
+
 	GetDataRetrievalPolicy(*glacier.GetDataRetrievalPolicyInput) (*glacier.GetDataRetrievalPolicyOutput, error)
 
+	GetDataRetrievalPolicyWithContext(context.Context, *glacier.GetDataRetrievalPolicyInput) (*glacier.GetDataRetrievalPolicyOutput, error)
+
 	GetJobOutput(*glacier.GetJobOutputInput) (*glacier.GetJobOutputOutput, error)
 
+	GetJobOutputWithContext(context.Context, *glacier.GetJobOutputInput) (*glacier.GetJobOutputOutput, error)
+
 	GetVaultNotifications(*glacier.GetVaultNotificationsInput) (*glacier.GetVaultNotificationsOutput, error)
 
+	GetVaultNotificationsWithContext(context.Context, *glacier.GetVaultNotificationsInput) (*glacier.GetVaultNotificationsOutput, error)
+
 	InitiateJob(*glacier.InitiateJobInput) (*glacier.InitiateJobOutput, error)
 
+	InitiateJobWithContext(context.Context, *glacier.InitiateJobInput) (*glacier.InitiateJobOutput, error)
+
 	InitiateMultipartUpload(*glacier.InitiateMultipartUploadInput) (*glacier.InitiateMultipartUploadOutput, error)
 
+	InitiateMultipartUploadWithContext(context.Context, *glacier.InitiateMultipartUploadInput) (*glacier.InitiateMultipartUploadOutput, error)
+
 	ListJobs(*glacier.ListJobsInput) (*glacier.ListJobsOutput, error)
 
+	ListJobsWithContext(context.Context, *glacier.ListJobsInput) (*glacier.ListJobsOutput, error)
+
 	ListMultipartUploads(*glacier.ListMultipartUploadsInput) (*glacier.ListMultipartUploadsOutput, error)
 
+	ListMultipartUploadsWithContext(context.Context, *glacier.ListMultipartUploadsInput) (*glacier.ListMultipartUploadsOutput, error)
+
 	ListParts(*glacier.ListPartsInput) (*glacier.ListPartsOutput, error)
 
+	ListPartsWithContext(context.Context, *glacier.ListPartsInput) (*glacier.ListPartsOutput, error)
+
 	ListVaults(*glacier.ListVaultsInput) (*glacier.ListVaultsOutput, error)
 
+	ListVaultsWithContext(context.Context, *glacier.ListVaultsInput) (*glacier.ListVaultsOutput, error)
+
 	SetDataRetrievalPolicy(*glacier.SetDataRetrievalPolicyInput) (*glacier.SetDataRetrievalPolicyOutput, error)
 
+	SetDataRetrievalPolicyWithContext(context.Context, *glacier.SetDataRetrievalPolicyInput) (*glacier.SetDataRetrievalPolicyOutput, error)
+
 	SetVaultNotifications(*glacier.SetVaultNotificationsInput) (*glacier.SetVaultNotificationsOutput, error)
 
+	SetVaultNotificationsWithContext(context.Context, *glacier.SetVaultNotificationsInput) (*glacier.SetVaultNotificationsOutput, error)
+
 	UploadArchive(*glacier.UploadArchiveInput) (*glacier.ArchiveCreationOutput, error)
 
+	UploadArchiveWithContext(context.Context, *glacier.UploadArchiveInput) (*glacier.ArchiveCreationOutput, error)
+
 	UploadMultipartPart(*glacier.UploadMultipartPartInput) (*glacier.UploadMultipartPartOutput, error)
+
+	UploadMultipartPartWithContext(context.Context, *glacier.UploadMultipartPartInput) (*glacier.UploadMultipartPartOutput, error)
 }
\ No newline at end of file
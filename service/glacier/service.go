@@ -27,6 +27,7 @@ func New(config *aws.Config) *Glacier {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "glacier",
 		APIVersion:  "2012-06-01",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package iam
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -47,6 +48,17 @@ func (c *IAM) AddClientIDToOpenIDConnectProvider(input *AddClientIDToOpenIDConne
 	return
 }
 
+// AddClientIDToOpenIDConnectProviderWithContext is the same as AddClientIDToOpenIDConnectProvider with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) AddClientIDToOpenIDConnectProviderWithContext(ctx context.Context, input *AddClientIDToOpenIDConnectProviderInput) (output *AddClientIDToOpenIDConnectProviderOutput, err error) {
+	req, out := c.AddClientIDToOpenIDConnectProviderRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddClientIDToOpenIDConnectProvider *aws.Operation
 
 // AddRoleToInstanceProfileRequest generates a request for the AddRoleToInstanceProfile operation.
@@ -83,6 +95,17 @@ func (c *IAM) AddRoleToInstanceProfile(input *AddRoleToInstanceProfileInput) (ou
 	return
 }
 
+// AddRoleToInstanceProfileWithContext is the same as AddRoleToInstanceProfile with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) AddRoleToInstanceProfileWithContext(ctx context.Context, input *AddRoleToInstanceProfileInput) (output *AddRoleToInstanceProfileOutput, err error) {
+	req, out := c.AddRoleToInstanceProfileRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddRoleToInstanceProfile *aws.Operation
 
 // AddUserToGroupRequest generates a request for the AddUserToGroup operation.
@@ -116,6 +139,17 @@ func (c *IAM) AddUserToGroup(input *AddUserToGroupInput) (output *AddUserToGroup
 	return
 }
 
+// AddUserToGroupWithContext is the same as AddUserToGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) AddUserToGroupWithContext(ctx context.Context, input *AddUserToGroupInput) (output *AddUserToGroupOutput, err error) {
+	req, out := c.AddUserToGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddUserToGroup *aws.Operation
 
 // AttachGroupPolicyRequest generates a request for the AttachGroupPolicy operation.
@@ -156,6 +190,17 @@ func (c *IAM) AttachGroupPolicy(input *AttachGroupPolicyInput) (output *AttachGr
 	return
 }
 
+// AttachGroupPolicyWithContext is the same as AttachGroupPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) AttachGroupPolicyWithContext(ctx context.Context, input *AttachGroupPolicyInput) (output *AttachGroupPolicyOutput, err error) {
+	req, out := c.AttachGroupPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAttachGroupPolicy *aws.Operation
 
 // AttachRolePolicyRequest generates a request for the AttachRolePolicy operation.
@@ -200,6 +245,17 @@ func (c *IAM) AttachRolePolicy(input *AttachRolePolicyInput) (output *AttachRole
 	return
 }
 
+// AttachRolePolicyWithContext is the same as AttachRolePolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) AttachRolePolicyWithContext(ctx context.Context, input *AttachRolePolicyInput) (output *AttachRolePolicyOutput, err error) {
+	req, out := c.AttachRolePolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAttachRolePolicy *aws.Operation
 
 // AttachUserPolicyRequest generates a request for the AttachUserPolicy operation.
@@ -240,6 +296,17 @@ func (c *IAM) AttachUserPolicy(input *AttachUserPolicyInput) (output *AttachUser
 	return
 }
 
+// AttachUserPolicyWithContext is the same as AttachUserPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) AttachUserPolicyWithContext(ctx context.Context, input *AttachUserPolicyInput) (output *AttachUserPolicyOutput, err error) {
+	req, out := c.AttachUserPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAttachUserPolicy *aws.Operation
 
 // ChangePasswordRequest generates a request for the ChangePassword operation.
@@ -278,6 +345,17 @@ func (c *IAM) ChangePassword(input *ChangePasswordInput) (output *ChangePassword
 	return
 }
 
+// ChangePasswordWithContext is the same as ChangePassword with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ChangePasswordWithContext(ctx context.Context, input *ChangePasswordInput) (output *ChangePasswordOutput, err error) {
+	req, out := c.ChangePasswordRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opChangePassword *aws.Operation
 
 // CreateAccessKeyRequest generates a request for the CreateAccessKey operation.
@@ -327,6 +405,17 @@ func (c *IAM) CreateAccessKey(input *CreateAccessKeyInput) (output *CreateAccess
 	return
 }
 
+// CreateAccessKeyWithContext is the same as CreateAccessKey with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) CreateAccessKeyWithContext(ctx context.Context, input *CreateAccessKeyInput) (output *CreateAccessKeyOutput, err error) {
+	req, out := c.CreateAccessKeyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateAccessKey *aws.Operation
 
 // CreateAccountAliasRequest generates a request for the CreateAccountAlias operation.
@@ -362,6 +451,17 @@ func (c *IAM) CreateAccountAlias(input *CreateAccountAliasInput) (output *Create
 	return
 }
 
+// CreateAccountAliasWithContext is the same as CreateAccountAlias with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) CreateAccountAliasWithContext(ctx context.Context, input *CreateAccountAliasInput) (output *CreateAccountAliasOutput, err error) {
+	req, out := c.CreateAccountAliasRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateAccountAlias *aws.Operation
 
 // CreateGroupRequest generates a request for the CreateGroup operation.
@@ -399,6 +499,17 @@ func (c *IAM) CreateGroup(input *CreateGroupInput) (output *CreateGroupOutput, e
 	return
 }
 
+// CreateGroupWithContext is the same as CreateGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) CreateGroupWithContext(ctx context.Context, input *CreateGroupInput) (output *CreateGroupOutput, err error) {
+	req, out := c.CreateGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateGroup *aws.Operation
 
 // CreateInstanceProfileRequest generates a request for the CreateInstanceProfile operation.
@@ -437,6 +548,17 @@ func (c *IAM) CreateInstanceProfile(input *CreateInstanceProfileInput) (output *
 	return
 }
 
+// CreateInstanceProfileWithContext is the same as CreateInstanceProfile with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) CreateInstanceProfileWithContext(ctx context.Context, input *CreateInstanceProfileInput) (output *CreateInstanceProfileOutput, err error) {
+	req, out := c.CreateInstanceProfileRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateInstanceProfile *aws.Operation
 
 // CreateLoginProfileRequest generates a request for the CreateLoginProfile operation.
@@ -473,6 +595,17 @@ func (c *IAM) CreateLoginProfile(input *CreateLoginProfileInput) (output *Create
 	return
 }
 
+// CreateLoginProfileWithContext is the same as CreateLoginProfile with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) CreateLoginProfileWithContext(ctx context.Context, input *CreateLoginProfileInput) (output *CreateLoginProfileOutput, err error) {
+	req, out := c.CreateLoginProfileRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateLoginProfile *aws.Operation
 
 // CreateOpenIDConnectProviderRequest generates a request for the CreateOpenIDConnectProvider operation.
@@ -522,6 +655,17 @@ func (c *IAM) CreateOpenIDConnectProvider(input *CreateOpenIDConnectProviderInpu
 	return
 }
 
+// CreateOpenIDConnectProviderWithContext is the same as CreateOpenIDConnectProvider with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) CreateOpenIDConnectProviderWithContext(ctx context.Context, input *CreateOpenIDConnectProviderInput) (output *CreateOpenIDConnectProviderOutput, err error) {
+	req, out := c.CreateOpenIDConnectProviderRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateOpenIDConnectProvider *aws.Operation
 
 // CreatePolicyRequest generates a request for the CreatePolicy operation.
@@ -564,6 +708,17 @@ func (c *IAM) CreatePolicy(input *CreatePolicyInput) (output *CreatePolicyOutput
 	return
 }
 
+// CreatePolicyWithContext is the same as CreatePolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) CreatePolicyWithContext(ctx context.Context, input *CreatePolicyInput) (output *CreatePolicyOutput, err error) {
+	req, out := c.CreatePolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreatePolicy *aws.Operation
 
 // CreatePolicyVersionRequest generates a request for the CreatePolicyVersion operation.
@@ -609,6 +764,17 @@ func (c *IAM) CreatePolicyVersion(input *CreatePolicyVersionInput) (output *Crea
 	return
 }
 
+// CreatePolicyVersionWithContext is the same as CreatePolicyVersion with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) CreatePolicyVersionWithContext(ctx context.Context, input *CreatePolicyVersionInput) (output *CreatePolicyVersionOutput, err error) {
+	req, out := c.CreatePolicyVersionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreatePolicyVersion *aws.Operation
 
 // CreateRoleRequest generates a request for the CreateRole operation.
@@ -650,6 +816,17 @@ func (c *IAM) CreateRole(input *CreateRoleInput) (output *CreateRoleOutput, err
 	return
 }
 
+// CreateRoleWithContext is the same as CreateRole with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) CreateRoleWithContext(ctx context.Context, input *CreateRoleInput) (output *CreateRoleOutput, err error) {
+	req, out := c.CreateRoleRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateRole *aws.Operation
 
 // CreateSAMLProviderRequest generates a request for the CreateSAMLProvider operation.
@@ -702,6 +879,17 @@ func (c *IAM) CreateSAMLProvider(input *CreateSAMLProviderInput) (output *Create
 	return
 }
 
+// CreateSAMLProviderWithContext is the same as CreateSAMLProvider with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) CreateSAMLProviderWithContext(ctx context.Context, input *CreateSAMLProviderInput) (output *CreateSAMLProviderOutput, err error) {
+	req, out := c.CreateSAMLProviderRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateSAMLProvider *aws.Operation
 
 // CreateUserRequest generates a request for the CreateUser operation.
@@ -739,6 +927,17 @@ func (c *IAM) CreateUser(input *CreateUserInput) (output *CreateUserOutput, err
 	return
 }
 
+// CreateUserWithContext is the same as CreateUser with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) CreateUserWithContext(ctx context.Context, input *CreateUserInput) (output *CreateUserOutput, err error) {
+	req, out := c.CreateUserRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateUser *aws.Operation
 
 // CreateVirtualMFADeviceRequest generates a request for the CreateVirtualMFADevice operation.
@@ -785,6 +984,17 @@ func (c *IAM) CreateVirtualMFADevice(input *CreateVirtualMFADeviceInput) (output
 	return
 }
 
+// CreateVirtualMFADeviceWithContext is the same as CreateVirtualMFADevice with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) CreateVirtualMFADeviceWithContext(ctx context.Context, input *CreateVirtualMFADeviceInput) (output *CreateVirtualMFADeviceOutput, err error) {
+	req, out := c.CreateVirtualMFADeviceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateVirtualMFADevice *aws.Operation
 
 // DeactivateMFADeviceRequest generates a request for the DeactivateMFADevice operation.
@@ -823,6 +1033,17 @@ func (c *IAM) DeactivateMFADevice(input *DeactivateMFADeviceInput) (output *Deac
 	return
 }
 
+// DeactivateMFADeviceWithContext is the same as DeactivateMFADevice with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeactivateMFADeviceWithContext(ctx context.Context, input *DeactivateMFADeviceInput) (output *DeactivateMFADeviceOutput, err error) {
+	req, out := c.DeactivateMFADeviceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeactivateMFADevice *aws.Operation
 
 // DeleteAccessKeyRequest generates a request for the DeleteAccessKey operation.
@@ -861,6 +1082,17 @@ func (c *IAM) DeleteAccessKey(input *DeleteAccessKeyInput) (output *DeleteAccess
 	return
 }
 
+// DeleteAccessKeyWithContext is the same as DeleteAccessKey with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeleteAccessKeyWithContext(ctx context.Context, input *DeleteAccessKeyInput) (output *DeleteAccessKeyOutput, err error) {
+	req, out := c.DeleteAccessKeyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteAccessKey *aws.Operation
 
 // DeleteAccountAliasRequest generates a request for the DeleteAccountAlias operation.
@@ -896,6 +1128,17 @@ func (c *IAM) DeleteAccountAlias(input *DeleteAccountAliasInput) (output *Delete
 	return
 }
 
+// DeleteAccountAliasWithContext is the same as DeleteAccountAlias with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeleteAccountAliasWithContext(ctx context.Context, input *DeleteAccountAliasInput) (output *DeleteAccountAliasOutput, err error) {
+	req, out := c.DeleteAccountAliasRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteAccountAlias *aws.Operation
 
 // DeleteAccountPasswordPolicyRequest generates a request for the DeleteAccountPasswordPolicy operation.
@@ -929,6 +1172,17 @@ func (c *IAM) DeleteAccountPasswordPolicy(input *DeleteAccountPasswordPolicyInpu
 	return
 }
 
+// DeleteAccountPasswordPolicyWithContext is the same as DeleteAccountPasswordPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeleteAccountPasswordPolicyWithContext(ctx context.Context, input *DeleteAccountPasswordPolicyInput) (output *DeleteAccountPasswordPolicyOutput, err error) {
+	req, out := c.DeleteAccountPasswordPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteAccountPasswordPolicy *aws.Operation
 
 // DeleteGroupRequest generates a request for the DeleteGroup operation.
@@ -963,6 +1217,17 @@ func (c *IAM) DeleteGroup(input *DeleteGroupInput) (output *DeleteGroupOutput, e
 	return
 }
 
+// DeleteGroupWithContext is the same as DeleteGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeleteGroupWithContext(ctx context.Context, input *DeleteGroupInput) (output *DeleteGroupOutput, err error) {
+	req, out := c.DeleteGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteGroup *aws.Operation
 
 // DeleteGroupPolicyRequest generates a request for the DeleteGroupPolicy operation.
@@ -1001,6 +1266,17 @@ func (c *IAM) DeleteGroupPolicy(input *DeleteGroupPolicyInput) (output *DeleteGr
 	return
 }
 
+// DeleteGroupPolicyWithContext is the same as DeleteGroupPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeleteGroupPolicyWithContext(ctx context.Context, input *DeleteGroupPolicyInput) (output *DeleteGroupPolicyOutput, err error) {
+	req, out := c.DeleteGroupPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteGroupPolicy *aws.Operation
 
 // DeleteInstanceProfileRequest generates a request for the DeleteInstanceProfile operation.
@@ -1041,6 +1317,17 @@ func (c *IAM) DeleteInstanceProfile(input *DeleteInstanceProfileInput) (output *
 	return
 }
 
+// DeleteInstanceProfileWithContext is the same as DeleteInstanceProfile with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeleteInstanceProfileWithContext(ctx context.Context, input *DeleteInstanceProfileInput) (output *DeleteInstanceProfileOutput, err error) {
+	req, out := c.DeleteInstanceProfileRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteInstanceProfile *aws.Operation
 
 // DeleteLoginProfileRequest generates a request for the DeleteLoginProfile operation.
@@ -1080,6 +1367,17 @@ func (c *IAM) DeleteLoginProfile(input *DeleteLoginProfileInput) (output *Delete
 	return
 }
 
+// DeleteLoginProfileWithContext is the same as DeleteLoginProfile with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeleteLoginProfileWithContext(ctx context.Context, input *DeleteLoginProfileInput) (output *DeleteLoginProfileOutput, err error) {
+	req, out := c.DeleteLoginProfileRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteLoginProfile *aws.Operation
 
 // DeleteOpenIDConnectProviderRequest generates a request for the DeleteOpenIDConnectProvider operation.
@@ -1120,6 +1418,17 @@ func (c *IAM) DeleteOpenIDConnectProvider(input *DeleteOpenIDConnectProviderInpu
 	return
 }
 
+// DeleteOpenIDConnectProviderWithContext is the same as DeleteOpenIDConnectProvider with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeleteOpenIDConnectProviderWithContext(ctx context.Context, input *DeleteOpenIDConnectProviderInput) (output *DeleteOpenIDConnectProviderOutput, err error) {
+	req, out := c.DeleteOpenIDConnectProviderRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteOpenIDConnectProvider *aws.Operation
 
 // DeletePolicyRequest generates a request for the DeletePolicy operation.
@@ -1170,6 +1479,17 @@ func (c *IAM) DeletePolicy(input *DeletePolicyInput) (output *DeletePolicyOutput
 	return
 }
 
+// DeletePolicyWithContext is the same as DeletePolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeletePolicyWithContext(ctx context.Context, input *DeletePolicyInput) (output *DeletePolicyOutput, err error) {
+	req, out := c.DeletePolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeletePolicy *aws.Operation
 
 // DeletePolicyVersionRequest generates a request for the DeletePolicyVersion operation.
@@ -1211,6 +1531,17 @@ func (c *IAM) DeletePolicyVersion(input *DeletePolicyVersionInput) (output *Dele
 	return
 }
 
+// DeletePolicyVersionWithContext is the same as DeletePolicyVersion with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeletePolicyVersionWithContext(ctx context.Context, input *DeletePolicyVersionInput) (output *DeletePolicyVersionOutput, err error) {
+	req, out := c.DeletePolicyVersionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeletePolicyVersion *aws.Operation
 
 // DeleteRoleRequest generates a request for the DeleteRole operation.
@@ -1249,6 +1580,17 @@ func (c *IAM) DeleteRole(input *DeleteRoleInput) (output *DeleteRoleOutput, err
 	return
 }
 
+// DeleteRoleWithContext is the same as DeleteRole with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeleteRoleWithContext(ctx context.Context, input *DeleteRoleInput) (output *DeleteRoleOutput, err error) {
+	req, out := c.DeleteRoleRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteRole *aws.Operation
 
 // DeleteRolePolicyRequest generates a request for the DeleteRolePolicy operation.
@@ -1287,6 +1629,17 @@ func (c *IAM) DeleteRolePolicy(input *DeleteRolePolicyInput) (output *DeleteRole
 	return
 }
 
+// DeleteRolePolicyWithContext is the same as DeleteRolePolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeleteRolePolicyWithContext(ctx context.Context, input *DeleteRolePolicyInput) (output *DeleteRolePolicyOutput, err error) {
+	req, out := c.DeleteRolePolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteRolePolicy *aws.Operation
 
 // DeleteSAMLProviderRequest generates a request for the DeleteSAMLProvider operation.
@@ -1326,6 +1679,17 @@ func (c *IAM) DeleteSAMLProvider(input *DeleteSAMLProviderInput) (output *Delete
 	return
 }
 
+// DeleteSAMLProviderWithContext is the same as DeleteSAMLProvider with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeleteSAMLProviderWithContext(ctx context.Context, input *DeleteSAMLProviderInput) (output *DeleteSAMLProviderOutput, err error) {
+	req, out := c.DeleteSAMLProviderRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteSAMLProvider *aws.Operation
 
 // DeleteServerCertificateRequest generates a request for the DeleteServerCertificate operation.
@@ -1369,6 +1733,17 @@ func (c *IAM) DeleteServerCertificate(input *DeleteServerCertificateInput) (outp
 	return
 }
 
+// DeleteServerCertificateWithContext is the same as DeleteServerCertificate with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeleteServerCertificateWithContext(ctx context.Context, input *DeleteServerCertificateInput) (output *DeleteServerCertificateOutput, err error) {
+	req, out := c.DeleteServerCertificateRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteServerCertificate *aws.Operation
 
 // DeleteSigningCertificateRequest generates a request for the DeleteSigningCertificate operation.
@@ -1407,6 +1782,17 @@ func (c *IAM) DeleteSigningCertificate(input *DeleteSigningCertificateInput) (ou
 	return
 }
 
+// DeleteSigningCertificateWithContext is the same as DeleteSigningCertificate with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeleteSigningCertificateWithContext(ctx context.Context, input *DeleteSigningCertificateInput) (output *DeleteSigningCertificateOutput, err error) {
+	req, out := c.DeleteSigningCertificateRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteSigningCertificate *aws.Operation
 
 // DeleteUserRequest generates a request for the DeleteUser operation.
@@ -1441,6 +1827,17 @@ func (c *IAM) DeleteUser(input *DeleteUserInput) (output *DeleteUserOutput, err
 	return
 }
 
+// DeleteUserWithContext is the same as DeleteUser with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeleteUserWithContext(ctx context.Context, input *DeleteUserInput) (output *DeleteUserOutput, err error) {
+	req, out := c.DeleteUserRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteUser *aws.Operation
 
 // DeleteUserPolicyRequest generates a request for the DeleteUserPolicy operation.
@@ -1479,6 +1876,17 @@ func (c *IAM) DeleteUserPolicy(input *DeleteUserPolicyInput) (output *DeleteUser
 	return
 }
 
+// DeleteUserPolicyWithContext is the same as DeleteUserPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeleteUserPolicyWithContext(ctx context.Context, input *DeleteUserPolicyInput) (output *DeleteUserPolicyOutput, err error) {
+	req, out := c.DeleteUserPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteUserPolicy *aws.Operation
 
 // DeleteVirtualMFADeviceRequest generates a request for the DeleteVirtualMFADevice operation.
@@ -1515,6 +1923,17 @@ func (c *IAM) DeleteVirtualMFADevice(input *DeleteVirtualMFADeviceInput) (output
 	return
 }
 
+// DeleteVirtualMFADeviceWithContext is the same as DeleteVirtualMFADevice with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DeleteVirtualMFADeviceWithContext(ctx context.Context, input *DeleteVirtualMFADeviceInput) (output *DeleteVirtualMFADeviceOutput, err error) {
+	req, out := c.DeleteVirtualMFADeviceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteVirtualMFADevice *aws.Operation
 
 // DetachGroupPolicyRequest generates a request for the DetachGroupPolicy operation.
@@ -1553,6 +1972,17 @@ func (c *IAM) DetachGroupPolicy(input *DetachGroupPolicyInput) (output *DetachGr
 	return
 }
 
+// DetachGroupPolicyWithContext is the same as DetachGroupPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DetachGroupPolicyWithContext(ctx context.Context, input *DetachGroupPolicyInput) (output *DetachGroupPolicyOutput, err error) {
+	req, out := c.DetachGroupPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDetachGroupPolicy *aws.Operation
 
 // DetachRolePolicyRequest generates a request for the DetachRolePolicy operation.
@@ -1591,6 +2021,17 @@ func (c *IAM) DetachRolePolicy(input *DetachRolePolicyInput) (output *DetachRole
 	return
 }
 
+// DetachRolePolicyWithContext is the same as DetachRolePolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DetachRolePolicyWithContext(ctx context.Context, input *DetachRolePolicyInput) (output *DetachRolePolicyOutput, err error) {
+	req, out := c.DetachRolePolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDetachRolePolicy *aws.Operation
 
 // DetachUserPolicyRequest generates a request for the DetachUserPolicy operation.
@@ -1629,6 +2070,17 @@ func (c *IAM) DetachUserPolicy(input *DetachUserPolicyInput) (output *DetachUser
 	return
 }
 
+// DetachUserPolicyWithContext is the same as DetachUserPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) DetachUserPolicyWithContext(ctx context.Context, input *DetachUserPolicyInput) (output *DetachUserPolicyOutput, err error) {
+	req, out := c.DetachUserPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDetachUserPolicy *aws.Operation
 
 // EnableMFADeviceRequest generates a request for the EnableMFADevice operation.
@@ -1664,6 +2116,17 @@ func (c *IAM) EnableMFADevice(input *EnableMFADeviceInput) (output *EnableMFADev
 	return
 }
 
+// EnableMFADeviceWithContext is the same as EnableMFADevice with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) EnableMFADeviceWithContext(ctx context.Context, input *EnableMFADeviceInput) (output *EnableMFADeviceOutput, err error) {
+	req, out := c.EnableMFADeviceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEnableMFADevice *aws.Operation
 
 // GenerateCredentialReportRequest generates a request for the GenerateCredentialReport operation.
@@ -1699,6 +2162,17 @@ func (c *IAM) GenerateCredentialReport(input *GenerateCredentialReportInput) (ou
 	return
 }
 
+// GenerateCredentialReportWithContext is the same as GenerateCredentialReport with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GenerateCredentialReportWithContext(ctx context.Context, input *GenerateCredentialReportInput) (output *GenerateCredentialReportOutput, err error) {
+	req, out := c.GenerateCredentialReportRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGenerateCredentialReport *aws.Operation
 
 // GetAccountAuthorizationDetailsRequest generates a request for the GetAccountAuthorizationDetails operation.
@@ -1738,6 +2212,17 @@ func (c *IAM) GetAccountAuthorizationDetails(input *GetAccountAuthorizationDetai
 	return
 }
 
+// GetAccountAuthorizationDetailsWithContext is the same as GetAccountAuthorizationDetails with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetAccountAuthorizationDetailsWithContext(ctx context.Context, input *GetAccountAuthorizationDetailsInput) (output *GetAccountAuthorizationDetailsOutput, err error) {
+	req, out := c.GetAccountAuthorizationDetailsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetAccountAuthorizationDetails *aws.Operation
 
 // GetAccountPasswordPolicyRequest generates a request for the GetAccountPasswordPolicy operation.
@@ -1772,6 +2257,17 @@ func (c *IAM) GetAccountPasswordPolicy(input *GetAccountPasswordPolicyInput) (ou
 	return
 }
 
+// GetAccountPasswordPolicyWithContext is the same as GetAccountPasswordPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetAccountPasswordPolicyWithContext(ctx context.Context, input *GetAccountPasswordPolicyInput) (output *GetAccountPasswordPolicyOutput, err error) {
+	req, out := c.GetAccountPasswordPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetAccountPasswordPolicy *aws.Operation
 
 // GetAccountSummaryRequest generates a request for the GetAccountSummary operation.
@@ -1809,6 +2305,17 @@ func (c *IAM) GetAccountSummary(input *GetAccountSummaryInput) (output *GetAccou
 	return
 }
 
+// GetAccountSummaryWithContext is the same as GetAccountSummary with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetAccountSummaryWithContext(ctx context.Context, input *GetAccountSummaryInput) (output *GetAccountSummaryOutput, err error) {
+	req, out := c.GetAccountSummaryRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetAccountSummary *aws.Operation
 
 // GetCredentialReportRequest generates a request for the GetCredentialReport operation.
@@ -1844,6 +2351,17 @@ func (c *IAM) GetCredentialReport(input *GetCredentialReportInput) (output *GetC
 	return
 }
 
+// GetCredentialReportWithContext is the same as GetCredentialReport with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetCredentialReportWithContext(ctx context.Context, input *GetCredentialReportInput) (output *GetCredentialReportOutput, err error) {
+	req, out := c.GetCredentialReportRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetCredentialReport *aws.Operation
 
 // GetGroupRequest generates a request for the GetGroup operation.
@@ -1856,6 +2374,12 @@ func (c *IAM) GetGroupRequest(input *GetGroupInput) (req *aws.Request, output *G
 			Name:       "GetGroup",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -1878,6 +2402,27 @@ func (c *IAM) GetGroup(input *GetGroupInput) (output *GetGroupOutput, err error)
 	return
 }
 
+// GetGroupWithContext is the same as GetGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetGroupWithContext(ctx context.Context, input *GetGroupInput) (output *GetGroupOutput, err error) {
+	req, out := c.GetGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// GetGroupPages iterates over the pages of a GetGroup operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *IAM) GetGroupPages(input *GetGroupInput, fn func(p *GetGroupOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.GetGroupRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*GetGroupOutput), lastPage)
+	})
+}
+
 var opGetGroup *aws.Operation
 
 // GetGroupPolicyRequest generates a request for the GetGroupPolicy operation.
@@ -1921,6 +2466,17 @@ func (c *IAM) GetGroupPolicy(input *GetGroupPolicyInput) (output *GetGroupPolicy
 	return
 }
 
+// GetGroupPolicyWithContext is the same as GetGroupPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetGroupPolicyWithContext(ctx context.Context, input *GetGroupPolicyInput) (output *GetGroupPolicyOutput, err error) {
+	req, out := c.GetGroupPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetGroupPolicy *aws.Operation
 
 // GetInstanceProfileRequest generates a request for the GetInstanceProfile operation.
@@ -1957,6 +2513,17 @@ func (c *IAM) GetInstanceProfile(input *GetInstanceProfileInput) (output *GetIns
 	return
 }
 
+// GetInstanceProfileWithContext is the same as GetInstanceProfile with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetInstanceProfileWithContext(ctx context.Context, input *GetInstanceProfileInput) (output *GetInstanceProfileOutput, err error) {
+	req, out := c.GetInstanceProfileRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetInstanceProfile *aws.Operation
 
 // GetLoginProfileRequest generates a request for the GetLoginProfile operation.
@@ -1992,6 +2559,17 @@ func (c *IAM) GetLoginProfile(input *GetLoginProfileInput) (output *GetLoginProf
 	return
 }
 
+// GetLoginProfileWithContext is the same as GetLoginProfile with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetLoginProfileWithContext(ctx context.Context, input *GetLoginProfileInput) (output *GetLoginProfileOutput, err error) {
+	req, out := c.GetLoginProfileRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetLoginProfile *aws.Operation
 
 // GetOpenIDConnectProviderRequest generates a request for the GetOpenIDConnectProvider operation.
@@ -2025,6 +2603,17 @@ func (c *IAM) GetOpenIDConnectProvider(input *GetOpenIDConnectProviderInput) (ou
 	return
 }
 
+// GetOpenIDConnectProviderWithContext is the same as GetOpenIDConnectProvider with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetOpenIDConnectProviderWithContext(ctx context.Context, input *GetOpenIDConnectProviderInput) (output *GetOpenIDConnectProviderOutput, err error) {
+	req, out := c.GetOpenIDConnectProviderRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetOpenIDConnectProvider *aws.Operation
 
 // GetPolicyRequest generates a request for the GetPolicy operation.
@@ -2071,6 +2660,17 @@ func (c *IAM) GetPolicy(input *GetPolicyInput) (output *GetPolicyOutput, err err
 	return
 }
 
+// GetPolicyWithContext is the same as GetPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetPolicyWithContext(ctx context.Context, input *GetPolicyInput) (output *GetPolicyOutput, err error) {
+	req, out := c.GetPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetPolicy *aws.Operation
 
 // GetPolicyVersionRequest generates a request for the GetPolicyVersion operation.
@@ -2115,6 +2715,17 @@ func (c *IAM) GetPolicyVersion(input *GetPolicyVersionInput) (output *GetPolicyV
 	return
 }
 
+// GetPolicyVersionWithContext is the same as GetPolicyVersion with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetPolicyVersionWithContext(ctx context.Context, input *GetPolicyVersionInput) (output *GetPolicyVersionOutput, err error) {
+	req, out := c.GetPolicyVersionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetPolicyVersion *aws.Operation
 
 // GetRoleRequest generates a request for the GetRole operation.
@@ -2154,6 +2765,17 @@ func (c *IAM) GetRole(input *GetRoleInput) (output *GetRoleOutput, err error) {
 	return
 }
 
+// GetRoleWithContext is the same as GetRole with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetRoleWithContext(ctx context.Context, input *GetRoleInput) (output *GetRoleOutput, err error) {
+	req, out := c.GetRoleRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetRole *aws.Operation
 
 // GetRolePolicyRequest generates a request for the GetRolePolicy operation.
@@ -2200,6 +2822,17 @@ func (c *IAM) GetRolePolicy(input *GetRolePolicyInput) (output *GetRolePolicyOut
 	return
 }
 
+// GetRolePolicyWithContext is the same as GetRolePolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetRolePolicyWithContext(ctx context.Context, input *GetRolePolicyInput) (output *GetRolePolicyOutput, err error) {
+	req, out := c.GetRolePolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetRolePolicy *aws.Operation
 
 // GetSAMLProviderRequest generates a request for the GetSAMLProvider operation.
@@ -2236,6 +2869,17 @@ func (c *IAM) GetSAMLProvider(input *GetSAMLProviderInput) (output *GetSAMLProvi
 	return
 }
 
+// GetSAMLProviderWithContext is the same as GetSAMLProvider with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetSAMLProviderWithContext(ctx context.Context, input *GetSAMLProviderInput) (output *GetSAMLProviderOutput, err error) {
+	req, out := c.GetSAMLProviderRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetSAMLProvider *aws.Operation
 
 // GetServerCertificateRequest generates a request for the GetServerCertificate operation.
@@ -2269,6 +2913,17 @@ func (c *IAM) GetServerCertificate(input *GetServerCertificateInput) (output *Ge
 	return
 }
 
+// GetServerCertificateWithContext is the same as GetServerCertificate with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetServerCertificateWithContext(ctx context.Context, input *GetServerCertificateInput) (output *GetServerCertificateOutput, err error) {
+	req, out := c.GetServerCertificateRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetServerCertificate *aws.Operation
 
 // GetUserRequest generates a request for the GetUser operation.
@@ -2306,6 +2961,17 @@ func (c *IAM) GetUser(input *GetUserInput) (output *GetUserOutput, err error) {
 	return
 }
 
+// GetUserWithContext is the same as GetUser with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetUserWithContext(ctx context.Context, input *GetUserInput) (output *GetUserOutput, err error) {
+	req, out := c.GetUserRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetUser *aws.Operation
 
 // GetUserPolicyRequest generates a request for the GetUserPolicy operation.
@@ -2349,6 +3015,17 @@ func (c *IAM) GetUserPolicy(input *GetUserPolicyInput) (output *GetUserPolicyOut
 	return
 }
 
+// GetUserPolicyWithContext is the same as GetUserPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) GetUserPolicyWithContext(ctx context.Context, input *GetUserPolicyInput) (output *GetUserPolicyOutput, err error) {
+	req, out := c.GetUserPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetUserPolicy *aws.Operation
 
 // ListAccessKeysRequest generates a request for the ListAccessKeys operation.
@@ -2361,6 +3038,12 @@ func (c *IAM) ListAccessKeysRequest(input *ListAccessKeysInput) (req *aws.Reques
 			Name:       "ListAccessKeys",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2394,6 +3077,27 @@ func (c *IAM) ListAccessKeys(input *ListAccessKeysInput) (output *ListAccessKeys
 	return
 }
 
+// ListAccessKeysWithContext is the same as ListAccessKeys with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListAccessKeysWithContext(ctx context.Context, input *ListAccessKeysInput) (output *ListAccessKeysOutput, err error) {
+	req, out := c.ListAccessKeysRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListAccessKeysPages iterates over the pages of a ListAccessKeys operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *IAM) ListAccessKeysPages(input *ListAccessKeysInput, fn func(p *ListAccessKeysOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListAccessKeysRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListAccessKeysOutput), lastPage)
+	})
+}
+
 var opListAccessKeys *aws.Operation
 
 // ListAccountAliasesRequest generates a request for the ListAccountAliases operation.
@@ -2406,6 +3110,12 @@ func (c *IAM) ListAccountAliasesRequest(input *ListAccountAliasesInput) (req *aw
 			Name:       "ListAccountAliases",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2431,6 +3141,27 @@ func (c *IAM) ListAccountAliases(input *ListAccountAliasesInput) (output *ListAc
 	return
 }
 
+// ListAccountAliasesWithContext is the same as ListAccountAliases with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListAccountAliasesWithContext(ctx context.Context, input *ListAccountAliasesInput) (output *ListAccountAliasesOutput, err error) {
+	req, out := c.ListAccountAliasesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListAccountAliasesPages iterates over the pages of a ListAccountAliases operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *IAM) ListAccountAliasesPages(input *ListAccountAliasesInput, fn func(p *ListAccountAliasesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListAccountAliasesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListAccountAliasesOutput), lastPage)
+	})
+}
+
 var opListAccountAliases *aws.Operation
 
 // ListAttachedGroupPoliciesRequest generates a request for the ListAttachedGroupPolicies operation.
@@ -2475,6 +3206,17 @@ func (c *IAM) ListAttachedGroupPolicies(input *ListAttachedGroupPoliciesInput) (
 	return
 }
 
+// ListAttachedGroupPoliciesWithContext is the same as ListAttachedGroupPolicies with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListAttachedGroupPoliciesWithContext(ctx context.Context, input *ListAttachedGroupPoliciesInput) (output *ListAttachedGroupPoliciesOutput, err error) {
+	req, out := c.ListAttachedGroupPoliciesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListAttachedGroupPolicies *aws.Operation
 
 // ListAttachedRolePoliciesRequest generates a request for the ListAttachedRolePolicies operation.
@@ -2519,6 +3261,17 @@ func (c *IAM) ListAttachedRolePolicies(input *ListAttachedRolePoliciesInput) (ou
 	return
 }
 
+// ListAttachedRolePoliciesWithContext is the same as ListAttachedRolePolicies with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListAttachedRolePoliciesWithContext(ctx context.Context, input *ListAttachedRolePoliciesInput) (output *ListAttachedRolePoliciesOutput, err error) {
+	req, out := c.ListAttachedRolePoliciesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListAttachedRolePolicies *aws.Operation
 
 // ListAttachedUserPoliciesRequest generates a request for the ListAttachedUserPolicies operation.
@@ -2563,6 +3316,17 @@ func (c *IAM) ListAttachedUserPolicies(input *ListAttachedUserPoliciesInput) (ou
 	return
 }
 
+// ListAttachedUserPoliciesWithContext is the same as ListAttachedUserPolicies with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListAttachedUserPoliciesWithContext(ctx context.Context, input *ListAttachedUserPoliciesInput) (output *ListAttachedUserPoliciesOutput, err error) {
+	req, out := c.ListAttachedUserPoliciesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListAttachedUserPolicies *aws.Operation
 
 // ListEntitiesForPolicyRequest generates a request for the ListEntitiesForPolicy operation.
@@ -2604,6 +3368,17 @@ func (c *IAM) ListEntitiesForPolicy(input *ListEntitiesForPolicyInput) (output *
 	return
 }
 
+// ListEntitiesForPolicyWithContext is the same as ListEntitiesForPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListEntitiesForPolicyWithContext(ctx context.Context, input *ListEntitiesForPolicyInput) (output *ListEntitiesForPolicyOutput, err error) {
+	req, out := c.ListEntitiesForPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListEntitiesForPolicy *aws.Operation
 
 // ListGroupPoliciesRequest generates a request for the ListGroupPolicies operation.
@@ -2616,6 +3391,12 @@ func (c *IAM) ListGroupPoliciesRequest(input *ListGroupPoliciesInput) (req *aws.
 			Name:       "ListGroupPolicies",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2648,6 +3429,27 @@ func (c *IAM) ListGroupPolicies(input *ListGroupPoliciesInput) (output *ListGrou
 	return
 }
 
+// ListGroupPoliciesWithContext is the same as ListGroupPolicies with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListGroupPoliciesWithContext(ctx context.Context, input *ListGroupPoliciesInput) (output *ListGroupPoliciesOutput, err error) {
+	req, out := c.ListGroupPoliciesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListGroupPoliciesPages iterates over the pages of a ListGroupPolicies operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *IAM) ListGroupPoliciesPages(input *ListGroupPoliciesInput, fn func(p *ListGroupPoliciesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListGroupPoliciesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListGroupPoliciesOutput), lastPage)
+	})
+}
+
 var opListGroupPolicies *aws.Operation
 
 // ListGroupsRequest generates a request for the ListGroups operation.
@@ -2660,6 +3462,12 @@ func (c *IAM) ListGroupsRequest(input *ListGroupsInput) (req *aws.Request, outpu
 			Name:       "ListGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2683,6 +3491,27 @@ func (c *IAM) ListGroups(input *ListGroupsInput) (output *ListGroupsOutput, err
 	return
 }
 
+// ListGroupsWithContext is the same as ListGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListGroupsWithContext(ctx context.Context, input *ListGroupsInput) (output *ListGroupsOutput, err error) {
+	req, out := c.ListGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListGroupsPages iterates over the pages of a ListGroups operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *IAM) ListGroupsPages(input *ListGroupsInput, fn func(p *ListGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListGroupsOutput), lastPage)
+	})
+}
+
 var opListGroups *aws.Operation
 
 // ListGroupsForUserRequest generates a request for the ListGroupsForUser operation.
@@ -2695,6 +3524,12 @@ func (c *IAM) ListGroupsForUserRequest(input *ListGroupsForUserInput) (req *aws.
 			Name:       "ListGroupsForUser",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2718,6 +3553,27 @@ func (c *IAM) ListGroupsForUser(input *ListGroupsForUserInput) (output *ListGrou
 	return
 }
 
+// ListGroupsForUserWithContext is the same as ListGroupsForUser with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListGroupsForUserWithContext(ctx context.Context, input *ListGroupsForUserInput) (output *ListGroupsForUserOutput, err error) {
+	req, out := c.ListGroupsForUserRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListGroupsForUserPages iterates over the pages of a ListGroupsForUser operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *IAM) ListGroupsForUserPages(input *ListGroupsForUserInput, fn func(p *ListGroupsForUserOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListGroupsForUserRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListGroupsForUserOutput), lastPage)
+	})
+}
+
 var opListGroupsForUser *aws.Operation
 
 // ListInstanceProfilesRequest generates a request for the ListInstanceProfiles operation.
@@ -2730,6 +3586,12 @@ func (c *IAM) ListInstanceProfilesRequest(input *ListInstanceProfilesInput) (req
 			Name:       "ListInstanceProfiles",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2755,6 +3617,27 @@ func (c *IAM) ListInstanceProfiles(input *ListInstanceProfilesInput) (output *Li
 	return
 }
 
+// ListInstanceProfilesWithContext is the same as ListInstanceProfiles with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListInstanceProfilesWithContext(ctx context.Context, input *ListInstanceProfilesInput) (output *ListInstanceProfilesOutput, err error) {
+	req, out := c.ListInstanceProfilesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListInstanceProfilesPages iterates over the pages of a ListInstanceProfiles operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *IAM) ListInstanceProfilesPages(input *ListInstanceProfilesInput, fn func(p *ListInstanceProfilesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListInstanceProfilesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListInstanceProfilesOutput), lastPage)
+	})
+}
+
 var opListInstanceProfiles *aws.Operation
 
 // ListInstanceProfilesForRoleRequest generates a request for the ListInstanceProfilesForRole operation.
@@ -2767,6 +3650,12 @@ func (c *IAM) ListInstanceProfilesForRoleRequest(input *ListInstanceProfilesForR
 			Name:       "ListInstanceProfilesForRole",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2792,6 +3681,27 @@ func (c *IAM) ListInstanceProfilesForRole(input *ListInstanceProfilesForRoleInpu
 	return
 }
 
+// ListInstanceProfilesForRoleWithContext is the same as ListInstanceProfilesForRole with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListInstanceProfilesForRoleWithContext(ctx context.Context, input *ListInstanceProfilesForRoleInput) (output *ListInstanceProfilesForRoleOutput, err error) {
+	req, out := c.ListInstanceProfilesForRoleRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListInstanceProfilesForRolePages iterates over the pages of a ListInstanceProfilesForRole operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *IAM) ListInstanceProfilesForRolePages(input *ListInstanceProfilesForRoleInput, fn func(p *ListInstanceProfilesForRoleOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListInstanceProfilesForRoleRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListInstanceProfilesForRoleOutput), lastPage)
+	})
+}
+
 var opListInstanceProfilesForRole *aws.Operation
 
 // ListMFADevicesRequest generates a request for the ListMFADevices operation.
@@ -2804,6 +3714,12 @@ func (c *IAM) ListMFADevicesRequest(input *ListMFADevicesInput) (req *aws.Reques
 			Name:       "ListMFADevices",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2830,6 +3746,27 @@ func (c *IAM) ListMFADevices(input *ListMFADevicesInput) (output *ListMFADevices
 	return
 }
 
+// ListMFADevicesWithContext is the same as ListMFADevices with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListMFADevicesWithContext(ctx context.Context, input *ListMFADevicesInput) (output *ListMFADevicesOutput, err error) {
+	req, out := c.ListMFADevicesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListMFADevicesPages iterates over the pages of a ListMFADevices operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *IAM) ListMFADevicesPages(input *ListMFADevicesInput, fn func(p *ListMFADevicesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListMFADevicesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListMFADevicesOutput), lastPage)
+	})
+}
+
 var opListMFADevices *aws.Operation
 
 // ListOpenIDConnectProvidersRequest generates a request for the ListOpenIDConnectProviders operation.
@@ -2863,6 +3800,17 @@ func (c *IAM) ListOpenIDConnectProviders(input *ListOpenIDConnectProvidersInput)
 	return
 }
 
+// ListOpenIDConnectProvidersWithContext is the same as ListOpenIDConnectProviders with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListOpenIDConnectProvidersWithContext(ctx context.Context, input *ListOpenIDConnectProvidersInput) (output *ListOpenIDConnectProvidersOutput, err error) {
+	req, out := c.ListOpenIDConnectProvidersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListOpenIDConnectProviders *aws.Operation
 
 // ListPoliciesRequest generates a request for the ListPolicies operation.
@@ -2908,6 +3856,17 @@ func (c *IAM) ListPolicies(input *ListPoliciesInput) (output *ListPoliciesOutput
 	return
 }
 
+// ListPoliciesWithContext is the same as ListPolicies with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListPoliciesWithContext(ctx context.Context, input *ListPoliciesInput) (output *ListPoliciesOutput, err error) {
+	req, out := c.ListPoliciesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListPolicies *aws.Operation
 
 // ListPolicyVersionsRequest generates a request for the ListPolicyVersions operation.
@@ -2946,6 +3905,17 @@ func (c *IAM) ListPolicyVersions(input *ListPolicyVersionsInput) (output *ListPo
 	return
 }
 
+// ListPolicyVersionsWithContext is the same as ListPolicyVersions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListPolicyVersionsWithContext(ctx context.Context, input *ListPolicyVersionsInput) (output *ListPolicyVersionsOutput, err error) {
+	req, out := c.ListPolicyVersionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListPolicyVersions *aws.Operation
 
 // ListRolePoliciesRequest generates a request for the ListRolePolicies operation.
@@ -2958,6 +3928,12 @@ func (c *IAM) ListRolePoliciesRequest(input *ListRolePoliciesInput) (req *aws.Re
 			Name:       "ListRolePolicies",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2990,6 +3966,27 @@ func (c *IAM) ListRolePolicies(input *ListRolePoliciesInput) (output *ListRolePo
 	return
 }
 
+// ListRolePoliciesWithContext is the same as ListRolePolicies with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListRolePoliciesWithContext(ctx context.Context, input *ListRolePoliciesInput) (output *ListRolePoliciesOutput, err error) {
+	req, out := c.ListRolePoliciesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListRolePoliciesPages iterates over the pages of a ListRolePolicies operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *IAM) ListRolePoliciesPages(input *ListRolePoliciesInput, fn func(p *ListRolePoliciesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListRolePoliciesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListRolePoliciesOutput), lastPage)
+	})
+}
+
 var opListRolePolicies *aws.Operation
 
 // ListRolesRequest generates a request for the ListRoles operation.
@@ -3002,6 +3999,12 @@ func (c *IAM) ListRolesRequest(input *ListRolesInput) (req *aws.Request, output
 			Name:       "ListRoles",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -3030,6 +4033,27 @@ func (c *IAM) ListRoles(input *ListRolesInput) (output *ListRolesOutput, err err
 	return
 }
 
+// ListRolesWithContext is the same as ListRoles with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListRolesWithContext(ctx context.Context, input *ListRolesInput) (output *ListRolesOutput, err error) {
+	req, out := c.ListRolesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListRolesPages iterates over the pages of a ListRoles operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *IAM) ListRolesPages(input *ListRolesInput, fn func(p *ListRolesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListRolesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListRolesOutput), lastPage)
+	})
+}
+
 var opListRoles *aws.Operation
 
 // ListSAMLProvidersRequest generates a request for the ListSAMLProviders operation.
@@ -3065,6 +4089,17 @@ func (c *IAM) ListSAMLProviders(input *ListSAMLProvidersInput) (output *ListSAML
 	return
 }
 
+// ListSAMLProvidersWithContext is the same as ListSAMLProviders with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListSAMLProvidersWithContext(ctx context.Context, input *ListSAMLProvidersInput) (output *ListSAMLProvidersOutput, err error) {
+	req, out := c.ListSAMLProvidersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListSAMLProviders *aws.Operation
 
 // ListServerCertificatesRequest generates a request for the ListServerCertificates operation.
@@ -3077,6 +4112,12 @@ func (c *IAM) ListServerCertificatesRequest(input *ListServerCertificatesInput)
 			Name:       "ListServerCertificates",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -3101,6 +4142,27 @@ func (c *IAM) ListServerCertificates(input *ListServerCertificatesInput) (output
 	return
 }
 
+// ListServerCertificatesWithContext is the same as ListServerCertificates with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListServerCertificatesWithContext(ctx context.Context, input *ListServerCertificatesInput) (output *ListServerCertificatesOutput, err error) {
+	req, out := c.ListServerCertificatesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListServerCertificatesPages iterates over the pages of a ListServerCertificates operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *IAM) ListServerCertificatesPages(input *ListServerCertificatesInput, fn func(p *ListServerCertificatesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListServerCertificatesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListServerCertificatesOutput), lastPage)
+	})
+}
+
 var opListServerCertificates *aws.Operation
 
 // ListSigningCertificatesRequest generates a request for the ListSigningCertificates operation.
@@ -3113,6 +4175,12 @@ func (c *IAM) ListSigningCertificatesRequest(input *ListSigningCertificatesInput
 			Name:       "ListSigningCertificates",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -3143,6 +4211,27 @@ func (c *IAM) ListSigningCertificates(input *ListSigningCertificatesInput) (outp
 	return
 }
 
+// ListSigningCertificatesWithContext is the same as ListSigningCertificates with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListSigningCertificatesWithContext(ctx context.Context, input *ListSigningCertificatesInput) (output *ListSigningCertificatesOutput, err error) {
+	req, out := c.ListSigningCertificatesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListSigningCertificatesPages iterates over the pages of a ListSigningCertificates operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *IAM) ListSigningCertificatesPages(input *ListSigningCertificatesInput, fn func(p *ListSigningCertificatesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListSigningCertificatesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListSigningCertificatesOutput), lastPage)
+	})
+}
+
 var opListSigningCertificates *aws.Operation
 
 // ListUserPoliciesRequest generates a request for the ListUserPolicies operation.
@@ -3155,6 +4244,12 @@ func (c *IAM) ListUserPoliciesRequest(input *ListUserPoliciesInput) (req *aws.Re
 			Name:       "ListUserPolicies",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -3186,6 +4281,27 @@ func (c *IAM) ListUserPolicies(input *ListUserPoliciesInput) (output *ListUserPo
 	return
 }
 
+// ListUserPoliciesWithContext is the same as ListUserPolicies with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListUserPoliciesWithContext(ctx context.Context, input *ListUserPoliciesInput) (output *ListUserPoliciesOutput, err error) {
+	req, out := c.ListUserPoliciesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListUserPoliciesPages iterates over the pages of a ListUserPolicies operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *IAM) ListUserPoliciesPages(input *ListUserPoliciesInput, fn func(p *ListUserPoliciesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListUserPoliciesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListUserPoliciesOutput), lastPage)
+	})
+}
+
 var opListUserPolicies *aws.Operation
 
 // ListUsersRequest generates a request for the ListUsers operation.
@@ -3198,6 +4314,12 @@ func (c *IAM) ListUsersRequest(input *ListUsersInput) (req *aws.Request, output
 			Name:       "ListUsers",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -3223,6 +4345,27 @@ func (c *IAM) ListUsers(input *ListUsersInput) (output *ListUsersOutput, err err
 	return
 }
 
+// ListUsersWithContext is the same as ListUsers with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListUsersWithContext(ctx context.Context, input *ListUsersInput) (output *ListUsersOutput, err error) {
+	req, out := c.ListUsersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListUsersPages iterates over the pages of a ListUsers operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *IAM) ListUsersPages(input *ListUsersInput, fn func(p *ListUsersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListUsersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListUsersOutput), lastPage)
+	})
+}
+
 var opListUsers *aws.Operation
 
 // ListVirtualMFADevicesRequest generates a request for the ListVirtualMFADevices operation.
@@ -3235,6 +4378,12 @@ func (c *IAM) ListVirtualMFADevicesRequest(input *ListVirtualMFADevicesInput) (r
 			Name:       "ListVirtualMFADevices",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -3261,6 +4410,27 @@ func (c *IAM) ListVirtualMFADevices(input *ListVirtualMFADevicesInput) (output *
 	return
 }
 
+// ListVirtualMFADevicesWithContext is the same as ListVirtualMFADevices with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ListVirtualMFADevicesWithContext(ctx context.Context, input *ListVirtualMFADevicesInput) (output *ListVirtualMFADevicesOutput, err error) {
+	req, out := c.ListVirtualMFADevicesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListVirtualMFADevicesPages iterates over the pages of a ListVirtualMFADevices operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *IAM) ListVirtualMFADevicesPages(input *ListVirtualMFADevicesInput, fn func(p *ListVirtualMFADevicesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListVirtualMFADevicesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListVirtualMFADevicesOutput), lastPage)
+	})
+}
+
 var opListVirtualMFADevices *aws.Operation
 
 // PutGroupPolicyRequest generates a request for the PutGroupPolicy operation.
@@ -3310,6 +4480,17 @@ func (c *IAM) PutGroupPolicy(input *PutGroupPolicyInput) (output *PutGroupPolicy
 	return
 }
 
+// PutGroupPolicyWithContext is the same as PutGroupPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) PutGroupPolicyWithContext(ctx context.Context, input *PutGroupPolicyInput) (output *PutGroupPolicyOutput, err error) {
+	req, out := c.PutGroupPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutGroupPolicy *aws.Operation
 
 // PutRolePolicyRequest generates a request for the PutRolePolicy operation.
@@ -3365,6 +4546,17 @@ func (c *IAM) PutRolePolicy(input *PutRolePolicyInput) (output *PutRolePolicyOut
 	return
 }
 
+// PutRolePolicyWithContext is the same as PutRolePolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) PutRolePolicyWithContext(ctx context.Context, input *PutRolePolicyInput) (output *PutRolePolicyOutput, err error) {
+	req, out := c.PutRolePolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutRolePolicy *aws.Operation
 
 // PutUserPolicyRequest generates a request for the PutUserPolicy operation.
@@ -3414,6 +4606,17 @@ func (c *IAM) PutUserPolicy(input *PutUserPolicyInput) (output *PutUserPolicyOut
 	return
 }
 
+// PutUserPolicyWithContext is the same as PutUserPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) PutUserPolicyWithContext(ctx context.Context, input *PutUserPolicyInput) (output *PutUserPolicyOutput, err error) {
+	req, out := c.PutUserPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutUserPolicy *aws.Operation
 
 // RemoveClientIDFromOpenIDConnectProviderRequest generates a request for the RemoveClientIDFromOpenIDConnectProvider operation.
@@ -3451,6 +4654,17 @@ func (c *IAM) RemoveClientIDFromOpenIDConnectProvider(input *RemoveClientIDFromO
 	return
 }
 
+// RemoveClientIDFromOpenIDConnectProviderWithContext is the same as RemoveClientIDFromOpenIDConnectProvider with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) RemoveClientIDFromOpenIDConnectProviderWithContext(ctx context.Context, input *RemoveClientIDFromOpenIDConnectProviderInput) (output *RemoveClientIDFromOpenIDConnectProviderOutput, err error) {
+	req, out := c.RemoveClientIDFromOpenIDConnectProviderRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRemoveClientIDFromOpenIDConnectProvider *aws.Operation
 
 // RemoveRoleFromInstanceProfileRequest generates a request for the RemoveRoleFromInstanceProfile operation.
@@ -3492,6 +4706,17 @@ func (c *IAM) RemoveRoleFromInstanceProfile(input *RemoveRoleFromInstanceProfile
 	return
 }
 
+// RemoveRoleFromInstanceProfileWithContext is the same as RemoveRoleFromInstanceProfile with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) RemoveRoleFromInstanceProfileWithContext(ctx context.Context, input *RemoveRoleFromInstanceProfileInput) (output *RemoveRoleFromInstanceProfileOutput, err error) {
+	req, out := c.RemoveRoleFromInstanceProfileRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRemoveRoleFromInstanceProfile *aws.Operation
 
 // RemoveUserFromGroupRequest generates a request for the RemoveUserFromGroup operation.
@@ -3525,6 +4750,17 @@ func (c *IAM) RemoveUserFromGroup(input *RemoveUserFromGroupInput) (output *Remo
 	return
 }
 
+// RemoveUserFromGroupWithContext is the same as RemoveUserFromGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) RemoveUserFromGroupWithContext(ctx context.Context, input *RemoveUserFromGroupInput) (output *RemoveUserFromGroupOutput, err error) {
+	req, out := c.RemoveUserFromGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRemoveUserFromGroup *aws.Operation
 
 // ResyncMFADeviceRequest generates a request for the ResyncMFADevice operation.
@@ -3562,6 +4798,17 @@ func (c *IAM) ResyncMFADevice(input *ResyncMFADeviceInput) (output *ResyncMFADev
 	return
 }
 
+// ResyncMFADeviceWithContext is the same as ResyncMFADevice with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) ResyncMFADeviceWithContext(ctx context.Context, input *ResyncMFADeviceInput) (output *ResyncMFADeviceOutput, err error) {
+	req, out := c.ResyncMFADeviceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opResyncMFADevice *aws.Operation
 
 // SetDefaultPolicyVersionRequest generates a request for the SetDefaultPolicyVersion operation.
@@ -3604,6 +4851,17 @@ func (c *IAM) SetDefaultPolicyVersion(input *SetDefaultPolicyVersionInput) (outp
 	return
 }
 
+// SetDefaultPolicyVersionWithContext is the same as SetDefaultPolicyVersion with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) SetDefaultPolicyVersionWithContext(ctx context.Context, input *SetDefaultPolicyVersionInput) (output *SetDefaultPolicyVersionOutput, err error) {
+	req, out := c.SetDefaultPolicyVersionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetDefaultPolicyVersion *aws.Operation
 
 // UpdateAccessKeyRequest generates a request for the UpdateAccessKey operation.
@@ -3648,6 +4906,17 @@ func (c *IAM) UpdateAccessKey(input *UpdateAccessKeyInput) (output *UpdateAccess
 	return
 }
 
+// UpdateAccessKeyWithContext is the same as UpdateAccessKey with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) UpdateAccessKeyWithContext(ctx context.Context, input *UpdateAccessKeyInput) (output *UpdateAccessKeyOutput, err error) {
+	req, out := c.UpdateAccessKeyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateAccessKey *aws.Operation
 
 // UpdateAccountPasswordPolicyRequest generates a request for the UpdateAccountPasswordPolicy operation.
@@ -3690,6 +4959,17 @@ func (c *IAM) UpdateAccountPasswordPolicy(input *UpdateAccountPasswordPolicyInpu
 	return
 }
 
+// UpdateAccountPasswordPolicyWithContext is the same as UpdateAccountPasswordPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) UpdateAccountPasswordPolicyWithContext(ctx context.Context, input *UpdateAccountPasswordPolicyInput) (output *UpdateAccountPasswordPolicyOutput, err error) {
+	req, out := c.UpdateAccountPasswordPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateAccountPasswordPolicy *aws.Operation
 
 // UpdateAssumeRolePolicyRequest generates a request for the UpdateAssumeRolePolicy operation.
@@ -3725,6 +5005,17 @@ func (c *IAM) UpdateAssumeRolePolicy(input *UpdateAssumeRolePolicyInput) (output
 	return
 }
 
+// UpdateAssumeRolePolicyWithContext is the same as UpdateAssumeRolePolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) UpdateAssumeRolePolicyWithContext(ctx context.Context, input *UpdateAssumeRolePolicyInput) (output *UpdateAssumeRolePolicyOutput, err error) {
+	req, out := c.UpdateAssumeRolePolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateAssumeRolePolicy *aws.Operation
 
 // UpdateGroupRequest generates a request for the UpdateGroup operation.
@@ -3767,6 +5058,17 @@ func (c *IAM) UpdateGroup(input *UpdateGroupInput) (output *UpdateGroupOutput, e
 	return
 }
 
+// UpdateGroupWithContext is the same as UpdateGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) UpdateGroupWithContext(ctx context.Context, input *UpdateGroupInput) (output *UpdateGroupOutput, err error) {
+	req, out := c.UpdateGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateGroup *aws.Operation
 
 // UpdateLoginProfileRequest generates a request for the UpdateLoginProfile operation.
@@ -3804,6 +5106,17 @@ func (c *IAM) UpdateLoginProfile(input *UpdateLoginProfileInput) (output *Update
 	return
 }
 
+// UpdateLoginProfileWithContext is the same as UpdateLoginProfile with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) UpdateLoginProfileWithContext(ctx context.Context, input *UpdateLoginProfileInput) (output *UpdateLoginProfileOutput, err error) {
+	req, out := c.UpdateLoginProfileRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateLoginProfile *aws.Operation
 
 // UpdateOpenIDConnectProviderThumbprintRequest generates a request for the UpdateOpenIDConnectProviderThumbprint operation.
@@ -3850,6 +5163,17 @@ func (c *IAM) UpdateOpenIDConnectProviderThumbprint(input *UpdateOpenIDConnectPr
 	return
 }
 
+// UpdateOpenIDConnectProviderThumbprintWithContext is the same as UpdateOpenIDConnectProviderThumbprint with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) UpdateOpenIDConnectProviderThumbprintWithContext(ctx context.Context, input *UpdateOpenIDConnectProviderThumbprintInput) (output *UpdateOpenIDConnectProviderThumbprintOutput, err error) {
+	req, out := c.UpdateOpenIDConnectProviderThumbprintRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateOpenIDConnectProviderThumbprint *aws.Operation
 
 // UpdateSAMLProviderRequest generates a request for the UpdateSAMLProvider operation.
@@ -3885,6 +5209,17 @@ func (c *IAM) UpdateSAMLProvider(input *UpdateSAMLProviderInput) (output *Update
 	return
 }
 
+// UpdateSAMLProviderWithContext is the same as UpdateSAMLProvider with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) UpdateSAMLProviderWithContext(ctx context.Context, input *UpdateSAMLProviderInput) (output *UpdateSAMLProviderOutput, err error) {
+	req, out := c.UpdateSAMLProviderRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateSAMLProvider *aws.Operation
 
 // UpdateServerCertificateRequest generates a request for the UpdateServerCertificate operation.
@@ -3928,6 +5263,17 @@ func (c *IAM) UpdateServerCertificate(input *UpdateServerCertificateInput) (outp
 	return
 }
 
+// UpdateServerCertificateWithContext is the same as UpdateServerCertificate with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) UpdateServerCertificateWithContext(ctx context.Context, input *UpdateServerCertificateInput) (output *UpdateServerCertificateOutput, err error) {
+	req, out := c.UpdateServerCertificateRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateServerCertificate *aws.Operation
 
 // UpdateSigningCertificateRequest generates a request for the UpdateSigningCertificate operation.
@@ -3972,6 +5318,17 @@ func (c *IAM) UpdateSigningCertificate(input *UpdateSigningCertificateInput) (ou
 	return
 }
 
+// UpdateSigningCertificateWithContext is the same as UpdateSigningCertificate with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) UpdateSigningCertificateWithContext(ctx context.Context, input *UpdateSigningCertificateInput) (output *UpdateSigningCertificateOutput, err error) {
+	req, out := c.UpdateSigningCertificateRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateSigningCertificate *aws.Operation
 
 // UpdateUserRequest generates a request for the UpdateUser operation.
@@ -4014,6 +5371,17 @@ func (c *IAM) UpdateUser(input *UpdateUserInput) (output *UpdateUserOutput, err
 	return
 }
 
+// UpdateUserWithContext is the same as UpdateUser with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) UpdateUserWithContext(ctx context.Context, input *UpdateUserInput) (output *UpdateUserOutput, err error) {
+	req, out := c.UpdateUserRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateUser *aws.Operation
 
 // UploadServerCertificateRequest generates a request for the UploadServerCertificate operation.
@@ -4061,6 +5429,17 @@ func (c *IAM) UploadServerCertificate(input *UploadServerCertificateInput) (outp
 	return
 }
 
+// UploadServerCertificateWithContext is the same as UploadServerCertificate with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) UploadServerCertificateWithContext(ctx context.Context, input *UploadServerCertificateInput) (output *UploadServerCertificateOutput, err error) {
+	req, out := c.UploadServerCertificateRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUploadServerCertificate *aws.Operation
 
 // UploadSigningCertificateRequest generates a request for the UploadSigningCertificate operation.
@@ -4110,6 +5489,17 @@ func (c *IAM) UploadSigningCertificate(input *UploadSigningCertificateInput) (ou
 	return
 }
 
+// UploadSigningCertificateWithContext is the same as UploadSigningCertificate with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *IAM) UploadSigningCertificateWithContext(ctx context.Context, input *UploadSigningCertificateInput) (output *UploadSigningCertificateOutput, err error) {
+	req, out := c.UploadSigningCertificateRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUploadSigningCertificate *aws.Operation
 
 // Contains information about an AWS access key.
@@ -4123,7 +5513,7 @@ var opUploadSigningCertificate *aws.Operation
 // key, you must create a new access key.
 type AccessKey struct {
 	// The ID for this access key.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The date when the access key was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -4133,10 +5523,10 @@ type AccessKey struct {
 
 	// The status of the access key. Active means the key is valid for API calls,
 	// while Inactive means it is not.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"Active,Inactive" required:"true"`
 
 	// The name of the IAM user that the access key is associated with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataAccessKey `json:"-", xml:"-"`
 }
@@ -4150,17 +5540,17 @@ type metadataAccessKey struct {
 // This data type is used as a response element in the ListAccessKeys action.
 type AccessKeyMetadata struct {
 	// The ID for this access key.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" pattern:"[\\w]*"`
 
 	// The date when the access key was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The status of the access key. Active means the key is valid for API calls;
 	// Inactive means it is not.
-	Status *string `type:"string"`
+	Status *string `type:"string" enum:"Active,Inactive"`
 
 	// The name of the IAM user that the key is associated with.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	metadataAccessKeyMetadata `json:"-", xml:"-"`
 }
@@ -4171,12 +5561,12 @@ type metadataAccessKeyMetadata struct {
 
 type AddClientIDToOpenIDConnectProviderInput struct {
 	// The client ID (also known as audience) to add to the IAM OpenID Connect provider.
-	ClientID *string `type:"string" required:"true"`
+	ClientID *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect (OIDC) provider
 	// to add the client ID to. You can get a list of OIDC provider ARNs by using
 	// the ListOpenIDConnectProviders action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataAddClientIDToOpenIDConnectProviderInput `json:"-", xml:"-"`
 }
@@ -4195,10 +5585,10 @@ type metadataAddClientIDToOpenIDConnectProviderOutput struct {
 
 type AddRoleToInstanceProfileInput struct {
 	// The name of the instance profile to update.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the role to add.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataAddRoleToInstanceProfileInput `json:"-", xml:"-"`
 }
@@ -4217,10 +5607,10 @@ type metadataAddRoleToInstanceProfileOutput struct {
 
 type AddUserToGroupInput struct {
 	// The name of the group to update.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the user to add.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataAddUserToGroupInput `json:"-", xml:"-"`
 }
@@ -4239,14 +5629,14 @@ type metadataAddUserToGroupOutput struct {
 
 type AttachGroupPolicyInput struct {
 	// The name (friendly name, not ARN) of the group to attach the policy to.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataAttachGroupPolicyInput `json:"-", xml:"-"`
 }
@@ -4269,10 +5659,10 @@ type AttachRolePolicyInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The name (friendly name, not ARN) of the role to attach the policy to.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataAttachRolePolicyInput `json:"-", xml:"-"`
 }
@@ -4295,10 +5685,10 @@ type AttachUserPolicyInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The name (friendly name, not ARN) of the user to attach the policy to.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataAttachUserPolicyInput `json:"-", xml:"-"`
 }
@@ -4330,10 +5720,10 @@ type AttachedPolicy struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048"`
 
 	// The friendly name of the attached policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataAttachedPolicy `json:"-", xml:"-"`
 }
@@ -4345,10 +5735,10 @@ type metadataAttachedPolicy struct {
 type ChangePasswordInput struct {
 	// The new password. The new password must conform to the AWS account's password
 	// policy, if one exists.
-	NewPassword *string `type:"string" required:"true"`
+	NewPassword *string `type:"string" min:"1" max:"128" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The IAM user's current password.
-	OldPassword *string `type:"string" required:"true"`
+	OldPassword *string `type:"string" min:"1" max:"128" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	metadataChangePasswordInput `json:"-", xml:"-"`
 }
@@ -4367,7 +5757,7 @@ type metadataChangePasswordOutput struct {
 
 type CreateAccessKeyInput struct {
 	// The user name that the new key will belong to.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataCreateAccessKeyInput `json:"-", xml:"-"`
 }
@@ -4390,7 +5780,7 @@ type metadataCreateAccessKeyOutput struct {
 
 type CreateAccountAliasInput struct {
 	// The account alias to create.
-	AccountAlias *string `type:"string" required:"true"`
+	AccountAlias *string `type:"string" min:"3" max:"63" required:"true"`
 
 	metadataCreateAccountAliasInput `json:"-", xml:"-"`
 }
@@ -4409,7 +5799,7 @@ type metadataCreateAccountAliasOutput struct {
 
 type CreateGroupInput struct {
 	// The name of the group to create. Do not include the path in this value.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The path to the group. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -4417,7 +5807,7 @@ type CreateGroupInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	metadataCreateGroupInput `json:"-", xml:"-"`
 }
@@ -4440,7 +5830,7 @@ type metadataCreateGroupOutput struct {
 
 type CreateInstanceProfileInput struct {
 	// The name of the instance profile to create.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The path to the instance profile. For more information about paths, see IAM
 	// Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -4448,7 +5838,7 @@ type CreateInstanceProfileInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	metadataCreateInstanceProfileInput `json:"-", xml:"-"`
 }
@@ -4471,13 +5861,13 @@ type metadataCreateInstanceProfileOutput struct {
 
 type CreateLoginProfileInput struct {
 	// The new password for the user.
-	Password *string `type:"string" required:"true"`
+	Password *string `type:"string" min:"1" max:"128" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// Specifies whether the user is required to set a new password on next sign-in.
 	PasswordResetRequired *bool `type:"boolean"`
 
 	// The name of the user to create a password for.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataCreateLoginProfileInput `json:"-", xml:"-"`
 }
@@ -4542,7 +5932,7 @@ type CreateOpenIDConnectProviderInput struct {
 	// You cannot register the same provider multiple times in a single AWS account.
 	// If you try to submit a URL that has already been used for an OpenID Connect
 	// provider in the AWS account, you will get an error.
-	URL *string `locationName:"Url" type:"string" required:"true"`
+	URL *string `locationName:"Url" type:"string" min:"1" max:"255" required:"true"`
 
 	metadataCreateOpenIDConnectProviderInput `json:"-", xml:"-"`
 }
@@ -4555,7 +5945,7 @@ type metadataCreateOpenIDConnectProviderInput struct {
 type CreateOpenIDConnectProviderOutput struct {
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect provider that was
 	// created. For more information, see OpenIDConnectProviderListEntry.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048"`
 
 	metadataCreateOpenIDConnectProviderOutput `json:"-", xml:"-"`
 }
@@ -4572,7 +5962,7 @@ type CreatePolicyInput struct {
 	//
 	// The policy description is immutable. After a value is assigned, it cannot
 	// be changed.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"1000"`
 
 	// The path for the policy.
 	//
@@ -4581,15 +5971,15 @@ type CreatePolicyInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	// The policy document.
 	//
 	// The policy must be URL-encoded according to RFC 3986 (http://www.faqs.org/rfcs/rfc3986.html).
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the policy document.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataCreatePolicyInput `json:"-", xml:"-"`
 }
@@ -4616,12 +6006,12 @@ type CreatePolicyVersionInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The policy document.
 	//
 	// The policy must be URL-encoded according to RFC 3986 (http://www.faqs.org/rfcs/rfc3986.html).
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// Specifies whether to set this version as the policy's default version.
 	//
@@ -4655,7 +6045,7 @@ type metadataCreatePolicyVersionOutput struct {
 
 type CreateRoleInput struct {
 	// The policy that grants an entity permission to assume the role.
-	AssumeRolePolicyDocument *string `type:"string" required:"true"`
+	AssumeRolePolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The path to the role. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -4663,10 +6053,10 @@ type CreateRoleInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// The name of the role to create.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataCreateRoleInput `json:"-", xml:"-"`
 }
@@ -4689,7 +6079,7 @@ type metadataCreateRoleOutput struct {
 
 type CreateSAMLProviderInput struct {
 	// The name of the provider to create.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"128" pattern:"[\\w._-]*" required:"true"`
 
 	// An XML document generated by an identity provider (IdP) that supports SAML
 	// 2.0. The document includes the issuer's name, expiration information, and
@@ -4700,7 +6090,7 @@ type CreateSAMLProviderInput struct {
 	//  For more information, see Creating Temporary Security Credentials for SAML
 	// Federation (http://docs.aws.amazon.com/STS/latest/UsingSTS/CreatingSAML.html)
 	// in the Using Temporary Security Credentials guide.
-	SAMLMetadataDocument *string `type:"string" required:"true"`
+	SAMLMetadataDocument *string `type:"string" min:"1000" max:"10000000" required:"true"`
 
 	metadataCreateSAMLProviderInput `json:"-", xml:"-"`
 }
@@ -4712,7 +6102,7 @@ type metadataCreateSAMLProviderInput struct {
 // Contains the response to a successful CreateSAMLProvider request.
 type CreateSAMLProviderOutput struct {
 	// The Amazon Resource Name (ARN) of the SAML provider.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048"`
 
 	metadataCreateSAMLProviderOutput `json:"-", xml:"-"`
 }
@@ -4728,10 +6118,10 @@ type CreateUserInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// The name of the user to create.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataCreateUserInput `json:"-", xml:"-"`
 }
@@ -4759,11 +6149,11 @@ type CreateVirtualMFADeviceInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// The name of the virtual MFA device. Use with path to uniquely identify a
 	// virtual MFA device.
-	VirtualMFADeviceName *string `type:"string" required:"true"`
+	VirtualMFADeviceName *string `type:"string" min:"1" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataCreateVirtualMFADeviceInput `json:"-", xml:"-"`
 }
@@ -4787,10 +6177,10 @@ type metadataCreateVirtualMFADeviceOutput struct {
 type DeactivateMFADeviceInput struct {
 	// The serial number that uniquely identifies the MFA device. For virtual MFA
 	// devices, the serial number is the device ARN.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	// The name of the user whose MFA device you want to deactivate.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeactivateMFADeviceInput `json:"-", xml:"-"`
 }
@@ -4810,10 +6200,10 @@ type metadataDeactivateMFADeviceOutput struct {
 type DeleteAccessKeyInput struct {
 	// The access key ID for the access key ID and secret access key you want to
 	// delete.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The name of the user whose key you want to delete.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataDeleteAccessKeyInput `json:"-", xml:"-"`
 }
@@ -4832,7 +6222,7 @@ type metadataDeleteAccessKeyOutput struct {
 
 type DeleteAccountAliasInput struct {
 	// The name of the account alias to delete.
-	AccountAlias *string `type:"string" required:"true"`
+	AccountAlias *string `type:"string" min:"3" max:"63" required:"true"`
 
 	metadataDeleteAccountAliasInput `json:"-", xml:"-"`
 }
@@ -4867,7 +6257,7 @@ type metadataDeleteAccountPasswordPolicyOutput struct {
 
 type DeleteGroupInput struct {
 	// The name of the group to delete.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteGroupInput `json:"-", xml:"-"`
 }
@@ -4887,10 +6277,10 @@ type metadataDeleteGroupOutput struct {
 type DeleteGroupPolicyInput struct {
 	// The name (friendly name, not ARN) identifying the group that the policy is
 	// embedded in.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name identifying the policy document to delete.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteGroupPolicyInput `json:"-", xml:"-"`
 }
@@ -4909,7 +6299,7 @@ type metadataDeleteGroupPolicyOutput struct {
 
 type DeleteInstanceProfileInput struct {
 	// The name of the instance profile to delete.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteInstanceProfileInput `json:"-", xml:"-"`
 }
@@ -4928,7 +6318,7 @@ type metadataDeleteInstanceProfileOutput struct {
 
 type DeleteLoginProfileInput struct {
 	// The name of the user whose password you want to delete.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteLoginProfileInput `json:"-", xml:"-"`
 }
@@ -4949,7 +6339,7 @@ type DeleteOpenIDConnectProviderInput struct {
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect provider to delete.
 	// You can get a list of OpenID Connect provider ARNs by using the ListOpenIDConnectProviders
 	// action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataDeleteOpenIDConnectProviderInput `json:"-", xml:"-"`
 }
@@ -4972,7 +6362,7 @@ type DeletePolicyInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataDeletePolicyInput `json:"-", xml:"-"`
 }
@@ -4995,14 +6385,14 @@ type DeletePolicyVersionInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The policy version to delete.
 	//
 	// For more information about managed policy versions, see Versioning for Managed
 	// Policies (http://docs.aws.amazon.com/IAM/latest/UserGuide/policies-managed-versions.html)
 	// in the Using IAM guide.
-	VersionID *string `locationName:"VersionId" type:"string" required:"true"`
+	VersionID *string `locationName:"VersionId" type:"string" pattern:"v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?" required:"true"`
 
 	metadataDeletePolicyVersionInput `json:"-", xml:"-"`
 }
@@ -5021,7 +6411,7 @@ type metadataDeletePolicyVersionOutput struct {
 
 type DeleteRoleInput struct {
 	// The name of the role to delete.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteRoleInput `json:"-", xml:"-"`
 }
@@ -5040,11 +6430,11 @@ type metadataDeleteRoleOutput struct {
 
 type DeleteRolePolicyInput struct {
 	// The name identifying the policy document to delete.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name (friendly name, not ARN) identifying the role that the policy is
 	// embedded in.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteRolePolicyInput `json:"-", xml:"-"`
 }
@@ -5063,7 +6453,7 @@ type metadataDeleteRolePolicyOutput struct {
 
 type DeleteSAMLProviderInput struct {
 	// The Amazon Resource Name (ARN) of the SAML provider to delete.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" required:"true"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataDeleteSAMLProviderInput `json:"-", xml:"-"`
 }
@@ -5082,7 +6472,7 @@ type metadataDeleteSAMLProviderOutput struct {
 
 type DeleteServerCertificateInput struct {
 	// The name of the server certificate you want to delete.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteServerCertificateInput `json:"-", xml:"-"`
 }
@@ -5101,10 +6491,10 @@ type metadataDeleteServerCertificateOutput struct {
 
 type DeleteSigningCertificateInput struct {
 	// The ID of the signing certificate to delete.
-	CertificateID *string `locationName:"CertificateId" type:"string" required:"true"`
+	CertificateID *string `locationName:"CertificateId" type:"string" min:"24" max:"128" pattern:"[\\w]*" required:"true"`
 
 	// The name of the user the signing certificate belongs to.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataDeleteSigningCertificateInput `json:"-", xml:"-"`
 }
@@ -5123,7 +6513,7 @@ type metadataDeleteSigningCertificateOutput struct {
 
 type DeleteUserInput struct {
 	// The name of the user to delete.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteUserInput `json:"-", xml:"-"`
 }
@@ -5142,11 +6532,11 @@ type metadataDeleteUserOutput struct {
 
 type DeleteUserPolicyInput struct {
 	// The name identifying the policy document to delete.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name (friendly name, not ARN) identifying the user that the policy is
 	// embedded in.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteUserPolicyInput `json:"-", xml:"-"`
 }
@@ -5166,7 +6556,7 @@ type metadataDeleteUserPolicyOutput struct {
 type DeleteVirtualMFADeviceInput struct {
 	// The serial number that uniquely identifies the MFA device. For virtual MFA
 	// devices, the serial number is the same as the ARN.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	metadataDeleteVirtualMFADeviceInput `json:"-", xml:"-"`
 }
@@ -5185,14 +6575,14 @@ type metadataDeleteVirtualMFADeviceOutput struct {
 
 type DetachGroupPolicyInput struct {
 	// The name (friendly name, not ARN) of the group to detach the policy from.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataDetachGroupPolicyInput `json:"-", xml:"-"`
 }
@@ -5215,10 +6605,10 @@ type DetachRolePolicyInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The name (friendly name, not ARN) of the role to detach the policy from.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDetachRolePolicyInput `json:"-", xml:"-"`
 }
@@ -5241,10 +6631,10 @@ type DetachUserPolicyInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The name (friendly name, not ARN) of the user to detach the policy from.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDetachUserPolicyInput `json:"-", xml:"-"`
 }
@@ -5263,17 +6653,17 @@ type metadataDetachUserPolicyOutput struct {
 
 type EnableMFADeviceInput struct {
 	// An authentication code emitted by the device.
-	AuthenticationCode1 *string `type:"string" required:"true"`
+	AuthenticationCode1 *string `type:"string" min:"6" max:"6" pattern:"[\\d]*" required:"true"`
 
 	// A subsequent authentication code emitted by the device.
-	AuthenticationCode2 *string `type:"string" required:"true"`
+	AuthenticationCode2 *string `type:"string" min:"6" max:"6" pattern:"[\\d]*" required:"true"`
 
 	// The serial number that uniquely identifies the MFA device. For virtual MFA
 	// devices, the serial number is the device ARN.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	// The name of the user for whom you want to enable the MFA device.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataEnableMFADeviceInput `json:"-", xml:"-"`
 }
@@ -5304,7 +6694,7 @@ type GenerateCredentialReportOutput struct {
 	Description *string `type:"string"`
 
 	// Information about the state of the credential report.
-	State *string `type:"string"`
+	State *string `type:"string" enum:"STARTED,INPROGRESS,COMPLETE"`
 
 	metadataGenerateCredentialReportOutput `json:"-", xml:"-"`
 }
@@ -5320,13 +6710,13 @@ type GetAccountAuthorizationDetailsInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataGetAccountAuthorizationDetailsInput `json:"-", xml:"-"`
 }
@@ -5347,7 +6737,7 @@ type GetAccountAuthorizationDetailsOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list containing information about IAM roles.
 	RoleDetailList []*RoleDetail `type:"list"`
@@ -5565,7 +6955,7 @@ type GetCredentialReportOutput struct {
 	GeneratedTime *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The format (MIME type) of the credential report.
-	ReportFormat *string `type:"string"`
+	ReportFormat *string `type:"string" enum:"text/csv"`
 
 	metadataGetCredentialReportOutput `json:"-", xml:"-"`
 }
@@ -5576,18 +6966,18 @@ type metadataGetCredentialReportOutput struct {
 
 type GetGroupInput struct {
 	// The name of the group.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of groups
 	// you want in the response. If there are additional groups beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataGetGroupInput `json:"-", xml:"-"`
 }
@@ -5608,7 +6998,7 @@ type GetGroupOutput struct {
 
 	// If IsTruncated is true, then this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of users in the group.
 	Users []*User `type:"list" required:"true"`
@@ -5622,10 +7012,10 @@ type metadataGetGroupOutput struct {
 
 type GetGroupPolicyInput struct {
 	// The name of the group the policy is associated with.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the policy document to get.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetGroupPolicyInput `json:"-", xml:"-"`
 }
@@ -5637,13 +7027,13 @@ type metadataGetGroupPolicyInput struct {
 // Contains the response to a successful GetGroupPolicy request.
 type GetGroupPolicyOutput struct {
 	// The group the policy is associated with.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetGroupPolicyOutput `json:"-", xml:"-"`
 }
@@ -5654,7 +7044,7 @@ type metadataGetGroupPolicyOutput struct {
 
 type GetInstanceProfileInput struct {
 	// The name of the instance profile to get information about.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetInstanceProfileInput `json:"-", xml:"-"`
 }
@@ -5677,7 +7067,7 @@ type metadataGetInstanceProfileOutput struct {
 
 type GetLoginProfileInput struct {
 	// The name of the user whose login profile you want to retrieve.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetLoginProfileInput `json:"-", xml:"-"`
 }
@@ -5702,7 +7092,7 @@ type GetOpenIDConnectProviderInput struct {
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect (OIDC) provider
 	// to get information for. You can get a list of OIDC provider ARNs by using
 	// the ListOpenIDConnectProviders action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataGetOpenIDConnectProviderInput `json:"-", xml:"-"`
 }
@@ -5727,7 +7117,7 @@ type GetOpenIDConnectProviderOutput struct {
 
 	// The URL that the IAM OpenID Connect provider is associated with. For more
 	// information, see CreateOpenIDConnectProvider.
-	URL *string `locationName:"Url" type:"string"`
+	URL *string `locationName:"Url" type:"string" min:"1" max:"255"`
 
 	metadataGetOpenIDConnectProviderOutput `json:"-", xml:"-"`
 }
@@ -5742,7 +7132,7 @@ type GetPolicyInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataGetPolicyInput `json:"-", xml:"-"`
 }
@@ -5769,10 +7159,10 @@ type GetPolicyVersionInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// Identifies the policy version to retrieve.
-	VersionID *string `locationName:"VersionId" type:"string" required:"true"`
+	VersionID *string `locationName:"VersionId" type:"string" pattern:"v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?" required:"true"`
 
 	metadataGetPolicyVersionInput `json:"-", xml:"-"`
 }
@@ -5799,7 +7189,7 @@ type metadataGetPolicyVersionOutput struct {
 
 type GetRoleInput struct {
 	// The name of the role to get information about.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetRoleInput `json:"-", xml:"-"`
 }
@@ -5822,10 +7212,10 @@ type metadataGetRoleOutput struct {
 
 type GetRolePolicyInput struct {
 	// The name of the policy document to get.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the role associated with the policy.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetRolePolicyInput `json:"-", xml:"-"`
 }
@@ -5837,13 +7227,13 @@ type metadataGetRolePolicyInput struct {
 // Contains the response to a successful GetRolePolicy request.
 type GetRolePolicyOutput struct {
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The role the policy is associated with.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetRolePolicyOutput `json:"-", xml:"-"`
 }
@@ -5854,7 +7244,7 @@ type metadataGetRolePolicyOutput struct {
 
 type GetSAMLProviderInput struct {
 	// The Amazon Resource Name (ARN) of the SAML provider to get information about.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" required:"true"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataGetSAMLProviderInput `json:"-", xml:"-"`
 }
@@ -5869,7 +7259,7 @@ type GetSAMLProviderOutput struct {
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The XML metadata document that includes information about an identity provider.
-	SAMLMetadataDocument *string `type:"string"`
+	SAMLMetadataDocument *string `type:"string" min:"1000" max:"10000000"`
 
 	// The expiration date and time for the SAML provider.
 	ValidUntil *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -5883,7 +7273,7 @@ type metadataGetSAMLProviderOutput struct {
 
 type GetServerCertificateInput struct {
 	// The name of the server certificate you want to retrieve information about.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetServerCertificateInput `json:"-", xml:"-"`
 }
@@ -5909,7 +7299,7 @@ type GetUserInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to the user
 	// making the request.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataGetUserInput `json:"-", xml:"-"`
 }
@@ -5932,10 +7322,10 @@ type metadataGetUserOutput struct {
 
 type GetUserPolicyInput struct {
 	// The name of the policy document to get.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the user who the policy is associated with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetUserPolicyInput `json:"-", xml:"-"`
 }
@@ -5947,13 +7337,13 @@ type metadataGetUserPolicyInput struct {
 // Contains the response to a successful GetUserPolicy request.
 type GetUserPolicyOutput struct {
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The user the policy is associated with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetUserPolicyOutput `json:"-", xml:"-"`
 }
@@ -5971,7 +7361,7 @@ type Group struct {
 	// The Amazon Resource Name (ARN) specifying the group. For more information
 	// about ARNs and how to use them in policies, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the group was created.
@@ -5980,15 +7370,15 @@ type Group struct {
 	// The stable and unique string identifying the group. For more information
 	// about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	GroupID *string `locationName:"GroupId" type:"string" required:"true"`
+	GroupID *string `locationName:"GroupId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The friendly name that identifies the group.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The path to the group. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})" required:"true"`
 
 	metadataGroup `json:"-", xml:"-"`
 }
@@ -6007,7 +7397,7 @@ type GroupDetail struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the group was created.
@@ -6016,10 +7406,10 @@ type GroupDetail struct {
 	// The stable and unique string identifying the group. For more information
 	// about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	GroupID *string `locationName:"GroupId" type:"string"`
+	GroupID *string `locationName:"GroupId" type:"string" min:"16" max:"32" pattern:"[\\w]*"`
 
 	// The friendly name that identifies the group.
-	GroupName *string `type:"string"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	// A list of the group's policies.
 	GroupPolicyList []*PolicyDetail `type:"list"`
@@ -6027,7 +7417,7 @@ type GroupDetail struct {
 	// The path to the group. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	metadataGroupDetail `json:"-", xml:"-"`
 }
@@ -6052,7 +7442,7 @@ type InstanceProfile struct {
 	// information about ARNs and how to use them in policies, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The date when the instance profile was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
@@ -6060,15 +7450,15 @@ type InstanceProfile struct {
 	// The stable and unique string identifying the instance profile. For more information
 	// about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	InstanceProfileID *string `locationName:"InstanceProfileId" type:"string" required:"true"`
+	InstanceProfileID *string `locationName:"InstanceProfileId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The name identifying the instance profile.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The path to the instance profile. For more information about paths, see IAM
 	// Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})" required:"true"`
 
 	// The role associated with the instance profile.
 	Roles []*Role `type:"list" required:"true"`
@@ -6084,16 +7474,16 @@ type ListAccessKeysInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of keys you want in the response. If there are additional keys beyond the
 	// maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataListAccessKeysInput `json:"-", xml:"-"`
 }
@@ -6114,7 +7504,7 @@ type ListAccessKeysOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListAccessKeysOutput `json:"-", xml:"-"`
 }
@@ -6127,13 +7517,13 @@ type ListAccountAliasesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of account
 	// aliases you want in the response. If there are additional account aliases
 	// beyond the maximum you specify, the IsTruncated response element is true.
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataListAccountAliasesInput `json:"-", xml:"-"`
 }
@@ -6156,7 +7546,7 @@ type ListAccountAliasesOutput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListAccountAliasesOutput `json:"-", xml:"-"`
 }
@@ -6168,22 +7558,22 @@ type metadataListAccountAliasesOutput struct {
 type ListAttachedGroupPoliciesInput struct {
 	// The name (friendly name, not ARN) of the group to list attached policies
 	// for.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of policies
 	// you want in the response. If there are additional policies beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all policies.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	metadataListAttachedGroupPoliciesInput `json:"-", xml:"-"`
 }
@@ -6204,7 +7594,7 @@ type ListAttachedGroupPoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListAttachedGroupPoliciesOutput `json:"-", xml:"-"`
 }
@@ -6217,20 +7607,20 @@ type ListAttachedRolePoliciesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of policies
 	// you want in the response. If there are additional policies beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all policies.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	// The name (friendly name, not ARN) of the role to list attached policies for.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListAttachedRolePoliciesInput `json:"-", xml:"-"`
 }
@@ -6251,7 +7641,7 @@ type ListAttachedRolePoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListAttachedRolePoliciesOutput `json:"-", xml:"-"`
 }
@@ -6264,20 +7654,20 @@ type ListAttachedUserPoliciesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of policies
 	// you want in the response. If there are additional policies beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all policies.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	// The name (friendly name, not ARN) of the user to list attached policies for.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListAttachedUserPoliciesInput `json:"-", xml:"-"`
 }
@@ -6298,7 +7688,7 @@ type ListAttachedUserPoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListAttachedUserPoliciesOutput `json:"-", xml:"-"`
 }
@@ -6313,29 +7703,29 @@ type ListEntitiesForPolicyInput struct {
 	// For example, when EntityFilter is Role, only the roles that are attached
 	// to the specified policy are returned. This parameter is optional. If it is
 	// not included, all attached entities (users, groups, and roles) are returned.
-	EntityFilter *string `type:"string"`
+	EntityFilter *string `type:"string" enum:"User,Role,Group"`
 
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of entities
 	// you want in the response. If there are additional entities beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all entities.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataListEntitiesForPolicyInput `json:"-", xml:"-"`
 }
@@ -6353,7 +7743,7 @@ type ListEntitiesForPolicyOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of groups that the policy is attached to.
 	PolicyGroups []*PolicyGroup `type:"list"`
@@ -6373,18 +7763,18 @@ type metadataListEntitiesForPolicyOutput struct {
 
 type ListGroupPoliciesInput struct {
 	// The name of the group to list policies for.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of policy
 	// names you want in the response. If there are additional policy names beyond
 	// the maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataListGroupPoliciesInput `json:"-", xml:"-"`
 }
@@ -6402,7 +7792,7 @@ type ListGroupPoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of policy names.
 	PolicyNames []*string `type:"list" required:"true"`
@@ -6418,16 +7808,16 @@ type ListGroupsForUserInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of groups
 	// you want in the response. If there are additional groups beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user to list groups for.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListGroupsForUserInput `json:"-", xml:"-"`
 }
@@ -6448,7 +7838,7 @@ type ListGroupsForUserOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListGroupsForUserOutput `json:"-", xml:"-"`
 }
@@ -6461,20 +7851,20 @@ type ListGroupsInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of groups
 	// you want in the response. If there are additional groups beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example, the prefix /division_abc/subdivision_xyz/
 	// gets all groups whose path starts with /division_abc/subdivision_xyz/.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all groups.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"\\x{002F}[\\x{0021}-\\x{007F}]*"`
 
 	metadataListGroupsInput `json:"-", xml:"-"`
 }
@@ -6495,7 +7885,7 @@ type ListGroupsOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListGroupsOutput `json:"-", xml:"-"`
 }
@@ -6508,17 +7898,17 @@ type ListInstanceProfilesForRoleInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of instance profiles you want in the response. If there are additional instance
 	// profiles beyond the maximum you specify, the IsTruncated response element
 	// is true. This parameter is optional. If you do not include it, it defaults
 	// to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the role to list instance profiles for.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListInstanceProfilesForRoleInput `json:"-", xml:"-"`
 }
@@ -6540,7 +7930,7 @@ type ListInstanceProfilesForRoleOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListInstanceProfilesForRoleOutput `json:"-", xml:"-"`
 }
@@ -6553,21 +7943,21 @@ type ListInstanceProfilesInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of instance profiles you want in the response. If there are additional instance
 	// profiles beyond the maximum you specify, the IsTruncated response element
 	// is true. This parameter is optional. If you do not include it, it defaults
 	// to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example, the prefix /application_abc/component_xyz/
 	// gets all instance profiles whose path starts with /application_abc/component_xyz/.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all instance profiles.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"\\x{002F}[\\x{0021}-\\x{007F}]*"`
 
 	metadataListInstanceProfilesInput `json:"-", xml:"-"`
 }
@@ -6589,7 +7979,7 @@ type ListInstanceProfilesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListInstanceProfilesOutput `json:"-", xml:"-"`
 }
@@ -6602,16 +7992,16 @@ type ListMFADevicesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of MFA
 	// devices you want in the response. If there are additional MFA devices beyond
 	// the maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user whose MFA devices you want to list.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataListMFADevicesInput `json:"-", xml:"-"`
 }
@@ -6632,7 +8022,7 @@ type ListMFADevicesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListMFADevicesOutput `json:"-", xml:"-"`
 }
@@ -6665,13 +8055,13 @@ type ListPoliciesInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of policies you want in the response. If there are additional policies beyond
 	// the maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// A flag to filter the results to only the attached policies.
 	//
@@ -6682,7 +8072,7 @@ type ListPoliciesInput struct {
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all policies.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	// The scope to use for filtering the results.
 	//
@@ -6691,7 +8081,7 @@ type ListPoliciesInput struct {
 	//
 	// This parameter is optional. If it is not included, or if it is set to All,
 	// all policies are returned.
-	Scope *string `type:"string"`
+	Scope *string `type:"string" enum:"All,AWS,Local"`
 
 	metadataListPoliciesInput `json:"-", xml:"-"`
 }
@@ -6709,7 +8099,7 @@ type ListPoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of policies.
 	Policies []*Policy `type:"list"`
@@ -6725,21 +8115,21 @@ type ListPolicyVersionsInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of policy versions you want in the response. If there are additional policy
 	// versions beyond the maximum you specify, the IsTruncated response element
 	// is true. This parameter is optional. If you do not include it, it defaults
 	// to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataListPolicyVersionsInput `json:"-", xml:"-"`
 }
@@ -6758,7 +8148,7 @@ type ListPolicyVersionsOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of policy versions.
 	//
@@ -6778,16 +8168,16 @@ type ListRolePoliciesInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of role policies you want in the response. If there are additional role policies
 	// beyond the maximum you specify, the IsTruncated response element is true.
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the role to list policies for.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListRolePoliciesInput `json:"-", xml:"-"`
 }
@@ -6805,7 +8195,7 @@ type ListRolePoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of policy names.
 	PolicyNames []*string `type:"list" required:"true"`
@@ -6821,20 +8211,20 @@ type ListRolesInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of roles you want in the response. If there are additional roles beyond the
 	// maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example, the prefix /application_abc/component_xyz/
 	// gets all roles whose path starts with /application_abc/component_xyz/.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all roles.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"\\x{002F}[\\x{0021}-\\x{007F}]*"`
 
 	metadataListRolesInput `json:"-", xml:"-"`
 }
@@ -6852,7 +8242,7 @@ type ListRolesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of roles.
 	Roles []*Role `type:"list" required:"true"`
@@ -6888,21 +8278,21 @@ type ListServerCertificatesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of server
 	// certificates you want in the response. If there are additional server certificates
 	// beyond the maximum you specify, the IsTruncated response element will be
 	// set to true. This parameter is optional. If you do not include it, it defaults
 	// to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example: /company/servercerts
 	// would get all server certificates for which the path starts with /company/servercerts.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all server certificates.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"\\x{002F}[\\x{0021}-\\x{007F}]*"`
 
 	metadataListServerCertificatesInput `json:"-", xml:"-"`
 }
@@ -6921,7 +8311,7 @@ type ListServerCertificatesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of server certificates.
 	ServerCertificateMetadataList []*ServerCertificateMetadata `type:"list" required:"true"`
@@ -6937,16 +8327,16 @@ type ListSigningCertificatesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of certificate
 	// IDs you want in the response. If there are additional certificate IDs beyond
 	// the maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataListSigningCertificatesInput `json:"-", xml:"-"`
 }
@@ -6967,7 +8357,7 @@ type ListSigningCertificatesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListSigningCertificatesOutput `json:"-", xml:"-"`
 }
@@ -6980,16 +8370,16 @@ type ListUserPoliciesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of policy
 	// names you want in the response. If there are additional policy names beyond
 	// the maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user to list policies for.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListUserPoliciesInput `json:"-", xml:"-"`
 }
@@ -7007,7 +8397,7 @@ type ListUserPoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of policy names.
 	PolicyNames []*string `type:"list" required:"true"`
@@ -7023,20 +8413,20 @@ type ListUsersInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of user names you want in the response. If there are additional user names
 	// beyond the maximum you specify, the IsTruncated response element is true.
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example: /division_abc/subdivision_xyz/,
 	// which would get all user names whose path starts with /division_abc/subdivision_xyz/.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all user names.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"\\x{002F}[\\x{0021}-\\x{007F}]*"`
 
 	metadataListUsersInput `json:"-", xml:"-"`
 }
@@ -7054,7 +8444,7 @@ type ListUsersOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of users.
 	Users []*User `type:"list" required:"true"`
@@ -7070,18 +8460,18 @@ type ListVirtualMFADevicesInput struct {
 	// The status (unassigned or assigned) of the devices to list. If you do not
 	// specify an AssignmentStatus, the action defaults to Any which lists both
 	// assigned and unassigned virtual MFA devices.
-	AssignmentStatus *string `type:"string"`
+	AssignmentStatus *string `type:"string" enum:"Assigned,Unassigned,Any"`
 
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of MFA devices you want in the response. If there are additional MFA devices
 	// beyond the maximum you specify, the IsTruncated response element is true.
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataListVirtualMFADevicesInput `json:"-", xml:"-"`
 }
@@ -7099,7 +8489,7 @@ type ListVirtualMFADevicesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// The list of virtual MFA devices in the current account that match the AssignmentStatus
 	// value that was passed in the request.
@@ -7125,7 +8515,7 @@ type LoginProfile struct {
 
 	// The name of the user, which can be used for signing in to the AWS Management
 	// Console.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataLoginProfile `json:"-", xml:"-"`
 }
@@ -7143,10 +8533,10 @@ type MFADevice struct {
 
 	// The serial number that uniquely identifies the MFA device. For virtual MFA
 	// devices, the serial number is the device ARN.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	// The user with whom the MFA device is associated.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataMFADevice `json:"-", xml:"-"`
 }
@@ -7162,7 +8552,7 @@ type OpenIDConnectProviderListEntry struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	metadataOpenIDConnectProviderListEntry `json:"-", xml:"-"`
 }
@@ -7188,14 +8578,14 @@ type PasswordPolicy struct {
 	HardExpiry *bool `type:"boolean"`
 
 	// The number of days that an IAM user password is valid.
-	MaxPasswordAge *int64 `type:"integer"`
+	MaxPasswordAge *int64 `type:"integer" min:"1" max:"1095"`
 
 	// Minimum length to require for IAM user passwords.
-	MinimumPasswordLength *int64 `type:"integer"`
+	MinimumPasswordLength *int64 `type:"integer" min:"6" max:"128"`
 
 	// Specifies the number of previous passwords that IAM users are prevented from
 	// reusing.
-	PasswordReusePrevention *int64 `type:"integer"`
+	PasswordReusePrevention *int64 `type:"integer" min:"1" max:"24"`
 
 	// Specifies whether to require lowercase characters for IAM user passwords.
 	RequireLowercaseCharacters *bool `type:"boolean"`
@@ -7230,7 +8620,7 @@ type Policy struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The number of entities (users, groups, and roles) that the policy is attached
 	// to.
@@ -7241,13 +8631,13 @@ type Policy struct {
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The identifier for the version of the policy that is set as the default version.
-	DefaultVersionID *string `locationName:"DefaultVersionId" type:"string"`
+	DefaultVersionID *string `locationName:"DefaultVersionId" type:"string" pattern:"v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?"`
 
 	// A friendly description of the policy.
 	//
 	// This element is included in the response to the GetPolicy operation. It
 	// is not included in the response to the ListPolicies operation.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"1000"`
 
 	// Specifies whether the policy can be attached to an IAM user, group, or role.
 	IsAttachable *bool `type:"boolean"`
@@ -7256,16 +8646,16 @@ type Policy struct {
 	//
 	// For more information about paths, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string"`
+	Path *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	// The stable and unique string identifying the policy.
 	//
 	// For more information about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	PolicyID *string `locationName:"PolicyId" type:"string"`
+	PolicyID *string `locationName:"PolicyId" type:"string" min:"16" max:"32" pattern:"[\\w]*"`
 
 	// The friendly name (not ARN) identifying the policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the policy was last updated.
@@ -7291,10 +8681,10 @@ type PolicyDetail struct {
 	// The policy document.
 	//
 	// The returned policy is URL-encoded according to RFC 3986 (http://www.faqs.org/rfcs/rfc3986.html).
-	PolicyDocument *string `type:"string"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataPolicyDetail `json:"-", xml:"-"`
 }
@@ -7313,7 +8703,7 @@ type metadataPolicyDetail struct {
 // in the Using IAM guide.
 type PolicyGroup struct {
 	// The name (friendly name, not ARN) identifying the group.
-	GroupName *string `type:"string"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataPolicyGroup `json:"-", xml:"-"`
 }
@@ -7332,7 +8722,7 @@ type metadataPolicyGroup struct {
 // in the Using IAM guide.
 type PolicyRole struct {
 	// The name (friendly name, not ARN) identifying the role.
-	RoleName *string `type:"string"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	metadataPolicyRole `json:"-", xml:"-"`
 }
@@ -7351,7 +8741,7 @@ type metadataPolicyRole struct {
 // in the Using IAM guide.
 type PolicyUser struct {
 	// The name (friendly name, not ARN) identifying the user.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	metadataPolicyUser `json:"-", xml:"-"`
 }
@@ -7377,7 +8767,7 @@ type PolicyVersion struct {
 	//
 	// The policy document is returned in the response to the GetPolicyVersion
 	// operation. It is not included in the response to the ListPolicyVersions operation.
-	Document *string `type:"string"`
+	Document *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	// Specifies whether the policy version is set as the policy's default version.
 	IsDefaultVersion *bool `type:"boolean"`
@@ -7386,7 +8776,7 @@ type PolicyVersion struct {
 	//
 	// Policy version identifiers always begin with v (always lowercase). When
 	// a policy is created, the first policy version is v1.
-	VersionID *string `locationName:"VersionId" type:"string"`
+	VersionID *string `locationName:"VersionId" type:"string" pattern:"v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?"`
 
 	metadataPolicyVersion `json:"-", xml:"-"`
 }
@@ -7397,13 +8787,13 @@ type metadataPolicyVersion struct {
 
 type PutGroupPolicyInput struct {
 	// The name of the group to associate the policy with.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the policy document.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataPutGroupPolicyInput `json:"-", xml:"-"`
 }
@@ -7422,13 +8812,13 @@ type metadataPutGroupPolicyOutput struct {
 
 type PutRolePolicyInput struct {
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the policy document.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the role to associate the policy with.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataPutRolePolicyInput `json:"-", xml:"-"`
 }
@@ -7447,13 +8837,13 @@ type metadataPutRolePolicyOutput struct {
 
 type PutUserPolicyInput struct {
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the policy document.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the user to associate the policy with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataPutUserPolicyInput `json:"-", xml:"-"`
 }
@@ -7473,12 +8863,12 @@ type metadataPutUserPolicyOutput struct {
 type RemoveClientIDFromOpenIDConnectProviderInput struct {
 	// The client ID (also known as audience) to remove from the IAM OpenID Connect
 	// provider. For more information about client IDs, see CreateOpenIDConnectProvider.
-	ClientID *string `type:"string" required:"true"`
+	ClientID *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect (OIDC) provider
 	// to remove the client ID from. You can get a list of OIDC provider ARNs by
 	// using the ListOpenIDConnectProviders action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataRemoveClientIDFromOpenIDConnectProviderInput `json:"-", xml:"-"`
 }
@@ -7497,10 +8887,10 @@ type metadataRemoveClientIDFromOpenIDConnectProviderOutput struct {
 
 type RemoveRoleFromInstanceProfileInput struct {
 	// The name of the instance profile to update.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the role to remove.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataRemoveRoleFromInstanceProfileInput `json:"-", xml:"-"`
 }
@@ -7519,10 +8909,10 @@ type metadataRemoveRoleFromInstanceProfileOutput struct {
 
 type RemoveUserFromGroupInput struct {
 	// The name of the group to update.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the user to remove.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataRemoveUserFromGroupInput `json:"-", xml:"-"`
 }
@@ -7541,16 +8931,16 @@ type metadataRemoveUserFromGroupOutput struct {
 
 type ResyncMFADeviceInput struct {
 	// An authentication code emitted by the device.
-	AuthenticationCode1 *string `type:"string" required:"true"`
+	AuthenticationCode1 *string `type:"string" min:"6" max:"6" pattern:"[\\d]*" required:"true"`
 
 	// A subsequent authentication code emitted by the device.
-	AuthenticationCode2 *string `type:"string" required:"true"`
+	AuthenticationCode2 *string `type:"string" min:"6" max:"6" pattern:"[\\d]*" required:"true"`
 
 	// Serial number that uniquely identifies the MFA device.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	// The name of the user whose MFA device you want to resynchronize.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataResyncMFADeviceInput `json:"-", xml:"-"`
 }
@@ -7580,12 +8970,12 @@ type Role struct {
 	// The Amazon Resource Name (ARN) specifying the role. For more information
 	// about ARNs and how to use them in policies, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The policy that grants an entity permission to assume the role.
 	//
 	//  The returned policy is URL-encoded according to RFC 3986 (http://www.faqs.org/rfcs/rfc3986.html).
-	AssumeRolePolicyDocument *string `type:"string"`
+	AssumeRolePolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the role was created.
@@ -7594,15 +8984,15 @@ type Role struct {
 	// The path to the role. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})" required:"true"`
 
 	// The stable and unique string identifying the role. For more information about
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	RoleID *string `locationName:"RoleId" type:"string" required:"true"`
+	RoleID *string `locationName:"RoleId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The friendly name that identifies the role.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataRole `json:"-", xml:"-"`
 }
@@ -7621,12 +9011,12 @@ type RoleDetail struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The trust policy that grants permission to assume the role.
 	//
 	//  The returned policy is URL-encoded according to RFC 3986 (http://www.faqs.org/rfcs/rfc3986.html).
-	AssumeRolePolicyDocument *string `type:"string"`
+	AssumeRolePolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the role was created.
@@ -7638,15 +9028,15 @@ type RoleDetail struct {
 	// The path to the role. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// The stable and unique string identifying the role. For more information about
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	RoleID *string `locationName:"RoleId" type:"string"`
+	RoleID *string `locationName:"RoleId" type:"string" min:"16" max:"32" pattern:"[\\w]*"`
 
 	// The friendly name that identifies the role.
-	RoleName *string `type:"string"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	// A list of the role's access (permissions) policies.
 	RolePolicyList []*PolicyDetail `type:"list"`
@@ -7661,7 +9051,7 @@ type metadataRoleDetail struct {
 // Contains the list of SAML providers for this account.
 type SAMLProviderListEntry struct {
 	// The Amazon Resource Name (ARN) of the SAML provider.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The date and time when the SAML provider was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -7682,10 +9072,10 @@ type metadataSAMLProviderListEntry struct {
 // action.
 type ServerCertificate struct {
 	// The contents of the public key certificate.
-	CertificateBody *string `type:"string" required:"true"`
+	CertificateBody *string `type:"string" min:"1" max:"16384" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The contents of the public key certificate chain.
-	CertificateChain *string `type:"string"`
+	CertificateChain *string `type:"string" min:"1" max:"2097152" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]*"`
 
 	// The meta information of the server certificate, such as its name, path, ID,
 	// and ARN.
@@ -7708,7 +9098,7 @@ type ServerCertificateMetadata struct {
 	// information about ARNs and how to use them in policies, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The date on which the certificate is set to expire.
 	Expiration *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -7716,15 +9106,15 @@ type ServerCertificateMetadata struct {
 	// The path to the server certificate. For more information about paths, see
 	// IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})" required:"true"`
 
 	// The stable and unique string identifying the server certificate. For more
 	// information about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ServerCertificateID *string `locationName:"ServerCertificateId" type:"string" required:"true"`
+	ServerCertificateID *string `locationName:"ServerCertificateId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The name that identifies the server certificate.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The date when the server certificate was uploaded.
 	UploadDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -7742,14 +9132,14 @@ type SetDefaultPolicyVersionInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The version of the policy to set as the default (operative) version.
 	//
 	// For more information about managed policy versions, see Versioning for Managed
 	// Policies (http://docs.aws.amazon.com/IAM/latest/UserGuide/policies-managed-versions.html)
 	// in the Using IAM guide.
-	VersionID *string `locationName:"VersionId" type:"string" required:"true"`
+	VersionID *string `locationName:"VersionId" type:"string" pattern:"v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?" required:"true"`
 
 	metadataSetDefaultPolicyVersionInput `json:"-", xml:"-"`
 }
@@ -7772,20 +9162,20 @@ type metadataSetDefaultPolicyVersionOutput struct {
 // and ListSigningCertificates actions.
 type SigningCertificate struct {
 	// The contents of the signing certificate.
-	CertificateBody *string `type:"string" required:"true"`
+	CertificateBody *string `type:"string" min:"1" max:"16384" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The ID for the signing certificate.
-	CertificateID *string `locationName:"CertificateId" type:"string" required:"true"`
+	CertificateID *string `locationName:"CertificateId" type:"string" min:"24" max:"128" pattern:"[\\w]*" required:"true"`
 
 	// The status of the signing certificate. Active means the key is valid for
 	// API calls, while Inactive means it is not.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"Active,Inactive" required:"true"`
 
 	// The date when the signing certificate was uploaded.
 	UploadDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The name of the user the signing certificate is associated with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataSigningCertificate `json:"-", xml:"-"`
 }
@@ -7796,15 +9186,15 @@ type metadataSigningCertificate struct {
 
 type UpdateAccessKeyInput struct {
 	// The access key ID of the secret access key you want to update.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The status you want to assign to the secret access key. Active means the
 	// key can be used for API calls to AWS, while Inactive means the key cannot
 	// be used.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"Active,Inactive" required:"true"`
 
 	// The name of the user whose key you want to update.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataUpdateAccessKeyInput `json:"-", xml:"-"`
 }
@@ -7839,19 +9229,19 @@ type UpdateAccountPasswordPolicyInput struct {
 	// of 0 means IAM user passwords never expire.
 	//
 	// Default value: 0
-	MaxPasswordAge *int64 `type:"integer"`
+	MaxPasswordAge *int64 `type:"integer" min:"1" max:"1095"`
 
 	// The minimum number of characters allowed in an IAM user password.
 	//
 	// Default value: 6
-	MinimumPasswordLength *int64 `type:"integer"`
+	MinimumPasswordLength *int64 `type:"integer" min:"6" max:"128"`
 
 	// Specifies the number of previous passwords that IAM users are prevented from
 	// reusing. The default value of 0 means IAM users are not prevented from reusing
 	// previous passwords.
 	//
 	// Default value: 0
-	PasswordReusePrevention *int64 `type:"integer"`
+	PasswordReusePrevention *int64 `type:"integer" min:"1" max:"24"`
 
 	// Specifies whether IAM user passwords must contain at least one lowercase
 	// character from the ISO basic Latin alphabet (a to z).
@@ -7896,10 +9286,10 @@ type metadataUpdateAccountPasswordPolicyOutput struct {
 
 type UpdateAssumeRolePolicyInput struct {
 	// The policy that grants an entity permission to assume the role.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the role to update.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUpdateAssumeRolePolicyInput `json:"-", xml:"-"`
 }
@@ -7919,13 +9309,13 @@ type metadataUpdateAssumeRolePolicyOutput struct {
 type UpdateGroupInput struct {
 	// Name of the group to update. If you're changing the name of the group, this
 	// is the original name.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// New name for the group. Only include this if changing the group's name.
-	NewGroupName *string `type:"string"`
+	NewGroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	// New path for the group. Only include this if changing the group's path.
-	NewPath *string `type:"string"`
+	NewPath *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	metadataUpdateGroupInput `json:"-", xml:"-"`
 }
@@ -7944,13 +9334,13 @@ type metadataUpdateGroupOutput struct {
 
 type UpdateLoginProfileInput struct {
 	// The new password for the specified user.
-	Password *string `type:"string"`
+	Password *string `type:"string" min:"1" max:"128" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	// Require the specified user to set a new password on next sign-in.
 	PasswordResetRequired *bool `type:"boolean"`
 
 	// The name of the user whose password you want to update.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUpdateLoginProfileInput `json:"-", xml:"-"`
 }
@@ -7971,7 +9361,7 @@ type UpdateOpenIDConnectProviderThumbprintInput struct {
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect (OIDC) provider
 	// to update the thumbprint for. You can get a list of OIDC provider ARNs by
 	// using the ListOpenIDConnectProviders action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// A list of certificate thumbprints that are associated with the specified
 	// IAM OpenID Connect provider. For more information, see CreateOpenIDConnectProvider.
@@ -7998,10 +9388,10 @@ type UpdateSAMLProviderInput struct {
 	// keys that can be used to validate the SAML authentication response (assertions)
 	// that are received from the IdP. You must generate the metadata document using
 	// the identity management software that is used as your organization's IdP.
-	SAMLMetadataDocument *string `type:"string" required:"true"`
+	SAMLMetadataDocument *string `type:"string" min:"1000" max:"10000000" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the SAML provider to update.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" required:"true"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataUpdateSAMLProviderInput `json:"-", xml:"-"`
 }
@@ -8013,7 +9403,7 @@ type metadataUpdateSAMLProviderInput struct {
 // Contains the response to a successful UpdateSAMLProvider request.
 type UpdateSAMLProviderOutput struct {
 	// The Amazon Resource Name (ARN) of the SAML provider that was updated.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048"`
 
 	metadataUpdateSAMLProviderOutput `json:"-", xml:"-"`
 }
@@ -8025,14 +9415,14 @@ type metadataUpdateSAMLProviderOutput struct {
 type UpdateServerCertificateInput struct {
 	// The new path for the server certificate. Include this only if you are updating
 	// the server certificate's path.
-	NewPath *string `type:"string"`
+	NewPath *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// The new name for the server certificate. Include this only if you are updating
 	// the server certificate's name.
-	NewServerCertificateName *string `type:"string"`
+	NewServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	// The name of the server certificate that you want to update.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUpdateServerCertificateInput `json:"-", xml:"-"`
 }
@@ -8051,15 +9441,15 @@ type metadataUpdateServerCertificateOutput struct {
 
 type UpdateSigningCertificateInput struct {
 	// The ID of the signing certificate you want to update.
-	CertificateID *string `locationName:"CertificateId" type:"string" required:"true"`
+	CertificateID *string `locationName:"CertificateId" type:"string" min:"24" max:"128" pattern:"[\\w]*" required:"true"`
 
 	// The status you want to assign to the certificate. Active means the certificate
 	// can be used for API calls to AWS, while Inactive means the certificate cannot
 	// be used.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"Active,Inactive" required:"true"`
 
 	// The name of the user the signing certificate belongs to.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataUpdateSigningCertificateInput `json:"-", xml:"-"`
 }
@@ -8079,15 +9469,15 @@ type metadataUpdateSigningCertificateOutput struct {
 type UpdateUserInput struct {
 	// New path for the user. Include this parameter only if you're changing the
 	// user's path.
-	NewPath *string `type:"string"`
+	NewPath *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// New name for the user. Include this parameter only if you're changing the
 	// user's name.
-	NewUserName *string `type:"string"`
+	NewUserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	// Name of the user to update. If you're changing the name of the user, this
 	// is the original user name.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUpdateUserInput `json:"-", xml:"-"`
 }
@@ -8106,11 +9496,11 @@ type metadataUpdateUserOutput struct {
 
 type UploadServerCertificateInput struct {
 	// The contents of the public key certificate in PEM-encoded format.
-	CertificateBody *string `type:"string" required:"true"`
+	CertificateBody *string `type:"string" min:"1" max:"16384" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The contents of the certificate chain. This is typically a concatenation
 	// of the PEM-encoded public key certificates of the chain.
-	CertificateChain *string `type:"string"`
+	CertificateChain *string `type:"string" min:"1" max:"2097152" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]*"`
 
 	// The path for the server certificate. For more information about paths, see
 	// IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -8123,13 +9513,13 @@ type UploadServerCertificateInput struct {
 	// CloudFront distributions, you must specify a path using the --path option.
 	// The path must begin with /cloudfront and must include a trailing slash (for
 	// example, /cloudfront/test/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// The contents of the private key in PEM-encoded format.
-	PrivateKey *string `type:"string" required:"true"`
+	PrivateKey *string `type:"string" min:"1" max:"16384" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]*" required:"true"`
 
 	// The name for the server certificate. Do not include the path in this value.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUploadServerCertificateInput `json:"-", xml:"-"`
 }
@@ -8153,10 +9543,10 @@ type metadataUploadServerCertificateOutput struct {
 
 type UploadSigningCertificateInput struct {
 	// The contents of the signing certificate.
-	CertificateBody *string `type:"string" required:"true"`
+	CertificateBody *string `type:"string" min:"1" max:"16384" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the user the signing certificate is for.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataUploadSigningCertificateInput `json:"-", xml:"-"`
 }
@@ -8190,7 +9580,7 @@ type User struct {
 	// The Amazon Resource Name (ARN) that identifies the user. For more information
 	// about ARNs and how to use ARNs in policies, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the user was created.
@@ -8212,15 +9602,15 @@ type User struct {
 	// The path to the user. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})" required:"true"`
 
 	// The stable and unique string identifying the user. For more information about
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	UserID *string `locationName:"UserId" type:"string" required:"true"`
+	UserID *string `locationName:"UserId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The friendly name identifying the user.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUser `json:"-", xml:"-"`
 }
@@ -8240,7 +9630,7 @@ type UserDetail struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the user was created.
@@ -8252,15 +9642,15 @@ type UserDetail struct {
 	// The path to the user. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// The stable and unique string identifying the user. For more information about
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	UserID *string `locationName:"UserId" type:"string"`
+	UserID *string `locationName:"UserId" type:"string" min:"16" max:"32" pattern:"[\\w]*"`
 
 	// The friendly name identifying the user.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	// A list of the user's policies.
 	UserPolicyList []*PolicyDetail `type:"list"`
@@ -8288,7 +9678,7 @@ type VirtualMFADevice struct {
 	QRCodePNG []byte `type:"blob"`
 
 	// The serial number associated with VirtualMFADevice.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	// Contains information about an IAM user entity.
 	//
@@ -8306,4 +9696,154 @@ type VirtualMFADevice struct {
 
 type metadataVirtualMFADevice struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for EntityType.
+const (
+	EntityTypeUser  = "User"
+	EntityTypeRole  = "Role"
+	EntityTypeGroup = "Group"
+)
+
+// EntityTypeValues returns the valid values of EntityType.
+func EntityTypeValues() []string {
+	return []string{
+		EntityTypeUser,
+		EntityTypeRole,
+		EntityTypeGroup,
+	}
+}
+
+// Enum values for ReportFormatType.
+const (
+	ReportFormatTypeTextCsv = "text/csv"
+)
+
+// ReportFormatTypeValues returns the valid values of ReportFormatType.
+func ReportFormatTypeValues() []string {
+	return []string{
+		ReportFormatTypeTextCsv,
+	}
+}
+
+// Enum values for ReportStateType.
+const (
+	ReportStateTypeStarted    = "STARTED"
+	ReportStateTypeInprogress = "INPROGRESS"
+	ReportStateTypeComplete   = "COMPLETE"
+)
+
+// ReportStateTypeValues returns the valid values of ReportStateType.
+func ReportStateTypeValues() []string {
+	return []string{
+		ReportStateTypeStarted,
+		ReportStateTypeInprogress,
+		ReportStateTypeComplete,
+	}
+}
+
+// Enum values for assignmentStatusType.
+const (
+	AssignmentStatusTypeAssigned   = "Assigned"
+	AssignmentStatusTypeUnassigned = "Unassigned"
+	AssignmentStatusTypeAny        = "Any"
+)
+
+// assignmentStatusTypeValues returns the valid values of assignmentStatusType.
+func assignmentStatusTypeValues() []string {
+	return []string{
+		AssignmentStatusTypeAssigned,
+		AssignmentStatusTypeUnassigned,
+		AssignmentStatusTypeAny,
+	}
+}
+
+// Enum values for policyScopeType.
+const (
+	PolicyScopeTypeAll   = "All"
+	PolicyScopeTypeAWS   = "AWS"
+	PolicyScopeTypeLocal = "Local"
+)
+
+// policyScopeTypeValues returns the valid values of policyScopeType.
+func policyScopeTypeValues() []string {
+	return []string{
+		PolicyScopeTypeAll,
+		PolicyScopeTypeAWS,
+		PolicyScopeTypeLocal,
+	}
+}
+
+// Enum values for statusType.
+const (
+	StatusTypeActive   = "Active"
+	StatusTypeInactive = "Inactive"
+)
+
+// statusTypeValues returns the valid values of statusType.
+func statusTypeValues() []string {
+	return []string{
+		StatusTypeActive,
+		StatusTypeInactive,
+	}
+}
+
+// Enum values for summaryKeyType.
+const (
+	SummaryKeyTypeUsers                             = "Users"
+	SummaryKeyTypeUsersQuota                        = "UsersQuota"
+	SummaryKeyTypeGroups                            = "Groups"
+	SummaryKeyTypeGroupsQuota                       = "GroupsQuota"
+	SummaryKeyTypeServerCertificates                = "ServerCertificates"
+	SummaryKeyTypeServerCertificatesQuota           = "ServerCertificatesQuota"
+	SummaryKeyTypeUserPolicySizeQuota               = "UserPolicySizeQuota"
+	SummaryKeyTypeGroupPolicySizeQuota              = "GroupPolicySizeQuota"
+	SummaryKeyTypeGroupsPerUserQuota                = "GroupsPerUserQuota"
+	SummaryKeyTypeSigningCertificatesPerUserQuota   = "SigningCertificatesPerUserQuota"
+	SummaryKeyTypeAccessKeysPerUserQuota            = "AccessKeysPerUserQuota"
+	SummaryKeyTypeMFADevices                        = "MFADevices"
+	SummaryKeyTypeMFADevicesInUse                   = "MFADevicesInUse"
+	SummaryKeyTypeAccountMFAEnabled                 = "AccountMFAEnabled"
+	SummaryKeyTypeAccountAccessKeysPresent          = "AccountAccessKeysPresent"
+	SummaryKeyTypeAccountSigningCertificatesPresent = "AccountSigningCertificatesPresent"
+	SummaryKeyTypeAttachedPoliciesPerGroupQuota     = "AttachedPoliciesPerGroupQuota"
+	SummaryKeyTypeAttachedPoliciesPerRoleQuota      = "AttachedPoliciesPerRoleQuota"
+	SummaryKeyTypeAttachedPoliciesPerUserQuota      = "AttachedPoliciesPerUserQuota"
+	SummaryKeyTypePolicies                          = "Policies"
+	SummaryKeyTypePoliciesQuota                     = "PoliciesQuota"
+	SummaryKeyTypePolicySizeQuota                   = "PolicySizeQuota"
+	SummaryKeyTypePolicyVersionsInUse               = "PolicyVersionsInUse"
+	SummaryKeyTypePolicyVersionsInUseQuota          = "PolicyVersionsInUseQuota"
+	SummaryKeyTypeVersionsPerPolicyQuota            = "VersionsPerPolicyQuota"
+)
+
+// summaryKeyTypeValues returns the valid values of summaryKeyType.
+func summaryKeyTypeValues() []string {
+	return []string{
+		SummaryKeyTypeUsers,
+		SummaryKeyTypeUsersQuota,
+		SummaryKeyTypeGroups,
+		SummaryKeyTypeGroupsQuota,
+		SummaryKeyTypeServerCertificates,
+		SummaryKeyTypeServerCertificatesQuota,
+		SummaryKeyTypeUserPolicySizeQuota,
+		SummaryKeyTypeGroupPolicySizeQuota,
+		SummaryKeyTypeGroupsPerUserQuota,
+		SummaryKeyTypeSigningCertificatesPerUserQuota,
+		SummaryKeyTypeAccessKeysPerUserQuota,
+		SummaryKeyTypeMFADevices,
+		SummaryKeyTypeMFADevicesInUse,
+		SummaryKeyTypeAccountMFAEnabled,
+		SummaryKeyTypeAccountAccessKeysPresent,
+		SummaryKeyTypeAccountSigningCertificatesPresent,
+		SummaryKeyTypeAttachedPoliciesPerGroupQuota,
+		SummaryKeyTypeAttachedPoliciesPerRoleQuota,
+		SummaryKeyTypeAttachedPoliciesPerUserQuota,
+		SummaryKeyTypePolicies,
+		SummaryKeyTypePoliciesQuota,
+		SummaryKeyTypePolicySizeQuota,
+		SummaryKeyTypePolicyVersionsInUse,
+		SummaryKeyTypePolicyVersionsInUseQuota,
+		SummaryKeyTypeVersionsPerPolicyQuota,
+	}
 }
\ No newline at end of file
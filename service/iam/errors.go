@@ -0,0 +1,163 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package iam
+
+import (
+	"github.com/datacratic/aws-sdk-go/aws"
+)
+
+// Error codes of the IAM errors. Errors with these codes are
+// returned as the typed error of the same name, e.g. an error with the code
+// ErrCodeFoo is a *Foo.
+const (
+	ErrCodeCredentialReportExpiredException       = "ReportExpired"
+	ErrCodeCredentialReportNotPresentException    = "ReportNotPresent"
+	ErrCodeCredentialReportNotReadyException      = "ReportInProgress"
+	ErrCodeDeleteConflictException                = "DeleteConflict"
+	ErrCodeDuplicateCertificateException          = "DuplicateCertificate"
+	ErrCodeEntityAlreadyExistsException           = "EntityAlreadyExists"
+	ErrCodeEntityTemporarilyUnmodifiableException = "EntityTemporarilyUnmodifiable"
+	ErrCodeInvalidAuthenticationCodeException     = "InvalidAuthenticationCode"
+	ErrCodeInvalidCertificateException            = "InvalidCertificate"
+	ErrCodeInvalidInputException                  = "InvalidInput"
+	ErrCodeInvalidUserTypeException               = "InvalidUserType"
+	ErrCodeKeyPairMismatchException               = "KeyPairMismatch"
+	ErrCodeLimitExceededException                 = "LimitExceeded"
+	ErrCodeMalformedCertificateException          = "MalformedCertificate"
+	ErrCodeMalformedPolicyDocumentException       = "MalformedPolicyDocument"
+	ErrCodeNoSuchEntityException                  = "NoSuchEntity"
+	ErrCodePasswordPolicyViolationException       = "PasswordPolicyViolation"
+	ErrCodeServiceFailureException                = "ServiceFailure"
+)
+
+// The request was rejected because the most recent credential report has expired.
+// To generate a new credential report, use GenerateCredentialReport. For more
+// information about credential report expiration, see Getting Credential Reports
+// (http://docs.aws.amazon.com/IAM/latest/UserGuide/credential-reports.html)
+// in the Using IAM guide.
+type CredentialReportExpiredException struct {
+	aws.APIError
+}
+
+// The request was rejected because the credential report does not exist. To
+// generate a credential report, use GenerateCredentialReport.
+type CredentialReportNotPresentException struct {
+	aws.APIError
+}
+
+// The request was rejected because the credential report is still being generated.
+type CredentialReportNotReadyException struct {
+	aws.APIError
+}
+
+// The request was rejected because it attempted to delete a resource that has
+// attached subordinate entities. The error message describes these entities.
+type DeleteConflictException struct {
+	aws.APIError
+}
+
+// The request was rejected because the same certificate is associated to another
+// user under the account.
+type DuplicateCertificateException struct {
+	aws.APIError
+}
+
+// The request was rejected because it attempted to create a resource that already
+// exists.
+type EntityAlreadyExistsException struct {
+	aws.APIError
+}
+
+// The request was rejected because it referenced an entity that is temporarily
+// unmodifiable, such as a user name that was deleted and then recreated. The
+// error indicates that the request is likely to succeed if you try again after
+// waiting several minutes. The error message describes the entity.
+type EntityTemporarilyUnmodifiableException struct {
+	aws.APIError
+}
+
+// The request was rejected because the authentication code was not recognized.
+// The error message describes the specific error.
+type InvalidAuthenticationCodeException struct {
+	aws.APIError
+}
+
+// The request was rejected because the certificate is invalid.
+type InvalidCertificateException struct {
+	aws.APIError
+}
+
+// The request was rejected because an invalid or out-of-range value was supplied
+// for an input parameter.
+type InvalidInputException struct {
+	aws.APIError
+}
+
+// The request was rejected because the type of user for the transaction was
+// incorrect.
+type InvalidUserTypeException struct {
+	aws.APIError
+}
+
+// The request was rejected because the public key certificate and the private
+// key do not match.
+type KeyPairMismatchException struct {
+	aws.APIError
+}
+
+// The request was rejected because it attempted to create resources beyond
+// the current AWS account limits. The error message describes the limit exceeded.
+type LimitExceededException struct {
+	aws.APIError
+}
+
+// The request was rejected because the certificate was malformed or expired.
+// The error message describes the specific error.
+type MalformedCertificateException struct {
+	aws.APIError
+}
+
+// The request was rejected because the policy document was malformed. The error
+// message describes the specific error.
+type MalformedPolicyDocumentException struct {
+	aws.APIError
+}
+
+// The request was rejected because it referenced an entity that does not exist.
+// The error message describes the entity.
+type NoSuchEntityException struct {
+	aws.APIError
+}
+
+// The request was rejected because the provided password did not meet the requirements
+// imposed by the account password policy.
+type PasswordPolicyViolationException struct {
+	aws.APIError
+}
+
+// The request processing has failed because of an unknown error, exception
+// or failure.
+type ServiceFailureException struct {
+	aws.APIError
+}
+
+var errorTypes = map[string]func() aws.ServiceError{
+	ErrCodeCredentialReportExpiredException:       func() aws.ServiceError { return &CredentialReportExpiredException{} },
+	ErrCodeCredentialReportNotPresentException:    func() aws.ServiceError { return &CredentialReportNotPresentException{} },
+	ErrCodeCredentialReportNotReadyException:      func() aws.ServiceError { return &CredentialReportNotReadyException{} },
+	ErrCodeDeleteConflictException:                func() aws.ServiceError { return &DeleteConflictException{} },
+	ErrCodeDuplicateCertificateException:          func() aws.ServiceError { return &DuplicateCertificateException{} },
+	ErrCodeEntityAlreadyExistsException:           func() aws.ServiceError { return &EntityAlreadyExistsException{} },
+	ErrCodeEntityTemporarilyUnmodifiableException: func() aws.ServiceError { return &EntityTemporarilyUnmodifiableException{} },
+	ErrCodeInvalidAuthenticationCodeException:     func() aws.ServiceError { return &InvalidAuthenticationCodeException{} },
+	ErrCodeInvalidCertificateException:            func() aws.ServiceError { return &InvalidCertificateException{} },
+	ErrCodeInvalidInputException:                  func() aws.ServiceError { return &InvalidInputException{} },
+	ErrCodeInvalidUserTypeException:               func() aws.ServiceError { return &InvalidUserTypeException{} },
+	ErrCodeKeyPairMismatchException:               func() aws.ServiceError { return &KeyPairMismatchException{} },
+	ErrCodeLimitExceededException:                 func() aws.ServiceError { return &LimitExceededException{} },
+	ErrCodeMalformedCertificateException:          func() aws.ServiceError { return &MalformedCertificateException{} },
+	ErrCodeMalformedPolicyDocumentException:       func() aws.ServiceError { return &MalformedPolicyDocumentException{} },
+	ErrCodeNoSuchEntityException:                  func() aws.ServiceError { return &NoSuchEntityException{} },
+	ErrCodePasswordPolicyViolationException:       func() aws.ServiceError { return &PasswordPolicyViolationException{} },
+	ErrCodeServiceFailureException:                func() aws.ServiceError { return &ServiceFailureException{} },
+}
@@ -1,213 +1,437 @@
 package iamiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/iam"
 )
 
 type IAMAPI interface {
 	AddClientIDToOpenIDConnectProvider(*iam.AddClientIDToOpenIDConnectProviderInput) (*iam.AddClientIDToOpenIDConnectProviderOutput, error)
 
+	AddClientIDToOpenIDConnectProviderWithContext(context.Context, *iam.AddClientIDToOpenIDConnectProviderInput) (*iam.AddClientIDToOpenIDConnectProviderOutput, error)
+
 	AddRoleToInstanceProfile(*iam.AddRoleToInstanceProfileInput) (*iam.AddRoleToInstanceProfileOutput, error)
 
+	AddRoleToInstanceProfileWithContext(context.Context, *iam.AddRoleToInstanceProfileInput) (*iam.AddRoleToInstanceProfileOutput, error)
+
 	AddUserToGroup(*iam.AddUserToGroupInput) (*iam.AddUserToGroupOutput, error)
 
+	AddUserToGroupWithContext(context.Context, *iam.AddUserToGroupInput) (*iam.AddUserToGroupOutput, error)
+
 	AttachGroupPolicy(*iam.AttachGroupPolicyInput) (*iam.AttachGroupPolicyOutput, error)
 
+	AttachGroupPolicyWithContext(context.Context, *iam.AttachGroupPolicyInput) (*iam.AttachGroupPolicyOutput, error)
+
 	AttachRolePolicy(*iam.AttachRolePolicyInput) (*iam.AttachRolePolicyOutput, error)
 
+	AttachRolePolicyWithContext(context.Context, *iam.AttachRolePolicyInput) (*iam.AttachRolePolicyOutput, error)
+
 	AttachUserPolicy(*iam.AttachUserPolicyInput) (*iam.AttachUserPolicyOutput, error)
 
+	AttachUserPolicyWithContext(context.Context, *iam.AttachUserPolicyInput) (*iam.AttachUserPolicyOutput, error)
+
 	ChangePassword(*iam.ChangePasswordInput) (*iam.ChangePasswordOutput, error)
 
+	ChangePasswordWithContext(context.Context, *iam.ChangePasswordInput) (*iam.ChangePasswordOutput, error)
+
 	CreateAccessKey(*iam.CreateAccessKeyInput) (*iam.CreateAccessKeyOutput, error)
 
+	CreateAccessKeyWithContext(context.Context, *iam.CreateAccessKeyInput) (*iam.CreateAccessKeyOutput, error)
+
 	CreateAccountAlias(*iam.CreateAccountAliasInput) (*iam.CreateAccountAliasOutput, error)
 
+	CreateAccountAliasWithContext(context.Context, *iam.CreateAccountAliasInput) (*iam.CreateAccountAliasOutput, error)
+
 	CreateGroup(*iam.CreateGroupInput) (*iam.CreateGroupOutput, error)
 
+	CreateGroupWithContext(context.Context, *iam.CreateGroupInput) (*iam.CreateGroupOutput, error)
+
 	CreateInstanceProfile(*iam.CreateInstanceProfileInput) (*iam.CreateInstanceProfileOutput, error)
 
+	CreateInstanceProfileWithContext(context.Context, *iam.CreateInstanceProfileInput) (*iam.CreateInstanceProfileOutput, error)
+
 	CreateLoginProfile(*iam.CreateLoginProfileInput) (*iam.CreateLoginProfileOutput, error)
 
+	CreateLoginProfileWithContext(context.Context, *iam.CreateLoginProfileInput) (*iam.CreateLoginProfileOutput, error)
+
 	CreateOpenIDConnectProvider(*iam.CreateOpenIDConnectProviderInput) (*iam.CreateOpenIDConnectProviderOutput, error)
 
+	CreateOpenIDConnectProviderWithContext(context.Context, *iam.CreateOpenIDConnectProviderInput) (*iam.CreateOpenIDConnectProviderOutput, error)
+
 	CreatePolicy(*iam.CreatePolicyInput) (*iam.CreatePolicyOutput, error)
 
+	CreatePolicyWithContext(context.Context, *iam.CreatePolicyInput) (*iam.CreatePolicyOutput, error)
+
 	CreatePolicyVersion(*iam.CreatePolicyVersionInput) (*iam.CreatePolicyVersionOutput, error)
 
+	CreatePolicyVersionWithContext(context.Context, *iam.CreatePolicyVersionInput) (*iam.CreatePolicyVersionOutput, error)
+
 	CreateRole(*iam.CreateRoleInput) (*iam.CreateRoleOutput, error)
 
+	CreateRoleWithContext(context.Context, *iam.CreateRoleInput) (*iam.CreateRoleOutput, error)
+
 	CreateSAMLProvider(*iam.CreateSAMLProviderInput) (*iam.CreateSAMLProviderOutput, error)
 
+	CreateSAMLProviderWithContext(context.Context, *iam.CreateSAMLProviderInput) (*iam.CreateSAMLProviderOutput, error)
+
 	CreateUser(*iam.CreateUserInput) (*iam.CreateUserOutput, error)
 
+	CreateUserWithContext(context.Context, *iam.CreateUserInput) (*iam.CreateUserOutput, error)
+
 	CreateVirtualMFADevice(*iam.CreateVirtualMFADeviceInput) (*iam.CreateVirtualMFADeviceOutput, error)
 
+	CreateVirtualMFADeviceWithContext(context.Context, *iam.CreateVirtualMFADeviceInput) (*iam.CreateVirtualMFADeviceOutput, error)
+
 	DeactivateMFADevice(*iam.DeactivateMFADeviceInput) (*iam.DeactivateMFADeviceOutput, error)
 
+	DeactivateMFADeviceWithContext(context.Context, *iam.DeactivateMFADeviceInput) (*iam.DeactivateMFADeviceOutput, error)
+
 	DeleteAccessKey(*iam.DeleteAccessKeyInput) (*iam.DeleteAccessKeyOutput, error)
 
+	DeleteAccessKeyWithContext(context.Context, *iam.DeleteAccessKeyInput) (*iam.DeleteAccessKeyOutput, error)
+
 	DeleteAccountAlias(*iam.DeleteAccountAliasInput) (*iam.DeleteAccountAliasOutput, error)
 
+	DeleteAccountAliasWithContext(context.Context, *iam.DeleteAccountAliasInput) (*iam.DeleteAccountAliasOutput, error)
+
 	DeleteAccountPasswordPolicy(*iam.DeleteAccountPasswordPolicyInput) (*iam.DeleteAccountPasswordPolicyOutput, error)
 
+	DeleteAccountPasswordPolicyWithContext(context.Context, *iam.DeleteAccountPasswordPolicyInput) (*iam.DeleteAccountPasswordPolicyOutput, error)
+
 	DeleteGroup(*iam.DeleteGroupInput) (*iam.DeleteGroupOutput, error)
 
+	DeleteGroupWithContext(context.Context, *iam.DeleteGroupInput) (*iam.DeleteGroupOutput, error)
+
 	DeleteGroupPolicy(*iam.DeleteGroupPolicyInput) (*iam.DeleteGroupPolicyOutput, error)
 
+	DeleteGroupPolicyWithContext(context.Context, *iam.DeleteGroupPolicyInput) (*iam.DeleteGroupPolicyOutput, error)
+
 	DeleteInstanceProfile(*iam.DeleteInstanceProfileInput) (*iam.DeleteInstanceProfileOutput, error)
 
+	DeleteInstanceProfileWithContext(context.Context, *iam.DeleteInstanceProfileInput) (*iam.DeleteInstanceProfileOutput, error)
+
 	DeleteLoginProfile(*iam.DeleteLoginProfileInput) (*iam.DeleteLoginProfileOutput, error)
 
+	DeleteLoginProfileWithContext(context.Context, *iam.DeleteLoginProfileInput) (*iam.DeleteLoginProfileOutput, error)
+
 	DeleteOpenIDConnectProvider(*iam.DeleteOpenIDConnectProviderInput) (*iam.DeleteOpenIDConnectProviderOutput, error)
 
+	DeleteOpenIDConnectProviderWithContext(context.Context, *iam.DeleteOpenIDConnectProviderInput) (*iam.DeleteOpenIDConnectProviderOutput, error)
+
 	DeletePolicy(*iam.DeletePolicyInput) (*iam.DeletePolicyOutput, error)
 
+	DeletePolicyWithContext(context.Context, *iam.DeletePolicyInput) (*iam.DeletePolicyOutput, error)
+
 	DeletePolicyVersion(*iam.DeletePolicyVersionInput) (*iam.DeletePolicyVersionOutput, error)
 
+	DeletePolicyVersionWithContext(context.Context, *iam.DeletePolicyVersionInput) (*iam.DeletePolicyVersionOutput, error)
+
 	DeleteRole(*iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error)
 
+	DeleteRoleWithContext(context.Context, *iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error)
+
 	DeleteRolePolicy(*iam.DeleteRolePolicyInput) (*iam.DeleteRolePolicyOutput, error)
 
+	DeleteRolePolicyWithContext(context.Context, *iam.DeleteRolePolicyInput) (*iam.DeleteRolePolicyOutput, error)
+
 	DeleteSAMLProvider(*iam.DeleteSAMLProviderInput) (*iam.DeleteSAMLProviderOutput, error)
 
+	DeleteSAMLProviderWithContext(context.Context, *iam.DeleteSAMLProviderInput) (*iam.DeleteSAMLProviderOutput, error)
+
 	DeleteServerCertificate(*iam.DeleteServerCertificateInput) (*iam.DeleteServerCertificateOutput, error)
 
+	DeleteServerCertificateWithContext(context.Context, *iam.DeleteServerCertificateInput) (*iam.DeleteServerCertificateOutput, error)
+
 	DeleteSigningCertificate(*iam.DeleteSigningCertificateInput) (*iam.DeleteSigningCertificateOutput, error)
 
+	DeleteSigningCertificateWithContext(context.Context, *iam.DeleteSigningCertificateInput) (*iam.DeleteSigningCertificateOutput, error)
+
 	DeleteUser(*iam.DeleteUserInput) (*iam.DeleteUserOutput, error)
 
+	DeleteUserWithContext(context.Context, *iam.DeleteUserInput) (*iam.DeleteUserOutput, error)
+
 	DeleteUserPolicy(*iam.DeleteUserPolicyInput) (*iam.DeleteUserPolicyOutput, error)
 
+	DeleteUserPolicyWithContext(context.Context, *iam.DeleteUserPolicyInput) (*iam.DeleteUserPolicyOutput, error)
+
 	DeleteVirtualMFADevice(*iam.DeleteVirtualMFADeviceInput) (*iam.DeleteVirtualMFADeviceOutput, error)
 
+	DeleteVirtualMFADeviceWithContext(context.Context, *iam.DeleteVirtualMFADeviceInput) (*iam.DeleteVirtualMFADeviceOutput, error)
+
 	DetachGroupPolicy(*iam.DetachGroupPolicyInput) (*iam.DetachGroupPolicyOutput, error)
 
+	DetachGroupPolicyWithContext(context.Context, *iam.DetachGroupPolicyInput) (*iam.DetachGroupPolicyOutput, error)
+
 	DetachRolePolicy(*iam.DetachRolePolicyInput) (*iam.DetachRolePolicyOutput, error)
 
+	DetachRolePolicyWithContext(context.Context, *iam.DetachRolePolicyInput) (*iam.DetachRolePolicyOutput, error)
+
 	DetachUserPolicy(*iam.DetachUserPolicyInput) (*iam.DetachUserPolicyOutput, error)
 
+	DetachUserPolicyWithContext(context.Context, *iam.DetachUserPolicyInput) (*iam.DetachUserPolicyOutput, error)
+
 	EnableMFADevice(*iam.EnableMFADeviceInput) (*iam.EnableMFADeviceOutput, error)
 
+	EnableMFADeviceWithContext(context.Context, *iam.EnableMFADeviceInput) (*iam.EnableMFADeviceOutput, error)
+
 	GenerateCredentialReport(*iam.GenerateCredentialReportInput) (*iam.GenerateCredentialReportOutput, error)
 
+	GenerateCredentialReportWithContext(context.Context, *iam.GenerateCredentialReportInput) (*iam.GenerateCredentialReportOutput, error)
+
 	GetAccountAuthorizationDetails(*iam.GetAccountAuthorizationDetailsInput) (*iam.GetAccountAuthorizationDetailsOutput, error)
 
+	GetAccountAuthorizationDetailsWithContext(context.Context, *iam.GetAccountAuthorizationDetailsInput) (*iam.GetAccountAuthorizationDetailsOutput, error)
+
 	GetAccountPasswordPolicy(*iam.GetAccountPasswordPolicyInput) (*iam.GetAccountPasswordPolicyOutput, error)
 
+	GetAccountPasswordPolicyWithContext(context.Context, *iam.GetAccountPasswordPolicyInput) (*iam.GetAccountPasswordPolicyOutput, error)
+
 	GetAccountSummary(*iam.GetAccountSummaryInput) (*iam.GetAccountSummaryOutput, error)
 
+	GetAccountSummaryWithContext(context.Context, *iam.GetAccountSummaryInput) (*iam.GetAccountSummaryOutput, error)
+
 	GetCredentialReport(*iam.GetCredentialReportInput) (*iam.GetCredentialReportOutput, error)
 
+	GetCredentialReportWithContext(context.Context, *iam.GetCredentialReportInput) (*iam.GetCredentialReportOutput, error)
+
 	GetGroup(*iam.GetGroupInput) (*iam.GetGroupOutput, error)
 
+	GetGroupWithContext(context.Context, *iam.GetGroupInput) (*iam.GetGroupOutput, error)
+	GetGroupPages(*iam.GetGroupInput, func(*iam.GetGroupOutput, bool) bool) error
+
 	GetGroupPolicy(*iam.GetGroupPolicyInput) (*iam.GetGroupPolicyOutput, error)
 
+	GetGroupPolicyWithContext(context.Context, *iam.GetGroupPolicyInput) (*iam.GetGroupPolicyOutput, error)
+
 	GetInstanceProfile(*iam.GetInstanceProfileInput) (*iam.GetInstanceProfileOutput, error)
 
+	GetInstanceProfileWithContext(context.Context, *iam.GetInstanceProfileInput) (*iam.GetInstanceProfileOutput, error)
+
 	GetLoginProfile(*iam.GetLoginProfileInput) (*iam.GetLoginProfileOutput, error)
 
+	GetLoginProfileWithContext(context.Context, *iam.GetLoginProfileInput) (*iam.GetLoginProfileOutput, error)
+
 	GetOpenIDConnectProvider(*iam.GetOpenIDConnectProviderInput) (*iam.GetOpenIDConnectProviderOutput, error)
 
+	GetOpenIDConnectProviderWithContext(context.Context, *iam.GetOpenIDConnectProviderInput) (*iam.GetOpenIDConnectProviderOutput, error)
+
 	GetPolicy(*iam.GetPolicyInput) (*iam.GetPolicyOutput, error)
 
+	GetPolicyWithContext(context.Context, *iam.GetPolicyInput) (*iam.GetPolicyOutput, error)
+
 	GetPolicyVersion(*iam.GetPolicyVersionInput) (*iam.GetPolicyVersionOutput, error)
 
+	GetPolicyVersionWithContext(context.Context, *iam.GetPolicyVersionInput) (*iam.GetPolicyVersionOutput, error)
+
 	GetRole(*iam.GetRoleInput) (*iam.GetRoleOutput, error)
 
+	GetRoleWithContext(context.Context, *iam.GetRoleInput) (*iam.GetRoleOutput, error)
+
 	GetRolePolicy(*iam.GetRolePolicyInput) (*iam.GetRolePolicyOutput, error)
 
+	GetRolePolicyWithContext(context.Context, *iam.GetRolePolicyInput) (*iam.GetRolePolicyOutput, error)
+
 	GetSAMLProvider(*iam.GetSAMLProviderInput) (*iam.GetSAMLProviderOutput, error)
 
+	GetSAMLProviderWithContext(context.Context, *iam.GetSAMLProviderInput) (*iam.GetSAMLProviderOutput, error)
+
 	GetServerCertificate(*iam.GetServerCertificateInput) (*iam.GetServerCertificateOutput, error)
 
+	GetServerCertificateWithContext(context.Context, *iam.GetServerCertificateInput) (*iam.GetServerCertificateOutput, error)
+
 	GetUser(*iam.GetUserInput) (*iam.GetUserOutput, error)
 
+	GetUserWithContext(context.Context, *iam.GetUserInput) (*iam.GetUserOutput, error)
+
 	GetUserPolicy(*iam.GetUserPolicyInput) (*iam.GetUserPolicyOutput, error)
 
+	GetUserPolicyWithContext(context.Context, *iam.GetUserPolicyInput) (*iam.GetUserPolicyOutput, error)
+
 	ListAccessKeys(*iam.ListAccessKeysInput) (*iam.ListAccessKeysOutput, error)
 
+	ListAccessKeysWithContext(context.Context, *iam.ListAccessKeysInput) (*iam.ListAccessKeysOutput, error)
+	ListAccessKeysPages(*iam.ListAccessKeysInput, func(*iam.ListAccessKeysOutput, bool) bool) error
+
 	ListAccountAliases(*iam.ListAccountAliasesInput) (*iam.ListAccountAliasesOutput, error)
 
+	ListAccountAliasesWithContext(context.Context, *iam.ListAccountAliasesInput) (*iam.ListAccountAliasesOutput, error)
+	ListAccountAliasesPages(*iam.ListAccountAliasesInput, func(*iam.ListAccountAliasesOutput, bool) bool) error
+
 	ListAttachedGroupPolicies(*iam.ListAttachedGroupPoliciesInput) (*iam.ListAttachedGroupPoliciesOutput, error)
 
+	ListAttachedGroupPoliciesWithContext(context.Context, *iam.ListAttachedGroupPoliciesInput) (*iam.ListAttachedGroupPoliciesOutput, error)
+
 	ListAttachedRolePolicies(*iam.ListAttachedRolePoliciesInput) (*iam.ListAttachedRolePoliciesOutput, error)
 
+	ListAttachedRolePoliciesWithContext(context.Context, *iam.ListAttachedRolePoliciesInput) (*iam.ListAttachedRolePoliciesOutput, error)
+
 	ListAttachedUserPolicies(*iam.ListAttachedUserPoliciesInput) (*iam.ListAttachedUserPoliciesOutput, error)
 
+	ListAttachedUserPoliciesWithContext(context.Context, *iam.ListAttachedUserPoliciesInput) (*iam.ListAttachedUserPoliciesOutput, error)
+
 	ListEntitiesForPolicy(*iam.ListEntitiesForPolicyInput) (*iam.ListEntitiesForPolicyOutput, error)
 
+	ListEntitiesForPolicyWithContext(context.Context, *iam.ListEntitiesForPolicyInput) (*iam.ListEntitiesForPolicyOutput, error)
+
 	ListGroupPolicies(*iam.ListGroupPoliciesInput) (*iam.ListGroupPoliciesOutput, error)
 
+	ListGroupPoliciesWithContext(context.Context, *iam.ListGroupPoliciesInput) (*iam.ListGroupPoliciesOutput, error)
+	ListGroupPoliciesPages(*iam.ListGroupPoliciesInput, func(*iam.ListGroupPoliciesOutput, bool) bool) error
+
 	ListGroups(*iam.ListGroupsInput) (*iam.ListGroupsOutput, error)
 
+	ListGroupsWithContext(context.Context, *iam.ListGroupsInput) (*iam.ListGroupsOutput, error)
+	ListGroupsPages(*iam.ListGroupsInput, func(*iam.ListGroupsOutput, bool) bool) error
+
 	ListGroupsForUser(*iam.ListGroupsForUserInput) (*iam.ListGroupsForUserOutput, error)
 
+	ListGroupsForUserWithContext(context.Context, *iam.ListGroupsForUserInput) (*iam.ListGroupsForUserOutput, error)
+	ListGroupsForUserPages(*iam.ListGroupsForUserInput, func(*iam.ListGroupsForUserOutput, bool) bool) error
+
 	ListInstanceProfiles(*iam.ListInstanceProfilesInput) (*iam.ListInstanceProfilesOutput, error)
 
+	ListInstanceProfilesWithContext(context.Context, *iam.ListInstanceProfilesInput) (*iam.ListInstanceProfilesOutput, error)
+	ListInstanceProfilesPages(*iam.ListInstanceProfilesInput, func(*iam.ListInstanceProfilesOutput, bool) bool) error
+
 	ListInstanceProfilesForRole(*iam.ListInstanceProfilesForRoleInput) (*iam.ListInstanceProfilesForRoleOutput, error)
 
+	ListInstanceProfilesForRoleWithContext(context.Context, *iam.ListInstanceProfilesForRoleInput) (*iam.ListInstanceProfilesForRoleOutput, error)
+	ListInstanceProfilesForRolePages(*iam.ListInstanceProfilesForRoleInput, func(*iam.ListInstanceProfilesForRoleOutput, bool) bool) error
+
 	ListMFADevices(*iam.ListMFADevicesInput) (*iam.ListMFADevicesOutput, error)
 
+	ListMFADevicesWithContext(context.Context, *iam.ListMFADevicesInput) (*iam.ListMFADevicesOutput, error)
+	ListMFADevicesPages(*iam.ListMFADevicesInput, func(*iam.ListMFADevicesOutput, bool) bool) error
+
 	ListOpenIDConnectProviders(*iam.ListOpenIDConnectProvidersInput) (*iam.ListOpenIDConnectProvidersOutput, error)
 
+	ListOpenIDConnectProvidersWithContext(context.Context, *iam.ListOpenIDConnectProvidersInput) (*iam.ListOpenIDConnectProvidersOutput, error)
+
 	ListPolicies(*iam.ListPoliciesInput) (*iam.ListPoliciesOutput, error)
 
+	ListPoliciesWithContext(context.Context, *iam.ListPoliciesInput) (*iam.ListPoliciesOutput, error)
+
 	ListPolicyVersions(*iam.ListPolicyVersionsInput) (*iam.ListPolicyVersionsOutput, error)
 
+	ListPolicyVersionsWithContext(context.Context, *iam.ListPolicyVersionsInput) (*iam.ListPolicyVersionsOutput, error)
+
 	ListRolePolicies(*iam.ListRolePoliciesInput) (*iam.ListRolePoliciesOutput, error)
 
+	ListRolePoliciesWithContext(context.Context, *iam.ListRolePoliciesInput) (*iam.ListRolePoliciesOutput, error)
+	ListRolePoliciesPages(*iam.ListRolePoliciesInput, func(*iam.ListRolePoliciesOutput, bool) bool) error
+
 	ListRoles(*iam.ListRolesInput) (*iam.ListRolesOutput, error)
 
+	ListRolesWithContext(context.Context, *iam.ListRolesInput) (*iam.ListRolesOutput, error)
+	ListRolesPages(*iam.ListRolesInput, func(*iam.ListRolesOutput, bool) bool) error
+
 	ListSAMLProviders(*iam.ListSAMLProvidersInput) (*iam.ListSAMLProvidersOutput, error)
 
+	ListSAMLProvidersWithContext(context.Context, *iam.ListSAMLProvidersInput) (*iam.ListSAMLProvidersOutput, error)
+
 	ListServerCertificates(*iam.ListServerCertificatesInput) (*iam.ListServerCertificatesOutput, error)
 
+	ListServerCertificatesWithContext(context.Context, *iam.ListServerCertificatesInput) (*iam.ListServerCertificatesOutput, error)
+	ListServerCertificatesPages(*iam.ListServerCertificatesInput, func(*iam.ListServerCertificatesOutput, bool) bool) error
+
 	ListSigningCertificates(*iam.ListSigningCertificatesInput) (*iam.ListSigningCertificatesOutput, error)
 
+	ListSigningCertificatesWithContext(context.Context, *iam.ListSigningCertificatesInput) (*iam.ListSigningCertificatesOutput, error)
+	ListSigningCertificatesPages(*iam.ListSigningCertificatesInput, func(*iam.ListSigningCertificatesOutput, bool) bool) error
+
 	ListUserPolicies(*iam.ListUserPoliciesInput) (*iam.ListUserPoliciesOutput, error)
 
+	ListUserPoliciesWithContext(context.Context, *iam.ListUserPoliciesInput) (*iam.ListUserPoliciesOutput, error)
+	ListUserPoliciesPages(*iam.ListUserPoliciesInput, func(*iam.ListUserPoliciesOutput, bool) bool) error
+
 	ListUsers(*iam.ListUsersInput) (*iam.ListUsersOutput, error)
 
+	ListUsersWithContext(context.Context, *iam.ListUsersInput) (*iam.ListUsersOutput, error)
+	ListUsersPages(*iam.ListUsersInput, func(*iam.ListUsersOutput, bool) bool) error
+
 	ListVirtualMFADevices(*iam.ListVirtualMFADevicesInput) (*iam.ListVirtualMFADevicesOutput, error)
 
+	ListVirtualMFADevicesWithContext(context.Context, *iam.ListVirtualMFADevicesInput) (*iam.ListVirtualMFADevicesOutput, error)
+	ListVirtualMFADevicesPages(*iam.ListVirtualMFADevicesInput, func(*iam.ListVirtualMFADevicesOutput, bool) bool) error
+
 	PutGroupPolicy(*iam.PutGroupPolicyInput) (*iam.PutGroupPolicyOutput, error)
 
+	PutGroupPolicyWithContext(context.Context, *iam.PutGroupPolicyInput) (*iam.PutGroupPolicyOutput, error)
+
 	PutRolePolicy(*iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error)
 
+	PutRolePolicyWithContext(context.Context, *iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error)
+
 	PutUserPolicy(*iam.PutUserPolicyInput) (*iam.PutUserPolicyOutput, error)
 
+	PutUserPolicyWithContext(context.Context, *iam.PutUserPolicyInput) (*iam.PutUserPolicyOutput, error)
+
 	RemoveClientIDFromOpenIDConnectProvider(*iam.RemoveClientIDFromOpenIDConnectProviderInput) (*iam.RemoveClientIDFromOpenIDConnectProviderOutput, error)
 
+	RemoveClientIDFromOpenIDConnectProviderWithContext(context.Context, *iam.RemoveClientIDFromOpenIDConnectProviderInput) (*iam.RemoveClientIDFromOpenIDConnectProviderOutput, error)
+
 	RemoveRoleFromInstanceProfile(*iam.RemoveRoleFromInstanceProfileInput) (*iam.RemoveRoleFromInstanceProfileOutput, error)
 
+	RemoveRoleFromInstanceProfileWithContext(context.Context, *iam.RemoveRoleFromInstanceProfileInput) (*iam.RemoveRoleFromInstanceProfileOutput, error)
+
 	RemoveUserFromGroup(*iam.RemoveUserFromGroupInput) (*iam.RemoveUserFromGroupOutput, error)
 
+	RemoveUserFromGroupWithContext(context.Context, *iam.RemoveUserFromGroupInput) (*iam.RemoveUserFromGroupOutput, error)
+
 	ResyncMFADevice(*iam.ResyncMFADeviceInput) (*iam.ResyncMFADeviceOutput, error)
 
+	ResyncMFADeviceWithContext(context.Context, *iam.ResyncMFADeviceInput) (*iam.ResyncMFADeviceOutput, error)
+
 	SetDefaultPolicyVersion(*iam.SetDefaultPolicyVersionInput) (*iam.SetDefaultPolicyVersionOutput, error)
 
+	SetDefaultPolicyVersionWithContext(context.Context, *iam.SetDefaultPolicyVersionInput) (*iam.SetDefaultPolicyVersionOutput, error)
+
 	UpdateAccessKey(*iam.UpdateAccessKeyInput) (*iam.UpdateAccessKeyOutput, error)
 
+	UpdateAccessKeyWithContext(context.Context, *iam.UpdateAccessKeyInput) (*iam.UpdateAccessKeyOutput, error)
+
 	UpdateAccountPasswordPolicy(*iam.UpdateAccountPasswordPolicyInput) (*iam.UpdateAccountPasswordPolicyOutput, error)
 
+	UpdateAccountPasswordPolicyWithContext(context.Context, *iam.UpdateAccountPasswordPolicyInput) (*iam.UpdateAccountPasswordPolicyOutput, error)
+
 	UpdateAssumeRolePolicy(*iam.UpdateAssumeRolePolicyInput) (*iam.UpdateAssumeRolePolicyOutput, error)
 
+	UpdateAssumeRolePolicyWithContext(context.Context, *iam.UpdateAssumeRolePolicyInput) (*iam.UpdateAssumeRolePolicyOutput, error)
+
 	UpdateGroup(*iam.UpdateGroupInput) (*iam.UpdateGroupOutput, error)
 
+	UpdateGroupWithContext(context.Context, *iam.UpdateGroupInput) (*iam.UpdateGroupOutput, error)
+
 	UpdateLoginProfile(*iam.UpdateLoginProfileInput) (*iam.UpdateLoginProfileOutput, error)
 
+	UpdateLoginProfileWithContext(context.Context, *iam.UpdateLoginProfileInput) (*iam.UpdateLoginProfileOutput, error)
+
 	UpdateOpenIDConnectProviderThumbprint(*iam.UpdateOpenIDConnectProviderThumbprintInput) (*iam.UpdateOpenIDConnectProviderThumbprintOutput, error)
 
+	UpdateOpenIDConnectProviderThumbprintWithContext(context.Context, *iam.UpdateOpenIDConnectProviderThumbprintInput) (*iam.UpdateOpenIDConnectProviderThumbprintOutput, error)
+
 	UpdateSAMLProvider(*iam.UpdateSAMLProviderInput) (*iam.UpdateSAMLProviderOutput, error)
 
+	UpdateSAMLProviderWithContext(context.Context, *iam.UpdateSAMLProviderInput) (*iam.UpdateSAMLProviderOutput, error)
+
 	UpdateServerCertificate(*iam.UpdateServerCertificateInput) (*iam.UpdateServerCertificateOutput, error)
 
+	UpdateServerCertificateWithContext(context.Context, *iam.UpdateServerCertificateInput) (*iam.UpdateServerCertificateOutput, error)
+
 	UpdateSigningCertificate(*iam.UpdateSigningCertificateInput) (*iam.UpdateSigningCertificateOutput, error)
 
+	UpdateSigningCertificateWithContext(context.Context, *iam.UpdateSigningCertificateInput) (*iam.UpdateSigningCertificateOutput, error)
+
 	UpdateUser(*iam.UpdateUserInput) (*iam.UpdateUserOutput, error)
 
+	UpdateUserWithContext(context.Context, *iam.UpdateUserInput) (*iam.UpdateUserOutput, error)
+
 	UploadServerCertificate(*iam.UploadServerCertificateInput) (*iam.UploadServerCertificateOutput, error)
 
+	UploadServerCertificateWithContext(context.Context, *iam.UploadServerCertificateInput) (*iam.UploadServerCertificateOutput, error)
+
 	UploadSigningCertificate(*iam.UploadSigningCertificateInput) (*iam.UploadSigningCertificateOutput, error)
+
+	UploadSigningCertificateWithContext(context.Context, *iam.UploadSigningCertificateInput) (*iam.UploadSigningCertificateOutput, error)
 }
\ No newline at end of file
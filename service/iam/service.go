@@ -27,6 +27,7 @@ func New(config *aws.Config) *IAM {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "iam",
 		APIVersion:  "2010-05-08",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package kinesis
 
 import (
+	"context"
 	"sync"
 
 	"github.com/datacratic/aws-sdk-go/aws"
@@ -46,6 +47,17 @@ func (c *Kinesis) AddTagsToStream(input *AddTagsToStreamInput) (output *AddTagsT
 	return
 }
 
+// AddTagsToStreamWithContext is the same as AddTagsToStream with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Kinesis) AddTagsToStreamWithContext(ctx context.Context, input *AddTagsToStreamInput) (output *AddTagsToStreamOutput, err error) {
+	req, out := c.AddTagsToStreamRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddTagsToStream *aws.Operation
 
 // CreateStreamRequest generates a request for the CreateStream operation.
@@ -115,6 +127,17 @@ func (c *Kinesis) CreateStream(input *CreateStreamInput) (output *CreateStreamOu
 	return
 }
 
+// CreateStreamWithContext is the same as CreateStream with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Kinesis) CreateStreamWithContext(ctx context.Context, input *CreateStreamInput) (output *CreateStreamOutput, err error) {
+	req, out := c.CreateStreamRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateStream *aws.Operation
 
 // DeleteStreamRequest generates a request for the DeleteStream operation.
@@ -166,6 +189,17 @@ func (c *Kinesis) DeleteStream(input *DeleteStreamInput) (output *DeleteStreamOu
 	return
 }
 
+// DeleteStreamWithContext is the same as DeleteStream with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Kinesis) DeleteStreamWithContext(ctx context.Context, input *DeleteStreamInput) (output *DeleteStreamOutput, err error) {
+	req, out := c.DeleteStreamRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteStream *aws.Operation
 
 // DescribeStreamRequest generates a request for the DescribeStream operation.
@@ -178,6 +212,12 @@ func (c *Kinesis) DescribeStreamRequest(input *DescribeStreamInput) (req *aws.Re
 			Name:       "DescribeStream",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"ExclusiveStartShardID"},
+				OutputTokens:    []string{"StreamDescription.Shards[-1].ShardID"},
+				LimitToken:      "Limit",
+				TruncationToken: "StreamDescription.HasMoreShards",
+			},
 		}
 	}
 
@@ -220,6 +260,27 @@ func (c *Kinesis) DescribeStream(input *DescribeStreamInput) (output *DescribeSt
 	return
 }
 
+// DescribeStreamWithContext is the same as DescribeStream with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Kinesis) DescribeStreamWithContext(ctx context.Context, input *DescribeStreamInput) (output *DescribeStreamOutput, err error) {
+	req, out := c.DescribeStreamRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeStreamPages iterates over the pages of a DescribeStream operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Kinesis) DescribeStreamPages(input *DescribeStreamInput, fn func(p *DescribeStreamOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeStreamRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeStreamOutput), lastPage)
+	})
+}
+
 var opDescribeStream *aws.Operation
 
 // GetRecordsRequest generates a request for the GetRecords operation.
@@ -297,6 +358,17 @@ func (c *Kinesis) GetRecords(input *GetRecordsInput) (output *GetRecordsOutput,
 	return
 }
 
+// GetRecordsWithContext is the same as GetRecords with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Kinesis) GetRecordsWithContext(ctx context.Context, input *GetRecordsInput) (output *GetRecordsOutput, err error) {
+	req, out := c.GetRecordsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetRecords *aws.Operation
 
 // GetShardIteratorRequest generates a request for the GetShardIterator operation.
@@ -366,6 +438,17 @@ func (c *Kinesis) GetShardIterator(input *GetShardIteratorInput) (output *GetSha
 	return
 }
 
+// GetShardIteratorWithContext is the same as GetShardIterator with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Kinesis) GetShardIteratorWithContext(ctx context.Context, input *GetShardIteratorInput) (output *GetShardIteratorOutput, err error) {
+	req, out := c.GetShardIteratorRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetShardIterator *aws.Operation
 
 // ListStreamsRequest generates a request for the ListStreams operation.
@@ -378,6 +461,12 @@ func (c *Kinesis) ListStreamsRequest(input *ListStreamsInput) (req *aws.Request,
 			Name:       "ListStreams",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"ExclusiveStartStreamName"},
+				OutputTokens:    []string{"StreamNames[-1]"},
+				LimitToken:      "Limit",
+				TruncationToken: "HasMoreStreams",
+			},
 		}
 	}
 
@@ -414,6 +503,27 @@ func (c *Kinesis) ListStreams(input *ListStreamsInput) (output *ListStreamsOutpu
 	return
 }
 
+// ListStreamsWithContext is the same as ListStreams with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Kinesis) ListStreamsWithContext(ctx context.Context, input *ListStreamsInput) (output *ListStreamsOutput, err error) {
+	req, out := c.ListStreamsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListStreamsPages iterates over the pages of a ListStreams operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Kinesis) ListStreamsPages(input *ListStreamsInput, fn func(p *ListStreamsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListStreamsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListStreamsOutput), lastPage)
+	})
+}
+
 var opListStreams *aws.Operation
 
 // ListTagsForStreamRequest generates a request for the ListTagsForStream operation.
@@ -447,6 +557,17 @@ func (c *Kinesis) ListTagsForStream(input *ListTagsForStreamInput) (output *List
 	return
 }
 
+// ListTagsForStreamWithContext is the same as ListTagsForStream with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Kinesis) ListTagsForStreamWithContext(ctx context.Context, input *ListTagsForStreamInput) (output *ListTagsForStreamOutput, err error) {
+	req, out := c.ListTagsForStreamRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListTagsForStream *aws.Operation
 
 // MergeShardsRequest generates a request for the MergeShards operation.
@@ -514,6 +635,17 @@ func (c *Kinesis) MergeShards(input *MergeShardsInput) (output *MergeShardsOutpu
 	return
 }
 
+// MergeShardsWithContext is the same as MergeShards with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Kinesis) MergeShardsWithContext(ctx context.Context, input *MergeShardsInput) (output *MergeShardsOutput, err error) {
+	req, out := c.MergeShardsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opMergeShards *aws.Operation
 
 // PutRecordRequest generates a request for the PutRecord operation.
@@ -584,6 +716,17 @@ func (c *Kinesis) PutRecord(input *PutRecordInput) (output *PutRecordOutput, err
 	return
 }
 
+// PutRecordWithContext is the same as PutRecord with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Kinesis) PutRecordWithContext(ctx context.Context, input *PutRecordInput) (output *PutRecordOutput, err error) {
+	req, out := c.PutRecordRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutRecord *aws.Operation
 
 // PutRecordsRequest generates a request for the PutRecords operation.
@@ -669,6 +812,17 @@ func (c *Kinesis) PutRecords(input *PutRecordsInput) (output *PutRecordsOutput,
 	return
 }
 
+// PutRecordsWithContext is the same as PutRecords with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Kinesis) PutRecordsWithContext(ctx context.Context, input *PutRecordsInput) (output *PutRecordsOutput, err error) {
+	req, out := c.PutRecordsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutRecords *aws.Operation
 
 // RemoveTagsFromStreamRequest generates a request for the RemoveTagsFromStream operation.
@@ -704,6 +858,17 @@ func (c *Kinesis) RemoveTagsFromStream(input *RemoveTagsFromStreamInput) (output
 	return
 }
 
+// RemoveTagsFromStreamWithContext is the same as RemoveTagsFromStream with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Kinesis) RemoveTagsFromStreamWithContext(ctx context.Context, input *RemoveTagsFromStreamInput) (output *RemoveTagsFromStreamOutput, err error) {
+	req, out := c.RemoveTagsFromStreamRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRemoveTagsFromStream *aws.Operation
 
 // SplitShardRequest generates a request for the SplitShard operation.
@@ -782,15 +947,26 @@ func (c *Kinesis) SplitShard(input *SplitShardInput) (output *SplitShardOutput,
 	return
 }
 
+// SplitShardWithContext is the same as SplitShard with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Kinesis) SplitShardWithContext(ctx context.Context, input *SplitShardInput) (output *SplitShardOutput, err error) {
+	req, out := c.SplitShardRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSplitShard *aws.Operation
 
 // Represents the input for AddTagsToStream.
 type AddTagsToStreamInput struct {
 	// The name of the stream.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The set of key-value pairs to use to create the tags.
-	Tags *map[string]*string `type:"map" required:"true"`
+	Tags *map[string]*string `type:"map" min:"1" max:"10" required:"true"`
 
 	metadataAddTagsToStreamInput `json:"-", xml:"-"`
 }
@@ -816,14 +992,14 @@ type CreateStreamInput struct {
 	// Note: The default limit for an AWS account is 10 shards per stream. If you
 	// need to create a stream with more than 10 shards, contact AWS Support (http://docs.aws.amazon.com/general/latest/gr/aws_service_limits.html)
 	// to increase the limit on your account.
-	ShardCount *int64 `type:"integer" required:"true"`
+	ShardCount *int64 `type:"integer" min:"1" required:"true"`
 
 	// A name to identify the stream. The stream name is scoped to the AWS account
 	// used by the application that creates the stream. It is also scoped by region.
 	// That is, two streams in two different AWS accounts can have the same name,
 	// and two streams in the same AWS account, but in two different regions, can
 	// have the same name.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataCreateStreamInput `json:"-", xml:"-"`
 }
@@ -843,7 +1019,7 @@ type metadataCreateStreamOutput struct {
 // Represents the input for DeleteStream.
 type DeleteStreamInput struct {
 	// The name of the stream to delete.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteStreamInput `json:"-", xml:"-"`
 }
@@ -863,13 +1039,13 @@ type metadataDeleteStreamOutput struct {
 // Represents the input for DescribeStream.
 type DescribeStreamInput struct {
 	// The shard ID of the shard to start with.
-	ExclusiveStartShardID *string `locationName:"ExclusiveStartShardId" type:"string"`
+	ExclusiveStartShardID *string `locationName:"ExclusiveStartShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The maximum number of shards to return.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"10000"`
 
 	// The name of the stream to describe.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDescribeStreamInput `json:"-", xml:"-"`
 }
@@ -895,12 +1071,12 @@ type metadataDescribeStreamOutput struct {
 type GetRecordsInput struct {
 	// The maximum number of records to return. Specify a value of up to 10,000.
 	// If you specify a value that is greater than 10,000, GetRecords throws InvalidArgumentException.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"10000"`
 
 	// The position in the shard from which you want to start sequentially reading
 	// data records. A shard iterator specifies this position using the sequence
 	// number of a data record in the shard.
-	ShardIterator *string `type:"string" required:"true"`
+	ShardIterator *string `type:"string" min:"1" max:"512" required:"true"`
 
 	metadataGetRecordsInput `json:"-", xml:"-"`
 }
@@ -914,7 +1090,7 @@ type GetRecordsOutput struct {
 	// The next position in the shard from which to start sequentially reading data
 	// records. If set to null, the shard has been closed and the requested iterator
 	// will not return any more data.
-	NextShardIterator *string `type:"string"`
+	NextShardIterator *string `type:"string" min:"1" max:"512"`
 
 	// The data records retrieved from the shard.
 	Records []*Record `type:"list" required:"true"`
@@ -929,7 +1105,7 @@ type metadataGetRecordsOutput struct {
 // Represents the input for GetShardIterator.
 type GetShardIteratorInput struct {
 	// The shard ID of the shard to get the iterator for.
-	ShardID *string `locationName:"ShardId" type:"string" required:"true"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// Determines how the shard iterator is used to start reading data records from
 	// the shard.
@@ -943,14 +1119,14 @@ type GetShardIteratorInput struct {
 	// the oldest data record in the shard. LATEST - Start reading just after the
 	// most recent record in the shard, so that you always read the most recent
 	// data in the shard.
-	ShardIteratorType *string `type:"string" required:"true"`
+	ShardIteratorType *string `type:"string" enum:"AT_SEQUENCE_NUMBER,AFTER_SEQUENCE_NUMBER,TRIM_HORIZON,LATEST" required:"true"`
 
 	// The sequence number of the data record in the shard from which to start reading
 	// from.
-	StartingSequenceNumber *string `type:"string"`
+	StartingSequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})"`
 
 	// The name of the stream.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataGetShardIteratorInput `json:"-", xml:"-"`
 }
@@ -964,7 +1140,7 @@ type GetShardIteratorOutput struct {
 	// The position in the shard from which to start reading data records sequentially.
 	// A shard iterator specifies this position using the sequence number of a data
 	// record in a shard.
-	ShardIterator *string `type:"string"`
+	ShardIterator *string `type:"string" min:"1" max:"512"`
 
 	metadataGetShardIteratorOutput `json:"-", xml:"-"`
 }
@@ -977,10 +1153,10 @@ type metadataGetShardIteratorOutput struct {
 // contiguous positive integers.
 type HashKeyRange struct {
 	// The ending hash key of the hash key range.
-	EndingHashKey *string `type:"string" required:"true"`
+	EndingHashKey *string `type:"string" pattern:"0|([1-9]\\d{0,38})" required:"true"`
 
 	// The starting hash key of the hash key range.
-	StartingHashKey *string `type:"string" required:"true"`
+	StartingHashKey *string `type:"string" pattern:"0|([1-9]\\d{0,38})" required:"true"`
 
 	metadataHashKeyRange `json:"-", xml:"-"`
 }
@@ -992,10 +1168,10 @@ type metadataHashKeyRange struct {
 // Represents the input for ListStreams.
 type ListStreamsInput struct {
 	// The name of the stream to start the list with.
-	ExclusiveStartStreamName *string `type:"string"`
+	ExclusiveStartStreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The maximum number of streams to list.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"10000"`
 
 	metadataListStreamsInput `json:"-", xml:"-"`
 }
@@ -1024,15 +1200,15 @@ type metadataListStreamsOutput struct {
 type ListTagsForStreamInput struct {
 	// The key to use as the starting point for the list of tags. If this parameter
 	// is set, ListTagsForStream gets all tags that occur after ExclusiveStartTagKey.
-	ExclusiveStartTagKey *string `type:"string"`
+	ExclusiveStartTagKey *string `type:"string" min:"1" max:"128"`
 
 	// The number of tags to return. If this number is less than the total number
 	// of tags associated with the stream, HasMoreTags is set to true. To list additional
 	// tags, set ExclusiveStartTagKey to the last key in the response.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"10"`
 
 	// The name of the stream.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataListTagsForStreamInput `json:"-", xml:"-"`
 }
@@ -1049,7 +1225,7 @@ type ListTagsForStreamOutput struct {
 
 	// A list of tags associated with StreamName, starting with the first tag after
 	// ExclusiveStartTagKey and up to the specified Limit.
-	Tags []*Tag `type:"list" required:"true"`
+	Tags []*Tag `type:"list" min:"0" required:"true"`
 
 	metadataListTagsForStreamOutput `json:"-", xml:"-"`
 }
@@ -1061,13 +1237,13 @@ type metadataListTagsForStreamOutput struct {
 // Represents the input for MergeShards.
 type MergeShardsInput struct {
 	// The shard ID of the adjacent shard for the merge.
-	AdjacentShardToMerge *string `type:"string" required:"true"`
+	AdjacentShardToMerge *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The shard ID of the shard to combine with the adjacent shard for the merge.
-	ShardToMerge *string `type:"string" required:"true"`
+	ShardToMerge *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The name of the stream for the merge.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataMergeShardsInput `json:"-", xml:"-"`
 }
@@ -1089,11 +1265,11 @@ type PutRecordInput struct {
 	// The data blob to put into the record, which is base64-encoded when the blob
 	// is serialized. The maximum size of the data blob (the payload before base64-encoding)
 	// is 50 kilobytes (KB)
-	Data []byte `type:"blob" required:"true"`
+	Data []byte `type:"blob" min:"0" max:"51200" required:"true"`
 
 	// The hash value used to explicitly determine the shard the data record is
 	// assigned to by overriding the partition key hash.
-	ExplicitHashKey *string `type:"string"`
+	ExplicitHashKey *string `type:"string" pattern:"0|([1-9]\\d{0,38})"`
 
 	// Determines which shard in the stream the data record is assigned to. Partition
 	// keys are Unicode strings with a maximum length limit of 256 bytes. Amazon
@@ -1103,17 +1279,17 @@ type PutRecordInput struct {
 	// to map associated data records to shards. As a result of this hashing mechanism,
 	// all data records with the same partition key will map to the same shard within
 	// the stream.
-	PartitionKey *string `type:"string" required:"true"`
+	PartitionKey *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// Guarantees strictly increasing sequence numbers, for puts from the same client
 	// and to the same partition key. Usage: set the SequenceNumberForOrdering of
 	// record n to the sequence number of record n-1 (as returned in the PutRecordResult
 	// when putting record n-1). If this parameter is not set, records will be coarsely
 	// ordered based on arrival time.
-	SequenceNumberForOrdering *string `type:"string"`
+	SequenceNumberForOrdering *string `type:"string" pattern:"0|([1-9]\\d{0,128})"`
 
 	// The name of the stream to put the data record into.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataPutRecordInput `json:"-", xml:"-"`
 }
@@ -1128,10 +1304,10 @@ type PutRecordOutput struct {
 	// The sequence number for the record is unique across all records in the stream.
 	// A sequence number is the identifier associated with every record put into
 	// the stream.
-	SequenceNumber *string `type:"string" required:"true"`
+	SequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})" required:"true"`
 
 	// The shard ID of the shard where the data record was placed.
-	ShardID *string `locationName:"ShardId" type:"string" required:"true"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataPutRecordOutput `json:"-", xml:"-"`
 }
@@ -1143,10 +1319,10 @@ type metadataPutRecordOutput struct {
 // A PutRecords request.
 type PutRecordsInput struct {
 	// The records associated with the request.
-	Records []*PutRecordsRequestEntry `type:"list" required:"true"`
+	Records []*PutRecordsRequestEntry `type:"list" min:"1" max:"500" required:"true"`
 
 	// The stream name associated with the request.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataPutRecordsInput `json:"-", xml:"-"`
 }
@@ -1158,14 +1334,14 @@ type metadataPutRecordsInput struct {
 // PutRecords results.
 type PutRecordsOutput struct {
 	// The number of unsuccessfully processed records in a PutRecords request.
-	FailedRecordCount *int64 `type:"integer"`
+	FailedRecordCount *int64 `type:"integer" min:"1"`
 
 	// An array of successfully and unsuccessfully processed record results, correlated
 	// with the request by natural ordering. A record that is successfully added
 	// to your Amazon Kinesis stream includes SequenceNumber and ShardId in the
 	// result. A record that fails to be added to your Amazon Kinesis stream includes
 	// ErrorCode and ErrorMessage in the result.
-	Records []*PutRecordsResultEntry `type:"list" required:"true"`
+	Records []*PutRecordsResultEntry `type:"list" min:"1" max:"500" required:"true"`
 
 	metadataPutRecordsOutput `json:"-", xml:"-"`
 }
@@ -1179,11 +1355,11 @@ type PutRecordsRequestEntry struct {
 	// The data blob to put into the record, which is base64-encoded when the blob
 	// is serialized. The maximum size of the data blob (the payload before base64-encoding)
 	// is 50 kilobytes (KB)
-	Data []byte `type:"blob" required:"true"`
+	Data []byte `type:"blob" min:"0" max:"51200" required:"true"`
 
 	// The hash value used to determine explicitly the shard that the data record
 	// is assigned to by overriding the partition key hash.
-	ExplicitHashKey *string `type:"string"`
+	ExplicitHashKey *string `type:"string" pattern:"0|([1-9]\\d{0,38})"`
 
 	// Determines which shard in the stream the data record is assigned to. Partition
 	// keys are Unicode strings with a maximum length limit of 256 bytes. Amazon
@@ -1193,7 +1369,7 @@ type PutRecordsRequestEntry struct {
 	// to map associated data records to shards. As a result of this hashing mechanism,
 	// all data records with the same partition key map to the same shard within
 	// the stream.
-	PartitionKey *string `type:"string" required:"true"`
+	PartitionKey *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataPutRecordsRequestEntry `json:"-", xml:"-"`
 }
@@ -1219,10 +1395,10 @@ type PutRecordsResultEntry struct {
 	ErrorMessage *string `type:"string"`
 
 	// The sequence number for an individual record result.
-	SequenceNumber *string `type:"string"`
+	SequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})"`
 
 	// The shard ID for an individual record result.
-	ShardID *string `locationName:"ShardId" type:"string"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataPutRecordsResultEntry `json:"-", xml:"-"`
 }
@@ -1238,13 +1414,13 @@ type Record struct {
 	// Kinesis service, which does not inspect, interpret, or change the data in
 	// the blob in any way. The maximum size of the data blob (the payload before
 	// base64-encoding) is 50 kilobytes (KB)
-	Data []byte `type:"blob" required:"true"`
+	Data []byte `type:"blob" min:"0" max:"51200" required:"true"`
 
 	// Identifies which shard in the stream the data record is assigned to.
-	PartitionKey *string `type:"string" required:"true"`
+	PartitionKey *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// The unique identifier for the record in the Amazon Kinesis stream.
-	SequenceNumber *string `type:"string" required:"true"`
+	SequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})" required:"true"`
 
 	metadataRecord `json:"-", xml:"-"`
 }
@@ -1256,10 +1432,10 @@ type metadataRecord struct {
 // Represents the input for RemoveTagsFromStream.
 type RemoveTagsFromStreamInput struct {
 	// The name of the stream.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// A list of tag keys. Each corresponding tag is removed from the stream.
-	TagKeys []*string `type:"list" required:"true"`
+	TagKeys []*string `type:"list" min:"1" max:"10" required:"true"`
 
 	metadataRemoveTagsFromStreamInput `json:"-", xml:"-"`
 }
@@ -1280,10 +1456,10 @@ type metadataRemoveTagsFromStreamOutput struct {
 type SequenceNumberRange struct {
 	// The ending sequence number for the range. Shards that are in the OPEN state
 	// have an ending sequence number of null.
-	EndingSequenceNumber *string `type:"string"`
+	EndingSequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})"`
 
 	// The starting sequence number for the range.
-	StartingSequenceNumber *string `type:"string" required:"true"`
+	StartingSequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})" required:"true"`
 
 	metadataSequenceNumberRange `json:"-", xml:"-"`
 }
@@ -1295,20 +1471,20 @@ type metadataSequenceNumberRange struct {
 // A uniquely identified group of data records in an Amazon Kinesis stream.
 type Shard struct {
 	// The shard Id of the shard adjacent to the shard's parent.
-	AdjacentParentShardID *string `locationName:"AdjacentParentShardId" type:"string"`
+	AdjacentParentShardID *string `locationName:"AdjacentParentShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The range of possible hash key values for the shard, which is a set of ordered
 	// contiguous positive integers.
 	HashKeyRange *HashKeyRange `type:"structure" required:"true"`
 
 	// The shard Id of the shard's parent.
-	ParentShardID *string `locationName:"ParentShardId" type:"string"`
+	ParentShardID *string `locationName:"ParentShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The range of possible sequence numbers for the shard.
 	SequenceNumberRange *SequenceNumberRange `type:"structure" required:"true"`
 
 	// The unique identifier of the shard within the Amazon Kinesis stream.
-	ShardID *string `locationName:"ShardId" type:"string" required:"true"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataShard `json:"-", xml:"-"`
 }
@@ -1326,13 +1502,13 @@ type SplitShardInput struct {
 	// hash key value and all higher hash key values in hash key range are distributed
 	// to one of the child shards. All the lower hash key values in the range are
 	// distributed to the other child shard.
-	NewStartingHashKey *string `type:"string" required:"true"`
+	NewStartingHashKey *string `type:"string" pattern:"0|([1-9]\\d{0,38})" required:"true"`
 
 	// The shard ID of the shard to split.
-	ShardToSplit *string `type:"string" required:"true"`
+	ShardToSplit *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The name of the stream for the shard split.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataSplitShardInput `json:"-", xml:"-"`
 }
@@ -1361,7 +1537,7 @@ type StreamDescription struct {
 	StreamARN *string `type:"string" required:"true"`
 
 	// The name of the stream being described.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The current status of the stream being described.
 	//
@@ -1375,7 +1551,7 @@ type StreamDescription struct {
 	// on an ACTIVE stream.  UPDATING - Shards in the stream are being merged or
 	// split. Read and write operations continue to work while the stream is in
 	// the UPDATING state.
-	StreamStatus *string `type:"string" required:"true"`
+	StreamStatus *string `type:"string" enum:"CREATING,DELETING,ACTIVE,UPDATING" required:"true"`
 
 	metadataStreamDescription `json:"-", xml:"-"`
 }
@@ -1388,16 +1564,52 @@ type metadataStreamDescription struct {
 type Tag struct {
 	// A unique identifier for the tag. Maximum length: 128 characters. Valid characters:
 	// Unicode letters, digits, white space, _ . / = + - % @
-	Key *string `type:"string" required:"true"`
+	Key *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// An optional string, typically used to describe or define the tag. Maximum
 	// length: 256 characters. Valid characters: Unicode letters, digits, white
 	// space, _ . / = + - % @
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"0" max:"256"`
 
 	metadataTag `json:"-", xml:"-"`
 }
 
 type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for ShardIteratorType.
+const (
+	ShardIteratorTypeATSequenceNumber    = "AT_SEQUENCE_NUMBER"
+	ShardIteratorTypeAfterSequenceNumber = "AFTER_SEQUENCE_NUMBER"
+	ShardIteratorTypeTrimHorizon         = "TRIM_HORIZON"
+	ShardIteratorTypeLatest              = "LATEST"
+)
+
+// ShardIteratorTypeValues returns the valid values of ShardIteratorType.
+func ShardIteratorTypeValues() []string {
+	return []string{
+		ShardIteratorTypeATSequenceNumber,
+		ShardIteratorTypeAfterSequenceNumber,
+		ShardIteratorTypeTrimHorizon,
+		ShardIteratorTypeLatest,
+	}
+}
+
+// Enum values for StreamStatus.
+const (
+	StreamStatusCreating = "CREATING"
+	StreamStatusDeleting = "DELETING"
+	StreamStatusActive   = "ACTIVE"
+	StreamStatusUpdating = "UPDATING"
+)
+
+// StreamStatusValues returns the valid values of StreamStatus.
+func StreamStatusValues() []string {
+	return []string{
+		StreamStatusCreating,
+		StreamStatusDeleting,
+		StreamStatusActive,
+		StreamStatusUpdating,
+	}
 }
\ No newline at end of file
@@ -1,33 +1,65 @@
 package kinesisiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/kinesis"
 )
 
 type KinesisAPI interface {
 	AddTagsToStream(*kinesis.AddTagsToStreamInput) (*kinesis.AddTagsToStreamOutput, error)
 
+	AddTagsToStreamWithContext(context.Context, *kinesis.AddTagsToStreamInput) (*kinesis.AddTagsToStreamOutput, error)
+
 	CreateStream(*kinesis.CreateStreamInput) (*kinesis.CreateStreamOutput, error)
 
+	CreateStreamWithContext(context.Context, *kinesis.CreateStreamInput) (*kinesis.CreateStreamOutput, error)
+
 	DeleteStream(*kinesis.DeleteStreamInput) (*kinesis.DeleteStreamOutput, error)
 
+	DeleteStreamWithContext(context.Context, *kinesis.DeleteStreamInput) (*kinesis.DeleteStreamOutput, error)
+
 	DescribeStream(*kinesis.DescribeStreamInput) (*kinesis.DescribeStreamOutput, error)
 
+	DescribeStreamWithContext(context.Context, *kinesis.DescribeStreamInput) (*kinesis.DescribeStreamOutput, error)
+	DescribeStreamPages(*kinesis.DescribeStreamInput, func(*kinesis.DescribeStreamOutput, bool) bool) error
+
 	GetRecords(*kinesis.GetRecordsInput) (*kinesis.GetRecordsOutput, error)
 
+	GetRecordsWithContext(context.Context, *kinesis.GetRecordsInput) (*kinesis.GetRecordsOutput, error)
+
 	GetShardIterator(*kinesis.GetShardIteratorInput) (*kinesis.GetShardIteratorOutput, error)
 
+	GetShardIteratorWithContext(context.Context, *kinesis.GetShardIteratorInput) (*kinesis.GetShardIteratorOutput, error)
+
 	ListStreams(*kinesis.ListStreamsInput) (*kinesis.ListStreamsOutput, error)
 
+	ListStreamsWithContext(context.Context, *kinesis.ListStreamsInput) (*kinesis.ListStreamsOutput, error)
+	ListStreamsPages(*kinesis.ListStreamsInput, func(*kinesis.ListStreamsOutput, bool) bool) error
+
 	ListTagsForStream(*kinesis.ListTagsForStreamInput) (*kinesis.ListTagsForStreamOutput, error)
 
+	ListTagsForStreamWithContext(context.Context, *kinesis.ListTagsForStreamInput) (*kinesis.ListTagsForStreamOutput, error)
+
 	MergeShards(*kinesis.MergeShardsInput) (*kinesis.MergeShardsOutput, error)
 
+	MergeShardsWithContext(context.Context, *kinesis.MergeShardsInput) (*kinesis.MergeShardsOutput, error)
+
 	PutRecord(*kinesis.PutRecordInput) (*kinesis.PutRecordOutput, error)
 
+	PutRecordWithContext(context.Context, *kinesis.PutRecordInput) (*kinesis.PutRecordOutput, error)
+
 	PutRecords(*kinesis.PutRecordsInput) (*kinesis.PutRecordsOutput, error)
 
+	PutRecordsWithContext(context.Context, *kinesis.PutRecordsInput) (*kinesis.PutRecordsOutput, error)
+
 	RemoveTagsFromStream(*kinesis.RemoveTagsFromStreamInput) (*kinesis.RemoveTagsFromStreamOutput, error)
 
+	RemoveTagsFromStreamWithContext(context.Context, *kinesis.RemoveTagsFromStreamInput) (*kinesis.RemoveTagsFromStreamOutput, error)
+
 	SplitShard(*kinesis.SplitShardInput) (*kinesis.SplitShardOutput, error)
+
+	SplitShardWithContext(context.Context, *kinesis.SplitShardInput) (*kinesis.SplitShardOutput, error)
+
+	WaitUntilStreamExists(*kinesis.DescribeStreamInput) error
 }
\ No newline at end of file
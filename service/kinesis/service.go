@@ -29,6 +29,7 @@ func New(config *aws.Config) *Kinesis {
 		APIVersion:   "2013-12-02",
 		JSONVersion:  "1.1",
 		TargetPrefix: "Kinesis_20131202",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package kms
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -49,6 +50,17 @@ func (c *KMS) CreateAlias(input *CreateAliasInput) (output *CreateAliasOutput, e
 	return
 }
 
+// CreateAliasWithContext is the same as CreateAlias with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) CreateAliasWithContext(ctx context.Context, input *CreateAliasInput) (output *CreateAliasOutput, err error) {
+	req, out := c.CreateAliasRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateAlias *aws.Operation
 
 // CreateGrantRequest generates a request for the CreateGrant operation.
@@ -86,6 +98,17 @@ func (c *KMS) CreateGrant(input *CreateGrantInput) (output *CreateGrantOutput, e
 	return
 }
 
+// CreateGrantWithContext is the same as CreateGrant with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) CreateGrantWithContext(ctx context.Context, input *CreateGrantInput) (output *CreateGrantOutput, err error) {
+	req, out := c.CreateGrantRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateGrant *aws.Operation
 
 // CreateKeyRequest generates a request for the CreateKey operation.
@@ -122,6 +145,17 @@ func (c *KMS) CreateKey(input *CreateKeyInput) (output *CreateKeyOutput, err err
 	return
 }
 
+// CreateKeyWithContext is the same as CreateKey with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) CreateKeyWithContext(ctx context.Context, input *CreateKeyInput) (output *CreateKeyOutput, err error) {
+	req, out := c.CreateKeyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateKey *aws.Operation
 
 // DecryptRequest generates a request for the Decrypt operation.
@@ -166,6 +200,17 @@ func (c *KMS) Decrypt(input *DecryptInput) (output *DecryptOutput, err error) {
 	return
 }
 
+// DecryptWithContext is the same as Decrypt with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) DecryptWithContext(ctx context.Context, input *DecryptInput) (output *DecryptOutput, err error) {
+	req, out := c.DecryptRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDecrypt *aws.Operation
 
 // DeleteAliasRequest generates a request for the DeleteAlias operation.
@@ -199,6 +244,17 @@ func (c *KMS) DeleteAlias(input *DeleteAliasInput) (output *DeleteAliasOutput, e
 	return
 }
 
+// DeleteAliasWithContext is the same as DeleteAlias with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) DeleteAliasWithContext(ctx context.Context, input *DeleteAliasInput) (output *DeleteAliasOutput, err error) {
+	req, out := c.DeleteAliasRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteAlias *aws.Operation
 
 // DescribeKeyRequest generates a request for the DescribeKey operation.
@@ -232,6 +288,17 @@ func (c *KMS) DescribeKey(input *DescribeKeyInput) (output *DescribeKeyOutput, e
 	return
 }
 
+// DescribeKeyWithContext is the same as DescribeKey with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) DescribeKeyWithContext(ctx context.Context, input *DescribeKeyInput) (output *DescribeKeyOutput, err error) {
+	req, out := c.DescribeKeyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeKey *aws.Operation
 
 // DisableKeyRequest generates a request for the DisableKey operation.
@@ -265,6 +332,17 @@ func (c *KMS) DisableKey(input *DisableKeyInput) (output *DisableKeyOutput, err
 	return
 }
 
+// DisableKeyWithContext is the same as DisableKey with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) DisableKeyWithContext(ctx context.Context, input *DisableKeyInput) (output *DisableKeyOutput, err error) {
+	req, out := c.DisableKeyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDisableKey *aws.Operation
 
 // DisableKeyRotationRequest generates a request for the DisableKeyRotation operation.
@@ -298,6 +376,17 @@ func (c *KMS) DisableKeyRotation(input *DisableKeyRotationInput) (output *Disabl
 	return
 }
 
+// DisableKeyRotationWithContext is the same as DisableKeyRotation with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) DisableKeyRotationWithContext(ctx context.Context, input *DisableKeyRotationInput) (output *DisableKeyRotationOutput, err error) {
+	req, out := c.DisableKeyRotationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDisableKeyRotation *aws.Operation
 
 // EnableKeyRequest generates a request for the EnableKey operation.
@@ -332,6 +421,17 @@ func (c *KMS) EnableKey(input *EnableKeyInput) (output *EnableKeyOutput, err err
 	return
 }
 
+// EnableKeyWithContext is the same as EnableKey with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) EnableKeyWithContext(ctx context.Context, input *EnableKeyInput) (output *EnableKeyOutput, err error) {
+	req, out := c.EnableKeyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEnableKey *aws.Operation
 
 // EnableKeyRotationRequest generates a request for the EnableKeyRotation operation.
@@ -365,6 +465,17 @@ func (c *KMS) EnableKeyRotation(input *EnableKeyRotationInput) (output *EnableKe
 	return
 }
 
+// EnableKeyRotationWithContext is the same as EnableKeyRotation with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) EnableKeyRotationWithContext(ctx context.Context, input *EnableKeyRotationInput) (output *EnableKeyRotationOutput, err error) {
+	req, out := c.EnableKeyRotationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEnableKeyRotation *aws.Operation
 
 // EncryptRequest generates a request for the Encrypt operation.
@@ -414,6 +525,17 @@ func (c *KMS) Encrypt(input *EncryptInput) (output *EncryptOutput, err error) {
 	return
 }
 
+// EncryptWithContext is the same as Encrypt with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) EncryptWithContext(ctx context.Context, input *EncryptInput) (output *EncryptOutput, err error) {
+	req, out := c.EncryptRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEncrypt *aws.Operation
 
 // GenerateDataKeyRequest generates a request for the GenerateDataKey operation.
@@ -476,6 +598,17 @@ func (c *KMS) GenerateDataKey(input *GenerateDataKeyInput) (output *GenerateData
 	return
 }
 
+// GenerateDataKeyWithContext is the same as GenerateDataKey with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) GenerateDataKeyWithContext(ctx context.Context, input *GenerateDataKeyInput) (output *GenerateDataKeyOutput, err error) {
+	req, out := c.GenerateDataKeyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGenerateDataKey *aws.Operation
 
 // GenerateDataKeyWithoutPlaintextRequest generates a request for the GenerateDataKeyWithoutPlaintext operation.
@@ -513,6 +646,17 @@ func (c *KMS) GenerateDataKeyWithoutPlaintext(input *GenerateDataKeyWithoutPlain
 	return
 }
 
+// GenerateDataKeyWithoutPlaintextWithContext is the same as GenerateDataKeyWithoutPlaintext with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) GenerateDataKeyWithoutPlaintextWithContext(ctx context.Context, input *GenerateDataKeyWithoutPlaintextInput) (output *GenerateDataKeyWithoutPlaintextOutput, err error) {
+	req, out := c.GenerateDataKeyWithoutPlaintextRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGenerateDataKeyWithoutPlaintext *aws.Operation
 
 // GenerateRandomRequest generates a request for the GenerateRandom operation.
@@ -546,6 +690,17 @@ func (c *KMS) GenerateRandom(input *GenerateRandomInput) (output *GenerateRandom
 	return
 }
 
+// GenerateRandomWithContext is the same as GenerateRandom with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) GenerateRandomWithContext(ctx context.Context, input *GenerateRandomInput) (output *GenerateRandomOutput, err error) {
+	req, out := c.GenerateRandomRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGenerateRandom *aws.Operation
 
 // GetKeyPolicyRequest generates a request for the GetKeyPolicy operation.
@@ -579,6 +734,17 @@ func (c *KMS) GetKeyPolicy(input *GetKeyPolicyInput) (output *GetKeyPolicyOutput
 	return
 }
 
+// GetKeyPolicyWithContext is the same as GetKeyPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) GetKeyPolicyWithContext(ctx context.Context, input *GetKeyPolicyInput) (output *GetKeyPolicyOutput, err error) {
+	req, out := c.GetKeyPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetKeyPolicy *aws.Operation
 
 // GetKeyRotationStatusRequest generates a request for the GetKeyRotationStatus operation.
@@ -613,6 +779,17 @@ func (c *KMS) GetKeyRotationStatus(input *GetKeyRotationStatusInput) (output *Ge
 	return
 }
 
+// GetKeyRotationStatusWithContext is the same as GetKeyRotationStatus with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) GetKeyRotationStatusWithContext(ctx context.Context, input *GetKeyRotationStatusInput) (output *GetKeyRotationStatusOutput, err error) {
+	req, out := c.GetKeyRotationStatusRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetKeyRotationStatus *aws.Operation
 
 // ListAliasesRequest generates a request for the ListAliases operation.
@@ -646,6 +823,17 @@ func (c *KMS) ListAliases(input *ListAliasesInput) (output *ListAliasesOutput, e
 	return
 }
 
+// ListAliasesWithContext is the same as ListAliases with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) ListAliasesWithContext(ctx context.Context, input *ListAliasesInput) (output *ListAliasesOutput, err error) {
+	req, out := c.ListAliasesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListAliases *aws.Operation
 
 // ListGrantsRequest generates a request for the ListGrants operation.
@@ -679,6 +867,17 @@ func (c *KMS) ListGrants(input *ListGrantsInput) (output *ListGrantsOutput, err
 	return
 }
 
+// ListGrantsWithContext is the same as ListGrants with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) ListGrantsWithContext(ctx context.Context, input *ListGrantsInput) (output *ListGrantsOutput, err error) {
+	req, out := c.ListGrantsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListGrants *aws.Operation
 
 // ListKeyPoliciesRequest generates a request for the ListKeyPolicies operation.
@@ -712,6 +911,17 @@ func (c *KMS) ListKeyPolicies(input *ListKeyPoliciesInput) (output *ListKeyPolic
 	return
 }
 
+// ListKeyPoliciesWithContext is the same as ListKeyPolicies with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) ListKeyPoliciesWithContext(ctx context.Context, input *ListKeyPoliciesInput) (output *ListKeyPoliciesOutput, err error) {
+	req, out := c.ListKeyPoliciesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListKeyPolicies *aws.Operation
 
 // ListKeysRequest generates a request for the ListKeys operation.
@@ -745,6 +955,17 @@ func (c *KMS) ListKeys(input *ListKeysInput) (output *ListKeysOutput, err error)
 	return
 }
 
+// ListKeysWithContext is the same as ListKeys with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) ListKeysWithContext(ctx context.Context, input *ListKeysInput) (output *ListKeysOutput, err error) {
+	req, out := c.ListKeysRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListKeys *aws.Operation
 
 // PutKeyPolicyRequest generates a request for the PutKeyPolicy operation.
@@ -778,6 +999,17 @@ func (c *KMS) PutKeyPolicy(input *PutKeyPolicyInput) (output *PutKeyPolicyOutput
 	return
 }
 
+// PutKeyPolicyWithContext is the same as PutKeyPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) PutKeyPolicyWithContext(ctx context.Context, input *PutKeyPolicyInput) (output *PutKeyPolicyOutput, err error) {
+	req, out := c.PutKeyPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutKeyPolicy *aws.Operation
 
 // ReEncryptRequest generates a request for the ReEncrypt operation.
@@ -814,6 +1046,17 @@ func (c *KMS) ReEncrypt(input *ReEncryptInput) (output *ReEncryptOutput, err err
 	return
 }
 
+// ReEncryptWithContext is the same as ReEncrypt with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) ReEncryptWithContext(ctx context.Context, input *ReEncryptInput) (output *ReEncryptOutput, err error) {
+	req, out := c.ReEncryptRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opReEncrypt *aws.Operation
 
 // RetireGrantRequest generates a request for the RetireGrant operation.
@@ -851,6 +1094,17 @@ func (c *KMS) RetireGrant(input *RetireGrantInput) (output *RetireGrantOutput, e
 	return
 }
 
+// RetireGrantWithContext is the same as RetireGrant with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) RetireGrantWithContext(ctx context.Context, input *RetireGrantInput) (output *RetireGrantOutput, err error) {
+	req, out := c.RetireGrantRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRetireGrant *aws.Operation
 
 // RevokeGrantRequest generates a request for the RevokeGrant operation.
@@ -885,6 +1139,17 @@ func (c *KMS) RevokeGrant(input *RevokeGrantInput) (output *RevokeGrantOutput, e
 	return
 }
 
+// RevokeGrantWithContext is the same as RevokeGrant with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) RevokeGrantWithContext(ctx context.Context, input *RevokeGrantInput) (output *RevokeGrantOutput, err error) {
+	req, out := c.RevokeGrantRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRevokeGrant *aws.Operation
 
 // UpdateKeyDescriptionRequest generates a request for the UpdateKeyDescription operation.
@@ -918,18 +1183,29 @@ func (c *KMS) UpdateKeyDescription(input *UpdateKeyDescriptionInput) (output *Up
 	return
 }
 
+// UpdateKeyDescriptionWithContext is the same as UpdateKeyDescription with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *KMS) UpdateKeyDescriptionWithContext(ctx context.Context, input *UpdateKeyDescriptionInput) (output *UpdateKeyDescriptionOutput, err error) {
+	req, out := c.UpdateKeyDescriptionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateKeyDescription *aws.Operation
 
 // Contains information about an alias.
 type AliasListEntry struct {
 	// String that contains the key ARN.
-	AliasARN *string `locationName:"AliasArn" type:"string"`
+	AliasARN *string `locationName:"AliasArn" type:"string" min:"20" max:"2048"`
 
 	// String that contains the alias.
-	AliasName *string `type:"string"`
+	AliasName *string `type:"string" min:"1" max:"256" pattern:"^[a-zA-Z0-9:/_-]+$"`
 
 	// String that contains the key identifier pointed to by the alias.
-	TargetKeyID *string `locationName:"TargetKeyId" type:"string"`
+	TargetKeyID *string `locationName:"TargetKeyId" type:"string" min:"1" max:"256"`
 
 	metadataAliasListEntry `json:"-", xml:"-"`
 }
@@ -940,13 +1216,13 @@ type metadataAliasListEntry struct {
 
 type CreateAliasInput struct {
 	// String that contains the display name. Aliases that begin with AWS are reserved.
-	AliasName *string `type:"string" required:"true"`
+	AliasName *string `type:"string" min:"1" max:"256" pattern:"^[a-zA-Z0-9:/_-]+$" required:"true"`
 
 	// An identifier of the key for which you are creating the alias. This value
 	// cannot be another alias but can be a globally unique identifier or a fully
 	// specified ARN to a key.  Key ARN Example - arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	TargetKeyID *string `locationName:"TargetKeyId" type:"string" required:"true"`
+	TargetKeyID *string `locationName:"TargetKeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataCreateAliasInput `json:"-", xml:"-"`
 }
@@ -969,17 +1245,17 @@ type CreateGrantInput struct {
 	Constraints *GrantConstraints `type:"structure"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	// Principal given permission by the grant to use the key identified by the
 	// keyId parameter.
-	GranteePrincipal *string `type:"string" required:"true"`
+	GranteePrincipal *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// List of operations permitted by the grant. This can be any combination of
 	// one or more of the following values:  Decrypt Encrypt GenerateDataKey GenerateDataKeyWithoutPlaintext
@@ -988,7 +1264,7 @@ type CreateGrantInput struct {
 
 	// Principal given permission to retire the grant. For more information, see
 	// RetireGrant.
-	RetiringPrincipal *string `type:"string"`
+	RetiringPrincipal *string `type:"string" min:"1" max:"256"`
 
 	metadataCreateGrantInput `json:"-", xml:"-"`
 }
@@ -999,10 +1275,10 @@ type metadataCreateGrantInput struct {
 
 type CreateGrantOutput struct {
 	// Unique grant identifier. You can use the GrantId value to revoke a grant.
-	GrantID *string `locationName:"GrantId" type:"string"`
+	GrantID *string `locationName:"GrantId" type:"string" min:"1" max:"128"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantToken *string `type:"string"`
+	GrantToken *string `type:"string" min:"1" max:"8192"`
 
 	metadataCreateGrantOutput `json:"-", xml:"-"`
 }
@@ -1014,15 +1290,15 @@ type metadataCreateGrantOutput struct {
 type CreateKeyInput struct {
 	// Description of the key. We recommend that you choose a description that helps
 	// your customer decide whether the key is appropriate for a task.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"8192"`
 
 	// Specifies the intended use of the key. Currently this defaults to ENCRYPT/DECRYPT,
 	// and only symmetric encryption and decryption are supported.
-	KeyUsage *string `type:"string"`
+	KeyUsage *string `type:"string" enum:"ENCRYPT_DECRYPT"`
 
 	// Policy to be attached to the key. This is required and delegates back to
 	// the account. The key is the root of trust.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	metadataCreateKeyInput `json:"-", xml:"-"`
 }
@@ -1044,7 +1320,7 @@ type metadataCreateKeyOutput struct {
 
 type DecryptInput struct {
 	// Ciphertext to be decrypted. The blob includes metadata.
-	CiphertextBlob []byte `type:"blob" required:"true"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144" required:"true"`
 
 	// The encryption context. If this was specified in the Encrypt function, it
 	// must be specified here or the decryption operation will fail. For more information,
@@ -1052,7 +1328,7 @@ type DecryptInput struct {
 	EncryptionContext *map[string]*string `type:"map"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	metadataDecryptInput `json:"-", xml:"-"`
 }
@@ -1064,11 +1340,11 @@ type metadataDecryptInput struct {
 type DecryptOutput struct {
 	// ARN of the key used to perform the decryption. This value is returned if
 	// no errors are encountered during the operation.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	// Decrypted plaintext data. This value may not be returned if the customer
 	// master key is not available or if you didn't have permission to use it.
-	Plaintext []byte `type:"blob"`
+	Plaintext []byte `type:"blob" min:"1" max:"4096"`
 
 	metadataDecryptOutput `json:"-", xml:"-"`
 }
@@ -1079,7 +1355,7 @@ type metadataDecryptOutput struct {
 
 type DeleteAliasInput struct {
 	// The alias to be deleted.
-	AliasName *string `type:"string" required:"true"`
+	AliasName *string `type:"string" min:"1" max:"256" pattern:"^[a-zA-Z0-9:/_-]+$" required:"true"`
 
 	metadataDeleteAliasInput `json:"-", xml:"-"`
 }
@@ -1103,7 +1379,7 @@ type DescribeKeyInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:/alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012 Alias Name
 	// Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDescribeKeyInput `json:"-", xml:"-"`
 }
@@ -1128,7 +1404,7 @@ type DisableKeyInput struct {
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDisableKeyInput `json:"-", xml:"-"`
 }
@@ -1150,7 +1426,7 @@ type DisableKeyRotationInput struct {
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDisableKeyRotationInput `json:"-", xml:"-"`
 }
@@ -1172,7 +1448,7 @@ type EnableKeyInput struct {
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataEnableKeyInput `json:"-", xml:"-"`
 }
@@ -1194,7 +1470,7 @@ type EnableKeyRotationInput struct {
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataEnableKeyRotationInput `json:"-", xml:"-"`
 }
@@ -1219,7 +1495,7 @@ type EncryptInput struct {
 	EncryptionContext *map[string]*string `type:"map"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier, a fully specified ARN to either an alias or a key, or
@@ -1227,10 +1503,10 @@ type EncryptInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:/alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012 Alias Name
 	// Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Data to be encrypted.
-	Plaintext []byte `type:"blob" required:"true"`
+	Plaintext []byte `type:"blob" min:"1" max:"4096" required:"true"`
 
 	metadataEncryptInput `json:"-", xml:"-"`
 }
@@ -1242,10 +1518,10 @@ type metadataEncryptInput struct {
 type EncryptOutput struct {
 	// The encrypted plaintext. If you are using the CLI, the value is Base64 encoded.
 	// Otherwise, it is not encoded.
-	CiphertextBlob []byte `type:"blob"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144"`
 
 	// The ID of the key used during encryption.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	metadataEncryptOutput `json:"-", xml:"-"`
 }
@@ -1261,7 +1537,7 @@ type GenerateDataKeyInput struct {
 	EncryptionContext *map[string]*string `type:"map"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier, a fully specified ARN to either an alias or a key, or
@@ -1269,16 +1545,16 @@ type GenerateDataKeyInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:/alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012 Alias Name
 	// Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Value that identifies the encryption algorithm and key size to generate a
 	// data key for. Currently this can be AES_128 or AES_256.
-	KeySpec *string `type:"string"`
+	KeySpec *string `type:"string" enum:"AES_256,AES_128"`
 
 	// Integer that contains the number of bytes to generate. Common values are
 	// 128, 256, 512, and 1024. 1024 is the current limit. We recommend that you
 	// use the KeySpec parameter instead.
-	NumberOfBytes *int64 `type:"integer"`
+	NumberOfBytes *int64 `type:"integer" min:"1" max:"1024"`
 
 	metadataGenerateDataKeyInput `json:"-", xml:"-"`
 }
@@ -1296,15 +1572,15 @@ type GenerateDataKeyOutput struct {
 	//
 	// If you are using the CLI, the value is Base64 encoded. Otherwise, it is
 	// not encoded.
-	CiphertextBlob []byte `type:"blob"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144"`
 
 	// System generated unique identifier of the key to be used to decrypt the encrypted
 	// copy of the data key.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	// Plaintext that contains the data key. Use this for encryption and decryption
 	// and then remove it from memory as soon as possible.
-	Plaintext []byte `type:"blob"`
+	Plaintext []byte `type:"blob" min:"1" max:"4096"`
 
 	metadataGenerateDataKeyOutput `json:"-", xml:"-"`
 }
@@ -1319,7 +1595,7 @@ type GenerateDataKeyWithoutPlaintextInput struct {
 	EncryptionContext *map[string]*string `type:"map"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier, a fully specified ARN to either an alias or a key, or
@@ -1327,16 +1603,16 @@ type GenerateDataKeyWithoutPlaintextInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:/alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012 Alias Name
 	// Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Value that identifies the encryption algorithm and key size. Currently this
 	// can be AES_128 or AES_256.
-	KeySpec *string `type:"string"`
+	KeySpec *string `type:"string" enum:"AES_256,AES_128"`
 
 	// Integer that contains the number of bytes to generate. Common values are
 	// 128, 256, 512, 1024 and so on. We recommend that you use the KeySpec parameter
 	// instead.
-	NumberOfBytes *int64 `type:"integer"`
+	NumberOfBytes *int64 `type:"integer" min:"1" max:"1024"`
 
 	metadataGenerateDataKeyWithoutPlaintextInput `json:"-", xml:"-"`
 }
@@ -1351,11 +1627,11 @@ type GenerateDataKeyWithoutPlaintextOutput struct {
 	//
 	// If you are using the CLI, the value is Base64 encoded. Otherwise, it is
 	// not encoded.
-	CiphertextBlob []byte `type:"blob"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144"`
 
 	// System generated unique identifier of the key to be used to decrypt the encrypted
 	// copy of the data key.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	metadataGenerateDataKeyWithoutPlaintextOutput `json:"-", xml:"-"`
 }
@@ -1367,7 +1643,7 @@ type metadataGenerateDataKeyWithoutPlaintextOutput struct {
 type GenerateRandomInput struct {
 	// Integer that contains the number of bytes to generate. Common values are
 	// 128, 256, 512, 1024 and so on. The current limit is 1024 bytes.
-	NumberOfBytes *int64 `type:"integer"`
+	NumberOfBytes *int64 `type:"integer" min:"1" max:"1024"`
 
 	metadataGenerateRandomInput `json:"-", xml:"-"`
 }
@@ -1378,7 +1654,7 @@ type metadataGenerateRandomInput struct {
 
 type GenerateRandomOutput struct {
 	// Plaintext that contains the unpredictable byte string.
-	Plaintext []byte `type:"blob"`
+	Plaintext []byte `type:"blob" min:"1" max:"4096"`
 
 	metadataGenerateRandomOutput `json:"-", xml:"-"`
 }
@@ -1392,11 +1668,11 @@ type GetKeyPolicyInput struct {
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// String that contains the name of the policy. Currently, this must be "default".
 	// Policy names can be discovered by calling ListKeyPolicies.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w]+" required:"true"`
 
 	metadataGetKeyPolicyInput `json:"-", xml:"-"`
 }
@@ -1407,7 +1683,7 @@ type metadataGetKeyPolicyInput struct {
 
 type GetKeyPolicyOutput struct {
 	// A policy document in JSON format.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	metadataGetKeyPolicyOutput `json:"-", xml:"-"`
 }
@@ -1421,7 +1697,7 @@ type GetKeyRotationStatusInput struct {
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataGetKeyRotationStatusInput `json:"-", xml:"-"`
 }
@@ -1464,13 +1740,13 @@ type GrantListEntry struct {
 	Constraints *GrantConstraints `type:"structure"`
 
 	// Unique grant identifier.
-	GrantID *string `locationName:"GrantId" type:"string"`
+	GrantID *string `locationName:"GrantId" type:"string" min:"1" max:"128"`
 
 	// The principal that receives the grant permission.
-	GranteePrincipal *string `type:"string"`
+	GranteePrincipal *string `type:"string" min:"1" max:"256"`
 
 	// The account under which the grant was issued.
-	IssuingAccount *string `type:"string"`
+	IssuingAccount *string `type:"string" min:"1" max:"256"`
 
 	// List of operations permitted by the grant. This can be any combination of
 	// one or more of the following values:  Decrypt Encrypt GenerateDataKey GenerateDataKeyWithoutPlaintext
@@ -1478,7 +1754,7 @@ type GrantListEntry struct {
 	Operations []*string `type:"list"`
 
 	// The principal that can retire the account.
-	RetiringPrincipal *string `type:"string"`
+	RetiringPrincipal *string `type:"string" min:"1" max:"256"`
 
 	metadataGrantListEntry `json:"-", xml:"-"`
 }
@@ -1490,10 +1766,10 @@ type metadataGrantListEntry struct {
 // Contains information about each entry in the key list.
 type KeyListEntry struct {
 	// ARN of the key.
-	KeyARN *string `locationName:"KeyArn" type:"string"`
+	KeyARN *string `locationName:"KeyArn" type:"string" min:"20" max:"2048"`
 
 	// Unique identifier of the key.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	metadataKeyListEntry `json:"-", xml:"-"`
 }
@@ -1505,7 +1781,7 @@ type metadataKeyListEntry struct {
 // Contains metadata associated with a specific key.
 type KeyMetadata struct {
 	// Key ARN (Amazon Resource Name).
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// Account ID number.
 	AWSAccountID *string `locationName:"AWSAccountId" type:"string"`
@@ -1514,16 +1790,16 @@ type KeyMetadata struct {
 	CreationDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The description of the key.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"8192"`
 
 	// Value that specifies whether the key is enabled.
 	Enabled *bool `type:"boolean"`
 
 	// Unique identifier for the key.
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// A value that specifies what operation(s) the key can perform.
-	KeyUsage *string `type:"string"`
+	KeyUsage *string `type:"string" enum:"ENCRYPT_DECRYPT"`
 
 	metadataKeyMetadata `json:"-", xml:"-"`
 }
@@ -1536,12 +1812,12 @@ type ListAliasesInput struct {
 	// Specify this parameter when paginating results to indicate the maximum number
 	// of aliases you want in each response. If there are additional aliases beyond
 	// the maximum you specify, the Truncated response element will be set to true.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Use this parameter when paginating results, and only in a subsequent request
 	// after you've received a response where the results are truncated. Set it
 	// to the value of the NextMarker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListAliasesInput `json:"-", xml:"-"`
 }
@@ -1556,7 +1832,7 @@ type ListAliasesOutput struct {
 
 	// If Truncated is true, this value is present and contains the value to use
 	// for the Marker request parameter in a subsequent pagination request.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A flag that indicates whether there are more items in the list. If your results
 	// were truncated, you can make a subsequent pagination request using the Marker
@@ -1575,18 +1851,18 @@ type ListGrantsInput struct {
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Specify this parameter only when paginating results to indicate the maximum
 	// number of grants you want listed in the response. If there are additional
 	// grants beyond the maximum you specify, the Truncated response element will
 	// be set to true.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the NextMarker in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListGrantsInput `json:"-", xml:"-"`
 }
@@ -1601,7 +1877,7 @@ type ListGrantsOutput struct {
 
 	// If Truncated is true, this value is present and contains the value to use
 	// for the Marker request parameter in a subsequent pagination request.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A flag that indicates whether there are more items in the list. If your results
 	// were truncated, you can make a subsequent pagination request using the Marker
@@ -1622,18 +1898,18 @@ type ListKeyPoliciesInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:/alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012 Alias Name
 	// Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Specify this parameter only when paginating results to indicate the maximum
 	// number of policies you want listed in the response. If there are additional
 	// policies beyond the maximum you specify, the Truncated response element will
 	// be set to true.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the NextMarker in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListKeyPoliciesInput `json:"-", xml:"-"`
 }
@@ -1645,7 +1921,7 @@ type metadataListKeyPoliciesInput struct {
 type ListKeyPoliciesOutput struct {
 	// If Truncated is true, this value is present and contains the value to use
 	// for the Marker request parameter in a subsequent pagination request.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of policy names. Currently, there is only one policy and it is named
 	// "Default".
@@ -1668,12 +1944,12 @@ type ListKeysInput struct {
 	// number of keys you want listed in the response. If there are additional keys
 	// beyond the maximum you specify, the Truncated response element will be set
 	// to true.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the NextMarker in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListKeysInput `json:"-", xml:"-"`
 }
@@ -1688,7 +1964,7 @@ type ListKeysOutput struct {
 
 	// If Truncated is true, this value is present and contains the value to use
 	// for the Marker request parameter in a subsequent pagination request.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A flag that indicates whether there are more items in the list. If your results
 	// were truncated, you can make a subsequent pagination request using the Marker
@@ -1707,14 +1983,14 @@ type PutKeyPolicyInput struct {
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The policy, in JSON format, to be attached to the key.
-	Policy *string `type:"string" required:"true"`
+	Policy *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// Name of the policy to be attached. Currently, the only supported name is
 	// "default".
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w]+" required:"true"`
 
 	metadataPutKeyPolicyInput `json:"-", xml:"-"`
 }
@@ -1733,7 +2009,7 @@ type metadataPutKeyPolicyOutput struct {
 
 type ReEncryptInput struct {
 	// Ciphertext of the data to re-encrypt.
-	CiphertextBlob []byte `type:"blob" required:"true"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144" required:"true"`
 
 	// Encryption context to be used when the data is re-encrypted.
 	DestinationEncryptionContext *map[string]*string `type:"map"`
@@ -1745,10 +2021,10 @@ type ReEncryptInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:/alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012 Alias Name
 	// Example - alias/MyAliasName
-	DestinationKeyID *string `locationName:"DestinationKeyId" type:"string" required:"true"`
+	DestinationKeyID *string `locationName:"DestinationKeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	// Encryption context used to encrypt and decrypt the data specified in the
 	// CiphertextBlob parameter.
@@ -1764,13 +2040,13 @@ type metadataReEncryptInput struct {
 type ReEncryptOutput struct {
 	// The re-encrypted data. If you are using the CLI, the value is Base64 encoded.
 	// Otherwise, it is not encoded.
-	CiphertextBlob []byte `type:"blob"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144"`
 
 	// Unique identifier of the key used to re-encrypt the data.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	// Unique identifier of the key used to originally encrypt the data.
-	SourceKeyID *string `locationName:"SourceKeyId" type:"string"`
+	SourceKeyID *string `locationName:"SourceKeyId" type:"string" min:"1" max:"256"`
 
 	metadataReEncryptOutput `json:"-", xml:"-"`
 }
@@ -1781,7 +2057,7 @@ type metadataReEncryptOutput struct {
 
 type RetireGrantInput struct {
 	// Token that identifies the grant to be retired.
-	GrantToken *string `type:"string" required:"true"`
+	GrantToken *string `type:"string" min:"1" max:"8192" required:"true"`
 
 	metadataRetireGrantInput `json:"-", xml:"-"`
 }
@@ -1800,13 +2076,13 @@ type metadataRetireGrantOutput struct {
 
 type RevokeGrantInput struct {
 	// Identifier of the grant to be revoked.
-	GrantID *string `locationName:"GrantId" type:"string" required:"true"`
+	GrantID *string `locationName:"GrantId" type:"string" min:"1" max:"128" required:"true"`
 
 	// A unique identifier for the customer master key associated with the grant.
 	// This value can be a globally unique identifier or the fully specified ARN
 	// to a key.  Key ARN Example - arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRevokeGrantInput `json:"-", xml:"-"`
 }
@@ -1825,13 +2101,13 @@ type metadataRevokeGrantOutput struct {
 
 type UpdateKeyDescriptionInput struct {
 	// New description for the key.
-	Description *string `type:"string" required:"true"`
+	Description *string `type:"string" min:"0" max:"8192" required:"true"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataUpdateKeyDescriptionInput `json:"-", xml:"-"`
 }
@@ -1846,4 +2122,56 @@ type UpdateKeyDescriptionOutput struct {
 
 type metadataUpdateKeyDescriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for DataKeySpec.
+const (
+	DataKeySpecAes256 = "AES_256"
+	DataKeySpecAes128 = "AES_128"
+)
+
+// DataKeySpecValues returns the valid values of DataKeySpec.
+func DataKeySpecValues() []string {
+	return []string{
+		DataKeySpecAes256,
+		DataKeySpecAes128,
+	}
+}
+
+// Enum values for GrantOperation.
+const (
+	GrantOperationDecrypt                         = "Decrypt"
+	GrantOperationEncrypt                         = "Encrypt"
+	GrantOperationGenerateDataKey                 = "GenerateDataKey"
+	GrantOperationGenerateDataKeyWithoutPlaintext = "GenerateDataKeyWithoutPlaintext"
+	GrantOperationReEncryptFrom                   = "ReEncryptFrom"
+	GrantOperationReEncryptTo                     = "ReEncryptTo"
+	GrantOperationCreateGrant                     = "CreateGrant"
+	GrantOperationRetireGrant                     = "RetireGrant"
+)
+
+// GrantOperationValues returns the valid values of GrantOperation.
+func GrantOperationValues() []string {
+	return []string{
+		GrantOperationDecrypt,
+		GrantOperationEncrypt,
+		GrantOperationGenerateDataKey,
+		GrantOperationGenerateDataKeyWithoutPlaintext,
+		GrantOperationReEncryptFrom,
+		GrantOperationReEncryptTo,
+		GrantOperationCreateGrant,
+		GrantOperationRetireGrant,
+	}
+}
+
+// Enum values for KeyUsageType.
+const (
+	KeyUsageTypeEncryptDecrypt = "ENCRYPT_DECRYPT"
+)
+
+// KeyUsageTypeValues returns the valid values of KeyUsageType.
+func KeyUsageTypeValues() []string {
+	return []string{
+		KeyUsageTypeEncryptDecrypt,
+	}
 }
\ No newline at end of file
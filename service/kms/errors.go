@@ -0,0 +1,129 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package kms
+
+import (
+	"github.com/datacratic/aws-sdk-go/aws"
+)
+
+// Error codes of the KMS errors. Errors with these codes are
+// returned as the typed error of the same name, e.g. an error with the code
+// ErrCodeFoo is a *Foo.
+const (
+	ErrCodeAlreadyExistsException           = "AlreadyExists"
+	ErrCodeDependencyTimeoutException       = "DependencyTimeout"
+	ErrCodeDisabledException                = "Disabled"
+	ErrCodeInvalidARNException              = "InvalidArn"
+	ErrCodeInvalidAliasNameException        = "InvalidAliasName"
+	ErrCodeInvalidCiphertextException       = "InvalidCiphertext"
+	ErrCodeInvalidGrantTokenException       = "InvalidGrantToken"
+	ErrCodeInvalidKeyUsageException         = "InvalidKeyUsage"
+	ErrCodeInvalidMarkerException           = "InvalidMarker"
+	ErrCodeKMSInternalException             = "KMSInternal"
+	ErrCodeKeyUnavailableException          = "KeyUnavailable"
+	ErrCodeLimitExceededException           = "LimitExceeded"
+	ErrCodeMalformedPolicyDocumentException = "MalformedPolicyDocument"
+	ErrCodeNotFoundException                = "NotFound"
+	ErrCodeUnsupportedOperationException    = "UnsupportedOperation"
+)
+
+// The request was rejected because it attempted to create a resource that already
+// exists.
+type AlreadyExistsException struct {
+	aws.APIError
+}
+
+// The system timed out while trying to fulfill the request.
+type DependencyTimeoutException struct {
+	aws.APIError
+}
+
+// A request was rejected because the specified key was marked as disabled.
+type DisabledException struct {
+	aws.APIError
+}
+
+// The request was rejected because a specified ARN was not valid.
+type InvalidARNException struct {
+	aws.APIError
+}
+
+// The request was rejected because the specified alias name is not valid.
+type InvalidAliasNameException struct {
+	aws.APIError
+}
+
+// The request was rejected because the specified ciphertext has been corrupted
+// or is otherwise invalid.
+type InvalidCiphertextException struct {
+	aws.APIError
+}
+
+// A grant token provided as part of the request is invalid.
+type InvalidGrantTokenException struct {
+	aws.APIError
+}
+
+// The request was rejected because the specified KeySpec parameter is not valid.
+// The currently supported value is ENCRYPT/DECRYPT.
+type InvalidKeyUsageException struct {
+	aws.APIError
+}
+
+// The request was rejected because the marker that specifies where pagination
+// should next begin is not valid.
+type InvalidMarkerException struct {
+	aws.APIError
+}
+
+// The request was rejected because an internal exception occurred. This error
+// can be retried.
+type KMSInternalException struct {
+	aws.APIError
+}
+
+// The request was rejected because the key was disabled, not found, or otherwise
+// not available.
+type KeyUnavailableException struct {
+	aws.APIError
+}
+
+// The request was rejected because a quota was exceeded.
+type LimitExceededException struct {
+	aws.APIError
+}
+
+// The request was rejected because the specified policy is not syntactically
+// or semantically correct.
+type MalformedPolicyDocumentException struct {
+	aws.APIError
+}
+
+// The request was rejected because the specified entity or resource could not
+// be found.
+type NotFoundException struct {
+	aws.APIError
+}
+
+// The request was rejected because a specified parameter is not supported.
+type UnsupportedOperationException struct {
+	aws.APIError
+}
+
+var errorTypes = map[string]func() aws.ServiceError{
+	ErrCodeAlreadyExistsException:           func() aws.ServiceError { return &AlreadyExistsException{} },
+	ErrCodeDependencyTimeoutException:       func() aws.ServiceError { return &DependencyTimeoutException{} },
+	ErrCodeDisabledException:                func() aws.ServiceError { return &DisabledException{} },
+	ErrCodeInvalidARNException:              func() aws.ServiceError { return &InvalidARNException{} },
+	ErrCodeInvalidAliasNameException:        func() aws.ServiceError { return &InvalidAliasNameException{} },
+	ErrCodeInvalidCiphertextException:       func() aws.ServiceError { return &InvalidCiphertextException{} },
+	ErrCodeInvalidGrantTokenException:       func() aws.ServiceError { return &InvalidGrantTokenException{} },
+	ErrCodeInvalidKeyUsageException:         func() aws.ServiceError { return &InvalidKeyUsageException{} },
+	ErrCodeInvalidMarkerException:           func() aws.ServiceError { return &InvalidMarkerException{} },
+	ErrCodeKMSInternalException:             func() aws.ServiceError { return &KMSInternalException{} },
+	ErrCodeKeyUnavailableException:          func() aws.ServiceError { return &KeyUnavailableException{} },
+	ErrCodeLimitExceededException:           func() aws.ServiceError { return &LimitExceededException{} },
+	ErrCodeMalformedPolicyDocumentException: func() aws.ServiceError { return &MalformedPolicyDocumentException{} },
+	ErrCodeNotFoundException:                func() aws.ServiceError { return &NotFoundException{} },
+	ErrCodeUnsupportedOperationException:    func() aws.ServiceError { return &UnsupportedOperationException{} },
+}
@@ -1,57 +1,109 @@
 package kmsiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/kms"
 )
 
 type KMSAPI interface {
 	CreateAlias(*kms.CreateAliasInput) (*kms.CreateAliasOutput, error)
 
+	CreateAliasWithContext(context.Context, *kms.CreateAliasInput) (*kms.CreateAliasOutput, error)
+
 	CreateGrant(*kms.CreateGrantInput) (*kms.CreateGrantOutput, error)
 
+	CreateGrantWithContext(context.Context, *kms.CreateGrantInput) (*kms.CreateGrantOutput, error)
+
 	CreateKey(*kms.CreateKeyInput) (*kms.CreateKeyOutput, error)
 
+	CreateKeyWithContext(context.Context, *kms.CreateKeyInput) (*kms.CreateKeyOutput, error)
+
 	Decrypt(*kms.DecryptInput) (*kms.DecryptOutput, error)
 
+	DecryptWithContext(context.Context, *kms.DecryptInput) (*kms.DecryptOutput, error)
+
 	DeleteAlias(*kms.DeleteAliasInput) (*kms.DeleteAliasOutput, error)
 
+	DeleteAliasWithContext(context.Context, *kms.DeleteAliasInput) (*kms.DeleteAliasOutput, error)
+
 	DescribeKey(*kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error)
 
+	DescribeKeyWithContext(context.Context, *kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error)
+
 	DisableKey(*kms.DisableKeyInput) (*kms.DisableKeyOutput, error)
 
+	DisableKeyWithContext(context.Context, *kms.DisableKeyInput) (*kms.DisableKeyOutput, error)
+
 	DisableKeyRotation(*kms.DisableKeyRotationInput) (*kms.DisableKeyRotationOutput, error)
 
+	DisableKeyRotationWithContext(context.Context, *kms.DisableKeyRotationInput) (*kms.DisableKeyRotationOutput, error)
+
 	EnableKey(*kms.EnableKeyInput) (*kms.EnableKeyOutput, error)
 
+	EnableKeyWithContext(context.Context, *kms.EnableKeyInput) (*kms.EnableKeyOutput, error)
+
 	EnableKeyRotation(*kms.EnableKeyRotationInput) (*kms.EnableKeyRotationOutput, error)
 
+	EnableKeyRotationWithContext(context.Context, *kms.EnableKeyRotationInput) (*kms.EnableKeyRotationOutput, error)
+
 	Encrypt(*kms.EncryptInput) (*kms.EncryptOutput, error)
 
+	EncryptWithContext(context.Context, *kms.EncryptInput) (*kms.EncryptOutput, error)
+
 	GenerateDataKey(*kms.GenerateDataKeyInput) (*kms.GenerateDataKeyOutput, error)
 
+	GenerateDataKeyWithContext(context.Context, *kms.GenerateDataKeyInput) (*kms.GenerateDataKeyOutput, error)
+
 	GenerateDataKeyWithoutPlaintext(*kms.GenerateDataKeyWithoutPlaintextInput) (*kms.GenerateDataKeyWithoutPlaintextOutput, error)
 
+	GenerateDataKeyWithoutPlaintextWithContext(context.Context, *kms.GenerateDataKeyWithoutPlaintextInput) (*kms.GenerateDataKeyWithoutPlaintextOutput, error)
+
 	GenerateRandom(*kms.GenerateRandomInput) (*kms.GenerateRandomOutput, error)
 
+	GenerateRandomWithContext(context.Context, *kms.GenerateRandomInput) (*kms.GenerateRandomOutput, error)
+
 	GetKeyPolicy(*kms.GetKeyPolicyInput) (*kms.GetKeyPolicyOutput, error)
 
+	GetKeyPolicyWithContext(context.Context, *kms.GetKeyPolicyInput) (*kms.GetKeyPolicyOutput, error)
+
 	GetKeyRotationStatus(*kms.GetKeyRotationStatusInput) (*kms.GetKeyRotationStatusOutput, error)
 
+	GetKeyRotationStatusWithContext(context.Context, *kms.GetKeyRotationStatusInput) (*kms.GetKeyRotationStatusOutput, error)
+
 	ListAliases(*kms.ListAliasesInput) (*kms.ListAliasesOutput, error)
 
+	ListAliasesWithContext(context.Context, *kms.ListAliasesInput) (*kms.ListAliasesOutput, error)
+
 	ListGrants(*kms.ListGrantsInput) (*kms.ListGrantsOutput, error)
 
+	ListGrantsWithContext(context.Context, *kms.ListGrantsInput) (*kms.ListGrantsOutput, error)
+
 	ListKeyPolicies(*kms.ListKeyPoliciesInput) (*kms.ListKeyPoliciesOutput, error)
 
+	ListKeyPoliciesWithContext(context.Context, *kms.ListKeyPoliciesInput) (*kms.ListKeyPoliciesOutput, error)
+
 	ListKeys(*kms.ListKeysInput) (*kms.ListKeysOutput, error)
 
+	ListKeysWithContext(context.Context, *kms.ListKeysInput) (*kms.ListKeysOutput, error)
+
 	PutKeyPolicy(*kms.PutKeyPolicyInput) (*kms.PutKeyPolicyOutput, error)
 
+	PutKeyPolicyWithContext(context.Context, *kms.PutKeyPolicyInput) (*kms.PutKeyPolicyOutput, error)
+
 	ReEncrypt(*kms.ReEncryptInput) (*kms.ReEncryptOutput, error)
 
+	ReEncryptWithContext(context.Context, *kms.ReEncryptInput) (*kms.ReEncryptOutput, error)
+
 	RetireGrant(*kms.RetireGrantInput) (*kms.RetireGrantOutput, error)
 
+	RetireGrantWithContext(context.Context, *kms.RetireGrantInput) (*kms.RetireGrantOutput, error)
+
 	RevokeGrant(*kms.RevokeGrantInput) (*kms.RevokeGrantOutput, error)
 
+	RevokeGrantWithContext(context.Context, *kms.RevokeGrantInput) (*kms.RevokeGrantOutput, error)
+
 	UpdateKeyDescription(*kms.UpdateKeyDescriptionInput) (*kms.UpdateKeyDescriptionOutput, error)
+
+	UpdateKeyDescriptionWithContext(context.Context, *kms.UpdateKeyDescriptionInput) (*kms.UpdateKeyDescriptionOutput, error)
 }
\ No newline at end of file
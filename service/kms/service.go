@@ -29,6 +29,7 @@ func New(config *aws.Config) *KMS {
 		APIVersion:   "2014-11-01",
 		JSONVersion:  "1.1",
 		TargetPrefix: "TrentService",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
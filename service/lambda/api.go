@@ -4,6 +4,7 @@
 package lambda
 
 import (
+	"context"
 	"io"
 	"sync"
 
@@ -62,6 +63,17 @@ func (c *Lambda) AddEventSource(input *AddEventSourceInput) (output *EventSource
 	return
 }
 
+// AddEventSourceWithContext is the same as AddEventSource with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Lambda) AddEventSourceWithContext(ctx context.Context, input *AddEventSourceInput) (output *EventSourceConfiguration, err error) {
+	req, out := c.AddEventSourceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddEventSource *aws.Operation
 
 // DeleteFunctionRequest generates a request for the DeleteFunction operation.
@@ -97,6 +109,17 @@ func (c *Lambda) DeleteFunction(input *DeleteFunctionInput) (output *DeleteFunct
 	return
 }
 
+// DeleteFunctionWithContext is the same as DeleteFunction with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Lambda) DeleteFunctionWithContext(ctx context.Context, input *DeleteFunctionInput) (output *DeleteFunctionOutput, err error) {
+	req, out := c.DeleteFunctionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteFunction *aws.Operation
 
 // GetEventSourceRequest generates a request for the GetEventSource operation.
@@ -133,6 +156,17 @@ func (c *Lambda) GetEventSource(input *GetEventSourceInput) (output *EventSource
 	return
 }
 
+// GetEventSourceWithContext is the same as GetEventSource with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Lambda) GetEventSourceWithContext(ctx context.Context, input *GetEventSourceInput) (output *EventSourceConfiguration, err error) {
+	req, out := c.GetEventSourceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetEventSource *aws.Operation
 
 // GetFunctionRequest generates a request for the GetFunction operation.
@@ -172,6 +206,17 @@ func (c *Lambda) GetFunction(input *GetFunctionInput) (output *GetFunctionOutput
 	return
 }
 
+// GetFunctionWithContext is the same as GetFunction with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Lambda) GetFunctionWithContext(ctx context.Context, input *GetFunctionInput) (output *GetFunctionOutput, err error) {
+	req, out := c.GetFunctionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetFunction *aws.Operation
 
 // GetFunctionConfigurationRequest generates a request for the GetFunctionConfiguration operation.
@@ -210,6 +255,17 @@ func (c *Lambda) GetFunctionConfiguration(input *GetFunctionConfigurationInput)
 	return
 }
 
+// GetFunctionConfigurationWithContext is the same as GetFunctionConfiguration with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Lambda) GetFunctionConfigurationWithContext(ctx context.Context, input *GetFunctionConfigurationInput) (output *FunctionConfiguration, err error) {
+	req, out := c.GetFunctionConfigurationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetFunctionConfiguration *aws.Operation
 
 // InvokeAsyncRequest generates a request for the InvokeAsync operation.
@@ -247,6 +303,17 @@ func (c *Lambda) InvokeAsync(input *InvokeAsyncInput) (output *InvokeAsyncOutput
 	return
 }
 
+// InvokeAsyncWithContext is the same as InvokeAsync with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Lambda) InvokeAsyncWithContext(ctx context.Context, input *InvokeAsyncInput) (output *InvokeAsyncOutput, err error) {
+	req, out := c.InvokeAsyncRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opInvokeAsync *aws.Operation
 
 // ListEventSourcesRequest generates a request for the ListEventSources operation.
@@ -287,6 +354,17 @@ func (c *Lambda) ListEventSources(input *ListEventSourcesInput) (output *ListEve
 	return
 }
 
+// ListEventSourcesWithContext is the same as ListEventSources with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Lambda) ListEventSourcesWithContext(ctx context.Context, input *ListEventSourcesInput) (output *ListEventSourcesOutput, err error) {
+	req, out := c.ListEventSourcesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListEventSources *aws.Operation
 
 // ListFunctionsRequest generates a request for the ListFunctions operation.
@@ -324,6 +402,17 @@ func (c *Lambda) ListFunctions(input *ListFunctionsInput) (output *ListFunctions
 	return
 }
 
+// ListFunctionsWithContext is the same as ListFunctions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Lambda) ListFunctionsWithContext(ctx context.Context, input *ListFunctionsInput) (output *ListFunctionsOutput, err error) {
+	req, out := c.ListFunctionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListFunctions *aws.Operation
 
 // RemoveEventSourceRequest generates a request for the RemoveEventSource operation.
@@ -360,6 +449,17 @@ func (c *Lambda) RemoveEventSource(input *RemoveEventSourceInput) (output *Remov
 	return
 }
 
+// RemoveEventSourceWithContext is the same as RemoveEventSource with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Lambda) RemoveEventSourceWithContext(ctx context.Context, input *RemoveEventSourceInput) (output *RemoveEventSourceOutput, err error) {
+	req, out := c.RemoveEventSourceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRemoveEventSource *aws.Operation
 
 // UpdateFunctionConfigurationRequest generates a request for the UpdateFunctionConfiguration operation.
@@ -399,6 +499,17 @@ func (c *Lambda) UpdateFunctionConfiguration(input *UpdateFunctionConfigurationI
 	return
 }
 
+// UpdateFunctionConfigurationWithContext is the same as UpdateFunctionConfiguration with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Lambda) UpdateFunctionConfigurationWithContext(ctx context.Context, input *UpdateFunctionConfigurationInput) (output *FunctionConfiguration, err error) {
+	req, out := c.UpdateFunctionConfigurationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateFunctionConfiguration *aws.Operation
 
 // UploadFunctionRequest generates a request for the UploadFunction operation.
@@ -437,6 +548,17 @@ func (c *Lambda) UploadFunction(input *UploadFunctionInput) (output *FunctionCon
 	return
 }
 
+// UploadFunctionWithContext is the same as UploadFunction with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Lambda) UploadFunctionWithContext(ctx context.Context, input *UploadFunctionInput) (output *FunctionConfiguration, err error) {
+	req, out := c.UploadFunctionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUploadFunction *aws.Operation
 
 type AddEventSourceInput struct {
@@ -451,7 +573,7 @@ type AddEventSourceInput struct {
 	EventSource *string `type:"string" required:"true"`
 
 	// The Lambda function to invoke when AWS Lambda detects an event on the stream.
-	FunctionName *string `type:"string" required:"true"`
+	FunctionName *string `type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+" required:"true"`
 
 	// A map (key-value pairs) defining the configuration for AWS Lambda to use
 	// when reading the event source. Currently, AWS Lambda supports only the InitialPositionInStream
@@ -462,7 +584,7 @@ type AddEventSourceInput struct {
 
 	// The ARN of the IAM role (invocation role) that AWS Lambda can assume to read
 	// from the stream and invoke the function.
-	Role *string `type:"string" required:"true"`
+	Role *string `type:"string" pattern:"arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+" required:"true"`
 
 	metadataAddEventSourceInput `json:"-", xml:"-"`
 }
@@ -473,7 +595,7 @@ type metadataAddEventSourceInput struct {
 
 type DeleteFunctionInput struct {
 	// The Lambda function to delete.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+" required:"true"`
 
 	metadataDeleteFunctionInput `json:"-", xml:"-"`
 }
@@ -501,7 +623,7 @@ type EventSourceConfiguration struct {
 	EventSource *string `type:"string"`
 
 	// The Lambda function to invoke when AWS Lambda detects an event on the stream.
-	FunctionName *string `type:"string"`
+	FunctionName *string `type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+"`
 
 	// Indicates whether the event source mapping is currently honored. Events are
 	// only processes if IsActive is true.
@@ -516,7 +638,7 @@ type EventSourceConfiguration struct {
 
 	// The ARN of the IAM role (invocation role) that AWS Lambda can assume to read
 	// from the stream and invoke the function.
-	Role *string `type:"string"`
+	Role *string `type:"string" pattern:"arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"`
 
 	// The description of the health of the event source mapping. Valid values are:
 	// "PENDING", "OK", and "PROBLEM:message". Initially this staus is "PENDING".
@@ -559,38 +681,38 @@ type FunctionConfiguration struct {
 	ConfigurationID *string `locationName:"ConfigurationId" type:"string"`
 
 	// The user-provided description.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"256"`
 
 	// The Amazon Resource Name (ARN) assigned to the function.
-	FunctionARN *string `type:"string"`
+	FunctionARN *string `type:"string" pattern:"arn:aws:lambda:[a-z]{2}-[a-z]+-\\d{1}:\\d{12}:function:[a-zA-Z0-9-_]+(\\/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})?"`
 
 	// The name of the function.
-	FunctionName *string `type:"string"`
+	FunctionName *string `type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+"`
 
 	// The function Lambda calls to begin executing your function.
-	Handler *string `type:"string"`
+	Handler *string `type:"string" pattern:"[a-zA-Z0-9./\\-_]+"`
 
 	// The timestamp of the last time you updated the function.
 	LastModified *string `type:"string"`
 
 	// The memory size, in MB, you configured for the function. Must be a multiple
 	// of 64 MB.
-	MemorySize *int64 `type:"integer"`
+	MemorySize *int64 `type:"integer" min:"128" max:"1024"`
 
 	// The type of the Lambda function you uploaded.
-	Mode *string `type:"string"`
+	Mode *string `type:"string" enum:"event"`
 
 	// The Amazon Resource Name (ARN) of the IAM role that Lambda assumes when it
 	// executes your function to access any other Amazon Web Services (AWS) resources.
-	Role *string `type:"string"`
+	Role *string `type:"string" pattern:"arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"`
 
 	// The runtime environment for the Lambda function.
-	Runtime *string `type:"string"`
+	Runtime *string `type:"string" enum:"nodejs"`
 
 	// The function execution time at which Lambda should terminate the function.
 	// Because the execution time has cost implications, we recommend you set this
 	// value based on your expected execution time. The default is 3 seconds.
-	Timeout *int64 `type:"integer"`
+	Timeout *int64 `type:"integer" min:"1" max:"60"`
 
 	metadataFunctionConfiguration `json:"-", xml:"-"`
 }
@@ -613,7 +735,7 @@ type metadataGetEventSourceInput struct {
 type GetFunctionConfigurationInput struct {
 	// The name of the Lambda function for which you want to retrieve the configuration
 	// information.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+" required:"true"`
 
 	metadataGetFunctionConfigurationInput `json:"-", xml:"-"`
 }
@@ -624,7 +746,7 @@ type metadataGetFunctionConfigurationInput struct {
 
 type GetFunctionInput struct {
 	// The Lambda function name.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+" required:"true"`
 
 	metadataGetFunctionInput `json:"-", xml:"-"`
 }
@@ -650,7 +772,7 @@ type metadataGetFunctionOutput struct {
 
 type InvokeAsyncInput struct {
 	// The Lambda function name.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+" required:"true"`
 
 	// JSON that you want to provide to your Lambda function as input.
 	InvokeArgs io.ReadSeeker `type:"blob" required:"true"`
@@ -679,7 +801,7 @@ type ListEventSourcesInput struct {
 	EventSourceARN *string `location:"querystring" locationName:"EventSource" type:"string"`
 
 	// The name of the AWS Lambda function.
-	FunctionName *string `location:"querystring" locationName:"FunctionName" type:"string"`
+	FunctionName *string `location:"querystring" locationName:"FunctionName" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+"`
 
 	// Optional string. An opaque pagination token returned from a previous ListEventSources
 	// operation. If present, specifies to continue the list from where the returning
@@ -688,7 +810,7 @@ type ListEventSourcesInput struct {
 
 	// Optional integer. Specifies the maximum number of event sources to return
 	// in response. This value must be greater than 0.
-	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer"`
+	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer" min:"1" max:"10000"`
 
 	metadataListEventSourcesInput `json:"-", xml:"-"`
 }
@@ -719,7 +841,7 @@ type ListFunctionsInput struct {
 
 	// Optional integer. Specifies the maximum number of AWS Lambda functions to
 	// return in response. This parameter value must be greater than 0.
-	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer"`
+	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer" min:"1" max:"10000"`
 
 	metadataListFunctionsInput `json:"-", xml:"-"`
 }
@@ -765,30 +887,30 @@ type metadataRemoveEventSourceOutput struct {
 type UpdateFunctionConfigurationInput struct {
 	// A short user-defined function description. Lambda does not use this value.
 	// Assign a meaningful description as you see fit.
-	Description *string `location:"querystring" locationName:"Description" type:"string"`
+	Description *string `location:"querystring" locationName:"Description" type:"string" min:"0" max:"256"`
 
 	// The name of the Lambda function.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+" required:"true"`
 
 	// The function that Lambda calls to begin executing your function. For Node.js,
 	// it is the module-name.export value in your function.
-	Handler *string `location:"querystring" locationName:"Handler" type:"string"`
+	Handler *string `location:"querystring" locationName:"Handler" type:"string" pattern:"[a-zA-Z0-9./\\-_]+"`
 
 	// The amount of memory, in MB, your Lambda function is given. Lambda uses this
 	// memory size to infer the amount of CPU allocated to your function. Your function
 	// use-case determines your CPU and memory requirements. For example, a database
 	// operation might need less memory compared to an image processing function.
 	// The default value is 128 MB. The value must be a multiple of 64 MB.
-	MemorySize *int64 `location:"querystring" locationName:"MemorySize" type:"integer"`
+	MemorySize *int64 `location:"querystring" locationName:"MemorySize" type:"integer" min:"128" max:"1024"`
 
 	// The Amazon Resource Name (ARN) of the IAM role that Lambda will assume when
 	// it executes your function.
-	Role *string `location:"querystring" locationName:"Role" type:"string"`
+	Role *string `location:"querystring" locationName:"Role" type:"string" pattern:"arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"`
 
 	// The function execution time at which Lambda should terminate the function.
 	// Because the execution time has cost implications, we recommend you set this
 	// value based on your expected execution time. The default is 3 seconds.
-	Timeout *int64 `location:"querystring" locationName:"Timeout" type:"integer"`
+	Timeout *int64 `location:"querystring" locationName:"Timeout" type:"integer" min:"1" max:"60"`
 
 	metadataUpdateFunctionConfigurationInput `json:"-", xml:"-"`
 }
@@ -800,12 +922,12 @@ type metadataUpdateFunctionConfigurationInput struct {
 type UploadFunctionInput struct {
 	// A short, user-defined function description. Lambda does not use this value.
 	// Assign a meaningful description as you see fit.
-	Description *string `location:"querystring" locationName:"Description" type:"string"`
+	Description *string `location:"querystring" locationName:"Description" type:"string" min:"0" max:"256"`
 
 	// The name you want to assign to the function you are uploading. The function
 	// names appear in the console and are returned in the ListFunctions API. Function
 	// names are used to specify functions to other AWS Lambda APIs, such as InvokeAsync.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+" required:"true"`
 
 	// A .zip file containing your packaged source code. For more information about
 	// creating a .zip file, go to AWS LambdaL How it Works (http://docs.aws.amazon.com/lambda/latest/dg/walkthrough-custom-events.html)
@@ -814,35 +936,59 @@ type UploadFunctionInput struct {
 
 	// The function that Lambda calls to begin execution. For Node.js, it is the
 	// module-name.export value in your function.
-	Handler *string `location:"querystring" locationName:"Handler" type:"string" required:"true"`
+	Handler *string `location:"querystring" locationName:"Handler" type:"string" pattern:"[a-zA-Z0-9./\\-_]+" required:"true"`
 
 	// The amount of memory, in MB, your Lambda function is given. Lambda uses this
 	// memory size to infer the amount of CPU allocated to your function. Your function
 	// use-case determines your CPU and memory requirements. For example, database
 	// operation might need less memory compared to image processing function. The
 	// default value is 128 MB. The value must be a multiple of 64 MB.
-	MemorySize *int64 `location:"querystring" locationName:"MemorySize" type:"integer"`
+	MemorySize *int64 `location:"querystring" locationName:"MemorySize" type:"integer" min:"128" max:"1024"`
 
 	// How the Lambda function will be invoked. Lambda supports only the "event"
 	// mode.
-	Mode *string `location:"querystring" locationName:"Mode" type:"string" required:"true"`
+	Mode *string `location:"querystring" locationName:"Mode" type:"string" enum:"event" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the IAM role that Lambda assumes when it
 	// executes your function to access any other Amazon Web Services (AWS) resources.
-	Role *string `location:"querystring" locationName:"Role" type:"string" required:"true"`
+	Role *string `location:"querystring" locationName:"Role" type:"string" pattern:"arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+" required:"true"`
 
 	// The runtime environment for the Lambda function you are uploading. Currently,
 	// Lambda supports only "nodejs" as the runtime.
-	Runtime *string `location:"querystring" locationName:"Runtime" type:"string" required:"true"`
+	Runtime *string `location:"querystring" locationName:"Runtime" type:"string" enum:"nodejs" required:"true"`
 
 	// The function execution time at which Lambda should terminate the function.
 	// Because the execution time has cost implications, we recommend you set this
 	// value based on your expected execution time. The default is 3 seconds.
-	Timeout *int64 `location:"querystring" locationName:"Timeout" type:"integer"`
+	Timeout *int64 `location:"querystring" locationName:"Timeout" type:"integer" min:"1" max:"60"`
 
 	metadataUploadFunctionInput `json:"-", xml:"-"`
 }
 
 type metadataUploadFunctionInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"FunctionZip"`
+}
+
+// Enum values for Mode.
+const (
+	ModeEvent = "event"
+)
+
+// ModeValues returns the valid values of Mode.
+func ModeValues() []string {
+	return []string{
+		ModeEvent,
+	}
+}
+
+// Enum values for Runtime.
+const (
+	RuntimeNodejs = "nodejs"
+)
+
+// RuntimeValues returns the valid values of Runtime.
+func RuntimeValues() []string {
+	return []string{
+		RuntimeNodejs,
+	}
 }
\ No newline at end of file
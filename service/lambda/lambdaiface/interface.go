@@ -1,29 +1,53 @@
 package lambdaiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/lambda"
 )
 
 type LambdaAPI interface {
 	AddEventSource(*lambda.AddEventSourceInput) (*lambda.EventSourceConfiguration, error)
 
+	AddEventSourceWithContext(context.Context, *lambda.AddEventSourceInput) (*lambda.EventSourceConfiguration, error)
+
 	DeleteFunction(*lambda.DeleteFunctionInput) (*lambda.DeleteFunctionOutput, error)
 
+	DeleteFunctionWithContext(context.Context, *lambda.DeleteFunctionInput) (*lambda.DeleteFunctionOutput, error)
+
 	GetEventSource(*lambda.GetEventSourceInput) (*lambda.EventSourceConfiguration, error)
 
+	GetEventSourceWithContext(context.Context, *lambda.GetEventSourceInput) (*lambda.EventSourceConfiguration, error)
+
 	GetFunction(*lambda.GetFunctionInput) (*lambda.GetFunctionOutput, error)
 
+	GetFunctionWithContext(context.Context, *lambda.GetFunctionInput) (*lambda.GetFunctionOutput, error)
+
 	GetFunctionConfiguration(*lambda.GetFunctionConfigurationInput) (*lambda.FunctionConfiguration, error)
 
+	GetFunctionConfigurationWithContext(context.Context, *lambda.GetFunctionConfigurationInput) (*lambda.FunctionConfiguration, error)
+
 	InvokeAsync(*lambda.InvokeAsyncInput) (*lambda.InvokeAsyncOutput, error)
 
+	InvokeAsyncWithContext(context.Context, *lambda.InvokeAsyncInput) (*lambda.InvokeAsyncOutput, error)
+
 	ListEventSources(*lambda.ListEventSourcesInput) (*lambda.ListEventSourcesOutput, error)
 
+	ListEventSourcesWithContext(context.Context, *lambda.ListEventSourcesInput) (*lambda.ListEventSourcesOutput, error)
+
 	ListFunctions(*lambda.ListFunctionsInput) (*lambda.ListFunctionsOutput, error)
 
+	ListFunctionsWithContext(context.Context, *lambda.ListFunctionsInput) (*lambda.ListFunctionsOutput, error)
+
 	RemoveEventSource(*lambda.RemoveEventSourceInput) (*lambda.RemoveEventSourceOutput, error)
 
+	RemoveEventSourceWithContext(context.Context, *lambda.RemoveEventSourceInput) (*lambda.RemoveEventSourceOutput, error)
+
 	UpdateFunctionConfiguration(*lambda.UpdateFunctionConfigurationInput) (*lambda.FunctionConfiguration, error)
 
+	UpdateFunctionConfigurationWithContext(context.Context, *lambda.UpdateFunctionConfigurationInput) (*lambda.FunctionConfiguration, error)
+
 	UploadFunction(*lambda.UploadFunctionInput) (*lambda.FunctionConfiguration, error)
+
+	UploadFunctionWithContext(context.Context, *lambda.UploadFunctionInput) (*lambda.FunctionConfiguration, error)
 }
\ No newline at end of file
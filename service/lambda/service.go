@@ -27,6 +27,7 @@ func New(config *aws.Config) *Lambda {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "lambda",
 		APIVersion:  "2014-11-11",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
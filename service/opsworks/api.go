@@ -4,6 +4,7 @@
 package opsworks
 
 import (
+	"context"
 	"sync"
 
 	"github.com/datacratic/aws-sdk-go/aws"
@@ -48,6 +49,17 @@ func (c *OpsWorks) AssignInstance(input *AssignInstanceInput) (output *AssignIns
 	return
 }
 
+// AssignInstanceWithContext is the same as AssignInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) AssignInstanceWithContext(ctx context.Context, input *AssignInstanceInput) (output *AssignInstanceOutput, err error) {
+	req, out := c.AssignInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAssignInstance *aws.Operation
 
 // AssignVolumeRequest generates a request for the AssignVolume operation.
@@ -88,6 +100,17 @@ func (c *OpsWorks) AssignVolume(input *AssignVolumeInput) (output *AssignVolumeO
 	return
 }
 
+// AssignVolumeWithContext is the same as AssignVolume with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) AssignVolumeWithContext(ctx context.Context, input *AssignVolumeInput) (output *AssignVolumeOutput, err error) {
+	req, out := c.AssignVolumeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAssignVolume *aws.Operation
 
 // AssociateElasticIPRequest generates a request for the AssociateElasticIP operation.
@@ -128,6 +151,17 @@ func (c *OpsWorks) AssociateElasticIP(input *AssociateElasticIPInput) (output *A
 	return
 }
 
+// AssociateElasticIPWithContext is the same as AssociateElasticIP with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) AssociateElasticIPWithContext(ctx context.Context, input *AssociateElasticIPInput) (output *AssociateElasticIPOutput, err error) {
+	req, out := c.AssociateElasticIPRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAssociateElasticIP *aws.Operation
 
 // AttachElasticLoadBalancerRequest generates a request for the AttachElasticLoadBalancer operation.
@@ -171,6 +205,17 @@ func (c *OpsWorks) AttachElasticLoadBalancer(input *AttachElasticLoadBalancerInp
 	return
 }
 
+// AttachElasticLoadBalancerWithContext is the same as AttachElasticLoadBalancer with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) AttachElasticLoadBalancerWithContext(ctx context.Context, input *AttachElasticLoadBalancerInput) (output *AttachElasticLoadBalancerOutput, err error) {
+	req, out := c.AttachElasticLoadBalancerRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAttachElasticLoadBalancer *aws.Operation
 
 // CloneStackRequest generates a request for the CloneStack operation.
@@ -209,6 +254,17 @@ func (c *OpsWorks) CloneStack(input *CloneStackInput) (output *CloneStackOutput,
 	return
 }
 
+// CloneStackWithContext is the same as CloneStack with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) CloneStackWithContext(ctx context.Context, input *CloneStackInput) (output *CloneStackOutput, err error) {
+	req, out := c.CloneStackRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCloneStack *aws.Operation
 
 // CreateAppRequest generates a request for the CreateApp operation.
@@ -248,6 +304,17 @@ func (c *OpsWorks) CreateApp(input *CreateAppInput) (output *CreateAppOutput, er
 	return
 }
 
+// CreateAppWithContext is the same as CreateApp with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) CreateAppWithContext(ctx context.Context, input *CreateAppInput) (output *CreateAppOutput, err error) {
+	req, out := c.CreateAppRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateApp *aws.Operation
 
 // CreateDeploymentRequest generates a request for the CreateDeployment operation.
@@ -288,6 +355,17 @@ func (c *OpsWorks) CreateDeployment(input *CreateDeploymentInput) (output *Creat
 	return
 }
 
+// CreateDeploymentWithContext is the same as CreateDeployment with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) CreateDeploymentWithContext(ctx context.Context, input *CreateDeploymentInput) (output *CreateDeploymentOutput, err error) {
+	req, out := c.CreateDeploymentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateDeployment *aws.Operation
 
 // CreateInstanceRequest generates a request for the CreateInstance operation.
@@ -327,6 +405,17 @@ func (c *OpsWorks) CreateInstance(input *CreateInstanceInput) (output *CreateIns
 	return
 }
 
+// CreateInstanceWithContext is the same as CreateInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) CreateInstanceWithContext(ctx context.Context, input *CreateInstanceInput) (output *CreateInstanceOutput, err error) {
+	req, out := c.CreateInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateInstance *aws.Operation
 
 // CreateLayerRequest generates a request for the CreateLayer operation.
@@ -372,6 +461,17 @@ func (c *OpsWorks) CreateLayer(input *CreateLayerInput) (output *CreateLayerOutp
 	return
 }
 
+// CreateLayerWithContext is the same as CreateLayer with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) CreateLayerWithContext(ctx context.Context, input *CreateLayerInput) (output *CreateLayerOutput, err error) {
+	req, out := c.CreateLayerRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateLayer *aws.Operation
 
 // CreateStackRequest generates a request for the CreateStack operation.
@@ -409,6 +509,17 @@ func (c *OpsWorks) CreateStack(input *CreateStackInput) (output *CreateStackOutp
 	return
 }
 
+// CreateStackWithContext is the same as CreateStack with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) CreateStackWithContext(ctx context.Context, input *CreateStackInput) (output *CreateStackOutput, err error) {
+	req, out := c.CreateStackRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateStack *aws.Operation
 
 // CreateUserProfileRequest generates a request for the CreateUserProfile operation.
@@ -446,6 +557,17 @@ func (c *OpsWorks) CreateUserProfile(input *CreateUserProfileInput) (output *Cre
 	return
 }
 
+// CreateUserProfileWithContext is the same as CreateUserProfile with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) CreateUserProfileWithContext(ctx context.Context, input *CreateUserProfileInput) (output *CreateUserProfileOutput, err error) {
+	req, out := c.CreateUserProfileRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateUserProfile *aws.Operation
 
 // DeleteAppRequest generates a request for the DeleteApp operation.
@@ -484,6 +606,17 @@ func (c *OpsWorks) DeleteApp(input *DeleteAppInput) (output *DeleteAppOutput, er
 	return
 }
 
+// DeleteAppWithContext is the same as DeleteApp with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DeleteAppWithContext(ctx context.Context, input *DeleteAppInput) (output *DeleteAppOutput, err error) {
+	req, out := c.DeleteAppRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteApp *aws.Operation
 
 // DeleteInstanceRequest generates a request for the DeleteInstance operation.
@@ -525,6 +658,17 @@ func (c *OpsWorks) DeleteInstance(input *DeleteInstanceInput) (output *DeleteIns
 	return
 }
 
+// DeleteInstanceWithContext is the same as DeleteInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DeleteInstanceWithContext(ctx context.Context, input *DeleteInstanceInput) (output *DeleteInstanceOutput, err error) {
+	req, out := c.DeleteInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteInstance *aws.Operation
 
 // DeleteLayerRequest generates a request for the DeleteLayer operation.
@@ -565,6 +709,17 @@ func (c *OpsWorks) DeleteLayer(input *DeleteLayerInput) (output *DeleteLayerOutp
 	return
 }
 
+// DeleteLayerWithContext is the same as DeleteLayer with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DeleteLayerWithContext(ctx context.Context, input *DeleteLayerInput) (output *DeleteLayerOutput, err error) {
+	req, out := c.DeleteLayerRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteLayer *aws.Operation
 
 // DeleteStackRequest generates a request for the DeleteStack operation.
@@ -605,6 +760,17 @@ func (c *OpsWorks) DeleteStack(input *DeleteStackInput) (output *DeleteStackOutp
 	return
 }
 
+// DeleteStackWithContext is the same as DeleteStack with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DeleteStackWithContext(ctx context.Context, input *DeleteStackInput) (output *DeleteStackOutput, err error) {
+	req, out := c.DeleteStackRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteStack *aws.Operation
 
 // DeleteUserProfileRequest generates a request for the DeleteUserProfile operation.
@@ -642,6 +808,17 @@ func (c *OpsWorks) DeleteUserProfile(input *DeleteUserProfileInput) (output *Del
 	return
 }
 
+// DeleteUserProfileWithContext is the same as DeleteUserProfile with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DeleteUserProfileWithContext(ctx context.Context, input *DeleteUserProfileInput) (output *DeleteUserProfileOutput, err error) {
+	req, out := c.DeleteUserProfileRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteUserProfile *aws.Operation
 
 // DeregisterElasticIPRequest generates a request for the DeregisterElasticIP operation.
@@ -681,6 +858,17 @@ func (c *OpsWorks) DeregisterElasticIP(input *DeregisterElasticIPInput) (output
 	return
 }
 
+// DeregisterElasticIPWithContext is the same as DeregisterElasticIP with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DeregisterElasticIPWithContext(ctx context.Context, input *DeregisterElasticIPInput) (output *DeregisterElasticIPOutput, err error) {
+	req, out := c.DeregisterElasticIPRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeregisterElasticIP *aws.Operation
 
 // DeregisterInstanceRequest generates a request for the DeregisterInstance operation.
@@ -721,6 +909,17 @@ func (c *OpsWorks) DeregisterInstance(input *DeregisterInstanceInput) (output *D
 	return
 }
 
+// DeregisterInstanceWithContext is the same as DeregisterInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DeregisterInstanceWithContext(ctx context.Context, input *DeregisterInstanceInput) (output *DeregisterInstanceOutput, err error) {
+	req, out := c.DeregisterInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeregisterInstance *aws.Operation
 
 // DeregisterRDSDBInstanceRequest generates a request for the DeregisterRDSDBInstance operation.
@@ -759,6 +958,17 @@ func (c *OpsWorks) DeregisterRDSDBInstance(input *DeregisterRDSDBInstanceInput)
 	return
 }
 
+// DeregisterRDSDBInstanceWithContext is the same as DeregisterRDSDBInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DeregisterRDSDBInstanceWithContext(ctx context.Context, input *DeregisterRDSDBInstanceInput) (output *DeregisterRDSDBInstanceOutput, err error) {
+	req, out := c.DeregisterRDSDBInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeregisterRDSDBInstance *aws.Operation
 
 // DeregisterVolumeRequest generates a request for the DeregisterVolume operation.
@@ -798,6 +1008,17 @@ func (c *OpsWorks) DeregisterVolume(input *DeregisterVolumeInput) (output *Dereg
 	return
 }
 
+// DeregisterVolumeWithContext is the same as DeregisterVolume with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DeregisterVolumeWithContext(ctx context.Context, input *DeregisterVolumeInput) (output *DeregisterVolumeOutput, err error) {
+	req, out := c.DeregisterVolumeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeregisterVolume *aws.Operation
 
 // DescribeAppsRequest generates a request for the DescribeApps operation.
@@ -838,6 +1059,17 @@ func (c *OpsWorks) DescribeApps(input *DescribeAppsInput) (output *DescribeAppsO
 	return
 }
 
+// DescribeAppsWithContext is the same as DescribeApps with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeAppsWithContext(ctx context.Context, input *DescribeAppsInput) (output *DescribeAppsOutput, err error) {
+	req, out := c.DescribeAppsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeApps *aws.Operation
 
 // DescribeCommandsRequest generates a request for the DescribeCommands operation.
@@ -878,6 +1110,17 @@ func (c *OpsWorks) DescribeCommands(input *DescribeCommandsInput) (output *Descr
 	return
 }
 
+// DescribeCommandsWithContext is the same as DescribeCommands with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeCommandsWithContext(ctx context.Context, input *DescribeCommandsInput) (output *DescribeCommandsOutput, err error) {
+	req, out := c.DescribeCommandsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeCommands *aws.Operation
 
 // DescribeDeploymentsRequest generates a request for the DescribeDeployments operation.
@@ -918,6 +1161,17 @@ func (c *OpsWorks) DescribeDeployments(input *DescribeDeploymentsInput) (output
 	return
 }
 
+// DescribeDeploymentsWithContext is the same as DescribeDeployments with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeDeploymentsWithContext(ctx context.Context, input *DescribeDeploymentsInput) (output *DescribeDeploymentsOutput, err error) {
+	req, out := c.DescribeDeploymentsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeDeployments *aws.Operation
 
 // DescribeElasticIPsRequest generates a request for the DescribeElasticIPs operation.
@@ -958,6 +1212,17 @@ func (c *OpsWorks) DescribeElasticIPs(input *DescribeElasticIPsInput) (output *D
 	return
 }
 
+// DescribeElasticIPsWithContext is the same as DescribeElasticIPs with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeElasticIPsWithContext(ctx context.Context, input *DescribeElasticIPsInput) (output *DescribeElasticIPsOutput, err error) {
+	req, out := c.DescribeElasticIPsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeElasticIPs *aws.Operation
 
 // DescribeElasticLoadBalancersRequest generates a request for the DescribeElasticLoadBalancers operation.
@@ -998,6 +1263,17 @@ func (c *OpsWorks) DescribeElasticLoadBalancers(input *DescribeElasticLoadBalanc
 	return
 }
 
+// DescribeElasticLoadBalancersWithContext is the same as DescribeElasticLoadBalancers with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeElasticLoadBalancersWithContext(ctx context.Context, input *DescribeElasticLoadBalancersInput) (output *DescribeElasticLoadBalancersOutput, err error) {
+	req, out := c.DescribeElasticLoadBalancersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeElasticLoadBalancers *aws.Operation
 
 // DescribeInstancesRequest generates a request for the DescribeInstances operation.
@@ -1038,6 +1314,17 @@ func (c *OpsWorks) DescribeInstances(input *DescribeInstancesInput) (output *Des
 	return
 }
 
+// DescribeInstancesWithContext is the same as DescribeInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeInstancesWithContext(ctx context.Context, input *DescribeInstancesInput) (output *DescribeInstancesOutput, err error) {
+	req, out := c.DescribeInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeInstances *aws.Operation
 
 // DescribeLayersRequest generates a request for the DescribeLayers operation.
@@ -1078,6 +1365,17 @@ func (c *OpsWorks) DescribeLayers(input *DescribeLayersInput) (output *DescribeL
 	return
 }
 
+// DescribeLayersWithContext is the same as DescribeLayers with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeLayersWithContext(ctx context.Context, input *DescribeLayersInput) (output *DescribeLayersOutput, err error) {
+	req, out := c.DescribeLayersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeLayers *aws.Operation
 
 // DescribeLoadBasedAutoScalingRequest generates a request for the DescribeLoadBasedAutoScaling operation.
@@ -1118,6 +1416,17 @@ func (c *OpsWorks) DescribeLoadBasedAutoScaling(input *DescribeLoadBasedAutoScal
 	return
 }
 
+// DescribeLoadBasedAutoScalingWithContext is the same as DescribeLoadBasedAutoScaling with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeLoadBasedAutoScalingWithContext(ctx context.Context, input *DescribeLoadBasedAutoScalingInput) (output *DescribeLoadBasedAutoScalingOutput, err error) {
+	req, out := c.DescribeLoadBasedAutoScalingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeLoadBasedAutoScaling *aws.Operation
 
 // DescribeMyUserProfileRequest generates a request for the DescribeMyUserProfile operation.
@@ -1155,6 +1464,17 @@ func (c *OpsWorks) DescribeMyUserProfile(input *DescribeMyUserProfileInput) (out
 	return
 }
 
+// DescribeMyUserProfileWithContext is the same as DescribeMyUserProfile with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeMyUserProfileWithContext(ctx context.Context, input *DescribeMyUserProfileInput) (output *DescribeMyUserProfileOutput, err error) {
+	req, out := c.DescribeMyUserProfileRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeMyUserProfile *aws.Operation
 
 // DescribePermissionsRequest generates a request for the DescribePermissions operation.
@@ -1193,6 +1513,17 @@ func (c *OpsWorks) DescribePermissions(input *DescribePermissionsInput) (output
 	return
 }
 
+// DescribePermissionsWithContext is the same as DescribePermissions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribePermissionsWithContext(ctx context.Context, input *DescribePermissionsInput) (output *DescribePermissionsOutput, err error) {
+	req, out := c.DescribePermissionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribePermissions *aws.Operation
 
 // DescribeRAIDArraysRequest generates a request for the DescribeRAIDArrays operation.
@@ -1233,6 +1564,17 @@ func (c *OpsWorks) DescribeRAIDArrays(input *DescribeRAIDArraysInput) (output *D
 	return
 }
 
+// DescribeRAIDArraysWithContext is the same as DescribeRAIDArrays with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeRAIDArraysWithContext(ctx context.Context, input *DescribeRAIDArraysInput) (output *DescribeRAIDArraysOutput, err error) {
+	req, out := c.DescribeRAIDArraysRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeRAIDArrays *aws.Operation
 
 // DescribeRDSDBInstancesRequest generates a request for the DescribeRDSDBInstances operation.
@@ -1271,6 +1613,17 @@ func (c *OpsWorks) DescribeRDSDBInstances(input *DescribeRDSDBInstancesInput) (o
 	return
 }
 
+// DescribeRDSDBInstancesWithContext is the same as DescribeRDSDBInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeRDSDBInstancesWithContext(ctx context.Context, input *DescribeRDSDBInstancesInput) (output *DescribeRDSDBInstancesOutput, err error) {
+	req, out := c.DescribeRDSDBInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeRDSDBInstances *aws.Operation
 
 // DescribeServiceErrorsRequest generates a request for the DescribeServiceErrors operation.
@@ -1309,6 +1662,17 @@ func (c *OpsWorks) DescribeServiceErrors(input *DescribeServiceErrorsInput) (out
 	return
 }
 
+// DescribeServiceErrorsWithContext is the same as DescribeServiceErrors with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeServiceErrorsWithContext(ctx context.Context, input *DescribeServiceErrorsInput) (output *DescribeServiceErrorsOutput, err error) {
+	req, out := c.DescribeServiceErrorsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeServiceErrors *aws.Operation
 
 // DescribeStackProvisioningParametersRequest generates a request for the DescribeStackProvisioningParameters operation.
@@ -1347,6 +1711,17 @@ func (c *OpsWorks) DescribeStackProvisioningParameters(input *DescribeStackProvi
 	return
 }
 
+// DescribeStackProvisioningParametersWithContext is the same as DescribeStackProvisioningParameters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeStackProvisioningParametersWithContext(ctx context.Context, input *DescribeStackProvisioningParametersInput) (output *DescribeStackProvisioningParametersOutput, err error) {
+	req, out := c.DescribeStackProvisioningParametersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeStackProvisioningParameters *aws.Operation
 
 // DescribeStackSummaryRequest generates a request for the DescribeStackSummary operation.
@@ -1386,6 +1761,17 @@ func (c *OpsWorks) DescribeStackSummary(input *DescribeStackSummaryInput) (outpu
 	return
 }
 
+// DescribeStackSummaryWithContext is the same as DescribeStackSummary with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeStackSummaryWithContext(ctx context.Context, input *DescribeStackSummaryInput) (output *DescribeStackSummaryOutput, err error) {
+	req, out := c.DescribeStackSummaryRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeStackSummary *aws.Operation
 
 // DescribeStacksRequest generates a request for the DescribeStacks operation.
@@ -1424,6 +1810,17 @@ func (c *OpsWorks) DescribeStacks(input *DescribeStacksInput) (output *DescribeS
 	return
 }
 
+// DescribeStacksWithContext is the same as DescribeStacks with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeStacksWithContext(ctx context.Context, input *DescribeStacksInput) (output *DescribeStacksOutput, err error) {
+	req, out := c.DescribeStacksRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeStacks *aws.Operation
 
 // DescribeTimeBasedAutoScalingRequest generates a request for the DescribeTimeBasedAutoScaling operation.
@@ -1464,6 +1861,17 @@ func (c *OpsWorks) DescribeTimeBasedAutoScaling(input *DescribeTimeBasedAutoScal
 	return
 }
 
+// DescribeTimeBasedAutoScalingWithContext is the same as DescribeTimeBasedAutoScaling with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeTimeBasedAutoScalingWithContext(ctx context.Context, input *DescribeTimeBasedAutoScalingInput) (output *DescribeTimeBasedAutoScalingOutput, err error) {
+	req, out := c.DescribeTimeBasedAutoScalingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeTimeBasedAutoScaling *aws.Operation
 
 // DescribeUserProfilesRequest generates a request for the DescribeUserProfiles operation.
@@ -1501,6 +1909,17 @@ func (c *OpsWorks) DescribeUserProfiles(input *DescribeUserProfilesInput) (outpu
 	return
 }
 
+// DescribeUserProfilesWithContext is the same as DescribeUserProfiles with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeUserProfilesWithContext(ctx context.Context, input *DescribeUserProfilesInput) (output *DescribeUserProfilesOutput, err error) {
+	req, out := c.DescribeUserProfilesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeUserProfiles *aws.Operation
 
 // DescribeVolumesRequest generates a request for the DescribeVolumes operation.
@@ -1541,6 +1960,17 @@ func (c *OpsWorks) DescribeVolumes(input *DescribeVolumesInput) (output *Describ
 	return
 }
 
+// DescribeVolumesWithContext is the same as DescribeVolumes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DescribeVolumesWithContext(ctx context.Context, input *DescribeVolumesInput) (output *DescribeVolumesOutput, err error) {
+	req, out := c.DescribeVolumesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeVolumes *aws.Operation
 
 // DetachElasticLoadBalancerRequest generates a request for the DetachElasticLoadBalancer operation.
@@ -1579,6 +2009,17 @@ func (c *OpsWorks) DetachElasticLoadBalancer(input *DetachElasticLoadBalancerInp
 	return
 }
 
+// DetachElasticLoadBalancerWithContext is the same as DetachElasticLoadBalancer with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DetachElasticLoadBalancerWithContext(ctx context.Context, input *DetachElasticLoadBalancerInput) (output *DetachElasticLoadBalancerOutput, err error) {
+	req, out := c.DetachElasticLoadBalancerRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDetachElasticLoadBalancer *aws.Operation
 
 // DisassociateElasticIPRequest generates a request for the DisassociateElasticIP operation.
@@ -1619,6 +2060,17 @@ func (c *OpsWorks) DisassociateElasticIP(input *DisassociateElasticIPInput) (out
 	return
 }
 
+// DisassociateElasticIPWithContext is the same as DisassociateElasticIP with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) DisassociateElasticIPWithContext(ctx context.Context, input *DisassociateElasticIPInput) (output *DisassociateElasticIPOutput, err error) {
+	req, out := c.DisassociateElasticIPRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDisassociateElasticIP *aws.Operation
 
 // GetHostnameSuggestionRequest generates a request for the GetHostnameSuggestion operation.
@@ -1658,6 +2110,17 @@ func (c *OpsWorks) GetHostnameSuggestion(input *GetHostnameSuggestionInput) (out
 	return
 }
 
+// GetHostnameSuggestionWithContext is the same as GetHostnameSuggestion with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) GetHostnameSuggestionWithContext(ctx context.Context, input *GetHostnameSuggestionInput) (output *GetHostnameSuggestionOutput, err error) {
+	req, out := c.GetHostnameSuggestionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetHostnameSuggestion *aws.Operation
 
 // RebootInstanceRequest generates a request for the RebootInstance operation.
@@ -1697,6 +2160,17 @@ func (c *OpsWorks) RebootInstance(input *RebootInstanceInput) (output *RebootIns
 	return
 }
 
+// RebootInstanceWithContext is the same as RebootInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) RebootInstanceWithContext(ctx context.Context, input *RebootInstanceInput) (output *RebootInstanceOutput, err error) {
+	req, out := c.RebootInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRebootInstance *aws.Operation
 
 // RegisterElasticIPRequest generates a request for the RegisterElasticIP operation.
@@ -1738,6 +2212,17 @@ func (c *OpsWorks) RegisterElasticIP(input *RegisterElasticIPInput) (output *Reg
 	return
 }
 
+// RegisterElasticIPWithContext is the same as RegisterElasticIP with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) RegisterElasticIPWithContext(ctx context.Context, input *RegisterElasticIPInput) (output *RegisterElasticIPOutput, err error) {
+	req, out := c.RegisterElasticIPRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRegisterElasticIP *aws.Operation
 
 // RegisterInstanceRequest generates a request for the RegisterInstance operation.
@@ -1781,6 +2266,17 @@ func (c *OpsWorks) RegisterInstance(input *RegisterInstanceInput) (output *Regis
 	return
 }
 
+// RegisterInstanceWithContext is the same as RegisterInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) RegisterInstanceWithContext(ctx context.Context, input *RegisterInstanceInput) (output *RegisterInstanceOutput, err error) {
+	req, out := c.RegisterInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRegisterInstance *aws.Operation
 
 // RegisterRDSDBInstanceRequest generates a request for the RegisterRDSDBInstance operation.
@@ -1819,6 +2315,17 @@ func (c *OpsWorks) RegisterRDSDBInstance(input *RegisterRDSDBInstanceInput) (out
 	return
 }
 
+// RegisterRDSDBInstanceWithContext is the same as RegisterRDSDBInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) RegisterRDSDBInstanceWithContext(ctx context.Context, input *RegisterRDSDBInstanceInput) (output *RegisterRDSDBInstanceOutput, err error) {
+	req, out := c.RegisterRDSDBInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRegisterRDSDBInstance *aws.Operation
 
 // RegisterVolumeRequest generates a request for the RegisterVolume operation.
@@ -1860,6 +2367,17 @@ func (c *OpsWorks) RegisterVolume(input *RegisterVolumeInput) (output *RegisterV
 	return
 }
 
+// RegisterVolumeWithContext is the same as RegisterVolume with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) RegisterVolumeWithContext(ctx context.Context, input *RegisterVolumeInput) (output *RegisterVolumeOutput, err error) {
+	req, out := c.RegisterVolumeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRegisterVolume *aws.Operation
 
 // SetLoadBasedAutoScalingRequest generates a request for the SetLoadBasedAutoScaling operation.
@@ -1905,6 +2423,17 @@ func (c *OpsWorks) SetLoadBasedAutoScaling(input *SetLoadBasedAutoScalingInput)
 	return
 }
 
+// SetLoadBasedAutoScalingWithContext is the same as SetLoadBasedAutoScaling with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) SetLoadBasedAutoScalingWithContext(ctx context.Context, input *SetLoadBasedAutoScalingInput) (output *SetLoadBasedAutoScalingOutput, err error) {
+	req, out := c.SetLoadBasedAutoScalingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetLoadBasedAutoScaling *aws.Operation
 
 // SetPermissionRequest generates a request for the SetPermission operation.
@@ -1944,6 +2473,17 @@ func (c *OpsWorks) SetPermission(input *SetPermissionInput) (output *SetPermissi
 	return
 }
 
+// SetPermissionWithContext is the same as SetPermission with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) SetPermissionWithContext(ctx context.Context, input *SetPermissionInput) (output *SetPermissionOutput, err error) {
+	req, out := c.SetPermissionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetPermission *aws.Operation
 
 // SetTimeBasedAutoScalingRequest generates a request for the SetTimeBasedAutoScaling operation.
@@ -1984,6 +2524,17 @@ func (c *OpsWorks) SetTimeBasedAutoScaling(input *SetTimeBasedAutoScalingInput)
 	return
 }
 
+// SetTimeBasedAutoScalingWithContext is the same as SetTimeBasedAutoScaling with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) SetTimeBasedAutoScalingWithContext(ctx context.Context, input *SetTimeBasedAutoScalingInput) (output *SetTimeBasedAutoScalingOutput, err error) {
+	req, out := c.SetTimeBasedAutoScalingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetTimeBasedAutoScaling *aws.Operation
 
 // StartInstanceRequest generates a request for the StartInstance operation.
@@ -2023,6 +2574,17 @@ func (c *OpsWorks) StartInstance(input *StartInstanceInput) (output *StartInstan
 	return
 }
 
+// StartInstanceWithContext is the same as StartInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) StartInstanceWithContext(ctx context.Context, input *StartInstanceInput) (output *StartInstanceOutput, err error) {
+	req, out := c.StartInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opStartInstance *aws.Operation
 
 // StartStackRequest generates a request for the StartStack operation.
@@ -2061,6 +2623,17 @@ func (c *OpsWorks) StartStack(input *StartStackInput) (output *StartStackOutput,
 	return
 }
 
+// StartStackWithContext is the same as StartStack with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) StartStackWithContext(ctx context.Context, input *StartStackInput) (output *StartStackOutput, err error) {
+	req, out := c.StartStackRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opStartStack *aws.Operation
 
 // StopInstanceRequest generates a request for the StopInstance operation.
@@ -2102,6 +2675,17 @@ func (c *OpsWorks) StopInstance(input *StopInstanceInput) (output *StopInstanceO
 	return
 }
 
+// StopInstanceWithContext is the same as StopInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) StopInstanceWithContext(ctx context.Context, input *StopInstanceInput) (output *StopInstanceOutput, err error) {
+	req, out := c.StopInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opStopInstance *aws.Operation
 
 // StopStackRequest generates a request for the StopStack operation.
@@ -2140,6 +2724,17 @@ func (c *OpsWorks) StopStack(input *StopStackInput) (output *StopStackOutput, er
 	return
 }
 
+// StopStackWithContext is the same as StopStack with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) StopStackWithContext(ctx context.Context, input *StopStackInput) (output *StopStackOutput, err error) {
+	req, out := c.StopStackRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opStopStack *aws.Operation
 
 // UnassignInstanceRequest generates a request for the UnassignInstance operation.
@@ -2181,6 +2776,17 @@ func (c *OpsWorks) UnassignInstance(input *UnassignInstanceInput) (output *Unass
 	return
 }
 
+// UnassignInstanceWithContext is the same as UnassignInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) UnassignInstanceWithContext(ctx context.Context, input *UnassignInstanceInput) (output *UnassignInstanceOutput, err error) {
+	req, out := c.UnassignInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUnassignInstance *aws.Operation
 
 // UnassignVolumeRequest generates a request for the UnassignVolume operation.
@@ -2220,6 +2826,17 @@ func (c *OpsWorks) UnassignVolume(input *UnassignVolumeInput) (output *UnassignV
 	return
 }
 
+// UnassignVolumeWithContext is the same as UnassignVolume with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) UnassignVolumeWithContext(ctx context.Context, input *UnassignVolumeInput) (output *UnassignVolumeOutput, err error) {
+	req, out := c.UnassignVolumeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUnassignVolume *aws.Operation
 
 // UpdateAppRequest generates a request for the UpdateApp operation.
@@ -2258,6 +2875,17 @@ func (c *OpsWorks) UpdateApp(input *UpdateAppInput) (output *UpdateAppOutput, er
 	return
 }
 
+// UpdateAppWithContext is the same as UpdateApp with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) UpdateAppWithContext(ctx context.Context, input *UpdateAppInput) (output *UpdateAppOutput, err error) {
+	req, out := c.UpdateAppRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateApp *aws.Operation
 
 // UpdateElasticIPRequest generates a request for the UpdateElasticIP operation.
@@ -2297,6 +2925,17 @@ func (c *OpsWorks) UpdateElasticIP(input *UpdateElasticIPInput) (output *UpdateE
 	return
 }
 
+// UpdateElasticIPWithContext is the same as UpdateElasticIP with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) UpdateElasticIPWithContext(ctx context.Context, input *UpdateElasticIPInput) (output *UpdateElasticIPOutput, err error) {
+	req, out := c.UpdateElasticIPRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateElasticIP *aws.Operation
 
 // UpdateInstanceRequest generates a request for the UpdateInstance operation.
@@ -2335,6 +2974,17 @@ func (c *OpsWorks) UpdateInstance(input *UpdateInstanceInput) (output *UpdateIns
 	return
 }
 
+// UpdateInstanceWithContext is the same as UpdateInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) UpdateInstanceWithContext(ctx context.Context, input *UpdateInstanceInput) (output *UpdateInstanceOutput, err error) {
+	req, out := c.UpdateInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateInstance *aws.Operation
 
 // UpdateLayerRequest generates a request for the UpdateLayer operation.
@@ -2373,6 +3023,17 @@ func (c *OpsWorks) UpdateLayer(input *UpdateLayerInput) (output *UpdateLayerOutp
 	return
 }
 
+// UpdateLayerWithContext is the same as UpdateLayer with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) UpdateLayerWithContext(ctx context.Context, input *UpdateLayerInput) (output *UpdateLayerOutput, err error) {
+	req, out := c.UpdateLayerRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateLayer *aws.Operation
 
 // UpdateMyUserProfileRequest generates a request for the UpdateMyUserProfile operation.
@@ -2410,6 +3071,17 @@ func (c *OpsWorks) UpdateMyUserProfile(input *UpdateMyUserProfileInput) (output
 	return
 }
 
+// UpdateMyUserProfileWithContext is the same as UpdateMyUserProfile with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) UpdateMyUserProfileWithContext(ctx context.Context, input *UpdateMyUserProfileInput) (output *UpdateMyUserProfileOutput, err error) {
+	req, out := c.UpdateMyUserProfileRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateMyUserProfile *aws.Operation
 
 // UpdateRDSDBInstanceRequest generates a request for the UpdateRDSDBInstance operation.
@@ -2448,6 +3120,17 @@ func (c *OpsWorks) UpdateRDSDBInstance(input *UpdateRDSDBInstanceInput) (output
 	return
 }
 
+// UpdateRDSDBInstanceWithContext is the same as UpdateRDSDBInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) UpdateRDSDBInstanceWithContext(ctx context.Context, input *UpdateRDSDBInstanceInput) (output *UpdateRDSDBInstanceOutput, err error) {
+	req, out := c.UpdateRDSDBInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateRDSDBInstance *aws.Operation
 
 // UpdateStackRequest generates a request for the UpdateStack operation.
@@ -2486,6 +3169,17 @@ func (c *OpsWorks) UpdateStack(input *UpdateStackInput) (output *UpdateStackOutp
 	return
 }
 
+// UpdateStackWithContext is the same as UpdateStack with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) UpdateStackWithContext(ctx context.Context, input *UpdateStackInput) (output *UpdateStackOutput, err error) {
+	req, out := c.UpdateStackRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateStack *aws.Operation
 
 // UpdateUserProfileRequest generates a request for the UpdateUserProfile operation.
@@ -2523,6 +3217,17 @@ func (c *OpsWorks) UpdateUserProfile(input *UpdateUserProfileInput) (output *Upd
 	return
 }
 
+// UpdateUserProfileWithContext is the same as UpdateUserProfile with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) UpdateUserProfileWithContext(ctx context.Context, input *UpdateUserProfileInput) (output *UpdateUserProfileOutput, err error) {
+	req, out := c.UpdateUserProfileRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateUserProfile *aws.Operation
 
 // UpdateVolumeRequest generates a request for the UpdateVolume operation.
@@ -2562,6 +3267,17 @@ func (c *OpsWorks) UpdateVolume(input *UpdateVolumeInput) (output *UpdateVolumeO
 	return
 }
 
+// UpdateVolumeWithContext is the same as UpdateVolume with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *OpsWorks) UpdateVolumeWithContext(ctx context.Context, input *UpdateVolumeInput) (output *UpdateVolumeOutput, err error) {
+	req, out := c.UpdateVolumeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateVolume *aws.Operation
 
 // A description of the app.
@@ -2610,7 +3326,7 @@ type App struct {
 	StackID *string `locationName:"StackId" type:"string"`
 
 	// The app type.
-	Type *string `type:"string"`
+	Type *string `type:"string" enum:"java,rails,php,nodejs,static,other"`
 
 	metadataApp `json:"-", xml:"-"`
 }
@@ -2723,7 +3439,7 @@ type AutoScalingThresholds struct {
 	// which typically takes several minutes. IgnoreMetricsTime allows you to direct
 	// AWS OpsWorks to not raise any scaling events long enough to get the new instances
 	// online.
-	IgnoreMetricsTime *int64 `type:"integer"`
+	IgnoreMetricsTime *int64 `type:"integer" min:"1" max:"100"`
 
 	// The number of instances to add or remove when the load exceeds a threshold.
 	InstanceCount *int64 `type:"integer"`
@@ -2737,7 +3453,7 @@ type AutoScalingThresholds struct {
 
 	// The amount of time, in minutes, that the load must exceed a threshold before
 	// more instances are added or removed.
-	ThresholdsWaitTime *int64 `type:"integer"`
+	ThresholdsWaitTime *int64 `type:"integer" min:"1" max:"100"`
 
 	metadataAutoScalingThresholds `json:"-", xml:"-"`
 }
@@ -2819,7 +3535,7 @@ type CloneStackInput struct {
 	// The default root device type. This value is used by default for all instances
 	// in the cloned stack, but you can override it when you create an instance.
 	// For more information, see Storage for the Root Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	DefaultRootDeviceType *string `type:"string"`
+	DefaultRootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// A default SSH key for the stack instances. You can override this value when
 	// you create or update an instance.
@@ -3018,7 +3734,7 @@ type CreateAppInput struct {
 	// For example, PHP applications are associated with a PHP layer. AWS OpsWorks
 	// deploys an application to those instances that are members of the corresponding
 	// layer.
-	Type *string `type:"string" required:"true"`
+	Type *string `type:"string" enum:"java,rails,php,nodejs,static,other" required:"true"`
 
 	metadataCreateAppInput `json:"-", xml:"-"`
 }
@@ -3099,10 +3815,10 @@ type CreateInstanceInput struct {
 	// not necessarily support both architectures. For a list of the architectures
 	// that are supported by the different instance types, see Instance Families
 	// and Types (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-types.html).
-	Architecture *string `type:"string"`
+	Architecture *string `type:"string" enum:"x86_64,i386"`
 
 	// For load-based or time-based instances, the type.
-	AutoScalingType *string `type:"string"`
+	AutoScalingType *string `type:"string" enum:"load,timer"`
 
 	// The instance Availability Zone. For more information, see Regions and Endpoints
 	// (http://docs.aws.amazon.com/general/latest/gr/rande.html).
@@ -3148,7 +3864,7 @@ type CreateInstanceInput struct {
 
 	// The instance root device type. For more information, see Storage for the
 	// Root Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	RootDeviceType *string `type:"string"`
+	RootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// The instance SSH key name.
 	SSHKeyName *string `locationName:"SshKeyName" type:"string"`
@@ -3241,7 +3957,7 @@ type CreateLayerInput struct {
 
 	// The layer type. A stack cannot have more than one built-in layer of the same
 	// type. It can have any number of custom layers.
-	Type *string `type:"string" required:"true"`
+	Type *string `type:"string" enum:"java-app,lb,web,php-app,rails-app,nodejs-app,memcached,db-master,monitoring-master,custom" required:"true"`
 
 	// Whether to use Amazon EBS-optimized instances.
 	UseEBSOptimizedInstances *bool `locationName:"UseEbsOptimizedInstances" type:"boolean"`
@@ -3320,7 +4036,7 @@ type CreateStackInput struct {
 	// in the stack, but you can override it when you create an instance. The default
 	// option is instance-store. For more information, see Storage for the Root
 	// Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	DefaultRootDeviceType *string `type:"string"`
+	DefaultRootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// A default SSH key for the stack instances. You can override this value when
 	// you create or update an instance.
@@ -3673,7 +4389,7 @@ type DeploymentCommand struct {
 	// back as many as four versions.  start: Start the app's web or application
 	// server.  stop: Stop the app's web or application server.  restart: Restart
 	// the app's web or application server.  undeploy: Undeploy the app.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" enum:"install_dependencies,update_dependencies,update_custom_cookbooks,execute_recipes,deploy,rollback,start,stop,restart,undeploy" required:"true"`
 
 	metadataDeploymentCommand `json:"-", xml:"-"`
 }
@@ -4464,10 +5180,10 @@ type Instance struct {
 	AMIID *string `locationName:"AmiId" type:"string"`
 
 	// The instance architecture, "i386" or "x86_64".
-	Architecture *string `type:"string"`
+	Architecture *string `type:"string" enum:"x86_64,i386"`
 
 	// For load-based or time-based instances, the type.
-	AutoScalingType *string `type:"string"`
+	AutoScalingType *string `type:"string" enum:"load,timer"`
 
 	// The instance Availability Zone. For more information, see Regions and Endpoints
 	// (http://docs.aws.amazon.com/general/latest/gr/rande.html).
@@ -4544,7 +5260,7 @@ type Instance struct {
 
 	// The instance root device type. For more information, see Storage for the
 	// Root Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	RootDeviceType *string `type:"string"`
+	RootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// The root device volume ID.
 	RootDeviceVolumeID *string `locationName:"RootDeviceVolumeId" type:"string"`
@@ -4574,7 +5290,7 @@ type Instance struct {
 	SubnetID *string `locationName:"SubnetId" type:"string"`
 
 	// The instance's virtualization type, paravirtual or hvm.
-	VirtualizationType *string `type:"string"`
+	VirtualizationType *string `type:"string" enum:"paravirtual,hvm"`
 
 	metadataInstance `json:"-", xml:"-"`
 }
@@ -4740,7 +5456,7 @@ type Layer struct {
 	StackID *string `locationName:"StackId" type:"string"`
 
 	// The layer type.
-	Type *string `type:"string"`
+	Type *string `type:"string" enum:"java-app,lb,web,php-app,rails-app,nodejs-app,memcached,db-master,monitoring-master,custom"`
 
 	// Whether the layer uses Amazon EBS-optimized instances.
 	UseEBSOptimizedInstances *bool `locationName:"UseEbsOptimizedInstances" type:"boolean"`
@@ -5292,7 +6008,7 @@ type Source struct {
 	SSHKey *string `locationName:"SshKey" type:"string"`
 
 	// The repository type.
-	Type *string `type:"string"`
+	Type *string `type:"string" enum:"git,svn,archive,s3"`
 
 	// The source URL.
 	URL *string `locationName:"Url" type:"string"`
@@ -5359,7 +6075,7 @@ type Stack struct {
 	// The default root device type. This value is used by default for all instances
 	// in the stack, but you can override it when you create an instance. For more
 	// information, see Storage for the Root Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	DefaultRootDeviceType *string `type:"string"`
+	DefaultRootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// A default SSH key for the stack's instances. You can override this value
 	// when you create or update an instance.
@@ -5612,7 +6328,7 @@ type UpdateAppInput struct {
 	SSLConfiguration *SSLConfiguration `locationName:"SslConfiguration" type:"structure"`
 
 	// The app type.
-	Type *string `type:"string"`
+	Type *string `type:"string" enum:"java,rails,php,nodejs,static,other"`
 
 	metadataUpdateAppInput `json:"-", xml:"-"`
 }
@@ -5662,10 +6378,10 @@ type UpdateInstanceInput struct {
 	// The instance architecture. Instance types do not necessarily support both
 	// architectures. For a list of the architectures that are supported by the
 	// different instance types, see Instance Families and Types (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-types.html).
-	Architecture *string `type:"string"`
+	Architecture *string `type:"string" enum:"x86_64,i386"`
 
 	// For load-based or time-based instances, the type.
-	AutoScalingType *string `type:"string"`
+	AutoScalingType *string `type:"string" enum:"load,timer"`
 
 	// Whether this is an Amazon EBS-optimized instance.
 	EBSOptimized *bool `locationName:"EbsOptimized" type:"boolean"`
@@ -5896,7 +6612,7 @@ type UpdateStackInput struct {
 	// The default root device type. This value is used by default for all instances
 	// in the stack, but you can override it when you create an instance. For more
 	// information, see Storage for the Root Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	DefaultRootDeviceType *string `type:"string"`
+	DefaultRootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// A default SSH key for the stack instances. You can override this value when
 	// you create or update an instance.
@@ -6178,4 +6894,246 @@ type WeeklyAutoScalingSchedule struct {
 
 type metadataWeeklyAutoScalingSchedule struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for AppAttributesKeys.
+const (
+	AppAttributesKeysDocumentRoot       = "DocumentRoot"
+	AppAttributesKeysRailsEnv           = "RailsEnv"
+	AppAttributesKeysAutoBundleOnDeploy = "AutoBundleOnDeploy"
+)
+
+// AppAttributesKeysValues returns the valid values of AppAttributesKeys.
+func AppAttributesKeysValues() []string {
+	return []string{
+		AppAttributesKeysDocumentRoot,
+		AppAttributesKeysRailsEnv,
+		AppAttributesKeysAutoBundleOnDeploy,
+	}
+}
+
+// Enum values for AppType.
+const (
+	AppTypeJava   = "java"
+	AppTypeRails  = "rails"
+	AppTypePhp    = "php"
+	AppTypeNodejs = "nodejs"
+	AppTypeStatic = "static"
+	AppTypeOther  = "other"
+)
+
+// AppTypeValues returns the valid values of AppType.
+func AppTypeValues() []string {
+	return []string{
+		AppTypeJava,
+		AppTypeRails,
+		AppTypePhp,
+		AppTypeNodejs,
+		AppTypeStatic,
+		AppTypeOther,
+	}
+}
+
+// Enum values for Architecture.
+const (
+	ArchitectureX8664 = "x86_64"
+	ArchitectureI386  = "i386"
+)
+
+// ArchitectureValues returns the valid values of Architecture.
+func ArchitectureValues() []string {
+	return []string{
+		ArchitectureX8664,
+		ArchitectureI386,
+	}
+}
+
+// Enum values for AutoScalingType.
+const (
+	AutoScalingTypeLoad  = "load"
+	AutoScalingTypeTimer = "timer"
+)
+
+// AutoScalingTypeValues returns the valid values of AutoScalingType.
+func AutoScalingTypeValues() []string {
+	return []string{
+		AutoScalingTypeLoad,
+		AutoScalingTypeTimer,
+	}
+}
+
+// Enum values for DeploymentCommandName.
+const (
+	DeploymentCommandNameInstallDependencies   = "install_dependencies"
+	DeploymentCommandNameUpdateDependencies    = "update_dependencies"
+	DeploymentCommandNameUpdateCustomCookbooks = "update_custom_cookbooks"
+	DeploymentCommandNameExecuteRecipes        = "execute_recipes"
+	DeploymentCommandNameDeploy                = "deploy"
+	DeploymentCommandNameRollback              = "rollback"
+	DeploymentCommandNameStart                 = "start"
+	DeploymentCommandNameStop                  = "stop"
+	DeploymentCommandNameRestart               = "restart"
+	DeploymentCommandNameUndeploy              = "undeploy"
+)
+
+// DeploymentCommandNameValues returns the valid values of DeploymentCommandName.
+func DeploymentCommandNameValues() []string {
+	return []string{
+		DeploymentCommandNameInstallDependencies,
+		DeploymentCommandNameUpdateDependencies,
+		DeploymentCommandNameUpdateCustomCookbooks,
+		DeploymentCommandNameExecuteRecipes,
+		DeploymentCommandNameDeploy,
+		DeploymentCommandNameRollback,
+		DeploymentCommandNameStart,
+		DeploymentCommandNameStop,
+		DeploymentCommandNameRestart,
+		DeploymentCommandNameUndeploy,
+	}
+}
+
+// Enum values for LayerAttributesKeys.
+const (
+	LayerAttributesKeysEnableHaproxyStats          = "EnableHaproxyStats"
+	LayerAttributesKeysHaproxyStatsUrl             = "HaproxyStatsUrl"
+	LayerAttributesKeysHaproxyStatsUser            = "HaproxyStatsUser"
+	LayerAttributesKeysHaproxyStatsPassword        = "HaproxyStatsPassword"
+	LayerAttributesKeysHaproxyHealthCheckUrl       = "HaproxyHealthCheckUrl"
+	LayerAttributesKeysHaproxyHealthCheckMethod    = "HaproxyHealthCheckMethod"
+	LayerAttributesKeysMysqlRootPassword           = "MysqlRootPassword"
+	LayerAttributesKeysMysqlRootPasswordUbiquitous = "MysqlRootPasswordUbiquitous"
+	LayerAttributesKeysGangliaUrl                  = "GangliaUrl"
+	LayerAttributesKeysGangliaUser                 = "GangliaUser"
+	LayerAttributesKeysGangliaPassword             = "GangliaPassword"
+	LayerAttributesKeysMemcachedMemory             = "MemcachedMemory"
+	LayerAttributesKeysNodejsVersion               = "NodejsVersion"
+	LayerAttributesKeysRubyVersion                 = "RubyVersion"
+	LayerAttributesKeysRubygemsVersion             = "RubygemsVersion"
+	LayerAttributesKeysManageBundler               = "ManageBundler"
+	LayerAttributesKeysBundlerVersion              = "BundlerVersion"
+	LayerAttributesKeysRailsStack                  = "RailsStack"
+	LayerAttributesKeysPassengerVersion            = "PassengerVersion"
+	LayerAttributesKeysJvm                         = "Jvm"
+	LayerAttributesKeysJvmVersion                  = "JvmVersion"
+	LayerAttributesKeysJvmOptions                  = "JvmOptions"
+	LayerAttributesKeysJavaAppServer               = "JavaAppServer"
+	LayerAttributesKeysJavaAppServerVersion        = "JavaAppServerVersion"
+)
+
+// LayerAttributesKeysValues returns the valid values of LayerAttributesKeys.
+func LayerAttributesKeysValues() []string {
+	return []string{
+		LayerAttributesKeysEnableHaproxyStats,
+		LayerAttributesKeysHaproxyStatsUrl,
+		LayerAttributesKeysHaproxyStatsUser,
+		LayerAttributesKeysHaproxyStatsPassword,
+		LayerAttributesKeysHaproxyHealthCheckUrl,
+		LayerAttributesKeysHaproxyHealthCheckMethod,
+		LayerAttributesKeysMysqlRootPassword,
+		LayerAttributesKeysMysqlRootPasswordUbiquitous,
+		LayerAttributesKeysGangliaUrl,
+		LayerAttributesKeysGangliaUser,
+		LayerAttributesKeysGangliaPassword,
+		LayerAttributesKeysMemcachedMemory,
+		LayerAttributesKeysNodejsVersion,
+		LayerAttributesKeysRubyVersion,
+		LayerAttributesKeysRubygemsVersion,
+		LayerAttributesKeysManageBundler,
+		LayerAttributesKeysBundlerVersion,
+		LayerAttributesKeysRailsStack,
+		LayerAttributesKeysPassengerVersion,
+		LayerAttributesKeysJvm,
+		LayerAttributesKeysJvmVersion,
+		LayerAttributesKeysJvmOptions,
+		LayerAttributesKeysJavaAppServer,
+		LayerAttributesKeysJavaAppServerVersion,
+	}
+}
+
+// Enum values for LayerType.
+const (
+	LayerTypeJavaApp          = "java-app"
+	LayerTypeLb               = "lb"
+	LayerTypeWeb              = "web"
+	LayerTypePhpApp           = "php-app"
+	LayerTypeRailsApp         = "rails-app"
+	LayerTypeNodejsApp        = "nodejs-app"
+	LayerTypeMemcached        = "memcached"
+	LayerTypeDBMaster         = "db-master"
+	LayerTypeMonitoringMaster = "monitoring-master"
+	LayerTypeCustom           = "custom"
+)
+
+// LayerTypeValues returns the valid values of LayerType.
+func LayerTypeValues() []string {
+	return []string{
+		LayerTypeJavaApp,
+		LayerTypeLb,
+		LayerTypeWeb,
+		LayerTypePhpApp,
+		LayerTypeRailsApp,
+		LayerTypeNodejsApp,
+		LayerTypeMemcached,
+		LayerTypeDBMaster,
+		LayerTypeMonitoringMaster,
+		LayerTypeCustom,
+	}
+}
+
+// Enum values for RootDeviceType.
+const (
+	RootDeviceTypeEBS           = "ebs"
+	RootDeviceTypeInstanceStore = "instance-store"
+)
+
+// RootDeviceTypeValues returns the valid values of RootDeviceType.
+func RootDeviceTypeValues() []string {
+	return []string{
+		RootDeviceTypeEBS,
+		RootDeviceTypeInstanceStore,
+	}
+}
+
+// Enum values for SourceType.
+const (
+	SourceTypeGit     = "git"
+	SourceTypeSvn     = "svn"
+	SourceTypeArchive = "archive"
+	SourceTypeS3      = "s3"
+)
+
+// SourceTypeValues returns the valid values of SourceType.
+func SourceTypeValues() []string {
+	return []string{
+		SourceTypeGit,
+		SourceTypeSvn,
+		SourceTypeArchive,
+		SourceTypeS3,
+	}
+}
+
+// Enum values for StackAttributesKeys.
+const (
+	StackAttributesKeysColor = "Color"
+)
+
+// StackAttributesKeysValues returns the valid values of StackAttributesKeys.
+func StackAttributesKeysValues() []string {
+	return []string{
+		StackAttributesKeysColor,
+	}
+}
+
+// Enum values for VirtualizationType.
+const (
+	VirtualizationTypeParavirtual = "paravirtual"
+	VirtualizationTypeHvm         = "hvm"
+)
+
+// VirtualizationTypeValues returns the valid values of VirtualizationType.
+func VirtualizationTypeValues() []string {
+	return []string{
+		VirtualizationTypeParavirtual,
+		VirtualizationTypeHvm,
+	}
 }
\ No newline at end of file
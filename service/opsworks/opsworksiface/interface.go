@@ -1,137 +1,269 @@
 package opsworksiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/opsworks"
 )
 
 type OpsWorksAPI interface {
 	AssignInstance(*opsworks.AssignInstanceInput) (*opsworks.AssignInstanceOutput, error)
 
+	AssignInstanceWithContext(context.Context, *opsworks.AssignInstanceInput) (*opsworks.AssignInstanceOutput, error)
+
 	AssignVolume(*opsworks.AssignVolumeInput) (*opsworks.AssignVolumeOutput, error)
 
+	AssignVolumeWithContext(context.Context, *opsworks.AssignVolumeInput) (*opsworks.AssignVolumeOutput, error)
+
 	AssociateElasticIP(*opsworks.AssociateElasticIPInput) (*opsworks.AssociateElasticIPOutput, error)
 
+	AssociateElasticIPWithContext(context.Context, *opsworks.AssociateElasticIPInput) (*opsworks.AssociateElasticIPOutput, error)
+
 	AttachElasticLoadBalancer(*opsworks.AttachElasticLoadBalancerInput) (*opsworks.AttachElasticLoadBalancerOutput, error)
 
+	AttachElasticLoadBalancerWithContext(context.Context, *opsworks.AttachElasticLoadBalancerInput) (*opsworks.AttachElasticLoadBalancerOutput, error)
+
 	CloneStack(*opsworks.CloneStackInput) (*opsworks.CloneStackOutput, error)
 
+	CloneStackWithContext(context.Context, *opsworks.CloneStackInput) (*opsworks.CloneStackOutput, error)
+
 	CreateApp(*opsworks.CreateAppInput) (*opsworks.CreateAppOutput, error)
 
+	CreateAppWithContext(context.Context, *opsworks.CreateAppInput) (*opsworks.CreateAppOutput, error)
+
 	CreateDeployment(*opsworks.CreateDeploymentInput) (*opsworks.CreateDeploymentOutput, error)
 
+	CreateDeploymentWithContext(context.Context, *opsworks.CreateDeploymentInput) (*opsworks.CreateDeploymentOutput, error)
+
 	CreateInstance(*opsworks.CreateInstanceInput) (*opsworks.CreateInstanceOutput, error)
 
+	CreateInstanceWithContext(context.Context, *opsworks.CreateInstanceInput) (*opsworks.CreateInstanceOutput, error)
+
 	CreateLayer(*opsworks.CreateLayerInput) (*opsworks.CreateLayerOutput, error)
 
+	CreateLayerWithContext(context.Context, *opsworks.CreateLayerInput) (*opsworks.CreateLayerOutput, error)
+
 	CreateStack(*opsworks.CreateStackInput) (*opsworks.CreateStackOutput, error)
 
+	CreateStackWithContext(context.Context, *opsworks.CreateStackInput) (*opsworks.CreateStackOutput, error)
+
 	CreateUserProfile(*opsworks.CreateUserProfileInput) (*opsworks.CreateUserProfileOutput, error)
 
+	CreateUserProfileWithContext(context.Context, *opsworks.CreateUserProfileInput) (*opsworks.CreateUserProfileOutput, error)
+
 	DeleteApp(*opsworks.DeleteAppInput) (*opsworks.DeleteAppOutput, error)
 
+	DeleteAppWithContext(context.Context, *opsworks.DeleteAppInput) (*opsworks.DeleteAppOutput, error)
+
 	DeleteInstance(*opsworks.DeleteInstanceInput) (*opsworks.DeleteInstanceOutput, error)
 
+	DeleteInstanceWithContext(context.Context, *opsworks.DeleteInstanceInput) (*opsworks.DeleteInstanceOutput, error)
+
 	DeleteLayer(*opsworks.DeleteLayerInput) (*opsworks.DeleteLayerOutput, error)
 
+	DeleteLayerWithContext(context.Context, *opsworks.DeleteLayerInput) (*opsworks.DeleteLayerOutput, error)
+
 	DeleteStack(*opsworks.DeleteStackInput) (*opsworks.DeleteStackOutput, error)
 
+	DeleteStackWithContext(context.Context, *opsworks.DeleteStackInput) (*opsworks.DeleteStackOutput, error)
+
 	DeleteUserProfile(*opsworks.DeleteUserProfileInput) (*opsworks.DeleteUserProfileOutput, error)
 
+	DeleteUserProfileWithContext(context.Context, *opsworks.DeleteUserProfileInput) (*opsworks.DeleteUserProfileOutput, error)
+
 	DeregisterElasticIP(*opsworks.DeregisterElasticIPInput) (*opsworks.DeregisterElasticIPOutput, error)
 
+	DeregisterElasticIPWithContext(context.Context, *opsworks.DeregisterElasticIPInput) (*opsworks.DeregisterElasticIPOutput, error)
+
 	DeregisterInstance(*opsworks.DeregisterInstanceInput) (*opsworks.DeregisterInstanceOutput, error)
 
+	DeregisterInstanceWithContext(context.Context, *opsworks.DeregisterInstanceInput) (*opsworks.DeregisterInstanceOutput, error)
+
 	DeregisterRDSDBInstance(*opsworks.DeregisterRDSDBInstanceInput) (*opsworks.DeregisterRDSDBInstanceOutput, error)
 
+	DeregisterRDSDBInstanceWithContext(context.Context, *opsworks.DeregisterRDSDBInstanceInput) (*opsworks.DeregisterRDSDBInstanceOutput, error)
+
 	DeregisterVolume(*opsworks.DeregisterVolumeInput) (*opsworks.DeregisterVolumeOutput, error)
 
+	DeregisterVolumeWithContext(context.Context, *opsworks.DeregisterVolumeInput) (*opsworks.DeregisterVolumeOutput, error)
+
 	DescribeApps(*opsworks.DescribeAppsInput) (*opsworks.DescribeAppsOutput, error)
 
+	DescribeAppsWithContext(context.Context, *opsworks.DescribeAppsInput) (*opsworks.DescribeAppsOutput, error)
+
 	DescribeCommands(*opsworks.DescribeCommandsInput) (*opsworks.DescribeCommandsOutput, error)
 
+	DescribeCommandsWithContext(context.Context, *opsworks.DescribeCommandsInput) (*opsworks.DescribeCommandsOutput, error)
+
 	DescribeDeployments(*opsworks.DescribeDeploymentsInput) (*opsworks.DescribeDeploymentsOutput, error)
 
+	DescribeDeploymentsWithContext(context.Context, *opsworks.DescribeDeploymentsInput) (*opsworks.DescribeDeploymentsOutput, error)
+
 	DescribeElasticIPs(*opsworks.DescribeElasticIPsInput) (*opsworks.DescribeElasticIPsOutput, error)
 
+	DescribeElasticIPsWithContext(context.Context, *opsworks.DescribeElasticIPsInput) (*opsworks.DescribeElasticIPsOutput, error)
+
 	DescribeElasticLoadBalancers(*opsworks.DescribeElasticLoadBalancersInput) (*opsworks.DescribeElasticLoadBalancersOutput, error)
 
+	DescribeElasticLoadBalancersWithContext(context.Context, *opsworks.DescribeElasticLoadBalancersInput) (*opsworks.DescribeElasticLoadBalancersOutput, error)
+
 	DescribeInstances(*opsworks.DescribeInstancesInput) (*opsworks.DescribeInstancesOutput, error)
 
+	DescribeInstancesWithContext(context.Context, *opsworks.DescribeInstancesInput) (*opsworks.DescribeInstancesOutput, error)
+
 	DescribeLayers(*opsworks.DescribeLayersInput) (*opsworks.DescribeLayersOutput, error)
 
+	DescribeLayersWithContext(context.Context, *opsworks.DescribeLayersInput) (*opsworks.DescribeLayersOutput, error)
+
 	DescribeLoadBasedAutoScaling(*opsworks.DescribeLoadBasedAutoScalingInput) (*opsworks.DescribeLoadBasedAutoScalingOutput, error)
 
+	DescribeLoadBasedAutoScalingWithContext(context.Context, *opsworks.DescribeLoadBasedAutoScalingInput) (*opsworks.DescribeLoadBasedAutoScalingOutput, error)
+
 	DescribeMyUserProfile(*opsworks.DescribeMyUserProfileInput) (*opsworks.DescribeMyUserProfileOutput, error)
 
+	DescribeMyUserProfileWithContext(context.Context, *opsworks.DescribeMyUserProfileInput) (*opsworks.DescribeMyUserProfileOutput, error)
+
 	DescribePermissions(*opsworks.DescribePermissionsInput) (*opsworks.DescribePermissionsOutput, error)
 
+	DescribePermissionsWithContext(context.Context, *opsworks.DescribePermissionsInput) (*opsworks.DescribePermissionsOutput, error)
+
 	DescribeRAIDArrays(*opsworks.DescribeRAIDArraysInput) (*opsworks.DescribeRAIDArraysOutput, error)
 
+	DescribeRAIDArraysWithContext(context.Context, *opsworks.DescribeRAIDArraysInput) (*opsworks.DescribeRAIDArraysOutput, error)
+
 	DescribeRDSDBInstances(*opsworks.DescribeRDSDBInstancesInput) (*opsworks.DescribeRDSDBInstancesOutput, error)
 
+	DescribeRDSDBInstancesWithContext(context.Context, *opsworks.DescribeRDSDBInstancesInput) (*opsworks.DescribeRDSDBInstancesOutput, error)
+
 	DescribeServiceErrors(*opsworks.DescribeServiceErrorsInput) (*opsworks.DescribeServiceErrorsOutput, error)
 
+	DescribeServiceErrorsWithContext(context.Context, *opsworks.DescribeServiceErrorsInput) (*opsworks.DescribeServiceErrorsOutput, error)
+
 	DescribeStackProvisioningParameters(*opsworks.DescribeStackProvisioningParametersInput) (*opsworks.DescribeStackProvisioningParametersOutput, error)
 
+	DescribeStackProvisioningParametersWithContext(context.Context, *opsworks.DescribeStackProvisioningParametersInput) (*opsworks.DescribeStackProvisioningParametersOutput, error)
+
 	DescribeStackSummary(*opsworks.DescribeStackSummaryInput) (*opsworks.DescribeStackSummaryOutput, error)
 
+	DescribeStackSummaryWithContext(context.Context, *opsworks.DescribeStackSummaryInput) (*opsworks.DescribeStackSummaryOutput, error)
+
 	DescribeStacks(*opsworks.DescribeStacksInput) (*opsworks.DescribeStacksOutput, error)
 
+	DescribeStacksWithContext(context.Context, *opsworks.DescribeStacksInput) (*opsworks.DescribeStacksOutput, error)
+
 	DescribeTimeBasedAutoScaling(*opsworks.DescribeTimeBasedAutoScalingInput) (*opsworks.DescribeTimeBasedAutoScalingOutput, error)
 
+	DescribeTimeBasedAutoScalingWithContext(context.Context, *opsworks.DescribeTimeBasedAutoScalingInput) (*opsworks.DescribeTimeBasedAutoScalingOutput, error)
+
 	DescribeUserProfiles(*opsworks.DescribeUserProfilesInput) (*opsworks.DescribeUserProfilesOutput, error)
 
+	DescribeUserProfilesWithContext(context.Context, *opsworks.DescribeUserProfilesInput) (*opsworks.DescribeUserProfilesOutput, error)
+
 	DescribeVolumes(*opsworks.DescribeVolumesInput) (*opsworks.DescribeVolumesOutput, error)
 
+	DescribeVolumesWithContext(context.Context, *opsworks.DescribeVolumesInput) (*opsworks.DescribeVolumesOutput, error)
+
 	DetachElasticLoadBalancer(*opsworks.DetachElasticLoadBalancerInput) (*opsworks.DetachElasticLoadBalancerOutput, error)
 
+	DetachElasticLoadBalancerWithContext(context.Context, *opsworks.DetachElasticLoadBalancerInput) (*opsworks.DetachElasticLoadBalancerOutput, error)
+
 	DisassociateElasticIP(*opsworks.DisassociateElasticIPInput) (*opsworks.DisassociateElasticIPOutput, error)
 
+	DisassociateElasticIPWithContext(context.Context, *opsworks.DisassociateElasticIPInput) (*opsworks.DisassociateElasticIPOutput, error)
+
 	GetHostnameSuggestion(*opsworks.GetHostnameSuggestionInput) (*opsworks.GetHostnameSuggestionOutput, error)
 
+	GetHostnameSuggestionWithContext(context.Context, *opsworks.GetHostnameSuggestionInput) (*opsworks.GetHostnameSuggestionOutput, error)
+
 	RebootInstance(*opsworks.RebootInstanceInput) (*opsworks.RebootInstanceOutput, error)
 
+	RebootInstanceWithContext(context.Context, *opsworks.RebootInstanceInput) (*opsworks.RebootInstanceOutput, error)
+
 	RegisterElasticIP(*opsworks.RegisterElasticIPInput) (*opsworks.RegisterElasticIPOutput, error)
 
+	RegisterElasticIPWithContext(context.Context, *opsworks.RegisterElasticIPInput) (*opsworks.RegisterElasticIPOutput, error)
+
 	RegisterInstance(*opsworks.RegisterInstanceInput) (*opsworks.RegisterInstanceOutput, error)
 
+	RegisterInstanceWithContext(context.Context, *opsworks.RegisterInstanceInput) (*opsworks.RegisterInstanceOutput, error)
+
 	RegisterRDSDBInstance(*opsworks.RegisterRDSDBInstanceInput) (*opsworks.RegisterRDSDBInstanceOutput, error)
 
+	RegisterRDSDBInstanceWithContext(context.Context, *opsworks.RegisterRDSDBInstanceInput) (*opsworks.RegisterRDSDBInstanceOutput, error)
+
 	RegisterVolume(*opsworks.RegisterVolumeInput) (*opsworks.RegisterVolumeOutput, error)
 
+	RegisterVolumeWithContext(context.Context, *opsworks.RegisterVolumeInput) (*opsworks.RegisterVolumeOutput, error)
+
 	SetLoadBasedAutoScaling(*opsworks.SetLoadBasedAutoScalingInput) (*opsworks.SetLoadBasedAutoScalingOutput, error)
 
+	SetLoadBasedAutoScalingWithContext(context.Context, *opsworks.SetLoadBasedAutoScalingInput) (*opsworks.SetLoadBasedAutoScalingOutput, error)
+
 	SetPermission(*opsworks.SetPermissionInput) (*opsworks.SetPermissionOutput, error)
 
+	SetPermissionWithContext(context.Context, *opsworks.SetPermissionInput) (*opsworks.SetPermissionOutput, error)
+
 	SetTimeBasedAutoScaling(*opsworks.SetTimeBasedAutoScalingInput) (*opsworks.SetTimeBasedAutoScalingOutput, error)
 
+	SetTimeBasedAutoScalingWithContext(context.Context, *opsworks.SetTimeBasedAutoScalingInput) (*opsworks.SetTimeBasedAutoScalingOutput, error)
+
 	StartInstance(*opsworks.StartInstanceInput) (*opsworks.StartInstanceOutput, error)
 
+	StartInstanceWithContext(context.Context, *opsworks.StartInstanceInput) (*opsworks.StartInstanceOutput, error)
+
 	StartStack(*opsworks.StartStackInput) (*opsworks.StartStackOutput, error)
 
+	StartStackWithContext(context.Context, *opsworks.StartStackInput) (*opsworks.StartStackOutput, error)
+
 	StopInstance(*opsworks.StopInstanceInput) (*opsworks.StopInstanceOutput, error)
 
+	StopInstanceWithContext(context.Context, *opsworks.StopInstanceInput) (*opsworks.StopInstanceOutput, error)
+
 	StopStack(*opsworks.StopStackInput) (*opsworks.StopStackOutput, error)
 
+	StopStackWithContext(context.Context, *opsworks.StopStackInput) (*opsworks.StopStackOutput, error)
+
 	UnassignInstance(*opsworks.UnassignInstanceInput) (*opsworks.UnassignInstanceOutput, error)
 
+	UnassignInstanceWithContext(context.Context, *opsworks.UnassignInstanceInput) (*opsworks.UnassignInstanceOutput, error)
+
 	UnassignVolume(*opsworks.UnassignVolumeInput) (*opsworks.UnassignVolumeOutput, error)
 
+	UnassignVolumeWithContext(context.Context, *opsworks.UnassignVolumeInput) (*opsworks.UnassignVolumeOutput, error)
+
 	UpdateApp(*opsworks.UpdateAppInput) (*opsworks.UpdateAppOutput, error)
 
+	UpdateAppWithContext(context.Context, *opsworks.UpdateAppInput) (*opsworks.UpdateAppOutput, error)
+
 	UpdateElasticIP(*opsworks.UpdateElasticIPInput) (*opsworks.UpdateElasticIPOutput, error)
 
+	UpdateElasticIPWithContext(context.Context, *opsworks.UpdateElasticIPInput) (*opsworks.UpdateElasticIPOutput, error)
+
 	UpdateInstance(*opsworks.UpdateInstanceInput) (*opsworks.UpdateInstanceOutput, error)
 
+	UpdateInstanceWithContext(context.Context, *opsworks.UpdateInstanceInput) (*opsworks.UpdateInstanceOutput, error)
+
 	UpdateLayer(*opsworks.UpdateLayerInput) (*opsworks.UpdateLayerOutput, error)
 
+	UpdateLayerWithContext(context.Context, *opsworks.UpdateLayerInput) (*opsworks.UpdateLayerOutput, error)
+
 	UpdateMyUserProfile(*opsworks.UpdateMyUserProfileInput) (*opsworks.UpdateMyUserProfileOutput, error)
 
+	UpdateMyUserProfileWithContext(context.Context, *opsworks.UpdateMyUserProfileInput) (*opsworks.UpdateMyUserProfileOutput, error)
+
 	UpdateRDSDBInstance(*opsworks.UpdateRDSDBInstanceInput) (*opsworks.UpdateRDSDBInstanceOutput, error)
 
+	UpdateRDSDBInstanceWithContext(context.Context, *opsworks.UpdateRDSDBInstanceInput) (*opsworks.UpdateRDSDBInstanceOutput, error)
+
 	UpdateStack(*opsworks.UpdateStackInput) (*opsworks.UpdateStackOutput, error)
 
+	UpdateStackWithContext(context.Context, *opsworks.UpdateStackInput) (*opsworks.UpdateStackOutput, error)
+
 	UpdateUserProfile(*opsworks.UpdateUserProfileInput) (*opsworks.UpdateUserProfileOutput, error)
 
+	UpdateUserProfileWithContext(context.Context, *opsworks.UpdateUserProfileInput) (*opsworks.UpdateUserProfileOutput, error)
+
 	UpdateVolume(*opsworks.UpdateVolumeInput) (*opsworks.UpdateVolumeOutput, error)
+
+	UpdateVolumeWithContext(context.Context, *opsworks.UpdateVolumeInput) (*opsworks.UpdateVolumeOutput, error)
 }
\ No newline at end of file
@@ -29,6 +29,7 @@ func New(config *aws.Config) *OpsWorks {
 		APIVersion:   "2013-02-18",
 		JSONVersion:  "1.1",
 		TargetPrefix: "OpsWorks_20130218",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
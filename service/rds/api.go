@@ -4,6 +4,7 @@
 package rds
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -43,6 +44,17 @@ func (c *RDS) AddSourceIdentifierToSubscription(input *AddSourceIdentifierToSubs
 	return
 }
 
+// AddSourceIdentifierToSubscriptionWithContext is the same as AddSourceIdentifierToSubscription with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) AddSourceIdentifierToSubscriptionWithContext(ctx context.Context, input *AddSourceIdentifierToSubscriptionInput) (output *AddSourceIdentifierToSubscriptionOutput, err error) {
+	req, out := c.AddSourceIdentifierToSubscriptionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddSourceIdentifierToSubscription *aws.Operation
 
 // AddTagsToResourceRequest generates a request for the AddTagsToResource operation.
@@ -81,6 +93,17 @@ func (c *RDS) AddTagsToResource(input *AddTagsToResourceInput) (output *AddTagsT
 	return
 }
 
+// AddTagsToResourceWithContext is the same as AddTagsToResource with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) AddTagsToResourceWithContext(ctx context.Context, input *AddTagsToResourceInput) (output *AddTagsToResourceOutput, err error) {
+	req, out := c.AddTagsToResourceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddTagsToResource *aws.Operation
 
 // ApplyPendingMaintenanceActionRequest generates a request for the ApplyPendingMaintenanceAction operation.
@@ -114,6 +137,17 @@ func (c *RDS) ApplyPendingMaintenanceAction(input *ApplyPendingMaintenanceAction
 	return
 }
 
+// ApplyPendingMaintenanceActionWithContext is the same as ApplyPendingMaintenanceAction with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) ApplyPendingMaintenanceActionWithContext(ctx context.Context, input *ApplyPendingMaintenanceActionInput) (output *ApplyPendingMaintenanceActionOutput, err error) {
+	req, out := c.ApplyPendingMaintenanceActionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opApplyPendingMaintenanceAction *aws.Operation
 
 // AuthorizeDBSecurityGroupIngressRequest generates a request for the AuthorizeDBSecurityGroupIngress operation.
@@ -158,6 +192,17 @@ func (c *RDS) AuthorizeDBSecurityGroupIngress(input *AuthorizeDBSecurityGroupIng
 	return
 }
 
+// AuthorizeDBSecurityGroupIngressWithContext is the same as AuthorizeDBSecurityGroupIngress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) AuthorizeDBSecurityGroupIngressWithContext(ctx context.Context, input *AuthorizeDBSecurityGroupIngressInput) (output *AuthorizeDBSecurityGroupIngressOutput, err error) {
+	req, out := c.AuthorizeDBSecurityGroupIngressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAuthorizeDBSecurityGroupIngress *aws.Operation
 
 // CopyDBParameterGroupRequest generates a request for the CopyDBParameterGroup operation.
@@ -191,6 +236,17 @@ func (c *RDS) CopyDBParameterGroup(input *CopyDBParameterGroupInput) (output *Co
 	return
 }
 
+// CopyDBParameterGroupWithContext is the same as CopyDBParameterGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) CopyDBParameterGroupWithContext(ctx context.Context, input *CopyDBParameterGroupInput) (output *CopyDBParameterGroupOutput, err error) {
+	req, out := c.CopyDBParameterGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCopyDBParameterGroup *aws.Operation
 
 // CopyDBSnapshotRequest generates a request for the CopyDBSnapshot operation.
@@ -225,6 +281,17 @@ func (c *RDS) CopyDBSnapshot(input *CopyDBSnapshotInput) (output *CopyDBSnapshot
 	return
 }
 
+// CopyDBSnapshotWithContext is the same as CopyDBSnapshot with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) CopyDBSnapshotWithContext(ctx context.Context, input *CopyDBSnapshotInput) (output *CopyDBSnapshotOutput, err error) {
+	req, out := c.CopyDBSnapshotRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCopyDBSnapshot *aws.Operation
 
 // CopyOptionGroupRequest generates a request for the CopyOptionGroup operation.
@@ -258,6 +325,17 @@ func (c *RDS) CopyOptionGroup(input *CopyOptionGroupInput) (output *CopyOptionGr
 	return
 }
 
+// CopyOptionGroupWithContext is the same as CopyOptionGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) CopyOptionGroupWithContext(ctx context.Context, input *CopyOptionGroupInput) (output *CopyOptionGroupOutput, err error) {
+	req, out := c.CopyOptionGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCopyOptionGroup *aws.Operation
 
 // CreateDBInstanceRequest generates a request for the CreateDBInstance operation.
@@ -291,6 +369,17 @@ func (c *RDS) CreateDBInstance(input *CreateDBInstanceInput) (output *CreateDBIn
 	return
 }
 
+// CreateDBInstanceWithContext is the same as CreateDBInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) CreateDBInstanceWithContext(ctx context.Context, input *CreateDBInstanceInput) (output *CreateDBInstanceOutput, err error) {
+	req, out := c.CreateDBInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateDBInstance *aws.Operation
 
 // CreateDBInstanceReadReplicaRequest generates a request for the CreateDBInstanceReadReplica operation.
@@ -331,6 +420,17 @@ func (c *RDS) CreateDBInstanceReadReplica(input *CreateDBInstanceReadReplicaInpu
 	return
 }
 
+// CreateDBInstanceReadReplicaWithContext is the same as CreateDBInstanceReadReplica with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) CreateDBInstanceReadReplicaWithContext(ctx context.Context, input *CreateDBInstanceReadReplicaInput) (output *CreateDBInstanceReadReplicaOutput, err error) {
+	req, out := c.CreateDBInstanceReadReplicaRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateDBInstanceReadReplica *aws.Operation
 
 // CreateDBParameterGroupRequest generates a request for the CreateDBParameterGroup operation.
@@ -384,6 +484,17 @@ func (c *RDS) CreateDBParameterGroup(input *CreateDBParameterGroupInput) (output
 	return
 }
 
+// CreateDBParameterGroupWithContext is the same as CreateDBParameterGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) CreateDBParameterGroupWithContext(ctx context.Context, input *CreateDBParameterGroupInput) (output *CreateDBParameterGroupOutput, err error) {
+	req, out := c.CreateDBParameterGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateDBParameterGroup *aws.Operation
 
 // CreateDBSecurityGroupRequest generates a request for the CreateDBSecurityGroup operation.
@@ -418,6 +529,17 @@ func (c *RDS) CreateDBSecurityGroup(input *CreateDBSecurityGroupInput) (output *
 	return
 }
 
+// CreateDBSecurityGroupWithContext is the same as CreateDBSecurityGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) CreateDBSecurityGroupWithContext(ctx context.Context, input *CreateDBSecurityGroupInput) (output *CreateDBSecurityGroupOutput, err error) {
+	req, out := c.CreateDBSecurityGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateDBSecurityGroup *aws.Operation
 
 // CreateDBSnapshotRequest generates a request for the CreateDBSnapshot operation.
@@ -451,6 +573,17 @@ func (c *RDS) CreateDBSnapshot(input *CreateDBSnapshotInput) (output *CreateDBSn
 	return
 }
 
+// CreateDBSnapshotWithContext is the same as CreateDBSnapshot with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) CreateDBSnapshotWithContext(ctx context.Context, input *CreateDBSnapshotInput) (output *CreateDBSnapshotOutput, err error) {
+	req, out := c.CreateDBSnapshotRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateDBSnapshot *aws.Operation
 
 // CreateDBSubnetGroupRequest generates a request for the CreateDBSubnetGroup operation.
@@ -485,6 +618,17 @@ func (c *RDS) CreateDBSubnetGroup(input *CreateDBSubnetGroupInput) (output *Crea
 	return
 }
 
+// CreateDBSubnetGroupWithContext is the same as CreateDBSubnetGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) CreateDBSubnetGroupWithContext(ctx context.Context, input *CreateDBSubnetGroupInput) (output *CreateDBSubnetGroupOutput, err error) {
+	req, out := c.CreateDBSubnetGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateDBSubnetGroup *aws.Operation
 
 // CreateEventSubscriptionRequest generates a request for the CreateEventSubscription operation.
@@ -536,6 +680,17 @@ func (c *RDS) CreateEventSubscription(input *CreateEventSubscriptionInput) (outp
 	return
 }
 
+// CreateEventSubscriptionWithContext is the same as CreateEventSubscription with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) CreateEventSubscriptionWithContext(ctx context.Context, input *CreateEventSubscriptionInput) (output *CreateEventSubscriptionOutput, err error) {
+	req, out := c.CreateEventSubscriptionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateEventSubscription *aws.Operation
 
 // CreateOptionGroupRequest generates a request for the CreateOptionGroup operation.
@@ -569,6 +724,17 @@ func (c *RDS) CreateOptionGroup(input *CreateOptionGroupInput) (output *CreateOp
 	return
 }
 
+// CreateOptionGroupWithContext is the same as CreateOptionGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) CreateOptionGroupWithContext(ctx context.Context, input *CreateOptionGroupInput) (output *CreateOptionGroupOutput, err error) {
+	req, out := c.CreateOptionGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateOptionGroup *aws.Operation
 
 // DeleteDBInstanceRequest generates a request for the DeleteDBInstance operation.
@@ -611,6 +777,17 @@ func (c *RDS) DeleteDBInstance(input *DeleteDBInstanceInput) (output *DeleteDBIn
 	return
 }
 
+// DeleteDBInstanceWithContext is the same as DeleteDBInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DeleteDBInstanceWithContext(ctx context.Context, input *DeleteDBInstanceInput) (output *DeleteDBInstanceOutput, err error) {
+	req, out := c.DeleteDBInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteDBInstance *aws.Operation
 
 // DeleteDBParameterGroupRequest generates a request for the DeleteDBParameterGroup operation.
@@ -647,6 +824,17 @@ func (c *RDS) DeleteDBParameterGroup(input *DeleteDBParameterGroupInput) (output
 	return
 }
 
+// DeleteDBParameterGroupWithContext is the same as DeleteDBParameterGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DeleteDBParameterGroupWithContext(ctx context.Context, input *DeleteDBParameterGroupInput) (output *DeleteDBParameterGroupOutput, err error) {
+	req, out := c.DeleteDBParameterGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteDBParameterGroup *aws.Operation
 
 // DeleteDBSecurityGroupRequest generates a request for the DeleteDBSecurityGroup operation.
@@ -682,6 +870,17 @@ func (c *RDS) DeleteDBSecurityGroup(input *DeleteDBSecurityGroupInput) (output *
 	return
 }
 
+// DeleteDBSecurityGroupWithContext is the same as DeleteDBSecurityGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DeleteDBSecurityGroupWithContext(ctx context.Context, input *DeleteDBSecurityGroupInput) (output *DeleteDBSecurityGroupOutput, err error) {
+	req, out := c.DeleteDBSecurityGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteDBSecurityGroup *aws.Operation
 
 // DeleteDBSnapshotRequest generates a request for the DeleteDBSnapshot operation.
@@ -718,6 +917,17 @@ func (c *RDS) DeleteDBSnapshot(input *DeleteDBSnapshotInput) (output *DeleteDBSn
 	return
 }
 
+// DeleteDBSnapshotWithContext is the same as DeleteDBSnapshot with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DeleteDBSnapshotWithContext(ctx context.Context, input *DeleteDBSnapshotInput) (output *DeleteDBSnapshotOutput, err error) {
+	req, out := c.DeleteDBSnapshotRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteDBSnapshot *aws.Operation
 
 // DeleteDBSubnetGroupRequest generates a request for the DeleteDBSubnetGroup operation.
@@ -753,6 +963,17 @@ func (c *RDS) DeleteDBSubnetGroup(input *DeleteDBSubnetGroupInput) (output *Dele
 	return
 }
 
+// DeleteDBSubnetGroupWithContext is the same as DeleteDBSubnetGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DeleteDBSubnetGroupWithContext(ctx context.Context, input *DeleteDBSubnetGroupInput) (output *DeleteDBSubnetGroupOutput, err error) {
+	req, out := c.DeleteDBSubnetGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteDBSubnetGroup *aws.Operation
 
 // DeleteEventSubscriptionRequest generates a request for the DeleteEventSubscription operation.
@@ -786,6 +1007,17 @@ func (c *RDS) DeleteEventSubscription(input *DeleteEventSubscriptionInput) (outp
 	return
 }
 
+// DeleteEventSubscriptionWithContext is the same as DeleteEventSubscription with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DeleteEventSubscriptionWithContext(ctx context.Context, input *DeleteEventSubscriptionInput) (output *DeleteEventSubscriptionOutput, err error) {
+	req, out := c.DeleteEventSubscriptionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteEventSubscription *aws.Operation
 
 // DeleteOptionGroupRequest generates a request for the DeleteOptionGroup operation.
@@ -819,6 +1051,17 @@ func (c *RDS) DeleteOptionGroup(input *DeleteOptionGroupInput) (output *DeleteOp
 	return
 }
 
+// DeleteOptionGroupWithContext is the same as DeleteOptionGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DeleteOptionGroupWithContext(ctx context.Context, input *DeleteOptionGroupInput) (output *DeleteOptionGroupOutput, err error) {
+	req, out := c.DeleteOptionGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteOptionGroup *aws.Operation
 
 // DescribeDBEngineVersionsRequest generates a request for the DescribeDBEngineVersions operation.
@@ -831,6 +1074,12 @@ func (c *RDS) DescribeDBEngineVersionsRequest(input *DescribeDBEngineVersionsInp
 			Name:       "DescribeDBEngineVersions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -852,6 +1101,27 @@ func (c *RDS) DescribeDBEngineVersions(input *DescribeDBEngineVersionsInput) (ou
 	return
 }
 
+// DescribeDBEngineVersionsWithContext is the same as DescribeDBEngineVersions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeDBEngineVersionsWithContext(ctx context.Context, input *DescribeDBEngineVersionsInput) (output *DescribeDBEngineVersionsOutput, err error) {
+	req, out := c.DescribeDBEngineVersionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeDBEngineVersionsPages iterates over the pages of a DescribeDBEngineVersions operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DescribeDBEngineVersionsPages(input *DescribeDBEngineVersionsInput, fn func(p *DescribeDBEngineVersionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDBEngineVersionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDBEngineVersionsOutput), lastPage)
+	})
+}
+
 var opDescribeDBEngineVersions *aws.Operation
 
 // DescribeDBInstancesRequest generates a request for the DescribeDBInstances operation.
@@ -864,6 +1134,12 @@ func (c *RDS) DescribeDBInstancesRequest(input *DescribeDBInstancesInput) (req *
 			Name:       "DescribeDBInstances",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -885,6 +1161,27 @@ func (c *RDS) DescribeDBInstances(input *DescribeDBInstancesInput) (output *Desc
 	return
 }
 
+// DescribeDBInstancesWithContext is the same as DescribeDBInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeDBInstancesWithContext(ctx context.Context, input *DescribeDBInstancesInput) (output *DescribeDBInstancesOutput, err error) {
+	req, out := c.DescribeDBInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeDBInstancesPages iterates over the pages of a DescribeDBInstances operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DescribeDBInstancesPages(input *DescribeDBInstancesInput, fn func(p *DescribeDBInstancesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDBInstancesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDBInstancesOutput), lastPage)
+	})
+}
+
 var opDescribeDBInstances *aws.Operation
 
 // DescribeDBLogFilesRequest generates a request for the DescribeDBLogFiles operation.
@@ -897,6 +1194,12 @@ func (c *RDS) DescribeDBLogFilesRequest(input *DescribeDBLogFilesInput) (req *aw
 			Name:       "DescribeDBLogFiles",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -918,6 +1221,27 @@ func (c *RDS) DescribeDBLogFiles(input *DescribeDBLogFilesInput) (output *Descri
 	return
 }
 
+// DescribeDBLogFilesWithContext is the same as DescribeDBLogFiles with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeDBLogFilesWithContext(ctx context.Context, input *DescribeDBLogFilesInput) (output *DescribeDBLogFilesOutput, err error) {
+	req, out := c.DescribeDBLogFilesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeDBLogFilesPages iterates over the pages of a DescribeDBLogFiles operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DescribeDBLogFilesPages(input *DescribeDBLogFilesInput, fn func(p *DescribeDBLogFilesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDBLogFilesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDBLogFilesOutput), lastPage)
+	})
+}
+
 var opDescribeDBLogFiles *aws.Operation
 
 // DescribeDBParameterGroupsRequest generates a request for the DescribeDBParameterGroups operation.
@@ -930,6 +1254,12 @@ func (c *RDS) DescribeDBParameterGroupsRequest(input *DescribeDBParameterGroupsI
 			Name:       "DescribeDBParameterGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -953,6 +1283,27 @@ func (c *RDS) DescribeDBParameterGroups(input *DescribeDBParameterGroupsInput) (
 	return
 }
 
+// DescribeDBParameterGroupsWithContext is the same as DescribeDBParameterGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeDBParameterGroupsWithContext(ctx context.Context, input *DescribeDBParameterGroupsInput) (output *DescribeDBParameterGroupsOutput, err error) {
+	req, out := c.DescribeDBParameterGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeDBParameterGroupsPages iterates over the pages of a DescribeDBParameterGroups operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DescribeDBParameterGroupsPages(input *DescribeDBParameterGroupsInput, fn func(p *DescribeDBParameterGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDBParameterGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDBParameterGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeDBParameterGroups *aws.Operation
 
 // DescribeDBParametersRequest generates a request for the DescribeDBParameters operation.
@@ -965,6 +1316,12 @@ func (c *RDS) DescribeDBParametersRequest(input *DescribeDBParametersInput) (req
 			Name:       "DescribeDBParameters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -986,6 +1343,27 @@ func (c *RDS) DescribeDBParameters(input *DescribeDBParametersInput) (output *De
 	return
 }
 
+// DescribeDBParametersWithContext is the same as DescribeDBParameters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeDBParametersWithContext(ctx context.Context, input *DescribeDBParametersInput) (output *DescribeDBParametersOutput, err error) {
+	req, out := c.DescribeDBParametersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeDBParametersPages iterates over the pages of a DescribeDBParameters operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DescribeDBParametersPages(input *DescribeDBParametersInput, fn func(p *DescribeDBParametersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDBParametersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDBParametersOutput), lastPage)
+	})
+}
+
 var opDescribeDBParameters *aws.Operation
 
 // DescribeDBSecurityGroupsRequest generates a request for the DescribeDBSecurityGroups operation.
@@ -998,6 +1376,12 @@ func (c *RDS) DescribeDBSecurityGroupsRequest(input *DescribeDBSecurityGroupsInp
 			Name:       "DescribeDBSecurityGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1021,6 +1405,27 @@ func (c *RDS) DescribeDBSecurityGroups(input *DescribeDBSecurityGroupsInput) (ou
 	return
 }
 
+// DescribeDBSecurityGroupsWithContext is the same as DescribeDBSecurityGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeDBSecurityGroupsWithContext(ctx context.Context, input *DescribeDBSecurityGroupsInput) (output *DescribeDBSecurityGroupsOutput, err error) {
+	req, out := c.DescribeDBSecurityGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeDBSecurityGroupsPages iterates over the pages of a DescribeDBSecurityGroups operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DescribeDBSecurityGroupsPages(input *DescribeDBSecurityGroupsInput, fn func(p *DescribeDBSecurityGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDBSecurityGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDBSecurityGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeDBSecurityGroups *aws.Operation
 
 // DescribeDBSnapshotsRequest generates a request for the DescribeDBSnapshots operation.
@@ -1033,6 +1438,12 @@ func (c *RDS) DescribeDBSnapshotsRequest(input *DescribeDBSnapshotsInput) (req *
 			Name:       "DescribeDBSnapshots",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1054,6 +1465,27 @@ func (c *RDS) DescribeDBSnapshots(input *DescribeDBSnapshotsInput) (output *Desc
 	return
 }
 
+// DescribeDBSnapshotsWithContext is the same as DescribeDBSnapshots with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeDBSnapshotsWithContext(ctx context.Context, input *DescribeDBSnapshotsInput) (output *DescribeDBSnapshotsOutput, err error) {
+	req, out := c.DescribeDBSnapshotsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeDBSnapshotsPages iterates over the pages of a DescribeDBSnapshots operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DescribeDBSnapshotsPages(input *DescribeDBSnapshotsInput, fn func(p *DescribeDBSnapshotsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDBSnapshotsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDBSnapshotsOutput), lastPage)
+	})
+}
+
 var opDescribeDBSnapshots *aws.Operation
 
 // DescribeDBSubnetGroupsRequest generates a request for the DescribeDBSubnetGroups operation.
@@ -1066,6 +1498,12 @@ func (c *RDS) DescribeDBSubnetGroupsRequest(input *DescribeDBSubnetGroupsInput)
 			Name:       "DescribeDBSubnetGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1090,6 +1528,27 @@ func (c *RDS) DescribeDBSubnetGroups(input *DescribeDBSubnetGroupsInput) (output
 	return
 }
 
+// DescribeDBSubnetGroupsWithContext is the same as DescribeDBSubnetGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeDBSubnetGroupsWithContext(ctx context.Context, input *DescribeDBSubnetGroupsInput) (output *DescribeDBSubnetGroupsOutput, err error) {
+	req, out := c.DescribeDBSubnetGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeDBSubnetGroupsPages iterates over the pages of a DescribeDBSubnetGroups operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DescribeDBSubnetGroupsPages(input *DescribeDBSubnetGroupsInput, fn func(p *DescribeDBSubnetGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDBSubnetGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDBSubnetGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeDBSubnetGroups *aws.Operation
 
 // DescribeEngineDefaultParametersRequest generates a request for the DescribeEngineDefaultParameters operation.
@@ -1102,6 +1561,12 @@ func (c *RDS) DescribeEngineDefaultParametersRequest(input *DescribeEngineDefaul
 			Name:       "DescribeEngineDefaultParameters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"EngineDefaults.Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1124,6 +1589,27 @@ func (c *RDS) DescribeEngineDefaultParameters(input *DescribeEngineDefaultParame
 	return
 }
 
+// DescribeEngineDefaultParametersWithContext is the same as DescribeEngineDefaultParameters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeEngineDefaultParametersWithContext(ctx context.Context, input *DescribeEngineDefaultParametersInput) (output *DescribeEngineDefaultParametersOutput, err error) {
+	req, out := c.DescribeEngineDefaultParametersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeEngineDefaultParametersPages iterates over the pages of a DescribeEngineDefaultParameters operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DescribeEngineDefaultParametersPages(input *DescribeEngineDefaultParametersInput, fn func(p *DescribeEngineDefaultParametersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeEngineDefaultParametersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEngineDefaultParametersOutput), lastPage)
+	})
+}
+
 var opDescribeEngineDefaultParameters *aws.Operation
 
 // DescribeEventCategoriesRequest generates a request for the DescribeEventCategories operation.
@@ -1160,6 +1646,17 @@ func (c *RDS) DescribeEventCategories(input *DescribeEventCategoriesInput) (outp
 	return
 }
 
+// DescribeEventCategoriesWithContext is the same as DescribeEventCategories with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeEventCategoriesWithContext(ctx context.Context, input *DescribeEventCategoriesInput) (output *DescribeEventCategoriesOutput, err error) {
+	req, out := c.DescribeEventCategoriesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeEventCategories *aws.Operation
 
 // DescribeEventSubscriptionsRequest generates a request for the DescribeEventSubscriptions operation.
@@ -1172,6 +1669,12 @@ func (c *RDS) DescribeEventSubscriptionsRequest(input *DescribeEventSubscription
 			Name:       "DescribeEventSubscriptions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1197,6 +1700,27 @@ func (c *RDS) DescribeEventSubscriptions(input *DescribeEventSubscriptionsInput)
 	return
 }
 
+// DescribeEventSubscriptionsWithContext is the same as DescribeEventSubscriptions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeEventSubscriptionsWithContext(ctx context.Context, input *DescribeEventSubscriptionsInput) (output *DescribeEventSubscriptionsOutput, err error) {
+	req, out := c.DescribeEventSubscriptionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeEventSubscriptionsPages iterates over the pages of a DescribeEventSubscriptions operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DescribeEventSubscriptionsPages(input *DescribeEventSubscriptionsInput, fn func(p *DescribeEventSubscriptionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeEventSubscriptionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEventSubscriptionsOutput), lastPage)
+	})
+}
+
 var opDescribeEventSubscriptions *aws.Operation
 
 // DescribeEventsRequest generates a request for the DescribeEvents operation.
@@ -1209,6 +1733,12 @@ func (c *RDS) DescribeEventsRequest(input *DescribeEventsInput) (req *aws.Reques
 			Name:       "DescribeEvents",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1234,6 +1764,27 @@ func (c *RDS) DescribeEvents(input *DescribeEventsInput) (output *DescribeEvents
 	return
 }
 
+// DescribeEventsWithContext is the same as DescribeEvents with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeEventsWithContext(ctx context.Context, input *DescribeEventsInput) (output *DescribeEventsOutput, err error) {
+	req, out := c.DescribeEventsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeEventsPages iterates over the pages of a DescribeEvents operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DescribeEventsPages(input *DescribeEventsInput, fn func(p *DescribeEventsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeEventsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEventsOutput), lastPage)
+	})
+}
+
 var opDescribeEvents *aws.Operation
 
 // DescribeOptionGroupOptionsRequest generates a request for the DescribeOptionGroupOptions operation.
@@ -1246,6 +1797,12 @@ func (c *RDS) DescribeOptionGroupOptionsRequest(input *DescribeOptionGroupOption
 			Name:       "DescribeOptionGroupOptions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1267,6 +1824,27 @@ func (c *RDS) DescribeOptionGroupOptions(input *DescribeOptionGroupOptionsInput)
 	return
 }
 
+// DescribeOptionGroupOptionsWithContext is the same as DescribeOptionGroupOptions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeOptionGroupOptionsWithContext(ctx context.Context, input *DescribeOptionGroupOptionsInput) (output *DescribeOptionGroupOptionsOutput, err error) {
+	req, out := c.DescribeOptionGroupOptionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeOptionGroupOptionsPages iterates over the pages of a DescribeOptionGroupOptions operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DescribeOptionGroupOptionsPages(input *DescribeOptionGroupOptionsInput, fn func(p *DescribeOptionGroupOptionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeOptionGroupOptionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeOptionGroupOptionsOutput), lastPage)
+	})
+}
+
 var opDescribeOptionGroupOptions *aws.Operation
 
 // DescribeOptionGroupsRequest generates a request for the DescribeOptionGroups operation.
@@ -1279,6 +1857,12 @@ func (c *RDS) DescribeOptionGroupsRequest(input *DescribeOptionGroupsInput) (req
 			Name:       "DescribeOptionGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1300,6 +1884,27 @@ func (c *RDS) DescribeOptionGroups(input *DescribeOptionGroupsInput) (output *De
 	return
 }
 
+// DescribeOptionGroupsWithContext is the same as DescribeOptionGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeOptionGroupsWithContext(ctx context.Context, input *DescribeOptionGroupsInput) (output *DescribeOptionGroupsOutput, err error) {
+	req, out := c.DescribeOptionGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeOptionGroupsPages iterates over the pages of a DescribeOptionGroups operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DescribeOptionGroupsPages(input *DescribeOptionGroupsInput, fn func(p *DescribeOptionGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeOptionGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeOptionGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeOptionGroups *aws.Operation
 
 // DescribeOrderableDBInstanceOptionsRequest generates a request for the DescribeOrderableDBInstanceOptions operation.
@@ -1312,6 +1917,12 @@ func (c *RDS) DescribeOrderableDBInstanceOptionsRequest(input *DescribeOrderable
 			Name:       "DescribeOrderableDBInstanceOptions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1333,6 +1944,27 @@ func (c *RDS) DescribeOrderableDBInstanceOptions(input *DescribeOrderableDBInsta
 	return
 }
 
+// DescribeOrderableDBInstanceOptionsWithContext is the same as DescribeOrderableDBInstanceOptions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeOrderableDBInstanceOptionsWithContext(ctx context.Context, input *DescribeOrderableDBInstanceOptionsInput) (output *DescribeOrderableDBInstanceOptionsOutput, err error) {
+	req, out := c.DescribeOrderableDBInstanceOptionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeOrderableDBInstanceOptionsPages iterates over the pages of a DescribeOrderableDBInstanceOptions operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DescribeOrderableDBInstanceOptionsPages(input *DescribeOrderableDBInstanceOptionsInput, fn func(p *DescribeOrderableDBInstanceOptionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeOrderableDBInstanceOptionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeOrderableDBInstanceOptionsOutput), lastPage)
+	})
+}
+
 var opDescribeOrderableDBInstanceOptions *aws.Operation
 
 // DescribePendingMaintenanceActionsRequest generates a request for the DescribePendingMaintenanceActions operation.
@@ -1367,6 +1999,17 @@ func (c *RDS) DescribePendingMaintenanceActions(input *DescribePendingMaintenanc
 	return
 }
 
+// DescribePendingMaintenanceActionsWithContext is the same as DescribePendingMaintenanceActions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribePendingMaintenanceActionsWithContext(ctx context.Context, input *DescribePendingMaintenanceActionsInput) (output *DescribePendingMaintenanceActionsOutput, err error) {
+	req, out := c.DescribePendingMaintenanceActionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribePendingMaintenanceActions *aws.Operation
 
 // DescribeReservedDBInstancesRequest generates a request for the DescribeReservedDBInstances operation.
@@ -1379,6 +2022,12 @@ func (c *RDS) DescribeReservedDBInstancesRequest(input *DescribeReservedDBInstan
 			Name:       "DescribeReservedDBInstances",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1401,6 +2050,27 @@ func (c *RDS) DescribeReservedDBInstances(input *DescribeReservedDBInstancesInpu
 	return
 }
 
+// DescribeReservedDBInstancesWithContext is the same as DescribeReservedDBInstances with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeReservedDBInstancesWithContext(ctx context.Context, input *DescribeReservedDBInstancesInput) (output *DescribeReservedDBInstancesOutput, err error) {
+	req, out := c.DescribeReservedDBInstancesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeReservedDBInstancesPages iterates over the pages of a DescribeReservedDBInstances operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DescribeReservedDBInstancesPages(input *DescribeReservedDBInstancesInput, fn func(p *DescribeReservedDBInstancesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReservedDBInstancesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReservedDBInstancesOutput), lastPage)
+	})
+}
+
 var opDescribeReservedDBInstances *aws.Operation
 
 // DescribeReservedDBInstancesOfferingsRequest generates a request for the DescribeReservedDBInstancesOfferings operation.
@@ -1413,6 +2083,12 @@ func (c *RDS) DescribeReservedDBInstancesOfferingsRequest(input *DescribeReserve
 			Name:       "DescribeReservedDBInstancesOfferings",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1434,6 +2110,27 @@ func (c *RDS) DescribeReservedDBInstancesOfferings(input *DescribeReservedDBInst
 	return
 }
 
+// DescribeReservedDBInstancesOfferingsWithContext is the same as DescribeReservedDBInstancesOfferings with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DescribeReservedDBInstancesOfferingsWithContext(ctx context.Context, input *DescribeReservedDBInstancesOfferingsInput) (output *DescribeReservedDBInstancesOfferingsOutput, err error) {
+	req, out := c.DescribeReservedDBInstancesOfferingsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeReservedDBInstancesOfferingsPages iterates over the pages of a DescribeReservedDBInstancesOfferings operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DescribeReservedDBInstancesOfferingsPages(input *DescribeReservedDBInstancesOfferingsInput, fn func(p *DescribeReservedDBInstancesOfferingsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReservedDBInstancesOfferingsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReservedDBInstancesOfferingsOutput), lastPage)
+	})
+}
+
 var opDescribeReservedDBInstancesOfferings *aws.Operation
 
 // DownloadDBLogFilePortionRequest generates a request for the DownloadDBLogFilePortion operation.
@@ -1446,6 +2143,12 @@ func (c *RDS) DownloadDBLogFilePortionRequest(input *DownloadDBLogFilePortionInp
 			Name:       "DownloadDBLogFilePortion",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "NumberOfLines",
+				TruncationToken: "AdditionalDataPending",
+			},
 		}
 	}
 
@@ -1467,6 +2170,27 @@ func (c *RDS) DownloadDBLogFilePortion(input *DownloadDBLogFilePortionInput) (ou
 	return
 }
 
+// DownloadDBLogFilePortionWithContext is the same as DownloadDBLogFilePortion with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) DownloadDBLogFilePortionWithContext(ctx context.Context, input *DownloadDBLogFilePortionInput) (output *DownloadDBLogFilePortionOutput, err error) {
+	req, out := c.DownloadDBLogFilePortionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DownloadDBLogFilePortionPages iterates over the pages of a DownloadDBLogFilePortion operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *RDS) DownloadDBLogFilePortionPages(input *DownloadDBLogFilePortionInput, fn func(p *DownloadDBLogFilePortionOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DownloadDBLogFilePortionRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DownloadDBLogFilePortionOutput), lastPage)
+	})
+}
+
 var opDownloadDBLogFilePortion *aws.Operation
 
 // ListTagsForResourceRequest generates a request for the ListTagsForResource operation.
@@ -1503,6 +2227,17 @@ func (c *RDS) ListTagsForResource(input *ListTagsForResourceInput) (output *List
 	return
 }
 
+// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) ListTagsForResourceWithContext(ctx context.Context, input *ListTagsForResourceInput) (output *ListTagsForResourceOutput, err error) {
+	req, out := c.ListTagsForResourceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListTagsForResource *aws.Operation
 
 // ModifyDBInstanceRequest generates a request for the ModifyDBInstance operation.
@@ -1537,6 +2272,17 @@ func (c *RDS) ModifyDBInstance(input *ModifyDBInstanceInput) (output *ModifyDBIn
 	return
 }
 
+// ModifyDBInstanceWithContext is the same as ModifyDBInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) ModifyDBInstanceWithContext(ctx context.Context, input *ModifyDBInstanceInput) (output *ModifyDBInstanceOutput, err error) {
+	req, out := c.ModifyDBInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyDBInstance *aws.Operation
 
 // ModifyDBParameterGroupRequest generates a request for the ModifyDBParameterGroup operation.
@@ -1587,6 +2333,17 @@ func (c *RDS) ModifyDBParameterGroup(input *ModifyDBParameterGroupInput) (output
 	return
 }
 
+// ModifyDBParameterGroupWithContext is the same as ModifyDBParameterGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) ModifyDBParameterGroupWithContext(ctx context.Context, input *ModifyDBParameterGroupInput) (output *DBParameterGroupNameMessage, err error) {
+	req, out := c.ModifyDBParameterGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyDBParameterGroup *aws.Operation
 
 // ModifyDBSubnetGroupRequest generates a request for the ModifyDBSubnetGroup operation.
@@ -1621,6 +2378,17 @@ func (c *RDS) ModifyDBSubnetGroup(input *ModifyDBSubnetGroupInput) (output *Modi
 	return
 }
 
+// ModifyDBSubnetGroupWithContext is the same as ModifyDBSubnetGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) ModifyDBSubnetGroupWithContext(ctx context.Context, input *ModifyDBSubnetGroupInput) (output *ModifyDBSubnetGroupOutput, err error) {
+	req, out := c.ModifyDBSubnetGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyDBSubnetGroup *aws.Operation
 
 // ModifyEventSubscriptionRequest generates a request for the ModifyEventSubscription operation.
@@ -1662,6 +2430,17 @@ func (c *RDS) ModifyEventSubscription(input *ModifyEventSubscriptionInput) (outp
 	return
 }
 
+// ModifyEventSubscriptionWithContext is the same as ModifyEventSubscription with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) ModifyEventSubscriptionWithContext(ctx context.Context, input *ModifyEventSubscriptionInput) (output *ModifyEventSubscriptionOutput, err error) {
+	req, out := c.ModifyEventSubscriptionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyEventSubscription *aws.Operation
 
 // ModifyOptionGroupRequest generates a request for the ModifyOptionGroup operation.
@@ -1695,6 +2474,17 @@ func (c *RDS) ModifyOptionGroup(input *ModifyOptionGroupInput) (output *ModifyOp
 	return
 }
 
+// ModifyOptionGroupWithContext is the same as ModifyOptionGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) ModifyOptionGroupWithContext(ctx context.Context, input *ModifyOptionGroupInput) (output *ModifyOptionGroupOutput, err error) {
+	req, out := c.ModifyOptionGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyOptionGroup *aws.Operation
 
 // PromoteReadReplicaRequest generates a request for the PromoteReadReplica operation.
@@ -1733,6 +2523,17 @@ func (c *RDS) PromoteReadReplica(input *PromoteReadReplicaInput) (output *Promot
 	return
 }
 
+// PromoteReadReplicaWithContext is the same as PromoteReadReplica with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) PromoteReadReplicaWithContext(ctx context.Context, input *PromoteReadReplicaInput) (output *PromoteReadReplicaOutput, err error) {
+	req, out := c.PromoteReadReplicaRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPromoteReadReplica *aws.Operation
 
 // PurchaseReservedDBInstancesOfferingRequest generates a request for the PurchaseReservedDBInstancesOffering operation.
@@ -1766,6 +2567,17 @@ func (c *RDS) PurchaseReservedDBInstancesOffering(input *PurchaseReservedDBInsta
 	return
 }
 
+// PurchaseReservedDBInstancesOfferingWithContext is the same as PurchaseReservedDBInstancesOffering with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) PurchaseReservedDBInstancesOfferingWithContext(ctx context.Context, input *PurchaseReservedDBInstancesOfferingInput) (output *PurchaseReservedDBInstancesOfferingOutput, err error) {
+	req, out := c.PurchaseReservedDBInstancesOfferingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPurchaseReservedDBInstancesOffering *aws.Operation
 
 // RebootDBInstanceRequest generates a request for the RebootDBInstance operation.
@@ -1815,6 +2627,17 @@ func (c *RDS) RebootDBInstance(input *RebootDBInstanceInput) (output *RebootDBIn
 	return
 }
 
+// RebootDBInstanceWithContext is the same as RebootDBInstance with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) RebootDBInstanceWithContext(ctx context.Context, input *RebootDBInstanceInput) (output *RebootDBInstanceOutput, err error) {
+	req, out := c.RebootDBInstanceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRebootDBInstance *aws.Operation
 
 // RemoveSourceIdentifierFromSubscriptionRequest generates a request for the RemoveSourceIdentifierFromSubscription operation.
@@ -1848,6 +2671,17 @@ func (c *RDS) RemoveSourceIdentifierFromSubscription(input *RemoveSourceIdentifi
 	return
 }
 
+// RemoveSourceIdentifierFromSubscriptionWithContext is the same as RemoveSourceIdentifierFromSubscription with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) RemoveSourceIdentifierFromSubscriptionWithContext(ctx context.Context, input *RemoveSourceIdentifierFromSubscriptionInput) (output *RemoveSourceIdentifierFromSubscriptionOutput, err error) {
+	req, out := c.RemoveSourceIdentifierFromSubscriptionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRemoveSourceIdentifierFromSubscription *aws.Operation
 
 // RemoveTagsFromResourceRequest generates a request for the RemoveTagsFromResource operation.
@@ -1884,6 +2718,17 @@ func (c *RDS) RemoveTagsFromResource(input *RemoveTagsFromResourceInput) (output
 	return
 }
 
+// RemoveTagsFromResourceWithContext is the same as RemoveTagsFromResource with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) RemoveTagsFromResourceWithContext(ctx context.Context, input *RemoveTagsFromResourceInput) (output *RemoveTagsFromResourceOutput, err error) {
+	req, out := c.RemoveTagsFromResourceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRemoveTagsFromResource *aws.Operation
 
 // ResetDBParameterGroupRequest generates a request for the ResetDBParameterGroup operation.
@@ -1923,6 +2768,17 @@ func (c *RDS) ResetDBParameterGroup(input *ResetDBParameterGroupInput) (output *
 	return
 }
 
+// ResetDBParameterGroupWithContext is the same as ResetDBParameterGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) ResetDBParameterGroupWithContext(ctx context.Context, input *ResetDBParameterGroupInput) (output *DBParameterGroupNameMessage, err error) {
+	req, out := c.ResetDBParameterGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opResetDBParameterGroup *aws.Operation
 
 // RestoreDBInstanceFromDBSnapshotRequest generates a request for the RestoreDBInstanceFromDBSnapshot operation.
@@ -1968,6 +2824,17 @@ func (c *RDS) RestoreDBInstanceFromDBSnapshot(input *RestoreDBInstanceFromDBSnap
 	return
 }
 
+// RestoreDBInstanceFromDBSnapshotWithContext is the same as RestoreDBInstanceFromDBSnapshot with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) RestoreDBInstanceFromDBSnapshotWithContext(ctx context.Context, input *RestoreDBInstanceFromDBSnapshotInput) (output *RestoreDBInstanceFromDBSnapshotOutput, err error) {
+	req, out := c.RestoreDBInstanceFromDBSnapshotRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRestoreDBInstanceFromDBSnapshot *aws.Operation
 
 // RestoreDBInstanceToPointInTimeRequest generates a request for the RestoreDBInstanceToPointInTime operation.
@@ -2005,6 +2872,17 @@ func (c *RDS) RestoreDBInstanceToPointInTime(input *RestoreDBInstanceToPointInTi
 	return
 }
 
+// RestoreDBInstanceToPointInTimeWithContext is the same as RestoreDBInstanceToPointInTime with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) RestoreDBInstanceToPointInTimeWithContext(ctx context.Context, input *RestoreDBInstanceToPointInTimeInput) (output *RestoreDBInstanceToPointInTimeOutput, err error) {
+	req, out := c.RestoreDBInstanceToPointInTimeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRestoreDBInstanceToPointInTime *aws.Operation
 
 // RevokeDBSecurityGroupIngressRequest generates a request for the RevokeDBSecurityGroupIngress operation.
@@ -2041,6 +2919,17 @@ func (c *RDS) RevokeDBSecurityGroupIngress(input *RevokeDBSecurityGroupIngressIn
 	return
 }
 
+// RevokeDBSecurityGroupIngressWithContext is the same as RevokeDBSecurityGroupIngress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *RDS) RevokeDBSecurityGroupIngressWithContext(ctx context.Context, input *RevokeDBSecurityGroupIngressInput) (output *RevokeDBSecurityGroupIngressOutput, err error) {
+	req, out := c.RevokeDBSecurityGroupIngressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRevokeDBSecurityGroupIngress *aws.Operation
 
 type AddSourceIdentifierToSubscriptionInput struct {
@@ -4389,7 +5278,7 @@ type DescribeEventsInput struct {
 
 	// The event source to retrieve events for. If no value is specified, all events
 	// are returned.
-	SourceType *string `type:"string"`
+	SourceType *string `type:"string" enum:"db-instance,db-parameter-group,db-security-group,db-snapshot"`
 
 	// The beginning of the time interval to retrieve events for, specified in ISO
 	// 8601 format. For more information about ISO 8601, go to the ISO8601 Wikipedia
@@ -4929,7 +5818,7 @@ type Event struct {
 	SourceIdentifier *string `type:"string"`
 
 	// Specifies the source type for this event.
-	SourceType *string `type:"string"`
+	SourceType *string `type:"string" enum:"db-instance,db-parameter-group,db-security-group,db-snapshot"`
 
 	metadataEvent `json:"-", xml:"-"`
 }
@@ -5822,7 +6711,7 @@ type Parameter struct {
 	AllowedValues *string `type:"string"`
 
 	// Indicates when to apply parameter updates.
-	ApplyMethod *string `type:"string"`
+	ApplyMethod *string `type:"string" enum:"immediate,pending-reboot"`
 
 	// Specifies the engine specific parameters type.
 	ApplyType *string `type:"string"`
@@ -6708,4 +7597,36 @@ type VPCSecurityGroupMembership struct {
 
 type metadataVPCSecurityGroupMembership struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for ApplyMethod.
+const (
+	ApplyMethodImmediate     = "immediate"
+	ApplyMethodPendingReboot = "pending-reboot"
+)
+
+// ApplyMethodValues returns the valid values of ApplyMethod.
+func ApplyMethodValues() []string {
+	return []string{
+		ApplyMethodImmediate,
+		ApplyMethodPendingReboot,
+	}
+}
+
+// Enum values for SourceType.
+const (
+	SourceTypeDBInstance       = "db-instance"
+	SourceTypeDBParameterGroup = "db-parameter-group"
+	SourceTypeDBSecurityGroup  = "db-security-group"
+	SourceTypeDBSnapshot       = "db-snapshot"
+)
+
+// SourceTypeValues returns the valid values of SourceType.
+func SourceTypeValues() []string {
+	return []string{
+		SourceTypeDBInstance,
+		SourceTypeDBParameterGroup,
+		SourceTypeDBSecurityGroup,
+		SourceTypeDBSnapshot,
+	}
 }
\ No newline at end of file
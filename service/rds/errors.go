@@ -0,0 +1,440 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package rds
+
+import (
+	"github.com/datacratic/aws-sdk-go/aws"
+)
+
+// Error codes of the RDS errors. Errors with these codes are
+// returned as the typed error of the same name, e.g. an error with the code
+// ErrCodeFoo is a *Foo.
+const (
+	ErrCodeAuthorizationAlreadyExistsFault          = "AuthorizationAlreadyExists"
+	ErrCodeAuthorizationNotFoundFault               = "AuthorizationNotFound"
+	ErrCodeAuthorizationQuotaExceededFault          = "AuthorizationQuotaExceeded"
+	ErrCodeDBInstanceAlreadyExistsFault             = "DBInstanceAlreadyExists"
+	ErrCodeDBInstanceNotFoundFault                  = "DBInstanceNotFound"
+	ErrCodeDBParameterGroupAlreadyExistsFault       = "DBParameterGroupAlreadyExists"
+	ErrCodeDBParameterGroupNotFoundFault            = "DBParameterGroupNotFound"
+	ErrCodeDBParameterGroupQuotaExceededFault       = "DBParameterGroupQuotaExceeded"
+	ErrCodeDBSecurityGroupAlreadyExistsFault        = "DBSecurityGroupAlreadyExists"
+	ErrCodeDBSecurityGroupNotFoundFault             = "DBSecurityGroupNotFound"
+	ErrCodeDBSecurityGroupNotSupportedFault         = "DBSecurityGroupNotSupported"
+	ErrCodeDBSecurityGroupQuotaExceededFault        = "QuotaExceeded.DBSecurityGroup"
+	ErrCodeDBSnapshotAlreadyExistsFault             = "DBSnapshotAlreadyExists"
+	ErrCodeDBSnapshotNotFoundFault                  = "DBSnapshotNotFound"
+	ErrCodeDBSubnetGroupAlreadyExistsFault          = "DBSubnetGroupAlreadyExists"
+	ErrCodeDBSubnetGroupDoesNotCoverEnoughAZs       = "DBSubnetGroupDoesNotCoverEnoughAZs"
+	ErrCodeDBSubnetGroupNotAllowedFault             = "DBSubnetGroupNotAllowedFault"
+	ErrCodeDBSubnetGroupNotFoundFault               = "DBSubnetGroupNotFoundFault"
+	ErrCodeDBSubnetGroupQuotaExceededFault          = "DBSubnetGroupQuotaExceeded"
+	ErrCodeDBSubnetQuotaExceededFault               = "DBSubnetQuotaExceededFault"
+	ErrCodeDBUpgradeDependencyFailureFault          = "DBUpgradeDependencyFailure"
+	ErrCodeEventSubscriptionQuotaExceededFault      = "EventSubscriptionQuotaExceeded"
+	ErrCodeInstanceQuotaExceededFault               = "InstanceQuotaExceeded"
+	ErrCodeInsufficientDBInstanceCapacityFault      = "InsufficientDBInstanceCapacity"
+	ErrCodeInvalidDBInstanceStateFault              = "InvalidDBInstanceState"
+	ErrCodeInvalidDBParameterGroupStateFault        = "InvalidDBParameterGroupState"
+	ErrCodeInvalidDBSecurityGroupStateFault         = "InvalidDBSecurityGroupState"
+	ErrCodeInvalidDBSnapshotStateFault              = "InvalidDBSnapshotState"
+	ErrCodeInvalidDBSubnetGroupFault                = "InvalidDBSubnetGroupFault"
+	ErrCodeInvalidDBSubnetGroupStateFault           = "InvalidDBSubnetGroupStateFault"
+	ErrCodeInvalidDBSubnetStateFault                = "InvalidDBSubnetStateFault"
+	ErrCodeInvalidEventSubscriptionStateFault       = "InvalidEventSubscriptionState"
+	ErrCodeInvalidOptionGroupStateFault             = "InvalidOptionGroupStateFault"
+	ErrCodeInvalidRestoreFault                      = "InvalidRestoreFault"
+	ErrCodeInvalidSubnet                            = "InvalidSubnet"
+	ErrCodeInvalidVPCNetworkStateFault              = "InvalidVPCNetworkStateFault"
+	ErrCodeKMSKeyNotAccessibleFault                 = "KMSKeyNotAccessibleFault"
+	ErrCodeOptionGroupAlreadyExistsFault            = "OptionGroupAlreadyExistsFault"
+	ErrCodeOptionGroupNotFoundFault                 = "OptionGroupNotFoundFault"
+	ErrCodeOptionGroupQuotaExceededFault            = "OptionGroupQuotaExceededFault"
+	ErrCodePointInTimeRestoreNotEnabledFault        = "PointInTimeRestoreNotEnabled"
+	ErrCodeProvisionedIOPSNotAvailableInAZFault     = "ProvisionedIopsNotAvailableInAZFault"
+	ErrCodeReservedDBInstanceAlreadyExistsFault     = "ReservedDBInstanceAlreadyExists"
+	ErrCodeReservedDBInstanceNotFoundFault          = "ReservedDBInstanceNotFound"
+	ErrCodeReservedDBInstanceQuotaExceededFault     = "ReservedDBInstanceQuotaExceeded"
+	ErrCodeReservedDBInstancesOfferingNotFoundFault = "ReservedDBInstancesOfferingNotFound"
+	ErrCodeResourceNotFoundFault                    = "ResourceNotFoundFault"
+	ErrCodeSNSInvalidTopicFault                     = "SNSInvalidTopic"
+	ErrCodeSNSNoAuthorizationFault                  = "SNSNoAuthorization"
+	ErrCodeSNSTopicARNNotFoundFault                 = "SNSTopicArnNotFound"
+	ErrCodeSnapshotQuotaExceededFault               = "SnapshotQuotaExceeded"
+	ErrCodeSourceNotFoundFault                      = "SourceNotFound"
+	ErrCodeStorageQuotaExceededFault                = "StorageQuotaExceeded"
+	ErrCodeStorageTypeNotSupportedFault             = "StorageTypeNotSupported"
+	ErrCodeSubnetAlreadyInUse                       = "SubnetAlreadyInUse"
+	ErrCodeSubscriptionAlreadyExistFault            = "SubscriptionAlreadyExist"
+	ErrCodeSubscriptionCategoryNotFoundFault        = "SubscriptionCategoryNotFound"
+	ErrCodeSubscriptionNotFoundFault                = "SubscriptionNotFound"
+)
+
+// The specified CIDRIP or EC2 security group is already authorized for the
+// specified DB security group.
+type AuthorizationAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// Specified CIDRIP or EC2 security group is not authorized for the specified
+// DB security group.
+//
+// RDS may not also be authorized via IAM to perform necessary actions on your
+// behalf.
+type AuthorizationNotFoundFault struct {
+	aws.APIError
+}
+
+// DB security group authorization quota has been reached.
+type AuthorizationQuotaExceededFault struct {
+	aws.APIError
+}
+
+// User already has a DB instance with the given identifier.
+type DBInstanceAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// DBInstanceIdentifier does not refer to an existing DB instance.
+type DBInstanceNotFoundFault struct {
+	aws.APIError
+}
+
+// A DB parameter group with the same name exists.
+type DBParameterGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// DBParameterGroupName does not refer to an existing DB parameter group.
+type DBParameterGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// Request would result in user exceeding the allowed number of DB parameter
+// groups.
+type DBParameterGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// A DB security group with the name specified in DBSecurityGroupName already
+// exists.
+type DBSecurityGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// DBSecurityGroupName does not refer to an existing DB security group.
+type DBSecurityGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// A DB security group is not allowed for this action.
+type DBSecurityGroupNotSupportedFault struct {
+	aws.APIError
+}
+
+// Request would result in user exceeding the allowed number of DB security
+// groups.
+type DBSecurityGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// DBSnapshotIdentifier is already used by an existing snapshot.
+type DBSnapshotAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// DBSnapshotIdentifier does not refer to an existing DB snapshot.
+type DBSnapshotNotFoundFault struct {
+	aws.APIError
+}
+
+// DBSubnetGroupName is already used by an existing DB subnet group.
+type DBSubnetGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// Subnets in the DB subnet group should cover at least two Availability Zones
+// unless there is only one Availability Zone.
+type DBSubnetGroupDoesNotCoverEnoughAZs struct {
+	aws.APIError
+}
+
+// Indicates that the DBSubnetGroup should not be specified while creating read
+// replicas that lie in the same region as the source instance.
+type DBSubnetGroupNotAllowedFault struct {
+	aws.APIError
+}
+
+// DBSubnetGroupName does not refer to an existing DB subnet group.
+type DBSubnetGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// Request would result in user exceeding the allowed number of DB subnet groups.
+type DBSubnetGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// Request would result in user exceeding the allowed number of subnets in a
+// DB subnet groups.
+type DBSubnetQuotaExceededFault struct {
+	aws.APIError
+}
+
+// The DB upgrade failed because a resource the DB depends on could not be modified.
+type DBUpgradeDependencyFailureFault struct {
+	aws.APIError
+}
+
+// You have reached the maximum number of event subscriptions.
+type EventSubscriptionQuotaExceededFault struct {
+	aws.APIError
+}
+
+// Request would result in user exceeding the allowed number of DB instances.
+type InstanceQuotaExceededFault struct {
+	aws.APIError
+}
+
+// Specified DB instance class is not available in the specified Availability
+// Zone.
+type InsufficientDBInstanceCapacityFault struct {
+	aws.APIError
+}
+
+// The specified DB instance is not in the available state.
+type InvalidDBInstanceStateFault struct {
+	aws.APIError
+}
+
+// The DB parameter group cannot be deleted because it is in use.
+type InvalidDBParameterGroupStateFault struct {
+	aws.APIError
+}
+
+// The state of the DB security group does not allow deletion.
+type InvalidDBSecurityGroupStateFault struct {
+	aws.APIError
+}
+
+// The state of the DB snapshot does not allow deletion.
+type InvalidDBSnapshotStateFault struct {
+	aws.APIError
+}
+
+// Indicates the DBSubnetGroup does not belong to the same VPC as that of an
+// existing cross region read replica of the same source instance.
+type InvalidDBSubnetGroupFault struct {
+	aws.APIError
+}
+
+// The DB subnet group cannot be deleted because it is in use.
+type InvalidDBSubnetGroupStateFault struct {
+	aws.APIError
+}
+
+// The DB subnet is not in the available state.
+type InvalidDBSubnetStateFault struct {
+	aws.APIError
+}
+
+// This error can occur if someone else is modifying a subscription. You should
+// retry the action.
+type InvalidEventSubscriptionStateFault struct {
+	aws.APIError
+}
+
+// The option group is not in the available state.
+type InvalidOptionGroupStateFault struct {
+	aws.APIError
+}
+
+// Cannot restore from vpc backup to non-vpc DB instance.
+type InvalidRestoreFault struct {
+	aws.APIError
+}
+
+// The requested subnet is invalid, or multiple subnets were requested that
+// are not all in a common VPC.
+type InvalidSubnet struct {
+	aws.APIError
+}
+
+// DB subnet group does not cover all Availability Zones after it is created
+// because users' change.
+type InvalidVPCNetworkStateFault struct {
+	aws.APIError
+}
+
+// Error accessing KMS key.
+type KMSKeyNotAccessibleFault struct {
+	aws.APIError
+}
+
+// The option group you are trying to create already exists.
+type OptionGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// The specified option group could not be found.
+type OptionGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// The quota of 20 option groups was exceeded for this AWS account.
+type OptionGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// SourceDBInstanceIdentifier refers to a DB instance with BackupRetentionPeriod
+// equal to 0.
+type PointInTimeRestoreNotEnabledFault struct {
+	aws.APIError
+}
+
+// Provisioned IOPS not available in the specified Availability Zone.
+type ProvisionedIOPSNotAvailableInAZFault struct {
+	aws.APIError
+}
+
+// User already has a reservation with the given identifier.
+type ReservedDBInstanceAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// The specified reserved DB Instance not found.
+type ReservedDBInstanceNotFoundFault struct {
+	aws.APIError
+}
+
+// Request would exceed the user's DB Instance quota.
+type ReservedDBInstanceQuotaExceededFault struct {
+	aws.APIError
+}
+
+// Specified offering does not exist.
+type ReservedDBInstancesOfferingNotFoundFault struct {
+	aws.APIError
+}
+
+// The specified Resource ID was not found.
+type ResourceNotFoundFault struct {
+	aws.APIError
+}
+
+// SNS has responded that there is a problem with the SND topic specified.
+type SNSInvalidTopicFault struct {
+	aws.APIError
+}
+
+// You do not have permission to publish to the SNS topic ARN.
+type SNSNoAuthorizationFault struct {
+	aws.APIError
+}
+
+// The SNS topic ARN does not exist.
+type SNSTopicARNNotFoundFault struct {
+	aws.APIError
+}
+
+// Request would result in user exceeding the allowed number of DB snapshots.
+type SnapshotQuotaExceededFault struct {
+	aws.APIError
+}
+
+// The requested source could not be found.
+type SourceNotFoundFault struct {
+	aws.APIError
+}
+
+// Request would result in user exceeding the allowed amount of storage available
+// across all DB instances.
+type StorageQuotaExceededFault struct {
+	aws.APIError
+}
+
+// StorageType specified cannot be associated with the DB Instance.
+type StorageTypeNotSupportedFault struct {
+	aws.APIError
+}
+
+// The DB subnet is already in use in the Availability Zone.
+type SubnetAlreadyInUse struct {
+	aws.APIError
+}
+
+// The supplied subscription name already exists.
+type SubscriptionAlreadyExistFault struct {
+	aws.APIError
+}
+
+// The supplied category does not exist.
+type SubscriptionCategoryNotFoundFault struct {
+	aws.APIError
+}
+
+// The subscription name does not exist.
+type SubscriptionNotFoundFault struct {
+	aws.APIError
+}
+
+var errorTypes = map[string]func() aws.ServiceError{
+	ErrCodeAuthorizationAlreadyExistsFault:          func() aws.ServiceError { return &AuthorizationAlreadyExistsFault{} },
+	ErrCodeAuthorizationNotFoundFault:               func() aws.ServiceError { return &AuthorizationNotFoundFault{} },
+	ErrCodeAuthorizationQuotaExceededFault:          func() aws.ServiceError { return &AuthorizationQuotaExceededFault{} },
+	ErrCodeDBInstanceAlreadyExistsFault:             func() aws.ServiceError { return &DBInstanceAlreadyExistsFault{} },
+	ErrCodeDBInstanceNotFoundFault:                  func() aws.ServiceError { return &DBInstanceNotFoundFault{} },
+	ErrCodeDBParameterGroupAlreadyExistsFault:       func() aws.ServiceError { return &DBParameterGroupAlreadyExistsFault{} },
+	ErrCodeDBParameterGroupNotFoundFault:            func() aws.ServiceError { return &DBParameterGroupNotFoundFault{} },
+	ErrCodeDBParameterGroupQuotaExceededFault:       func() aws.ServiceError { return &DBParameterGroupQuotaExceededFault{} },
+	ErrCodeDBSecurityGroupAlreadyExistsFault:        func() aws.ServiceError { return &DBSecurityGroupAlreadyExistsFault{} },
+	ErrCodeDBSecurityGroupNotFoundFault:             func() aws.ServiceError { return &DBSecurityGroupNotFoundFault{} },
+	ErrCodeDBSecurityGroupNotSupportedFault:         func() aws.ServiceError { return &DBSecurityGroupNotSupportedFault{} },
+	ErrCodeDBSecurityGroupQuotaExceededFault:        func() aws.ServiceError { return &DBSecurityGroupQuotaExceededFault{} },
+	ErrCodeDBSnapshotAlreadyExistsFault:             func() aws.ServiceError { return &DBSnapshotAlreadyExistsFault{} },
+	ErrCodeDBSnapshotNotFoundFault:                  func() aws.ServiceError { return &DBSnapshotNotFoundFault{} },
+	ErrCodeDBSubnetGroupAlreadyExistsFault:          func() aws.ServiceError { return &DBSubnetGroupAlreadyExistsFault{} },
+	ErrCodeDBSubnetGroupDoesNotCoverEnoughAZs:       func() aws.ServiceError { return &DBSubnetGroupDoesNotCoverEnoughAZs{} },
+	ErrCodeDBSubnetGroupNotAllowedFault:             func() aws.ServiceError { return &DBSubnetGroupNotAllowedFault{} },
+	ErrCodeDBSubnetGroupNotFoundFault:               func() aws.ServiceError { return &DBSubnetGroupNotFoundFault{} },
+	ErrCodeDBSubnetGroupQuotaExceededFault:          func() aws.ServiceError { return &DBSubnetGroupQuotaExceededFault{} },
+	ErrCodeDBSubnetQuotaExceededFault:               func() aws.ServiceError { return &DBSubnetQuotaExceededFault{} },
+	ErrCodeDBUpgradeDependencyFailureFault:          func() aws.ServiceError { return &DBUpgradeDependencyFailureFault{} },
+	ErrCodeEventSubscriptionQuotaExceededFault:      func() aws.ServiceError { return &EventSubscriptionQuotaExceededFault{} },
+	ErrCodeInstanceQuotaExceededFault:               func() aws.ServiceError { return &InstanceQuotaExceededFault{} },
+	ErrCodeInsufficientDBInstanceCapacityFault:      func() aws.ServiceError { return &InsufficientDBInstanceCapacityFault{} },
+	ErrCodeInvalidDBInstanceStateFault:              func() aws.ServiceError { return &InvalidDBInstanceStateFault{} },
+	ErrCodeInvalidDBParameterGroupStateFault:        func() aws.ServiceError { return &InvalidDBParameterGroupStateFault{} },
+	ErrCodeInvalidDBSecurityGroupStateFault:         func() aws.ServiceError { return &InvalidDBSecurityGroupStateFault{} },
+	ErrCodeInvalidDBSnapshotStateFault:              func() aws.ServiceError { return &InvalidDBSnapshotStateFault{} },
+	ErrCodeInvalidDBSubnetGroupFault:                func() aws.ServiceError { return &InvalidDBSubnetGroupFault{} },
+	ErrCodeInvalidDBSubnetGroupStateFault:           func() aws.ServiceError { return &InvalidDBSubnetGroupStateFault{} },
+	ErrCodeInvalidDBSubnetStateFault:                func() aws.ServiceError { return &InvalidDBSubnetStateFault{} },
+	ErrCodeInvalidEventSubscriptionStateFault:       func() aws.ServiceError { return &InvalidEventSubscriptionStateFault{} },
+	ErrCodeInvalidOptionGroupStateFault:             func() aws.ServiceError { return &InvalidOptionGroupStateFault{} },
+	ErrCodeInvalidRestoreFault:                      func() aws.ServiceError { return &InvalidRestoreFault{} },
+	ErrCodeInvalidSubnet:                            func() aws.ServiceError { return &InvalidSubnet{} },
+	ErrCodeInvalidVPCNetworkStateFault:              func() aws.ServiceError { return &InvalidVPCNetworkStateFault{} },
+	ErrCodeKMSKeyNotAccessibleFault:                 func() aws.ServiceError { return &KMSKeyNotAccessibleFault{} },
+	ErrCodeOptionGroupAlreadyExistsFault:            func() aws.ServiceError { return &OptionGroupAlreadyExistsFault{} },
+	ErrCodeOptionGroupNotFoundFault:                 func() aws.ServiceError { return &OptionGroupNotFoundFault{} },
+	ErrCodeOptionGroupQuotaExceededFault:            func() aws.ServiceError { return &OptionGroupQuotaExceededFault{} },
+	ErrCodePointInTimeRestoreNotEnabledFault:        func() aws.ServiceError { return &PointInTimeRestoreNotEnabledFault{} },
+	ErrCodeProvisionedIOPSNotAvailableInAZFault:     func() aws.ServiceError { return &ProvisionedIOPSNotAvailableInAZFault{} },
+	ErrCodeReservedDBInstanceAlreadyExistsFault:     func() aws.ServiceError { return &ReservedDBInstanceAlreadyExistsFault{} },
+	ErrCodeReservedDBInstanceNotFoundFault:          func() aws.ServiceError { return &ReservedDBInstanceNotFoundFault{} },
+	ErrCodeReservedDBInstanceQuotaExceededFault:     func() aws.ServiceError { return &ReservedDBInstanceQuotaExceededFault{} },
+	ErrCodeReservedDBInstancesOfferingNotFoundFault: func() aws.ServiceError { return &ReservedDBInstancesOfferingNotFoundFault{} },
+	ErrCodeResourceNotFoundFault:                    func() aws.ServiceError { return &ResourceNotFoundFault{} },
+	ErrCodeSNSInvalidTopicFault:                     func() aws.ServiceError { return &SNSInvalidTopicFault{} },
+	ErrCodeSNSNoAuthorizationFault:                  func() aws.ServiceError { return &SNSNoAuthorizationFault{} },
+	ErrCodeSNSTopicARNNotFoundFault:                 func() aws.ServiceError { return &SNSTopicARNNotFoundFault{} },
+	ErrCodeSnapshotQuotaExceededFault:               func() aws.ServiceError { return &SnapshotQuotaExceededFault{} },
+	ErrCodeSourceNotFoundFault:                      func() aws.ServiceError { return &SourceNotFoundFault{} },
+	ErrCodeStorageQuotaExceededFault:                func() aws.ServiceError { return &StorageQuotaExceededFault{} },
+	ErrCodeStorageTypeNotSupportedFault:             func() aws.ServiceError { return &StorageTypeNotSupportedFault{} },
+	ErrCodeSubnetAlreadyInUse:                       func() aws.ServiceError { return &SubnetAlreadyInUse{} },
+	ErrCodeSubscriptionAlreadyExistFault:            func() aws.ServiceError { return &SubscriptionAlreadyExistFault{} },
+	ErrCodeSubscriptionCategoryNotFoundFault:        func() aws.ServiceError { return &SubscriptionCategoryNotFoundFault{} },
+	ErrCodeSubscriptionNotFoundFault:                func() aws.ServiceError { return &SubscriptionNotFoundFault{} },
+}
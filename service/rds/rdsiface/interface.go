@@ -1,119 +1,254 @@
 package rdsiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/rds"
 )
 
 type RDSAPI interface {
 	AddSourceIdentifierToSubscription(*rds.AddSourceIdentifierToSubscriptionInput) (*rds.AddSourceIdentifierToSubscriptionOutput, error)
 
+	AddSourceIdentifierToSubscriptionWithContext(context.Context, *rds.AddSourceIdentifierToSubscriptionInput) (*rds.AddSourceIdentifierToSubscriptionOutput, error)
+
 	AddTagsToResource(*rds.AddTagsToResourceInput) (*rds.AddTagsToResourceOutput, error)
 
+	AddTagsToResourceWithContext(context.Context, *rds.AddTagsToResourceInput) (*rds.AddTagsToResourceOutput, error)
+
 	ApplyPendingMaintenanceAction(*rds.ApplyPendingMaintenanceActionInput) (*rds.ApplyPendingMaintenanceActionOutput, error)
 
+	ApplyPendingMaintenanceActionWithContext(context.Context, *rds.ApplyPendingMaintenanceActionInput) (*rds.ApplyPendingMaintenanceActionOutput, error)
+
 	AuthorizeDBSecurityGroupIngress(*rds.AuthorizeDBSecurityGroupIngressInput) (*rds.AuthorizeDBSecurityGroupIngressOutput, error)
 
+	AuthorizeDBSecurityGroupIngressWithContext(context.Context, *rds.AuthorizeDBSecurityGroupIngressInput) (*rds.AuthorizeDBSecurityGroupIngressOutput, error)
+
 	CopyDBParameterGroup(*rds.CopyDBParameterGroupInput) (*rds.CopyDBParameterGroupOutput, error)
 
+	CopyDBParameterGroupWithContext(context.Context, *rds.CopyDBParameterGroupInput) (*rds.CopyDBParameterGroupOutput, error)
+
 	CopyDBSnapshot(*rds.CopyDBSnapshotInput) (*rds.CopyDBSnapshotOutput, error)
 
+	CopyDBSnapshotWithContext(context.Context, *rds.CopyDBSnapshotInput) (*rds.CopyDBSnapshotOutput, error)
+
 	CopyOptionGroup(*rds.CopyOptionGroupInput) (*rds.CopyOptionGroupOutput, error)
 
+	CopyOptionGroupWithContext(context.Context, *rds.CopyOptionGroupInput) (*rds.CopyOptionGroupOutput, error)
+
 	CreateDBInstance(*rds.CreateDBInstanceInput) (*rds.CreateDBInstanceOutput, error)
 
+	CreateDBInstanceWithContext(context.Context, *rds.CreateDBInstanceInput) (*rds.CreateDBInstanceOutput, error)
+
 	CreateDBInstanceReadReplica(*rds.CreateDBInstanceReadReplicaInput) (*rds.CreateDBInstanceReadReplicaOutput, error)
 
+	CreateDBInstanceReadReplicaWithContext(context.Context, *rds.CreateDBInstanceReadReplicaInput) (*rds.CreateDBInstanceReadReplicaOutput, error)
+
 	CreateDBParameterGroup(*rds.CreateDBParameterGroupInput) (*rds.CreateDBParameterGroupOutput, error)
 
+	CreateDBParameterGroupWithContext(context.Context, *rds.CreateDBParameterGroupInput) (*rds.CreateDBParameterGroupOutput, error)
+
 	CreateDBSecurityGroup(*rds.CreateDBSecurityGroupInput) (*rds.CreateDBSecurityGroupOutput, error)
 
+	CreateDBSecurityGroupWithContext(context.Context, *rds.CreateDBSecurityGroupInput) (*rds.CreateDBSecurityGroupOutput, error)
+
 	CreateDBSnapshot(*rds.CreateDBSnapshotInput) (*rds.CreateDBSnapshotOutput, error)
 
+	CreateDBSnapshotWithContext(context.Context, *rds.CreateDBSnapshotInput) (*rds.CreateDBSnapshotOutput, error)
+
 	CreateDBSubnetGroup(*rds.CreateDBSubnetGroupInput) (*rds.CreateDBSubnetGroupOutput, error)
 
+	CreateDBSubnetGroupWithContext(context.Context, *rds.CreateDBSubnetGroupInput) (*rds.CreateDBSubnetGroupOutput, error)
+
 	CreateEventSubscription(*rds.CreateEventSubscriptionInput) (*rds.CreateEventSubscriptionOutput, error)
 
+	CreateEventSubscriptionWithContext(context.Context, *rds.CreateEventSubscriptionInput) (*rds.CreateEventSubscriptionOutput, error)
+
 	CreateOptionGroup(*rds.CreateOptionGroupInput) (*rds.CreateOptionGroupOutput, error)
 
+	CreateOptionGroupWithContext(context.Context, *rds.CreateOptionGroupInput) (*rds.CreateOptionGroupOutput, error)
+
 	DeleteDBInstance(*rds.DeleteDBInstanceInput) (*rds.DeleteDBInstanceOutput, error)
 
+	DeleteDBInstanceWithContext(context.Context, *rds.DeleteDBInstanceInput) (*rds.DeleteDBInstanceOutput, error)
+
 	DeleteDBParameterGroup(*rds.DeleteDBParameterGroupInput) (*rds.DeleteDBParameterGroupOutput, error)
 
+	DeleteDBParameterGroupWithContext(context.Context, *rds.DeleteDBParameterGroupInput) (*rds.DeleteDBParameterGroupOutput, error)
+
 	DeleteDBSecurityGroup(*rds.DeleteDBSecurityGroupInput) (*rds.DeleteDBSecurityGroupOutput, error)
 
+	DeleteDBSecurityGroupWithContext(context.Context, *rds.DeleteDBSecurityGroupInput) (*rds.DeleteDBSecurityGroupOutput, error)
+
 	DeleteDBSnapshot(*rds.DeleteDBSnapshotInput) (*rds.DeleteDBSnapshotOutput, error)
 
+	DeleteDBSnapshotWithContext(context.Context, *rds.DeleteDBSnapshotInput) (*rds.DeleteDBSnapshotOutput, error)
+
 	DeleteDBSubnetGroup(*rds.DeleteDBSubnetGroupInput) (*rds.DeleteDBSubnetGroupOutput, error)
 
+	DeleteDBSubnetGroupWithContext(context.Context, *rds.DeleteDBSubnetGroupInput) (*rds.DeleteDBSubnetGroupOutput, error)
+
 	DeleteEventSubscription(*rds.DeleteEventSubscriptionInput) (*rds.DeleteEventSubscriptionOutput, error)
 
+	DeleteEventSubscriptionWithContext(context.Context, *rds.DeleteEventSubscriptionInput) (*rds.DeleteEventSubscriptionOutput, error)
+
 	DeleteOptionGroup(*rds.DeleteOptionGroupInput) (*rds.DeleteOptionGroupOutput, error)
 
+	DeleteOptionGroupWithContext(context.Context, *rds.DeleteOptionGroupInput) (*rds.DeleteOptionGroupOutput, error)
+
 	DescribeDBEngineVersions(*rds.DescribeDBEngineVersionsInput) (*rds.DescribeDBEngineVersionsOutput, error)
 
+	DescribeDBEngineVersionsWithContext(context.Context, *rds.DescribeDBEngineVersionsInput) (*rds.DescribeDBEngineVersionsOutput, error)
+	DescribeDBEngineVersionsPages(*rds.DescribeDBEngineVersionsInput, func(*rds.DescribeDBEngineVersionsOutput, bool) bool) error
+
 	DescribeDBInstances(*rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error)
 
+	DescribeDBInstancesWithContext(context.Context, *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error)
+	DescribeDBInstancesPages(*rds.DescribeDBInstancesInput, func(*rds.DescribeDBInstancesOutput, bool) bool) error
+
 	DescribeDBLogFiles(*rds.DescribeDBLogFilesInput) (*rds.DescribeDBLogFilesOutput, error)
 
+	DescribeDBLogFilesWithContext(context.Context, *rds.DescribeDBLogFilesInput) (*rds.DescribeDBLogFilesOutput, error)
+	DescribeDBLogFilesPages(*rds.DescribeDBLogFilesInput, func(*rds.DescribeDBLogFilesOutput, bool) bool) error
+
 	DescribeDBParameterGroups(*rds.DescribeDBParameterGroupsInput) (*rds.DescribeDBParameterGroupsOutput, error)
 
+	DescribeDBParameterGroupsWithContext(context.Context, *rds.DescribeDBParameterGroupsInput) (*rds.DescribeDBParameterGroupsOutput, error)
+	DescribeDBParameterGroupsPages(*rds.DescribeDBParameterGroupsInput, func(*rds.DescribeDBParameterGroupsOutput, bool) bool) error
+
 	DescribeDBParameters(*rds.DescribeDBParametersInput) (*rds.DescribeDBParametersOutput, error)
 
+	DescribeDBParametersWithContext(context.Context, *rds.DescribeDBParametersInput) (*rds.DescribeDBParametersOutput, error)
+	DescribeDBParametersPages(*rds.DescribeDBParametersInput, func(*rds.DescribeDBParametersOutput, bool) bool) error
+
 	DescribeDBSecurityGroups(*rds.DescribeDBSecurityGroupsInput) (*rds.DescribeDBSecurityGroupsOutput, error)
 
+	DescribeDBSecurityGroupsWithContext(context.Context, *rds.DescribeDBSecurityGroupsInput) (*rds.DescribeDBSecurityGroupsOutput, error)
+	DescribeDBSecurityGroupsPages(*rds.DescribeDBSecurityGroupsInput, func(*rds.DescribeDBSecurityGroupsOutput, bool) bool) error
+
 	DescribeDBSnapshots(*rds.DescribeDBSnapshotsInput) (*rds.DescribeDBSnapshotsOutput, error)
 
+	DescribeDBSnapshotsWithContext(context.Context, *rds.DescribeDBSnapshotsInput) (*rds.DescribeDBSnapshotsOutput, error)
+	DescribeDBSnapshotsPages(*rds.DescribeDBSnapshotsInput, func(*rds.DescribeDBSnapshotsOutput, bool) bool) error
+
 	DescribeDBSubnetGroups(*rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error)
 
+	DescribeDBSubnetGroupsWithContext(context.Context, *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error)
+	DescribeDBSubnetGroupsPages(*rds.DescribeDBSubnetGroupsInput, func(*rds.DescribeDBSubnetGroupsOutput, bool) bool) error
+
 	DescribeEngineDefaultParameters(*rds.DescribeEngineDefaultParametersInput) (*rds.DescribeEngineDefaultParametersOutput, error)
 
+	DescribeEngineDefaultParametersWithContext(context.Context, *rds.DescribeEngineDefaultParametersInput) (*rds.DescribeEngineDefaultParametersOutput, error)
+	DescribeEngineDefaultParametersPages(*rds.DescribeEngineDefaultParametersInput, func(*rds.DescribeEngineDefaultParametersOutput, bool) bool) error
+
 	DescribeEventCategories(*rds.DescribeEventCategoriesInput) (*rds.DescribeEventCategoriesOutput, error)
 
+	DescribeEventCategoriesWithContext(context.Context, *rds.DescribeEventCategoriesInput) (*rds.DescribeEventCategoriesOutput, error)
+
 	DescribeEventSubscriptions(*rds.DescribeEventSubscriptionsInput) (*rds.DescribeEventSubscriptionsOutput, error)
 
+	DescribeEventSubscriptionsWithContext(context.Context, *rds.DescribeEventSubscriptionsInput) (*rds.DescribeEventSubscriptionsOutput, error)
+	DescribeEventSubscriptionsPages(*rds.DescribeEventSubscriptionsInput, func(*rds.DescribeEventSubscriptionsOutput, bool) bool) error
+
 	DescribeEvents(*rds.DescribeEventsInput) (*rds.DescribeEventsOutput, error)
 
+	DescribeEventsWithContext(context.Context, *rds.DescribeEventsInput) (*rds.DescribeEventsOutput, error)
+	DescribeEventsPages(*rds.DescribeEventsInput, func(*rds.DescribeEventsOutput, bool) bool) error
+
 	DescribeOptionGroupOptions(*rds.DescribeOptionGroupOptionsInput) (*rds.DescribeOptionGroupOptionsOutput, error)
 
+	DescribeOptionGroupOptionsWithContext(context.Context, *rds.DescribeOptionGroupOptionsInput) (*rds.DescribeOptionGroupOptionsOutput, error)
+	DescribeOptionGroupOptionsPages(*rds.DescribeOptionGroupOptionsInput, func(*rds.DescribeOptionGroupOptionsOutput, bool) bool) error
+
 	DescribeOptionGroups(*rds.DescribeOptionGroupsInput) (*rds.DescribeOptionGroupsOutput, error)
 
+	DescribeOptionGroupsWithContext(context.Context, *rds.DescribeOptionGroupsInput) (*rds.DescribeOptionGroupsOutput, error)
+	DescribeOptionGroupsPages(*rds.DescribeOptionGroupsInput, func(*rds.DescribeOptionGroupsOutput, bool) bool) error
+
 	DescribeOrderableDBInstanceOptions(*rds.DescribeOrderableDBInstanceOptionsInput) (*rds.DescribeOrderableDBInstanceOptionsOutput, error)
 
+	DescribeOrderableDBInstanceOptionsWithContext(context.Context, *rds.DescribeOrderableDBInstanceOptionsInput) (*rds.DescribeOrderableDBInstanceOptionsOutput, error)
+	DescribeOrderableDBInstanceOptionsPages(*rds.DescribeOrderableDBInstanceOptionsInput, func(*rds.DescribeOrderableDBInstanceOptionsOutput, bool) bool) error
+
 	DescribePendingMaintenanceActions(*rds.DescribePendingMaintenanceActionsInput) (*rds.DescribePendingMaintenanceActionsOutput, error)
 
+	DescribePendingMaintenanceActionsWithContext(context.Context, *rds.DescribePendingMaintenanceActionsInput) (*rds.DescribePendingMaintenanceActionsOutput, error)
+
 	DescribeReservedDBInstances(*rds.DescribeReservedDBInstancesInput) (*rds.DescribeReservedDBInstancesOutput, error)
 
+	DescribeReservedDBInstancesWithContext(context.Context, *rds.DescribeReservedDBInstancesInput) (*rds.DescribeReservedDBInstancesOutput, error)
+	DescribeReservedDBInstancesPages(*rds.DescribeReservedDBInstancesInput, func(*rds.DescribeReservedDBInstancesOutput, bool) bool) error
+
 	DescribeReservedDBInstancesOfferings(*rds.DescribeReservedDBInstancesOfferingsInput) (*rds.DescribeReservedDBInstancesOfferingsOutput, error)
 
+	DescribeReservedDBInstancesOfferingsWithContext(context.Context, *rds.DescribeReservedDBInstancesOfferingsInput) (*rds.DescribeReservedDBInstancesOfferingsOutput, error)
+	DescribeReservedDBInstancesOfferingsPages(*rds.DescribeReservedDBInstancesOfferingsInput, func(*rds.DescribeReservedDBInstancesOfferingsOutput, bool) bool) error
+
 	DownloadDBLogFilePortion(*rds.DownloadDBLogFilePortionInput) (*rds.DownloadDBLogFilePortionOutput, error)
 
+	DownloadDBLogFilePortionWithContext(context.Context, *rds.DownloadDBLogFilePortionInput) (*rds.DownloadDBLogFilePortionOutput, error)
+	DownloadDBLogFilePortionPages(*rds.DownloadDBLogFilePortionInput, func(*rds.DownloadDBLogFilePortionOutput, bool) bool) error
+
 	ListTagsForResource(*rds.ListTagsForResourceInput) (*rds.ListTagsForResourceOutput, error)
 
+	ListTagsForResourceWithContext(context.Context, *rds.ListTagsForResourceInput) (*rds.ListTagsForResourceOutput, error)
+
 	ModifyDBInstance(*rds.ModifyDBInstanceInput) (*rds.ModifyDBInstanceOutput, error)
 
+	ModifyDBInstanceWithContext(context.Context, *rds.ModifyDBInstanceInput) (*rds.ModifyDBInstanceOutput, error)
+
 	ModifyDBParameterGroup(*rds.ModifyDBParameterGroupInput) (*rds.DBParameterGroupNameMessage, error)
 
+	ModifyDBParameterGroupWithContext(context.Context, *rds.ModifyDBParameterGroupInput) (*rds.DBParameterGroupNameMessage, error)
+
 	ModifyDBSubnetGroup(*rds.ModifyDBSubnetGroupInput) (*rds.ModifyDBSubnetGroupOutput, error)
 
+	ModifyDBSubnetGroupWithContext(context.Context, *rds.ModifyDBSubnetGroupInput) (*rds.ModifyDBSubnetGroupOutput, error)
+
 	ModifyEventSubscription(*rds.ModifyEventSubscriptionInput) (*rds.ModifyEventSubscriptionOutput, error)
 
+	ModifyEventSubscriptionWithContext(context.Context, *rds.ModifyEventSubscriptionInput) (*rds.ModifyEventSubscriptionOutput, error)
+
 	ModifyOptionGroup(*rds.ModifyOptionGroupInput) (*rds.ModifyOptionGroupOutput, error)
 
+	ModifyOptionGroupWithContext(context.Context, *rds.ModifyOptionGroupInput) (*rds.ModifyOptionGroupOutput, error)
+
 	PromoteReadReplica(*rds.PromoteReadReplicaInput) (*rds.PromoteReadReplicaOutput, error)
 
+	PromoteReadReplicaWithContext(context.Context, *rds.PromoteReadReplicaInput) (*rds.PromoteReadReplicaOutput, error)
+
 	PurchaseReservedDBInstancesOffering(*rds.PurchaseReservedDBInstancesOfferingInput) (*rds.PurchaseReservedDBInstancesOfferingOutput, error)
 
+	PurchaseReservedDBInstancesOfferingWithContext(context.Context, *rds.PurchaseReservedDBInstancesOfferingInput) (*rds.PurchaseReservedDBInstancesOfferingOutput, error)
+
 	RebootDBInstance(*rds.RebootDBInstanceInput) (*rds.RebootDBInstanceOutput, error)
 
+	RebootDBInstanceWithContext(context.Context, *rds.RebootDBInstanceInput) (*rds.RebootDBInstanceOutput, error)
+
 	RemoveSourceIdentifierFromSubscription(*rds.RemoveSourceIdentifierFromSubscriptionInput) (*rds.RemoveSourceIdentifierFromSubscriptionOutput, error)
 
+	RemoveSourceIdentifierFromSubscriptionWithContext(context.Context, *rds.RemoveSourceIdentifierFromSubscriptionInput) (*rds.RemoveSourceIdentifierFromSubscriptionOutput, error)
+
 	RemoveTagsFromResource(*rds.RemoveTagsFromResourceInput) (*rds.RemoveTagsFromResourceOutput, error)
 
+	RemoveTagsFromResourceWithContext(context.Context, *rds.RemoveTagsFromResourceInput) (*rds.RemoveTagsFromResourceOutput, error)
+
 	ResetDBParameterGroup(*rds.ResetDBParameterGroupInput) (*rds.DBParameterGroupNameMessage, error)
 
+	ResetDBParameterGroupWithContext(context.Context, *rds.ResetDBParameterGroupInput) (*rds.DBParameterGroupNameMessage, error)
+
 	RestoreDBInstanceFromDBSnapshot(*rds.RestoreDBInstanceFromDBSnapshotInput) (*rds.RestoreDBInstanceFromDBSnapshotOutput, error)
 
+	RestoreDBInstanceFromDBSnapshotWithContext(context.Context, *rds.RestoreDBInstanceFromDBSnapshotInput) (*rds.RestoreDBInstanceFromDBSnapshotOutput, error)
+
 	RestoreDBInstanceToPointInTime(*rds.RestoreDBInstanceToPointInTimeInput) (*rds.RestoreDBInstanceToPointInTimeOutput, error)
 
+	RestoreDBInstanceToPointInTimeWithContext(context.Context, *rds.RestoreDBInstanceToPointInTimeInput) (*rds.RestoreDBInstanceToPointInTimeOutput, error)
+
 	RevokeDBSecurityGroupIngress(*rds.RevokeDBSecurityGroupIngressInput) (*rds.RevokeDBSecurityGroupIngressOutput, error)
+
+	RevokeDBSecurityGroupIngressWithContext(context.Context, *rds.RevokeDBSecurityGroupIngressInput) (*rds.RevokeDBSecurityGroupIngressOutput, error)
+
+	WaitUntilDBInstanceAvailable(*rds.DescribeDBInstancesInput) error
+
+	WaitUntilDBInstanceDeleted(*rds.DescribeDBInstancesInput) error
 }
\ No newline at end of file
@@ -27,6 +27,7 @@ func New(config *aws.Config) *RDS {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "rds",
 		APIVersion:  "2014-10-31",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package redshift
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -57,6 +58,17 @@ func (c *Redshift) AuthorizeClusterSecurityGroupIngress(input *AuthorizeClusterS
 	return
 }
 
+// AuthorizeClusterSecurityGroupIngressWithContext is the same as AuthorizeClusterSecurityGroupIngress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) AuthorizeClusterSecurityGroupIngressWithContext(ctx context.Context, input *AuthorizeClusterSecurityGroupIngressInput) (output *AuthorizeClusterSecurityGroupIngressOutput, err error) {
+	req, out := c.AuthorizeClusterSecurityGroupIngressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAuthorizeClusterSecurityGroupIngress *aws.Operation
 
 // AuthorizeSnapshotAccessRequest generates a request for the AuthorizeSnapshotAccess operation.
@@ -94,6 +106,17 @@ func (c *Redshift) AuthorizeSnapshotAccess(input *AuthorizeSnapshotAccessInput)
 	return
 }
 
+// AuthorizeSnapshotAccessWithContext is the same as AuthorizeSnapshotAccess with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) AuthorizeSnapshotAccessWithContext(ctx context.Context, input *AuthorizeSnapshotAccessInput) (output *AuthorizeSnapshotAccessOutput, err error) {
+	req, out := c.AuthorizeSnapshotAccessRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAuthorizeSnapshotAccess *aws.Operation
 
 // CopyClusterSnapshotRequest generates a request for the CopyClusterSnapshot operation.
@@ -139,6 +162,17 @@ func (c *Redshift) CopyClusterSnapshot(input *CopyClusterSnapshotInput) (output
 	return
 }
 
+// CopyClusterSnapshotWithContext is the same as CopyClusterSnapshot with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) CopyClusterSnapshotWithContext(ctx context.Context, input *CopyClusterSnapshotInput) (output *CopyClusterSnapshotOutput, err error) {
+	req, out := c.CopyClusterSnapshotRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCopyClusterSnapshot *aws.Operation
 
 // CreateClusterRequest generates a request for the CreateCluster operation.
@@ -178,6 +212,17 @@ func (c *Redshift) CreateCluster(input *CreateClusterInput) (output *CreateClust
 	return
 }
 
+// CreateClusterWithContext is the same as CreateCluster with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) CreateClusterWithContext(ctx context.Context, input *CreateClusterInput) (output *CreateClusterOutput, err error) {
+	req, out := c.CreateClusterRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateCluster *aws.Operation
 
 // CreateClusterParameterGroupRequest generates a request for the CreateClusterParameterGroup operation.
@@ -221,6 +266,17 @@ func (c *Redshift) CreateClusterParameterGroup(input *CreateClusterParameterGrou
 	return
 }
 
+// CreateClusterParameterGroupWithContext is the same as CreateClusterParameterGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) CreateClusterParameterGroupWithContext(ctx context.Context, input *CreateClusterParameterGroupInput) (output *CreateClusterParameterGroupOutput, err error) {
+	req, out := c.CreateClusterParameterGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateClusterParameterGroup *aws.Operation
 
 // CreateClusterSecurityGroupRequest generates a request for the CreateClusterSecurityGroup operation.
@@ -259,6 +315,17 @@ func (c *Redshift) CreateClusterSecurityGroup(input *CreateClusterSecurityGroupI
 	return
 }
 
+// CreateClusterSecurityGroupWithContext is the same as CreateClusterSecurityGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) CreateClusterSecurityGroupWithContext(ctx context.Context, input *CreateClusterSecurityGroupInput) (output *CreateClusterSecurityGroupOutput, err error) {
+	req, out := c.CreateClusterSecurityGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateClusterSecurityGroup *aws.Operation
 
 // CreateClusterSnapshotRequest generates a request for the CreateClusterSnapshot operation.
@@ -297,6 +364,17 @@ func (c *Redshift) CreateClusterSnapshot(input *CreateClusterSnapshotInput) (out
 	return
 }
 
+// CreateClusterSnapshotWithContext is the same as CreateClusterSnapshot with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) CreateClusterSnapshotWithContext(ctx context.Context, input *CreateClusterSnapshotInput) (output *CreateClusterSnapshotOutput, err error) {
+	req, out := c.CreateClusterSnapshotRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateClusterSnapshot *aws.Operation
 
 // CreateClusterSubnetGroupRequest generates a request for the CreateClusterSubnetGroup operation.
@@ -336,6 +414,17 @@ func (c *Redshift) CreateClusterSubnetGroup(input *CreateClusterSubnetGroupInput
 	return
 }
 
+// CreateClusterSubnetGroupWithContext is the same as CreateClusterSubnetGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) CreateClusterSubnetGroupWithContext(ctx context.Context, input *CreateClusterSubnetGroupInput) (output *CreateClusterSubnetGroupOutput, err error) {
+	req, out := c.CreateClusterSubnetGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateClusterSubnetGroup *aws.Operation
 
 // CreateEventSubscriptionRequest generates a request for the CreateEventSubscription operation.
@@ -390,6 +479,17 @@ func (c *Redshift) CreateEventSubscription(input *CreateEventSubscriptionInput)
 	return
 }
 
+// CreateEventSubscriptionWithContext is the same as CreateEventSubscription with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) CreateEventSubscriptionWithContext(ctx context.Context, input *CreateEventSubscriptionInput) (output *CreateEventSubscriptionOutput, err error) {
+	req, out := c.CreateEventSubscriptionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateEventSubscription *aws.Operation
 
 // CreateHSMClientCertificateRequest generates a request for the CreateHSMClientCertificate operation.
@@ -431,6 +531,17 @@ func (c *Redshift) CreateHSMClientCertificate(input *CreateHSMClientCertificateI
 	return
 }
 
+// CreateHSMClientCertificateWithContext is the same as CreateHSMClientCertificate with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) CreateHSMClientCertificateWithContext(ctx context.Context, input *CreateHSMClientCertificateInput) (output *CreateHSMClientCertificateOutput, err error) {
+	req, out := c.CreateHSMClientCertificateRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateHSMClientCertificate *aws.Operation
 
 // CreateHSMConfigurationRequest generates a request for the CreateHSMConfiguration operation.
@@ -473,6 +584,17 @@ func (c *Redshift) CreateHSMConfiguration(input *CreateHSMConfigurationInput) (o
 	return
 }
 
+// CreateHSMConfigurationWithContext is the same as CreateHSMConfiguration with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) CreateHSMConfigurationWithContext(ctx context.Context, input *CreateHSMConfigurationInput) (output *CreateHSMConfigurationOutput, err error) {
+	req, out := c.CreateHSMConfigurationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateHSMConfiguration *aws.Operation
 
 // CreateTagsRequest generates a request for the CreateTags operation.
@@ -512,6 +634,17 @@ func (c *Redshift) CreateTags(input *CreateTagsInput) (output *CreateTagsOutput,
 	return
 }
 
+// CreateTagsWithContext is the same as CreateTags with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) CreateTagsWithContext(ctx context.Context, input *CreateTagsInput) (output *CreateTagsOutput, err error) {
+	req, out := c.CreateTagsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateTags *aws.Operation
 
 // DeleteClusterRequest generates a request for the DeleteCluster operation.
@@ -561,6 +694,17 @@ func (c *Redshift) DeleteCluster(input *DeleteClusterInput) (output *DeleteClust
 	return
 }
 
+// DeleteClusterWithContext is the same as DeleteCluster with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DeleteClusterWithContext(ctx context.Context, input *DeleteClusterInput) (output *DeleteClusterOutput, err error) {
+	req, out := c.DeleteClusterRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteCluster *aws.Operation
 
 // DeleteClusterParameterGroupRequest generates a request for the DeleteClusterParameterGroup operation.
@@ -595,6 +739,17 @@ func (c *Redshift) DeleteClusterParameterGroup(input *DeleteClusterParameterGrou
 	return
 }
 
+// DeleteClusterParameterGroupWithContext is the same as DeleteClusterParameterGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DeleteClusterParameterGroupWithContext(ctx context.Context, input *DeleteClusterParameterGroupInput) (output *DeleteClusterParameterGroupOutput, err error) {
+	req, out := c.DeleteClusterParameterGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteClusterParameterGroup *aws.Operation
 
 // DeleteClusterSecurityGroupRequest generates a request for the DeleteClusterSecurityGroup operation.
@@ -633,6 +788,17 @@ func (c *Redshift) DeleteClusterSecurityGroup(input *DeleteClusterSecurityGroupI
 	return
 }
 
+// DeleteClusterSecurityGroupWithContext is the same as DeleteClusterSecurityGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DeleteClusterSecurityGroupWithContext(ctx context.Context, input *DeleteClusterSecurityGroupInput) (output *DeleteClusterSecurityGroupOutput, err error) {
+	req, out := c.DeleteClusterSecurityGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteClusterSecurityGroup *aws.Operation
 
 // DeleteClusterSnapshotRequest generates a request for the DeleteClusterSnapshot operation.
@@ -673,6 +839,17 @@ func (c *Redshift) DeleteClusterSnapshot(input *DeleteClusterSnapshotInput) (out
 	return
 }
 
+// DeleteClusterSnapshotWithContext is the same as DeleteClusterSnapshot with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DeleteClusterSnapshotWithContext(ctx context.Context, input *DeleteClusterSnapshotInput) (output *DeleteClusterSnapshotOutput, err error) {
+	req, out := c.DeleteClusterSnapshotRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteClusterSnapshot *aws.Operation
 
 // DeleteClusterSubnetGroupRequest generates a request for the DeleteClusterSubnetGroup operation.
@@ -706,6 +883,17 @@ func (c *Redshift) DeleteClusterSubnetGroup(input *DeleteClusterSubnetGroupInput
 	return
 }
 
+// DeleteClusterSubnetGroupWithContext is the same as DeleteClusterSubnetGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DeleteClusterSubnetGroupWithContext(ctx context.Context, input *DeleteClusterSubnetGroupInput) (output *DeleteClusterSubnetGroupOutput, err error) {
+	req, out := c.DeleteClusterSubnetGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteClusterSubnetGroup *aws.Operation
 
 // DeleteEventSubscriptionRequest generates a request for the DeleteEventSubscription operation.
@@ -739,6 +927,17 @@ func (c *Redshift) DeleteEventSubscription(input *DeleteEventSubscriptionInput)
 	return
 }
 
+// DeleteEventSubscriptionWithContext is the same as DeleteEventSubscription with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DeleteEventSubscriptionWithContext(ctx context.Context, input *DeleteEventSubscriptionInput) (output *DeleteEventSubscriptionOutput, err error) {
+	req, out := c.DeleteEventSubscriptionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteEventSubscription *aws.Operation
 
 // DeleteHSMClientCertificateRequest generates a request for the DeleteHSMClientCertificate operation.
@@ -772,6 +971,17 @@ func (c *Redshift) DeleteHSMClientCertificate(input *DeleteHSMClientCertificateI
 	return
 }
 
+// DeleteHSMClientCertificateWithContext is the same as DeleteHSMClientCertificate with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DeleteHSMClientCertificateWithContext(ctx context.Context, input *DeleteHSMClientCertificateInput) (output *DeleteHSMClientCertificateOutput, err error) {
+	req, out := c.DeleteHSMClientCertificateRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteHSMClientCertificate *aws.Operation
 
 // DeleteHSMConfigurationRequest generates a request for the DeleteHSMConfiguration operation.
@@ -805,6 +1015,17 @@ func (c *Redshift) DeleteHSMConfiguration(input *DeleteHSMConfigurationInput) (o
 	return
 }
 
+// DeleteHSMConfigurationWithContext is the same as DeleteHSMConfiguration with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DeleteHSMConfigurationWithContext(ctx context.Context, input *DeleteHSMConfigurationInput) (output *DeleteHSMConfigurationOutput, err error) {
+	req, out := c.DeleteHSMConfigurationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteHSMConfiguration *aws.Operation
 
 // DeleteTagsRequest generates a request for the DeleteTags operation.
@@ -839,6 +1060,17 @@ func (c *Redshift) DeleteTags(input *DeleteTagsInput) (output *DeleteTagsOutput,
 	return
 }
 
+// DeleteTagsWithContext is the same as DeleteTags with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DeleteTagsWithContext(ctx context.Context, input *DeleteTagsInput) (output *DeleteTagsOutput, err error) {
+	req, out := c.DeleteTagsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteTags *aws.Operation
 
 // DescribeClusterParameterGroupsRequest generates a request for the DescribeClusterParameterGroups operation.
@@ -851,6 +1083,12 @@ func (c *Redshift) DescribeClusterParameterGroupsRequest(input *DescribeClusterP
 			Name:       "DescribeClusterParameterGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -890,6 +1128,27 @@ func (c *Redshift) DescribeClusterParameterGroups(input *DescribeClusterParamete
 	return
 }
 
+// DescribeClusterParameterGroupsWithContext is the same as DescribeClusterParameterGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeClusterParameterGroupsWithContext(ctx context.Context, input *DescribeClusterParameterGroupsInput) (output *DescribeClusterParameterGroupsOutput, err error) {
+	req, out := c.DescribeClusterParameterGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeClusterParameterGroupsPages iterates over the pages of a DescribeClusterParameterGroups operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Redshift) DescribeClusterParameterGroupsPages(input *DescribeClusterParameterGroupsInput, fn func(p *DescribeClusterParameterGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeClusterParameterGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeClusterParameterGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeClusterParameterGroups *aws.Operation
 
 // DescribeClusterParametersRequest generates a request for the DescribeClusterParameters operation.
@@ -902,6 +1161,12 @@ func (c *Redshift) DescribeClusterParametersRequest(input *DescribeClusterParame
 			Name:       "DescribeClusterParameters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -934,6 +1199,27 @@ func (c *Redshift) DescribeClusterParameters(input *DescribeClusterParametersInp
 	return
 }
 
+// DescribeClusterParametersWithContext is the same as DescribeClusterParameters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeClusterParametersWithContext(ctx context.Context, input *DescribeClusterParametersInput) (output *DescribeClusterParametersOutput, err error) {
+	req, out := c.DescribeClusterParametersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeClusterParametersPages iterates over the pages of a DescribeClusterParameters operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Redshift) DescribeClusterParametersPages(input *DescribeClusterParametersInput, fn func(p *DescribeClusterParametersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeClusterParametersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeClusterParametersOutput), lastPage)
+	})
+}
+
 var opDescribeClusterParameters *aws.Operation
 
 // DescribeClusterSecurityGroupsRequest generates a request for the DescribeClusterSecurityGroups operation.
@@ -946,6 +1232,12 @@ func (c *Redshift) DescribeClusterSecurityGroupsRequest(input *DescribeClusterSe
 			Name:       "DescribeClusterSecurityGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -983,6 +1275,27 @@ func (c *Redshift) DescribeClusterSecurityGroups(input *DescribeClusterSecurityG
 	return
 }
 
+// DescribeClusterSecurityGroupsWithContext is the same as DescribeClusterSecurityGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeClusterSecurityGroupsWithContext(ctx context.Context, input *DescribeClusterSecurityGroupsInput) (output *DescribeClusterSecurityGroupsOutput, err error) {
+	req, out := c.DescribeClusterSecurityGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeClusterSecurityGroupsPages iterates over the pages of a DescribeClusterSecurityGroups operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Redshift) DescribeClusterSecurityGroupsPages(input *DescribeClusterSecurityGroupsInput, fn func(p *DescribeClusterSecurityGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeClusterSecurityGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeClusterSecurityGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeClusterSecurityGroups *aws.Operation
 
 // DescribeClusterSnapshotsRequest generates a request for the DescribeClusterSnapshots operation.
@@ -995,6 +1308,12 @@ func (c *Redshift) DescribeClusterSnapshotsRequest(input *DescribeClusterSnapsho
 			Name:       "DescribeClusterSnapshots",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1031,6 +1350,27 @@ func (c *Redshift) DescribeClusterSnapshots(input *DescribeClusterSnapshotsInput
 	return
 }
 
+// DescribeClusterSnapshotsWithContext is the same as DescribeClusterSnapshots with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeClusterSnapshotsWithContext(ctx context.Context, input *DescribeClusterSnapshotsInput) (output *DescribeClusterSnapshotsOutput, err error) {
+	req, out := c.DescribeClusterSnapshotsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeClusterSnapshotsPages iterates over the pages of a DescribeClusterSnapshots operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Redshift) DescribeClusterSnapshotsPages(input *DescribeClusterSnapshotsInput, fn func(p *DescribeClusterSnapshotsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeClusterSnapshotsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeClusterSnapshotsOutput), lastPage)
+	})
+}
+
 var opDescribeClusterSnapshots *aws.Operation
 
 // DescribeClusterSubnetGroupsRequest generates a request for the DescribeClusterSubnetGroups operation.
@@ -1043,6 +1383,12 @@ func (c *Redshift) DescribeClusterSubnetGroupsRequest(input *DescribeClusterSubn
 			Name:       "DescribeClusterSubnetGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1076,6 +1422,27 @@ func (c *Redshift) DescribeClusterSubnetGroups(input *DescribeClusterSubnetGroup
 	return
 }
 
+// DescribeClusterSubnetGroupsWithContext is the same as DescribeClusterSubnetGroups with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeClusterSubnetGroupsWithContext(ctx context.Context, input *DescribeClusterSubnetGroupsInput) (output *DescribeClusterSubnetGroupsOutput, err error) {
+	req, out := c.DescribeClusterSubnetGroupsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeClusterSubnetGroupsPages iterates over the pages of a DescribeClusterSubnetGroups operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Redshift) DescribeClusterSubnetGroupsPages(input *DescribeClusterSubnetGroupsInput, fn func(p *DescribeClusterSubnetGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeClusterSubnetGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeClusterSubnetGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeClusterSubnetGroups *aws.Operation
 
 // DescribeClusterVersionsRequest generates a request for the DescribeClusterVersions operation.
@@ -1088,6 +1455,12 @@ func (c *Redshift) DescribeClusterVersionsRequest(input *DescribeClusterVersions
 			Name:       "DescribeClusterVersions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1113,6 +1486,27 @@ func (c *Redshift) DescribeClusterVersions(input *DescribeClusterVersionsInput)
 	return
 }
 
+// DescribeClusterVersionsWithContext is the same as DescribeClusterVersions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeClusterVersionsWithContext(ctx context.Context, input *DescribeClusterVersionsInput) (output *DescribeClusterVersionsOutput, err error) {
+	req, out := c.DescribeClusterVersionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeClusterVersionsPages iterates over the pages of a DescribeClusterVersions operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Redshift) DescribeClusterVersionsPages(input *DescribeClusterVersionsInput, fn func(p *DescribeClusterVersionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeClusterVersionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeClusterVersionsOutput), lastPage)
+	})
+}
+
 var opDescribeClusterVersions *aws.Operation
 
 // DescribeClustersRequest generates a request for the DescribeClusters operation.
@@ -1125,6 +1519,12 @@ func (c *Redshift) DescribeClustersRequest(input *DescribeClustersInput) (req *a
 			Name:       "DescribeClusters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1159,6 +1559,27 @@ func (c *Redshift) DescribeClusters(input *DescribeClustersInput) (output *Descr
 	return
 }
 
+// DescribeClustersWithContext is the same as DescribeClusters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeClustersWithContext(ctx context.Context, input *DescribeClustersInput) (output *DescribeClustersOutput, err error) {
+	req, out := c.DescribeClustersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeClustersPages iterates over the pages of a DescribeClusters operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Redshift) DescribeClustersPages(input *DescribeClustersInput, fn func(p *DescribeClustersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeClustersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeClustersOutput), lastPage)
+	})
+}
+
 var opDescribeClusters *aws.Operation
 
 // DescribeDefaultClusterParametersRequest generates a request for the DescribeDefaultClusterParameters operation.
@@ -1171,6 +1592,12 @@ func (c *Redshift) DescribeDefaultClusterParametersRequest(input *DescribeDefaul
 			Name:       "DescribeDefaultClusterParameters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"DefaultClusterParameters.Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1196,6 +1623,27 @@ func (c *Redshift) DescribeDefaultClusterParameters(input *DescribeDefaultCluste
 	return
 }
 
+// DescribeDefaultClusterParametersWithContext is the same as DescribeDefaultClusterParameters with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeDefaultClusterParametersWithContext(ctx context.Context, input *DescribeDefaultClusterParametersInput) (output *DescribeDefaultClusterParametersOutput, err error) {
+	req, out := c.DescribeDefaultClusterParametersRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeDefaultClusterParametersPages iterates over the pages of a DescribeDefaultClusterParameters operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Redshift) DescribeDefaultClusterParametersPages(input *DescribeDefaultClusterParametersInput, fn func(p *DescribeDefaultClusterParametersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDefaultClusterParametersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDefaultClusterParametersOutput), lastPage)
+	})
+}
+
 var opDescribeDefaultClusterParameters *aws.Operation
 
 // DescribeEventCategoriesRequest generates a request for the DescribeEventCategories operation.
@@ -1231,6 +1679,17 @@ func (c *Redshift) DescribeEventCategories(input *DescribeEventCategoriesInput)
 	return
 }
 
+// DescribeEventCategoriesWithContext is the same as DescribeEventCategories with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeEventCategoriesWithContext(ctx context.Context, input *DescribeEventCategoriesInput) (output *DescribeEventCategoriesOutput, err error) {
+	req, out := c.DescribeEventCategoriesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeEventCategories *aws.Operation
 
 // DescribeEventSubscriptionsRequest generates a request for the DescribeEventSubscriptions operation.
@@ -1243,6 +1702,12 @@ func (c *Redshift) DescribeEventSubscriptionsRequest(input *DescribeEventSubscri
 			Name:       "DescribeEventSubscriptions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1266,6 +1731,27 @@ func (c *Redshift) DescribeEventSubscriptions(input *DescribeEventSubscriptionsI
 	return
 }
 
+// DescribeEventSubscriptionsWithContext is the same as DescribeEventSubscriptions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeEventSubscriptionsWithContext(ctx context.Context, input *DescribeEventSubscriptionsInput) (output *DescribeEventSubscriptionsOutput, err error) {
+	req, out := c.DescribeEventSubscriptionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeEventSubscriptionsPages iterates over the pages of a DescribeEventSubscriptions operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Redshift) DescribeEventSubscriptionsPages(input *DescribeEventSubscriptionsInput, fn func(p *DescribeEventSubscriptionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeEventSubscriptionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEventSubscriptionsOutput), lastPage)
+	})
+}
+
 var opDescribeEventSubscriptions *aws.Operation
 
 // DescribeEventsRequest generates a request for the DescribeEvents operation.
@@ -1278,6 +1764,12 @@ func (c *Redshift) DescribeEventsRequest(input *DescribeEventsInput) (req *aws.R
 			Name:       "DescribeEvents",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1302,6 +1794,27 @@ func (c *Redshift) DescribeEvents(input *DescribeEventsInput) (output *DescribeE
 	return
 }
 
+// DescribeEventsWithContext is the same as DescribeEvents with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeEventsWithContext(ctx context.Context, input *DescribeEventsInput) (output *DescribeEventsOutput, err error) {
+	req, out := c.DescribeEventsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeEventsPages iterates over the pages of a DescribeEvents operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Redshift) DescribeEventsPages(input *DescribeEventsInput, fn func(p *DescribeEventsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeEventsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEventsOutput), lastPage)
+	})
+}
+
 var opDescribeEvents *aws.Operation
 
 // DescribeHSMClientCertificatesRequest generates a request for the DescribeHSMClientCertificates operation.
@@ -1314,6 +1827,12 @@ func (c *Redshift) DescribeHSMClientCertificatesRequest(input *DescribeHSMClient
 			Name:       "DescribeHsmClientCertificates",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1347,6 +1866,27 @@ func (c *Redshift) DescribeHSMClientCertificates(input *DescribeHSMClientCertifi
 	return
 }
 
+// DescribeHSMClientCertificatesWithContext is the same as DescribeHSMClientCertificates with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeHSMClientCertificatesWithContext(ctx context.Context, input *DescribeHSMClientCertificatesInput) (output *DescribeHSMClientCertificatesOutput, err error) {
+	req, out := c.DescribeHSMClientCertificatesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeHSMClientCertificatesPages iterates over the pages of a DescribeHSMClientCertificates operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Redshift) DescribeHSMClientCertificatesPages(input *DescribeHSMClientCertificatesInput, fn func(p *DescribeHSMClientCertificatesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeHSMClientCertificatesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeHSMClientCertificatesOutput), lastPage)
+	})
+}
+
 var opDescribeHSMClientCertificates *aws.Operation
 
 // DescribeHSMConfigurationsRequest generates a request for the DescribeHSMConfigurations operation.
@@ -1359,6 +1899,12 @@ func (c *Redshift) DescribeHSMConfigurationsRequest(input *DescribeHSMConfigurat
 			Name:       "DescribeHsmConfigurations",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1392,6 +1938,27 @@ func (c *Redshift) DescribeHSMConfigurations(input *DescribeHSMConfigurationsInp
 	return
 }
 
+// DescribeHSMConfigurationsWithContext is the same as DescribeHSMConfigurations with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeHSMConfigurationsWithContext(ctx context.Context, input *DescribeHSMConfigurationsInput) (output *DescribeHSMConfigurationsOutput, err error) {
+	req, out := c.DescribeHSMConfigurationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeHSMConfigurationsPages iterates over the pages of a DescribeHSMConfigurations operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Redshift) DescribeHSMConfigurationsPages(input *DescribeHSMConfigurationsInput, fn func(p *DescribeHSMConfigurationsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeHSMConfigurationsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeHSMConfigurationsOutput), lastPage)
+	})
+}
+
 var opDescribeHSMConfigurations *aws.Operation
 
 // DescribeLoggingStatusRequest generates a request for the DescribeLoggingStatus operation.
@@ -1426,6 +1993,17 @@ func (c *Redshift) DescribeLoggingStatus(input *DescribeLoggingStatusInput) (out
 	return
 }
 
+// DescribeLoggingStatusWithContext is the same as DescribeLoggingStatus with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeLoggingStatusWithContext(ctx context.Context, input *DescribeLoggingStatusInput) (output *LoggingStatus, err error) {
+	req, out := c.DescribeLoggingStatusRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeLoggingStatus *aws.Operation
 
 // DescribeOrderableClusterOptionsRequest generates a request for the DescribeOrderableClusterOptions operation.
@@ -1438,6 +2016,12 @@ func (c *Redshift) DescribeOrderableClusterOptionsRequest(input *DescribeOrderab
 			Name:       "DescribeOrderableClusterOptions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1467,6 +2051,27 @@ func (c *Redshift) DescribeOrderableClusterOptions(input *DescribeOrderableClust
 	return
 }
 
+// DescribeOrderableClusterOptionsWithContext is the same as DescribeOrderableClusterOptions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeOrderableClusterOptionsWithContext(ctx context.Context, input *DescribeOrderableClusterOptionsInput) (output *DescribeOrderableClusterOptionsOutput, err error) {
+	req, out := c.DescribeOrderableClusterOptionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeOrderableClusterOptionsPages iterates over the pages of a DescribeOrderableClusterOptions operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Redshift) DescribeOrderableClusterOptionsPages(input *DescribeOrderableClusterOptionsInput, fn func(p *DescribeOrderableClusterOptionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeOrderableClusterOptionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeOrderableClusterOptionsOutput), lastPage)
+	})
+}
+
 var opDescribeOrderableClusterOptions *aws.Operation
 
 // DescribeReservedNodeOfferingsRequest generates a request for the DescribeReservedNodeOfferings operation.
@@ -1479,6 +2084,12 @@ func (c *Redshift) DescribeReservedNodeOfferingsRequest(input *DescribeReservedN
 			Name:       "DescribeReservedNodeOfferings",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1509,6 +2120,27 @@ func (c *Redshift) DescribeReservedNodeOfferings(input *DescribeReservedNodeOffe
 	return
 }
 
+// DescribeReservedNodeOfferingsWithContext is the same as DescribeReservedNodeOfferings with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeReservedNodeOfferingsWithContext(ctx context.Context, input *DescribeReservedNodeOfferingsInput) (output *DescribeReservedNodeOfferingsOutput, err error) {
+	req, out := c.DescribeReservedNodeOfferingsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeReservedNodeOfferingsPages iterates over the pages of a DescribeReservedNodeOfferings operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Redshift) DescribeReservedNodeOfferingsPages(input *DescribeReservedNodeOfferingsInput, fn func(p *DescribeReservedNodeOfferingsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReservedNodeOfferingsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReservedNodeOfferingsOutput), lastPage)
+	})
+}
+
 var opDescribeReservedNodeOfferings *aws.Operation
 
 // DescribeReservedNodesRequest generates a request for the DescribeReservedNodes operation.
@@ -1521,6 +2153,12 @@ func (c *Redshift) DescribeReservedNodesRequest(input *DescribeReservedNodesInpu
 			Name:       "DescribeReservedNodes",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxRecords",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1542,6 +2180,27 @@ func (c *Redshift) DescribeReservedNodes(input *DescribeReservedNodesInput) (out
 	return
 }
 
+// DescribeReservedNodesWithContext is the same as DescribeReservedNodes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeReservedNodesWithContext(ctx context.Context, input *DescribeReservedNodesInput) (output *DescribeReservedNodesOutput, err error) {
+	req, out := c.DescribeReservedNodesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeReservedNodesPages iterates over the pages of a DescribeReservedNodes operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Redshift) DescribeReservedNodesPages(input *DescribeReservedNodesInput, fn func(p *DescribeReservedNodesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReservedNodesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReservedNodesOutput), lastPage)
+	})
+}
+
 var opDescribeReservedNodes *aws.Operation
 
 // DescribeResizeRequest generates a request for the DescribeResize operation.
@@ -1581,6 +2240,17 @@ func (c *Redshift) DescribeResize(input *DescribeResizeInput) (output *DescribeR
 	return
 }
 
+// DescribeResizeWithContext is the same as DescribeResize with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeResizeWithContext(ctx context.Context, input *DescribeResizeInput) (output *DescribeResizeOutput, err error) {
+	req, out := c.DescribeResizeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeResize *aws.Operation
 
 // DescribeTagsRequest generates a request for the DescribeTags operation.
@@ -1632,6 +2302,17 @@ func (c *Redshift) DescribeTags(input *DescribeTagsInput) (output *DescribeTagsO
 	return
 }
 
+// DescribeTagsWithContext is the same as DescribeTags with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DescribeTagsWithContext(ctx context.Context, input *DescribeTagsInput) (output *DescribeTagsOutput, err error) {
+	req, out := c.DescribeTagsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeTags *aws.Operation
 
 // DisableLoggingRequest generates a request for the DisableLogging operation.
@@ -1666,6 +2347,17 @@ func (c *Redshift) DisableLogging(input *DisableLoggingInput) (output *LoggingSt
 	return
 }
 
+// DisableLoggingWithContext is the same as DisableLogging with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DisableLoggingWithContext(ctx context.Context, input *DisableLoggingInput) (output *LoggingStatus, err error) {
+	req, out := c.DisableLoggingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDisableLogging *aws.Operation
 
 // DisableSnapshotCopyRequest generates a request for the DisableSnapshotCopy operation.
@@ -1700,6 +2392,17 @@ func (c *Redshift) DisableSnapshotCopy(input *DisableSnapshotCopyInput) (output
 	return
 }
 
+// DisableSnapshotCopyWithContext is the same as DisableSnapshotCopy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) DisableSnapshotCopyWithContext(ctx context.Context, input *DisableSnapshotCopyInput) (output *DisableSnapshotCopyOutput, err error) {
+	req, out := c.DisableSnapshotCopyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDisableSnapshotCopy *aws.Operation
 
 // EnableLoggingRequest generates a request for the EnableLogging operation.
@@ -1734,6 +2437,17 @@ func (c *Redshift) EnableLogging(input *EnableLoggingInput) (output *LoggingStat
 	return
 }
 
+// EnableLoggingWithContext is the same as EnableLogging with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) EnableLoggingWithContext(ctx context.Context, input *EnableLoggingInput) (output *LoggingStatus, err error) {
+	req, out := c.EnableLoggingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEnableLogging *aws.Operation
 
 // EnableSnapshotCopyRequest generates a request for the EnableSnapshotCopy operation.
@@ -1768,6 +2482,17 @@ func (c *Redshift) EnableSnapshotCopy(input *EnableSnapshotCopyInput) (output *E
 	return
 }
 
+// EnableSnapshotCopyWithContext is the same as EnableSnapshotCopy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) EnableSnapshotCopyWithContext(ctx context.Context, input *EnableSnapshotCopyInput) (output *EnableSnapshotCopyOutput, err error) {
+	req, out := c.EnableSnapshotCopyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEnableSnapshotCopy *aws.Operation
 
 // ModifyClusterRequest generates a request for the ModifyCluster operation.
@@ -1811,6 +2536,17 @@ func (c *Redshift) ModifyCluster(input *ModifyClusterInput) (output *ModifyClust
 	return
 }
 
+// ModifyClusterWithContext is the same as ModifyCluster with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) ModifyClusterWithContext(ctx context.Context, input *ModifyClusterInput) (output *ModifyClusterOutput, err error) {
+	req, out := c.ModifyClusterRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyCluster *aws.Operation
 
 // ModifyClusterParameterGroupRequest generates a request for the ModifyClusterParameterGroup operation.
@@ -1848,6 +2584,17 @@ func (c *Redshift) ModifyClusterParameterGroup(input *ModifyClusterParameterGrou
 	return
 }
 
+// ModifyClusterParameterGroupWithContext is the same as ModifyClusterParameterGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) ModifyClusterParameterGroupWithContext(ctx context.Context, input *ModifyClusterParameterGroupInput) (output *ClusterParameterGroupNameMessage, err error) {
+	req, out := c.ModifyClusterParameterGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyClusterParameterGroup *aws.Operation
 
 // ModifyClusterSubnetGroupRequest generates a request for the ModifyClusterSubnetGroup operation.
@@ -1883,6 +2630,17 @@ func (c *Redshift) ModifyClusterSubnetGroup(input *ModifyClusterSubnetGroupInput
 	return
 }
 
+// ModifyClusterSubnetGroupWithContext is the same as ModifyClusterSubnetGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) ModifyClusterSubnetGroupWithContext(ctx context.Context, input *ModifyClusterSubnetGroupInput) (output *ModifyClusterSubnetGroupOutput, err error) {
+	req, out := c.ModifyClusterSubnetGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyClusterSubnetGroup *aws.Operation
 
 // ModifyEventSubscriptionRequest generates a request for the ModifyEventSubscription operation.
@@ -1916,6 +2674,17 @@ func (c *Redshift) ModifyEventSubscription(input *ModifyEventSubscriptionInput)
 	return
 }
 
+// ModifyEventSubscriptionWithContext is the same as ModifyEventSubscription with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) ModifyEventSubscriptionWithContext(ctx context.Context, input *ModifyEventSubscriptionInput) (output *ModifyEventSubscriptionOutput, err error) {
+	req, out := c.ModifyEventSubscriptionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifyEventSubscription *aws.Operation
 
 // ModifySnapshotCopyRetentionPeriodRequest generates a request for the ModifySnapshotCopyRetentionPeriod operation.
@@ -1950,6 +2719,17 @@ func (c *Redshift) ModifySnapshotCopyRetentionPeriod(input *ModifySnapshotCopyRe
 	return
 }
 
+// ModifySnapshotCopyRetentionPeriodWithContext is the same as ModifySnapshotCopyRetentionPeriod with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) ModifySnapshotCopyRetentionPeriodWithContext(ctx context.Context, input *ModifySnapshotCopyRetentionPeriodInput) (output *ModifySnapshotCopyRetentionPeriodOutput, err error) {
+	req, out := c.ModifySnapshotCopyRetentionPeriodRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opModifySnapshotCopyRetentionPeriod *aws.Operation
 
 // PurchaseReservedNodeOfferingRequest generates a request for the PurchaseReservedNodeOffering operation.
@@ -1991,6 +2771,17 @@ func (c *Redshift) PurchaseReservedNodeOffering(input *PurchaseReservedNodeOffer
 	return
 }
 
+// PurchaseReservedNodeOfferingWithContext is the same as PurchaseReservedNodeOffering with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) PurchaseReservedNodeOfferingWithContext(ctx context.Context, input *PurchaseReservedNodeOfferingInput) (output *PurchaseReservedNodeOfferingOutput, err error) {
+	req, out := c.PurchaseReservedNodeOfferingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPurchaseReservedNodeOffering *aws.Operation
 
 // RebootClusterRequest generates a request for the RebootCluster operation.
@@ -2030,6 +2821,17 @@ func (c *Redshift) RebootCluster(input *RebootClusterInput) (output *RebootClust
 	return
 }
 
+// RebootClusterWithContext is the same as RebootCluster with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) RebootClusterWithContext(ctx context.Context, input *RebootClusterInput) (output *RebootClusterOutput, err error) {
+	req, out := c.RebootClusterRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRebootCluster *aws.Operation
 
 // ResetClusterParameterGroupRequest generates a request for the ResetClusterParameterGroup operation.
@@ -2066,6 +2868,17 @@ func (c *Redshift) ResetClusterParameterGroup(input *ResetClusterParameterGroupI
 	return
 }
 
+// ResetClusterParameterGroupWithContext is the same as ResetClusterParameterGroup with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) ResetClusterParameterGroupWithContext(ctx context.Context, input *ResetClusterParameterGroupInput) (output *ClusterParameterGroupNameMessage, err error) {
+	req, out := c.ResetClusterParameterGroupRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opResetClusterParameterGroup *aws.Operation
 
 // RestoreFromClusterSnapshotRequest generates a request for the RestoreFromClusterSnapshot operation.
@@ -2111,6 +2924,17 @@ func (c *Redshift) RestoreFromClusterSnapshot(input *RestoreFromClusterSnapshotI
 	return
 }
 
+// RestoreFromClusterSnapshotWithContext is the same as RestoreFromClusterSnapshot with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) RestoreFromClusterSnapshotWithContext(ctx context.Context, input *RestoreFromClusterSnapshotInput) (output *RestoreFromClusterSnapshotOutput, err error) {
+	req, out := c.RestoreFromClusterSnapshotRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRestoreFromClusterSnapshot *aws.Operation
 
 // RevokeClusterSecurityGroupIngressRequest generates a request for the RevokeClusterSecurityGroupIngress operation.
@@ -2148,6 +2972,17 @@ func (c *Redshift) RevokeClusterSecurityGroupIngress(input *RevokeClusterSecurit
 	return
 }
 
+// RevokeClusterSecurityGroupIngressWithContext is the same as RevokeClusterSecurityGroupIngress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) RevokeClusterSecurityGroupIngressWithContext(ctx context.Context, input *RevokeClusterSecurityGroupIngressInput) (output *RevokeClusterSecurityGroupIngressOutput, err error) {
+	req, out := c.RevokeClusterSecurityGroupIngressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRevokeClusterSecurityGroupIngress *aws.Operation
 
 // RevokeSnapshotAccessRequest generates a request for the RevokeSnapshotAccess operation.
@@ -2187,6 +3022,17 @@ func (c *Redshift) RevokeSnapshotAccess(input *RevokeSnapshotAccessInput) (outpu
 	return
 }
 
+// RevokeSnapshotAccessWithContext is the same as RevokeSnapshotAccess with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) RevokeSnapshotAccessWithContext(ctx context.Context, input *RevokeSnapshotAccessInput) (output *RevokeSnapshotAccessOutput, err error) {
+	req, out := c.RevokeSnapshotAccessRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRevokeSnapshotAccess *aws.Operation
 
 // RotateEncryptionKeyRequest generates a request for the RotateEncryptionKey operation.
@@ -2220,6 +3066,17 @@ func (c *Redshift) RotateEncryptionKey(input *RotateEncryptionKeyInput) (output
 	return
 }
 
+// RotateEncryptionKeyWithContext is the same as RotateEncryptionKey with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Redshift) RotateEncryptionKeyWithContext(ctx context.Context, input *RotateEncryptionKeyInput) (output *RotateEncryptionKeyOutput, err error) {
+	req, out := c.RotateEncryptionKeyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRotateEncryptionKey *aws.Operation
 
 // Describes an AWS customer account authorized to restore a snapshot.
@@ -4113,7 +4970,7 @@ type DescribeEventsInput struct {
 	// name. Specify cluster-parameter-group when SourceIdentifier is a cluster
 	// parameter group name. Specify cluster-snapshot when SourceIdentifier is a
 	// cluster snapshot identifier.
-	SourceType *string `type:"string"`
+	SourceType *string `type:"string" enum:"cluster,cluster-parameter-group,cluster-security-group,cluster-snapshot"`
 
 	// The beginning of the time interval to retrieve events for, specified in ISO
 	// 8601 format. For more information about ISO 8601, go to the ISO8601 Wikipedia
@@ -4784,7 +5641,7 @@ type Event struct {
 	SourceIdentifier *string `type:"string"`
 
 	// The source type for this event.
-	SourceType *string `type:"string"`
+	SourceType *string `type:"string" enum:"cluster,cluster-parameter-group,cluster-security-group,cluster-snapshot"`
 
 	metadataEvent `json:"-", xml:"-"`
 }
@@ -6037,4 +6894,22 @@ type VPCSecurityGroupMembership struct {
 
 type metadataVPCSecurityGroupMembership struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for SourceType.
+const (
+	SourceTypeCluster               = "cluster"
+	SourceTypeClusterParameterGroup = "cluster-parameter-group"
+	SourceTypeClusterSecurityGroup  = "cluster-security-group"
+	SourceTypeClusterSnapshot       = "cluster-snapshot"
+)
+
+// SourceTypeValues returns the valid values of SourceType.
+func SourceTypeValues() []string {
+	return []string{
+		SourceTypeCluster,
+		SourceTypeClusterParameterGroup,
+		SourceTypeClusterSecurityGroup,
+		SourceTypeClusterSnapshot,
+	}
 }
\ No newline at end of file
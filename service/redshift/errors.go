@@ -0,0 +1,582 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package redshift
+
+import (
+	"github.com/datacratic/aws-sdk-go/aws"
+)
+
+// Error codes of the Redshift errors. Errors with these codes are
+// returned as the typed error of the same name, e.g. an error with the code
+// ErrCodeFoo is a *Foo.
+const (
+	ErrCodeAccessToSnapshotDeniedFault               = "AccessToSnapshotDenied"
+	ErrCodeAuthorizationAlreadyExistsFault           = "AuthorizationAlreadyExists"
+	ErrCodeAuthorizationNotFoundFault                = "AuthorizationNotFound"
+	ErrCodeAuthorizationQuotaExceededFault           = "AuthorizationQuotaExceeded"
+	ErrCodeBucketNotFoundFault                       = "BucketNotFoundFault"
+	ErrCodeClusterAlreadyExistsFault                 = "ClusterAlreadyExists"
+	ErrCodeClusterNotFoundFault                      = "ClusterNotFound"
+	ErrCodeClusterParameterGroupAlreadyExistsFault   = "ClusterParameterGroupAlreadyExists"
+	ErrCodeClusterParameterGroupNotFoundFault        = "ClusterParameterGroupNotFound"
+	ErrCodeClusterParameterGroupQuotaExceededFault   = "ClusterParameterGroupQuotaExceeded"
+	ErrCodeClusterQuotaExceededFault                 = "ClusterQuotaExceeded"
+	ErrCodeClusterSecurityGroupAlreadyExistsFault    = "ClusterSecurityGroupAlreadyExists"
+	ErrCodeClusterSecurityGroupNotFoundFault         = "ClusterSecurityGroupNotFound"
+	ErrCodeClusterSecurityGroupQuotaExceededFault    = "QuotaExceeded.ClusterSecurityGroup"
+	ErrCodeClusterSnapshotAlreadyExistsFault         = "ClusterSnapshotAlreadyExists"
+	ErrCodeClusterSnapshotNotFoundFault              = "ClusterSnapshotNotFound"
+	ErrCodeClusterSnapshotQuotaExceededFault         = "ClusterSnapshotQuotaExceeded"
+	ErrCodeClusterSubnetGroupAlreadyExistsFault      = "ClusterSubnetGroupAlreadyExists"
+	ErrCodeClusterSubnetGroupNotFoundFault           = "ClusterSubnetGroupNotFoundFault"
+	ErrCodeClusterSubnetGroupQuotaExceededFault      = "ClusterSubnetGroupQuotaExceeded"
+	ErrCodeClusterSubnetQuotaExceededFault           = "ClusterSubnetQuotaExceededFault"
+	ErrCodeCopyToRegionDisabledFault                 = "CopyToRegionDisabledFault"
+	ErrCodeEventSubscriptionQuotaExceededFault       = "EventSubscriptionQuotaExceeded"
+	ErrCodeHSMClientCertificateAlreadyExistsFault    = "HsmClientCertificateAlreadyExistsFault"
+	ErrCodeHSMClientCertificateNotFoundFault         = "HsmClientCertificateNotFoundFault"
+	ErrCodeHSMClientCertificateQuotaExceededFault    = "HsmClientCertificateQuotaExceededFault"
+	ErrCodeHSMConfigurationAlreadyExistsFault        = "HsmConfigurationAlreadyExistsFault"
+	ErrCodeHSMConfigurationNotFoundFault             = "HsmConfigurationNotFoundFault"
+	ErrCodeHSMConfigurationQuotaExceededFault        = "HsmConfigurationQuotaExceededFault"
+	ErrCodeIncompatibleOrderableOptions              = "IncompatibleOrderableOptions"
+	ErrCodeInsufficientClusterCapacityFault          = "InsufficientClusterCapacity"
+	ErrCodeInsufficientS3BucketPolicyFault           = "InsufficientS3BucketPolicyFault"
+	ErrCodeInvalidClusterParameterGroupStateFault    = "InvalidClusterParameterGroupState"
+	ErrCodeInvalidClusterSecurityGroupStateFault     = "InvalidClusterSecurityGroupState"
+	ErrCodeInvalidClusterSnapshotStateFault          = "InvalidClusterSnapshotState"
+	ErrCodeInvalidClusterStateFault                  = "InvalidClusterState"
+	ErrCodeInvalidClusterSubnetGroupStateFault       = "InvalidClusterSubnetGroupStateFault"
+	ErrCodeInvalidClusterSubnetStateFault            = "InvalidClusterSubnetStateFault"
+	ErrCodeInvalidElasticIPFault                     = "InvalidElasticIpFault"
+	ErrCodeInvalidHSMClientCertificateStateFault     = "InvalidHsmClientCertificateStateFault"
+	ErrCodeInvalidHSMConfigurationStateFault         = "InvalidHsmConfigurationStateFault"
+	ErrCodeInvalidRestoreFault                       = "InvalidRestore"
+	ErrCodeInvalidS3BucketNameFault                  = "InvalidS3BucketNameFault"
+	ErrCodeInvalidS3KeyPrefixFault                   = "InvalidS3KeyPrefixFault"
+	ErrCodeInvalidSubnet                             = "InvalidSubnet"
+	ErrCodeInvalidSubscriptionStateFault             = "InvalidSubscriptionStateFault"
+	ErrCodeInvalidTagFault                           = "InvalidTagFault"
+	ErrCodeInvalidVPCNetworkStateFault               = "InvalidVPCNetworkStateFault"
+	ErrCodeNumberOfNodesPerClusterLimitExceededFault = "NumberOfNodesPerClusterLimitExceeded"
+	ErrCodeNumberOfNodesQuotaExceededFault           = "NumberOfNodesQuotaExceeded"
+	ErrCodeReservedNodeAlreadyExistsFault            = "ReservedNodeAlreadyExists"
+	ErrCodeReservedNodeNotFoundFault                 = "ReservedNodeNotFound"
+	ErrCodeReservedNodeOfferingNotFoundFault         = "ReservedNodeOfferingNotFound"
+	ErrCodeReservedNodeQuotaExceededFault            = "ReservedNodeQuotaExceeded"
+	ErrCodeResizeNotFoundFault                       = "ResizeNotFound"
+	ErrCodeResourceNotFoundFault                     = "ResourceNotFoundFault"
+	ErrCodeSNSInvalidTopicFault                      = "SNSInvalidTopic"
+	ErrCodeSNSNoAuthorizationFault                   = "SNSNoAuthorization"
+	ErrCodeSNSTopicARNNotFoundFault                  = "SNSTopicArnNotFound"
+	ErrCodeSnapshotCopyAlreadyDisabledFault          = "SnapshotCopyAlreadyDisabledFault"
+	ErrCodeSnapshotCopyAlreadyEnabledFault           = "SnapshotCopyAlreadyEnabledFault"
+	ErrCodeSnapshotCopyDisabledFault                 = "SnapshotCopyDisabledFault"
+	ErrCodeSourceNotFoundFault                       = "SourceNotFound"
+	ErrCodeSubnetAlreadyInUse                        = "SubnetAlreadyInUse"
+	ErrCodeSubscriptionAlreadyExistFault             = "SubscriptionAlreadyExist"
+	ErrCodeSubscriptionCategoryNotFoundFault         = "SubscriptionCategoryNotFound"
+	ErrCodeSubscriptionEventIDNotFoundFault          = "SubscriptionEventIdNotFound"
+	ErrCodeSubscriptionNotFoundFault                 = "SubscriptionNotFound"
+	ErrCodeSubscriptionSeverityNotFoundFault         = "SubscriptionSeverityNotFound"
+	ErrCodeTagLimitExceededFault                     = "TagLimitExceededFault"
+	ErrCodeUnauthorizedOperation                     = "UnauthorizedOperation"
+	ErrCodeUnknownSnapshotCopyRegionFault            = "UnknownSnapshotCopyRegionFault"
+	ErrCodeUnsupportedOptionFault                    = "UnsupportedOptionFault"
+)
+
+// The owner of the specified snapshot has not authorized your account to access
+// the snapshot.
+type AccessToSnapshotDeniedFault struct {
+	aws.APIError
+}
+
+// The specified CIDR block or EC2 security group is already authorized for
+// the specified cluster security group.
+type AuthorizationAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// The specified CIDR IP range or EC2 security group is not authorized for the
+// specified cluster security group.
+type AuthorizationNotFoundFault struct {
+	aws.APIError
+}
+
+// The authorization quota for the cluster security group has been reached.
+type AuthorizationQuotaExceededFault struct {
+	aws.APIError
+}
+
+// Could not find the specified S3 bucket.
+type BucketNotFoundFault struct {
+	aws.APIError
+}
+
+// The account already has a cluster with the given identifier.
+type ClusterAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// The ClusterIdentifier parameter does not refer to an existing cluster.
+type ClusterNotFoundFault struct {
+	aws.APIError
+}
+
+// A cluster parameter group with the same name already exists.
+type ClusterParameterGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// The parameter group name does not refer to an existing parameter group.
+type ClusterParameterGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// The request would result in the user exceeding the allowed number of cluster
+// parameter groups. For information about increasing your quota, go to Limits
+// in Amazon Redshift (http://docs.aws.amazon.com/redshift/latest/mgmt/amazon-redshift-limits.html)
+// in the Amazon Redshift Cluster Management Guide.
+type ClusterParameterGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// The request would exceed the allowed number of cluster instances for this
+// account. For information about increasing your quota, go to Limits in Amazon
+// Redshift (http://docs.aws.amazon.com/redshift/latest/mgmt/amazon-redshift-limits.html)
+// in the Amazon Redshift Cluster Management Guide.
+type ClusterQuotaExceededFault struct {
+	aws.APIError
+}
+
+// A cluster security group with the same name already exists.
+type ClusterSecurityGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// The cluster security group name does not refer to an existing cluster security
+// group.
+type ClusterSecurityGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// The request would result in the user exceeding the allowed number of cluster
+// security groups. For information about increasing your quota, go to Limits
+// in Amazon Redshift (http://docs.aws.amazon.com/redshift/latest/mgmt/amazon-redshift-limits.html)
+// in the Amazon Redshift Cluster Management Guide.
+type ClusterSecurityGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// The value specified as a snapshot identifier is already used by an existing
+// snapshot.
+type ClusterSnapshotAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// The snapshot identifier does not refer to an existing cluster snapshot.
+type ClusterSnapshotNotFoundFault struct {
+	aws.APIError
+}
+
+// The request would result in the user exceeding the allowed number of cluster
+// snapshots.
+type ClusterSnapshotQuotaExceededFault struct {
+	aws.APIError
+}
+
+// A ClusterSubnetGroupName is already used by an existing cluster subnet group.
+type ClusterSubnetGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// The cluster subnet group name does not refer to an existing cluster subnet
+// group.
+type ClusterSubnetGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// The request would result in user exceeding the allowed number of cluster
+// subnet groups. For information about increasing your quota, go to Limits
+// in Amazon Redshift (http://docs.aws.amazon.com/redshift/latest/mgmt/amazon-redshift-limits.html)
+// in the Amazon Redshift Cluster Management Guide.
+type ClusterSubnetGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// The request would result in user exceeding the allowed number of subnets
+// in a cluster subnet groups. For information about increasing your quota,
+// go to Limits in Amazon Redshift (http://docs.aws.amazon.com/redshift/latest/mgmt/amazon-redshift-limits.html)
+// in the Amazon Redshift Cluster Management Guide.
+type ClusterSubnetQuotaExceededFault struct {
+	aws.APIError
+}
+
+// Cross-region snapshot copy was temporarily disabled. Try your request again.
+type CopyToRegionDisabledFault struct {
+	aws.APIError
+}
+
+// The request would exceed the allowed number of event subscriptions for this
+// account. For information about increasing your quota, go to Limits in Amazon
+// Redshift (http://docs.aws.amazon.com/redshift/latest/mgmt/amazon-redshift-limits.html)
+// in the Amazon Redshift Cluster Management Guide.
+type EventSubscriptionQuotaExceededFault struct {
+	aws.APIError
+}
+
+// There is already an existing Amazon Redshift HSM client certificate with
+// the specified identifier.
+type HSMClientCertificateAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// There is no Amazon Redshift HSM client certificate with the specified identifier.
+type HSMClientCertificateNotFoundFault struct {
+	aws.APIError
+}
+
+// The quota for HSM client certificates has been reached. For information about
+// increasing your quota, go to Limits in Amazon Redshift (http://docs.aws.amazon.com/redshift/latest/mgmt/amazon-redshift-limits.html)
+// in the Amazon Redshift Cluster Management Guide.
+type HSMClientCertificateQuotaExceededFault struct {
+	aws.APIError
+}
+
+// There is already an existing Amazon Redshift HSM configuration with the specified
+// identifier.
+type HSMConfigurationAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// There is no Amazon Redshift HSM configuration with the specified identifier.
+type HSMConfigurationNotFoundFault struct {
+	aws.APIError
+}
+
+// The quota for HSM configurations has been reached. For information about
+// increasing your quota, go to Limits in Amazon Redshift (http://docs.aws.amazon.com/redshift/latest/mgmt/amazon-redshift-limits.html)
+// in the Amazon Redshift Cluster Management Guide.
+type HSMConfigurationQuotaExceededFault struct {
+	aws.APIError
+}
+
+// The specified options are incompatible.
+type IncompatibleOrderableOptions struct {
+	aws.APIError
+}
+
+// The number of nodes specified exceeds the allotted capacity of the cluster.
+type InsufficientClusterCapacityFault struct {
+	aws.APIError
+}
+
+// The cluster does not have read bucket or put object permissions on the S3
+// bucket specified when enabling logging.
+type InsufficientS3BucketPolicyFault struct {
+	aws.APIError
+}
+
+// The cluster parameter group action can not be completed because another task
+// is in progress that involves the parameter group. Wait a few moments and
+// try the operation again.
+type InvalidClusterParameterGroupStateFault struct {
+	aws.APIError
+}
+
+// The state of the cluster security group is not available.
+type InvalidClusterSecurityGroupStateFault struct {
+	aws.APIError
+}
+
+// The state of the cluster snapshot is not available, or other accounts are
+// authorized to access the snapshot.
+type InvalidClusterSnapshotStateFault struct {
+	aws.APIError
+}
+
+// The specified cluster is not in the available state.
+type InvalidClusterStateFault struct {
+	aws.APIError
+}
+
+// The cluster subnet group cannot be deleted because it is in use.
+type InvalidClusterSubnetGroupStateFault struct {
+	aws.APIError
+}
+
+// The state of the subnet is invalid.
+type InvalidClusterSubnetStateFault struct {
+	aws.APIError
+}
+
+// The Elastic IP (EIP) is invalid or cannot be found.
+type InvalidElasticIPFault struct {
+	aws.APIError
+}
+
+// The specified HSM client certificate is not in the available state, or it
+// is still in use by one or more Amazon Redshift clusters.
+type InvalidHSMClientCertificateStateFault struct {
+	aws.APIError
+}
+
+// The specified HSM configuration is not in the available state, or it is still
+// in use by one or more Amazon Redshift clusters.
+type InvalidHSMConfigurationStateFault struct {
+	aws.APIError
+}
+
+// The restore is invalid.
+type InvalidRestoreFault struct {
+	aws.APIError
+}
+
+// The S3 bucket name is invalid. For more information about naming rules, go
+// to Bucket Restrictions and Limitations (http://docs.aws.amazon.com/AmazonS3/latest/dev/BucketRestrictions.html)
+// in the Amazon Simple Storage Service (S3) Developer Guide.
+type InvalidS3BucketNameFault struct {
+	aws.APIError
+}
+
+// The string specified for the logging S3 key prefix does not comply with the
+// documented constraints.
+type InvalidS3KeyPrefixFault struct {
+	aws.APIError
+}
+
+// The requested subnet is not valid, or not all of the subnets are in the same
+// VPC.
+type InvalidSubnet struct {
+	aws.APIError
+}
+
+// The subscription request is invalid because it is a duplicate request. This
+// subscription request is already in progress.
+type InvalidSubscriptionStateFault struct {
+	aws.APIError
+}
+
+// The tag is invalid.
+type InvalidTagFault struct {
+	aws.APIError
+}
+
+// The cluster subnet group does not cover all Availability Zones.
+type InvalidVPCNetworkStateFault struct {
+	aws.APIError
+}
+
+// The operation would exceed the number of nodes allowed for a cluster.
+type NumberOfNodesPerClusterLimitExceededFault struct {
+	aws.APIError
+}
+
+// The operation would exceed the number of nodes allotted to the account. For
+// information about increasing your quota, go to Limits in Amazon Redshift
+// (http://docs.aws.amazon.com/redshift/latest/mgmt/amazon-redshift-limits.html)
+// in the Amazon Redshift Cluster Management Guide.
+type NumberOfNodesQuotaExceededFault struct {
+	aws.APIError
+}
+
+// User already has a reservation with the given identifier.
+type ReservedNodeAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// The specified reserved compute node not found.
+type ReservedNodeNotFoundFault struct {
+	aws.APIError
+}
+
+// Specified offering does not exist.
+type ReservedNodeOfferingNotFoundFault struct {
+	aws.APIError
+}
+
+// Request would exceed the user's compute node quota. For information about
+// increasing your quota, go to Limits in Amazon Redshift (http://docs.aws.amazon.com/redshift/latest/mgmt/amazon-redshift-limits.html)
+// in the Amazon Redshift Cluster Management Guide.
+type ReservedNodeQuotaExceededFault struct {
+	aws.APIError
+}
+
+// A resize operation for the specified cluster is not found.
+type ResizeNotFoundFault struct {
+	aws.APIError
+}
+
+// The resource could not be found.
+type ResourceNotFoundFault struct {
+	aws.APIError
+}
+
+// Amazon SNS has responded that there is a problem with the specified Amazon
+// SNS topic.
+type SNSInvalidTopicFault struct {
+	aws.APIError
+}
+
+// You do not have permission to publish to the specified Amazon SNS topic.
+type SNSNoAuthorizationFault struct {
+	aws.APIError
+}
+
+// An Amazon SNS topic with the specified Amazon Resource Name (ARN) does not
+// exist.
+type SNSTopicARNNotFoundFault struct {
+	aws.APIError
+}
+
+// The cluster already has cross-region snapshot copy disabled.
+type SnapshotCopyAlreadyDisabledFault struct {
+	aws.APIError
+}
+
+// The cluster already has cross-region snapshot copy enabled.
+type SnapshotCopyAlreadyEnabledFault struct {
+	aws.APIError
+}
+
+// Cross-region snapshot copy was temporarily disabled. Try your request again.
+type SnapshotCopyDisabledFault struct {
+	aws.APIError
+}
+
+// The specified Amazon Redshift event source could not be found.
+type SourceNotFoundFault struct {
+	aws.APIError
+}
+
+// A specified subnet is already in use by another cluster.
+type SubnetAlreadyInUse struct {
+	aws.APIError
+}
+
+// There is already an existing event notification subscription with the specified
+// name.
+type SubscriptionAlreadyExistFault struct {
+	aws.APIError
+}
+
+// The value specified for the event category was not one of the allowed values,
+// or it specified a category that does not apply to the specified source type.
+// The allowed values are Configuration, Management, Monitoring, and Security.
+type SubscriptionCategoryNotFoundFault struct {
+	aws.APIError
+}
+
+// An Amazon Redshift event with the specified event ID does not exist.
+type SubscriptionEventIDNotFoundFault struct {
+	aws.APIError
+}
+
+// An Amazon Redshift event notification subscription with the specified name
+// does not exist.
+type SubscriptionNotFoundFault struct {
+	aws.APIError
+}
+
+// The value specified for the event severity was not one of the allowed values,
+// or it specified a severity that does not apply to the specified source type.
+// The allowed values are ERROR and INFO.
+type SubscriptionSeverityNotFoundFault struct {
+	aws.APIError
+}
+
+// The request exceeds the limit of 10 tags for the resource.
+type TagLimitExceededFault struct {
+	aws.APIError
+}
+
+// Your account is not authorized to perform the requested operation.
+type UnauthorizedOperation struct {
+	aws.APIError
+}
+
+// The specified region is incorrect or does not exist.
+type UnknownSnapshotCopyRegionFault struct {
+	aws.APIError
+}
+
+// A request option was specified that is not supported.
+type UnsupportedOptionFault struct {
+	aws.APIError
+}
+
+var errorTypes = map[string]func() aws.ServiceError{
+	ErrCodeAccessToSnapshotDeniedFault:               func() aws.ServiceError { return &AccessToSnapshotDeniedFault{} },
+	ErrCodeAuthorizationAlreadyExistsFault:           func() aws.ServiceError { return &AuthorizationAlreadyExistsFault{} },
+	ErrCodeAuthorizationNotFoundFault:                func() aws.ServiceError { return &AuthorizationNotFoundFault{} },
+	ErrCodeAuthorizationQuotaExceededFault:           func() aws.ServiceError { return &AuthorizationQuotaExceededFault{} },
+	ErrCodeBucketNotFoundFault:                       func() aws.ServiceError { return &BucketNotFoundFault{} },
+	ErrCodeClusterAlreadyExistsFault:                 func() aws.ServiceError { return &ClusterAlreadyExistsFault{} },
+	ErrCodeClusterNotFoundFault:                      func() aws.ServiceError { return &ClusterNotFoundFault{} },
+	ErrCodeClusterParameterGroupAlreadyExistsFault:   func() aws.ServiceError { return &ClusterParameterGroupAlreadyExistsFault{} },
+	ErrCodeClusterParameterGroupNotFoundFault:        func() aws.ServiceError { return &ClusterParameterGroupNotFoundFault{} },
+	ErrCodeClusterParameterGroupQuotaExceededFault:   func() aws.ServiceError { return &ClusterParameterGroupQuotaExceededFault{} },
+	ErrCodeClusterQuotaExceededFault:                 func() aws.ServiceError { return &ClusterQuotaExceededFault{} },
+	ErrCodeClusterSecurityGroupAlreadyExistsFault:    func() aws.ServiceError { return &ClusterSecurityGroupAlreadyExistsFault{} },
+	ErrCodeClusterSecurityGroupNotFoundFault:         func() aws.ServiceError { return &ClusterSecurityGroupNotFoundFault{} },
+	ErrCodeClusterSecurityGroupQuotaExceededFault:    func() aws.ServiceError { return &ClusterSecurityGroupQuotaExceededFault{} },
+	ErrCodeClusterSnapshotAlreadyExistsFault:         func() aws.ServiceError { return &ClusterSnapshotAlreadyExistsFault{} },
+	ErrCodeClusterSnapshotNotFoundFault:              func() aws.ServiceError { return &ClusterSnapshotNotFoundFault{} },
+	ErrCodeClusterSnapshotQuotaExceededFault:         func() aws.ServiceError { return &ClusterSnapshotQuotaExceededFault{} },
+	ErrCodeClusterSubnetGroupAlreadyExistsFault:      func() aws.ServiceError { return &ClusterSubnetGroupAlreadyExistsFault{} },
+	ErrCodeClusterSubnetGroupNotFoundFault:           func() aws.ServiceError { return &ClusterSubnetGroupNotFoundFault{} },
+	ErrCodeClusterSubnetGroupQuotaExceededFault:      func() aws.ServiceError { return &ClusterSubnetGroupQuotaExceededFault{} },
+	ErrCodeClusterSubnetQuotaExceededFault:           func() aws.ServiceError { return &ClusterSubnetQuotaExceededFault{} },
+	ErrCodeCopyToRegionDisabledFault:                 func() aws.ServiceError { return &CopyToRegionDisabledFault{} },
+	ErrCodeEventSubscriptionQuotaExceededFault:       func() aws.ServiceError { return &EventSubscriptionQuotaExceededFault{} },
+	ErrCodeHSMClientCertificateAlreadyExistsFault:    func() aws.ServiceError { return &HSMClientCertificateAlreadyExistsFault{} },
+	ErrCodeHSMClientCertificateNotFoundFault:         func() aws.ServiceError { return &HSMClientCertificateNotFoundFault{} },
+	ErrCodeHSMClientCertificateQuotaExceededFault:    func() aws.ServiceError { return &HSMClientCertificateQuotaExceededFault{} },
+	ErrCodeHSMConfigurationAlreadyExistsFault:        func() aws.ServiceError { return &HSMConfigurationAlreadyExistsFault{} },
+	ErrCodeHSMConfigurationNotFoundFault:             func() aws.ServiceError { return &HSMConfigurationNotFoundFault{} },
+	ErrCodeHSMConfigurationQuotaExceededFault:        func() aws.ServiceError { return &HSMConfigurationQuotaExceededFault{} },
+	ErrCodeIncompatibleOrderableOptions:              func() aws.ServiceError { return &IncompatibleOrderableOptions{} },
+	ErrCodeInsufficientClusterCapacityFault:          func() aws.ServiceError { return &InsufficientClusterCapacityFault{} },
+	ErrCodeInsufficientS3BucketPolicyFault:           func() aws.ServiceError { return &InsufficientS3BucketPolicyFault{} },
+	ErrCodeInvalidClusterParameterGroupStateFault:    func() aws.ServiceError { return &InvalidClusterParameterGroupStateFault{} },
+	ErrCodeInvalidClusterSecurityGroupStateFault:     func() aws.ServiceError { return &InvalidClusterSecurityGroupStateFault{} },
+	ErrCodeInvalidClusterSnapshotStateFault:          func() aws.ServiceError { return &InvalidClusterSnapshotStateFault{} },
+	ErrCodeInvalidClusterStateFault:                  func() aws.ServiceError { return &InvalidClusterStateFault{} },
+	ErrCodeInvalidClusterSubnetGroupStateFault:       func() aws.ServiceError { return &InvalidClusterSubnetGroupStateFault{} },
+	ErrCodeInvalidClusterSubnetStateFault:            func() aws.ServiceError { return &InvalidClusterSubnetStateFault{} },
+	ErrCodeInvalidElasticIPFault:                     func() aws.ServiceError { return &InvalidElasticIPFault{} },
+	ErrCodeInvalidHSMClientCertificateStateFault:     func() aws.ServiceError { return &InvalidHSMClientCertificateStateFault{} },
+	ErrCodeInvalidHSMConfigurationStateFault:         func() aws.ServiceError { return &InvalidHSMConfigurationStateFault{} },
+	ErrCodeInvalidRestoreFault:                       func() aws.ServiceError { return &InvalidRestoreFault{} },
+	ErrCodeInvalidS3BucketNameFault:                  func() aws.ServiceError { return &InvalidS3BucketNameFault{} },
+	ErrCodeInvalidS3KeyPrefixFault:                   func() aws.ServiceError { return &InvalidS3KeyPrefixFault{} },
+	ErrCodeInvalidSubnet:                             func() aws.ServiceError { return &InvalidSubnet{} },
+	ErrCodeInvalidSubscriptionStateFault:             func() aws.ServiceError { return &InvalidSubscriptionStateFault{} },
+	ErrCodeInvalidTagFault:                           func() aws.ServiceError { return &InvalidTagFault{} },
+	ErrCodeInvalidVPCNetworkStateFault:               func() aws.ServiceError { return &InvalidVPCNetworkStateFault{} },
+	ErrCodeNumberOfNodesPerClusterLimitExceededFault: func() aws.ServiceError { return &NumberOfNodesPerClusterLimitExceededFault{} },
+	ErrCodeNumberOfNodesQuotaExceededFault:           func() aws.ServiceError { return &NumberOfNodesQuotaExceededFault{} },
+	ErrCodeReservedNodeAlreadyExistsFault:            func() aws.ServiceError { return &ReservedNodeAlreadyExistsFault{} },
+	ErrCodeReservedNodeNotFoundFault:                 func() aws.ServiceError { return &ReservedNodeNotFoundFault{} },
+	ErrCodeReservedNodeOfferingNotFoundFault:         func() aws.ServiceError { return &ReservedNodeOfferingNotFoundFault{} },
+	ErrCodeReservedNodeQuotaExceededFault:            func() aws.ServiceError { return &ReservedNodeQuotaExceededFault{} },
+	ErrCodeResizeNotFoundFault:                       func() aws.ServiceError { return &ResizeNotFoundFault{} },
+	ErrCodeResourceNotFoundFault:                     func() aws.ServiceError { return &ResourceNotFoundFault{} },
+	ErrCodeSNSInvalidTopicFault:                      func() aws.ServiceError { return &SNSInvalidTopicFault{} },
+	ErrCodeSNSNoAuthorizationFault:                   func() aws.ServiceError { return &SNSNoAuthorizationFault{} },
+	ErrCodeSNSTopicARNNotFoundFault:                  func() aws.ServiceError { return &SNSTopicARNNotFoundFault{} },
+	ErrCodeSnapshotCopyAlreadyDisabledFault:          func() aws.ServiceError { return &SnapshotCopyAlreadyDisabledFault{} },
+	ErrCodeSnapshotCopyAlreadyEnabledFault:           func() aws.ServiceError { return &SnapshotCopyAlreadyEnabledFault{} },
+	ErrCodeSnapshotCopyDisabledFault:                 func() aws.ServiceError { return &SnapshotCopyDisabledFault{} },
+	ErrCodeSourceNotFoundFault:                       func() aws.ServiceError { return &SourceNotFoundFault{} },
+	ErrCodeSubnetAlreadyInUse:                        func() aws.ServiceError { return &SubnetAlreadyInUse{} },
+	ErrCodeSubscriptionAlreadyExistFault:             func() aws.ServiceError { return &SubscriptionAlreadyExistFault{} },
+	ErrCodeSubscriptionCategoryNotFoundFault:         func() aws.ServiceError { return &SubscriptionCategoryNotFoundFault{} },
+	ErrCodeSubscriptionEventIDNotFoundFault:          func() aws.ServiceError { return &SubscriptionEventIDNotFoundFault{} },
+	ErrCodeSubscriptionNotFoundFault:                 func() aws.ServiceError { return &SubscriptionNotFoundFault{} },
+	ErrCodeSubscriptionSeverityNotFoundFault:         func() aws.ServiceError { return &SubscriptionSeverityNotFoundFault{} },
+	ErrCodeTagLimitExceededFault:                     func() aws.ServiceError { return &TagLimitExceededFault{} },
+	ErrCodeUnauthorizedOperation:                     func() aws.ServiceError { return &UnauthorizedOperation{} },
+	ErrCodeUnknownSnapshotCopyRegionFault:            func() aws.ServiceError { return &UnknownSnapshotCopyRegionFault{} },
+	ErrCodeUnsupportedOptionFault:                    func() aws.ServiceError { return &UnsupportedOptionFault{} },
+}
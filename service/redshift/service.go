@@ -27,6 +27,7 @@ func New(config *aws.Config) *Redshift {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "redshift",
 		APIVersion:  "2012-12-01",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
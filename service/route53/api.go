@@ -4,6 +4,7 @@
 package route53
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -50,6 +51,17 @@ func (c *Route53) AssociateVPCWithHostedZone(input *AssociateVPCWithHostedZoneIn
 	return
 }
 
+// AssociateVPCWithHostedZoneWithContext is the same as AssociateVPCWithHostedZone with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) AssociateVPCWithHostedZoneWithContext(ctx context.Context, input *AssociateVPCWithHostedZoneInput) (output *AssociateVPCWithHostedZoneOutput, err error) {
+	req, out := c.AssociateVPCWithHostedZoneRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAssociateVPCWithHostedZone *aws.Operation
 
 // ChangeResourceRecordSetsRequest generates a request for the ChangeResourceRecordSets operation.
@@ -110,6 +122,17 @@ func (c *Route53) ChangeResourceRecordSets(input *ChangeResourceRecordSetsInput)
 	return
 }
 
+// ChangeResourceRecordSetsWithContext is the same as ChangeResourceRecordSets with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) ChangeResourceRecordSetsWithContext(ctx context.Context, input *ChangeResourceRecordSetsInput) (output *ChangeResourceRecordSetsOutput, err error) {
+	req, out := c.ChangeResourceRecordSetsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opChangeResourceRecordSets *aws.Operation
 
 // ChangeTagsForResourceRequest generates a request for the ChangeTagsForResource operation.
@@ -142,6 +165,17 @@ func (c *Route53) ChangeTagsForResource(input *ChangeTagsForResourceInput) (outp
 	return
 }
 
+// ChangeTagsForResourceWithContext is the same as ChangeTagsForResource with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) ChangeTagsForResourceWithContext(ctx context.Context, input *ChangeTagsForResourceInput) (output *ChangeTagsForResourceOutput, err error) {
+	req, out := c.ChangeTagsForResourceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opChangeTagsForResource *aws.Operation
 
 // CreateHealthCheckRequest generates a request for the CreateHealthCheck operation.
@@ -180,6 +214,17 @@ func (c *Route53) CreateHealthCheck(input *CreateHealthCheckInput) (output *Crea
 	return
 }
 
+// CreateHealthCheckWithContext is the same as CreateHealthCheck with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) CreateHealthCheckWithContext(ctx context.Context, input *CreateHealthCheckInput) (output *CreateHealthCheckOutput, err error) {
+	req, out := c.CreateHealthCheckRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateHealthCheck *aws.Operation
 
 // CreateHostedZoneRequest generates a request for the CreateHostedZone operation.
@@ -233,6 +278,17 @@ func (c *Route53) CreateHostedZone(input *CreateHostedZoneInput) (output *Create
 	return
 }
 
+// CreateHostedZoneWithContext is the same as CreateHostedZone with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) CreateHostedZoneWithContext(ctx context.Context, input *CreateHostedZoneInput) (output *CreateHostedZoneOutput, err error) {
+	req, out := c.CreateHostedZoneRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateHostedZone *aws.Operation
 
 // CreateReusableDelegationSetRequest generates a request for the CreateReusableDelegationSet operation.
@@ -274,6 +330,17 @@ func (c *Route53) CreateReusableDelegationSet(input *CreateReusableDelegationSet
 	return
 }
 
+// CreateReusableDelegationSetWithContext is the same as CreateReusableDelegationSet with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) CreateReusableDelegationSetWithContext(ctx context.Context, input *CreateReusableDelegationSetInput) (output *CreateReusableDelegationSetOutput, err error) {
+	req, out := c.CreateReusableDelegationSetRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateReusableDelegationSet *aws.Operation
 
 // DeleteHealthCheckRequest generates a request for the DeleteHealthCheck operation.
@@ -316,6 +383,17 @@ func (c *Route53) DeleteHealthCheck(input *DeleteHealthCheckInput) (output *Dele
 	return
 }
 
+// DeleteHealthCheckWithContext is the same as DeleteHealthCheck with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) DeleteHealthCheckWithContext(ctx context.Context, input *DeleteHealthCheckInput) (output *DeleteHealthCheckOutput, err error) {
+	req, out := c.DeleteHealthCheckRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteHealthCheck *aws.Operation
 
 // DeleteHostedZoneRequest generates a request for the DeleteHostedZone operation.
@@ -362,6 +440,17 @@ func (c *Route53) DeleteHostedZone(input *DeleteHostedZoneInput) (output *Delete
 	return
 }
 
+// DeleteHostedZoneWithContext is the same as DeleteHostedZone with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) DeleteHostedZoneWithContext(ctx context.Context, input *DeleteHostedZoneInput) (output *DeleteHostedZoneOutput, err error) {
+	req, out := c.DeleteHostedZoneRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteHostedZone *aws.Operation
 
 // DeleteReusableDelegationSetRequest generates a request for the DeleteReusableDelegationSet operation.
@@ -403,6 +492,17 @@ func (c *Route53) DeleteReusableDelegationSet(input *DeleteReusableDelegationSet
 	return
 }
 
+// DeleteReusableDelegationSetWithContext is the same as DeleteReusableDelegationSet with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) DeleteReusableDelegationSetWithContext(ctx context.Context, input *DeleteReusableDelegationSetInput) (output *DeleteReusableDelegationSetOutput, err error) {
+	req, out := c.DeleteReusableDelegationSetRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteReusableDelegationSet *aws.Operation
 
 // DisassociateVPCFromHostedZoneRequest generates a request for the DisassociateVPCFromHostedZone operation.
@@ -443,6 +543,17 @@ func (c *Route53) DisassociateVPCFromHostedZone(input *DisassociateVPCFromHosted
 	return
 }
 
+// DisassociateVPCFromHostedZoneWithContext is the same as DisassociateVPCFromHostedZone with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) DisassociateVPCFromHostedZoneWithContext(ctx context.Context, input *DisassociateVPCFromHostedZoneInput) (output *DisassociateVPCFromHostedZoneOutput, err error) {
+	req, out := c.DisassociateVPCFromHostedZoneRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDisassociateVPCFromHostedZone *aws.Operation
 
 // GetChangeRequest generates a request for the GetChange operation.
@@ -484,6 +595,17 @@ func (c *Route53) GetChange(input *GetChangeInput) (output *GetChangeOutput, err
 	return
 }
 
+// GetChangeWithContext is the same as GetChange with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) GetChangeWithContext(ctx context.Context, input *GetChangeInput) (output *GetChangeOutput, err error) {
+	req, out := c.GetChangeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetChange *aws.Operation
 
 // GetCheckerIPRangesRequest generates a request for the GetCheckerIPRanges operation.
@@ -520,6 +642,17 @@ func (c *Route53) GetCheckerIPRanges(input *GetCheckerIPRangesInput) (output *Ge
 	return
 }
 
+// GetCheckerIPRangesWithContext is the same as GetCheckerIPRanges with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) GetCheckerIPRangesWithContext(ctx context.Context, input *GetCheckerIPRangesInput) (output *GetCheckerIPRangesOutput, err error) {
+	req, out := c.GetCheckerIPRangesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetCheckerIPRanges *aws.Operation
 
 // GetGeoLocationRequest generates a request for the GetGeoLocation operation.
@@ -555,6 +688,17 @@ func (c *Route53) GetGeoLocation(input *GetGeoLocationInput) (output *GetGeoLoca
 	return
 }
 
+// GetGeoLocationWithContext is the same as GetGeoLocation with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) GetGeoLocationWithContext(ctx context.Context, input *GetGeoLocationInput) (output *GetGeoLocationOutput, err error) {
+	req, out := c.GetGeoLocationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetGeoLocation *aws.Operation
 
 // GetHealthCheckRequest generates a request for the GetHealthCheck operation.
@@ -589,6 +733,17 @@ func (c *Route53) GetHealthCheck(input *GetHealthCheckInput) (output *GetHealthC
 	return
 }
 
+// GetHealthCheckWithContext is the same as GetHealthCheck with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) GetHealthCheckWithContext(ctx context.Context, input *GetHealthCheckInput) (output *GetHealthCheckOutput, err error) {
+	req, out := c.GetHealthCheckRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetHealthCheck *aws.Operation
 
 // GetHealthCheckCountRequest generates a request for the GetHealthCheckCount operation.
@@ -623,6 +778,17 @@ func (c *Route53) GetHealthCheckCount(input *GetHealthCheckCountInput) (output *
 	return
 }
 
+// GetHealthCheckCountWithContext is the same as GetHealthCheckCount with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) GetHealthCheckCountWithContext(ctx context.Context, input *GetHealthCheckCountInput) (output *GetHealthCheckCountOutput, err error) {
+	req, out := c.GetHealthCheckCountRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetHealthCheckCount *aws.Operation
 
 // GetHealthCheckLastFailureReasonRequest generates a request for the GetHealthCheckLastFailureReason operation.
@@ -659,6 +825,17 @@ func (c *Route53) GetHealthCheckLastFailureReason(input *GetHealthCheckLastFailu
 	return
 }
 
+// GetHealthCheckLastFailureReasonWithContext is the same as GetHealthCheckLastFailureReason with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) GetHealthCheckLastFailureReasonWithContext(ctx context.Context, input *GetHealthCheckLastFailureReasonInput) (output *GetHealthCheckLastFailureReasonOutput, err error) {
+	req, out := c.GetHealthCheckLastFailureReasonRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetHealthCheckLastFailureReason *aws.Operation
 
 // GetHealthCheckStatusRequest generates a request for the GetHealthCheckStatus operation.
@@ -694,6 +871,17 @@ func (c *Route53) GetHealthCheckStatus(input *GetHealthCheckStatusInput) (output
 	return
 }
 
+// GetHealthCheckStatusWithContext is the same as GetHealthCheckStatus with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) GetHealthCheckStatusWithContext(ctx context.Context, input *GetHealthCheckStatusInput) (output *GetHealthCheckStatusOutput, err error) {
+	req, out := c.GetHealthCheckStatusRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetHealthCheckStatus *aws.Operation
 
 // GetHostedZoneRequest generates a request for the GetHostedZone operation.
@@ -730,6 +918,17 @@ func (c *Route53) GetHostedZone(input *GetHostedZoneInput) (output *GetHostedZon
 	return
 }
 
+// GetHostedZoneWithContext is the same as GetHostedZone with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) GetHostedZoneWithContext(ctx context.Context, input *GetHostedZoneInput) (output *GetHostedZoneOutput, err error) {
+	req, out := c.GetHostedZoneRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetHostedZone *aws.Operation
 
 // GetHostedZoneCountRequest generates a request for the GetHostedZoneCount operation.
@@ -764,6 +963,17 @@ func (c *Route53) GetHostedZoneCount(input *GetHostedZoneCountInput) (output *Ge
 	return
 }
 
+// GetHostedZoneCountWithContext is the same as GetHostedZoneCount with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) GetHostedZoneCountWithContext(ctx context.Context, input *GetHostedZoneCountInput) (output *GetHostedZoneCountOutput, err error) {
+	req, out := c.GetHostedZoneCountRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetHostedZoneCount *aws.Operation
 
 // GetReusableDelegationSetRequest generates a request for the GetReusableDelegationSet operation.
@@ -798,6 +1008,17 @@ func (c *Route53) GetReusableDelegationSet(input *GetReusableDelegationSetInput)
 	return
 }
 
+// GetReusableDelegationSetWithContext is the same as GetReusableDelegationSet with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) GetReusableDelegationSetWithContext(ctx context.Context, input *GetReusableDelegationSetInput) (output *GetReusableDelegationSetOutput, err error) {
+	req, out := c.GetReusableDelegationSetRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetReusableDelegationSet *aws.Operation
 
 // ListGeoLocationsRequest generates a request for the ListGeoLocations operation.
@@ -843,6 +1064,17 @@ func (c *Route53) ListGeoLocations(input *ListGeoLocationsInput) (output *ListGe
 	return
 }
 
+// ListGeoLocationsWithContext is the same as ListGeoLocations with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) ListGeoLocationsWithContext(ctx context.Context, input *ListGeoLocationsInput) (output *ListGeoLocationsOutput, err error) {
+	req, out := c.ListGeoLocationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListGeoLocations *aws.Operation
 
 // ListHealthChecksRequest generates a request for the ListHealthChecks operation.
@@ -855,6 +1087,12 @@ func (c *Route53) ListHealthChecksRequest(input *ListHealthChecksInput) (req *aw
 			Name:       "ListHealthChecks",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2013-04-01/healthcheck",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"NextMarker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -884,6 +1122,27 @@ func (c *Route53) ListHealthChecks(input *ListHealthChecksInput) (output *ListHe
 	return
 }
 
+// ListHealthChecksWithContext is the same as ListHealthChecks with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) ListHealthChecksWithContext(ctx context.Context, input *ListHealthChecksInput) (output *ListHealthChecksOutput, err error) {
+	req, out := c.ListHealthChecksRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListHealthChecksPages iterates over the pages of a ListHealthChecks operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Route53) ListHealthChecksPages(input *ListHealthChecksInput, fn func(p *ListHealthChecksOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListHealthChecksRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListHealthChecksOutput), lastPage)
+	})
+}
+
 var opListHealthChecks *aws.Operation
 
 // ListHostedZonesRequest generates a request for the ListHostedZones operation.
@@ -896,6 +1155,12 @@ func (c *Route53) ListHostedZonesRequest(input *ListHostedZonesInput) (req *aws.
 			Name:       "ListHostedZones",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2013-04-01/hostedzone",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"NextMarker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -925,6 +1190,27 @@ func (c *Route53) ListHostedZones(input *ListHostedZonesInput) (output *ListHost
 	return
 }
 
+// ListHostedZonesWithContext is the same as ListHostedZones with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) ListHostedZonesWithContext(ctx context.Context, input *ListHostedZonesInput) (output *ListHostedZonesOutput, err error) {
+	req, out := c.ListHostedZonesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListHostedZonesPages iterates over the pages of a ListHostedZones operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Route53) ListHostedZonesPages(input *ListHostedZonesInput, fn func(p *ListHostedZonesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListHostedZonesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListHostedZonesOutput), lastPage)
+	})
+}
+
 var opListHostedZones *aws.Operation
 
 // ListHostedZonesByNameRequest generates a request for the ListHostedZonesByName operation.
@@ -968,6 +1254,17 @@ func (c *Route53) ListHostedZonesByName(input *ListHostedZonesByNameInput) (outp
 	return
 }
 
+// ListHostedZonesByNameWithContext is the same as ListHostedZonesByName with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) ListHostedZonesByNameWithContext(ctx context.Context, input *ListHostedZonesByNameInput) (output *ListHostedZonesByNameOutput, err error) {
+	req, out := c.ListHostedZonesByNameRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListHostedZonesByName *aws.Operation
 
 // ListResourceRecordSetsRequest generates a request for the ListResourceRecordSets operation.
@@ -980,6 +1277,12 @@ func (c *Route53) ListResourceRecordSetsRequest(input *ListResourceRecordSetsInp
 			Name:       "ListResourceRecordSets",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2013-04-01/hostedzone/{Id}/rrset",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"StartRecordName", "StartRecordType", "StartRecordIdentifier"},
+				OutputTokens:    []string{"NextRecordName", "NextRecordType", "NextRecordIdentifier"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -1038,6 +1341,27 @@ func (c *Route53) ListResourceRecordSets(input *ListResourceRecordSetsInput) (ou
 	return
 }
 
+// ListResourceRecordSetsWithContext is the same as ListResourceRecordSets with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) ListResourceRecordSetsWithContext(ctx context.Context, input *ListResourceRecordSetsInput) (output *ListResourceRecordSetsOutput, err error) {
+	req, out := c.ListResourceRecordSetsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListResourceRecordSetsPages iterates over the pages of a ListResourceRecordSets operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Route53) ListResourceRecordSetsPages(input *ListResourceRecordSetsInput, fn func(p *ListResourceRecordSetsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListResourceRecordSetsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListResourceRecordSetsOutput), lastPage)
+	})
+}
+
 var opListResourceRecordSets *aws.Operation
 
 // ListReusableDelegationSetsRequest generates a request for the ListReusableDelegationSets operation.
@@ -1080,6 +1404,17 @@ func (c *Route53) ListReusableDelegationSets(input *ListReusableDelegationSetsIn
 	return
 }
 
+// ListReusableDelegationSetsWithContext is the same as ListReusableDelegationSets with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) ListReusableDelegationSetsWithContext(ctx context.Context, input *ListReusableDelegationSetsInput) (output *ListReusableDelegationSetsOutput, err error) {
+	req, out := c.ListReusableDelegationSetsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListReusableDelegationSets *aws.Operation
 
 // ListTagsForResourceRequest generates a request for the ListTagsForResource operation.
@@ -1112,6 +1447,17 @@ func (c *Route53) ListTagsForResource(input *ListTagsForResourceInput) (output *
 	return
 }
 
+// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) ListTagsForResourceWithContext(ctx context.Context, input *ListTagsForResourceInput) (output *ListTagsForResourceOutput, err error) {
+	req, out := c.ListTagsForResourceRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListTagsForResource *aws.Operation
 
 // ListTagsForResourcesRequest generates a request for the ListTagsForResources operation.
@@ -1144,6 +1490,17 @@ func (c *Route53) ListTagsForResources(input *ListTagsForResourcesInput) (output
 	return
 }
 
+// ListTagsForResourcesWithContext is the same as ListTagsForResources with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) ListTagsForResourcesWithContext(ctx context.Context, input *ListTagsForResourcesInput) (output *ListTagsForResourcesOutput, err error) {
+	req, out := c.ListTagsForResourcesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListTagsForResources *aws.Operation
 
 // UpdateHealthCheckRequest generates a request for the UpdateHealthCheck operation.
@@ -1182,6 +1539,17 @@ func (c *Route53) UpdateHealthCheck(input *UpdateHealthCheckInput) (output *Upda
 	return
 }
 
+// UpdateHealthCheckWithContext is the same as UpdateHealthCheck with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) UpdateHealthCheckWithContext(ctx context.Context, input *UpdateHealthCheckInput) (output *UpdateHealthCheckOutput, err error) {
+	req, out := c.UpdateHealthCheckRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateHealthCheck *aws.Operation
 
 // UpdateHostedZoneCommentRequest generates a request for the UpdateHostedZoneComment operation.
@@ -1219,6 +1587,17 @@ func (c *Route53) UpdateHostedZoneComment(input *UpdateHostedZoneCommentInput) (
 	return
 }
 
+// UpdateHostedZoneCommentWithContext is the same as UpdateHostedZoneComment with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53) UpdateHostedZoneCommentWithContext(ctx context.Context, input *UpdateHostedZoneCommentInput) (output *UpdateHostedZoneCommentOutput, err error) {
+	req, out := c.UpdateHostedZoneCommentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateHostedZoneComment *aws.Operation
 
 // Alias resource record sets only: Information about the domain to which you
@@ -1238,7 +1617,7 @@ type AliasTarget struct {
 	// in the Amazon Route 53 Developer Guide
 	//
 	// .
-	DNSName *string `type:"string" required:"true"`
+	DNSName *string `type:"string" max:"1024" required:"true"`
 
 	// Alias resource record sets only: A boolean value that indicates whether this
 	// Resource Record Set should respect the health status of any health checks
@@ -1259,7 +1638,7 @@ type AliasTarget struct {
 	// in the Amazon Route 53 Developer Guide
 	//
 	// .
-	HostedZoneID *string `locationName:"HostedZoneId" type:"string" required:"true"`
+	HostedZoneID *string `locationName:"HostedZoneId" type:"string" max:"32" required:"true"`
 
 	metadataAliasTarget `json:"-", xml:"-"`
 }
@@ -1278,7 +1657,7 @@ type AssociateVPCWithHostedZoneInput struct {
 	//
 	// Note that you cannot associate a VPC with a hosted zone that doesn't have
 	// an existing VPC association.
-	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The VPC that you want your hosted zone to be associated with.
 	VPC *VPC `type:"structure" required:"true"`
@@ -1309,7 +1688,7 @@ type Change struct {
 	// The action to perform.
 	//
 	// Valid values: CREATE | DELETE | UPSERT
-	Action *string `type:"string" required:"true"`
+	Action *string `type:"string" enum:"CREATE,DELETE,UPSERT" required:"true"`
 
 	// Information about the resource record set to create or delete.
 	ResourceRecordSet *ResourceRecordSet `type:"structure" required:"true"`
@@ -1326,10 +1705,10 @@ type metadataChange struct {
 type ChangeBatch struct {
 	// A complex type that contains one Change element for each resource record
 	// set that you want to create or delete.
-	Changes []*Change `locationNameList:"Change" type:"list" required:"true"`
+	Changes []*Change `locationNameList:"Change" type:"list" min:"1" required:"true"`
 
 	// Optional: Any comments you want to include about a change batch request.
-	Comment *string `type:"string"`
+	Comment *string `type:"string" max:"256"`
 
 	metadataChangeBatch `json:"-", xml:"-"`
 }
@@ -1349,17 +1728,17 @@ type ChangeInfo struct {
 	//
 	// This element contains an ID that you use when performing a GetChange action
 	// to get detailed information about the change.
-	Comment *string `type:"string"`
+	Comment *string `type:"string" max:"256"`
 
 	// The ID of the request. Use this ID to track when the change has completed
 	// across all Amazon Route 53 DNS servers.
-	ID *string `locationName:"Id" type:"string" required:"true"`
+	ID *string `locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The current state of the request. PENDING indicates that this request has
 	// not yet been applied to all Amazon Route 53 DNS servers.
 	//
 	// Valid Values: PENDING | INSYNC
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"PENDING,INSYNC" required:"true"`
 
 	// The date and time the change was submitted, in the format YYYY-MM-DDThh:mm:ssZ,
 	// as specified in the ISO 8601 standard (for example, 2009-11-19T19:37:58Z).
@@ -1381,7 +1760,7 @@ type ChangeResourceRecordSetsInput struct {
 
 	// The ID of the hosted zone that contains the resource record sets that you
 	// want to change.
-	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataChangeResourceRecordSetsInput `json:"-", xml:"-"`
 }
@@ -1411,20 +1790,20 @@ type metadataChangeResourceRecordSetsOutput struct {
 type ChangeTagsForResourceInput struct {
 	// A complex type that contains a list of Tag elements. Each Tag element identifies
 	// a tag that you want to add or update for the specified resource.
-	AddTags []*Tag `locationNameList:"Tag" type:"list"`
+	AddTags []*Tag `locationNameList:"Tag" type:"list" min:"1" max:"10"`
 
 	// A list of Tag keys that you want to remove from the specified resource.
-	RemoveTagKeys []*string `locationNameList:"Key" type:"list"`
+	RemoveTagKeys []*string `locationNameList:"Key" type:"list" min:"1" max:"10"`
 
 	// The ID of the resource for which you want to add, change, or delete tags.
-	ResourceID *string `location:"uri" locationName:"ResourceId" type:"string" required:"true"`
+	ResourceID *string `location:"uri" locationName:"ResourceId" type:"string" max:"64" required:"true"`
 
 	// The type of the resource.
 	//
 	// - The resource type for health checks is healthcheck.
 	//
 	// - The resource type for hosted zones is hostedzone.
-	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" required:"true"`
+	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" enum:"healthcheck,hostedzone" required:"true"`
 
 	metadataChangeTagsForResourceInput `json:"-", xml:"-"`
 }
@@ -1453,7 +1832,7 @@ type CreateHealthCheckInput struct {
 	//
 	// Valid characters are any Unicode code points that are legal in an XML 1.0
 	// document. The UTF-8 encoding of the value must be less than 128 bytes.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// A complex type that contains health check configuration.
 	HealthCheckConfig *HealthCheckConfig `type:"structure" required:"true"`
@@ -1471,7 +1850,7 @@ type CreateHealthCheckOutput struct {
 	HealthCheck *HealthCheck `type:"structure" required:"true"`
 
 	// The unique URL representing the new health check.
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+	Location *string `location:"header" locationName:"Location" type:"string" max:"1024" required:"true"`
 
 	metadataCreateHealthCheckOutput `json:"-", xml:"-"`
 }
@@ -1491,11 +1870,11 @@ type CreateHostedZoneInput struct {
 	//
 	// Valid characters are any Unicode code points that are legal in an XML 1.0
 	// document. The UTF-8 encoding of the value must be less than 128 bytes.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The delegation set id of the reusable delgation set whose NS records you
 	// want to assign to the new hosted zone.
-	DelegationSetID *string `locationName:"DelegationSetId" type:"string"`
+	DelegationSetID *string `locationName:"DelegationSetId" type:"string" max:"32"`
 
 	// A complex type that contains an optional comment about your hosted zone.
 	HostedZoneConfig *HostedZoneConfig `type:"structure"`
@@ -1508,7 +1887,7 @@ type CreateHostedZoneInput struct {
 	// This is the name you have registered with your DNS registrar. You should
 	// ask your registrar to change the authoritative name servers for your domain
 	// to the set of NameServers elements returned in DelegationSet.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"1024" required:"true"`
 
 	// The VPC that you want your hosted zone to be associated with. By providing
 	// this parameter, your newly created hosted cannot be resolved anywhere other
@@ -1536,7 +1915,7 @@ type CreateHostedZoneOutput struct {
 	HostedZone *HostedZone `type:"structure" required:"true"`
 
 	// The unique URL representing the new hosted zone.
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+	Location *string `location:"header" locationName:"Location" type:"string" max:"1024" required:"true"`
 
 	VPC *VPC `type:"structure"`
 
@@ -1556,11 +1935,11 @@ type CreateReusableDelegationSetInput struct {
 	//
 	// Valid characters are any Unicode code points that are legal in an XML 1.0
 	// document. The UTF-8 encoding of the value must be less than 128 bytes.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The ID of the hosted zone whose delegation set you want to mark as reusable.
 	// It is an optional parameter.
-	HostedZoneID *string `locationName:"HostedZoneId" type:"string"`
+	HostedZoneID *string `locationName:"HostedZoneId" type:"string" max:"32"`
 
 	metadataCreateReusableDelegationSetInput `json:"-", xml:"-"`
 }
@@ -1574,7 +1953,7 @@ type CreateReusableDelegationSetOutput struct {
 	DelegationSet *DelegationSet `type:"structure" required:"true"`
 
 	// The unique URL representing the new reusbale delegation set.
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+	Location *string `location:"header" locationName:"Location" type:"string" max:"1024" required:"true"`
 
 	metadataCreateReusableDelegationSetOutput `json:"-", xml:"-"`
 }
@@ -1585,14 +1964,14 @@ type metadataCreateReusableDelegationSetOutput struct {
 
 // A complex type that contains name server information.
 type DelegationSet struct {
-	CallerReference *string `type:"string"`
+	CallerReference *string `type:"string" min:"1" max:"128"`
 
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" max:"32"`
 
 	// A complex type that contains the authoritative name servers for the hosted
 	// zone. Use the method provided by your domain registrar to add an NS record
 	// to your domain for each NameServer that is assigned to your hosted zone.
-	NameServers []*string `locationNameList:"NameServer" type:"list" required:"true"`
+	NameServers []*string `locationNameList:"NameServer" type:"list" min:"1" required:"true"`
 
 	metadataDelegationSet `json:"-", xml:"-"`
 }
@@ -1604,7 +1983,7 @@ type metadataDelegationSet struct {
 // A complex type containing the request information for delete health check.
 type DeleteHealthCheckInput struct {
 	// The ID of the health check to delete.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	metadataDeleteHealthCheckInput `json:"-", xml:"-"`
 }
@@ -1626,7 +2005,7 @@ type metadataDeleteHealthCheckOutput struct {
 // to delete.
 type DeleteHostedZoneInput struct {
 	// The ID of the hosted zone you want to delete.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataDeleteHostedZoneInput `json:"-", xml:"-"`
 }
@@ -1651,7 +2030,7 @@ type metadataDeleteHostedZoneOutput struct {
 // A complex type containing the information for the delete request.
 type DeleteReusableDelegationSetInput struct {
 	// The ID of the reusable delegation set you want to delete.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataDeleteReusableDelegationSetInput `json:"-", xml:"-"`
 }
@@ -1678,7 +2057,7 @@ type DisassociateVPCFromHostedZoneInput struct {
 	// The ID of the hosted zone you want to disassociate your VPC from.
 	//
 	// Note that you cannot disassociate the last VPC from a hosted zone.
-	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The VPC that you want your hosted zone to be disassociated from.
 	VPC *VPC `type:"structure" required:"true"`
@@ -1712,21 +2091,21 @@ type GeoLocation struct {
 	//
 	// Constraint: Specifying ContinentCode with either CountryCode or SubdivisionCode
 	// returns an InvalidInput error.
-	ContinentCode *string `type:"string"`
+	ContinentCode *string `type:"string" min:"2" max:"2"`
 
 	// The code for a country geo location. The default location uses '*' for the
 	// country code and will match all locations that are not matched by a geo location.
 	//
 	// The default geo location uses a * for the country code. All other country
 	// codes follow the ISO 3166 two-character code.
-	CountryCode *string `type:"string"`
+	CountryCode *string `type:"string" min:"1" max:"2"`
 
 	// The code for a country's subdivision (e.g., a province of Canada). A subdivision
 	// code is only valid with the appropriate country code.
 	//
 	// Constraint: Specifying SubdivisionCode without CountryCode returns an InvalidInput
 	// error.
-	SubdivisionCode *string `type:"string"`
+	SubdivisionCode *string `type:"string" min:"1" max:"3"`
 
 	metadataGeoLocation `json:"-", xml:"-"`
 }
@@ -1739,30 +2118,30 @@ type metadataGeoLocation struct {
 type GeoLocationDetails struct {
 	// The code for a continent geo location. Note: only continent locations have
 	// a continent code.
-	ContinentCode *string `type:"string"`
+	ContinentCode *string `type:"string" min:"2" max:"2"`
 
 	// The name of the continent. This element is only present if ContinentCode
 	// is also present.
-	ContinentName *string `type:"string"`
+	ContinentName *string `type:"string" min:"1" max:"32"`
 
 	// The code for a country geo location. The default location uses '*' for the
 	// country code and will match all locations that are not matched by a geo location.
 	//
 	// The default geo location uses a * for the country code. All other country
 	// codes follow the ISO 3166 two-character code.
-	CountryCode *string `type:"string"`
+	CountryCode *string `type:"string" min:"1" max:"2"`
 
 	// The name of the country. This element is only present if CountryCode is also
 	// present.
-	CountryName *string `type:"string"`
+	CountryName *string `type:"string" min:"1" max:"64"`
 
 	// The code for a country's subdivision (e.g., a province of Canada). A subdivision
 	// code is only valid with the appropriate country code.
-	SubdivisionCode *string `type:"string"`
+	SubdivisionCode *string `type:"string" min:"1" max:"3"`
 
 	// The name of the subdivision. This element is only present if SubdivisionCode
 	// is also present.
-	SubdivisionName *string `type:"string"`
+	SubdivisionName *string `type:"string" min:"1" max:"64"`
 
 	metadataGeoLocationDetails `json:"-", xml:"-"`
 }
@@ -1776,7 +2155,7 @@ type GetChangeInput struct {
 	// The ID of the change batch request. The value that you specify here is the
 	// value that ChangeResourceRecordSets returned in the Id element when you submitted
 	// the request.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataGetChangeInput `json:"-", xml:"-"`
 }
@@ -1830,21 +2209,21 @@ type GetGeoLocationInput struct {
 	//
 	// Constraint: Specifying ContinentCode with either CountryCode or SubdivisionCode
 	// returns an InvalidInput error.
-	ContinentCode *string `location:"querystring" locationName:"continentcode" type:"string"`
+	ContinentCode *string `location:"querystring" locationName:"continentcode" type:"string" min:"2" max:"2"`
 
 	// The code for a country geo location. The default location uses '*' for the
 	// country code and will match all locations that are not matched by a geo location.
 	//
 	// The default geo location uses a * for the country code. All other country
 	// codes follow the ISO 3166 two-character code.
-	CountryCode *string `location:"querystring" locationName:"countrycode" type:"string"`
+	CountryCode *string `location:"querystring" locationName:"countrycode" type:"string" min:"1" max:"2"`
 
 	// The code for a country's subdivision (e.g., a province of Canada). A subdivision
 	// code is only valid with the appropriate country code.
 	//
 	// Constraint: Specifying SubdivisionCode without CountryCode returns an InvalidInput
 	// error.
-	SubdivisionCode *string `location:"querystring" locationName:"subdivisioncode" type:"string"`
+	SubdivisionCode *string `location:"querystring" locationName:"subdivisioncode" type:"string" min:"1" max:"3"`
 
 	metadataGetGeoLocationInput `json:"-", xml:"-"`
 }
@@ -1892,7 +2271,7 @@ type metadataGetHealthCheckCountOutput struct {
 // check.
 type GetHealthCheckInput struct {
 	// The ID of the health check to retrieve.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	metadataGetHealthCheckInput `json:"-", xml:"-"`
 }
@@ -1906,7 +2285,7 @@ type metadataGetHealthCheckInput struct {
 type GetHealthCheckLastFailureReasonInput struct {
 	// The ID of the health check for which you want to retrieve the reason for
 	// the most recent failure.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	metadataGetHealthCheckLastFailureReasonInput `json:"-", xml:"-"`
 }
@@ -1946,7 +2325,7 @@ type metadataGetHealthCheckOutput struct {
 type GetHealthCheckStatusInput struct {
 	// The ID of the health check for which you want to retrieve the most recent
 	// status.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	metadataGetHealthCheckStatusInput `json:"-", xml:"-"`
 }
@@ -1996,7 +2375,7 @@ type metadataGetHostedZoneCountOutput struct {
 type GetHostedZoneInput struct {
 	// The ID of the hosted zone for which you want to get a list of the name servers
 	// in the delegation set.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataGetHostedZoneInput `json:"-", xml:"-"`
 }
@@ -2016,7 +2395,7 @@ type GetHostedZoneOutput struct {
 
 	// A complex type that contains information about VPCs associated with the specified
 	// hosted zone.
-	VPCs []*VPC `locationNameList:"VPC" type:"list"`
+	VPCs []*VPC `locationNameList:"VPC" type:"list" min:"1"`
 
 	metadataGetHostedZoneOutput `json:"-", xml:"-"`
 }
@@ -2029,7 +2408,7 @@ type metadataGetHostedZoneOutput struct {
 type GetReusableDelegationSetInput struct {
 	// The ID of the reusable delegation set for which you want to get a list of
 	// the name server.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataGetReusableDelegationSetInput `json:"-", xml:"-"`
 }
@@ -2055,7 +2434,7 @@ type metadataGetReusableDelegationSetOutput struct {
 // A complex type that contains identifying information about the health check.
 type HealthCheck struct {
 	// A unique string that identifies the request to create the health check.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// A complex type that contains the health check configuration.
 	HealthCheckConfig *HealthCheckConfig `type:"structure" required:"true"`
@@ -2063,10 +2442,10 @@ type HealthCheck struct {
 	// The version of the health check. You can optionally pass this value in a
 	// call to UpdateHealthCheck to prevent overwriting another change to the health
 	// check.
-	HealthCheckVersion *int64 `type:"long" required:"true"`
+	HealthCheckVersion *int64 `type:"long" min:"1" required:"true"`
 
 	// The ID of the specified health check.
-	ID *string `locationName:"Id" type:"string" required:"true"`
+	ID *string `locationName:"Id" type:"string" max:"64" required:"true"`
 
 	metadataHealthCheck `json:"-", xml:"-"`
 }
@@ -2084,38 +2463,38 @@ type HealthCheckConfig struct {
 	// Valid values are integers between 1 and 10. For more information, see "How
 	// Amazon Route 53 Determines Whether an Endpoint Is Healthy" in the Amazon
 	// Route 53 Developer Guide.
-	FailureThreshold *int64 `type:"integer"`
+	FailureThreshold *int64 `type:"integer" min:"1" max:"10"`
 
 	// Fully qualified domain name of the instance to be health checked.
-	FullyQualifiedDomainName *string `type:"string"`
+	FullyQualifiedDomainName *string `type:"string" max:"255"`
 
 	// IP Address of the instance being checked.
-	IPAddress *string `type:"string"`
+	IPAddress *string `type:"string" max:"15" pattern:"^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$"`
 
 	// Port on which connection will be opened to the instance to health check.
 	// For HTTP and HTTP_STR_MATCH this defaults to 80 if the port is not specified.
 	// For HTTPS and HTTPS_STR_MATCH this defaults to 443 if the port is not specified.
-	Port *int64 `type:"integer"`
+	Port *int64 `type:"integer" min:"1" max:"65535"`
 
 	// The number of seconds between the time that Route 53 gets a response from
 	// your endpoint and the time that it sends the next health-check request.
 	//
 	// Each Route 53 health checker makes requests at this interval. Valid values
 	// are 10 and 30. The default value is 30.
-	RequestInterval *int64 `type:"integer"`
+	RequestInterval *int64 `type:"integer" min:"10" max:"30"`
 
 	// Path to ping on the instance to check the health. Required for HTTP, HTTPS,
 	// HTTP_STR_MATCH, and HTTPS_STR_MATCH health checks, HTTP request is issued
 	// to the instance on the given port and path.
-	ResourcePath *string `type:"string"`
+	ResourcePath *string `type:"string" max:"255"`
 
 	// A string to search for in the body of a health check response. Required for
 	// HTTP_STR_MATCH and HTTPS_STR_MATCH health checks.
-	SearchString *string `type:"string"`
+	SearchString *string `type:"string" max:"255"`
 
 	// The type of health check to be performed. Currently supported types are TCP,
 	// HTTP, HTTPS, HTTP_STR_MATCH, and HTTPS_STR_MATCH.
-	Type *string `type:"string" required:"true"`
+	Type *string `type:"string" enum:"HTTP,HTTPS,HTTP_STR_MATCH,HTTPS_STR_MATCH,TCP" required:"true"`
 
 	metadataHealthCheckConfig `json:"-", xml:"-"`
 }
@@ -2128,7 +2507,7 @@ type metadataHealthCheckConfig struct {
 // and the reason for the health check status.
 type HealthCheckObservation struct {
 	// The IP address of the Route 53 health checker that performed the health check.
-	IPAddress *string `type:"string"`
+	IPAddress *string `type:"string" max:"15" pattern:"^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$"`
 
 	// A complex type that contains information about the health check status for
 	// the current observation.
@@ -2144,13 +2523,13 @@ type metadataHealthCheckObservation struct {
 // A complex type that contain information about the specified hosted zone.
 type HostedZone struct {
 	// A unique string that identifies the request to create the hosted zone.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// A complex type that contains the Comment element.
 	Config *HostedZoneConfig `type:"structure"`
 
 	// The ID of the specified hosted zone.
-	ID *string `locationName:"Id" type:"string" required:"true"`
+	ID *string `locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The name of the domain. This must be a fully-specified domain, for example,
 	// www.example.com. The trailing dot is optional; Route 53 assumes that the
@@ -2160,7 +2539,7 @@ type HostedZone struct {
 	// This is the name you have registered with your DNS registrar. You should
 	// ask your registrar to change the authoritative name servers for your domain
 	// to the set of NameServers elements returned in DelegationSet.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"1024" required:"true"`
 
 	// Total number of resource record sets in the hosted zone.
 	ResourceRecordSetCount *int64 `type:"long"`
@@ -2179,7 +2558,7 @@ type HostedZoneConfig struct {
 	// An optional comment about your hosted zone. If you don't want to specify
 	// a comment, you can omit the HostedZoneConfig and Comment elements from the
 	// XML document.
-	Comment *string `type:"string"`
+	Comment *string `type:"string" max:"256"`
 
 	// A value that indicates whether this is a private hosted zone. The value is
 	// returned in the response; do not specify it in the request.
@@ -2205,21 +2584,21 @@ type ListGeoLocationsInput struct {
 	//
 	// Constraint: Specifying ContinentCode with either CountryCode or SubdivisionCode
 	// returns an InvalidInput error.
-	StartContinentCode *string `location:"querystring" locationName:"startcontinentcode" type:"string"`
+	StartContinentCode *string `location:"querystring" locationName:"startcontinentcode" type:"string" min:"2" max:"2"`
 
 	// The first country code in the lexicographic ordering of geo locations that
 	// you want the ListGeoLocations request to list.
 	//
 	// The default geo location uses a * for the country code. All other country
 	// codes follow the ISO 3166 two-character code.
-	StartCountryCode *string `location:"querystring" locationName:"startcountrycode" type:"string"`
+	StartCountryCode *string `location:"querystring" locationName:"startcountrycode" type:"string" min:"1" max:"2"`
 
 	// The first subdivision code in the lexicographic ordering of geo locations
 	// that you want the ListGeoLocations request to list.
 	//
 	// Constraint: Specifying SubdivisionCode without CountryCode returns an InvalidInput
 	// error.
-	StartSubdivisionCode *string `location:"querystring" locationName:"startsubdivisioncode" type:"string"`
+	StartSubdivisionCode *string `location:"querystring" locationName:"startsubdivisioncode" type:"string" min:"1" max:"3"`
 
 	metadataListGeoLocationsInput `json:"-", xml:"-"`
 }
@@ -2251,17 +2630,17 @@ type ListGeoLocationsOutput struct {
 	// If the results were truncated, the continent code of the next geo location
 	// in the list. This element is present only if ListGeoLocationsResponse$IsTruncated
 	// is true and the next geo location to list is a continent location.
-	NextContinentCode *string `type:"string"`
+	NextContinentCode *string `type:"string" min:"2" max:"2"`
 
 	// If the results were truncated, the country code of the next geo location
 	// in the list. This element is present only if ListGeoLocationsResponse$IsTruncated
 	// is true and the next geo location to list is not a continent location.
-	NextCountryCode *string `type:"string"`
+	NextCountryCode *string `type:"string" min:"1" max:"2"`
 
 	// If the results were truncated, the subdivision code of the next geo location
 	// in the list. This element is present only if ListGeoLocationsResponse$IsTruncated
 	// is true and the next geo location has a subdivision.
-	NextSubdivisionCode *string `type:"string"`
+	NextSubdivisionCode *string `type:"string" min:"1" max:"3"`
 
 	metadataListGeoLocationsOutput `json:"-", xml:"-"`
 }
@@ -2283,7 +2662,7 @@ type ListHealthChecksInput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `location:"querystring" locationName:"marker" type:"string"`
+	Marker *string `location:"querystring" locationName:"marker" type:"string" max:"64"`
 
 	// Specify the maximum number of health checks to return per page of results.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
@@ -2311,7 +2690,7 @@ type ListHealthChecksOutput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `type:"string" required:"true"`
+	Marker *string `type:"string" max:"64" required:"true"`
 
 	// The maximum number of health checks to be included in the response body.
 	// If the number of health checks associated with this AWS account exceeds MaxItems,
@@ -2323,7 +2702,7 @@ type ListHealthChecksOutput struct {
 	// Indicates where to continue listing health checks. If ListHealthChecksResponse$IsTruncated
 	// is true, make another request to ListHealthChecks and include the value of
 	// the NextMarker element in the Marker element to get the next page of results.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" max:"64"`
 
 	metadataListHealthChecksOutput `json:"-", xml:"-"`
 }
@@ -2351,12 +2730,12 @@ type ListHostedZonesByNameInput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextDNSName and NextHostedZoneId from the last response
 	// in the DNSName and HostedZoneId parameters to get the next page of results.
-	DNSName *string `location:"querystring" locationName:"dnsname" type:"string"`
+	DNSName *string `location:"querystring" locationName:"dnsname" type:"string" max:"1024"`
 
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextDNSName and NextHostedZoneId from the last response
 	// in the DNSName and HostedZoneId parameters to get the next page of results.
-	HostedZoneID *string `location:"querystring" locationName:"hostedzoneid" type:"string"`
+	HostedZoneID *string `location:"querystring" locationName:"hostedzoneid" type:"string" max:"32"`
 
 	// Specify the maximum number of hosted zones to return per page of results.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
@@ -2371,10 +2750,10 @@ type metadataListHostedZonesByNameInput struct {
 // A complex type that contains the response for the request.
 type ListHostedZonesByNameOutput struct {
 	// The DNSName value sent in the request.
-	DNSName *string `type:"string"`
+	DNSName *string `type:"string" max:"1024"`
 
 	// The HostedZoneId value sent in the request.
-	HostedZoneID *string `locationName:"HostedZoneId" type:"string"`
+	HostedZoneID *string `locationName:"HostedZoneId" type:"string" max:"32"`
 
 	// A complex type that contains information about the hosted zones associated
 	// with the current AWS account.
@@ -2400,14 +2779,14 @@ type ListHostedZonesByNameOutput struct {
 	// make another request to ListHostedZonesByName. Specify the value of ListHostedZonesByNameResponse$NextDNSName
 	// in the ListHostedZonesByNameRequest$DNSName element and ListHostedZonesByNameResponse$NextHostedZoneId
 	// in the ListHostedZonesByNameRequest$HostedZoneId element.
-	NextDNSName *string `type:"string"`
+	NextDNSName *string `type:"string" max:"1024"`
 
 	// If ListHostedZonesByNameResponse$IsTruncated is true, there are more hosted
 	// zones associated with the current AWS account. To get the next page of results,
 	// make another request to ListHostedZonesByName. Specify the value of ListHostedZonesByNameResponse$NextDNSName
 	// in the ListHostedZonesByNameRequest$DNSName element and ListHostedZonesByNameResponse$NextHostedZoneId
 	// in the ListHostedZonesByNameRequest$HostedZoneId element.
-	NextHostedZoneID *string `locationName:"NextHostedZoneId" type:"string"`
+	NextHostedZoneID *string `locationName:"NextHostedZoneId" type:"string" max:"32"`
 
 	metadataListHostedZonesByNameOutput `json:"-", xml:"-"`
 }
@@ -2429,12 +2808,12 @@ type metadataListHostedZonesByNameOutput struct {
 //  Route 53 returns a maximum of 100 items. If you set MaxItems to a value
 // greater than 100, Route 53 returns only the first 100.
 type ListHostedZonesInput struct {
-	DelegationSetID *string `location:"querystring" locationName:"delegationsetid" type:"string"`
+	DelegationSetID *string `location:"querystring" locationName:"delegationsetid" type:"string" max:"32"`
 
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `location:"querystring" locationName:"marker" type:"string"`
+	Marker *string `location:"querystring" locationName:"marker" type:"string" max:"64"`
 
 	// Specify the maximum number of hosted zones to return per page of results.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
@@ -2462,7 +2841,7 @@ type ListHostedZonesOutput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `type:"string" required:"true"`
+	Marker *string `type:"string" max:"64" required:"true"`
 
 	// The maximum number of hosted zones to be included in the response body. If
 	// the number of hosted zones associated with this AWS account exceeds MaxItems,
@@ -2474,7 +2853,7 @@ type ListHostedZonesOutput struct {
 	// Indicates where to continue listing hosted zones. If ListHostedZonesResponse$IsTruncated
 	// is true, make another request to ListHostedZones and include the value of
 	// the NextMarker element in the Marker element to get the next page of results.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" max:"64"`
 
 	metadataListHostedZonesOutput `json:"-", xml:"-"`
 }
@@ -2487,7 +2866,7 @@ type metadataListHostedZonesOutput struct {
 type ListResourceRecordSetsInput struct {
 	// The ID of the hosted zone that contains the resource record sets that you
 	// want to get.
-	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The maximum number of records you want in the response body.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
@@ -2496,11 +2875,11 @@ type ListResourceRecordSetsInput struct {
 	// DNS name and type, specify the value of ListResourceRecordSetsResponse$NextRecordIdentifier
 	// from the previous response to get the next resource record set that has the
 	// current DNS name and type.
-	StartRecordIdentifier *string `location:"querystring" locationName:"identifier" type:"string"`
+	StartRecordIdentifier *string `location:"querystring" locationName:"identifier" type:"string" min:"1" max:"128"`
 
 	// The first name in the lexicographic ordering of domain names that you want
 	// the ListResourceRecordSets request to list.
-	StartRecordName *string `location:"querystring" locationName:"name" type:"string"`
+	StartRecordName *string `location:"querystring" locationName:"name" type:"string" max:"1024"`
 
 	// The DNS type at which to begin the listing of resource record sets.
 	//
@@ -2514,7 +2893,7 @@ type ListResourceRecordSetsInput struct {
 	//
 	// Constraint: Specifying type without specifying name returns an InvalidInput
 	// error.
-	StartRecordType *string `location:"querystring" locationName:"type" type:"string"`
+	StartRecordType *string `location:"querystring" locationName:"type" type:"string" enum:"SOA,A,TXT,NS,CNAME,MX,PTR,SRV,SPF,AAAA"`
 
 	metadataListResourceRecordSetsInput `json:"-", xml:"-"`
 }
@@ -2541,17 +2920,17 @@ type ListResourceRecordSetsOutput struct {
 	// Weighted resource record sets only: If results were truncated for a given
 	// DNS name and type, the value of SetIdentifier for the next resource record
 	// set that has the current DNS name and type.
-	NextRecordIdentifier *string `type:"string"`
+	NextRecordIdentifier *string `type:"string" min:"1" max:"128"`
 
 	// If the results were truncated, the name of the next record in the list. This
 	// element is present only if ListResourceRecordSetsResponse$IsTruncated is
 	// true.
-	NextRecordName *string `type:"string"`
+	NextRecordName *string `type:"string" max:"1024"`
 
 	// If the results were truncated, the type of the next record in the list. This
 	// element is present only if ListResourceRecordSetsResponse$IsTruncated is
 	// true.
-	NextRecordType *string `type:"string"`
+	NextRecordType *string `type:"string" enum:"SOA,A,TXT,NS,CNAME,MX,PTR,SRV,SPF,AAAA"`
 
 	// A complex type that contains information about the resource record sets that
 	// are returned by the request.
@@ -2578,7 +2957,7 @@ type ListReusableDelegationSetsInput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `location:"querystring" locationName:"marker" type:"string"`
+	Marker *string `location:"querystring" locationName:"marker" type:"string" max:"64"`
 
 	// Specify the maximum number of reusable delegation sets to return per page
 	// of results.
@@ -2607,7 +2986,7 @@ type ListReusableDelegationSetsOutput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `type:"string" required:"true"`
+	Marker *string `type:"string" max:"64" required:"true"`
 
 	// The maximum number of reusable delegation sets to be included in the response
 	// body. If the number of reusable delegation sets associated with this AWS
@@ -2621,7 +3000,7 @@ type ListReusableDelegationSetsOutput struct {
 	// is true, make another request to ListReusableDelegationSets and include the
 	// value of the NextMarker element in the Marker element to get the next page
 	// of results.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" max:"64"`
 
 	metadataListReusableDelegationSetsOutput `json:"-", xml:"-"`
 }
@@ -2634,14 +3013,14 @@ type metadataListReusableDelegationSetsOutput struct {
 // that are associated with an individual resource.
 type ListTagsForResourceInput struct {
 	// The ID of the resource for which you want to retrieve tags.
-	ResourceID *string `location:"uri" locationName:"ResourceId" type:"string" required:"true"`
+	ResourceID *string `location:"uri" locationName:"ResourceId" type:"string" max:"64" required:"true"`
 
 	// The type of the resource.
 	//
 	// - The resource type for health checks is healthcheck.
 	//
 	// - The resource type for hosted zones is hostedzone.
-	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" required:"true"`
+	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" enum:"healthcheck,hostedzone" required:"true"`
 
 	metadataListTagsForResourceInput `json:"-", xml:"-"`
 }
@@ -2667,14 +3046,14 @@ type metadataListTagsForResourceOutput struct {
 type ListTagsForResourcesInput struct {
 	// A complex type that contains the ResourceId element for each resource for
 	// which you want to get a list of tags.
-	ResourceIDs []*string `locationName:"ResourceIds" locationNameList:"ResourceId" type:"list" required:"true"`
+	ResourceIDs []*string `locationName:"ResourceIds" locationNameList:"ResourceId" type:"list" min:"1" max:"10" required:"true"`
 
 	// The type of the resources.
 	//
 	// - The resource type for health checks is healthcheck.
 	//
 	// - The resource type for hosted zones is hostedzone.
-	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" required:"true"`
+	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" enum:"healthcheck,hostedzone" required:"true"`
 
 	metadataListTagsForResourcesInput `json:"-", xml:"-"`
 }
@@ -2699,7 +3078,7 @@ type metadataListTagsForResourcesOutput struct {
 // resource record set.
 type ResourceRecord struct {
 	// The value of the Value element for the current resource record set.
-	Value *string `type:"string" required:"true"`
+	Value *string `type:"string" max:"4000" required:"true"`
 
 	metadataResourceRecord `json:"-", xml:"-"`
 }
@@ -2731,7 +3110,7 @@ type ResourceRecordSet struct {
 	// set.
 	//
 	// Valid values: PRIMARY | SECONDARY
-	Failover *string `type:"string"`
+	Failover *string `type:"string" enum:"PRIMARY,SECONDARY"`
 
 	// Geo location resource record sets only: Among resource record sets that have
 	// the same combination of DNS name and type, a value that specifies the geo
@@ -2741,36 +3120,36 @@ type ResourceRecordSet struct {
 	// Health Check resource record sets only, not required for alias resource record
 	// sets: An identifier that is used to identify health check associated with
 	// the resource record set.
-	HealthCheckID *string `locationName:"HealthCheckId" type:"string"`
+	HealthCheckID *string `locationName:"HealthCheckId" type:"string" max:"64"`
 
 	// The domain name of the current resource record set.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"1024" required:"true"`
 
 	// Latency-based resource record sets only: Among resource record sets that
 	// have the same combination of DNS name and type, a value that specifies the
 	// AWS region for the current resource record set.
-	Region *string `type:"string"`
+	Region *string `type:"string" min:"1" max:"64" enum:"us-east-1,us-west-1,us-west-2,eu-west-1,eu-central-1,ap-southeast-1,ap-southeast-2,ap-northeast-1,sa-east-1,cn-north-1"`
 
 	// A complex type that contains the resource records for the current resource
 	// record set.
-	ResourceRecords []*ResourceRecord `locationNameList:"ResourceRecord" type:"list"`
+	ResourceRecords []*ResourceRecord `locationNameList:"ResourceRecord" type:"list" min:"1"`
 
 	// Weighted, Latency, Geo, and Failover resource record sets only: An identifier
 	// that differentiates among multiple resource record sets that have the same
 	// combination of DNS name and type.
-	SetIdentifier *string `type:"string"`
+	SetIdentifier *string `type:"string" min:"1" max:"128"`
 
 	// The cache time to live for the current resource record set.
-	TTL *int64 `type:"long"`
+	TTL *int64 `type:"long" min:"0" max:"2147483647"`
 
 	// The type of the current resource record set.
-	Type *string `type:"string" required:"true"`
+	Type *string `type:"string" enum:"SOA,A,TXT,NS,CNAME,MX,PTR,SRV,SPF,AAAA" required:"true"`
 
 	// Weighted resource record sets only: Among resource record sets that have
 	// the same combination of DNS name and type, a value that determines what portion
 	// of traffic for the current resource record set is routed to the associated
 	// location.
-	Weight *int64 `type:"long"`
+	Weight *int64 `type:"long" min:"0" max:"255"`
 
 	metadataResourceRecordSet `json:"-", xml:"-"`
 }
@@ -2782,17 +3161,17 @@ type metadataResourceRecordSet struct {
 // A complex type containing a resource and its associated tags.
 type ResourceTagSet struct {
 	// The ID for the specified resource.
-	ResourceID *string `locationName:"ResourceId" type:"string"`
+	ResourceID *string `locationName:"ResourceId" type:"string" max:"64"`
 
 	// The type of the resource.
 	//
 	// - The resource type for health checks is healthcheck.
 	//
 	// - The resource type for hosted zones is hostedzone.
-	ResourceType *string `type:"string"`
+	ResourceType *string `type:"string" enum:"healthcheck,hostedzone"`
 
 	// The tags associated with the specified resource.
-	Tags []*Tag `locationNameList:"Tag" type:"list"`
+	Tags []*Tag `locationNameList:"Tag" type:"list" min:"1" max:"10"`
 
 	metadataResourceTagSet `json:"-", xml:"-"`
 }
@@ -2823,10 +3202,10 @@ type metadataStatusReport struct {
 // A single tag containing a key and value.
 type Tag struct {
 	// The key for a Tag.
-	Key *string `type:"string"`
+	Key *string `type:"string" max:"128"`
 
 	// The value for a Tag.
-	Value *string `type:"string"`
+	Value *string `type:"string" max:"256"`
 
 	metadataTag `json:"-", xml:"-"`
 }
@@ -2847,32 +3226,32 @@ type UpdateHealthCheckInput struct {
 	// Route 53 Developer Guide.
 	//
 	// Specify this value only if you want to change it.
-	FailureThreshold *int64 `type:"integer"`
+	FailureThreshold *int64 `type:"integer" min:"1" max:"10"`
 
 	// Fully qualified domain name of the instance to be health checked.
 	//
 	// Specify this value only if you want to change it.
-	FullyQualifiedDomainName *string `type:"string"`
+	FullyQualifiedDomainName *string `type:"string" max:"255"`
 
 	// The ID of the health check to update.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	// Optional. When you specify a health check version, Route 53 compares this
 	// value with the current value in the health check, which prevents you from
 	// updating the health check when the versions don't match. Using HealthCheckVersion
 	// lets you prevent overwriting another change to the health check.
-	HealthCheckVersion *int64 `type:"long"`
+	HealthCheckVersion *int64 `type:"long" min:"1"`
 
 	// The IP address of the resource that you want to check.
 	//
 	// Specify this value only if you want to change it.
-	IPAddress *string `type:"string"`
+	IPAddress *string `type:"string" max:"15" pattern:"^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$"`
 
 	// The port on which you want Route 53 to open a connection to perform health
 	// checks.
 	//
 	// Specify this value only if you want to change it.
-	Port *int64 `type:"integer"`
+	Port *int64 `type:"integer" min:"1" max:"65535"`
 
 	// The path that you want Amazon Route 53 to request when performing health
 	// checks. The path can be any value for which your endpoint will return an
@@ -2880,7 +3259,7 @@ type UpdateHealthCheckInput struct {
 	// the file /docs/route53-health-check.html.
 	//
 	// Specify this value only if you want to change it.
-	ResourcePath *string `type:"string"`
+	ResourcePath *string `type:"string" max:"255"`
 
 	// If the value of Type is HTTP_STR_MATCH or HTTP_STR_MATCH, the string that
 	// you want Route 53 to search for in the response body from the specified resource.
@@ -2888,7 +3267,7 @@ type UpdateHealthCheckInput struct {
 	// healthy.
 	//
 	// Specify this value only if you want to change it.
-	SearchString *string `type:"string"`
+	SearchString *string `type:"string" max:"255"`
 
 	metadataUpdateHealthCheckInput `json:"-", xml:"-"`
 }
@@ -2912,10 +3291,10 @@ type metadataUpdateHealthCheckOutput struct {
 // zone comment.
 type UpdateHostedZoneCommentInput struct {
 	// A comment about your hosted zone.
-	Comment *string `type:"string"`
+	Comment *string `type:"string" max:"256"`
 
 	// The ID of the hosted zone you want to update.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataUpdateHostedZoneCommentInput `json:"-", xml:"-"`
 }
@@ -2939,13 +3318,181 @@ type metadataUpdateHostedZoneCommentOutput struct {
 
 type VPC struct {
 	// A VPC ID
-	VPCID *string `locationName:"VPCId" type:"string"`
+	VPCID *string `locationName:"VPCId" type:"string" max:"1024"`
 
-	VPCRegion *string `type:"string"`
+	VPCRegion *string `type:"string" min:"1" max:"64" enum:"us-east-1,us-west-1,us-west-2,eu-west-1,eu-central-1,ap-southeast-1,ap-southeast-2,ap-northeast-1,sa-east-1,cn-north-1"`
 
 	metadataVPC `json:"-", xml:"-"`
 }
 
 type metadataVPC struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for ChangeAction.
+const (
+	ChangeActionCreate = "CREATE"
+	ChangeActionDelete = "DELETE"
+	ChangeActionUpsert = "UPSERT"
+)
+
+// ChangeActionValues returns the valid values of ChangeAction.
+func ChangeActionValues() []string {
+	return []string{
+		ChangeActionCreate,
+		ChangeActionDelete,
+		ChangeActionUpsert,
+	}
+}
+
+// Enum values for ChangeStatus.
+const (
+	ChangeStatusPending = "PENDING"
+	ChangeStatusInsync  = "INSYNC"
+)
+
+// ChangeStatusValues returns the valid values of ChangeStatus.
+func ChangeStatusValues() []string {
+	return []string{
+		ChangeStatusPending,
+		ChangeStatusInsync,
+	}
+}
+
+// Enum values for HealthCheckType.
+const (
+	HealthCheckTypeHTTP          = "HTTP"
+	HealthCheckTypeHttps         = "HTTPS"
+	HealthCheckTypeHttpStrMatch  = "HTTP_STR_MATCH"
+	HealthCheckTypeHttpsStrMatch = "HTTPS_STR_MATCH"
+	HealthCheckTypeTcp           = "TCP"
+)
+
+// HealthCheckTypeValues returns the valid values of HealthCheckType.
+func HealthCheckTypeValues() []string {
+	return []string{
+		HealthCheckTypeHTTP,
+		HealthCheckTypeHttps,
+		HealthCheckTypeHttpStrMatch,
+		HealthCheckTypeHttpsStrMatch,
+		HealthCheckTypeTcp,
+	}
+}
+
+// Enum values for RRType.
+const (
+	RRTypeSoa   = "SOA"
+	RRTypeA     = "A"
+	RRTypeTxt   = "TXT"
+	RRTypeNS    = "NS"
+	RRTypeCNAME = "CNAME"
+	RRTypeMX    = "MX"
+	RRTypePtr   = "PTR"
+	RRTypeSrv   = "SRV"
+	RRTypeSpf   = "SPF"
+	RRTypeAaaa  = "AAAA"
+)
+
+// RRTypeValues returns the valid values of RRType.
+func RRTypeValues() []string {
+	return []string{
+		RRTypeSoa,
+		RRTypeA,
+		RRTypeTxt,
+		RRTypeNS,
+		RRTypeCNAME,
+		RRTypeMX,
+		RRTypePtr,
+		RRTypeSrv,
+		RRTypeSpf,
+		RRTypeAaaa,
+	}
+}
+
+// Enum values for ResourceRecordSetFailover.
+const (
+	ResourceRecordSetFailoverPrimary   = "PRIMARY"
+	ResourceRecordSetFailoverSecondary = "SECONDARY"
+)
+
+// ResourceRecordSetFailoverValues returns the valid values of ResourceRecordSetFailover.
+func ResourceRecordSetFailoverValues() []string {
+	return []string{
+		ResourceRecordSetFailoverPrimary,
+		ResourceRecordSetFailoverSecondary,
+	}
+}
+
+// Enum values for ResourceRecordSetRegion.
+const (
+	ResourceRecordSetRegionUsEast1      = "us-east-1"
+	ResourceRecordSetRegionUsWest1      = "us-west-1"
+	ResourceRecordSetRegionUsWest2      = "us-west-2"
+	ResourceRecordSetRegionEuWest1      = "eu-west-1"
+	ResourceRecordSetRegionEuCentral1   = "eu-central-1"
+	ResourceRecordSetRegionApSoutheast1 = "ap-southeast-1"
+	ResourceRecordSetRegionApSoutheast2 = "ap-southeast-2"
+	ResourceRecordSetRegionApNortheast1 = "ap-northeast-1"
+	ResourceRecordSetRegionSaEast1      = "sa-east-1"
+	ResourceRecordSetRegionCnNorth1     = "cn-north-1"
+)
+
+// ResourceRecordSetRegionValues returns the valid values of ResourceRecordSetRegion.
+func ResourceRecordSetRegionValues() []string {
+	return []string{
+		ResourceRecordSetRegionUsEast1,
+		ResourceRecordSetRegionUsWest1,
+		ResourceRecordSetRegionUsWest2,
+		ResourceRecordSetRegionEuWest1,
+		ResourceRecordSetRegionEuCentral1,
+		ResourceRecordSetRegionApSoutheast1,
+		ResourceRecordSetRegionApSoutheast2,
+		ResourceRecordSetRegionApNortheast1,
+		ResourceRecordSetRegionSaEast1,
+		ResourceRecordSetRegionCnNorth1,
+	}
+}
+
+// Enum values for TagResourceType.
+const (
+	TagResourceTypeHealthcheck = "healthcheck"
+	TagResourceTypeHostedzone  = "hostedzone"
+)
+
+// TagResourceTypeValues returns the valid values of TagResourceType.
+func TagResourceTypeValues() []string {
+	return []string{
+		TagResourceTypeHealthcheck,
+		TagResourceTypeHostedzone,
+	}
+}
+
+// Enum values for VPCRegion.
+const (
+	VPCRegionUsEast1      = "us-east-1"
+	VPCRegionUsWest1      = "us-west-1"
+	VPCRegionUsWest2      = "us-west-2"
+	VPCRegionEuWest1      = "eu-west-1"
+	VPCRegionEuCentral1   = "eu-central-1"
+	VPCRegionApSoutheast1 = "ap-southeast-1"
+	VPCRegionApSoutheast2 = "ap-southeast-2"
+	VPCRegionApNortheast1 = "ap-northeast-1"
+	VPCRegionSaEast1      = "sa-east-1"
+	VPCRegionCnNorth1     = "cn-north-1"
+)
+
+// VPCRegionValues returns the valid values of VPCRegion.
+func VPCRegionValues() []string {
+	return []string{
+		VPCRegionUsEast1,
+		VPCRegionUsWest1,
+		VPCRegionUsWest2,
+		VPCRegionEuWest1,
+		VPCRegionEuCentral1,
+		VPCRegionApSoutheast1,
+		VPCRegionApSoutheast2,
+		VPCRegionApNortheast1,
+		VPCRegionSaEast1,
+		VPCRegionCnNorth1,
+	}
 }
\ No newline at end of file
@@ -0,0 +1,261 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package route53
+
+import (
+	"github.com/datacratic/aws-sdk-go/aws"
+)
+
+// Error codes of the Route53 errors. Errors with these codes are
+// returned as the typed error of the same name, e.g. an error with the code
+// ErrCodeFoo is a *Foo.
+const (
+	ErrCodeConflictingDomainExists      = "ConflictingDomainExists"
+	ErrCodeDelegationSetAlreadyCreated  = "DelegationSetAlreadyCreated"
+	ErrCodeDelegationSetAlreadyReusable = "DelegationSetAlreadyReusable"
+	ErrCodeDelegationSetInUse           = "DelegationSetInUse"
+	ErrCodeDelegationSetNotAvailable    = "DelegationSetNotAvailable"
+	ErrCodeDelegationSetNotReusable     = "DelegationSetNotReusable"
+	ErrCodeHealthCheckAlreadyExists     = "HealthCheckAlreadyExists"
+	ErrCodeHealthCheckInUse             = "HealthCheckInUse"
+	ErrCodeHealthCheckVersionMismatch   = "HealthCheckVersionMismatch"
+	ErrCodeHostedZoneAlreadyExists      = "HostedZoneAlreadyExists"
+	ErrCodeHostedZoneNotEmpty           = "HostedZoneNotEmpty"
+	ErrCodeHostedZoneNotFound           = "HostedZoneNotFound"
+	ErrCodeIncompatibleVersion          = "IncompatibleVersion"
+	ErrCodeInvalidArgument              = "InvalidArgument"
+	ErrCodeInvalidChangeBatch           = "InvalidChangeBatch"
+	ErrCodeInvalidDomainName            = "InvalidDomainName"
+	ErrCodeInvalidInput                 = "InvalidInput"
+	ErrCodeInvalidVPCID                 = "InvalidVPCID"
+	ErrCodeLastVPCAssociation           = "LastVPCAssociation"
+	ErrCodeLimitsExceeded               = "LimitsExceeded"
+	ErrCodeNoSuchChange                 = "NoSuchChange"
+	ErrCodeNoSuchDelegationSet          = "NoSuchDelegationSet"
+	ErrCodeNoSuchGeoLocation            = "NoSuchGeoLocation"
+	ErrCodeNoSuchHealthCheck            = "NoSuchHealthCheck"
+	ErrCodeNoSuchHostedZone             = "NoSuchHostedZone"
+	ErrCodePriorRequestNotComplete      = "PriorRequestNotComplete"
+	ErrCodePublicZoneVPCAssociation     = "PublicZoneVPCAssociation"
+	ErrCodeThrottlingException          = "ThrottlingException"
+	ErrCodeTooManyHealthChecks          = "TooManyHealthChecks"
+	ErrCodeTooManyHostedZones           = "TooManyHostedZones"
+	ErrCodeVPCAssociationNotFound       = "VPCAssociationNotFound"
+)
+
+// ConflictingDomainExists is returned with the ErrCodeConflictingDomainExists error code.
+type ConflictingDomainExists struct {
+	aws.APIError
+}
+
+// A delegation set with the same owner and caller reference combination has
+// already been created.
+type DelegationSetAlreadyCreated struct {
+	aws.APIError
+}
+
+// The specified delegation set has already been marked as reusable.
+type DelegationSetAlreadyReusable struct {
+	aws.APIError
+}
+
+// The specified delegation contains associated hosted zones which must be deleted
+// before the reusable delegation set can be deleted.
+type DelegationSetInUse struct {
+	aws.APIError
+}
+
+// Route 53 allows some duplicate domain names, but there is a maximum number
+// of duplicate names. This error indicates that you have reached that maximum.
+// If you want to create another hosted zone with the same name and Route 53
+// generates this error, you can request an increase to the limit on the Contact
+// Us (http://aws.amazon.com/route53-request/) page.
+type DelegationSetNotAvailable struct {
+	aws.APIError
+}
+
+// The specified delegation set has not been marked as reusable.
+type DelegationSetNotReusable struct {
+	aws.APIError
+}
+
+// The health check you are trying to create already exists. Route 53 returns
+// this error when a health check has already been created with the specified
+// CallerReference.
+type HealthCheckAlreadyExists struct {
+	aws.APIError
+}
+
+// There are resource records associated with this health check. Before you
+// can delete the health check, you must disassociate it from the resource record
+// sets.
+type HealthCheckInUse struct {
+	aws.APIError
+}
+
+// HealthCheckVersionMismatch is returned with the ErrCodeHealthCheckVersionMismatch error code.
+type HealthCheckVersionMismatch struct {
+	aws.APIError
+}
+
+// The hosted zone you are trying to create already exists. Route 53 returns
+// this error when a hosted zone has already been created with the specified
+// CallerReference.
+type HostedZoneAlreadyExists struct {
+	aws.APIError
+}
+
+// The hosted zone contains resource record sets in addition to the default
+// NS and SOA resource record sets. Before you can delete the hosted zone, you
+// must delete the additional resource record sets.
+type HostedZoneNotEmpty struct {
+	aws.APIError
+}
+
+// The specified HostedZone cannot be found.
+type HostedZoneNotFound struct {
+	aws.APIError
+}
+
+// The resource you are trying to access is unsupported on this Route 53 endpoint.
+// Please consider using a newer endpoint or a tool that does so.
+type IncompatibleVersion struct {
+	aws.APIError
+}
+
+// At least one of the specified arguments is invalid.
+type InvalidArgument struct {
+	aws.APIError
+}
+
+// This error contains a list of one or more error messages. Each error message
+// indicates one error in the change batch. For more information, see Example
+// InvalidChangeBatch Errors (http://docs.aws.amazon.com/Route53/latest/APIReference/API_ChangeResourceRecordSets.html#example_Errors).
+type InvalidChangeBatch struct {
+	aws.APIError
+
+	// Descriptive message for the error response.
+	Messages []*string `locationName:"messages" locationNameList:"Message" type:"list"`
+}
+
+// This error indicates that the specified domain name is not valid.
+type InvalidDomainName struct {
+	aws.APIError
+}
+
+// Some value specified in the request is invalid or the XML document is malformed.
+type InvalidInput struct {
+	aws.APIError
+}
+
+// The hosted zone you are trying to create for your VPC_ID does not belong
+// to you. Route 53 returns this error when the VPC specified by VPCId does
+// not belong to you.
+type InvalidVPCID struct {
+	aws.APIError
+}
+
+// The VPC you are trying to disassociate from the hosted zone is the last the
+// VPC that is associated with the hosted zone. Route 53 currently doesn't support
+// disassociate the last VPC from the hosted zone.
+type LastVPCAssociation struct {
+	aws.APIError
+}
+
+// The limits specified for a resource have been exceeded.
+type LimitsExceeded struct {
+	aws.APIError
+}
+
+// NoSuchChange is returned with the ErrCodeNoSuchChange error code.
+type NoSuchChange struct {
+	aws.APIError
+}
+
+// The specified delegation set does not exist.
+type NoSuchDelegationSet struct {
+	aws.APIError
+}
+
+// The geo location you are trying to get does not exist.
+type NoSuchGeoLocation struct {
+	aws.APIError
+}
+
+// The health check you are trying to get or delete does not exist.
+type NoSuchHealthCheck struct {
+	aws.APIError
+}
+
+// NoSuchHostedZone is returned with the ErrCodeNoSuchHostedZone error code.
+type NoSuchHostedZone struct {
+	aws.APIError
+}
+
+// The request was rejected because Route 53 was still processing a prior request.
+type PriorRequestNotComplete struct {
+	aws.APIError
+}
+
+// The hosted zone you are trying to associate VPC with doesn't have any VPC
+// association. Route 53 currently doesn't support associate a VPC with a public
+// hosted zone.
+type PublicZoneVPCAssociation struct {
+	aws.APIError
+}
+
+// ThrottlingException is returned with the ErrCodeThrottlingException error code.
+type ThrottlingException struct {
+	aws.APIError
+}
+
+// TooManyHealthChecks is returned with the ErrCodeTooManyHealthChecks error code.
+type TooManyHealthChecks struct {
+	aws.APIError
+}
+
+// This error indicates that you've reached the maximum number of hosted zones
+// that can be created for the current AWS account. You can request an increase
+// to the limit on the Contact Us (http://aws.amazon.com/route53-request/) page.
+type TooManyHostedZones struct {
+	aws.APIError
+}
+
+// The VPC you specified is not currently associated with the hosted zone.
+type VPCAssociationNotFound struct {
+	aws.APIError
+}
+
+var errorTypes = map[string]func() aws.ServiceError{
+	ErrCodeConflictingDomainExists:      func() aws.ServiceError { return &ConflictingDomainExists{} },
+	ErrCodeDelegationSetAlreadyCreated:  func() aws.ServiceError { return &DelegationSetAlreadyCreated{} },
+	ErrCodeDelegationSetAlreadyReusable: func() aws.ServiceError { return &DelegationSetAlreadyReusable{} },
+	ErrCodeDelegationSetInUse:           func() aws.ServiceError { return &DelegationSetInUse{} },
+	ErrCodeDelegationSetNotAvailable:    func() aws.ServiceError { return &DelegationSetNotAvailable{} },
+	ErrCodeDelegationSetNotReusable:     func() aws.ServiceError { return &DelegationSetNotReusable{} },
+	ErrCodeHealthCheckAlreadyExists:     func() aws.ServiceError { return &HealthCheckAlreadyExists{} },
+	ErrCodeHealthCheckInUse:             func() aws.ServiceError { return &HealthCheckInUse{} },
+	ErrCodeHealthCheckVersionMismatch:   func() aws.ServiceError { return &HealthCheckVersionMismatch{} },
+	ErrCodeHostedZoneAlreadyExists:      func() aws.ServiceError { return &HostedZoneAlreadyExists{} },
+	ErrCodeHostedZoneNotEmpty:           func() aws.ServiceError { return &HostedZoneNotEmpty{} },
+	ErrCodeHostedZoneNotFound:           func() aws.ServiceError { return &HostedZoneNotFound{} },
+	ErrCodeIncompatibleVersion:          func() aws.ServiceError { return &IncompatibleVersion{} },
+	ErrCodeInvalidArgument:              func() aws.ServiceError { return &InvalidArgument{} },
+	ErrCodeInvalidChangeBatch:           func() aws.ServiceError { return &InvalidChangeBatch{} },
+	ErrCodeInvalidDomainName:            func() aws.ServiceError { return &InvalidDomainName{} },
+	ErrCodeInvalidInput:                 func() aws.ServiceError { return &InvalidInput{} },
+	ErrCodeInvalidVPCID:                 func() aws.ServiceError { return &InvalidVPCID{} },
+	ErrCodeLastVPCAssociation:           func() aws.ServiceError { return &LastVPCAssociation{} },
+	ErrCodeLimitsExceeded:               func() aws.ServiceError { return &LimitsExceeded{} },
+	ErrCodeNoSuchChange:                 func() aws.ServiceError { return &NoSuchChange{} },
+	ErrCodeNoSuchDelegationSet:          func() aws.ServiceError { return &NoSuchDelegationSet{} },
+	ErrCodeNoSuchGeoLocation:            func() aws.ServiceError { return &NoSuchGeoLocation{} },
+	ErrCodeNoSuchHealthCheck:            func() aws.ServiceError { return &NoSuchHealthCheck{} },
+	ErrCodeNoSuchHostedZone:             func() aws.ServiceError { return &NoSuchHostedZone{} },
+	ErrCodePriorRequestNotComplete:      func() aws.ServiceError { return &PriorRequestNotComplete{} },
+	ErrCodePublicZoneVPCAssociation:     func() aws.ServiceError { return &PublicZoneVPCAssociation{} },
+	ErrCodeThrottlingException:          func() aws.ServiceError { return &ThrottlingException{} },
+	ErrCodeTooManyHealthChecks:          func() aws.ServiceError { return &TooManyHealthChecks{} },
+	ErrCodeTooManyHostedZones:           func() aws.ServiceError { return &TooManyHostedZones{} },
+	ErrCodeVPCAssociationNotFound:       func() aws.ServiceError { return &VPCAssociationNotFound{} },
+}
@@ -1,67 +1,132 @@
 package route53iface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/route53"
 )
 
 type Route53API interface {
 	AssociateVPCWithHostedZone(*route53.AssociateVPCWithHostedZoneInput) (*route53.AssociateVPCWithHostedZoneOutput, error)
 
+	AssociateVPCWithHostedZoneWithContext(context.Context, *route53.AssociateVPCWithHostedZoneInput) (*route53.AssociateVPCWithHostedZoneOutput, error)
+
 	ChangeResourceRecordSets(*route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error)
 
+	ChangeResourceRecordSetsWithContext(context.Context, *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error)
+
 	ChangeTagsForResource(*route53.ChangeTagsForResourceInput) (*route53.ChangeTagsForResourceOutput, error)
 
+	ChangeTagsForResourceWithContext(context.Context, *route53.ChangeTagsForResourceInput) (*route53.ChangeTagsForResourceOutput, error)
+
 	CreateHealthCheck(*route53.CreateHealthCheckInput) (*route53.CreateHealthCheckOutput, error)
 
+	CreateHealthCheckWithContext(context.Context, *route53.CreateHealthCheckInput) (*route53.CreateHealthCheckOutput, error)
+
 	CreateHostedZone(*route53.CreateHostedZoneInput) (*route53.CreateHostedZoneOutput, error)
 
+	CreateHostedZoneWithContext(context.Context, *route53.CreateHostedZoneInput) (*route53.CreateHostedZoneOutput, error)
+
 	CreateReusableDelegationSet(*route53.CreateReusableDelegationSetInput) (*route53.CreateReusableDelegationSetOutput, error)
 
+	CreateReusableDelegationSetWithContext(context.Context, *route53.CreateReusableDelegationSetInput) (*route53.CreateReusableDelegationSetOutput, error)
+
 	DeleteHealthCheck(*route53.DeleteHealthCheckInput) (*route53.DeleteHealthCheckOutput, error)
 
+	DeleteHealthCheckWithContext(context.Context, *route53.DeleteHealthCheckInput) (*route53.DeleteHealthCheckOutput, error)
+
 	DeleteHostedZone(*route53.DeleteHostedZoneInput) (*route53.DeleteHostedZoneOutput, error)
 
+	DeleteHostedZoneWithContext(context.Context, *route53.DeleteHostedZoneInput) (*route53.DeleteHostedZoneOutput, error)
+
 	DeleteReusableDelegationSet(*route53.DeleteReusableDelegationSetInput) (*route53.DeleteReusableDelegationSetOutput, error)
 
+	DeleteReusableDelegationSetWithContext(context.Context, *route53.DeleteReusableDelegationSetInput) (*route53.DeleteReusableDelegationSetOutput, error)
+
 	DisassociateVPCFromHostedZone(*route53.DisassociateVPCFromHostedZoneInput) (*route53.DisassociateVPCFromHostedZoneOutput, error)
 
+	DisassociateVPCFromHostedZoneWithContext(context.Context, *route53.DisassociateVPCFromHostedZoneInput) (*route53.DisassociateVPCFromHostedZoneOutput, error)
+
 	GetChange(*route53.GetChangeInput) (*route53.GetChangeOutput, error)
 
+	GetChangeWithContext(context.Context, *route53.GetChangeInput) (*route53.GetChangeOutput, error)
+
 	GetCheckerIPRanges(*route53.GetCheckerIPRangesInput) (*route53.GetCheckerIPRangesOutput, error)
 
+	GetCheckerIPRangesWithContext(context.Context, *route53.GetCheckerIPRangesInput) (*route53.GetCheckerIPRangesOutput, error)
+
 	GetGeoLocation(*route53.GetGeoLocationInput) (*route53.GetGeoLocationOutput, error)
 
+	GetGeoLocationWithContext(context.Context, *route53.GetGeoLocationInput) (*route53.GetGeoLocationOutput, error)
+
 	GetHealthCheck(*route53.GetHealthCheckInput) (*route53.GetHealthCheckOutput, error)
 
+	GetHealthCheckWithContext(context.Context, *route53.GetHealthCheckInput) (*route53.GetHealthCheckOutput, error)
+
 	GetHealthCheckCount(*route53.GetHealthCheckCountInput) (*route53.GetHealthCheckCountOutput, error)
 
+	GetHealthCheckCountWithContext(context.Context, *route53.GetHealthCheckCountInput) (*route53.GetHealthCheckCountOutput, error)
+
 	GetHealthCheckLastFailureReason(*route53.GetHealthCheckLastFailureReasonInput) (*route53.GetHealthCheckLastFailureReasonOutput, error)
 
+	GetHealthCheckLastFailureReasonWithContext(context.Context, *route53.GetHealthCheckLastFailureReasonInput) (*route53.GetHealthCheckLastFailureReasonOutput, error)
+
 	GetHealthCheckStatus(*route53.GetHealthCheckStatusInput) (*route53.GetHealthCheckStatusOutput, error)
 
+	GetHealthCheckStatusWithContext(context.Context, *route53.GetHealthCheckStatusInput) (*route53.GetHealthCheckStatusOutput, error)
+
 	GetHostedZone(*route53.GetHostedZoneInput) (*route53.GetHostedZoneOutput, error)
 
+	GetHostedZoneWithContext(context.Context, *route53.GetHostedZoneInput) (*route53.GetHostedZoneOutput, error)
+
 	GetHostedZoneCount(*route53.GetHostedZoneCountInput) (*route53.GetHostedZoneCountOutput, error)
 
+	GetHostedZoneCountWithContext(context.Context, *route53.GetHostedZoneCountInput) (*route53.GetHostedZoneCountOutput, error)
+
 	GetReusableDelegationSet(*route53.GetReusableDelegationSetInput) (*route53.GetReusableDelegationSetOutput, error)
 
+	GetReusableDelegationSetWithContext(context.Context, *route53.GetReusableDelegationSetInput) (*route53.GetReusableDelegationSetOutput, error)
+
 	ListGeoLocations(*route53.ListGeoLocationsInput) (*route53.ListGeoLocationsOutput, error)
 
+	ListGeoLocationsWithContext(context.Context, *route53.ListGeoLocationsInput) (*route53.ListGeoLocationsOutput, error)
+
 	ListHealthChecks(*route53.ListHealthChecksInput) (*route53.ListHealthChecksOutput, error)
 
+	ListHealthChecksWithContext(context.Context, *route53.ListHealthChecksInput) (*route53.ListHealthChecksOutput, error)
+	ListHealthChecksPages(*route53.ListHealthChecksInput, func(*route53.ListHealthChecksOutput, bool) bool) error
+
 	ListHostedZones(*route53.ListHostedZonesInput) (*route53.ListHostedZonesOutput, error)
 
+	ListHostedZonesWithContext(context.Context, *route53.ListHostedZonesInput) (*route53.ListHostedZonesOutput, error)
+	ListHostedZonesPages(*route53.ListHostedZonesInput, func(*route53.ListHostedZonesOutput, bool) bool) error
+
 	ListHostedZonesByName(*route53.ListHostedZonesByNameInput) (*route53.ListHostedZonesByNameOutput, error)
 
+	ListHostedZonesByNameWithContext(context.Context, *route53.ListHostedZonesByNameInput) (*route53.ListHostedZonesByNameOutput, error)
+
 	ListResourceRecordSets(*route53.ListResourceRecordSetsInput) (*route53.ListResourceRecordSetsOutput, error)
 
+	ListResourceRecordSetsWithContext(context.Context, *route53.ListResourceRecordSetsInput) (*route53.ListResourceRecordSetsOutput, error)
+	ListResourceRecordSetsPages(*route53.ListResourceRecordSetsInput, func(*route53.ListResourceRecordSetsOutput, bool) bool) error
+
 	ListReusableDelegationSets(*route53.ListReusableDelegationSetsInput) (*route53.ListReusableDelegationSetsOutput, error)
 
+	ListReusableDelegationSetsWithContext(context.Context, *route53.ListReusableDelegationSetsInput) (*route53.ListReusableDelegationSetsOutput, error)
+
 	ListTagsForResource(*route53.ListTagsForResourceInput) (*route53.ListTagsForResourceOutput, error)
 
+	ListTagsForResourceWithContext(context.Context, *route53.ListTagsForResourceInput) (*route53.ListTagsForResourceOutput, error)
+
 	ListTagsForResources(*route53.ListTagsForResourcesInput) (*route53.ListTagsForResourcesOutput, error)
 
+	ListTagsForResourcesWithContext(context.Context, *route53.ListTagsForResourcesInput) (*route53.ListTagsForResourcesOutput, error)
+
 	UpdateHealthCheck(*route53.UpdateHealthCheckInput) (*route53.UpdateHealthCheckOutput, error)
 
+	UpdateHealthCheckWithContext(context.Context, *route53.UpdateHealthCheckInput) (*route53.UpdateHealthCheckOutput, error)
+
 	UpdateHostedZoneComment(*route53.UpdateHostedZoneCommentInput) (*route53.UpdateHostedZoneCommentOutput, error)
+
+	UpdateHostedZoneCommentWithContext(context.Context, *route53.UpdateHostedZoneCommentInput) (*route53.UpdateHostedZoneCommentOutput, error)
 }
\ No newline at end of file
@@ -27,6 +27,7 @@ func New(config *aws.Config) *Route53 {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "route53",
 		APIVersion:  "2013-04-01",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
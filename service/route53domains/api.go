@@ -4,6 +4,7 @@
 package route53domains
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -46,6 +47,17 @@ func (c *Route53Domains) CheckDomainAvailability(input *CheckDomainAvailabilityI
 	return
 }
 
+// CheckDomainAvailabilityWithContext is the same as CheckDomainAvailability with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) CheckDomainAvailabilityWithContext(ctx context.Context, input *CheckDomainAvailabilityInput) (output *CheckDomainAvailabilityOutput, err error) {
+	req, out := c.CheckDomainAvailabilityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCheckDomainAvailability *aws.Operation
 
 // DeleteTagsForDomainRequest generates a request for the DeleteTagsForDomain operation.
@@ -82,6 +94,17 @@ func (c *Route53Domains) DeleteTagsForDomain(input *DeleteTagsForDomainInput) (o
 	return
 }
 
+// DeleteTagsForDomainWithContext is the same as DeleteTagsForDomain with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) DeleteTagsForDomainWithContext(ctx context.Context, input *DeleteTagsForDomainInput) (output *DeleteTagsForDomainOutput, err error) {
+	req, out := c.DeleteTagsForDomainRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteTagsForDomain *aws.Operation
 
 // DisableDomainAutoRenewRequest generates a request for the DisableDomainAutoRenew operation.
@@ -121,6 +144,17 @@ func (c *Route53Domains) DisableDomainAutoRenew(input *DisableDomainAutoRenewInp
 	return
 }
 
+// DisableDomainAutoRenewWithContext is the same as DisableDomainAutoRenew with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) DisableDomainAutoRenewWithContext(ctx context.Context, input *DisableDomainAutoRenewInput) (output *DisableDomainAutoRenewOutput, err error) {
+	req, out := c.DisableDomainAutoRenewRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDisableDomainAutoRenew *aws.Operation
 
 // DisableDomainTransferLockRequest generates a request for the DisableDomainTransferLock operation.
@@ -160,6 +194,17 @@ func (c *Route53Domains) DisableDomainTransferLock(input *DisableDomainTransferL
 	return
 }
 
+// DisableDomainTransferLockWithContext is the same as DisableDomainTransferLock with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) DisableDomainTransferLockWithContext(ctx context.Context, input *DisableDomainTransferLockInput) (output *DisableDomainTransferLockOutput, err error) {
+	req, out := c.DisableDomainTransferLockRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDisableDomainTransferLock *aws.Operation
 
 // EnableDomainAutoRenewRequest generates a request for the EnableDomainAutoRenew operation.
@@ -202,6 +247,17 @@ func (c *Route53Domains) EnableDomainAutoRenew(input *EnableDomainAutoRenewInput
 	return
 }
 
+// EnableDomainAutoRenewWithContext is the same as EnableDomainAutoRenew with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) EnableDomainAutoRenewWithContext(ctx context.Context, input *EnableDomainAutoRenewInput) (output *EnableDomainAutoRenewOutput, err error) {
+	req, out := c.EnableDomainAutoRenewRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEnableDomainAutoRenew *aws.Operation
 
 // EnableDomainTransferLockRequest generates a request for the EnableDomainTransferLock operation.
@@ -239,6 +295,17 @@ func (c *Route53Domains) EnableDomainTransferLock(input *EnableDomainTransferLoc
 	return
 }
 
+// EnableDomainTransferLockWithContext is the same as EnableDomainTransferLock with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) EnableDomainTransferLockWithContext(ctx context.Context, input *EnableDomainTransferLockInput) (output *EnableDomainTransferLockOutput, err error) {
+	req, out := c.EnableDomainTransferLockRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opEnableDomainTransferLock *aws.Operation
 
 // GetDomainDetailRequest generates a request for the GetDomainDetail operation.
@@ -273,6 +340,17 @@ func (c *Route53Domains) GetDomainDetail(input *GetDomainDetailInput) (output *G
 	return
 }
 
+// GetDomainDetailWithContext is the same as GetDomainDetail with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) GetDomainDetailWithContext(ctx context.Context, input *GetDomainDetailInput) (output *GetDomainDetailOutput, err error) {
+	req, out := c.GetDomainDetailRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetDomainDetail *aws.Operation
 
 // GetOperationDetailRequest generates a request for the GetOperationDetail operation.
@@ -306,6 +384,17 @@ func (c *Route53Domains) GetOperationDetail(input *GetOperationDetailInput) (out
 	return
 }
 
+// GetOperationDetailWithContext is the same as GetOperationDetail with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) GetOperationDetailWithContext(ctx context.Context, input *GetOperationDetailInput) (output *GetOperationDetailOutput, err error) {
+	req, out := c.GetOperationDetailRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetOperationDetail *aws.Operation
 
 // ListDomainsRequest generates a request for the ListDomains operation.
@@ -318,6 +407,12 @@ func (c *Route53Domains) ListDomainsRequest(input *ListDomainsInput) (req *aws.R
 			Name:       "ListDomains",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"NextPageMarker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -340,6 +435,27 @@ func (c *Route53Domains) ListDomains(input *ListDomainsInput) (output *ListDomai
 	return
 }
 
+// ListDomainsWithContext is the same as ListDomains with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) ListDomainsWithContext(ctx context.Context, input *ListDomainsInput) (output *ListDomainsOutput, err error) {
+	req, out := c.ListDomainsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListDomainsPages iterates over the pages of a ListDomains operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Route53Domains) ListDomainsPages(input *ListDomainsInput, fn func(p *ListDomainsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListDomainsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListDomainsOutput), lastPage)
+	})
+}
+
 var opListDomains *aws.Operation
 
 // ListOperationsRequest generates a request for the ListOperations operation.
@@ -352,6 +468,12 @@ func (c *Route53Domains) ListOperationsRequest(input *ListOperationsInput) (req
 			Name:       "ListOperations",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"NextPageMarker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -373,6 +495,27 @@ func (c *Route53Domains) ListOperations(input *ListOperationsInput) (output *Lis
 	return
 }
 
+// ListOperationsWithContext is the same as ListOperations with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) ListOperationsWithContext(ctx context.Context, input *ListOperationsInput) (output *ListOperationsOutput, err error) {
+	req, out := c.ListOperationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListOperationsPages iterates over the pages of a ListOperations operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Route53Domains) ListOperationsPages(input *ListOperationsInput, fn func(p *ListOperationsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListOperationsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListOperationsOutput), lastPage)
+	})
+}
+
 var opListOperations *aws.Operation
 
 // ListTagsForDomainRequest generates a request for the ListTagsForDomain operation.
@@ -410,6 +553,17 @@ func (c *Route53Domains) ListTagsForDomain(input *ListTagsForDomainInput) (outpu
 	return
 }
 
+// ListTagsForDomainWithContext is the same as ListTagsForDomain with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) ListTagsForDomainWithContext(ctx context.Context, input *ListTagsForDomainInput) (output *ListTagsForDomainOutput, err error) {
+	req, out := c.ListTagsForDomainRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListTagsForDomain *aws.Operation
 
 // RegisterDomainRequest generates a request for the RegisterDomain operation.
@@ -461,6 +615,17 @@ func (c *Route53Domains) RegisterDomain(input *RegisterDomainInput) (output *Reg
 	return
 }
 
+// RegisterDomainWithContext is the same as RegisterDomain with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) RegisterDomainWithContext(ctx context.Context, input *RegisterDomainInput) (output *RegisterDomainOutput, err error) {
+	req, out := c.RegisterDomainRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRegisterDomain *aws.Operation
 
 // RetrieveDomainAuthCodeRequest generates a request for the RetrieveDomainAuthCode operation.
@@ -495,6 +660,17 @@ func (c *Route53Domains) RetrieveDomainAuthCode(input *RetrieveDomainAuthCodeInp
 	return
 }
 
+// RetrieveDomainAuthCodeWithContext is the same as RetrieveDomainAuthCode with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) RetrieveDomainAuthCodeWithContext(ctx context.Context, input *RetrieveDomainAuthCodeInput) (output *RetrieveDomainAuthCodeOutput, err error) {
+	req, out := c.RetrieveDomainAuthCodeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRetrieveDomainAuthCode *aws.Operation
 
 // TransferDomainRequest generates a request for the TransferDomain operation.
@@ -551,6 +727,17 @@ func (c *Route53Domains) TransferDomain(input *TransferDomainInput) (output *Tra
 	return
 }
 
+// TransferDomainWithContext is the same as TransferDomain with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) TransferDomainWithContext(ctx context.Context, input *TransferDomainInput) (output *TransferDomainOutput, err error) {
+	req, out := c.TransferDomainRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opTransferDomain *aws.Operation
 
 // UpdateDomainContactRequest generates a request for the UpdateDomainContact operation.
@@ -591,6 +778,17 @@ func (c *Route53Domains) UpdateDomainContact(input *UpdateDomainContactInput) (o
 	return
 }
 
+// UpdateDomainContactWithContext is the same as UpdateDomainContact with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) UpdateDomainContactWithContext(ctx context.Context, input *UpdateDomainContactInput) (output *UpdateDomainContactOutput, err error) {
+	req, out := c.UpdateDomainContactRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateDomainContact *aws.Operation
 
 // UpdateDomainContactPrivacyRequest generates a request for the UpdateDomainContactPrivacy operation.
@@ -634,6 +832,17 @@ func (c *Route53Domains) UpdateDomainContactPrivacy(input *UpdateDomainContactPr
 	return
 }
 
+// UpdateDomainContactPrivacyWithContext is the same as UpdateDomainContactPrivacy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) UpdateDomainContactPrivacyWithContext(ctx context.Context, input *UpdateDomainContactPrivacyInput) (output *UpdateDomainContactPrivacyOutput, err error) {
+	req, out := c.UpdateDomainContactPrivacyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateDomainContactPrivacy *aws.Operation
 
 // UpdateDomainNameserversRequest generates a request for the UpdateDomainNameservers operation.
@@ -674,6 +883,17 @@ func (c *Route53Domains) UpdateDomainNameservers(input *UpdateDomainNameserversI
 	return
 }
 
+// UpdateDomainNameserversWithContext is the same as UpdateDomainNameservers with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) UpdateDomainNameserversWithContext(ctx context.Context, input *UpdateDomainNameserversInput) (output *UpdateDomainNameserversOutput, err error) {
+	req, out := c.UpdateDomainNameserversRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateDomainNameservers *aws.Operation
 
 // UpdateTagsForDomainRequest generates a request for the UpdateTagsForDomain operation.
@@ -710,6 +930,17 @@ func (c *Route53Domains) UpdateTagsForDomain(input *UpdateTagsForDomainInput) (o
 	return
 }
 
+// UpdateTagsForDomainWithContext is the same as UpdateTagsForDomain with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Route53Domains) UpdateTagsForDomainWithContext(ctx context.Context, input *UpdateTagsForDomainInput) (output *UpdateTagsForDomainOutput, err error) {
+	req, out := c.UpdateTagsForDomainRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateTagsForDomain *aws.Operation
 
 // The CheckDomainAvailability request contains the following elements.
@@ -725,10 +956,10 @@ type CheckDomainAvailabilityInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// Reserved for future use.
-	IDNLangCode *string `locationName:"IdnLangCode" type:"string"`
+	IDNLangCode *string `locationName:"IdnLangCode" type:"string" max:"3"`
 
 	metadataCheckDomainAvailabilityInput `json:"-", xml:"-"`
 }
@@ -753,7 +984,7 @@ type CheckDomainAvailabilityOutput struct {
 	// not available.  UNAVAILABLE_PREMIUM – The domain name is not available.
 	// UNAVAILABLE_RESTRICTED – The domain name is forbidden.  RESERVED – The domain
 	// name has been reserved for another person or organization.
-	Availability *string `type:"string" required:"true"`
+	Availability *string `type:"string" enum:"AVAILABLE,AVAILABLE_RESERVED,AVAILABLE_PREORDER,UNAVAILABLE,UNAVAILABLE_PREMIUM,UNAVAILABLE_RESTRICTED,RESERVED" required:"true"`
 
 	metadataCheckDomainAvailabilityOutput `json:"-", xml:"-"`
 }
@@ -775,7 +1006,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	AddressLine1 *string `type:"string"`
+	AddressLine1 *string `type:"string" max:"255"`
 
 	// Second line of contact's address, if any.
 	//
@@ -788,7 +1019,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	AddressLine2 *string `type:"string"`
+	AddressLine2 *string `type:"string" max:"255"`
 
 	// The city of the contact's address.
 	//
@@ -801,7 +1032,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	City *string `type:"string"`
+	City *string `type:"string" max:"255"`
 
 	// Indicates whether the contact is a person, company, association, or public
 	// organization. If you choose an option other than PERSON, you must enter an
@@ -818,7 +1049,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	ContactType *string `type:"string"`
+	ContactType *string `type:"string" enum:"PERSON,COMPANY,ASSOCIATION,PUBLIC_BODY,RESELLER"`
 
 	// Code for the country of the contact's address.
 	//
@@ -831,7 +1062,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	CountryCode *string `type:"string"`
+	CountryCode *string `type:"string" enum:"AD,AE,AF,AG,AI,AL,AM,AN,AO,AQ,AR,AS,AT,AU,AW,AZ,BA,BB,BD,BE,BF,BG,BH,BI,BJ,BL,BM,BN,BO,BR,BS,BT,BW,BY,BZ,CA,CC,CD,CF,CG,CH,CI,CK,CL,CM,CN,CO,CR,CU,CV,CX,CY,CZ,DE,DJ,DK,DM,DO,DZ,EC,EE,EG,ER,ES,ET,FI,FJ,FK,FM,FO,FR,GA,GB,GD,GE,GH,GI,GL,GM,GN,GQ,GR,GT,GU,GW,GY,HK,HN,HR,HT,HU,ID,IE,IL,IM,IN,IQ,IR,IS,IT,JM,JO,JP,KE,KG,KH,KI,KM,KN,KP,KR,KW,KY,KZ,LA,LB,LC,LI,LK,LR,LS,LT,LU,LV,LY,MA,MC,MD,ME,MF,MG,MH,MK,ML,MM,MN,MO,MP,MR,MS,MT,MU,MV,MW,MX,MY,MZ,NA,NC,NE,NG,NI,NL,NO,NP,NR,NU,NZ,OM,PA,PE,PF,PG,PH,PK,PL,PM,PN,PR,PT,PW,PY,QA,RO,RS,RU,RW,SA,SB,SC,SD,SE,SG,SH,SI,SK,SL,SM,SN,SO,SR,ST,SV,SY,SZ,TC,TD,TG,TH,TJ,TK,TL,TM,TN,TO,TR,TT,TV,TW,TZ,UA,UG,US,UY,UZ,VA,VC,VE,VG,VI,VN,VU,WF,WS,YE,YT,ZA,ZM,ZW"`
 
 	// Email address of the contact.
 	//
@@ -844,7 +1075,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	Email *string `type:"string"`
+	Email *string `type:"string" max:"254"`
 
 	// A list of name-value pairs for parameters required by certain top-level domains.
 	//
@@ -872,7 +1103,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	Fax *string `type:"string"`
+	Fax *string `type:"string" max:"30"`
 
 	// First name of contact.
 	//
@@ -885,7 +1116,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	FirstName *string `type:"string"`
+	FirstName *string `type:"string" max:"255"`
 
 	// Last name of contact.
 	//
@@ -898,7 +1129,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	LastName *string `type:"string"`
+	LastName *string `type:"string" max:"255"`
 
 	// Name of the organization for contact types other than PERSON.
 	//
@@ -911,7 +1142,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	OrganizationName *string `type:"string"`
+	OrganizationName *string `type:"string" max:"255"`
 
 	// The phone number of the contact.
 	//
@@ -926,7 +1157,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	PhoneNumber *string `type:"string"`
+	PhoneNumber *string `type:"string" max:"30"`
 
 	// The state or province of the contact's city.
 	//
@@ -939,7 +1170,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	State *string `type:"string"`
+	State *string `type:"string" max:"255"`
 
 	// The zip or postal code of the contact's address.
 	//
@@ -952,7 +1183,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	ZipCode *string `type:"string"`
+	ZipCode *string `type:"string" max:"255"`
 
 	metadataContactDetail `json:"-", xml:"-"`
 }
@@ -978,7 +1209,7 @@ type DeleteTagsForDomainInput struct {
 	// Domain Name, you must convert the name to Punycode.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// A list of tag keys to delete.
 	//
@@ -1007,7 +1238,7 @@ type metadataDeleteTagsForDomainOutput struct {
 }
 
 type DisableDomainAutoRenewInput struct {
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataDisableDomainAutoRenewInput `json:"-", xml:"-"`
 }
@@ -1037,7 +1268,7 @@ type DisableDomainTransferLockInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataDisableDomainTransferLockInput `json:"-", xml:"-"`
 }
@@ -1056,7 +1287,7 @@ type DisableDomainTransferLockOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataDisableDomainTransferLockOutput `json:"-", xml:"-"`
 }
@@ -1076,7 +1307,7 @@ type DomainSummary struct {
 	// The name of a domain.
 	//
 	// Type: String
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// Expiration date of the domain in Coordinated Universal Time (UTC).
 	//
@@ -1099,7 +1330,7 @@ type metadataDomainSummary struct {
 }
 
 type EnableDomainAutoRenewInput struct {
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataEnableDomainAutoRenewInput `json:"-", xml:"-"`
 }
@@ -1129,7 +1360,7 @@ type EnableDomainTransferLockInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataEnableDomainTransferLockInput `json:"-", xml:"-"`
 }
@@ -1148,7 +1379,7 @@ type EnableDomainTransferLockOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataEnableDomainTransferLockOutput `json:"-", xml:"-"`
 }
@@ -1174,7 +1405,7 @@ type ExtraParam struct {
 	// Parent: ExtraParams
 	//
 	// Required: Yes
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" enum:"DUNS_NUMBER,BRAND_NUMBER,BIRTH_DEPARTMENT,BIRTH_DATE_IN_YYYY_MM_DD,BIRTH_COUNTRY,BIRTH_CITY,DOCUMENT_NUMBER,AU_ID_NUMBER,AU_ID_TYPE,CA_LEGAL_TYPE,ES_IDENTIFICATION,ES_IDENTIFICATION_TYPE,ES_LEGAL_FORM,FI_BUSINESS_NUMBER,FI_ID_NUMBER,IT_PIN,RU_PASSPORT_DATA,SE_ID_NUMBER,SG_ID_NUMBER,VAT_NUMBER" required:"true"`
 
 	// Values corresponding to the additional parameter names required by some top-level
 	// domains.
@@ -1188,7 +1419,7 @@ type ExtraParam struct {
 	// Parent: ExtraParams
 	//
 	// Required: Yes
-	Value *string `type:"string" required:"true"`
+	Value *string `type:"string" max:"2048" required:"true"`
 
 	metadataExtraParam `json:"-", xml:"-"`
 }
@@ -1210,7 +1441,7 @@ type GetDomainDetailInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataGetDomainDetailInput `json:"-", xml:"-"`
 }
@@ -1226,12 +1457,12 @@ type GetDomainDetailOutput struct {
 	// is cybersquatting on a domain name, or report some other type of abuse.
 	//
 	// Type: String
-	AbuseContactEmail *string `type:"string"`
+	AbuseContactEmail *string `type:"string" max:"254"`
 
 	// Phone number for reporting abuse.
 	//
 	// Type: String
-	AbuseContactPhone *string `type:"string"`
+	AbuseContactPhone *string `type:"string" max:"30"`
 
 	// Provides details about the domain administrative contact.
 	//
@@ -1265,7 +1496,7 @@ type GetDomainDetailOutput struct {
 	// The name of a domain.
 	//
 	// Type: String
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// The date when the registration for the domain is set to expire. The date
 	// format is Unix time.
@@ -1375,7 +1606,7 @@ type GetOperationDetailInput struct {
 	// Default: None
 	//
 	// Required: Yes
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataGetOperationDetailInput `json:"-", xml:"-"`
 }
@@ -1389,7 +1620,7 @@ type GetOperationDetailOutput struct {
 	// The name of a domain.
 	//
 	// Type: String
-	DomainName *string `type:"string"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*"`
 
 	// Detailed information on the status including possible errors.
 	//
@@ -1399,12 +1630,12 @@ type GetOperationDetailOutput struct {
 	// The identifier for the operation.
 	//
 	// Type: String
-	OperationID *string `locationName:"OperationId" type:"string"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255"`
 
 	// The current status of the requested operation in the system.
 	//
 	// Type: String
-	Status *string `type:"string"`
+	Status *string `type:"string" enum:"SUBMITTED,IN_PROGRESS,ERROR,SUCCESSFUL,FAILED"`
 
 	// The date when the request was submitted.
 	SubmittedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -1412,7 +1643,7 @@ type GetOperationDetailOutput struct {
 	// The type of operation that was requested.
 	//
 	// Type: String
-	Type *string `type:"string"`
+	Type *string `type:"string" enum:"REGISTER_DOMAIN,DELETE_DOMAIN,TRANSFER_IN_DOMAIN,UPDATE_DOMAIN_CONTACT,UPDATE_NAMESERVER,CHANGE_PRIVACY_PROTECTION,DOMAIN_LOCK"`
 
 	metadataGetOperationDetailOutput `json:"-", xml:"-"`
 }
@@ -1437,7 +1668,7 @@ type ListDomainsInput struct {
 	// Constraints: The marker must match the value specified in the previous request.
 	//
 	// Required: No
-	Marker *string `type:"string"`
+	Marker *string `type:"string" max:"4096"`
 
 	// Number of domains to be returned.
 	//
@@ -1448,7 +1679,7 @@ type ListDomainsInput struct {
 	// Constraints: A numeral between 1 and 100.
 	//
 	// Required: No
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" max:"100"`
 
 	metadataListDomainsInput `json:"-", xml:"-"`
 }
@@ -1473,7 +1704,7 @@ type ListDomainsOutput struct {
 	// Type: String
 	//
 	// Parent: Operations
-	NextPageMarker *string `type:"string"`
+	NextPageMarker *string `type:"string" max:"4096"`
 
 	metadataListDomainsOutput `json:"-", xml:"-"`
 }
@@ -1496,7 +1727,7 @@ type ListOperationsInput struct {
 	// Default: None
 	//
 	// Required: No
-	Marker *string `type:"string"`
+	Marker *string `type:"string" max:"4096"`
 
 	// Number of domains to be returned.
 	//
@@ -1507,7 +1738,7 @@ type ListOperationsInput struct {
 	// Constraints: A value between 1 and 100.
 	//
 	// Required: No
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" max:"100"`
 
 	metadataListOperationsInput `json:"-", xml:"-"`
 }
@@ -1525,7 +1756,7 @@ type ListOperationsOutput struct {
 	// Type: String
 	//
 	// Parent: Operations
-	NextPageMarker *string `type:"string"`
+	NextPageMarker *string `type:"string" max:"4096"`
 
 	// Lists summaries of the operations.
 	//
@@ -1544,7 +1775,7 @@ type metadataListOperationsOutput struct {
 // The ListTagsForDomainRequest includes the following elements.
 type ListTagsForDomainInput struct {
 	// The domain for which you want to get a list of tags.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataListTagsForDomainInput `json:"-", xml:"-"`
 }
@@ -1602,7 +1833,7 @@ type Nameserver struct {
 	// Constraint: Maximum 255 characterss
 	//
 	// Parent: Nameservers
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataNameserver `json:"-", xml:"-"`
 }
@@ -1616,12 +1847,12 @@ type OperationSummary struct {
 	// Identifier returned to track the requested action.
 	//
 	// Type: String
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	// The current status of the requested operation in the system.
 	//
 	// Type: String
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"SUBMITTED,IN_PROGRESS,ERROR,SUCCESSFUL,FAILED" required:"true"`
 
 	// The date when the request was submitted.
 	SubmittedDate *time.Time `type:"timestamp" timestampFormat:"unix" required:"true"`
@@ -1632,7 +1863,7 @@ type OperationSummary struct {
 	//
 	// Valid values: REGISTER_DOMAIN | DELETE_DOMAIN | TRANSFER_IN_DOMAIN | UPDATE_DOMAIN_CONTACT
 	// | UPDATE_NAMESERVER | CHANGE_PRIVACY_PROTECTION | DOMAIN_LOCK
-	Type *string `type:"string" required:"true"`
+	Type *string `type:"string" enum:"REGISTER_DOMAIN,DELETE_DOMAIN,TRANSFER_IN_DOMAIN,UPDATE_DOMAIN_CONTACT,UPDATE_NAMESERVER,CHANGE_PRIVACY_PROTECTION,DOMAIN_LOCK" required:"true"`
 
 	metadataOperationSummary `json:"-", xml:"-"`
 }
@@ -1677,7 +1908,7 @@ type RegisterDomainInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// The number of years the domain will be registered. Domains are registered
 	// for a minimum of one year. The maximum period depends on the top-level domain.
@@ -1689,10 +1920,10 @@ type RegisterDomainInput struct {
 	// Valid values: Integer from 1 to 10
 	//
 	// Required: Yes
-	DurationInYears *int64 `type:"integer" required:"true"`
+	DurationInYears *int64 `type:"integer" min:"1" max:"10" required:"true"`
 
 	// Reserved for future use.
-	IDNLangCode *string `locationName:"IdnLangCode" type:"string"`
+	IDNLangCode *string `locationName:"IdnLangCode" type:"string" max:"3"`
 
 	// Whether you want to conceal contact information from WHOIS queries. If you
 	// specify true, WHOIS ("who is") queries will return contact information for
@@ -1775,7 +2006,7 @@ type RegisterDomainOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataRegisterDomainOutput `json:"-", xml:"-"`
 }
@@ -1797,7 +2028,7 @@ type RetrieveDomainAuthCodeInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataRetrieveDomainAuthCodeInput `json:"-", xml:"-"`
 }
@@ -1811,7 +2042,7 @@ type RetrieveDomainAuthCodeOutput struct {
 	// The authorization code for the domain.
 	//
 	// Type: String
-	AuthCode *string `type:"string" required:"true"`
+	AuthCode *string `type:"string" max:"1024" required:"true"`
 
 	metadataRetrieveDomainAuthCodeOutput `json:"-", xml:"-"`
 }
@@ -1874,7 +2105,7 @@ type TransferDomainInput struct {
 	// Type: String
 	//
 	// Required: Yes
-	AuthCode *string `type:"string"`
+	AuthCode *string `type:"string" max:"1024"`
 
 	// Indicates whether the domain will be automatically renewed (true) or not
 	// (false). Autorenewal only takes effect after the account is charged.
@@ -1899,7 +2130,7 @@ type TransferDomainInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// The number of years the domain will be registered. Domains are registered
 	// for a minimum of one year. The maximum period depends on the top-level domain.
@@ -1911,10 +2142,10 @@ type TransferDomainInput struct {
 	// Valid values: Integer from 1 to 10
 	//
 	// Required: Yes
-	DurationInYears *int64 `type:"integer" required:"true"`
+	DurationInYears *int64 `type:"integer" min:"1" max:"10" required:"true"`
 
 	// Reserved for future use.
-	IDNLangCode *string `locationName:"IdnLangCode" type:"string"`
+	IDNLangCode *string `locationName:"IdnLangCode" type:"string" max:"3"`
 
 	// Contains details for the host and glue IP addresses.
 	//
@@ -2006,7 +2237,7 @@ type TransferDomainOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataTransferDomainOutput `json:"-", xml:"-"`
 }
@@ -2039,7 +2270,7 @@ type UpdateDomainContactInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// Provides detailed contact information.
 	//
@@ -2080,7 +2311,7 @@ type UpdateDomainContactOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataUpdateDomainContactOutput `json:"-", xml:"-"`
 }
@@ -2116,7 +2347,7 @@ type UpdateDomainContactPrivacyInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// Whether you want to conceal contact information from WHOIS queries. If you
 	// specify true, WHOIS ("who is") queries will return contact information for
@@ -2163,7 +2394,7 @@ type UpdateDomainContactPrivacyOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataUpdateDomainContactPrivacyOutput `json:"-", xml:"-"`
 }
@@ -2185,7 +2416,7 @@ type UpdateDomainNameserversInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// The authorization key for .fi domains
 	FIAuthKey *string `type:"string"`
@@ -2216,7 +2447,7 @@ type UpdateDomainNameserversOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataUpdateDomainNameserversOutput `json:"-", xml:"-"`
 }
@@ -2242,7 +2473,7 @@ type UpdateTagsForDomainInput struct {
 	// Domain Name, you must convert the name to Punycode.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// A list of the tag keys and values that you want to add or update. If you
 	// specify a key that already exists, the corresponding value will be replaced.
@@ -2297,4 +2528,610 @@ type UpdateTagsForDomainOutput struct {
 
 type metadataUpdateTagsForDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for ContactType.
+const (
+	ContactTypePerson      = "PERSON"
+	ContactTypeCompany     = "COMPANY"
+	ContactTypeAssociation = "ASSOCIATION"
+	ContactTypePublicBody  = "PUBLIC_BODY"
+	ContactTypeReseller    = "RESELLER"
+)
+
+// ContactTypeValues returns the valid values of ContactType.
+func ContactTypeValues() []string {
+	return []string{
+		ContactTypePerson,
+		ContactTypeCompany,
+		ContactTypeAssociation,
+		ContactTypePublicBody,
+		ContactTypeReseller,
+	}
+}
+
+// Enum values for CountryCode.
+const (
+	CountryCodeAD = "AD"
+	CountryCodeAE = "AE"
+	CountryCodeAF = "AF"
+	CountryCodeAG = "AG"
+	CountryCodeAI = "AI"
+	CountryCodeAL = "AL"
+	CountryCodeAM = "AM"
+	CountryCodeAN = "AN"
+	CountryCodeAO = "AO"
+	CountryCodeAQ = "AQ"
+	CountryCodeAR = "AR"
+	CountryCodeAS = "AS"
+	CountryCodeAT = "AT"
+	CountryCodeAU = "AU"
+	CountryCodeAW = "AW"
+	CountryCodeAZ = "AZ"
+	CountryCodeBA = "BA"
+	CountryCodeBB = "BB"
+	CountryCodeBD = "BD"
+	CountryCodeBE = "BE"
+	CountryCodeBF = "BF"
+	CountryCodeBG = "BG"
+	CountryCodeBH = "BH"
+	CountryCodeBI = "BI"
+	CountryCodeBJ = "BJ"
+	CountryCodeBL = "BL"
+	CountryCodeBM = "BM"
+	CountryCodeBN = "BN"
+	CountryCodeBO = "BO"
+	CountryCodeBR = "BR"
+	CountryCodeBS = "BS"
+	CountryCodeBT = "BT"
+	CountryCodeBW = "BW"
+	CountryCodeBY = "BY"
+	CountryCodeBZ = "BZ"
+	CountryCodeCA = "CA"
+	CountryCodeCC = "CC"
+	CountryCodeCD = "CD"
+	CountryCodeCF = "CF"
+	CountryCodeCG = "CG"
+	CountryCodeCH = "CH"
+	CountryCodeCI = "CI"
+	CountryCodeCK = "CK"
+	CountryCodeCL = "CL"
+	CountryCodeCM = "CM"
+	CountryCodeCN = "CN"
+	CountryCodeCO = "CO"
+	CountryCodeCR = "CR"
+	CountryCodeCU = "CU"
+	CountryCodeCV = "CV"
+	CountryCodeCX = "CX"
+	CountryCodeCY = "CY"
+	CountryCodeCZ = "CZ"
+	CountryCodeDE = "DE"
+	CountryCodeDJ = "DJ"
+	CountryCodeDK = "DK"
+	CountryCodeDM = "DM"
+	CountryCodeDO = "DO"
+	CountryCodeDZ = "DZ"
+	CountryCodeEC = "EC"
+	CountryCodeEE = "EE"
+	CountryCodeEG = "EG"
+	CountryCodeER = "ER"
+	CountryCodeES = "ES"
+	CountryCodeET = "ET"
+	CountryCodeFI = "FI"
+	CountryCodeFJ = "FJ"
+	CountryCodeFK = "FK"
+	CountryCodeFM = "FM"
+	CountryCodeFO = "FO"
+	CountryCodeFR = "FR"
+	CountryCodeGA = "GA"
+	CountryCodeGB = "GB"
+	CountryCodeGD = "GD"
+	CountryCodeGE = "GE"
+	CountryCodeGH = "GH"
+	CountryCodeGI = "GI"
+	CountryCodeGL = "GL"
+	CountryCodeGM = "GM"
+	CountryCodeGN = "GN"
+	CountryCodeGQ = "GQ"
+	CountryCodeGR = "GR"
+	CountryCodeGT = "GT"
+	CountryCodeGU = "GU"
+	CountryCodeGW = "GW"
+	CountryCodeGY = "GY"
+	CountryCodeHK = "HK"
+	CountryCodeHN = "HN"
+	CountryCodeHR = "HR"
+	CountryCodeHT = "HT"
+	CountryCodeHU = "HU"
+	CountryCodeID = "ID"
+	CountryCodeIE = "IE"
+	CountryCodeIL = "IL"
+	CountryCodeIM = "IM"
+	CountryCodeIN = "IN"
+	CountryCodeIQ = "IQ"
+	CountryCodeIR = "IR"
+	CountryCodeIS = "IS"
+	CountryCodeIT = "IT"
+	CountryCodeJM = "JM"
+	CountryCodeJO = "JO"
+	CountryCodeJP = "JP"
+	CountryCodeKE = "KE"
+	CountryCodeKG = "KG"
+	CountryCodeKH = "KH"
+	CountryCodeKI = "KI"
+	CountryCodeKM = "KM"
+	CountryCodeKN = "KN"
+	CountryCodeKP = "KP"
+	CountryCodeKR = "KR"
+	CountryCodeKW = "KW"
+	CountryCodeKY = "KY"
+	CountryCodeKZ = "KZ"
+	CountryCodeLA = "LA"
+	CountryCodeLB = "LB"
+	CountryCodeLC = "LC"
+	CountryCodeLI = "LI"
+	CountryCodeLK = "LK"
+	CountryCodeLR = "LR"
+	CountryCodeLS = "LS"
+	CountryCodeLT = "LT"
+	CountryCodeLU = "LU"
+	CountryCodeLV = "LV"
+	CountryCodeLY = "LY"
+	CountryCodeMA = "MA"
+	CountryCodeMC = "MC"
+	CountryCodeMD = "MD"
+	CountryCodeME = "ME"
+	CountryCodeMF = "MF"
+	CountryCodeMG = "MG"
+	CountryCodeMH = "MH"
+	CountryCodeMK = "MK"
+	CountryCodeML = "ML"
+	CountryCodeMM = "MM"
+	CountryCodeMN = "MN"
+	CountryCodeMO = "MO"
+	CountryCodeMP = "MP"
+	CountryCodeMR = "MR"
+	CountryCodeMS = "MS"
+	CountryCodeMT = "MT"
+	CountryCodeMU = "MU"
+	CountryCodeMV = "MV"
+	CountryCodeMW = "MW"
+	CountryCodeMX = "MX"
+	CountryCodeMY = "MY"
+	CountryCodeMZ = "MZ"
+	CountryCodeNA = "NA"
+	CountryCodeNC = "NC"
+	CountryCodeNE = "NE"
+	CountryCodeNG = "NG"
+	CountryCodeNI = "NI"
+	CountryCodeNL = "NL"
+	CountryCodeNO = "NO"
+	CountryCodeNP = "NP"
+	CountryCodeNR = "NR"
+	CountryCodeNU = "NU"
+	CountryCodeNZ = "NZ"
+	CountryCodeOM = "OM"
+	CountryCodePA = "PA"
+	CountryCodePE = "PE"
+	CountryCodePF = "PF"
+	CountryCodePG = "PG"
+	CountryCodePH = "PH"
+	CountryCodePK = "PK"
+	CountryCodePL = "PL"
+	CountryCodePM = "PM"
+	CountryCodePN = "PN"
+	CountryCodePR = "PR"
+	CountryCodePT = "PT"
+	CountryCodePW = "PW"
+	CountryCodePY = "PY"
+	CountryCodeQA = "QA"
+	CountryCodeRO = "RO"
+	CountryCodeRS = "RS"
+	CountryCodeRU = "RU"
+	CountryCodeRW = "RW"
+	CountryCodeSA = "SA"
+	CountryCodeSB = "SB"
+	CountryCodeSC = "SC"
+	CountryCodeSD = "SD"
+	CountryCodeSE = "SE"
+	CountryCodeSG = "SG"
+	CountryCodeSH = "SH"
+	CountryCodeSI = "SI"
+	CountryCodeSK = "SK"
+	CountryCodeSL = "SL"
+	CountryCodeSM = "SM"
+	CountryCodeSN = "SN"
+	CountryCodeSO = "SO"
+	CountryCodeSR = "SR"
+	CountryCodeST = "ST"
+	CountryCodeSV = "SV"
+	CountryCodeSY = "SY"
+	CountryCodeSZ = "SZ"
+	CountryCodeTC = "TC"
+	CountryCodeTD = "TD"
+	CountryCodeTG = "TG"
+	CountryCodeTH = "TH"
+	CountryCodeTJ = "TJ"
+	CountryCodeTK = "TK"
+	CountryCodeTL = "TL"
+	CountryCodeTM = "TM"
+	CountryCodeTN = "TN"
+	CountryCodeTO = "TO"
+	CountryCodeTR = "TR"
+	CountryCodeTT = "TT"
+	CountryCodeTV = "TV"
+	CountryCodeTW = "TW"
+	CountryCodeTZ = "TZ"
+	CountryCodeUA = "UA"
+	CountryCodeUG = "UG"
+	CountryCodeUS = "US"
+	CountryCodeUY = "UY"
+	CountryCodeUZ = "UZ"
+	CountryCodeVA = "VA"
+	CountryCodeVC = "VC"
+	CountryCodeVE = "VE"
+	CountryCodeVG = "VG"
+	CountryCodeVI = "VI"
+	CountryCodeVN = "VN"
+	CountryCodeVU = "VU"
+	CountryCodeWF = "WF"
+	CountryCodeWS = "WS"
+	CountryCodeYE = "YE"
+	CountryCodeYT = "YT"
+	CountryCodeZA = "ZA"
+	CountryCodeZM = "ZM"
+	CountryCodeZW = "ZW"
+)
+
+// CountryCodeValues returns the valid values of CountryCode.
+func CountryCodeValues() []string {
+	return []string{
+		CountryCodeAD,
+		CountryCodeAE,
+		CountryCodeAF,
+		CountryCodeAG,
+		CountryCodeAI,
+		CountryCodeAL,
+		CountryCodeAM,
+		CountryCodeAN,
+		CountryCodeAO,
+		CountryCodeAQ,
+		CountryCodeAR,
+		CountryCodeAS,
+		CountryCodeAT,
+		CountryCodeAU,
+		CountryCodeAW,
+		CountryCodeAZ,
+		CountryCodeBA,
+		CountryCodeBB,
+		CountryCodeBD,
+		CountryCodeBE,
+		CountryCodeBF,
+		CountryCodeBG,
+		CountryCodeBH,
+		CountryCodeBI,
+		CountryCodeBJ,
+		CountryCodeBL,
+		CountryCodeBM,
+		CountryCodeBN,
+		CountryCodeBO,
+		CountryCodeBR,
+		CountryCodeBS,
+		CountryCodeBT,
+		CountryCodeBW,
+		CountryCodeBY,
+		CountryCodeBZ,
+		CountryCodeCA,
+		CountryCodeCC,
+		CountryCodeCD,
+		CountryCodeCF,
+		CountryCodeCG,
+		CountryCodeCH,
+		CountryCodeCI,
+		CountryCodeCK,
+		CountryCodeCL,
+		CountryCodeCM,
+		CountryCodeCN,
+		CountryCodeCO,
+		CountryCodeCR,
+		CountryCodeCU,
+		CountryCodeCV,
+		CountryCodeCX,
+		CountryCodeCY,
+		CountryCodeCZ,
+		CountryCodeDE,
+		CountryCodeDJ,
+		CountryCodeDK,
+		CountryCodeDM,
+		CountryCodeDO,
+		CountryCodeDZ,
+		CountryCodeEC,
+		CountryCodeEE,
+		CountryCodeEG,
+		CountryCodeER,
+		CountryCodeES,
+		CountryCodeET,
+		CountryCodeFI,
+		CountryCodeFJ,
+		CountryCodeFK,
+		CountryCodeFM,
+		CountryCodeFO,
+		CountryCodeFR,
+		CountryCodeGA,
+		CountryCodeGB,
+		CountryCodeGD,
+		CountryCodeGE,
+		CountryCodeGH,
+		CountryCodeGI,
+		CountryCodeGL,
+		CountryCodeGM,
+		CountryCodeGN,
+		CountryCodeGQ,
+		CountryCodeGR,
+		CountryCodeGT,
+		CountryCodeGU,
+		CountryCodeGW,
+		CountryCodeGY,
+		CountryCodeHK,
+		CountryCodeHN,
+		CountryCodeHR,
+		CountryCodeHT,
+		CountryCodeHU,
+		CountryCodeID,
+		CountryCodeIE,
+		CountryCodeIL,
+		CountryCodeIM,
+		CountryCodeIN,
+		CountryCodeIQ,
+		CountryCodeIR,
+		CountryCodeIS,
+		CountryCodeIT,
+		CountryCodeJM,
+		CountryCodeJO,
+		CountryCodeJP,
+		CountryCodeKE,
+		CountryCodeKG,
+		CountryCodeKH,
+		CountryCodeKI,
+		CountryCodeKM,
+		CountryCodeKN,
+		CountryCodeKP,
+		CountryCodeKR,
+		CountryCodeKW,
+		CountryCodeKY,
+		CountryCodeKZ,
+		CountryCodeLA,
+		CountryCodeLB,
+		CountryCodeLC,
+		CountryCodeLI,
+		CountryCodeLK,
+		CountryCodeLR,
+		CountryCodeLS,
+		CountryCodeLT,
+		CountryCodeLU,
+		CountryCodeLV,
+		CountryCodeLY,
+		CountryCodeMA,
+		CountryCodeMC,
+		CountryCodeMD,
+		CountryCodeME,
+		CountryCodeMF,
+		CountryCodeMG,
+		CountryCodeMH,
+		CountryCodeMK,
+		CountryCodeML,
+		CountryCodeMM,
+		CountryCodeMN,
+		CountryCodeMO,
+		CountryCodeMP,
+		CountryCodeMR,
+		CountryCodeMS,
+		CountryCodeMT,
+		CountryCodeMU,
+		CountryCodeMV,
+		CountryCodeMW,
+		CountryCodeMX,
+		CountryCodeMY,
+		CountryCodeMZ,
+		CountryCodeNA,
+		CountryCodeNC,
+		CountryCodeNE,
+		CountryCodeNG,
+		CountryCodeNI,
+		CountryCodeNL,
+		CountryCodeNO,
+		CountryCodeNP,
+		CountryCodeNR,
+		CountryCodeNU,
+		CountryCodeNZ,
+		CountryCodeOM,
+		CountryCodePA,
+		CountryCodePE,
+		CountryCodePF,
+		CountryCodePG,
+		CountryCodePH,
+		CountryCodePK,
+		CountryCodePL,
+		CountryCodePM,
+		CountryCodePN,
+		CountryCodePR,
+		CountryCodePT,
+		CountryCodePW,
+		CountryCodePY,
+		CountryCodeQA,
+		CountryCodeRO,
+		CountryCodeRS,
+		CountryCodeRU,
+		CountryCodeRW,
+		CountryCodeSA,
+		CountryCodeSB,
+		CountryCodeSC,
+		CountryCodeSD,
+		CountryCodeSE,
+		CountryCodeSG,
+		CountryCodeSH,
+		CountryCodeSI,
+		CountryCodeSK,
+		CountryCodeSL,
+		CountryCodeSM,
+		CountryCodeSN,
+		CountryCodeSO,
+		CountryCodeSR,
+		CountryCodeST,
+		CountryCodeSV,
+		CountryCodeSY,
+		CountryCodeSZ,
+		CountryCodeTC,
+		CountryCodeTD,
+		CountryCodeTG,
+		CountryCodeTH,
+		CountryCodeTJ,
+		CountryCodeTK,
+		CountryCodeTL,
+		CountryCodeTM,
+		CountryCodeTN,
+		CountryCodeTO,
+		CountryCodeTR,
+		CountryCodeTT,
+		CountryCodeTV,
+		CountryCodeTW,
+		CountryCodeTZ,
+		CountryCodeUA,
+		CountryCodeUG,
+		CountryCodeUS,
+		CountryCodeUY,
+		CountryCodeUZ,
+		CountryCodeVA,
+		CountryCodeVC,
+		CountryCodeVE,
+		CountryCodeVG,
+		CountryCodeVI,
+		CountryCodeVN,
+		CountryCodeVU,
+		CountryCodeWF,
+		CountryCodeWS,
+		CountryCodeYE,
+		CountryCodeYT,
+		CountryCodeZA,
+		CountryCodeZM,
+		CountryCodeZW,
+	}
+}
+
+// Enum values for DomainAvailability.
+const (
+	DomainAvailabilityAvailable             = "AVAILABLE"
+	DomainAvailabilityAvailableReserved     = "AVAILABLE_RESERVED"
+	DomainAvailabilityAvailablePreorder     = "AVAILABLE_PREORDER"
+	DomainAvailabilityUnavailable           = "UNAVAILABLE"
+	DomainAvailabilityUnavailablePremium    = "UNAVAILABLE_PREMIUM"
+	DomainAvailabilityUnavailableRestricted = "UNAVAILABLE_RESTRICTED"
+	DomainAvailabilityReserved              = "RESERVED"
+)
+
+// DomainAvailabilityValues returns the valid values of DomainAvailability.
+func DomainAvailabilityValues() []string {
+	return []string{
+		DomainAvailabilityAvailable,
+		DomainAvailabilityAvailableReserved,
+		DomainAvailabilityAvailablePreorder,
+		DomainAvailabilityUnavailable,
+		DomainAvailabilityUnavailablePremium,
+		DomainAvailabilityUnavailableRestricted,
+		DomainAvailabilityReserved,
+	}
+}
+
+// Enum values for ExtraParamName.
+const (
+	ExtraParamNameDunsNumber           = "DUNS_NUMBER"
+	ExtraParamNameBrandNumber          = "BRAND_NUMBER"
+	ExtraParamNameBirthDepartment      = "BIRTH_DEPARTMENT"
+	ExtraParamNameBirthDateINYyyyMMDD  = "BIRTH_DATE_IN_YYYY_MM_DD"
+	ExtraParamNameBirthCountry         = "BIRTH_COUNTRY"
+	ExtraParamNameBirthCity            = "BIRTH_CITY"
+	ExtraParamNameDocumentNumber       = "DOCUMENT_NUMBER"
+	ExtraParamNameAUIDNumber           = "AU_ID_NUMBER"
+	ExtraParamNameAUIDType             = "AU_ID_TYPE"
+	ExtraParamNameCALegalType          = "CA_LEGAL_TYPE"
+	ExtraParamNameESIdentification     = "ES_IDENTIFICATION"
+	ExtraParamNameESIdentificationType = "ES_IDENTIFICATION_TYPE"
+	ExtraParamNameESLegalForm          = "ES_LEGAL_FORM"
+	ExtraParamNameFIBusinessNumber     = "FI_BUSINESS_NUMBER"
+	ExtraParamNameFIIDNumber           = "FI_ID_NUMBER"
+	ExtraParamNameITPin                = "IT_PIN"
+	ExtraParamNameRUPassportData       = "RU_PASSPORT_DATA"
+	ExtraParamNameSEIDNumber           = "SE_ID_NUMBER"
+	ExtraParamNameSGIDNumber           = "SG_ID_NUMBER"
+	ExtraParamNameVatNumber            = "VAT_NUMBER"
+)
+
+// ExtraParamNameValues returns the valid values of ExtraParamName.
+func ExtraParamNameValues() []string {
+	return []string{
+		ExtraParamNameDunsNumber,
+		ExtraParamNameBrandNumber,
+		ExtraParamNameBirthDepartment,
+		ExtraParamNameBirthDateINYyyyMMDD,
+		ExtraParamNameBirthCountry,
+		ExtraParamNameBirthCity,
+		ExtraParamNameDocumentNumber,
+		ExtraParamNameAUIDNumber,
+		ExtraParamNameAUIDType,
+		ExtraParamNameCALegalType,
+		ExtraParamNameESIdentification,
+		ExtraParamNameESIdentificationType,
+		ExtraParamNameESLegalForm,
+		ExtraParamNameFIBusinessNumber,
+		ExtraParamNameFIIDNumber,
+		ExtraParamNameITPin,
+		ExtraParamNameRUPassportData,
+		ExtraParamNameSEIDNumber,
+		ExtraParamNameSGIDNumber,
+		ExtraParamNameVatNumber,
+	}
+}
+
+// Enum values for OperationStatus.
+const (
+	OperationStatusSubmitted  = "SUBMITTED"
+	OperationStatusINProgress = "IN_PROGRESS"
+	OperationStatusError      = "ERROR"
+	OperationStatusSuccessful = "SUCCESSFUL"
+	OperationStatusFailed     = "FAILED"
+)
+
+// OperationStatusValues returns the valid values of OperationStatus.
+func OperationStatusValues() []string {
+	return []string{
+		OperationStatusSubmitted,
+		OperationStatusINProgress,
+		OperationStatusError,
+		OperationStatusSuccessful,
+		OperationStatusFailed,
+	}
+}
+
+// Enum values for OperationType.
+const (
+	OperationTypeRegisterDomain          = "REGISTER_DOMAIN"
+	OperationTypeDeleteDomain            = "DELETE_DOMAIN"
+	OperationTypeTransferINDomain        = "TRANSFER_IN_DOMAIN"
+	OperationTypeUpdateDomainContact     = "UPDATE_DOMAIN_CONTACT"
+	OperationTypeUpdateNameserver        = "UPDATE_NAMESERVER"
+	OperationTypeChangePrivacyProtection = "CHANGE_PRIVACY_PROTECTION"
+	OperationTypeDomainLock              = "DOMAIN_LOCK"
+)
+
+// OperationTypeValues returns the valid values of OperationType.
+func OperationTypeValues() []string {
+	return []string{
+		OperationTypeRegisterDomain,
+		OperationTypeDeleteDomain,
+		OperationTypeTransferINDomain,
+		OperationTypeUpdateDomainContact,
+		OperationTypeUpdateNameserver,
+		OperationTypeChangePrivacyProtection,
+		OperationTypeDomainLock,
+	}
 }
\ No newline at end of file
@@ -1,43 +1,83 @@
 package route53domainsiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/route53domains"
 )
 
 type Route53DomainsAPI interface {
 	CheckDomainAvailability(*route53domains.CheckDomainAvailabilityInput) (*route53domains.CheckDomainAvailabilityOutput, error)
 
+	CheckDomainAvailabilityWithContext(context.Context, *route53domains.CheckDomainAvailabilityInput) (*route53domains.CheckDomainAvailabilityOutput, error)
+
 	DeleteTagsForDomain(*route53domains.DeleteTagsForDomainInput) (*route53domains.DeleteTagsForDomainOutput, error)
 
+	DeleteTagsForDomainWithContext(context.Context, *route53domains.DeleteTagsForDomainInput) (*route53domains.DeleteTagsForDomainOutput, error)
+
 	DisableDomainAutoRenew(*route53domains.DisableDomainAutoRenewInput) (*route53domains.DisableDomainAutoRenewOutput, error)
 
+	DisableDomainAutoRenewWithContext(context.Context, *route53domains.DisableDomainAutoRenewInput) (*route53domains.DisableDomainAutoRenewOutput, error)
+
 	DisableDomainTransferLock(*route53domains.DisableDomainTransferLockInput) (*route53domains.DisableDomainTransferLockOutput, error)
 
+	DisableDomainTransferLockWithContext(context.Context, *route53domains.DisableDomainTransferLockInput) (*route53domains.DisableDomainTransferLockOutput, error)
+
 	EnableDomainAutoRenew(*route53domains.EnableDomainAutoRenewInput) (*route53domains.EnableDomainAutoRenewOutput, error)
 
+	EnableDomainAutoRenewWithContext(context.Context, *route53domains.EnableDomainAutoRenewInput) (*route53domains.EnableDomainAutoRenewOutput, error)
+
 	EnableDomainTransferLock(*route53domains.EnableDomainTransferLockInput) (*route53domains.EnableDomainTransferLockOutput, error)
 
+	EnableDomainTransferLockWithContext(context.Context, *route53domains.EnableDomainTransferLockInput) (*route53domains.EnableDomainTransferLockOutput, error)
+
 	GetDomainDetail(*route53domains.GetDomainDetailInput) (*route53domains.GetDomainDetailOutput, error)
 
+	GetDomainDetailWithContext(context.Context, *route53domains.GetDomainDetailInput) (*route53domains.GetDomainDetailOutput, error)
+
 	GetOperationDetail(*route53domains.GetOperationDetailInput) (*route53domains.GetOperationDetailOutput, error)
 
+	GetOperationDetailWithContext(context.Context, *route53domains.GetOperationDetailInput) (*route53domains.GetOperationDetailOutput, error)
+
 	ListDomains(*route53domains.ListDomainsInput) (*route53domains.ListDomainsOutput, error)
 
+	ListDomainsWithContext(context.Context, *route53domains.ListDomainsInput) (*route53domains.ListDomainsOutput, error)
+	ListDomainsPages(*route53domains.ListDomainsInput, func(*route53domains.ListDomainsOutput, bool) bool) error
+
 	ListOperations(*route53domains.ListOperationsInput) (*route53domains.ListOperationsOutput, error)
 
+	ListOperationsWithContext(context.Context, *route53domains.ListOperationsInput) (*route53domains.ListOperationsOutput, error)
+	ListOperationsPages(*route53domains.ListOperationsInput, func(*route53domains.ListOperationsOutput, bool) bool) error
+
 	ListTagsForDomain(*route53domains.ListTagsForDomainInput) (*route53domains.ListTagsForDomainOutput, error)
 
+	ListTagsForDomainWithContext(context.Context, *route53domains.ListTagsForDomainInput) (*route53domains.ListTagsForDomainOutput, error)
+
 	RegisterDomain(*route53domains.RegisterDomainInput) (*route53domains.RegisterDomainOutput, error)
 
+	RegisterDomainWithContext(context.Context, *route53domains.RegisterDomainInput) (*route53domains.RegisterDomainOutput, error)
+
 	RetrieveDomainAuthCode(*route53domains.RetrieveDomainAuthCodeInput) (*route53domains.RetrieveDomainAuthCodeOutput, error)
 
+	RetrieveDomainAuthCodeWithContext(context.Context, *route53domains.RetrieveDomainAuthCodeInput) (*route53domains.RetrieveDomainAuthCodeOutput, error)
+
 	TransferDomain(*route53domains.TransferDomainInput) (*route53domains.TransferDomainOutput, error)
 
+	TransferDomainWithContext(context.Context, *route53domains.TransferDomainInput) (*route53domains.TransferDomainOutput, error)
+
 	UpdateDomainContact(*route53domains.UpdateDomainContactInput) (*route53domains.UpdateDomainContactOutput, error)
 
+	UpdateDomainContactWithContext(context.Context, *route53domains.UpdateDomainContactInput) (*route53domains.UpdateDomainContactOutput, error)
+
 	UpdateDomainContactPrivacy(*route53domains.UpdateDomainContactPrivacyInput) (*route53domains.UpdateDomainContactPrivacyOutput, error)
 
+	UpdateDomainContactPrivacyWithContext(context.Context, *route53domains.UpdateDomainContactPrivacyInput) (*route53domains.UpdateDomainContactPrivacyOutput, error)
+
 	UpdateDomainNameservers(*route53domains.UpdateDomainNameserversInput) (*route53domains.UpdateDomainNameserversOutput, error)
 
+	UpdateDomainNameserversWithContext(context.Context, *route53domains.UpdateDomainNameserversInput) (*route53domains.UpdateDomainNameserversOutput, error)
+
 	UpdateTagsForDomain(*route53domains.UpdateTagsForDomainInput) (*route53domains.UpdateTagsForDomainOutput, error)
+
+	UpdateTagsForDomainWithContext(context.Context, *route53domains.UpdateTagsForDomainInput) (*route53domains.UpdateTagsForDomainOutput, error)
 }
\ No newline at end of file
@@ -29,6 +29,7 @@ func New(config *aws.Config) *Route53Domains {
 		APIVersion:   "2014-05-15",
 		JSONVersion:  "1.1",
 		TargetPrefix: "Route53Domains_v20140515",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
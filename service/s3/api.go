@@ -4,6 +4,7 @@
 package s3
 
 import (
+	"context"
 	"io"
 	"sync"
 	"time"
@@ -48,6 +49,17 @@ func (c *S3) AbortMultipartUpload(input *AbortMultipartUploadInput) (output *Abo
 	return
 }
 
+// AbortMultipartUploadWithContext is the same as AbortMultipartUpload with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) AbortMultipartUploadWithContext(ctx context.Context, input *AbortMultipartUploadInput) (output *AbortMultipartUploadOutput, err error) {
+	req, out := c.AbortMultipartUploadRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAbortMultipartUpload *aws.Operation
 
 // CompleteMultipartUploadRequest generates a request for the CompleteMultipartUpload operation.
@@ -81,6 +93,17 @@ func (c *S3) CompleteMultipartUpload(input *CompleteMultipartUploadInput) (outpu
 	return
 }
 
+// CompleteMultipartUploadWithContext is the same as CompleteMultipartUpload with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) CompleteMultipartUploadWithContext(ctx context.Context, input *CompleteMultipartUploadInput) (output *CompleteMultipartUploadOutput, err error) {
+	req, out := c.CompleteMultipartUploadRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCompleteMultipartUpload *aws.Operation
 
 // CopyObjectRequest generates a request for the CopyObject operation.
@@ -114,6 +137,17 @@ func (c *S3) CopyObject(input *CopyObjectInput) (output *CopyObjectOutput, err e
 	return
 }
 
+// CopyObjectWithContext is the same as CopyObject with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) CopyObjectWithContext(ctx context.Context, input *CopyObjectInput) (output *CopyObjectOutput, err error) {
+	req, out := c.CopyObjectRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCopyObject *aws.Operation
 
 // CreateBucketRequest generates a request for the CreateBucket operation.
@@ -147,6 +181,17 @@ func (c *S3) CreateBucket(input *CreateBucketInput) (output *CreateBucketOutput,
 	return
 }
 
+// CreateBucketWithContext is the same as CreateBucket with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) CreateBucketWithContext(ctx context.Context, input *CreateBucketInput) (output *CreateBucketOutput, err error) {
+	req, out := c.CreateBucketRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateBucket *aws.Operation
 
 // CreateMultipartUploadRequest generates a request for the CreateMultipartUpload operation.
@@ -186,6 +231,17 @@ func (c *S3) CreateMultipartUpload(input *CreateMultipartUploadInput) (output *C
 	return
 }
 
+// CreateMultipartUploadWithContext is the same as CreateMultipartUpload with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) CreateMultipartUploadWithContext(ctx context.Context, input *CreateMultipartUploadInput) (output *CreateMultipartUploadOutput, err error) {
+	req, out := c.CreateMultipartUploadRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateMultipartUpload *aws.Operation
 
 // DeleteBucketRequest generates a request for the DeleteBucket operation.
@@ -220,6 +276,17 @@ func (c *S3) DeleteBucket(input *DeleteBucketInput) (output *DeleteBucketOutput,
 	return
 }
 
+// DeleteBucketWithContext is the same as DeleteBucket with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) DeleteBucketWithContext(ctx context.Context, input *DeleteBucketInput) (output *DeleteBucketOutput, err error) {
+	req, out := c.DeleteBucketRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteBucket *aws.Operation
 
 // DeleteBucketCORSRequest generates a request for the DeleteBucketCORS operation.
@@ -253,6 +320,17 @@ func (c *S3) DeleteBucketCORS(input *DeleteBucketCORSInput) (output *DeleteBucke
 	return
 }
 
+// DeleteBucketCORSWithContext is the same as DeleteBucketCORS with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) DeleteBucketCORSWithContext(ctx context.Context, input *DeleteBucketCORSInput) (output *DeleteBucketCORSOutput, err error) {
+	req, out := c.DeleteBucketCORSRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteBucketCORS *aws.Operation
 
 // DeleteBucketLifecycleRequest generates a request for the DeleteBucketLifecycle operation.
@@ -286,6 +364,17 @@ func (c *S3) DeleteBucketLifecycle(input *DeleteBucketLifecycleInput) (output *D
 	return
 }
 
+// DeleteBucketLifecycleWithContext is the same as DeleteBucketLifecycle with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) DeleteBucketLifecycleWithContext(ctx context.Context, input *DeleteBucketLifecycleInput) (output *DeleteBucketLifecycleOutput, err error) {
+	req, out := c.DeleteBucketLifecycleRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteBucketLifecycle *aws.Operation
 
 // DeleteBucketPolicyRequest generates a request for the DeleteBucketPolicy operation.
@@ -319,6 +408,17 @@ func (c *S3) DeleteBucketPolicy(input *DeleteBucketPolicyInput) (output *DeleteB
 	return
 }
 
+// DeleteBucketPolicyWithContext is the same as DeleteBucketPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) DeleteBucketPolicyWithContext(ctx context.Context, input *DeleteBucketPolicyInput) (output *DeleteBucketPolicyOutput, err error) {
+	req, out := c.DeleteBucketPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteBucketPolicy *aws.Operation
 
 // DeleteBucketReplicationRequest generates a request for the DeleteBucketReplication operation.
@@ -351,6 +451,17 @@ func (c *S3) DeleteBucketReplication(input *DeleteBucketReplicationInput) (outpu
 	return
 }
 
+// DeleteBucketReplicationWithContext is the same as DeleteBucketReplication with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) DeleteBucketReplicationWithContext(ctx context.Context, input *DeleteBucketReplicationInput) (output *DeleteBucketReplicationOutput, err error) {
+	req, out := c.DeleteBucketReplicationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteBucketReplication *aws.Operation
 
 // DeleteBucketTaggingRequest generates a request for the DeleteBucketTagging operation.
@@ -384,6 +495,17 @@ func (c *S3) DeleteBucketTagging(input *DeleteBucketTaggingInput) (output *Delet
 	return
 }
 
+// DeleteBucketTaggingWithContext is the same as DeleteBucketTagging with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) DeleteBucketTaggingWithContext(ctx context.Context, input *DeleteBucketTaggingInput) (output *DeleteBucketTaggingOutput, err error) {
+	req, out := c.DeleteBucketTaggingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteBucketTagging *aws.Operation
 
 // DeleteBucketWebsiteRequest generates a request for the DeleteBucketWebsite operation.
@@ -417,6 +539,17 @@ func (c *S3) DeleteBucketWebsite(input *DeleteBucketWebsiteInput) (output *Delet
 	return
 }
 
+// DeleteBucketWebsiteWithContext is the same as DeleteBucketWebsite with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) DeleteBucketWebsiteWithContext(ctx context.Context, input *DeleteBucketWebsiteInput) (output *DeleteBucketWebsiteOutput, err error) {
+	req, out := c.DeleteBucketWebsiteRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteBucketWebsite *aws.Operation
 
 // DeleteObjectRequest generates a request for the DeleteObject operation.
@@ -452,6 +585,17 @@ func (c *S3) DeleteObject(input *DeleteObjectInput) (output *DeleteObjectOutput,
 	return
 }
 
+// DeleteObjectWithContext is the same as DeleteObject with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) DeleteObjectWithContext(ctx context.Context, input *DeleteObjectInput) (output *DeleteObjectOutput, err error) {
+	req, out := c.DeleteObjectRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteObject *aws.Operation
 
 // DeleteObjectsRequest generates a request for the DeleteObjects operation.
@@ -486,6 +630,17 @@ func (c *S3) DeleteObjects(input *DeleteObjectsInput) (output *DeleteObjectsOutp
 	return
 }
 
+// DeleteObjectsWithContext is the same as DeleteObjects with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) DeleteObjectsWithContext(ctx context.Context, input *DeleteObjectsInput) (output *DeleteObjectsOutput, err error) {
+	req, out := c.DeleteObjectsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteObjects *aws.Operation
 
 // GetBucketACLRequest generates a request for the GetBucketACL operation.
@@ -519,6 +674,17 @@ func (c *S3) GetBucketACL(input *GetBucketACLInput) (output *GetBucketACLOutput,
 	return
 }
 
+// GetBucketACLWithContext is the same as GetBucketACL with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) GetBucketACLWithContext(ctx context.Context, input *GetBucketACLInput) (output *GetBucketACLOutput, err error) {
+	req, out := c.GetBucketACLRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetBucketACL *aws.Operation
 
 // GetBucketCORSRequest generates a request for the GetBucketCORS operation.
@@ -552,6 +718,17 @@ func (c *S3) GetBucketCORS(input *GetBucketCORSInput) (output *GetBucketCORSOutp
 	return
 }
 
+// GetBucketCORSWithContext is the same as GetBucketCORS with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) GetBucketCORSWithContext(ctx context.Context, input *GetBucketCORSInput) (output *GetBucketCORSOutput, err error) {
+	req, out := c.GetBucketCORSRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetBucketCORS *aws.Operation
 
 // GetBucketLifecycleRequest generates a request for the GetBucketLifecycle operation.
@@ -585,6 +762,17 @@ func (c *S3) GetBucketLifecycle(input *GetBucketLifecycleInput) (output *GetBuck
 	return
 }
 
+// GetBucketLifecycleWithContext is the same as GetBucketLifecycle with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) GetBucketLifecycleWithContext(ctx context.Context, input *GetBucketLifecycleInput) (output *GetBucketLifecycleOutput, err error) {
+	req, out := c.GetBucketLifecycleRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetBucketLifecycle *aws.Operation
 
 // GetBucketLocationRequest generates a request for the GetBucketLocation operation.
@@ -618,6 +806,17 @@ func (c *S3) GetBucketLocation(input *GetBucketLocationInput) (output *GetBucket
 	return
 }
 
+// GetBucketLocationWithContext is the same as GetBucketLocation with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) GetBucketLocationWithContext(ctx context.Context, input *GetBucketLocationInput) (output *GetBucketLocationOutput, err error) {
+	req, out := c.GetBucketLocationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetBucketLocation *aws.Operation
 
 // GetBucketLoggingRequest generates a request for the GetBucketLogging operation.
@@ -652,6 +851,17 @@ func (c *S3) GetBucketLogging(input *GetBucketLoggingInput) (output *GetBucketLo
 	return
 }
 
+// GetBucketLoggingWithContext is the same as GetBucketLogging with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) GetBucketLoggingWithContext(ctx context.Context, input *GetBucketLoggingInput) (output *GetBucketLoggingOutput, err error) {
+	req, out := c.GetBucketLoggingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetBucketLogging *aws.Operation
 
 // GetBucketNotificationRequest generates a request for the GetBucketNotification operation.
@@ -685,6 +895,17 @@ func (c *S3) GetBucketNotification(input *GetBucketNotificationInput) (output *G
 	return
 }
 
+// GetBucketNotificationWithContext is the same as GetBucketNotification with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) GetBucketNotificationWithContext(ctx context.Context, input *GetBucketNotificationInput) (output *GetBucketNotificationOutput, err error) {
+	req, out := c.GetBucketNotificationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetBucketNotification *aws.Operation
 
 // GetBucketPolicyRequest generates a request for the GetBucketPolicy operation.
@@ -718,6 +939,17 @@ func (c *S3) GetBucketPolicy(input *GetBucketPolicyInput) (output *GetBucketPoli
 	return
 }
 
+// GetBucketPolicyWithContext is the same as GetBucketPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) GetBucketPolicyWithContext(ctx context.Context, input *GetBucketPolicyInput) (output *GetBucketPolicyOutput, err error) {
+	req, out := c.GetBucketPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetBucketPolicy *aws.Operation
 
 // GetBucketReplicationRequest generates a request for the GetBucketReplication operation.
@@ -750,6 +982,17 @@ func (c *S3) GetBucketReplication(input *GetBucketReplicationInput) (output *Get
 	return
 }
 
+// GetBucketReplicationWithContext is the same as GetBucketReplication with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) GetBucketReplicationWithContext(ctx context.Context, input *GetBucketReplicationInput) (output *GetBucketReplicationOutput, err error) {
+	req, out := c.GetBucketReplicationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetBucketReplication *aws.Operation
 
 // GetBucketRequestPaymentRequest generates a request for the GetBucketRequestPayment operation.
@@ -783,6 +1026,17 @@ func (c *S3) GetBucketRequestPayment(input *GetBucketRequestPaymentInput) (outpu
 	return
 }
 
+// GetBucketRequestPaymentWithContext is the same as GetBucketRequestPayment with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) GetBucketRequestPaymentWithContext(ctx context.Context, input *GetBucketRequestPaymentInput) (output *GetBucketRequestPaymentOutput, err error) {
+	req, out := c.GetBucketRequestPaymentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetBucketRequestPayment *aws.Operation
 
 // GetBucketTaggingRequest generates a request for the GetBucketTagging operation.
@@ -816,6 +1070,17 @@ func (c *S3) GetBucketTagging(input *GetBucketTaggingInput) (output *GetBucketTa
 	return
 }
 
+// GetBucketTaggingWithContext is the same as GetBucketTagging with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) GetBucketTaggingWithContext(ctx context.Context, input *GetBucketTaggingInput) (output *GetBucketTaggingOutput, err error) {
+	req, out := c.GetBucketTaggingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetBucketTagging *aws.Operation
 
 // GetBucketVersioningRequest generates a request for the GetBucketVersioning operation.
@@ -849,6 +1114,17 @@ func (c *S3) GetBucketVersioning(input *GetBucketVersioningInput) (output *GetBu
 	return
 }
 
+// GetBucketVersioningWithContext is the same as GetBucketVersioning with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) GetBucketVersioningWithContext(ctx context.Context, input *GetBucketVersioningInput) (output *GetBucketVersioningOutput, err error) {
+	req, out := c.GetBucketVersioningRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetBucketVersioning *aws.Operation
 
 // GetBucketWebsiteRequest generates a request for the GetBucketWebsite operation.
@@ -882,6 +1158,17 @@ func (c *S3) GetBucketWebsite(input *GetBucketWebsiteInput) (output *GetBucketWe
 	return
 }
 
+// GetBucketWebsiteWithContext is the same as GetBucketWebsite with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) GetBucketWebsiteWithContext(ctx context.Context, input *GetBucketWebsiteInput) (output *GetBucketWebsiteOutput, err error) {
+	req, out := c.GetBucketWebsiteRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetBucketWebsite *aws.Operation
 
 // GetObjectRequest generates a request for the GetObject operation.
@@ -915,6 +1202,17 @@ func (c *S3) GetObject(input *GetObjectInput) (output *GetObjectOutput, err erro
 	return
 }
 
+// GetObjectWithContext is the same as GetObject with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) GetObjectWithContext(ctx context.Context, input *GetObjectInput) (output *GetObjectOutput, err error) {
+	req, out := c.GetObjectRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetObject *aws.Operation
 
 // GetObjectACLRequest generates a request for the GetObjectACL operation.
@@ -948,6 +1246,17 @@ func (c *S3) GetObjectACL(input *GetObjectACLInput) (output *GetObjectACLOutput,
 	return
 }
 
+// GetObjectACLWithContext is the same as GetObjectACL with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) GetObjectACLWithContext(ctx context.Context, input *GetObjectACLInput) (output *GetObjectACLOutput, err error) {
+	req, out := c.GetObjectACLRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetObjectACL *aws.Operation
 
 // GetObjectTorrentRequest generates a request for the GetObjectTorrent operation.
@@ -981,6 +1290,17 @@ func (c *S3) GetObjectTorrent(input *GetObjectTorrentInput) (output *GetObjectTo
 	return
 }
 
+// GetObjectTorrentWithContext is the same as GetObjectTorrent with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) GetObjectTorrentWithContext(ctx context.Context, input *GetObjectTorrentInput) (output *GetObjectTorrentOutput, err error) {
+	req, out := c.GetObjectTorrentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetObjectTorrent *aws.Operation
 
 // HeadBucketRequest generates a request for the HeadBucket operation.
@@ -1015,6 +1335,17 @@ func (c *S3) HeadBucket(input *HeadBucketInput) (output *HeadBucketOutput, err e
 	return
 }
 
+// HeadBucketWithContext is the same as HeadBucket with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) HeadBucketWithContext(ctx context.Context, input *HeadBucketInput) (output *HeadBucketOutput, err error) {
+	req, out := c.HeadBucketRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opHeadBucket *aws.Operation
 
 // HeadObjectRequest generates a request for the HeadObject operation.
@@ -1050,6 +1381,17 @@ func (c *S3) HeadObject(input *HeadObjectInput) (output *HeadObjectOutput, err e
 	return
 }
 
+// HeadObjectWithContext is the same as HeadObject with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) HeadObjectWithContext(ctx context.Context, input *HeadObjectInput) (output *HeadObjectOutput, err error) {
+	req, out := c.HeadObjectRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opHeadObject *aws.Operation
 
 // ListBucketsRequest generates a request for the ListBuckets operation.
@@ -1083,6 +1425,17 @@ func (c *S3) ListBuckets(input *ListBucketsInput) (output *ListBucketsOutput, er
 	return
 }
 
+// ListBucketsWithContext is the same as ListBuckets with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) ListBucketsWithContext(ctx context.Context, input *ListBucketsInput) (output *ListBucketsOutput, err error) {
+	req, out := c.ListBucketsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListBuckets *aws.Operation
 
 // ListMultipartUploadsRequest generates a request for the ListMultipartUploads operation.
@@ -1095,6 +1448,12 @@ func (c *S3) ListMultipartUploadsRequest(input *ListMultipartUploadsInput) (req
 			Name:       "ListMultipartUploads",
 			HTTPMethod: "GET",
 			HTTPPath:   "/{Bucket}?uploads",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"KeyMarker", "UploadIDMarker"},
+				OutputTokens:    []string{"NextKeyMarker", "NextUploadIDMarker"},
+				LimitToken:      "MaxUploads",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -1116,6 +1475,27 @@ func (c *S3) ListMultipartUploads(input *ListMultipartUploadsInput) (output *Lis
 	return
 }
 
+// ListMultipartUploadsWithContext is the same as ListMultipartUploads with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) ListMultipartUploadsWithContext(ctx context.Context, input *ListMultipartUploadsInput) (output *ListMultipartUploadsOutput, err error) {
+	req, out := c.ListMultipartUploadsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListMultipartUploadsPages iterates over the pages of a ListMultipartUploads operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *S3) ListMultipartUploadsPages(input *ListMultipartUploadsInput, fn func(p *ListMultipartUploadsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListMultipartUploadsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListMultipartUploadsOutput), lastPage)
+	})
+}
+
 var opListMultipartUploads *aws.Operation
 
 // ListObjectVersionsRequest generates a request for the ListObjectVersions operation.
@@ -1128,6 +1508,12 @@ func (c *S3) ListObjectVersionsRequest(input *ListObjectVersionsInput) (req *aws
 			Name:       "ListObjectVersions",
 			HTTPMethod: "GET",
 			HTTPPath:   "/{Bucket}?versions",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"KeyMarker", "VersionIDMarker"},
+				OutputTokens:    []string{"NextKeyMarker", "NextVersionIDMarker"},
+				LimitToken:      "MaxKeys",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -1149,6 +1535,27 @@ func (c *S3) ListObjectVersions(input *ListObjectVersionsInput) (output *ListObj
 	return
 }
 
+// ListObjectVersionsWithContext is the same as ListObjectVersions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) ListObjectVersionsWithContext(ctx context.Context, input *ListObjectVersionsInput) (output *ListObjectVersionsOutput, err error) {
+	req, out := c.ListObjectVersionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListObjectVersionsPages iterates over the pages of a ListObjectVersions operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *S3) ListObjectVersionsPages(input *ListObjectVersionsInput, fn func(p *ListObjectVersionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListObjectVersionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListObjectVersionsOutput), lastPage)
+	})
+}
+
 var opListObjectVersions *aws.Operation
 
 // ListObjectsRequest generates a request for the ListObjects operation.
@@ -1161,6 +1568,12 @@ func (c *S3) ListObjectsRequest(input *ListObjectsInput) (req *aws.Request, outp
 			Name:       "ListObjects",
 			HTTPMethod: "GET",
 			HTTPPath:   "/{Bucket}",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"NextMarker || Contents[-1].Key"},
+				LimitToken:      "MaxKeys",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -1184,6 +1597,27 @@ func (c *S3) ListObjects(input *ListObjectsInput) (output *ListObjectsOutput, er
 	return
 }
 
+// ListObjectsWithContext is the same as ListObjects with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) ListObjectsWithContext(ctx context.Context, input *ListObjectsInput) (output *ListObjectsOutput, err error) {
+	req, out := c.ListObjectsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListObjectsPages iterates over the pages of a ListObjects operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *S3) ListObjectsPages(input *ListObjectsInput, fn func(p *ListObjectsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListObjectsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListObjectsOutput), lastPage)
+	})
+}
+
 var opListObjects *aws.Operation
 
 // ListPartsRequest generates a request for the ListParts operation.
@@ -1196,6 +1630,12 @@ func (c *S3) ListPartsRequest(input *ListPartsInput) (req *aws.Request, output *
 			Name:       "ListParts",
 			HTTPMethod: "GET",
 			HTTPPath:   "/{Bucket}/{Key+}",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"PartNumberMarker"},
+				OutputTokens:    []string{"NextPartNumberMarker"},
+				LimitToken:      "MaxParts",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -1217,6 +1657,27 @@ func (c *S3) ListParts(input *ListPartsInput) (output *ListPartsOutput, err erro
 	return
 }
 
+// ListPartsWithContext is the same as ListParts with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) ListPartsWithContext(ctx context.Context, input *ListPartsInput) (output *ListPartsOutput, err error) {
+	req, out := c.ListPartsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListPartsPages iterates over the pages of a ListParts operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *S3) ListPartsPages(input *ListPartsInput, fn func(p *ListPartsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListPartsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListPartsOutput), lastPage)
+	})
+}
+
 var opListParts *aws.Operation
 
 // PutBucketACLRequest generates a request for the PutBucketACL operation.
@@ -1250,6 +1711,17 @@ func (c *S3) PutBucketACL(input *PutBucketACLInput) (output *PutBucketACLOutput,
 	return
 }
 
+// PutBucketACLWithContext is the same as PutBucketACL with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) PutBucketACLWithContext(ctx context.Context, input *PutBucketACLInput) (output *PutBucketACLOutput, err error) {
+	req, out := c.PutBucketACLRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutBucketACL *aws.Operation
 
 // PutBucketCORSRequest generates a request for the PutBucketCORS operation.
@@ -1283,6 +1755,17 @@ func (c *S3) PutBucketCORS(input *PutBucketCORSInput) (output *PutBucketCORSOutp
 	return
 }
 
+// PutBucketCORSWithContext is the same as PutBucketCORS with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) PutBucketCORSWithContext(ctx context.Context, input *PutBucketCORSInput) (output *PutBucketCORSOutput, err error) {
+	req, out := c.PutBucketCORSRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutBucketCORS *aws.Operation
 
 // PutBucketLifecycleRequest generates a request for the PutBucketLifecycle operation.
@@ -1317,6 +1800,17 @@ func (c *S3) PutBucketLifecycle(input *PutBucketLifecycleInput) (output *PutBuck
 	return
 }
 
+// PutBucketLifecycleWithContext is the same as PutBucketLifecycle with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) PutBucketLifecycleWithContext(ctx context.Context, input *PutBucketLifecycleInput) (output *PutBucketLifecycleOutput, err error) {
+	req, out := c.PutBucketLifecycleRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutBucketLifecycle *aws.Operation
 
 // PutBucketLoggingRequest generates a request for the PutBucketLogging operation.
@@ -1352,6 +1846,17 @@ func (c *S3) PutBucketLogging(input *PutBucketLoggingInput) (output *PutBucketLo
 	return
 }
 
+// PutBucketLoggingWithContext is the same as PutBucketLogging with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) PutBucketLoggingWithContext(ctx context.Context, input *PutBucketLoggingInput) (output *PutBucketLoggingOutput, err error) {
+	req, out := c.PutBucketLoggingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutBucketLogging *aws.Operation
 
 // PutBucketNotificationRequest generates a request for the PutBucketNotification operation.
@@ -1385,6 +1890,17 @@ func (c *S3) PutBucketNotification(input *PutBucketNotificationInput) (output *P
 	return
 }
 
+// PutBucketNotificationWithContext is the same as PutBucketNotification with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) PutBucketNotificationWithContext(ctx context.Context, input *PutBucketNotificationInput) (output *PutBucketNotificationOutput, err error) {
+	req, out := c.PutBucketNotificationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutBucketNotification *aws.Operation
 
 // PutBucketPolicyRequest generates a request for the PutBucketPolicy operation.
@@ -1419,6 +1935,17 @@ func (c *S3) PutBucketPolicy(input *PutBucketPolicyInput) (output *PutBucketPoli
 	return
 }
 
+// PutBucketPolicyWithContext is the same as PutBucketPolicy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) PutBucketPolicyWithContext(ctx context.Context, input *PutBucketPolicyInput) (output *PutBucketPolicyOutput, err error) {
+	req, out := c.PutBucketPolicyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutBucketPolicy *aws.Operation
 
 // PutBucketReplicationRequest generates a request for the PutBucketReplication operation.
@@ -1453,6 +1980,17 @@ func (c *S3) PutBucketReplication(input *PutBucketReplicationInput) (output *Put
 	return
 }
 
+// PutBucketReplicationWithContext is the same as PutBucketReplication with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) PutBucketReplicationWithContext(ctx context.Context, input *PutBucketReplicationInput) (output *PutBucketReplicationOutput, err error) {
+	req, out := c.PutBucketReplicationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutBucketReplication *aws.Operation
 
 // PutBucketRequestPaymentRequest generates a request for the PutBucketRequestPayment operation.
@@ -1490,6 +2028,17 @@ func (c *S3) PutBucketRequestPayment(input *PutBucketRequestPaymentInput) (outpu
 	return
 }
 
+// PutBucketRequestPaymentWithContext is the same as PutBucketRequestPayment with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) PutBucketRequestPaymentWithContext(ctx context.Context, input *PutBucketRequestPaymentInput) (output *PutBucketRequestPaymentOutput, err error) {
+	req, out := c.PutBucketRequestPaymentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutBucketRequestPayment *aws.Operation
 
 // PutBucketTaggingRequest generates a request for the PutBucketTagging operation.
@@ -1523,6 +2072,17 @@ func (c *S3) PutBucketTagging(input *PutBucketTaggingInput) (output *PutBucketTa
 	return
 }
 
+// PutBucketTaggingWithContext is the same as PutBucketTagging with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) PutBucketTaggingWithContext(ctx context.Context, input *PutBucketTaggingInput) (output *PutBucketTaggingOutput, err error) {
+	req, out := c.PutBucketTaggingRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutBucketTagging *aws.Operation
 
 // PutBucketVersioningRequest generates a request for the PutBucketVersioning operation.
@@ -1557,6 +2117,17 @@ func (c *S3) PutBucketVersioning(input *PutBucketVersioningInput) (output *PutBu
 	return
 }
 
+// PutBucketVersioningWithContext is the same as PutBucketVersioning with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) PutBucketVersioningWithContext(ctx context.Context, input *PutBucketVersioningInput) (output *PutBucketVersioningOutput, err error) {
+	req, out := c.PutBucketVersioningRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutBucketVersioning *aws.Operation
 
 // PutBucketWebsiteRequest generates a request for the PutBucketWebsite operation.
@@ -1590,6 +2161,17 @@ func (c *S3) PutBucketWebsite(input *PutBucketWebsiteInput) (output *PutBucketWe
 	return
 }
 
+// PutBucketWebsiteWithContext is the same as PutBucketWebsite with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) PutBucketWebsiteWithContext(ctx context.Context, input *PutBucketWebsiteInput) (output *PutBucketWebsiteOutput, err error) {
+	req, out := c.PutBucketWebsiteRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutBucketWebsite *aws.Operation
 
 // PutObjectRequest generates a request for the PutObject operation.
@@ -1623,6 +2205,17 @@ func (c *S3) PutObject(input *PutObjectInput) (output *PutObjectOutput, err erro
 	return
 }
 
+// PutObjectWithContext is the same as PutObject with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) PutObjectWithContext(ctx context.Context, input *PutObjectInput) (output *PutObjectOutput, err error) {
+	req, out := c.PutObjectRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutObject *aws.Operation
 
 // PutObjectACLRequest generates a request for the PutObjectACL operation.
@@ -1657,6 +2250,17 @@ func (c *S3) PutObjectACL(input *PutObjectACLInput) (output *PutObjectACLOutput,
 	return
 }
 
+// PutObjectACLWithContext is the same as PutObjectACL with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) PutObjectACLWithContext(ctx context.Context, input *PutObjectACLInput) (output *PutObjectACLOutput, err error) {
+	req, out := c.PutObjectACLRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPutObjectACL *aws.Operation
 
 // RestoreObjectRequest generates a request for the RestoreObject operation.
@@ -1690,6 +2294,17 @@ func (c *S3) RestoreObject(input *RestoreObjectInput) (output *RestoreObjectOutp
 	return
 }
 
+// RestoreObjectWithContext is the same as RestoreObject with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) RestoreObjectWithContext(ctx context.Context, input *RestoreObjectInput) (output *RestoreObjectOutput, err error) {
+	req, out := c.RestoreObjectRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRestoreObject *aws.Operation
 
 // UploadPartRequest generates a request for the UploadPart operation.
@@ -1729,6 +2344,17 @@ func (c *S3) UploadPart(input *UploadPartInput) (output *UploadPartOutput, err e
 	return
 }
 
+// UploadPartWithContext is the same as UploadPart with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) UploadPartWithContext(ctx context.Context, input *UploadPartInput) (output *UploadPartOutput, err error) {
+	req, out := c.UploadPartRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUploadPart *aws.Operation
 
 // UploadPartCopyRequest generates a request for the UploadPartCopy operation.
@@ -1762,6 +2388,17 @@ func (c *S3) UploadPartCopy(input *UploadPartCopyInput) (output *UploadPartCopyO
 	return
 }
 
+// UploadPartCopyWithContext is the same as UploadPartCopy with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *S3) UploadPartCopyWithContext(ctx context.Context, input *UploadPartCopyInput) (output *UploadPartCopyOutput, err error) {
+	req, out := c.UploadPartCopyRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUploadPartCopy *aws.Operation
 
 type AbortMultipartUploadInput struct {
@@ -1773,7 +2410,7 @@ type AbortMultipartUploadInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	UploadID *string `location:"querystring" locationName:"uploadId" type:"string" required:"true"`
 
@@ -1787,7 +2424,7 @@ type metadataAbortMultipartUploadInput struct {
 type AbortMultipartUploadOutput struct {
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	metadataAbortMultipartUploadOutput `json:"-", xml:"-"`
 }
@@ -1873,7 +2510,7 @@ type metadataCORSRule struct {
 type CloudFunctionConfiguration struct {
 	CloudFunction *string `type:"string"`
 
-	Event *string `type:"string"`
+	Event *string `type:"string" enum:"s3:ReducedRedundancyLostObject,s3:ObjectCreated:Put,s3:ObjectCreated:Post,s3:ObjectCreated:Copy,s3:ObjectCreated:CompleteMultipartUpload"`
 
 	Events []*string `locationName:"Event" type:"list" flattened:"true"`
 
@@ -1909,7 +2546,7 @@ type CompleteMultipartUploadInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	UploadID *string `location:"querystring" locationName:"uploadId" type:"string" required:"true"`
 
@@ -1936,7 +2573,7 @@ type CompleteMultipartUploadOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
@@ -1944,7 +2581,7 @@ type CompleteMultipartUploadOutput struct {
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	// Version of the object.
 	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
@@ -2006,7 +2643,7 @@ type metadataCondition struct {
 
 type CopyObjectInput struct {
 	// The canned ACL to apply to the object.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read,bucket-owner-read,bucket-owner-full-control"`
 
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2079,13 +2716,13 @@ type CopyObjectInput struct {
 
 	// Specifies whether the metadata is copied from the source object or replaced
 	// with metadata provided in the request.
-	MetadataDirective *string `location:"header" locationName:"x-amz-metadata-directive" type:"string"`
+	MetadataDirective *string `location:"header" locationName:"x-amz-metadata-directive" type:"string" enum:"COPY,REPLACE"`
 
 	// Confirms that the requester knows that she or he will be charged for the
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
 	// aws:kms).
@@ -2111,10 +2748,10 @@ type CopyObjectInput struct {
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	// The type of storage to use for the object. Defaults to 'STANDARD'.
-	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string"`
+	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string" enum:"STANDARD,REDUCED_REDUNDANCY"`
 
 	// If the bucket is configured as a website, redirects requests for this object
 	// to another object in the same bucket or to an external URL. Amazon S3 stores
@@ -2138,7 +2775,7 @@ type CopyObjectOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// If server-side encryption with a customer-provided encryption key was requested,
 	// the response will include this header confirming the encryption algorithm
@@ -2156,7 +2793,7 @@ type CopyObjectOutput struct {
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	metadataCopyObjectOutput `json:"-", xml:"-"`
 }
@@ -2204,7 +2841,7 @@ type metadataCreateBucketConfiguration struct {
 
 type CreateBucketInput struct {
 	// The canned ACL to apply to the bucket.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read"`
 
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2245,7 +2882,7 @@ type metadataCreateBucketOutput struct {
 
 type CreateMultipartUploadInput struct {
 	// The canned ACL to apply to the object.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read,bucket-owner-read,bucket-owner-full-control"`
 
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2290,7 +2927,7 @@ type CreateMultipartUploadInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
 	// aws:kms).
@@ -2316,10 +2953,10 @@ type CreateMultipartUploadInput struct {
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	// The type of storage to use for the object. Defaults to 'STANDARD'.
-	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string"`
+	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string" enum:"STANDARD,REDUCED_REDUNDANCY"`
 
 	// If the bucket is configured as a website, redirects requests for this object
 	// to another object in the same bucket or to an external URL. Amazon S3 stores
@@ -2342,7 +2979,7 @@ type CreateMultipartUploadOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// If server-side encryption with a customer-provided encryption key was requested,
 	// the response will include this header confirming the encryption algorithm
@@ -2360,7 +2997,7 @@ type CreateMultipartUploadOutput struct {
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	// ID for the initiated multipart upload.
 	UploadID *string `locationName:"UploadId" type:"string"`
@@ -2548,7 +3185,7 @@ type DeleteObjectInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// VersionId used to reference a specific version of the object.
 	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
@@ -2567,7 +3204,7 @@ type DeleteObjectOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// Returns the version ID of the delete marker created as a result of the DELETE
 	// operation.
@@ -2593,7 +3230,7 @@ type DeleteObjectsInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	metadataDeleteObjectsInput `json:"-", xml:"-"`
 }
@@ -2609,7 +3246,7 @@ type DeleteObjectsOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	metadataDeleteObjectsOutput `json:"-", xml:"-"`
 }
@@ -2855,7 +3492,7 @@ type metadataGetBucketRequestPaymentInput struct {
 
 type GetBucketRequestPaymentOutput struct {
 	// Specifies who pays for the download and request fees.
-	Payer *string `type:"string"`
+	Payer *string `type:"string" enum:"Requester,BucketOwner"`
 
 	metadataGetBucketRequestPaymentOutput `json:"-", xml:"-"`
 }
@@ -2898,10 +3535,10 @@ type GetBucketVersioningOutput struct {
 	// Specifies whether MFA delete is enabled in the bucket versioning configuration.
 	// This element is only returned if the bucket has been configured with MFA
 	// delete. If the bucket has never been so configured, this element is not returned.
-	MFADelete *string `locationName:"MfaDelete" type:"string"`
+	MFADelete *string `locationName:"MfaDelete" type:"string" enum:"Enabled,Disabled"`
 
 	// The versioning state of the bucket.
-	Status *string `type:"string"`
+	Status *string `type:"string" enum:"Enabled,Suspended"`
 
 	metadataGetBucketVersioningOutput `json:"-", xml:"-"`
 }
@@ -2945,7 +3582,7 @@ type GetObjectACLInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// VersionId used to reference a specific version of the object.
 	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
@@ -2965,7 +3602,7 @@ type GetObjectACLOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	metadataGetObjectACLOutput `json:"-", xml:"-"`
 }
@@ -3003,7 +3640,7 @@ type GetObjectInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Sets the Cache-Control header of the response.
 	ResponseCacheControl *string `location:"querystring" locationName:"response-cache-control" type:"string"`
@@ -3104,11 +3741,11 @@ type GetObjectOutput struct {
 	// you can create metadata whose values are not legal HTTP headers.
 	MissingMeta *int64 `location:"header" locationName:"x-amz-missing-meta" type:"integer"`
 
-	ReplicationStatus *string `location:"header" locationName:"x-amz-replication-status" type:"string"`
+	ReplicationStatus *string `location:"header" locationName:"x-amz-replication-status" type:"string" enum:"COMPLETE,PENDING,FAILED,REPLICA"`
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// Provides information about object restoration operation and expiration time
 	// of the restored object copy.
@@ -3130,7 +3767,7 @@ type GetObjectOutput struct {
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	// Version of the object.
 	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
@@ -3156,7 +3793,7 @@ type GetObjectTorrentInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	metadataGetObjectTorrentInput `json:"-", xml:"-"`
 }
@@ -3170,7 +3807,7 @@ type GetObjectTorrentOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	metadataGetObjectTorrentOutput `json:"-", xml:"-"`
 }
@@ -3183,7 +3820,7 @@ type Grant struct {
 	Grantee *Grantee `type:"structure"`
 
 	// Specifies the permission given to the grantee.
-	Permission *string `type:"string"`
+	Permission *string `type:"string" enum:"FULL_CONTROL,WRITE,WRITE_ACP,READ,READ_ACP"`
 
 	metadataGrant `json:"-", xml:"-"`
 }
@@ -3203,7 +3840,7 @@ type Grantee struct {
 	ID *string `type:"string"`
 
 	// Type of grantee
-	Type *string `locationName:"xsi:type" type:"string" required:"true"`
+	Type *string `locationName:"xsi:type" type:"string" enum:"CanonicalUser,AmazonCustomerByEmail,Group" required:"true"`
 
 	// URI of the grantee group.
 	URI *string `type:"string"`
@@ -3262,7 +3899,7 @@ type HeadObjectInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
 	// aws:kms).
@@ -3342,11 +3979,11 @@ type HeadObjectOutput struct {
 	// you can create metadata whose values are not legal HTTP headers.
 	MissingMeta *int64 `location:"header" locationName:"x-amz-missing-meta" type:"integer"`
 
-	ReplicationStatus *string `location:"header" locationName:"x-amz-replication-status" type:"string"`
+	ReplicationStatus *string `location:"header" locationName:"x-amz-replication-status" type:"string" enum:"COMPLETE,PENDING,FAILED,REPLICA"`
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// Provides information about object restoration operation and expiration time
 	// of the restored object copy.
@@ -3368,7 +4005,7 @@ type HeadObjectOutput struct {
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	// Version of the object.
 	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
@@ -3465,7 +4102,7 @@ type LifecycleRule struct {
 
 	// If 'Enabled', the rule is currently being applied. If 'Disabled', the rule
 	// is not currently being applied.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"Enabled,Disabled" required:"true"`
 
 	Transition *Transition `type:"structure"`
 
@@ -3508,7 +4145,7 @@ type ListMultipartUploadsInput struct {
 	// with an ASCII value from 0 to 10. For characters that are not supported in
 	// XML 1.0, you can add this parameter to request that Amazon S3 encode the
 	// keys in the response.
-	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string"`
+	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string" enum:"url"`
 
 	// Together with upload-id-marker, this parameter specifies the multipart upload
 	// after which listing should begin.
@@ -3544,7 +4181,7 @@ type ListMultipartUploadsOutput struct {
 	Delimiter *string `type:"string"`
 
 	// Encoding type used by Amazon S3 to encode object keys in the response.
-	EncodingType *string `type:"string"`
+	EncodingType *string `type:"string" enum:"url"`
 
 	// Indicates whether the returned list of multipart uploads is truncated. A
 	// value of true indicates that the list was truncated. The list can be truncated
@@ -3595,7 +4232,7 @@ type ListObjectVersionsInput struct {
 	// with an ASCII value from 0 to 10. For characters that are not supported in
 	// XML 1.0, you can add this parameter to request that Amazon S3 encode the
 	// keys in the response.
-	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string"`
+	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string" enum:"url"`
 
 	// Specifies the key to start with when listing objects in a bucket.
 	KeyMarker *string `location:"querystring" locationName:"key-marker" type:"string"`
@@ -3625,7 +4262,7 @@ type ListObjectVersionsOutput struct {
 	Delimiter *string `type:"string"`
 
 	// Encoding type used by Amazon S3 to encode object keys in the response.
-	EncodingType *string `type:"string"`
+	EncodingType *string `type:"string" enum:"url"`
 
 	// A flag that indicates whether or not Amazon S3 returned all of the results
 	// that satisfied the search criteria. If your results were truncated, you can
@@ -3672,7 +4309,7 @@ type ListObjectsInput struct {
 	// with an ASCII value from 0 to 10. For characters that are not supported in
 	// XML 1.0, you can add this parameter to request that Amazon S3 encode the
 	// keys in the response.
-	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string"`
+	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string" enum:"url"`
 
 	// Specifies the key to start with when listing objects in a bucket.
 	Marker *string `location:"querystring" locationName:"marker" type:"string"`
@@ -3699,7 +4336,7 @@ type ListObjectsOutput struct {
 	Delimiter *string `type:"string"`
 
 	// Encoding type used by Amazon S3 to encode object keys in the response.
-	EncodingType *string `type:"string"`
+	EncodingType *string `type:"string" enum:"url"`
 
 	// A flag that indicates whether or not Amazon S3 returned all of the results
 	// that satisfied the search criteria.
@@ -3745,7 +4382,7 @@ type ListPartsInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Upload ID identifying the multipart upload whose parts are being listed.
 	UploadID *string `location:"querystring" locationName:"uploadId" type:"string" required:"true"`
@@ -3787,10 +4424,10 @@ type ListPartsOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+	StorageClass *string `type:"string" enum:"STANDARD,REDUCED_REDUNDANCY"`
 
 	// Upload ID identifying the multipart upload whose parts are being listed.
 	UploadID *string `locationName:"UploadId" type:"string"`
@@ -3837,7 +4474,7 @@ type MultipartUpload struct {
 	Owner *Owner `type:"structure"`
 
 	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+	StorageClass *string `type:"string" enum:"STANDARD,REDUCED_REDUNDANCY"`
 
 	// Upload ID that identifies the multipart upload.
 	UploadID *string `locationName:"UploadId" type:"string"`
@@ -3883,7 +4520,7 @@ type NoncurrentVersionTransition struct {
 	NoncurrentDays *int64 `type:"integer"`
 
 	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+	StorageClass *string `type:"string" enum:"GLACIER"`
 
 	metadataNoncurrentVersionTransition `json:"-", xml:"-"`
 }
@@ -3918,7 +4555,7 @@ type Object struct {
 	Size *int64 `type:"integer"`
 
 	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+	StorageClass *string `type:"string" enum:"STANDARD,REDUCED_REDUNDANCY,GLACIER"`
 
 	metadataObject `json:"-", xml:"-"`
 }
@@ -3960,7 +4597,7 @@ type ObjectVersion struct {
 	Size *int64 `type:"integer"`
 
 	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+	StorageClass *string `type:"string" enum:"STANDARD"`
 
 	// Version ID of an object.
 	VersionID *string `locationName:"VersionId" type:"string"`
@@ -4006,7 +4643,7 @@ type metadataPart struct {
 
 type PutBucketACLInput struct {
 	// The canned ACL to apply to the bucket.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read"`
 
 	AccessControlPolicy *AccessControlPolicy `locationName:"AccessControlPolicy" type:"structure"`
 
@@ -4252,7 +4889,7 @@ type metadataPutBucketWebsiteOutput struct {
 
 type PutObjectACLInput struct {
 	// The canned ACL to apply to the object.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read,bucket-owner-read,bucket-owner-full-control"`
 
 	AccessControlPolicy *AccessControlPolicy `locationName:"AccessControlPolicy" type:"structure"`
 
@@ -4280,7 +4917,7 @@ type PutObjectACLInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	metadataPutObjectACLInput `json:"-", xml:"-"`
 }
@@ -4292,7 +4929,7 @@ type metadataPutObjectACLInput struct {
 type PutObjectACLOutput struct {
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	metadataPutObjectACLOutput `json:"-", xml:"-"`
 }
@@ -4303,7 +4940,7 @@ type metadataPutObjectACLOutput struct {
 
 type PutObjectInput struct {
 	// The canned ACL to apply to the object.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read,bucket-owner-read,bucket-owner-full-control"`
 
 	// Object data.
 	Body io.ReadSeeker `type:"blob"`
@@ -4355,7 +4992,7 @@ type PutObjectInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
 	// aws:kms).
@@ -4381,10 +5018,10 @@ type PutObjectInput struct {
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	// The type of storage to use for the object. Defaults to 'STANDARD'.
-	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string"`
+	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string" enum:"STANDARD,REDUCED_REDUNDANCY"`
 
 	// If the bucket is configured as a website, redirects requests for this object
 	// to another object in the same bucket or to an external URL. Amazon S3 stores
@@ -4408,7 +5045,7 @@ type PutObjectOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// If server-side encryption with a customer-provided encryption key was requested,
 	// the response will include this header confirming the encryption algorithm
@@ -4426,7 +5063,7 @@ type PutObjectOutput struct {
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	// Version of the object.
 	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
@@ -4439,7 +5076,7 @@ type metadataPutObjectOutput struct {
 }
 
 type QueueConfiguration struct {
-	Event *string `type:"string"`
+	Event *string `type:"string" enum:"s3:ReducedRedundancyLostObject,s3:ObjectCreated:Put,s3:ObjectCreated:Post,s3:ObjectCreated:Copy,s3:ObjectCreated:CompleteMultipartUpload"`
 
 	Events []*string `locationName:"Event" type:"list" flattened:"true"`
 
@@ -4464,7 +5101,7 @@ type Redirect struct {
 
 	// Protocol to use (http, https) when redirecting requests. The default is the
 	// protocol that is used in the original request.
-	Protocol *string `type:"string"`
+	Protocol *string `type:"string" enum:"http,https"`
 
 	// The object key prefix to use in the redirect request. For example, to redirect
 	// requests for all pages with prefix docs/ (objects in the docs/ folder) to
@@ -4492,7 +5129,7 @@ type RedirectAllRequestsTo struct {
 
 	// Protocol to use (http, https) when redirecting requests. The default is the
 	// protocol that is used in the original request.
-	Protocol *string `type:"string"`
+	Protocol *string `type:"string" enum:"http,https"`
 
 	metadataRedirectAllRequestsTo `json:"-", xml:"-"`
 }
@@ -4531,7 +5168,7 @@ type ReplicationRule struct {
 	Prefix *string `type:"string" required:"true"`
 
 	// The rule is ignored if status is not Enabled.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"Enabled,Disabled" required:"true"`
 
 	metadataReplicationRule `json:"-", xml:"-"`
 }
@@ -4542,7 +5179,7 @@ type metadataReplicationRule struct {
 
 type RequestPaymentConfiguration struct {
 	// Specifies who pays for the download and request fees.
-	Payer *string `type:"string" required:"true"`
+	Payer *string `type:"string" enum:"Requester,BucketOwner" required:"true"`
 
 	metadataRequestPaymentConfiguration `json:"-", xml:"-"`
 }
@@ -4560,7 +5197,7 @@ type RestoreObjectInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	RestoreRequest *RestoreRequest `locationName:"RestoreRequest" type:"structure"`
 
@@ -4576,7 +5213,7 @@ type metadataRestoreObjectInput struct {
 type RestoreObjectOutput struct {
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	metadataRestoreObjectOutput `json:"-", xml:"-"`
 }
@@ -4643,7 +5280,7 @@ type TargetGrant struct {
 	Grantee *Grantee `type:"structure"`
 
 	// Logging permissions assigned to the Grantee for the bucket.
-	Permission *string `type:"string"`
+	Permission *string `type:"string" enum:"FULL_CONTROL,READ,WRITE"`
 
 	metadataTargetGrant `json:"-", xml:"-"`
 }
@@ -4654,7 +5291,7 @@ type metadataTargetGrant struct {
 
 type TopicConfiguration struct {
 	// Bucket event for which to send notifications.
-	Event *string `type:"string"`
+	Event *string `type:"string" enum:"s3:ReducedRedundancyLostObject,s3:ObjectCreated:Put,s3:ObjectCreated:Post,s3:ObjectCreated:Copy,s3:ObjectCreated:CompleteMultipartUpload"`
 
 	Events []*string `locationName:"Event" type:"list" flattened:"true"`
 
@@ -4681,7 +5318,7 @@ type Transition struct {
 	Days *int64 `type:"integer"`
 
 	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+	StorageClass *string `type:"string" enum:"GLACIER"`
 
 	metadataTransition `json:"-", xml:"-"`
 }
@@ -4739,7 +5376,7 @@ type UploadPartCopyInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
 	// aws:kms).
@@ -4777,7 +5414,7 @@ type UploadPartCopyOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// If server-side encryption with a customer-provided encryption key was requested,
 	// the response will include this header confirming the encryption algorithm
@@ -4795,7 +5432,7 @@ type UploadPartCopyOutput struct {
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	metadataUploadPartCopyOutput `json:"-", xml:"-"`
 }
@@ -4822,7 +5459,7 @@ type UploadPartInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
 	// aws:kms).
@@ -4857,7 +5494,7 @@ type UploadPartOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// If server-side encryption with a customer-provided encryption key was requested,
 	// the response will include this header confirming the encryption algorithm
@@ -4875,7 +5512,7 @@ type UploadPartOutput struct {
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	metadataUploadPartOutput `json:"-", xml:"-"`
 }
@@ -4888,10 +5525,10 @@ type VersioningConfiguration struct {
 	// Specifies whether MFA delete is enabled in the bucket versioning configuration.
 	// This element is only returned if the bucket has been configured with MFA
 	// delete. If the bucket has never been so configured, this element is not returned.
-	MFADelete *string `locationName:"MfaDelete" type:"string"`
+	MFADelete *string `locationName:"MfaDelete" type:"string" enum:"Enabled,Disabled"`
 
 	// The versioning state of the bucket.
-	Status *string `type:"string"`
+	Status *string `type:"string" enum:"Enabled,Suspended"`
 
 	metadataVersioningConfiguration `json:"-", xml:"-"`
 }
@@ -4914,4 +5551,379 @@ type WebsiteConfiguration struct {
 
 type metadataWebsiteConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for BucketCannedACL.
+const (
+	BucketCannedACLPrivate           = "private"
+	BucketCannedACLPublicRead        = "public-read"
+	BucketCannedACLPublicReadWrite   = "public-read-write"
+	BucketCannedACLAuthenticatedRead = "authenticated-read"
+)
+
+// BucketCannedACLValues returns the valid values of BucketCannedACL.
+func BucketCannedACLValues() []string {
+	return []string{
+		BucketCannedACLPrivate,
+		BucketCannedACLPublicRead,
+		BucketCannedACLPublicReadWrite,
+		BucketCannedACLAuthenticatedRead,
+	}
+}
+
+// Enum values for BucketLocationConstraint.
+const (
+	BucketLocationConstraintEU           = "EU"
+	BucketLocationConstraintEuWest1      = "eu-west-1"
+	BucketLocationConstraintUsWest1      = "us-west-1"
+	BucketLocationConstraintUsWest2      = "us-west-2"
+	BucketLocationConstraintApSoutheast1 = "ap-southeast-1"
+	BucketLocationConstraintApSoutheast2 = "ap-southeast-2"
+	BucketLocationConstraintApNortheast1 = "ap-northeast-1"
+	BucketLocationConstraintSaEast1      = "sa-east-1"
+	BucketLocationConstraintCnNorth1     = "cn-north-1"
+	BucketLocationConstraintEuCentral1   = "eu-central-1"
+)
+
+// BucketLocationConstraintValues returns the valid values of BucketLocationConstraint.
+func BucketLocationConstraintValues() []string {
+	return []string{
+		BucketLocationConstraintEU,
+		BucketLocationConstraintEuWest1,
+		BucketLocationConstraintUsWest1,
+		BucketLocationConstraintUsWest2,
+		BucketLocationConstraintApSoutheast1,
+		BucketLocationConstraintApSoutheast2,
+		BucketLocationConstraintApNortheast1,
+		BucketLocationConstraintSaEast1,
+		"",
+		BucketLocationConstraintCnNorth1,
+		BucketLocationConstraintEuCentral1,
+	}
+}
+
+// Enum values for BucketLogsPermission.
+const (
+	BucketLogsPermissionFullControl = "FULL_CONTROL"
+	BucketLogsPermissionRead        = "READ"
+	BucketLogsPermissionWrite       = "WRITE"
+)
+
+// BucketLogsPermissionValues returns the valid values of BucketLogsPermission.
+func BucketLogsPermissionValues() []string {
+	return []string{
+		BucketLogsPermissionFullControl,
+		BucketLogsPermissionRead,
+		BucketLogsPermissionWrite,
+	}
+}
+
+// Enum values for BucketVersioningStatus.
+const (
+	BucketVersioningStatusEnabled   = "Enabled"
+	BucketVersioningStatusSuspended = "Suspended"
+)
+
+// BucketVersioningStatusValues returns the valid values of BucketVersioningStatus.
+func BucketVersioningStatusValues() []string {
+	return []string{
+		BucketVersioningStatusEnabled,
+		BucketVersioningStatusSuspended,
+	}
+}
+
+// Enum values for EncodingType.
+const (
+	EncodingTypeURL = "url"
+)
+
+// EncodingTypeValues returns the valid values of EncodingType.
+func EncodingTypeValues() []string {
+	return []string{
+		EncodingTypeURL,
+	}
+}
+
+// Enum values for Event.
+const (
+	EventS3ReducedRedundancyLostObject          = "s3:ReducedRedundancyLostObject"
+	EventS3ObjectCreatedPut                     = "s3:ObjectCreated:Put"
+	EventS3ObjectCreatedPost                    = "s3:ObjectCreated:Post"
+	EventS3ObjectCreatedCopy                    = "s3:ObjectCreated:Copy"
+	EventS3ObjectCreatedCompleteMultipartUpload = "s3:ObjectCreated:CompleteMultipartUpload"
+)
+
+// EventValues returns the valid values of Event.
+func EventValues() []string {
+	return []string{
+		EventS3ReducedRedundancyLostObject,
+		EventS3ObjectCreatedPut,
+		EventS3ObjectCreatedPost,
+		EventS3ObjectCreatedCopy,
+		EventS3ObjectCreatedCompleteMultipartUpload,
+	}
+}
+
+// Enum values for ExpirationStatus.
+const (
+	ExpirationStatusEnabled  = "Enabled"
+	ExpirationStatusDisabled = "Disabled"
+)
+
+// ExpirationStatusValues returns the valid values of ExpirationStatus.
+func ExpirationStatusValues() []string {
+	return []string{
+		ExpirationStatusEnabled,
+		ExpirationStatusDisabled,
+	}
+}
+
+// Enum values for MFADelete.
+const (
+	MFADeleteEnabled  = "Enabled"
+	MFADeleteDisabled = "Disabled"
+)
+
+// MFADeleteValues returns the valid values of MFADelete.
+func MFADeleteValues() []string {
+	return []string{
+		MFADeleteEnabled,
+		MFADeleteDisabled,
+	}
+}
+
+// Enum values for MFADeleteStatus.
+const (
+	MFADeleteStatusEnabled  = "Enabled"
+	MFADeleteStatusDisabled = "Disabled"
+)
+
+// MFADeleteStatusValues returns the valid values of MFADeleteStatus.
+func MFADeleteStatusValues() []string {
+	return []string{
+		MFADeleteStatusEnabled,
+		MFADeleteStatusDisabled,
+	}
+}
+
+// Enum values for MetadataDirective.
+const (
+	MetadataDirectiveCopy    = "COPY"
+	MetadataDirectiveReplace = "REPLACE"
+)
+
+// MetadataDirectiveValues returns the valid values of MetadataDirective.
+func MetadataDirectiveValues() []string {
+	return []string{
+		MetadataDirectiveCopy,
+		MetadataDirectiveReplace,
+	}
+}
+
+// Enum values for ObjectCannedACL.
+const (
+	ObjectCannedACLPrivate                = "private"
+	ObjectCannedACLPublicRead             = "public-read"
+	ObjectCannedACLPublicReadWrite        = "public-read-write"
+	ObjectCannedACLAuthenticatedRead      = "authenticated-read"
+	ObjectCannedACLBucketOwnerRead        = "bucket-owner-read"
+	ObjectCannedACLBucketOwnerFullControl = "bucket-owner-full-control"
+)
+
+// ObjectCannedACLValues returns the valid values of ObjectCannedACL.
+func ObjectCannedACLValues() []string {
+	return []string{
+		ObjectCannedACLPrivate,
+		ObjectCannedACLPublicRead,
+		ObjectCannedACLPublicReadWrite,
+		ObjectCannedACLAuthenticatedRead,
+		ObjectCannedACLBucketOwnerRead,
+		ObjectCannedACLBucketOwnerFullControl,
+	}
+}
+
+// Enum values for ObjectStorageClass.
+const (
+	ObjectStorageClassStandard          = "STANDARD"
+	ObjectStorageClassReducedRedundancy = "REDUCED_REDUNDANCY"
+	ObjectStorageClassGlacier           = "GLACIER"
+)
+
+// ObjectStorageClassValues returns the valid values of ObjectStorageClass.
+func ObjectStorageClassValues() []string {
+	return []string{
+		ObjectStorageClassStandard,
+		ObjectStorageClassReducedRedundancy,
+		ObjectStorageClassGlacier,
+	}
+}
+
+// Enum values for ObjectVersionStorageClass.
+const (
+	ObjectVersionStorageClassStandard = "STANDARD"
+)
+
+// ObjectVersionStorageClassValues returns the valid values of ObjectVersionStorageClass.
+func ObjectVersionStorageClassValues() []string {
+	return []string{
+		ObjectVersionStorageClassStandard,
+	}
+}
+
+// Enum values for Payer.
+const (
+	PayerRequester   = "Requester"
+	PayerBucketOwner = "BucketOwner"
+)
+
+// PayerValues returns the valid values of Payer.
+func PayerValues() []string {
+	return []string{
+		PayerRequester,
+		PayerBucketOwner,
+	}
+}
+
+// Enum values for Permission.
+const (
+	PermissionFullControl = "FULL_CONTROL"
+	PermissionWrite       = "WRITE"
+	PermissionWriteAcp    = "WRITE_ACP"
+	PermissionRead        = "READ"
+	PermissionReadAcp     = "READ_ACP"
+)
+
+// PermissionValues returns the valid values of Permission.
+func PermissionValues() []string {
+	return []string{
+		PermissionFullControl,
+		PermissionWrite,
+		PermissionWriteAcp,
+		PermissionRead,
+		PermissionReadAcp,
+	}
+}
+
+// Enum values for Protocol.
+const (
+	ProtocolHTTP  = "http"
+	ProtocolHttps = "https"
+)
+
+// ProtocolValues returns the valid values of Protocol.
+func ProtocolValues() []string {
+	return []string{
+		ProtocolHTTP,
+		ProtocolHttps,
+	}
+}
+
+// Enum values for ReplicationRuleStatus.
+const (
+	ReplicationRuleStatusEnabled  = "Enabled"
+	ReplicationRuleStatusDisabled = "Disabled"
+)
+
+// ReplicationRuleStatusValues returns the valid values of ReplicationRuleStatus.
+func ReplicationRuleStatusValues() []string {
+	return []string{
+		ReplicationRuleStatusEnabled,
+		ReplicationRuleStatusDisabled,
+	}
+}
+
+// Enum values for ReplicationStatus.
+const (
+	ReplicationStatusComplete = "COMPLETE"
+	ReplicationStatusPending  = "PENDING"
+	ReplicationStatusFailed   = "FAILED"
+	ReplicationStatusReplica  = "REPLICA"
+)
+
+// ReplicationStatusValues returns the valid values of ReplicationStatus.
+func ReplicationStatusValues() []string {
+	return []string{
+		ReplicationStatusComplete,
+		ReplicationStatusPending,
+		ReplicationStatusFailed,
+		ReplicationStatusReplica,
+	}
+}
+
+// Enum values for RequestCharged.
+const (
+	RequestChargedRequester = "requester"
+)
+
+// RequestChargedValues returns the valid values of RequestCharged.
+func RequestChargedValues() []string {
+	return []string{
+		RequestChargedRequester,
+	}
+}
+
+// Enum values for RequestPayer.
+const (
+	RequestPayerRequester = "requester"
+)
+
+// RequestPayerValues returns the valid values of RequestPayer.
+func RequestPayerValues() []string {
+	return []string{
+		RequestPayerRequester,
+	}
+}
+
+// Enum values for ServerSideEncryption.
+const (
+	ServerSideEncryptionAes256 = "AES256"
+)
+
+// ServerSideEncryptionValues returns the valid values of ServerSideEncryption.
+func ServerSideEncryptionValues() []string {
+	return []string{
+		ServerSideEncryptionAes256,
+	}
+}
+
+// Enum values for StorageClass.
+const (
+	StorageClassStandard          = "STANDARD"
+	StorageClassReducedRedundancy = "REDUCED_REDUNDANCY"
+)
+
+// StorageClassValues returns the valid values of StorageClass.
+func StorageClassValues() []string {
+	return []string{
+		StorageClassStandard,
+		StorageClassReducedRedundancy,
+	}
+}
+
+// Enum values for TransitionStorageClass.
+const (
+	TransitionStorageClassGlacier = "GLACIER"
+)
+
+// TransitionStorageClassValues returns the valid values of TransitionStorageClass.
+func TransitionStorageClassValues() []string {
+	return []string{
+		TransitionStorageClassGlacier,
+	}
+}
+
+// Enum values for Type.
+const (
+	TypeCanonicalUser         = "CanonicalUser"
+	TypeAmazonCustomerByEmail = "AmazonCustomerByEmail"
+	TypeGroup                 = "Group"
+)
+
+// TypeValues returns the valid values of Type.
+func TypeValues() []string {
+	return []string{
+		TypeCanonicalUser,
+		TypeAmazonCustomerByEmail,
+		TypeGroup,
+	}
 }
\ No newline at end of file
@@ -0,0 +1,139 @@
+package s3
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/datacratic/aws-sdk-go/internal/signer/v4"
+)
+
+// PresignPostInput is the input of PresignPost: the conditions of the
+// uploads allowed by the policy.
+type PresignPostInput struct {
+	Bucket *string
+
+	// Key is the key of the uploaded object. If KeyPrefix is set instead,
+	// the form can upload any key with that prefix, and its key field is
+	// the prefix followed by ${filename}, the name of the uploaded file.
+	Key       *string
+	KeyPrefix *string
+
+	// ContentType, if set, is the required content type of the object.
+	ContentType *string
+
+	// MinContentLength and MaxContentLength, if MaxContentLength is set,
+	// are the limits of the size of the object.
+	MinContentLength int64
+	MaxContentLength int64
+
+	// Metadata is the x-amz-meta- metadata of the object, by name without
+	// the prefix.
+	Metadata map[string]string
+
+	ACL                 *string
+	SuccessActionStatus *string
+
+	// Expires is how long the policy is valid.
+	Expires time.Duration
+}
+
+// PresignedPost is a presigned POST policy: the URL to post forms to, and
+// the fields of the form, before the file field.
+type PresignedPost struct {
+	URL    string
+	Fields map[string]string
+}
+
+// PresignPost returns a POST policy signed with the credentials of the
+// client, allowing browsers to upload objects matching the conditions of
+// input with HTML forms.
+func (c *S3) PresignPost(input *PresignPostInput) (*PresignedPost, error) {
+	if input.Expires <= 0 {
+		return nil, fmt.Errorf("presigned POST policies must expire")
+	}
+	if (input.Key == nil) == (input.KeyPrefix == nil) {
+		return nil, fmt.Errorf("presigned POST policies need either a key or a key prefix")
+	}
+
+	// build a request to get the endpoint of the bucket
+	req, _ := c.HeadBucketRequest(&HeadBucketInput{Bucket: input.Bucket})
+	if err := req.Build(); err != nil {
+		return nil, err
+	}
+	u := *req.HTTPRequest.URL
+	u.RawQuery = ""
+
+	creds, err := c.Config.Credentials.Credentials()
+	if err != nil {
+		return nil, err
+	}
+	region := c.SigningRegion
+	if region == "" {
+		region = c.Config.Region
+	}
+	service := c.SigningName
+	if service == "" {
+		service = c.ServiceName
+	}
+
+	fields := map[string]string{}
+	var conditions []interface{}
+	addField := func(name, value string) {
+		fields[name] = value
+		conditions = append(conditions, map[string]string{name: value})
+	}
+
+	conditions = append(conditions, map[string]string{"bucket": *input.Bucket})
+	if input.KeyPrefix != nil {
+		fields["key"] = *input.KeyPrefix + "${filename}"
+		conditions = append(conditions, []string{"starts-with", "$key", *input.KeyPrefix})
+	} else {
+		addField("key", *input.Key)
+	}
+	if input.MaxContentLength > 0 {
+		conditions = append(conditions, []interface{}{"content-length-range", input.MinContentLength, input.MaxContentLength})
+	}
+	if input.ContentType != nil {
+		addField("Content-Type", *input.ContentType)
+	}
+	if input.ACL != nil {
+		addField("acl", *input.ACL)
+	}
+	if input.SuccessActionStatus != nil {
+		addField("success_action_status", *input.SuccessActionStatus)
+	}
+
+	names := make([]string, 0, len(input.Metadata))
+	for name := range input.Metadata {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		addField("x-amz-meta-"+strings.ToLower(name), input.Metadata[name])
+	}
+
+	addField("x-amz-algorithm", v4.Algorithm)
+	addField("x-amz-credential", creds.AccessKeyID+"/"+v4.CredentialScope(req.Time, region, service))
+	addField("x-amz-date", req.Time.UTC().Format("20060102T150405Z"))
+	if creds.SessionToken != "" {
+		addField("x-amz-security-token", creds.SessionToken)
+	}
+
+	policy, err := json.Marshal(map[string]interface{}{
+		"expiration": req.Time.Add(input.Expires).UTC().Format("2006-01-02T15:04:05.000Z"),
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	fields["policy"] = base64.StdEncoding.EncodeToString(policy)
+
+	key := v4.SigningKey(creds.SecretAccessKey, req.Time, region, service)
+	fields["x-amz-signature"] = v4.SignString(key, fields["policy"])
+
+	return &PresignedPost{URL: u.String(), Fields: fields}, nil
+}
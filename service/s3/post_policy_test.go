@@ -0,0 +1,99 @@
+package s3_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func TestPresignPost(t *testing.T) {
+	svc := s3.New(baseConfig.Merge(&aws.Config{Credentials: aws.DetectCreds("AKID", "SECRET", "TOKEN")}))
+	post, err := svc.PresignPost(&s3.PresignPostInput{
+		Bucket:           aws.String("bucket"),
+		KeyPrefix:        aws.String("uploads/"),
+		ContentType:      aws.String("image/png"),
+		MinContentLength: 1,
+		MaxContentLength: 1024,
+		Metadata:         map[string]string{"User": "alice"},
+		Expires:          15 * time.Minute,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://bucket.s3.mock-region.amazonaws.com/", post.URL)
+
+	f := post.Fields
+	assert.Equal(t, "uploads/${filename}", f["key"])
+	assert.Equal(t, "image/png", f["Content-Type"])
+	assert.Equal(t, "alice", f["x-amz-meta-user"])
+	assert.Equal(t, "AWS4-HMAC-SHA256", f["x-amz-algorithm"])
+	assert.Equal(t, "TOKEN", f["x-amz-security-token"])
+	date := f["x-amz-date"]
+	assert.Equal(t, "AKID/"+date[:8]+"/mock-region/s3/aws4_request", f["x-amz-credential"])
+
+	b, err := base64.StdEncoding.DecodeString(f["policy"])
+	assert.NoError(t, err)
+	var policy struct {
+		Expiration string
+		Conditions []interface{}
+	}
+	assert.NoError(t, json.Unmarshal(b, &policy))
+	signed, _ := time.Parse("20060102T150405Z", date)
+	expiration, err := time.Parse("2006-01-02T15:04:05.000Z", policy.Expiration)
+	assert.NoError(t, err)
+	assert.Equal(t, 15*time.Minute, expiration.Sub(signed).Truncate(time.Second))
+
+	assert.Contains(t, policy.Conditions, map[string]interface{}{"bucket": "bucket"})
+	assert.Contains(t, policy.Conditions, []interface{}{"starts-with", "$key", "uploads/"})
+	assert.Contains(t, policy.Conditions, []interface{}{"content-length-range", 1.0, 1024.0})
+	for name, value := range f {
+		if name != "key" && name != "policy" && name != "x-amz-signature" {
+			assert.Contains(t, policy.Conditions, map[string]interface{}{name: value})
+		}
+	}
+
+	key := hmacSHA256([]byte("AWS4SECRET"), date[:8])
+	key = hmacSHA256(key, "mock-region")
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	assert.Equal(t, hex.EncodeToString(hmacSHA256(key, f["policy"])), f["x-amz-signature"])
+}
+
+func TestPresignPostExactKey(t *testing.T) {
+	svc := s3.New(baseConfig.Merge(&aws.Config{S3ForcePathStyle: true}))
+	post, err := svc.PresignPost(&s3.PresignPostInput{
+		Bucket:  aws.String("bucket"),
+		Key:     aws.String("report.csv"),
+		Expires: time.Hour,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://s3.mock-region.amazonaws.com/bucket", post.URL)
+	assert.Equal(t, "report.csv", post.Fields["key"])
+	assert.Equal(t, "", post.Fields["x-amz-security-token"])
+
+	b, _ := base64.StdEncoding.DecodeString(post.Fields["policy"])
+	assert.True(t, strings.Contains(string(b), `{"key":"report.csv"}`))
+	assert.False(t, strings.Contains(string(b), "content-length-range"))
+}
+
+func TestPresignPostInvalid(t *testing.T) {
+	svc := s3.New(baseConfig)
+	_, err := svc.PresignPost(&s3.PresignPostInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	assert.Error(t, err)
+
+	_, err = svc.PresignPost(&s3.PresignPostInput{Bucket: aws.String("bucket"), Expires: time.Hour})
+	assert.Error(t, err)
+}
@@ -0,0 +1,81 @@
+package s3crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+)
+
+const (
+	// AESGCM is the content encryption algorithm of the objects encrypted
+	// by the client.
+	AESGCM = "AES/GCM/NoPadding"
+
+	// AESCBC is the content encryption algorithm of legacy objects, which
+	// the client only decrypts.
+	AESCBC = "AES/CBC/PKCS5Padding"
+
+	// gcmTagLen is the length of the tags of AES-GCM, in bits.
+	gcmTagLen = 128
+)
+
+// encryptGCM encrypts plaintext with key, and returns the ciphertext,
+// followed by its tag, and the IV.
+func encryptGCM(key, plaintext []byte) (ciphertext, iv []byte, err error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	iv = make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, nil, err
+	}
+	return aead.Seal(nil, iv, plaintext, nil), iv, nil
+}
+
+// decryptGCM decrypts ciphertext encrypted by encryptGCM.
+func decryptGCM(key, iv, ciphertext []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aead.NonceSize() {
+		return nil, fmt.Errorf("invalid IV length %d", len(iv))
+	}
+	return aead.Open(nil, iv, ciphertext, nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// decryptCBC decrypts ciphertext encrypted with AES-CBC and PKCS#5 padding.
+func decryptCBC(key, iv, ciphertext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != block.BlockSize() {
+		return nil, fmt.Errorf("invalid IV length %d", len(iv))
+	}
+	if len(ciphertext) == 0 || len(ciphertext)%block.BlockSize() != 0 {
+		return nil, fmt.Errorf("invalid ciphertext length %d", len(ciphertext))
+	}
+
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext, ciphertext)
+
+	pad := int(plaintext[len(plaintext)-1])
+	if pad == 0 || pad > block.BlockSize() ||
+		!bytes.Equal(plaintext[len(plaintext)-pad:], bytes.Repeat([]byte{byte(pad)}, pad)) {
+		return nil, fmt.Errorf("invalid padding")
+	}
+	return plaintext[:len(plaintext)-pad], nil
+}
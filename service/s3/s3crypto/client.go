@@ -0,0 +1,197 @@
+// Package s3crypto provides a client encrypting objects before they are put
+// to S3, and decrypting them when they are got, with envelope encryption:
+// each object is encrypted with its own data key, stored with the object
+// wrapped by a master key, such as a KMS key.
+//
+// Objects are encrypted with AES-GCM. Legacy objects encrypted with AES-CBC
+// can also be decrypted. Objects are encrypted and decrypted in memory, and
+// ranges of objects can't be got.
+package s3crypto
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/service/s3"
+	"github.com/datacratic/aws-sdk-go/service/s3/s3iface"
+)
+
+// A Client puts and gets objects encrypted on the client.
+type Client struct {
+	S3         s3iface.S3API
+	KeyWrapper KeyWrapper
+
+	// InstructionFile stores the envelopes of the objects put in
+	// instruction files, the objects with the keys of the objects suffixed
+	// with InstructionFileSuffix, instead of their metadata. Envelopes are
+	// read from either, whatever InstructionFile is: objects with their
+	// envelope in an instruction file are marked by their metadata.
+	InstructionFile bool
+}
+
+// New returns a client encrypting objects with the data keys of wrapper,
+// using svc.
+func New(svc s3iface.S3API, wrapper KeyWrapper) *Client {
+	return &Client{S3: svc, KeyWrapper: wrapper}
+}
+
+// PutObject encrypts the body of input, and puts it with its envelope.
+func (c *Client) PutObject(input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	return c.PutObjectWithContext(context.Background(), input)
+}
+
+// PutObjectWithContext is the same as PutObject with the addition of a
+// context.
+func (c *Client) PutObjectWithContext(ctx context.Context, input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	var plaintext []byte
+	if input.Body != nil {
+		var err error
+		if plaintext, err = ioutil.ReadAll(input.Body); err != nil {
+			return nil, err
+		}
+	}
+
+	key, wrapped, matDesc, err := c.KeyWrapper.GenerateDataKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, iv, err := encryptGCM(key, plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	env := &envelope{
+		WrappedKey:        wrapped,
+		IV:                iv,
+		MatDesc:           matDesc,
+		WrapAlg:           c.KeyWrapper.WrapAlgorithm(),
+		CEKAlg:            AESGCM,
+		TagLen:            strconv.Itoa(gcmTagLen),
+		UnencryptedLength: strconv.Itoa(len(plaintext)),
+	}
+	fields, err := env.fields()
+	if err != nil {
+		return nil, err
+	}
+
+	in := *input
+	in.Body = bytes.NewReader(ciphertext)
+	in.ContentLength = aws.Long(int64(len(ciphertext)))
+	if c.InstructionFile {
+		in.Metadata = addMetadata(input.Metadata, map[string]string{instructionFileMetadataName: ""})
+	} else {
+		in.Metadata = addMetadata(input.Metadata, fields)
+	}
+	out, err := c.S3.PutObjectWithContext(ctx, &in)
+	if err != nil || !c.InstructionFile {
+		return out, err
+	}
+
+	instruction, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.S3.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:        input.Bucket,
+		Key:           aws.String(*input.Key + InstructionFileSuffix),
+		Body:          bytes.NewReader(instruction),
+		ContentLength: aws.Long(int64(len(instruction))),
+		RequestPayer:  input.RequestPayer,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetObject gets the object of input, and returns it with its body
+// decrypted. Objects without envelope can't be got.
+func (c *Client) GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	return c.GetObjectWithContext(context.Background(), input)
+}
+
+// GetObjectWithContext is the same as GetObject with the addition of a
+// context.
+func (c *Client) GetObjectWithContext(ctx context.Context, input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	if input.Range != nil {
+		return nil, fmt.Errorf("ranges of encrypted objects can't be got")
+	}
+
+	out, err := c.S3.GetObjectWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := ioutil.ReadAll(out.Body)
+	out.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	env, err := parseEnvelope(metadataFields(out.Metadata))
+	if err == nil && env == nil {
+		if !hasMetadata(out.Metadata, instructionFileMetadataName) {
+			return nil, fmt.Errorf("object %s is not encrypted: no envelope", *input.Key)
+		}
+		env, err = c.instructionFile(ctx, input)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if env.WrapAlg != "" && env.WrapAlg != c.KeyWrapper.WrapAlgorithm() {
+		return nil, fmt.Errorf("object key wrapped with %s, not %s", env.WrapAlg, c.KeyWrapper.WrapAlgorithm())
+	}
+
+	key, err := c.KeyWrapper.UnwrapKey(ctx, env.WrappedKey, env.MatDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	var plaintext []byte
+	switch env.CEKAlg {
+	case AESGCM:
+		plaintext, err = decryptGCM(key, env.IV, ciphertext)
+	case AESCBC:
+		plaintext, err = decryptCBC(key, env.IV, ciphertext)
+	default:
+		err = fmt.Errorf("unsupported content encryption algorithm %s", env.CEKAlg)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	out.Body = ioutil.NopCloser(bytes.NewReader(plaintext))
+	out.ContentLength = aws.Long(int64(len(plaintext)))
+	return out, nil
+}
+
+// instructionFile returns the envelope in the instruction file of the
+// object of input.
+func (c *Client) instructionFile(ctx context.Context, input *s3.GetObjectInput) (*envelope, error) {
+	out, err := c.S3.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket:       input.Bucket,
+		Key:          aws.String(*input.Key + InstructionFileSuffix),
+		RequestPayer: input.RequestPayer,
+	})
+	if aerr := aws.Error(err); aerr != nil && aerr.Code == "NoSuchKey" {
+		return nil, fmt.Errorf("instruction file of object %s not found", *input.Key)
+	} else if err != nil {
+		return nil, err
+	}
+	defer out.Body.Close()
+
+	var fields map[string]string
+	if err := json.NewDecoder(io.LimitReader(out.Body, 1<<20)).Decode(&fields); err != nil {
+		return nil, fmt.Errorf("invalid instruction file: %v", err)
+	}
+	env, err := parseEnvelope(fields)
+	if err == nil && env == nil {
+		err = fmt.Errorf("invalid instruction file: no key")
+	}
+	return env, err
+}
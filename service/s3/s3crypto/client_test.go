@@ -0,0 +1,182 @@
+package s3crypto_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/service/kms"
+	"github.com/datacratic/aws-sdk-go/service/kms/kmsiface"
+	"github.com/datacratic/aws-sdk-go/service/s3"
+	"github.com/datacratic/aws-sdk-go/service/s3/s3crypto"
+	"github.com/datacratic/aws-sdk-go/service/s3/s3iface"
+	"github.com/stretchr/testify/assert"
+)
+
+type object struct {
+	body     []byte
+	metadata map[string]string
+}
+
+// mockS3 stores objects in memory. Like S3, it returns the names of the
+// metadata in canonical header form.
+type mockS3 struct {
+	s3iface.S3API
+	objects map[string]object
+	gets    []string
+}
+
+func (m *mockS3) PutObjectWithContext(ctx context.Context, in *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	b, _ := ioutil.ReadAll(in.Body)
+	o := object{body: b, metadata: map[string]string{}}
+	if in.Metadata != nil {
+		for k, v := range *in.Metadata {
+			o.metadata[http.CanonicalHeaderKey(k)] = *v
+		}
+	}
+	m.objects[*in.Key] = o
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (m *mockS3) GetObjectWithContext(ctx context.Context, in *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	m.gets = append(m.gets, *in.Key)
+	o, ok := m.objects[*in.Key]
+	if !ok {
+		return nil, aws.APIError{StatusCode: 404, Code: "NoSuchKey"}
+	}
+	metadata := map[string]*string{}
+	for k, v := range o.metadata {
+		metadata[k] = aws.String(v)
+	}
+	return &s3.GetObjectOutput{
+		Body:          ioutil.NopCloser(bytes.NewReader(o.body)),
+		ContentLength: aws.Long(int64(len(o.body))),
+		Metadata:      &metadata,
+	}, nil
+}
+
+// mockKMS wraps data keys with a local key, checking the encryption
+// context.
+type mockKMS struct {
+	kmsiface.KMSAPI
+	wrapper *s3crypto.AESKeyWrapper
+	context map[string]*string
+}
+
+func (m *mockKMS) GenerateDataKeyWithContext(ctx context.Context, in *kms.GenerateDataKeyInput) (*kms.GenerateDataKeyOutput, error) {
+	m.context = *in.EncryptionContext
+	key, wrapped, _, err := m.wrapper.GenerateDataKey(ctx)
+	return &kms.GenerateDataKeyOutput{Plaintext: key, CiphertextBlob: wrapped, KeyID: in.KeyID}, err
+}
+
+func (m *mockKMS) DecryptWithContext(ctx context.Context, in *kms.DecryptInput) (*kms.DecryptOutput, error) {
+	if *(*in.EncryptionContext)["kms_cmk_id"] != *m.context["kms_cmk_id"] {
+		return nil, aws.APIError{StatusCode: 400, Code: "InvalidCiphertextException"}
+	}
+	key, err := m.wrapper.UnwrapKey(ctx, in.CiphertextBlob, nil)
+	return &kms.DecryptOutput{Plaintext: key}, err
+}
+
+func newWrapper(t *testing.T) *s3crypto.AESKeyWrapper {
+	w, err := s3crypto.NewAESKeyWrapper(bytes.Repeat([]byte{1}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+func get(t *testing.T, c *s3crypto.Client, key string) []byte {
+	out, err := c.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String(key)})
+	if !assert.NoError(t, err) {
+		return nil
+	}
+	b, _ := ioutil.ReadAll(out.Body)
+	assert.Equal(t, int64(len(b)), *out.ContentLength)
+	return b
+}
+
+func TestEncryptWithKMS(t *testing.T) {
+	svc := &mockS3{objects: map[string]object{}}
+	c := s3crypto.New(svc, &s3crypto.KMSKeyWrapper{KMS: &mockKMS{wrapper: newWrapper(t)}, KeyID: "alias/archive"})
+
+	_, err := c.PutObject(&s3.PutObjectInput{
+		Bucket:   aws.String("bucket"),
+		Key:      aws.String("key"),
+		Body:     bytes.NewReader([]byte("secret payload")),
+		Metadata: &map[string]*string{"owner": aws.String("alice")},
+	})
+	assert.NoError(t, err)
+
+	o := svc.objects["key"]
+	assert.NotContains(t, string(o.body), "secret")
+	assert.Equal(t, "alice", o.metadata["Owner"])
+	assert.Equal(t, "kms", o.metadata["X-Amz-Wrap-Alg"])
+	assert.Equal(t, "AES/GCM/NoPadding", o.metadata["X-Amz-Cek-Alg"])
+	assert.Equal(t, `{"kms_cmk_id":"alias/archive"}`, o.metadata["X-Amz-Matdesc"])
+	assert.Equal(t, "14", o.metadata["X-Amz-Unencrypted-Content-Length"])
+
+	assert.Equal(t, []byte("secret payload"), get(t, c, "key"))
+
+	// tampered objects aren't decrypted
+	o.body[0] ^= 1
+	_, err = c.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	assert.Error(t, err)
+}
+
+func TestEncryptWithInstructionFile(t *testing.T) {
+	svc := &mockS3{objects: map[string]object{}}
+	c := s3crypto.New(svc, newWrapper(t))
+	c.InstructionFile = true
+
+	_, err := c.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader([]byte("secret payload")),
+	})
+	assert.NoError(t, err)
+
+	assert.NotContains(t, svc.objects["key"].metadata, "X-Amz-Key-V2")
+	assert.Contains(t, string(svc.objects["key"+s3crypto.InstructionFileSuffix].body), `"x-amz-key-v2"`)
+	assert.Equal(t, []byte("secret payload"), get(t, c, "key"))
+}
+
+func TestDecryptLegacyCBC(t *testing.T) {
+	wrapper := newWrapper(t)
+	key, wrapped, _, err := wrapper.GenerateDataKey(context.Background())
+	assert.NoError(t, err)
+
+	// "legacy payload" padded to a block with PKCS#5
+	plaintext := append([]byte("legacy payload"), 2, 2)
+	iv := bytes.Repeat([]byte{7}, aes.BlockSize)
+	block, _ := aes.NewCipher(key)
+	ciphertext := make([]byte, len(plaintext))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext, plaintext)
+
+	svc := &mockS3{objects: map[string]object{
+		"legacy": {body: ciphertext, metadata: map[string]string{
+			"X-Amz-Key": base64.StdEncoding.EncodeToString(wrapped),
+			"X-Amz-Iv":  base64.StdEncoding.EncodeToString(iv),
+		}},
+	}}
+	c := s3crypto.New(svc, wrapper)
+	assert.Equal(t, []byte("legacy payload"), get(t, c, "legacy"))
+}
+
+func TestDecryptErrors(t *testing.T) {
+	svc := &mockS3{objects: map[string]object{"plain": {body: []byte("plain")}}}
+	c := s3crypto.New(svc, newWrapper(t))
+
+	_, err := c.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("plain")})
+	assert.Error(t, err)
+	// objects without envelope have no instruction file to get
+	assert.Equal(t, []string{"plain"}, svc.gets)
+
+	_, err = c.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("plain"), Range: aws.String("bytes=0-1")})
+	assert.Error(t, err)
+}
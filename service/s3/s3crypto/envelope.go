@@ -0,0 +1,143 @@
+package s3crypto
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+)
+
+// Names of the envelope fields, stored as object metadata or in instruction
+// files.
+const (
+	keyV2Field                  = "x-amz-key-v2"
+	keyV1Field                  = "x-amz-key"
+	ivField                     = "x-amz-iv"
+	matDescField                = "x-amz-matdesc"
+	wrapAlgField                = "x-amz-wrap-alg"
+	cekAlgField                 = "x-amz-cek-alg"
+	tagLenField                 = "x-amz-tag-len"
+	unencryptedLengthField      = "x-amz-unencrypted-content-length"
+	instructionFileMetadataName = "x-amz-crypto-instr-file"
+)
+
+// InstructionFileSuffix is the suffix of the key of the instruction file of
+// an object, appended to the object's key.
+const InstructionFileSuffix = ".instruction"
+
+// envelope holds what decrypts an object: its data key, wrapped, and the
+// IV and algorithms of its content.
+type envelope struct {
+	WrappedKey        []byte
+	IV                []byte
+	MatDesc           map[string]string
+	WrapAlg           string
+	CEKAlg            string
+	TagLen            string
+	UnencryptedLength string
+}
+
+// fields returns the fields of e, by name.
+func (e *envelope) fields() (map[string]string, error) {
+	matDesc, err := json.Marshal(e.MatDesc)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		keyV2Field:             base64.StdEncoding.EncodeToString(e.WrappedKey),
+		ivField:                base64.StdEncoding.EncodeToString(e.IV),
+		matDescField:           string(matDesc),
+		wrapAlgField:           e.WrapAlg,
+		cekAlgField:            e.CEKAlg,
+		tagLenField:            e.TagLen,
+		unencryptedLengthField: e.UnencryptedLength,
+	}, nil
+}
+
+// parseEnvelope returns the envelope of fields, or nil if fields have no
+// key. Field names are case insensitive. Legacy v1 envelopes, without
+// content encryption algorithm, are of objects encrypted with AES-CBC.
+func parseEnvelope(fields map[string]string) (*envelope, error) {
+	get := func(name string) string {
+		for k, v := range fields {
+			if strings.EqualFold(k, name) {
+				return v
+			}
+		}
+		return ""
+	}
+
+	key := get(keyV2Field)
+	if key == "" {
+		key = get(keyV1Field)
+	}
+	if key == "" {
+		return nil, nil
+	}
+
+	e := &envelope{
+		WrapAlg:           get(wrapAlgField),
+		CEKAlg:            get(cekAlgField),
+		TagLen:            get(tagLenField),
+		UnencryptedLength: get(unencryptedLengthField),
+	}
+	if e.CEKAlg == "" {
+		e.CEKAlg = AESCBC
+	}
+
+	var err error
+	if e.WrappedKey, err = base64.StdEncoding.DecodeString(key); err != nil {
+		return nil, fmt.Errorf("invalid encrypted object key: %v", err)
+	}
+	if e.IV, err = base64.StdEncoding.DecodeString(get(ivField)); err != nil {
+		return nil, fmt.Errorf("invalid encrypted object IV: %v", err)
+	}
+	if matDesc := get(matDescField); matDesc != "" {
+		if err := json.Unmarshal([]byte(matDesc), &e.MatDesc); err != nil {
+			return nil, fmt.Errorf("invalid encrypted object material description: %v", err)
+		}
+	}
+	return e, nil
+}
+
+// metadataFields returns the values of metadata, by name.
+func metadataFields(metadata *map[string]*string) map[string]string {
+	fields := map[string]string{}
+	if metadata != nil {
+		for k, v := range *metadata {
+			if v != nil {
+				fields[k] = *v
+			}
+		}
+	}
+	return fields
+}
+
+// hasMetadata returns whether metadata has name, case insensitively.
+func hasMetadata(metadata *map[string]*string, name string) bool {
+	if metadata == nil {
+		return false
+	}
+	for k := range *metadata {
+		if strings.EqualFold(k, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// addMetadata returns a copy of metadata with fields added.
+func addMetadata(metadata *map[string]*string, fields map[string]string) *map[string]*string {
+	m := map[string]*string{}
+	if metadata != nil {
+		for k, v := range *metadata {
+			m[k] = v
+		}
+	}
+	for k, v := range fields {
+		m[k] = aws.String(v)
+	}
+	return &m
+}
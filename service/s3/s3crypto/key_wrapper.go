@@ -0,0 +1,127 @@
+package s3crypto
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/service/kms"
+	"github.com/datacratic/aws-sdk-go/service/kms/kmsiface"
+)
+
+// A KeyWrapper generates the data keys encrypting objects, and wraps them
+// with a master key so they can be stored with the objects.
+type KeyWrapper interface {
+	// WrapAlgorithm is the name of the algorithm wrapping the keys, stored
+	// with the objects, such as "kms".
+	WrapAlgorithm() string
+
+	// GenerateDataKey returns a new 256 bit data key, the key wrapped, and
+	// the material description to unwrap it with.
+	GenerateDataKey(ctx context.Context) (key, wrapped []byte, matDesc map[string]string, err error)
+
+	// UnwrapKey returns the data key wrapped with the material description
+	// matDesc.
+	UnwrapKey(ctx context.Context, wrapped []byte, matDesc map[string]string) ([]byte, error)
+}
+
+// KMSKeyWrapper is a KeyWrapper generating data keys with KMS, wrapped by
+// a KMS master key.
+type KMSKeyWrapper struct {
+	KMS kmsiface.KMSAPI
+
+	// KeyID is the ID or ARN of the master key.
+	KeyID string
+}
+
+// WrapAlgorithm returns "kms".
+func (w *KMSKeyWrapper) WrapAlgorithm() string {
+	return "kms"
+}
+
+// GenerateDataKey generates a data key with the master key. The material
+// description is the KMS encryption context of the key, naming the master
+// key as kms_cmk_id.
+func (w *KMSKeyWrapper) GenerateDataKey(ctx context.Context) ([]byte, []byte, map[string]string, error) {
+	matDesc := map[string]string{"kms_cmk_id": w.KeyID}
+	out, err := w.KMS.GenerateDataKeyWithContext(ctx, &kms.GenerateDataKeyInput{
+		EncryptionContext: encryptionContext(matDesc),
+		KeyID:             aws.String(w.KeyID),
+		KeySpec:           aws.String("AES_256"),
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return out.Plaintext, out.CiphertextBlob, matDesc, nil
+}
+
+// UnwrapKey decrypts the data key with KMS.
+func (w *KMSKeyWrapper) UnwrapKey(ctx context.Context, wrapped []byte, matDesc map[string]string) ([]byte, error) {
+	out, err := w.KMS.DecryptWithContext(ctx, &kms.DecryptInput{
+		CiphertextBlob:    wrapped,
+		EncryptionContext: encryptionContext(matDesc),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Plaintext, nil
+}
+
+func encryptionContext(matDesc map[string]string) *map[string]*string {
+	c := map[string]*string{}
+	for k, v := range matDesc {
+		c[k] = aws.String(v)
+	}
+	return &c
+}
+
+// AESKeyWrapper is a KeyWrapper wrapping random data keys with a local AES
+// master key, with AES-GCM.
+type AESKeyWrapper struct {
+	aead cipher.AEAD
+}
+
+// NewAESKeyWrapper returns a key wrapper with the AES master key, of 16, 24
+// or 32 bytes.
+func NewAESKeyWrapper(masterKey []byte) (*AESKeyWrapper, error) {
+	block, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &AESKeyWrapper{aead: aead}, nil
+}
+
+// WrapAlgorithm returns "AES/GCM".
+func (w *AESKeyWrapper) WrapAlgorithm() string {
+	return "AES/GCM"
+}
+
+// GenerateDataKey generates a random data key, wrapped with its nonce.
+func (w *AESKeyWrapper) GenerateDataKey(ctx context.Context) ([]byte, []byte, map[string]string, error) {
+	key := make([]byte, 32)
+	nonce := make([]byte, w.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, nil, nil, err
+	}
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, nil, nil, err
+	}
+	return key, w.aead.Seal(nonce, nonce, key, nil), map[string]string{}, nil
+}
+
+// UnwrapKey unwraps a data key wrapped by GenerateDataKey.
+func (w *AESKeyWrapper) UnwrapKey(ctx context.Context, wrapped []byte, matDesc map[string]string) ([]byte, error) {
+	n := w.aead.NonceSize()
+	if len(wrapped) < n {
+		return nil, fmt.Errorf("invalid wrapped key")
+	}
+	return w.aead.Open(nil, wrapped[:n], wrapped[n:], nil)
+}
@@ -1,111 +1,229 @@
 package s3iface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/s3"
 )
 
 type S3API interface {
 	AbortMultipartUpload(*s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error)
 
+	AbortMultipartUploadWithContext(context.Context, *s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error)
+
 	CompleteMultipartUpload(*s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error)
 
+	CompleteMultipartUploadWithContext(context.Context, *s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error)
+
 	CopyObject(*s3.CopyObjectInput) (*s3.CopyObjectOutput, error)
 
+	CopyObjectWithContext(context.Context, *s3.CopyObjectInput) (*s3.CopyObjectOutput, error)
+
 	CreateBucket(*s3.CreateBucketInput) (*s3.CreateBucketOutput, error)
 
+	CreateBucketWithContext(context.Context, *s3.CreateBucketInput) (*s3.CreateBucketOutput, error)
+
 	CreateMultipartUpload(*s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error)
 
+	CreateMultipartUploadWithContext(context.Context, *s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error)
+
 	DeleteBucket(*s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error)
 
+	DeleteBucketWithContext(context.Context, *s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error)
+
 	DeleteBucketCORS(*s3.DeleteBucketCORSInput) (*s3.DeleteBucketCORSOutput, error)
 
+	DeleteBucketCORSWithContext(context.Context, *s3.DeleteBucketCORSInput) (*s3.DeleteBucketCORSOutput, error)
+
 	DeleteBucketLifecycle(*s3.DeleteBucketLifecycleInput) (*s3.DeleteBucketLifecycleOutput, error)
 
+	DeleteBucketLifecycleWithContext(context.Context, *s3.DeleteBucketLifecycleInput) (*s3.DeleteBucketLifecycleOutput, error)
+
 	DeleteBucketPolicy(*s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error)
 
+	DeleteBucketPolicyWithContext(context.Context, *s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error)
+
 	DeleteBucketReplication(*s3.DeleteBucketReplicationInput) (*s3.DeleteBucketReplicationOutput, error)
 
+	DeleteBucketReplicationWithContext(context.Context, *s3.DeleteBucketReplicationInput) (*s3.DeleteBucketReplicationOutput, error)
+
 	DeleteBucketTagging(*s3.DeleteBucketTaggingInput) (*s3.DeleteBucketTaggingOutput, error)
 
+	DeleteBucketTaggingWithContext(context.Context, *s3.DeleteBucketTaggingInput) (*s3.DeleteBucketTaggingOutput, error)
+
 	DeleteBucketWebsite(*s3.DeleteBucketWebsiteInput) (*s3.DeleteBucketWebsiteOutput, error)
 
+	DeleteBucketWebsiteWithContext(context.Context, *s3.DeleteBucketWebsiteInput) (*s3.DeleteBucketWebsiteOutput, error)
+
 	DeleteObject(*s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
 
+	DeleteObjectWithContext(context.Context, *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
+
 	DeleteObjects(*s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
 
+	DeleteObjectsWithContext(context.Context, *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
+
 	GetBucketACL(*s3.GetBucketACLInput) (*s3.GetBucketACLOutput, error)
 
+	GetBucketACLWithContext(context.Context, *s3.GetBucketACLInput) (*s3.GetBucketACLOutput, error)
+
 	GetBucketCORS(*s3.GetBucketCORSInput) (*s3.GetBucketCORSOutput, error)
 
+	GetBucketCORSWithContext(context.Context, *s3.GetBucketCORSInput) (*s3.GetBucketCORSOutput, error)
+
 	GetBucketLifecycle(*s3.GetBucketLifecycleInput) (*s3.GetBucketLifecycleOutput, error)
 
+	GetBucketLifecycleWithContext(context.Context, *s3.GetBucketLifecycleInput) (*s3.GetBucketLifecycleOutput, error)
+
 	GetBucketLocation(*s3.GetBucketLocationInput) (*s3.GetBucketLocationOutput, error)
 
+	GetBucketLocationWithContext(context.Context, *s3.GetBucketLocationInput) (*s3.GetBucketLocationOutput, error)
+
 	GetBucketLogging(*s3.GetBucketLoggingInput) (*s3.GetBucketLoggingOutput, error)
 
+	GetBucketLoggingWithContext(context.Context, *s3.GetBucketLoggingInput) (*s3.GetBucketLoggingOutput, error)
+
 	GetBucketNotification(*s3.GetBucketNotificationInput) (*s3.GetBucketNotificationOutput, error)
 
+	GetBucketNotificationWithContext(context.Context, *s3.GetBucketNotificationInput) (*s3.GetBucketNotificationOutput, error)
+
 	GetBucketPolicy(*s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error)
 
+	GetBucketPolicyWithContext(context.Context, *s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error)
+
 	GetBucketReplication(*s3.GetBucketReplicationInput) (*s3.GetBucketReplicationOutput, error)
 
+	GetBucketReplicationWithContext(context.Context, *s3.GetBucketReplicationInput) (*s3.GetBucketReplicationOutput, error)
+
 	GetBucketRequestPayment(*s3.GetBucketRequestPaymentInput) (*s3.GetBucketRequestPaymentOutput, error)
 
+	GetBucketRequestPaymentWithContext(context.Context, *s3.GetBucketRequestPaymentInput) (*s3.GetBucketRequestPaymentOutput, error)
+
 	GetBucketTagging(*s3.GetBucketTaggingInput) (*s3.GetBucketTaggingOutput, error)
 
+	GetBucketTaggingWithContext(context.Context, *s3.GetBucketTaggingInput) (*s3.GetBucketTaggingOutput, error)
+
 	GetBucketVersioning(*s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error)
 
+	GetBucketVersioningWithContext(context.Context, *s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error)
+
 	GetBucketWebsite(*s3.GetBucketWebsiteInput) (*s3.GetBucketWebsiteOutput, error)
 
+	GetBucketWebsiteWithContext(context.Context, *s3.GetBucketWebsiteInput) (*s3.GetBucketWebsiteOutput, error)
+
 	GetObject(*s3.GetObjectInput) (*s3.GetObjectOutput, error)
 
+	GetObjectWithContext(context.Context, *s3.GetObjectInput) (*s3.GetObjectOutput, error)
+
 	GetObjectACL(*s3.GetObjectACLInput) (*s3.GetObjectACLOutput, error)
 
+	GetObjectACLWithContext(context.Context, *s3.GetObjectACLInput) (*s3.GetObjectACLOutput, error)
+
 	GetObjectTorrent(*s3.GetObjectTorrentInput) (*s3.GetObjectTorrentOutput, error)
 
+	GetObjectTorrentWithContext(context.Context, *s3.GetObjectTorrentInput) (*s3.GetObjectTorrentOutput, error)
+
 	HeadBucket(*s3.HeadBucketInput) (*s3.HeadBucketOutput, error)
 
+	HeadBucketWithContext(context.Context, *s3.HeadBucketInput) (*s3.HeadBucketOutput, error)
+
 	HeadObject(*s3.HeadObjectInput) (*s3.HeadObjectOutput, error)
 
+	HeadObjectWithContext(context.Context, *s3.HeadObjectInput) (*s3.HeadObjectOutput, error)
+
 	ListBuckets(*s3.ListBucketsInput) (*s3.ListBucketsOutput, error)
 
+	ListBucketsWithContext(context.Context, *s3.ListBucketsInput) (*s3.ListBucketsOutput, error)
+
 	ListMultipartUploads(*s3.ListMultipartUploadsInput) (*s3.ListMultipartUploadsOutput, error)
 
+	ListMultipartUploadsWithContext(context.Context, *s3.ListMultipartUploadsInput) (*s3.ListMultipartUploadsOutput, error)
+	ListMultipartUploadsPages(*s3.ListMultipartUploadsInput, func(*s3.ListMultipartUploadsOutput, bool) bool) error
+
 	ListObjectVersions(*s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
 
+	ListObjectVersionsWithContext(context.Context, *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
+	ListObjectVersionsPages(*s3.ListObjectVersionsInput, func(*s3.ListObjectVersionsOutput, bool) bool) error
+
 	ListObjects(*s3.ListObjectsInput) (*s3.ListObjectsOutput, error)
 
+	ListObjectsWithContext(context.Context, *s3.ListObjectsInput) (*s3.ListObjectsOutput, error)
+	ListObjectsPages(*s3.ListObjectsInput, func(*s3.ListObjectsOutput, bool) bool) error
+
 	ListParts(*s3.ListPartsInput) (*s3.ListPartsOutput, error)
 
+	ListPartsWithContext(context.Context, *s3.ListPartsInput) (*s3.ListPartsOutput, error)
+	ListPartsPages(*s3.ListPartsInput, func(*s3.ListPartsOutput, bool) bool) error
+
 	PutBucketACL(*s3.PutBucketACLInput) (*s3.PutBucketACLOutput, error)
 
+	PutBucketACLWithContext(context.Context, *s3.PutBucketACLInput) (*s3.PutBucketACLOutput, error)
+
 	PutBucketCORS(*s3.PutBucketCORSInput) (*s3.PutBucketCORSOutput, error)
 
+	PutBucketCORSWithContext(context.Context, *s3.PutBucketCORSInput) (*s3.PutBucketCORSOutput, error)
+
 	PutBucketLifecycle(*s3.PutBucketLifecycleInput) (*s3.PutBucketLifecycleOutput, error)
 
+	PutBucketLifecycleWithContext(context.Context, *s3.PutBucketLifecycleInput) (*s3.PutBucketLifecycleOutput, error)
+
 	PutBucketLogging(*s3.PutBucketLoggingInput) (*s3.PutBucketLoggingOutput, error)
 
+	PutBucketLoggingWithContext(context.Context, *s3.PutBucketLoggingInput) (*s3.PutBucketLoggingOutput, error)
+
 	PutBucketNotification(*s3.PutBucketNotificationInput) (*s3.PutBucketNotificationOutput, error)
 
+	PutBucketNotificationWithContext(context.Context, *s3.PutBucketNotificationInput) (*s3.PutBucketNotificationOutput, error)
+
 	PutBucketPolicy(*s3.PutBucketPolicyInput) (*s3.PutBucketPolicyOutput, error)
 
+	PutBucketPolicyWithContext(context.Context, *s3.PutBucketPolicyInput) (*s3.PutBucketPolicyOutput, error)
+
 	PutBucketReplication(*s3.PutBucketReplicationInput) (*s3.PutBucketReplicationOutput, error)
 
+	PutBucketReplicationWithContext(context.Context, *s3.PutBucketReplicationInput) (*s3.PutBucketReplicationOutput, error)
+
 	PutBucketRequestPayment(*s3.PutBucketRequestPaymentInput) (*s3.PutBucketRequestPaymentOutput, error)
 
+	PutBucketRequestPaymentWithContext(context.Context, *s3.PutBucketRequestPaymentInput) (*s3.PutBucketRequestPaymentOutput, error)
+
 	PutBucketTagging(*s3.PutBucketTaggingInput) (*s3.PutBucketTaggingOutput, error)
 
+	PutBucketTaggingWithContext(context.Context, *s3.PutBucketTaggingInput) (*s3.PutBucketTaggingOutput, error)
+
 	PutBucketVersioning(*s3.PutBucketVersioningInput) (*s3.PutBucketVersioningOutput, error)
 
+	PutBucketVersioningWithContext(context.Context, *s3.PutBucketVersioningInput) (*s3.PutBucketVersioningOutput, error)
+
 	PutBucketWebsite(*s3.PutBucketWebsiteInput) (*s3.PutBucketWebsiteOutput, error)
 
+	PutBucketWebsiteWithContext(context.Context, *s3.PutBucketWebsiteInput) (*s3.PutBucketWebsiteOutput, error)
+
 	PutObject(*s3.PutObjectInput) (*s3.PutObjectOutput, error)
 
+	PutObjectWithContext(context.Context, *s3.PutObjectInput) (*s3.PutObjectOutput, error)
+
 	PutObjectACL(*s3.PutObjectACLInput) (*s3.PutObjectACLOutput, error)
 
+	PutObjectACLWithContext(context.Context, *s3.PutObjectACLInput) (*s3.PutObjectACLOutput, error)
+
 	RestoreObject(*s3.RestoreObjectInput) (*s3.RestoreObjectOutput, error)
 
+	RestoreObjectWithContext(context.Context, *s3.RestoreObjectInput) (*s3.RestoreObjectOutput, error)
+
 	UploadPart(*s3.UploadPartInput) (*s3.UploadPartOutput, error)
 
+	UploadPartWithContext(context.Context, *s3.UploadPartInput) (*s3.UploadPartOutput, error)
+
 	UploadPartCopy(*s3.UploadPartCopyInput) (*s3.UploadPartCopyOutput, error)
+
+	UploadPartCopyWithContext(context.Context, *s3.UploadPartCopyInput) (*s3.UploadPartCopyOutput, error)
+
+	WaitUntilBucketExists(*s3.HeadBucketInput) error
+
+	WaitUntilBucketNotExists(*s3.HeadBucketInput) error
+
+	WaitUntilObjectExists(*s3.HeadObjectInput) error
+
+	WaitUntilObjectNotExists(*s3.HeadObjectInput) error
 }
\ No newline at end of file
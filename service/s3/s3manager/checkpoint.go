@@ -0,0 +1,133 @@
+package s3manager
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/service/s3"
+)
+
+// checkpoint is the state of a multipart upload persisted to a file, to
+// resume the upload after a restart.
+type checkpoint struct {
+	Bucket   string
+	Key      string
+	UploadID string
+	PartSize int64
+	Parts    []checkpointPart
+
+	path string
+}
+
+// checkpointPart is an uploaded part of a checkpoint.
+type checkpointPart struct {
+	PartNumber int64
+	ETag       string
+	Size       int64
+}
+
+// loadCheckpoint loads the checkpoint at path, or returns a new one if there
+// is none. It fails if the checkpoint is of another object.
+func loadCheckpoint(path, bucket, key string) (*checkpoint, error) {
+	c := &checkpoint{Bucket: bucket, Key: key, path: path}
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return c, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint %s: %v", path, err)
+	}
+	if c.Bucket != bucket || c.Key != key {
+		return nil, fmt.Errorf("checkpoint %s is of s3://%s/%s", path, c.Bucket, c.Key)
+	}
+	return c, nil
+}
+
+// save writes the checkpoint to its file. The file is replaced atomically,
+// so it is never left partially written.
+func (c *checkpoint) save() error {
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(c.path), filepath.Base(c.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), c.path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// remove removes the checkpoint's file, once its upload is done.
+func (c *checkpoint) remove() {
+	os.Remove(c.path)
+}
+
+// resume reconciles the checkpoint of up with the parts of its upload listed
+// by S3, which are uploaded again only if they differ from the body. The
+// checkpoint is reset if its upload no longer exists.
+func (up *upload) resume() error {
+	c := up.checkpoint
+	if c.UploadID == "" {
+		return nil
+	}
+	up.partSize = c.PartSize
+
+	existing := map[int64]string{}
+	in := &s3.ListPartsInput{
+		Bucket:       up.in.Bucket,
+		Key:          up.in.Key,
+		RequestPayer: up.in.RequestPayer,
+		UploadID:     aws.String(c.UploadID),
+	}
+	for {
+		out, err := up.u.S3.ListPartsWithContext(up.ctx, in)
+		if aerr := aws.Error(err); aerr != nil && aerr.Code == "NoSuchUpload" {
+			c.UploadID, c.Parts = "", nil
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		for _, p := range out.Parts {
+			if p.PartNumber != nil && p.ETag != nil {
+				existing[*p.PartNumber] = *p.ETag
+			}
+		}
+		if out.IsTruncated == nil || !*out.IsTruncated {
+			break
+		}
+		in.PartNumberMarker = out.NextPartNumberMarker
+	}
+
+	up.uploadID = c.UploadID
+	up.existing = existing
+	c.Parts = nil
+	return nil
+}
+
+// partETag returns the ETag of the part with body b, for uploads without
+// SSE-KMS or SSE-C: the quoted hex MD5 of the body.
+func partETag(b []byte) string {
+	sum := md5.Sum(b)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
@@ -0,0 +1,140 @@
+package s3manager_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/service/s3"
+	"github.com/datacratic/aws-sdk-go/service/s3/s3manager"
+	"github.com/stretchr/testify/assert"
+)
+
+// ListPartsWithContext lists the parts of the upload two by two.
+func (m *mockS3) ListPartsWithContext(ctx context.Context, in *s3.ListPartsInput) (*s3.ListPartsOutput, error) {
+	m.record("ListParts")
+	if *in.UploadID != "upload" {
+		return nil, aws.APIError{StatusCode: 404, Code: "NoSuchUpload"}
+	}
+
+	m.m.Lock()
+	defer m.m.Unlock()
+	var numbers []int
+	for n := range m.parts {
+		if in.PartNumberMarker == nil || n > *in.PartNumberMarker {
+			numbers = append(numbers, int(n))
+		}
+	}
+	sort.Ints(numbers)
+
+	out := &s3.ListPartsOutput{IsTruncated: aws.Boolean(len(numbers) > 2)}
+	if len(numbers) > 2 {
+		numbers = numbers[:2]
+		out.NextPartNumberMarker = aws.Long(int64(numbers[1]))
+	}
+	for _, n := range numbers {
+		b := m.parts[int64(n)]
+		out.Parts = append(out.Parts, &s3.Part{PartNumber: aws.Long(int64(n)), ETag: aws.String(etag(b)), Size: aws.Long(int64(len(b)))})
+	}
+	return out, nil
+}
+
+func tempCheckpoint(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "s3manager")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return filepath.Join(dir, "upload.json"), func() { os.RemoveAll(dir) }
+}
+
+func TestUploadResumesFromCheckpoint(t *testing.T) {
+	checkpoint, cleanup := tempCheckpoint(t)
+	defer cleanup()
+
+	svc := newMockS3()
+	svc.partFails[4] = -1
+	data := body(int(4*s3manager.MinUploadPartSize - 100))
+	u := newUploader(svc)
+	u.PartRetries = -1
+	// parts are uploaded in order, so the first three are done when the
+	// last fails
+	u.Concurrency = 1
+	input := &s3manager.UploadInput{Bucket: aws.String("bucket"), Key: aws.String("key")}
+
+	input.Body = bytes.NewReader(data)
+	_, err := u.UploadWithCheckpoint(input, checkpoint)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"CreateMultipartUpload"}, svc.ops)
+
+	var saved struct {
+		UploadID string
+		PartSize int64
+		Parts    []struct{ PartNumber int64 }
+	}
+	b, err := ioutil.ReadFile(checkpoint)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(b, &saved))
+	assert.Equal(t, "upload", saved.UploadID)
+	assert.Equal(t, s3manager.MinUploadPartSize, saved.PartSize)
+	assert.Equal(t, 3, len(saved.Parts))
+
+	// a part changed since it was uploaded is uploaded again
+	svc.parts[2] = []byte("stale")
+	delete(svc.partFails, 4)
+	svc.uploaded, svc.ops = nil, nil
+
+	input.Body = onlyReader{bytes.NewReader(data)}
+	out, err := u.UploadWithCheckpoint(input, checkpoint)
+	assert.NoError(t, err)
+	assert.Equal(t, "upload", out.UploadID)
+	assert.Equal(t, []string{"ListParts", "ListParts", "CompleteMultipartUpload"}, svc.ops)
+	sort.Sort(int64s(svc.uploaded))
+	assert.Equal(t, []int64{2, 4}, svc.uploaded)
+	assert.Equal(t, 4, len(svc.completed))
+	assert.Equal(t, data, bytes.Join([][]byte{svc.parts[1], svc.parts[2], svc.parts[3], svc.parts[4]}, nil))
+
+	_, err = os.Stat(checkpoint)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestUploadCheckpointOfExpiredUpload(t *testing.T) {
+	checkpoint, cleanup := tempCheckpoint(t)
+	defer cleanup()
+	ioutil.WriteFile(checkpoint, []byte(`{"Bucket":"bucket","Key":"key","UploadID":"expired","PartSize":5242880}`), 0600)
+
+	svc := newMockS3()
+	_, err := newUploader(svc).UploadWithCheckpoint(&s3manager.UploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader(body(int(2 * s3manager.MinUploadPartSize))),
+	}, checkpoint)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ListParts", "CreateMultipartUpload", "CompleteMultipartUpload"}, svc.ops)
+}
+
+func TestUploadCheckpointOfOtherObject(t *testing.T) {
+	checkpoint, cleanup := tempCheckpoint(t)
+	defer cleanup()
+	ioutil.WriteFile(checkpoint, []byte(`{"Bucket":"bucket","Key":"other","UploadID":"upload"}`), 0600)
+
+	svc := newMockS3()
+	_, err := newUploader(svc).UploadWithCheckpoint(&s3manager.UploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader(body(10)),
+	}, checkpoint)
+	assert.Error(t, err)
+	assert.Empty(t, svc.ops)
+}
+
+type int64s []int64
+
+func (s int64s) Len() int           { return len(s) }
+func (s int64s) Less(i, j int) bool { return s[i] < s[j] }
+func (s int64s) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
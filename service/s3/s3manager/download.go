@@ -0,0 +1,249 @@
+package s3manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/service/s3"
+	"github.com/datacratic/aws-sdk-go/service/s3/s3iface"
+)
+
+const (
+	// DefaultDownloadPartSize is the size of the ranges downloaded when none
+	// is configured.
+	DefaultDownloadPartSize int64 = 5 * 1024 * 1024
+
+	// DefaultDownloadConcurrency is the number of ranges downloaded
+	// concurrently when no concurrency is configured.
+	DefaultDownloadConcurrency = 5
+)
+
+// ErrObjectChanged is returned when an object is replaced while it is
+// downloaded, so its ranges would be of different versions.
+var ErrObjectChanged = errors.New("object changed during download")
+
+// A Downloader downloads objects from S3 in concurrent ranges. A Downloader
+// is safe for concurrent use, once configured.
+type Downloader struct {
+	S3 s3iface.S3API
+
+	// PartSize is the size of the ranges downloaded.
+	PartSize int64
+
+	// Concurrency is the number of ranges downloaded concurrently.
+	Concurrency int
+
+	// PartRetries is the number of times a failed range is downloaded
+	// again, from where it failed, on top of the retries of the client. Use
+	// -1 for no retries.
+	PartRetries int
+}
+
+// NewDownloader returns a downloader with the default settings, using svc.
+func NewDownloader(svc s3iface.S3API) *Downloader {
+	return &Downloader{
+		S3:          svc,
+		PartSize:    DefaultDownloadPartSize,
+		Concurrency: DefaultDownloadConcurrency,
+		PartRetries: DefaultPartRetries,
+	}
+}
+
+// Download downloads the object of input to w, and returns the number of
+// bytes written. The ranges of the object are written at their offset in
+// the object, concurrently. If input has a Range, only that range is
+// downloaded, at offset 0.
+//
+// The ranges are downloaded with the ETag of the object as IfMatch, unless
+// input has one, so the download fails with ErrObjectChanged if the object
+// is replaced meanwhile.
+func (d *Downloader) Download(w io.WriterAt, input *s3.GetObjectInput) (int64, error) {
+	return d.DownloadWithContext(context.Background(), w, input)
+}
+
+// DownloadWithContext is the same as Download with the addition of a
+// context. Canceling the context aborts the download.
+func (d *Downloader) DownloadWithContext(ctx context.Context, w io.WriterAt, input *s3.GetObjectInput) (int64, error) {
+	dl := &download{d: d, w: w, in: input}
+
+	if input.Range != nil {
+		// a single range, which can't be split without parsing it
+		return dl.getRange(ctx, 0, input.Range, -1)
+	}
+
+	head, err := d.S3.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket:               input.Bucket,
+		IfMatch:              input.IfMatch,
+		IfModifiedSince:      input.IfModifiedSince,
+		IfNoneMatch:          input.IfNoneMatch,
+		IfUnmodifiedSince:    input.IfUnmodifiedSince,
+		Key:                  input.Key,
+		RequestPayer:         input.RequestPayer,
+		SSECustomerAlgorithm: input.SSECustomerAlgorithm,
+		SSECustomerKey:       input.SSECustomerKey,
+		SSECustomerKeyMD5:    input.SSECustomerKeyMD5,
+		VersionID:            input.VersionID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if head.ContentLength == nil {
+		return 0, fmt.Errorf("no content length for object %s", *input.Key)
+	}
+	size := *head.ContentLength
+	dl.etag = head.ETag
+	if dl.in.IfMatch != nil {
+		dl.etag = dl.in.IfMatch
+	}
+
+	err = dl.downloadRanges(ctx, size)
+	return dl.written, err
+}
+
+// download is the state of a download.
+type download struct {
+	d    *Downloader
+	w    io.WriterAt
+	in   *s3.GetObjectInput
+	etag *string
+
+	m       sync.Mutex
+	written int64
+	err     error
+}
+
+// byteRange is a range of an object, from start to end inclusive.
+type byteRange struct {
+	start, end int64
+}
+
+// downloadRanges downloads the object of size bytes in concurrent ranges.
+func (dl *download) downloadRanges(ctx context.Context, size int64) error {
+	partSize := dl.d.PartSize
+	if partSize <= 0 {
+		partSize = DefaultDownloadPartSize
+	}
+	concurrency := dl.d.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultDownloadConcurrency
+	}
+
+	// the first error cancels the ranges in progress
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ranges := make(chan byteRange)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range ranges {
+				if err := dl.downloadRange(ctx, r); err != nil {
+					dl.fail(err)
+					cancel()
+				}
+			}
+		}()
+	}
+
+sendRanges:
+	for start := int64(0); start < size; start += partSize {
+		end := start + partSize - 1
+		if end >= size {
+			end = size - 1
+		}
+		select {
+		case ranges <- byteRange{start, end}:
+		case <-ctx.Done():
+			dl.fail(ctx.Err())
+			break sendRanges
+		}
+	}
+	close(ranges)
+	wg.Wait()
+
+	return dl.err
+}
+
+// downloadRange downloads r, downloading again from where it failed on
+// errors up to PartRetries times.
+func (dl *download) downloadRange(ctx context.Context, r byteRange) error {
+	retries := dl.d.PartRetries
+	if retries == 0 {
+		retries = DefaultPartRetries
+	}
+
+	var err error
+	for attempt := 0; attempt == 0 || attempt <= retries; attempt++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var n int64
+		rng := fmt.Sprintf("bytes=%d-%d", r.start, r.end)
+		n, err = dl.getRange(ctx, r.start, &rng, r.end-r.start+1)
+		r.start += n
+		if err == nil || err == ErrObjectChanged {
+			return err
+		}
+		if aerr := aws.Error(err); aerr != nil && aerr.Code == "PreconditionFailed" {
+			return ErrObjectChanged
+		}
+	}
+	return err
+}
+
+// getRange gets the range rng of the object, and writes it to w at offset.
+// It returns the number of bytes written, and an error if they aren't size,
+// unless size is -1.
+func (dl *download) getRange(ctx context.Context, offset int64, rng *string, size int64) (int64, error) {
+	in := *dl.in
+	in.Range = rng
+	if dl.etag != nil {
+		in.IfMatch = dl.etag
+	}
+
+	out, err := dl.d.S3.GetObjectWithContext(ctx, &in)
+	if err != nil {
+		return 0, err
+	}
+	defer out.Body.Close()
+	if dl.etag != nil && out.ETag != nil && *out.ETag != *dl.etag {
+		return 0, ErrObjectChanged
+	}
+
+	n, err := io.Copy(&offsetWriter{w: dl.w, off: offset}, out.Body)
+	dl.m.Lock()
+	dl.written += n
+	dl.m.Unlock()
+	if err == nil && size >= 0 && n < size {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+// fail records the first error of the download.
+func (dl *download) fail(err error) {
+	dl.m.Lock()
+	defer dl.m.Unlock()
+	if dl.err == nil {
+		dl.err = err
+	}
+}
+
+// offsetWriter writes to a WriterAt from an offset.
+type offsetWriter struct {
+	w   io.WriterAt
+	off int64
+}
+
+func (w *offsetWriter) Write(p []byte) (int, error) {
+	n, err := w.w.WriteAt(p, w.off)
+	w.off += int64(n)
+	return n, err
+}
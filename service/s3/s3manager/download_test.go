@@ -0,0 +1,126 @@
+package s3manager_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/service/s3"
+	"github.com/datacratic/aws-sdk-go/service/s3/s3iface"
+	"github.com/datacratic/aws-sdk-go/service/s3/s3manager"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockObject serves ranges of an object.
+type mockObject struct {
+	s3iface.S3API
+
+	m          sync.Mutex
+	data       []byte
+	etag       string
+	ranges     []string
+	rangeFails map[string]int // number of truncated responses to ranges
+	changeAt   int            // number of ranges after which the object changes
+}
+
+func (o *mockObject) HeadObjectWithContext(ctx context.Context, in *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
+	return &s3.HeadObjectOutput{ContentLength: aws.Long(int64(len(o.data))), ETag: aws.String(o.etag)}, nil
+}
+
+func (o *mockObject) GetObjectWithContext(ctx context.Context, in *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	o.m.Lock()
+	defer o.m.Unlock()
+	o.ranges = append(o.ranges, *in.Range)
+	if o.changeAt > 0 && len(o.ranges) > o.changeAt {
+		o.etag = "changed"
+	}
+	if in.IfMatch != nil && *in.IfMatch != o.etag {
+		return nil, aws.APIError{StatusCode: 412, Code: "PreconditionFailed"}
+	}
+
+	var start, end int
+	fmt.Sscanf(*in.Range, "bytes=%d-%d", &start, &end)
+	if end >= len(o.data) {
+		end = len(o.data) - 1
+	}
+	b := o.data[start : end+1]
+	if o.rangeFails[*in.Range] > 0 {
+		o.rangeFails[*in.Range]--
+		b = b[:len(b)/2]
+	}
+	return &s3.GetObjectOutput{Body: ioutil.NopCloser(bytes.NewReader(b)), ETag: aws.String(o.etag)}, nil
+}
+
+// writeAtBuffer is a growing buffer written at offsets.
+type writeAtBuffer struct {
+	m sync.Mutex
+	b []byte
+}
+
+func (w *writeAtBuffer) WriteAt(p []byte, off int64) (int, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	if end := int(off) + len(p); end > len(w.b) {
+		w.b = append(w.b, make([]byte, end-len(w.b))...)
+	}
+	copy(w.b[off:], p)
+	return len(p), nil
+}
+
+var _ io.WriterAt = &writeAtBuffer{}
+
+func newDownloader(svc s3iface.S3API) *s3manager.Downloader {
+	d := s3manager.NewDownloader(svc)
+	d.PartSize = 10
+	d.Concurrency = 3
+	return d
+}
+
+func TestDownload(t *testing.T) {
+	obj := &mockObject{data: body(25), etag: "etag", rangeFails: map[string]int{"bytes=10-19": 1}}
+	w := &writeAtBuffer{}
+	n, err := newDownloader(obj).Download(w, &s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(25), n)
+	assert.Equal(t, obj.data, w.b)
+
+	// the truncated range is resumed where it failed
+	assert.Contains(t, obj.ranges, "bytes=15-19")
+	assert.Equal(t, 4, len(obj.ranges))
+}
+
+func TestDownloadObjectChanged(t *testing.T) {
+	obj := &mockObject{data: body(100), etag: "etag", changeAt: 2}
+	d := newDownloader(obj)
+	d.Concurrency = 1
+	_, err := d.Download(&writeAtBuffer{}, &s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	assert.Equal(t, s3manager.ErrObjectChanged, err)
+	assert.Equal(t, 3, len(obj.ranges))
+}
+
+func TestDownloadEmptyObject(t *testing.T) {
+	obj := &mockObject{etag: "etag"}
+	n, err := newDownloader(obj).Download(&writeAtBuffer{}, &s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+	assert.Empty(t, obj.ranges)
+}
+
+func TestDownloadRange(t *testing.T) {
+	obj := &mockObject{data: body(100), etag: "etag"}
+	w := &writeAtBuffer{}
+	n, err := newDownloader(obj).Download(w, &s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Range:  aws.String("bytes=40-69"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(30), n)
+	assert.Equal(t, obj.data[40:70], w.b)
+	assert.Equal(t, []string{"bytes=40-69"}, obj.ranges)
+}
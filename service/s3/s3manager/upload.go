@@ -0,0 +1,602 @@
+// Package s3manager provides managers transferring large objects to and from
+// S3 with concurrent multipart requests.
+package s3manager
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/service/s3"
+	"github.com/datacratic/aws-sdk-go/service/s3/s3iface"
+)
+
+const (
+	// MinUploadPartSize is the minimum size of the parts of a multipart
+	// upload, except the last one.
+	MinUploadPartSize int64 = 5 * 1024 * 1024
+
+	// MaxUploadParts is the maximum number of parts of a multipart upload.
+	MaxUploadParts = 10000
+
+	// DefaultUploadPartSize is the part size used when none is configured.
+	DefaultUploadPartSize = MinUploadPartSize
+
+	// DefaultUploadConcurrency is the number of parts uploaded concurrently
+	// when no concurrency is configured.
+	DefaultUploadConcurrency = 5
+
+	// DefaultPartRetries is the number of times a failed part is uploaded
+	// again when no number is configured.
+	DefaultPartRetries = 3
+)
+
+// UploadInput is the input of an upload. It has the fields of
+// s3.PutObjectInput, except that the body can be any reader.
+type UploadInput struct {
+	ACL                     *string
+	Body                    io.Reader
+	Bucket                  *string
+	CacheControl            *string
+	ContentDisposition      *string
+	ContentEncoding         *string
+	ContentLanguage         *string
+	ContentType             *string
+	Expires                 *time.Time
+	GrantFullControl        *string
+	GrantRead               *string
+	GrantReadACP            *string
+	GrantWriteACP           *string
+	Key                     *string
+	Metadata                *map[string]*string
+	RequestPayer            *string
+	SSECustomerAlgorithm    *string
+	SSECustomerKey          *string
+	SSECustomerKeyMD5       *string
+	SSEKMSKeyID             *string
+	ServerSideEncryption    *string
+	StorageClass            *string
+	WebsiteRedirectLocation *string
+}
+
+// UploadOutput is the result of an upload.
+type UploadOutput struct {
+	// Location is the URL of the object, for multipart uploads.
+	Location string
+
+	// UploadID is the ID of the multipart upload, or empty if the object
+	// was put in a single request.
+	UploadID string
+
+	ETag      *string
+	VersionID *string
+}
+
+// UploadError is the error of a failed multipart upload.
+type UploadError struct {
+	Err error
+
+	// UploadID is the ID of the failed upload. Its parts are left in S3 if
+	// the Uploader is configured with LeavePartsOnError, or the upload has a
+	// checkpoint.
+	UploadID string
+}
+
+func (e *UploadError) Error() string {
+	return fmt.Sprintf("multipart upload %s failed: %v", e.UploadID, e.Err)
+}
+
+// UploadProgress is the progress of an upload.
+type UploadProgress struct {
+	// BytesSent is the number of bytes of the body uploaded so far.
+	BytesSent int64
+
+	// TotalBytes is the size of the body, or -1 if it's unknown.
+	TotalBytes int64
+}
+
+// An Uploader uploads objects to S3, in concurrent parts if they are larger
+// than a part. An Uploader is safe for concurrent use, once configured.
+type Uploader struct {
+	S3 s3iface.S3API
+
+	// PartSize is the size of the parts, and so of the buffers holding
+	// them. It is increased as needed to upload bodies of known size in at
+	// most MaxUploadParts parts. Bodies of unknown size larger than
+	// PartSize*MaxUploadParts can't be uploaded.
+	PartSize int64
+
+	// Concurrency is the number of parts uploaded concurrently, which is
+	// also the number of buffers allocated per upload.
+	Concurrency int
+
+	// PartRetries is the number of times a failed part is uploaded again,
+	// on top of the retries of the client. Use -1 for no retries.
+	PartRetries int
+
+	// LeavePartsOnError disables aborting failed multipart uploads, so they
+	// can be inspected or resumed. Their parts are billed until aborted.
+	LeavePartsOnError bool
+
+	// Progress, if set, is called after each uploaded part. Calls are
+	// serialized.
+	Progress func(UploadProgress)
+}
+
+// NewUploader returns an uploader with the default settings, using svc.
+func NewUploader(svc s3iface.S3API) *Uploader {
+	return &Uploader{
+		S3:          svc,
+		PartSize:    DefaultUploadPartSize,
+		Concurrency: DefaultUploadConcurrency,
+		PartRetries: DefaultPartRetries,
+	}
+}
+
+// Upload uploads the body of input to S3. A body smaller than a part is put
+// in a single request, and larger bodies are uploaded in concurrent parts.
+func (u *Uploader) Upload(input *UploadInput) (*UploadOutput, error) {
+	return u.UploadWithContext(context.Background(), input)
+}
+
+// UploadWithContext is the same as Upload with the addition of a context.
+// Canceling the context aborts the upload.
+func (u *Uploader) UploadWithContext(ctx context.Context, input *UploadInput) (*UploadOutput, error) {
+	return u.upload(ctx, input, "")
+}
+
+// UploadWithCheckpoint is the same as Upload, but persists the state of a
+// multipart upload to the checkpoint file, so it can be resumed by calling
+// UploadWithCheckpoint again with the same body and checkpoint if it fails,
+// or the process is restarted.
+//
+// A resumed upload reads the whole body again, but only uploads the parts
+// not yet in S3, or whose ETag isn't the MD5 of their body. Failed uploads
+// are not aborted, whatever LeavePartsOnError is, and the checkpoint is
+// removed once the upload succeeds.
+func (u *Uploader) UploadWithCheckpoint(input *UploadInput, checkpoint string) (*UploadOutput, error) {
+	return u.UploadWithCheckpointContext(context.Background(), input, checkpoint)
+}
+
+// UploadWithCheckpointContext is the same as UploadWithCheckpoint with the
+// addition of a context. Canceling the context aborts the upload, which can
+// then be resumed.
+func (u *Uploader) UploadWithCheckpointContext(ctx context.Context, input *UploadInput, checkpoint string) (*UploadOutput, error) {
+	return u.upload(ctx, input, checkpoint)
+}
+
+// upload uploads input, with the checkpoint file if not empty.
+func (u *Uploader) upload(ctx context.Context, input *UploadInput, checkpointPath string) (*UploadOutput, error) {
+	up := &upload{u: u, ctx: ctx, in: input, total: bodySize(input.Body)}
+	up.partSize = u.PartSize
+	if up.partSize <= 0 {
+		up.partSize = DefaultUploadPartSize
+	}
+	if up.partSize < MinUploadPartSize {
+		up.partSize = MinUploadPartSize
+	}
+	if up.total > 0 {
+		if min := (up.total + MaxUploadParts - 1) / MaxUploadParts; up.partSize < min {
+			up.partSize = min
+		}
+	}
+
+	if checkpointPath != "" {
+		var bucket, key string
+		if input.Bucket != nil && input.Key != nil {
+			bucket, key = *input.Bucket, *input.Key
+		}
+		c, err := loadCheckpoint(checkpointPath, bucket, key)
+		if err != nil {
+			return nil, err
+		}
+		up.checkpoint = c
+		if err := up.resume(); err != nil {
+			return nil, err
+		}
+	}
+	return up.upload()
+}
+
+// bodySize returns the number of bytes left in body, or -1 if unknown.
+func bodySize(body io.Reader) int64 {
+	switch b := body.(type) {
+	case interface {
+		Len() int
+	}:
+		return int64(b.Len())
+	case io.Seeker:
+		start, err := b.Seek(0, 1)
+		if err != nil {
+			return -1
+		}
+		end, err := b.Seek(0, 2)
+		if err != nil {
+			return -1
+		}
+		if _, err := b.Seek(start, 0); err != nil {
+			return -1
+		}
+		return end - start
+	}
+	return -1
+}
+
+// upload is the state of an upload.
+type upload struct {
+	u        *Uploader
+	ctx      context.Context
+	in       *UploadInput
+	partSize int64
+	total    int64
+
+	// uploadID is the ID of the multipart upload, once created or resumed
+	uploadID string
+
+	// checkpoint is the persisted state of the upload, if any, and existing
+	// the ETags of the parts it already uploaded, by part number
+	checkpoint *checkpoint
+	existing   map[int64]string
+
+	m     sync.Mutex
+	sent  int64
+	parts []*s3.CompletedPart
+	err   error
+}
+
+// part is a part read from the body.
+type part struct {
+	number int64
+	buf    []byte
+	n      int
+}
+
+func (up *upload) upload() (*UploadOutput, error) {
+	if up.in.Body == nil {
+		return up.putObject(nil)
+	}
+
+	buf := make([]byte, up.partSize)
+	n, err := io.ReadFull(up.in.Body, buf)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		// resumed uploads are completed even with a single part
+		if up.uploadID == "" {
+			if up.checkpoint != nil {
+				up.checkpoint.remove()
+			}
+			return up.putObject(buf[:n])
+		}
+	} else if err != nil {
+		return nil, err
+	}
+	return up.multipartUpload(&part{number: 1, buf: buf, n: n})
+}
+
+// putObject puts an object with body in a single request.
+func (up *upload) putObject(body []byte) (*UploadOutput, error) {
+	in := up.in
+	out, err := up.u.S3.PutObjectWithContext(up.ctx, &s3.PutObjectInput{
+		ACL:                     in.ACL,
+		Body:                    bytes.NewReader(body),
+		Bucket:                  in.Bucket,
+		CacheControl:            in.CacheControl,
+		ContentDisposition:      in.ContentDisposition,
+		ContentEncoding:         in.ContentEncoding,
+		ContentLanguage:         in.ContentLanguage,
+		ContentLength:           aws.Long(int64(len(body))),
+		ContentType:             in.ContentType,
+		Expires:                 in.Expires,
+		GrantFullControl:        in.GrantFullControl,
+		GrantRead:               in.GrantRead,
+		GrantReadACP:            in.GrantReadACP,
+		GrantWriteACP:           in.GrantWriteACP,
+		Key:                     in.Key,
+		Metadata:                in.Metadata,
+		RequestPayer:            in.RequestPayer,
+		SSECustomerAlgorithm:    in.SSECustomerAlgorithm,
+		SSECustomerKey:          in.SSECustomerKey,
+		SSECustomerKeyMD5:       in.SSECustomerKeyMD5,
+		SSEKMSKeyID:             in.SSEKMSKeyID,
+		ServerSideEncryption:    in.ServerSideEncryption,
+		StorageClass:            in.StorageClass,
+		WebsiteRedirectLocation: in.WebsiteRedirectLocation,
+	})
+	if err != nil {
+		return nil, err
+	}
+	up.progress(int64(len(body)))
+	return &UploadOutput{ETag: out.ETag, VersionID: out.VersionID}, nil
+}
+
+// multipartUpload uploads the body in parts, starting with first.
+func (up *upload) multipartUpload(first *part) (*UploadOutput, error) {
+	if up.uploadID == "" {
+		if err := up.create(); err != nil {
+			return nil, err
+		}
+	}
+	uploadID := up.uploadID
+
+	// the first error cancels the parts in progress and stops reading
+	ctx, cancel := context.WithCancel(up.ctx)
+	defer cancel()
+
+	concurrency := up.u.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultUploadConcurrency
+	}
+	buffers := newBufferPool(concurrency, up.partSize)
+	buffers.add(first.buf)
+
+	parts := make(chan *part)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range parts {
+				if err := up.uploadPart(ctx, uploadID, p); err != nil {
+					up.fail(err)
+					cancel()
+				}
+				buffers.put(p.buf)
+			}
+		}()
+	}
+
+	up.readParts(ctx, first, buffers, parts)
+	close(parts)
+	wg.Wait()
+
+	if up.err == nil {
+		out, err := up.complete(uploadID)
+		if err == nil {
+			if up.checkpoint != nil {
+				up.checkpoint.remove()
+			}
+			return out, nil
+		}
+		up.err = err
+	}
+
+	if !up.u.LeavePartsOnError && up.checkpoint == nil {
+		// the upload's context may be canceled
+		up.u.S3.AbortMultipartUploadWithContext(context.Background(), &s3.AbortMultipartUploadInput{
+			Bucket:       up.in.Bucket,
+			Key:          up.in.Key,
+			RequestPayer: up.in.RequestPayer,
+			UploadID:     aws.String(uploadID),
+		})
+	}
+	return nil, &UploadError{Err: up.err, UploadID: uploadID}
+}
+
+// create creates the multipart upload, and checkpoints it.
+func (up *upload) create() error {
+	in := up.in
+	created, err := up.u.S3.CreateMultipartUploadWithContext(up.ctx, &s3.CreateMultipartUploadInput{
+		ACL:                     in.ACL,
+		Bucket:                  in.Bucket,
+		CacheControl:            in.CacheControl,
+		ContentDisposition:      in.ContentDisposition,
+		ContentEncoding:         in.ContentEncoding,
+		ContentLanguage:         in.ContentLanguage,
+		ContentType:             in.ContentType,
+		Expires:                 in.Expires,
+		GrantFullControl:        in.GrantFullControl,
+		GrantRead:               in.GrantRead,
+		GrantReadACP:            in.GrantReadACP,
+		GrantWriteACP:           in.GrantWriteACP,
+		Key:                     in.Key,
+		Metadata:                in.Metadata,
+		RequestPayer:            in.RequestPayer,
+		SSECustomerAlgorithm:    in.SSECustomerAlgorithm,
+		SSECustomerKey:          in.SSECustomerKey,
+		SSECustomerKeyMD5:       in.SSECustomerKeyMD5,
+		SSEKMSKeyID:             in.SSEKMSKeyID,
+		ServerSideEncryption:    in.ServerSideEncryption,
+		StorageClass:            in.StorageClass,
+		WebsiteRedirectLocation: in.WebsiteRedirectLocation,
+	})
+	if err != nil {
+		return err
+	}
+	up.uploadID = *created.UploadID
+
+	if c := up.checkpoint; c != nil {
+		c.UploadID = up.uploadID
+		c.PartSize = up.partSize
+		return c.save()
+	}
+	return nil
+}
+
+// readParts reads the parts of the body after first into buffers, and sends
+// them to parts until the end of the body, or an error.
+func (up *upload) readParts(ctx context.Context, first *part, buffers *bufferPool, parts chan<- *part) {
+	p := first
+	for {
+		select {
+		case parts <- p:
+		case <-ctx.Done():
+			buffers.put(p.buf)
+			up.fail(ctx.Err())
+			return
+		}
+		if p.n < len(p.buf) {
+			return
+		}
+
+		buf, err := buffers.get(ctx)
+		if err != nil {
+			up.fail(err)
+			return
+		}
+		n, err := io.ReadFull(up.in.Body, buf)
+		if err == io.EOF {
+			buffers.put(buf)
+			return
+		} else if err != nil && err != io.ErrUnexpectedEOF {
+			buffers.put(buf)
+			up.fail(err)
+			return
+		}
+
+		if p.number == MaxUploadParts {
+			buffers.put(buf)
+			up.fail(fmt.Errorf("body exceeds %d parts of %d bytes", MaxUploadParts, up.partSize))
+			return
+		}
+		p = &part{number: p.number + 1, buf: buf, n: n}
+	}
+}
+
+// uploadPart uploads p, trying again on errors up to PartRetries times.
+func (up *upload) uploadPart(ctx context.Context, uploadID string, p *part) error {
+	if etag, ok := up.existing[p.number]; ok && etag == partETag(p.buf[:p.n]) {
+		return up.completePart(p, etag)
+	}
+
+	retries := up.u.PartRetries
+	if retries == 0 {
+		retries = DefaultPartRetries
+	}
+
+	var err error
+	for attempt := 0; attempt == 0 || attempt <= retries; attempt++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var out *s3.UploadPartOutput
+		out, err = up.u.S3.UploadPartWithContext(ctx, &s3.UploadPartInput{
+			Body:                 bytes.NewReader(p.buf[:p.n]),
+			Bucket:               up.in.Bucket,
+			ContentLength:        aws.Long(int64(p.n)),
+			Key:                  up.in.Key,
+			PartNumber:           aws.Long(p.number),
+			RequestPayer:         up.in.RequestPayer,
+			SSECustomerAlgorithm: up.in.SSECustomerAlgorithm,
+			SSECustomerKey:       up.in.SSECustomerKey,
+			SSECustomerKeyMD5:    up.in.SSECustomerKeyMD5,
+			UploadID:             aws.String(uploadID),
+		})
+		if err == nil {
+			var etag string
+			if out.ETag != nil {
+				etag = *out.ETag
+			}
+			return up.completePart(p, etag)
+		}
+	}
+	return err
+}
+
+// completePart records p as uploaded with etag, and checkpoints it.
+func (up *upload) completePart(p *part, etag string) error {
+	up.m.Lock()
+	up.parts = append(up.parts, &s3.CompletedPart{ETag: aws.String(etag), PartNumber: aws.Long(p.number)})
+	var err error
+	if c := up.checkpoint; c != nil {
+		c.Parts = append(c.Parts, checkpointPart{PartNumber: p.number, ETag: etag, Size: int64(p.n)})
+		err = c.save()
+	}
+	up.m.Unlock()
+
+	up.progress(int64(p.n))
+	return err
+}
+
+// complete completes the upload with the uploaded parts.
+func (up *upload) complete(uploadID string) (*UploadOutput, error) {
+	sort.Sort(completedParts(up.parts))
+	out, err := up.u.S3.CompleteMultipartUploadWithContext(up.ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          up.in.Bucket,
+		Key:             up.in.Key,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: up.parts},
+		RequestPayer:    up.in.RequestPayer,
+		UploadID:        aws.String(uploadID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UploadOutput{UploadID: uploadID, ETag: out.ETag, VersionID: out.VersionID}
+	if out.Location != nil {
+		result.Location = *out.Location
+	}
+	return result, nil
+}
+
+// fail records the first error of the upload.
+func (up *upload) fail(err error) {
+	up.m.Lock()
+	defer up.m.Unlock()
+	if up.err == nil {
+		up.err = err
+	}
+}
+
+// progress records n more bytes sent, and reports the progress.
+func (up *upload) progress(n int64) {
+	up.m.Lock()
+	defer up.m.Unlock()
+	up.sent += n
+	if up.u.Progress != nil {
+		up.u.Progress(UploadProgress{BytesSent: up.sent, TotalBytes: up.total})
+	}
+}
+
+type completedParts []*s3.CompletedPart
+
+func (p completedParts) Len() int           { return len(p) }
+func (p completedParts) Less(i, j int) bool { return *p[i].PartNumber < *p[j].PartNumber }
+func (p completedParts) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+
+// bufferPool is a pool of at most size buffers, allocated as needed.
+type bufferPool struct {
+	free    chan []byte
+	tokens  chan struct{}
+	bufSize int64
+}
+
+func newBufferPool(size int, bufSize int64) *bufferPool {
+	return &bufferPool{
+		free:    make(chan []byte, size),
+		tokens:  make(chan struct{}, size),
+		bufSize: bufSize,
+	}
+}
+
+// add adds buf, allocated by the caller, to the buffers in use.
+func (p *bufferPool) add(buf []byte) {
+	p.tokens <- struct{}{}
+}
+
+// get returns a free buffer, waiting for one if they are all in use.
+func (p *bufferPool) get(ctx context.Context) ([]byte, error) {
+	select {
+	case buf := <-p.free:
+		return buf, nil
+	default:
+	}
+
+	select {
+	case buf := <-p.free:
+		return buf, nil
+	case p.tokens <- struct{}{}:
+		return make([]byte, p.bufSize), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// put returns buf to the pool.
+func (p *bufferPool) put(buf []byte) {
+	p.free <- buf
+}
@@ -0,0 +1,231 @@
+package s3manager_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+
+	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/service/s3"
+	"github.com/datacratic/aws-sdk-go/service/s3/s3iface"
+	"github.com/datacratic/aws-sdk-go/service/s3/s3manager"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockS3 records the multipart uploads made through it. The methods not
+// used by the managers panic.
+type mockS3 struct {
+	s3iface.S3API
+
+	m         sync.Mutex
+	ops       []string
+	puts      [][]byte
+	parts     map[int64][]byte
+	uploaded  []int64 // numbers of the uploaded parts
+	completed []*s3.CompletedPart
+	partFails map[int64]int // number of failures of parts before success
+}
+
+func newMockS3() *mockS3 {
+	return &mockS3{parts: map[int64][]byte{}, partFails: map[int64]int{}}
+}
+
+func (m *mockS3) record(op string) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.ops = append(m.ops, op)
+}
+
+func (m *mockS3) PutObjectWithContext(ctx context.Context, in *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	m.record("PutObject")
+	b, _ := ioutil.ReadAll(in.Body)
+	m.m.Lock()
+	m.puts = append(m.puts, b)
+	m.m.Unlock()
+	return &s3.PutObjectOutput{ETag: aws.String("etag")}, nil
+}
+
+func (m *mockS3) CreateMultipartUploadWithContext(ctx context.Context, in *s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error) {
+	m.record("CreateMultipartUpload")
+	return &s3.CreateMultipartUploadOutput{UploadID: aws.String("upload")}, nil
+}
+
+func (m *mockS3) UploadPartWithContext(ctx context.Context, in *s3.UploadPartInput) (*s3.UploadPartOutput, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	n := *in.PartNumber
+	if m.partFails[n] != 0 {
+		if m.partFails[n] > 0 {
+			m.partFails[n]--
+		}
+		return nil, aws.APIError{Code: "InternalError", Message: "part failed"}
+	}
+	b, _ := ioutil.ReadAll(in.Body)
+	m.parts[n] = b
+	m.uploaded = append(m.uploaded, n)
+	return &s3.UploadPartOutput{ETag: aws.String(etag(b))}, nil
+}
+
+func (m *mockS3) CompleteMultipartUploadWithContext(ctx context.Context, in *s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error) {
+	m.record("CompleteMultipartUpload")
+	m.completed = in.MultipartUpload.Parts
+	return &s3.CompleteMultipartUploadOutput{Location: aws.String("https://bucket.s3.amazonaws.com/key")}, nil
+}
+
+func (m *mockS3) AbortMultipartUploadWithContext(ctx context.Context, in *s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error) {
+	m.record("AbortMultipartUpload")
+	return &s3.AbortMultipartUploadOutput{}, nil
+}
+
+// etag returns the ETag S3 gives to a part with body b.
+func etag(b []byte) string {
+	sum := md5.Sum(b)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// onlyReader hides the other interfaces of a reader, such as Len and Seek.
+type onlyReader struct {
+	io.Reader
+}
+
+func newUploader(svc s3iface.S3API) *s3manager.Uploader {
+	u := s3manager.NewUploader(svc)
+	u.Concurrency = 3
+	return u
+}
+
+func body(size int) []byte {
+	b := make([]byte, size)
+	for i := range b {
+		b[i] = byte(i)
+	}
+	return b
+}
+
+func TestUploadSmallBody(t *testing.T) {
+	svc := newMockS3()
+	out, err := newUploader(svc).Upload(&s3manager.UploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   onlyReader{bytes.NewReader([]byte("payload"))},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "etag", *out.ETag)
+	assert.Equal(t, "", out.UploadID)
+	assert.Equal(t, []string{"PutObject"}, svc.ops)
+	assert.Equal(t, [][]byte{[]byte("payload")}, svc.puts)
+}
+
+func TestUploadMultipart(t *testing.T) {
+	svc := newMockS3()
+	data := body(int(2*s3manager.MinUploadPartSize + 100))
+	var progress []s3manager.UploadProgress
+	u := newUploader(svc)
+	u.Progress = func(p s3manager.UploadProgress) { progress = append(progress, p) }
+
+	out, err := u.Upload(&s3manager.UploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   onlyReader{bytes.NewReader(data)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "upload", out.UploadID)
+	assert.Equal(t, "https://bucket.s3.amazonaws.com/key", out.Location)
+	assert.Equal(t, []string{"CreateMultipartUpload", "CompleteMultipartUpload"}, svc.ops)
+
+	assert.Equal(t, 3, len(svc.parts))
+	assert.Equal(t, data, bytes.Join([][]byte{svc.parts[1], svc.parts[2], svc.parts[3]}, nil))
+	for i, p := range svc.completed {
+		assert.Equal(t, int64(i+1), *p.PartNumber)
+		assert.Equal(t, etag(svc.parts[int64(i+1)]), *p.ETag)
+	}
+
+	assert.Equal(t, 3, len(progress))
+	assert.Equal(t, s3manager.UploadProgress{BytesSent: int64(len(data)), TotalBytes: -1}, progress[2])
+}
+
+func TestUploadPartSizeOfKnownSize(t *testing.T) {
+	svc := newMockS3()
+	data := body(int(3 * s3manager.MinUploadPartSize))
+	u := newUploader(svc)
+	u.PartSize = s3manager.MinUploadPartSize
+
+	// the body fits exactly in parts
+	_, err := u.Upload(&s3manager.UploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader(data),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(svc.completed))
+}
+
+func TestUploadRetriesParts(t *testing.T) {
+	svc := newMockS3()
+	svc.partFails[2] = 2
+	_, err := newUploader(svc).Upload(&s3manager.UploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader(body(int(2 * s3manager.MinUploadPartSize))),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(svc.completed))
+}
+
+func TestUploadFailure(t *testing.T) {
+	svc := newMockS3()
+	svc.partFails[2] = -1
+	_, err := newUploader(svc).Upload(&s3manager.UploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   onlyReader{bytes.NewReader(body(int(4 * s3manager.MinUploadPartSize)))},
+	})
+	assert.Error(t, err)
+	uerr, ok := err.(*s3manager.UploadError)
+	assert.True(t, ok)
+	assert.Equal(t, "upload", uerr.UploadID)
+	assert.Equal(t, "InternalError", aws.Error(uerr.Err).Code)
+	assert.Equal(t, []string{"CreateMultipartUpload", "AbortMultipartUpload"}, svc.ops)
+
+	// failed uploads can be left for inspection
+	svc = newMockS3()
+	svc.partFails[1] = -1
+	u := newUploader(svc)
+	u.LeavePartsOnError = true
+	u.PartRetries = -1
+	_, err = u.Upload(&s3manager.UploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader(body(int(2 * s3manager.MinUploadPartSize))),
+	})
+	assert.Error(t, err)
+	assert.Equal(t, []string{"CreateMultipartUpload"}, svc.ops)
+}
+
+func TestUploadReadError(t *testing.T) {
+	svc := newMockS3()
+	readErr := errors.New("read failed")
+	body := io.MultiReader(bytes.NewReader(body(int(s3manager.MinUploadPartSize))), &errReader{readErr})
+	_, err := newUploader(svc).Upload(&s3manager.UploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   body,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, readErr, err.(*s3manager.UploadError).Err)
+	assert.Equal(t, []string{"CreateMultipartUpload", "AbortMultipartUpload"}, svc.ops)
+}
+
+type errReader struct {
+	err error
+}
+
+func (r *errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
@@ -27,6 +27,7 @@ func New(config *aws.Config) *S3 {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "s3",
 		APIVersion:  "2006-03-01",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
@@ -1,11 +1,14 @@
 package s3
 
 import (
+	"bytes"
 	"encoding/xml"
 	"io"
+	"io/ioutil"
 	"strings"
 
 	"github.com/datacratic/aws-sdk-go/aws"
+	"github.com/datacratic/aws-sdk-go/internal/protocol/xml/xmlutil"
 )
 
 type xmlErrorResponse struct {
@@ -27,15 +30,33 @@ func unmarshalError(r *aws.Request) {
 		return
 	}
 
+	bodyBytes, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	if err != nil {
+		r.Error = err
+		return
+	}
+
 	resp := &xmlErrorResponse{}
-	err := xml.NewDecoder(r.HTTPResponse.Body).Decode(resp)
+	err = xml.NewDecoder(bytes.NewReader(bodyBytes)).Decode(resp)
 	if err != nil && err != io.EOF {
 		r.Error = err
-	} else {
-		r.Error = aws.APIError{
-			StatusCode: r.HTTPResponse.StatusCode,
-			Code:       resp.Code,
-			Message:    resp.Message,
+		return
+	}
+
+	apiErr := aws.APIError{
+		StatusCode: r.HTTPResponse.StatusCode,
+		Code:       resp.Code,
+		Message:    resp.Message,
+	}
+
+	// the generic error is kept if the typed error can't be decoded
+	if e := r.Service.NewError(apiErr.Code); e != nil {
+		decoder := xml.NewDecoder(bytes.NewReader(bodyBytes))
+		if err := xmlutil.UnmarshalXML(e, decoder, "Error"); err == nil {
+			*e.BaseError() = apiErr
+			r.Error = e
+			return
 		}
 	}
+	r.Error = apiErr
 }
@@ -47,3 +47,29 @@ func TestStatusCodeError(t *testing.T) {
 		assert.Equal(t, test.message, aerr.Message)
 	}
 }
+
+func TestTypedError(t *testing.T) {
+	body := `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchBucket</Code><Message>The specified bucket does not exist</Message></Error>`
+
+	s := s3.New(baseConfig)
+	s.Handlers.Send.Clear()
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		r.HTTPResponse = &http.Response{
+			ContentLength: int64(len(body)),
+			StatusCode:    404,
+			Status:        "Not Found",
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		}
+	})
+	_, err := s.ListObjects(&s3.ListObjectsInput{Bucket: aws.String("bucket")})
+
+	assert.Error(t, err)
+	_, ok := err.(*s3.NoSuchBucket)
+	assert.True(t, ok)
+
+	aerr := aws.Error(err)
+	assert.Equal(t, 404, aerr.StatusCode)
+	assert.Equal(t, s3.ErrCodeNoSuchBucket, aerr.Code)
+	assert.Equal(t, "The specified bucket does not exist", aerr.Message)
+	assert.Equal(t, "NoSuchBucket: The specified bucket does not exist", err.Error())
+}
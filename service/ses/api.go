@@ -4,6 +4,7 @@
 package ses
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -46,6 +47,17 @@ func (c *SES) DeleteIdentity(input *DeleteIdentityInput) (output *DeleteIdentity
 	return
 }
 
+// DeleteIdentityWithContext is the same as DeleteIdentity with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) DeleteIdentityWithContext(ctx context.Context, input *DeleteIdentityInput) (output *DeleteIdentityOutput, err error) {
+	req, out := c.DeleteIdentityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteIdentity *aws.Operation
 
 // DeleteVerifiedEmailAddressRequest generates a request for the DeleteVerifiedEmailAddress operation.
@@ -83,6 +95,17 @@ func (c *SES) DeleteVerifiedEmailAddress(input *DeleteVerifiedEmailAddressInput)
 	return
 }
 
+// DeleteVerifiedEmailAddressWithContext is the same as DeleteVerifiedEmailAddress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) DeleteVerifiedEmailAddressWithContext(ctx context.Context, input *DeleteVerifiedEmailAddressInput) (output *DeleteVerifiedEmailAddressOutput, err error) {
+	req, out := c.DeleteVerifiedEmailAddressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteVerifiedEmailAddress *aws.Operation
 
 // GetIdentityDKIMAttributesRequest generates a request for the GetIdentityDKIMAttributes operation.
@@ -132,6 +155,17 @@ func (c *SES) GetIdentityDKIMAttributes(input *GetIdentityDKIMAttributesInput) (
 	return
 }
 
+// GetIdentityDKIMAttributesWithContext is the same as GetIdentityDKIMAttributes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) GetIdentityDKIMAttributesWithContext(ctx context.Context, input *GetIdentityDKIMAttributesInput) (output *GetIdentityDKIMAttributesOutput, err error) {
+	req, out := c.GetIdentityDKIMAttributesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetIdentityDKIMAttributes *aws.Operation
 
 // GetIdentityNotificationAttributesRequest generates a request for the GetIdentityNotificationAttributes operation.
@@ -171,6 +205,17 @@ func (c *SES) GetIdentityNotificationAttributes(input *GetIdentityNotificationAt
 	return
 }
 
+// GetIdentityNotificationAttributesWithContext is the same as GetIdentityNotificationAttributes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) GetIdentityNotificationAttributesWithContext(ctx context.Context, input *GetIdentityNotificationAttributesInput) (output *GetIdentityNotificationAttributesOutput, err error) {
+	req, out := c.GetIdentityNotificationAttributesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetIdentityNotificationAttributes *aws.Operation
 
 // GetIdentityVerificationAttributesRequest generates a request for the GetIdentityVerificationAttributes operation.
@@ -208,6 +253,17 @@ func (c *SES) GetIdentityVerificationAttributes(input *GetIdentityVerificationAt
 	return
 }
 
+// GetIdentityVerificationAttributesWithContext is the same as GetIdentityVerificationAttributes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) GetIdentityVerificationAttributesWithContext(ctx context.Context, input *GetIdentityVerificationAttributesInput) (output *GetIdentityVerificationAttributesOutput, err error) {
+	req, out := c.GetIdentityVerificationAttributesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetIdentityVerificationAttributes *aws.Operation
 
 // GetSendQuotaRequest generates a request for the GetSendQuota operation.
@@ -243,6 +299,17 @@ func (c *SES) GetSendQuota(input *GetSendQuotaInput) (output *GetSendQuotaOutput
 	return
 }
 
+// GetSendQuotaWithContext is the same as GetSendQuota with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) GetSendQuotaWithContext(ctx context.Context, input *GetSendQuotaInput) (output *GetSendQuotaOutput, err error) {
+	req, out := c.GetSendQuotaRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetSendQuota *aws.Operation
 
 // GetSendStatisticsRequest generates a request for the GetSendStatistics operation.
@@ -281,6 +348,17 @@ func (c *SES) GetSendStatistics(input *GetSendStatisticsInput) (output *GetSendS
 	return
 }
 
+// GetSendStatisticsWithContext is the same as GetSendStatistics with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) GetSendStatisticsWithContext(ctx context.Context, input *GetSendStatisticsInput) (output *GetSendStatisticsOutput, err error) {
+	req, out := c.GetSendStatisticsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetSendStatistics *aws.Operation
 
 // ListIdentitiesRequest generates a request for the ListIdentities operation.
@@ -293,6 +371,12 @@ func (c *SES) ListIdentitiesRequest(input *ListIdentitiesInput) (req *aws.Reques
 			Name:       "ListIdentities",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -317,6 +401,27 @@ func (c *SES) ListIdentities(input *ListIdentitiesInput) (output *ListIdentities
 	return
 }
 
+// ListIdentitiesWithContext is the same as ListIdentities with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) ListIdentitiesWithContext(ctx context.Context, input *ListIdentitiesInput) (output *ListIdentitiesOutput, err error) {
+	req, out := c.ListIdentitiesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListIdentitiesPages iterates over the pages of a ListIdentities operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *SES) ListIdentitiesPages(input *ListIdentitiesInput, fn func(p *ListIdentitiesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListIdentitiesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListIdentitiesOutput), lastPage)
+	})
+}
+
 var opListIdentities *aws.Operation
 
 // ListVerifiedEmailAddressesRequest generates a request for the ListVerifiedEmailAddresses operation.
@@ -354,6 +459,17 @@ func (c *SES) ListVerifiedEmailAddresses(input *ListVerifiedEmailAddressesInput)
 	return
 }
 
+// ListVerifiedEmailAddressesWithContext is the same as ListVerifiedEmailAddresses with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) ListVerifiedEmailAddressesWithContext(ctx context.Context, input *ListVerifiedEmailAddressesInput) (output *ListVerifiedEmailAddressesOutput, err error) {
+	req, out := c.ListVerifiedEmailAddressesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListVerifiedEmailAddresses *aws.Operation
 
 // SendEmailRequest generates a request for the SendEmail operation.
@@ -406,6 +522,17 @@ func (c *SES) SendEmail(input *SendEmailInput) (output *SendEmailOutput, err err
 	return
 }
 
+// SendEmailWithContext is the same as SendEmail with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) SendEmailWithContext(ctx context.Context, input *SendEmailInput) (output *SendEmailOutput, err error) {
+	req, out := c.SendEmailRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSendEmail *aws.Operation
 
 // SendRawEmailRequest generates a request for the SendRawEmail operation.
@@ -465,6 +592,17 @@ func (c *SES) SendRawEmail(input *SendRawEmailInput) (output *SendRawEmailOutput
 	return
 }
 
+// SendRawEmailWithContext is the same as SendRawEmail with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) SendRawEmailWithContext(ctx context.Context, input *SendRawEmailInput) (output *SendRawEmailOutput, err error) {
+	req, out := c.SendRawEmailRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSendRawEmail *aws.Operation
 
 // SetIdentityDKIMEnabledRequest generates a request for the SetIdentityDKIMEnabled operation.
@@ -511,6 +649,17 @@ func (c *SES) SetIdentityDKIMEnabled(input *SetIdentityDKIMEnabledInput) (output
 	return
 }
 
+// SetIdentityDKIMEnabledWithContext is the same as SetIdentityDKIMEnabled with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) SetIdentityDKIMEnabledWithContext(ctx context.Context, input *SetIdentityDKIMEnabledInput) (output *SetIdentityDKIMEnabledOutput, err error) {
+	req, out := c.SetIdentityDKIMEnabledRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetIdentityDKIMEnabled *aws.Operation
 
 // SetIdentityFeedbackForwardingEnabledRequest generates a request for the SetIdentityFeedbackForwardingEnabled operation.
@@ -552,6 +701,17 @@ func (c *SES) SetIdentityFeedbackForwardingEnabled(input *SetIdentityFeedbackFor
 	return
 }
 
+// SetIdentityFeedbackForwardingEnabledWithContext is the same as SetIdentityFeedbackForwardingEnabled with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) SetIdentityFeedbackForwardingEnabledWithContext(ctx context.Context, input *SetIdentityFeedbackForwardingEnabledInput) (output *SetIdentityFeedbackForwardingEnabledOutput, err error) {
+	req, out := c.SetIdentityFeedbackForwardingEnabledRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetIdentityFeedbackForwardingEnabled *aws.Operation
 
 // SetIdentityNotificationTopicRequest generates a request for the SetIdentityNotificationTopic operation.
@@ -592,6 +752,17 @@ func (c *SES) SetIdentityNotificationTopic(input *SetIdentityNotificationTopicIn
 	return
 }
 
+// SetIdentityNotificationTopicWithContext is the same as SetIdentityNotificationTopic with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) SetIdentityNotificationTopicWithContext(ctx context.Context, input *SetIdentityNotificationTopicInput) (output *SetIdentityNotificationTopicOutput, err error) {
+	req, out := c.SetIdentityNotificationTopicRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetIdentityNotificationTopic *aws.Operation
 
 // VerifyDomainDKIMRequest generates a request for the VerifyDomainDKIM operation.
@@ -639,6 +810,17 @@ func (c *SES) VerifyDomainDKIM(input *VerifyDomainDKIMInput) (output *VerifyDoma
 	return
 }
 
+// VerifyDomainDKIMWithContext is the same as VerifyDomainDKIM with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) VerifyDomainDKIMWithContext(ctx context.Context, input *VerifyDomainDKIMInput) (output *VerifyDomainDKIMOutput, err error) {
+	req, out := c.VerifyDomainDKIMRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opVerifyDomainDKIM *aws.Operation
 
 // VerifyDomainIdentityRequest generates a request for the VerifyDomainIdentity operation.
@@ -674,6 +856,17 @@ func (c *SES) VerifyDomainIdentity(input *VerifyDomainIdentityInput) (output *Ve
 	return
 }
 
+// VerifyDomainIdentityWithContext is the same as VerifyDomainIdentity with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) VerifyDomainIdentityWithContext(ctx context.Context, input *VerifyDomainIdentityInput) (output *VerifyDomainIdentityOutput, err error) {
+	req, out := c.VerifyDomainIdentityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opVerifyDomainIdentity *aws.Operation
 
 // VerifyEmailAddressRequest generates a request for the VerifyEmailAddress operation.
@@ -712,6 +905,17 @@ func (c *SES) VerifyEmailAddress(input *VerifyEmailAddressInput) (output *Verify
 	return
 }
 
+// VerifyEmailAddressWithContext is the same as VerifyEmailAddress with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) VerifyEmailAddressWithContext(ctx context.Context, input *VerifyEmailAddressInput) (output *VerifyEmailAddressOutput, err error) {
+	req, out := c.VerifyEmailAddressRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opVerifyEmailAddress *aws.Operation
 
 // VerifyEmailIdentityRequest generates a request for the VerifyEmailIdentity operation.
@@ -748,6 +952,17 @@ func (c *SES) VerifyEmailIdentity(input *VerifyEmailIdentityInput) (output *Veri
 	return
 }
 
+// VerifyEmailIdentityWithContext is the same as VerifyEmailIdentity with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SES) VerifyEmailIdentityWithContext(ctx context.Context, input *VerifyEmailIdentityInput) (output *VerifyEmailIdentityOutput, err error) {
+	req, out := c.VerifyEmailIdentityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opVerifyEmailIdentity *aws.Operation
 
 // Represents the body of the message. You can specify text, HTML, or both.
@@ -1007,7 +1222,7 @@ type IdentityDKIMAttributes struct {
 	// Describes whether Amazon SES has successfully verified the DKIM DNS records
 	// (tokens) published in the domain name's DNS. (This only applies to domain
 	// identities, not email address identities.)
-	DKIMVerificationStatus *string `locationName:"DkimVerificationStatus" type:"string" required:"true"`
+	DKIMVerificationStatus *string `locationName:"DkimVerificationStatus" type:"string" enum:"Pending,Success,Failed,TemporaryFailure,NotStarted" required:"true"`
 
 	metadataIdentityDKIMAttributes `json:"-", xml:"-"`
 }
@@ -1050,7 +1265,7 @@ type metadataIdentityNotificationAttributes struct {
 type IdentityVerificationAttributes struct {
 	// The verification status of the identity: "Pending", "Success", "Failed",
 	// or "TemporaryFailure".
-	VerificationStatus *string `type:"string" required:"true"`
+	VerificationStatus *string `type:"string" enum:"Pending,Success,Failed,TemporaryFailure,NotStarted" required:"true"`
 
 	// The verification token for a domain identity. Null for email address identities.
 	VerificationToken *string `type:"string"`
@@ -1067,7 +1282,7 @@ type metadataIdentityVerificationAttributes struct {
 type ListIdentitiesInput struct {
 	// The type of the identities to list. Possible values are "EmailAddress" and
 	// "Domain". If this parameter is omitted, then all identities will be listed.
-	IdentityType *string `type:"string"`
+	IdentityType *string `type:"string" enum:"EmailAddress,Domain"`
 
 	// The maximum number of identities per page. Possible values are 1-100 inclusive.
 	MaxItems *int64 `type:"integer"`
@@ -1338,7 +1553,7 @@ type SetIdentityNotificationTopicInput struct {
 
 	// The type of notifications that will be published to the specified Amazon
 	// SNS topic.
-	NotificationType *string `type:"string" required:"true"`
+	NotificationType *string `type:"string" enum:"Bounce,Complaint,Delivery" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the Amazon SNS topic. If the parameter
 	// is omitted from the request or a null value is passed, SnsTopic is cleared
@@ -1463,4 +1678,54 @@ type VerifyEmailIdentityOutput struct {
 
 type metadataVerifyEmailIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for IdentityType.
+const (
+	IdentityTypeEmailAddress = "EmailAddress"
+	IdentityTypeDomain       = "Domain"
+)
+
+// IdentityTypeValues returns the valid values of IdentityType.
+func IdentityTypeValues() []string {
+	return []string{
+		IdentityTypeEmailAddress,
+		IdentityTypeDomain,
+	}
+}
+
+// Enum values for NotificationType.
+const (
+	NotificationTypeBounce    = "Bounce"
+	NotificationTypeComplaint = "Complaint"
+	NotificationTypeDelivery  = "Delivery"
+)
+
+// NotificationTypeValues returns the valid values of NotificationType.
+func NotificationTypeValues() []string {
+	return []string{
+		NotificationTypeBounce,
+		NotificationTypeComplaint,
+		NotificationTypeDelivery,
+	}
+}
+
+// Enum values for VerificationStatus.
+const (
+	VerificationStatusPending          = "Pending"
+	VerificationStatusSuccess          = "Success"
+	VerificationStatusFailed           = "Failed"
+	VerificationStatusTemporaryFailure = "TemporaryFailure"
+	VerificationStatusNotStarted       = "NotStarted"
+)
+
+// VerificationStatusValues returns the valid values of VerificationStatus.
+func VerificationStatusValues() []string {
+	return []string{
+		VerificationStatusPending,
+		VerificationStatusSuccess,
+		VerificationStatusFailed,
+		VerificationStatusTemporaryFailure,
+		VerificationStatusNotStarted,
+	}
 }
\ No newline at end of file
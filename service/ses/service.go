@@ -27,6 +27,7 @@ func New(config *aws.Config) *SES {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "email",
 		APIVersion:  "2010-12-01",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
@@ -1,43 +1,84 @@
 package sesiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/ses"
 )
 
 type SESAPI interface {
 	DeleteIdentity(*ses.DeleteIdentityInput) (*ses.DeleteIdentityOutput, error)
 
+	DeleteIdentityWithContext(context.Context, *ses.DeleteIdentityInput) (*ses.DeleteIdentityOutput, error)
+
 	DeleteVerifiedEmailAddress(*ses.DeleteVerifiedEmailAddressInput) (*ses.DeleteVerifiedEmailAddressOutput, error)
 
+	DeleteVerifiedEmailAddressWithContext(context.Context, *ses.DeleteVerifiedEmailAddressInput) (*ses.DeleteVerifiedEmailAddressOutput, error)
+
 	GetIdentityDKIMAttributes(*ses.GetIdentityDKIMAttributesInput) (*ses.GetIdentityDKIMAttributesOutput, error)
 
+	GetIdentityDKIMAttributesWithContext(context.Context, *ses.GetIdentityDKIMAttributesInput) (*ses.GetIdentityDKIMAttributesOutput, error)
+
 	GetIdentityNotificationAttributes(*ses.GetIdentityNotificationAttributesInput) (*ses.GetIdentityNotificationAttributesOutput, error)
 
+	GetIdentityNotificationAttributesWithContext(context.Context, *ses.GetIdentityNotificationAttributesInput) (*ses.GetIdentityNotificationAttributesOutput, error)
+
 	GetIdentityVerificationAttributes(*ses.GetIdentityVerificationAttributesInput) (*ses.GetIdentityVerificationAttributesOutput, error)
 
+	GetIdentityVerificationAttributesWithContext(context.Context, *ses.GetIdentityVerificationAttributesInput) (*ses.GetIdentityVerificationAttributesOutput, error)
+
 	GetSendQuota(*ses.GetSendQuotaInput) (*ses.GetSendQuotaOutput, error)
 
+	GetSendQuotaWithContext(context.Context, *ses.GetSendQuotaInput) (*ses.GetSendQuotaOutput, error)
+
 	GetSendStatistics(*ses.GetSendStatisticsInput) (*ses.GetSendStatisticsOutput, error)
 
+	GetSendStatisticsWithContext(context.Context, *ses.GetSendStatisticsInput) (*ses.GetSendStatisticsOutput, error)
+
 	ListIdentities(*ses.ListIdentitiesInput) (*ses.ListIdentitiesOutput, error)
 
+	ListIdentitiesWithContext(context.Context, *ses.ListIdentitiesInput) (*ses.ListIdentitiesOutput, error)
+	ListIdentitiesPages(*ses.ListIdentitiesInput, func(*ses.ListIdentitiesOutput, bool) bool) error
+
 	ListVerifiedEmailAddresses(*ses.ListVerifiedEmailAddressesInput) (*ses.ListVerifiedEmailAddressesOutput, error)
 
+	ListVerifiedEmailAddressesWithContext(context.Context, *ses.ListVerifiedEmailAddressesInput) (*ses.ListVerifiedEmailAddressesOutput, error)
+
 	SendEmail(*ses.SendEmailInput) (*ses.SendEmailOutput, error)
 
+	SendEmailWithContext(context.Context, *ses.SendEmailInput) (*ses.SendEmailOutput, error)
+
 	SendRawEmail(*ses.SendRawEmailInput) (*ses.SendRawEmailOutput, error)
 
+	SendRawEmailWithContext(context.Context, *ses.SendRawEmailInput) (*ses.SendRawEmailOutput, error)
+
 	SetIdentityDKIMEnabled(*ses.SetIdentityDKIMEnabledInput) (*ses.SetIdentityDKIMEnabledOutput, error)
 
+	SetIdentityDKIMEnabledWithContext(context.Context, *ses.SetIdentityDKIMEnabledInput) (*ses.SetIdentityDKIMEnabledOutput, error)
+
 	SetIdentityFeedbackForwardingEnabled(*ses.SetIdentityFeedbackForwardingEnabledInput) (*ses.SetIdentityFeedbackForwardingEnabledOutput, error)
 
+	SetIdentityFeedbackForwardingEnabledWithContext(context.Context, *ses.SetIdentityFeedbackForwardingEnabledInput) (*ses.SetIdentityFeedbackForwardingEnabledOutput, error)
+
 	SetIdentityNotificationTopic(*ses.SetIdentityNotificationTopicInput) (*ses.SetIdentityNotificationTopicOutput, error)
 
+	SetIdentityNotificationTopicWithContext(context.Context, *ses.SetIdentityNotificationTopicInput) (*ses.SetIdentityNotificationTopicOutput, error)
+
 	VerifyDomainDKIM(*ses.VerifyDomainDKIMInput) (*ses.VerifyDomainDKIMOutput, error)
 
+	VerifyDomainDKIMWithContext(context.Context, *ses.VerifyDomainDKIMInput) (*ses.VerifyDomainDKIMOutput, error)
+
 	VerifyDomainIdentity(*ses.VerifyDomainIdentityInput) (*ses.VerifyDomainIdentityOutput, error)
 
+	VerifyDomainIdentityWithContext(context.Context, *ses.VerifyDomainIdentityInput) (*ses.VerifyDomainIdentityOutput, error)
+
 	VerifyEmailAddress(*ses.VerifyEmailAddressInput) (*ses.VerifyEmailAddressOutput, error)
 
+	VerifyEmailAddressWithContext(context.Context, *ses.VerifyEmailAddressInput) (*ses.VerifyEmailAddressOutput, error)
+
 	VerifyEmailIdentity(*ses.VerifyEmailIdentityInput) (*ses.VerifyEmailIdentityOutput, error)
+
+	VerifyEmailIdentityWithContext(context.Context, *ses.VerifyEmailIdentityInput) (*ses.VerifyEmailIdentityOutput, error)
+
+	WaitUntilIdentityExists(*ses.GetIdentityVerificationAttributesInput) error
 }
\ No newline at end of file
@@ -4,6 +4,7 @@
 package sns
 
 import (
+	"context"
 	"sync"
 
 	"github.com/datacratic/aws-sdk-go/aws"
@@ -43,6 +44,17 @@ func (c *SNS) AddPermission(input *AddPermissionInput) (output *AddPermissionOut
 	return
 }
 
+// AddPermissionWithContext is the same as AddPermission with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) AddPermissionWithContext(ctx context.Context, input *AddPermissionInput) (output *AddPermissionOutput, err error) {
+	req, out := c.AddPermissionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddPermission *aws.Operation
 
 // ConfirmSubscriptionRequest generates a request for the ConfirmSubscription operation.
@@ -80,6 +92,17 @@ func (c *SNS) ConfirmSubscription(input *ConfirmSubscriptionInput) (output *Conf
 	return
 }
 
+// ConfirmSubscriptionWithContext is the same as ConfirmSubscription with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) ConfirmSubscriptionWithContext(ctx context.Context, input *ConfirmSubscriptionInput) (output *ConfirmSubscriptionOutput, err error) {
+	req, out := c.ConfirmSubscriptionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opConfirmSubscription *aws.Operation
 
 // CreatePlatformApplicationRequest generates a request for the CreatePlatformApplication operation.
@@ -125,6 +148,17 @@ func (c *SNS) CreatePlatformApplication(input *CreatePlatformApplicationInput) (
 	return
 }
 
+// CreatePlatformApplicationWithContext is the same as CreatePlatformApplication with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) CreatePlatformApplicationWithContext(ctx context.Context, input *CreatePlatformApplicationInput) (output *CreatePlatformApplicationOutput, err error) {
+	req, out := c.CreatePlatformApplicationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreatePlatformApplication *aws.Operation
 
 // CreatePlatformEndpointRequest generates a request for the CreatePlatformEndpoint operation.
@@ -171,6 +205,17 @@ func (c *SNS) CreatePlatformEndpoint(input *CreatePlatformEndpointInput) (output
 	return
 }
 
+// CreatePlatformEndpointWithContext is the same as CreatePlatformEndpoint with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) CreatePlatformEndpointWithContext(ctx context.Context, input *CreatePlatformEndpointInput) (output *CreatePlatformEndpointOutput, err error) {
+	req, out := c.CreatePlatformEndpointRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreatePlatformEndpoint *aws.Operation
 
 // CreateTopicRequest generates a request for the CreateTopic operation.
@@ -208,6 +253,17 @@ func (c *SNS) CreateTopic(input *CreateTopicInput) (output *CreateTopicOutput, e
 	return
 }
 
+// CreateTopicWithContext is the same as CreateTopic with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) CreateTopicWithContext(ctx context.Context, input *CreateTopicInput) (output *CreateTopicOutput, err error) {
+	req, out := c.CreateTopicRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateTopic *aws.Operation
 
 // DeleteEndpointRequest generates a request for the DeleteEndpoint operation.
@@ -242,6 +298,17 @@ func (c *SNS) DeleteEndpoint(input *DeleteEndpointInput) (output *DeleteEndpoint
 	return
 }
 
+// DeleteEndpointWithContext is the same as DeleteEndpoint with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) DeleteEndpointWithContext(ctx context.Context, input *DeleteEndpointInput) (output *DeleteEndpointOutput, err error) {
+	req, out := c.DeleteEndpointRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteEndpoint *aws.Operation
 
 // DeletePlatformApplicationRequest generates a request for the DeletePlatformApplication operation.
@@ -277,6 +344,17 @@ func (c *SNS) DeletePlatformApplication(input *DeletePlatformApplicationInput) (
 	return
 }
 
+// DeletePlatformApplicationWithContext is the same as DeletePlatformApplication with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) DeletePlatformApplicationWithContext(ctx context.Context, input *DeletePlatformApplicationInput) (output *DeletePlatformApplicationOutput, err error) {
+	req, out := c.DeletePlatformApplicationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeletePlatformApplication *aws.Operation
 
 // DeleteTopicRequest generates a request for the DeleteTopic operation.
@@ -313,6 +391,17 @@ func (c *SNS) DeleteTopic(input *DeleteTopicInput) (output *DeleteTopicOutput, e
 	return
 }
 
+// DeleteTopicWithContext is the same as DeleteTopic with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) DeleteTopicWithContext(ctx context.Context, input *DeleteTopicInput) (output *DeleteTopicOutput, err error) {
+	req, out := c.DeleteTopicRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteTopic *aws.Operation
 
 // GetEndpointAttributesRequest generates a request for the GetEndpointAttributes operation.
@@ -348,6 +437,17 @@ func (c *SNS) GetEndpointAttributes(input *GetEndpointAttributesInput) (output *
 	return
 }
 
+// GetEndpointAttributesWithContext is the same as GetEndpointAttributes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) GetEndpointAttributesWithContext(ctx context.Context, input *GetEndpointAttributesInput) (output *GetEndpointAttributesOutput, err error) {
+	req, out := c.GetEndpointAttributesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetEndpointAttributes *aws.Operation
 
 // GetPlatformApplicationAttributesRequest generates a request for the GetPlatformApplicationAttributes operation.
@@ -383,6 +483,17 @@ func (c *SNS) GetPlatformApplicationAttributes(input *GetPlatformApplicationAttr
 	return
 }
 
+// GetPlatformApplicationAttributesWithContext is the same as GetPlatformApplicationAttributes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) GetPlatformApplicationAttributesWithContext(ctx context.Context, input *GetPlatformApplicationAttributesInput) (output *GetPlatformApplicationAttributesOutput, err error) {
+	req, out := c.GetPlatformApplicationAttributesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetPlatformApplicationAttributes *aws.Operation
 
 // GetSubscriptionAttributesRequest generates a request for the GetSubscriptionAttributes operation.
@@ -416,6 +527,17 @@ func (c *SNS) GetSubscriptionAttributes(input *GetSubscriptionAttributesInput) (
 	return
 }
 
+// GetSubscriptionAttributesWithContext is the same as GetSubscriptionAttributes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) GetSubscriptionAttributesWithContext(ctx context.Context, input *GetSubscriptionAttributesInput) (output *GetSubscriptionAttributesOutput, err error) {
+	req, out := c.GetSubscriptionAttributesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetSubscriptionAttributes *aws.Operation
 
 // GetTopicAttributesRequest generates a request for the GetTopicAttributes operation.
@@ -450,6 +572,17 @@ func (c *SNS) GetTopicAttributes(input *GetTopicAttributesInput) (output *GetTop
 	return
 }
 
+// GetTopicAttributesWithContext is the same as GetTopicAttributes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) GetTopicAttributesWithContext(ctx context.Context, input *GetTopicAttributesInput) (output *GetTopicAttributesOutput, err error) {
+	req, out := c.GetTopicAttributesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetTopicAttributes *aws.Operation
 
 // ListEndpointsByPlatformApplicationRequest generates a request for the ListEndpointsByPlatformApplication operation.
@@ -462,6 +595,12 @@ func (c *SNS) ListEndpointsByPlatformApplicationRequest(input *ListEndpointsByPl
 			Name:       "ListEndpointsByPlatformApplication",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -490,6 +629,27 @@ func (c *SNS) ListEndpointsByPlatformApplication(input *ListEndpointsByPlatformA
 	return
 }
 
+// ListEndpointsByPlatformApplicationWithContext is the same as ListEndpointsByPlatformApplication with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) ListEndpointsByPlatformApplicationWithContext(ctx context.Context, input *ListEndpointsByPlatformApplicationInput) (output *ListEndpointsByPlatformApplicationOutput, err error) {
+	req, out := c.ListEndpointsByPlatformApplicationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListEndpointsByPlatformApplicationPages iterates over the pages of a ListEndpointsByPlatformApplication operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *SNS) ListEndpointsByPlatformApplicationPages(input *ListEndpointsByPlatformApplicationInput, fn func(p *ListEndpointsByPlatformApplicationOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListEndpointsByPlatformApplicationRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListEndpointsByPlatformApplicationOutput), lastPage)
+	})
+}
+
 var opListEndpointsByPlatformApplication *aws.Operation
 
 // ListPlatformApplicationsRequest generates a request for the ListPlatformApplications operation.
@@ -502,6 +662,12 @@ func (c *SNS) ListPlatformApplicationsRequest(input *ListPlatformApplicationsInp
 			Name:       "ListPlatformApplications",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -530,6 +696,27 @@ func (c *SNS) ListPlatformApplications(input *ListPlatformApplicationsInput) (ou
 	return
 }
 
+// ListPlatformApplicationsWithContext is the same as ListPlatformApplications with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) ListPlatformApplicationsWithContext(ctx context.Context, input *ListPlatformApplicationsInput) (output *ListPlatformApplicationsOutput, err error) {
+	req, out := c.ListPlatformApplicationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListPlatformApplicationsPages iterates over the pages of a ListPlatformApplications operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *SNS) ListPlatformApplicationsPages(input *ListPlatformApplicationsInput, fn func(p *ListPlatformApplicationsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListPlatformApplicationsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListPlatformApplicationsOutput), lastPage)
+	})
+}
+
 var opListPlatformApplications *aws.Operation
 
 // ListSubscriptionsRequest generates a request for the ListSubscriptions operation.
@@ -542,6 +729,12 @@ func (c *SNS) ListSubscriptionsRequest(input *ListSubscriptionsInput) (req *aws.
 			Name:       "ListSubscriptions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -566,6 +759,27 @@ func (c *SNS) ListSubscriptions(input *ListSubscriptionsInput) (output *ListSubs
 	return
 }
 
+// ListSubscriptionsWithContext is the same as ListSubscriptions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) ListSubscriptionsWithContext(ctx context.Context, input *ListSubscriptionsInput) (output *ListSubscriptionsOutput, err error) {
+	req, out := c.ListSubscriptionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListSubscriptionsPages iterates over the pages of a ListSubscriptions operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *SNS) ListSubscriptionsPages(input *ListSubscriptionsInput, fn func(p *ListSubscriptionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListSubscriptionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListSubscriptionsOutput), lastPage)
+	})
+}
+
 var opListSubscriptions *aws.Operation
 
 // ListSubscriptionsByTopicRequest generates a request for the ListSubscriptionsByTopic operation.
@@ -578,6 +792,12 @@ func (c *SNS) ListSubscriptionsByTopicRequest(input *ListSubscriptionsByTopicInp
 			Name:       "ListSubscriptionsByTopic",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -602,6 +822,27 @@ func (c *SNS) ListSubscriptionsByTopic(input *ListSubscriptionsByTopicInput) (ou
 	return
 }
 
+// ListSubscriptionsByTopicWithContext is the same as ListSubscriptionsByTopic with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) ListSubscriptionsByTopicWithContext(ctx context.Context, input *ListSubscriptionsByTopicInput) (output *ListSubscriptionsByTopicOutput, err error) {
+	req, out := c.ListSubscriptionsByTopicRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListSubscriptionsByTopicPages iterates over the pages of a ListSubscriptionsByTopic operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *SNS) ListSubscriptionsByTopicPages(input *ListSubscriptionsByTopicInput, fn func(p *ListSubscriptionsByTopicOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListSubscriptionsByTopicRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListSubscriptionsByTopicOutput), lastPage)
+	})
+}
+
 var opListSubscriptionsByTopic *aws.Operation
 
 // ListTopicsRequest generates a request for the ListTopics operation.
@@ -614,6 +855,12 @@ func (c *SNS) ListTopicsRequest(input *ListTopicsInput) (req *aws.Request, outpu
 			Name:       "ListTopics",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -637,6 +884,27 @@ func (c *SNS) ListTopics(input *ListTopicsInput) (output *ListTopicsOutput, err
 	return
 }
 
+// ListTopicsWithContext is the same as ListTopics with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) ListTopicsWithContext(ctx context.Context, input *ListTopicsInput) (output *ListTopicsOutput, err error) {
+	req, out := c.ListTopicsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListTopicsPages iterates over the pages of a ListTopics operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *SNS) ListTopicsPages(input *ListTopicsInput, fn func(p *ListTopicsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListTopicsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListTopicsOutput), lastPage)
+	})
+}
+
 var opListTopics *aws.Operation
 
 // PublishRequest generates a request for the Publish operation.
@@ -679,6 +947,17 @@ func (c *SNS) Publish(input *PublishInput) (output *PublishOutput, err error) {
 	return
 }
 
+// PublishWithContext is the same as Publish with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) PublishWithContext(ctx context.Context, input *PublishInput) (output *PublishOutput, err error) {
+	req, out := c.PublishRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPublish *aws.Operation
 
 // RemovePermissionRequest generates a request for the RemovePermission operation.
@@ -712,6 +991,17 @@ func (c *SNS) RemovePermission(input *RemovePermissionInput) (output *RemovePerm
 	return
 }
 
+// RemovePermissionWithContext is the same as RemovePermission with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) RemovePermissionWithContext(ctx context.Context, input *RemovePermissionInput) (output *RemovePermissionOutput, err error) {
+	req, out := c.RemovePermissionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRemovePermission *aws.Operation
 
 // SetEndpointAttributesRequest generates a request for the SetEndpointAttributes operation.
@@ -747,6 +1037,17 @@ func (c *SNS) SetEndpointAttributes(input *SetEndpointAttributesInput) (output *
 	return
 }
 
+// SetEndpointAttributesWithContext is the same as SetEndpointAttributes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) SetEndpointAttributesWithContext(ctx context.Context, input *SetEndpointAttributesInput) (output *SetEndpointAttributesOutput, err error) {
+	req, out := c.SetEndpointAttributesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetEndpointAttributes *aws.Operation
 
 // SetPlatformApplicationAttributesRequest generates a request for the SetPlatformApplicationAttributes operation.
@@ -782,6 +1083,17 @@ func (c *SNS) SetPlatformApplicationAttributes(input *SetPlatformApplicationAttr
 	return
 }
 
+// SetPlatformApplicationAttributesWithContext is the same as SetPlatformApplicationAttributes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) SetPlatformApplicationAttributesWithContext(ctx context.Context, input *SetPlatformApplicationAttributesInput) (output *SetPlatformApplicationAttributesOutput, err error) {
+	req, out := c.SetPlatformApplicationAttributesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetPlatformApplicationAttributes *aws.Operation
 
 // SetSubscriptionAttributesRequest generates a request for the SetSubscriptionAttributes operation.
@@ -815,6 +1127,17 @@ func (c *SNS) SetSubscriptionAttributes(input *SetSubscriptionAttributesInput) (
 	return
 }
 
+// SetSubscriptionAttributesWithContext is the same as SetSubscriptionAttributes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) SetSubscriptionAttributesWithContext(ctx context.Context, input *SetSubscriptionAttributesInput) (output *SetSubscriptionAttributesOutput, err error) {
+	req, out := c.SetSubscriptionAttributesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetSubscriptionAttributes *aws.Operation
 
 // SetTopicAttributesRequest generates a request for the SetTopicAttributes operation.
@@ -848,6 +1171,17 @@ func (c *SNS) SetTopicAttributes(input *SetTopicAttributesInput) (output *SetTop
 	return
 }
 
+// SetTopicAttributesWithContext is the same as SetTopicAttributes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) SetTopicAttributesWithContext(ctx context.Context, input *SetTopicAttributesInput) (output *SetTopicAttributesOutput, err error) {
+	req, out := c.SetTopicAttributesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetTopicAttributes *aws.Operation
 
 // SubscribeRequest generates a request for the Subscribe operation.
@@ -884,6 +1218,17 @@ func (c *SNS) Subscribe(input *SubscribeInput) (output *SubscribeOutput, err err
 	return
 }
 
+// SubscribeWithContext is the same as Subscribe with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) SubscribeWithContext(ctx context.Context, input *SubscribeInput) (output *SubscribeOutput, err error) {
+	req, out := c.SubscribeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSubscribe *aws.Operation
 
 // UnsubscribeRequest generates a request for the Unsubscribe operation.
@@ -922,6 +1267,17 @@ func (c *SNS) Unsubscribe(input *UnsubscribeInput) (output *UnsubscribeOutput, e
 	return
 }
 
+// UnsubscribeWithContext is the same as Unsubscribe with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SNS) UnsubscribeWithContext(ctx context.Context, input *UnsubscribeInput) (output *UnsubscribeOutput, err error) {
+	req, out := c.UnsubscribeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUnsubscribe *aws.Operation
 
 type AddPermissionInput struct {
@@ -27,6 +27,7 @@ func New(config *aws.Config) *SNS {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "sns",
 		APIVersion:  "2010-03-31",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
@@ -1,57 +1,114 @@
 package snsiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/sns"
 )
 
 type SNSAPI interface {
 	AddPermission(*sns.AddPermissionInput) (*sns.AddPermissionOutput, error)
 
+	AddPermissionWithContext(context.Context, *sns.AddPermissionInput) (*sns.AddPermissionOutput, error)
+
 	ConfirmSubscription(*sns.ConfirmSubscriptionInput) (*sns.ConfirmSubscriptionOutput, error)
 
+	ConfirmSubscriptionWithContext(context.Context, *sns.ConfirmSubscriptionInput) (*sns.ConfirmSubscriptionOutput, error)
+
 	CreatePlatformApplication(*sns.CreatePlatformApplicationInput) (*sns.CreatePlatformApplicationOutput, error)
 
+	CreatePlatformApplicationWithContext(context.Context, *sns.CreatePlatformApplicationInput) (*sns.CreatePlatformApplicationOutput, error)
+
 	CreatePlatformEndpoint(*sns.CreatePlatformEndpointInput) (*sns.CreatePlatformEndpointOutput, error)
 
+	CreatePlatformEndpointWithContext(context.Context, *sns.CreatePlatformEndpointInput) (*sns.CreatePlatformEndpointOutput, error)
+
 	CreateTopic(*sns.CreateTopicInput) (*sns.CreateTopicOutput, error)
 
+	CreateTopicWithContext(context.Context, *sns.CreateTopicInput) (*sns.CreateTopicOutput, error)
+
 	DeleteEndpoint(*sns.DeleteEndpointInput) (*sns.DeleteEndpointOutput, error)
 
+	DeleteEndpointWithContext(context.Context, *sns.DeleteEndpointInput) (*sns.DeleteEndpointOutput, error)
+
 	DeletePlatformApplication(*sns.DeletePlatformApplicationInput) (*sns.DeletePlatformApplicationOutput, error)
 
+	DeletePlatformApplicationWithContext(context.Context, *sns.DeletePlatformApplicationInput) (*sns.DeletePlatformApplicationOutput, error)
+
 	DeleteTopic(*sns.DeleteTopicInput) (*sns.DeleteTopicOutput, error)
 
+	DeleteTopicWithContext(context.Context, *sns.DeleteTopicInput) (*sns.DeleteTopicOutput, error)
+
 	GetEndpointAttributes(*sns.GetEndpointAttributesInput) (*sns.GetEndpointAttributesOutput, error)
 
+	GetEndpointAttributesWithContext(context.Context, *sns.GetEndpointAttributesInput) (*sns.GetEndpointAttributesOutput, error)
+
 	GetPlatformApplicationAttributes(*sns.GetPlatformApplicationAttributesInput) (*sns.GetPlatformApplicationAttributesOutput, error)
 
+	GetPlatformApplicationAttributesWithContext(context.Context, *sns.GetPlatformApplicationAttributesInput) (*sns.GetPlatformApplicationAttributesOutput, error)
+
 	GetSubscriptionAttributes(*sns.GetSubscriptionAttributesInput) (*sns.GetSubscriptionAttributesOutput, error)
 
+	GetSubscriptionAttributesWithContext(context.Context, *sns.GetSubscriptionAttributesInput) (*sns.GetSubscriptionAttributesOutput, error)
+
 	GetTopicAttributes(*sns.GetTopicAttributesInput) (*sns.GetTopicAttributesOutput, error)
 
+	GetTopicAttributesWithContext(context.Context, *sns.GetTopicAttributesInput) (*sns.GetTopicAttributesOutput, error)
+
 	ListEndpointsByPlatformApplication(*sns.ListEndpointsByPlatformApplicationInput) (*sns.ListEndpointsByPlatformApplicationOutput, error)
 
+	ListEndpointsByPlatformApplicationWithContext(context.Context, *sns.ListEndpointsByPlatformApplicationInput) (*sns.ListEndpointsByPlatformApplicationOutput, error)
+	ListEndpointsByPlatformApplicationPages(*sns.ListEndpointsByPlatformApplicationInput, func(*sns.ListEndpointsByPlatformApplicationOutput, bool) bool) error
+
 	ListPlatformApplications(*sns.ListPlatformApplicationsInput) (*sns.ListPlatformApplicationsOutput, error)
 
+	ListPlatformApplicationsWithContext(context.Context, *sns.ListPlatformApplicationsInput) (*sns.ListPlatformApplicationsOutput, error)
+	ListPlatformApplicationsPages(*sns.ListPlatformApplicationsInput, func(*sns.ListPlatformApplicationsOutput, bool) bool) error
+
 	ListSubscriptions(*sns.ListSubscriptionsInput) (*sns.ListSubscriptionsOutput, error)
 
+	ListSubscriptionsWithContext(context.Context, *sns.ListSubscriptionsInput) (*sns.ListSubscriptionsOutput, error)
+	ListSubscriptionsPages(*sns.ListSubscriptionsInput, func(*sns.ListSubscriptionsOutput, bool) bool) error
+
 	ListSubscriptionsByTopic(*sns.ListSubscriptionsByTopicInput) (*sns.ListSubscriptionsByTopicOutput, error)
 
+	ListSubscriptionsByTopicWithContext(context.Context, *sns.ListSubscriptionsByTopicInput) (*sns.ListSubscriptionsByTopicOutput, error)
+	ListSubscriptionsByTopicPages(*sns.ListSubscriptionsByTopicInput, func(*sns.ListSubscriptionsByTopicOutput, bool) bool) error
+
 	ListTopics(*sns.ListTopicsInput) (*sns.ListTopicsOutput, error)
 
+	ListTopicsWithContext(context.Context, *sns.ListTopicsInput) (*sns.ListTopicsOutput, error)
+	ListTopicsPages(*sns.ListTopicsInput, func(*sns.ListTopicsOutput, bool) bool) error
+
 	Publish(*sns.PublishInput) (*sns.PublishOutput, error)
 
+	PublishWithContext(context.Context, *sns.PublishInput) (*sns.PublishOutput, error)
+
 	RemovePermission(*sns.RemovePermissionInput) (*sns.RemovePermissionOutput, error)
 
+	RemovePermissionWithContext(context.Context, *sns.RemovePermissionInput) (*sns.RemovePermissionOutput, error)
+
 	SetEndpointAttributes(*sns.SetEndpointAttributesInput) (*sns.SetEndpointAttributesOutput, error)
 
+	SetEndpointAttributesWithContext(context.Context, *sns.SetEndpointAttributesInput) (*sns.SetEndpointAttributesOutput, error)
+
 	SetPlatformApplicationAttributes(*sns.SetPlatformApplicationAttributesInput) (*sns.SetPlatformApplicationAttributesOutput, error)
 
+	SetPlatformApplicationAttributesWithContext(context.Context, *sns.SetPlatformApplicationAttributesInput) (*sns.SetPlatformApplicationAttributesOutput, error)
+
 	SetSubscriptionAttributes(*sns.SetSubscriptionAttributesInput) (*sns.SetSubscriptionAttributesOutput, error)
 
+	SetSubscriptionAttributesWithContext(context.Context, *sns.SetSubscriptionAttributesInput) (*sns.SetSubscriptionAttributesOutput, error)
+
 	SetTopicAttributes(*sns.SetTopicAttributesInput) (*sns.SetTopicAttributesOutput, error)
 
+	SetTopicAttributesWithContext(context.Context, *sns.SetTopicAttributesInput) (*sns.SetTopicAttributesOutput, error)
+
 	Subscribe(*sns.SubscribeInput) (*sns.SubscribeOutput, error)
 
+	SubscribeWithContext(context.Context, *sns.SubscribeInput) (*sns.SubscribeOutput, error)
+
 	Unsubscribe(*sns.UnsubscribeInput) (*sns.UnsubscribeOutput, error)
+
+	UnsubscribeWithContext(context.Context, *sns.UnsubscribeInput) (*sns.UnsubscribeOutput, error)
 }
\ No newline at end of file
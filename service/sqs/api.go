@@ -4,6 +4,7 @@
 package sqs
 
 import (
+	"context"
 	"sync"
 
 	"github.com/datacratic/aws-sdk-go/aws"
@@ -59,6 +60,17 @@ func (c *SQS) AddPermission(input *AddPermissionInput) (output *AddPermissionOut
 	return
 }
 
+// AddPermissionWithContext is the same as AddPermission with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) AddPermissionWithContext(ctx context.Context, input *AddPermissionInput) (output *AddPermissionOutput, err error) {
+	req, out := c.AddPermissionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddPermission *aws.Operation
 
 // ChangeMessageVisibilityRequest generates a request for the ChangeMessageVisibility operation.
@@ -121,6 +133,17 @@ func (c *SQS) ChangeMessageVisibility(input *ChangeMessageVisibilityInput) (outp
 	return
 }
 
+// ChangeMessageVisibilityWithContext is the same as ChangeMessageVisibility with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) ChangeMessageVisibilityWithContext(ctx context.Context, input *ChangeMessageVisibilityInput) (output *ChangeMessageVisibilityOutput, err error) {
+	req, out := c.ChangeMessageVisibilityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opChangeMessageVisibility *aws.Operation
 
 // ChangeMessageVisibilityBatchRequest generates a request for the ChangeMessageVisibilityBatch operation.
@@ -166,6 +189,17 @@ func (c *SQS) ChangeMessageVisibilityBatch(input *ChangeMessageVisibilityBatchIn
 	return
 }
 
+// ChangeMessageVisibilityBatchWithContext is the same as ChangeMessageVisibilityBatch with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) ChangeMessageVisibilityBatchWithContext(ctx context.Context, input *ChangeMessageVisibilityBatchInput) (output *ChangeMessageVisibilityBatchOutput, err error) {
+	req, out := c.ChangeMessageVisibilityBatchRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opChangeMessageVisibilityBatch *aws.Operation
 
 // CreateQueueRequest generates a request for the CreateQueue operation.
@@ -223,6 +257,17 @@ func (c *SQS) CreateQueue(input *CreateQueueInput) (output *CreateQueueOutput, e
 	return
 }
 
+// CreateQueueWithContext is the same as CreateQueue with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) CreateQueueWithContext(ctx context.Context, input *CreateQueueInput) (output *CreateQueueOutput, err error) {
+	req, out := c.CreateQueueRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateQueue *aws.Operation
 
 // DeleteMessageRequest generates a request for the DeleteMessage operation.
@@ -275,6 +320,17 @@ func (c *SQS) DeleteMessage(input *DeleteMessageInput) (output *DeleteMessageOut
 	return
 }
 
+// DeleteMessageWithContext is the same as DeleteMessage with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) DeleteMessageWithContext(ctx context.Context, input *DeleteMessageInput) (output *DeleteMessageOutput, err error) {
+	req, out := c.DeleteMessageRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteMessage *aws.Operation
 
 // DeleteMessageBatchRequest generates a request for the DeleteMessageBatch operation.
@@ -320,6 +376,17 @@ func (c *SQS) DeleteMessageBatch(input *DeleteMessageBatchInput) (output *Delete
 	return
 }
 
+// DeleteMessageBatchWithContext is the same as DeleteMessageBatch with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) DeleteMessageBatchWithContext(ctx context.Context, input *DeleteMessageBatchInput) (output *DeleteMessageBatchOutput, err error) {
+	req, out := c.DeleteMessageBatchRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteMessageBatch *aws.Operation
 
 // DeleteQueueRequest generates a request for the DeleteQueue operation.
@@ -369,6 +436,17 @@ func (c *SQS) DeleteQueue(input *DeleteQueueInput) (output *DeleteQueueOutput, e
 	return
 }
 
+// DeleteQueueWithContext is the same as DeleteQueue with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) DeleteQueueWithContext(ctx context.Context, input *DeleteQueueInput) (output *DeleteQueueOutput, err error) {
+	req, out := c.DeleteQueueRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteQueue *aws.Operation
 
 // GetQueueAttributesRequest generates a request for the GetQueueAttributes operation.
@@ -436,6 +514,17 @@ func (c *SQS) GetQueueAttributes(input *GetQueueAttributesInput) (output *GetQue
 	return
 }
 
+// GetQueueAttributesWithContext is the same as GetQueueAttributes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) GetQueueAttributesWithContext(ctx context.Context, input *GetQueueAttributesInput) (output *GetQueueAttributesOutput, err error) {
+	req, out := c.GetQueueAttributesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetQueueAttributes *aws.Operation
 
 // GetQueueURLRequest generates a request for the GetQueueURL operation.
@@ -476,6 +565,17 @@ func (c *SQS) GetQueueURL(input *GetQueueURLInput) (output *GetQueueURLOutput, e
 	return
 }
 
+// GetQueueURLWithContext is the same as GetQueueURL with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) GetQueueURLWithContext(ctx context.Context, input *GetQueueURLInput) (output *GetQueueURLOutput, err error) {
+	req, out := c.GetQueueURLRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetQueueURL *aws.Operation
 
 // ListDeadLetterSourceQueuesRequest generates a request for the ListDeadLetterSourceQueues operation.
@@ -513,6 +613,17 @@ func (c *SQS) ListDeadLetterSourceQueues(input *ListDeadLetterSourceQueuesInput)
 	return
 }
 
+// ListDeadLetterSourceQueuesWithContext is the same as ListDeadLetterSourceQueues with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) ListDeadLetterSourceQueuesWithContext(ctx context.Context, input *ListDeadLetterSourceQueuesInput) (output *ListDeadLetterSourceQueuesOutput, err error) {
+	req, out := c.ListDeadLetterSourceQueuesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListDeadLetterSourceQueues *aws.Operation
 
 // ListQueuesRequest generates a request for the ListQueues operation.
@@ -548,6 +659,17 @@ func (c *SQS) ListQueues(input *ListQueuesInput) (output *ListQueuesOutput, err
 	return
 }
 
+// ListQueuesWithContext is the same as ListQueues with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) ListQueuesWithContext(ctx context.Context, input *ListQueuesInput) (output *ListQueuesOutput, err error) {
+	req, out := c.ListQueuesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListQueues *aws.Operation
 
 // PurgeQueueRequest generates a request for the PurgeQueue operation.
@@ -589,6 +711,17 @@ func (c *SQS) PurgeQueue(input *PurgeQueueInput) (output *PurgeQueueOutput, err
 	return
 }
 
+// PurgeQueueWithContext is the same as PurgeQueue with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) PurgeQueueWithContext(ctx context.Context, input *PurgeQueueInput) (output *PurgeQueueOutput, err error) {
+	req, out := c.PurgeQueueRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPurgeQueue *aws.Operation
 
 // ReceiveMessageRequest generates a request for the ReceiveMessage operation.
@@ -663,6 +796,17 @@ func (c *SQS) ReceiveMessage(input *ReceiveMessageInput) (output *ReceiveMessage
 	return
 }
 
+// ReceiveMessageWithContext is the same as ReceiveMessage with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) ReceiveMessageWithContext(ctx context.Context, input *ReceiveMessageInput) (output *ReceiveMessageOutput, err error) {
+	req, out := c.ReceiveMessageRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opReceiveMessage *aws.Operation
 
 // RemovePermissionRequest generates a request for the RemovePermission operation.
@@ -697,6 +841,17 @@ func (c *SQS) RemovePermission(input *RemovePermissionInput) (output *RemovePerm
 	return
 }
 
+// RemovePermissionWithContext is the same as RemovePermission with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) RemovePermissionWithContext(ctx context.Context, input *RemovePermissionInput) (output *RemovePermissionOutput, err error) {
+	req, out := c.RemovePermissionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRemovePermission *aws.Operation
 
 // SendMessageRequest generates a request for the SendMessage operation.
@@ -741,6 +896,17 @@ func (c *SQS) SendMessage(input *SendMessageInput) (output *SendMessageOutput, e
 	return
 }
 
+// SendMessageWithContext is the same as SendMessage with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) SendMessageWithContext(ctx context.Context, input *SendMessageInput) (output *SendMessageOutput, err error) {
+	req, out := c.SendMessageRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSendMessage *aws.Operation
 
 // SendMessageBatchRequest generates a request for the SendMessageBatch operation.
@@ -799,6 +965,17 @@ func (c *SQS) SendMessageBatch(input *SendMessageBatchInput) (output *SendMessag
 	return
 }
 
+// SendMessageBatchWithContext is the same as SendMessageBatch with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) SendMessageBatchWithContext(ctx context.Context, input *SendMessageBatchInput) (output *SendMessageBatchOutput, err error) {
+	req, out := c.SendMessageBatchRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSendMessageBatch *aws.Operation
 
 // SetQueueAttributesRequest generates a request for the SetQueueAttributes operation.
@@ -839,6 +1016,17 @@ func (c *SQS) SetQueueAttributes(input *SetQueueAttributesInput) (output *SetQue
 	return
 }
 
+// SetQueueAttributesWithContext is the same as SetQueueAttributes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SQS) SetQueueAttributesWithContext(ctx context.Context, input *SetQueueAttributesInput) (output *SetQueueAttributesOutput, err error) {
+	req, out := c.SetQueueAttributesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSetQueueAttributes *aws.Operation
 
 type AddPermissionInput struct {
@@ -1620,4 +1808,40 @@ type SetQueueAttributesOutput struct {
 
 type metadataSetQueueAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for QueueAttributeName.
+const (
+	QueueAttributeNamePolicy                                = "Policy"
+	QueueAttributeNameVisibilityTimeout                     = "VisibilityTimeout"
+	QueueAttributeNameMaximumMessageSize                    = "MaximumMessageSize"
+	QueueAttributeNameMessageRetentionPeriod                = "MessageRetentionPeriod"
+	QueueAttributeNameApproximateNumberOfMessages           = "ApproximateNumberOfMessages"
+	QueueAttributeNameApproximateNumberOfMessagesNotVisible = "ApproximateNumberOfMessagesNotVisible"
+	QueueAttributeNameCreatedTimestamp                      = "CreatedTimestamp"
+	QueueAttributeNameLastModifiedTimestamp                 = "LastModifiedTimestamp"
+	QueueAttributeNameQueueARN                              = "QueueArn"
+	QueueAttributeNameApproximateNumberOfMessagesDelayed    = "ApproximateNumberOfMessagesDelayed"
+	QueueAttributeNameDelaySeconds                          = "DelaySeconds"
+	QueueAttributeNameReceiveMessageWaitTimeSeconds         = "ReceiveMessageWaitTimeSeconds"
+	QueueAttributeNameRedrivePolicy                         = "RedrivePolicy"
+)
+
+// QueueAttributeNameValues returns the valid values of QueueAttributeName.
+func QueueAttributeNameValues() []string {
+	return []string{
+		QueueAttributeNamePolicy,
+		QueueAttributeNameVisibilityTimeout,
+		QueueAttributeNameMaximumMessageSize,
+		QueueAttributeNameMessageRetentionPeriod,
+		QueueAttributeNameApproximateNumberOfMessages,
+		QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+		QueueAttributeNameCreatedTimestamp,
+		QueueAttributeNameLastModifiedTimestamp,
+		QueueAttributeNameQueueARN,
+		QueueAttributeNameApproximateNumberOfMessagesDelayed,
+		QueueAttributeNameDelaySeconds,
+		QueueAttributeNameReceiveMessageWaitTimeSeconds,
+		QueueAttributeNameRedrivePolicy,
+	}
 }
\ No newline at end of file
@@ -0,0 +1,136 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package sqs
+
+import (
+	"github.com/datacratic/aws-sdk-go/aws"
+)
+
+// Error codes of the SQS errors. Errors with these codes are
+// returned as the typed error of the same name, e.g. an error with the code
+// ErrCodeFoo is a *Foo.
+const (
+	ErrCodeBatchEntryIDsNotDistinct     = "AWS.SimpleQueueService.BatchEntryIdsNotDistinct"
+	ErrCodeBatchRequestTooLong          = "AWS.SimpleQueueService.BatchRequestTooLong"
+	ErrCodeEmptyBatchRequest            = "AWS.SimpleQueueService.EmptyBatchRequest"
+	ErrCodeInvalidAttributeName         = "InvalidAttributeName"
+	ErrCodeInvalidBatchEntryID          = "AWS.SimpleQueueService.InvalidBatchEntryId"
+	ErrCodeInvalidIDFormat              = "InvalidIDFormat"
+	ErrCodeInvalidMessageContents       = "InvalidMessageContents"
+	ErrCodeMessageNotInflight           = "AWS.SimpleQueueService.MessageNotInflight"
+	ErrCodeOverLimit                    = "OverLimit"
+	ErrCodePurgeQueueInProgress         = "AWS.SimpleQueueService.PurgeQueueInProgress"
+	ErrCodeQueueDeletedRecently         = "AWS.SimpleQueueService.QueueDeletedRecently"
+	ErrCodeQueueDoesNotExist            = "AWS.SimpleQueueService.NonExistentQueue"
+	ErrCodeQueueNameExists              = "QueueAlreadyExists"
+	ErrCodeReceiptHandleIsInvalid       = "ReceiptHandleIsInvalid"
+	ErrCodeTooManyEntriesInBatchRequest = "AWS.SimpleQueueService.TooManyEntriesInBatchRequest"
+	ErrCodeUnsupportedOperation         = "AWS.SimpleQueueService.UnsupportedOperation"
+)
+
+// Two or more batch entries have the same Id in the request.
+type BatchEntryIDsNotDistinct struct {
+	aws.APIError
+}
+
+// The length of all the messages put together is more than the limit.
+type BatchRequestTooLong struct {
+	aws.APIError
+}
+
+// Batch request does not contain an entry.
+type EmptyBatchRequest struct {
+	aws.APIError
+}
+
+// The attribute referred to does not exist.
+type InvalidAttributeName struct {
+	aws.APIError
+}
+
+// The Id of a batch entry in a batch request does not abide by the specification.
+type InvalidBatchEntryID struct {
+	aws.APIError
+}
+
+// The receipt handle is not valid for the current version.
+type InvalidIDFormat struct {
+	aws.APIError
+}
+
+// The message contains characters outside the allowed set.
+type InvalidMessageContents struct {
+	aws.APIError
+}
+
+// The message referred to is not in flight.
+type MessageNotInflight struct {
+	aws.APIError
+}
+
+// The action that you requested would violate a limit. For example, ReceiveMessage
+// returns this error if the maximum number of messages inflight has already
+// been reached. AddPermission returns this error if the maximum number of permissions
+// for the queue has already been reached.
+type OverLimit struct {
+	aws.APIError
+}
+
+// Indicates that the specified queue previously received a PurgeQueue request
+// within the last 60 seconds, the time it can take to delete the messages in
+// the queue.
+type PurgeQueueInProgress struct {
+	aws.APIError
+}
+
+// You must wait 60 seconds after deleting a queue before you can create another
+// with the same name.
+type QueueDeletedRecently struct {
+	aws.APIError
+}
+
+// The queue referred to does not exist.
+type QueueDoesNotExist struct {
+	aws.APIError
+}
+
+// A queue already exists with this name. Amazon SQS returns this error only
+// if the request includes attributes whose values differ from those of the
+// existing queue.
+type QueueNameExists struct {
+	aws.APIError
+}
+
+// The receipt handle provided is not valid.
+type ReceiptHandleIsInvalid struct {
+	aws.APIError
+}
+
+// Batch request contains more number of entries than permissible.
+type TooManyEntriesInBatchRequest struct {
+	aws.APIError
+}
+
+// Error code 400. Unsupported operation.
+type UnsupportedOperation struct {
+	aws.APIError
+}
+
+var errorTypes = map[string]func() aws.ServiceError{
+	ErrCodeBatchEntryIDsNotDistinct:     func() aws.ServiceError { return &BatchEntryIDsNotDistinct{} },
+	ErrCodeBatchRequestTooLong:          func() aws.ServiceError { return &BatchRequestTooLong{} },
+	ErrCodeEmptyBatchRequest:            func() aws.ServiceError { return &EmptyBatchRequest{} },
+	ErrCodeInvalidAttributeName:         func() aws.ServiceError { return &InvalidAttributeName{} },
+	ErrCodeInvalidBatchEntryID:          func() aws.ServiceError { return &InvalidBatchEntryID{} },
+	ErrCodeInvalidIDFormat:              func() aws.ServiceError { return &InvalidIDFormat{} },
+	ErrCodeInvalidMessageContents:       func() aws.ServiceError { return &InvalidMessageContents{} },
+	ErrCodeMessageNotInflight:           func() aws.ServiceError { return &MessageNotInflight{} },
+	ErrCodeOverLimit:                    func() aws.ServiceError { return &OverLimit{} },
+	ErrCodePurgeQueueInProgress:         func() aws.ServiceError { return &PurgeQueueInProgress{} },
+	ErrCodeQueueDeletedRecently:         func() aws.ServiceError { return &QueueDeletedRecently{} },
+	ErrCodeQueueDoesNotExist:            func() aws.ServiceError { return &QueueDoesNotExist{} },
+	ErrCodeQueueNameExists:              func() aws.ServiceError { return &QueueNameExists{} },
+	ErrCodeReceiptHandleIsInvalid:       func() aws.ServiceError { return &ReceiptHandleIsInvalid{} },
+	ErrCodeTooManyEntriesInBatchRequest: func() aws.ServiceError { return &TooManyEntriesInBatchRequest{} },
+	ErrCodeUnsupportedOperation:         func() aws.ServiceError { return &UnsupportedOperation{} },
+}
@@ -27,6 +27,7 @@ func New(config *aws.Config) *SQS {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "sqs",
 		APIVersion:  "2012-11-05",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
@@ -1,41 +1,77 @@
 package sqsiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/sqs"
 )
 
 type SQSAPI interface {
 	AddPermission(*sqs.AddPermissionInput) (*sqs.AddPermissionOutput, error)
 
+	AddPermissionWithContext(context.Context, *sqs.AddPermissionInput) (*sqs.AddPermissionOutput, error)
+
 	ChangeMessageVisibility(*sqs.ChangeMessageVisibilityInput) (*sqs.ChangeMessageVisibilityOutput, error)
 
+	ChangeMessageVisibilityWithContext(context.Context, *sqs.ChangeMessageVisibilityInput) (*sqs.ChangeMessageVisibilityOutput, error)
+
 	ChangeMessageVisibilityBatch(*sqs.ChangeMessageVisibilityBatchInput) (*sqs.ChangeMessageVisibilityBatchOutput, error)
 
+	ChangeMessageVisibilityBatchWithContext(context.Context, *sqs.ChangeMessageVisibilityBatchInput) (*sqs.ChangeMessageVisibilityBatchOutput, error)
+
 	CreateQueue(*sqs.CreateQueueInput) (*sqs.CreateQueueOutput, error)
 
+	CreateQueueWithContext(context.Context, *sqs.CreateQueueInput) (*sqs.CreateQueueOutput, error)
+
 	DeleteMessage(*sqs.DeleteMessageInput) (*sqs.DeleteMessageOutput, error)
 
+	DeleteMessageWithContext(context.Context, *sqs.DeleteMessageInput) (*sqs.DeleteMessageOutput, error)
+
 	DeleteMessageBatch(*sqs.DeleteMessageBatchInput) (*sqs.DeleteMessageBatchOutput, error)
 
+	DeleteMessageBatchWithContext(context.Context, *sqs.DeleteMessageBatchInput) (*sqs.DeleteMessageBatchOutput, error)
+
 	DeleteQueue(*sqs.DeleteQueueInput) (*sqs.DeleteQueueOutput, error)
 
+	DeleteQueueWithContext(context.Context, *sqs.DeleteQueueInput) (*sqs.DeleteQueueOutput, error)
+
 	GetQueueAttributes(*sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error)
 
+	GetQueueAttributesWithContext(context.Context, *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error)
+
 	GetQueueURL(*sqs.GetQueueURLInput) (*sqs.GetQueueURLOutput, error)
 
+	GetQueueURLWithContext(context.Context, *sqs.GetQueueURLInput) (*sqs.GetQueueURLOutput, error)
+
 	ListDeadLetterSourceQueues(*sqs.ListDeadLetterSourceQueuesInput) (*sqs.ListDeadLetterSourceQueuesOutput, error)
 
+	ListDeadLetterSourceQueuesWithContext(context.Context, *sqs.ListDeadLetterSourceQueuesInput) (*sqs.ListDeadLetterSourceQueuesOutput, error)
+
 	ListQueues(*sqs.ListQueuesInput) (*sqs.ListQueuesOutput, error)
 
+	ListQueuesWithContext(context.Context, *sqs.ListQueuesInput) (*sqs.ListQueuesOutput, error)
+
 	PurgeQueue(*sqs.PurgeQueueInput) (*sqs.PurgeQueueOutput, error)
 
+	PurgeQueueWithContext(context.Context, *sqs.PurgeQueueInput) (*sqs.PurgeQueueOutput, error)
+
 	ReceiveMessage(*sqs.ReceiveMessageInput) (*sqs.ReceiveMessageOutput, error)
 
+	ReceiveMessageWithContext(context.Context, *sqs.ReceiveMessageInput) (*sqs.ReceiveMessageOutput, error)
+
 	RemovePermission(*sqs.RemovePermissionInput) (*sqs.RemovePermissionOutput, error)
 
+	RemovePermissionWithContext(context.Context, *sqs.RemovePermissionInput) (*sqs.RemovePermissionOutput, error)
+
 	SendMessage(*sqs.SendMessageInput) (*sqs.SendMessageOutput, error)
 
+	SendMessageWithContext(context.Context, *sqs.SendMessageInput) (*sqs.SendMessageOutput, error)
+
 	SendMessageBatch(*sqs.SendMessageBatchInput) (*sqs.SendMessageBatchOutput, error)
 
+	SendMessageBatchWithContext(context.Context, *sqs.SendMessageBatchInput) (*sqs.SendMessageBatchOutput, error)
+
 	SetQueueAttributes(*sqs.SetQueueAttributesInput) (*sqs.SetQueueAttributesOutput, error)
+
+	SetQueueAttributesWithContext(context.Context, *sqs.SetQueueAttributesInput) (*sqs.SetQueueAttributesOutput, error)
 }
\ No newline at end of file
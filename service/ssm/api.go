@@ -4,6 +4,7 @@
 package ssm
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -51,6 +52,17 @@ func (c *SSM) CreateAssociation(input *CreateAssociationInput) (output *CreateAs
 	return
 }
 
+// CreateAssociationWithContext is the same as CreateAssociation with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SSM) CreateAssociationWithContext(ctx context.Context, input *CreateAssociationInput) (output *CreateAssociationOutput, err error) {
+	req, out := c.CreateAssociationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateAssociation *aws.Operation
 
 // CreateAssociationBatchRequest generates a request for the CreateAssociationBatch operation.
@@ -92,6 +104,17 @@ func (c *SSM) CreateAssociationBatch(input *CreateAssociationBatchInput) (output
 	return
 }
 
+// CreateAssociationBatchWithContext is the same as CreateAssociationBatch with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SSM) CreateAssociationBatchWithContext(ctx context.Context, input *CreateAssociationBatchInput) (output *CreateAssociationBatchOutput, err error) {
+	req, out := c.CreateAssociationBatchRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateAssociationBatch *aws.Operation
 
 // CreateDocumentRequest generates a request for the CreateDocument operation.
@@ -128,6 +151,17 @@ func (c *SSM) CreateDocument(input *CreateDocumentInput) (output *CreateDocument
 	return
 }
 
+// CreateDocumentWithContext is the same as CreateDocument with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SSM) CreateDocumentWithContext(ctx context.Context, input *CreateDocumentInput) (output *CreateDocumentOutput, err error) {
+	req, out := c.CreateDocumentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateDocument *aws.Operation
 
 // DeleteAssociationRequest generates a request for the DeleteAssociation operation.
@@ -167,6 +201,17 @@ func (c *SSM) DeleteAssociation(input *DeleteAssociationInput) (output *DeleteAs
 	return
 }
 
+// DeleteAssociationWithContext is the same as DeleteAssociation with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SSM) DeleteAssociationWithContext(ctx context.Context, input *DeleteAssociationInput) (output *DeleteAssociationOutput, err error) {
+	req, out := c.DeleteAssociationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteAssociation *aws.Operation
 
 // DeleteDocumentRequest generates a request for the DeleteDocument operation.
@@ -203,6 +248,17 @@ func (c *SSM) DeleteDocument(input *DeleteDocumentInput) (output *DeleteDocument
 	return
 }
 
+// DeleteDocumentWithContext is the same as DeleteDocument with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SSM) DeleteDocumentWithContext(ctx context.Context, input *DeleteDocumentInput) (output *DeleteDocumentOutput, err error) {
+	req, out := c.DeleteDocumentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteDocument *aws.Operation
 
 // DescribeAssociationRequest generates a request for the DescribeAssociation operation.
@@ -236,6 +292,17 @@ func (c *SSM) DescribeAssociation(input *DescribeAssociationInput) (output *Desc
 	return
 }
 
+// DescribeAssociationWithContext is the same as DescribeAssociation with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SSM) DescribeAssociationWithContext(ctx context.Context, input *DescribeAssociationInput) (output *DescribeAssociationOutput, err error) {
+	req, out := c.DescribeAssociationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeAssociation *aws.Operation
 
 // DescribeDocumentRequest generates a request for the DescribeDocument operation.
@@ -269,6 +336,17 @@ func (c *SSM) DescribeDocument(input *DescribeDocumentInput) (output *DescribeDo
 	return
 }
 
+// DescribeDocumentWithContext is the same as DescribeDocument with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SSM) DescribeDocumentWithContext(ctx context.Context, input *DescribeDocumentInput) (output *DescribeDocumentOutput, err error) {
+	req, out := c.DescribeDocumentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeDocument *aws.Operation
 
 // GetDocumentRequest generates a request for the GetDocument operation.
@@ -302,6 +380,17 @@ func (c *SSM) GetDocument(input *GetDocumentInput) (output *GetDocumentOutput, e
 	return
 }
 
+// GetDocumentWithContext is the same as GetDocument with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SSM) GetDocumentWithContext(ctx context.Context, input *GetDocumentInput) (output *GetDocumentOutput, err error) {
+	req, out := c.GetDocumentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetDocument *aws.Operation
 
 // ListAssociationsRequest generates a request for the ListAssociations operation.
@@ -335,6 +424,17 @@ func (c *SSM) ListAssociations(input *ListAssociationsInput) (output *ListAssoci
 	return
 }
 
+// ListAssociationsWithContext is the same as ListAssociations with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SSM) ListAssociationsWithContext(ctx context.Context, input *ListAssociationsInput) (output *ListAssociationsOutput, err error) {
+	req, out := c.ListAssociationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListAssociations *aws.Operation
 
 // ListDocumentsRequest generates a request for the ListDocuments operation.
@@ -368,6 +468,17 @@ func (c *SSM) ListDocuments(input *ListDocumentsInput) (output *ListDocumentsOut
 	return
 }
 
+// ListDocumentsWithContext is the same as ListDocuments with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SSM) ListDocumentsWithContext(ctx context.Context, input *ListDocumentsInput) (output *ListDocumentsOutput, err error) {
+	req, out := c.ListDocumentsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListDocuments *aws.Operation
 
 // UpdateAssociationStatusRequest generates a request for the UpdateAssociationStatus operation.
@@ -402,15 +513,26 @@ func (c *SSM) UpdateAssociationStatus(input *UpdateAssociationStatusInput) (outp
 	return
 }
 
+// UpdateAssociationStatusWithContext is the same as UpdateAssociationStatus with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SSM) UpdateAssociationStatusWithContext(ctx context.Context, input *UpdateAssociationStatusInput) (output *UpdateAssociationStatusOutput, err error) {
+	req, out := c.UpdateAssociationStatusRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateAssociationStatus *aws.Operation
 
 // Describes an association of a configuration document and an instance.
 type Association struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	metadataAssociation `json:"-", xml:"-"`
 }
@@ -425,10 +547,10 @@ type AssociationDescription struct {
 	Date *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	// The association status.
 	Status *AssociationStatus `type:"structure"`
@@ -443,10 +565,10 @@ type metadataAssociationDescription struct {
 // Describes a filter.
 type AssociationFilter struct {
 	// The name of the filter.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	Key *string `locationName:"key" type:"string" enum:"InstanceId,Name" required:"true"`
 
 	// The filter value.
-	Value *string `locationName:"value" type:"string" required:"true"`
+	Value *string `locationName:"value" type:"string" min:"1" required:"true"`
 
 	metadataAssociationFilter `json:"-", xml:"-"`
 }
@@ -458,16 +580,16 @@ type metadataAssociationFilter struct {
 // Describes an association status.
 type AssociationStatus struct {
 	// A user-defined string.
-	AdditionalInfo *string `type:"string"`
+	AdditionalInfo *string `type:"string" max:"1024"`
 
 	// The date when the status changed.
 	Date *time.Time `type:"timestamp" timestampFormat:"unix" required:"true"`
 
 	// The reason for the status.
-	Message *string `type:"string" required:"true"`
+	Message *string `type:"string" max:"1024" required:"true"`
 
 	// The status.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" enum:"Pending,Success,Failed" required:"true"`
 
 	metadataAssociationStatus `json:"-", xml:"-"`
 }
@@ -504,10 +626,10 @@ type metadataCreateAssociationBatchOutput struct {
 // Describes the association of a configuration document and an instance.
 type CreateAssociationBatchRequestEntry struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	metadataCreateAssociationBatchRequestEntry `json:"-", xml:"-"`
 }
@@ -518,10 +640,10 @@ type metadataCreateAssociationBatchRequestEntry struct {
 
 type CreateAssociationInput struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" required:"true"`
 
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataCreateAssociationInput `json:"-", xml:"-"`
 }
@@ -544,10 +666,10 @@ type metadataCreateAssociationOutput struct {
 type CreateDocumentInput struct {
 	// A valid JSON file. For more information about the contents of this file,
 	// see Configuration Document (http://docs.aws.amazon.com/ssm/latest/APIReference/aws-ssm-document.html).
-	Content *string `type:"string" required:"true"`
+	Content *string `type:"string" min:"1" required:"true"`
 
 	// A name for the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataCreateDocumentInput `json:"-", xml:"-"`
 }
@@ -569,10 +691,10 @@ type metadataCreateDocumentOutput struct {
 
 type DeleteAssociationInput struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" required:"true"`
 
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataDeleteAssociationInput `json:"-", xml:"-"`
 }
@@ -591,7 +713,7 @@ type metadataDeleteAssociationOutput struct {
 
 type DeleteDocumentInput struct {
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataDeleteDocumentInput `json:"-", xml:"-"`
 }
@@ -610,10 +732,10 @@ type metadataDeleteDocumentOutput struct {
 
 type DescribeAssociationInput struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" required:"true"`
 
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataDescribeAssociationInput `json:"-", xml:"-"`
 }
@@ -635,7 +757,7 @@ type metadataDescribeAssociationOutput struct {
 
 type DescribeDocumentInput struct {
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataDescribeDocumentInput `json:"-", xml:"-"`
 }
@@ -661,13 +783,13 @@ type DocumentDescription struct {
 	CreatedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	// The SHA1 hash of the document, which you can use for verification purposes.
 	SHA1 *string `locationName:"Sha1" type:"string"`
 
 	// The status of the configuration document.
-	Status *string `type:"string"`
+	Status *string `type:"string" enum:"Creating,Active,Deleting"`
 
 	metadataDocumentDescription `json:"-", xml:"-"`
 }
@@ -679,10 +801,10 @@ type metadataDocumentDescription struct {
 // Describes a filter.
 type DocumentFilter struct {
 	// The name of the filter.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	Key *string `locationName:"key" type:"string" enum:"Name" required:"true"`
 
 	// The value of the filter.
-	Value *string `locationName:"value" type:"string" required:"true"`
+	Value *string `locationName:"value" type:"string" min:"1" required:"true"`
 
 	metadataDocumentFilter `json:"-", xml:"-"`
 }
@@ -694,7 +816,7 @@ type metadataDocumentFilter struct {
 // Describes the name of a configuration document.
 type DocumentIdentifier struct {
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	metadataDocumentIdentifier `json:"-", xml:"-"`
 }
@@ -709,7 +831,7 @@ type FailedCreateAssociation struct {
 	Entry *CreateAssociationBatchRequestEntry `type:"structure"`
 
 	// The source of the failure.
-	Fault *string `type:"string"`
+	Fault *string `type:"string" enum:"Client,Server,Unknown"`
 
 	// A description of the failure.
 	Message *string `type:"string"`
@@ -723,7 +845,7 @@ type metadataFailedCreateAssociation struct {
 
 type GetDocumentInput struct {
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataGetDocumentInput `json:"-", xml:"-"`
 }
@@ -734,10 +856,10 @@ type metadataGetDocumentInput struct {
 
 type GetDocumentOutput struct {
 	// The contents of the configuration document.
-	Content *string `type:"string"`
+	Content *string `type:"string" min:"1"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	metadataGetDocumentOutput `json:"-", xml:"-"`
 }
@@ -748,12 +870,12 @@ type metadataGetDocumentOutput struct {
 
 type ListAssociationsInput struct {
 	// One or more filters. Use a filter to return a more specific list of results.
-	AssociationFilterList []*AssociationFilter `locationNameList:"AssociationFilter" type:"list" required:"true"`
+	AssociationFilterList []*AssociationFilter `locationNameList:"AssociationFilter" type:"list" min:"1" required:"true"`
 
 	// The maximum number of items to return for this call. The call also returns
 	// a token that you can specify in a subsequent call to get the next set of
 	// results.
-	MaxResults *int64 `type:"integer"`
+	MaxResults *int64 `type:"integer" min:"1" max:"25"`
 
 	// The token for the next set of items to return. (You received this token from
 	// a previous call.)
@@ -783,12 +905,12 @@ type metadataListAssociationsOutput struct {
 
 type ListDocumentsInput struct {
 	// One or more filters. Use a filter to return a more specific list of results.
-	DocumentFilterList []*DocumentFilter `locationNameList:"DocumentFilter" type:"list"`
+	DocumentFilterList []*DocumentFilter `locationNameList:"DocumentFilter" type:"list" min:"1"`
 
 	// The maximum number of items to return for this call. The call also returns
 	// a token that you can specify in a subsequent call to get the next set of
 	// results.
-	MaxResults *int64 `type:"integer"`
+	MaxResults *int64 `type:"integer" min:"1" max:"25"`
 
 	// The token for the next set of items to return. (You received this token from
 	// a previous call.)
@@ -821,10 +943,10 @@ type UpdateAssociationStatusInput struct {
 	AssociationStatus *AssociationStatus `type:"structure" required:"true"`
 
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" required:"true"`
 
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataUpdateAssociationStatusInput `json:"-", xml:"-"`
 }
@@ -842,4 +964,78 @@ type UpdateAssociationStatusOutput struct {
 
 type metadataUpdateAssociationStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for AssociationFilterKey.
+const (
+	AssociationFilterKeyInstanceID = "InstanceId"
+	AssociationFilterKeyName       = "Name"
+)
+
+// AssociationFilterKeyValues returns the valid values of AssociationFilterKey.
+func AssociationFilterKeyValues() []string {
+	return []string{
+		AssociationFilterKeyInstanceID,
+		AssociationFilterKeyName,
+	}
+}
+
+// Enum values for AssociationStatusName.
+const (
+	AssociationStatusNamePending = "Pending"
+	AssociationStatusNameSuccess = "Success"
+	AssociationStatusNameFailed  = "Failed"
+)
+
+// AssociationStatusNameValues returns the valid values of AssociationStatusName.
+func AssociationStatusNameValues() []string {
+	return []string{
+		AssociationStatusNamePending,
+		AssociationStatusNameSuccess,
+		AssociationStatusNameFailed,
+	}
+}
+
+// Enum values for DocumentFilterKey.
+const (
+	DocumentFilterKeyName = "Name"
+)
+
+// DocumentFilterKeyValues returns the valid values of DocumentFilterKey.
+func DocumentFilterKeyValues() []string {
+	return []string{
+		DocumentFilterKeyName,
+	}
+}
+
+// Enum values for DocumentStatus.
+const (
+	DocumentStatusCreating = "Creating"
+	DocumentStatusActive   = "Active"
+	DocumentStatusDeleting = "Deleting"
+)
+
+// DocumentStatusValues returns the valid values of DocumentStatus.
+func DocumentStatusValues() []string {
+	return []string{
+		DocumentStatusCreating,
+		DocumentStatusActive,
+		DocumentStatusDeleting,
+	}
+}
+
+// Enum values for Fault.
+const (
+	FaultClient  = "Client"
+	FaultServer  = "Server"
+	FaultUnknown = "Unknown"
+)
+
+// FaultValues returns the valid values of Fault.
+func FaultValues() []string {
+	return []string{
+		FaultClient,
+		FaultServer,
+		FaultUnknown,
+	}
 }
\ No newline at end of file
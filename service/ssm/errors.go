@@ -0,0 +1,123 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package ssm
+
+import (
+	"github.com/datacratic/aws-sdk-go/aws"
+)
+
+// Error codes of the SSM errors. Errors with these codes are
+// returned as the typed error of the same name, e.g. an error with the code
+// ErrCodeFoo is a *Foo.
+const (
+	ErrCodeAssociatedInstances      = "AssociatedInstances"
+	ErrCodeAssociationAlreadyExists = "AssociationAlreadyExists"
+	ErrCodeAssociationDoesNotExist  = "AssociationDoesNotExist"
+	ErrCodeAssociationLimitExceeded = "AssociationLimitExceeded"
+	ErrCodeDocumentAlreadyExists    = "DocumentAlreadyExists"
+	ErrCodeDocumentLimitExceeded    = "DocumentLimitExceeded"
+	ErrCodeDuplicateInstanceID      = "DuplicateInstanceId"
+	ErrCodeInternalServerError      = "InternalServerError"
+	ErrCodeInvalidDocument          = "InvalidDocument"
+	ErrCodeInvalidDocumentContent   = "InvalidDocumentContent"
+	ErrCodeInvalidInstanceID        = "InvalidInstanceId"
+	ErrCodeInvalidNextToken         = "InvalidNextToken"
+	ErrCodeMaxDocumentSizeExceeded  = "MaxDocumentSizeExceeded"
+	ErrCodeStatusUnchanged          = "StatusUnchanged"
+	ErrCodeTooManyUpdates           = "TooManyUpdates"
+)
+
+// You must disassociate a configuration document from all instances before
+// you can delete it.
+type AssociatedInstances struct {
+	aws.APIError
+}
+
+// The specified association already exists.
+type AssociationAlreadyExists struct {
+	aws.APIError
+}
+
+// The specified association does not exist.
+type AssociationDoesNotExist struct {
+	aws.APIError
+}
+
+// You can have at most 2,000 active associations.
+type AssociationLimitExceeded struct {
+	aws.APIError
+}
+
+// The specified configuration document already exists.
+type DocumentAlreadyExists struct {
+	aws.APIError
+}
+
+// You can have at most 100 active configuration documents.
+type DocumentLimitExceeded struct {
+	aws.APIError
+}
+
+// You cannot specify an instance ID in more than one association.
+type DuplicateInstanceID struct {
+	aws.APIError
+}
+
+// An error occurred on the server side.
+type InternalServerError struct {
+	aws.APIError
+}
+
+// The configuration document is not valid.
+type InvalidDocument struct {
+	aws.APIError
+}
+
+// The content for the configuration document is not valid.
+type InvalidDocumentContent struct {
+	aws.APIError
+}
+
+// You must specify the ID of a running instance.
+type InvalidInstanceID struct {
+	aws.APIError
+}
+
+// The specified token is not valid.
+type InvalidNextToken struct {
+	aws.APIError
+}
+
+// The size limit of a configuration document is 64 KB.
+type MaxDocumentSizeExceeded struct {
+	aws.APIError
+}
+
+// The updated status is the same as the current status.
+type StatusUnchanged struct {
+	aws.APIError
+}
+
+// There are concurrent updates for a resource that supports one update at a
+// time.
+type TooManyUpdates struct {
+	aws.APIError
+}
+
+var errorTypes = map[string]func() aws.ServiceError{
+	ErrCodeAssociatedInstances:      func() aws.ServiceError { return &AssociatedInstances{} },
+	ErrCodeAssociationAlreadyExists: func() aws.ServiceError { return &AssociationAlreadyExists{} },
+	ErrCodeAssociationDoesNotExist:  func() aws.ServiceError { return &AssociationDoesNotExist{} },
+	ErrCodeAssociationLimitExceeded: func() aws.ServiceError { return &AssociationLimitExceeded{} },
+	ErrCodeDocumentAlreadyExists:    func() aws.ServiceError { return &DocumentAlreadyExists{} },
+	ErrCodeDocumentLimitExceeded:    func() aws.ServiceError { return &DocumentLimitExceeded{} },
+	ErrCodeDuplicateInstanceID:      func() aws.ServiceError { return &DuplicateInstanceID{} },
+	ErrCodeInternalServerError:      func() aws.ServiceError { return &InternalServerError{} },
+	ErrCodeInvalidDocument:          func() aws.ServiceError { return &InvalidDocument{} },
+	ErrCodeInvalidDocumentContent:   func() aws.ServiceError { return &InvalidDocumentContent{} },
+	ErrCodeInvalidInstanceID:        func() aws.ServiceError { return &InvalidInstanceID{} },
+	ErrCodeInvalidNextToken:         func() aws.ServiceError { return &InvalidNextToken{} },
+	ErrCodeMaxDocumentSizeExceeded:  func() aws.ServiceError { return &MaxDocumentSizeExceeded{} },
+	ErrCodeStatusUnchanged:          func() aws.ServiceError { return &StatusUnchanged{} },
+	ErrCodeTooManyUpdates:           func() aws.ServiceError { return &TooManyUpdates{} },
+}
@@ -29,6 +29,7 @@ func New(config *aws.Config) *SSM {
 		APIVersion:   "2014-11-06",
 		JSONVersion:  "1.1",
 		TargetPrefix: "AmazonSSM",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
@@ -1,29 +1,53 @@
 package ssmiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/ssm"
 )
 
 type SSMAPI interface {
 	CreateAssociation(*ssm.CreateAssociationInput) (*ssm.CreateAssociationOutput, error)
 
+	CreateAssociationWithContext(context.Context, *ssm.CreateAssociationInput) (*ssm.CreateAssociationOutput, error)
+
 	CreateAssociationBatch(*ssm.CreateAssociationBatchInput) (*ssm.CreateAssociationBatchOutput, error)
 
+	CreateAssociationBatchWithContext(context.Context, *ssm.CreateAssociationBatchInput) (*ssm.CreateAssociationBatchOutput, error)
+
 	CreateDocument(*ssm.CreateDocumentInput) (*ssm.CreateDocumentOutput, error)
 
+	CreateDocumentWithContext(context.Context, *ssm.CreateDocumentInput) (*ssm.CreateDocumentOutput, error)
+
 	DeleteAssociation(*ssm.DeleteAssociationInput) (*ssm.DeleteAssociationOutput, error)
 
+	DeleteAssociationWithContext(context.Context, *ssm.DeleteAssociationInput) (*ssm.DeleteAssociationOutput, error)
+
 	DeleteDocument(*ssm.DeleteDocumentInput) (*ssm.DeleteDocumentOutput, error)
 
+	DeleteDocumentWithContext(context.Context, *ssm.DeleteDocumentInput) (*ssm.DeleteDocumentOutput, error)
+
 	DescribeAssociation(*ssm.DescribeAssociationInput) (*ssm.DescribeAssociationOutput, error)
 
+	DescribeAssociationWithContext(context.Context, *ssm.DescribeAssociationInput) (*ssm.DescribeAssociationOutput, error)
+
 	DescribeDocument(*ssm.DescribeDocumentInput) (*ssm.DescribeDocumentOutput, error)
 
+	DescribeDocumentWithContext(context.Context, *ssm.DescribeDocumentInput) (*ssm.DescribeDocumentOutput, error)
+
 	GetDocument(*ssm.GetDocumentInput) (*ssm.GetDocumentOutput, error)
 
+	GetDocumentWithContext(context.Context, *ssm.GetDocumentInput) (*ssm.GetDocumentOutput, error)
+
 	ListAssociations(*ssm.ListAssociationsInput) (*ssm.ListAssociationsOutput, error)
 
+	ListAssociationsWithContext(context.Context, *ssm.ListAssociationsInput) (*ssm.ListAssociationsOutput, error)
+
 	ListDocuments(*ssm.ListDocumentsInput) (*ssm.ListDocumentsOutput, error)
 
+	ListDocumentsWithContext(context.Context, *ssm.ListDocumentsInput) (*ssm.ListDocumentsOutput, error)
+
 	UpdateAssociationStatus(*ssm.UpdateAssociationStatusInput) (*ssm.UpdateAssociationStatusOutput, error)
+
+	UpdateAssociationStatusWithContext(context.Context, *ssm.UpdateAssociationStatusInput) (*ssm.UpdateAssociationStatusOutput, error)
 }
\ No newline at end of file
@@ -4,6 +4,7 @@
 package storagegateway
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -51,6 +52,17 @@ func (c *StorageGateway) ActivateGateway(input *ActivateGatewayInput) (output *A
 	return
 }
 
+// ActivateGatewayWithContext is the same as ActivateGateway with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) ActivateGatewayWithContext(ctx context.Context, input *ActivateGatewayInput) (output *ActivateGatewayOutput, err error) {
+	req, out := c.ActivateGatewayRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opActivateGateway *aws.Operation
 
 // AddCacheRequest generates a request for the AddCache operation.
@@ -90,6 +102,17 @@ func (c *StorageGateway) AddCache(input *AddCacheInput) (output *AddCacheOutput,
 	return
 }
 
+// AddCacheWithContext is the same as AddCache with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) AddCacheWithContext(ctx context.Context, input *AddCacheInput) (output *AddCacheOutput, err error) {
+	req, out := c.AddCacheRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddCache *aws.Operation
 
 // AddUploadBufferRequest generates a request for the AddUploadBuffer operation.
@@ -129,6 +152,17 @@ func (c *StorageGateway) AddUploadBuffer(input *AddUploadBufferInput) (output *A
 	return
 }
 
+// AddUploadBufferWithContext is the same as AddUploadBuffer with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) AddUploadBufferWithContext(ctx context.Context, input *AddUploadBufferInput) (output *AddUploadBufferOutput, err error) {
+	req, out := c.AddUploadBufferRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddUploadBuffer *aws.Operation
 
 // AddWorkingStorageRequest generates a request for the AddWorkingStorage operation.
@@ -172,6 +206,17 @@ func (c *StorageGateway) AddWorkingStorage(input *AddWorkingStorageInput) (outpu
 	return
 }
 
+// AddWorkingStorageWithContext is the same as AddWorkingStorage with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) AddWorkingStorageWithContext(ctx context.Context, input *AddWorkingStorageInput) (output *AddWorkingStorageOutput, err error) {
+	req, out := c.AddWorkingStorageRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddWorkingStorage *aws.Operation
 
 // CancelArchivalRequest generates a request for the CancelArchival operation.
@@ -206,6 +251,17 @@ func (c *StorageGateway) CancelArchival(input *CancelArchivalInput) (output *Can
 	return
 }
 
+// CancelArchivalWithContext is the same as CancelArchival with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) CancelArchivalWithContext(ctx context.Context, input *CancelArchivalInput) (output *CancelArchivalOutput, err error) {
+	req, out := c.CancelArchivalRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCancelArchival *aws.Operation
 
 // CancelRetrievalRequest generates a request for the CancelRetrieval operation.
@@ -241,6 +297,17 @@ func (c *StorageGateway) CancelRetrieval(input *CancelRetrievalInput) (output *C
 	return
 }
 
+// CancelRetrievalWithContext is the same as CancelRetrieval with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) CancelRetrievalWithContext(ctx context.Context, input *CancelRetrievalInput) (output *CancelRetrievalOutput, err error) {
+	req, out := c.CancelRetrievalRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCancelRetrieval *aws.Operation
 
 // CreateCachediSCSIVolumeRequest generates a request for the CreateCachediSCSIVolume operation.
@@ -284,6 +351,17 @@ func (c *StorageGateway) CreateCachediSCSIVolume(input *CreateCachediSCSIVolumeI
 	return
 }
 
+// CreateCachediSCSIVolumeWithContext is the same as CreateCachediSCSIVolume with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) CreateCachediSCSIVolumeWithContext(ctx context.Context, input *CreateCachediSCSIVolumeInput) (output *CreateCachediSCSIVolumeOutput, err error) {
+	req, out := c.CreateCachediSCSIVolumeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateCachediSCSIVolume *aws.Operation
 
 // CreateSnapshotRequest generates a request for the CreateSnapshot operation.
@@ -336,6 +414,17 @@ func (c *StorageGateway) CreateSnapshot(input *CreateSnapshotInput) (output *Cre
 	return
 }
 
+// CreateSnapshotWithContext is the same as CreateSnapshot with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) CreateSnapshotWithContext(ctx context.Context, input *CreateSnapshotInput) (output *CreateSnapshotOutput, err error) {
+	req, out := c.CreateSnapshotRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateSnapshot *aws.Operation
 
 // CreateSnapshotFromVolumeRecoveryPointRequest generates a request for the CreateSnapshotFromVolumeRecoveryPoint operation.
@@ -386,6 +475,17 @@ func (c *StorageGateway) CreateSnapshotFromVolumeRecoveryPoint(input *CreateSnap
 	return
 }
 
+// CreateSnapshotFromVolumeRecoveryPointWithContext is the same as CreateSnapshotFromVolumeRecoveryPoint with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) CreateSnapshotFromVolumeRecoveryPointWithContext(ctx context.Context, input *CreateSnapshotFromVolumeRecoveryPointInput) (output *CreateSnapshotFromVolumeRecoveryPointOutput, err error) {
+	req, out := c.CreateSnapshotFromVolumeRecoveryPointRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateSnapshotFromVolumeRecoveryPoint *aws.Operation
 
 // CreateStorediSCSIVolumeRequest generates a request for the CreateStorediSCSIVolume operation.
@@ -431,6 +531,17 @@ func (c *StorageGateway) CreateStorediSCSIVolume(input *CreateStorediSCSIVolumeI
 	return
 }
 
+// CreateStorediSCSIVolumeWithContext is the same as CreateStorediSCSIVolume with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) CreateStorediSCSIVolumeWithContext(ctx context.Context, input *CreateStorediSCSIVolumeInput) (output *CreateStorediSCSIVolumeOutput, err error) {
+	req, out := c.CreateStorediSCSIVolumeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateStorediSCSIVolume *aws.Operation
 
 // CreateTapesRequest generates a request for the CreateTapes operation.
@@ -468,6 +579,17 @@ func (c *StorageGateway) CreateTapes(input *CreateTapesInput) (output *CreateTap
 	return
 }
 
+// CreateTapesWithContext is the same as CreateTapes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) CreateTapesWithContext(ctx context.Context, input *CreateTapesInput) (output *CreateTapesOutput, err error) {
+	req, out := c.CreateTapesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateTapes *aws.Operation
 
 // DeleteBandwidthRateLimitRequest generates a request for the DeleteBandwidthRateLimit operation.
@@ -505,6 +627,17 @@ func (c *StorageGateway) DeleteBandwidthRateLimit(input *DeleteBandwidthRateLimi
 	return
 }
 
+// DeleteBandwidthRateLimitWithContext is the same as DeleteBandwidthRateLimit with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DeleteBandwidthRateLimitWithContext(ctx context.Context, input *DeleteBandwidthRateLimitInput) (output *DeleteBandwidthRateLimitOutput, err error) {
+	req, out := c.DeleteBandwidthRateLimitRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteBandwidthRateLimit *aws.Operation
 
 // DeleteChapCredentialsRequest generates a request for the DeleteChapCredentials operation.
@@ -539,6 +672,17 @@ func (c *StorageGateway) DeleteChapCredentials(input *DeleteChapCredentialsInput
 	return
 }
 
+// DeleteChapCredentialsWithContext is the same as DeleteChapCredentials with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DeleteChapCredentialsWithContext(ctx context.Context, input *DeleteChapCredentialsInput) (output *DeleteChapCredentialsOutput, err error) {
+	req, out := c.DeleteChapCredentialsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteChapCredentials *aws.Operation
 
 // DeleteGatewayRequest generates a request for the DeleteGateway operation.
@@ -587,6 +731,17 @@ func (c *StorageGateway) DeleteGateway(input *DeleteGatewayInput) (output *Delet
 	return
 }
 
+// DeleteGatewayWithContext is the same as DeleteGateway with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DeleteGatewayWithContext(ctx context.Context, input *DeleteGatewayInput) (output *DeleteGatewayOutput, err error) {
+	req, out := c.DeleteGatewayRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteGateway *aws.Operation
 
 // DeleteSnapshotScheduleRequest generates a request for the DeleteSnapshotSchedule operation.
@@ -629,6 +784,17 @@ func (c *StorageGateway) DeleteSnapshotSchedule(input *DeleteSnapshotScheduleInp
 	return
 }
 
+// DeleteSnapshotScheduleWithContext is the same as DeleteSnapshotSchedule with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DeleteSnapshotScheduleWithContext(ctx context.Context, input *DeleteSnapshotScheduleInput) (output *DeleteSnapshotScheduleOutput, err error) {
+	req, out := c.DeleteSnapshotScheduleRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteSnapshotSchedule *aws.Operation
 
 // DeleteTapeRequest generates a request for the DeleteTape operation.
@@ -662,6 +828,17 @@ func (c *StorageGateway) DeleteTape(input *DeleteTapeInput) (output *DeleteTapeO
 	return
 }
 
+// DeleteTapeWithContext is the same as DeleteTape with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DeleteTapeWithContext(ctx context.Context, input *DeleteTapeInput) (output *DeleteTapeOutput, err error) {
+	req, out := c.DeleteTapeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteTape *aws.Operation
 
 // DeleteTapeArchiveRequest generates a request for the DeleteTapeArchive operation.
@@ -695,6 +872,17 @@ func (c *StorageGateway) DeleteTapeArchive(input *DeleteTapeArchiveInput) (outpu
 	return
 }
 
+// DeleteTapeArchiveWithContext is the same as DeleteTapeArchive with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DeleteTapeArchiveWithContext(ctx context.Context, input *DeleteTapeArchiveInput) (output *DeleteTapeArchiveOutput, err error) {
+	req, out := c.DeleteTapeArchiveRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteTapeArchive *aws.Operation
 
 // DeleteVolumeRequest generates a request for the DeleteVolume operation.
@@ -741,6 +929,17 @@ func (c *StorageGateway) DeleteVolume(input *DeleteVolumeInput) (output *DeleteV
 	return
 }
 
+// DeleteVolumeWithContext is the same as DeleteVolume with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DeleteVolumeWithContext(ctx context.Context, input *DeleteVolumeInput) (output *DeleteVolumeOutput, err error) {
+	req, out := c.DeleteVolumeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeleteVolume *aws.Operation
 
 // DescribeBandwidthRateLimitRequest generates a request for the DescribeBandwidthRateLimit operation.
@@ -780,6 +979,17 @@ func (c *StorageGateway) DescribeBandwidthRateLimit(input *DescribeBandwidthRate
 	return
 }
 
+// DescribeBandwidthRateLimitWithContext is the same as DescribeBandwidthRateLimit with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DescribeBandwidthRateLimitWithContext(ctx context.Context, input *DescribeBandwidthRateLimitInput) (output *DescribeBandwidthRateLimitOutput, err error) {
+	req, out := c.DescribeBandwidthRateLimitRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeBandwidthRateLimit *aws.Operation
 
 // DescribeCacheRequest generates a request for the DescribeCache operation.
@@ -817,6 +1027,17 @@ func (c *StorageGateway) DescribeCache(input *DescribeCacheInput) (output *Descr
 	return
 }
 
+// DescribeCacheWithContext is the same as DescribeCache with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DescribeCacheWithContext(ctx context.Context, input *DescribeCacheInput) (output *DescribeCacheOutput, err error) {
+	req, out := c.DescribeCacheRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeCache *aws.Operation
 
 // DescribeCachediSCSIVolumesRequest generates a request for the DescribeCachediSCSIVolumes operation.
@@ -856,6 +1077,17 @@ func (c *StorageGateway) DescribeCachediSCSIVolumes(input *DescribeCachediSCSIVo
 	return
 }
 
+// DescribeCachediSCSIVolumesWithContext is the same as DescribeCachediSCSIVolumes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DescribeCachediSCSIVolumesWithContext(ctx context.Context, input *DescribeCachediSCSIVolumesInput) (output *DescribeCachediSCSIVolumesOutput, err error) {
+	req, out := c.DescribeCachediSCSIVolumesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeCachediSCSIVolumes *aws.Operation
 
 // DescribeChapCredentialsRequest generates a request for the DescribeChapCredentials operation.
@@ -891,6 +1123,17 @@ func (c *StorageGateway) DescribeChapCredentials(input *DescribeChapCredentialsI
 	return
 }
 
+// DescribeChapCredentialsWithContext is the same as DescribeChapCredentials with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DescribeChapCredentialsWithContext(ctx context.Context, input *DescribeChapCredentialsInput) (output *DescribeChapCredentialsOutput, err error) {
+	req, out := c.DescribeChapCredentialsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeChapCredentials *aws.Operation
 
 // DescribeGatewayInformationRequest generates a request for the DescribeGatewayInformation operation.
@@ -927,6 +1170,17 @@ func (c *StorageGateway) DescribeGatewayInformation(input *DescribeGatewayInform
 	return
 }
 
+// DescribeGatewayInformationWithContext is the same as DescribeGatewayInformation with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DescribeGatewayInformationWithContext(ctx context.Context, input *DescribeGatewayInformationInput) (output *DescribeGatewayInformationOutput, err error) {
+	req, out := c.DescribeGatewayInformationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeGatewayInformation *aws.Operation
 
 // DescribeMaintenanceStartTimeRequest generates a request for the DescribeMaintenanceStartTime operation.
@@ -962,6 +1216,17 @@ func (c *StorageGateway) DescribeMaintenanceStartTime(input *DescribeMaintenance
 	return
 }
 
+// DescribeMaintenanceStartTimeWithContext is the same as DescribeMaintenanceStartTime with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DescribeMaintenanceStartTimeWithContext(ctx context.Context, input *DescribeMaintenanceStartTimeInput) (output *DescribeMaintenanceStartTimeOutput, err error) {
+	req, out := c.DescribeMaintenanceStartTimeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeMaintenanceStartTime *aws.Operation
 
 // DescribeSnapshotScheduleRequest generates a request for the DescribeSnapshotSchedule operation.
@@ -997,6 +1262,17 @@ func (c *StorageGateway) DescribeSnapshotSchedule(input *DescribeSnapshotSchedul
 	return
 }
 
+// DescribeSnapshotScheduleWithContext is the same as DescribeSnapshotSchedule with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DescribeSnapshotScheduleWithContext(ctx context.Context, input *DescribeSnapshotScheduleInput) (output *DescribeSnapshotScheduleOutput, err error) {
+	req, out := c.DescribeSnapshotScheduleRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeSnapshotSchedule *aws.Operation
 
 // DescribeStorediSCSIVolumesRequest generates a request for the DescribeStorediSCSIVolumes operation.
@@ -1033,6 +1309,17 @@ func (c *StorageGateway) DescribeStorediSCSIVolumes(input *DescribeStorediSCSIVo
 	return
 }
 
+// DescribeStorediSCSIVolumesWithContext is the same as DescribeStorediSCSIVolumes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DescribeStorediSCSIVolumesWithContext(ctx context.Context, input *DescribeStorediSCSIVolumesInput) (output *DescribeStorediSCSIVolumesOutput, err error) {
+	req, out := c.DescribeStorediSCSIVolumesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeStorediSCSIVolumes *aws.Operation
 
 // DescribeTapeArchivesRequest generates a request for the DescribeTapeArchives operation.
@@ -1045,6 +1332,12 @@ func (c *StorageGateway) DescribeTapeArchivesRequest(input *DescribeTapeArchives
 			Name:       "DescribeTapeArchives",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "Limit",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1070,6 +1363,27 @@ func (c *StorageGateway) DescribeTapeArchives(input *DescribeTapeArchivesInput)
 	return
 }
 
+// DescribeTapeArchivesWithContext is the same as DescribeTapeArchives with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DescribeTapeArchivesWithContext(ctx context.Context, input *DescribeTapeArchivesInput) (output *DescribeTapeArchivesOutput, err error) {
+	req, out := c.DescribeTapeArchivesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeTapeArchivesPages iterates over the pages of a DescribeTapeArchives operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *StorageGateway) DescribeTapeArchivesPages(input *DescribeTapeArchivesInput, fn func(p *DescribeTapeArchivesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeTapeArchivesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeTapeArchivesOutput), lastPage)
+	})
+}
+
 var opDescribeTapeArchives *aws.Operation
 
 // DescribeTapeRecoveryPointsRequest generates a request for the DescribeTapeRecoveryPoints operation.
@@ -1082,6 +1396,12 @@ func (c *StorageGateway) DescribeTapeRecoveryPointsRequest(input *DescribeTapeRe
 			Name:       "DescribeTapeRecoveryPoints",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "Limit",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1108,6 +1428,27 @@ func (c *StorageGateway) DescribeTapeRecoveryPoints(input *DescribeTapeRecoveryP
 	return
 }
 
+// DescribeTapeRecoveryPointsWithContext is the same as DescribeTapeRecoveryPoints with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DescribeTapeRecoveryPointsWithContext(ctx context.Context, input *DescribeTapeRecoveryPointsInput) (output *DescribeTapeRecoveryPointsOutput, err error) {
+	req, out := c.DescribeTapeRecoveryPointsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeTapeRecoveryPointsPages iterates over the pages of a DescribeTapeRecoveryPoints operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *StorageGateway) DescribeTapeRecoveryPointsPages(input *DescribeTapeRecoveryPointsInput, fn func(p *DescribeTapeRecoveryPointsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeTapeRecoveryPointsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeTapeRecoveryPointsOutput), lastPage)
+	})
+}
+
 var opDescribeTapeRecoveryPoints *aws.Operation
 
 // DescribeTapesRequest generates a request for the DescribeTapes operation.
@@ -1120,6 +1461,12 @@ func (c *StorageGateway) DescribeTapesRequest(input *DescribeTapesInput) (req *a
 			Name:       "DescribeTapes",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "Limit",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1143,6 +1490,27 @@ func (c *StorageGateway) DescribeTapes(input *DescribeTapesInput) (output *Descr
 	return
 }
 
+// DescribeTapesWithContext is the same as DescribeTapes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DescribeTapesWithContext(ctx context.Context, input *DescribeTapesInput) (output *DescribeTapesOutput, err error) {
+	req, out := c.DescribeTapesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeTapesPages iterates over the pages of a DescribeTapes operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *StorageGateway) DescribeTapesPages(input *DescribeTapesInput, fn func(p *DescribeTapesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeTapesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeTapesOutput), lastPage)
+	})
+}
+
 var opDescribeTapes *aws.Operation
 
 // DescribeUploadBufferRequest generates a request for the DescribeUploadBuffer operation.
@@ -1181,6 +1549,17 @@ func (c *StorageGateway) DescribeUploadBuffer(input *DescribeUploadBufferInput)
 	return
 }
 
+// DescribeUploadBufferWithContext is the same as DescribeUploadBuffer with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DescribeUploadBufferWithContext(ctx context.Context, input *DescribeUploadBufferInput) (output *DescribeUploadBufferOutput, err error) {
+	req, out := c.DescribeUploadBufferRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeUploadBuffer *aws.Operation
 
 // DescribeVTLDevicesRequest generates a request for the DescribeVTLDevices operation.
@@ -1193,6 +1572,12 @@ func (c *StorageGateway) DescribeVTLDevicesRequest(input *DescribeVTLDevicesInpu
 			Name:       "DescribeVTLDevices",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "Limit",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1217,6 +1602,27 @@ func (c *StorageGateway) DescribeVTLDevices(input *DescribeVTLDevicesInput) (out
 	return
 }
 
+// DescribeVTLDevicesWithContext is the same as DescribeVTLDevices with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DescribeVTLDevicesWithContext(ctx context.Context, input *DescribeVTLDevicesInput) (output *DescribeVTLDevicesOutput, err error) {
+	req, out := c.DescribeVTLDevicesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeVTLDevicesPages iterates over the pages of a DescribeVTLDevices operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *StorageGateway) DescribeVTLDevicesPages(input *DescribeVTLDevicesInput, fn func(p *DescribeVTLDevicesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeVTLDevicesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeVTLDevicesOutput), lastPage)
+	})
+}
+
 var opDescribeVTLDevices *aws.Operation
 
 // DescribeWorkingStorageRequest generates a request for the DescribeWorkingStorage operation.
@@ -1259,6 +1665,17 @@ func (c *StorageGateway) DescribeWorkingStorage(input *DescribeWorkingStorageInp
 	return
 }
 
+// DescribeWorkingStorageWithContext is the same as DescribeWorkingStorage with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DescribeWorkingStorageWithContext(ctx context.Context, input *DescribeWorkingStorageInput) (output *DescribeWorkingStorageOutput, err error) {
+	req, out := c.DescribeWorkingStorageRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeWorkingStorage *aws.Operation
 
 // DisableGatewayRequest generates a request for the DisableGateway operation.
@@ -1298,6 +1715,17 @@ func (c *StorageGateway) DisableGateway(input *DisableGatewayInput) (output *Dis
 	return
 }
 
+// DisableGatewayWithContext is the same as DisableGateway with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) DisableGatewayWithContext(ctx context.Context, input *DisableGatewayInput) (output *DisableGatewayOutput, err error) {
+	req, out := c.DisableGatewayRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDisableGateway *aws.Operation
 
 // ListGatewaysRequest generates a request for the ListGateways operation.
@@ -1310,6 +1738,12 @@ func (c *StorageGateway) ListGatewaysRequest(input *ListGatewaysInput) (req *aws
 			Name:       "ListGateways",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "Limit",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1341,6 +1775,27 @@ func (c *StorageGateway) ListGateways(input *ListGatewaysInput) (output *ListGat
 	return
 }
 
+// ListGatewaysWithContext is the same as ListGateways with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) ListGatewaysWithContext(ctx context.Context, input *ListGatewaysInput) (output *ListGatewaysOutput, err error) {
+	req, out := c.ListGatewaysRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListGatewaysPages iterates over the pages of a ListGateways operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *StorageGateway) ListGatewaysPages(input *ListGatewaysInput, fn func(p *ListGatewaysOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListGatewaysRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListGatewaysOutput), lastPage)
+	})
+}
+
 var opListGateways *aws.Operation
 
 // ListLocalDisksRequest generates a request for the ListLocalDisks operation.
@@ -1383,6 +1838,17 @@ func (c *StorageGateway) ListLocalDisks(input *ListLocalDisksInput) (output *Lis
 	return
 }
 
+// ListLocalDisksWithContext is the same as ListLocalDisks with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) ListLocalDisksWithContext(ctx context.Context, input *ListLocalDisksInput) (output *ListLocalDisksOutput, err error) {
+	req, out := c.ListLocalDisksRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListLocalDisks *aws.Operation
 
 // ListVolumeRecoveryPointsRequest generates a request for the ListVolumeRecoveryPoints operation.
@@ -1422,6 +1888,17 @@ func (c *StorageGateway) ListVolumeRecoveryPoints(input *ListVolumeRecoveryPoint
 	return
 }
 
+// ListVolumeRecoveryPointsWithContext is the same as ListVolumeRecoveryPoints with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) ListVolumeRecoveryPointsWithContext(ctx context.Context, input *ListVolumeRecoveryPointsInput) (output *ListVolumeRecoveryPointsOutput, err error) {
+	req, out := c.ListVolumeRecoveryPointsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opListVolumeRecoveryPoints *aws.Operation
 
 // ListVolumesRequest generates a request for the ListVolumes operation.
@@ -1434,6 +1911,12 @@ func (c *StorageGateway) ListVolumesRequest(input *ListVolumesInput) (req *aws.R
 			Name:       "ListVolumes",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "Limit",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1464,6 +1947,27 @@ func (c *StorageGateway) ListVolumes(input *ListVolumesInput) (output *ListVolum
 	return
 }
 
+// ListVolumesWithContext is the same as ListVolumes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) ListVolumesWithContext(ctx context.Context, input *ListVolumesInput) (output *ListVolumesOutput, err error) {
+	req, out := c.ListVolumesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListVolumesPages iterates over the pages of a ListVolumes operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *StorageGateway) ListVolumesPages(input *ListVolumesInput, fn func(p *ListVolumesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListVolumesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListVolumesOutput), lastPage)
+	})
+}
+
 var opListVolumes *aws.Operation
 
 // ResetCacheRequest generates a request for the ResetCache operation.
@@ -1499,6 +2003,17 @@ func (c *StorageGateway) ResetCache(input *ResetCacheInput) (output *ResetCacheO
 	return
 }
 
+// ResetCacheWithContext is the same as ResetCache with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) ResetCacheWithContext(ctx context.Context, input *ResetCacheInput) (output *ResetCacheOutput, err error) {
+	req, out := c.ResetCacheRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opResetCache *aws.Operation
 
 // RetrieveTapeArchiveRequest generates a request for the RetrieveTapeArchive operation.
@@ -1539,6 +2054,17 @@ func (c *StorageGateway) RetrieveTapeArchive(input *RetrieveTapeArchiveInput) (o
 	return
 }
 
+// RetrieveTapeArchiveWithContext is the same as RetrieveTapeArchive with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) RetrieveTapeArchiveWithContext(ctx context.Context, input *RetrieveTapeArchiveInput) (output *RetrieveTapeArchiveOutput, err error) {
+	req, out := c.RetrieveTapeArchiveRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRetrieveTapeArchive *aws.Operation
 
 // RetrieveTapeRecoveryPointRequest generates a request for the RetrieveTapeRecoveryPoint operation.
@@ -1580,6 +2106,17 @@ func (c *StorageGateway) RetrieveTapeRecoveryPoint(input *RetrieveTapeRecoveryPo
 	return
 }
 
+// RetrieveTapeRecoveryPointWithContext is the same as RetrieveTapeRecoveryPoint with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) RetrieveTapeRecoveryPointWithContext(ctx context.Context, input *RetrieveTapeRecoveryPointInput) (output *RetrieveTapeRecoveryPointOutput, err error) {
+	req, out := c.RetrieveTapeRecoveryPointRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRetrieveTapeRecoveryPoint *aws.Operation
 
 // ShutdownGatewayRequest generates a request for the ShutdownGateway operation.
@@ -1631,6 +2168,17 @@ func (c *StorageGateway) ShutdownGateway(input *ShutdownGatewayInput) (output *S
 	return
 }
 
+// ShutdownGatewayWithContext is the same as ShutdownGateway with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) ShutdownGatewayWithContext(ctx context.Context, input *ShutdownGatewayInput) (output *ShutdownGatewayOutput, err error) {
+	req, out := c.ShutdownGatewayRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opShutdownGateway *aws.Operation
 
 // StartGatewayRequest generates a request for the StartGateway operation.
@@ -1673,6 +2221,17 @@ func (c *StorageGateway) StartGateway(input *StartGatewayInput) (output *StartGa
 	return
 }
 
+// StartGatewayWithContext is the same as StartGateway with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) StartGatewayWithContext(ctx context.Context, input *StartGatewayInput) (output *StartGatewayOutput, err error) {
+	req, out := c.StartGatewayRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opStartGateway *aws.Operation
 
 // UpdateBandwidthRateLimitRequest generates a request for the UpdateBandwidthRateLimit operation.
@@ -1716,6 +2275,17 @@ func (c *StorageGateway) UpdateBandwidthRateLimit(input *UpdateBandwidthRateLimi
 	return
 }
 
+// UpdateBandwidthRateLimitWithContext is the same as UpdateBandwidthRateLimit with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) UpdateBandwidthRateLimitWithContext(ctx context.Context, input *UpdateBandwidthRateLimitInput) (output *UpdateBandwidthRateLimitOutput, err error) {
+	req, out := c.UpdateBandwidthRateLimitRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateBandwidthRateLimit *aws.Operation
 
 // UpdateChapCredentialsRequest generates a request for the UpdateChapCredentials operation.
@@ -1754,6 +2324,17 @@ func (c *StorageGateway) UpdateChapCredentials(input *UpdateChapCredentialsInput
 	return
 }
 
+// UpdateChapCredentialsWithContext is the same as UpdateChapCredentials with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) UpdateChapCredentialsWithContext(ctx context.Context, input *UpdateChapCredentialsInput) (output *UpdateChapCredentialsOutput, err error) {
+	req, out := c.UpdateChapCredentialsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateChapCredentials *aws.Operation
 
 // UpdateGatewayInformationRequest generates a request for the UpdateGatewayInformation operation.
@@ -1789,6 +2370,17 @@ func (c *StorageGateway) UpdateGatewayInformation(input *UpdateGatewayInformatio
 	return
 }
 
+// UpdateGatewayInformationWithContext is the same as UpdateGatewayInformation with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) UpdateGatewayInformationWithContext(ctx context.Context, input *UpdateGatewayInformationInput) (output *UpdateGatewayInformationOutput, err error) {
+	req, out := c.UpdateGatewayInformationRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateGatewayInformation *aws.Operation
 
 // UpdateGatewaySoftwareNowRequest generates a request for the UpdateGatewaySoftwareNow operation.
@@ -1834,6 +2426,17 @@ func (c *StorageGateway) UpdateGatewaySoftwareNow(input *UpdateGatewaySoftwareNo
 	return
 }
 
+// UpdateGatewaySoftwareNowWithContext is the same as UpdateGatewaySoftwareNow with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) UpdateGatewaySoftwareNowWithContext(ctx context.Context, input *UpdateGatewaySoftwareNowInput) (output *UpdateGatewaySoftwareNowOutput, err error) {
+	req, out := c.UpdateGatewaySoftwareNowRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateGatewaySoftwareNow *aws.Operation
 
 // UpdateMaintenanceStartTimeRequest generates a request for the UpdateMaintenanceStartTime operation.
@@ -1869,6 +2472,17 @@ func (c *StorageGateway) UpdateMaintenanceStartTime(input *UpdateMaintenanceStar
 	return
 }
 
+// UpdateMaintenanceStartTimeWithContext is the same as UpdateMaintenanceStartTime with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) UpdateMaintenanceStartTimeWithContext(ctx context.Context, input *UpdateMaintenanceStartTimeInput) (output *UpdateMaintenanceStartTimeOutput, err error) {
+	req, out := c.UpdateMaintenanceStartTimeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateMaintenanceStartTime *aws.Operation
 
 // UpdateSnapshotScheduleRequest generates a request for the UpdateSnapshotSchedule operation.
@@ -1910,6 +2524,17 @@ func (c *StorageGateway) UpdateSnapshotSchedule(input *UpdateSnapshotScheduleInp
 	return
 }
 
+// UpdateSnapshotScheduleWithContext is the same as UpdateSnapshotSchedule with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) UpdateSnapshotScheduleWithContext(ctx context.Context, input *UpdateSnapshotScheduleInput) (output *UpdateSnapshotScheduleOutput, err error) {
+	req, out := c.UpdateSnapshotScheduleRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateSnapshotSchedule *aws.Operation
 
 // UpdateVTLDeviceTypeRequest generates a request for the UpdateVTLDeviceType operation.
@@ -1946,6 +2571,17 @@ func (c *StorageGateway) UpdateVTLDeviceType(input *UpdateVTLDeviceTypeInput) (o
 	return
 }
 
+// UpdateVTLDeviceTypeWithContext is the same as UpdateVTLDeviceType with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *StorageGateway) UpdateVTLDeviceTypeWithContext(ctx context.Context, input *UpdateVTLDeviceTypeInput) (output *UpdateVTLDeviceTypeOutput, err error) {
+	req, out := c.UpdateVTLDeviceTypeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opUpdateVTLDeviceType *aws.Operation
 
 // A JSON object containing one or more of the following fields:
@@ -1961,11 +2597,11 @@ type ActivateGatewayInput struct {
 	// also include other activation-related parameters, however, these are merely
 	// defaults -- the arguments you pass to the ActivateGateway API call determine
 	// the actual configuration of your gateway.
-	ActivationKey *string `type:"string" required:"true"`
+	ActivationKey *string `type:"string" min:"1" max:"50" required:"true"`
 
 	// A unique identifier for your gateway. This name becomes part of the gateway
 	// Amazon Resources Name (ARN) which is what you use as an input to other operations.
-	GatewayName *string `type:"string" required:"true"`
+	GatewayName *string `type:"string" min:"2" max:"255" pattern:"^[ -\\.0-\\[\\]-~]*[!-\\.0-\\[\\]-~][ -\\.0-\\[\\]-~]*$" required:"true"`
 
 	// One of the values that indicates the region where you want to store the snapshot
 	// backups. The gateway region specified must be the same region as the region
@@ -1976,29 +2612,29 @@ type ActivateGatewayInput struct {
 	//
 	// Valid Values: "us-east-1", "us-west-1", "us-west-2", "eu-west-1", "eu-central-1",
 	// "ap-northeast-1", "ap-southeast-1", "ap-southeast-2", "sa-east-1"
-	GatewayRegion *string `type:"string" required:"true"`
+	GatewayRegion *string `type:"string" min:"1" max:"25" required:"true"`
 
 	// One of the values that indicates the time zone you want to set for the gateway.
 	// The time zone is used, for example, for scheduling snapshots and your gateway's
 	// maintenance schedule.
-	GatewayTimezone *string `type:"string" required:"true"`
+	GatewayTimezone *string `type:"string" min:"3" max:"10" required:"true"`
 
 	// One of the values that defines the type of gateway to activate. The type
 	// specified is critical to all later functions of the gateway and cannot be
 	// changed after activation. The default value is STORED.
-	GatewayType *string `type:"string"`
+	GatewayType *string `type:"string" min:"2" max:"20"`
 
 	// The value that indicates the type of medium changer to use for gateway-VTL.
 	// This field is optional.
 	//
 	// Valid Values: "STK-L700", "AWS-Gateway-VTL"
-	MediumChangerType *string `type:"string"`
+	MediumChangerType *string `type:"string" min:"2" max:"50"`
 
 	// The value that indicates the type of tape drive to use for gateway-VTL. This
 	// field is optional.
 	//
 	// Valid Values: "IBM-ULT3580-TD5"
-	TapeDriveType *string `type:"string"`
+	TapeDriveType *string `type:"string" min:"2" max:"50"`
 
 	metadataActivateGatewayInput `json:"-", xml:"-"`
 }
@@ -2014,7 +2650,7 @@ type metadataActivateGatewayInput struct {
 type ActivateGatewayOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataActivateGatewayOutput `json:"-", xml:"-"`
 }
@@ -2028,7 +2664,7 @@ type AddCacheInput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataAddCacheInput `json:"-", xml:"-"`
 }
@@ -2040,7 +2676,7 @@ type metadataAddCacheInput struct {
 type AddCacheOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataAddCacheOutput `json:"-", xml:"-"`
 }
@@ -2054,7 +2690,7 @@ type AddUploadBufferInput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataAddUploadBufferInput `json:"-", xml:"-"`
 }
@@ -2066,7 +2702,7 @@ type metadataAddUploadBufferInput struct {
 type AddUploadBufferOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataAddUploadBufferOutput `json:"-", xml:"-"`
 }
@@ -2086,7 +2722,7 @@ type AddWorkingStorageInput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataAddWorkingStorageInput `json:"-", xml:"-"`
 }
@@ -2100,7 +2736,7 @@ type metadataAddWorkingStorageInput struct {
 type AddWorkingStorageOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataAddWorkingStorageOutput `json:"-", xml:"-"`
 }
@@ -2110,19 +2746,19 @@ type metadataAddWorkingStorageOutput struct {
 }
 
 type CachediSCSIVolume struct {
-	SourceSnapshotID *string `locationName:"SourceSnapshotId" type:"string"`
+	SourceSnapshotID *string `locationName:"SourceSnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
-	VolumeID *string `locationName:"VolumeId" type:"string"`
+	VolumeID *string `locationName:"VolumeId" type:"string" min:"12" max:"30"`
 
 	VolumeProgress *float64 `type:"double"`
 
 	VolumeSizeInBytes *int64 `type:"long"`
 
-	VolumeStatus *string `type:"string"`
+	VolumeStatus *string `type:"string" min:"3" max:"50"`
 
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" min:"3" max:"100"`
 
 	// Lists iSCSI information about a volume.
 	VolumeiSCSIAttributes *VolumeiSCSIAttributes `type:"structure"`
@@ -2138,11 +2774,11 @@ type metadataCachediSCSIVolume struct {
 type CancelArchivalInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape you want to cancel archiving
 	// for.
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataCancelArchivalInput `json:"-", xml:"-"`
 }
@@ -2155,7 +2791,7 @@ type metadataCancelArchivalInput struct {
 type CancelArchivalOutput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape for which archiving was
 	// canceled.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataCancelArchivalOutput `json:"-", xml:"-"`
 }
@@ -2168,11 +2804,11 @@ type metadataCancelArchivalOutput struct {
 type CancelRetrievalInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape you want to cancel retrieval
 	// for.
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataCancelRetrievalInput `json:"-", xml:"-"`
 }
@@ -2185,7 +2821,7 @@ type metadataCancelRetrievalInput struct {
 type CancelRetrievalOutput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape for which retrieval was
 	// canceled.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataCancelRetrievalOutput `json:"-", xml:"-"`
 }
@@ -2198,21 +2834,21 @@ type metadataCancelRetrievalOutput struct {
 // that supports authentication between your gateway and iSCSI initiators.
 type ChapInfo struct {
 	// The iSCSI initiator that connects to the target.
-	InitiatorName *string `type:"string"`
+	InitiatorName *string `type:"string" min:"1" max:"255" pattern:"[0-9a-z:.-]+"`
 
 	// The secret key that the initiator (e.g. Windows client) must provide to participate
 	// in mutual CHAP with the target.
-	SecretToAuthenticateInitiator *string `type:"string"`
+	SecretToAuthenticateInitiator *string `type:"string" min:"12" max:"16"`
 
 	// The secret key that the target must provide to participate in mutual CHAP
 	// with the initiator (e.g. Windows client).
-	SecretToAuthenticateTarget *string `type:"string"`
+	SecretToAuthenticateTarget *string `type:"string" min:"12" max:"16"`
 
 	// The Amazon Resource Name (ARN) of the volume.
 	//
 	// Valid Values: 50 to 500 lowercase letters, numbers, periods (.), and hyphens
 	// (-).
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataChapInfo `json:"-", xml:"-"`
 }
@@ -2222,17 +2858,17 @@ type metadataChapInfo struct {
 }
 
 type CreateCachediSCSIVolumeInput struct {
-	ClientToken *string `type:"string" required:"true"`
+	ClientToken *string `type:"string" min:"5" max:"100" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
-	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" required:"true"`
+	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" pattern:"\\A(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)(\\.(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)){3}\\z" required:"true"`
 
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
-	TargetName *string `type:"string" required:"true"`
+	TargetName *string `type:"string" min:"1" max:"200" pattern:"^[-\\.;a-z0-9]+$" required:"true"`
 
 	VolumeSizeInBytes *int64 `type:"long" required:"true"`
 
@@ -2244,9 +2880,9 @@ type metadataCreateCachediSCSIVolumeInput struct {
 }
 
 type CreateCachediSCSIVolumeOutput struct {
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataCreateCachediSCSIVolumeOutput `json:"-", xml:"-"`
 }
@@ -2256,9 +2892,9 @@ type metadataCreateCachediSCSIVolumeOutput struct {
 }
 
 type CreateSnapshotFromVolumeRecoveryPointInput struct {
-	SnapshotDescription *string `type:"string" required:"true"`
+	SnapshotDescription *string `type:"string" min:"1" max:"255" required:"true"`
 
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataCreateSnapshotFromVolumeRecoveryPointInput `json:"-", xml:"-"`
 }
@@ -2268,9 +2904,9 @@ type metadataCreateSnapshotFromVolumeRecoveryPointInput struct {
 }
 
 type CreateSnapshotFromVolumeRecoveryPointOutput struct {
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	VolumeRecoveryPointTime *string `type:"string"`
 
@@ -2288,11 +2924,11 @@ type CreateSnapshotInput struct {
 	// Textual description of the snapshot that appears in the Amazon EC2 console,
 	// Elastic Block Store snapshots panel in the Description field, and in the
 	// AWS Storage Gateway snapshot Details pane, Description field
-	SnapshotDescription *string `type:"string" required:"true"`
+	SnapshotDescription *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataCreateSnapshotInput `json:"-", xml:"-"`
 }
@@ -2306,10 +2942,10 @@ type CreateSnapshotOutput struct {
 	// The snapshot ID that is used to refer to the snapshot in future operations
 	// such as describing snapshots (Amazon Elastic Compute Cloud API DescribeSnapshots)
 	// or creating a volume from a snapshot (CreateStorediSCSIVolume).
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
 	// The Amazon Resource Name (ARN) of the volume of which the snapshot was taken.
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataCreateSnapshotOutput `json:"-", xml:"-"`
 }
@@ -2327,18 +2963,18 @@ type CreateStorediSCSIVolumeInput struct {
 	// The unique identifier for the gateway local disk that is configured as a
 	// stored volume. Use ListLocalDisks (http://docs.aws.amazon.com/storagegateway/latest/userguide/API_ListLocalDisks.html)
 	// to list disk IDs for a gateway.
-	DiskID *string `locationName:"DiskId" type:"string" required:"true"`
+	DiskID *string `locationName:"DiskId" type:"string" min:"1" max:"300" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The network interface of the gateway on which to expose the iSCSI target.
 	// Only IPv4 addresses are accepted. Use DescribeGatewayInformation to get a
 	// list of the network interfaces available on a gateway.
 	//
 	// Valid Values: A valid IP address.
-	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" required:"true"`
+	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" pattern:"\\A(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)(\\.(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)){3}\\z" required:"true"`
 
 	// Specify this field as true if you want to preserve the data on the local
 	// disk. Otherwise, specifying this field as false creates an empty volume.
@@ -2351,13 +2987,13 @@ type CreateStorediSCSIVolumeInput struct {
 	// volume from a snapshot otherwise do not include this field. To list snapshots
 	// for your account use DescribeSnapshots (http://docs.aws.amazon.com/AWSEC2/latest/APIReference/ApiReference-query-DescribeSnapshots.html)
 	// in the Amazon Elastic Compute Cloud API Reference.
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
 	// The name of the iSCSI target used by initiators to connect to the target
 	// and as a suffix for the target ARN. For example, specifying TargetName as
 	// myvolume results in the target ARN of arn:aws:storagegateway:us-east-1:111122223333:gateway/mygateway/target/iqn.1997-05.com.amazon:myvolume.
 	// The target name must be unique across all volumes of a gateway.
-	TargetName *string `type:"string" required:"true"`
+	TargetName *string `type:"string" min:"1" max:"200" pattern:"^[-\\.;a-z0-9]+$" required:"true"`
 
 	metadataCreateStorediSCSIVolumeInput `json:"-", xml:"-"`
 }
@@ -2370,10 +3006,10 @@ type metadataCreateStorediSCSIVolumeInput struct {
 type CreateStorediSCSIVolumeOutput struct {
 	// he Amazon Resource Name (ARN) of the volume target that includes the iSCSI
 	// name that initiators can use to connect to the target.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	// The Amazon Resource Name (ARN) of the configured volume.
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	// The size of the volume in bytes.
 	VolumeSizeInBytes *int64 `type:"long"`
@@ -2391,22 +3027,22 @@ type CreateTapesInput struct {
 	// use the same ClientToken you specified in the initial request.
 	//
 	// Using the same ClientToken prevents creating the tape multiple times.
-	ClientToken *string `type:"string" required:"true"`
+	ClientToken *string `type:"string" min:"5" max:"100" required:"true"`
 
 	// The unique Amazon Resource Name(ARN) that represents the gateway to associate
 	// the virtual tapes with. Use the ListGateways operation to return a list of
 	// gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The number of virtual tapes you want to create.
-	NumTapesToCreate *int64 `type:"integer" required:"true"`
+	NumTapesToCreate *int64 `type:"integer" min:"1" max:"10" required:"true"`
 
 	// A prefix you append to the barcode of the virtual tape you are creating.
 	// This makes a barcode unique.
 	//
 	// The prefix must be 1 to 4 characters in length and must be upper-case letters
 	// A-Z.
-	TapeBarcodePrefix *string `type:"string" required:"true"`
+	TapeBarcodePrefix *string `type:"string" min:"1" max:"4" pattern:"^[A-Z]*$" required:"true"`
 
 	// The size, in bytes, of the virtual tapes you want to create.
 	//
@@ -2434,11 +3070,11 @@ type metadataCreateTapesOutput struct {
 }
 
 type DeleteBandwidthRateLimitInput struct {
-	BandwidthType *string `type:"string" required:"true"`
+	BandwidthType *string `type:"string" min:"3" max:"25" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteBandwidthRateLimitInput `json:"-", xml:"-"`
 }
@@ -2452,7 +3088,7 @@ type metadataDeleteBandwidthRateLimitInput struct {
 type DeleteBandwidthRateLimitOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteBandwidthRateLimitOutput `json:"-", xml:"-"`
 }
@@ -2466,11 +3102,11 @@ type metadataDeleteBandwidthRateLimitOutput struct {
 //   DeleteChapCredentialsInput$InitiatorName   DeleteChapCredentialsInput$TargetARN
 type DeleteChapCredentialsInput struct {
 	// The iSCSI initiator that connects to the target.
-	InitiatorName *string `type:"string" required:"true"`
+	InitiatorName *string `type:"string" min:"1" max:"255" pattern:"[0-9a-z:.-]+" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the iSCSI volume target. Use the DescribeStorediSCSIVolumes
 	// operation to return to retrieve the TargetARN for specified VolumeARN.
-	TargetARN *string `type:"string" required:"true"`
+	TargetARN *string `type:"string" min:"50" max:"800" required:"true"`
 
 	metadataDeleteChapCredentialsInput `json:"-", xml:"-"`
 }
@@ -2482,10 +3118,10 @@ type metadataDeleteChapCredentialsInput struct {
 // A JSON object containing the following fields:
 type DeleteChapCredentialsOutput struct {
 	// The iSCSI initiator that connects to the target.
-	InitiatorName *string `type:"string"`
+	InitiatorName *string `type:"string" min:"1" max:"255" pattern:"[0-9a-z:.-]+"`
 
 	// The Amazon Resource Name (ARN) of the target.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataDeleteChapCredentialsOutput `json:"-", xml:"-"`
 }
@@ -2498,7 +3134,7 @@ type metadataDeleteChapCredentialsOutput struct {
 type DeleteGatewayInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteGatewayInput `json:"-", xml:"-"`
 }
@@ -2511,7 +3147,7 @@ type metadataDeleteGatewayInput struct {
 type DeleteGatewayOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteGatewayOutput `json:"-", xml:"-"`
 }
@@ -2521,7 +3157,7 @@ type metadataDeleteGatewayOutput struct {
 }
 
 type DeleteSnapshotScheduleInput struct {
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteSnapshotScheduleInput `json:"-", xml:"-"`
 }
@@ -2531,7 +3167,7 @@ type metadataDeleteSnapshotScheduleInput struct {
 }
 
 type DeleteSnapshotScheduleOutput struct {
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteSnapshotScheduleOutput `json:"-", xml:"-"`
 }
@@ -2544,7 +3180,7 @@ type metadataDeleteSnapshotScheduleOutput struct {
 type DeleteTapeArchiveInput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape to delete from the virtual
 	// tape shelf (VTS).
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteTapeArchiveInput `json:"-", xml:"-"`
 }
@@ -2557,7 +3193,7 @@ type metadataDeleteTapeArchiveInput struct {
 type DeleteTapeArchiveOutput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape that was deleted from
 	// the virtual tape shelf (VTS).
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteTapeArchiveOutput `json:"-", xml:"-"`
 }
@@ -2571,10 +3207,10 @@ type DeleteTapeInput struct {
 	// The unique Amazon Resource Name (ARN) of the gateway that the virtual tape
 	// to delete is associated with. Use the ListGateways operation to return a
 	// list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape to delete.
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteTapeInput `json:"-", xml:"-"`
 }
@@ -2586,7 +3222,7 @@ type metadataDeleteTapeInput struct {
 // DeleteTapeOutput
 type DeleteTapeOutput struct {
 	// The Amazon Resource Name (ARN) of the deleted virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteTapeOutput `json:"-", xml:"-"`
 }
@@ -2599,7 +3235,7 @@ type metadataDeleteTapeOutput struct {
 type DeleteVolumeInput struct {
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteVolumeInput `json:"-", xml:"-"`
 }
@@ -2612,7 +3248,7 @@ type metadataDeleteVolumeInput struct {
 type DeleteVolumeOutput struct {
 	// The Amazon Resource Name (ARN) of the storage volume that was deleted. It
 	// is the same ARN you provided in the request.
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteVolumeOutput `json:"-", xml:"-"`
 }
@@ -2625,7 +3261,7 @@ type metadataDeleteVolumeOutput struct {
 type DescribeBandwidthRateLimitInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeBandwidthRateLimitInput `json:"-", xml:"-"`
 }
@@ -2638,15 +3274,15 @@ type metadataDescribeBandwidthRateLimitInput struct {
 type DescribeBandwidthRateLimitOutput struct {
 	// The average download bandwidth rate limit in bits per second. This field
 	// does not appear in the response if the download rate limit is not set.
-	AverageDownloadRateLimitInBitsPerSec *int64 `type:"long"`
+	AverageDownloadRateLimitInBitsPerSec *int64 `type:"long" min:"102400"`
 
 	// The average upload bandwidth rate limit in bits per second. This field does
 	// not appear in the response if the upload rate limit is not set.
-	AverageUploadRateLimitInBitsPerSec *int64 `type:"long"`
+	AverageUploadRateLimitInBitsPerSec *int64 `type:"long" min:"51200"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDescribeBandwidthRateLimitOutput `json:"-", xml:"-"`
 }
@@ -2658,7 +3294,7 @@ type metadataDescribeBandwidthRateLimitOutput struct {
 type DescribeCacheInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeCacheInput `json:"-", xml:"-"`
 }
@@ -2682,7 +3318,7 @@ type DescribeCacheOutput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDescribeCacheOutput `json:"-", xml:"-"`
 }
@@ -2719,7 +3355,7 @@ type metadataDescribeCachediSCSIVolumesOutput struct {
 type DescribeChapCredentialsInput struct {
 	// The Amazon Resource Name (ARN) of the iSCSI volume target. Use the DescribeStorediSCSIVolumes
 	// operation to return to retrieve the TargetARN for specified VolumeARN.
-	TargetARN *string `type:"string" required:"true"`
+	TargetARN *string `type:"string" min:"50" max:"800" required:"true"`
 
 	metadataDescribeChapCredentialsInput `json:"-", xml:"-"`
 }
@@ -2757,7 +3393,7 @@ type metadataDescribeChapCredentialsOutput struct {
 type DescribeGatewayInformationInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeGatewayInformationInput `json:"-", xml:"-"`
 }
@@ -2770,28 +3406,28 @@ type metadataDescribeGatewayInformationInput struct {
 type DescribeGatewayInformationOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	// The gateway ID.
-	GatewayID *string `locationName:"GatewayId" type:"string"`
+	GatewayID *string `locationName:"GatewayId" type:"string" min:"12" max:"30"`
 
 	// A NetworkInterface array that contains descriptions of the gateway network
 	// interfaces.
 	GatewayNetworkInterfaces []*NetworkInterface `type:"list"`
 
 	// One of the values that indicates the operating state of the gateway.
-	GatewayState *string `type:"string"`
+	GatewayState *string `type:"string" min:"2" max:"25"`
 
 	// One of the values that indicates the time zone configured for the gateway.
-	GatewayTimezone *string `type:"string"`
+	GatewayTimezone *string `type:"string" min:"3" max:"10"`
 
 	// TBD
-	GatewayType *string `type:"string"`
+	GatewayType *string `type:"string" min:"2" max:"20"`
 
 	// The date at which an update to the gateway is available. This date is in
 	// the time zone of the gateway. If the gateway is not available for an update
 	// this field is not returned in the response.
-	NextUpdateAvailabilityDate *string `type:"string"`
+	NextUpdateAvailabilityDate *string `type:"string" min:"1" max:"25"`
 
 	metadataDescribeGatewayInformationOutput `json:"-", xml:"-"`
 }
@@ -2804,7 +3440,7 @@ type metadataDescribeGatewayInformationOutput struct {
 type DescribeMaintenanceStartTimeInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeMaintenanceStartTimeInput `json:"-", xml:"-"`
 }
@@ -2814,17 +3450,17 @@ type metadataDescribeMaintenanceStartTimeInput struct {
 }
 
 type DescribeMaintenanceStartTimeOutput struct {
-	DayOfWeek *int64 `type:"integer"`
+	DayOfWeek *int64 `type:"integer" min:"0" max:"6"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
-	HourOfDay *int64 `type:"integer"`
+	HourOfDay *int64 `type:"integer" min:"0" max:"23"`
 
-	MinuteOfHour *int64 `type:"integer"`
+	MinuteOfHour *int64 `type:"integer" min:"0" max:"59"`
 
-	Timezone *string `type:"string"`
+	Timezone *string `type:"string" min:"3" max:"10"`
 
 	metadataDescribeMaintenanceStartTimeOutput `json:"-", xml:"-"`
 }
@@ -2838,7 +3474,7 @@ type metadataDescribeMaintenanceStartTimeOutput struct {
 type DescribeSnapshotScheduleInput struct {
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeSnapshotScheduleInput `json:"-", xml:"-"`
 }
@@ -2848,15 +3484,15 @@ type metadataDescribeSnapshotScheduleInput struct {
 }
 
 type DescribeSnapshotScheduleOutput struct {
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"1" max:"255"`
 
-	RecurrenceInHours *int64 `type:"integer"`
+	RecurrenceInHours *int64 `type:"integer" min:"1" max:"24"`
 
-	StartAt *int64 `type:"integer"`
+	StartAt *int64 `type:"integer" min:"0" max:"23"`
 
-	Timezone *string `type:"string"`
+	Timezone *string `type:"string" min:"3" max:"10"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDescribeSnapshotScheduleOutput `json:"-", xml:"-"`
 }
@@ -2893,11 +3529,11 @@ type metadataDescribeStorediSCSIVolumesOutput struct {
 type DescribeTapeArchivesInput struct {
 	// Specifies that the number of virtual tapes descried be limited to the specified
 	// number.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// An opaque string that indicates the position at which to begin describing
 	// virtual tapes.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// Specifies one or more unique Amazon Resource Names (ARNs) that represent
 	// the virtual tapes you want to describe.
@@ -2917,7 +3553,7 @@ type DescribeTapeArchivesOutput struct {
 	// to fetch the next set of virtual tapes in the virtual tape shelf (VTS). If
 	// there are no more virtual tapes to describe, this field does not appear in
 	// the response.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of virtual tape objects in the virtual tape shelf (VTS). The description
 	// includes of the Amazon Resource Name(ARN) of the virtual tapes. The information
@@ -2936,15 +3572,15 @@ type metadataDescribeTapeArchivesOutput struct {
 type DescribeTapeRecoveryPointsInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// Specifies that the number of virtual tape recovery points that are described
 	// be limited to the specified number.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// An opaque string that indicates the position at which to begin describing
 	// the virtual tape recovery points.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	metadataDescribeTapeRecoveryPointsInput `json:"-", xml:"-"`
 }
@@ -2957,7 +3593,7 @@ type metadataDescribeTapeRecoveryPointsInput struct {
 type DescribeTapeRecoveryPointsOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	// An opaque string that indicates the position at which the virtual tape recovery
 	// points that were listed for description ended.
@@ -2965,7 +3601,7 @@ type DescribeTapeRecoveryPointsOutput struct {
 	// Use this marker in your next request to list the next set of virtual tape
 	// recovery points in the list. If there are no more recovery points to describe,
 	// this field does not appear in the response.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of TapeRecoveryPointInfos that are available for the specified gateway.
 	TapeRecoveryPointInfos []*TapeRecoveryPointInfo `type:"list"`
@@ -2981,19 +3617,19 @@ type metadataDescribeTapeRecoveryPointsOutput struct {
 type DescribeTapesInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// Specifies that the number of virtual tapes described be limited to the specified
 	// number.
 	//
 	// Amazon Web Services may impose its own limit, if this field is not set.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A marker value, obtained in a previous call to DescribeTapes. This marker
 	// indicates which page of results to retrieve.
 	//
 	// If not specified, the first page of results is retrieved.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// Specifies one or more unique Amazon Resource Names (ARNs) that represent
 	// the virtual tapes you want to describe. If this parameter is not specified,
@@ -3015,7 +3651,7 @@ type DescribeTapesOutput struct {
 	//
 	// If a response does not contain a marker, then there are no more results
 	// to be retrieved.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of virtual tape descriptions.
 	Tapes []*Tape `type:"list"`
@@ -3030,7 +3666,7 @@ type metadataDescribeTapesOutput struct {
 type DescribeUploadBufferInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeUploadBufferInput `json:"-", xml:"-"`
 }
@@ -3044,7 +3680,7 @@ type DescribeUploadBufferOutput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	UploadBufferAllocatedInBytes *int64 `type:"long"`
 
@@ -3061,15 +3697,15 @@ type metadataDescribeUploadBufferOutput struct {
 type DescribeVTLDevicesInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// Specifies that the number of VTL devices described be limited to the specified
 	// number.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// An opaque string that indicates the position at which to begin describing
 	// the VTL devices.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of strings, where each string represents the Amazon Resource Name
 	// (ARN) of a VTL device.
@@ -3090,13 +3726,13 @@ type metadataDescribeVTLDevicesInput struct {
 type DescribeVTLDevicesOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	// An opaque string that indicates the position at which the VTL devices that
 	// were fetched for description ended. Use the marker in your next request to
 	// fetch the next set of VTL devices in the list. If there are no more VTL devices
 	// to describe, this field does not appear in the response.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of VTL device objects composed of the Amazon Resource Name(ARN)
 	// of the VTL devices.
@@ -3113,7 +3749,7 @@ type metadataDescribeVTLDevicesOutput struct {
 type DescribeWorkingStorageInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeWorkingStorageInput `json:"-", xml:"-"`
 }
@@ -3132,7 +3768,7 @@ type DescribeWorkingStorageOutput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	// The total working storage in bytes allocated for the gateway. If no working
 	// storage is configured for the gateway, this field returns 0.
@@ -3155,14 +3791,14 @@ type DeviceiSCSIAttributes struct {
 	ChapEnabled *bool `type:"boolean"`
 
 	// The network interface identifier of the VTL device.
-	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string"`
+	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" pattern:"\\A(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)(\\.(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)){3}\\z"`
 
 	// The port used to communicate with iSCSI VTL device targets.
 	NetworkInterfacePort *int64 `type:"integer"`
 
 	// Specifies the unique Amazon Resource Name(ARN) that encodes the iSCSI qualified
 	// name(iqn) of a tape drive or media changer target.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataDeviceiSCSIAttributes `json:"-", xml:"-"`
 }
@@ -3175,7 +3811,7 @@ type metadataDeviceiSCSIAttributes struct {
 type DisableGatewayInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDisableGatewayInput `json:"-", xml:"-"`
 }
@@ -3187,7 +3823,7 @@ type metadataDisableGatewayInput struct {
 // DisableGatewayOutput
 type DisableGatewayOutput struct {
 	// The unique Amazon Resource Name of the disabled gateway.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDisableGatewayOutput `json:"-", xml:"-"`
 }
@@ -3199,9 +3835,9 @@ type metadataDisableGatewayOutput struct {
 type Disk struct {
 	DiskAllocationResource *string `type:"string"`
 
-	DiskAllocationType *string `type:"string"`
+	DiskAllocationType *string `type:"string" min:"3" max:"100"`
 
-	DiskID *string `locationName:"DiskId" type:"string"`
+	DiskID *string `locationName:"DiskId" type:"string" min:"1" max:"300"`
 
 	DiskNode *string `type:"string"`
 
@@ -3221,11 +3857,11 @@ type metadataDisk struct {
 type GatewayInfo struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
-	GatewayOperationalState *string `type:"string"`
+	GatewayOperationalState *string `type:"string" min:"2" max:"25"`
 
-	GatewayType *string `type:"string"`
+	GatewayType *string `type:"string" min:"2" max:"20"`
 
 	metadataGatewayInfo `json:"-", xml:"-"`
 }
@@ -3240,11 +3876,11 @@ type metadataGatewayInfo struct {
 type ListGatewaysInput struct {
 	// Specifies that the list of gateways returned be limited to the specified
 	// number of items.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// An opaque string that indicates the position at which to begin the returned
 	// list of gateways.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	metadataListGatewaysInput `json:"-", xml:"-"`
 }
@@ -3256,7 +3892,7 @@ type metadataListGatewaysInput struct {
 type ListGatewaysOutput struct {
 	Gateways []*GatewayInfo `type:"list"`
 
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	metadataListGatewaysOutput `json:"-", xml:"-"`
 }
@@ -3269,7 +3905,7 @@ type metadataListGatewaysOutput struct {
 type ListLocalDisksInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataListLocalDisksInput `json:"-", xml:"-"`
 }
@@ -3283,7 +3919,7 @@ type ListLocalDisksOutput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataListLocalDisksOutput `json:"-", xml:"-"`
 }
@@ -3295,7 +3931,7 @@ type metadataListLocalDisksOutput struct {
 type ListVolumeRecoveryPointsInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataListVolumeRecoveryPointsInput `json:"-", xml:"-"`
 }
@@ -3307,7 +3943,7 @@ type metadataListVolumeRecoveryPointsInput struct {
 type ListVolumeRecoveryPointsOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	VolumeRecoveryPointInfos []*VolumeRecoveryPointInfo `type:"list"`
 
@@ -3324,16 +3960,16 @@ type metadataListVolumeRecoveryPointsOutput struct {
 type ListVolumesInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// Specifies that the list of volumes returned be limited to the specified number
 	// of items.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A string that indicates the position at which to begin the returned list
 	// of volumes. Obtain the marker from the response of a previous List iSCSI
 	// Volumes request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	metadataListVolumesInput `json:"-", xml:"-"`
 }
@@ -3345,9 +3981,9 @@ type metadataListVolumesInput struct {
 type ListVolumesOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	VolumeInfos []*VolumeInfo `type:"list"`
 
@@ -3382,7 +4018,7 @@ type metadataNetworkInterface struct {
 type ResetCacheInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataResetCacheInput `json:"-", xml:"-"`
 }
@@ -3394,7 +4030,7 @@ type metadataResetCacheInput struct {
 type ResetCacheOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataResetCacheOutput `json:"-", xml:"-"`
 }
@@ -3411,11 +4047,11 @@ type RetrieveTapeArchiveInput struct {
 	//
 	// You retrieve archived virtual tapes to only one gateway and the gateway
 	// must be a gateway-VTL.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape you want to retrieve from
 	// the virtual tape shelf (VTS).
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataRetrieveTapeArchiveInput `json:"-", xml:"-"`
 }
@@ -3427,7 +4063,7 @@ type metadataRetrieveTapeArchiveInput struct {
 // RetrieveTapeArchiveOutput
 type RetrieveTapeArchiveOutput struct {
 	// The Amazon Resource Name (ARN) of the retrieved virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataRetrieveTapeArchiveOutput `json:"-", xml:"-"`
 }
@@ -3440,11 +4076,11 @@ type metadataRetrieveTapeArchiveOutput struct {
 type RetrieveTapeRecoveryPointInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape for which you want to
 	// retrieve the recovery point.
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataRetrieveTapeRecoveryPointInput `json:"-", xml:"-"`
 }
@@ -3457,7 +4093,7 @@ type metadataRetrieveTapeRecoveryPointInput struct {
 type RetrieveTapeRecoveryPointOutput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape for which the recovery
 	// point was retrieved.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataRetrieveTapeRecoveryPointOutput `json:"-", xml:"-"`
 }
@@ -3470,7 +4106,7 @@ type metadataRetrieveTapeRecoveryPointOutput struct {
 type ShutdownGatewayInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataShutdownGatewayInput `json:"-", xml:"-"`
 }
@@ -3483,7 +4119,7 @@ type metadataShutdownGatewayInput struct {
 type ShutdownGatewayOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataShutdownGatewayOutput `json:"-", xml:"-"`
 }
@@ -3496,7 +4132,7 @@ type metadataShutdownGatewayOutput struct {
 type StartGatewayInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataStartGatewayInput `json:"-", xml:"-"`
 }
@@ -3509,7 +4145,7 @@ type metadataStartGatewayInput struct {
 type StartGatewayOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataStartGatewayOutput `json:"-", xml:"-"`
 }
@@ -3523,7 +4159,7 @@ type metadataStartGatewayOutput struct {
 // about the error.
 type StorageGatewayError struct {
 	// Additional information about the error.
-	ErrorCode *string `locationName:"errorCode" type:"string"`
+	ErrorCode *string `locationName:"errorCode" type:"string" enum:"ActivationKeyExpired,ActivationKeyInvalid,ActivationKeyNotFound,GatewayInternalError,GatewayNotConnected,GatewayNotFound,GatewayProxyNetworkConnectionBusy,AuthenticationFailure,BandwidthThrottleScheduleNotFound,Blocked,CannotExportSnapshot,ChapCredentialNotFound,DiskAlreadyAllocated,DiskDoesNotExist,DiskSizeGreaterThanVolumeMaxSize,DiskSizeLessThanVolumeSize,DiskSizeNotGigAligned,DuplicateCertificateInfo,DuplicateSchedule,EndpointNotFound,IAMNotSupported,InitiatorInvalid,InitiatorNotFound,InternalError,InvalidGateway,InvalidEndpoint,InvalidParameters,InvalidSchedule,LocalStorageLimitExceeded,LunAlreadyAllocated ,LunInvalid,MaximumContentLengthExceeded,MaximumTapeCartridgeCountExceeded,MaximumVolumeCountExceeded,NetworkConfigurationChanged,NoDisksAvailable,NotImplemented,NotSupported,OperationAborted,OutdatedGateway,ParametersNotImplemented,RegionInvalid,RequestTimeout,ServiceUnavailable,SnapshotDeleted,SnapshotIdInvalid,SnapshotInProgress,SnapshotNotFound,SnapshotScheduleNotFound,StagingAreaFull,StorageFailure,TapeCartridgeNotFound,TargetAlreadyExists,TargetInvalid,TargetNotFound,UnauthorizedOperation,VolumeAlreadyExists,VolumeIdInvalid,VolumeInUse,VolumeNotFound,VolumeNotReady"`
 
 	// Human-readable text that provides detail about the error that occurred.
 	ErrorDetails *map[string]*string `locationName:"errorDetails" type:"map"`
@@ -3538,21 +4174,21 @@ type metadataStorageGatewayError struct {
 type StorediSCSIVolume struct {
 	PreservedExistingData *bool `type:"boolean"`
 
-	SourceSnapshotID *string `locationName:"SourceSnapshotId" type:"string"`
+	SourceSnapshotID *string `locationName:"SourceSnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
-	VolumeDiskID *string `locationName:"VolumeDiskId" type:"string"`
+	VolumeDiskID *string `locationName:"VolumeDiskId" type:"string" min:"1" max:"300"`
 
-	VolumeID *string `locationName:"VolumeId" type:"string"`
+	VolumeID *string `locationName:"VolumeId" type:"string" min:"12" max:"30"`
 
 	VolumeProgress *float64 `type:"double"`
 
 	VolumeSizeInBytes *int64 `type:"long"`
 
-	VolumeStatus *string `type:"string"`
+	VolumeStatus *string `type:"string" min:"3" max:"50"`
 
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" min:"3" max:"100"`
 
 	// Lists iSCSI information about a volume.
 	VolumeiSCSIAttributes *VolumeiSCSIAttributes `type:"structure"`
@@ -3573,10 +4209,10 @@ type Tape struct {
 	Progress *float64 `type:"double"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	// The barcode that identifies a specific virtual tape.
-	TapeBarcode *string `type:"string"`
+	TapeBarcode *string `type:"string" min:"8" max:"16" pattern:"^[A-Z0-9]*$"`
 
 	// The size, in bytes, of the virtual tape.
 	TapeSizeInBytes *int64 `type:"long"`
@@ -3586,7 +4222,7 @@ type Tape struct {
 
 	// The virtual tape library (VTL) device that the virtual tape is associated
 	// with.
-	VTLDevice *string `type:"string"`
+	VTLDevice *string `type:"string" min:"50" max:"500"`
 
 	metadataTape `json:"-", xml:"-"`
 }
@@ -3607,13 +4243,13 @@ type TapeArchive struct {
 	// being retrieved to.
 	//
 	// The virtual tape is retrieved from the virtual tape shelf (VTS).
-	RetrievedTo *string `type:"string"`
+	RetrievedTo *string `type:"string" min:"50" max:"500"`
 
 	// The Amazon Resource Name (ARN) of an archived virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	// The barcode that identifies the archived virtual tape.
-	TapeBarcode *string `type:"string"`
+	TapeBarcode *string `type:"string" min:"8" max:"16" pattern:"^[A-Z0-9]*$"`
 
 	// The size, in bytes, of the archived virtual tape.
 	TapeSizeInBytes *int64 `type:"long"`
@@ -3631,7 +4267,7 @@ type metadataTapeArchive struct {
 // Describes a recovery point.
 type TapeRecoveryPointInfo struct {
 	// The Amazon Resource Name (ARN) of the virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	// The time when the point-in-time view of the virtual tape was replicated for
 	// later recovery.
@@ -3657,14 +4293,14 @@ type metadataTapeRecoveryPointInfo struct {
 //   UpdateBandwidthRateLimitInput$AverageDownloadRateLimitInBitsPerSec   UpdateBandwidthRateLimitInput$AverageUploadRateLimitInBitsPerSec
 type UpdateBandwidthRateLimitInput struct {
 	// The average download bandwidth rate limit in bits per second.
-	AverageDownloadRateLimitInBitsPerSec *int64 `type:"long"`
+	AverageDownloadRateLimitInBitsPerSec *int64 `type:"long" min:"102400"`
 
 	// The average upload bandwidth rate limit in bits per second.
-	AverageUploadRateLimitInBitsPerSec *int64 `type:"long"`
+	AverageUploadRateLimitInBitsPerSec *int64 `type:"long" min:"51200"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataUpdateBandwidthRateLimitInput `json:"-", xml:"-"`
 }
@@ -3678,7 +4314,7 @@ type metadataUpdateBandwidthRateLimitInput struct {
 type UpdateBandwidthRateLimitOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateBandwidthRateLimitOutput `json:"-", xml:"-"`
 }
@@ -3693,19 +4329,19 @@ type metadataUpdateBandwidthRateLimitOutput struct {
 //   UpdateChapCredentialsInput$SecretToAuthenticateTarget   UpdateChapCredentialsInput$TargetARN
 type UpdateChapCredentialsInput struct {
 	// The iSCSI initiator that connects to the target.
-	InitiatorName *string `type:"string" required:"true"`
+	InitiatorName *string `type:"string" min:"1" max:"255" pattern:"[0-9a-z:.-]+" required:"true"`
 
 	// The secret key that the initiator (e.g. Windows client) must provide to participate
 	// in mutual CHAP with the target.
-	SecretToAuthenticateInitiator *string `type:"string" required:"true"`
+	SecretToAuthenticateInitiator *string `type:"string" min:"12" max:"16" required:"true"`
 
 	// The secret key that the target must provide to participate in mutual CHAP
 	// with the initiator (e.g. Windows client).
-	SecretToAuthenticateTarget *string `type:"string"`
+	SecretToAuthenticateTarget *string `type:"string" min:"12" max:"16"`
 
 	// The Amazon Resource Name (ARN) of the iSCSI volume target. Use the DescribeStorediSCSIVolumes
 	// operation to return to retrieve the TargetARN for specified VolumeARN.
-	TargetARN *string `type:"string" required:"true"`
+	TargetARN *string `type:"string" min:"50" max:"800" required:"true"`
 
 	metadataUpdateChapCredentialsInput `json:"-", xml:"-"`
 }
@@ -3718,11 +4354,11 @@ type metadataUpdateChapCredentialsInput struct {
 type UpdateChapCredentialsOutput struct {
 	// The iSCSI initiator that connects to the target. This is the same initiator
 	// name specified in the request.
-	InitiatorName *string `type:"string"`
+	InitiatorName *string `type:"string" min:"1" max:"255" pattern:"[0-9a-z:.-]+"`
 
 	// The Amazon Resource Name (ARN) of the target. This is the same target specified
 	// in the request.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataUpdateChapCredentialsOutput `json:"-", xml:"-"`
 }
@@ -3734,13 +4370,13 @@ type metadataUpdateChapCredentialsOutput struct {
 type UpdateGatewayInformationInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// A unique identifier for your gateway. This name becomes part of the gateway
 	// Amazon Resources Name (ARN) which is what you use as an input to other operations.
-	GatewayName *string `type:"string"`
+	GatewayName *string `type:"string" min:"2" max:"255" pattern:"^[ -\\.0-\\[\\]-~]*[!-\\.0-\\[\\]-~][ -\\.0-\\[\\]-~]*$"`
 
-	GatewayTimezone *string `type:"string"`
+	GatewayTimezone *string `type:"string" min:"3" max:"10"`
 
 	metadataUpdateGatewayInformationInput `json:"-", xml:"-"`
 }
@@ -3753,7 +4389,7 @@ type metadataUpdateGatewayInformationInput struct {
 type UpdateGatewayInformationOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateGatewayInformationOutput `json:"-", xml:"-"`
 }
@@ -3766,7 +4402,7 @@ type metadataUpdateGatewayInformationOutput struct {
 type UpdateGatewaySoftwareNowInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataUpdateGatewaySoftwareNowInput `json:"-", xml:"-"`
 }
@@ -3779,7 +4415,7 @@ type metadataUpdateGatewaySoftwareNowInput struct {
 type UpdateGatewaySoftwareNowOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateGatewaySoftwareNowOutput `json:"-", xml:"-"`
 }
@@ -3794,21 +4430,21 @@ type metadataUpdateGatewaySoftwareNowOutput struct {
 //   UpdateMaintenanceStartTimeInput$MinuteOfHour
 type UpdateMaintenanceStartTimeInput struct {
 	// The maintenance start time day of the week.
-	DayOfWeek *int64 `type:"integer" required:"true"`
+	DayOfWeek *int64 `type:"integer" min:"0" max:"6" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The hour component of the maintenance start time represented as hh, where
 	// hh is the hour (00 to 23). The hour of the day is in the time zone of the
 	// gateway.
-	HourOfDay *int64 `type:"integer" required:"true"`
+	HourOfDay *int64 `type:"integer" min:"0" max:"23" required:"true"`
 
 	// The minute component of the maintenance start time represented as mm, where
 	// mm is the minute (00 to 59). The minute of the hour is in the time zone of
 	// the gateway.
-	MinuteOfHour *int64 `type:"integer" required:"true"`
+	MinuteOfHour *int64 `type:"integer" min:"0" max:"59" required:"true"`
 
 	metadataUpdateMaintenanceStartTimeInput `json:"-", xml:"-"`
 }
@@ -3822,7 +4458,7 @@ type metadataUpdateMaintenanceStartTimeInput struct {
 type UpdateMaintenanceStartTimeOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateMaintenanceStartTimeOutput `json:"-", xml:"-"`
 }
@@ -3837,19 +4473,19 @@ type metadataUpdateMaintenanceStartTimeOutput struct {
 //   UpdateSnapshotScheduleInput$StartAt   UpdateSnapshotScheduleInput$VolumeARN
 type UpdateSnapshotScheduleInput struct {
 	// Optional description of the snapshot that overwrites the existing description.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"1" max:"255"`
 
 	// Frequency of snapshots. Specify the number of hours between snapshots.
-	RecurrenceInHours *int64 `type:"integer" required:"true"`
+	RecurrenceInHours *int64 `type:"integer" min:"1" max:"24" required:"true"`
 
 	// The hour of the day at which the snapshot schedule begins represented as
 	// hh, where hh is the hour (0 to 23). The hour of the day is in the time zone
 	// of the gateway.
-	StartAt *int64 `type:"integer" required:"true"`
+	StartAt *int64 `type:"integer" min:"0" max:"23" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataUpdateSnapshotScheduleInput `json:"-", xml:"-"`
 }
@@ -3860,7 +4496,7 @@ type metadataUpdateSnapshotScheduleInput struct {
 
 // A JSON object containing the of the updated storage volume.
 type UpdateSnapshotScheduleOutput struct {
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateSnapshotScheduleOutput `json:"-", xml:"-"`
 }
@@ -3874,10 +4510,10 @@ type UpdateVTLDeviceTypeInput struct {
 	// The type of medium changer you want to select.
 	//
 	// Valid Values: "STK-L700", "AWS-Gateway-VTL"
-	DeviceType *string `type:"string" required:"true"`
+	DeviceType *string `type:"string" min:"2" max:"50" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the medium changer you want to select.
-	VTLDeviceARN *string `type:"string" required:"true"`
+	VTLDeviceARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataUpdateVTLDeviceTypeInput `json:"-", xml:"-"`
 }
@@ -3889,7 +4525,7 @@ type metadataUpdateVTLDeviceTypeInput struct {
 // UpdateVTLDeviceTypeOutput
 type UpdateVTLDeviceTypeOutput struct {
 	// The Amazon Resource Name (ARN) of the medium changer you have selected.
-	VTLDeviceARN *string `type:"string"`
+	VTLDeviceARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateVTLDeviceTypeOutput `json:"-", xml:"-"`
 }
@@ -3905,7 +4541,7 @@ type VTLDevice struct {
 
 	// Specifies the unique Amazon Resource Name (ARN) of the device (tape drive
 	// or media changer).
-	VTLDeviceARN *string `type:"string"`
+	VTLDeviceARN *string `type:"string" min:"50" max:"500"`
 
 	VTLDeviceProductIdentifier *string `type:"string"`
 
@@ -3921,9 +4557,9 @@ type metadataVTLDevice struct {
 }
 
 type VolumeInfo struct {
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" min:"3" max:"100"`
 
 	metadataVolumeInfo `json:"-", xml:"-"`
 }
@@ -3933,7 +4569,7 @@ type metadataVolumeInfo struct {
 }
 
 type VolumeRecoveryPointInfo struct {
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	VolumeRecoveryPointTime *string `type:"string"`
 
@@ -3954,20 +4590,152 @@ type VolumeiSCSIAttributes struct {
 	ChapEnabled *bool `type:"boolean"`
 
 	// The logical disk number.
-	LunNumber *int64 `type:"integer"`
+	LunNumber *int64 `type:"integer" min:"1"`
 
 	// The network interface identifier.
-	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string"`
+	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" pattern:"\\A(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)(\\.(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)){3}\\z"`
 
 	// The port used to communicate with iSCSI targets.
 	NetworkInterfacePort *int64 `type:"integer"`
 
 	// The Amazon Resource Name (ARN) of the volume target.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataVolumeiSCSIAttributes `json:"-", xml:"-"`
 }
 
 type metadataVolumeiSCSIAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for ErrorCode.
+const (
+	ErrorCodeActivationKeyExpired              = "ActivationKeyExpired"
+	ErrorCodeActivationKeyInvalid              = "ActivationKeyInvalid"
+	ErrorCodeActivationKeyNotFound             = "ActivationKeyNotFound"
+	ErrorCodeGatewayInternalError              = "GatewayInternalError"
+	ErrorCodeGatewayNotConnected               = "GatewayNotConnected"
+	ErrorCodeGatewayNotFound                   = "GatewayNotFound"
+	ErrorCodeGatewayProxyNetworkConnectionBusy = "GatewayProxyNetworkConnectionBusy"
+	ErrorCodeAuthenticationFailure             = "AuthenticationFailure"
+	ErrorCodeBandwidthThrottleScheduleNotFound = "BandwidthThrottleScheduleNotFound"
+	ErrorCodeBlocked                           = "Blocked"
+	ErrorCodeCannotExportSnapshot              = "CannotExportSnapshot"
+	ErrorCodeChapCredentialNotFound            = "ChapCredentialNotFound"
+	ErrorCodeDiskAlreadyAllocated              = "DiskAlreadyAllocated"
+	ErrorCodeDiskDoesNotExist                  = "DiskDoesNotExist"
+	ErrorCodeDiskSizeGreaterThanVolumeMaxSize  = "DiskSizeGreaterThanVolumeMaxSize"
+	ErrorCodeDiskSizeLessThanVolumeSize        = "DiskSizeLessThanVolumeSize"
+	ErrorCodeDiskSizeNotGigAligned             = "DiskSizeNotGigAligned"
+	ErrorCodeDuplicateCertificateInfo          = "DuplicateCertificateInfo"
+	ErrorCodeDuplicateSchedule                 = "DuplicateSchedule"
+	ErrorCodeEndpointNotFound                  = "EndpointNotFound"
+	ErrorCodeIAMNotSupported                   = "IAMNotSupported"
+	ErrorCodeInitiatorInvalid                  = "InitiatorInvalid"
+	ErrorCodeInitiatorNotFound                 = "InitiatorNotFound"
+	ErrorCodeInternalError                     = "InternalError"
+	ErrorCodeInvalidGateway                    = "InvalidGateway"
+	ErrorCodeInvalidEndpoint                   = "InvalidEndpoint"
+	ErrorCodeInvalidParameters                 = "InvalidParameters"
+	ErrorCodeInvalidSchedule                   = "InvalidSchedule"
+	ErrorCodeLocalStorageLimitExceeded         = "LocalStorageLimitExceeded"
+	ErrorCodeLunAlreadyAllocated               = "LunAlreadyAllocated "
+	ErrorCodeLunInvalid                        = "LunInvalid"
+	ErrorCodeMaximumContentLengthExceeded      = "MaximumContentLengthExceeded"
+	ErrorCodeMaximumTapeCartridgeCountExceeded = "MaximumTapeCartridgeCountExceeded"
+	ErrorCodeMaximumVolumeCountExceeded        = "MaximumVolumeCountExceeded"
+	ErrorCodeNetworkConfigurationChanged       = "NetworkConfigurationChanged"
+	ErrorCodeNoDisksAvailable                  = "NoDisksAvailable"
+	ErrorCodeNotImplemented                    = "NotImplemented"
+	ErrorCodeNotSupported                      = "NotSupported"
+	ErrorCodeOperationAborted                  = "OperationAborted"
+	ErrorCodeOutdatedGateway                   = "OutdatedGateway"
+	ErrorCodeParametersNotImplemented          = "ParametersNotImplemented"
+	ErrorCodeRegionInvalid                     = "RegionInvalid"
+	ErrorCodeRequestTimeout                    = "RequestTimeout"
+	ErrorCodeServiceUnavailable                = "ServiceUnavailable"
+	ErrorCodeSnapshotDeleted                   = "SnapshotDeleted"
+	ErrorCodeSnapshotIDInvalid                 = "SnapshotIdInvalid"
+	ErrorCodeSnapshotInProgress                = "SnapshotInProgress"
+	ErrorCodeSnapshotNotFound                  = "SnapshotNotFound"
+	ErrorCodeSnapshotScheduleNotFound          = "SnapshotScheduleNotFound"
+	ErrorCodeStagingAreaFull                   = "StagingAreaFull"
+	ErrorCodeStorageFailure                    = "StorageFailure"
+	ErrorCodeTapeCartridgeNotFound             = "TapeCartridgeNotFound"
+	ErrorCodeTargetAlreadyExists               = "TargetAlreadyExists"
+	ErrorCodeTargetInvalid                     = "TargetInvalid"
+	ErrorCodeTargetNotFound                    = "TargetNotFound"
+	ErrorCodeUnauthorizedOperation             = "UnauthorizedOperation"
+	ErrorCodeVolumeAlreadyExists               = "VolumeAlreadyExists"
+	ErrorCodeVolumeIDInvalid                   = "VolumeIdInvalid"
+	ErrorCodeVolumeInUse                       = "VolumeInUse"
+	ErrorCodeVolumeNotFound                    = "VolumeNotFound"
+	ErrorCodeVolumeNotReady                    = "VolumeNotReady"
+)
+
+// ErrorCodeValues returns the valid values of ErrorCode.
+func ErrorCodeValues() []string {
+	return []string{
+		ErrorCodeActivationKeyExpired,
+		ErrorCodeActivationKeyInvalid,
+		ErrorCodeActivationKeyNotFound,
+		ErrorCodeGatewayInternalError,
+		ErrorCodeGatewayNotConnected,
+		ErrorCodeGatewayNotFound,
+		ErrorCodeGatewayProxyNetworkConnectionBusy,
+		ErrorCodeAuthenticationFailure,
+		ErrorCodeBandwidthThrottleScheduleNotFound,
+		ErrorCodeBlocked,
+		ErrorCodeCannotExportSnapshot,
+		ErrorCodeChapCredentialNotFound,
+		ErrorCodeDiskAlreadyAllocated,
+		ErrorCodeDiskDoesNotExist,
+		ErrorCodeDiskSizeGreaterThanVolumeMaxSize,
+		ErrorCodeDiskSizeLessThanVolumeSize,
+		ErrorCodeDiskSizeNotGigAligned,
+		ErrorCodeDuplicateCertificateInfo,
+		ErrorCodeDuplicateSchedule,
+		ErrorCodeEndpointNotFound,
+		ErrorCodeIAMNotSupported,
+		ErrorCodeInitiatorInvalid,
+		ErrorCodeInitiatorNotFound,
+		ErrorCodeInternalError,
+		ErrorCodeInvalidGateway,
+		ErrorCodeInvalidEndpoint,
+		ErrorCodeInvalidParameters,
+		ErrorCodeInvalidSchedule,
+		ErrorCodeLocalStorageLimitExceeded,
+		ErrorCodeLunAlreadyAllocated,
+		ErrorCodeLunInvalid,
+		ErrorCodeMaximumContentLengthExceeded,
+		ErrorCodeMaximumTapeCartridgeCountExceeded,
+		ErrorCodeMaximumVolumeCountExceeded,
+		ErrorCodeNetworkConfigurationChanged,
+		ErrorCodeNoDisksAvailable,
+		ErrorCodeNotImplemented,
+		ErrorCodeNotSupported,
+		ErrorCodeOperationAborted,
+		ErrorCodeOutdatedGateway,
+		ErrorCodeParametersNotImplemented,
+		ErrorCodeRegionInvalid,
+		ErrorCodeRequestTimeout,
+		ErrorCodeServiceUnavailable,
+		ErrorCodeSnapshotDeleted,
+		ErrorCodeSnapshotIDInvalid,
+		ErrorCodeSnapshotInProgress,
+		ErrorCodeSnapshotNotFound,
+		ErrorCodeSnapshotScheduleNotFound,
+		ErrorCodeStagingAreaFull,
+		ErrorCodeStorageFailure,
+		ErrorCodeTapeCartridgeNotFound,
+		ErrorCodeTargetAlreadyExists,
+		ErrorCodeTargetInvalid,
+		ErrorCodeTargetNotFound,
+		ErrorCodeUnauthorizedOperation,
+		ErrorCodeVolumeAlreadyExists,
+		ErrorCodeVolumeIDInvalid,
+		ErrorCodeVolumeInUse,
+		ErrorCodeVolumeNotFound,
+		ErrorCodeVolumeNotReady,
+	}
 }
\ No newline at end of file
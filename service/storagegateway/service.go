@@ -29,6 +29,7 @@ func New(config *aws.Config) *StorageGateway {
 		APIVersion:   "2013-06-30",
 		JSONVersion:  "1.1",
 		TargetPrefix: "StorageGateway_20130630",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
@@ -4,6 +4,7 @@
 package sts
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -113,6 +114,17 @@ func (c *STS) AssumeRole(input *AssumeRoleInput) (output *AssumeRoleOutput, err
 	return
 }
 
+// AssumeRoleWithContext is the same as AssumeRole with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *STS) AssumeRoleWithContext(ctx context.Context, input *AssumeRoleInput) (output *AssumeRoleOutput, err error) {
+	req, out := c.AssumeRoleRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAssumeRole *aws.Operation
 
 // AssumeRoleWithSAMLRequest generates a request for the AssumeRoleWithSAML operation.
@@ -188,6 +200,17 @@ func (c *STS) AssumeRoleWithSAML(input *AssumeRoleWithSAMLInput) (output *Assume
 	return
 }
 
+// AssumeRoleWithSAMLWithContext is the same as AssumeRoleWithSAML with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *STS) AssumeRoleWithSAMLWithContext(ctx context.Context, input *AssumeRoleWithSAMLInput) (output *AssumeRoleWithSAMLOutput, err error) {
+	req, out := c.AssumeRoleWithSAMLRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAssumeRoleWithSAML *aws.Operation
 
 // AssumeRoleWithWebIdentityRequest generates a request for the AssumeRoleWithWebIdentity operation.
@@ -276,6 +299,17 @@ func (c *STS) AssumeRoleWithWebIdentity(input *AssumeRoleWithWebIdentityInput) (
 	return
 }
 
+// AssumeRoleWithWebIdentityWithContext is the same as AssumeRoleWithWebIdentity with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *STS) AssumeRoleWithWebIdentityWithContext(ctx context.Context, input *AssumeRoleWithWebIdentityInput) (output *AssumeRoleWithWebIdentityOutput, err error) {
+	req, out := c.AssumeRoleWithWebIdentityRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAssumeRoleWithWebIdentity *aws.Operation
 
 // DecodeAuthorizationMessageRequest generates a request for the DecodeAuthorizationMessage operation.
@@ -330,6 +364,17 @@ func (c *STS) DecodeAuthorizationMessage(input *DecodeAuthorizationMessageInput)
 	return
 }
 
+// DecodeAuthorizationMessageWithContext is the same as DecodeAuthorizationMessage with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *STS) DecodeAuthorizationMessageWithContext(ctx context.Context, input *DecodeAuthorizationMessageInput) (output *DecodeAuthorizationMessageOutput, err error) {
+	req, out := c.DecodeAuthorizationMessageRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDecodeAuthorizationMessage *aws.Operation
 
 // GetFederationTokenRequest generates a request for the GetFederationToken operation.
@@ -424,6 +469,17 @@ func (c *STS) GetFederationToken(input *GetFederationTokenInput) (output *GetFed
 	return
 }
 
+// GetFederationTokenWithContext is the same as GetFederationToken with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *STS) GetFederationTokenWithContext(ctx context.Context, input *GetFederationTokenInput) (output *GetFederationTokenOutput, err error) {
+	req, out := c.GetFederationTokenRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetFederationToken *aws.Operation
 
 // GetSessionTokenRequest generates a request for the GetSessionToken operation.
@@ -482,13 +538,24 @@ func (c *STS) GetSessionToken(input *GetSessionTokenInput) (output *GetSessionTo
 	return
 }
 
+// GetSessionTokenWithContext is the same as GetSessionToken with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *STS) GetSessionTokenWithContext(ctx context.Context, input *GetSessionTokenInput) (output *GetSessionTokenOutput, err error) {
+	req, out := c.GetSessionTokenRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opGetSessionToken *aws.Operation
 
 type AssumeRoleInput struct {
 	// The duration, in seconds, of the role session. The value can range from 900
 	// seconds (15 minutes) to 3600 seconds (1 hour). By default, the value is set
 	// to 3600 seconds.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"3600"`
 
 	// A unique identifier that is used by third parties to assume a role in their
 	// customers' accounts. For each role that the third party can assume, they
@@ -498,7 +565,7 @@ type AssumeRoleInput struct {
 	// to help third parties bind a role to the customer who created it. For more
 	// information about the external ID, see About the External ID (http://docs.aws.amazon.com/STS/latest/UsingSTS/sts-delegating-externalid.html"
 	// target="_blank) in Using Temporary Security Credentials.
-	ExternalID *string `locationName:"ExternalId" type:"string"`
+	ExternalID *string `locationName:"ExternalId" type:"string" min:"2" max:"96" pattern:"[\\w+=,.@:-]*"`
 
 	// An IAM policy in JSON format.
 	//
@@ -511,27 +578,27 @@ type AssumeRoleInput struct {
 	// by the access policy of the role that is being assumed. For more information,
 	// see Permissions for AssumeRole (http://docs.aws.amazon.com/STS/latest/UsingSTS/permissions-assume-role.html)
 	// in Using Temporary Security Credentials.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"2048" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	// The Amazon Resource Name (ARN) of the role that the caller is assuming.
-	RoleARN *string `locationName:"RoleArn" type:"string" required:"true"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// An identifier for the assumed role session. The session name is included
 	// as part of the AssumedRoleUser.
-	RoleSessionName *string `type:"string" required:"true"`
+	RoleSessionName *string `type:"string" min:"2" max:"32" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The identification number of the MFA device that is associated with the user
 	// who is making the AssumeRole call. Specify this value if the trust policy
 	// of the role being assumed includes a condition that requires MFA authentication.
 	// The value is either the serial number for a hardware device (such as GAHT12345678)
 	// or an Amazon Resource Name (ARN) for a virtual device (such as arn:aws:iam::123456789012:mfa/user).
-	SerialNumber *string `type:"string"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*"`
 
 	// The value provided by the MFA device, if the trust policy of the role being
 	// assumed requires MFA (that is, if the policy includes a condition that tests
 	// for MFA). If the role being assumed requires MFA and if the TokenCode value
 	// is missing or expired, the AssumeRole call returns an "access denied" error.
-	TokenCode *string `type:"string"`
+	TokenCode *string `type:"string" min:"6" max:"6" pattern:"[\\d]*"`
 
 	metadataAssumeRoleInput `json:"-", xml:"-"`
 }
@@ -557,7 +624,7 @@ type AssumeRoleOutput struct {
 	// A percentage value that indicates the size of the policy in packed form.
 	// The service rejects any policy with a packed size greater than 100 percent,
 	// which means the policy exceeded the allowed space.
-	PackedPolicySize *int64 `type:"integer"`
+	PackedPolicySize *int64 `type:"integer" min:"0"`
 
 	metadataAssumeRoleOutput `json:"-", xml:"-"`
 }
@@ -572,7 +639,7 @@ type AssumeRoleWithSAMLInput struct {
 	// to 3600 seconds. An expiration can also be specified in the SAML authentication
 	// response's NotOnOrAfter value. The actual expiration time is whichever value
 	// is shorter.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"129600"`
 
 	// An IAM policy in JSON format.
 	//
@@ -585,21 +652,21 @@ type AssumeRoleWithSAMLInput struct {
 	// by the access policy of the role that is being assumed. For more information,
 	// see Permissions for AssumeRoleWithSAML (http://docs.aws.amazon.com/STS/latest/UsingSTS/permissions-assume-role.html)
 	// in Using Temporary Security Credentials.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"2048" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	// The Amazon Resource Name (ARN) of the SAML provider in IAM that describes
 	// the IdP.
-	PrincipalARN *string `locationName:"PrincipalArn" type:"string" required:"true"`
+	PrincipalARN *string `locationName:"PrincipalArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the role that the caller is assuming.
-	RoleARN *string `locationName:"RoleArn" type:"string" required:"true"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The base-64 encoded SAML authentication response provided by the IdP.
 	//
 	// For more information, see Configuring a Relying Party and Adding Claims
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/create-role-saml-IdP-tasks.html)
 	// in the Using IAM guide.
-	SAMLAssertion *string `type:"string" required:"true"`
+	SAMLAssertion *string `type:"string" min:"4" max:"50000" required:"true"`
 
 	metadataAssumeRoleWithSAMLInput `json:"-", xml:"-"`
 }
@@ -639,7 +706,7 @@ type AssumeRoleWithSAMLOutput struct {
 	// A percentage value that indicates the size of the policy in packed form.
 	// The service rejects any policy with a packed size greater than 100 percent,
 	// which means the policy exceeded the allowed space.
-	PackedPolicySize *int64 `type:"integer"`
+	PackedPolicySize *int64 `type:"integer" min:"0"`
 
 	// The value of the NameID element in the Subject element of the SAML assertion.
 	Subject *string `type:"string"`
@@ -665,7 +732,7 @@ type AssumeRoleWithWebIdentityInput struct {
 	// The duration, in seconds, of the role session. The value can range from 900
 	// seconds (15 minutes) to 3600 seconds (1 hour). By default, the value is set
 	// to 3600 seconds.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"129600"`
 
 	// An IAM policy in JSON format.
 	//
@@ -678,30 +745,30 @@ type AssumeRoleWithWebIdentityInput struct {
 	// by the access policy of the role that is being assumed. For more information,
 	// see Permissions for AssumeRoleWithWebIdentity (http://docs.aws.amazon.com/STS/latest/UsingSTS/permissions-assume-role.html)
 	// in Using Temporary Security Credentials.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"2048" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	// The fully-qualified host component of the domain name of the identity provider.
 	// Specify this value only for OAuth access tokens. Do not specify this value
 	// for OpenID Connect ID tokens, such as accounts.google.com. Do not include
 	// URL schemes and port numbers. Currently, www.amazon.com and graph.facebook.com
 	// are supported.
-	ProviderID *string `locationName:"ProviderId" type:"string"`
+	ProviderID *string `locationName:"ProviderId" type:"string" min:"4" max:"2048"`
 
 	// The Amazon Resource Name (ARN) of the role that the caller is assuming.
-	RoleARN *string `locationName:"RoleArn" type:"string" required:"true"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// An identifier for the assumed role session. Typically, you pass the name
 	// or identifier that is associated with the user who is using your application.
 	// That way, the temporary security credentials that your application will use
 	// are associated with that user. This session name is included as part of the
 	// ARN and assumed role ID in the AssumedRoleUser response element.
-	RoleSessionName *string `type:"string" required:"true"`
+	RoleSessionName *string `type:"string" min:"2" max:"32" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The OAuth 2.0 access token or OpenID Connect ID token that is provided by
 	// the identity provider. Your application must get this token by authenticating
 	// the user who is using your application with a web identity provider before
 	// the application makes an AssumeRoleWithWebIdentity call.
-	WebIdentityToken *string `type:"string" required:"true"`
+	WebIdentityToken *string `type:"string" min:"4" max:"2048" required:"true"`
 
 	metadataAssumeRoleWithWebIdentityInput `json:"-", xml:"-"`
 }
@@ -733,7 +800,7 @@ type AssumeRoleWithWebIdentityOutput struct {
 	// A percentage value that indicates the size of the policy in packed form.
 	// The service rejects any policy with a packed size greater than 100 percent,
 	// which means the policy exceeded the allowed space.
-	PackedPolicySize *int64 `type:"integer"`
+	PackedPolicySize *int64 `type:"integer" min:"0"`
 
 	// The issuing authority of the web identity token presented. For OpenID Connect
 	// ID Tokens this contains the value of the iss field. For OAuth 2.0 Access
@@ -748,7 +815,7 @@ type AssumeRoleWithWebIdentityOutput struct {
 	// identifier). If an OpenID Connect ID token was submitted in the WebIdentityToken,
 	// this value is returned by the identity provider as the token's sub (Subject)
 	// claim.
-	SubjectFromWebIdentityToken *string `type:"string"`
+	SubjectFromWebIdentityToken *string `type:"string" min:"6" max:"255"`
 
 	metadataAssumeRoleWithWebIdentityOutput `json:"-", xml:"-"`
 }
@@ -764,12 +831,12 @@ type AssumedRoleUser struct {
 	// AssumeRole action. For more information about ARNs and how to use them in
 	// policies, see  Identifiers for IAM Entities  (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html"
 	// target="_blank) in Using IAM.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// A unique identifier that contains the role ID and the role session name of
 	// the role that is being assumed. The role ID is generated by AWS when the
 	// role is created.
-	AssumedRoleID *string `locationName:"AssumedRoleId" type:"string" required:"true"`
+	AssumedRoleID *string `locationName:"AssumedRoleId" type:"string" min:"2" max:"96" pattern:"[\\w+=,.@:-]*" required:"true"`
 
 	metadataAssumedRoleUser `json:"-", xml:"-"`
 }
@@ -781,7 +848,7 @@ type metadataAssumedRoleUser struct {
 // AWS credentials for API authentication.
 type Credentials struct {
 	// The access key ID that identifies the temporary security credentials.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The date on which the current credentials expire.
 	Expiration *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
@@ -801,7 +868,7 @@ type metadataCredentials struct {
 
 type DecodeAuthorizationMessageInput struct {
 	// The encoded message that was returned with the response.
-	EncodedMessage *string `type:"string" required:"true"`
+	EncodedMessage *string `type:"string" min:"1" max:"10240" required:"true"`
 
 	metadataDecodeAuthorizationMessageInput `json:"-", xml:"-"`
 }
@@ -831,11 +898,11 @@ type FederatedUser struct {
 	// For more information about ARNs and how to use them in policies, see Identifiers
 	// for IAM Entities (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html"
 	// target="_blank) in Using IAM.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The string that identifies the federated user associated with the credentials,
 	// similar to the unique ID of an IAM user.
-	FederatedUserID *string `locationName:"FederatedUserId" type:"string" required:"true"`
+	FederatedUserID *string `locationName:"FederatedUserId" type:"string" min:"2" max:"96" pattern:"[\\w+=,.@\\:-]*" required:"true"`
 
 	metadataFederatedUser `json:"-", xml:"-"`
 }
@@ -852,13 +919,13 @@ type GetFederationTokenInput struct {
 	// seconds (one hour). If the specified duration is longer than one hour, the
 	// session obtained by using AWS account (root) credentials defaults to one
 	// hour.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"129600"`
 
 	// The name of the federated user. The name is used as an identifier for the
 	// temporary security credentials (such as Bob). For example, you can reference
 	// the federated user name in a resource-based policy, such as in an Amazon
 	// S3 bucket policy.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"2" max:"32" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// An IAM policy in JSON format that is passed with the GetFederationToken call
 	// and evaluated along with the policy or policies that are attached to the
@@ -878,7 +945,7 @@ type GetFederationTokenInput struct {
 	// For more information about how permissions work, see Permissions for GetFederationToken
 	// (http://docs.aws.amazon.com/STS/latest/UsingSTS/permissions-get-federation-token.html)
 	// in Using Temporary Security Credentials.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"2048" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	metadataGetFederationTokenInput `json:"-", xml:"-"`
 }
@@ -902,7 +969,7 @@ type GetFederationTokenOutput struct {
 	// A percentage value indicating the size of the policy in packed form. The
 	// service rejects policies for which the packed size is greater than 100 percent
 	// of the allowed value.
-	PackedPolicySize *int64 `type:"integer"`
+	PackedPolicySize *int64 `type:"integer" min:"0"`
 
 	metadataGetFederationTokenOutput `json:"-", xml:"-"`
 }
@@ -918,7 +985,7 @@ type GetSessionTokenInput struct {
 	// for AWS account owners are restricted to a maximum of 3600 seconds (one hour).
 	// If the duration is longer than one hour, the session for AWS account owners
 	// defaults to one hour.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"129600"`
 
 	// The identification number of the MFA device that is associated with the IAM
 	// user who is making the GetSessionToken call. Specify this value if the IAM
@@ -927,14 +994,14 @@ type GetSessionTokenInput struct {
 	// Name (ARN) for a virtual device (such as arn:aws:iam::123456789012:mfa/user).
 	// You can find the device for an IAM user by going to the AWS Management Console
 	// and viewing the user's security credentials.
-	SerialNumber *string `type:"string"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*"`
 
 	// The value provided by the MFA device, if MFA is required. If any policy requires
 	// the IAM user to submit an MFA code, specify this value. If MFA authentication
 	// is required, and the user does not provide a code when requesting a set of
 	// temporary security credentials, the user will receive an "access denied"
 	// response when requesting resources that require MFA authentication.
-	TokenCode *string `type:"string"`
+	TokenCode *string `type:"string" min:"6" max:"6" pattern:"[\\d]*"`
 
 	metadataGetSessionTokenInput `json:"-", xml:"-"`
 }
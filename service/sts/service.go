@@ -27,6 +27,7 @@ func New(config *aws.Config) *STS {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "sts",
 		APIVersion:  "2011-06-15",
+		ErrorTypes:  errorTypes,
 	}
 	service.Initialize()
 
@@ -1,19 +1,33 @@
 package stsiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/sts"
 )
 
 type STSAPI interface {
 	AssumeRole(*sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error)
 
+	AssumeRoleWithContext(context.Context, *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error)
+
 	AssumeRoleWithSAML(*sts.AssumeRoleWithSAMLInput) (*sts.AssumeRoleWithSAMLOutput, error)
 
+	AssumeRoleWithSAMLWithContext(context.Context, *sts.AssumeRoleWithSAMLInput) (*sts.AssumeRoleWithSAMLOutput, error)
+
 	AssumeRoleWithWebIdentity(*sts.AssumeRoleWithWebIdentityInput) (*sts.AssumeRoleWithWebIdentityOutput, error)
 
+	AssumeRoleWithWebIdentityWithContext(context.Context, *sts.AssumeRoleWithWebIdentityInput) (*sts.AssumeRoleWithWebIdentityOutput, error)
+
 	DecodeAuthorizationMessage(*sts.DecodeAuthorizationMessageInput) (*sts.DecodeAuthorizationMessageOutput, error)
 
+	DecodeAuthorizationMessageWithContext(context.Context, *sts.DecodeAuthorizationMessageInput) (*sts.DecodeAuthorizationMessageOutput, error)
+
 	GetFederationToken(*sts.GetFederationTokenInput) (*sts.GetFederationTokenOutput, error)
 
+	GetFederationTokenWithContext(context.Context, *sts.GetFederationTokenInput) (*sts.GetFederationTokenOutput, error)
+
 	GetSessionToken(*sts.GetSessionTokenInput) (*sts.GetSessionTokenOutput, error)
+
+	GetSessionTokenWithContext(context.Context, *sts.GetSessionTokenInput) (*sts.GetSessionTokenOutput, error)
 }
\ No newline at end of file
@@ -4,6 +4,7 @@
 package support
 
 import (
+	"context"
 	"sync"
 
 	"github.com/datacratic/aws-sdk-go/aws"
@@ -51,6 +52,17 @@ func (c *Support) AddAttachmentsToSet(input *AddAttachmentsToSetInput) (output *
 	return
 }
 
+// AddAttachmentsToSetWithContext is the same as AddAttachmentsToSet with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Support) AddAttachmentsToSetWithContext(ctx context.Context, input *AddAttachmentsToSetInput) (output *AddAttachmentsToSetOutput, err error) {
+	req, out := c.AddAttachmentsToSetRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddAttachmentsToSet *aws.Operation
 
 // AddCommunicationToCaseRequest generates a request for the AddCommunicationToCase operation.
@@ -91,6 +103,17 @@ func (c *Support) AddCommunicationToCase(input *AddCommunicationToCaseInput) (ou
 	return
 }
 
+// AddCommunicationToCaseWithContext is the same as AddCommunicationToCase with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Support) AddCommunicationToCaseWithContext(ctx context.Context, input *AddCommunicationToCaseInput) (output *AddCommunicationToCaseOutput, err error) {
+	req, out := c.AddCommunicationToCaseRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opAddCommunicationToCase *aws.Operation
 
 // CreateCaseRequest generates a request for the CreateCase operation.
@@ -153,6 +176,17 @@ func (c *Support) CreateCase(input *CreateCaseInput) (output *CreateCaseOutput,
 	return
 }
 
+// CreateCaseWithContext is the same as CreateCase with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Support) CreateCaseWithContext(ctx context.Context, input *CreateCaseInput) (output *CreateCaseOutput, err error) {
+	req, out := c.CreateCaseRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCreateCase *aws.Operation
 
 // DescribeAttachmentRequest generates a request for the DescribeAttachment operation.
@@ -189,6 +223,17 @@ func (c *Support) DescribeAttachment(input *DescribeAttachmentInput) (output *De
 	return
 }
 
+// DescribeAttachmentWithContext is the same as DescribeAttachment with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Support) DescribeAttachmentWithContext(ctx context.Context, input *DescribeAttachmentInput) (output *DescribeAttachmentOutput, err error) {
+	req, out := c.DescribeAttachmentRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeAttachment *aws.Operation
 
 // DescribeCasesRequest generates a request for the DescribeCases operation.
@@ -201,6 +246,12 @@ func (c *Support) DescribeCasesRequest(input *DescribeCasesInput) (req *aws.Requ
 			Name:       "DescribeCases",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxResults",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -235,6 +286,27 @@ func (c *Support) DescribeCases(input *DescribeCasesInput) (output *DescribeCase
 	return
 }
 
+// DescribeCasesWithContext is the same as DescribeCases with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Support) DescribeCasesWithContext(ctx context.Context, input *DescribeCasesInput) (output *DescribeCasesOutput, err error) {
+	req, out := c.DescribeCasesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeCasesPages iterates over the pages of a DescribeCases operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Support) DescribeCasesPages(input *DescribeCasesInput, fn func(p *DescribeCasesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeCasesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCasesOutput), lastPage)
+	})
+}
+
 var opDescribeCases *aws.Operation
 
 // DescribeCommunicationsRequest generates a request for the DescribeCommunications operation.
@@ -247,6 +319,12 @@ func (c *Support) DescribeCommunicationsRequest(input *DescribeCommunicationsInp
 			Name:       "DescribeCommunications",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextToken"},
+				OutputTokens:    []string{"NextToken"},
+				LimitToken:      "MaxResults",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -277,6 +355,27 @@ func (c *Support) DescribeCommunications(input *DescribeCommunicationsInput) (ou
 	return
 }
 
+// DescribeCommunicationsWithContext is the same as DescribeCommunications with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Support) DescribeCommunicationsWithContext(ctx context.Context, input *DescribeCommunicationsInput) (output *DescribeCommunicationsOutput, err error) {
+	req, out := c.DescribeCommunicationsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// DescribeCommunicationsPages iterates over the pages of a DescribeCommunications operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *Support) DescribeCommunicationsPages(input *DescribeCommunicationsInput, fn func(p *DescribeCommunicationsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeCommunicationsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCommunicationsOutput), lastPage)
+	})
+}
+
 var opDescribeCommunications *aws.Operation
 
 // DescribeServicesRequest generates a request for the DescribeServices operation.
@@ -320,6 +419,17 @@ func (c *Support) DescribeServices(input *DescribeServicesInput) (output *Descri
 	return
 }
 
+// DescribeServicesWithContext is the same as DescribeServices with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Support) DescribeServicesWithContext(ctx context.Context, input *DescribeServicesInput) (output *DescribeServicesOutput, err error) {
+	req, out := c.DescribeServicesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeServices *aws.Operation
 
 // DescribeSeverityLevelsRequest generates a request for the DescribeSeverityLevels operation.
@@ -355,6 +465,17 @@ func (c *Support) DescribeSeverityLevels(input *DescribeSeverityLevelsInput) (ou
 	return
 }
 
+// DescribeSeverityLevelsWithContext is the same as DescribeSeverityLevels with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Support) DescribeSeverityLevelsWithContext(ctx context.Context, input *DescribeSeverityLevelsInput) (output *DescribeSeverityLevelsOutput, err error) {
+	req, out := c.DescribeSeverityLevelsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeSeverityLevels *aws.Operation
 
 // DescribeTrustedAdvisorCheckRefreshStatusesRequest generates a request for the DescribeTrustedAdvisorCheckRefreshStatuses operation.
@@ -389,6 +510,17 @@ func (c *Support) DescribeTrustedAdvisorCheckRefreshStatuses(input *DescribeTrus
 	return
 }
 
+// DescribeTrustedAdvisorCheckRefreshStatusesWithContext is the same as DescribeTrustedAdvisorCheckRefreshStatuses with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Support) DescribeTrustedAdvisorCheckRefreshStatusesWithContext(ctx context.Context, input *DescribeTrustedAdvisorCheckRefreshStatusesInput) (output *DescribeTrustedAdvisorCheckRefreshStatusesOutput, err error) {
+	req, out := c.DescribeTrustedAdvisorCheckRefreshStatusesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeTrustedAdvisorCheckRefreshStatuses *aws.Operation
 
 // DescribeTrustedAdvisorCheckResultRequest generates a request for the DescribeTrustedAdvisorCheckResult operation.
@@ -433,6 +565,17 @@ func (c *Support) DescribeTrustedAdvisorCheckResult(input *DescribeTrustedAdviso
 	return
 }
 
+// DescribeTrustedAdvisorCheckResultWithContext is the same as DescribeTrustedAdvisorCheckResult with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Support) DescribeTrustedAdvisorCheckResultWithContext(ctx context.Context, input *DescribeTrustedAdvisorCheckResultInput) (output *DescribeTrustedAdvisorCheckResultOutput, err error) {
+	req, out := c.DescribeTrustedAdvisorCheckResultRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeTrustedAdvisorCheckResult *aws.Operation
 
 // DescribeTrustedAdvisorCheckSummariesRequest generates a request for the DescribeTrustedAdvisorCheckSummaries operation.
@@ -469,6 +612,17 @@ func (c *Support) DescribeTrustedAdvisorCheckSummaries(input *DescribeTrustedAdv
 	return
 }
 
+// DescribeTrustedAdvisorCheckSummariesWithContext is the same as DescribeTrustedAdvisorCheckSummaries with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Support) DescribeTrustedAdvisorCheckSummariesWithContext(ctx context.Context, input *DescribeTrustedAdvisorCheckSummariesInput) (output *DescribeTrustedAdvisorCheckSummariesOutput, err error) {
+	req, out := c.DescribeTrustedAdvisorCheckSummariesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeTrustedAdvisorCheckSummaries *aws.Operation
 
 // DescribeTrustedAdvisorChecksRequest generates a request for the DescribeTrustedAdvisorChecks operation.
@@ -505,6 +659,17 @@ func (c *Support) DescribeTrustedAdvisorChecks(input *DescribeTrustedAdvisorChec
 	return
 }
 
+// DescribeTrustedAdvisorChecksWithContext is the same as DescribeTrustedAdvisorChecks with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Support) DescribeTrustedAdvisorChecksWithContext(ctx context.Context, input *DescribeTrustedAdvisorChecksInput) (output *DescribeTrustedAdvisorChecksOutput, err error) {
+	req, out := c.DescribeTrustedAdvisorChecksRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeTrustedAdvisorChecks *aws.Operation
 
 // RefreshTrustedAdvisorCheckRequest generates a request for the RefreshTrustedAdvisorCheck operation.
@@ -547,6 +712,17 @@ func (c *Support) RefreshTrustedAdvisorCheck(input *RefreshTrustedAdvisorCheckIn
 	return
 }
 
+// RefreshTrustedAdvisorCheckWithContext is the same as RefreshTrustedAdvisorCheck with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Support) RefreshTrustedAdvisorCheckWithContext(ctx context.Context, input *RefreshTrustedAdvisorCheckInput) (output *RefreshTrustedAdvisorCheckOutput, err error) {
+	req, out := c.RefreshTrustedAdvisorCheckRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRefreshTrustedAdvisorCheck *aws.Operation
 
 // ResolveCaseRequest generates a request for the ResolveCase operation.
@@ -581,6 +757,17 @@ func (c *Support) ResolveCase(input *ResolveCaseInput) (output *ResolveCaseOutpu
 	return
 }
 
+// ResolveCaseWithContext is the same as ResolveCase with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *Support) ResolveCaseWithContext(ctx context.Context, input *ResolveCaseInput) (output *ResolveCaseOutput, err error) {
+	req, out := c.ResolveCaseRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opResolveCase *aws.Operation
 
 type AddAttachmentsToSetInput struct {
@@ -735,7 +922,7 @@ type CaseDetails struct {
 	RecentCommunications *RecentCaseCommunications `locationName:"recentCommunications" type:"structure"`
 
 	// The code for the AWS service returned by the call to DescribeServices.
-	ServiceCode *string `locationName:"serviceCode" type:"string"`
+	ServiceCode *string `locationName:"serviceCode" type:"string" pattern:"[0-9a-z\\-_]+"`
 
 	// The code for the severity level returned by the call to DescribeSeverityLevels.
 	SeverityCode *string `locationName:"severityCode" type:"string"`
@@ -828,7 +1015,7 @@ type CreateCaseInput struct {
 	Language *string `locationName:"language" type:"string"`
 
 	// The code for the AWS service returned by the call to DescribeServices.
-	ServiceCode *string `locationName:"serviceCode" type:"string"`
+	ServiceCode *string `locationName:"serviceCode" type:"string" pattern:"[0-9a-z\\-_]+"`
 
 	// The code for the severity level returned by the call to DescribeSeverityLevels.
 	//
@@ -897,7 +1084,7 @@ type DescribeCasesInput struct {
 
 	// A list of ID numbers of the support cases you want returned. The maximum
 	// number of cases is 100.
-	CaseIDList []*string `locationName:"caseIdList" type:"list"`
+	CaseIDList []*string `locationName:"caseIdList" type:"list" min:"0" max:"100"`
 
 	// The ID displayed for a case in the AWS Support Center user interface.
 	DisplayID *string `locationName:"displayId" type:"string"`
@@ -916,7 +1103,7 @@ type DescribeCasesInput struct {
 	Language *string `locationName:"language" type:"string"`
 
 	// The maximum number of results to return before paginating.
-	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+	MaxResults *int64 `locationName:"maxResults" type:"integer" min:"10" max:"100"`
 
 	// A resumption point for pagination.
 	NextToken *string `locationName:"nextToken" type:"string"`
@@ -958,7 +1145,7 @@ type DescribeCommunicationsInput struct {
 	CaseID *string `locationName:"caseId" type:"string" required:"true"`
 
 	// The maximum number of results to return before paginating.
-	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+	MaxResults *int64 `locationName:"maxResults" type:"integer" min:"10" max:"100"`
 
 	// A resumption point for pagination.
 	NextToken *string `locationName:"nextToken" type:"string"`
@@ -992,7 +1179,7 @@ type DescribeServicesInput struct {
 	Language *string `locationName:"language" type:"string"`
 
 	// A JSON-formatted list of service codes available for AWS services.
-	ServiceCodeList []*string `locationName:"serviceCodeList" type:"list"`
+	ServiceCodeList []*string `locationName:"serviceCodeList" type:"list" min:"0" max:"100"`
 
 	metadataDescribeServicesInput `json:"-", xml:"-"`
 }
@@ -1217,7 +1404,7 @@ type Service struct {
 
 	// The code for an AWS service returned by the DescribeServices response. The
 	// Name element contains the corresponding friendly name.
-	Code *string `locationName:"code" type:"string"`
+	Code *string `locationName:"code" type:"string" pattern:"[0-9a-z\\-_]+"`
 
 	// The friendly name for an AWS service. The Code element contains the corresponding
 	// code.
@@ -29,6 +29,7 @@ func New(config *aws.Config) *Support {
 		APIVersion:   "2013-04-15",
 		JSONVersion:  "1.1",
 		TargetPrefix: "AWSSupport_20130415",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
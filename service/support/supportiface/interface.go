@@ -1,35 +1,67 @@
 package supportiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/support"
 )
 
 type SupportAPI interface {
 	AddAttachmentsToSet(*support.AddAttachmentsToSetInput) (*support.AddAttachmentsToSetOutput, error)
 
+	AddAttachmentsToSetWithContext(context.Context, *support.AddAttachmentsToSetInput) (*support.AddAttachmentsToSetOutput, error)
+
 	AddCommunicationToCase(*support.AddCommunicationToCaseInput) (*support.AddCommunicationToCaseOutput, error)
 
+	AddCommunicationToCaseWithContext(context.Context, *support.AddCommunicationToCaseInput) (*support.AddCommunicationToCaseOutput, error)
+
 	CreateCase(*support.CreateCaseInput) (*support.CreateCaseOutput, error)
 
+	CreateCaseWithContext(context.Context, *support.CreateCaseInput) (*support.CreateCaseOutput, error)
+
 	DescribeAttachment(*support.DescribeAttachmentInput) (*support.DescribeAttachmentOutput, error)
 
+	DescribeAttachmentWithContext(context.Context, *support.DescribeAttachmentInput) (*support.DescribeAttachmentOutput, error)
+
 	DescribeCases(*support.DescribeCasesInput) (*support.DescribeCasesOutput, error)
 
+	DescribeCasesWithContext(context.Context, *support.DescribeCasesInput) (*support.DescribeCasesOutput, error)
+	DescribeCasesPages(*support.DescribeCasesInput, func(*support.DescribeCasesOutput, bool) bool) error
+
 	DescribeCommunications(*support.DescribeCommunicationsInput) (*support.DescribeCommunicationsOutput, error)
 
+	DescribeCommunicationsWithContext(context.Context, *support.DescribeCommunicationsInput) (*support.DescribeCommunicationsOutput, error)
+	DescribeCommunicationsPages(*support.DescribeCommunicationsInput, func(*support.DescribeCommunicationsOutput, bool) bool) error
+
 	DescribeServices(*support.DescribeServicesInput) (*support.DescribeServicesOutput, error)
 
+	DescribeServicesWithContext(context.Context, *support.DescribeServicesInput) (*support.DescribeServicesOutput, error)
+
 	DescribeSeverityLevels(*support.DescribeSeverityLevelsInput) (*support.DescribeSeverityLevelsOutput, error)
 
+	DescribeSeverityLevelsWithContext(context.Context, *support.DescribeSeverityLevelsInput) (*support.DescribeSeverityLevelsOutput, error)
+
 	DescribeTrustedAdvisorCheckRefreshStatuses(*support.DescribeTrustedAdvisorCheckRefreshStatusesInput) (*support.DescribeTrustedAdvisorCheckRefreshStatusesOutput, error)
 
+	DescribeTrustedAdvisorCheckRefreshStatusesWithContext(context.Context, *support.DescribeTrustedAdvisorCheckRefreshStatusesInput) (*support.DescribeTrustedAdvisorCheckRefreshStatusesOutput, error)
+
 	DescribeTrustedAdvisorCheckResult(*support.DescribeTrustedAdvisorCheckResultInput) (*support.DescribeTrustedAdvisorCheckResultOutput, error)
 
+	DescribeTrustedAdvisorCheckResultWithContext(context.Context, *support.DescribeTrustedAdvisorCheckResultInput) (*support.DescribeTrustedAdvisorCheckResultOutput, error)
+
 	DescribeTrustedAdvisorCheckSummaries(*support.DescribeTrustedAdvisorCheckSummariesInput) (*support.DescribeTrustedAdvisorCheckSummariesOutput, error)
 
+	DescribeTrustedAdvisorCheckSummariesWithContext(context.Context, *support.DescribeTrustedAdvisorCheckSummariesInput) (*support.DescribeTrustedAdvisorCheckSummariesOutput, error)
+
 	DescribeTrustedAdvisorChecks(*support.DescribeTrustedAdvisorChecksInput) (*support.DescribeTrustedAdvisorChecksOutput, error)
 
+	DescribeTrustedAdvisorChecksWithContext(context.Context, *support.DescribeTrustedAdvisorChecksInput) (*support.DescribeTrustedAdvisorChecksOutput, error)
+
 	RefreshTrustedAdvisorCheck(*support.RefreshTrustedAdvisorCheckInput) (*support.RefreshTrustedAdvisorCheckOutput, error)
 
+	RefreshTrustedAdvisorCheckWithContext(context.Context, *support.RefreshTrustedAdvisorCheckInput) (*support.RefreshTrustedAdvisorCheckOutput, error)
+
 	ResolveCase(*support.ResolveCaseInput) (*support.ResolveCaseOutput, error)
+
+	ResolveCaseWithContext(context.Context, *support.ResolveCaseInput) (*support.ResolveCaseOutput, error)
 }
\ No newline at end of file
@@ -4,6 +4,7 @@
 package swf
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -62,6 +63,17 @@ func (c *SWF) CountClosedWorkflowExecutions(input *CountClosedWorkflowExecutions
 	return
 }
 
+// CountClosedWorkflowExecutionsWithContext is the same as CountClosedWorkflowExecutions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) CountClosedWorkflowExecutionsWithContext(ctx context.Context, input *CountClosedWorkflowExecutionsInput) (output *WorkflowExecutionCount, err error) {
+	req, out := c.CountClosedWorkflowExecutionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCountClosedWorkflowExecutions *aws.Operation
 
 // CountOpenWorkflowExecutionsRequest generates a request for the CountOpenWorkflowExecutions operation.
@@ -114,6 +126,17 @@ func (c *SWF) CountOpenWorkflowExecutions(input *CountOpenWorkflowExecutionsInpu
 	return
 }
 
+// CountOpenWorkflowExecutionsWithContext is the same as CountOpenWorkflowExecutions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) CountOpenWorkflowExecutionsWithContext(ctx context.Context, input *CountOpenWorkflowExecutionsInput) (output *WorkflowExecutionCount, err error) {
+	req, out := c.CountOpenWorkflowExecutionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCountOpenWorkflowExecutions *aws.Operation
 
 // CountPendingActivityTasksRequest generates a request for the CountPendingActivityTasks operation.
@@ -165,6 +188,17 @@ func (c *SWF) CountPendingActivityTasks(input *CountPendingActivityTasksInput) (
 	return
 }
 
+// CountPendingActivityTasksWithContext is the same as CountPendingActivityTasks with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) CountPendingActivityTasksWithContext(ctx context.Context, input *CountPendingActivityTasksInput) (output *PendingTaskCount, err error) {
+	req, out := c.CountPendingActivityTasksRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCountPendingActivityTasks *aws.Operation
 
 // CountPendingDecisionTasksRequest generates a request for the CountPendingDecisionTasks operation.
@@ -216,6 +250,17 @@ func (c *SWF) CountPendingDecisionTasks(input *CountPendingDecisionTasksInput) (
 	return
 }
 
+// CountPendingDecisionTasksWithContext is the same as CountPendingDecisionTasks with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) CountPendingDecisionTasksWithContext(ctx context.Context, input *CountPendingDecisionTasksInput) (output *PendingTaskCount, err error) {
+	req, out := c.CountPendingDecisionTasksRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opCountPendingDecisionTasks *aws.Operation
 
 // DeprecateActivityTypeRequest generates a request for the DeprecateActivityType operation.
@@ -268,6 +313,17 @@ func (c *SWF) DeprecateActivityType(input *DeprecateActivityTypeInput) (output *
 	return
 }
 
+// DeprecateActivityTypeWithContext is the same as DeprecateActivityType with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) DeprecateActivityTypeWithContext(ctx context.Context, input *DeprecateActivityTypeInput) (output *DeprecateActivityTypeOutput, err error) {
+	req, out := c.DeprecateActivityTypeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeprecateActivityType *aws.Operation
 
 // DeprecateDomainRequest generates a request for the DeprecateDomain operation.
@@ -321,6 +377,17 @@ func (c *SWF) DeprecateDomain(input *DeprecateDomainInput) (output *DeprecateDom
 	return
 }
 
+// DeprecateDomainWithContext is the same as DeprecateDomain with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) DeprecateDomainWithContext(ctx context.Context, input *DeprecateDomainInput) (output *DeprecateDomainOutput, err error) {
+	req, out := c.DeprecateDomainRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeprecateDomain *aws.Operation
 
 // DeprecateWorkflowTypeRequest generates a request for the DeprecateWorkflowType operation.
@@ -374,6 +441,17 @@ func (c *SWF) DeprecateWorkflowType(input *DeprecateWorkflowTypeInput) (output *
 	return
 }
 
+// DeprecateWorkflowTypeWithContext is the same as DeprecateWorkflowType with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) DeprecateWorkflowTypeWithContext(ctx context.Context, input *DeprecateWorkflowTypeInput) (output *DeprecateWorkflowTypeOutput, err error) {
+	req, out := c.DeprecateWorkflowTypeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDeprecateWorkflowType *aws.Operation
 
 // DescribeActivityTypeRequest generates a request for the DescribeActivityType operation.
@@ -425,6 +503,17 @@ func (c *SWF) DescribeActivityType(input *DescribeActivityTypeInput) (output *De
 	return
 }
 
+// DescribeActivityTypeWithContext is the same as DescribeActivityType with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) DescribeActivityTypeWithContext(ctx context.Context, input *DescribeActivityTypeInput) (output *DescribeActivityTypeOutput, err error) {
+	req, out := c.DescribeActivityTypeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeActivityType *aws.Operation
 
 // DescribeDomainRequest generates a request for the DescribeDomain operation.
@@ -473,6 +562,17 @@ func (c *SWF) DescribeDomain(input *DescribeDomainInput) (output *DescribeDomain
 	return
 }
 
+// DescribeDomainWithContext is the same as DescribeDomain with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) DescribeDomainWithContext(ctx context.Context, input *DescribeDomainInput) (output *DescribeDomainOutput, err error) {
+	req, out := c.DescribeDomainRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeDomain *aws.Operation
 
 // DescribeWorkflowExecutionRequest generates a request for the DescribeWorkflowExecution operation.
@@ -522,6 +622,17 @@ func (c *SWF) DescribeWorkflowExecution(input *DescribeWorkflowExecutionInput) (
 	return
 }
 
+// DescribeWorkflowExecutionWithContext is the same as DescribeWorkflowExecution with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) DescribeWorkflowExecutionWithContext(ctx context.Context, input *DescribeWorkflowExecutionInput) (output *DescribeWorkflowExecutionOutput, err error) {
+	req, out := c.DescribeWorkflowExecutionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeWorkflowExecution *aws.Operation
 
 // DescribeWorkflowTypeRequest generates a request for the DescribeWorkflowType operation.
@@ -573,6 +684,17 @@ func (c *SWF) DescribeWorkflowType(input *DescribeWorkflowTypeInput) (output *De
 	return
 }
 
+// DescribeWorkflowTypeWithContext is the same as DescribeWorkflowType with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) DescribeWorkflowTypeWithContext(ctx context.Context, input *DescribeWorkflowTypeInput) (output *DescribeWorkflowTypeOutput, err error) {
+	req, out := c.DescribeWorkflowTypeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opDescribeWorkflowType *aws.Operation
 
 // GetWorkflowExecutionHistoryRequest generates a request for the GetWorkflowExecutionHistory operation.
@@ -585,6 +707,12 @@ func (c *SWF) GetWorkflowExecutionHistoryRequest(input *GetWorkflowExecutionHist
 			Name:       "GetWorkflowExecutionHistory",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextPageToken"},
+				OutputTokens:    []string{"NextPageToken"},
+				LimitToken:      "MaximumPageSize",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -623,6 +751,27 @@ func (c *SWF) GetWorkflowExecutionHistory(input *GetWorkflowExecutionHistoryInpu
 	return
 }
 
+// GetWorkflowExecutionHistoryWithContext is the same as GetWorkflowExecutionHistory with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) GetWorkflowExecutionHistoryWithContext(ctx context.Context, input *GetWorkflowExecutionHistoryInput) (output *GetWorkflowExecutionHistoryOutput, err error) {
+	req, out := c.GetWorkflowExecutionHistoryRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// GetWorkflowExecutionHistoryPages iterates over the pages of a GetWorkflowExecutionHistory operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *SWF) GetWorkflowExecutionHistoryPages(input *GetWorkflowExecutionHistoryInput, fn func(p *GetWorkflowExecutionHistoryOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.GetWorkflowExecutionHistoryRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*GetWorkflowExecutionHistoryOutput), lastPage)
+	})
+}
+
 var opGetWorkflowExecutionHistory *aws.Operation
 
 // ListActivityTypesRequest generates a request for the ListActivityTypes operation.
@@ -635,6 +784,12 @@ func (c *SWF) ListActivityTypesRequest(input *ListActivityTypesInput) (req *aws.
 			Name:       "ListActivityTypes",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextPageToken"},
+				OutputTokens:    []string{"NextPageToken"},
+				LimitToken:      "MaximumPageSize",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -674,6 +829,27 @@ func (c *SWF) ListActivityTypes(input *ListActivityTypesInput) (output *ListActi
 	return
 }
 
+// ListActivityTypesWithContext is the same as ListActivityTypes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) ListActivityTypesWithContext(ctx context.Context, input *ListActivityTypesInput) (output *ListActivityTypesOutput, err error) {
+	req, out := c.ListActivityTypesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListActivityTypesPages iterates over the pages of a ListActivityTypes operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *SWF) ListActivityTypesPages(input *ListActivityTypesInput, fn func(p *ListActivityTypesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListActivityTypesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListActivityTypesOutput), lastPage)
+	})
+}
+
 var opListActivityTypes *aws.Operation
 
 // ListClosedWorkflowExecutionsRequest generates a request for the ListClosedWorkflowExecutions operation.
@@ -686,6 +862,12 @@ func (c *SWF) ListClosedWorkflowExecutionsRequest(input *ListClosedWorkflowExecu
 			Name:       "ListClosedWorkflowExecutions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextPageToken"},
+				OutputTokens:    []string{"NextPageToken"},
+				LimitToken:      "MaximumPageSize",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -728,6 +910,27 @@ func (c *SWF) ListClosedWorkflowExecutions(input *ListClosedWorkflowExecutionsIn
 	return
 }
 
+// ListClosedWorkflowExecutionsWithContext is the same as ListClosedWorkflowExecutions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) ListClosedWorkflowExecutionsWithContext(ctx context.Context, input *ListClosedWorkflowExecutionsInput) (output *WorkflowExecutionInfos, err error) {
+	req, out := c.ListClosedWorkflowExecutionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListClosedWorkflowExecutionsPages iterates over the pages of a ListClosedWorkflowExecutions operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *SWF) ListClosedWorkflowExecutionsPages(input *ListClosedWorkflowExecutionsInput, fn func(p *WorkflowExecutionInfos, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListClosedWorkflowExecutionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*WorkflowExecutionInfos), lastPage)
+	})
+}
+
 var opListClosedWorkflowExecutions *aws.Operation
 
 // ListDomainsRequest generates a request for the ListDomains operation.
@@ -740,6 +943,12 @@ func (c *SWF) ListDomainsRequest(input *ListDomainsInput) (req *aws.Request, out
 			Name:       "ListDomains",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextPageToken"},
+				OutputTokens:    []string{"NextPageToken"},
+				LimitToken:      "MaximumPageSize",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -779,6 +988,27 @@ func (c *SWF) ListDomains(input *ListDomainsInput) (output *ListDomainsOutput, e
 	return
 }
 
+// ListDomainsWithContext is the same as ListDomains with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) ListDomainsWithContext(ctx context.Context, input *ListDomainsInput) (output *ListDomainsOutput, err error) {
+	req, out := c.ListDomainsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListDomainsPages iterates over the pages of a ListDomains operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *SWF) ListDomainsPages(input *ListDomainsInput, fn func(p *ListDomainsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListDomainsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListDomainsOutput), lastPage)
+	})
+}
+
 var opListDomains *aws.Operation
 
 // ListOpenWorkflowExecutionsRequest generates a request for the ListOpenWorkflowExecutions operation.
@@ -791,6 +1021,12 @@ func (c *SWF) ListOpenWorkflowExecutionsRequest(input *ListOpenWorkflowExecution
 			Name:       "ListOpenWorkflowExecutions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextPageToken"},
+				OutputTokens:    []string{"NextPageToken"},
+				LimitToken:      "MaximumPageSize",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -833,6 +1069,27 @@ func (c *SWF) ListOpenWorkflowExecutions(input *ListOpenWorkflowExecutionsInput)
 	return
 }
 
+// ListOpenWorkflowExecutionsWithContext is the same as ListOpenWorkflowExecutions with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) ListOpenWorkflowExecutionsWithContext(ctx context.Context, input *ListOpenWorkflowExecutionsInput) (output *WorkflowExecutionInfos, err error) {
+	req, out := c.ListOpenWorkflowExecutionsRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListOpenWorkflowExecutionsPages iterates over the pages of a ListOpenWorkflowExecutions operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *SWF) ListOpenWorkflowExecutionsPages(input *ListOpenWorkflowExecutionsInput, fn func(p *WorkflowExecutionInfos, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListOpenWorkflowExecutionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*WorkflowExecutionInfos), lastPage)
+	})
+}
+
 var opListOpenWorkflowExecutions *aws.Operation
 
 // ListWorkflowTypesRequest generates a request for the ListWorkflowTypes operation.
@@ -845,6 +1102,12 @@ func (c *SWF) ListWorkflowTypesRequest(input *ListWorkflowTypesInput) (req *aws.
 			Name:       "ListWorkflowTypes",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextPageToken"},
+				OutputTokens:    []string{"NextPageToken"},
+				LimitToken:      "MaximumPageSize",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -882,6 +1145,27 @@ func (c *SWF) ListWorkflowTypes(input *ListWorkflowTypesInput) (output *ListWork
 	return
 }
 
+// ListWorkflowTypesWithContext is the same as ListWorkflowTypes with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) ListWorkflowTypesWithContext(ctx context.Context, input *ListWorkflowTypesInput) (output *ListWorkflowTypesOutput, err error) {
+	req, out := c.ListWorkflowTypesRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// ListWorkflowTypesPages iterates over the pages of a ListWorkflowTypes operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *SWF) ListWorkflowTypesPages(input *ListWorkflowTypesInput, fn func(p *ListWorkflowTypesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListWorkflowTypesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListWorkflowTypesOutput), lastPage)
+	})
+}
+
 var opListWorkflowTypes *aws.Operation
 
 // PollForActivityTaskRequest generates a request for the PollForActivityTask operation.
@@ -939,6 +1223,17 @@ func (c *SWF) PollForActivityTask(input *PollForActivityTaskInput) (output *Poll
 	return
 }
 
+// PollForActivityTaskWithContext is the same as PollForActivityTask with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) PollForActivityTaskWithContext(ctx context.Context, input *PollForActivityTaskInput) (output *PollForActivityTaskOutput, err error) {
+	req, out := c.PollForActivityTaskRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opPollForActivityTask *aws.Operation
 
 // PollForDecisionTaskRequest generates a request for the PollForDecisionTask operation.
@@ -951,6 +1246,12 @@ func (c *SWF) PollForDecisionTaskRequest(input *PollForDecisionTaskInput) (req *
 			Name:       "PollForDecisionTask",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"NextPageToken"},
+				OutputTokens:    []string{"NextPageToken"},
+				LimitToken:      "MaximumPageSize",
+				TruncationToken: "",
+			},
 		}
 	}
 
@@ -1004,6 +1305,27 @@ func (c *SWF) PollForDecisionTask(input *PollForDecisionTaskInput) (output *Poll
 	return
 }
 
+// PollForDecisionTaskWithContext is the same as PollForDecisionTask with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) PollForDecisionTaskWithContext(ctx context.Context, input *PollForDecisionTaskInput) (output *PollForDecisionTaskOutput, err error) {
+	req, out := c.PollForDecisionTaskRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
+// PollForDecisionTaskPages iterates over the pages of a PollForDecisionTask operation,
+// calling fn with each page of results. Iteration stops when fn returns false
+// or when the last page has been retrieved.
+func (c *SWF) PollForDecisionTaskPages(input *PollForDecisionTaskInput, fn func(p *PollForDecisionTaskOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.PollForDecisionTaskRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*PollForDecisionTaskOutput), lastPage)
+	})
+}
+
 var opPollForDecisionTask *aws.Operation
 
 // RecordActivityTaskHeartbeatRequest generates a request for the RecordActivityTaskHeartbeat operation.
@@ -1072,6 +1394,17 @@ func (c *SWF) RecordActivityTaskHeartbeat(input *RecordActivityTaskHeartbeatInpu
 	return
 }
 
+// RecordActivityTaskHeartbeatWithContext is the same as RecordActivityTaskHeartbeat with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) RecordActivityTaskHeartbeatWithContext(ctx context.Context, input *RecordActivityTaskHeartbeatInput) (output *RecordActivityTaskHeartbeatOutput, err error) {
+	req, out := c.RecordActivityTaskHeartbeatRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRecordActivityTaskHeartbeat *aws.Operation
 
 // RegisterActivityTypeRequest generates a request for the RegisterActivityType operation.
@@ -1125,6 +1458,17 @@ func (c *SWF) RegisterActivityType(input *RegisterActivityTypeInput) (output *Re
 	return
 }
 
+// RegisterActivityTypeWithContext is the same as RegisterActivityType with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) RegisterActivityTypeWithContext(ctx context.Context, input *RegisterActivityTypeInput) (output *RegisterActivityTypeOutput, err error) {
+	req, out := c.RegisterActivityTypeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRegisterActivityType *aws.Operation
 
 // RegisterDomainRequest generates a request for the RegisterDomain operation.
@@ -1173,6 +1517,17 @@ func (c *SWF) RegisterDomain(input *RegisterDomainInput) (output *RegisterDomain
 	return
 }
 
+// RegisterDomainWithContext is the same as RegisterDomain with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) RegisterDomainWithContext(ctx context.Context, input *RegisterDomainInput) (output *RegisterDomainOutput, err error) {
+	req, out := c.RegisterDomainRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRegisterDomain *aws.Operation
 
 // RegisterWorkflowTypeRequest generates a request for the RegisterWorkflowType operation.
@@ -1229,6 +1584,17 @@ func (c *SWF) RegisterWorkflowType(input *RegisterWorkflowTypeInput) (output *Re
 	return
 }
 
+// RegisterWorkflowTypeWithContext is the same as RegisterWorkflowType with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) RegisterWorkflowTypeWithContext(ctx context.Context, input *RegisterWorkflowTypeInput) (output *RegisterWorkflowTypeOutput, err error) {
+	req, out := c.RegisterWorkflowTypeRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRegisterWorkflowType *aws.Operation
 
 // RequestCancelWorkflowExecutionRequest generates a request for the RequestCancelWorkflowExecution operation.
@@ -1284,6 +1650,17 @@ func (c *SWF) RequestCancelWorkflowExecution(input *RequestCancelWorkflowExecuti
 	return
 }
 
+// RequestCancelWorkflowExecutionWithContext is the same as RequestCancelWorkflowExecution with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) RequestCancelWorkflowExecutionWithContext(ctx context.Context, input *RequestCancelWorkflowExecutionInput) (output *RequestCancelWorkflowExecutionOutput, err error) {
+	req, out := c.RequestCancelWorkflowExecutionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRequestCancelWorkflowExecution *aws.Operation
 
 // RespondActivityTaskCanceledRequest generates a request for the RespondActivityTaskCanceled operation.
@@ -1344,6 +1721,17 @@ func (c *SWF) RespondActivityTaskCanceled(input *RespondActivityTaskCanceledInpu
 	return
 }
 
+// RespondActivityTaskCanceledWithContext is the same as RespondActivityTaskCanceled with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) RespondActivityTaskCanceledWithContext(ctx context.Context, input *RespondActivityTaskCanceledInput) (output *RespondActivityTaskCanceledOutput, err error) {
+	req, out := c.RespondActivityTaskCanceledRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRespondActivityTaskCanceled *aws.Operation
 
 // RespondActivityTaskCompletedRequest generates a request for the RespondActivityTaskCompleted operation.
@@ -1402,6 +1790,17 @@ func (c *SWF) RespondActivityTaskCompleted(input *RespondActivityTaskCompletedIn
 	return
 }
 
+// RespondActivityTaskCompletedWithContext is the same as RespondActivityTaskCompleted with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) RespondActivityTaskCompletedWithContext(ctx context.Context, input *RespondActivityTaskCompletedInput) (output *RespondActivityTaskCompletedOutput, err error) {
+	req, out := c.RespondActivityTaskCompletedRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRespondActivityTaskCompleted *aws.Operation
 
 // RespondActivityTaskFailedRequest generates a request for the RespondActivityTaskFailed operation.
@@ -1457,6 +1856,17 @@ func (c *SWF) RespondActivityTaskFailed(input *RespondActivityTaskFailedInput) (
 	return
 }
 
+// RespondActivityTaskFailedWithContext is the same as RespondActivityTaskFailed with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) RespondActivityTaskFailedWithContext(ctx context.Context, input *RespondActivityTaskFailedInput) (output *RespondActivityTaskFailedOutput, err error) {
+	req, out := c.RespondActivityTaskFailedRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRespondActivityTaskFailed *aws.Operation
 
 // RespondDecisionTaskCompletedRequest generates a request for the RespondDecisionTaskCompleted operation.
@@ -1505,6 +1915,17 @@ func (c *SWF) RespondDecisionTaskCompleted(input *RespondDecisionTaskCompletedIn
 	return
 }
 
+// RespondDecisionTaskCompletedWithContext is the same as RespondDecisionTaskCompleted with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) RespondDecisionTaskCompletedWithContext(ctx context.Context, input *RespondDecisionTaskCompletedInput) (output *RespondDecisionTaskCompletedOutput, err error) {
+	req, out := c.RespondDecisionTaskCompletedRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opRespondDecisionTaskCompleted *aws.Operation
 
 // SignalWorkflowExecutionRequest generates a request for the SignalWorkflowExecution operation.
@@ -1558,6 +1979,17 @@ func (c *SWF) SignalWorkflowExecution(input *SignalWorkflowExecutionInput) (outp
 	return
 }
 
+// SignalWorkflowExecutionWithContext is the same as SignalWorkflowExecution with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) SignalWorkflowExecutionWithContext(ctx context.Context, input *SignalWorkflowExecutionInput) (output *SignalWorkflowExecutionOutput, err error) {
+	req, out := c.SignalWorkflowExecutionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opSignalWorkflowExecution *aws.Operation
 
 // StartWorkflowExecutionRequest generates a request for the StartWorkflowExecution operation.
@@ -1614,6 +2046,17 @@ func (c *SWF) StartWorkflowExecution(input *StartWorkflowExecutionInput) (output
 	return
 }
 
+// StartWorkflowExecutionWithContext is the same as StartWorkflowExecution with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) StartWorkflowExecutionWithContext(ctx context.Context, input *StartWorkflowExecutionInput) (output *StartWorkflowExecutionOutput, err error) {
+	req, out := c.StartWorkflowExecutionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opStartWorkflowExecution *aws.Operation
 
 // TerminateWorkflowExecutionRequest generates a request for the TerminateWorkflowExecution operation.
@@ -1670,12 +2113,23 @@ func (c *SWF) TerminateWorkflowExecution(input *TerminateWorkflowExecutionInput)
 	return
 }
 
+// TerminateWorkflowExecutionWithContext is the same as TerminateWorkflowExecution with the addition of a
+// context. Canceling the context aborts the request, including any delay
+// between retries, with a RequestCanceled error.
+func (c *SWF) TerminateWorkflowExecutionWithContext(ctx context.Context, input *TerminateWorkflowExecutionInput) (output *TerminateWorkflowExecutionOutput, err error) {
+	req, out := c.TerminateWorkflowExecutionRequest(input)
+	output = out
+	req.SetContext(ctx)
+	err = req.Send()
+	return
+}
+
 var opTerminateWorkflowExecution *aws.Operation
 
 // Provides details of the ActivityTaskCancelRequested event.
 type ActivityTaskCancelRequestedEventAttributes struct {
 	// The unique ID of the task.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RequestCancelActivityTask decision for this cancellation
@@ -1693,7 +2147,7 @@ type metadataActivityTaskCancelRequestedEventAttributes struct {
 // Provides details of the ActivityTaskCanceled event.
 type ActivityTaskCanceledEventAttributes struct {
 	// Details of the cancellation (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// If set, contains the Id of the last ActivityTaskCancelRequested event recorded
 	// for this activity task. This information can be useful for diagnosing problems
@@ -1720,7 +2174,7 @@ type metadataActivityTaskCanceledEventAttributes struct {
 // Provides details of the ActivityTaskCompleted event.
 type ActivityTaskCompletedEventAttributes struct {
 	// The results of the activity task (if any).
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	// The id of the ActivityTaskScheduled event that was recorded when this activity
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -1742,10 +2196,10 @@ type metadataActivityTaskCompletedEventAttributes struct {
 // Provides details of the ActivityTaskFailed event.
 type ActivityTaskFailedEventAttributes struct {
 	// The details of the failure (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The reason provided for the failure (if any).
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	// The id of the ActivityTaskScheduled event that was recorded when this activity
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -1767,14 +2221,14 @@ type metadataActivityTaskFailedEventAttributes struct {
 // Provides details of the ActivityTaskScheduled event.
 type ActivityTaskScheduledEventAttributes struct {
 	// The unique id of the activity task.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The type of the activity task.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks. This data is not sent to the activity.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision that
 	// resulted in the scheduling of this activity task. This information can be
@@ -1786,20 +2240,20 @@ type ActivityTaskScheduledEventAttributes struct {
 	// progress by calling RecordActivityTaskHeartbeat. If the timeout is exceeded,
 	// the activity task is automatically timed out. If the worker subsequently
 	// attempts to record a heartbeat or return a result, it will be ignored.
-	HeartbeatTimeout *string `locationName:"heartbeatTimeout" type:"string"`
+	HeartbeatTimeout *string `locationName:"heartbeatTimeout" type:"string" max:"8"`
 
 	// The input provided to the activity task.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The maximum amount of time for this activity task.
-	ScheduleToCloseTimeout *string `locationName:"scheduleToCloseTimeout" type:"string"`
+	ScheduleToCloseTimeout *string `locationName:"scheduleToCloseTimeout" type:"string" max:"8"`
 
 	// The maximum amount of time the activity task can wait to be assigned to a
 	// worker.
-	ScheduleToStartTimeout *string `locationName:"scheduleToStartTimeout" type:"string"`
+	ScheduleToStartTimeout *string `locationName:"scheduleToStartTimeout" type:"string" max:"8"`
 
 	// The maximum amount of time a worker may take to process the activity task.
-	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string"`
+	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string" max:"8"`
 
 	// The task list in which the activity task has been scheduled.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -1815,7 +2269,7 @@ type ActivityTaskScheduledEventAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	metadataActivityTaskScheduledEventAttributes `json:"-", xml:"-"`
 }
@@ -1828,7 +2282,7 @@ type metadataActivityTaskScheduledEventAttributes struct {
 type ActivityTaskStartedEventAttributes struct {
 	// Identity of the worker that was assigned this task. This aids diagnostics
 	// when problems arise. The form of this identity is user defined.
-	Identity *string `locationName:"identity" type:"string"`
+	Identity *string `locationName:"identity" type:"string" max:"256"`
 
 	// The id of the ActivityTaskScheduled event that was recorded when this activity
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -1846,7 +2300,7 @@ type metadataActivityTaskStartedEventAttributes struct {
 type ActivityTaskTimedOutEventAttributes struct {
 	// Contains the content of the details parameter for the last call made by the
 	// activity to RecordActivityTaskHeartbeat.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"2048"`
 
 	// The id of the ActivityTaskScheduled event that was recorded when this activity
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -1859,7 +2313,7 @@ type ActivityTaskTimedOutEventAttributes struct {
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
 
 	// The type of the timeout that caused this event.
-	TimeoutType *string `locationName:"timeoutType" type:"string" required:"true"`
+	TimeoutType *string `locationName:"timeoutType" type:"string" enum:"START_TO_CLOSE,SCHEDULE_TO_START,SCHEDULE_TO_CLOSE,HEARTBEAT" required:"true"`
 
 	metadataActivityTaskTimedOutEventAttributes `json:"-", xml:"-"`
 }
@@ -1874,13 +2328,13 @@ type ActivityType struct {
 	//
 	// The combination of activity type name and version must be unique within
 	// a domain.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The version of this activity.
 	//
 	// The combination of activity type name and version must be unique with in
 	// a domain.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataActivityType `json:"-", xml:"-"`
 }
@@ -1903,7 +2357,7 @@ type ActivityTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskHeartbeatTimeout *string `locationName:"defaultTaskHeartbeatTimeout" type:"string"`
+	DefaultTaskHeartbeatTimeout *string `locationName:"defaultTaskHeartbeatTimeout" type:"string" max:"8"`
 
 	// Optional. The default task list specified for this activity type at registration.
 	// This default is used if a task list is not provided when a task is scheduled
@@ -1921,7 +2375,7 @@ type ActivityTypeConfiguration struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string"`
+	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string" max:"11"`
 
 	// Optional. The default maximum duration, specified when registering the activity
 	// type, for tasks of this activity type. You can override this default when
@@ -1929,7 +2383,7 @@ type ActivityTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskScheduleToCloseTimeout *string `locationName:"defaultTaskScheduleToCloseTimeout" type:"string"`
+	DefaultTaskScheduleToCloseTimeout *string `locationName:"defaultTaskScheduleToCloseTimeout" type:"string" max:"8"`
 
 	// Optional. The default maximum duration, specified when registering the activity
 	// type, that a task of an activity type can wait before being assigned to a
@@ -1938,7 +2392,7 @@ type ActivityTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskScheduleToStartTimeout *string `locationName:"defaultTaskScheduleToStartTimeout" type:"string"`
+	DefaultTaskScheduleToStartTimeout *string `locationName:"defaultTaskScheduleToStartTimeout" type:"string" max:"8"`
 
 	// Optional. The default maximum duration for tasks of an activity type specified
 	// when registering the activity type. You can override this default when scheduling
@@ -1946,7 +2400,7 @@ type ActivityTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string"`
+	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string" max:"8"`
 
 	metadataActivityTypeConfiguration `json:"-", xml:"-"`
 }
@@ -1967,10 +2421,10 @@ type ActivityTypeInfo struct {
 	DeprecationDate *time.Time `locationName:"deprecationDate" type:"timestamp" timestampFormat:"unix"`
 
 	// The description of the activity type provided in RegisterActivityType.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The current status of the activity type.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	Status *string `locationName:"status" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	metadataActivityTypeInfo `json:"-", xml:"-"`
 }
@@ -1996,7 +2450,7 @@ type metadataActivityTypeInfo struct {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type CancelTimerDecisionAttributes struct {
 	// Required. The unique Id of the timer to cancel.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataCancelTimerDecisionAttributes `json:"-", xml:"-"`
 }
@@ -2013,7 +2467,7 @@ type CancelTimerFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"TIMER_ID_UNKNOWN,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the CancelTimer decision to cancel this timer. This information
@@ -2022,7 +2476,7 @@ type CancelTimerFailedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The timerId provided in the CancelTimer decision that failed.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataCancelTimerFailedEventAttributes `json:"-", xml:"-"`
 }
@@ -2048,7 +2502,7 @@ type metadataCancelTimerFailedEventAttributes struct {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type CancelWorkflowExecutionDecisionAttributes struct {
 	// Optional. details of the cancellation.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	metadataCancelWorkflowExecutionDecisionAttributes `json:"-", xml:"-"`
 }
@@ -2065,7 +2519,7 @@ type CancelWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"UNHANDLED_DECISION,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the CancelWorkflowExecution decision for this cancellation
@@ -2083,7 +2537,7 @@ type metadataCancelWorkflowExecutionFailedEventAttributes struct {
 // Provide details of the ChildWorkflowExecutionCanceled event.
 type ChildWorkflowExecutionCanceledEventAttributes struct {
 	// Details of the cancellation (if provided).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
 	// the StartChildWorkflowExecution Decision to start this child workflow execution.
@@ -2118,7 +2572,7 @@ type ChildWorkflowExecutionCompletedEventAttributes struct {
 	InitiatedEventID *int64 `locationName:"initiatedEventId" type:"long" required:"true"`
 
 	// The result of the child workflow execution (if any).
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	// The Id of the ChildWorkflowExecutionStarted event recorded when this child
 	// workflow execution was started. This information can be useful for diagnosing
@@ -2141,7 +2595,7 @@ type metadataChildWorkflowExecutionCompletedEventAttributes struct {
 // Provides details of the ChildWorkflowExecutionFailed event.
 type ChildWorkflowExecutionFailedEventAttributes struct {
 	// The details of the failure (if provided).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
 	// the StartChildWorkflowExecution Decision to start this child workflow execution.
@@ -2150,7 +2604,7 @@ type ChildWorkflowExecutionFailedEventAttributes struct {
 	InitiatedEventID *int64 `locationName:"initiatedEventId" type:"long" required:"true"`
 
 	// The reason for the failure (if provided).
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	// The Id of the ChildWorkflowExecutionStarted event recorded when this child
 	// workflow execution was started. This information can be useful for diagnosing
@@ -2232,7 +2686,7 @@ type ChildWorkflowExecutionTimedOutEventAttributes struct {
 
 	// The type of the timeout that caused the child workflow execution to time
 	// out.
-	TimeoutType *string `locationName:"timeoutType" type:"string" required:"true"`
+	TimeoutType *string `locationName:"timeoutType" type:"string" enum:"START_TO_CLOSE" required:"true"`
 
 	// The child workflow execution that timed out.
 	WorkflowExecution *WorkflowExecution `locationName:"workflowExecution" type:"structure" required:"true"`
@@ -2252,7 +2706,7 @@ type metadataChildWorkflowExecutionTimedOutEventAttributes struct {
 type CloseStatusFilter struct {
 	// Required. The close status that must match the close status of an execution
 	// for it to meet the criteria of this filter.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	Status *string `locationName:"status" type:"string" enum:"COMPLETED,FAILED,CANCELED,TERMINATED,CONTINUED_AS_NEW,TIMED_OUT" required:"true"`
 
 	metadataCloseStatusFilter `json:"-", xml:"-"`
 }
@@ -2279,7 +2733,7 @@ type metadataCloseStatusFilter struct {
 type CompleteWorkflowExecutionDecisionAttributes struct {
 	// The result of the workflow execution. The form of the result is implementation
 	// defined.
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	metadataCompleteWorkflowExecutionDecisionAttributes `json:"-", xml:"-"`
 }
@@ -2296,7 +2750,7 @@ type CompleteWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"UNHANDLED_DECISION,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the CompleteWorkflowExecution decision to complete this
@@ -2346,7 +2800,7 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	// workflow type or through this parameter. If neither this parameter is set
 	// nor a default child policy was specified at registration time then a fault
 	// will be returned.
-	ChildPolicy *string `locationName:"childPolicy" type:"string"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// If set, specifies the total duration for this workflow execution. This overrides
 	// the defaultExecutionStartToCloseTimeout specified when registering the workflow
@@ -2359,16 +2813,16 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	// specified either as a default for the workflow type or through this field.
 	// If neither this field is set nor a default execution start-to-close timeout
 	// was specified at registration time then a fault will be returned.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input provided to the new workflow execution.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The list of tags to associate with the new workflow execution. A maximum
 	// of 5 tags can be specified. You can list workflow executions with a specific
 	// tag by calling ListOpenWorkflowExecutions or ListClosedWorkflowExecutions
 	// and specifying a TagFilter.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// Represents a task list.
 	TaskList *TaskList `locationName:"taskList" type:"structure"`
@@ -2382,7 +2836,7 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// Specifies the maximum duration of decision tasks for the new workflow execution.
 	// This parameter overrides the defaultTaskStartToCloseTimout specified when
@@ -2395,9 +2849,9 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	// either as a default for the workflow type or through this parameter. If neither
 	// this parameter is set nor a default task start-to-close timeout was specified
 	// at registration time then a fault will be returned.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
-	WorkflowTypeVersion *string `locationName:"workflowTypeVersion" type:"string"`
+	WorkflowTypeVersion *string `locationName:"workflowTypeVersion" type:"string" min:"1" max:"64"`
 
 	metadataContinueAsNewWorkflowExecutionDecisionAttributes `json:"-", xml:"-"`
 }
@@ -2414,7 +2868,7 @@ type ContinueAsNewWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"UNHANDLED_DECISION,WORKFLOW_TYPE_DEPRECATED,WORKFLOW_TYPE_DOES_NOT_EXIST,DEFAULT_EXECUTION_START_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_TASK_START_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_TASK_LIST_UNDEFINED,DEFAULT_CHILD_POLICY_UNDEFINED,CONTINUE_AS_NEW_WORKFLOW_EXECUTION_RATE_EXCEEDED,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the ContinueAsNewWorkflowExecution decision that started
@@ -2445,7 +2899,7 @@ type CountClosedWorkflowExecutionsInput struct {
 	CloseTimeFilter *ExecutionTimeFilter `locationName:"closeTimeFilter" type:"structure"`
 
 	// The name of the domain containing the workflow executions to count.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// If specified, only workflow executions matching the WorkflowId in the filter
 	// are counted.
@@ -2483,7 +2937,7 @@ type metadataCountClosedWorkflowExecutionsInput struct {
 
 type CountOpenWorkflowExecutionsInput struct {
 	// The name of the domain containing the workflow executions to count.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// If specified, only workflow executions matching the WorkflowId in the filter
 	// are counted.
@@ -2518,7 +2972,7 @@ type metadataCountOpenWorkflowExecutionsInput struct {
 
 type CountPendingActivityTasksInput struct {
 	// The name of the domain that contains the task list.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The name of the task list.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -2532,7 +2986,7 @@ type metadataCountPendingActivityTasksInput struct {
 
 type CountPendingDecisionTasksInput struct {
 	// The name of the domain that contains the task list.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The name of the task list.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -2665,7 +3119,7 @@ type Decision struct {
 	ContinueAsNewWorkflowExecutionDecisionAttributes *ContinueAsNewWorkflowExecutionDecisionAttributes `locationName:"continueAsNewWorkflowExecutionDecisionAttributes" type:"structure"`
 
 	// Specifies the type of the decision.
-	DecisionType *string `locationName:"decisionType" type:"string" required:"true"`
+	DecisionType *string `locationName:"decisionType" type:"string" enum:"ScheduleActivityTask,RequestCancelActivityTask,CompleteWorkflowExecution,FailWorkflowExecution,CancelWorkflowExecution,ContinueAsNewWorkflowExecution,RecordMarker,StartTimer,CancelTimer,SignalExternalWorkflowExecution,RequestCancelExternalWorkflowExecution,StartChildWorkflowExecution" required:"true"`
 
 	// Provides details of the FailWorkflowExecution decision. It is not set for
 	// other decision types.
@@ -2709,7 +3163,7 @@ type metadataDecision struct {
 // Provides details of the DecisionTaskCompleted event.
 type DecisionTaskCompletedEventAttributes struct {
 	// User defined context for the workflow execution.
-	ExecutionContext *string `locationName:"executionContext" type:"string"`
+	ExecutionContext *string `locationName:"executionContext" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskScheduled event that was recorded when this decision
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -2735,7 +3189,7 @@ type DecisionTaskScheduledEventAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string"`
+	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string" max:"8"`
 
 	// The name of the task list in which the decision task was scheduled.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -2748,7 +3202,7 @@ type DecisionTaskScheduledEventAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	metadataDecisionTaskScheduledEventAttributes `json:"-", xml:"-"`
 }
@@ -2761,7 +3215,7 @@ type metadataDecisionTaskScheduledEventAttributes struct {
 type DecisionTaskStartedEventAttributes struct {
 	// Identity of the decider making the request. This enables diagnostic tracing
 	// when problems arise. The form of this identity is user defined.
-	Identity *string `locationName:"identity" type:"string"`
+	Identity *string `locationName:"identity" type:"string" max:"256"`
 
 	// The id of the DecisionTaskScheduled event that was recorded when this decision
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -2788,7 +3242,7 @@ type DecisionTaskTimedOutEventAttributes struct {
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
 
 	// The type of timeout that expired before the decision task could be completed.
-	TimeoutType *string `locationName:"timeoutType" type:"string" required:"true"`
+	TimeoutType *string `locationName:"timeoutType" type:"string" enum:"START_TO_CLOSE" required:"true"`
 
 	metadataDecisionTaskTimedOutEventAttributes `json:"-", xml:"-"`
 }
@@ -2802,7 +3256,7 @@ type DeprecateActivityTypeInput struct {
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// The name of the domain in which the activity type is registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeprecateActivityTypeInput `json:"-", xml:"-"`
 }
@@ -2821,7 +3275,7 @@ type metadataDeprecateActivityTypeOutput struct {
 
 type DeprecateDomainInput struct {
 	// The name of the domain to deprecate.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeprecateDomainInput `json:"-", xml:"-"`
 }
@@ -2840,7 +3294,7 @@ type metadataDeprecateDomainOutput struct {
 
 type DeprecateWorkflowTypeInput struct {
 	// The name of the domain in which the workflow type is registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflow type to deprecate.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -2866,7 +3320,7 @@ type DescribeActivityTypeInput struct {
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// The name of the domain in which the activity type is registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDescribeActivityTypeInput `json:"-", xml:"-"`
 }
@@ -2900,7 +3354,7 @@ type metadataDescribeActivityTypeOutput struct {
 
 type DescribeDomainInput struct {
 	// The name of the domain to describe.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDescribeDomainInput `json:"-", xml:"-"`
 }
@@ -2926,7 +3380,7 @@ type metadataDescribeDomainOutput struct {
 
 type DescribeWorkflowExecutionInput struct {
 	// The name of the domain containing the workflow execution.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflow execution to describe.
 	Execution *WorkflowExecution `locationName:"execution" type:"structure" required:"true"`
@@ -2955,7 +3409,7 @@ type DescribeWorkflowExecutionOutput struct {
 	// The latest executionContext provided by the decider for this workflow execution.
 	// A decider can provide an executionContext (a free-form string) when closing
 	// a decision task using RespondDecisionTaskCompleted.
-	LatestExecutionContext *string `locationName:"latestExecutionContext" type:"string"`
+	LatestExecutionContext *string `locationName:"latestExecutionContext" type:"string" max:"32768"`
 
 	// The number of tasks for this workflow execution. This includes open and closed
 	// tasks of all types.
@@ -2970,7 +3424,7 @@ type metadataDescribeWorkflowExecutionOutput struct {
 
 type DescribeWorkflowTypeInput struct {
 	// The name of the domain in which this workflow type is registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflow type to describe.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -3008,7 +3462,7 @@ type metadataDescribeWorkflowTypeOutput struct {
 // Contains the configuration settings of a domain.
 type DomainConfiguration struct {
 	// The retention period for workflow executions in this domain.
-	WorkflowExecutionRetentionPeriodInDays *string `locationName:"workflowExecutionRetentionPeriodInDays" type:"string" required:"true"`
+	WorkflowExecutionRetentionPeriodInDays *string `locationName:"workflowExecutionRetentionPeriodInDays" type:"string" min:"1" max:"8" required:"true"`
 
 	metadataDomainConfiguration `json:"-", xml:"-"`
 }
@@ -3020,10 +3474,10 @@ type metadataDomainConfiguration struct {
 // Contains general information about a domain.
 type DomainInfo struct {
 	// The description of the domain provided through RegisterDomain.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The name of the domain. This name is unique within the account.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The status of the domain:
 	//
@@ -3031,7 +3485,7 @@ type DomainInfo struct {
 	// this domain for registering types and creating new workflow executions.
 	//  DEPRECATED: The domain was deprecated using DeprecateDomain, but is still
 	// in use. You should not create new workflow executions in this domain.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	Status *string `locationName:"status" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	metadataDomainInfo `json:"-", xml:"-"`
 }
@@ -3112,10 +3566,10 @@ type metadataExternalWorkflowExecutionSignaledEventAttributes struct {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type FailWorkflowExecutionDecisionAttributes struct {
 	// Optional. Details of the failure.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// A descriptive reason for the failure that may help in diagnostics.
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	metadataFailWorkflowExecutionDecisionAttributes `json:"-", xml:"-"`
 }
@@ -3132,7 +3586,7 @@ type FailWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"UNHANDLED_DECISION,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the FailWorkflowExecution decision to fail this execution.
@@ -3149,7 +3603,7 @@ type metadataFailWorkflowExecutionFailedEventAttributes struct {
 
 type GetWorkflowExecutionHistoryInput struct {
 	// The name of the domain containing the workflow execution.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Specifies the workflow execution for which to return the history.
 	Execution *WorkflowExecution `locationName:"execution" type:"structure" required:"true"`
@@ -3161,7 +3615,7 @@ type GetWorkflowExecutionHistoryInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3169,7 +3623,7 @@ type GetWorkflowExecutionHistoryInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// When set to true, returns the events in reverse order. By default the results
 	// are returned in ascending order of the eventTimeStamp of the events.
@@ -3195,7 +3649,7 @@ type GetWorkflowExecutionHistoryOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	metadataGetWorkflowExecutionHistoryOutput `json:"-", xml:"-"`
 }
@@ -3373,7 +3827,7 @@ type HistoryEvent struct {
 	EventTimestamp *time.Time `locationName:"eventTimestamp" type:"timestamp" timestampFormat:"unix" required:"true"`
 
 	// The type of the history event.
-	EventType *string `locationName:"eventType" type:"string" required:"true"`
+	EventType *string `locationName:"eventType" type:"string" enum:"WorkflowExecutionStarted,WorkflowExecutionCancelRequested,WorkflowExecutionCompleted,CompleteWorkflowExecutionFailed,WorkflowExecutionFailed,FailWorkflowExecutionFailed,WorkflowExecutionTimedOut,WorkflowExecutionCanceled,CancelWorkflowExecutionFailed,WorkflowExecutionContinuedAsNew,ContinueAsNewWorkflowExecutionFailed,WorkflowExecutionTerminated,DecisionTaskScheduled,DecisionTaskStarted,DecisionTaskCompleted,DecisionTaskTimedOut,ActivityTaskScheduled,ScheduleActivityTaskFailed,ActivityTaskStarted,ActivityTaskCompleted,ActivityTaskFailed,ActivityTaskTimedOut,ActivityTaskCanceled,ActivityTaskCancelRequested,RequestCancelActivityTaskFailed,WorkflowExecutionSignaled,MarkerRecorded,RecordMarkerFailed,TimerStarted,StartTimerFailed,TimerFired,TimerCanceled,CancelTimerFailed,StartChildWorkflowExecutionInitiated,StartChildWorkflowExecutionFailed,ChildWorkflowExecutionStarted,ChildWorkflowExecutionCompleted,ChildWorkflowExecutionFailed,ChildWorkflowExecutionTimedOut,ChildWorkflowExecutionCanceled,ChildWorkflowExecutionTerminated,SignalExternalWorkflowExecutionInitiated,SignalExternalWorkflowExecutionFailed,ExternalWorkflowExecutionSignaled,RequestCancelExternalWorkflowExecutionInitiated,RequestCancelExternalWorkflowExecutionFailed,ExternalWorkflowExecutionCancelRequested" required:"true"`
 
 	// If the event is of type ExternalWorkflowExecutionCancelRequested then this
 	// member is set and provides detailed information about the event. It is not
@@ -3508,7 +3962,7 @@ type metadataHistoryEvent struct {
 
 type ListActivityTypesInput struct {
 	// The name of the domain in which the activity types have been registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The maximum number of results that will be returned per call. nextPageToken
 	// can be used to obtain futher pages of results. The default is 100, which
@@ -3517,10 +3971,10 @@ type ListActivityTypesInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If specified, only lists the activity types that have this name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3528,10 +3982,10 @@ type ListActivityTypesInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// Specifies the registration status of the activity types to list.
-	RegistrationStatus *string `locationName:"registrationStatus" type:"string" required:"true"`
+	RegistrationStatus *string `locationName:"registrationStatus" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	// When set to true, returns the results in reverse order. By default, the results
 	// are returned in ascending alphabetical order by name of the activity types.
@@ -3552,7 +4006,7 @@ type ListActivityTypesOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// List of activity type information.
 	TypeInfos []*ActivityTypeInfo `locationName:"typeInfos" type:"list" required:"true"`
@@ -3583,7 +4037,7 @@ type ListClosedWorkflowExecutionsInput struct {
 	CloseTimeFilter *ExecutionTimeFilter `locationName:"closeTimeFilter" type:"structure"`
 
 	// The name of the domain that contains the workflow executions to list.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// If specified, only workflow executions matching the workflow id specified
 	// in the filter are returned.
@@ -3599,7 +4053,7 @@ type ListClosedWorkflowExecutionsInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3607,7 +4061,7 @@ type ListClosedWorkflowExecutionsInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// When set to true, returns the results in reverse order. By default the results
 	// are returned in descending order of the start or the close time of the executions.
@@ -3649,7 +4103,7 @@ type ListDomainsInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3657,10 +4111,10 @@ type ListDomainsInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// Specifies the registration status of the domains to list.
-	RegistrationStatus *string `locationName:"registrationStatus" type:"string" required:"true"`
+	RegistrationStatus *string `locationName:"registrationStatus" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	// When set to true, returns the results in reverse order. By default, the results
 	// are returned in ascending alphabetical order by name of the domains.
@@ -3684,7 +4138,7 @@ type ListDomainsOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	metadataListDomainsOutput `json:"-", xml:"-"`
 }
@@ -3695,7 +4149,7 @@ type metadataListDomainsOutput struct {
 
 type ListOpenWorkflowExecutionsInput struct {
 	// The name of the domain that contains the workflow executions to list.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// If specified, only workflow executions matching the workflow id specified
 	// in the filter are returned.
@@ -3711,7 +4165,7 @@ type ListOpenWorkflowExecutionsInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3719,7 +4173,7 @@ type ListOpenWorkflowExecutionsInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// When set to true, returns the results in reverse order. By default the results
 	// are returned in descending order of the start time of the executions.
@@ -3750,7 +4204,7 @@ type metadataListOpenWorkflowExecutionsInput struct {
 
 type ListWorkflowTypesInput struct {
 	// The name of the domain in which the workflow types have been registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The maximum number of results that will be returned per call. nextPageToken
 	// can be used to obtain futher pages of results. The default is 100, which
@@ -3759,10 +4213,10 @@ type ListWorkflowTypesInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If specified, lists the workflow type with this name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3770,10 +4224,10 @@ type ListWorkflowTypesInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// Specifies the registration status of the workflow types to list.
-	RegistrationStatus *string `locationName:"registrationStatus" type:"string" required:"true"`
+	RegistrationStatus *string `locationName:"registrationStatus" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	// When set to true, returns the results in reverse order. By default the results
 	// are returned in ascending alphabetical order of the name of the workflow
@@ -3795,7 +4249,7 @@ type ListWorkflowTypesOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// The list of workflow type information.
 	TypeInfos []*WorkflowTypeInfo `locationName:"typeInfos" type:"list" required:"true"`
@@ -3816,10 +4270,10 @@ type MarkerRecordedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// Details of the marker (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The name of the marker.
-	MarkerName *string `locationName:"markerName" type:"string" required:"true"`
+	MarkerName *string `locationName:"markerName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataMarkerRecordedEventAttributes `json:"-", xml:"-"`
 }
@@ -3831,7 +4285,7 @@ type metadataMarkerRecordedEventAttributes struct {
 // Contains the count of tasks in a task list.
 type PendingTaskCount struct {
 	// The number of tasks in the task list.
-	Count *int64 `locationName:"count" type:"integer" required:"true"`
+	Count *int64 `locationName:"count" type:"integer" min:"0" required:"true"`
 
 	// If set to true, indicates that the actual count was more than the maximum
 	// supported by this API and the count returned is the truncated value.
@@ -3846,12 +4300,12 @@ type metadataPendingTaskCount struct {
 
 type PollForActivityTaskInput struct {
 	// The name of the domain that contains the task lists being polled.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Identity of the worker making the request, recorded in the ActivityTaskStarted
 	// event in the workflow history. This enables diagnostic tracing when problems
 	// arise. The form of this identity is user defined.
-	Identity *string `locationName:"identity" type:"string"`
+	Identity *string `locationName:"identity" type:"string" max:"256"`
 
 	// Specifies the task list to poll for activity tasks.
 	//
@@ -3871,14 +4325,14 @@ type metadataPollForActivityTaskInput struct {
 // Unit of work sent to an activity worker.
 type PollForActivityTaskOutput struct {
 	// The unique ID of the task.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The type of this activity task.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// The inputs provided when the activity task was scheduled. The form of the
 	// input is user defined and should be meaningful to the activity implementation.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The id of the ActivityTaskStarted event recorded in the history.
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
@@ -3886,7 +4340,7 @@ type PollForActivityTaskOutput struct {
 	// The opaque string used as a handle on the task. This token is used by workers
 	// to communicate progress and response information back to the system about
 	// the task.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The workflow execution that started this activity task.
 	WorkflowExecution *WorkflowExecution `locationName:"workflowExecution" type:"structure" required:"true"`
@@ -3900,12 +4354,12 @@ type metadataPollForActivityTaskOutput struct {
 
 type PollForDecisionTaskInput struct {
 	// The name of the domain containing the task lists to poll.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Identity of the decider making the request, which is recorded in the DecisionTaskStarted
 	// event in the workflow history. This enables diagnostic tracing when problems
 	// arise. The form of this identity is user defined.
-	Identity *string `locationName:"identity" type:"string"`
+	Identity *string `locationName:"identity" type:"string" max:"256"`
 
 	// The maximum number of results that will be returned per call. nextPageToken
 	// can be used to obtain futher pages of results. The default is 100, which
@@ -3914,7 +4368,7 @@ type PollForDecisionTaskInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3927,7 +4381,7 @@ type PollForDecisionTaskInput struct {
 	// to get the next page. You must call PollForDecisionTask again (with the nextPageToken)
 	// to retrieve the next page of history records. Calling PollForDecisionTask
 	// with a nextPageToken will not return a new decision task..
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// When set to true, returns the events in reverse order. By default the results
 	// are returned in ascending order of the eventTimestamp of the events.
@@ -3961,7 +4415,7 @@ type PollForDecisionTaskOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// The id of the DecisionTaskStarted event of the previous decision task of
 	// this workflow execution that was processed by the decider. This can be used
@@ -3975,7 +4429,7 @@ type PollForDecisionTaskOutput struct {
 	// The opaque string used as a handle on the task. This token is used by workers
 	// to communicate progress and response information back to the system about
 	// the task.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The workflow execution for which this decision task was created.
 	WorkflowExecution *WorkflowExecution `locationName:"workflowExecution" type:"structure" required:"true"`
@@ -3992,14 +4446,14 @@ type metadataPollForDecisionTaskOutput struct {
 
 type RecordActivityTaskHeartbeatInput struct {
 	// If specified, contains details about the progress of the task.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"2048"`
 
 	// The taskToken of the ActivityTask.
 	//
 	//  taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRecordActivityTaskHeartbeatInput `json:"-", xml:"-"`
 }
@@ -4037,10 +4491,10 @@ type metadataRecordActivityTaskHeartbeatOutput struct {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type RecordMarkerDecisionAttributes struct {
 	// Optional. details of the marker.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// Required. The name of the marker.
-	MarkerName *string `locationName:"markerName" type:"string" required:"true"`
+	MarkerName *string `locationName:"markerName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRecordMarkerDecisionAttributes `json:"-", xml:"-"`
 }
@@ -4057,7 +4511,7 @@ type RecordMarkerFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RecordMarkerFailed decision for this cancellation request.
@@ -4066,7 +4520,7 @@ type RecordMarkerFailedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The marker's name.
-	MarkerName *string `locationName:"markerName" type:"string" required:"true"`
+	MarkerName *string `locationName:"markerName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRecordMarkerFailedEventAttributes `json:"-", xml:"-"`
 }
@@ -4087,7 +4541,7 @@ type RegisterActivityTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskHeartbeatTimeout *string `locationName:"defaultTaskHeartbeatTimeout" type:"string"`
+	DefaultTaskHeartbeatTimeout *string `locationName:"defaultTaskHeartbeatTimeout" type:"string" max:"8"`
 
 	// If set, specifies the default task list to use for scheduling tasks of this
 	// activity type. This default task list is used if a task list is not provided
@@ -4102,7 +4556,7 @@ type RegisterActivityTypeInput struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string"`
+	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string" max:"11"`
 
 	// If set, specifies the default maximum duration for a task of this activity
 	// type. This default can be overridden when scheduling an activity task using
@@ -4110,7 +4564,7 @@ type RegisterActivityTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskScheduleToCloseTimeout *string `locationName:"defaultTaskScheduleToCloseTimeout" type:"string"`
+	DefaultTaskScheduleToCloseTimeout *string `locationName:"defaultTaskScheduleToCloseTimeout" type:"string" max:"8"`
 
 	// If set, specifies the default maximum duration that a task of this activity
 	// type can wait before being assigned to a worker. This default can be overridden
@@ -4118,7 +4572,7 @@ type RegisterActivityTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskScheduleToStartTimeout *string `locationName:"defaultTaskScheduleToStartTimeout" type:"string"`
+	DefaultTaskScheduleToStartTimeout *string `locationName:"defaultTaskScheduleToStartTimeout" type:"string" max:"8"`
 
 	// If set, specifies the default maximum duration that a worker can take to
 	// process tasks of this activity type. This default can be overridden when
@@ -4126,13 +4580,13 @@ type RegisterActivityTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string"`
+	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string" max:"8"`
 
 	// A textual description of the activity type.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The name of the domain in which this activity is to be registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The name of the activity type within the domain.
 	//
@@ -4140,7 +4594,7 @@ type RegisterActivityTypeInput struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The version of the activity type.
 	//
@@ -4149,7 +4603,7 @@ type RegisterActivityTypeInput struct {
 	// end with whitespace. It must not contain a : (colon), / (slash), | (vertical
 	// bar), or any control characters (\u0000-\u001f | \u007f - \u009f). Also,
 	// it must not contain the literal string quotarnquot.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataRegisterActivityTypeInput `json:"-", xml:"-"`
 }
@@ -4168,7 +4622,7 @@ type metadataRegisterActivityTypeOutput struct {
 
 type RegisterDomainInput struct {
 	// A text description of the domain.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// Name of the domain to register. The name must be unique in the region that
 	// the domain is registered in.
@@ -4177,7 +4631,7 @@ type RegisterDomainInput struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The duration (in days) that records and histories of workflow executions
 	// on the domain should be kept by the service. After the retention period,
@@ -4190,7 +4644,7 @@ type RegisterDomainInput struct {
 	// The maximum workflow execution retention period is 90 days. For more information
 	// about Amazon SWF service limits, see: Amazon SWF Service Limits (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dg-limits.html)
 	// in the Amazon SWF Developer Guide.
-	WorkflowExecutionRetentionPeriodInDays *string `locationName:"workflowExecutionRetentionPeriodInDays" type:"string" required:"true"`
+	WorkflowExecutionRetentionPeriodInDays *string `locationName:"workflowExecutionRetentionPeriodInDays" type:"string" min:"1" max:"8" required:"true"`
 
 	metadataRegisterDomainInput `json:"-", xml:"-"`
 }
@@ -4221,7 +4675,7 @@ type RegisterWorkflowTypeInput struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	DefaultChildPolicy *string `locationName:"defaultChildPolicy" type:"string"`
+	DefaultChildPolicy *string `locationName:"defaultChildPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// If set, specifies the default maximum duration for executions of this workflow
 	// type. You can override this default when starting an execution through the
@@ -4232,7 +4686,7 @@ type RegisterWorkflowTypeInput struct {
 	// specify a value of "NONE" for defaultExecutionStartToCloseTimeout; there
 	// is a one-year max limit on the time that a workflow execution can run. Exceeding
 	// this limit will always cause the workflow execution to time out.
-	DefaultExecutionStartToCloseTimeout *string `locationName:"defaultExecutionStartToCloseTimeout" type:"string"`
+	DefaultExecutionStartToCloseTimeout *string `locationName:"defaultExecutionStartToCloseTimeout" type:"string" max:"8"`
 
 	// If set, specifies the default task list to use for scheduling decision tasks
 	// for executions of this workflow type. This default is used only if a task
@@ -4248,7 +4702,7 @@ type RegisterWorkflowTypeInput struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string"`
+	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string" max:"11"`
 
 	// If set, specifies the default maximum duration of decision tasks for this
 	// workflow type. This default can be overridden when starting a workflow execution
@@ -4257,13 +4711,13 @@ type RegisterWorkflowTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string"`
+	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string" max:"8"`
 
 	// Textual description of the workflow type.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The name of the domain in which to register the workflow type.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The name of the workflow type.
 	//
@@ -4271,7 +4725,7 @@ type RegisterWorkflowTypeInput struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The version of the workflow type.
 	//
@@ -4281,7 +4735,7 @@ type RegisterWorkflowTypeInput struct {
 	// not start or end with whitespace. It must not contain a : (colon), / (slash),
 	// | (vertical bar), or any control characters (\u0000-\u001f | \u007f - \u009f).
 	// Also, it must not contain the literal string quotarnquot.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataRegisterWorkflowTypeInput `json:"-", xml:"-"`
 }
@@ -4315,7 +4769,7 @@ type metadataRegisterWorkflowTypeOutput struct {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type RequestCancelActivityTaskDecisionAttributes struct {
 	// The activityId of the activity task to be canceled.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelActivityTaskDecisionAttributes `json:"-", xml:"-"`
 }
@@ -4327,7 +4781,7 @@ type metadataRequestCancelActivityTaskDecisionAttributes struct {
 // Provides details of the RequestCancelActivityTaskFailed event.
 type RequestCancelActivityTaskFailedEventAttributes struct {
 	// The activityId provided in the RequestCancelActivityTask decision that failed.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The cause of the failure. This information is generated by the system and
 	// can be useful for diagnostic purposes.
@@ -4335,7 +4789,7 @@ type RequestCancelActivityTaskFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"ACTIVITY_ID_UNKNOWN,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RequestCancelActivityTask decision for this cancellation
@@ -4368,13 +4822,13 @@ type metadataRequestCancelActivityTaskFailedEventAttributes struct {
 type RequestCancelExternalWorkflowExecutionDecisionAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The runId of the external workflow execution to cancel.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// Required. The workflowId of the external workflow execution to cancel.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelExternalWorkflowExecutionDecisionAttributes `json:"-", xml:"-"`
 }
@@ -4391,9 +4845,9 @@ type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_RATE_EXCEEDED,OPERATION_NOT_PERMITTED" required:"true"`
 
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RequestCancelExternalWorkflowExecution decision for
@@ -4408,11 +4862,11 @@ type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
 	InitiatedEventID *int64 `locationName:"initiatedEventId" type:"long" required:"true"`
 
 	// The runId of the external workflow execution.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the external workflow to which the cancel request was to
 	// be delivered.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelExternalWorkflowExecutionFailedEventAttributes `json:"-", xml:"-"`
 }
@@ -4425,7 +4879,7 @@ type metadataRequestCancelExternalWorkflowExecutionFailedEventAttributes struct
 type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RequestCancelExternalWorkflowExecution decision for
@@ -4434,10 +4888,10 @@ type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The runId of the external workflow execution to be canceled.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the external workflow execution to be canceled.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelExternalWorkflowExecutionInitiatedEventAttributes `json:"-", xml:"-"`
 }
@@ -4448,13 +4902,13 @@ type metadataRequestCancelExternalWorkflowExecutionInitiatedEventAttributes stru
 
 type RequestCancelWorkflowExecutionInput struct {
 	// The name of the domain containing the workflow execution to cancel.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The runId of the workflow execution to cancel.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the workflow execution to cancel.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelWorkflowExecutionInput `json:"-", xml:"-"`
 }
@@ -4473,14 +4927,14 @@ type metadataRequestCancelWorkflowExecutionOutput struct {
 
 type RespondActivityTaskCanceledInput struct {
 	// Optional. Information about the cancellation.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The taskToken of the ActivityTask.
 	//
 	// taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRespondActivityTaskCanceledInput `json:"-", xml:"-"`
 }
@@ -4500,14 +4954,14 @@ type metadataRespondActivityTaskCanceledOutput struct {
 type RespondActivityTaskCompletedInput struct {
 	// The result of the activity task. It is a free form string that is implementation
 	// specific.
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	// The taskToken of the ActivityTask.
 	//
 	//  taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRespondActivityTaskCompletedInput `json:"-", xml:"-"`
 }
@@ -4526,17 +4980,17 @@ type metadataRespondActivityTaskCompletedOutput struct {
 
 type RespondActivityTaskFailedInput struct {
 	// Optional. Detailed information about the failure.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// Description of the error that may assist in diagnostics.
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	// The taskToken of the ActivityTask.
 	//
 	//  taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRespondActivityTaskFailedInput `json:"-", xml:"-"`
 }
@@ -4559,14 +5013,14 @@ type RespondDecisionTaskCompletedInput struct {
 	Decisions []*Decision `locationName:"decisions" type:"list"`
 
 	// User defined context to add to workflow execution.
-	ExecutionContext *string `locationName:"executionContext" type:"string"`
+	ExecutionContext *string `locationName:"executionContext" type:"string" max:"32768"`
 
 	// The taskToken from the DecisionTask.
 	//
 	// taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRespondDecisionTaskCompletedInput `json:"-", xml:"-"`
 }
@@ -4608,14 +5062,14 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Required. The type of the activity task to schedule.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks. This data is not sent to the activity.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// If set, specifies the maximum time before which a worker processing a task
 	// of this type must report progress by calling RecordActivityTaskHeartbeat.
@@ -4626,10 +5080,10 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	HeartbeatTimeout *string `locationName:"heartbeatTimeout" type:"string"`
+	HeartbeatTimeout *string `locationName:"heartbeatTimeout" type:"string" max:"8"`
 
 	// The input provided to the activity task.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The maximum duration for this activity task.
 	//
@@ -4640,7 +5094,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// as a default for the activity type or through this field. If neither this
 	// field is set nor a default schedule-to-close timeout was specified at registration
 	// time then a fault will be returned.
-	ScheduleToCloseTimeout *string `locationName:"scheduleToCloseTimeout" type:"string"`
+	ScheduleToCloseTimeout *string `locationName:"scheduleToCloseTimeout" type:"string" max:"8"`
 
 	// Optional. If set, specifies the maximum duration the activity task can wait
 	// to be assigned to a worker. This overrides the default schedule-to-start
@@ -4653,7 +5107,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// as a default for the activity type or through this field. If neither this
 	// field is set nor a default schedule-to-start timeout was specified at registration
 	// time then a fault will be returned.
-	ScheduleToStartTimeout *string `locationName:"scheduleToStartTimeout" type:"string"`
+	ScheduleToStartTimeout *string `locationName:"scheduleToStartTimeout" type:"string" max:"8"`
 
 	// If set, specifies the maximum duration a worker may take to process this
 	// activity task. This overrides the default start-to-close timeout specified
@@ -4666,7 +5120,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// as a default for the activity type or through this field. If neither this
 	// field is set nor a default start-to-close timeout was specified at registration
 	// time then a fault will be returned.
-	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string"`
+	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string" max:"8"`
 
 	// If set, specifies the name of the task list in which to schedule the activity
 	// task. If not specified, the defaultTaskList registered with the activity
@@ -4690,7 +5144,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	metadataScheduleActivityTaskDecisionAttributes `json:"-", xml:"-"`
 }
@@ -4702,7 +5156,7 @@ type metadataScheduleActivityTaskDecisionAttributes struct {
 // Provides details of the ScheduleActivityTaskFailed event.
 type ScheduleActivityTaskFailedEventAttributes struct {
 	// The activityId provided in the ScheduleActivityTask decision that failed.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The activity type provided in the ScheduleActivityTask decision that failed.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
@@ -4713,7 +5167,7 @@ type ScheduleActivityTaskFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"ACTIVITY_TYPE_DEPRECATED,ACTIVITY_TYPE_DOES_NOT_EXIST,ACTIVITY_ID_ALREADY_IN_USE,OPEN_ACTIVITIES_LIMIT_EXCEEDED,ACTIVITY_CREATION_RATE_EXCEEDED,DEFAULT_SCHEDULE_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_TASK_LIST_UNDEFINED,DEFAULT_SCHEDULE_TO_START_TIMEOUT_UNDEFINED,DEFAULT_START_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_HEARTBEAT_TIMEOUT_UNDEFINED,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision that
 	// resulted in the scheduling of this activity task. This information can be
@@ -4746,21 +5200,21 @@ type metadataScheduleActivityTaskFailedEventAttributes struct {
 type SignalExternalWorkflowExecutionDecisionAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// decision tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// Optional. Input data to be provided with the signal. The target workflow
 	// execution will use the signal name and input data to process the signal.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The runId of the workflow execution to be signaled.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// Required. The name of the signal.The target workflow execution will use the
 	// signal name and input to process the signal.
-	SignalName *string `locationName:"signalName" type:"string" required:"true"`
+	SignalName *string `locationName:"signalName" type:"string" min:"1" max:"256" required:"true"`
 
 	// Required. The workflowId of the workflow execution to be signaled.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataSignalExternalWorkflowExecutionDecisionAttributes `json:"-", xml:"-"`
 }
@@ -4777,9 +5231,9 @@ type SignalExternalWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_RATE_EXCEEDED,OPERATION_NOT_PERMITTED" required:"true"`
 
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the SignalExternalWorkflowExecution decision for this signal.
@@ -4795,11 +5249,11 @@ type SignalExternalWorkflowExecutionFailedEventAttributes struct {
 
 	// The runId of the external workflow execution that the signal was being delivered
 	// to.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the external workflow execution that the signal was being
 	// delivered to.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataSignalExternalWorkflowExecutionFailedEventAttributes `json:"-", xml:"-"`
 }
@@ -4812,7 +5266,7 @@ type metadataSignalExternalWorkflowExecutionFailedEventAttributes struct {
 type SignalExternalWorkflowExecutionInitiatedEventAttributes struct {
 	// Optional. data attached to the event that can be used by the decider in subsequent
 	// decision tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the SignalExternalWorkflowExecution decision for this signal.
@@ -4821,16 +5275,16 @@ type SignalExternalWorkflowExecutionInitiatedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// Input provided to the signal (if any).
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The runId of the external workflow execution to send the signal to.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The name of the signal.
-	SignalName *string `locationName:"signalName" type:"string" required:"true"`
+	SignalName *string `locationName:"signalName" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflowId of the external workflow execution.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataSignalExternalWorkflowExecutionInitiatedEventAttributes `json:"-", xml:"-"`
 }
@@ -4841,20 +5295,20 @@ type metadataSignalExternalWorkflowExecutionInitiatedEventAttributes struct {
 
 type SignalWorkflowExecutionInput struct {
 	// The name of the domain containing the workflow execution to signal.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Data to attach to the WorkflowExecutionSignaled event in the target workflow
 	// execution's history.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The runId of the workflow execution to signal.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The name of the signal. This name must be meaningful to the target workflow.
-	SignalName *string `locationName:"signalName" type:"string" required:"true"`
+	SignalName *string `locationName:"signalName" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflowId of the workflow execution to signal.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataSignalWorkflowExecutionInput `json:"-", xml:"-"`
 }
@@ -4907,11 +5361,11 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// workflow type or through this parameter. If neither this parameter is set
 	// nor a default child policy was specified at registration time then a fault
 	// will be returned.
-	ChildPolicy *string `locationName:"childPolicy" type:"string"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks. This data is not sent to the child workflow execution.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The total duration for this workflow execution. This overrides the defaultExecutionStartToCloseTimeout
 	// specified when registering the workflow type.
@@ -4923,16 +5377,16 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// specified either as a default for the workflow type or through this parameter.
 	// If neither this parameter is set nor a default execution start-to-close timeout
 	// was specified at registration time then a fault will be returned.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input to be provided to the workflow execution.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The list of tags to associate with the child workflow execution. A maximum
 	// of 5 tags can be specified. You can list workflow executions with a specific
 	// tag by calling ListOpenWorkflowExecutions or ListClosedWorkflowExecutions
 	// and specifying a TagFilter.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The name of the task list to be used for decision tasks of the child workflow
 	// execution.
@@ -4955,7 +5409,7 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// Specifies the maximum duration of decision tasks for this workflow execution.
 	// This parameter overrides the defaultTaskStartToCloseTimout specified when
@@ -4968,7 +5422,7 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// either as a default for the workflow type or through this parameter. If neither
 	// this parameter is set nor a default task start-to-close timeout was specified
 	// at registration time then a fault will be returned.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// Required. The workflowId of the workflow execution.
 	//
@@ -4976,7 +5430,7 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Required. The type of the workflow execution to be started.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -4996,9 +5450,9 @@ type StartChildWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"WORKFLOW_TYPE_DOES_NOT_EXIST,WORKFLOW_TYPE_DEPRECATED,OPEN_CHILDREN_LIMIT_EXCEEDED,OPEN_WORKFLOWS_LIMIT_EXCEEDED,CHILD_CREATION_RATE_EXCEEDED,WORKFLOW_ALREADY_RUNNING,DEFAULT_EXECUTION_START_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_TASK_LIST_UNDEFINED,DEFAULT_TASK_START_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_CHILD_POLICY_UNDEFINED,OPERATION_NOT_PERMITTED" required:"true"`
 
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the StartChildWorkflowExecution Decision to request this
@@ -5013,7 +5467,7 @@ type StartChildWorkflowExecutionFailedEventAttributes struct {
 	InitiatedEventID *int64 `locationName:"initiatedEventId" type:"long" required:"true"`
 
 	// The workflowId of the child workflow execution.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflow type provided in the StartChildWorkflowExecution Decision that
 	// failed.
@@ -5039,11 +5493,11 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// decision tasks. This data is not sent to the activity.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the StartChildWorkflowExecution Decision to request this
@@ -5056,13 +5510,13 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The inputs provided to the child workflow execution (if any).
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The list of tags to associated with the child workflow execution.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The name of the task list used for the decision tasks of the child workflow
 	// execution.
@@ -5076,16 +5530,16 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// The maximum duration allowed for the decision tasks for this workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// The workflowId of the child workflow execution.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The type of the child workflow execution.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -5115,13 +5569,13 @@ type metadataStartChildWorkflowExecutionInitiatedEventAttributes struct {
 type StartTimerDecisionAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// Required. The duration to wait before firing the timer.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0.
-	StartToFireTimeout *string `locationName:"startToFireTimeout" type:"string" required:"true"`
+	StartToFireTimeout *string `locationName:"startToFireTimeout" type:"string" min:"1" max:"8" required:"true"`
 
 	// Required. The unique Id of the timer.
 	//
@@ -5129,7 +5583,7 @@ type StartTimerDecisionAttributes struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataStartTimerDecisionAttributes `json:"-", xml:"-"`
 }
@@ -5146,7 +5600,7 @@ type StartTimerFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"TIMER_ID_ALREADY_IN_USE,OPEN_TIMERS_LIMIT_EXCEEDED,TIMER_CREATION_RATE_EXCEEDED,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the StartTimer decision for this activity task. This information
@@ -5155,7 +5609,7 @@ type StartTimerFailedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The timerId provided in the StartTimer decision that failed.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataStartTimerFailedEventAttributes `json:"-", xml:"-"`
 }
@@ -5181,10 +5635,10 @@ type StartWorkflowExecutionInput struct {
 	// workflow type or through this parameter. If neither this parameter is set
 	// nor a default child policy was specified at registration time then a fault
 	// will be returned.
-	ChildPolicy *string `locationName:"childPolicy" type:"string"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// The name of the domain in which the workflow execution is created.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The total duration for this workflow execution. This overrides the defaultExecutionStartToCloseTimeout
 	// specified when registering the workflow type.
@@ -5199,18 +5653,18 @@ type StartWorkflowExecutionInput struct {
 	// parameter or as a default when the workflow type is registered. If neither
 	// this parameter nor a default execution start-to-close timeout is specified,
 	// a fault is returned.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input for the workflow execution. This is a free form string which should
 	// be meaningful to the workflow you are starting. This input is made available
 	// to the new workflow execution in the WorkflowExecutionStarted history event.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The list of tags to associate with the workflow execution. You can specify
 	// a maximum of 5 tags. You can list workflow executions with a specific tag
 	// by calling ListOpenWorkflowExecutions or ListClosedWorkflowExecutions and
 	// specifying a TagFilter.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The task list to use for the decision tasks generated for this workflow execution.
 	// This overrides the defaultTaskList specified when registering the workflow
@@ -5235,7 +5689,7 @@ type StartWorkflowExecutionInput struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// Specifies the maximum duration of decision tasks for this workflow execution.
 	// This parameter overrides the defaultTaskStartToCloseTimout specified when
@@ -5248,7 +5702,7 @@ type StartWorkflowExecutionInput struct {
 	// either as a default for the workflow type or through this parameter. If neither
 	// this parameter is set nor a default task start-to-close timeout was specified
 	// at registration time then a fault will be returned.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// The user defined identifier associated with the workflow execution. You can
 	// use this to associate a custom identifier with the workflow execution. You
@@ -5260,7 +5714,7 @@ type StartWorkflowExecutionInput struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The type of the workflow to start.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -5276,7 +5730,7 @@ type metadataStartWorkflowExecutionInput struct {
 type StartWorkflowExecutionOutput struct {
 	// The runId of a workflow execution. This Id is generated by the service and
 	// can be used to uniquely identify the workflow execution within a domain.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" min:"1" max:"64"`
 
 	metadataStartWorkflowExecutionOutput `json:"-", xml:"-"`
 }
@@ -5289,7 +5743,7 @@ type metadataStartWorkflowExecutionOutput struct {
 type TagFilter struct {
 	// Required. Specifies the tag that must be associated with the execution for
 	// it to meet the filter criteria.
-	Tag *string `locationName:"tag" type:"string" required:"true"`
+	Tag *string `locationName:"tag" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTagFilter `json:"-", xml:"-"`
 }
@@ -5301,7 +5755,7 @@ type metadataTagFilter struct {
 // Represents a task list.
 type TaskList struct {
 	// The name of the task list.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTaskList `json:"-", xml:"-"`
 }
@@ -5327,22 +5781,22 @@ type TerminateWorkflowExecutionInput struct {
 	// workflow type or through this parameter. If neither this parameter is set
 	// nor a default child policy was specified at registration time then a fault
 	// will be returned.
-	ChildPolicy *string `locationName:"childPolicy" type:"string"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// Optional. Details for terminating the workflow execution.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The domain of the workflow execution to terminate.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Optional. A descriptive reason for terminating the workflow execution.
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	// The runId of the workflow execution to terminate.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the workflow execution to terminate.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTerminateWorkflowExecutionInput `json:"-", xml:"-"`
 }
@@ -5373,7 +5827,7 @@ type TimerCanceledEventAttributes struct {
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
 
 	// The unique Id of the timer that was canceled.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTimerCanceledEventAttributes `json:"-", xml:"-"`
 }
@@ -5390,7 +5844,7 @@ type TimerFiredEventAttributes struct {
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
 
 	// The unique Id of the timer that fired.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTimerFiredEventAttributes `json:"-", xml:"-"`
 }
@@ -5403,7 +5857,7 @@ type metadataTimerFiredEventAttributes struct {
 type TimerStartedEventAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the StartTimer decision for this activity task. This information
@@ -5415,10 +5869,10 @@ type TimerStartedEventAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0.
-	StartToFireTimeout *string `locationName:"startToFireTimeout" type:"string" required:"true"`
+	StartToFireTimeout *string `locationName:"startToFireTimeout" type:"string" min:"1" max:"8" required:"true"`
 
 	// The unique Id of the timer that was started.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTimerStartedEventAttributes `json:"-", xml:"-"`
 }
@@ -5430,10 +5884,10 @@ type metadataTimerStartedEventAttributes struct {
 // Represents a workflow execution.
 type WorkflowExecution struct {
 	// A system-generated unique identifier for the workflow execution.
-	RunID *string `locationName:"runId" type:"string" required:"true"`
+	RunID *string `locationName:"runId" type:"string" min:"1" max:"64" required:"true"`
 
 	// The user defined identifier associated with the workflow execution.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataWorkflowExecution `json:"-", xml:"-"`
 }
@@ -5447,7 +5901,7 @@ type WorkflowExecutionCancelRequestedEventAttributes struct {
 	// If set, indicates that the request to cancel the workflow execution was automatically
 	// generated, and specifies the cause. This happens if the parent workflow execution
 	// times out or is terminated, and the child policy is set to cancel child executions.
-	Cause *string `locationName:"cause" type:"string"`
+	Cause *string `locationName:"cause" type:"string" enum:"CHILD_POLICY_APPLIED"`
 
 	// The id of the RequestCancelExternalWorkflowExecutionInitiated event corresponding
 	// to the RequestCancelExternalWorkflowExecution decision to cancel this workflow
@@ -5475,7 +5929,7 @@ type WorkflowExecutionCanceledEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// Details for the cancellation (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	metadataWorkflowExecutionCanceledEventAttributes `json:"-", xml:"-"`
 }
@@ -5493,7 +5947,7 @@ type WorkflowExecutionCompletedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The result produced by the workflow execution upon successful completion.
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	metadataWorkflowExecutionCompletedEventAttributes `json:"-", xml:"-"`
 }
@@ -5518,13 +5972,13 @@ type WorkflowExecutionConfiguration struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// The total duration for this workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" required:"true"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" min:"1" max:"8" required:"true"`
 
 	// The task list used for the decision tasks generated for this workflow execution.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -5536,13 +5990,13 @@ type WorkflowExecutionConfiguration struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// The maximum duration allowed for decision tasks for this workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" required:"true"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" min:"1" max:"8" required:"true"`
 
 	metadataWorkflowExecutionConfiguration `json:"-", xml:"-"`
 }
@@ -5564,7 +6018,7 @@ type WorkflowExecutionContinuedAsNewEventAttributes struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the ContinueAsNewWorkflowExecution decision that started
@@ -5576,27 +6030,27 @@ type WorkflowExecutionContinuedAsNewEventAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input provided to the new workflow execution.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The runId of the new workflow execution.
-	NewExecutionRunID *string `locationName:"newExecutionRunId" type:"string" required:"true"`
+	NewExecutionRunID *string `locationName:"newExecutionRunId" type:"string" min:"1" max:"64" required:"true"`
 
 	// The list of tags associated with the new workflow execution.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// Represents a task list.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
 
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// The maximum duration of decision tasks for the new workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// Represents a workflow type.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -5612,7 +6066,7 @@ type metadataWorkflowExecutionContinuedAsNewEventAttributes struct {
 // or CountClosedWorkflowExecutions
 type WorkflowExecutionCount struct {
 	// The number of workflow executions.
-	Count *int64 `locationName:"count" type:"integer" required:"true"`
+	Count *int64 `locationName:"count" type:"integer" min:"0" required:"true"`
 
 	// If set to true, indicates that the actual count was more than the maximum
 	// supported by this API and the count returned is the truncated value.
@@ -5634,10 +6088,10 @@ type WorkflowExecutionFailedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The details of the failure (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The descriptive reason provided for the failure (if any).
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	metadataWorkflowExecutionFailedEventAttributes `json:"-", xml:"-"`
 }
@@ -5649,7 +6103,7 @@ type metadataWorkflowExecutionFailedEventAttributes struct {
 // Used to filter the workflow executions in visibility APIs by their workflowId.
 type WorkflowExecutionFilter struct {
 	// The workflowId to pass of match the criteria of this filter.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataWorkflowExecutionFilter `json:"-", xml:"-"`
 }
@@ -5673,7 +6127,7 @@ type WorkflowExecutionInfo struct {
 	// not complete in the alloted time and was automatically timed out.  CONTINUED_AS_NEW:
 	// the execution is logically continued. This means the current execution was
 	// completed and a new execution was started to carry on the workflow.
-	CloseStatus *string `locationName:"closeStatus" type:"string"`
+	CloseStatus *string `locationName:"closeStatus" type:"string" enum:"COMPLETED,FAILED,CANCELED,TERMINATED,CONTINUED_AS_NEW,TIMED_OUT"`
 
 	// The time when the workflow execution was closed. Set only if the execution
 	// status is CLOSED.
@@ -5683,7 +6137,7 @@ type WorkflowExecutionInfo struct {
 	Execution *WorkflowExecution `locationName:"execution" type:"structure" required:"true"`
 
 	// The current status of the execution.
-	ExecutionStatus *string `locationName:"executionStatus" type:"string" required:"true"`
+	ExecutionStatus *string `locationName:"executionStatus" type:"string" enum:"OPEN,CLOSED" required:"true"`
 
 	// If this workflow execution is a child of another execution then contains
 	// the workflow execution that started this execution.
@@ -5695,7 +6149,7 @@ type WorkflowExecutionInfo struct {
 	// The list of tags associated with the workflow execution. Tags can be used
 	// to identify and list workflow executions of interest through the visibility
 	// APIs. A workflow execution can have a maximum of 5 tags.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The type of the workflow execution.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -5718,7 +6172,7 @@ type WorkflowExecutionInfos struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	metadataWorkflowExecutionInfos `json:"-", xml:"-"`
 }
@@ -5731,18 +6185,18 @@ type metadataWorkflowExecutionInfos struct {
 // a workflow execution.
 type WorkflowExecutionOpenCounts struct {
 	// The count of activity tasks whose status is OPEN.
-	OpenActivityTasks *int64 `locationName:"openActivityTasks" type:"integer" required:"true"`
+	OpenActivityTasks *int64 `locationName:"openActivityTasks" type:"integer" min:"0" required:"true"`
 
 	// The count of child workflow executions whose status is OPEN.
-	OpenChildWorkflowExecutions *int64 `locationName:"openChildWorkflowExecutions" type:"integer" required:"true"`
+	OpenChildWorkflowExecutions *int64 `locationName:"openChildWorkflowExecutions" type:"integer" min:"0" required:"true"`
 
 	// The count of decision tasks whose status is OPEN. A workflow execution can
 	// have at most one open decision task.
-	OpenDecisionTasks *int64 `locationName:"openDecisionTasks" type:"integer" required:"true"`
+	OpenDecisionTasks *int64 `locationName:"openDecisionTasks" type:"integer" min:"0" max:"1" required:"true"`
 
 	// The count of timers started by this workflow execution that have not fired
 	// yet.
-	OpenTimers *int64 `locationName:"openTimers" type:"integer" required:"true"`
+	OpenTimers *int64 `locationName:"openTimers" type:"integer" min:"0" required:"true"`
 
 	metadataWorkflowExecutionOpenCounts `json:"-", xml:"-"`
 }
@@ -5767,11 +6221,11 @@ type WorkflowExecutionSignaledEventAttributes struct {
 
 	// Inputs provided with the signal (if any). The decider can use the signal
 	// name and inputs to determine how to process the signal.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The name of the signal received. The decider can use the signal name and
 	// inputs to determine how to the process the signal.
-	SignalName *string `locationName:"signalName" type:"string" required:"true"`
+	SignalName *string `locationName:"signalName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataWorkflowExecutionSignaledEventAttributes `json:"-", xml:"-"`
 }
@@ -5793,21 +6247,21 @@ type WorkflowExecutionStartedEventAttributes struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// If this workflow execution was started due to a ContinueAsNewWorkflowExecution
 	// decision, then it contains the runId of the previous workflow execution that
 	// was closed and continued as this execution.
-	ContinuedExecutionRunID *string `locationName:"continuedExecutionRunId" type:"string"`
+	ContinuedExecutionRunID *string `locationName:"continuedExecutionRunId" type:"string" max:"64"`
 
 	// The maximum duration for this workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input provided to the workflow execution (if any).
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
 	// the StartChildWorkflowExecution Decision to start this workflow execution.
@@ -5822,19 +6276,19 @@ type WorkflowExecutionStartedEventAttributes struct {
 
 	// The list of tags associated with this workflow execution. An execution can
 	// have up to 5 tags.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The name of the task list for scheduling the decision tasks for this workflow
 	// execution.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
 
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// The maximum duration of decision tasks for this workflow type.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// The workflow type of this execution.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -5851,7 +6305,7 @@ type WorkflowExecutionTerminatedEventAttributes struct {
 	// If set, indicates that the workflow execution was automatically terminated,
 	// and specifies the cause. This happens if the parent workflow execution times
 	// out or is terminated and the child policy is set to terminate child executions.
-	Cause *string `locationName:"cause" type:"string"`
+	Cause *string `locationName:"cause" type:"string" enum:"CHILD_POLICY_APPLIED,EVENT_LIMIT_EXCEEDED,OPERATOR_INITIATED"`
 
 	// The policy used for the child workflow executions of this workflow execution.
 	//
@@ -5862,13 +6316,13 @@ type WorkflowExecutionTerminatedEventAttributes struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// The details provided for the termination (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The reason provided for the termination (if any).
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	metadataWorkflowExecutionTerminatedEventAttributes `json:"-", xml:"-"`
 }
@@ -5888,10 +6342,10 @@ type WorkflowExecutionTimedOutEventAttributes struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// The type of timeout that caused this event.
-	TimeoutType *string `locationName:"timeoutType" type:"string" required:"true"`
+	TimeoutType *string `locationName:"timeoutType" type:"string" enum:"START_TO_CLOSE" required:"true"`
 
 	metadataWorkflowExecutionTimedOutEventAttributes `json:"-", xml:"-"`
 }
@@ -5906,13 +6360,13 @@ type WorkflowType struct {
 	//
 	// The combination of workflow type name and version must be unique with in
 	// a domain.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// Required. The version of the workflow type.
 	//
 	// The combination of workflow type name and version must be unique with in
 	// a domain.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataWorkflowType `json:"-", xml:"-"`
 }
@@ -5936,7 +6390,7 @@ type WorkflowTypeConfiguration struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	DefaultChildPolicy *string `locationName:"defaultChildPolicy" type:"string"`
+	DefaultChildPolicy *string `locationName:"defaultChildPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// Optional. The default maximum duration, specified when registering the workflow
 	// type, for executions of this workflow type. This default can be overridden
@@ -5945,7 +6399,7 @@ type WorkflowTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultExecutionStartToCloseTimeout *string `locationName:"defaultExecutionStartToCloseTimeout" type:"string"`
+	DefaultExecutionStartToCloseTimeout *string `locationName:"defaultExecutionStartToCloseTimeout" type:"string" max:"8"`
 
 	// Optional. The default task list, specified when registering the workflow
 	// type, for decisions tasks scheduled for workflow executions of this type.
@@ -5964,7 +6418,7 @@ type WorkflowTypeConfiguration struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string"`
+	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string" max:"11"`
 
 	// Optional. The default maximum duration, specified when registering the workflow
 	// type, that a decision task for executions of this workflow type might take
@@ -5976,7 +6430,7 @@ type WorkflowTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string"`
+	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string" max:"8"`
 
 	metadataWorkflowTypeConfiguration `json:"-", xml:"-"`
 }
@@ -5989,10 +6443,10 @@ type metadataWorkflowTypeConfiguration struct {
 // if specified, defines a rule that must be satisfied by each returned result.
 type WorkflowTypeFilter struct {
 	// Required. Name of the workflow type.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// Version of the workflow type.
-	Version *string `locationName:"version" type:"string"`
+	Version *string `locationName:"version" type:"string" max:"64"`
 
 	metadataWorkflowTypeFilter `json:"-", xml:"-"`
 }
@@ -6011,10 +6465,10 @@ type WorkflowTypeInfo struct {
 	DeprecationDate *time.Time `locationName:"deprecationDate" type:"timestamp" timestampFormat:"unix"`
 
 	// The description of the type registered through RegisterWorkflowType.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The current status of the workflow type.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	Status *string `locationName:"status" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	// The workflow type this information is about.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -6024,4 +6478,502 @@ type WorkflowTypeInfo struct {
 
 type metadataWorkflowTypeInfo struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// Enum values for ActivityTaskTimeoutType.
+const (
+	ActivityTaskTimeoutTypeStartTOClose    = "START_TO_CLOSE"
+	ActivityTaskTimeoutTypeScheduleTOStart = "SCHEDULE_TO_START"
+	ActivityTaskTimeoutTypeScheduleTOClose = "SCHEDULE_TO_CLOSE"
+	ActivityTaskTimeoutTypeHeartbeat       = "HEARTBEAT"
+)
+
+// ActivityTaskTimeoutTypeValues returns the valid values of ActivityTaskTimeoutType.
+func ActivityTaskTimeoutTypeValues() []string {
+	return []string{
+		ActivityTaskTimeoutTypeStartTOClose,
+		ActivityTaskTimeoutTypeScheduleTOStart,
+		ActivityTaskTimeoutTypeScheduleTOClose,
+		ActivityTaskTimeoutTypeHeartbeat,
+	}
+}
+
+// Enum values for CancelTimerFailedCause.
+const (
+	CancelTimerFailedCauseTimerIDUnknown        = "TIMER_ID_UNKNOWN"
+	CancelTimerFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+// CancelTimerFailedCauseValues returns the valid values of CancelTimerFailedCause.
+func CancelTimerFailedCauseValues() []string {
+	return []string{
+		CancelTimerFailedCauseTimerIDUnknown,
+		CancelTimerFailedCauseOperationNotPermitted,
+	}
+}
+
+// Enum values for CancelWorkflowExecutionFailedCause.
+const (
+	CancelWorkflowExecutionFailedCauseUnhandledDecision     = "UNHANDLED_DECISION"
+	CancelWorkflowExecutionFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+// CancelWorkflowExecutionFailedCauseValues returns the valid values of CancelWorkflowExecutionFailedCause.
+func CancelWorkflowExecutionFailedCauseValues() []string {
+	return []string{
+		CancelWorkflowExecutionFailedCauseUnhandledDecision,
+		CancelWorkflowExecutionFailedCauseOperationNotPermitted,
+	}
+}
+
+// Enum values for ChildPolicy.
+const (
+	ChildPolicyTerminate     = "TERMINATE"
+	ChildPolicyRequestCancel = "REQUEST_CANCEL"
+	ChildPolicyAbandon       = "ABANDON"
+)
+
+// ChildPolicyValues returns the valid values of ChildPolicy.
+func ChildPolicyValues() []string {
+	return []string{
+		ChildPolicyTerminate,
+		ChildPolicyRequestCancel,
+		ChildPolicyAbandon,
+	}
+}
+
+// Enum values for CloseStatus.
+const (
+	CloseStatusCompleted      = "COMPLETED"
+	CloseStatusFailed         = "FAILED"
+	CloseStatusCanceled       = "CANCELED"
+	CloseStatusTerminated     = "TERMINATED"
+	CloseStatusContinuedASNew = "CONTINUED_AS_NEW"
+	CloseStatusTimedOut       = "TIMED_OUT"
+)
+
+// CloseStatusValues returns the valid values of CloseStatus.
+func CloseStatusValues() []string {
+	return []string{
+		CloseStatusCompleted,
+		CloseStatusFailed,
+		CloseStatusCanceled,
+		CloseStatusTerminated,
+		CloseStatusContinuedASNew,
+		CloseStatusTimedOut,
+	}
+}
+
+// Enum values for CompleteWorkflowExecutionFailedCause.
+const (
+	CompleteWorkflowExecutionFailedCauseUnhandledDecision     = "UNHANDLED_DECISION"
+	CompleteWorkflowExecutionFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+// CompleteWorkflowExecutionFailedCauseValues returns the valid values of CompleteWorkflowExecutionFailedCause.
+func CompleteWorkflowExecutionFailedCauseValues() []string {
+	return []string{
+		CompleteWorkflowExecutionFailedCauseUnhandledDecision,
+		CompleteWorkflowExecutionFailedCauseOperationNotPermitted,
+	}
+}
+
+// Enum values for ContinueAsNewWorkflowExecutionFailedCause.
+const (
+	ContinueAsNewWorkflowExecutionFailedCauseUnhandledDecision                            = "UNHANDLED_DECISION"
+	ContinueAsNewWorkflowExecutionFailedCauseWorkflowTypeDeprecated                       = "WORKFLOW_TYPE_DEPRECATED"
+	ContinueAsNewWorkflowExecutionFailedCauseWorkflowTypeDoesNotExist                     = "WORKFLOW_TYPE_DOES_NOT_EXIST"
+	ContinueAsNewWorkflowExecutionFailedCauseDefaultExecutionStartTOCloseTimeoutUndefined = "DEFAULT_EXECUTION_START_TO_CLOSE_TIMEOUT_UNDEFINED"
+	ContinueAsNewWorkflowExecutionFailedCauseDefaultTaskStartTOCloseTimeoutUndefined      = "DEFAULT_TASK_START_TO_CLOSE_TIMEOUT_UNDEFINED"
+	ContinueAsNewWorkflowExecutionFailedCauseDefaultTaskListUndefined                     = "DEFAULT_TASK_LIST_UNDEFINED"
+	ContinueAsNewWorkflowExecutionFailedCauseDefaultChildPolicyUndefined                  = "DEFAULT_CHILD_POLICY_UNDEFINED"
+	ContinueAsNewWorkflowExecutionFailedCauseContinueASNewWorkflowExecutionRateExceeded   = "CONTINUE_AS_NEW_WORKFLOW_EXECUTION_RATE_EXCEEDED"
+	ContinueAsNewWorkflowExecutionFailedCauseOperationNotPermitted                        = "OPERATION_NOT_PERMITTED"
+)
+
+// ContinueAsNewWorkflowExecutionFailedCauseValues returns the valid values of ContinueAsNewWorkflowExecutionFailedCause.
+func ContinueAsNewWorkflowExecutionFailedCauseValues() []string {
+	return []string{
+		ContinueAsNewWorkflowExecutionFailedCauseUnhandledDecision,
+		ContinueAsNewWorkflowExecutionFailedCauseWorkflowTypeDeprecated,
+		ContinueAsNewWorkflowExecutionFailedCauseWorkflowTypeDoesNotExist,
+		ContinueAsNewWorkflowExecutionFailedCauseDefaultExecutionStartTOCloseTimeoutUndefined,
+		ContinueAsNewWorkflowExecutionFailedCauseDefaultTaskStartTOCloseTimeoutUndefined,
+		ContinueAsNewWorkflowExecutionFailedCauseDefaultTaskListUndefined,
+		ContinueAsNewWorkflowExecutionFailedCauseDefaultChildPolicyUndefined,
+		ContinueAsNewWorkflowExecutionFailedCauseContinueASNewWorkflowExecutionRateExceeded,
+		ContinueAsNewWorkflowExecutionFailedCauseOperationNotPermitted,
+	}
+}
+
+// Enum values for DecisionTaskTimeoutType.
+const (
+	DecisionTaskTimeoutTypeStartTOClose = "START_TO_CLOSE"
+)
+
+// DecisionTaskTimeoutTypeValues returns the valid values of DecisionTaskTimeoutType.
+func DecisionTaskTimeoutTypeValues() []string {
+	return []string{
+		DecisionTaskTimeoutTypeStartTOClose,
+	}
+}
+
+// Enum values for DecisionType.
+const (
+	DecisionTypeScheduleActivityTask                   = "ScheduleActivityTask"
+	DecisionTypeRequestCancelActivityTask              = "RequestCancelActivityTask"
+	DecisionTypeCompleteWorkflowExecution              = "CompleteWorkflowExecution"
+	DecisionTypeFailWorkflowExecution                  = "FailWorkflowExecution"
+	DecisionTypeCancelWorkflowExecution                = "CancelWorkflowExecution"
+	DecisionTypeContinueAsNewWorkflowExecution         = "ContinueAsNewWorkflowExecution"
+	DecisionTypeRecordMarker                           = "RecordMarker"
+	DecisionTypeStartTimer                             = "StartTimer"
+	DecisionTypeCancelTimer                            = "CancelTimer"
+	DecisionTypeSignalExternalWorkflowExecution        = "SignalExternalWorkflowExecution"
+	DecisionTypeRequestCancelExternalWorkflowExecution = "RequestCancelExternalWorkflowExecution"
+	DecisionTypeStartChildWorkflowExecution            = "StartChildWorkflowExecution"
+)
+
+// DecisionTypeValues returns the valid values of DecisionType.
+func DecisionTypeValues() []string {
+	return []string{
+		DecisionTypeScheduleActivityTask,
+		DecisionTypeRequestCancelActivityTask,
+		DecisionTypeCompleteWorkflowExecution,
+		DecisionTypeFailWorkflowExecution,
+		DecisionTypeCancelWorkflowExecution,
+		DecisionTypeContinueAsNewWorkflowExecution,
+		DecisionTypeRecordMarker,
+		DecisionTypeStartTimer,
+		DecisionTypeCancelTimer,
+		DecisionTypeSignalExternalWorkflowExecution,
+		DecisionTypeRequestCancelExternalWorkflowExecution,
+		DecisionTypeStartChildWorkflowExecution,
+	}
+}
+
+// Enum values for EventType.
+const (
+	EventTypeWorkflowExecutionStarted                        = "WorkflowExecutionStarted"
+	EventTypeWorkflowExecutionCancelRequested                = "WorkflowExecutionCancelRequested"
+	EventTypeWorkflowExecutionCompleted                      = "WorkflowExecutionCompleted"
+	EventTypeCompleteWorkflowExecutionFailed                 = "CompleteWorkflowExecutionFailed"
+	EventTypeWorkflowExecutionFailed                         = "WorkflowExecutionFailed"
+	EventTypeFailWorkflowExecutionFailed                     = "FailWorkflowExecutionFailed"
+	EventTypeWorkflowExecutionTimedOut                       = "WorkflowExecutionTimedOut"
+	EventTypeWorkflowExecutionCanceled                       = "WorkflowExecutionCanceled"
+	EventTypeCancelWorkflowExecutionFailed                   = "CancelWorkflowExecutionFailed"
+	EventTypeWorkflowExecutionContinuedAsNew                 = "WorkflowExecutionContinuedAsNew"
+	EventTypeContinueAsNewWorkflowExecutionFailed            = "ContinueAsNewWorkflowExecutionFailed"
+	EventTypeWorkflowExecutionTerminated                     = "WorkflowExecutionTerminated"
+	EventTypeDecisionTaskScheduled                           = "DecisionTaskScheduled"
+	EventTypeDecisionTaskStarted                             = "DecisionTaskStarted"
+	EventTypeDecisionTaskCompleted                           = "DecisionTaskCompleted"
+	EventTypeDecisionTaskTimedOut                            = "DecisionTaskTimedOut"
+	EventTypeActivityTaskScheduled                           = "ActivityTaskScheduled"
+	EventTypeScheduleActivityTaskFailed                      = "ScheduleActivityTaskFailed"
+	EventTypeActivityTaskStarted                             = "ActivityTaskStarted"
+	EventTypeActivityTaskCompleted                           = "ActivityTaskCompleted"
+	EventTypeActivityTaskFailed                              = "ActivityTaskFailed"
+	EventTypeActivityTaskTimedOut                            = "ActivityTaskTimedOut"
+	EventTypeActivityTaskCanceled                            = "ActivityTaskCanceled"
+	EventTypeActivityTaskCancelRequested                     = "ActivityTaskCancelRequested"
+	EventTypeRequestCancelActivityTaskFailed                 = "RequestCancelActivityTaskFailed"
+	EventTypeWorkflowExecutionSignaled                       = "WorkflowExecutionSignaled"
+	EventTypeMarkerRecorded                                  = "MarkerRecorded"
+	EventTypeRecordMarkerFailed                              = "RecordMarkerFailed"
+	EventTypeTimerStarted                                    = "TimerStarted"
+	EventTypeStartTimerFailed                                = "StartTimerFailed"
+	EventTypeTimerFired                                      = "TimerFired"
+	EventTypeTimerCanceled                                   = "TimerCanceled"
+	EventTypeCancelTimerFailed                               = "CancelTimerFailed"
+	EventTypeStartChildWorkflowExecutionInitiated            = "StartChildWorkflowExecutionInitiated"
+	EventTypeStartChildWorkflowExecutionFailed               = "StartChildWorkflowExecutionFailed"
+	EventTypeChildWorkflowExecutionStarted                   = "ChildWorkflowExecutionStarted"
+	EventTypeChildWorkflowExecutionCompleted                 = "ChildWorkflowExecutionCompleted"
+	EventTypeChildWorkflowExecutionFailed                    = "ChildWorkflowExecutionFailed"
+	EventTypeChildWorkflowExecutionTimedOut                  = "ChildWorkflowExecutionTimedOut"
+	EventTypeChildWorkflowExecutionCanceled                  = "ChildWorkflowExecutionCanceled"
+	EventTypeChildWorkflowExecutionTerminated                = "ChildWorkflowExecutionTerminated"
+	EventTypeSignalExternalWorkflowExecutionInitiated        = "SignalExternalWorkflowExecutionInitiated"
+	EventTypeSignalExternalWorkflowExecutionFailed           = "SignalExternalWorkflowExecutionFailed"
+	EventTypeExternalWorkflowExecutionSignaled               = "ExternalWorkflowExecutionSignaled"
+	EventTypeRequestCancelExternalWorkflowExecutionInitiated = "RequestCancelExternalWorkflowExecutionInitiated"
+	EventTypeRequestCancelExternalWorkflowExecutionFailed    = "RequestCancelExternalWorkflowExecutionFailed"
+	EventTypeExternalWorkflowExecutionCancelRequested        = "ExternalWorkflowExecutionCancelRequested"
+)
+
+// EventTypeValues returns the valid values of EventType.
+func EventTypeValues() []string {
+	return []string{
+		EventTypeWorkflowExecutionStarted,
+		EventTypeWorkflowExecutionCancelRequested,
+		EventTypeWorkflowExecutionCompleted,
+		EventTypeCompleteWorkflowExecutionFailed,
+		EventTypeWorkflowExecutionFailed,
+		EventTypeFailWorkflowExecutionFailed,
+		EventTypeWorkflowExecutionTimedOut,
+		EventTypeWorkflowExecutionCanceled,
+		EventTypeCancelWorkflowExecutionFailed,
+		EventTypeWorkflowExecutionContinuedAsNew,
+		EventTypeContinueAsNewWorkflowExecutionFailed,
+		EventTypeWorkflowExecutionTerminated,
+		EventTypeDecisionTaskScheduled,
+		EventTypeDecisionTaskStarted,
+		EventTypeDecisionTaskCompleted,
+		EventTypeDecisionTaskTimedOut,
+		EventTypeActivityTaskScheduled,
+		EventTypeScheduleActivityTaskFailed,
+		EventTypeActivityTaskStarted,
+		EventTypeActivityTaskCompleted,
+		EventTypeActivityTaskFailed,
+		EventTypeActivityTaskTimedOut,
+		EventTypeActivityTaskCanceled,
+		EventTypeActivityTaskCancelRequested,
+		EventTypeRequestCancelActivityTaskFailed,
+		EventTypeWorkflowExecutionSignaled,
+		EventTypeMarkerRecorded,
+		EventTypeRecordMarkerFailed,
+		EventTypeTimerStarted,
+		EventTypeStartTimerFailed,
+		EventTypeTimerFired,
+		EventTypeTimerCanceled,
+		EventTypeCancelTimerFailed,
+		EventTypeStartChildWorkflowExecutionInitiated,
+		EventTypeStartChildWorkflowExecutionFailed,
+		EventTypeChildWorkflowExecutionStarted,
+		EventTypeChildWorkflowExecutionCompleted,
+		EventTypeChildWorkflowExecutionFailed,
+		EventTypeChildWorkflowExecutionTimedOut,
+		EventTypeChildWorkflowExecutionCanceled,
+		EventTypeChildWorkflowExecutionTerminated,
+		EventTypeSignalExternalWorkflowExecutionInitiated,
+		EventTypeSignalExternalWorkflowExecutionFailed,
+		EventTypeExternalWorkflowExecutionSignaled,
+		EventTypeRequestCancelExternalWorkflowExecutionInitiated,
+		EventTypeRequestCancelExternalWorkflowExecutionFailed,
+		EventTypeExternalWorkflowExecutionCancelRequested,
+	}
+}
+
+// Enum values for ExecutionStatus.
+const (
+	ExecutionStatusOpen   = "OPEN"
+	ExecutionStatusClosed = "CLOSED"
+)
+
+// ExecutionStatusValues returns the valid values of ExecutionStatus.
+func ExecutionStatusValues() []string {
+	return []string{
+		ExecutionStatusOpen,
+		ExecutionStatusClosed,
+	}
+}
+
+// Enum values for FailWorkflowExecutionFailedCause.
+const (
+	FailWorkflowExecutionFailedCauseUnhandledDecision     = "UNHANDLED_DECISION"
+	FailWorkflowExecutionFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+// FailWorkflowExecutionFailedCauseValues returns the valid values of FailWorkflowExecutionFailedCause.
+func FailWorkflowExecutionFailedCauseValues() []string {
+	return []string{
+		FailWorkflowExecutionFailedCauseUnhandledDecision,
+		FailWorkflowExecutionFailedCauseOperationNotPermitted,
+	}
+}
+
+// Enum values for RecordMarkerFailedCause.
+const (
+	RecordMarkerFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+// RecordMarkerFailedCauseValues returns the valid values of RecordMarkerFailedCause.
+func RecordMarkerFailedCauseValues() []string {
+	return []string{
+		RecordMarkerFailedCauseOperationNotPermitted,
+	}
+}
+
+// Enum values for RegistrationStatus.
+const (
+	RegistrationStatusRegistered = "REGISTERED"
+	RegistrationStatusDeprecated = "DEPRECATED"
+)
+
+// RegistrationStatusValues returns the valid values of RegistrationStatus.
+func RegistrationStatusValues() []string {
+	return []string{
+		RegistrationStatusRegistered,
+		RegistrationStatusDeprecated,
+	}
+}
+
+// Enum values for RequestCancelActivityTaskFailedCause.
+const (
+	RequestCancelActivityTaskFailedCauseActivityIDUnknown     = "ACTIVITY_ID_UNKNOWN"
+	RequestCancelActivityTaskFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+// RequestCancelActivityTaskFailedCauseValues returns the valid values of RequestCancelActivityTaskFailedCause.
+func RequestCancelActivityTaskFailedCauseValues() []string {
+	return []string{
+		RequestCancelActivityTaskFailedCauseActivityIDUnknown,
+		RequestCancelActivityTaskFailedCauseOperationNotPermitted,
+	}
+}
+
+// Enum values for RequestCancelExternalWorkflowExecutionFailedCause.
+const (
+	RequestCancelExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution                   = "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION"
+	RequestCancelExternalWorkflowExecutionFailedCauseRequestCancelExternalWorkflowExecutionRateExceeded = "REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_RATE_EXCEEDED"
+	RequestCancelExternalWorkflowExecutionFailedCauseOperationNotPermitted                              = "OPERATION_NOT_PERMITTED"
+)
+
+// RequestCancelExternalWorkflowExecutionFailedCauseValues returns the valid values of RequestCancelExternalWorkflowExecutionFailedCause.
+func RequestCancelExternalWorkflowExecutionFailedCauseValues() []string {
+	return []string{
+		RequestCancelExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution,
+		RequestCancelExternalWorkflowExecutionFailedCauseRequestCancelExternalWorkflowExecutionRateExceeded,
+		RequestCancelExternalWorkflowExecutionFailedCauseOperationNotPermitted,
+	}
+}
+
+// Enum values for ScheduleActivityTaskFailedCause.
+const (
+	ScheduleActivityTaskFailedCauseActivityTypeDeprecated                 = "ACTIVITY_TYPE_DEPRECATED"
+	ScheduleActivityTaskFailedCauseActivityTypeDoesNotExist               = "ACTIVITY_TYPE_DOES_NOT_EXIST"
+	ScheduleActivityTaskFailedCauseActivityIDAlreadyINUse                 = "ACTIVITY_ID_ALREADY_IN_USE"
+	ScheduleActivityTaskFailedCauseOpenActivitiesLimitExceeded            = "OPEN_ACTIVITIES_LIMIT_EXCEEDED"
+	ScheduleActivityTaskFailedCauseActivityCreationRateExceeded           = "ACTIVITY_CREATION_RATE_EXCEEDED"
+	ScheduleActivityTaskFailedCauseDefaultScheduleTOCloseTimeoutUndefined = "DEFAULT_SCHEDULE_TO_CLOSE_TIMEOUT_UNDEFINED"
+	ScheduleActivityTaskFailedCauseDefaultTaskListUndefined               = "DEFAULT_TASK_LIST_UNDEFINED"
+	ScheduleActivityTaskFailedCauseDefaultScheduleTOStartTimeoutUndefined = "DEFAULT_SCHEDULE_TO_START_TIMEOUT_UNDEFINED"
+	ScheduleActivityTaskFailedCauseDefaultStartTOCloseTimeoutUndefined    = "DEFAULT_START_TO_CLOSE_TIMEOUT_UNDEFINED"
+	ScheduleActivityTaskFailedCauseDefaultHeartbeatTimeoutUndefined       = "DEFAULT_HEARTBEAT_TIMEOUT_UNDEFINED"
+	ScheduleActivityTaskFailedCauseOperationNotPermitted                  = "OPERATION_NOT_PERMITTED"
+)
+
+// ScheduleActivityTaskFailedCauseValues returns the valid values of ScheduleActivityTaskFailedCause.
+func ScheduleActivityTaskFailedCauseValues() []string {
+	return []string{
+		ScheduleActivityTaskFailedCauseActivityTypeDeprecated,
+		ScheduleActivityTaskFailedCauseActivityTypeDoesNotExist,
+		ScheduleActivityTaskFailedCauseActivityIDAlreadyINUse,
+		ScheduleActivityTaskFailedCauseOpenActivitiesLimitExceeded,
+		ScheduleActivityTaskFailedCauseActivityCreationRateExceeded,
+		ScheduleActivityTaskFailedCauseDefaultScheduleTOCloseTimeoutUndefined,
+		ScheduleActivityTaskFailedCauseDefaultTaskListUndefined,
+		ScheduleActivityTaskFailedCauseDefaultScheduleTOStartTimeoutUndefined,
+		ScheduleActivityTaskFailedCauseDefaultStartTOCloseTimeoutUndefined,
+		ScheduleActivityTaskFailedCauseDefaultHeartbeatTimeoutUndefined,
+		ScheduleActivityTaskFailedCauseOperationNotPermitted,
+	}
+}
+
+// Enum values for SignalExternalWorkflowExecutionFailedCause.
+const (
+	SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution            = "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION"
+	SignalExternalWorkflowExecutionFailedCauseSignalExternalWorkflowExecutionRateExceeded = "SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_RATE_EXCEEDED"
+	SignalExternalWorkflowExecutionFailedCauseOperationNotPermitted                       = "OPERATION_NOT_PERMITTED"
+)
+
+// SignalExternalWorkflowExecutionFailedCauseValues returns the valid values of SignalExternalWorkflowExecutionFailedCause.
+func SignalExternalWorkflowExecutionFailedCauseValues() []string {
+	return []string{
+		SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution,
+		SignalExternalWorkflowExecutionFailedCauseSignalExternalWorkflowExecutionRateExceeded,
+		SignalExternalWorkflowExecutionFailedCauseOperationNotPermitted,
+	}
+}
+
+// Enum values for StartChildWorkflowExecutionFailedCause.
+const (
+	StartChildWorkflowExecutionFailedCauseWorkflowTypeDoesNotExist                     = "WORKFLOW_TYPE_DOES_NOT_EXIST"
+	StartChildWorkflowExecutionFailedCauseWorkflowTypeDeprecated                       = "WORKFLOW_TYPE_DEPRECATED"
+	StartChildWorkflowExecutionFailedCauseOpenChildrenLimitExceeded                    = "OPEN_CHILDREN_LIMIT_EXCEEDED"
+	StartChildWorkflowExecutionFailedCauseOpenWorkflowsLimitExceeded                   = "OPEN_WORKFLOWS_LIMIT_EXCEEDED"
+	StartChildWorkflowExecutionFailedCauseChildCreationRateExceeded                    = "CHILD_CREATION_RATE_EXCEEDED"
+	StartChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning                       = "WORKFLOW_ALREADY_RUNNING"
+	StartChildWorkflowExecutionFailedCauseDefaultExecutionStartTOCloseTimeoutUndefined = "DEFAULT_EXECUTION_START_TO_CLOSE_TIMEOUT_UNDEFINED"
+	StartChildWorkflowExecutionFailedCauseDefaultTaskListUndefined                     = "DEFAULT_TASK_LIST_UNDEFINED"
+	StartChildWorkflowExecutionFailedCauseDefaultTaskStartTOCloseTimeoutUndefined      = "DEFAULT_TASK_START_TO_CLOSE_TIMEOUT_UNDEFINED"
+	StartChildWorkflowExecutionFailedCauseDefaultChildPolicyUndefined                  = "DEFAULT_CHILD_POLICY_UNDEFINED"
+	StartChildWorkflowExecutionFailedCauseOperationNotPermitted                        = "OPERATION_NOT_PERMITTED"
+)
+
+// StartChildWorkflowExecutionFailedCauseValues returns the valid values of StartChildWorkflowExecutionFailedCause.
+func StartChildWorkflowExecutionFailedCauseValues() []string {
+	return []string{
+		StartChildWorkflowExecutionFailedCauseWorkflowTypeDoesNotExist,
+		StartChildWorkflowExecutionFailedCauseWorkflowTypeDeprecated,
+		StartChildWorkflowExecutionFailedCauseOpenChildrenLimitExceeded,
+		StartChildWorkflowExecutionFailedCauseOpenWorkflowsLimitExceeded,
+		StartChildWorkflowExecutionFailedCauseChildCreationRateExceeded,
+		StartChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning,
+		StartChildWorkflowExecutionFailedCauseDefaultExecutionStartTOCloseTimeoutUndefined,
+		StartChildWorkflowExecutionFailedCauseDefaultTaskListUndefined,
+		StartChildWorkflowExecutionFailedCauseDefaultTaskStartTOCloseTimeoutUndefined,
+		StartChildWorkflowExecutionFailedCauseDefaultChildPolicyUndefined,
+		StartChildWorkflowExecutionFailedCauseOperationNotPermitted,
+	}
+}
+
+// Enum values for StartTimerFailedCause.
+const (
+	StartTimerFailedCauseTimerIDAlreadyINUse       = "TIMER_ID_ALREADY_IN_USE"
+	StartTimerFailedCauseOpenTimersLimitExceeded   = "OPEN_TIMERS_LIMIT_EXCEEDED"
+	StartTimerFailedCauseTimerCreationRateExceeded = "TIMER_CREATION_RATE_EXCEEDED"
+	StartTimerFailedCauseOperationNotPermitted     = "OPERATION_NOT_PERMITTED"
+)
+
+// StartTimerFailedCauseValues returns the valid values of StartTimerFailedCause.
+func StartTimerFailedCauseValues() []string {
+	return []string{
+		StartTimerFailedCauseTimerIDAlreadyINUse,
+		StartTimerFailedCauseOpenTimersLimitExceeded,
+		StartTimerFailedCauseTimerCreationRateExceeded,
+		StartTimerFailedCauseOperationNotPermitted,
+	}
+}
+
+// Enum values for WorkflowExecutionCancelRequestedCause.
+const (
+	WorkflowExecutionCancelRequestedCauseChildPolicyApplied = "CHILD_POLICY_APPLIED"
+)
+
+// WorkflowExecutionCancelRequestedCauseValues returns the valid values of WorkflowExecutionCancelRequestedCause.
+func WorkflowExecutionCancelRequestedCauseValues() []string {
+	return []string{
+		WorkflowExecutionCancelRequestedCauseChildPolicyApplied,
+	}
+}
+
+// Enum values for WorkflowExecutionTerminatedCause.
+const (
+	WorkflowExecutionTerminatedCauseChildPolicyApplied = "CHILD_POLICY_APPLIED"
+	WorkflowExecutionTerminatedCauseEventLimitExceeded = "EVENT_LIMIT_EXCEEDED"
+	WorkflowExecutionTerminatedCauseOperatorInitiated  = "OPERATOR_INITIATED"
+)
+
+// WorkflowExecutionTerminatedCauseValues returns the valid values of WorkflowExecutionTerminatedCause.
+func WorkflowExecutionTerminatedCauseValues() []string {
+	return []string{
+		WorkflowExecutionTerminatedCauseChildPolicyApplied,
+		WorkflowExecutionTerminatedCauseEventLimitExceeded,
+		WorkflowExecutionTerminatedCauseOperatorInitiated,
+	}
+}
+
+// Enum values for WorkflowExecutionTimeoutType.
+const (
+	WorkflowExecutionTimeoutTypeStartTOClose = "START_TO_CLOSE"
+)
+
+// WorkflowExecutionTimeoutTypeValues returns the valid values of WorkflowExecutionTimeoutType.
+func WorkflowExecutionTimeoutTypeValues() []string {
+	return []string{
+		WorkflowExecutionTimeoutTypeStartTOClose,
+	}
 }
\ No newline at end of file
@@ -29,6 +29,7 @@ func New(config *aws.Config) *SWF {
 		APIVersion:   "2012-01-25",
 		JSONVersion:  "1.0",
 		TargetPrefix: "SimpleWorkflowService",
+		ErrorTypes:   errorTypes,
 	}
 	service.Initialize()
 
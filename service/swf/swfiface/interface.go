@@ -1,69 +1,140 @@
 package swfiface
 
 import (
+	"context"
+
 	"github.com/datacratic/aws-sdk-go/service/swf"
 )
 
 type SWFAPI interface {
 	CountClosedWorkflowExecutions(*swf.CountClosedWorkflowExecutionsInput) (*swf.WorkflowExecutionCount, error)
 
+	CountClosedWorkflowExecutionsWithContext(context.Context, *swf.CountClosedWorkflowExecutionsInput) (*swf.WorkflowExecutionCount, error)
+
 	CountOpenWorkflowExecutions(*swf.CountOpenWorkflowExecutionsInput) (*swf.WorkflowExecutionCount, error)
 
+	CountOpenWorkflowExecutionsWithContext(context.Context, *swf.CountOpenWorkflowExecutionsInput) (*swf.WorkflowExecutionCount, error)
+
 	CountPendingActivityTasks(*swf.CountPendingActivityTasksInput) (*swf.PendingTaskCount, error)
 
+	CountPendingActivityTasksWithContext(context.Context, *swf.CountPendingActivityTasksInput) (*swf.PendingTaskCount, error)
+
 	CountPendingDecisionTasks(*swf.CountPendingDecisionTasksInput) (*swf.PendingTaskCount, error)
 
+	CountPendingDecisionTasksWithContext(context.Context, *swf.CountPendingDecisionTasksInput) (*swf.PendingTaskCount, error)
+
 	DeprecateActivityType(*swf.DeprecateActivityTypeInput) (*swf.DeprecateActivityTypeOutput, error)
 
+	DeprecateActivityTypeWithContext(context.Context, *swf.DeprecateActivityTypeInput) (*swf.DeprecateActivityTypeOutput, error)
+
 	DeprecateDomain(*swf.DeprecateDomainInput) (*swf.DeprecateDomainOutput, error)
 
+	DeprecateDomainWithContext(context.Context, *swf.DeprecateDomainInput) (*swf.DeprecateDomainOutput, error)
+
 	DeprecateWorkflowType(*swf.DeprecateWorkflowTypeInput) (*swf.DeprecateWorkflowTypeOutput, error)
 
+	DeprecateWorkflowTypeWithContext(context.Context, *swf.DeprecateWorkflowTypeInput) (*swf.DeprecateWorkflowTypeOutput, error)
+
 	DescribeActivityType(*swf.DescribeActivityTypeInput) (*swf.DescribeActivityTypeOutput, error)
 
+	DescribeActivityTypeWithContext(context.Context, *swf.DescribeActivityTypeInput) (*swf.DescribeActivityTypeOutput, error)
+
 	DescribeDomain(*swf.DescribeDomainInput) (*swf.DescribeDomainOutput, error)
 
+	DescribeDomainWithContext(context.Context, *swf.DescribeDomainInput) (*swf.DescribeDomainOutput, error)
+
 	DescribeWorkflowExecution(*swf.DescribeWorkflowExecutionInput) (*swf.DescribeWorkflowExecutionOutput, error)
 
+	DescribeWorkflowExecutionWithContext(context.Context, *swf.DescribeWorkflowExecutionInput) (*swf.DescribeWorkflowExecutionOutput, error)
+
 	DescribeWorkflowType(*swf.DescribeWorkflowTypeInput) (*swf.DescribeWorkflowTypeOutput, error)
 
+	DescribeWorkflowTypeWithContext(context.Context, *swf.DescribeWorkflowTypeInput) (*swf.DescribeWorkflowTypeOutput, error)
+
 	GetWorkflowExecutionHistory(*swf.GetWorkflowExecutionHistoryInput) (*swf.GetWorkflowExecutionHistoryOutput, error)
 
+	GetWorkflowExecutionHistoryWithContext(context.Context, *swf.GetWorkflowExecutionHistoryInput) (*swf.GetWorkflowExecutionHistoryOutput, error)
+	GetWorkflowExecutionHistoryPages(*swf.GetWorkflowExecutionHistoryInput, func(*swf.GetWorkflowExecutionHistoryOutput, bool) bool) error
+
 	ListActivityTypes(*swf.ListActivityTypesInput) (*swf.ListActivityTypesOutput, error)
 
+	ListActivityTypesWithContext(context.Context, *swf.ListActivityTypesInput) (*swf.ListActivityTypesOutput, error)
+	ListActivityTypesPages(*swf.ListActivityTypesInput, func(*swf.ListActivityTypesOutput, bool) bool) error
+
 	ListClosedWorkflowExecutions(*swf.ListClosedWorkflowExecutionsInput) (*swf.WorkflowExecutionInfos, error)
 
+	ListClosedWorkflowExecutionsWithContext(context.Context, *swf.ListClosedWorkflowExecutionsInput) (*swf.WorkflowExecutionInfos, error)
+	ListClosedWorkflowExecutionsPages(*swf.ListClosedWorkflowExecutionsInput, func(*swf.WorkflowExecutionInfos, bool) bool) error
+
 	ListDomains(*swf.ListDomainsInput) (*swf.ListDomainsOutput, error)
 
+	ListDomainsWithContext(context.Context, *swf.ListDomainsInput) (*swf.ListDomainsOutput, error)
+	ListDomainsPages(*swf.ListDomainsInput, func(*swf.ListDomainsOutput, bool) bool) error
+
 	ListOpenWorkflowExecutions(*swf.ListOpenWorkflowExecutionsInput) (*swf.WorkflowExecutionInfos, error)
 
+	ListOpenWorkflowExecutionsWithContext(context.Context, *swf.ListOpenWorkflowExecutionsInput) (*swf.WorkflowExecutionInfos, error)
+	ListOpenWorkflowExecutionsPages(*swf.ListOpenWorkflowExecutionsInput, func(*swf.WorkflowExecutionInfos, bool) bool) error
+
 	ListWorkflowTypes(*swf.ListWorkflowTypesInput) (*swf.ListWorkflowTypesOutput, error)
 
+	ListWorkflowTypesWithContext(context.Context, *swf.ListWorkflowTypesInput) (*swf.ListWorkflowTypesOutput, error)
+	ListWorkflowTypesPages(*swf.ListWorkflowTypesInput, func(*swf.ListWorkflowTypesOutput, bool) bool) error
+
 	PollForActivityTask(*swf.PollForActivityTaskInput) (*swf.PollForActivityTaskOutput, error)
 
+	PollForActivityTaskWithContext(context.Context, *swf.PollForActivityTaskInput) (*swf.PollForActivityTaskOutput, error)
+
 	PollForDecisionTask(*swf.PollForDecisionTaskInput) (*swf.PollForDecisionTaskOutput, error)
 
+	PollForDecisionTaskWithContext(context.Context, *swf.PollForDecisionTaskInput) (*swf.PollForDecisionTaskOutput, error)
+	PollForDecisionTaskPages(*swf.PollForDecisionTaskInput, func(*swf.PollForDecisionTaskOutput, bool) bool) error
+
 	RecordActivityTaskHeartbeat(*swf.RecordActivityTaskHeartbeatInput) (*swf.RecordActivityTaskHeartbeatOutput, error)
 
+	RecordActivityTaskHeartbeatWithContext(context.Context, *swf.RecordActivityTaskHeartbeatInput) (*swf.RecordActivityTaskHeartbeatOutput, error)
+
 	RegisterActivityType(*swf.RegisterActivityTypeInput) (*swf.RegisterActivityTypeOutput, error)
 
+	RegisterActivityTypeWithContext(context.Context, *swf.RegisterActivityTypeInput) (*swf.RegisterActivityTypeOutput, error)
+
 	RegisterDomain(*swf.RegisterDomainInput) (*swf.RegisterDomainOutput, error)
 
+	RegisterDomainWithContext(context.Context, *swf.RegisterDomainInput) (*swf.RegisterDomainOutput, error)
+
 	RegisterWorkflowType(*swf.RegisterWorkflowTypeInput) (*swf.RegisterWorkflowTypeOutput, error)
 
+	RegisterWorkflowTypeWithContext(context.Context, *swf.RegisterWorkflowTypeInput) (*swf.RegisterWorkflowTypeOutput, error)
+
 	RequestCancelWorkflowExecution(*swf.RequestCancelWorkflowExecutionInput) (*swf.RequestCancelWorkflowExecutionOutput, error)
 
+	RequestCancelWorkflowExecutionWithContext(context.Context, *swf.RequestCancelWorkflowExecutionInput) (*swf.RequestCancelWorkflowExecutionOutput, error)
+
 	RespondActivityTaskCanceled(*swf.RespondActivityTaskCanceledInput) (*swf.RespondActivityTaskCanceledOutput, error)
 
+	RespondActivityTaskCanceledWithContext(context.Context, *swf.RespondActivityTaskCanceledInput) (*swf.RespondActivityTaskCanceledOutput, error)
+
 	RespondActivityTaskCompleted(*swf.RespondActivityTaskCompletedInput) (*swf.RespondActivityTaskCompletedOutput, error)
 
+	RespondActivityTaskCompletedWithContext(context.Context, *swf.RespondActivityTaskCompletedInput) (*swf.RespondActivityTaskCompletedOutput, error)
+
 	RespondActivityTaskFailed(*swf.RespondActivityTaskFailedInput) (*swf.RespondActivityTaskFailedOutput, error)
 
+	RespondActivityTaskFailedWithContext(context.Context, *swf.RespondActivityTaskFailedInput) (*swf.RespondActivityTaskFailedOutput, error)
+
 	RespondDecisionTaskCompleted(*swf.RespondDecisionTaskCompletedInput) (*swf.RespondDecisionTaskCompletedOutput, error)
 
+	RespondDecisionTaskCompletedWithContext(context.Context, *swf.RespondDecisionTaskCompletedInput) (*swf.RespondDecisionTaskCompletedOutput, error)
+
 	SignalWorkflowExecution(*swf.SignalWorkflowExecutionInput) (*swf.SignalWorkflowExecutionOutput, error)
 
+	SignalWorkflowExecutionWithContext(context.Context, *swf.SignalWorkflowExecutionInput) (*swf.SignalWorkflowExecutionOutput, error)
+
 	StartWorkflowExecution(*swf.StartWorkflowExecutionInput) (*swf.StartWorkflowExecutionOutput, error)
 
+	StartWorkflowExecutionWithContext(context.Context, *swf.StartWorkflowExecutionInput) (*swf.StartWorkflowExecutionOutput, error)
+
 	TerminateWorkflowExecution(*swf.TerminateWorkflowExecutionInput) (*swf.TerminateWorkflowExecutionOutput, error)
+
+	TerminateWorkflowExecutionWithContext(context.Context, *swf.TerminateWorkflowExecutionInput) (*swf.TerminateWorkflowExecutionOutput, error)
 }
\ No newline at end of file